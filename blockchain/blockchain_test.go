@@ -5,9 +5,15 @@
 package blockchain
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCalcHeightToBlockHeaderKey(t *testing.T) {
@@ -18,3 +24,31 @@ func TestCalcHeightToBlockHeaderKey(t *testing.T) {
 	key = calcHeightToBlockHeaderKey(10)
 	assert.Equal(t, key, []byte("HH:000000000010"))
 }
+
+func TestReadOnlyBlockChain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "readonly")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	//先创建数据库
+	db := dbm.NewDB("blockchain", "leveldb", dir, 16)
+	db.Close()
+
+	dbm.SetReadOnly(true)
+	defer dbm.SetReadOnly(false)
+	q := queue.New("channel")
+	chain := New(&types.BlockChain{Driver: "leveldb", DbPath: dir, DbCache: 16})
+	chain.SetQueueClient(q.Client())
+	defer chain.Close()
+
+	client := q.Client()
+	msg := client.NewMessage("blockchain", types.EventAddBlockDetail, &types.BlockDetail{Block: &types.Block{}})
+	require.NoError(t, client.Send(msg, true))
+	_, err = client.Wait(msg)
+	assert.Equal(t, types.ErrReadOnly, err)
+
+	msg = client.NewMessage("blockchain", types.EventGetBlockHeight, nil)
+	require.NoError(t, client.Send(msg, true))
+	resp, err := client.Wait(msg)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), resp.GetData().(*types.ReplyBlockHeight).Height)
+}
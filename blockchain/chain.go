@@ -223,8 +223,10 @@ func (chain *BlockChain) SetQueueClient(client queue.Client) {
 
 	//recv 消息的处理，共识模块需要获取lastblock从数据库中
 	chain.recvwg.Add(1)
-	//初始化blockchian模块
-	chain.pushseq.init()
+	//初始化blockchian模块，只读节点不推送区块序列
+	if !dbm.IsReadOnly() {
+		chain.pushseq.init()
+	}
 	chain.InitBlockChain()
 	go chain.ProcRecvMsg()
 }
@@ -260,7 +262,7 @@ func (chain *BlockChain) InitBlockChain() {
 
 	//获取数据库中最新的区块高度，以及blockchain的数据库版本号
 	curdbver := chain.blockStore.GetDbVersion()
-	if curdbver == 0 && curheight == -1 {
+	if curdbver == 0 && curheight == -1 && !dbm.IsReadOnly() {
 		curdbver = 1
 		err := chain.blockStore.SetDbVersion(curdbver)
 		//设置失败后恢复成原来的值保持和types.S("dbversion", curdbver)设置的版本一致
@@ -270,7 +272,8 @@ func (chain *BlockChain) InitBlockChain() {
 		}
 	}
 	types.S("dbversion", curdbver)
-	if !chain.cfg.IsParaChain {
+	//只读节点不同步区块
+	if !chain.cfg.IsParaChain && !dbm.IsReadOnly() {
 		// 定时检测/同步block
 		go chain.SynRoutine()
 
@@ -27,6 +27,8 @@ func (chain *BlockChain) procLocalDB(msgtype int64, msg *queue.Message, reqnum c
 		go chain.processMsg(msg, reqnum, chain.localNew)
 	case types.EventLocalClose:
 		go chain.processMsg(msg, reqnum, chain.localClose)
+	case types.EventGetValueHistory:
+		go chain.processMsg(msg, reqnum, chain.getValueHistory)
 	default:
 		return false
 	}
@@ -147,6 +149,37 @@ func (chain *BlockChain) localList(msg *queue.Message) {
 	msg.Reply(chain.client.NewMessage("", types.EventLocalReplyValue, &types.LocalReplyValue{Values: values}))
 }
 
+//一次最多返回的历史值个数
+const maxValueHistoryCount = 1000
+
+//获取状态数据key的历史值，数据是执行器的mvcc插件记录的
+func (chain *BlockChain) getValueHistory(msg *queue.Message) {
+	req := (msg.Data).(*types.ReqValueHistory)
+	if len(req.Key) == 0 || req.Count < 0 || req.Count > maxValueHistoryCount {
+		msg.Reply(chain.client.NewMessage("", types.EventReplyValueHistory, types.ErrInvalidParam))
+		return
+	}
+	count := int(req.Count)
+	if count == 0 {
+		count = 20
+	}
+	localdb := db.NewKVDB(chain.blockStore.db)
+	if _, err := localdb.Get(types.FlagKeyMVCC); err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventReplyValueHistory, types.ErrActionNotSupport))
+		return
+	}
+	height := req.Height
+	if height <= 0 {
+		height = -1
+	}
+	values, err := db.NewSimpleMVCC(localdb).GetHistory(req.Key, height, count)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventReplyValueHistory, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventReplyValueHistory, &types.ValueHistory{Key: req.Key, Values: values}))
+}
+
 //获取指定前缀key的数量
 func (chain *BlockChain) localPrefixCount(msg *queue.Message) {
 	Prefix := (msg.Data).(*types.ReqKey)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/util/testnode"
 	"github.com/stretchr/testify/assert"
 )
@@ -193,3 +194,45 @@ func TestLocalDBCommit(t *testing.T) {
 	assert.Equal(t, err, nil)
 	assert.Equal(t, 0, len(values.Values))
 }
+
+func TestGetValueHistory(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.Exec.EnableMVCC = true
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	api := mock33.GetAPI()
+	addr := mock33.GetHotAddress()
+	key := []byte("mavl-coins-" + types.GetCoinSymbol() + "-" + addr)
+	for i := 1; i <= 2; i++ {
+		mock33.SendTx(util.CreateCoinsTx(mock33.GetGenesisKey(), addr, int64(i)*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+
+	history, err := api.GetValueHistory(&types.ReqValueHistory{Key: key})
+	assert.Nil(t, err)
+	assert.Equal(t, key, history.Key)
+	assert.Equal(t, 2, len(history.Values))
+	assert.True(t, history.Values[0].Height > history.Values[1].Height)
+	//历史值和对应高度的状态一致
+	for _, v := range history.Values {
+		var acc types.Account
+		assert.Nil(t, types.Decode(v.Value, &acc))
+		state := mock33.GetAccount(mock33.GetBlock(v.Height).StateHash, addr)
+		assert.Equal(t, state.Balance, acc.Balance)
+	}
+
+	history, err = api.GetValueHistory(&types.ReqValueHistory{Key: key, Height: history.Values[0].Height - 1, Count: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history.Values))
+
+	_, err = api.GetValueHistory(&types.ReqValueHistory{Count: 1})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestGetValueHistoryWithoutMVCC(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	_, err := mock33.GetAPI().GetValueHistory(&types.ReqValueHistory{Key: []byte("mavl-coins-bty-")})
+	assert.Equal(t, types.ErrActionNotSupport, err)
+}
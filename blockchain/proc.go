@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 
 	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
 )
@@ -25,6 +26,10 @@ func (chain *BlockChain) ProcRecvMsg() {
 		if chain.procLocalDB(msgtype, msg, reqnum) {
 			continue
 		}
+		if dbm.IsReadOnly() && isWriteEvent(msgtype) {
+			go chain.processMsg(msg, reqnum, chain.readOnlyMsg)
+			continue
+		}
 		switch msgtype {
 		case types.EventQueryTx:
 			go chain.processMsg(msg, reqnum, chain.queryTx)
@@ -96,6 +101,21 @@ func (chain *BlockChain) ProcRecvMsg() {
 	}
 }
 
+//isWriteEvent 需要写区块数据库的消息，只读节点不处理
+func isWriteEvent(ty int64) bool {
+	switch ty {
+	case types.EventSyncBlock, types.EventAddBlockDetail, types.EventBroadcastAddBlock, types.EventAddBlockHeaders,
+		types.EventDelParaChainBlockDetail, types.EventAddParaChainBlockDetail, types.EventAddBlockSeqCB:
+		return true
+	}
+	return false
+}
+
+func (chain *BlockChain) readOnlyMsg(msg *queue.Message) {
+	chainlog.Debug("ProcRecvMsg read only node", "msgtype", types.GetEventName(int(msg.Ty)))
+	msg.Reply(chain.client.NewMessage("", msg.Ty, types.ErrReadOnly))
+}
+
 func (chain *BlockChain) unknowMsg(msg *queue.Message) {
 	chainlog.Warn("ProcRecvMsg unknow msg", "msgtype", msg.Ty)
 }
@@ -267,6 +267,13 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *types.BlockDetail)
 	chainlog.Debug("connectBestChain node", "height", node.height, "hash", common.ToHex(node.hash), "parentHash", common.ToHex(parentHash))
 	chainlog.Debug("connectBestChain block", "height", block.Block.Height, "hash", common.ToHex(block.Block.Hash()))
 
+	//不能回滚到最终确认的检查点之前
+	fork := b.bestChain.FindFork(node)
+	if finalized := b.getFinalizedHeight(); fork != nil && fork.height < finalized {
+		chainlog.Error("connectBestChain reorg past finalized checkpoint", "fork.height", fork.height, "finalized", finalized, "Block hash", common.ToHex(node.hash))
+		return nil, false, types.ErrReorgFinalized
+	}
+
 	// 获取需要重组的block node
 	detachNodes, attachNodes := b.getReorganizeNodes(node)
 
@@ -55,3 +55,17 @@ func (q *Query) getStateHash() (stateHash []byte) {
 	defer q.mu.Unlock()
 	return q.stateHash
 }
+
+//getFinalizedHeight 从finality合约读取最终确认的检查点高度，没有部署finality合约的时候返回0
+func (chain *BlockChain) getFinalizedHeight() int64 {
+	msg, err := chain.query.Query(types.ExecName("finality"), "GetFinalized", &types.ReqNil{})
+	if err != nil {
+		return 0
+	}
+	if checkpoint, ok := msg.(interface {
+		GetHeight() int64
+	}); ok {
+		return checkpoint.GetHeight()
+	}
+	return 0
+}
@@ -36,6 +36,7 @@ var errAPIEnv = errors.New("ErrAPIEnv")
 type ExecutorAPI interface {
 	GetBlockByHashes(param *types.ReqHashes) (*types.BlockDetails, error)
 	GetRandNum(param *types.ReqRandHash) ([]byte, error)
+	GetBlockHash(param *types.ReqInt) (*types.ReplyHash, error)
 	QueryTx(param *types.ReqHash) (*types.TransactionDetail, error)
 	IsErr() bool
 }
@@ -79,6 +80,11 @@ func (api *mainChainAPI) GetBlockByHashes(param *types.ReqHashes) (*types.BlockD
 	return data, seterr(err, &api.errflag)
 }
 
+func (api *mainChainAPI) GetBlockHash(param *types.ReqInt) (*types.ReplyHash, error) {
+	data, err := api.api.GetBlockHash(param)
+	return data, seterr(err, &api.errflag)
+}
+
 type paraChainAPI struct {
 	api        client.QueueProtocolAPI
 	grpcClient types.Chain33Client
@@ -118,6 +124,12 @@ func (api *paraChainAPI) GetBlockByHashes(param *types.ReqHashes) (*types.BlockD
 	return data, seterr(err, &api.errflag)
 }
 
+//GetBlockHash 平行链执行器的高度是平行链自己的高度，区块hash从本地的平行链获取
+func (api *paraChainAPI) GetBlockHash(param *types.ReqInt) (*types.ReplyHash, error) {
+	data, err := api.api.GetBlockHash(param)
+	return data, seterr(err, &api.errflag)
+}
+
 func seterr(err error, flag *int32) error {
 	if IsGrpcError(err) || IsQueueError(err) {
 		atomic.StoreInt32(flag, 1)
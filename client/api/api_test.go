@@ -45,6 +45,10 @@ func TestAPI(t *testing.T) {
 	txdetail, err := eapi.QueryTx(param3)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), txdetail.Height)
+	api.On("GetBlockHash", mock.Anything).Return(&types.ReplyHash{Hash: []byte("hash")}, nil)
+	blockhash, err := eapi.GetBlockHash(&types.ReqInt{Height: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hash"), blockhash.Hash)
 	types.SetTitleOnlyForTest("user.p.wzw.")
 	//testnode setup
 	rpcCfg := new(types.RPC)
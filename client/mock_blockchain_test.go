@@ -31,6 +31,12 @@ func (m *mockBlockChain) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventSyncBlock:
+				if req, ok := msg.GetData().(*types.BlockPid); ok && req.Block.Height > 0 {
+					msg.Reply(client.NewMessage(blockchainKey, types.EventReply, &types.Reply{IsOk: true}))
+				} else {
+					msg.Reply(client.NewMessage(blockchainKey, types.EventReply, &types.Reply{Msg: []byte(types.ErrBlockExist.Error())}))
+				}
 			case types.EventGetTransactionByAddr:
 				if req, ok := msg.GetData().(*types.ReqAddr); ok {
 					if req.Flag == 1 {
@@ -128,6 +134,8 @@ func (m *mockBlockChain) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventGetValueHistory:
+				msg.Reply(client.NewMessage(blockchainKey, types.EventReplyValueHistory, &types.ValueHistory{}))
 			case types.EventLocalList:
 				if req, ok := msg.GetData().(*types.LocalDBList); ok {
 					if len(req.Key) > 0 && bytes.Equal(req.Key, []byte("Statistics:TicketInfoOrder:Addr:case1")) {
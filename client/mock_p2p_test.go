@@ -23,6 +23,18 @@ func (m *mockP2P) SetQueueClient(q queue.Queue) {
 				msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, &types.PeerList{}))
 			case types.EventGetNetInfo:
 				msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, &types.NodeNetInfo{}))
+			case types.EventNetPeers:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetPeers, &types.NetPeers{}))
+			case types.EventNetAddPeer, types.EventNetRemovePeer, types.EventNetBanPeer, types.EventNetUnbanPeer:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReply, &types.Reply{IsOk: true}))
+			case types.EventNetBannedPeers:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetBannedPeers, &types.NetBannedPeers{}))
+			case types.EventNetSelfInfo:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetSelfInfo, &types.NetSelfInfo{}))
+			case types.EventNetAddrBook:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetAddrBook, &types.AddrBookEntries{}))
+			case types.EventNetAddrBookRemove, types.EventNetAddrBookSeed:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetAddrBookEdit, &types.ReplyAddrBookEdit{}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
@@ -30,6 +30,8 @@ func (m *mockStore) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventStoreGetProof:
+				msg.Reply(client.NewMessage("store", types.EventStoreGetProofReply, &types.StoreProof{}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
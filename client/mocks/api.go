@@ -11,6 +11,29 @@ type QueueProtocolAPI struct {
 	mock.Mock
 }
 
+// AddMempoolTxEventCallBack provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) AddMempoolTxEventCallBack(param *types.MempoolEventCB) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.MempoolEventCB) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.MempoolEventCB) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddSeqCallBack provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) AddSeqCallBack(param *types.BlockSeqCB) (*types.Reply, error) {
 	ret := _m.Called(param)
@@ -223,6 +246,29 @@ func (_m *QueueProtocolAPI) GetBlockBySeq(param *types.Int64) (*types.BlockSeq,
 	return r0, r1
 }
 
+// GetBlockCandidate provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetBlockCandidate(param *types.ReqBlockCandidate) (*types.ReplyTxList, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyTxList
+	if rf, ok := ret.Get(0).(func(*types.ReqBlockCandidate) *types.ReplyTxList); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyTxList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqBlockCandidate) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockHash provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetBlockHash(param *types.ReqInt) (*types.ReplyHash, error) {
 	ret := _m.Called(param)
@@ -660,6 +706,29 @@ func (_m *QueueProtocolAPI) GetWalletStatus() (*types.WalletStatus, error) {
 	return r0, r1
 }
 
+// ImportBlock provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) ImportBlock(param *types.Block) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.Block) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Block) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsNtpClockSync provides a mock function with given fields:
 func (_m *QueueProtocolAPI) IsNtpClockSync() (*types.Reply, error) {
 	ret := _m.Called()
@@ -794,6 +863,29 @@ func (_m *QueueProtocolAPI) LocalGet(param *types.LocalDBGet) (*types.LocalReply
 	return r0, r1
 }
 
+// GetValueHistory provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetValueHistory(param *types.ReqValueHistory) (*types.ValueHistory, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ValueHistory
+	if rf, ok := ret.Get(0).(func(*types.ReqValueHistory) *types.ValueHistory); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ValueHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqValueHistory) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LocalList provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) LocalList(param *types.LocalDBList) (*types.LocalReplyValue, error) {
 	ret := _m.Called(param)
@@ -868,6 +960,236 @@ func (_m *QueueProtocolAPI) LocalSet(param *types.LocalDBSet) error {
 	return r0
 }
 
+// NetAddPeer provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) NetAddPeer(param *types.ReqNetPeer) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqNetPeer) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqNetPeer) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetAddrBook provides a mock function with given fields:
+func (_m *QueueProtocolAPI) NetAddrBook() (*types.AddrBookEntries, error) {
+	ret := _m.Called()
+
+	var r0 *types.AddrBookEntries
+	if rf, ok := ret.Get(0).(func() *types.AddrBookEntries); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.AddrBookEntries)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetAddrBookRemove provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) NetAddrBookRemove(param *types.ReqAddrBookRemove) (*types.ReplyAddrBookEdit, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyAddrBookEdit
+	if rf, ok := ret.Get(0).(func(*types.ReqAddrBookRemove) *types.ReplyAddrBookEdit); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyAddrBookEdit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqAddrBookRemove) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetAddrBookSeed provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) NetAddrBookSeed(param *types.ReqAddrBookSeed) (*types.ReplyAddrBookEdit, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyAddrBookEdit
+	if rf, ok := ret.Get(0).(func(*types.ReqAddrBookSeed) *types.ReplyAddrBookEdit); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyAddrBookEdit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqAddrBookSeed) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetBanPeer provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) NetBanPeer(param *types.ReqNetPeer) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqNetPeer) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqNetPeer) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetBannedPeers provides a mock function with given fields:
+func (_m *QueueProtocolAPI) NetBannedPeers() (*types.NetBannedPeers, error) {
+	ret := _m.Called()
+
+	var r0 *types.NetBannedPeers
+	if rf, ok := ret.Get(0).(func() *types.NetBannedPeers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.NetBannedPeers)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetPeers provides a mock function with given fields:
+func (_m *QueueProtocolAPI) NetPeers() (*types.NetPeers, error) {
+	ret := _m.Called()
+
+	var r0 *types.NetPeers
+	if rf, ok := ret.Get(0).(func() *types.NetPeers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.NetPeers)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetRemovePeer provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) NetRemovePeer(param *types.ReqNetPeer) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqNetPeer) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqNetPeer) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetSelfInfo provides a mock function with given fields:
+func (_m *QueueProtocolAPI) NetSelfInfo() (*types.NetSelfInfo, error) {
+	ret := _m.Called()
+
+	var r0 *types.NetSelfInfo
+	if rf, ok := ret.Get(0).(func() *types.NetSelfInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.NetSelfInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetUnbanPeer provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) NetUnbanPeer(param *types.ReqNetPeer) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqNetPeer) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqNetPeer) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewAccount provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) NewAccount(param *types.ReqNewAccount) (*types.WalletAccount, error) {
 	ret := _m.Called(param)
@@ -1160,6 +1482,29 @@ func (_m *QueueProtocolAPI) StoreGet(_a0 *types.StoreGet) (*types.StoreReplyValu
 	return r0, r1
 }
 
+// StoreGetProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) StoreGetProof(param *types.ReqStoreProof) (*types.StoreProof, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.StoreProof
+	if rf, ok := ret.Get(0).(func(*types.ReqStoreProof) *types.StoreProof); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StoreProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqStoreProof) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreGetTotalCoins provides a mock function with given fields: _a0
 func (_m *QueueProtocolAPI) StoreGetTotalCoins(_a0 *types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error) {
 	ret := _m.Called(_a0)
@@ -167,6 +167,24 @@ func (q *QueueProtocol) GetBlocks(param *types.ReqBlocks) (*types.BlockDetails,
 	return nil, err
 }
 
+// ImportBlock add block to blockchain as if it was downloaded from peer, used to restore blocks dumped from other node
+func (q *QueueProtocol) ImportBlock(param *types.Block) (*types.Reply, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("ImportBlock", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(blockchainKey, types.EventSyncBlock, &types.BlockPid{Pid: "import", Block: param})
+	if err != nil {
+		log.Error("ImportBlock", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // QueryTx query transaction detail by transaction hash from blockchain
 func (q *QueueProtocol) QueryTx(param *types.ReqHash) (*types.TransactionDetail, error) {
 	if param == nil {
@@ -481,6 +499,42 @@ func (q *QueueProtocol) GetProperFee() (*types.ReplyProperFee, error) {
 	return nil, types.ErrTypeAsset
 }
 
+// GetBlockCandidate get transactions sorted by fee rate from mempool for packing block
+func (q *QueueProtocol) GetBlockCandidate(param *types.ReqBlockCandidate) (*types.ReplyTxList, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetBlockCandidate", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(mempoolKey, types.EventGetBlockCandidate, param)
+	if err != nil {
+		log.Error("GetBlockCandidate", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyTxList); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// AddMempoolTxEventCallBack add callback for pushing tx events of mempool
+func (q *QueueProtocol) AddMempoolTxEventCallBack(param *types.MempoolEventCB) (*types.Reply, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("AddMempoolTxEventCallBack", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(mempoolKey, types.EventAddMempoolTxEventCB, param)
+	if err != nil {
+		log.Error("AddMempoolTxEventCallBack", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetBlockOverview get block head detil by hash
 func (q *QueueProtocol) GetBlockOverview(param *types.ReqHash) (*types.BlockOverview, error) {
 	if param == nil {
@@ -835,6 +889,24 @@ func (q *QueueProtocol) LocalList(param *types.LocalDBList) (*types.LocalReplyVa
 	return nil, types.ErrTypeAsset
 }
 
+// GetValueHistory get the values of a state key at the heights it changed, need mvcc enabled
+func (q *QueueProtocol) GetValueHistory(param *types.ReqValueHistory) (*types.ValueHistory, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetValueHistory", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(blockchainKey, types.EventGetValueHistory, param)
+	if err != nil {
+		log.Error("GetValueHistory", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ValueHistory); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetLastHeader get the current head detail
 func (q *QueueProtocol) GetLastHeader() (*types.Header, error) {
 	msg, err := q.query(blockchainKey, types.EventGetLastHeader, &types.ReqNil{})
@@ -857,6 +929,7 @@ func (q *QueueProtocol) Version() (*types.VersionInfo, error) {
 		App:     version.GetAppVersion(),
 		Chain33: version.GetVersion(),
 		LocalDb: version.GetLocalDBVersion(),
+		ChainID: types.GetChainID(),
 	}, nil
 }
 
@@ -875,6 +948,131 @@ func (q *QueueProtocol) GetNetInfo() (*types.NodeNetInfo, error) {
 	return nil, err
 }
 
+// NetPeers get the outbound and inbound peers with latency and version
+func (q *QueueProtocol) NetPeers() (*types.NetPeers, error) {
+	msg, err := q.query(p2pKey, types.EventNetPeers, &types.ReqNil{})
+	if err != nil {
+		log.Error("NetPeers", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.NetPeers); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+func (q *QueueProtocol) netPeerAdmin(ty int64, param *types.ReqNetPeer) (*types.Reply, error) {
+	if param == nil || param.Addr == "" {
+		err := types.ErrInvalidParam
+		log.Error("netPeerAdmin", "event", types.GetEventName(int(ty)), "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(p2pKey, ty, param)
+	if err != nil {
+		log.Error("netPeerAdmin", "event", types.GetEventName(int(ty)), "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// NetAddPeer connect to the peer, remove it from the blacklist first
+func (q *QueueProtocol) NetAddPeer(param *types.ReqNetPeer) (*types.Reply, error) {
+	return q.netPeerAdmin(types.EventNetAddPeer, param)
+}
+
+// NetRemovePeer disconnect the outbound peer
+func (q *QueueProtocol) NetRemovePeer(param *types.ReqNetPeer) (*types.Reply, error) {
+	return q.netPeerAdmin(types.EventNetRemovePeer, param)
+}
+
+// NetBanPeer add the peer to the blacklist and disconnect it
+func (q *QueueProtocol) NetBanPeer(param *types.ReqNetPeer) (*types.Reply, error) {
+	return q.netPeerAdmin(types.EventNetBanPeer, param)
+}
+
+// NetUnbanPeer remove the peer from the blacklist
+func (q *QueueProtocol) NetUnbanPeer(param *types.ReqNetPeer) (*types.Reply, error) {
+	return q.netPeerAdmin(types.EventNetUnbanPeer, param)
+}
+
+// NetBannedPeers get the blacklist of p2p
+func (q *QueueProtocol) NetBannedPeers() (*types.NetBannedPeers, error) {
+	msg, err := q.query(p2pKey, types.EventNetBannedPeers, &types.ReqNil{})
+	if err != nil {
+		log.Error("NetBannedPeers", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.NetBannedPeers); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// NetSelfInfo get the node id and addresses of this node
+func (q *QueueProtocol) NetSelfInfo() (*types.NetSelfInfo, error) {
+	msg, err := q.query(p2pKey, types.EventNetSelfInfo, &types.ReqNil{})
+	if err != nil {
+		log.Error("NetSelfInfo", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.NetSelfInfo); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// NetAddrBook get the known addresses of p2p with the connection stats
+func (q *QueueProtocol) NetAddrBook() (*types.AddrBookEntries, error) {
+	msg, err := q.query(p2pKey, types.EventNetAddrBook, &types.ReqNil{})
+	if err != nil {
+		log.Error("NetAddrBook", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.AddrBookEntries); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// NetAddrBookRemove remove the given or stale addresses from the address book
+func (q *QueueProtocol) NetAddrBookRemove(param *types.ReqAddrBookRemove) (*types.ReplyAddrBookEdit, error) {
+	if param == nil || (len(param.Addrs) == 0 && param.MinAttempts <= 0 && param.StaleTime <= 0) {
+		err := types.ErrInvalidParam
+		log.Error("NetAddrBookRemove", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(p2pKey, types.EventNetAddrBookRemove, param)
+	if err != nil {
+		log.Error("NetAddrBookRemove", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyAddrBookEdit); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// NetAddrBookSeed add addresses to the address book
+func (q *QueueProtocol) NetAddrBookSeed(param *types.ReqAddrBookSeed) (*types.ReplyAddrBookEdit, error) {
+	if param == nil || len(param.Addrs) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("NetAddrBookSeed", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(p2pKey, types.EventNetAddrBookSeed, param)
+	if err != nil {
+		log.Error("NetAddrBookSeed", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyAddrBookEdit); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // SignRawTx sign transaction return the sign tx data
 func (q *QueueProtocol) SignRawTx(param *types.ReqSignRawTx) (*types.ReplySignRawTx, error) {
 	if param == nil {
@@ -936,6 +1134,24 @@ func (q *QueueProtocol) StoreList(param *types.StoreList) (*types.StoreListReply
 	return nil, types.ErrTypeAsset
 }
 
+// StoreGetProof get the existence or absence proof of a key from statedb
+func (q *QueueProtocol) StoreGetProof(param *types.ReqStoreProof) (*types.StoreProof, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("StoreGetProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(storeKey, types.EventStoreGetProof, param)
+	if err != nil {
+		log.Error("StoreGetProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StoreProof); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // StoreGetTotalCoins get total coins from statedb
 func (q *QueueProtocol) StoreGetTotalCoins(param *types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error) {
 	if param == nil {
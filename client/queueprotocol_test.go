@@ -61,6 +61,7 @@ func TestQueueProtocol(t *testing.T) {
 	testSendTx(t, api)
 	testGetTxList(t, api)
 	testGetBlocks(t, api)
+	testImportBlock(t, api)
 	testGetTransactionByAddr(t, api)
 	testQueryTx(t, api)
 	testGetTransactionByHash(t, api)
@@ -80,6 +81,8 @@ func TestQueueProtocol(t *testing.T) {
 	testGetHeaders(t, api)
 	testGetLastMempool(t, api)
 	testGetProperFee(t, api)
+	testGetBlockCandidate(t, api)
+	testAddMempoolTxEventCallBack(t, api)
 	testGetBlockOverview(t, api)
 	testGetAddrOverview(t, api)
 	testGetBlockHash(t, api)
@@ -93,10 +96,14 @@ func TestQueueProtocol(t *testing.T) {
 	testLocalGet(t, api)
 	testLocalTransaction(t, api)
 	testLocalList(t, api)
+	testGetValueHistory(t, api)
+	testNetPeerAdmin(t, api)
+	testNetAddrBook(t, api)
 	testGetLastHeader(t, api)
 	testSignRawTx(t, api)
 	testStoreGetTotalCoins(t, api)
 	testStoreList(t, api)
+	testStoreGetProof(t, api)
 	testBlockChainQuery(t, api)
 }
 
@@ -148,6 +155,79 @@ func testStoreList(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testGetValueHistory(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.GetValueHistory(&types.ReqValueHistory{Key: []byte("key")})
+	if err != nil {
+		t.Error("Call GetValueHistory Failed.", err)
+	}
+
+	_, err = api.GetValueHistory(nil)
+	if err == nil {
+		t.Error("GetValueHistory(nil) need return error.")
+	}
+}
+
+func testNetPeerAdmin(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.NetPeers()
+	require.Nil(t, err)
+	_, err = api.NetBannedPeers()
+	require.Nil(t, err)
+	_, err = api.NetSelfInfo()
+	require.Nil(t, err)
+
+	req := &types.ReqNetPeer{Addr: "192.168.1.1:13802", BanTime: 60}
+	for _, fn := range []func(*types.ReqNetPeer) (*types.Reply, error){api.NetAddPeer, api.NetRemovePeer, api.NetBanPeer, api.NetUnbanPeer} {
+		reply, err := fn(req)
+		require.Nil(t, err)
+		require.True(t, reply.IsOk)
+		_, err = fn(nil)
+		require.Equal(t, types.ErrInvalidParam, err)
+		_, err = fn(&types.ReqNetPeer{})
+		require.Equal(t, types.ErrInvalidParam, err)
+	}
+}
+
+func testImportBlock(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.ImportBlock(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	reply, err := api.ImportBlock(&types.Block{Height: 1})
+	require.Nil(t, err)
+	require.True(t, reply.IsOk)
+
+	reply, err = api.ImportBlock(&types.Block{})
+	require.Nil(t, err)
+	require.False(t, reply.IsOk)
+	require.Equal(t, types.ErrBlockExist.Error(), string(reply.Msg))
+}
+
+func testNetAddrBook(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.NetAddrBook()
+	require.Nil(t, err)
+
+	_, err = api.NetAddrBookRemove(&types.ReqAddrBookRemove{MinAttempts: 3})
+	require.Nil(t, err)
+	_, err = api.NetAddrBookRemove(&types.ReqAddrBookRemove{})
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	_, err = api.NetAddrBookSeed(&types.ReqAddrBookSeed{Addrs: []string{"192.168.1.1:13802"}})
+	require.Nil(t, err)
+	_, err = api.NetAddrBookSeed(&types.ReqAddrBookSeed{})
+	require.Equal(t, types.ErrInvalidParam, err)
+}
+
+func testStoreGetProof(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.StoreGetProof(&types.ReqStoreProof{})
+	if err != nil {
+		t.Error("Call StoreGetProof Failed.", err)
+	}
+
+	_, err = api.StoreGetProof(nil)
+	if err == nil {
+		t.Error("StoreGetProof(nil) need return error.")
+	}
+}
+
 func testSignRawTx(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.SignRawTx(&types.ReqSignRawTx{})
 	if err != nil {
@@ -349,6 +429,28 @@ func testGetProperFee(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testGetBlockCandidate(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.GetBlockCandidate(&types.ReqBlockCandidate{Height: 1})
+	if err != nil {
+		t.Error("Call GetBlockCandidate Failed.", err)
+	}
+	_, err = api.GetBlockCandidate(nil)
+	if err == nil {
+		t.Error("GetBlockCandidate(nil) need return error.")
+	}
+}
+
+func testAddMempoolTxEventCallBack(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.AddMempoolTxEventCallBack(&types.MempoolEventCB{Name: "test", URL: "http://127.0.0.1:8080"})
+	if err != nil {
+		t.Error("Call AddMempoolTxEventCallBack Failed.", err)
+	}
+	_, err = api.AddMempoolTxEventCallBack(nil)
+	if err == nil {
+		t.Error("AddMempoolTxEventCallBack(nil) need return error.")
+	}
+}
+
 func testGetHeaders(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.GetHeaders(&types.ReqBlocks{})
 	if err != nil {
@@ -889,6 +991,7 @@ func testVersionGRPC(t *testing.T, rpc *mockGRPCSystem) {
 		t.Error("Call Version Failed.", err)
 	}
 	assert.Equal(t, version.GetVersion(), res.Chain33)
+	assert.Equal(t, types.GetChainID(), res.ChainID)
 }
 
 func testDumpPrivkeyGRPC(t *testing.T, rpc *mockGRPCSystem) {
@@ -26,6 +26,10 @@ type QueueProtocolAPI interface {
 	GetLastMempool() (*types.ReplyTxList, error)
 	// types.EventGetProperFee
 	GetProperFee() (*types.ReplyProperFee, error)
+	// types.EventGetBlockCandidate
+	GetBlockCandidate(param *types.ReqBlockCandidate) (*types.ReplyTxList, error)
+	// types.EventAddMempoolTxEventCB
+	AddMempoolTxEventCallBack(param *types.MempoolEventCB) (*types.Reply, error)
 	// +++++++++++++++ execs interfaces begin
 	// types.EventBlockChainQuery
 	Query(driver, funcname string, param types.Message) (types.Message, error)
@@ -41,6 +45,26 @@ type QueueProtocolAPI interface {
 	PeerInfo() (*types.PeerList, error)
 	// types.EventGetNetInfo
 	GetNetInfo() (*types.NodeNetInfo, error)
+	// types.EventNetPeers
+	NetPeers() (*types.NetPeers, error)
+	// types.EventNetAddPeer
+	NetAddPeer(param *types.ReqNetPeer) (*types.Reply, error)
+	// types.EventNetRemovePeer
+	NetRemovePeer(param *types.ReqNetPeer) (*types.Reply, error)
+	// types.EventNetBanPeer
+	NetBanPeer(param *types.ReqNetPeer) (*types.Reply, error)
+	// types.EventNetUnbanPeer
+	NetUnbanPeer(param *types.ReqNetPeer) (*types.Reply, error)
+	// types.EventNetBannedPeers
+	NetBannedPeers() (*types.NetBannedPeers, error)
+	// types.EventNetSelfInfo
+	NetSelfInfo() (*types.NetSelfInfo, error)
+	// types.EventNetAddrBook
+	NetAddrBook() (*types.AddrBookEntries, error)
+	// types.EventNetAddrBookRemove
+	NetAddrBookRemove(param *types.ReqAddrBookRemove) (*types.ReplyAddrBookEdit, error)
+	// types.EventNetAddrBookSeed
+	NetAddrBookSeed(param *types.ReqAddrBookSeed) (*types.ReplyAddrBookEdit, error)
 	// --------------- p2p interfaces end
 	// +++++++++++++++ wallet interfaces begin
 	// types.EventLocalGet
@@ -59,6 +83,8 @@ type QueueProtocolAPI interface {
 	LocalSet(param *types.LocalDBSet) error
 	// types.EventLocalList
 	LocalList(param *types.LocalDBList) (*types.LocalReplyValue, error)
+	// types.EventGetValueHistory
+	GetValueHistory(param *types.ReqValueHistory) (*types.ValueHistory, error)
 	// types.EventWalletGetAccountList
 	WalletGetAccountList(req *types.ReqAccountList) (*types.WalletAccounts, error)
 	// types.EventNewAccount
@@ -98,6 +124,8 @@ type QueueProtocolAPI interface {
 	WalletCreateTx(param *types.ReqCreateTransaction) (*types.Transaction, error)
 	// types.EventGetBlocks
 	GetBlocks(param *types.ReqBlocks) (*types.BlockDetails, error)
+	// types.EventSyncBlock
+	ImportBlock(param *types.Block) (*types.Reply, error)
 	// types.EventQueryTx
 	QueryTx(param *types.ReqHash) (*types.TransactionDetail, error)
 	// types.EventGetTransactionByAddr
@@ -136,6 +164,8 @@ type QueueProtocolAPI interface {
 	StoreGet(*types.StoreGet) (*types.StoreReplyValue, error)
 	StoreGetTotalCoins(*types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error)
 	StoreList(param *types.StoreList) (*types.StoreListReply, error)
+	// types.EventStoreGetProof
+	StoreGetProof(param *types.ReqStoreProof) (*types.StoreProof, error)
 	// --------------- store interfaces end
 
 	// +++++++++++++++ other interfaces begin
@@ -0,0 +1,60 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/spf13/cobra"
+)
+
+//MigrateDBCmd 把一个数据目录转换成另外一种数据库驱动，比如leveldb转换成gobadgerdb
+func MigrateDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migratedb",
+		Short: "Convert a data directory to another db driver",
+		Run:   migrateDB,
+	}
+	addMigrateDBFlags(cmd)
+	return cmd
+}
+
+func addMigrateDBFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("name", "n", "", "db name, e.g. blockchain or store")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("src", "s", "", "source data directory")
+	cmd.MarkFlagRequired("src")
+	cmd.Flags().StringP("dst", "d", "", "destination data directory")
+	cmd.MarkFlagRequired("dst")
+	cmd.Flags().StringP("from", "f", "leveldb", "source db driver")
+	cmd.Flags().StringP("to", "t", "gobadgerdb", "destination db driver")
+	cmd.Flags().Int32P("cache", "c", 128, "db cache size(MB)")
+	cmd.Flags().IntP("batch", "b", 1024*1024, "batch size(bytes)")
+}
+
+func migrateDB(cmd *cobra.Command, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	src, _ := cmd.Flags().GetString("src")
+	dst, _ := cmd.Flags().GetString("dst")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	cache, _ := cmd.Flags().GetInt32("cache")
+	batch, _ := cmd.Flags().GetInt("batch")
+	if src == dst {
+		fmt.Println("src and dst should be different directories")
+		return
+	}
+	srcdb := db.NewDB(name, from, src, cache)
+	defer srcdb.Close()
+	dstdb := db.NewDB(name, to, dst, cache)
+	defer dstdb.Close()
+	count, err := db.MigrateDB(srcdb, dstdb, batch)
+	if err != nil {
+		fmt.Println("migrate db failed", "count", count, "err", err)
+		return
+	}
+	fmt.Println("migrate db success", "count", count)
+}
@@ -27,6 +27,7 @@ func addCommands(rootCmd *cobra.Command) {
 		commands.UpdateInitCmd(),
 		commands.CreatePluginCmd(),
 		commands.GenDappCmd(),
+		commands.MigrateDBCmd(),
 	)
 }
 
@@ -145,6 +145,7 @@ ticketMinerWaitTime = 7200
 maxTxNumber = 1500
 targetTimespan = 2160
 targetTimePerBlock = 15
+difficultyAlgo = "bitcoin"
 [consensus.sub.ticket]
 genesisBlockTime=1526486816
 [[consensus.sub.ticket.genesis]]
@@ -225,8 +226,16 @@ ForkTxHeight= -1
 ForkTxGroupPara= -1
 ForkChainParamV2= -1
 ForkBase58AddressCheck=1800000
+ForkFeeDelegation= -1
+ForkTxGas= -1
+ForkCanonicalEncoding= -1
+ForkMultiSigScript= -1
+ForkTxChainID= -1
+ForkKeyMigration= -1
+ForkMultiAction= -1
 [fork.sub.coins]
 Enable=0
+ForkTransferBatch=0
 [fork.sub.ticket]
 Enable=0
 ForkTicketId = 1200000
@@ -238,6 +247,10 @@ Enable=0
 [fork.sub.manage]
 Enable=0
 ForkManageExec=100000
+ForkManageFreeze=0
+ForkManageApprove=0
+ForkManageSchedule=0
+ForkManageHistory=0
 [fork.sub.token]
 Enable=0
 ForkTokenBlackList= 0
@@ -10,6 +10,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"sync"
 
 	"github.com/33cn/chain33/common"
 	"github.com/decred/base58"
@@ -35,12 +36,63 @@ var ErrAddressChecksum = errors.New("address checksum error")
 //MaxExecNameLength 执行器名最大长度
 const MaxExecNameLength = 100
 
-//NormalVer 普通地址的版本号
+//ErrAddressVersion :
+var ErrAddressVersion = errors.New("address version config error")
+
+//NormalVer 普通地址默认的版本号
 const NormalVer byte = 0
 
-//MultiSignVer 多重签名地址的版本号
+//MultiSignVer 多重签名地址默认的版本号
 const MultiSignVer byte = 5
 
+var (
+	verMu        sync.RWMutex
+	normalVer    = NormalVer
+	multiSignVer = MultiSignVer
+)
+
+//SetAddressVersion 设置本链地址的版本号，不同的链使用不同的版本号，防止交易被重放到其他链或者转错地址
+func SetAddressVersion(normal, multiSign byte) error {
+	if normal == multiSign {
+		return ErrAddressVersion
+	}
+	verMu.Lock()
+	defer verMu.Unlock()
+	if normal == normalVer && multiSign == multiSignVer {
+		return nil
+	}
+	normalVer, multiSignVer = normal, multiSign
+	//缓存的地址是按照原来的版本号计算的
+	addressCache.Purge()
+	pubkeyCache.Purge()
+	multisignCache.Purge()
+	checkAddressCache.Purge()
+	multiCheckAddressCache.Purge()
+	return nil
+}
+
+//GetNormalVer 本链普通地址的版本号
+func GetNormalVer() byte {
+	verMu.RLock()
+	defer verMu.RUnlock()
+	return normalVer
+}
+
+//GetMultiSignVer 本链多重签名地址的版本号
+func GetMultiSignVer() byte {
+	verMu.RLock()
+	defer verMu.RUnlock()
+	return multiSignVer
+}
+
+//checkVersion 只有本链的普通地址和多重签名地址的版本号是有效的
+func checkVersion(ver byte) error {
+	if ver != GetNormalVer() && ver != GetMultiSignVer() {
+		return ErrCheckVersion
+	}
+	return nil
+}
+
 func init() {
 	var err error
 	multisignCache, err = lru.New(10240)
@@ -93,7 +145,7 @@ func MultiSignAddress(pubkey []byte) string {
 	if value, ok := multisignCache.Get(string(pubkey)); ok {
 		return value.(string)
 	}
-	addr := HashToAddress(MultiSignVer, pubkey)
+	addr := HashToAddress(GetMultiSignVer(), pubkey)
 	addrstr := addr.String()
 	multisignCache.Add(string(pubkey), addrstr)
 	return addrstr
@@ -120,7 +172,7 @@ func GetExecAddress(name string) *Address {
 
 //PubKeyToAddress 公钥转为地址
 func PubKeyToAddress(in []byte) *Address {
-	return HashToAddress(NormalVer, in)
+	return HashToAddress(GetNormalVer(), in)
 }
 
 //PubKeyToAddr 公钥转为地址
@@ -128,7 +180,7 @@ func PubKeyToAddr(in []byte) string {
 	if value, ok := pubkeyCache.Get(string(in)); ok {
 		return value.(string)
 	}
-	addr := HashToAddress(NormalVer, in).String()
+	addr := HashToAddress(GetNormalVer(), in).String()
 	pubkeyCache.Add(string(in), addr)
 	return addr
 }
@@ -193,7 +245,7 @@ func CheckMultiSignAddress(addr string) (e error) {
 		}
 		return value.(error)
 	}
-	e = checkAddress(MultiSignVer, addr)
+	e = checkAddress(GetMultiSignVer(), addr)
 	multiCheckAddressCache.Add(addr, e)
 	return
 }
@@ -206,13 +258,16 @@ func CheckAddress(addr string) (e error) {
 		}
 		return value.(error)
 	}
-	e = checkAddress(NormalVer, addr)
+	e = checkAddress(GetNormalVer(), addr)
 	checkAddressCache.Add(addr, e)
 	return
 }
 
-//NewAddrFromString new 地址
+//NewAddrFromString new 地址，同时支持base58和bech32格式
 func NewAddrFromString(hs string) (a *Address, e error) {
+	if IsBech32(hs) {
+		return NewAddrFromBech32(hs)
+	}
 	dec := base58.Decode(hs)
 	if dec == nil {
 		e = errors.New("Cannot decode b58 string '" + hs + "'")
@@ -222,6 +277,9 @@ func NewAddrFromString(hs string) (a *Address, e error) {
 		e = errors.New("Address too short " + hex.EncodeToString(dec))
 		return
 	}
+	if e = checkVersion(dec[0]); e != nil {
+		return
+	}
 	if len(dec) == 25 {
 		sh := common.Sha2Sum(dec[0:21])
 		if !bytes.Equal(sh[:4], dec[21:25]) {
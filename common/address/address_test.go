@@ -112,3 +112,30 @@ func TestExecPubKey(t *testing.T) {
 	pubkey := ExecPubKey("test")
 	assert.True(t, len(pubkey) == 32)
 }
+
+func TestAddressVersion(t *testing.T) {
+	defer SetAddressVersion(NormalVer, MultiSignVer)
+	key := genkey()
+	pub := key.PubKey().Bytes()
+	legacy := PubKeyToAddr(pub)
+	legacyMulti := MultiSignAddress(pub)
+	b32 := PubKeyToAddress(pub).Bech32()
+
+	assert.Equal(t, ErrAddressVersion, SetAddressVersion(3, 3))
+	require.NoError(t, SetAddressVersion(0x10, 0x11))
+	addr := PubKeyToAddr(pub)
+	assert.NotEqual(t, legacy, addr)
+	assert.Nil(t, CheckAddress(addr))
+	assert.Nil(t, CheckMultiSignAddress(MultiSignAddress(pub)))
+
+	//其他链的地址不能通过检查，也不能解析
+	assert.Equal(t, ErrCheckVersion, CheckAddress(legacy))
+	assert.Equal(t, ErrCheckVersion, CheckMultiSignAddress(legacyMulti))
+	_, err := NewAddrFromString(legacy)
+	assert.Equal(t, ErrCheckVersion, err)
+	_, err = NewAddrFromString(b32)
+	assert.Equal(t, ErrCheckVersion, err)
+	a, err := NewAddrFromString(addr)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x10), a.Version)
+}
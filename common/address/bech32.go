@@ -0,0 +1,243 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package address
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+//ErrBech32 :
+var ErrBech32 = errors.New("bech32 address decode error")
+
+//ErrBech32HRP :
+var ErrBech32HRP = errors.New("bech32 address hrp error")
+
+//ErrAddressFormat :
+var ErrAddressFormat = errors.New("address format not support")
+
+//地址的显示格式
+const (
+	FormatBase58 = "base58"
+	FormatBech32 = "bech32"
+)
+
+//DefaultBech32HRP 没有配置的时候bech32地址的前缀
+const DefaultBech32HRP = "bty"
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var (
+	hrpMu     sync.RWMutex
+	bech32HRP = DefaultBech32HRP
+)
+
+//SetBech32HRP 设置本链bech32地址的前缀，不同的链使用不同的前缀，防止把资产转到其他链的地址
+func SetBech32HRP(hrp string) error {
+	if !validHRP(hrp) {
+		return ErrBech32HRP
+	}
+	hrpMu.Lock()
+	bech32HRP = hrp
+	hrpMu.Unlock()
+	return nil
+}
+
+//GetBech32HRP 本链bech32地址的前缀
+func GetBech32HRP() string {
+	hrpMu.RLock()
+	defer hrpMu.RUnlock()
+	return bech32HRP
+}
+
+//validHRP 前缀只能是小写的可见字符，BIP173要求大小写不能混用，这里统一使用小写
+func validHRP(hrp string) bool {
+	if len(hrp) == 0 || len(hrp) > 83 {
+		return false
+	}
+	for _, c := range hrp {
+		if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (b>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	ret := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		ret = append(ret, hrp[i]>>5)
+	}
+	ret = append(ret, 0)
+	for i := 0; i < len(hrp); i++ {
+		ret = append(ret, hrp[i]&31)
+	}
+	return ret
+}
+
+func bech32Checksum(hrp string, data []byte) []byte {
+	values := append(bech32HRPExpand(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+	mod := bech32Polymod(values) ^ 1
+	ret := make([]byte, 6)
+	for i := range ret {
+		ret[i] = byte(mod>>uint(5*(5-i))) & 31
+	}
+	return ret
+}
+
+//bech32Encode data是5bit一组的数据
+func bech32Encode(hrp string, data []byte) string {
+	combined := append(append([]byte{}, data...), bech32Checksum(hrp, data)...)
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, d := range combined {
+		sb.WriteByte(bech32Charset[d])
+	}
+	return sb.String()
+}
+
+//bech32Decode 返回前缀和去掉校验码的5bit数据，全部大写的地址也可以解析
+func bech32Decode(s string) (string, []byte, error) {
+	if len(s) < 8 || len(s) > 90 {
+		return "", nil, ErrBech32
+	}
+	lower := strings.ToLower(s)
+	if lower != s && strings.ToUpper(s) != s {
+		return "", nil, ErrBech32
+	}
+	pos := strings.LastIndexByte(lower, '1')
+	if pos < 1 || pos+7 > len(lower) {
+		return "", nil, ErrBech32
+	}
+	hrp := lower[:pos]
+	if !validHRP(hrp) {
+		return "", nil, ErrBech32HRP
+	}
+	data := make([]byte, 0, len(lower)-pos-1)
+	for i := pos + 1; i < len(lower); i++ {
+		d := strings.IndexByte(bech32Charset, lower[i])
+		if d < 0 {
+			return "", nil, ErrBech32
+		}
+		data = append(data, byte(d))
+	}
+	if bech32Polymod(append(bech32HRPExpand(hrp), data...)) != 1 {
+		return "", nil, ErrAddressChecksum
+	}
+	return hrp, data[:len(data)-6], nil
+}
+
+//convertBits 在8bit和5bit一组的数据之间转换
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var ret []byte
+	acc, bits := uint(0), uint(0)
+	maxv := uint(1)<<toBits - 1
+	for _, v := range data {
+		if uint(v)>>fromBits != 0 {
+			return nil, ErrBech32
+		}
+		acc = acc<<fromBits | uint(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			ret = append(ret, byte(acc>>bits&maxv))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			ret = append(ret, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, ErrBech32
+	}
+	return ret, nil
+}
+
+//IsBech32 地址是否是本链前缀的bech32格式，用于区分base58格式的旧地址
+func IsBech32(addr string) bool {
+	return strings.HasPrefix(strings.ToLower(addr), GetBech32HRP()+"1")
+}
+
+//NewAddrFromBech32 解析bech32格式的地址，第一组数据是地址的版本号，后面是hash160
+func NewAddrFromBech32(s string) (*Address, error) {
+	hrp, data, err := bech32Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != GetBech32HRP() {
+		return nil, ErrBech32HRP
+	}
+	if len(data) < 1 {
+		return nil, ErrBech32
+	}
+	if err := checkVersion(data[0]); err != nil {
+		return nil, err
+	}
+	hash, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) != 20 {
+		return nil, ErrBech32
+	}
+	a := new(Address)
+	a.Version = data[0]
+	a.SetBytes(hash)
+	return a, nil
+}
+
+//Bech32 地址的bech32格式，链上的状态仍然使用base58格式的地址
+func (a *Address) Bech32() string {
+	data, _ := convertBits(a.Hash160[:], 8, 5, true)
+	return bech32Encode(GetBech32HRP(), append([]byte{a.Version}, data...))
+}
+
+//NormalizeAddress 把bech32格式的地址转换成base58格式，其他的地址原样返回，由调用者检查
+func NormalizeAddress(addr string) (string, error) {
+	if !IsBech32(addr) {
+		return addr, nil
+	}
+	a, err := NewAddrFromBech32(addr)
+	if err != nil {
+		return "", err
+	}
+	return a.String(), nil
+}
+
+//FormatAddress 按format显示地址，format为空的时候使用base58格式
+func FormatAddress(addr, format string) (string, error) {
+	switch format {
+	case "", FormatBase58:
+		return NormalizeAddress(addr)
+	case FormatBech32:
+		a, err := NewAddrFromString(addr)
+		if err != nil {
+			return "", err
+		}
+		if a == nil {
+			return "", ErrCheckChecksum
+		}
+		return a.Bech32(), nil
+	}
+	return "", ErrAddressFormat
+}
@@ -0,0 +1,95 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package address
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBech32Vectors(t *testing.T) {
+	//BIP173的测试数据
+	valid := []string{
+		"A12UEL5L",
+		"a12uel5l",
+		"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+		"11" + strings.Repeat("q", 82) + "c8247j",
+		"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w",
+	}
+	for _, s := range valid {
+		hrp, data, err := bech32Decode(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, strings.ToLower(s), bech32Encode(hrp, data))
+	}
+	invalid := []string{
+		"pzry9x0s0muk",
+		"1pzry9x0s0muk",
+		"x1b4n0q5v",
+		"li1dgmt3",
+		"A1G7SGD8",
+		"10a06t8",
+		"1qzzfhee",
+		"a12UEL5L",
+	}
+	for _, s := range invalid {
+		_, _, err := bech32Decode(s)
+		assert.NotNil(t, err, s)
+	}
+}
+
+func TestBech32Address(t *testing.T) {
+	defer SetBech32HRP(DefaultBech32HRP)
+	key := genkey()
+	addr := PubKeyToAddress(key.PubKey().Bytes())
+	legacy := addr.String()
+	b32 := addr.Bech32()
+	assert.True(t, IsBech32(b32))
+	assert.False(t, IsBech32(legacy))
+
+	//两种格式都可以解析成同一个地址
+	a, err := NewAddrFromString(b32)
+	require.NoError(t, err)
+	assert.Equal(t, legacy, a.String())
+	a, err = NewAddrFromString(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, b32, a.Bech32())
+	norm, err := NormalizeAddress(b32)
+	require.NoError(t, err)
+	assert.Equal(t, legacy, norm)
+	norm, err = NormalizeAddress(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, legacy, norm)
+
+	for _, s := range []string{legacy, b32} {
+		out, err := FormatAddress(s, FormatBech32)
+		require.NoError(t, err)
+		assert.Equal(t, b32, out)
+		out, err = FormatAddress(s, "")
+		require.NoError(t, err)
+		assert.Equal(t, legacy, out)
+	}
+	_, err = FormatAddress(legacy, "hex")
+	assert.Equal(t, ErrAddressFormat, err)
+
+	//多重签名地址的版本号保存在bech32的第一组数据中
+	multi, err := NewAddrFromString(MultiSignAddress(key.PubKey().Bytes()))
+	require.NoError(t, err)
+	a, err = NewAddrFromBech32(multi.Bech32())
+	require.NoError(t, err)
+	assert.Equal(t, MultiSignVer, a.Version)
+	assert.Nil(t, CheckMultiSignAddress(a.String()))
+
+	//其他链的前缀不能解析
+	assert.Equal(t, ErrBech32HRP, SetBech32HRP("Bad"))
+	require.NoError(t, SetBech32HRP("ycc"))
+	assert.False(t, IsBech32(b32))
+	_, err = NewAddrFromBech32(b32)
+	assert.Equal(t, ErrBech32HRP, err)
+	assert.Equal(t, "ycc1", addr.Bech32()[:4])
+}
@@ -0,0 +1,76 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+//BatchItem 批量验证中的一个签名
+type BatchItem struct {
+	PubKey PubKey
+	Msg    []byte
+	Sig    Signature
+}
+
+//BatchVerifier 驱动可以实现这个接口优化一批签名的验证，比如缓存解码过的公钥，
+//接口不要求代数意义上的批量验证，结果必须和逐个验证完全一致
+type BatchVerifier interface {
+	BatchVerify(items []*BatchItem) bool
+}
+
+//BatchVerify 验证一批签名，全部正确返回true
+//每个签名仍然单独验证，只是多个cpu并行执行，不是把多个签名合并成一次运算的代数批量验证，
+//驱动实现了BatchVerifier的时候由驱动处理，否则用VerifyParallel逐个验证
+func BatchVerify(c Crypto, items []*BatchItem) bool {
+	if len(items) == 0 {
+		return true
+	}
+	if bv, ok := c.(BatchVerifier); ok {
+		return bv.BatchVerify(items)
+	}
+	return VerifyParallel(len(items), func(i int) bool {
+		return items[i].PubKey.VerifyBytes(items[i].Msg, items[i].Sig)
+	})
+}
+
+//VerifyParallel 按cpu数量并行执行n个验证，有一个验证失败就返回false，剩下的验证不再执行
+func VerifyParallel(n int, verify func(i int) bool) bool {
+	workers := runtime.NumCPU()
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			if !verify(i) {
+				return false
+			}
+		}
+		return true
+	}
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&failed) == 0 {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if !verify(i) {
+					atomic.StoreInt32(&failed, 1)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return failed == 0
+}
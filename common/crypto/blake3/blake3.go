@@ -0,0 +1,265 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package blake3 blake3 hash算法，按照官方参考实现编写，只支持默认模式和32字节的输出
+package blake3
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+//Size blake3默认输出的字节数
+const Size = 32
+
+//BlockSize 压缩函数每次处理的字节数
+const BlockSize = 64
+
+const (
+	chunkLen = 1024
+
+	chunkStart = 1 << 0
+	chunkEnd   = 1 << 1
+	parent     = 1 << 2
+	root       = 1 << 3
+)
+
+var iv = [8]uint32{
+	0x6A09E667, 0xBB67AE85, 0x3C6EF372, 0xA54FF53A, 0x510E527F, 0x9B05688C, 0x1F83D9AB, 0x5BE0CD19,
+}
+
+var msgPermutation = [16]int{2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8}
+
+func g(s *[16]uint32, a, b, c, d int, mx, my uint32) {
+	s[a] = s[a] + s[b] + mx
+	s[d] = bits.RotateLeft32(s[d]^s[a], -16)
+	s[c] = s[c] + s[d]
+	s[b] = bits.RotateLeft32(s[b]^s[c], -12)
+	s[a] = s[a] + s[b] + my
+	s[d] = bits.RotateLeft32(s[d]^s[a], -8)
+	s[c] = s[c] + s[d]
+	s[b] = bits.RotateLeft32(s[b]^s[c], -7)
+}
+
+func round(s *[16]uint32, m *[16]uint32) {
+	//列
+	g(s, 0, 4, 8, 12, m[0], m[1])
+	g(s, 1, 5, 9, 13, m[2], m[3])
+	g(s, 2, 6, 10, 14, m[4], m[5])
+	g(s, 3, 7, 11, 15, m[6], m[7])
+	//对角线
+	g(s, 0, 5, 10, 15, m[8], m[9])
+	g(s, 1, 6, 11, 12, m[10], m[11])
+	g(s, 2, 7, 8, 13, m[12], m[13])
+	g(s, 3, 4, 9, 14, m[14], m[15])
+}
+
+func permute(m *[16]uint32) {
+	var p [16]uint32
+	for i := range p {
+		p[i] = m[msgPermutation[i]]
+	}
+	*m = p
+}
+
+func compress(cv *[8]uint32, block *[16]uint32, counter uint64, blockLen uint32, flags uint32) [16]uint32 {
+	s := [16]uint32{
+		cv[0], cv[1], cv[2], cv[3], cv[4], cv[5], cv[6], cv[7],
+		iv[0], iv[1], iv[2], iv[3],
+		uint32(counter), uint32(counter >> 32), blockLen, flags,
+	}
+	m := *block
+	for i := 0; i < 7; i++ {
+		round(&s, &m)
+		if i < 6 {
+			permute(&m)
+		}
+	}
+	for i := 0; i < 8; i++ {
+		s[i] ^= s[i+8]
+		s[i+8] ^= cv[i]
+	}
+	return s
+}
+
+func first8(s [16]uint32) (cv [8]uint32) {
+	copy(cv[:], s[:8])
+	return cv
+}
+
+func wordsFromBlock(b *[BlockSize]byte) (m [16]uint32) {
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint32(b[i*4:])
+	}
+	return m
+}
+
+//output 压缩函数的输入，根节点用不同的counter可以产生任意长度的输出
+type output struct {
+	cv       [8]uint32
+	block    [16]uint32
+	counter  uint64
+	blockLen uint32
+	flags    uint32
+}
+
+func (o *output) chainingValue() [8]uint32 {
+	return first8(compress(&o.cv, &o.block, o.counter, o.blockLen, o.flags))
+}
+
+func (o *output) rootBytes(out []byte) {
+	var counter uint64
+	for len(out) > 0 {
+		s := compress(&o.cv, &o.block, counter, o.blockLen, o.flags|root)
+		var buf [BlockSize]byte
+		for i, w := range s {
+			binary.LittleEndian.PutUint32(buf[i*4:], w)
+		}
+		n := copy(out, buf[:])
+		out = out[n:]
+		counter++
+	}
+}
+
+type chunkState struct {
+	cv               [8]uint32
+	chunkCounter     uint64
+	block            [BlockSize]byte
+	blockLen         int
+	blocksCompressed int
+}
+
+func newChunkState(counter uint64) chunkState {
+	return chunkState{cv: iv, chunkCounter: counter}
+}
+
+func (c *chunkState) len() int {
+	return BlockSize*c.blocksCompressed + c.blockLen
+}
+
+func (c *chunkState) startFlag() uint32 {
+	if c.blocksCompressed == 0 {
+		return chunkStart
+	}
+	return 0
+}
+
+func (c *chunkState) update(in []byte) {
+	for len(in) > 0 {
+		//块满了并且还有数据的时候才压缩，最后一个块留到output中处理
+		if c.blockLen == BlockSize {
+			m := wordsFromBlock(&c.block)
+			c.cv = first8(compress(&c.cv, &m, c.chunkCounter, BlockSize, c.startFlag()))
+			c.blocksCompressed++
+			c.block = [BlockSize]byte{}
+			c.blockLen = 0
+		}
+		n := copy(c.block[c.blockLen:], in)
+		c.blockLen += n
+		in = in[n:]
+	}
+}
+
+func (c *chunkState) output() *output {
+	return &output{
+		cv:       c.cv,
+		block:    wordsFromBlock(&c.block),
+		counter:  c.chunkCounter,
+		blockLen: uint32(c.blockLen),
+		flags:    c.startFlag() | chunkEnd,
+	}
+}
+
+func parentOutput(left, right [8]uint32) *output {
+	o := &output{cv: iv, blockLen: BlockSize, flags: parent}
+	copy(o.block[:8], left[:])
+	copy(o.block[8:], right[:])
+	return o
+}
+
+//Hasher blake3 hash，实现了hash.Hash接口
+type Hasher struct {
+	chunk   chunkState
+	cvStack [][8]uint32
+}
+
+//New 新建blake3 hasher
+func New() hash.Hash {
+	h := &Hasher{}
+	h.Reset()
+	return h
+}
+
+//Sum256 计算数据的blake3 hash
+func Sum256(data []byte) [Size]byte {
+	var h Hasher
+	h.Reset()
+	h.Write(data)
+	var out [Size]byte
+	h.finalize(out[:])
+	return out
+}
+
+//Reset 重置
+func (h *Hasher) Reset() {
+	h.chunk = newChunkState(0)
+	h.cvStack = h.cvStack[:0]
+}
+
+//Size 输出的字节数
+func (h *Hasher) Size() int {
+	return Size
+}
+
+//BlockSize 块大小
+func (h *Hasher) BlockSize() int {
+	return BlockSize
+}
+
+//把完成的chunk合并到树中，totalChunks末尾有几个0就可以合并几层
+func (h *Hasher) addChunkChainingValue(cv [8]uint32, totalChunks uint64) {
+	for totalChunks&1 == 0 {
+		last := len(h.cvStack) - 1
+		cv = parentOutput(h.cvStack[last], cv).chainingValue()
+		h.cvStack = h.cvStack[:last]
+		totalChunks >>= 1
+	}
+	h.cvStack = append(h.cvStack, cv)
+}
+
+//Write 写入数据
+func (h *Hasher) Write(in []byte) (int, error) {
+	n := len(in)
+	for len(in) > 0 {
+		if h.chunk.len() == chunkLen {
+			cv := h.chunk.output().chainingValue()
+			total := h.chunk.chunkCounter + 1
+			h.addChunkChainingValue(cv, total)
+			h.chunk = newChunkState(total)
+		}
+		want := chunkLen - h.chunk.len()
+		if want > len(in) {
+			want = len(in)
+		}
+		h.chunk.update(in[:want])
+		in = in[want:]
+	}
+	return n, nil
+}
+
+func (h *Hasher) finalize(out []byte) {
+	o := h.chunk.output()
+	for i := len(h.cvStack) - 1; i >= 0; i-- {
+		o = parentOutput(h.cvStack[i], o.chainingValue())
+	}
+	o.rootBytes(out)
+}
+
+//Sum 把hash追加到b后面，不改变hasher的状态
+func (h *Hasher) Sum(b []byte) []byte {
+	var out [Size]byte
+	h.finalize(out[:])
+	return append(b, out[:]...)
+}
@@ -0,0 +1,64 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blake3
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum256(t *testing.T) {
+	cases := map[string]string{
+		"":     "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262",
+		"abc":  "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85",
+		"\x00": "2d3adedff11b61f14c886e35afa036736dcd87a74d27b5c1510225d0f592e213",
+	}
+	for in, want := range cases {
+		h := Sum256([]byte(in))
+		assert.Equal(t, want, hex.EncodeToString(h[:]), in)
+	}
+}
+
+//官方测试向量，输入是 i % 251 的序列
+func TestVectors(t *testing.T) {
+	cases := map[int]string{
+		1024:   "42214739f095a406f3fc83deb889744ac00df831c10daa55189b5d121c855af7",
+		1025:   "d00278ae47eb27b34faecf67b4fe263f82d5412916c1ffd97c8cb7fb814b8444",
+		2048:   "e776b6028c7cd22a4d0ba182a8bf62205d2ef576467e838ed6f2529b85fba24a",
+		102400: "bc3e3d41a1146b069abffad3c0d44860cf664390afce4d9661f7902e7943e085",
+	}
+	for n, want := range cases {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(i % 251)
+		}
+		h := Sum256(data)
+		assert.Equal(t, want, hex.EncodeToString(h[:]), "len %d", n)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	//跨越多个chunk，分多次写入和一次写入的结果一致
+	data := make([]byte, 5*chunkLen+123)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	for _, n := range []int{0, 1, 63, 64, 65, 1023, 1024, 1025, 2048, 3073, len(data)} {
+		want := Sum256(data[:n])
+		for _, step := range []int{1, 7, 64, 1000} {
+			h := New()
+			for i := 0; i < n; i += step {
+				end := i + step
+				if end > n {
+					end = n
+				}
+				h.Write(data[i:end])
+			}
+			assert.Equal(t, want[:], h.Sum(nil), "len %d step %d", n, step)
+		}
+	}
+}
@@ -22,6 +22,10 @@ func TestGet(t *testing.T) {
 	require.Equal("ed25519", name)
 	name = crypto.GetName(3)
 	require.Equal("sm2", name)
+	name = crypto.GetName(6)
+	require.Equal("bls", name)
+	name = crypto.GetName(7)
+	require.Equal("schnorr", name)
 
 	ty := crypto.GetType("secp256k1")
 	require.True(ty == 1)
@@ -29,6 +33,10 @@ func TestGet(t *testing.T) {
 	require.True(ty == 2)
 	ty = crypto.GetType("sm2")
 	require.True(ty == 3)
+	ty = crypto.GetType("bls")
+	require.True(ty == 6)
+	ty = crypto.GetType("schnorr")
+	require.True(ty == 7)
 }
 
 func TestRipemd160(t *testing.T) {
@@ -44,6 +52,10 @@ func TestAll(t *testing.T) {
 	testFromBytes(t, "secp256k1")
 	testCrypto(t, "sm2")
 	testFromBytes(t, "sm2")
+	testCrypto(t, "bls")
+	testFromBytes(t, "bls")
+	testCrypto(t, "schnorr")
+	testFromBytes(t, "schnorr")
 }
 
 func testFromBytes(t *testing.T, name string) {
@@ -174,3 +186,52 @@ func Verify(signType string, pubBytes, msg, signBytes []byte) bool {
 
 	return pub.VerifyBytes(msg, sign)
 }
+
+func TestBatchVerify(t *testing.T) {
+	for _, name := range []string{"secp256k1", "ed25519", "sm2"} {
+		c, err := crypto.New(name)
+		require.NoError(t, err)
+		var items []*crypto.BatchItem
+		for i := 0; i < 20; i++ {
+			priv, err := c.GenKey()
+			require.NoError(t, err)
+			msg := []byte(strings.Repeat("m", i+1))
+			//同一个公钥签名多个消息
+			for j := 0; j < 3; j++ {
+				msg = append(msg, byte(j))
+				items = append(items, &crypto.BatchItem{PubKey: priv.PubKey(), Msg: msg, Sig: priv.Sign(msg)})
+			}
+		}
+		require.True(t, crypto.BatchVerify(c, items), name)
+		require.True(t, crypto.BatchVerify(c, nil), name)
+
+		items[len(items)/2].Msg = []byte("wrong msg")
+		require.False(t, crypto.BatchVerify(c, items), name)
+	}
+}
+
+func TestRecoverPubKey(t *testing.T) {
+	c, err := crypto.New("secp256k1")
+	require.NoError(t, err)
+	priv, err := c.GenKey()
+	require.NoError(t, err)
+	msg := []byte("recover")
+	sig, err := crypto.SignRecoverable(c, priv, msg)
+	require.NoError(t, err)
+	pub, err := crypto.RecoverPubKey(c, msg, sig)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(priv.PubKey()))
+
+	//消息不同的时候恢复出来的是其他公钥
+	pub, err = crypto.RecoverPubKey(c, []byte("other"), sig)
+	if err == nil {
+		require.False(t, pub.Equals(priv.PubKey()))
+	}
+	_, err = crypto.RecoverPubKey(c, msg, sig[:64])
+	require.Error(t, err)
+
+	c, err = crypto.New("ed25519")
+	require.NoError(t, err)
+	_, err = crypto.RecoverPubKey(c, msg, sig)
+	require.Equal(t, crypto.ErrNotSupportRecover, err)
+}
@@ -0,0 +1,35 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import "errors"
+
+//ErrNotSupportEncrypt 签名算法不支持公钥加密
+var ErrNotSupportEncrypt = errors.New("ErrNotSupportEncrypt")
+
+//Encrypter 支持公钥加密的驱动实现这个接口
+//用接收方的公钥加密，只有持有对应私钥的一方可以解密
+type Encrypter interface {
+	Encrypt(pub PubKey, msg []byte) ([]byte, error)
+	Decrypt(priv PrivKey, data []byte) ([]byte, error)
+}
+
+//Encrypt 用公钥加密数据
+func Encrypt(c Crypto, pub PubKey, msg []byte) ([]byte, error) {
+	e, ok := c.(Encrypter)
+	if !ok {
+		return nil, ErrNotSupportEncrypt
+	}
+	return e.Encrypt(pub, msg)
+}
+
+//Decrypt 用私钥解密数据
+func Decrypt(c Crypto, priv PrivKey, data []byte) ([]byte, error) {
+	e, ok := c.(Encrypter)
+	if !ok {
+		return nil, ErrNotSupportEncrypt
+	}
+	return e.Decrypt(priv, data)
+}
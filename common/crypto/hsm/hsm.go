@@ -0,0 +1,337 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hsm 通过PKCS#11硬件安全模块签名，私钥不离开HSM，进程内存中没有私钥
+// Signer实现了crypto.PrivKey接口，钱包账户和共识验证节点都可以直接使用
+package hsm
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/btcsuite/btcd/btcec"
+)
+
+var hlog = log.New("module", "crypto.hsm")
+
+const (
+	defaultDriver   = "pkcs11"
+	defaultPoolSize = 4
+	defaultRetry    = 2
+	//retryInterval 每次重试之前等待的时间，按重试的次数递增
+	retryInterval = 100 * time.Millisecond
+)
+
+//HSM中的签名算法
+const (
+	//MechanismECDSA 对sha256摘要做ECDSA签名，返回r||s
+	MechanismECDSA = iota + 1
+	//MechanismEdDSA 对原始数据做EdDSA签名
+	MechanismEdDSA
+)
+
+var (
+	//ErrDriverNotFound 没有注册的HSM驱动，pkcs11驱动需要用 -tags pkcs11 编译
+	ErrDriverNotFound = errors.New("ErrHSMDriverNotFound")
+	//ErrKeyNotFound HSM中没有这个标签的密钥
+	ErrKeyNotFound = errors.New("ErrHSMKeyNotFound")
+	//ErrSignType HSM签名不支持这种签名类型
+	ErrSignType = errors.New("ErrHSMSignType")
+)
+
+//Module 加载以后的HSM模块，同一个动态库只加载一次
+type Module interface {
+	//OpenSession 打开slot上的会话并登录
+	OpenSession(slot uint, pin string) (Session, error)
+	Close() error
+}
+
+//Session HSM会话，一个会话同时只在一个goroutine中使用
+type Session interface {
+	//PublicKey label对应的公钥，secp256k1为压缩或者未压缩的点，ed25519为32字节
+	PublicKey(label string) ([]byte, error)
+	//Sign 用label对应的私钥按mechanism签名data
+	Sign(label string, mechanism int, data []byte) ([]byte, error)
+	Close() error
+}
+
+//OpenFunc 加载HSM的动态库
+type OpenFunc func(lib string) (Module, error)
+
+var (
+	drivers   = make(map[string]OpenFunc)
+	tokens    = make(map[string]*token)
+	driverMtx sync.Mutex
+)
+
+//Register 注册HSM驱动
+func Register(name string, open OpenFunc) {
+	driverMtx.Lock()
+	defer driverMtx.Unlock()
+	if open == nil {
+		panic("hsm: Register open is nil")
+	}
+	if _, dup := drivers[name]; dup {
+		panic("hsm: Register called twice for driver " + name)
+	}
+	drivers[name] = open
+}
+
+//token 一个slot上的会话池，钱包和共识使用同一个slot的时候共享
+type token struct {
+	module Module
+	slot   uint
+	pin    string
+	retry  int
+	idle   chan Session
+	//sem 限制同时打开的会话数
+	sem chan struct{}
+}
+
+func getToken(cfg *types.HSM) (*token, error) {
+	driver := cfg.Driver
+	if driver == "" {
+		driver = defaultDriver
+	}
+	driverMtx.Lock()
+	defer driverMtx.Unlock()
+	key := fmt.Sprintf("%s-%s-%d", driver, cfg.Lib, cfg.Slot)
+	if t, ok := tokens[key]; ok {
+		return t, nil
+	}
+	open, ok := drivers[driver]
+	if !ok {
+		return nil, ErrDriverNotFound
+	}
+	module, err := open(cfg.Lib)
+	if err != nil {
+		return nil, err
+	}
+	poolSize := int(cfg.PoolSize)
+	if poolSize <= 0 {
+		poolSize = defaultPoolSize
+	}
+	retry := int(cfg.Retry)
+	if retry <= 0 {
+		retry = defaultRetry
+	}
+	t := &token{
+		module: module,
+		slot:   uint(cfg.Slot),
+		pin:    cfg.Pin,
+		retry:  retry,
+		idle:   make(chan Session, poolSize),
+		sem:    make(chan struct{}, poolSize),
+	}
+	tokens[key] = t
+	return t, nil
+}
+
+func (t *token) get() (Session, error) {
+	t.sem <- struct{}{}
+	select {
+	case s := <-t.idle:
+		return s, nil
+	default:
+	}
+	s, err := t.module.OpenSession(t.slot, t.pin)
+	if err != nil {
+		<-t.sem
+		return nil, err
+	}
+	return s, nil
+}
+
+func (t *token) put(s Session) {
+	t.idle <- s
+	<-t.sem
+}
+
+//discard 出错的会话不再复用，下次使用的时候重新打开
+func (t *token) discard(s Session) {
+	if err := s.Close(); err != nil {
+		hlog.Debug("close session", "err", err)
+	}
+	<-t.sem
+}
+
+//do 在池中的会话上执行f，失败的时候换一个新的会话重试
+func (t *token) do(f func(Session) error) error {
+	var err error
+	for i := 0; i <= t.retry; i++ {
+		if i > 0 {
+			time.Sleep(time.Duration(i) * retryInterval)
+		}
+		var s Session
+		s, err = t.get()
+		if err != nil {
+			hlog.Warn("open session", "slot", t.slot, "try", i, "err", err)
+			continue
+		}
+		if err = f(s); err == nil {
+			t.put(s)
+			return nil
+		}
+		hlog.Warn("session call", "slot", t.slot, "try", i, "err", err)
+		t.discard(s)
+		if err == ErrKeyNotFound {
+			return err
+		}
+	}
+	return err
+}
+
+//Signer HSM中的一个私钥
+type Signer struct {
+	tok       *token
+	label     string
+	c         crypto.Crypto
+	name      string
+	mechanism int
+	pub       crypto.PubKey
+}
+
+//New 创建HSM中label对应私钥的签名者，公钥从HSM中读取
+func New(cfg *types.HSM, label string) (*Signer, error) {
+	name := cfg.SignType
+	if name == "" {
+		name = "secp256k1"
+	}
+	var mechanism int
+	switch name {
+	case "secp256k1":
+		mechanism = MechanismECDSA
+	case "ed25519":
+		mechanism = MechanismEdDSA
+	default:
+		return nil, ErrSignType
+	}
+	c, err := crypto.New(name)
+	if err != nil {
+		return nil, err
+	}
+	tok, err := getToken(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var raw []byte
+	err = tok.do(func(s Session) error {
+		raw, err = s.PublicKey(label)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	//chain33中secp256k1的公钥是压缩格式
+	if mechanism == MechanismECDSA {
+		pub, err := btcec.ParsePubKey(raw, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+		raw = pub.SerializeCompressed()
+	}
+	pub, err := c.PubKeyFromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{tok: tok, label: label, c: c, name: name, mechanism: mechanism, pub: pub}, nil
+}
+
+//emptySignature HSM签名失败时返回空的签名，签名检查不会通过
+type emptySignature struct{}
+
+func (emptySignature) Bytes() []byte {
+	return nil
+}
+
+func (emptySignature) IsZero() bool {
+	return true
+}
+
+func (emptySignature) String() string {
+	return "hsmEmptySignature"
+}
+
+func (emptySignature) Equals(crypto.Signature) bool {
+	return false
+}
+
+//Bytes 私钥不能从HSM中导出
+func (s *Signer) Bytes() []byte {
+	return nil
+}
+
+//PubKey HSM中私钥对应的公钥
+func (s *Signer) PubKey() crypto.PubKey {
+	return s.pub
+}
+
+func (s *Signer) String() string {
+	return "hsmSigner{" + s.label + "}"
+}
+
+//Equals 同一个slot上同一个标签
+func (s *Signer) Equals(other crypto.PrivKey) bool {
+	o, ok := other.(*Signer)
+	if !ok {
+		return false
+	}
+	return o.tok == s.tok && o.label == s.label && o.pub.Equals(s.pub)
+}
+
+//Sign 签名失败的时候返回空的签名
+func (s *Signer) Sign(msg []byte) crypto.Signature {
+	sig, err := s.sign(msg)
+	if err != nil {
+		hlog.Error("hsmSigner sign", "label", s.label, "err", err)
+		return emptySignature{}
+	}
+	return sig
+}
+
+func (s *Signer) sign(msg []byte) (crypto.Signature, error) {
+	data := msg
+	if s.mechanism == MechanismECDSA {
+		data = crypto.Sha256(msg)
+	}
+	var raw []byte
+	err := s.tok.do(func(sess Session) error {
+		var err error
+		raw, err = sess.Sign(s.label, s.mechanism, data)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if s.mechanism == MechanismECDSA {
+		if raw, err = ecdsaToDER(raw); err != nil {
+			return nil, err
+		}
+	}
+	sig, err := s.c.SignatureFromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	//HSM返回的签名必须能用这个公钥验证
+	if !s.pub.VerifyBytes(msg, sig) {
+		return nil, types.ErrSign
+	}
+	return sig, nil
+}
+
+//ecdsaToDER HSM返回r||s，chain33使用low-S的DER格式
+func ecdsaToDER(raw []byte) ([]byte, error) {
+	if len(raw) == 0 || len(raw)%2 != 0 {
+		return nil, types.ErrSign
+	}
+	n := len(raw) / 2
+	sig := &btcec.Signature{R: new(big.Int).SetBytes(raw[:n]), S: new(big.Int).SetBytes(raw[n:])}
+	return sig.Serialize(), nil
+}
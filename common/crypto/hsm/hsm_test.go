@@ -0,0 +1,121 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hsm
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	_ "github.com/33cn/chain33/system/crypto/init"
+	"github.com/33cn/chain33/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+var errBroken = errors.New("session broken")
+
+//mockModule 用内存中的私钥模拟HSM，接下来的failures次签名出错
+type mockModule struct {
+	mtx      sync.Mutex
+	keys     map[string]crypto.PrivKey
+	opened   int
+	failures int
+}
+
+func (m *mockModule) OpenSession(slot uint, pin string) (Session, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.opened++
+	return &mockSession{m: m}, nil
+}
+
+func (m *mockModule) Close() error {
+	return nil
+}
+
+type mockSession struct {
+	m *mockModule
+}
+
+func (s *mockSession) PublicKey(label string) ([]byte, error) {
+	priv, ok := s.m.keys[label]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	pub, err := btcec.ParsePubKey(priv.PubKey().Bytes(), btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+	return pub.SerializeUncompressed(), nil
+}
+
+func (s *mockSession) Sign(label string, mechanism int, data []byte) ([]byte, error) {
+	s.m.mtx.Lock()
+	broken := s.m.failures > 0
+	s.m.failures--
+	s.m.mtx.Unlock()
+	if broken {
+		return nil, errBroken
+	}
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), s.m.keys[label].Bytes())
+	sig, err := priv.Sign(data)
+	if err != nil {
+		return nil, err
+	}
+	raw := make([]byte, 64)
+	rb, sb := sig.R.Bytes(), sig.S.Bytes()
+	copy(raw[32-len(rb):32], rb)
+	copy(raw[64-len(sb):], sb)
+	return raw, nil
+}
+
+func (s *mockSession) Close() error {
+	return nil
+}
+
+func TestSigner(t *testing.T) {
+	c, err := crypto.New("secp256k1")
+	assert.Nil(t, err)
+	priv, err := c.GenKey()
+	assert.Nil(t, err)
+	m := &mockModule{keys: map[string]crypto.PrivKey{"validator": priv}}
+	Register("mock", func(lib string) (Module, error) { return m, nil })
+	cfg := &types.HSM{Driver: "mock", Lib: "mock.so", PoolSize: 2}
+
+	_, err = New(cfg, "nokey")
+	assert.Equal(t, ErrKeyNotFound, err)
+	signer, err := New(cfg, "validator")
+	assert.Nil(t, err)
+	assert.True(t, signer.PubKey().Equals(priv.PubKey()))
+	assert.Nil(t, signer.Bytes())
+
+	msg := []byte("hsm sign")
+	sig := signer.Sign(msg)
+	assert.False(t, sig.IsZero())
+	assert.True(t, priv.PubKey().VerifyBytes(msg, sig))
+
+	//会话出错以后换新的会话重试，重试次数用完以后返回空签名
+	m.failures = 2
+	assert.True(t, priv.PubKey().VerifyBytes(msg, signer.Sign(msg)))
+	m.failures = 3
+	assert.True(t, signer.Sign(msg).IsZero())
+
+	//并发签名的时候同时打开的会话不超过池的大小
+	m.mtx.Lock()
+	m.opened = 0
+	m.mtx.Unlock()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.True(t, priv.PubKey().VerifyBytes(msg, signer.Sign(msg)))
+		}()
+	}
+	wg.Wait()
+	assert.True(t, m.opened <= 2)
+}
@@ -0,0 +1,135 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build pkcs11
+
+//pkcs11驱动需要cgo和github.com/miekg/pkcs11，编译的时候加上 -tags pkcs11
+
+package hsm
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/miekg/pkcs11"
+)
+
+//ckmEDDSA PKCS#11 v3.0 的 CKM_EDDSA，github.com/miekg/pkcs11 只有v2.40的常量
+const ckmEDDSA = 0x00001057
+
+func init() {
+	Register(defaultDriver, openPKCS11)
+}
+
+type pkcs11Module struct {
+	ctx *pkcs11.Ctx
+}
+
+func openPKCS11(lib string) (Module, error) {
+	ctx := pkcs11.New(lib)
+	if ctx == nil {
+		return nil, fmt.Errorf("hsm: load pkcs11 lib %s failed", lib)
+	}
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+		return nil, err
+	}
+	return &pkcs11Module{ctx: ctx}, nil
+}
+
+//OpenSession 同一个应用在token上只需要登录一次，已经登录的错误可以忽略
+func (m *pkcs11Module) OpenSession(slot uint, pin string) (Session, error) {
+	sh, err := m.ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, err
+	}
+	err = m.ctx.Login(sh, pkcs11.CKU_USER, pin)
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		m.ctx.CloseSession(sh)
+		return nil, err
+	}
+	return &pkcs11Session{ctx: m.ctx, sh: sh, keys: make(map[string]pkcs11.ObjectHandle)}, nil
+}
+
+func (m *pkcs11Module) Close() error {
+	err := m.ctx.Finalize()
+	m.ctx.Destroy()
+	return err
+}
+
+type pkcs11Session struct {
+	ctx *pkcs11.Ctx
+	sh  pkcs11.SessionHandle
+	//keys 会话中查找过的私钥句柄
+	keys map[string]pkcs11.ObjectHandle
+}
+
+func (s *pkcs11Session) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	tmpl := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := s.ctx.FindObjectsInit(s.sh, tmpl); err != nil {
+		return 0, err
+	}
+	objs, _, err := s.ctx.FindObjects(s.sh, 1)
+	if ferr := s.ctx.FindObjectsFinal(s.sh); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(objs) == 0 {
+		return 0, ErrKeyNotFound
+	}
+	return objs[0], nil
+}
+
+//PublicKey CKA_EC_POINT是DER编码的OCTET STRING，有的HSM直接返回点
+func (s *pkcs11Session) PublicKey(label string) ([]byte, error) {
+	h, err := s.findObject(pkcs11.CKO_PUBLIC_KEY, label)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := s.ctx.GetAttributeValue(s.sh, h, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil)})
+	if err != nil {
+		return nil, err
+	}
+	if len(attrs) == 0 || len(attrs[0].Value) == 0 {
+		return nil, ErrKeyNotFound
+	}
+	var point []byte
+	if rest, err := asn1.Unmarshal(attrs[0].Value, &point); err == nil && len(rest) == 0 {
+		return point, nil
+	}
+	return attrs[0].Value, nil
+}
+
+func (s *pkcs11Session) Sign(label string, mechanism int, data []byte) ([]byte, error) {
+	var mech uint
+	switch mechanism {
+	case MechanismECDSA:
+		mech = pkcs11.CKM_ECDSA
+	case MechanismEdDSA:
+		mech = ckmEDDSA
+	default:
+		return nil, ErrSignType
+	}
+	key, ok := s.keys[label]
+	if !ok {
+		var err error
+		if key, err = s.findObject(pkcs11.CKO_PRIVATE_KEY, label); err != nil {
+			return nil, err
+		}
+		s.keys[label] = key
+	}
+	if err := s.ctx.SignInit(s.sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(mech, nil)}, key); err != nil {
+		return nil, err
+	}
+	return s.ctx.Sign(s.sh, data)
+}
+
+func (s *pkcs11Session) Close() error {
+	return s.ctx.CloseSession(s.sh)
+}
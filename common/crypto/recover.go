@@ -0,0 +1,35 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import "errors"
+
+//ErrNotSupportRecover 签名算法不支持从签名中恢复公钥
+var ErrNotSupportRecover = errors.New("ErrNotSupportRecover")
+
+//Recoverable 支持从签名中恢复公钥的驱动实现这个接口
+//可恢复的签名带有恢复id，用签名和原始数据就可以计算出签名者的公钥
+type Recoverable interface {
+	SignRecoverable(priv PrivKey, msg []byte) ([]byte, error)
+	RecoverPubKey(msg []byte, sig []byte) (PubKey, error)
+}
+
+//SignRecoverable 生成带有恢复id的签名
+func SignRecoverable(c Crypto, priv PrivKey, msg []byte) ([]byte, error) {
+	r, ok := c.(Recoverable)
+	if !ok {
+		return nil, ErrNotSupportRecover
+	}
+	return r.SignRecoverable(priv, msg)
+}
+
+//RecoverPubKey 从带有恢复id的签名中恢复公钥，恢复成功说明签名对这个公钥是有效的
+func RecoverPubKey(c Crypto, msg []byte, sig []byte) (PubKey, error) {
+	r, ok := c.(Recoverable)
+	if !ok {
+		return nil, ErrNotSupportRecover
+	}
+	return r.RecoverPubKey(msg, sig)
+}
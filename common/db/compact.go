@@ -0,0 +1,144 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/types"
+)
+
+//maxNamespaceLen 自动划分命名空间时前缀的最大长度
+const maxNamespaceLen = 32
+
+var (
+	openedMu sync.Mutex
+	openedDB = make(map[string]DB)
+)
+
+//Compactor 支持手动压缩的数据库
+type Compactor interface {
+	//CompactRange 压缩[start, limit)范围的key，都为nil表示整个数据库
+	CompactRange(start, limit []byte) error
+}
+
+//SizeEstimator 可以估算key范围占用磁盘空间的数据库
+type SizeEstimator interface {
+	//SizeOf 估算[start, limit)范围的key在磁盘上占用的字节数
+	SizeOf(start, limit []byte) (int64, error)
+}
+
+func registerOpened(name string, db DB) {
+	openedMu.Lock()
+	openedDB[name] = db
+	openedMu.Unlock()
+}
+
+//GetOpened 获取进程中用NewDB打开的数据库，同名的数据库是最后一次打开的
+func GetOpened(name string) (DB, bool) {
+	openedMu.Lock()
+	defer openedMu.Unlock()
+	db, ok := openedDB[name]
+	return db, ok
+}
+
+//ListOpened 进程中用NewDB打开的数据库的名字
+func ListOpened() []string {
+	openedMu.Lock()
+	defer openedMu.Unlock()
+	names := make([]string, 0, len(openedDB))
+	for name := range openedDB {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//CompactPrefix 压缩前缀为prefix的key，prefix为空表示整个数据库
+func CompactPrefix(db DB, prefix []byte) error {
+	if len(prefix) == 0 {
+		return CompactRange(db, nil, nil)
+	}
+	return CompactRange(db, prefix, bytesPrefix(prefix))
+}
+
+//CompactRange 压缩[start, limit)范围的key，数据库不支持的时候返回ErrNotSupport
+func CompactRange(db DB, start, limit []byte) error {
+	compactor, ok := db.(Compactor)
+	if !ok {
+		return types.ErrNotSupport
+	}
+	return compactor.CompactRange(start, limit)
+}
+
+//namespaceOf key的命名空间，是第一个'-'或者':'(包括)之前的前缀
+func namespaceOf(key []byte) []byte {
+	for i := 0; i < len(key) && i < maxNamespaceLen; i++ {
+		if key[i] == '-' || key[i] == ':' {
+			return key[:i+1]
+		}
+	}
+	if len(key) > maxNamespaceLen {
+		return key[:maxNamespaceLen]
+	}
+	return key
+}
+
+//GetUsage 统计每个前缀的key个数，有效数据的大小，磁盘占用和可以回收的空间，
+//prefixes为空的时候按key的命名空间自动划分，需要遍历对应的数据，比较慢
+func GetUsage(db DB, prefixes [][]byte) ([]*types.DBPrefixUsage, error) {
+	var usages []*types.DBPrefixUsage
+	if len(prefixes) == 0 {
+		it := db.Iterator(nil, nil, false)
+		var usage *types.DBPrefixUsage
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := it.Key()
+			if usage == nil || !bytes.HasPrefix(key, usage.Prefix) {
+				usage = &types.DBPrefixUsage{Prefix: cloneByte(namespaceOf(key))}
+				usages = append(usages, usage)
+			}
+			usage.Keys++
+			usage.LiveBytes += int64(len(key) + len(it.Value()))
+		}
+		err := it.Error()
+		it.Close()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, prefix := range prefixes {
+			usage := &types.DBPrefixUsage{Prefix: prefix}
+			it := db.Iterator(prefix, nil, false)
+			for it.Rewind(); it.Valid(); it.Next() {
+				usage.Keys++
+				usage.LiveBytes += int64(len(it.Key()) + len(it.Value()))
+			}
+			err := it.Error()
+			it.Close()
+			if err != nil {
+				return nil, err
+			}
+			usages = append(usages, usage)
+		}
+	}
+	estimator, ok := db.(SizeEstimator)
+	if !ok {
+		return usages, nil
+	}
+	for _, usage := range usages {
+		size, err := estimator.SizeOf(usage.Prefix, bytesPrefix(usage.Prefix))
+		if err != nil {
+			return nil, err
+		}
+		usage.DiskBytes = size
+		//有效数据是没有压缩的大小，磁盘上比它大的部分是已经删除或者覆盖的旧数据
+		if size > usage.LiveBytes {
+			usage.Reclaimable = size - usage.LiveBytes
+		}
+	}
+	return usages, nil
+}
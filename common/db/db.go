@@ -3,7 +3,7 @@
 // license that can be found in the LICENSE file.
 
 // Package db 数据库操作底层接口定义以及实现包括：leveldb、
-// memdb、mvcc、badgerdb、pegasus、ssdb
+// memdb、mvcc、badgerdb、pegasus、ssdb、rocksdb
 package db
 
 import (
@@ -171,12 +171,27 @@ const (
 	goBadgerDBBackendStr  = "gobadgerdb"
 	ssDBBackendStr        = "ssdb"
 	goPegasusDbBackendStr = "pegasus"
+	goRocksDBBackendStr   = "gorocksdb" // 需要librocksdb，编译的时候加上 -tags gorocksdb
 )
 
 type dbCreator func(name string, dir string, cache int) (DB, error)
 
 var backends = map[string]dbCreator{}
 
+//readOnly 只读节点打开的数据库都是只读的
+var readOnly bool
+
+//SetReadOnly 设置以后打开的leveldb，badger和rocksdb都是只读的，写操作返回错误。
+//leveldb和badger的只读模式可以让多个只读节点共用一个停止写入的数据目录，rocksdb的只读模式可以和写入的节点共用数据目录
+func SetReadOnly(ro bool) {
+	readOnly = ro
+}
+
+//IsReadOnly 是否是只读模式
+func IsReadOnly() bool {
+	return readOnly
+}
+
 func registerDBCreator(backend string, creator dbCreator, force bool) {
 	_, ok := backends[backend]
 	if !force && ok {
@@ -197,6 +212,7 @@ func NewDB(name string, backend string, dir string, cache int32) DB {
 		fmt.Printf("Error initializing DB: %v\n", err)
 		panic("initializing DB error")
 	}
+	registerOpened(name, db)
 	return db
 }
 
@@ -6,6 +6,9 @@ package db
 
 import (
 	"bytes"
+	"fmt"
+	"sync"
+	"time"
 
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
@@ -15,10 +18,20 @@ import (
 
 var blog = log.New("module", "db.gobadgerdb")
 
+const (
+	//badgerGCInterval value log垃圾回收的间隔
+	badgerGCInterval = 10 * time.Minute
+	//badgerGCDiscardRatio value log文件中超过这个比例的数据已经失效的时候重写文件
+	badgerGCDiscardRatio = 0.5
+)
+
 //GoBadgerDB db
 type GoBadgerDB struct {
 	BaseDB
-	db *badger.DB
+	db        *badger.DB
+	quit      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
 }
 
 func init() {
@@ -33,6 +46,7 @@ func NewGoBadgerDB(name string, dir string, cache int) (*GoBadgerDB, error) {
 	opts := badger.DefaultOptions
 	opts.Dir = dir
 	opts.ValueDir = dir
+	opts.ReadOnly = readOnly
 	if cache <= 128 {
 		opts.ValueLogLoadingMode = options.FileIO
 		//opts.MaxTableSize = int64(cache) << 18 // cache = 128, MaxTableSize = 32M
@@ -45,12 +59,71 @@ func NewGoBadgerDB(name string, dir string, cache int) (*GoBadgerDB, error) {
 	}
 
 	db, err := badger.Open(opts)
+	if err == badger.ErrTruncateNeeded && !readOnly {
+		//崩溃的时候value log没有写完整，截掉损坏的部分，已经提交的数据在LSM中有记录，不会丢失
+		blog.Warn("NewGoBadgerDB value log corrupted, truncate", "dir", dir)
+		opts.Truncate = true
+		db, err = badger.Open(opts)
+	}
 	if err != nil {
 		blog.Error("NewGoBadgerDB", "error", err)
 		return nil, err
 	}
+	lsm, vlog := db.Size()
+	blog.Info("NewGoBadgerDB", "dir", dir, "lsm", lsm, "vlog", vlog)
+
+	database := &GoBadgerDB{db: db, quit: make(chan struct{})}
+	if !readOnly {
+		database.wg.Add(1)
+		go database.gcLoop(badgerGCInterval)
+	}
+	return database, nil
+}
+
+//gcLoop 定时回收value log，每次回收到没有可以重写的文件为止
+func (db *GoBadgerDB) gcLoop(interval time.Duration) {
+	defer db.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			db.RunValueLogGC()
+		case <-db.quit:
+			return
+		}
+	}
+}
+
+//RunValueLogGC 回收value log，返回重写的文件个数
+func (db *GoBadgerDB) RunValueLogGC() int {
+	count := 0
+	for {
+		select {
+		case <-db.quit:
+			return count
+		default:
+		}
+		err := db.db.RunValueLogGC(badgerGCDiscardRatio)
+		if err != nil {
+			if err != badger.ErrNoRewrite && err != badger.ErrRejected {
+				blog.Error("RunValueLogGC", "error", err)
+			}
+			break
+		}
+		count++
+	}
+	if count > 0 {
+		lsm, vlog := db.db.Size()
+		blog.Info("RunValueLogGC", "rewrite", count, "lsm", lsm, "vlog", vlog)
+	}
+	return count
+}
 
-	return &GoBadgerDB{db: db}, nil
+//CompactRange badger不能按范围压缩，回收整个value log
+func (db *GoBadgerDB) CompactRange(start, limit []byte) error {
+	db.RunValueLogGC()
+	return nil
 }
 
 //Get get
@@ -149,10 +222,14 @@ func (db *GoBadgerDB) DB() *badger.DB {
 
 //Close 关闭
 func (db *GoBadgerDB) Close() {
-	err := db.db.Close()
-	if err != nil {
-		return
-	}
+	db.closeOnce.Do(func() {
+		close(db.quit)
+		db.wg.Wait()
+		err := db.db.Close()
+		if err != nil {
+			blog.Error("Close", "error", err)
+		}
+	})
 }
 
 //Print 打印
@@ -182,8 +259,11 @@ func (db *GoBadgerDB) Print() {
 
 //Stats ...
 func (db *GoBadgerDB) Stats() map[string]string {
-	//TODO
-	return nil
+	lsm, vlog := db.db.Size()
+	return map[string]string{
+		"badger.lsm.size":  fmt.Sprint(lsm),
+		"badger.vlog.size": fmt.Sprint(vlog),
+	}
 }
 
 //Iterator 迭代器
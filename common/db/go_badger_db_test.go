@@ -5,7 +5,10 @@
 package db
 
 import (
+	"fmt"
 	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/dgraph-io/badger"
@@ -122,3 +125,47 @@ func TestBadgerBatch(t *testing.T) {
 	defer db.Close()
 	testBatch(t, db)
 }
+
+func TestGoBadgerDBValueLogGC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "badger")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	badgerdb, err := NewGoBadgerDB("gobadgerdb", dir, 128)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, badgerdb.Set([]byte("key"), []byte(RandStr(1024))))
+	}
+	//只有一个value log文件的时候不会重写
+	require.Equal(t, 0, badgerdb.RunValueLogGC())
+	require.NotEmpty(t, badgerdb.Stats()["badger.vlog.size"])
+	badgerdb.Close()
+	//重复关闭
+	badgerdb.Close()
+}
+
+// leveldb的数据迁移到badgerdb
+func TestMigrateLevelDBToBadger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	leveldb, err := NewGoLevelDB("blockchain", path.Join(dir, "leveldb"), 128)
+	require.NoError(t, err)
+	defer leveldb.Close()
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, leveldb.Set([]byte(fmt.Sprintf("my_key/%010d", i)), []byte(fmt.Sprintf("my_value/%010d", i))))
+	}
+	badgerdb, err := NewGoBadgerDB("blockchain", path.Join(dir, "badger"), 128)
+	require.NoError(t, err)
+	defer badgerdb.Close()
+	count, err := MigrateDB(leveldb, badgerdb, 1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), count)
+	value, err := badgerdb.Get([]byte("my_key/0000000999"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("my_value/0000000999"), value)
+
+	require.NoError(t, badgerdb.Set([]byte("other"), []byte("value")))
+	require.Equal(t, ErrMigrateVerify, VerifyDB(leveldb, badgerdb))
+}
@@ -53,8 +53,9 @@ func NewGoLevelDB(name string, dir string, cache int) (*GoLevelDB, error) {
 		BlockCacheCapacity:     cache / 2 * opt.MiB,
 		WriteBuffer:            cache / 4 * opt.MiB, // Two of these are used internally
 		Filter:                 filter.NewBloomFilter(10),
+		ReadOnly:               readOnly,
 	})
-	if _, corrupted := err.(*errors.ErrCorrupted); corrupted {
+	if _, corrupted := err.(*errors.ErrCorrupted); corrupted && !readOnly {
 		db, err = leveldb.RecoverFile(dbPath, nil)
 	}
 	if err != nil {
@@ -171,6 +172,20 @@ func (db *GoLevelDB) Stats() map[string]string {
 	return stats
 }
 
+//CompactRange 压缩[start, limit)范围的key
+func (db *GoLevelDB) CompactRange(start, limit []byte) error {
+	return db.db.CompactRange(util.Range{Start: start, Limit: limit})
+}
+
+//SizeOf 估算[start, limit)范围的key占用的磁盘空间
+func (db *GoLevelDB) SizeOf(start, limit []byte) (int64, error) {
+	sizes, err := db.db.SizeOf([]util.Range{{Start: start, Limit: limit}})
+	if err != nil {
+		return 0, err
+	}
+	return sizes.Sum(), nil
+}
+
 //Iterator 迭代器
 func (db *GoLevelDB) Iterator(start []byte, end []byte, reverse bool) Iterator {
 	if end == nil {
@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -257,3 +258,72 @@ func int642Bytes(i int64) []byte {
 func bytes2Int64(buf []byte) int64 {
 	return int64(binary.BigEndian.Uint64(buf))
 }
+
+func TestGoLevelDBCompactAndUsage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goleveldb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	leveldb := NewDB("compact", "leveldb", dir, 128)
+	defer leveldb.Close()
+	opened, ok := GetOpened("compact")
+	require.True(t, ok)
+	require.Equal(t, leveldb, opened)
+
+	value := bytes.Repeat([]byte("v"), 1024)
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, leveldb.Set([]byte(fmt.Sprintf("TxHash:%04d", i)), value))
+		require.NoError(t, leveldb.Set([]byte(fmt.Sprintf("mavl-coins-%04d", i)), value))
+	}
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, leveldb.Delete([]byte(fmt.Sprintf("mavl-coins-%04d", i))))
+	}
+	require.NoError(t, leveldb.Set([]byte("mavl-coins-last"), value))
+	require.NoError(t, CompactRange(leveldb, nil, nil))
+
+	usages, err := GetUsage(leveldb, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(usages))
+	require.Equal(t, []byte("TxHash:"), usages[0].Prefix)
+	require.Equal(t, int64(1000), usages[0].Keys)
+	require.Equal(t, []byte("mavl-"), usages[1].Prefix)
+	require.Equal(t, int64(1), usages[1].Keys)
+
+	usages, err = GetUsage(leveldb, [][]byte{[]byte("TxHash:00")})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), usages[0].Keys)
+	require.Equal(t, int64(100*(len("TxHash:0000")+1024)), usages[0].LiveBytes)
+	require.True(t, usages[0].DiskBytes > 0)
+
+	require.NoError(t, CompactPrefix(leveldb, []byte("mavl-")))
+	require.Equal(t, types.ErrNotSupport, CompactPrefix(NewDB("compactmem", "memdb", dir, 128), nil))
+}
+
+func TestGoLevelDBReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goleveldb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	leveldb, err := NewGoLevelDB("goleveldb", dir, 128)
+	require.NoError(t, err)
+	require.NoError(t, leveldb.Set([]byte("key"), []byte("value")))
+	leveldb.Close()
+
+	//只读模式多个实例共用一个目录
+	SetReadOnly(true)
+	defer SetReadOnly(false)
+	assert.True(t, IsReadOnly())
+	db1, err := NewGoLevelDB("goleveldb", dir, 128)
+	require.NoError(t, err)
+	defer db1.Close()
+	db2, err := NewGoLevelDB("goleveldb", dir, 128)
+	require.NoError(t, err)
+	defer db2.Close()
+	for _, db := range []*GoLevelDB{db1, db2} {
+		value, err := db.Get([]byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+		assert.NotNil(t, db.Set([]byte("key"), []byte("value2")))
+		batch := db.NewBatch(true)
+		batch.Set([]byte("key2"), []byte("value2"))
+		assert.NotNil(t, batch.Write())
+	}
+}
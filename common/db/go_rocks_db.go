@@ -0,0 +1,501 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build gorocksdb
+
+package db
+
+import (
+	"bytes"
+	"path"
+	"runtime"
+	"sync"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/tecbot/gorocksdb"
+)
+
+var rlog = log.New("module", "db.gorocksdb")
+
+func init() {
+	dbCreator := func(name string, dir string, cache int) (DB, error) {
+		return NewGoRocksDB(name, dir, cache)
+	}
+	registerDBCreator(goRocksDBBackendStr, dbCreator, false)
+}
+
+//rocksInstance 同一个目录下的rocksdb只打开一次，不同name的DB使用各自的column family
+type rocksInstance struct {
+	path string
+	db   *gorocksdb.DB
+	opts *gorocksdb.Options
+	cfs  map[string]*gorocksdb.ColumnFamilyHandle
+	refs int
+}
+
+var (
+	rocksMu        sync.Mutex
+	rocksInstances = make(map[string]*rocksInstance)
+)
+
+func rocksPath(dir string) string {
+	return path.Join(dir, "rocks.db")
+}
+
+//newRocksOptions level compaction，bloom filter，block cache使用一半的cache，memtable使用四分之一
+func newRocksOptions(cache int) *gorocksdb.Options {
+	if cache == 0 {
+		cache = 64
+	}
+	handles := cache
+	if handles < 16 {
+		handles = 16
+	}
+	if cache < 4 {
+		cache = 4
+	}
+	bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
+	bbto.SetBlockCache(gorocksdb.NewLRUCache(uint64(cache/2) << 20))
+	bbto.SetFilterPolicy(gorocksdb.NewBloomFilter(10))
+	bbto.SetCacheIndexAndFilterBlocks(true)
+	bbto.SetPinL0FilterAndIndexBlocksInCache(true)
+
+	opts := gorocksdb.NewDefaultOptions()
+	opts.SetBlockBasedTableFactory(bbto)
+	opts.SetCreateIfMissing(true)
+	opts.SetCreateIfMissingColumnFamilies(true)
+	opts.SetMaxOpenFiles(handles)
+	opts.IncreaseParallelism(runtime.NumCPU())
+	opts.OptimizeLevelStyleCompaction(uint64(cache/4) << 20)
+	opts.SetLevelCompactionDynamicLevelBytes(true)
+	opts.SetCompression(gorocksdb.LZ4Compression)
+	return opts
+}
+
+func openRocksInstance(dir string, name string, cache int) (*rocksInstance, *gorocksdb.ColumnFamilyHandle, error) {
+	rocksMu.Lock()
+	defer rocksMu.Unlock()
+	dbPath := rocksPath(dir)
+	inst, ok := rocksInstances[dbPath]
+	if !ok {
+		opts := newRocksOptions(cache)
+		names, err := gorocksdb.ListColumnFamilies(opts, dbPath)
+		if err != nil {
+			//新的数据库只有default
+			names = []string{"default"}
+		}
+		cfOpts := make([]*gorocksdb.Options, len(names))
+		for i := range names {
+			cfOpts[i] = opts
+		}
+		var db *gorocksdb.DB
+		var handles []*gorocksdb.ColumnFamilyHandle
+		if readOnly {
+			//只读打开的是打开时刻的数据，可以和写入的节点共用目录
+			db, handles, err = gorocksdb.OpenDbForReadOnlyColumnFamilies(opts, dbPath, names, cfOpts, false)
+		} else {
+			db, handles, err = gorocksdb.OpenDbColumnFamilies(opts, dbPath, names, cfOpts)
+		}
+		if err != nil {
+			opts.Destroy()
+			return nil, nil, err
+		}
+		inst = &rocksInstance{path: dbPath, db: db, opts: opts, cfs: make(map[string]*gorocksdb.ColumnFamilyHandle)}
+		for i, name := range names {
+			inst.cfs[name] = handles[i]
+		}
+		rocksInstances[dbPath] = inst
+	}
+	cf, ok := inst.cfs[name]
+	if !ok && readOnly {
+		if inst.refs == 0 {
+			inst.close()
+		}
+		return nil, nil, types.ErrNotFound
+	}
+	if !ok {
+		var err error
+		cf, err = inst.db.CreateColumnFamily(inst.opts, name)
+		if err != nil {
+			if inst.refs == 0 {
+				inst.close()
+			}
+			return nil, nil, err
+		}
+		inst.cfs[name] = cf
+	}
+	inst.refs++
+	return inst, cf, nil
+}
+
+func (inst *rocksInstance) close() {
+	for _, cf := range inst.cfs {
+		cf.Destroy()
+	}
+	inst.db.Close()
+	inst.opts.Destroy()
+	delete(rocksInstances, inst.path)
+}
+
+//GoRocksDB db
+type GoRocksDB struct {
+	BaseDB
+	inst *rocksInstance
+	db   *gorocksdb.DB
+	cf   *gorocksdb.ColumnFamilyHandle
+	ro   *gorocksdb.ReadOptions
+	wo   *gorocksdb.WriteOptions
+	wos  *gorocksdb.WriteOptions
+}
+
+//NewGoRocksDB new，name作为column family的名字，同一个dir下的DB共用一个rocksdb
+func NewGoRocksDB(name string, dir string, cache int) (*GoRocksDB, error) {
+	inst, cf, err := openRocksInstance(dir, name, cache)
+	if err != nil {
+		rlog.Error("NewGoRocksDB", "dir", dir, "name", name, "error", err)
+		return nil, err
+	}
+	wos := gorocksdb.NewDefaultWriteOptions()
+	wos.SetSync(true)
+	return &GoRocksDB{
+		inst: inst,
+		db:   inst.db,
+		cf:   cf,
+		ro:   gorocksdb.NewDefaultReadOptions(),
+		wo:   gorocksdb.NewDefaultWriteOptions(),
+		wos:  wos,
+	}, nil
+}
+
+//Get get
+func (db *GoRocksDB) Get(key []byte) ([]byte, error) {
+	slice, err := db.db.GetCF(db.ro, db.cf, key)
+	if err != nil {
+		rlog.Error("Get", "error", err)
+		return nil, err
+	}
+	defer slice.Free()
+	if !slice.Exists() {
+		return nil, ErrNotFoundInDb
+	}
+	return cloneByte(slice.Data()), nil
+}
+
+//Set set
+func (db *GoRocksDB) Set(key []byte, value []byte) error {
+	err := db.db.PutCF(db.wo, db.cf, key, value)
+	if err != nil {
+		rlog.Error("Set", "error", err)
+		return err
+	}
+	return nil
+}
+
+//SetSync 同步
+func (db *GoRocksDB) SetSync(key []byte, value []byte) error {
+	err := db.db.PutCF(db.wos, db.cf, key, value)
+	if err != nil {
+		rlog.Error("SetSync", "error", err)
+		return err
+	}
+	return nil
+}
+
+//Delete 删除
+func (db *GoRocksDB) Delete(key []byte) error {
+	err := db.db.DeleteCF(db.wo, db.cf, key)
+	if err != nil {
+		rlog.Error("Delete", "error", err)
+		return err
+	}
+	return nil
+}
+
+//DeleteSync 删除同步
+func (db *GoRocksDB) DeleteSync(key []byte) error {
+	err := db.db.DeleteCF(db.wos, db.cf, key)
+	if err != nil {
+		rlog.Error("DeleteSync", "error", err)
+		return err
+	}
+	return nil
+}
+
+//DB db
+func (db *GoRocksDB) DB() *gorocksdb.DB {
+	return db.db
+}
+
+//Close 关闭，同一个目录下所有的DB都关闭以后才关闭rocksdb
+func (db *GoRocksDB) Close() {
+	db.ro.Destroy()
+	db.wo.Destroy()
+	db.wos.Destroy()
+	rocksMu.Lock()
+	defer rocksMu.Unlock()
+	db.inst.refs--
+	if db.inst.refs == 0 {
+		db.inst.close()
+	}
+}
+
+//Print 打印
+func (db *GoRocksDB) Print() {
+	rlog.Info("Print", "stats", db.db.GetPropertyCF("rocksdb.stats", db.cf))
+	it := db.db.NewIteratorCF(db.ro, db.cf)
+	defer it.Close()
+	for it.SeekToFirst(); it.Valid(); it.Next() {
+		key := it.Key()
+		value := it.Value()
+		rlog.Info("Print", "key", string(key.Data()), "value", string(value.Data()))
+		key.Free()
+		value.Free()
+	}
+}
+
+//Stats ...
+func (db *GoRocksDB) Stats() map[string]string {
+	keys := []string{
+		"rocksdb.stats",
+		"rocksdb.sstables",
+		"rocksdb.estimate-num-keys",
+		"rocksdb.estimate-live-data-size",
+		"rocksdb.num-running-compactions",
+		"rocksdb.block-cache-usage",
+		"rocksdb.cur-size-all-mem-tables",
+	}
+	stats := make(map[string]string)
+	for _, key := range keys {
+		if str := db.db.GetPropertyCF(key, db.cf); str != "" {
+			stats[key] = str
+		}
+	}
+	return stats
+}
+
+//CompactRange 压缩当前column family中[start, limit)范围的key
+func (db *GoRocksDB) CompactRange(start, limit []byte) error {
+	db.db.CompactRangeCF(db.cf, gorocksdb.Range{Start: start, Limit: limit})
+	return nil
+}
+
+//SizeOf 估算当前column family中[start, limit)范围的key占用的磁盘空间
+func (db *GoRocksDB) SizeOf(start, limit []byte) (int64, error) {
+	sizes := db.db.GetApproximateSizesCF(db.cf, []gorocksdb.Range{{Start: start, Limit: limit}})
+	return int64(sizes[0]), nil
+}
+
+//Backup 用backup engine把整个rocksdb(包括同一个目录下其他DB的column family)增量备份到backupDir，
+//keep大于0的时候只保留最近的keep个备份
+func (db *GoRocksDB) Backup(backupDir string, keep uint32) error {
+	engine, err := gorocksdb.OpenBackupEngine(db.inst.opts, backupDir)
+	if err != nil {
+		rlog.Error("Backup", "dir", backupDir, "error", err)
+		return err
+	}
+	defer engine.Close()
+	if err := engine.CreateNewBackupFlush(db.db, true); err != nil {
+		rlog.Error("Backup", "dir", backupDir, "error", err)
+		return err
+	}
+	if keep > 0 {
+		return engine.PurgeOldBackups(keep)
+	}
+	return nil
+}
+
+//RestoreGoRocksDB 用backupDir中最新的备份恢复dir下的rocksdb，恢复的时候数据库不能是打开的
+func RestoreGoRocksDB(backupDir string, dir string) error {
+	opts := gorocksdb.NewDefaultOptions()
+	defer opts.Destroy()
+	engine, err := gorocksdb.OpenBackupEngine(opts, backupDir)
+	if err != nil {
+		return err
+	}
+	defer engine.Close()
+	ro := gorocksdb.NewRestoreOptions()
+	defer ro.Destroy()
+	dbPath := rocksPath(dir)
+	return engine.RestoreDBFromLatestBackup(dbPath, dbPath, ro)
+}
+
+//Iterator 迭代器
+func (db *GoRocksDB) Iterator(start []byte, end []byte, reverse bool) Iterator {
+	if end == nil {
+		end = bytesPrefix(start)
+	}
+	if bytes.Equal(end, types.EmptyValue) {
+		end = nil
+	}
+	it := db.db.NewIteratorCF(db.ro, db.cf)
+	return &goRocksDBIt{it, itBase{start, end, reverse}}
+}
+
+//goRocksDBIt 和goleveldb的迭代器一样，范围是[start, end)
+type goRocksDBIt struct {
+	*gorocksdb.Iterator
+	itBase
+}
+
+func (dbit *goRocksDBIt) inRange() bool {
+	if !dbit.Iterator.Valid() {
+		return false
+	}
+	key := dbit.Iterator.Key()
+	defer key.Free()
+	k := key.Data()
+	if dbit.start != nil && bytes.Compare(k, dbit.start) < 0 {
+		return false
+	}
+	return dbit.end == nil || bytes.Compare(k, dbit.end) < 0
+}
+
+//afterEnd 迭代器已经超出了范围的结尾
+func (dbit *goRocksDBIt) afterEnd() bool {
+	if !dbit.Iterator.Valid() {
+		return true
+	}
+	if dbit.end == nil {
+		return false
+	}
+	key := dbit.Iterator.Key()
+	defer key.Free()
+	return bytes.Compare(key.Data(), dbit.end) >= 0
+}
+
+//seekLast 移动到范围内的最后一个key
+func (dbit *goRocksDBIt) seekLast() {
+	if dbit.end == nil {
+		dbit.Iterator.SeekToLast()
+		return
+	}
+	//SeekForPrev 停在小于等于end的key上，范围不包括end
+	dbit.Iterator.SeekForPrev(dbit.end)
+	if dbit.afterEnd() && dbit.Iterator.Valid() {
+		dbit.Iterator.Prev()
+	}
+}
+
+//Close 关闭
+func (dbit *goRocksDBIt) Close() {
+	dbit.Iterator.Close()
+}
+
+//Next next
+func (dbit *goRocksDBIt) Next() bool {
+	if dbit.reverse {
+		if dbit.afterEnd() {
+			dbit.seekLast()
+		} else {
+			dbit.Iterator.Prev()
+		}
+		return dbit.Valid()
+	}
+	dbit.Iterator.Next()
+	return dbit.Valid()
+}
+
+//Rewind ...
+func (dbit *goRocksDBIt) Rewind() bool {
+	if dbit.reverse {
+		dbit.seekLast()
+		return dbit.Valid()
+	}
+	if dbit.start == nil {
+		dbit.Iterator.SeekToFirst()
+	} else {
+		dbit.Iterator.Seek(dbit.start)
+	}
+	return dbit.Valid()
+}
+
+//Seek 查找
+func (dbit *goRocksDBIt) Seek(key []byte) bool {
+	dbit.Iterator.Seek(key)
+	return dbit.Valid()
+}
+
+func (dbit *goRocksDBIt) Key() []byte {
+	key := dbit.Iterator.Key()
+	defer key.Free()
+	return cloneByte(key.Data())
+}
+
+func (dbit *goRocksDBIt) Value() []byte {
+	value := dbit.Iterator.Value()
+	defer value.Free()
+	return cloneByte(value.Data())
+}
+
+func (dbit *goRocksDBIt) ValueCopy() []byte {
+	return dbit.Value()
+}
+
+func (dbit *goRocksDBIt) Valid() bool {
+	return dbit.inRange()
+}
+
+func (dbit *goRocksDBIt) Error() error {
+	return dbit.Iterator.Err()
+}
+
+type goRocksDBBatch struct {
+	db    *GoRocksDB
+	batch *gorocksdb.WriteBatch
+	wop   *gorocksdb.WriteOptions
+	size  int
+	len   int
+}
+
+//NewBatch new
+func (db *GoRocksDB) NewBatch(sync bool) Batch {
+	wop := db.wo
+	if sync {
+		wop = db.wos
+	}
+	batch := &goRocksDBBatch{db, gorocksdb.NewWriteBatch(), wop, 0, 0}
+	//Batch接口没有Close，由finalizer释放WriteBatch
+	runtime.SetFinalizer(batch, func(b *goRocksDBBatch) {
+		b.batch.Destroy()
+	})
+	return batch
+}
+
+func (mBatch *goRocksDBBatch) Set(key, value []byte) {
+	mBatch.batch.PutCF(mBatch.db.cf, key, value)
+	mBatch.size += len(key)
+	mBatch.size += len(value)
+	mBatch.len += len(value)
+}
+
+func (mBatch *goRocksDBBatch) Delete(key []byte) {
+	mBatch.batch.DeleteCF(mBatch.db.cf, key)
+	mBatch.size += len(key)
+	mBatch.len++
+}
+
+func (mBatch *goRocksDBBatch) Write() error {
+	err := mBatch.db.db.Write(mBatch.wop, mBatch.batch)
+	if err != nil {
+		rlog.Error("Write", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (mBatch *goRocksDBBatch) ValueSize() int {
+	return mBatch.size
+}
+
+//ValueLen  batch数量
+func (mBatch *goRocksDBBatch) ValueLen() int {
+	return mBatch.len
+}
+
+func (mBatch *goRocksDBBatch) Reset() {
+	mBatch.batch.Clear()
+	mBatch.len = 0
+	mBatch.size = 0
+}
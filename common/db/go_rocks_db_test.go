@@ -0,0 +1,104 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build gorocksdb
+
+package db
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+// rocksdb迭代器测试
+func TestGoRocksDBIterator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorocksdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rocksdb, err := NewGoRocksDB("gorocksdb", dir, 128)
+	require.NoError(t, err)
+	defer rocksdb.Close()
+	testDBIterator(t, rocksdb)
+}
+
+func TestGoRocksDBIteratorDel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorocksdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rocksdb, err := NewGoRocksDB("gorocksdb", dir, 128)
+	require.NoError(t, err)
+	defer rocksdb.Close()
+	testDBIteratorDel(t, rocksdb)
+}
+
+func TestGoRocksDBBatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorocksdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rocksdb, err := NewGoRocksDB("gorocksdb", dir, 128)
+	require.NoError(t, err)
+	defer rocksdb.Close()
+	testBatch(t, rocksdb)
+}
+
+// rocksdb边界测试
+func TestGoRocksDBBoundary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorocksdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rocksdb, err := NewGoRocksDB("gorocksdb", dir, 128)
+	require.NoError(t, err)
+	defer rocksdb.Close()
+	testDBBoundary(t, rocksdb)
+}
+
+// 同一个目录下不同name的DB在不同的column family，互相看不到数据
+func TestGoRocksDBColumnFamily(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorocksdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db1, err := NewGoRocksDB("db1", dir, 128)
+	require.NoError(t, err)
+	db2, err := NewGoRocksDB("db2", dir, 128)
+	require.NoError(t, err)
+	require.NoError(t, db1.Set([]byte("key"), []byte("value1")))
+	_, err = db2.Get([]byte("key"))
+	require.Equal(t, types.ErrNotFound, err)
+	require.NoError(t, db2.Set([]byte("key"), []byte("value2")))
+	db1.Close()
+	db2.Close()
+
+	//重新打开以后column family还在
+	db2, err = NewGoRocksDB("db2", dir, 128)
+	require.NoError(t, err)
+	value, err := db2.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), value)
+	db2.Close()
+}
+
+func TestGoRocksDBBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gorocksdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rocksdb, err := NewGoRocksDB("gorocksdb", path.Join(dir, "data"), 128)
+	require.NoError(t, err)
+	require.NoError(t, rocksdb.Set([]byte("key"), []byte("value")))
+	require.NoError(t, rocksdb.Backup(path.Join(dir, "backup"), 2))
+	require.NoError(t, rocksdb.Delete([]byte("key")))
+	rocksdb.Close()
+
+	require.NoError(t, RestoreGoRocksDB(path.Join(dir, "backup"), path.Join(dir, "data")))
+	rocksdb, err = NewGoRocksDB("gorocksdb", path.Join(dir, "data"), 128)
+	require.NoError(t, err)
+	defer rocksdb.Close()
+	value, err := rocksdb.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
@@ -0,0 +1,67 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"errors"
+
+	log "github.com/33cn/chain33/common/log/log15"
+)
+
+var migratelog = log.New("module", "db.migrate")
+
+//ErrMigrateVerify 迁移以后两个数据库的数据不一致
+var ErrMigrateVerify = errors.New("ErrMigrateVerify")
+
+//MigrateDB 把src中所有的数据按批写入dst，写完以后逐条对比两个数据库，返回迁移的记录数
+func MigrateDB(src, dst DB, batchSize int) (int64, error) {
+	if batchSize <= 0 {
+		batchSize = 1024 * 1024
+	}
+	it := src.Iterator(nil, nil, false)
+	defer it.Close()
+	batch := dst.NewBatch(true)
+	var count int64
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Set(cloneByte(it.Key()), it.ValueCopy())
+		count++
+		if batch.ValueSize() >= batchSize {
+			if err := batch.Write(); err != nil {
+				return count, err
+			}
+			batch.Reset()
+			migratelog.Info("MigrateDB", "count", count)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return count, err
+	}
+	if err := batch.Write(); err != nil {
+		return count, err
+	}
+	return count, VerifyDB(src, dst)
+}
+
+//VerifyDB 检查两个数据库的key和value完全一致
+func VerifyDB(src, dst DB) error {
+	sit := src.Iterator(nil, nil, false)
+	defer sit.Close()
+	dit := dst.Iterator(nil, nil, false)
+	defer dit.Close()
+	sok, dok := sit.Rewind(), dit.Rewind()
+	for sok && dok {
+		if !bytes.Equal(sit.Key(), dit.Key()) || !bytes.Equal(sit.Value(), dit.Value()) {
+			migratelog.Error("VerifyDB", "src key", string(sit.Key()), "dst key", string(dit.Key()))
+			return ErrMigrateVerify
+		}
+		sok, dok = sit.Next(), dit.Next()
+	}
+	if sok != dok {
+		migratelog.Error("VerifyDB", "src more", sok, "dst more", dok)
+		return ErrMigrateVerify
+	}
+	return nil
+}
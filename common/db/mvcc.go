@@ -7,6 +7,7 @@ package db
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"strconv"
 
 	log "github.com/33cn/chain33/common/log/log15"
@@ -313,6 +314,41 @@ func (m *SimpleMVCC) GetV(key []byte, version int64) ([]byte, error) {
 	return val, nil
 }
 
+//GetHistory 获取key在version(包括)之前的修改记录，最多count个，按版本从高到低排列
+func (m *SimpleMVCC) GetHistory(key []byte, version int64, count int) ([]*types.ValueAtHeight, error) {
+	if version < 0 {
+		version = math.MaxInt64
+	}
+	prefix := GetKeyPerfix(key)
+	var history []*types.ValueAtHeight
+	for len(history) < count && version >= 0 {
+		search, err := GetKey(key, version)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := m.kvdb.List(prefix, search, 1, ListSeek)
+		if err == types.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) != 2 {
+			break
+		}
+		v, err := getVersion(vals[0])
+		if err != nil {
+			return nil, err
+		}
+		if v > version {
+			break
+		}
+		history = append(history, &types.ValueAtHeight{Height: v, Value: vals[1]})
+		version = v - 1
+	}
+	return history, nil
+}
+
 //AddMVCC add keys in a version
 //添加MVCC的规则:
 //必须提供现有hash 和 prev 的hash, 而且这两个版本号相差1
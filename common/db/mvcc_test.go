@@ -223,3 +223,34 @@ func TestAddDelMVCC(t *testing.T) {
 	assert.Equal(t, int64(0), maxv)
 
 }
+
+func TestGetHistory(t *testing.T) {
+	m := getMVCC()
+	defer closeMVCC(m)
+	key := []byte("mavl-manage-token-blacklist")
+	for _, v := range []int64{1, 5, 9} {
+		assert.Nil(t, m.SetV(key, []byte(fmt.Sprint("value", v)), v))
+	}
+	//前缀相同的其他key不影响结果
+	assert.Nil(t, m.SetV([]byte("mavl-manage-token-blacklist2"), []byte("other"), 7))
+
+	history, err := m.GetHistory(key, -1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(history))
+	assert.Equal(t, int64(9), history[0].Height)
+	assert.Equal(t, []byte("value9"), history[0].Value)
+	assert.Equal(t, int64(1), history[2].Height)
+
+	history, err = m.GetHistory(key, 8, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(history))
+	assert.Equal(t, int64(5), history[0].Height)
+
+	history, err = m.GetHistory(key, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(history))
+
+	history, err = m.GetHistory([]byte("notexist"), -1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(history))
+}
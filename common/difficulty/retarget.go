@@ -0,0 +1,216 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package difficulty
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+)
+
+// ErrRetargetNotFound 没有注册的难度调整算法
+var ErrRetargetNotFound = errors.New("ErrRetargetNotFound")
+
+// 难度调整算法的名称
+const (
+	AlgoBitcoin    = "bitcoin"
+	AlgoLWMA       = "lwma"
+	AlgoDigishield = "digishield"
+)
+
+// BlockInfo 计算难度需要的区块信息
+type BlockInfo struct {
+	Height    int64
+	BlockTime int64
+	Bits      uint32
+}
+
+// Param 难度调整的参数，时间的单位为秒
+type Param struct {
+	PowLimitBits       uint32
+	TargetTimespan     int64
+	TargetTimePerBlock int64
+	//AdjustmentFactor 每次调整难度的最大倍数
+	AdjustmentFactor int64
+}
+
+// Retarget 难度调整算法
+type Retarget interface {
+	// Window 计算下一个区块的难度需要的最近的区块个数(包含父区块)
+	Window(param *Param) int64
+	// NextBits 根据最近的区块计算下一个区块的难度，blocks 按高度从低到高排列，最后一个是父区块
+	NextBits(blocks []*BlockInfo, param *Param) uint32
+}
+
+var (
+	mu        sync.Mutex
+	retargets = make(map[string]Retarget)
+)
+
+func init() {
+	Register(AlgoBitcoin, &bitcoinRetarget{})
+	Register(AlgoLWMA, &lwmaRetarget{})
+	Register(AlgoDigishield, &digishieldRetarget{})
+}
+
+// Register 注册难度调整算法，同名的算法会被替换
+func Register(name string, r Retarget) {
+	mu.Lock()
+	defer mu.Unlock()
+	retargets[name] = r
+}
+
+// GetRetarget 获取难度调整算法，name 为空的时候使用bitcoin的算法
+func GetRetarget(name string) (Retarget, error) {
+	if name == "" {
+		name = AlgoBitcoin
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	r, ok := retargets[name]
+	if !ok {
+		return nil, ErrRetargetNotFound
+	}
+	return r, nil
+}
+
+//limitTarget 难度不能低于powLimit
+func limitTarget(target *big.Int, param *Param) uint32 {
+	powLimit := CompactToBig(param.PowLimitBits)
+	if target.Sign() <= 0 {
+		target = big.NewInt(1)
+	}
+	if target.Cmp(powLimit) > 0 {
+		return param.PowLimitBits
+	}
+	return BigToCompact(target)
+}
+
+//bitcoinRetarget 每隔 targetTimespan / targetTimePerBlock 个区块调整一次难度，调整的范围由AdjustmentFactor限制，
+//区块时间波动比较大的时候难度会出现振荡
+type bitcoinRetarget struct{}
+
+func (r *bitcoinRetarget) interval(param *Param) int64 {
+	interval := param.TargetTimespan / param.TargetTimePerBlock
+	if interval < 1 {
+		interval = 1
+	}
+	return interval
+}
+
+func (r *bitcoinRetarget) Window(param *Param) int64 {
+	return r.interval(param) + 1
+}
+
+func (r *bitcoinRetarget) NextBits(blocks []*BlockInfo, param *Param) uint32 {
+	if len(blocks) == 0 {
+		return param.PowLimitBits
+	}
+	parent := blocks[len(blocks)-1]
+	interval := r.interval(param)
+	if (parent.Height+1)%interval != 0 || int64(len(blocks)) <= interval {
+		return parent.Bits
+	}
+	first := blocks[int64(len(blocks))-interval-1]
+	actual := parent.BlockTime - first.BlockTime
+	minTimespan := param.TargetTimespan / param.AdjustmentFactor
+	maxTimespan := param.TargetTimespan * param.AdjustmentFactor
+	if actual < minTimespan {
+		actual = minTimespan
+	} else if actual > maxTimespan {
+		actual = maxTimespan
+	}
+	target := CompactToBig(parent.Bits)
+	target.Mul(target, big.NewInt(actual))
+	target.Div(target, big.NewInt(param.TargetTimespan))
+	return limitTarget(target, param)
+}
+
+//lwmaRetarget 线性加权移动平均，每个区块都调整难度，越近的区块权重越大，
+//窗口大小为 targetTimespan / targetTimePerBlock
+type lwmaRetarget struct{}
+
+func (r *lwmaRetarget) Window(param *Param) int64 {
+	n := param.TargetTimespan / param.TargetTimePerBlock
+	if n < 2 {
+		n = 2
+	}
+	return n + 1
+}
+
+func (r *lwmaRetarget) NextBits(blocks []*BlockInfo, param *Param) uint32 {
+	if len(blocks) < 2 {
+		return param.PowLimitBits
+	}
+	if w := r.Window(param); int64(len(blocks)) > w {
+		blocks = blocks[int64(len(blocks))-w:]
+	}
+	t := param.TargetTimePerBlock
+	n := int64(len(blocks) - 1)
+	weighted := int64(0)
+	sumTarget := new(big.Int)
+	for i := int64(1); i <= n; i++ {
+		//出块时间限制在 [1, 6T] 之间，防止时间戳操纵
+		solvetime := blocks[i].BlockTime - blocks[i-1].BlockTime
+		if solvetime < 1 {
+			solvetime = 1
+		} else if solvetime > 6*t {
+			solvetime = 6 * t
+		}
+		weighted += i * solvetime
+		sumTarget.Add(sumTarget, CompactToBig(blocks[i].Bits))
+	}
+	//next = avgTarget * weighted / (T * n * (n+1) / 2)
+	k := t * n * (n + 1) / 2
+	target := sumTarget.Div(sumTarget, big.NewInt(n))
+	target.Mul(target, big.NewInt(weighted))
+	target.Div(target, big.NewInt(k))
+	return limitTarget(target, param)
+}
+
+// digishield 平均窗口和阻尼参数
+const (
+	digishieldWindow  = 17
+	digishieldDampen  = 4
+	digishieldMaxUp   = 16
+	digishieldMaxDown = 32
+	digishieldPercent = 100
+)
+
+//digishieldRetarget 每个区块都调整难度，使用最近17个区块的平均难度，实际时间的偏差按1/4阻尼，
+//难度一次最多增加16%，最多减少32%
+type digishieldRetarget struct{}
+
+func (r *digishieldRetarget) Window(param *Param) int64 {
+	return digishieldWindow + 1
+}
+
+func (r *digishieldRetarget) NextBits(blocks []*BlockInfo, param *Param) uint32 {
+	if len(blocks) < 2 {
+		return param.PowLimitBits
+	}
+	if w := r.Window(param); int64(len(blocks)) > w {
+		blocks = blocks[int64(len(blocks))-w:]
+	}
+	n := int64(len(blocks) - 1)
+	sumTarget := new(big.Int)
+	for _, b := range blocks[1:] {
+		sumTarget.Add(sumTarget, CompactToBig(b.Bits))
+	}
+	expected := param.TargetTimePerBlock * n
+	actual := blocks[n].BlockTime - blocks[0].BlockTime
+	actual = expected + (actual-expected)/digishieldDampen
+	minTimespan := expected * (digishieldPercent - digishieldMaxUp) / digishieldPercent
+	maxTimespan := expected * (digishieldPercent + digishieldMaxDown) / digishieldPercent
+	if actual < minTimespan {
+		actual = minTimespan
+	} else if actual > maxTimespan {
+		actual = maxTimespan
+	}
+	target := sumTarget.Div(sumTarget, big.NewInt(n))
+	target.Mul(target, big.NewInt(actual))
+	target.Div(target, big.NewInt(expected))
+	return limitTarget(target, param)
+}
@@ -0,0 +1,89 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package difficulty
+
+import (
+	"math/big"
+	"testing"
+)
+
+var testParam = &Param{
+	PowLimitBits:       0x1f00ffff,
+	TargetTimespan:     160,
+	TargetTimePerBlock: 16,
+	AdjustmentFactor:   4,
+}
+
+//genBlocks 生成固定出块间隔的区块，最后一个区块的高度为 height
+func genBlocks(count int, height int64, blocktime int64, bits uint32) []*BlockInfo {
+	var blocks []*BlockInfo
+	for i := 0; i < count; i++ {
+		h := height - int64(count-1-i)
+		blocks = append(blocks, &BlockInfo{Height: h, BlockTime: 1e9 + h*blocktime, Bits: bits})
+	}
+	return blocks
+}
+
+//compareTarget 返回新的难度目标和原来的比值(百分比)
+func compareTarget(next, prev uint32) int64 {
+	n := CompactToBig(next)
+	n.Mul(n, big.NewInt(100))
+	return n.Div(n, CompactToBig(prev)).Int64()
+}
+
+func TestGetRetarget(t *testing.T) {
+	r, err := GetRetarget("")
+	if err != nil || r != retargets[AlgoBitcoin] {
+		t.Error("default retarget should be bitcoin")
+	}
+	for _, name := range []string{AlgoBitcoin, AlgoLWMA, AlgoDigishield} {
+		if _, err := GetRetarget(name); err != nil {
+			t.Error("retarget not registered", name)
+		}
+	}
+	if _, err := GetRetarget("unknown"); err != ErrRetargetNotFound {
+		t.Error("unknown retarget should return ErrRetargetNotFound")
+	}
+}
+
+func TestRetarget(t *testing.T) {
+	bits := uint32(0x1e00ffff)
+	for _, name := range []string{AlgoBitcoin, AlgoLWMA, AlgoDigishield} {
+		r, _ := GetRetarget(name)
+		window := int(r.Window(testParam))
+		//bitcoin的算法只在调整的高度修改难度
+		height := int64(99)
+		if next := r.NextBits(genBlocks(window, height, 16, bits), testParam); compareTarget(next, bits) != 100 {
+			t.Error(name, "steady block time should keep difficulty", next)
+		}
+		if next := r.NextBits(genBlocks(window, height, 8, bits), testParam); compareTarget(next, bits) >= 100 {
+			t.Error(name, "fast blocks should increase difficulty", next)
+		}
+		if next := r.NextBits(genBlocks(window, height, 32, bits), testParam); compareTarget(next, bits) <= 100 {
+			t.Error(name, "slow blocks should decrease difficulty", next)
+		}
+		//难度不能低于powLimit
+		if next := r.NextBits(genBlocks(window, height, 1000, testParam.PowLimitBits), testParam); next != testParam.PowLimitBits {
+			t.Error(name, "difficulty should be limited by powLimit", next)
+		}
+		if next := r.NextBits(nil, testParam); next != testParam.PowLimitBits {
+			t.Error(name, "genesis should use powLimit", next)
+		}
+	}
+	//不在调整高度的时候使用父区块的难度
+	r, _ := GetRetarget(AlgoBitcoin)
+	if next := r.NextBits(genBlocks(10, 100, 8, bits), testParam); next != bits {
+		t.Error("bitcoin retarget should keep parent bits", next)
+	}
+	//bitcoin的算法一次最多调整AdjustmentFactor倍
+	if next := r.NextBits(genBlocks(11, 99, 1, bits), testParam); compareTarget(next, bits) != 25 {
+		t.Error("bitcoin retarget should be limited by adjustment factor", next)
+	}
+	//digishield 一次最多增加约16%的难度
+	r, _ = GetRetarget(AlgoDigishield)
+	if next := r.NextBits(genBlocks(18, 99, 1, bits), testParam); compareTarget(next, bits) < 83 || compareTarget(next, bits) > 84 {
+		t.Error("digishield retarget should be limited", next)
+	}
+}
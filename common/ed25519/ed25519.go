@@ -107,20 +107,40 @@ func Sign(privateKey *[PrivateKeySize]byte, message []byte) *[SignatureSize]byte
 
 // Verify returns true iff sig is a valid signature of message by publicKey.
 func Verify(publicKey *[PublicKeySize]byte, message []byte, sig *[SignatureSize]byte) bool {
-	if sig[63]&224 != 0 {
+	pub, ok := ParsePublicKey(publicKey)
+	if !ok {
 		return false
 	}
+	return pub.Verify(message, sig)
+}
 
-	var A edwards25519.ExtendedGroupElement
-	if !A.FromBytes(publicKey) {
+// PublicKey is a decoded public key. Decoding the curve point is a large part
+// of the verification cost, so it can be reused when one key verifies many signatures.
+type PublicKey struct {
+	raw  [PublicKeySize]byte
+	negA edwards25519.ExtendedGroupElement
+}
+
+// ParsePublicKey decodes publicKey, it returns false if publicKey is not a valid point.
+func ParsePublicKey(publicKey *[PublicKeySize]byte) (*PublicKey, bool) {
+	pub := &PublicKey{raw: *publicKey}
+	if !pub.negA.FromBytes(publicKey) {
+		return nil, false
+	}
+	edwards25519.FeNeg(&pub.negA.X, &pub.negA.X)
+	edwards25519.FeNeg(&pub.negA.T, &pub.negA.T)
+	return pub, true
+}
+
+// Verify returns true iff sig is a valid signature of message by the public key.
+func (pub *PublicKey) Verify(message []byte, sig *[SignatureSize]byte) bool {
+	if sig[63]&224 != 0 {
 		return false
 	}
-	edwards25519.FeNeg(&A.X, &A.X)
-	edwards25519.FeNeg(&A.T, &A.T)
 
 	h := sha512.New()
 	h.Write(sig[:32])
-	h.Write(publicKey[:])
+	h.Write(pub.raw[:])
 	h.Write(message)
 	var digest [64]byte
 	h.Sum(digest[:0])
@@ -131,7 +151,7 @@ func Verify(publicKey *[PublicKeySize]byte, message []byte, sig *[SignatureSize]
 	var R edwards25519.ProjectiveGroupElement
 	var b [32]byte
 	copy(b[:], sig[32:])
-	edwards25519.GeDoubleScalarMultVartime(&R, &hReduced, &A, &b)
+	edwards25519.GeDoubleScalarMultVartime(&R, &hReduced, &pub.negA, &b)
 
 	var checkR [32]byte
 	R.ToBytes(&checkR)
@@ -9,7 +9,6 @@ import (
 	"bytes"
 	"runtime"
 
-	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 )
 
@@ -268,7 +267,9 @@ func GetHashFromTwoHash(parent []byte, left []byte, right []byte) []byte {
 	}
 	copy(parent, left)
 	copy(parent[32:], right)
-	return common.Sha2Sum(parent)
+	//默认是 SHA256(SHA256(data))
+	h := types.GetChainHasher()
+	return h.Sum(h.Sum(parent))
 }
 
 //GetMerkleBranch 获取指定txindex的branch position 从0开始
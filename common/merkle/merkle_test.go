@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto/blake3"
+	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -373,6 +375,18 @@ func testGetMerkelRoot1(t *testing.T, testlen int) bool {
 	return true
 }
 
+func TestChainHasherMerkle(t *testing.T) {
+	left := common.Sha256([]byte("left"))
+	right := common.Sha256([]byte("right"))
+	assert.Equal(t, getHashFromTwoHash(left, right), GetMerkleRoot([][]byte{left, right}))
+
+	assert.Nil(t, types.SetChainHasher("blake3"))
+	defer types.SetChainHasher("")
+	h1 := blake3.Sum256(append(append([]byte{}, left...), right...))
+	h2 := blake3.Sum256(h1[:])
+	assert.Equal(t, h2[:], GetMerkleRoot([][]byte{left, right}))
+}
+
 func TestLog2(t *testing.T) {
 	assert.Equal(t, log2(0), 0)
 	assert.Equal(t, log2(1), 1)
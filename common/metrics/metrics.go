@@ -0,0 +1,211 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//Package metrics 进程内的计数器和直方图，按prometheus的文本格式在/metrics输出
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//LatencyBuckets 耗时直方图默认的分桶，单位秒
+var LatencyBuckets = []float64{0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5}
+
+//SizeBuckets 大小直方图默认的分桶
+var SizeBuckets = []float64{16, 64, 256, 1024, 4096, 16384, 65536, 262144, 1048576, 4194304}
+
+type metric interface {
+	name() string
+	write(w io.Writer)
+}
+
+var (
+	mu         sync.Mutex
+	metrics    = make(map[string]metric)
+	collectors []func(io.Writer)
+)
+
+func init() {
+	//和pprof共用监听地址
+	http.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		WriteMetrics(w)
+	})
+}
+
+func register(m metric) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := metrics[m.name()]; ok {
+		panic("metrics: duplicate metric " + m.name())
+	}
+	metrics[m.name()] = m
+}
+
+//RegisterCollector 注册一个自己输出prometheus文本格式的模块，在/metrics中输出在所有计数器之后
+func RegisterCollector(fn func(io.Writer)) {
+	mu.Lock()
+	collectors = append(collectors, fn)
+	mu.Unlock()
+}
+
+//WriteMetrics 按名字排序输出所有的计数器和直方图，然后输出注册的模块
+func WriteMetrics(w io.Writer) {
+	mu.Lock()
+	list := make([]metric, 0, len(metrics))
+	for _, m := range metrics {
+		list = append(list, m)
+	}
+	fns := append([]func(io.Writer){}, collectors...)
+	mu.Unlock()
+	sort.Slice(list, func(i, j int) bool { return list[i].name() < list[j].name() })
+	for _, m := range list {
+		m.write(w)
+	}
+	for _, fn := range fns {
+		fn(w)
+	}
+}
+
+//Counter 只增不减的计数器
+type Counter struct {
+	n, help string
+	value   int64
+}
+
+//NewCounter 创建并注册计数器，名字重复会panic
+func NewCounter(name, help string) *Counter {
+	c := &Counter{n: name, help: help}
+	register(c)
+	return c
+}
+
+//Add 增加delta
+func (c *Counter) Add(delta int64) {
+	atomic.AddInt64(&c.value, delta)
+}
+
+//Inc 加一
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+//Value 当前值
+func (c *Counter) Value() int64 {
+	return atomic.LoadInt64(&c.value)
+}
+
+func (c *Counter) name() string {
+	return c.n
+}
+
+func (c *Counter) write(w io.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", c.n, c.help, c.n, c.n, c.Value())
+}
+
+//Gauge 可以任意设置的值
+type Gauge struct {
+	n, help string
+	bits    uint64
+}
+
+//NewGauge 创建并注册gauge，名字重复会panic
+func NewGauge(name, help string) *Gauge {
+	g := &Gauge{n: name, help: help}
+	register(g)
+	return g
+}
+
+//Set 设置当前值
+func (g *Gauge) Set(v float64) {
+	atomic.StoreUint64(&g.bits, math.Float64bits(v))
+}
+
+//Value 当前值
+func (g *Gauge) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&g.bits))
+}
+
+func (g *Gauge) name() string {
+	return g.n
+}
+
+func (g *Gauge) write(w io.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s %g\n", g.n, g.help, g.n, g.n, g.Value())
+}
+
+//Histogram 按分桶统计观测值的分布
+type Histogram struct {
+	n, help string
+	mu      sync.Mutex
+	buckets []float64
+	counts  []uint64
+	count   uint64
+	sum     float64
+}
+
+//NewHistogram 创建并注册直方图，buckets必须从小到大排列，名字重复会panic
+func NewHistogram(name, help string, buckets []float64) *Histogram {
+	h := &Histogram{n: name, help: help, buckets: buckets, counts: make([]uint64, len(buckets))}
+	register(h)
+	return h
+}
+
+//Observe 记录一个观测值
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.buckets, v)
+	h.mu.Lock()
+	if i < len(h.counts) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += v
+	h.mu.Unlock()
+}
+
+//ObserveSince 记录从start到现在的秒数，返回耗时
+func (h *Histogram) ObserveSince(start time.Time) time.Duration {
+	cost := time.Since(start)
+	h.Observe(cost.Seconds())
+	return cost
+}
+
+//Count 观测的次数
+func (h *Histogram) Count() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+//Sum 所有观测值的和
+func (h *Histogram) Sum() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.sum
+}
+
+func (h *Histogram) name() string {
+	return h.n
+}
+
+func (h *Histogram) write(w io.Writer) {
+	h.mu.Lock()
+	counts := append([]uint64{}, h.counts...)
+	count, sum := h.count, h.sum
+	h.mu.Unlock()
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", h.n, h.help, h.n)
+	var cumulative uint64
+	for i, le := range h.buckets {
+		cumulative += counts[i]
+		fmt.Fprintf(w, "%s_bucket{le=\"%g\"} %d\n", h.n, le, cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket{le=\"+Inf\"} %d\n%s_sum %g\n%s_count %d\n", h.n, count, h.n, sum, h.n, count)
+}
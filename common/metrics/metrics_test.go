@@ -0,0 +1,69 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterAndGauge(t *testing.T) {
+	c := NewCounter("test_counter_total", "test counter")
+	c.Inc()
+	c.Add(2)
+	assert.Equal(t, int64(3), c.Value())
+	g := NewGauge("test_gauge", "test gauge")
+	g.Set(1.5)
+	assert.Equal(t, 1.5, g.Value())
+	assert.Panics(t, func() { NewCounter("test_counter_total", "dup") })
+
+	var buf bytes.Buffer
+	WriteMetrics(&buf)
+	assert.Contains(t, buf.String(), "# TYPE test_counter_total counter\ntest_counter_total 3\n")
+	assert.Contains(t, buf.String(), "# TYPE test_gauge gauge\ntest_gauge 1.5\n")
+}
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram("test_histogram", "test histogram", []float64{1, 10})
+	h.Observe(0.5)
+	h.Observe(1)
+	h.Observe(5)
+	h.Observe(100)
+	assert.Equal(t, uint64(4), h.Count())
+	assert.Equal(t, 106.5, h.Sum())
+
+	var buf bytes.Buffer
+	h.write(&buf)
+	expect := strings.Join([]string{
+		"# HELP test_histogram test histogram",
+		"# TYPE test_histogram histogram",
+		`test_histogram_bucket{le="1"} 2`,
+		`test_histogram_bucket{le="10"} 3`,
+		`test_histogram_bucket{le="+Inf"} 4`,
+		"test_histogram_sum 106.5",
+		"test_histogram_count 4",
+	}, "\n") + "\n"
+	assert.Equal(t, expect, buf.String())
+}
+
+func TestMetricsHandler(t *testing.T) {
+	NewCounter("test_handler_total", "handler counter").Inc()
+	RegisterCollector(func(w io.Writer) {
+		fmt.Fprintln(w, "test_collector 1")
+	})
+	rec := httptest.NewRecorder()
+	http.DefaultServeMux.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, 200, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, "test_handler_total 1\n")
+	assert.True(t, strings.HasSuffix(body, "test_collector 1\n"))
+}
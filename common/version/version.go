@@ -20,6 +20,9 @@ var (
 	GitCommit        string
 )
 
+//StoreFormatVerKey store数据库中记录存储格式版本的key
+var StoreFormatVerKey = []byte("StoreFormatVerKey")
+
 //GetLocalDBKeyList 获取本地key列表
 func GetLocalDBKeyList() [][]byte {
 	return [][]byte{
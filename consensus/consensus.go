@@ -15,6 +15,9 @@ import (
 
 // New new consensus queue module
 func New(cfg *types.Consensus, sub map[string][]byte) queue.Module {
+	if cfg.Schedule {
+		return newScheduler(cfg, sub)
+	}
 	con, err := consensus.Load(cfg.Name)
 	if err != nil {
 		panic("Unsupported consensus type:" + cfg.Name + " " + err.Error())
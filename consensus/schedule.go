@@ -0,0 +1,198 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/33cn/chain33/client"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/consensus"
+	"github.com/33cn/chain33/types"
+)
+
+var slog = log.New("module", "consensus.schedule")
+
+//proxyClient 子共识使用的客户端，消息由scheduler转发，关闭的时候不会关闭consensus的topic
+type proxyClient struct {
+	queue.Client
+	mu     sync.Mutex
+	recv   chan *queue.Message
+	closed bool
+}
+
+func newProxyClient(c queue.Client) *proxyClient {
+	return &proxyClient{Client: c, recv: make(chan *queue.Message, 5)}
+}
+
+//Recv 接收scheduler转发的消息
+func (p *proxyClient) Recv() chan *queue.Message {
+	return p.recv
+}
+
+//Sub consensus的topic由scheduler订阅
+func (p *proxyClient) Sub(topic string) {}
+
+//Close 只关闭转发的通道
+func (p *proxyClient) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.recv)
+	}
+}
+
+func (p *proxyClient) push(msg *queue.Message) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		msg.Reply(p.NewMessage("", msg.Ty, types.ErrChannelClosed))
+		return
+	}
+	p.recv <- msg
+}
+
+//scheduler 按照manage合约中的共识切换计划，在指定的高度停止当前的共识并启动新的共识
+//所有节点都按照父区块的状态决定下一个区块的共识，不需要同时修改配置文件
+type scheduler struct {
+	cfg    *types.Consensus
+	sub    map[string][]byte
+	client queue.Client
+	api    client.QueueProtocolAPI
+	name   string
+	module queue.Module
+	proxy  *proxyClient
+}
+
+func newScheduler(cfg *types.Consensus, sub map[string][]byte) *scheduler {
+	return &scheduler{cfg: cfg, sub: sub}
+}
+
+//SetQueueClient 按照最新区块的状态启动共识
+func (s *scheduler) SetQueueClient(c queue.Client) {
+	s.client = c
+	var err error
+	s.api, err = client.New(c, nil)
+	if err != nil {
+		panic(err)
+	}
+	name := s.cfg.Name
+	if header, err := s.api.GetLastHeader(); err == nil {
+		name = s.scheduled(header.Height+1, header.StateHash)
+	}
+	if err := s.switchTo(name); err != nil {
+		panic("Unsupported consensus type:" + name + " " + err.Error())
+	}
+	s.client.Sub("consensus")
+	go s.loop()
+}
+
+//Wait wait for ready
+func (s *scheduler) Wait() {
+	if s.module != nil {
+		s.module.Wait()
+	}
+}
+
+//Close 关闭当前的共识
+func (s *scheduler) Close() {
+	s.stop()
+	s.client.Close()
+	slog.Info("consensus schedule closed")
+}
+
+func (s *scheduler) loop() {
+	for msg := range s.client.Recv() {
+		switch msg.Ty {
+		case types.EventCheckBlock:
+			//区块必须由这个高度生效的共识检查
+			block := msg.GetData().(*types.BlockDetail).Block
+			if parent, err := s.getHeader(block.Height - 1); err == nil {
+				s.trySwitch(block.Height, parent.StateHash)
+			}
+			s.proxy.push(msg)
+		case types.EventAddBlock:
+			s.proxy.push(msg)
+			block := msg.GetData().(*types.BlockDetail).Block
+			s.trySwitch(block.Height+1, block.StateHash)
+		case types.EventDelBlock:
+			s.proxy.push(msg)
+			if header, err := s.api.GetLastHeader(); err == nil {
+				s.trySwitch(header.Height+1, header.StateHash)
+			}
+		default:
+			s.proxy.push(msg)
+		}
+	}
+}
+
+func (s *scheduler) getHeader(height int64) (*types.Header, error) {
+	if height < 0 {
+		return nil, types.ErrBlockHeight
+	}
+	headers, err := s.api.GetHeaders(&types.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return nil, err
+	}
+	if len(headers.Items) != 1 {
+		return nil, types.ErrBlockNotFound
+	}
+	return headers.Items[0], nil
+}
+
+//scheduled 读取height生效的共识，没有切换计划的时候使用创世时的共识
+func (s *scheduler) scheduled(height int64, stateHash []byte) string {
+	schedule, err := consensus.GetConsensusSchedule(s.api, height, stateHash)
+	if err != nil || schedule.Consensus == "" {
+		return s.cfg.Name
+	}
+	return schedule.Consensus
+}
+
+func (s *scheduler) trySwitch(height int64, stateHash []byte) {
+	name := s.scheduled(height, stateHash)
+	if name == s.name {
+		return
+	}
+	slog.Info("switch consensus", "height", height, "from", s.name, "to", name)
+	if err := s.switchTo(name); err != nil {
+		slog.Error("switch consensus", "height", height, "name", name, "err", err)
+	}
+}
+
+func (s *scheduler) switchTo(name string) error {
+	create, err := consensus.Load(name)
+	if err != nil {
+		return err
+	}
+	s.stop()
+	cfg := *s.cfg
+	cfg.Name = name
+	obj := create(&cfg, s.sub[name])
+	consensus.QueryData.SetThis(name, reflect.ValueOf(obj))
+	s.name = name
+	s.module = obj
+	s.proxy = newProxyClient(s.client)
+	obj.SetQueueClient(s.proxy)
+	return nil
+}
+
+func (s *scheduler) stop() {
+	if s.module == nil {
+		return
+	}
+	s.module.Close()
+	//子共识的Close一般不会关闭BaseClient，这里停止出块并关闭转发的通道
+	if base, ok := s.module.(interface {
+		Stop()
+	}); ok {
+		base.Stop()
+	} else {
+		s.proxy.Close()
+	}
+}
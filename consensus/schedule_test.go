@@ -0,0 +1,93 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consensus_test
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	"github.com/33cn/chain33/system/consensus/solo"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+var solo2Created int32
+
+func init() {
+	drivers.Reg("solo2", func(cfg *types.Consensus, sub []byte) queue.Module {
+		atomic.AddInt32(&solo2Created, 1)
+		return solo.New(cfg, sub)
+	})
+	drivers.QueryData.Register("solo2", &solo.Client{})
+}
+
+func sendScheduleTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, value string) int32 {
+	txbytes, err := types.CallCreateTx(mty.ManageX, "Modify", &types.ModifyConfig{Key: mty.ConsensusScheduleKey, Value: value, Op: "add"})
+	assert.Nil(t, err)
+	var tx types.Transaction
+	assert.Nil(t, types.Decode(txbytes, &tx))
+	tx.Fee = 1e6
+	tx.Sign(types.SECP256K1, priv)
+	hash := mock33.SendTx(&tx)
+	detail, err := mock33.WaitTx(hash)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+func TestConsensusSchedule(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.Consensus.Schedule = true
+	atomic.StoreInt32(&solo2Created, 0)
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	//manage合约的超级管理员
+	manager := util.TestPrivkeyList[0]
+	mock33.SendTx(util.CreateCoinsTx(genesis, address.PubKeyToAddress(manager.PubKey().Bytes()).String(), 100*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	height := mock33.GetLastBlock().Height
+	//已经生效的高度和不合法的计划不能添加
+	assert.Equal(t, int32(types.ExecPack), sendScheduleTx(t, mock33, manager, fmt.Sprintf("%d:solo2", height)))
+	assert.Equal(t, int32(types.ExecPack), sendScheduleTx(t, mock33, manager, fmt.Sprintf("%d:blockSize=10", height+10)))
+
+	height = mock33.GetLastBlock().Height
+	switchHeight := height + 4
+	assert.Equal(t, int32(types.ExecOk), sendScheduleTx(t, mock33, manager, fmt.Sprintf("%d:solo2", switchHeight)))
+	assert.Equal(t, int32(types.ExecOk), sendScheduleTx(t, mock33, manager, fmt.Sprintf("%d:maxTxNumber=1", switchHeight)))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&solo2Created))
+	for mock33.GetLastBlock().Height < switchHeight-1 {
+		mock33.SendTx(util.CreateNoneTx(genesis))
+		assert.Nil(t, mock33.Wait())
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&solo2Created))
+
+	//切换以后由新的共识出块，每个区块最多一个交易
+	var hashes [][]byte
+	for i := 0; i < 3; i++ {
+		hashes = append(hashes, mock33.SendTx(util.CreateNoneTx(genesis)))
+	}
+	for _, hash := range hashes {
+		_, err := mock33.WaitTx(hash)
+		assert.Nil(t, err)
+	}
+	last := mock33.GetLastBlock()
+	assert.True(t, last.Height >= switchHeight+2)
+	for h := switchHeight; h <= last.Height; h++ {
+		assert.Equal(t, 1, len(mock33.GetBlock(h).Txs))
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&solo2Created))
+}
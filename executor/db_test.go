@@ -122,3 +122,29 @@ func TestStateDBTxGet(t *testing.T) {
 	db := newStateDbForTest(types.GetFork("ForkExecRollback"))
 	testTxGet(t, db)
 }
+
+func TestStateDBGas(t *testing.T) {
+	db := newStateDbForTest(0)
+	stateDb := db.(*StateDB)
+	gas := types.NewGasMeter(types.GasStateSet*2 + types.GasStateGet)
+	stateDb.SetGasMeter(gas)
+	assert.Nil(t, db.Set([]byte("k"), []byte("v")))
+	assert.Equal(t, int64(types.GasStateSet+2*types.GasPerByte), gas.Used())
+	v, err := db.Get([]byte("k"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v"), v)
+	assert.Equal(t, int64(types.GasStateSet+types.GasStateGet+3*types.GasPerByte), gas.Used())
+
+	//gas用完以后读写都返回错误
+	assert.Equal(t, types.ErrOutOfGas, db.Set([]byte("k"), []byte("v1")))
+	assert.True(t, gas.IsOutOfGas())
+	_, err = db.Get([]byte("k"))
+	assert.Equal(t, types.ErrOutOfGas, err)
+
+	//不设置计量的时候不限制
+	stateDb.SetGasMeter(nil)
+	assert.Nil(t, db.Set([]byte("k"), []byte("v1")))
+	v, err = db.Get([]byte("k"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v1"), v)
+}
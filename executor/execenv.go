@@ -33,6 +33,7 @@ type executor struct {
 	execapi    api.ExecutorAPI
 	receipts   []*types.ReceiptData
 	execCache  map[string]drivers.Driver
+	gasMeter   *types.GasMeter
 }
 
 type executorCtx struct {
@@ -62,6 +63,7 @@ func newExecutor(ctx *executorCtx, exec *Executor, localdb dbm.KVDB, txs []*type
 		api:          exec.qclient,
 		gcli:         exec.grpccli,
 		execCache:    make(map[string]drivers.Driver),
+		gasMeter:     types.NewGasMeter(0),
 	}
 	e.coinsAccount.SetDB(e.stateDB)
 	return e
@@ -98,8 +100,9 @@ func DelMVCC(db dbm.KVDB, detail *types.BlockDetail) (kvlist []*types.KeyValue)
 //隐私交易费扣除规则：
 //1.公对私交易：直接从coin合约中扣除
 //2.私对私交易或者私对公交易：交易费的扣除从隐私合约账户在coin合约中的账户中扣除
+//代付手续费的交易从代付账户扣除
 func (e *executor) processFee(tx *types.Transaction) (*types.Receipt, error) {
-	from := tx.From()
+	from := tx.FeeAddr()
 	accFrom := e.coinsAccount.LoadAccount(from)
 	if accFrom.GetBalance()-tx.Fee >= 0 {
 		copyfrom := *accFrom
@@ -138,9 +141,22 @@ func (e *executor) checkTx(tx *types.Transaction, index int) error {
 		//如果已经过期
 		return types.ErrTxExpire
 	}
+	//分叉之前不支持代付手续费
+	if tx.FeePayer != nil && !types.IsFork(e.height, "ForkFeeDelegation") {
+		return types.ErrFeePayerNotAllow
+	}
+	//分叉之前不支持签名中的多重签名脚本
+	if (tx.GetSignature().GetTy() == types.MultiSigSign || tx.GetFeePayer().GetTy() == types.MultiSigSign) &&
+		!types.IsFork(e.height, "ForkMultiSigScript") {
+		return types.ErrMultiSigScript
+	}
 	if err := tx.Check(e.height, types.GInt("MinFee"), types.GInt("MaxFee")); err != nil {
 		return err
 	}
+	//执行器注册的节点级别的检查，比如证书验证
+	if err := drivers.CheckTxByRegistered(e.stateDB, tx, e.height); err != nil {
+		return err
+	}
 	//允许重写的情况
 	//看重写的名字 name, 是否被允许执行
 	if !types.IsAllowExecName(e.getRealExecName(tx, index), tx.Execer) {
@@ -159,6 +175,7 @@ func (e *executor) setEnv(exec drivers.Driver) {
 	e.execapi = exec.GetExecutorAPI()
 	exec.SetTxs(e.txs)
 	exec.SetReceipt(e.receipts)
+	exec.SetGasMeter(e.gasMeter)
 }
 
 func (e *executor) checkTxGroup(txgroup *types.Transactions, index int) error {
@@ -169,6 +186,11 @@ func (e *executor) checkTxGroup(txgroup *types.Transactions, index int) error {
 	if err := txgroup.Check(e.height, types.GInt("MinFee"), types.GInt("MaxFee")); err != nil {
 		return err
 	}
+	for _, tx := range txgroup.Txs {
+		if err := drivers.CheckTxByRegistered(e.stateDB, tx, e.height); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -186,7 +208,7 @@ func (e *executor) execCheckTx(tx *types.Transaction, index int) error {
 	exec := e.loadDriver(tx, index)
 	//手续费检查
 	if !exec.IsFree() && types.GInt("MinFee") > 0 {
-		from := tx.From()
+		from := tx.FeeAddr()
 		accFrom := e.coinsAccount.LoadAccount(from)
 
 		//余额少于手续费时直接返回错误
@@ -201,6 +223,9 @@ func (e *executor) execCheckTx(tx *types.Transaction, index int) error {
 		}
 
 	}
+	if e.isMultiAction(tx) {
+		return e.checkMultiAction(tx, index, true)
+	}
 	return exec.CheckTx(tx, index)
 }
 
@@ -227,7 +252,14 @@ func (e *executor) Exec(tx *types.Transaction, index int) (*types.Receipt, error
 	if err := exec.CheckTx(tx, index); err != nil {
 		return nil, err
 	}
+	//只计量合约执行过程中的状态读写，手续费和收据的写入不计量
+	e.stateDB.(*StateDB).SetGasMeter(e.gasMeter)
 	r, err := exec.Exec(tx, index)
+	e.stateDB.(*StateDB).SetGasMeter(nil)
+	//gas用完以后不管合约是否处理了读写的错误，交易都执行失败
+	if e.gasMeter.IsOutOfGas() {
+		return nil, types.ErrOutOfGas
+	}
 	return r, err
 }
 
@@ -263,6 +295,8 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 	if err != nil {
 		return nil, err
 	}
+	//交易组的手续费由第一笔交易支付，组内的交易共用一个gas计量
+	e.resetGas(txs[0], index)
 	//开启内存事务处理，假设系统只有一个thread 执行
 	//如果系统执行失败，回滚到这个状态
 	rollbackLog := copyReceipt(feelog)
@@ -331,7 +365,7 @@ func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, er
 	ex := e.loadDriver(tx, index)
 	//执行器名称 和  pubkey 相同，费用从内置的执行器中扣除,但是checkTx 中要过
 	//默认checkTx 中对这样的交易会返回
-	if bytes.Equal(address.ExecPubkey(execer), tx.GetSignature().GetPubkey()) {
+	if bytes.Equal(address.ExecPubkey(execer), tx.PubKey()) {
 		err := ex.CheckTx(tx, index)
 		if err != nil {
 			return nil, err
@@ -348,6 +382,15 @@ func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, er
 	return feelog, nil
 }
 
+//resetGas 按交易支付的手续费计算gas上限，没有收取手续费的交易不限制
+func (e *executor) resetGas(tx *types.Transaction, index int) {
+	limit := int64(0)
+	if !types.IsPara() && types.GInt("MinFee") > 0 && !e.loadDriver(tx, index).IsFree() {
+		limit = types.TxGasLimit(tx, e.height)
+	}
+	e.gasMeter.Reset(limit)
+}
+
 func copyReceipt(feelog *types.Receipt) *types.Receipt {
 	receipt := types.Receipt{}
 	receipt = *feelog
@@ -505,6 +548,9 @@ func (e *executor) execTx(exec *Executor, tx *types.Transaction, index int) (*ty
 	if err != nil {
 		return nil, err
 	}
+	if e.isMultiAction(tx) {
+		return e.execMultiAction(tx, index)
+	}
 	//处理交易手续费(先把手续费收了)
 	//如果收了手续费，表示receipt 至少是pack 级别
 	//收不了手续费的交易才是 error 级别
@@ -512,6 +558,7 @@ func (e *executor) execTx(exec *Executor, tx *types.Transaction, index int) (*ty
 	if err != nil {
 		return nil, err
 	}
+	e.resetGas(tx, index)
 	//ignore err
 	e.begin()
 	feelog, err = e.execTxOne(feelog, tx, index)
@@ -539,6 +586,158 @@ func (e *executor) execTx(exec *Executor, tx *types.Transaction, index int) (*ty
 
 2. friend 合约行为, 合约可以定义其他合约 可以修改的 key的内容
 */
+//isMultiAction 分叉之后多操作交易由执行器拆成每个操作执行
+func (e *executor) isMultiAction(tx *types.Transaction) bool {
+	return tx.GroupCount == 0 && tx.IsMultiAction() && types.IsFork(e.height, "ForkMultiAction")
+}
+
+//checkMultiAction 检查多操作交易中的每个操作，mempool 中还要做执行器的 CheckTx
+func (e *executor) checkMultiAction(tx *types.Transaction, index int, checkExec bool) error {
+	txs, err := tx.GetMultiActionTxs()
+	if err != nil {
+		return err
+	}
+	for _, sub := range txs {
+		if !types.IsAllowExecName(e.getRealExecName(sub, index), sub.Execer) {
+			return types.ErrExecNameNotAllow
+		}
+		if err := address.CheckAddress(sub.To); err != nil {
+			return err
+		}
+		if !checkExec {
+			continue
+		}
+		if err := e.loadDriver(sub, index).CheckTx(sub, index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//execMultiAction 按顺序执行多操作交易中的每个操作，手续费由这笔交易支付，所有的操作共用一个gas计量。
+//任何一个操作失败，回滚所有操作的修改，收据中只保留手续费和失败的操作的日志
+func (e *executor) execMultiAction(tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := e.checkMultiAction(tx, index, false); err != nil {
+		return nil, err
+	}
+	txs, err := tx.GetMultiActionTxs()
+	if err != nil {
+		return nil, err
+	}
+	feelog, err := e.execFee(tx, index)
+	if err != nil {
+		return nil, err
+	}
+	e.resetGas(tx, index)
+	rollbackLog := copyReceipt(feelog)
+	e.begin()
+	for i, sub := range txs {
+		//每个操作的日志之前加一个标记，ExecLocal 的时候按标记把日志拆给每个操作
+		mark := &types.ReceiptLog{Ty: types.TyLogMultiAction, Log: types.Encode(&types.ReceiptMultiAction{Index: int32(i), Execer: sub.Execer})}
+		feelog.Logs = append(feelog.Logs, mark)
+		feelog, err = e.execTxOne(feelog, sub, index)
+		if err != nil {
+			e.rollback()
+			elog.Debug("exec multi action", "index", index, "action", i, "execer", string(sub.Execer), "err", err)
+			if api.IsAPIEnvError(err) {
+				return nil, err
+			}
+			rollbackLog.Logs = append(rollbackLog.Logs, mark, feelog.Logs[len(feelog.Logs)-1])
+			return rollbackLog, nil
+		}
+	}
+	if err := e.commit(); err != nil {
+		return nil, err
+	}
+	return feelog, nil
+}
+
+//splitMultiActionReceipt 按日志中的标记把多操作交易的收据拆给每个操作，手续费的日志不属于任何操作
+func splitMultiActionReceipt(txs []*types.Transaction, r *types.ReceiptData) []*types.ReceiptData {
+	receipts := make([]*types.ReceiptData, len(txs))
+	for i := range receipts {
+		receipts[i] = &types.ReceiptData{Ty: r.GetTy()}
+	}
+	current := -1
+	for _, l := range r.GetLogs() {
+		if l.Ty == types.TyLogMultiAction && current+1 < len(txs) {
+			var mark types.ReceiptMultiAction
+			if types.Decode(l.Log, &mark) == nil && int(mark.Index) == current+1 &&
+				bytes.Equal(mark.Execer, txs[current+1].Execer) {
+				current++
+				continue
+			}
+		}
+		if current >= 0 {
+			receipts[current].Logs = append(receipts[current].Logs, l)
+		}
+	}
+	return receipts
+}
+
+//execLocalMultiAction 多操作交易的每个操作分别执行 ExecLocal，执行失败的交易所有的操作都已经回滚，不需要执行
+func (e *executor) execLocalMultiAction(tx *types.Transaction, r *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if r.GetTy() != types.ExecOk {
+		return nil, nil
+	}
+	txs, err := tx.GetMultiActionTxs()
+	if err != nil {
+		return nil, err
+	}
+	receipts := splitMultiActionReceipt(txs, r)
+	var set types.LocalDBSet
+	for i, sub := range txs {
+		e.localDB.(*LocalDB).StartTx()
+		kv, err := e.execLocalTx(sub, receipts[i], index)
+		if err != nil {
+			return nil, err
+		}
+		if kv != nil && kv.KV != nil {
+			set.KV = append(set.KV, kv.KV...)
+		}
+	}
+	return &set, nil
+}
+
+//execDelLocalTx 回滚区块的时候撤销交易的 ExecLocal，多操作交易按相反的顺序撤销每个操作
+func (e *executor) execDelLocalTx(tx *types.Transaction, r *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if !e.isMultiAction(tx) {
+		kv, err := e.execDelLocal(tx, r, index)
+		if err == types.ErrActionNotSupport {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if kv != nil && kv.KV != nil {
+			err := e.checkPrefix(tx.Execer, kv.KV)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return kv, nil
+	}
+	if r.GetTy() != types.ExecOk {
+		return nil, nil
+	}
+	txs, err := tx.GetMultiActionTxs()
+	if err != nil {
+		return nil, err
+	}
+	receipts := splitMultiActionReceipt(txs, r)
+	var set types.LocalDBSet
+	for i := len(txs) - 1; i >= 0; i-- {
+		kv, err := e.execDelLocalTx(txs[i], receipts[i], index)
+		if err != nil {
+			return nil, err
+		}
+		if kv != nil && kv.KV != nil {
+			set.KV = append(set.KV, kv.KV...)
+		}
+	}
+	return &set, nil
+}
+
 func (e *executor) isAllowExec(key []byte, tx *types.Transaction, index int) bool {
 	realExecer := e.getRealExecName(tx, index)
 	return isAllowKeyWrite(e, key, realExecer, tx, index)
@@ -575,6 +774,9 @@ func (e *executor) execLocalSameTime(tx *types.Transaction, receipt *types.Recei
 }
 
 func (e *executor) execLocalTx(tx *types.Transaction, r *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if e.isMultiAction(tx) {
+		return e.execLocalMultiAction(tx, r, index)
+	}
 	kv, err := e.execLocal(tx, r, index)
 	if err == types.ErrActionNotSupport {
 		return nil, nil
@@ -435,20 +435,12 @@ func (exec *Executor) procExecDelBlock(msg *queue.Message) {
 	}
 	for i := len(b.Txs) - 1; i >= 0; i-- {
 		tx := b.Txs[i]
-		kv, err := execute.execDelLocal(tx, datas.Receipts[i], i)
-		if err == types.ErrActionNotSupport {
-			continue
-		}
+		kv, err := execute.execDelLocalTx(tx, datas.Receipts[i], i)
 		if err != nil {
 			msg.Reply(exec.client.NewMessage("", types.EventDelBlock, err))
 			return
 		}
 		if kv != nil && kv.KV != nil {
-			err := execute.checkPrefix(tx.Execer, kv.KV)
-			if err != nil {
-				msg.Reply(exec.client.NewMessage("", types.EventDelBlock, err))
-				return
-			}
 			kvset.KV = append(kvset.KV, kv.KV...)
 		}
 	}
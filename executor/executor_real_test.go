@@ -450,3 +450,39 @@ func TestExecLocalSameTimeSetErrKey(t *testing.T) {
 		}
 	}
 }
+
+func TestFeePayer(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	sender, senderPriv := util.Genaddress()
+	payer, payerPriv := util.Genaddress()
+	to, _ := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(genesis, sender, 2*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(genesis, payer, types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	//发送者没有多余的coins支付手续费，由代付账户支付
+	tx := util.CreateCoinsTx(senderPriv, to, 2*types.Coin)
+	tx.SetFeePayer(payerPriv.PubKey().Bytes())
+	tx.Sign(types.SECP256K1, senderPriv)
+	tx.SignFeePayer(types.SECP256K1, payerPriv)
+	hash := mock33.SendTx(tx)
+	detail, err := mock33.WaitTx(hash)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	stateHash := mock33.GetLastBlock().StateHash
+	assert.Equal(t, int64(0), mock33.GetAccount(stateHash, sender).Balance)
+	assert.Equal(t, 2*types.Coin, mock33.GetAccount(stateHash, to).Balance)
+	assert.Equal(t, types.Coin-tx.Fee, mock33.GetAccount(stateHash, payer).Balance)
+
+	//代付账户余额不足
+	tx = util.CreateCoinsTx(senderPriv, to, 1)
+	tx.SetFeePayer(senderPriv.PubKey().Bytes())
+	tx.Sign(types.SECP256K1, senderPriv)
+	tx.SignFeePayer(types.SECP256K1, senderPriv)
+	_, err = mock33.GetAPI().SendTx(tx)
+	assert.Equal(t, types.ErrNoBalance, err)
+}
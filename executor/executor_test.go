@@ -199,6 +199,11 @@ func TestExecutorErrAPIEnv(t *testing.T) {
 	types.SetMinFee(0)
 	defer types.SetMinFee(minfee)
 	q := queue.New("channel")
+	//交易执行之前的检查需要读取状态
+	cfg, sub := types.InitCfg("../cmd/chain33/chain33.test.toml")
+	store := store.New(cfg.Store, sub.Store)
+	store.SetQueueClient(q.Client())
+	defer store.Close()
 	exec := &Executor{client: q.Client(), disableLocal: true}
 	execInit(nil)
 	var txs []*types.Transaction
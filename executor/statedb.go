@@ -24,6 +24,7 @@ type StateDB struct {
 	height    int64
 	local     *db.SimpleMVCC
 	opt       *StateDBOption
+	gas       *types.GasMeter
 }
 
 // StateDBOption state db option enable mvcc
@@ -100,10 +101,21 @@ func (s *StateDB) resetTx() {
 	s.keys = nil
 }
 
+// SetGasMeter 设置交易执行的gas计量，nil 的时候不计量
+func (s *StateDB) SetGasMeter(gas *types.GasMeter) {
+	s.gas = gas
+}
+
 // Get get value from state db
 func (s *StateDB) Get(key []byte) ([]byte, error) {
+	if err := s.gas.Consume(types.GasStateGet); err != nil {
+		return nil, err
+	}
 	v, err := s.get(key)
 	debugAccount("==get==", key, v)
+	if err := s.gas.Consume(int64(len(v)) * types.GasPerByte); err != nil {
+		return nil, err
+	}
 	return v, err
 }
 
@@ -176,6 +188,9 @@ func (s *StateDB) GetSetKeys() (keys []string) {
 // Set set key value to state db
 func (s *StateDB) Set(key []byte, value []byte) error {
 	debugAccount("==set==", key, value)
+	if err := s.gas.Consume(types.GasStateSet + int64(len(key)+len(value))*types.GasPerByte); err != nil {
+		return err
+	}
 	skey := string(key)
 	if s.intx {
 		if s.txcache == nil {
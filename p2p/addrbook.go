@@ -184,6 +184,11 @@ type addrBookJSON struct {
 }
 
 func (a *AddrBook) saveToDb() {
+	a.save(false)
+}
+
+//save allowEmpty为false的时候地址簿为空不保存，手动删除所有地址的时候需要保存空的地址簿
+func (a *AddrBook) save(allowEmpty bool) {
 	a.mtx.Lock()
 	defer a.mtx.Unlock()
 	addrs := []*KnownAddress{}
@@ -200,7 +205,7 @@ func (a *AddrBook) saveToDb() {
 		}
 
 	}
-	if len(addrs) == 0 {
+	if len(addrs) == 0 && !allowEmpty {
 		return
 	}
 	aJSON := &addrBookJSON{
@@ -339,6 +344,27 @@ func (a *AddrBook) RemoveAddr(peeraddr string) {
 	}
 }
 
+// GetKnownAddrs return copies of all addresses with the connection stats
+func (a *AddrBook) GetKnownAddrs() []*KnownAddress {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	addrs := make([]*KnownAddress, 0, len(a.addrPeer))
+	for _, ka := range a.addrPeer {
+		addrs = append(addrs, ka.Copy())
+	}
+	return addrs
+}
+
+// RemoveAddrs remove addresses and save the book at once
+func (a *AddrBook) RemoveAddrs(peeraddrs []string) {
+	a.mtx.Lock()
+	for _, addr := range peeraddrs {
+		delete(a.addrPeer, addr)
+	}
+	a.mtx.Unlock()
+	a.save(true)
+}
+
 // GetPeers return peerlist
 func (a *AddrBook) GetPeers() []*NetAddress {
 	a.mtx.Lock()
@@ -5,6 +5,7 @@
 package p2p
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/rand"
 
@@ -14,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/pubsub"
 	"github.com/33cn/chain33/p2p/nat"
 	"github.com/33cn/chain33/queue"
@@ -494,3 +496,25 @@ func (n *Node) deleteNatMapPort() {
 func (n *Node) natNotice() {
 	<-n.nodeInfo.natNoticeChain
 }
+
+func consensusHash(consensus *types.P2PConsensus) string {
+	return hex.EncodeToString(common.Sha256(types.Encode(consensus)))
+}
+
+//recvConsensus 收到的共识消息发送给本地的共识模块，并且继续转发给其他节点
+func (n *Node) recvConsensus(consensus *types.P2PConsensus) {
+	msghash := consensusHash(consensus)
+	Filter.GetLock()
+	if Filter.QueryRecvData(msghash) {
+		Filter.ReleaseLock()
+		return
+	}
+	Filter.RegRecvData(msghash)
+	Filter.ReleaseLock()
+	msg := n.nodeInfo.client.NewMessage("consensus", types.EventConsensusMsg, consensus)
+	err := n.nodeInfo.client.Send(msg, false)
+	if err != nil {
+		log.Error("recvConsensus", "to consensus EventConsensusMsg msg err", err)
+	}
+	n.pubsub.FIFOPub(consensus, "consensus")
+}
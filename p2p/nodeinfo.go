@@ -243,10 +243,14 @@ func (nf *NodeInfo) OutSide() bool {
 	return atomic.LoadInt32(&nf.outSide) == 1
 }
 
-// Add add badpeer
+// Add add badpeer, deadline为0表示永久加入黑名单
 func (bl *BlackList) Add(addr string, deadline int64) {
 	bl.mtx.Lock()
 	defer bl.mtx.Unlock()
+	if deadline == 0 {
+		bl.badPeers[addr] = 0
+		return
+	}
 	bl.badPeers[addr] = types.Now().Unix() + deadline
 
 }
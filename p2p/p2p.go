@@ -230,6 +230,28 @@ func (network *P2p) subP2pMsg() {
 				go network.p2pCli.GetHeaders(msg, taskIndex)
 			case types.EventGetNetInfo:
 				go network.p2pCli.GetNetInfo(msg, taskIndex)
+			case types.EventConsensusBroadcast: //广播共识消息
+				go network.p2pCli.ConsensusBroadcast(msg, taskIndex)
+			case types.EventNetPeers:
+				go network.p2pCli.GetNetPeers(msg, taskIndex)
+			case types.EventNetAddPeer:
+				go network.p2pCli.AddPeer(msg, taskIndex)
+			case types.EventNetRemovePeer:
+				go network.p2pCli.RemovePeer(msg, taskIndex)
+			case types.EventNetBanPeer:
+				go network.p2pCli.BanPeer(msg, taskIndex)
+			case types.EventNetUnbanPeer:
+				go network.p2pCli.UnbanPeer(msg, taskIndex)
+			case types.EventNetBannedPeers:
+				go network.p2pCli.GetBannedPeers(msg, taskIndex)
+			case types.EventNetSelfInfo:
+				go network.p2pCli.GetSelfInfo(msg, taskIndex)
+			case types.EventNetAddrBook:
+				go network.p2pCli.GetAddrBook(msg, taskIndex)
+			case types.EventNetAddrBookRemove:
+				go network.p2pCli.RemoveAddrBook(msg, taskIndex)
+			case types.EventNetAddrBookSeed:
+				go network.p2pCli.SeedAddrBook(msg, taskIndex)
 			default:
 				log.Warn("unknown msgtype", "msg", msg)
 				msg.Reply(network.client.NewMessage("", msg.Ty, types.Reply{Msg: []byte("unknown msgtype")}))
@@ -125,6 +125,8 @@ func TestP2PEvent(t *testing.T) {
 	msg = qcli.NewMessage("p2p", types.EventFetchBlockHeaders, &types.ReqBlocks{})
 	qcli.Send(msg, false)
 
+	msg = qcli.NewMessage("p2p", types.EventConsensusBroadcast, &types.P2PConsensus{Driver: "pbft", Data: []byte("msg")})
+	qcli.Send(msg, false)
 }
 func TestNetInfo(t *testing.T) {
 	p2pModule.node.nodeInfo.IsNatDone()
@@ -134,6 +136,38 @@ func TestNetInfo(t *testing.T) {
 }
 
 //测试Peer
+func TestAddrBookEvents(t *testing.T) {
+	qcli := q.Client()
+	send := func(ty int64, data interface{}) *queue.Message {
+		msg := qcli.NewMessage("p2p", ty, data)
+		assert.Nil(t, qcli.Send(msg, true))
+		resp, err := qcli.Wait(msg)
+		assert.Nil(t, err)
+		return resp
+	}
+
+	resp := send(types.EventNetAddrBookSeed, &types.ReqAddrBookSeed{Addrs: []string{"192.168.100.1:13802", "invalid", "192.168.100.1:13802"}})
+	edit := resp.GetData().(*types.ReplyAddrBookEdit)
+	assert.Equal(t, []string{"192.168.100.1:13802"}, edit.Done)
+	assert.Equal(t, []string{"invalid", "192.168.100.1:13802"}, edit.Skipped)
+
+	resp = send(types.EventNetAddrBook, &types.ReqNil{})
+	var found bool
+	for _, entry := range resp.GetData().(*types.AddrBookEntries).Entries {
+		if entry.Addr == "192.168.100.1:13802" {
+			found = true
+			assert.False(t, entry.Connected)
+		}
+	}
+	assert.True(t, found)
+
+	resp = send(types.EventNetAddrBookRemove, &types.ReqAddrBookRemove{Addrs: []string{"192.168.100.1:13802", "192.168.100.2:13802"}})
+	edit = resp.GetData().(*types.ReplyAddrBookEdit)
+	assert.Equal(t, []string{"192.168.100.1:13802"}, edit.Done)
+	assert.Equal(t, []string{"192.168.100.2:13802"}, edit.Skipped)
+	assert.Nil(t, p2pModule.node.nodeInfo.addrBook.GetPeerStat("192.168.100.1:13802"))
+}
+
 func TestPeer(t *testing.T) {
 
 	conn, err := grpc.Dial("localhost:33802", grpc.WithInsecure(),
@@ -11,10 +11,12 @@ import (
 	"net"
 
 	"math/rand"
+	"sort"
 
 	"sync/atomic"
 	"time"
 
+	"github.com/33cn/chain33/common/version"
 	"github.com/33cn/chain33/queue"
 	pb "github.com/33cn/chain33/types"
 	"golang.org/x/net/context"
@@ -30,6 +32,17 @@ type EventInterface interface {
 	GetBlocks(msg *queue.Message, taskindex int64)
 	BlockBroadcast(msg *queue.Message, taskindex int64)
 	GetNetInfo(msg *queue.Message, taskindex int64)
+	ConsensusBroadcast(msg *queue.Message, taskindex int64)
+	GetNetPeers(msg *queue.Message, taskindex int64)
+	AddPeer(msg *queue.Message, taskindex int64)
+	RemovePeer(msg *queue.Message, taskindex int64)
+	BanPeer(msg *queue.Message, taskindex int64)
+	UnbanPeer(msg *queue.Message, taskindex int64)
+	GetBannedPeers(msg *queue.Message, taskindex int64)
+	GetSelfInfo(msg *queue.Message, taskindex int64)
+	GetAddrBook(msg *queue.Message, taskindex int64)
+	RemoveAddrBook(msg *queue.Message, taskindex int64)
+	SeedAddrBook(msg *queue.Message, taskindex int64)
 }
 
 // NormalInterface subscribe to the event hander interface
@@ -294,11 +307,13 @@ func (m *Cli) SendPing(peer *Peer, nodeinfo *NodeInfo) error {
 		return err
 	}
 
+	start := time.Now()
 	r, err := peer.mconn.gcli.Ping(context.Background(), ping, grpc.FailFast(true))
 	P2pComm.CollectPeerStat(err, peer)
 	if err != nil {
 		return err
 	}
+	atomic.StoreInt64(&peer.latency, int64(time.Since(start)/time.Millisecond))
 
 	log.Debug("SendPing", "Peer", peer.Addr(), "nonce", randNonce, "recv", r.Nonce)
 	return nil
@@ -530,6 +545,15 @@ func (m *Cli) BlockBroadcast(msg *queue.Message, taskindex int64) {
 	m.network.node.pubsub.FIFOPub(&pb.P2PBlock{Block: msg.GetData().(*pb.Block)}, "block")
 }
 
+// ConsensusBroadcast consensus message broadcast
+func (m *Cli) ConsensusBroadcast(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("ConsensusBroadcast", "task complete:", taskindex)
+	}()
+	m.network.node.pubsub.FIFOPub(msg.GetData().(*pb.P2PConsensus), "consensus")
+}
+
 // GetNetInfo get network information
 func (m *Cli) GetNetInfo(msg *queue.Message, taskindex int64) {
 	defer func() {
@@ -547,6 +571,293 @@ func (m *Cli) GetNetInfo(msg *queue.Message, taskindex int64) {
 
 }
 
+// GetNetPeers 返回连接的节点，包括主动连接的节点和连接本节点的节点
+func (m *Cli) GetNetPeers(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetNetPeers", "task complete:", taskindex)
+	}()
+
+	node := m.network.node
+	infos := node.nodeInfo.peerInfos.GetPeerInfos()
+	var peers pb.NetPeers
+	for _, peer := range node.GetRegisterPeers() {
+		netpeer := &pb.NetPeer{
+			Addr:       peer.Addr(),
+			Name:       peer.GetPeerName(),
+			Version:    peer.version.GetVersion(),
+			Latency:    peer.GetLatency(),
+			Persistent: peer.IsPersistent(),
+		}
+		if info, ok := infos[peer.Addr()]; ok {
+			netpeer.Height = info.GetHeader().GetHeight()
+			netpeer.MempoolSize = info.GetMempoolSize()
+		}
+		peers.Peers = append(peers.Peers, netpeer)
+	}
+	for _, inpeer := range node.listener.(interface{}).(*listener).p2pserver.getInBoundPeers() {
+		peers.Peers = append(peers.Peers, &pb.NetPeer{
+			Addr:        inpeer.addr,
+			Name:        inpeer.name,
+			Inbound:     true,
+			Version:     inpeer.p2pversion,
+			Softversion: inpeer.softversion,
+		})
+	}
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReplyNetPeers, &peers))
+}
+
+// AddPeer 连接指定的节点，如果在黑名单中先从黑名单中删除
+func (m *Cli) AddPeer(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("AddPeer", "task complete:", taskindex)
+	}()
+
+	req := msg.GetData().(*pb.ReqNetPeer)
+	netaddr, err := NewNetAddressString(req.GetAddr())
+	if err != nil {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, pb.ErrInvalidAddress))
+		return
+	}
+	node := m.network.node
+	if node.nodeInfo.addrBook.ISOurAddress(netaddr) {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, &pb.Reply{Msg: []byte("can not connect to self")}))
+		return
+	}
+	if node.Has(netaddr.String()) {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, &pb.Reply{IsOk: true, Msg: []byte("already connected")}))
+		return
+	}
+	node.nodeInfo.blacklist.Delete(netaddr.String())
+	node.nodeInfo.blacklist.Delete(netaddr.IP.String())
+	node.nodeInfo.addrBook.AddAddress(netaddr, nil)
+	node.pubsub.FIFOPub(netaddr.String(), "addr")
+	log.Info("AddPeer", "addr", netaddr.String())
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, &pb.Reply{IsOk: true, Msg: []byte("connecting")}))
+}
+
+// RemovePeer 断开主动连接的节点，并从地址簿中删除
+func (m *Cli) RemovePeer(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("RemovePeer", "task complete:", taskindex)
+	}()
+
+	req := msg.GetData().(*pb.ReqNetPeer)
+	node := m.network.node
+	if !node.Has(req.GetAddr()) {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, pb.ErrNotFound))
+		return
+	}
+	node.nodeInfo.addrBook.RemoveAddr(req.GetAddr())
+	node.remove(req.GetAddr())
+	log.Info("RemovePeer", "addr", req.GetAddr())
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, &pb.Reply{IsOk: true}))
+}
+
+// BanPeer 把节点加入黑名单并断开连接，addr是ip:port的时候ip也加入黑名单，拒绝这个ip的连接
+func (m *Cli) BanPeer(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("BanPeer", "task complete:", taskindex)
+	}()
+
+	req := msg.GetData().(*pb.ReqNetPeer)
+	addrs, err := banAddrs(req.GetAddr())
+	if err != nil || req.GetBanTime() < 0 {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, pb.ErrInvalidParam))
+		return
+	}
+	node := m.network.node
+	for _, addr := range addrs {
+		node.nodeInfo.blacklist.Add(addr, req.GetBanTime())
+	}
+	for _, peer := range node.GetRegisterPeers() {
+		if peer.Addr() == req.GetAddr() || peer.peerAddr.IP.String() == req.GetAddr() {
+			node.nodeInfo.addrBook.RemoveAddr(peer.Addr())
+			node.remove(peer.Addr())
+		}
+	}
+	log.Info("BanPeer", "addr", req.GetAddr(), "banTime", req.GetBanTime())
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, &pb.Reply{IsOk: true}))
+}
+
+// UnbanPeer 从黑名单中删除节点
+func (m *Cli) UnbanPeer(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("UnbanPeer", "task complete:", taskindex)
+	}()
+
+	req := msg.GetData().(*pb.ReqNetPeer)
+	addrs, err := banAddrs(req.GetAddr())
+	if err != nil {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, pb.ErrInvalidParam))
+		return
+	}
+	for _, addr := range addrs {
+		m.network.node.nodeInfo.blacklist.Delete(addr)
+	}
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReply, &pb.Reply{IsOk: true}))
+}
+
+//banAddrs 黑名单中出站的节点用ip:port，入站的连接用ip检查
+func banAddrs(addr string) ([]string, error) {
+	if net.ParseIP(addr) != nil {
+		return []string{addr}, nil
+	}
+	netaddr, err := NewNetAddressString(addr)
+	if err != nil {
+		return nil, err
+	}
+	return []string{netaddr.String(), netaddr.IP.String()}, nil
+}
+
+// GetBannedPeers 返回黑名单，deadline为0表示永久
+func (m *Cli) GetBannedPeers(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetBannedPeers", "task complete:", taskindex)
+	}()
+
+	var banned pb.NetBannedPeers
+	for addr, deadline := range m.network.node.nodeInfo.blacklist.GetBadPeers() {
+		banned.Peers = append(banned.Peers, &pb.NetBannedPeer{Addr: addr, Deadline: deadline})
+	}
+	sort.Slice(banned.Peers, func(i, j int) bool { return banned.Peers[i].Addr < banned.Peers[j].Addr })
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReplyNetBannedPeers, &banned))
+}
+
+// GetSelfInfo 返回本节点的节点ID、监听地址和外网地址
+func (m *Cli) GetSelfInfo(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetSelfInfo", "task complete:", taskindex)
+	}()
+
+	node := m.network.node
+	_, pubkey := node.nodeInfo.addrBook.GetPrivPubKey()
+	info := &pb.NetSelfInfo{
+		NodeID:       pubkey,
+		Localaddr:    node.nodeInfo.GetListenAddr().String(),
+		Externaladdr: node.nodeInfo.GetExternalAddr().String(),
+		Service:      node.nodeInfo.IsOutService(),
+		Version:      node.nodeInfo.cfg.Version,
+		Softversion:  version.GetVersion(),
+		Seeds:        node.nodeInfo.cfg.Seeds,
+	}
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReplyNetSelfInfo, info))
+}
+
+// GetAddrBook 返回地址簿中的地址和连接的统计
+func (m *Cli) GetAddrBook(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetAddrBook", "task complete:", taskindex)
+	}()
+
+	node := m.network.node
+	var book pb.AddrBookEntries
+	for _, ka := range node.nodeInfo.addrBook.GetKnownAddrs() {
+		addr := ka.Addr.String()
+		entry := &pb.AddrBookEntry{
+			Addr:      addr,
+			Attempts:  int64(ka.Attempts),
+			Connected: node.Has(addr),
+			Banned:    node.nodeInfo.blacklist.Has(addr),
+		}
+		if !ka.LastAttempt.IsZero() {
+			entry.LastAttempt = ka.LastAttempt.Unix()
+		}
+		if !ka.LastSuccess.IsZero() {
+			entry.LastSuccess = ka.LastSuccess.Unix()
+		}
+		book.Entries = append(book.Entries, entry)
+	}
+	sort.Slice(book.Entries, func(i, j int) bool { return book.Entries[i].Addr < book.Entries[j].Addr })
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReplyNetAddrBook, &book))
+}
+
+// RemoveAddrBook 删除地址簿中指定的地址和连接失败的地址
+func (m *Cli) RemoveAddrBook(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("RemoveAddrBook", "task complete:", taskindex)
+	}()
+
+	req := msg.GetData().(*pb.ReqAddrBookRemove)
+	node := m.network.node
+	explicit := make(map[string]bool)
+	for _, addr := range req.GetAddrs() {
+		explicit[addr] = true
+	}
+	now := pb.Now()
+	var reply pb.ReplyAddrBookEdit
+	for _, ka := range node.nodeInfo.addrBook.GetKnownAddrs() {
+		addr := ka.Addr.String()
+		if explicit[addr] {
+			reply.Done = append(reply.Done, addr)
+			delete(explicit, addr)
+			continue
+		}
+		if node.Has(addr) {
+			continue
+		}
+		if req.GetMinAttempts() > 0 && int64(ka.Attempts) >= req.GetMinAttempts() {
+			reply.Done = append(reply.Done, addr)
+			continue
+		}
+		//没有成功连接过的地址从加入地址簿或者最后一次尝试的时间算起
+		last := ka.LastSuccess
+		if last.IsZero() {
+			last = ka.LastAttempt
+		}
+		if req.GetStaleTime() > 0 && now.Sub(last) > time.Duration(req.GetStaleTime())*time.Second {
+			reply.Done = append(reply.Done, addr)
+		}
+	}
+	for addr := range explicit {
+		reply.Skipped = append(reply.Skipped, addr)
+	}
+	sort.Strings(reply.Done)
+	sort.Strings(reply.Skipped)
+	if len(reply.Done) > 0 {
+		node.nodeInfo.addrBook.RemoveAddrs(reply.Done)
+	}
+	log.Info("RemoveAddrBook", "removed", len(reply.Done))
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReplyNetAddrBookEdit, &reply))
+}
+
+// SeedAddrBook 向地址簿中添加地址，无效的、自己的和已经存在的地址跳过
+func (m *Cli) SeedAddrBook(msg *queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("SeedAddrBook", "task complete:", taskindex)
+	}()
+
+	req := msg.GetData().(*pb.ReqAddrBookSeed)
+	node := m.network.node
+	var reply pb.ReplyAddrBookEdit
+	for _, addr := range req.GetAddrs() {
+		netaddr, err := NewNetAddressString(addr)
+		if err != nil || node.nodeInfo.addrBook.ISOurAddress(netaddr) || node.nodeInfo.addrBook.GetPeerStat(netaddr.String()) != nil {
+			reply.Skipped = append(reply.Skipped, addr)
+			continue
+		}
+		node.nodeInfo.addrBook.AddAddress(netaddr, nil)
+		reply.Done = append(reply.Done, netaddr.String())
+		if req.GetDial() && !node.nodeInfo.blacklist.Has(netaddr.String()) {
+			node.pubsub.FIFOPub(netaddr.String(), "addr")
+		}
+	}
+	if len(reply.Done) > 0 {
+		node.nodeInfo.addrBook.Save()
+	}
+	log.Info("SeedAddrBook", "added", len(reply.Done), "skipped", len(reply.Skipped))
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventReplyNetAddrBookEdit, &reply))
+}
+
 // CheckPeerNatOk check peer is ok or not
 func (m *Cli) CheckPeerNatOk(addr string) bool {
 	//连接自己的地址信息做测试
@@ -428,6 +428,9 @@ func (s *P2pserver) ServerStreamSend(in *pb.P2PPing, stream pb.P2Pgservice_Serve
 		} else if tx, ok := data.(*pb.P2PTx); ok {
 			log.Debug("ServerStreamSend", "txhash", hex.EncodeToString(tx.GetTx().Hash()))
 			p2pdata.Value = &pb.BroadCastData_Tx{Tx: tx}
+		} else if consensus, ok := data.(*pb.P2PConsensus); ok {
+			log.Debug("ServerStreamSend", "consensus", consensus.GetDriver())
+			p2pdata.Value = &pb.BroadCastData_Consensus{Consensus: consensus}
 		} else {
 			log.Error("RoutChate", "Convert error", data)
 			continue
@@ -526,6 +529,8 @@ func (s *P2pserver) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 			}
 			//Filter.RegRecvData(txhash)
 
+		} else if consensus := in.GetConsensus(); consensus != nil {
+			s.node.recvConsensus(consensus)
 		} else if ping := in.GetPing(); ping != nil { ///被远程节点初次连接后，会收到ping 数据包，收到后注册到inboundpeers.
 			//Ping package
 			if !P2pComm.CheckSign(ping) {
@@ -661,7 +666,7 @@ func (s *P2pserver) manageStream() {
 		}
 	}()
 	go func() {
-		fifoChan := s.node.pubsub.Sub("block", "tx")
+		fifoChan := s.node.pubsub.Sub("block", "tx", "consensus")
 		for data := range fifoChan {
 			if s.IsClose() {
 				return
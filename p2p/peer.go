@@ -28,7 +28,7 @@ func (p *Peer) Start() {
 func (p *Peer) Close() {
 	atomic.StoreInt32(&p.isclose, 1)
 	p.mconn.Close()
-	p.node.pubsub.Unsub(p.taskChan, "block", "tx")
+	p.node.pubsub.Unsub(p.taskChan, "block", "tx", "consensus")
 	log.Info("Peer", "closed", p.Addr())
 
 }
@@ -47,6 +47,7 @@ type Peer struct {
 	peerStat     *Stat
 	taskChan     chan interface{} //tx block
 	inBounds     int32            //连接此节点的客户端节点数量
+	latency      int64            //最近一次ping的往返时间，毫秒
 	IsMaxInbouds bool
 }
 
@@ -137,7 +138,7 @@ func (p *Peer) heartBeat() {
 		if err == nil {
 			log.Debug("sendVersion", "peer name", peername)
 			p.SetPeerName(peername) //设置连接的远程节点的节点名称
-			p.taskChan = p.node.pubsub.Sub("block", "tx")
+			p.taskChan = p.node.pubsub.Sub("block", "tx", "consensus")
 			go p.sendStream()
 			go p.readStream()
 			break
@@ -172,6 +173,11 @@ func (p *Peer) GetInBouns() int32 {
 	return atomic.LoadInt32(&p.inBounds)
 }
 
+// GetLatency get the round trip time of the last ping in milliseconds
+func (p *Peer) GetLatency() int64 {
+	return atomic.LoadInt64(&p.latency)
+}
+
 // GetPeerInfo get peer information of peer
 func (p *Peer) GetPeerInfo(version int32) (*pb.P2PPeerInfo, error) {
 	return p.mconn.gcli.GetPeerInfo(context.Background(), &pb.P2PGetPeerInfo{Version: version}, grpc.FailFast(true))
@@ -274,6 +280,11 @@ func (p *Peer) sendStream() {
 					log.Debug("sendStream", "will send tx", txhash)
 					p2pdata.Value = &pb.BroadCastData_Tx{Tx: tx}
 					Filter.RegRecvData(txhash)
+				} else if consensus, ok := task.(*pb.P2PConsensus); ok {
+					msghash := consensusHash(consensus)
+					log.Debug("sendStream", "will send consensus msg", msghash)
+					p2pdata.Value = &pb.BroadCastData_Consensus{Consensus: consensus}
+					Filter.RegRecvData(msghash)
 				}
 
 				err := resp.Send(p2pdata)
@@ -418,6 +429,8 @@ func (p *Peer) readStream() {
 					}
 					//Filter.RegRecvData(txhash) //登记
 				}
+			} else if consensus := data.GetConsensus(); consensus != nil {
+				p.node.recvConsensus(consensus)
 			}
 		}
 	}
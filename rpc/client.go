@@ -13,6 +13,7 @@ import (
 	"github.com/33cn/chain33/client"
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/queue"
 	ety "github.com/33cn/chain33/system/dapp/coins/types"
@@ -236,6 +237,14 @@ func (c *channelClient) GetAddrOverview(parm *types.ReqAddr) (*types.AddrOvervie
 
 // GetBalance get balance
 func (c *channelClient) GetBalance(in *types.ReqBalance) ([]*types.Account, error) {
+	//bech32格式的地址转换成base58格式查询
+	for i, addr := range in.Addresses {
+		norm, err := address.NormalizeAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		in.Addresses[i] = norm
+	}
 	// in.AssetExec & in.AssetSymbol 新增参数，
 	// 不填时兼容原来的调用
 	if in.AssetExec == "" || in.AssetSymbol == "" {
@@ -254,8 +263,11 @@ func (c *channelClient) GetBalance(in *types.ReqBalance) ([]*types.Account, erro
 
 // GetAllExecBalance get balance of exec
 func (c *channelClient) GetAllExecBalance(in *types.ReqAllExecBalance) (*types.AllExecBalance, error) {
-	addr := in.Addr
-	err := address.CheckAddress(addr)
+	addr, err := address.NormalizeAddress(in.Addr)
+	if err != nil {
+		return nil, err
+	}
+	err = address.CheckAddress(addr)
 	if err != nil {
 		if err = address.CheckMultiSignAddress(addr); err != nil {
 			return nil, types.ErrInvalidAddress
@@ -300,6 +312,40 @@ func (c *channelClient) GetTotalCoins(in *types.ReqGetTotalCoins) (*types.ReplyG
 	return resp, nil
 }
 
+// CompactDB compact a key range or prefix of a db opened in this process
+func (c *channelClient) CompactDB(in *types.ReqCompactDB) (*types.Reply, error) {
+	db, ok := dbm.GetOpened(in.GetName())
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	var err error
+	start := time.Now()
+	if len(in.Prefix) > 0 {
+		err = dbm.CompactPrefix(db, in.Prefix)
+	} else {
+		err = dbm.CompactRange(db, in.Start, in.End)
+	}
+	if err != nil {
+		log.Error("CompactDB", "name", in.Name, "err", err)
+		return nil, err
+	}
+	log.Info("CompactDB", "name", in.Name, "prefix", string(in.Prefix), "cost", time.Since(start))
+	return &types.Reply{IsOk: true}, nil
+}
+
+// GetDBUsage get disk usage and reclaimable space of every prefix of a db opened in this process
+func (c *channelClient) GetDBUsage(in *types.ReqDBUsage) (*types.DBUsage, error) {
+	db, ok := dbm.GetOpened(in.GetName())
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	usages, err := dbm.GetUsage(db, in.Prefixes)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DBUsage{Name: in.Name, Prefixes: usages}, nil
+}
+
 // DecodeRawTransaction decode rawtransaction
 func (c *channelClient) DecodeRawTransaction(param *types.ReqDecodeRawTransaction) (*types.Transaction, error) {
 	var tx types.Transaction
@@ -386,3 +386,23 @@ func (g *Grpc) GetFork(ctx context.Context, in *pb.ReqKey) (*pb.Int64, error) {
 	}
 	return &pb.Int64{Data: pb.GetFork(string(in.Key))}, nil
 }
+
+// GetStoreProof get the existence or absence proof of a key at the state hash
+func (g *Grpc) GetStoreProof(ctx context.Context, in *pb.ReqStoreProof) (*pb.StoreProof, error) {
+	return g.cli.StoreGetProof(in)
+}
+
+// GetValueHistory get the values of a state key at the heights it changed
+func (g *Grpc) GetValueHistory(ctx context.Context, in *pb.ReqValueHistory) (*pb.ValueHistory, error) {
+	return g.cli.GetValueHistory(in)
+}
+
+// CompactDB compact a key range or prefix of a db
+func (g *Grpc) CompactDB(ctx context.Context, in *pb.ReqCompactDB) (*pb.Reply, error) {
+	return g.cli.CompactDB(in)
+}
+
+// GetDBUsage get disk usage and reclaimable space of a db
+func (g *Grpc) GetDBUsage(ctx context.Context, in *pb.ReqDBUsage) (*pb.DBUsage, error) {
+	return g.cli.GetDBUsage(in)
+}
@@ -8,10 +8,12 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
+	drivers "github.com/33cn/chain33/system/consensus"
 	"github.com/33cn/chain33/types"
 	wcom "github.com/33cn/chain33/wallet/common"
 
@@ -34,6 +36,7 @@ func (c *Chain33) CreateRawTransaction(in *rpctypes.CreateTx, result *interface{
 		TokenSymbol: in.TokenSymbol,
 		ExecName:    in.ExecName,
 		Execer:      in.Execer,
+		NotePubKey:  in.NotePubKey,
 	}
 	reply, err := c.cli.CreateRawTransaction(inpb)
 	if err != nil {
@@ -90,7 +93,7 @@ func (c *Chain33) SendTransaction(in rpctypes.RawParm, result *interface{}) erro
 	if err != nil {
 		return err
 	}
-	err = types.Decode(data, &parm)
+	err = types.CheckCanonical(data, &parm)
 	if err != nil {
 		return err
 	}
@@ -169,6 +172,41 @@ func (c *Chain33) GetBlocks(in rpctypes.BlockParam, result *interface{}) error {
 
 }
 
+// ExportBlocks 导出区块，返回序列化以后的区块，不做转换，用于其他节点导入
+func (c *Chain33) ExportBlocks(in rpctypes.BlockParam, result *interface{}) error {
+	reply, err := c.cli.GetBlocks(&types.ReqBlocks{Start: in.Start, End: in.End, IsDetail: false, Pid: []string{""}})
+	if err != nil {
+		return err
+	}
+	var blocks rpctypes.ReplyRawBlocks
+	for _, item := range reply.GetItems() {
+		blocks.Blocks = append(blocks.Blocks, common.ToHex(types.Encode(item.GetBlock())))
+	}
+	*result = &blocks
+	return nil
+}
+
+// ImportBlock 导入一个序列化的区块，和从其他节点下载的区块一样执行以后加到链上
+func (c *Chain33) ImportBlock(in rpctypes.RawParm, result *interface{}) error {
+	data, err := common.FromHex(in.Data)
+	if err != nil {
+		return err
+	}
+	var block types.Block
+	if err := types.Decode(data, &block); err != nil {
+		return err
+	}
+	reply, err := c.cli.ImportBlock(&block)
+	if err != nil {
+		return err
+	}
+	var resp rpctypes.Reply
+	resp.IsOk = reply.GetIsOk()
+	resp.Msg = string(reply.GetMsg())
+	*result = &resp
+	return nil
+}
+
 // GetLastHeader get last header
 func (c *Chain33) GetLastHeader(in *types.ReqNil, result *interface{}) error {
 
@@ -616,6 +654,7 @@ func (c *Chain33) GetProperFee(in types.ReqNil, result *interface{}) error {
 	}
 	var properFee rpctypes.ReplyProperFee
 	properFee.ProperFee = reply.GetProperFee()
+	properFee.MinTxFee = reply.GetMinTxFee()
 	*result = &properFee
 	return nil
 }
@@ -794,6 +833,40 @@ func (c *Chain33) ExecWallet(in *rpctypes.ChainExecutor, result *interface{}) er
 	return nil
 }
 
+// QueryConsensus query consensus
+func (c *Chain33) QueryConsensus(in *rpctypes.ChainExecutor, result *interface{}) error {
+	param, err := drivers.QueryData.DecodeJSON(in.Driver, in.FuncName, in.Payload)
+	if err != nil {
+		return err
+	}
+	msg, err := c.cli.QueryConsensusFunc(in.Driver, in.FuncName, param)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(msg)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// GetFinalizedCheckpoint 获取finality合约最后一个最终确认的检查点，交易所可以按这个高度确认充值
+func (c *Chain33) GetFinalizedCheckpoint(in *types.ReqNil, result *interface{}) error {
+	msg, err := c.cli.Query(types.ExecName("finality"), "GetFinalized", &types.ReqNil{})
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(msg)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
 // Query query
 func (c *Chain33) Query(in rpctypes.Query4Jrpc, result *interface{}) error {
 	execty := types.LoadExecutorType(in.Execer)
@@ -833,6 +906,19 @@ func (c *Chain33) DumpPrivkey(in types.ReqString, result *interface{}) error {
 	return nil
 }
 
+// DeleteAccount delete account of the address from wallet
+func (c *Chain33) DeleteAccount(in types.ReqString, result *interface{}) error {
+	reply, err := c.cli.ExecWalletFunc("wallet", "DeleteAccount", &in)
+	if err != nil {
+		return err
+	}
+	var resp rpctypes.Reply
+	resp.IsOk = reply.(*types.Reply).GetIsOk()
+	resp.Msg = string(reply.(*types.Reply).GetMsg())
+	*result = &resp
+	return nil
+}
+
 // Version get software version
 func (c *Chain33) Version(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.Version()
@@ -910,6 +996,56 @@ func (c *Chain33) SignRawTx(in *types.ReqSignRawTx, result *interface{}) error {
 	return nil
 }
 
+// DecryptTxNote decrypt the encrypted note of a tx sent to a wallet address
+func (c *Chain33) DecryptTxNote(in rpctypes.QueryParm, result *interface{}) error {
+	hash, err := common.FromHex(in.Hash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.ExecWalletFunc("wallet", "DecryptTxNote", &types.ReqHash{Hash: hash})
+	if err != nil {
+		return err
+	}
+	*result = reply.(*types.ReplyString).Data
+	return nil
+}
+
+// SignTypedData sign EIP-712 typed structured data by wallet account
+func (c *Chain33) SignTypedData(in *types.ReqSignTypedData, result *interface{}) error {
+	reply, err := c.cli.ExecWalletFunc("wallet", "SignTypedData", in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// VerifyTypedData verify the signature of EIP-712 typed structured data
+func (c *Chain33) VerifyTypedData(in *types.ReqVerifyTypedData, result *interface{}) error {
+	td, err := types.ParseTypedData([]byte(in.TypedData))
+	if err != nil {
+		return err
+	}
+	pub, err := common.FromHex(in.PubKey)
+	if err != nil {
+		return err
+	}
+	sig, err := common.FromHex(in.Signature)
+	if err != nil {
+		return err
+	}
+	ty := in.Ty
+	if ty == 0 {
+		ty = types.SECP256K1
+	}
+	ok, err := types.VerifyTypedData(td, ty, pub, sig)
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.Reply{IsOk: ok}
+	return nil
+}
+
 // GetNetInfo get net information
 func (c *Chain33) GetNetInfo(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.GetNetInfo()
@@ -926,6 +1062,118 @@ func (c *Chain33) GetNetInfo(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+// GetNetPeers 获取连接的节点，包括方向、版本和ping的延时
+func (c *Chain33) GetNetPeers(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.NetPeers()
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(reply)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+func netPeerReply(reply *types.Reply, err error, result *interface{}) error {
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk(), Msg: string(reply.GetMsg())}
+	return nil
+}
+
+// AddPeer 连接指定的节点
+func (c *Chain33) AddPeer(in *types.ReqNetPeer, result *interface{}) error {
+	reply, err := c.cli.NetAddPeer(in)
+	return netPeerReply(reply, err, result)
+}
+
+// RemovePeer 断开指定的节点
+func (c *Chain33) RemovePeer(in *types.ReqNetPeer, result *interface{}) error {
+	reply, err := c.cli.NetRemovePeer(in)
+	return netPeerReply(reply, err, result)
+}
+
+// BanPeer 把节点加入黑名单，banTime为0表示永久
+func (c *Chain33) BanPeer(in *types.ReqNetPeer, result *interface{}) error {
+	reply, err := c.cli.NetBanPeer(in)
+	return netPeerReply(reply, err, result)
+}
+
+// UnbanPeer 把节点从黑名单中删除
+func (c *Chain33) UnbanPeer(in *types.ReqNetPeer, result *interface{}) error {
+	reply, err := c.cli.NetUnbanPeer(in)
+	return netPeerReply(reply, err, result)
+}
+
+// GetBannedPeers 获取p2p的黑名单
+func (c *Chain33) GetBannedPeers(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.NetBannedPeers()
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(reply)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// GetSelfInfo 获取本节点的节点ID和地址
+func (c *Chain33) GetSelfInfo(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.NetSelfInfo()
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(reply)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// GetAddrBook 获取p2p地址簿中的地址和连接的统计
+func (c *Chain33) GetAddrBook(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.NetAddrBook()
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(reply)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// RemoveAddrBook 删除地址簿中指定的地址或者长时间连接不上的地址
+func (c *Chain33) RemoveAddrBook(in *types.ReqAddrBookRemove, result *interface{}) error {
+	reply, err := c.cli.NetAddrBookRemove(in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// SeedAddrBook 向地址簿中添加地址
+func (c *Chain33) SeedAddrBook(in *types.ReqAddrBookSeed, result *interface{}) error {
+	reply, err := c.cli.NetAddrBookSeed(in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 // GetFatalFailure return fatal failure
 func (c *Chain33) GetFatalFailure(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.GetFatalFailure()
@@ -967,6 +1215,35 @@ func (c *Chain33) DecodeRawTransaction(in *types.ReqDecodeRawTransaction, result
 	return nil
 }
 
+// EncodeCanonicalJSONTx convert hex raw tx to canonical json
+func (c *Chain33) EncodeCanonicalJSONTx(in *types.ReqString, result *interface{}) error {
+	data, err := common.FromHex(in.Data)
+	if err != nil {
+		return err
+	}
+	var tx types.Transaction
+	err = types.Decode(data, &tx)
+	if err != nil {
+		return err
+	}
+	jsondata, err := tx.MarshalCanonicalJSON()
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.CanonicalJSONTx{Tx: string(jsondata), SignData: common.ToHex(tx.SignData())}
+	return nil
+}
+
+// DecodeCanonicalJSONTx convert canonical json tx to hex raw tx
+func (c *Chain33) DecodeCanonicalJSONTx(in *types.ReqString, result *interface{}) error {
+	tx, err := types.UnmarshalCanonicalJSON([]byte(in.Data))
+	if err != nil {
+		return err
+	}
+	*result = common.ToHex(types.Encode(tx))
+	return nil
+}
+
 // GetTimeStatus get status of time
 func (c *Chain33) GetTimeStatus(in *types.ReqNil, result *interface{}) error {
 	reply, err := c.cli.GetTimeStatus()
@@ -1075,12 +1352,176 @@ func (c *Chain33) CreateTransaction(in *rpctypes.CreateTxIn, result *interface{}
 	return nil
 }
 
+// CreateMultiActionTx create a multi action tx, the actions are packed into one tx which is signed once and executed atomically,
+// a single action is a normal tx
+func (c *Chain33) CreateMultiActionTx(in *rpctypes.CreateMultiActionTxIn, result *interface{}) error {
+	if in == nil || len(in.Actions) == 0 || len(in.Actions) > int(types.MaxTxGroupSize) {
+		return types.ErrInvalidParam
+	}
+	var expire int64
+	if in.Expire != "" {
+		var err error
+		expire, err = types.ParseExpire(in.Expire)
+		if err != nil {
+			return err
+		}
+	}
+	txs := make([]*types.Transaction, len(in.Actions))
+	for i, action := range in.Actions {
+		if action == nil {
+			return types.ErrInvalidParam
+		}
+		btx, err := types.CallCreateTxJSON(types.ExecName(action.Execer), action.ActionName, action.Payload)
+		if err != nil {
+			return err
+		}
+		var tx types.Transaction
+		if err = types.Decode(btx, &tx); err != nil {
+			return err
+		}
+		txs[i] = &tx
+	}
+	//只有一个操作的时候就是普通的交易
+	if len(txs) == 1 {
+		tx := txs[0]
+		if expire != 0 {
+			tx.SetExpire(time.Duration(expire))
+		}
+		if in.Fee > tx.Fee {
+			tx.Fee = in.Fee
+		}
+		*result = hex.EncodeToString(types.Encode(tx))
+		return nil
+	}
+	tx, err := types.CreateMultiActionTx(txs, in.Fee, time.Duration(expire))
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(types.Encode(tx))
+	return nil
+}
+
+// DecodeMultiActionTx decode a multi action tx, a tx group or a normal tx, the actions are returned with the fee paid by the tx
+func (c *Chain33) DecodeMultiActionTx(in *types.ReqDecodeRawTransaction, result *interface{}) error {
+	tx, err := c.cli.DecodeRawTransaction(in)
+	if err != nil {
+		return err
+	}
+	group, err := tx.GetTxGroup()
+	if err != nil {
+		return err
+	}
+	head := tx
+	txs := []*types.Transaction{tx}
+	if group != nil {
+		txs = group.GetTxs()
+		head = txs[0]
+	} else if tx.IsMultiAction() {
+		txs, err = tx.GetMultiActionTxs()
+		if err != nil {
+			return err
+		}
+	}
+	reply := &rpctypes.ReplyMultiActionTx{
+		Hash:   common.ToHex(head.Hash()),
+		Fee:    head.Fee,
+		FeeFmt: strconv.FormatFloat(float64(head.Fee)/float64(types.Coin), 'f', 4, 64),
+		Expire: head.Expire,
+	}
+	if head.GetSignature() != nil {
+		reply.From = head.From()
+	}
+	for _, action := range txs {
+		res, err := rpctypes.DecodeTx(action)
+		if err != nil {
+			return err
+		}
+		reply.Actions = append(reply.Actions, res)
+	}
+	*result = reply
+	return nil
+}
+
 // ConvertExectoAddr convert exec to address
 func (c *Chain33) ConvertExectoAddr(in rpctypes.ExecNameParm, result *string) error {
 	*result = address.ExecAddress(in.ExecName)
 	return nil
 }
 
+// GetStoreProof 获取key在stateHash状态下的存在或者不存在证明，可以用types.VerifyStoreProof验证
+func (c *Chain33) GetStoreProof(in *rpctypes.ReqStoreProof, result *interface{}) error {
+	stateHash, err := common.FromHex(in.StateHash)
+	if err != nil {
+		return err
+	}
+	proof, err := c.cli.StoreGetProof(&types.ReqStoreProof{StateHash: stateHash, Key: []byte(in.Key)})
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(proof)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// GetValueHistory 获取状态数据key在各个高度的历史值，可以用来审计manage配置和token状态的变化
+func (c *Chain33) GetValueHistory(in *rpctypes.ReqValueHistory, result *interface{}) error {
+	history, err := c.cli.GetValueHistory(&types.ReqValueHistory{Key: []byte(in.Key), Height: in.Height, Count: in.Count})
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(history)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// CompactDB 手动压缩数据库的一个前缀或者范围，用于长期运行的归档节点回收磁盘
+func (c *Chain33) CompactDB(in *rpctypes.ReqCompactDB, result *interface{}) error {
+	req := &types.ReqCompactDB{Name: in.Name}
+	if in.Prefix != "" {
+		req.Prefix = []byte(in.Prefix)
+	}
+	if in.Start != "" {
+		req.Start = []byte(in.Start)
+	}
+	if in.End != "" {
+		req.End = []byte(in.End)
+	}
+	reply, err := c.cli.CompactDB(req)
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.Reply{IsOk: reply.GetIsOk()}
+	return nil
+}
+
+// GetDBUsage 统计数据库各个前缀的磁盘占用和估算可以回收的空间
+func (c *Chain33) GetDBUsage(in *rpctypes.ReqDBUsage, result *interface{}) error {
+	req := &types.ReqDBUsage{Name: in.Name}
+	for _, prefix := range in.Prefixes {
+		req.Prefixes = append(req.Prefixes, []byte(prefix))
+	}
+	usage, err := c.cli.GetDBUsage(req)
+	if err != nil {
+		return err
+	}
+	var reply rpctypes.DBUsage
+	reply.Name = usage.Name
+	for _, u := range usage.Prefixes {
+		reply.Prefixes = append(reply.Prefixes, &rpctypes.DBPrefixUsage{Prefix: string(u.Prefix), Keys: u.Keys,
+			LiveBytes: u.LiveBytes, DiskBytes: u.DiskBytes, Reclaimable: u.Reclaimable})
+	}
+	*result = &reply
+	return nil
+}
+
 // GetExecBalance get balance exec
 func (c *Chain33) GetExecBalance(in *types.ReqGetExecBalance, result *interface{}) error {
 	resp, err := c.cli.GetExecBalance(in)
@@ -1107,6 +1548,19 @@ func (c *Chain33) AddSeqCallBack(in *types.BlockSeqCB, result *interface{}) erro
 	return nil
 }
 
+// AddMempoolTxEventCallBack  add callback for pushing tx events of mempool
+func (c *Chain33) AddMempoolTxEventCallBack(in *types.MempoolEventCB, result *interface{}) error {
+	reply, err := c.cli.AddMempoolTxEventCallBack(in)
+	if err != nil {
+		return err
+	}
+	var resp rpctypes.Reply
+	resp.IsOk = reply.GetIsOk()
+	resp.Msg = string(reply.GetMsg())
+	*result = &resp
+	return nil
+}
+
 // ListSeqCallBack  List Seq CallBack
 func (c *Chain33) ListSeqCallBack(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.ListSeqCallBack()
@@ -5,7 +5,10 @@
 package rpc
 
 import (
+	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"encoding/hex"
@@ -13,6 +16,7 @@ import (
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client/mocks"
 	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	_ "github.com/33cn/chain33/system"
 	cty "github.com/33cn/chain33/system/dapp/coins/types"
@@ -20,6 +24,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDecodeLogErr(t *testing.T) {
@@ -578,6 +583,30 @@ func TestChain33_GetBlocks(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, api)
 }
 
+func TestChain33_ExportImportBlocks(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	block := &types.Block{Height: 1, BlockTime: 100, ParentHash: []byte("parent")}
+	api.On("GetBlocks", &types.ReqBlocks{Start: 1, End: 1, Pid: []string{""}}).Return(&types.BlockDetails{Items: []*types.BlockDetail{{Block: block}}}, nil)
+	var testResult interface{}
+	err := testChain33.ExportBlocks(rpctypes.BlockParam{Start: 1, End: 1}, &testResult)
+	assert.NoError(t, err)
+	blocks := testResult.(*rpctypes.ReplyRawBlocks)
+	assert.Equal(t, 1, len(blocks.Blocks))
+	assert.Equal(t, common.ToHex(types.Encode(block)), blocks.Blocks[0])
+
+	api.On("ImportBlock", mock.Anything).Return(&types.Reply{IsOk: true}, nil)
+	err = testChain33.ImportBlock(rpctypes.RawParm{Data: blocks.Blocks[0]}, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+	imported := api.Calls[len(api.Calls)-1].Arguments.Get(0).(*types.Block)
+	assert.Equal(t, block.Hash(), imported.Hash())
+
+	err = testChain33.ImportBlock(rpctypes.RawParm{Data: "0xzz"}, &testResult)
+	assert.Error(t, err)
+}
+
 func TestChain33_GetLastHeader(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	api.On("GetLastHeader", mock.Anything).Return(&types.Header{}, nil)
@@ -942,6 +971,85 @@ func TestChain33_GetProperFee(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, api)
 }
 
+func TestChain33_GetStoreProof(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	req := &types.ReqStoreProof{StateHash: []byte{1, 2}, Key: []byte("mavl-coins-bty-")}
+	api.On("StoreGetProof", req).Return(&types.StoreProof{StateHash: []byte{1, 2}, Key: req.Key}, nil)
+	var testResult interface{}
+	err := testChain33.GetStoreProof(&rpctypes.ReqStoreProof{StateHash: "0x0102", Key: "mavl-coins-bty-"}, &testResult)
+	assert.NoError(t, err)
+	assert.Contains(t, string(testResult.(json.RawMessage)), `"stateHash":"0x0102"`)
+
+	err = testChain33.GetStoreProof(&rpctypes.ReqStoreProof{StateHash: "0xzz"}, &testResult)
+	assert.NotNil(t, err)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_GetValueHistory(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	req := &types.ReqValueHistory{Key: []byte("mavl-manage-key"), Count: 2}
+	reply := &types.ValueHistory{Key: req.Key, Values: []*types.ValueAtHeight{{Height: 10, Value: []byte("v")}}}
+	api.On("GetValueHistory", req).Return(reply, nil)
+	var testResult interface{}
+	err := testChain33.GetValueHistory(&rpctypes.ReqValueHistory{Key: "mavl-manage-key", Count: 2}, &testResult)
+	assert.NoError(t, err)
+	assert.Contains(t, string(testResult.(json.RawMessage)), `"height":"10"`)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_NetPeerAdmin(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	peers := &types.NetPeers{Peers: []*types.NetPeer{{Addr: "192.168.1.1:13802", Inbound: true, Latency: 15}}}
+	api.On("NetPeers").Return(peers, nil)
+	var testResult interface{}
+	err := testChain33.GetNetPeers(&types.ReqNil{}, &testResult)
+	assert.NoError(t, err)
+	assert.Contains(t, string(testResult.(json.RawMessage)), `"inbound":true`)
+
+	req := &types.ReqNetPeer{Addr: "192.168.1.1:13802", BanTime: 600}
+	api.On("NetBanPeer", req).Return(&types.Reply{IsOk: true}, nil)
+	err = testChain33.BanPeer(req, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+
+	api.On("NetRemovePeer", req).Return(nil, types.ErrNotFound)
+	err = testChain33.RemovePeer(req, &testResult)
+	assert.Equal(t, types.ErrNotFound, err)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_CompactDB(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+	dir, err := ioutil.TempDir("", "compactdb")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := dbm.NewDB("rpccompact", "leveldb", dir, 16)
+	defer db.Close()
+	assert.NoError(t, db.Set([]byte("TxHash:1"), []byte("v1")))
+	assert.NoError(t, db.Set([]byte("TxHash:2"), []byte("v2")))
+
+	var testResult interface{}
+	err = testChain33.CompactDB(&rpctypes.ReqCompactDB{Name: "rpccompact", Prefix: "TxHash:"}, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+	err = testChain33.CompactDB(&rpctypes.ReqCompactDB{Name: "notexist"}, &testResult)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	err = testChain33.GetDBUsage(&rpctypes.ReqDBUsage{Name: "rpccompact"}, &testResult)
+	assert.NoError(t, err)
+	usage := testResult.(*rpctypes.DBUsage)
+	assert.Equal(t, 1, len(usage.Prefixes))
+	assert.Equal(t, "TxHash:", usage.Prefixes[0].Prefix)
+	assert.Equal(t, int64(2), usage.Prefixes[0].Keys)
+}
+
 func TestChain33_GetBlockOverview(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	testChain33 := newTestChain33(api)
@@ -1207,6 +1315,56 @@ func TestChain33_CreateTransaction(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestChain33_CreateMultiActionTx(t *testing.T) {
+	client := newTestChain33(nil)
+
+	var result interface{}
+	err := client.CreateMultiActionTx(nil, &result)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	err = client.CreateMultiActionTx(&rpctypes.CreateMultiActionTxIn{}, &result)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	action := &rpctypes.CreateTxIn{
+		Execer:     types.ExecName("coins"),
+		ActionName: "Transfer",
+		Payload:    []byte("{\"to\": \"1MY4pMgjpS2vWiaSDZasRhN47pcwEire32\", \"amount\":\"10\"}"),
+	}
+	in := &rpctypes.CreateMultiActionTxIn{Actions: []*rpctypes.CreateTxIn{action, action, action}, Fee: 1e7, Expire: "H:100"}
+	err = client.CreateMultiActionTx(in, &result)
+	require.Nil(t, err)
+
+	var decoded interface{}
+	err = client.DecodeMultiActionTx(&types.ReqDecodeRawTransaction{TxHex: result.(string)}, &decoded)
+	require.Nil(t, err)
+	reply := decoded.(*rpctypes.ReplyMultiActionTx)
+	assert.Equal(t, 3, len(reply.Actions))
+	assert.Equal(t, int64(1e7), reply.Fee)
+	assert.Equal(t, types.TxHeightFlag+100, reply.Expire)
+	//多个操作在一笔交易中，每个操作的Header是这笔交易的hash
+	for _, tx := range reply.Actions {
+		assert.Equal(t, types.ExecName("coins"), tx.Execer)
+		assert.Equal(t, int32(0), tx.GroupCount)
+		assert.Equal(t, types.TxHeightFlag+100, tx.Expire)
+		assert.Equal(t, int64(0), tx.Fee)
+		assert.Equal(t, reply.Hash, tx.Header)
+	}
+	txbyte, err := common.FromHex(result.(string))
+	require.Nil(t, err)
+	var tx types.Transaction
+	require.Nil(t, types.Decode(txbyte, &tx))
+	assert.True(t, tx.IsMultiAction())
+	assert.Equal(t, int32(0), tx.GroupCount)
+
+	//一个操作的时候是普通的交易
+	in = &rpctypes.CreateMultiActionTxIn{Actions: []*rpctypes.CreateTxIn{action}}
+	err = client.CreateMultiActionTx(in, &result)
+	require.Nil(t, err)
+	err = client.DecodeMultiActionTx(&types.ReqDecodeRawTransaction{TxHex: result.(string)}, &decoded)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(decoded.(*rpctypes.ReplyMultiActionTx).Actions))
+	assert.Equal(t, int32(0), decoded.(*rpctypes.ReplyMultiActionTx).Actions[0].GroupCount)
+}
+
 func TestChain33_GetExecBalance(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	client := newTestChain33(api)
@@ -1297,6 +1455,35 @@ func TestChain33_ExecWallet(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestChain33_QueryConsensus(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var testResult interface{}
+	in := &rpctypes.ChainExecutor{Driver: "tendermint", FuncName: "GetStatus", Payload: []byte("{}")}
+	api.On("QueryConsensusFunc", "tendermint", "GetStatus", mock.Anything).Return(&types.Reply{IsOk: true}, nil)
+	err := client.QueryConsensus(in, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"isOk":true,"msg":null}`, string(testResult.(json.RawMessage)))
+
+	in = &rpctypes.ChainExecutor{Driver: "unknown", FuncName: "GetStatus", Payload: []byte("{}")}
+	err = client.QueryConsensus(in, &testResult)
+	assert.NotNil(t, err)
+}
+
+func TestChain33_GetFinalizedCheckpoint(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var testResult interface{}
+	api.On("Query", "finality", "GetFinalized", mock.Anything).Return(&types.ReplyString{Data: "ok"}, nil).Once()
+	err := client.GetFinalizedCheckpoint(&types.ReqNil{}, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"data":"ok"}`, string(testResult.(json.RawMessage)))
+
+	api.On("Query", "finality", "GetFinalized", mock.Anything).Return(nil, types.ErrActionNotSupport)
+	err = client.GetFinalizedCheckpoint(&types.ReqNil{}, &testResult)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+}
+
 func TestChain33_Query(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	client := newTestChain33(api)
@@ -1316,6 +1503,21 @@ func TestChain33_DumpPrivkey(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChain33_DeleteAccount(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var testResult interface{}
+	req := &types.ReqString{Data: "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"}
+	api.On("ExecWalletFunc", "wallet", "DeleteAccount", req).Return(&types.Reply{IsOk: true}, nil)
+	err := client.DeleteAccount(*req, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+
+	api.On("ExecWalletFunc", "wallet", "DeleteAccount", &types.ReqString{}).Return(nil, types.ErrInvalidParam)
+	err = client.DeleteAccount(types.ReqString{}, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
 func TestChain33_GetTotalCoins(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	client := newTestChain33(api)
@@ -1343,6 +1545,21 @@ func TestChain33_DecodeRawTransaction(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChain33_CanonicalJSONTx(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var testResult interface{}
+	txhex := "0x0a05636f696e73122c18010a281080c2d72f222131477444795771577233553637656a7663776d333867396e7a6e7a434b58434b7120a08d0630a696c0b3f78dd9ec083a2131477444795771577233553637656a7663776d333867396e7a6e7a434b58434b71"
+	err := client.EncodeCanonicalJSONTx(&types.ReqString{Data: txhex}, &testResult)
+	assert.NoError(t, err)
+	reply := testResult.(*rpctypes.CanonicalJSONTx)
+	err = client.DecodeCanonicalJSONTx(&types.ReqString{Data: reply.Tx}, &testResult)
+	assert.NoError(t, err)
+	assert.Equal(t, txhex, testResult)
+	err = client.DecodeCanonicalJSONTx(&types.ReqString{Data: reply.Tx + " "}, &testResult)
+	assert.Equal(t, types.ErrNonCanonical, err)
+}
+
 func TestChain33_WalletCreateTx(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	client := newTestChain33(api)
@@ -1371,6 +1588,16 @@ func TestChain33_AddSeqCallBack(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChain33_AddMempoolTxEventCallBack(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	var testResult interface{}
+	api.On("AddMempoolTxEventCallBack", mock.Anything).Return(&types.Reply{IsOk: true}, nil)
+	err := client.AddMempoolTxEventCallBack(&types.MempoolEventCB{Name: "test"}, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*rpctypes.Reply).IsOk)
+}
+
 func TestChain33_ListSeqCallBack(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	client := newTestChain33(api)
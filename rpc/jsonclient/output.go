@@ -0,0 +1,352 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+//命令行结果的输出格式
+const (
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+	OutputTable = "table"
+)
+
+//outputFormat 为空的时候各个命令使用自己默认的格式，rpc的结果默认是json
+var outputFormat string
+
+// SetOutputFormat 设置所有命令的输出格式，text是table的别名
+func SetOutputFormat(format string) error {
+	switch format {
+	case "", OutputJSON, OutputYAML, OutputTable:
+	case "text":
+		format = OutputTable
+	default:
+		return fmt.Errorf("unknown output format %s, supported: json, yaml, table", format)
+	}
+	outputFormat = format
+	return nil
+}
+
+// GetOutputFormat 返回设置的输出格式，没有设置的时候返回空
+func GetOutputFormat() string {
+	return outputFormat
+}
+
+// PrintResult 按设置的格式输出命令的结果，没有设置的时候输出json
+func PrintResult(result interface{}) {
+	if err := Render(os.Stdout, outputFormat, result); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// PrintString 字符串的结果，没有设置输出格式的时候直接输出，例如交易的hex
+func PrintString(result string) {
+	if outputFormat != "" {
+		PrintResult(result)
+		return
+	}
+	fmt.Println(result)
+}
+
+// Render 按format输出result，result先转换成json，字段的顺序和json一致
+func Render(w io.Writer, format string, result interface{}) error {
+	if format == "" || format == OutputJSON {
+		data, err := json.MarshalIndent(result, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	v, err := decodeOrdered(data)
+	if err != nil {
+		return err
+	}
+	if format == OutputYAML {
+		buf := new(bytes.Buffer)
+		writeYAML(buf, v, 0)
+		_, err = w.Write(buf.Bytes())
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	writeTable(tw, v)
+	return tw.Flush()
+}
+
+//field 保持json对象中字段的顺序
+type field struct {
+	key string
+	val interface{}
+}
+
+type object []field
+
+func decodeOrdered(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return decodeValue(dec)
+}
+
+func decodeValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := object{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			val, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, field{key: key.(string), val: val})
+		}
+		_, err = dec.Token()
+		return obj, err
+	case json.Delim('['):
+		list := []interface{}{}
+		for dec.More() {
+			val, err := decodeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, val)
+		}
+		_, err = dec.Token()
+		return list, err
+	}
+	return tok, nil
+}
+
+func isScalar(v interface{}) bool {
+	switch x := v.(type) {
+	case object:
+		return len(x) == 0
+	case []interface{}:
+		return len(x) == 0
+	}
+	return true
+}
+
+//yamlScalar 可能被解析成其他类型或者包含特殊字符的字符串加上引号
+func yamlScalar(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(x)
+	case json.Number:
+		return x.String()
+	case object:
+		return "{}"
+	case []interface{}:
+		return "[]"
+	case string:
+		return yamlString(x)
+	}
+	return fmt.Sprint(v)
+}
+
+func yamlString(s string) string {
+	if s == "" || strings.TrimSpace(s) != s || strings.ContainsAny(s, ":#{}[],&*!|>'\"%@`\n\t\\") ||
+		strings.ContainsAny(s[:1], "-?") {
+		return strconv.Quote(s)
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return strconv.Quote(s)
+	}
+	if looksNumeric(s) {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+//looksNumeric 0x开头的hash超过int64的范围，也会被yaml解析成数字
+func looksNumeric(s string) bool {
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	_, err := strconv.ParseInt(s, 0, 64)
+	if err == nil {
+		return true
+	}
+	numErr, ok := err.(*strconv.NumError)
+	return ok && numErr.Err == strconv.ErrRange
+}
+
+func writeYAML(w io.Writer, v interface{}, indent int) {
+	pad := strings.Repeat(" ", indent)
+	switch x := v.(type) {
+	case object:
+		if len(x) == 0 {
+			fmt.Fprintf(w, "%s{}\n", pad)
+			return
+		}
+		for _, f := range x {
+			if isScalar(f.val) {
+				fmt.Fprintf(w, "%s%s: %s\n", pad, yamlString(f.key), yamlScalar(f.val))
+				continue
+			}
+			fmt.Fprintf(w, "%s%s:\n", pad, yamlString(f.key))
+			writeYAML(w, f.val, indent+2)
+		}
+	case []interface{}:
+		if len(x) == 0 {
+			fmt.Fprintf(w, "%s[]\n", pad)
+			return
+		}
+		for _, item := range x {
+			if isScalar(item) {
+				fmt.Fprintf(w, "%s- %s\n", pad, yamlScalar(item))
+				continue
+			}
+			//列表中的对象第一个字段和 - 在同一行
+			buf := new(bytes.Buffer)
+			writeYAML(buf, item, indent+2)
+			fmt.Fprintf(w, "%s- %s", pad, strings.TrimPrefix(buf.String(), pad+"  "))
+		}
+	default:
+		fmt.Fprintf(w, "%s%s\n", pad, yamlScalar(v))
+	}
+}
+
+//cell 表格中的值，对象和列表用紧凑的json表示
+func cell(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		return x
+	case json.Number:
+		return x.String()
+	case bool:
+		return strconv.FormatBool(x)
+	}
+	data, _ := json.Marshal(toPlain(v))
+	return string(data)
+}
+
+func toPlain(v interface{}) interface{} {
+	switch x := v.(type) {
+	case object:
+		m := make(map[string]interface{}, len(x))
+		for _, f := range x {
+			m[f.key] = toPlain(f.val)
+		}
+		return m
+	case []interface{}:
+		list := make([]interface{}, len(x))
+		for i, item := range x {
+			list[i] = toPlain(item)
+		}
+		return list
+	}
+	return v
+}
+
+//writeTable 对象的列表每个对象一行，其他的结果按字段路径一行一个值
+func writeTable(w io.Writer, v interface{}) {
+	//只有一个列表字段的对象，比如 {"items": [...]}，直接输出列表
+	if obj, ok := v.(object); ok && len(obj) == 1 {
+		if list, ok := obj[0].val.([]interface{}); ok && len(list) > 0 {
+			v = list
+		}
+	}
+	if list, ok := v.([]interface{}); ok && len(list) > 0 {
+		if rows, ok := objectRows(list); ok {
+			writeRows(w, rows)
+			return
+		}
+	}
+	if isScalar(v) {
+		fmt.Fprintln(w, cell(v))
+		return
+	}
+	flatten(w, "", v)
+}
+
+func objectRows(list []interface{}) ([]object, bool) {
+	rows := make([]object, 0, len(list))
+	for _, item := range list {
+		obj, ok := item.(object)
+		if !ok {
+			return nil, false
+		}
+		rows = append(rows, obj)
+	}
+	return rows, true
+}
+
+func writeRows(w io.Writer, rows []object) {
+	var columns []string
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		for _, f := range row {
+			if !seen[f.key] {
+				seen[f.key] = true
+				columns = append(columns, f.key)
+			}
+		}
+	}
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = strings.ToUpper(c)
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		values := make(map[string]interface{}, len(row))
+		for _, f := range row {
+			values[f.key] = f.val
+		}
+		cells := make([]string, len(columns))
+		for i, c := range columns {
+			cells[i] = cell(values[c])
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+}
+
+func flatten(w io.Writer, path string, v interface{}) {
+	switch x := v.(type) {
+	case object:
+		if len(x) > 0 {
+			for _, f := range x {
+				key := f.key
+				if path != "" {
+					key = path + "." + f.key
+				}
+				flatten(w, key, f.val)
+			}
+			return
+		}
+	case []interface{}:
+		if len(x) > 0 {
+			for i, item := range x {
+				flatten(w, fmt.Sprintf("%s[%d]", path, i), item)
+			}
+			return
+		}
+	}
+	fmt.Fprintf(w, "%s\t%s\n", path, cell(v))
+}
@@ -0,0 +1,58 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jsonclient
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testHeader struct {
+	Height int64    `json:"height"`
+	Hash   string   `json:"hash"`
+	Tags   []string `json:"tags"`
+}
+
+func TestRender(t *testing.T) {
+	result := map[string]interface{}{"items": []testHeader{{1, "0xab", []string{"a", "1"}}, {2, "", nil}}}
+
+	buf := new(bytes.Buffer)
+	assert.Nil(t, Render(buf, OutputYAML, result))
+	assert.Equal(t, `items:
+  - height: 1
+    hash: "0xab"
+    tags:
+      - a
+      - "1"
+  - height: 2
+    hash: ""
+    tags: null
+`, buf.String())
+
+	buf.Reset()
+	assert.Nil(t, Render(buf, OutputTable, result))
+	assert.Equal(t, `HEIGHT  HASH  TAGS
+1       0xab  ["a","1"]
+2             -
+`, buf.String())
+
+	buf.Reset()
+	assert.Nil(t, Render(buf, OutputTable, testHeader{Height: 3, Tags: []string{"x"}}))
+	assert.Equal(t, "height   3\nhash     \ntags[0]  x\n", buf.String())
+
+	buf.Reset()
+	assert.Nil(t, Render(buf, OutputJSON, "hello"))
+	assert.Equal(t, "\"hello\"\n", buf.String())
+}
+
+func TestSetOutputFormat(t *testing.T) {
+	defer SetOutputFormat("")
+	assert.Nil(t, SetOutputFormat("text"))
+	assert.Equal(t, OutputTable, GetOutputFormat())
+	assert.NotNil(t, SetOutputFormat("xml"))
+	assert.Equal(t, OutputTable, GetOutputFormat())
+}
@@ -5,7 +5,6 @@
 package jsonclient
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 )
@@ -69,15 +68,10 @@ func (c *RPCCtx) Run() {
 		fmt.Fprintln(os.Stderr, err)
 		return
 	}
-	data, err := json.MarshalIndent(result, "", "    ")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		return
-	}
-	fmt.Println(string(data))
+	PrintResult(result)
 }
 
-// RunWithoutMarshal return source result of string
+// RunWithoutMarshal return source result of string, 设置了输出格式的时候按格式输出
 func (c *RPCCtx) RunWithoutMarshal() {
 	var res string
 	rpc, err := NewJSONClient(c.Addr)
@@ -91,6 +85,5 @@ func (c *RPCCtx) RunWithoutMarshal() {
 		fmt.Fprintln(os.Stderr, err)
 		return
 	}
-
-	fmt.Println(res)
+	PrintString(res)
 }
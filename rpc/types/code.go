@@ -29,6 +29,9 @@ func DecodeLog(execer []byte, rlog *ReceiptData) (*ReceiptDataResult, error) {
 		rTy = "Unknown"
 	}
 	rd := &ReceiptDataResult{Ty: rlog.Ty, TyName: rTy}
+	//多操作交易中每个操作的日志用标记中的操作的执行器解析
+	multi := string(execer) == types.ExecName(types.MultiActionX)
+	logExecer := execer
 	for _, l := range rlog.Logs {
 		var lTy string
 		var logIns json.RawMessage
@@ -36,7 +39,14 @@ func DecodeLog(execer []byte, rlog *ReceiptData) (*ReceiptDataResult, error) {
 		if err != nil {
 			return nil, err
 		}
-		logType := types.LoadLog(execer, int64(l.Ty))
+		logType := types.LoadLog(logExecer, int64(l.Ty))
+		if multi && l.Ty == types.TyLogMultiAction {
+			var mark types.ReceiptMultiAction
+			if types.Decode(lLog, &mark) == nil {
+				logType = types.LoadLog(execer, int64(l.Ty))
+				logExecer = mark.Execer
+			}
+		}
 		if logType == nil {
 			lTy = "unkownType"
 			logIns = nil
@@ -80,6 +90,7 @@ func ConvertWalletTxDetailToJSON(in *types.WalletTxDetails, out *WalletTxDetails
 			FromAddr:   tx.GetFromaddr(),
 			TxHash:     common.ToHex(tx.GetTxhash()),
 			ActionName: tx.GetActionName(),
+			Note:       string(tx.GetNote()),
 		})
 	}
 	return nil
@@ -115,6 +126,7 @@ func DecodeTx(tx *types.Transaction) (*Transaction, error) {
 			Ty:        tx.GetSignature().GetTy(),
 			Pubkey:    common.ToHex(tx.GetSignature().GetPubkey()),
 			Signature: common.ToHex(tx.GetSignature().GetSignature()),
+			Addr:      tx.GetSignature().GetAddr(),
 		},
 		Fee:        tx.Fee,
 		Expire:     tx.Expire,
@@ -125,6 +137,15 @@ func DecodeTx(tx *types.Transaction) (*Transaction, error) {
 		Header:     common.ToHex(tx.Header),
 		Next:       common.ToHex(tx.Next),
 		Hash:       common.ToHex(tx.Hash()),
+		ChainID:    tx.ChainID,
+	}
+	if tx.GetFeePayer() != nil {
+		result.FeePayer = &Signature{
+			Ty:        tx.GetFeePayer().GetTy(),
+			Pubkey:    common.ToHex(tx.GetFeePayer().GetPubkey()),
+			Signature: common.ToHex(tx.GetFeePayer().GetSignature()),
+		}
+		result.FeeAddr = tx.FeeAddr()
 	}
 	if result.Amount != 0 {
 		result.AmountFmt = strconv.FormatFloat(float64(result.Amount)/float64(types.Coin), 'f', 4, 64)
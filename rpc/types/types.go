@@ -62,6 +62,7 @@ type Signature struct {
 	Ty        int32  `json:"ty"`
 	Pubkey    string `json:"pubkey"`
 	Signature string `json:"signature"`
+	Addr      string `json:"addr,omitempty"`
 }
 
 // Transaction parameter
@@ -82,6 +83,9 @@ type Transaction struct {
 	Header     string          `json:"header,omitempty"`
 	Next       string          `json:"next,omitempty"`
 	Hash       string          `json:"hash,omitempty"`
+	FeePayer   *Signature      `json:"feePayer,omitempty"`
+	FeeAddr    string          `json:"feeAddr,omitempty"`
+	ChainID    int32           `json:"chainID,omitempty"`
 }
 
 // ReceiptLog defines receipt log command
@@ -178,9 +182,16 @@ type ReplyTxList struct {
 	Txs []*Transaction `json:"txs"`
 }
 
+// CanonicalJSONTx canonical json of tx and the data to be signed
+type CanonicalJSONTx struct {
+	Tx       string `json:"tx"`
+	SignData string `json:"signData"`
+}
+
 // ReplyProperFee reply proper fee
 type ReplyProperFee struct {
 	ProperFee int64 `json:"properFee"`
+	MinTxFee  int64 `json:"minTxFee"`
 }
 
 // ReplyHash reply hash string json
@@ -193,6 +204,11 @@ type ReplyHashes struct {
 	Hashes []string `json:"hashes"`
 }
 
+// ReplyRawBlocks 序列化以后的区块，用于区块的导出和导入
+type ReplyRawBlocks struct {
+	Blocks []string `json:"blocks"`
+}
+
 // PeerList peer list
 type PeerList struct {
 	Peers []*Peer `json:"peers"`
@@ -276,6 +292,7 @@ type WalletTxDetail struct {
 	FromAddr   string             `json:"fromAddr"`
 	TxHash     string             `json:"txHash"`
 	ActionName string             `json:"actionName"`
+	Note       string             `json:"note,omitempty"`
 }
 
 // BlockOverview block overview
@@ -354,6 +371,23 @@ type CreateTxIn struct {
 	Payload    json.RawMessage `json:"payload"`
 }
 
+// CreateMultiActionTxIn create multi action tx input, actions are packed into one tx and executed atomically
+type CreateMultiActionTxIn struct {
+	Actions []*CreateTxIn `json:"actions"`
+	Fee     int64         `json:"fee"`
+	Expire  string        `json:"expire,omitempty"`
+}
+
+// ReplyMultiActionTx decoded multi action tx
+type ReplyMultiActionTx struct {
+	Hash    string         `json:"hash"`
+	From    string         `json:"from,omitempty"`
+	Fee     int64          `json:"fee"`
+	FeeFmt  string         `json:"feefmt"`
+	Expire  int64          `json:"expire"`
+	Actions []*Transaction `json:"actions"`
+}
+
 // AllExecBalance all exec balance
 type AllExecBalance struct {
 	Addr        string         `json:"addr"`
@@ -371,6 +405,48 @@ type ExecNameParm struct {
 	ExecName string `json:"execname"`
 }
 
+// ReqCompactDB 请求压缩数据库，prefix为空的时候压缩[start, end)，都为空表示整个数据库
+type ReqCompactDB struct {
+	Name   string `json:"name"`
+	Prefix string `json:"prefix"`
+	Start  string `json:"start"`
+	End    string `json:"end"`
+}
+
+// ReqDBUsage 请求统计数据库的磁盘占用，prefixes为空的时候按key的命名空间自动划分
+type ReqDBUsage struct {
+	Name     string   `json:"name"`
+	Prefixes []string `json:"prefixes"`
+}
+
+// DBPrefixUsage 一个前缀的磁盘占用
+type DBPrefixUsage struct {
+	Prefix      string `json:"prefix"`
+	Keys        int64  `json:"keys"`
+	LiveBytes   int64  `json:"liveBytes"`
+	DiskBytes   int64  `json:"diskBytes"`
+	Reclaimable int64  `json:"reclaimable"`
+}
+
+// DBUsage 数据库的磁盘占用
+type DBUsage struct {
+	Name     string           `json:"name"`
+	Prefixes []*DBPrefixUsage `json:"prefixes"`
+}
+
+// ReqValueHistory 请求状态数据key的历史值，height小于等于0表示从最新的高度开始
+type ReqValueHistory struct {
+	Key    string `json:"key"`
+	Height int64  `json:"height"`
+	Count  int32  `json:"count"`
+}
+
+// ReqStoreProof 请求key在某个状态下的证明，stateHash为hex格式
+type ReqStoreProof struct {
+	StateHash string `json:"stateHash"`
+	Key       string `json:"key"`
+}
+
 //CreateTx 为了简化Note 的创建过程，在json rpc 中，note 采用string 格式
 type CreateTx struct {
 	To          string `json:"to,omitempty"`
@@ -382,6 +458,7 @@ type CreateTx struct {
 	TokenSymbol string `json:"tokenSymbol,omitempty"`
 	ExecName    string `json:"execName,omitempty"` //TransferToExec and Withdraw 的执行器
 	Execer      string `json:"execer,omitempty"`   //执行器名称
+	NotePubKey  string `json:"notePubKey,omitempty"`
 }
 
 // ReWriteRawTx parameter
@@ -7,10 +7,13 @@ package consensus
 import (
 	"errors"
 	"math/rand"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/difficulty"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/common/merkle"
 	"github.com/33cn/chain33/queue"
@@ -289,7 +292,7 @@ func (bc *BaseClient) CheckBlock(block *types.BlockDetail) error {
 		if block.Block.Size() > types.MaxBlockSize {
 			return types.ErrBlockSize
 		}
-		if int64(len(block.Block.Txs)) > types.GetP(block.Block.Height).MaxTxNumber {
+		if int64(len(block.Block.Txs)) > bc.GetMaxTxNumber(parent) {
 			return types.ErrManyTx
 		}
 	}
@@ -315,6 +318,23 @@ func (bc *BaseClient) RequestTx(listSize int, txHashList [][]byte) []*types.Tran
 	return resp.GetData().(*types.ReplyTxList).GetTxs()
 }
 
+//RequestBlockCandidate 从Mempool中获取按手续费率排序并且已经去重的打包候选交易
+func (bc *BaseClient) RequestBlockCandidate(req *types.ReqBlockCandidate) []*types.Transaction {
+	if bc.client == nil {
+		panic("bc not bind message queue.")
+	}
+	msg := bc.client.NewMessage("mempool", types.EventGetBlockCandidate, req)
+	err := bc.client.Send(msg, true)
+	if err != nil {
+		return nil
+	}
+	resp, err := bc.client.Wait(msg)
+	if err != nil {
+		return nil
+	}
+	return resp.GetData().(*types.ReplyTxList).GetTxs()
+}
+
 //RequestBlock 请求区块
 func (bc *BaseClient) RequestBlock(start int64) (*types.Block, error) {
 	if bc.client == nil {
@@ -518,3 +538,58 @@ func (bc *BaseClient) AddTxsToBlock(block *types.Block, txs []*types.Transaction
 	}
 	return addedTx
 }
+
+//BroadcastConsensus 通过p2p广播共识消息，其他节点的共识模块通过EventConsensusMsg收到
+func (bc *BaseClient) BroadcastConsensus(driver string, data []byte) error {
+	msg := bc.client.NewMessage("p2p", types.EventConsensusBroadcast, &types.P2PConsensus{Driver: driver, Data: data})
+	return bc.client.Send(msg, false)
+}
+
+//GetManageConfig 获取manage合约在指定状态下配置的列表，用于由manage管理的共识节点
+func (bc *BaseClient) GetManageConfig(key string, stateHash []byte) ([]string, error) {
+	return getManageConfig(bc.api, key, stateHash)
+}
+
+func getManageConfig(api client.QueueProtocolAPI, key string, stateHash []byte) ([]string, error) {
+	msg, err := api.QueryChain(&types.ChainExecutor{
+		Driver:    "manage",
+		FuncName:  "GetConfigItem",
+		StateHash: stateHash,
+		Param:     types.Encode(&types.ReqString{Data: key}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	//manage 合约返回的是fmt.Sprint格式的列表
+	value := strings.Trim(msg.(*types.ReplyConfig).Value, "[]")
+	return strings.Fields(value), nil
+}
+
+//GetNextTarget 按照下一个区块高度配置的难度调整算法，计算下一个区块的pow难度
+func (bc *BaseClient) GetNextTarget(parent *types.Block) (uint32, error) {
+	height := parent.Height + 1
+	cfg := types.GetP(height)
+	retarget, err := difficulty.GetRetarget(cfg.DifficultyAlgo)
+	if err != nil {
+		return 0, err
+	}
+	param := &difficulty.Param{
+		PowLimitBits:       cfg.PowLimitBits,
+		TargetTimespan:     int64(cfg.TargetTimespan / time.Second),
+		TargetTimePerBlock: int64(cfg.TargetTimePerBlock / time.Second),
+		AdjustmentFactor:   cfg.RetargetAdjustmentFactor,
+	}
+	start := parent.Height - retarget.Window(param) + 1
+	if start < 0 {
+		start = 0
+	}
+	headers, err := bc.api.GetHeaders(&types.ReqBlocks{Start: start, End: parent.Height})
+	if err != nil {
+		return 0, err
+	}
+	var blocks []*difficulty.BlockInfo
+	for _, header := range headers.Items {
+		blocks = append(blocks, &difficulty.BlockInfo{Height: header.Height, BlockTime: header.BlockTime, Bits: header.Difficulty})
+	}
+	return retarget.NextBits(blocks, param), nil
+}
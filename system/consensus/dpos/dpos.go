@@ -0,0 +1,310 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dpos 委托权益证明共识，得票最多的受托人按时间片轮流出块
+package dpos
+
+import (
+	"errors"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	"github.com/33cn/chain33/types"
+)
+
+var dlog = log.New("module", "dpos")
+
+var errNoDelegates = errors.New("ErrNoDelegates")
+
+//Client 客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg   *subConfig
+	privKey  crypto.PrivKey
+	addr     string
+	interval time.Duration
+}
+
+func init() {
+	drivers.Reg("dpos", New)
+	drivers.QueryData.Register("dpos", &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	//DelegateNum 参与出块的受托人个数
+	DelegateNum int64 `json:"delegateNum"`
+	//BlockInterval 每个时间片的长度，单位秒
+	BlockInterval int64 `json:"blockInterval"`
+	//PrivKey 本节点受托人的私钥，为空表示本节点不出块
+	PrivKey string `json:"privKey"`
+	//BootstrapDelegates 注册的受托人个数不足的时候，由这些地址出块
+	BootstrapDelegates []string `json:"bootstrapDelegates"`
+}
+
+//New new
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	if subcfg.DelegateNum <= 0 {
+		subcfg.DelegateNum = dty.DefaultDelegateNum
+	}
+	if subcfg.BlockInterval <= 0 {
+		subcfg.BlockInterval = dty.DefaultBlockIntervalTime
+	}
+	client := &Client{BaseClient: c, subcfg: &subcfg, interval: time.Duration(subcfg.BlockInterval) * time.Second}
+	if subcfg.PrivKey != "" {
+		priv, err := loadPrivKey(subcfg.PrivKey)
+		if err != nil {
+			panic(err)
+		}
+		client.privKey = priv
+		client.addr = address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+	}
+	c.SetChild(client)
+	return client
+}
+
+func loadPrivKey(key string) (crypto.PrivKey, error) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	bkey, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(bkey)
+}
+
+//Close close
+func (client *Client) Close() {
+	dlog.Info("consensus dpos closed")
+}
+
+//GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+//CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+//ProcEvent false
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	return false
+}
+
+//calcSlot 区块时间所在的时间片
+func calcSlot(genesisBlockTime, interval, blocktime int64) int64 {
+	if blocktime < genesisBlockTime {
+		return -1
+	}
+	return (blocktime - genesisBlockTime) / interval
+}
+
+//slotProducer 时间片对应的出块受托人
+func slotProducer(delegates []string, slot int64) string {
+	return delegates[slot%int64(len(delegates))]
+}
+
+//calcMissed 两个区块之间被跳过的时间片对应的受托人，最多统计一轮
+func calcMissed(delegates []string, parentSlot, slot int64) []string {
+	var missed []string
+	for s := parentSlot + 1; s < slot && len(missed) < len(delegates); s++ {
+		missed = append(missed, slotProducer(delegates, s))
+	}
+	return missed
+}
+
+func (client *Client) slotOf(blocktime int64) int64 {
+	return calcSlot(client.subcfg.GenesisBlockTime, client.subcfg.BlockInterval, blocktime)
+}
+
+//getDelegates 根据父区块的状态获取出块受托人，注册的受托人不足的时候使用初始受托人
+func (client *Client) getDelegates(parent *types.Block) ([]string, error) {
+	msg, err := client.GetAPI().QueryChain(&types.ChainExecutor{
+		Driver:    dty.DposX,
+		FuncName:  dty.FuncNameGetTopDelegates,
+		StateHash: parent.StateHash,
+		Param:     types.Encode(&dty.ReqDposTopDelegates{Count: int32(client.subcfg.DelegateNum)}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var delegates []string
+	for _, delegate := range msg.(*dty.ReplyDposDelegates).Delegates {
+		delegates = append(delegates, delegate.Addr)
+	}
+	if int64(len(delegates)) < client.subcfg.DelegateNum {
+		delegates = client.subcfg.BootstrapDelegates
+	}
+	if len(delegates) == 0 {
+		return nil, errNoDelegates
+	}
+	return delegates, nil
+}
+
+//parentSlot 创世区块之后的第一个区块不统计漏块
+func (client *Client) parentSlot(parent *types.Block, slot int64) int64 {
+	if parent.Height == 0 {
+		return slot - 1
+	}
+	return client.slotOf(parent.BlockTime)
+}
+
+//CheckBlock 检查出块交易以及出块的受托人
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	block := current.Block
+	if len(block.Txs) == 0 {
+		return dty.ErrMinerTx
+	}
+	minerTx := block.Txs[0]
+	if string(minerTx.Execer) != dty.DposX {
+		return dty.ErrMinerTx
+	}
+	var action dty.DposAction
+	err := types.Decode(minerTx.Payload, &action)
+	if err != nil {
+		return err
+	}
+	miner := action.GetMiner()
+	if action.Ty != dty.DposActionMiner || miner == nil {
+		return dty.ErrMinerTx
+	}
+	slot := client.slotOf(block.BlockTime)
+	parentSlot := client.parentSlot(parent, slot)
+	if slot < 0 || miner.Slot != slot || slot <= parentSlot {
+		return dty.ErrMinerSlot
+	}
+	//区块时间不能超过本地时间FutureBlockTime秒，时间片不能晚于本地时间所在的时间片，
+	//返回ErrFutureBlock不会记录为错误区块，本地时间到了以后可以重新同步
+	now := types.Now().Unix()
+	if block.BlockTime > now+types.GetP(block.Height).FutureBlockTime || slot > client.slotOf(now) {
+		return types.ErrFutureBlock
+	}
+	delegates, err := client.getDelegates(parent)
+	if err != nil {
+		return err
+	}
+	if minerTx.From() != slotProducer(delegates, slot) {
+		return dty.ErrMinerNotProducer
+	}
+	missed := calcMissed(delegates, parentSlot, slot)
+	if len(missed) != len(miner.Missed) {
+		return dty.ErrMinerMissed
+	}
+	for i := range missed {
+		if missed[i] != miner.Missed[i] {
+			return dty.ErrMinerMissed
+		}
+	}
+	if len(current.Receipts) > 0 && current.Receipts[0].Ty != types.ExecOk {
+		return types.ErrCoinBaseExecErr
+	}
+	return nil
+}
+
+func (client *Client) createMinerTx(slot int64, missed []string) (*types.Transaction, error) {
+	action := &dty.DposAction{
+		Ty:    dty.DposActionMiner,
+		Value: &dty.DposAction_Miner{Miner: &dty.DposMiner{Slot: slot, Missed: missed}},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(dty.DposX),
+		Payload: types.Encode(action),
+		To:      address.ExecAddress(dty.DposX),
+		Nonce:   client.RandInt64(),
+		ChainID: types.GetChainID(),
+	}
+	fee, err := tx.GetRealFee(types.GInt("MinFee"))
+	if err != nil {
+		return nil, err
+	}
+	tx.Fee = fee
+	tx.Sign(types.SECP256K1, client.privKey)
+	return tx, nil
+}
+
+//CreateBlock 轮到本节点的时间片的时候出块
+func (client *Client) CreateBlock() {
+	for {
+		if client.IsClosed() {
+			break
+		}
+		if client.privKey == nil || !client.IsMining() || !client.IsCaughtUp() {
+			time.Sleep(client.interval)
+			continue
+		}
+		err := client.tryCreateBlock()
+		if err != nil {
+			dlog.Debug("tryCreateBlock", "err", err)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func (client *Client) tryCreateBlock() error {
+	lastBlock := client.GetCurrentBlock()
+	slot := client.slotOf(types.Now().Unix())
+	parentSlot := client.parentSlot(lastBlock, slot)
+	if slot <= parentSlot {
+		return nil
+	}
+	delegates, err := client.getDelegates(lastBlock)
+	if err != nil {
+		return err
+	}
+	if slotProducer(delegates, slot) != client.addr {
+		return nil
+	}
+	height := lastBlock.Height + 1
+	minerTx, err := client.createMinerTx(slot, calcMissed(delegates, parentSlot, slot))
+	if err != nil {
+		return err
+	}
+	txs := client.RequestBlockCandidate(&types.ReqBlockCandidate{
+		Height:   height,
+		MaxCount: client.GetMaxTxNumber(lastBlock) - 1,
+	})
+	var newblock types.Block
+	newblock.ParentHash = lastBlock.Hash()
+	newblock.Height = height
+	newblock.Txs = append(newblock.Txs, minerTx)
+	client.AddTxsToBlock(&newblock, txs)
+	newblock.Difficulty = types.GetP(0).PowLimitBits
+	newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
+	newblock.BlockTime = client.subcfg.GenesisBlockTime + slot*client.subcfg.BlockInterval
+	dlog.Info("dpos create block", "height", height, "slot", slot, "txs", len(newblock.Txs))
+	return client.WriteBlock(lastBlock.StateHash, &newblock)
+}
@@ -0,0 +1,63 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"testing"
+
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	cfg, _ := types.InitCfg("../../../cmd/chain33/chain33.test.toml")
+	types.Init(cfg.Title, cfg)
+}
+
+func TestCalcSlot(t *testing.T) {
+	assert.Equal(t, int64(-1), calcSlot(100, 3, 99))
+	assert.Equal(t, int64(0), calcSlot(100, 3, 100))
+	assert.Equal(t, int64(0), calcSlot(100, 3, 102))
+	assert.Equal(t, int64(1), calcSlot(100, 3, 103))
+	assert.Equal(t, int64(10), calcSlot(100, 3, 131))
+}
+
+func TestSlotProducer(t *testing.T) {
+	delegates := []string{"a", "b", "c"}
+	assert.Equal(t, "a", slotProducer(delegates, 0))
+	assert.Equal(t, "b", slotProducer(delegates, 4))
+	assert.Equal(t, "c", slotProducer(delegates, 5))
+}
+
+func TestCalcMissed(t *testing.T) {
+	delegates := []string{"a", "b", "c"}
+	assert.Nil(t, calcMissed(delegates, 1, 2))
+	assert.Equal(t, []string{"c", "a"}, calcMissed(delegates, 1, 4))
+	//最多统计一轮
+	assert.Equal(t, []string{"b", "c", "a"}, calcMissed(delegates, 0, 100))
+}
+
+func newSlotBlock(client *Client, slot, blocktime int64) *types.BlockDetail {
+	action := &dty.DposAction{Ty: dty.DposActionMiner, Value: &dty.DposAction_Miner{Miner: &dty.DposMiner{Slot: slot}}}
+	tx := &types.Transaction{Execer: []byte(dty.DposX), Payload: types.Encode(action)}
+	return &types.BlockDetail{Block: &types.Block{Height: 1, BlockTime: blocktime, Txs: []*types.Transaction{tx}}}
+}
+
+func TestCheckBlockFuture(t *testing.T) {
+	now := types.Now().Unix()
+	drift := types.GetP(1).FutureBlockTime
+	//本地时间在时间片0的开始，下一个时间片在FutureBlockTime之后
+	client := &Client{subcfg: &subConfig{GenesisBlockTime: now - 1, BlockInterval: drift + 100}}
+	parent := &types.Block{BlockTime: now - 1}
+	assert.Equal(t, types.ErrFutureBlock, client.CheckBlock(parent, newSlotBlock(client, 0, now+drift+1)))
+	assert.Equal(t, types.ErrFutureBlock, client.CheckBlock(parent, newSlotBlock(client, 1, now+drift+99)))
+
+	//本地时间在时间片0的最后，下一个时间片的开始时间没有超过FutureBlockTime，也不能接受
+	assert.True(t, drift > 5)
+	client.subcfg.GenesisBlockTime = now - 95
+	client.subcfg.BlockInterval = 100
+	assert.Equal(t, types.ErrFutureBlock, client.CheckBlock(parent, newSlotBlock(client, 1, now+5)))
+}
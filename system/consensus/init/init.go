@@ -7,5 +7,9 @@ package init
 
 import (
 	//初始化
+	_ "github.com/33cn/chain33/system/consensus/dpos"
+	_ "github.com/33cn/chain33/system/consensus/pbft"
+	_ "github.com/33cn/chain33/system/consensus/raft"
 	_ "github.com/33cn/chain33/system/consensus/solo"
+	_ "github.com/33cn/chain33/system/consensus/tendermint"
 )
@@ -0,0 +1,513 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	pt "github.com/33cn/chain33/system/consensus/pbft/types"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	//maxFutureMsgs 缓存下一个高度的消息个数
+	maxFutureMsgs = 1024
+	//maxCommittedBlocks 保留最近提交的区块，用于检查收到的区块
+	maxCommittedBlocks = 128
+)
+
+//round 一个高度的共识状态，高度就是pbft的序号
+type round struct {
+	height   int64
+	view     int64
+	parent   *types.Block
+	deadline time.Time
+	//proposal 当前视图接受的提议
+	proposal *pt.PbftMessage
+	digest   []byte
+	proposed bool
+	prepared bool
+	//lastPrepared 本节点prepared的最新提议，视图切换的时候带给新的主节点
+	lastPrepared *pt.PbftMessage
+	//reproposal 新视图必须重新提议的区块
+	reproposal     *types.Block
+	committed      bool
+	prepares       map[string]map[string]*pt.PbftMessage
+	commits        map[string]map[string]*pt.PbftMessage
+	viewChanges    map[int64]map[string]*pt.PbftMessage
+	sentViewChange int64
+	//pending 新视图的提议可能先于newView到达
+	pending map[int64]*pt.PbftMessage
+}
+
+//core pbft 状态机，所有的消息和定时器都在同一个goroutine里处理
+type core struct {
+	validators     *validatorSet
+	priv           crypto.PrivKey
+	pubkey         string
+	requestTimeout time.Duration
+	emptyInterval  time.Duration
+	round          *round
+	future         []*pt.PbftMessage
+
+	//broadcast 把消息发送给其他节点
+	broadcast func(msg *pt.PbftMessage)
+	//commit 区块得到2f+1个验证节点的确认，写入区块链
+	commit func(parent, block *types.Block)
+
+	mu        sync.Mutex
+	committed map[int64]*types.Block
+	status    *pt.PbftStatus
+}
+
+func newCore(priv crypto.PrivKey, requestTimeout, emptyInterval time.Duration) *core {
+	c := &core{
+		priv:           priv,
+		requestTimeout: requestTimeout,
+		emptyInterval:  emptyInterval,
+		committed:      make(map[int64]*types.Block),
+		status:         &pt.PbftStatus{},
+	}
+	if priv != nil {
+		c.pubkey = common.ToHex(priv.PubKey().Bytes())
+	}
+	return c
+}
+
+//isValidator 没有配置私钥或者不在验证节点列表中的节点只跟随共识，不发送消息
+func (c *core) isValidator() bool {
+	return c.priv != nil && c.validators.has(c.pubkey)
+}
+
+func (c *core) isPrimary() bool {
+	return c.isValidator() && c.validators.primary(c.round.height, c.round.view) == c.pubkey
+}
+
+//newRound 父区块写入以后开始下一个高度的共识
+func (c *core) newRound(parent *types.Block, validators *validatorSet, now time.Time) {
+	c.validators = validators
+	c.round = &round{
+		height:      parent.Height + 1,
+		parent:      parent,
+		deadline:    now.Add(c.emptyInterval + c.requestTimeout),
+		prepares:    make(map[string]map[string]*pt.PbftMessage),
+		commits:     make(map[string]map[string]*pt.PbftMessage),
+		viewChanges: make(map[int64]map[string]*pt.PbftMessage),
+		pending:     make(map[int64]*pt.PbftMessage),
+	}
+	c.updateStatus()
+	future := c.future
+	c.future = nil
+	for _, msg := range future {
+		c.handleMessage(msg, now)
+	}
+}
+
+//shouldPropose 主节点在有交易的时候，或者空闲超过emptyInterval的时候提议区块，新视图立即提议
+func (c *core) shouldPropose(hasTx bool, now time.Time) bool {
+	if c.round == nil || c.round.proposed || c.round.committed || !c.isPrimary() {
+		return false
+	}
+	if c.round.view > 0 || hasTx {
+		return true
+	}
+	return now.Sub(time.Unix(c.round.parent.BlockTime, 0)) >= c.emptyInterval
+}
+
+//propose 主节点提议区块，新视图中如果有已经prepared的区块，必须重新提议这个区块
+func (c *core) propose(block *types.Block, now time.Time) {
+	if c.round.reproposal != nil {
+		block = c.round.reproposal
+	}
+	c.round.proposed = true
+	msg := &pt.PbftMessage{Value: &pt.PbftMessage_PrePrepare{
+		PrePrepare: &pt.PbftPrePrepare{View: c.round.view, Height: c.round.height, Block: block},
+	}}
+	c.sendMsg(msg, now)
+}
+
+//tick 检查超时，没有在规定时间内提交区块的时候请求切换视图
+func (c *core) tick(now time.Time) {
+	if c.round == nil || c.round.committed {
+		return
+	}
+	if now.After(c.round.deadline) {
+		c.startViewChange(c.round.view+1, now)
+	}
+}
+
+func (c *core) sendMsg(msg *pt.PbftMessage, now time.Time) {
+	if !c.isValidator() {
+		return
+	}
+	signMsg(c.priv, msg)
+	c.broadcast(msg)
+	c.process(c.pubkey, msg, now)
+}
+
+func (c *core) msgHeight(msg *pt.PbftMessage) int64 {
+	switch v := msg.Value.(type) {
+	case *pt.PbftMessage_PrePrepare:
+		return v.PrePrepare.Height
+	case *pt.PbftMessage_Prepare:
+		return v.Prepare.Height
+	case *pt.PbftMessage_Commit:
+		return v.Commit.Height
+	case *pt.PbftMessage_ViewChange:
+		return v.ViewChange.Height
+	case *pt.PbftMessage_NewView:
+		return v.NewView.Height
+	}
+	return -1
+}
+
+//handleMessage 处理其他节点发送的消息
+func (c *core) handleMessage(msg *pt.PbftMessage, now time.Time) error {
+	if c.round == nil {
+		return nil
+	}
+	height := c.msgHeight(msg)
+	if height == c.round.height+1 {
+		if len(c.future) < maxFutureMsgs {
+			c.future = append(c.future, msg)
+		}
+		return nil
+	}
+	if height != c.round.height {
+		return nil
+	}
+	from, err := verifyMsg(c.validators, msg)
+	if err != nil {
+		return err
+	}
+	return c.process(from, msg, now)
+}
+
+func (c *core) process(from string, msg *pt.PbftMessage, now time.Time) error {
+	var err error
+	switch v := msg.Value.(type) {
+	case *pt.PbftMessage_PrePrepare:
+		err = c.onPrePrepare(from, msg, now)
+	case *pt.PbftMessage_Prepare:
+		c.onPrepare(from, msg, now)
+	case *pt.PbftMessage_Commit:
+		c.onCommit(from, msg)
+	case *pt.PbftMessage_ViewChange:
+		err = c.onViewChange(from, msg, now)
+	case *pt.PbftMessage_NewView:
+		err = c.onNewView(from, v.NewView, now)
+	}
+	c.updateStatus()
+	return err
+}
+
+func (c *core) checkProposal(from string, pp *pt.PbftPrePrepare) error {
+	if from != c.validators.primary(pp.Height, pp.View) {
+		return pt.ErrNotPrimary
+	}
+	block := pp.Block
+	parent := c.round.parent
+	if block == nil || block.Height != c.round.height || !bytes.Equal(block.ParentHash, parent.Hash()) {
+		return pt.ErrProposalBlock
+	}
+	if block.BlockTime < parent.BlockTime || len(block.StateHash) != 0 {
+		return pt.ErrProposalBlock
+	}
+	if int64(len(block.Txs)) > types.GetP(block.Height).MaxTxNumber {
+		return types.ErrManyTx
+	}
+	if !bytes.Equal(block.TxHash, merkle.CalcMerkleRoot(block.Txs)) {
+		return types.ErrCheckTxHash
+	}
+	if !block.CheckSign() {
+		return types.ErrSign
+	}
+	if c.round.reproposal != nil && !bytes.Equal(blockDigest(c.round.reproposal), blockDigest(block)) {
+		return pt.ErrProposalBlock
+	}
+	return nil
+}
+
+func (c *core) onPrePrepare(from string, msg *pt.PbftMessage, now time.Time) error {
+	pp := msg.GetPrePrepare()
+	if pp.View > c.round.view {
+		c.round.pending[pp.View] = msg
+		return nil
+	}
+	if pp.View != c.round.view || c.round.proposal != nil {
+		return nil
+	}
+	if err := c.checkProposal(from, pp); err != nil {
+		plog.Error("onPrePrepare", "height", pp.Height, "view", pp.View, "err", err)
+		return err
+	}
+	c.round.proposal = msg
+	c.round.digest = blockDigest(pp.Block)
+	c.sendMsg(&pt.PbftMessage{Value: &pt.PbftMessage_Prepare{
+		Prepare: &pt.PbftVote{View: pp.View, Height: pp.Height, Digest: c.round.digest},
+	}}, now)
+	c.checkPrepared(now)
+	return nil
+}
+
+//addVote 保存签名的投票消息，commit消息用于生成区块的提交证明
+func addVote(votes map[string]map[string]*pt.PbftMessage, vote *pt.PbftVote, from string, msg *pt.PbftMessage) {
+	key := voteKey(vote.View, vote.Digest)
+	if votes[key] == nil {
+		votes[key] = make(map[string]*pt.PbftMessage)
+	}
+	votes[key][from] = msg
+}
+
+func (c *core) onPrepare(from string, msg *pt.PbftMessage, now time.Time) {
+	addVote(c.round.prepares, msg.GetPrepare(), from, msg)
+	c.checkPrepared(now)
+}
+
+func (c *core) onCommit(from string, msg *pt.PbftMessage) {
+	addVote(c.round.commits, msg.GetCommit(), from, msg)
+	c.checkCommitted()
+}
+
+//checkPrepared 收到2f+1个prepare以后进入commit阶段
+func (c *core) checkPrepared(now time.Time) {
+	r := c.round
+	if r.proposal == nil || r.prepared {
+		return
+	}
+	if len(r.prepares[voteKey(r.view, r.digest)]) < c.validators.quorum() {
+		return
+	}
+	r.prepared = true
+	r.lastPrepared = r.proposal
+	c.sendMsg(&pt.PbftMessage{Value: &pt.PbftMessage_Commit{
+		Commit: &pt.PbftVote{View: r.view, Height: r.height, Digest: r.digest},
+	}}, now)
+	c.checkCommitted()
+}
+
+//checkCommitted 收到2f+1个commit以后区块最终确定，不会被回滚，commit的签名做成提交证明写入区块头
+func (c *core) checkCommitted() {
+	r := c.round
+	if !r.prepared || r.committed {
+		return
+	}
+	commits := r.commits[voteKey(r.view, r.digest)]
+	if len(commits) < c.validators.quorum() {
+		return
+	}
+	r.committed = true
+	block := r.proposal.GetPrePrepare().Block
+	c.mu.Lock()
+	c.committed[r.height] = block
+	delete(c.committed, r.height-maxCommittedBlocks)
+	c.mu.Unlock()
+	plog.Info("pbft commit block", "height", r.height, "view", r.view, "txs", len(block.Txs))
+	sig, err := makeCommit(c.validators, commits, block)
+	if err != nil {
+		//没有提交证明的区块不会被接受，等待从其他节点同步这个区块
+		plog.Error("checkCommitted makeCommit", "height", r.height, "view", r.view, "err", err)
+		return
+	}
+	signed := *block
+	signed.Signature = sig
+	c.commit(r.parent, &signed)
+}
+
+func (c *core) startViewChange(view int64, now time.Time) {
+	r := c.round
+	if view <= r.sentViewChange {
+		return
+	}
+	r.sentViewChange = view
+	//超时时间随着视图的增加而增加，保证最终能够有足够的时间完成共识
+	r.deadline = now.Add(c.requestTimeout * time.Duration(view-r.view+1))
+	plog.Info("pbft view change", "height", r.height, "view", view)
+	c.sendMsg(&pt.PbftMessage{Value: &pt.PbftMessage_ViewChange{
+		ViewChange: &pt.PbftViewChange{View: view, Height: r.height, Prepared: r.lastPrepared},
+	}}, now)
+}
+
+//checkViewChange 检查viewChange中带的prepared提议是当时的主节点签名的
+func (c *core) checkViewChange(vc *pt.PbftViewChange) error {
+	if vc.Prepared == nil {
+		return nil
+	}
+	pp := vc.Prepared.GetPrePrepare()
+	if pp == nil || pp.Height != vc.Height || pp.View >= vc.View {
+		return pt.ErrProposalBlock
+	}
+	from, err := verifyMsg(c.validators, vc.Prepared)
+	if err != nil {
+		return err
+	}
+	if from != c.validators.primary(pp.Height, pp.View) {
+		return pt.ErrNotPrimary
+	}
+	return nil
+}
+
+func (c *core) onViewChange(from string, msg *pt.PbftMessage, now time.Time) error {
+	vc := msg.GetViewChange()
+	r := c.round
+	if vc.View <= r.view || r.committed {
+		return nil
+	}
+	if err := c.checkViewChange(vc); err != nil {
+		return err
+	}
+	if r.viewChanges[vc.View] == nil {
+		r.viewChanges[vc.View] = make(map[string]*pt.PbftMessage)
+	}
+	r.viewChanges[vc.View][from] = msg
+	count := len(r.viewChanges[vc.View])
+	//f+1个节点要求切换视图，说明至少有一个正常节点超时，本节点也加入
+	if count > c.validators.faulty() {
+		c.startViewChange(vc.View, now)
+		count = len(r.viewChanges[vc.View])
+	}
+	if count >= c.validators.quorum() {
+		c.enterView(vc.View, now)
+	}
+	return nil
+}
+
+func (c *core) onNewView(from string, nv *pt.PbftNewView, now time.Time) error {
+	if nv.View <= c.round.view || c.round.committed {
+		return nil
+	}
+	if from != c.validators.primary(nv.Height, nv.View) {
+		return pt.ErrNotPrimary
+	}
+	voters := make(map[string]bool)
+	for _, msg := range nv.ViewChanges {
+		vc := msg.GetViewChange()
+		if vc == nil || vc.View != nv.View || vc.Height != nv.Height {
+			return pt.ErrNewView
+		}
+		voter, err := verifyMsg(c.validators, msg)
+		if err != nil {
+			return err
+		}
+		if err := c.checkViewChange(vc); err != nil {
+			return err
+		}
+		voters[voter] = true
+	}
+	if len(voters) < c.validators.quorum() {
+		return pt.ErrNewView
+	}
+	for _, msg := range nv.ViewChanges {
+		voter, _ := verifyMsg(c.validators, msg)
+		if c.round.viewChanges[nv.View] == nil {
+			c.round.viewChanges[nv.View] = make(map[string]*pt.PbftMessage)
+		}
+		c.round.viewChanges[nv.View][voter] = msg
+	}
+	c.enterView(nv.View, now)
+	return nil
+}
+
+//enterView 切换到新的视图，新的主节点广播newView，并且重新提议已经prepared的区块
+func (c *core) enterView(view int64, now time.Time) {
+	r := c.round
+	if view <= r.view {
+		return
+	}
+	var reproposal *pt.PbftPrePrepare
+	var vcs []*pt.PbftMessage
+	for _, msg := range r.viewChanges[view] {
+		vcs = append(vcs, msg)
+		prepared := msg.GetViewChange().GetPrepared().GetPrePrepare()
+		if prepared != nil && (reproposal == nil || prepared.View > reproposal.View) {
+			reproposal = prepared
+		}
+	}
+	r.view = view
+	r.proposal = nil
+	r.digest = nil
+	r.proposed = false
+	r.prepared = false
+	r.reproposal = nil
+	if reproposal != nil {
+		r.reproposal = reproposal.Block
+	}
+	if r.sentViewChange < view {
+		r.sentViewChange = view
+	}
+	r.deadline = now.Add(c.requestTimeout)
+	plog.Info("pbft enter view", "height", r.height, "view", view, "primary", c.isPrimary())
+	if c.isPrimary() {
+		c.sendMsg(&pt.PbftMessage{Value: &pt.PbftMessage_NewView{
+			NewView: &pt.PbftNewView{View: view, Height: r.height, ViewChanges: vcs},
+		}}, now)
+	}
+	if msg, ok := r.pending[view]; ok {
+		delete(r.pending, view)
+		from, err := verifyMsg(c.validators, msg)
+		if err == nil {
+			c.onPrePrepare(from, msg, now)
+		}
+	}
+}
+
+func (c *core) updateStatus() {
+	r := c.round
+	status := &pt.PbftStatus{
+		View:       r.view,
+		Height:     r.height,
+		IsPrimary:  c.isPrimary(),
+		Primary:    c.validators.primary(r.height, r.view),
+		Validators: c.validators.pubkeys,
+	}
+	switch {
+	case r.committed:
+		status.Phase = "committed"
+	case r.prepared:
+		status.Phase = "prepared"
+	case r.proposal != nil:
+		status.Phase = "pre-prepared"
+	default:
+		status.Phase = "idle"
+	}
+	c.mu.Lock()
+	c.status = status
+	c.mu.Unlock()
+}
+
+func (c *core) getStatus() *pt.PbftStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.status
+}
+
+//checkBlock 检查区块和本节点看到的验证节点提交的区块一致，执行失败的交易会被删除，所以只检查交易是提交的区块的子集
+func (c *core) checkBlock(block *types.Block) error {
+	c.mu.Lock()
+	committed := c.committed[block.Height]
+	c.mu.Unlock()
+	if committed == nil {
+		//没有参与这个高度的共识，比如节点同步历史区块，只检查区块头中的提交证明
+		return nil
+	}
+	if !bytes.Equal(block.ParentHash, committed.ParentHash) || block.BlockTime != committed.BlockTime {
+		return pt.ErrBlockNotCommitted
+	}
+	txs := make(map[string]bool)
+	for _, tx := range committed.Txs {
+		txs[string(tx.Hash())] = true
+	}
+	for _, tx := range block.Txs {
+		if !txs[string(tx.Hash())] {
+			return pt.ErrBlockNotCommitted
+		}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	pt "github.com/33cn/chain33/system/consensus/pbft/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	cfg, _ := types.InitCfg("../../../cmd/chain33/chain33.test.toml")
+	types.Init(cfg.Title, cfg)
+}
+
+type testNetwork struct {
+	cores     []*core
+	down      map[int]bool
+	queue     []*pt.PbftMessage
+	committed map[int]*types.Block
+}
+
+func newTestNetwork(t *testing.T, n int, observer bool) *testNetwork {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	net := &testNetwork{down: make(map[int]bool), committed: make(map[int]*types.Block)}
+	var pubkeys []string
+	var privs []crypto.PrivKey
+	for i := 0; i < n; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		privs = append(privs, priv)
+		pubkeys = append(pubkeys, common.ToHex(priv.PubKey().Bytes()))
+	}
+	if observer {
+		privs = append(privs, nil)
+	}
+	parent := &types.Block{Height: 0, BlockTime: time.Now().Unix()}
+	for i := range privs {
+		index := i
+		c := newCore(privs[i], time.Second, time.Minute)
+		c.broadcast = func(msg *pt.PbftMessage) {
+			if !net.down[index] {
+				net.queue = append(net.queue, msg)
+			}
+		}
+		c.commit = func(parent, block *types.Block) {
+			net.committed[index] = block
+		}
+		c.newRound(parent, newValidatorSet(pubkeys), time.Now())
+		net.cores = append(net.cores, c)
+	}
+	return net
+}
+
+func (net *testNetwork) deliver(now time.Time) {
+	for len(net.queue) > 0 {
+		msg := net.queue[0]
+		net.queue = net.queue[1:]
+		data := types.Encode(msg)
+		for i, c := range net.cores {
+			if net.down[i] {
+				continue
+			}
+			var m pt.PbftMessage
+			types.Decode(data, &m)
+			c.handleMessage(&m, now)
+		}
+	}
+}
+
+func (net *testNetwork) primary() int {
+	for i, c := range net.cores {
+		if !net.down[i] && c.isPrimary() {
+			return i
+		}
+	}
+	return -1
+}
+
+func newTestBlock(parent *types.Block) *types.Block {
+	block := &types.Block{ParentHash: parent.Hash(), Height: parent.Height + 1, BlockTime: parent.BlockTime + 1}
+	block.TxHash = merkle.CalcMerkleRoot(block.Txs)
+	return block
+}
+
+func TestValidatorSet(t *testing.T) {
+	vs := newValidatorSet([]string{"a", "b", "c", "d", "a"})
+	assert.Equal(t, 4, vs.size())
+	assert.Equal(t, 1, vs.faulty())
+	assert.Equal(t, 3, vs.quorum())
+	assert.Equal(t, "b", vs.primary(1, 0))
+	assert.Equal(t, "c", vs.primary(1, 1))
+	assert.Equal(t, "a", vs.primary(3, 1))
+}
+
+func TestPbftCommit(t *testing.T) {
+	net := newTestNetwork(t, 4, true)
+	now := time.Now()
+	p := net.primary()
+	assert.True(t, p >= 0)
+	primary := net.cores[p]
+	assert.True(t, primary.shouldPropose(true, now))
+	assert.False(t, primary.shouldPropose(false, now))
+	primary.propose(newTestBlock(primary.round.parent), now)
+	net.deliver(now)
+	//观察节点也能确定区块
+	assert.Equal(t, 5, len(net.committed))
+	digest := blockDigest(net.committed[p])
+	for i := range net.cores {
+		assert.Equal(t, digest, blockDigest(net.committed[i]))
+		assert.Equal(t, "committed", net.cores[i].getStatus().Phase)
+	}
+	block := net.committed[p]
+	assert.Nil(t, net.cores[0].checkBlock(block))
+	other := *block
+	other.BlockTime++
+	assert.Equal(t, pt.ErrBlockNotCommitted, net.cores[0].checkBlock(&other))
+}
+
+func TestPbftCommitCert(t *testing.T) {
+	net := newTestNetwork(t, 4, false)
+	now := time.Now()
+	primary := net.cores[net.primary()]
+	block := newTestBlock(primary.round.parent)
+	for i := 0; i < 2; i++ {
+		tx := &types.Transaction{Execer: []byte("none"), Payload: []byte{byte(i)}, Nonce: int64(i)}
+		tx.Sign(types.SECP256K1, primary.priv)
+		block.Txs = append(block.Txs, tx)
+	}
+	block.TxHash = merkle.CalcMerkleRoot(block.Txs)
+	primary.propose(block, now)
+	net.deliver(now)
+	assert.Equal(t, 4, len(net.committed))
+	vs := primary.validators
+	committed := net.committed[0]
+	assert.Equal(t, int32(types.BlockSignCommit), committed.GetSignature().GetTy())
+	assert.Nil(t, verifyCommit(vs, committed))
+
+	//执行失败的交易被删除以后，提交证明仍然有效
+	removed := *committed
+	removed.Txs = committed.Txs[:1]
+	removed.TxHash = merkle.CalcMerkleRoot(removed.Txs)
+	removed.StateHash = []byte("state")
+	assert.Nil(t, verifyCommit(vs, &removed))
+
+	//修改了区块头
+	other := *committed
+	other.BlockTime++
+	assert.Equal(t, pt.ErrBlockCommit, verifyCommit(vs, &other))
+
+	//没有提交证明
+	other = *committed
+	other.Signature = nil
+	assert.Equal(t, pt.ErrBlockCommit, verifyCommit(vs, &other))
+
+	//增加了提议区块中没有的交易
+	other = *committed
+	tx := &types.Transaction{Execer: []byte("none"), Payload: []byte("other")}
+	tx.Sign(types.SECP256K1, primary.priv)
+	other.Txs = append([]*types.Transaction{tx}, committed.Txs...)
+	assert.Equal(t, pt.ErrBlockNotCommitted, verifyCommit(vs, &other))
+
+	//不足2f+1个commit
+	var cert pt.PbftCommitCert
+	assert.Nil(t, types.Decode(committed.Signature.Signature, &cert))
+	cert.Commits = cert.Commits[:vs.quorum()-1]
+	other = *committed
+	other.Signature = &types.Signature{Ty: types.BlockSignCommit, Signature: types.Encode(&cert)}
+	assert.Equal(t, pt.ErrBlockCommit, verifyCommit(vs, &other))
+
+	//不是验证节点的签名
+	assert.Equal(t, pt.ErrBlockCommit, verifyCommit(newValidatorSet([]string{"a", "b", "c", "d"}), committed))
+}
+
+func TestPbftViewChange(t *testing.T) {
+	net := newTestNetwork(t, 4, false)
+	now := time.Now()
+	p := net.primary()
+	net.down[p] = true
+	//主节点没有出块，超时以后切换视图
+	now = now.Add(2 * time.Minute)
+	for i, c := range net.cores {
+		if !net.down[i] {
+			c.tick(now)
+		}
+	}
+	net.deliver(now)
+	newp := net.primary()
+	assert.True(t, newp >= 0 && newp != p)
+	for i, c := range net.cores {
+		if !net.down[i] {
+			assert.Equal(t, int64(1), c.round.view)
+		}
+	}
+	primary := net.cores[newp]
+	assert.True(t, primary.shouldPropose(false, now))
+	primary.propose(newTestBlock(primary.round.parent), now)
+	net.deliver(now)
+	assert.Equal(t, 3, len(net.committed))
+}
+
+func TestPbftBadMsg(t *testing.T) {
+	net := newTestNetwork(t, 4, false)
+	now := time.Now()
+	p := net.primary()
+	//非主节点的提议
+	other := net.cores[(p+1)%4]
+	msg := &pt.PbftMessage{Value: &pt.PbftMessage_PrePrepare{
+		PrePrepare: &pt.PbftPrePrepare{Height: 1, Block: newTestBlock(other.round.parent)},
+	}}
+	signMsg(other.priv, msg)
+	assert.Equal(t, pt.ErrNotPrimary, net.cores[p].handleMessage(msg, now))
+	//修改了签名以后的内容
+	msg.GetPrePrepare().View = 1
+	assert.Equal(t, pt.ErrMsgSign, net.cores[p].handleMessage(msg, now))
+	//错误的父区块
+	primary := net.cores[p]
+	block := newTestBlock(primary.round.parent)
+	block.ParentHash = []byte("bad")
+	msg = &pt.PbftMessage{Value: &pt.PbftMessage_PrePrepare{PrePrepare: &pt.PbftPrePrepare{Height: 1, Block: block}}}
+	signMsg(primary.priv, msg)
+	assert.Equal(t, pt.ErrProposalBlock, other.handleMessage(msg, now))
+}
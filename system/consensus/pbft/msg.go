@@ -0,0 +1,167 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	pt "github.com/33cn/chain33/system/consensus/pbft/types"
+	"github.com/33cn/chain33/types"
+)
+
+//validatorSet 验证节点集合，按照顺序轮流作为主节点
+type validatorSet struct {
+	pubkeys []string
+	index   map[string]int
+}
+
+func newValidatorSet(pubkeys []string) *validatorSet {
+	vs := &validatorSet{index: make(map[string]int)}
+	for _, pubkey := range pubkeys {
+		if _, ok := vs.index[pubkey]; ok {
+			continue
+		}
+		vs.index[pubkey] = len(vs.pubkeys)
+		vs.pubkeys = append(vs.pubkeys, pubkey)
+	}
+	return vs
+}
+
+func (vs *validatorSet) size() int {
+	return len(vs.pubkeys)
+}
+
+//faulty 最多能够容忍的拜占庭节点个数 f = (n-1)/3
+func (vs *validatorSet) faulty() int {
+	return (vs.size() - 1) / 3
+}
+
+//quorum 2f+1
+func (vs *validatorSet) quorum() int {
+	return 2*vs.faulty() + 1
+}
+
+func (vs *validatorSet) has(pubkey string) bool {
+	_, ok := vs.index[pubkey]
+	return ok
+}
+
+//primary 每个高度的主节点轮换，视图切换的时候由下一个节点接替
+func (vs *validatorSet) primary(height, view int64) string {
+	return vs.pubkeys[(height+view)%int64(vs.size())]
+}
+
+//blockDigest 提议区块的摘要，交易由txHash确定，不包含执行以后才能确定的stateHash和提交证明
+func blockDigest(block *types.Block) []byte {
+	proposal := *block
+	proposal.Txs = nil
+	proposal.StateHash = nil
+	proposal.Signature = nil
+	return common.Sha256(types.Encode(&proposal))
+}
+
+//txRoot 交易hash的merkle根，计算的时候会修改传入的数组，所以使用复制的数组
+func txRoot(hashes [][]byte) []byte {
+	if len(hashes) == 0 {
+		return merkle.CalcMerkleRoot(nil)
+	}
+	return merkle.GetMerkleRoot(append([][]byte{}, hashes...))
+}
+
+func voteKey(view int64, digest []byte) string {
+	return fmt.Sprintf("%d-%x", view, digest)
+}
+
+func signMsg(priv crypto.PrivKey, msg *pt.PbftMessage) {
+	msg.Sig = nil
+	data := types.Encode(msg)
+	msg.Sig = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(data).Bytes(),
+	}
+}
+
+//verifyMsg 检查签名，返回签名的验证节点公钥
+func verifyMsg(vs *validatorSet, msg *pt.PbftMessage) (string, error) {
+	sig := msg.GetSig()
+	if sig == nil {
+		return "", pt.ErrMsgSign
+	}
+	pubkey := common.ToHex(sig.Pubkey)
+	if !vs.has(pubkey) {
+		return "", pt.ErrNotValidator
+	}
+	msg.Sig = nil
+	data := types.Encode(msg)
+	msg.Sig = sig
+	if !types.CheckSign(data, "", sig) {
+		return "", pt.ErrMsgSign
+	}
+	return pubkey, nil
+}
+
+//makeCommit 把2f+1个验证节点对提议区块的commit做成提交证明，按照验证节点的顺序保存
+func makeCommit(vs *validatorSet, commits map[string]*pt.PbftMessage, block *types.Block) (*types.Signature, error) {
+	cert := &pt.PbftCommitCert{}
+	for _, tx := range block.Txs {
+		cert.TxHashes = append(cert.TxHashes, tx.Hash())
+	}
+	for _, pubkey := range vs.pubkeys {
+		if msg, ok := commits[pubkey]; ok && len(cert.Commits) < vs.quorum() {
+			cert.Commits = append(cert.Commits, msg)
+		}
+	}
+	if len(cert.Commits) < vs.quorum() {
+		return nil, pt.ErrBlockCommit
+	}
+	return &types.Signature{Ty: types.BlockSignCommit, Signature: types.Encode(cert)}, nil
+}
+
+//verifyCommit 检查区块头中的提交证明，2f+1个验证节点在同一个视图commit的必须是这个区块对应的提议区块，
+//执行失败的交易会被删除，所以区块的交易只需要是提议区块交易的子集，没有提交证明的区块不接受
+func verifyCommit(vs *validatorSet, block *types.Block) error {
+	sig := block.GetSignature()
+	if sig == nil || sig.Ty != types.BlockSignCommit {
+		return pt.ErrBlockCommit
+	}
+	var cert pt.PbftCommitCert
+	if err := types.Decode(sig.Signature, &cert); err != nil || len(cert.Commits) == 0 {
+		return pt.ErrBlockCommit
+	}
+	proposal := *block
+	proposal.TxHash = txRoot(cert.TxHashes)
+	digest := blockDigest(&proposal)
+	view := cert.Commits[0].GetCommit().GetView()
+	signers := make(map[string]bool)
+	for _, msg := range cert.Commits {
+		vote := msg.GetCommit()
+		if vote == nil || vote.View != view || vote.Height != block.Height || !bytes.Equal(vote.Digest, digest) {
+			return pt.ErrBlockCommit
+		}
+		from, err := verifyMsg(vs, msg)
+		if err != nil {
+			return pt.ErrBlockCommit
+		}
+		signers[from] = true
+	}
+	if len(signers) < vs.quorum() {
+		return pt.ErrBlockCommit
+	}
+	txs := make(map[string]bool)
+	for _, hash := range cert.TxHashes {
+		txs[string(hash)] = true
+	}
+	for _, tx := range block.Txs {
+		if !txs[string(tx.Hash())] {
+			return pt.ErrBlockNotCommitted
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pbft 实用拜占庭容错共识，适用于验证节点固定或者由manage合约管理的联盟链
+package pbft
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	pt "github.com/33cn/chain33/system/consensus/pbft/types"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+)
+
+var plog = log.New("module", "pbft")
+
+const (
+	driverName = "pbft"
+	//tickInterval 状态机检查超时和出块的间隔
+	tickInterval = 100 * time.Millisecond
+)
+
+//Client 客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg *subConfig
+	core   *core
+	msgs   chan *pt.PbftMessage
+}
+
+func init() {
+	drivers.Reg(driverName, New)
+	drivers.QueryData.Register(driverName, &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	//Validators 验证节点的公钥(hex)，按照顺序轮流作为主节点
+	Validators []string `json:"validators"`
+	//ValidatorsKey 不为空的时候，从manage合约的这个配置项读取验证节点，没有配置的时候使用Validators
+	ValidatorsKey string `json:"validatorsKey"`
+	//PrivKey 本节点验证节点的私钥，为空表示只跟随共识
+	PrivKey string `json:"privKey"`
+	//RequestTimeoutMs 没有在这个时间内提交区块的时候切换视图
+	RequestTimeoutMs int64 `json:"requestTimeoutMs"`
+	//EmptyBlockIntervalMs 没有交易的时候，主节点出空块的间隔
+	EmptyBlockIntervalMs int64 `json:"emptyBlockIntervalMs"`
+}
+
+//New new
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	if subcfg.RequestTimeoutMs <= 0 {
+		subcfg.RequestTimeoutMs = 5000
+	}
+	if subcfg.EmptyBlockIntervalMs <= 0 {
+		subcfg.EmptyBlockIntervalMs = 30000
+	}
+	var priv crypto.PrivKey
+	if subcfg.PrivKey != "" {
+		var err error
+		priv, err = loadPrivKey(subcfg.PrivKey)
+		if err != nil {
+			panic(err)
+		}
+	}
+	client := &Client{
+		BaseClient: c,
+		subcfg:     &subcfg,
+		core: newCore(priv, time.Duration(subcfg.RequestTimeoutMs)*time.Millisecond,
+			time.Duration(subcfg.EmptyBlockIntervalMs)*time.Millisecond),
+		msgs: make(chan *pt.PbftMessage, 4096),
+	}
+	client.core.broadcast = client.broadcast
+	client.core.commit = client.commitBlock
+	c.SetChild(client)
+	drivers.QueryData.SetThis(driverName, reflect.ValueOf(client))
+	return client
+}
+
+func loadPrivKey(key string) (crypto.PrivKey, error) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	bkey, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(bkey)
+}
+
+//Close close
+func (client *Client) Close() {
+	plog.Info("consensus pbft closed")
+}
+
+//GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+//CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+//ProcEvent 接收p2p转发的共识消息
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	if msg.Ty != types.EventConsensusMsg {
+		return false
+	}
+	data := msg.GetData().(*types.P2PConsensus)
+	if data.Driver != driverName {
+		return true
+	}
+	var pmsg pt.PbftMessage
+	err := types.Decode(data.Data, &pmsg)
+	if err != nil {
+		plog.Error("ProcEvent", "decode err", err)
+		return true
+	}
+	select {
+	case client.msgs <- &pmsg:
+	default:
+		plog.Error("ProcEvent", "msg dropped", "queue full")
+	}
+	return true
+}
+
+//CheckBlock 区块必须带有2f+1个验证节点的提交证明，并且和本节点看到的验证节点提交的区块一致
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	if err := verifyCommit(client.getValidators(parent), current.Block); err != nil {
+		return err
+	}
+	return client.core.checkBlock(current.Block)
+}
+
+func (client *Client) broadcast(msg *pt.PbftMessage) {
+	err := client.BroadcastConsensus(driverName, types.Encode(msg))
+	if err != nil {
+		plog.Error("broadcast", "err", err)
+	}
+}
+
+//commitBlock 区块最终确定以后写入区块链，写入的区块会被blockchain修改，所以使用复制的区块
+func (client *Client) commitBlock(parent, block *types.Block) {
+	var newblock types.Block
+	types.Decode(types.Encode(block), &newblock)
+	err := client.WriteBlock(parent.StateHash, &newblock)
+	if err != nil {
+		plog.Error("commitBlock", "height", block.Height, "err", err)
+	}
+}
+
+func (client *Client) getValidators(parent *types.Block) *validatorSet {
+	if client.subcfg.ValidatorsKey != "" {
+		validators, err := client.GetManageConfig(client.subcfg.ValidatorsKey, parent.StateHash)
+		if err != nil {
+			plog.Error("getValidators", "err", err)
+		} else if len(validators) > 0 {
+			return newValidatorSet(validators)
+		}
+	}
+	return newValidatorSet(client.subcfg.Validators)
+}
+
+func (client *Client) createBlock(parent *types.Block) *types.Block {
+	txs := client.RequestBlockCandidate(&types.ReqBlockCandidate{
+		Height:   parent.Height + 1,
+		MaxCount: client.GetMaxTxNumber(parent),
+	})
+	var newblock types.Block
+	newblock.ParentHash = parent.Hash()
+	newblock.Height = parent.Height + 1
+	client.AddTxsToBlock(&newblock, txs)
+	newblock.Difficulty = types.GetP(0).PowLimitBits
+	newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
+	newblock.BlockTime = types.Now().Unix()
+	if parent.BlockTime > newblock.BlockTime {
+		newblock.BlockTime = parent.BlockTime
+	}
+	return &newblock
+}
+
+//CreateBlock pbft 状态机的主循环
+func (client *Client) CreateBlock() {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	for {
+		if client.IsClosed() {
+			break
+		}
+		select {
+		case msg := <-client.msgs:
+			if client.core.round == nil {
+				continue
+			}
+			err := client.core.handleMessage(msg, types.Now())
+			if err != nil {
+				plog.Debug("handleMessage", "err", err)
+			}
+		case <-ticker.C:
+			client.onTick()
+		}
+	}
+}
+
+func (client *Client) onTick() {
+	if !client.IsCaughtUp() {
+		return
+	}
+	now := types.Now()
+	parent := client.GetCurrentBlock()
+	if client.core.round == nil || client.core.round.height != parent.Height+1 {
+		client.core.newRound(parent, client.getValidators(parent), now)
+	}
+	if client.core.shouldPropose(client.hasTx(), now) {
+		client.core.propose(client.createBlock(parent), now)
+	}
+	client.core.tick(now)
+}
+
+func (client *Client) hasTx() bool {
+	txs := client.RequestTx(1, nil)
+	return len(txs) > 0
+}
+
+//Query_GetPbftStatus 获取当前的共识状态
+func (client *Client) Query_GetPbftStatus(req *types.ReqNil) (types.Message, error) {
+	if client.core == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	return client.core.getStatus(), nil
+}
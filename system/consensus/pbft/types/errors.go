@@ -0,0 +1,24 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrNotValidator 消息不是验证节点签名的
+	ErrNotValidator = errors.New("ErrNotValidator")
+	// ErrMsgSign 消息签名错误
+	ErrMsgSign = errors.New("ErrMsgSign")
+	// ErrNotPrimary 提议不是由当前视图的主节点发出的
+	ErrNotPrimary = errors.New("ErrNotPrimary")
+	// ErrProposalBlock 提议的区块不合法
+	ErrProposalBlock = errors.New("ErrProposalBlock")
+	// ErrNewView newView消息中的viewChange不足
+	ErrNewView = errors.New("ErrNewView")
+	// ErrBlockNotCommitted 区块和验证节点提交的区块不一致
+	ErrBlockNotCommitted = errors.New("ErrBlockNotCommitted")
+	// ErrBlockCommit 区块头中没有提交证明或者提交证明不正确
+	ErrBlockCommit = errors.New("ErrBlockCommit")
+)
@@ -0,0 +1,157 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/common/metrics"
+)
+
+const (
+	//minStatsSamples 最近应该出块的次数少于这个值的时候不报警
+	minStatsSamples = 10
+)
+
+var (
+	statsMu  sync.Mutex
+	allStats = make(map[string]*ProposerStats)
+)
+
+func init() {
+	metrics.RegisterCollector(WriteMetrics)
+}
+
+//ProposerStat 一个出块节点的统计，Recent开头的是最近window次应该出块的统计
+type ProposerStat struct {
+	Proposer       string
+	Expected       int64
+	Produced       int64
+	RecentExpected int64
+	RecentMissed   int64
+}
+
+//MissRate 最近的漏块率
+func (s *ProposerStat) MissRate() float64 {
+	if s.RecentExpected == 0 {
+		return 0
+	}
+	return float64(s.RecentMissed) / float64(s.RecentExpected)
+}
+
+type proposerStat struct {
+	ProposerStat
+	//recent 最近window次是否出块的环形缓冲
+	recent []bool
+	next   int
+}
+
+//ProposerStats 统计每个出块节点应该出块和实际出块的次数，漏块率过高的时候报警，用于发现审查交易或者宕机的节点
+type ProposerStats struct {
+	mu          sync.Mutex
+	name        string
+	window      int
+	warnPercent int64
+	stats       map[string]*proposerStat
+}
+
+//NewProposerStats 创建出块统计，同名的统计会替换之前的，warnPercent为0表示不报警
+func NewProposerStats(name string, window int, warnPercent int64) *ProposerStats {
+	if window <= 0 {
+		window = 100
+	}
+	s := &ProposerStats{
+		name:        name,
+		window:      window,
+		warnPercent: warnPercent,
+		stats:       make(map[string]*proposerStat),
+	}
+	statsMu.Lock()
+	allStats[name] = s
+	statsMu.Unlock()
+	return s
+}
+
+//Record 记录一次轮到proposer出块，produced表示是否出块
+func (s *ProposerStats) Record(proposer string, produced bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stat, ok := s.stats[proposer]
+	if !ok {
+		stat = &proposerStat{ProposerStat: ProposerStat{Proposer: proposer}, recent: make([]bool, 0, s.window)}
+		s.stats[proposer] = stat
+	}
+	stat.Expected++
+	if produced {
+		stat.Produced++
+	}
+	if len(stat.recent) < s.window {
+		stat.recent = append(stat.recent, produced)
+		stat.RecentExpected++
+	} else {
+		if !stat.recent[stat.next] {
+			stat.RecentMissed--
+		}
+		stat.recent[stat.next] = produced
+		stat.next = (stat.next + 1) % s.window
+	}
+	if !produced {
+		stat.RecentMissed++
+		if s.warnPercent > 0 && stat.RecentExpected >= minStatsSamples && stat.RecentMissed*100 > stat.RecentExpected*s.warnPercent {
+			tlog.Warn("proposer miss rate too high", "consensus", s.name, "proposer", proposer,
+				"missed", stat.RecentMissed, "expected", stat.RecentExpected)
+		}
+	}
+}
+
+//List 按出块节点排序的统计
+func (s *ProposerStats) List() []*ProposerStat {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]*ProposerStat, 0, len(s.stats))
+	for _, stat := range s.stats {
+		copied := stat.ProposerStat
+		list = append(list, &copied)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Proposer < list[j].Proposer })
+	return list
+}
+
+//WriteMetrics 按prometheus的文本格式输出所有的出块统计
+func WriteMetrics(w io.Writer) {
+	statsMu.Lock()
+	names := make([]string, 0, len(allStats))
+	for name := range allStats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lists := make([][]*ProposerStat, len(names))
+	for i, name := range names {
+		lists[i] = allStats[name].List()
+	}
+	statsMu.Unlock()
+	metrics := []struct {
+		name, help, ty string
+		value          func(*ProposerStat) string
+	}{
+		{"chain33_proposer_expected_blocks_total", "Blocks the proposer was expected to produce.", "counter",
+			func(s *ProposerStat) string { return fmt.Sprint(s.Expected) }},
+		{"chain33_proposer_produced_blocks_total", "Blocks the proposer actually produced.", "counter",
+			func(s *ProposerStat) string { return fmt.Sprint(s.Produced) }},
+		{"chain33_proposer_miss_rate", "Recent miss rate of the proposer.", "gauge",
+			func(s *ProposerStat) string { return fmt.Sprintf("%g", s.MissRate()) }},
+	}
+	for _, m := range metrics {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.ty)
+		for i, name := range names {
+			for _, stat := range lists[i] {
+				fmt.Fprintf(w, "%s{consensus=%q,proposer=%q} %s\n", m.name, name, stat.Proposer, m.value(stat))
+			}
+		}
+	}
+}
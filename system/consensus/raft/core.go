@@ -0,0 +1,368 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"bytes"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	rt "github.com/33cn/chain33/system/consensus/raft/types"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	roleFollower  = "follower"
+	roleCandidate = "candidate"
+	roleLeader    = "leader"
+)
+
+//core raft 状态机，区块就是raft的日志，区块高度就是日志的索引，
+//每次最多只有一个没有提交的区块，所有的消息和定时器都在同一个goroutine里处理
+type core struct {
+	members         *memberSet
+	priv            crypto.PrivKey
+	pubkey          string
+	heartbeat       time.Duration
+	electionTimeout time.Duration
+	rand            *rand.Rand
+
+	term     int64
+	votedFor string
+	role     string
+	leader   string
+	votes    map[string]bool
+	deadline time.Time
+	lastSend time.Time
+
+	parent  *types.Block
+	pending *types.Block
+	acks    map[string]bool
+	//lastCommit leader最近提交的区块，通过心跳通知follower
+	lastCommitHeight int64
+	lastCommitDigest []byte
+
+	//broadcast 把消息发送给其他节点
+	broadcast func(msg *rt.RaftMessage)
+	//commit 把区块写入区块链，返回写入以后的区块，失败的时候返回nil
+	commit func(parent, block *types.Block) *types.Block
+	//save 为空表示不保存任期和投票，saved 是最近保存的状态
+	save  func(state *rt.RaftState) error
+	saved rt.RaftState
+
+	mu     sync.Mutex
+	status *rt.RaftStatus
+}
+
+func newCore(priv crypto.PrivKey, heartbeat, electionTimeout time.Duration) *core {
+	c := &core{
+		priv:            priv,
+		heartbeat:       heartbeat,
+		electionTimeout: electionTimeout,
+		rand:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		role:            roleFollower,
+		status:          &rt.RaftStatus{},
+	}
+	if priv != nil {
+		c.pubkey = common.ToHex(priv.PubKey().Bytes())
+	}
+	return c
+}
+
+//setState 恢复重启之前保存的任期和投票
+func (c *core) setState(state *rt.RaftState) {
+	c.term = state.Term
+	c.votedFor = state.VotedFor
+	c.saved = rt.RaftState{Term: state.Term, VotedFor: state.VotedFor}
+}
+
+//saveState 任期和投票变化以后，发送消息之前先写入磁盘，重启以后不会在同一个任期再投给其他节点
+func (c *core) saveState() error {
+	if c.save == nil || (c.term == c.saved.Term && c.votedFor == c.saved.VotedFor) {
+		return nil
+	}
+	state := rt.RaftState{Term: c.term, VotedFor: c.votedFor}
+	if err := c.save(&state); err != nil {
+		return err
+	}
+	c.saved = state
+	return nil
+}
+
+//isMember 没有配置私钥或者不是成员的节点只跟随leader提交区块
+func (c *core) isMember() bool {
+	return c.priv != nil && c.members.has(c.pubkey)
+}
+
+//resetDeadline 选举超时在 [electionTimeout, 2*electionTimeout) 之间随机，减少同时发起选举的可能
+func (c *core) resetDeadline(now time.Time) {
+	c.deadline = now.Add(c.electionTimeout + time.Duration(c.rand.Int63n(int64(c.electionTimeout))))
+}
+
+//setHead 区块链的最新区块变化的时候调用，成员变化在下一个区块生效
+func (c *core) setHead(parent *types.Block, members *memberSet, now time.Time) {
+	if c.parent == nil {
+		c.resetDeadline(now)
+	}
+	c.parent = parent
+	c.members = members
+	if c.pending != nil && c.pending.Height <= parent.Height {
+		c.pending = nil
+	}
+	if c.role != roleFollower && !c.isMember() {
+		c.becomeFollower(c.term, "", now)
+	}
+	c.updateStatus()
+}
+
+func (c *core) lastHeight() int64 {
+	if c.pending != nil {
+		return c.pending.Height
+	}
+	return c.parent.Height
+}
+
+func (c *core) send(to string, msg *rt.RaftMessage) {
+	if !c.isMember() {
+		return
+	}
+	if err := c.saveState(); err != nil {
+		rlog.Error("send saveState", "term", c.term, "err", err)
+		return
+	}
+	msg.To = to
+	signMsg(c.priv, msg)
+	c.broadcast(msg)
+}
+
+func (c *core) becomeFollower(term int64, leader string, now time.Time) {
+	if term > c.term {
+		c.votedFor = ""
+	}
+	c.term = term
+	c.role = roleFollower
+	c.leader = leader
+	c.acks = nil
+	c.resetDeadline(now)
+}
+
+func (c *core) startElection(now time.Time) {
+	c.term++
+	c.role = roleCandidate
+	c.leader = ""
+	c.votedFor = c.pubkey
+	c.votes = map[string]bool{c.pubkey: true}
+	c.resetDeadline(now)
+	rlog.Info("raft start election", "term", c.term, "lastHeight", c.lastHeight())
+	c.send("", &rt.RaftMessage{Value: &rt.RaftMessage_VoteRequest{
+		VoteRequest: &rt.RaftVoteRequest{Term: c.term, LastHeight: c.lastHeight()},
+	}})
+	c.checkVotes(now)
+}
+
+func (c *core) checkVotes(now time.Time) {
+	if c.role != roleCandidate || len(c.votes) < c.members.quorum() {
+		return
+	}
+	c.role = roleLeader
+	c.leader = c.pubkey
+	c.lastCommitHeight = 0
+	c.lastCommitDigest = nil
+	rlog.Info("raft become leader", "term", c.term, "height", c.lastHeight())
+	//新的leader复制自己还没有提交的区块，提交以后前一个任期的区块也就确定了
+	c.acks = map[string]bool{c.pubkey: true}
+	c.sendAppend(now)
+	c.checkCommit(now)
+}
+
+func (c *core) sendAppend(now time.Time) {
+	c.lastSend = now
+	c.send("", &rt.RaftMessage{Value: &rt.RaftMessage_Append{
+		Append: &rt.RaftAppend{
+			Term:         c.term,
+			Block:        c.pending,
+			CommitHeight: c.lastCommitHeight,
+			CommitDigest: c.lastCommitDigest,
+		},
+	}})
+}
+
+//tick leader 有交易的时候复制新的区块，并且定时发送心跳，follower 超时以后发起选举
+func (c *core) tick(now time.Time, hasTx bool, createBlock func(parent *types.Block) *types.Block) {
+	if c.parent == nil || !c.isMember() {
+		return
+	}
+	if c.role != roleLeader {
+		if now.After(c.deadline) {
+			c.startElection(now)
+		}
+		c.updateStatus()
+		return
+	}
+	if c.pending == nil && hasTx {
+		c.pending = createBlock(c.parent)
+		signBlock(c.priv, c.pending)
+		c.acks = map[string]bool{c.pubkey: true}
+		c.sendAppend(now)
+		c.checkCommit(now)
+	} else if now.Sub(c.lastSend) >= c.heartbeat {
+		c.sendAppend(now)
+	}
+	c.updateStatus()
+}
+
+//checkCommit 超过半数的节点收到区块以后提交
+func (c *core) checkCommit(now time.Time) {
+	if c.role != roleLeader || c.pending == nil || len(c.acks) < c.members.quorum() {
+		return
+	}
+	block := c.pending
+	digest := blockDigest(block)
+	head := c.commit(c.parent, block)
+	c.pending = nil
+	c.acks = nil
+	if head == nil {
+		return
+	}
+	c.parent = head
+	c.lastCommitHeight = block.Height
+	c.lastCommitDigest = digest
+	c.sendAppend(now)
+}
+
+func msgTerm(msg *rt.RaftMessage) int64 {
+	switch v := msg.Value.(type) {
+	case *rt.RaftMessage_VoteRequest:
+		return v.VoteRequest.Term
+	case *rt.RaftMessage_VoteReply:
+		return v.VoteReply.Term
+	case *rt.RaftMessage_Append:
+		return v.Append.Term
+	case *rt.RaftMessage_AppendReply:
+		return v.AppendReply.Term
+	}
+	return 0
+}
+
+//handleMessage 处理其他节点发送的消息
+func (c *core) handleMessage(msg *rt.RaftMessage, now time.Time) error {
+	if c.parent == nil || (msg.To != "" && msg.To != c.pubkey) {
+		return nil
+	}
+	from, err := verifyMsg(c.members, msg)
+	if err != nil {
+		return err
+	}
+	if from == c.pubkey {
+		return nil
+	}
+	if term := msgTerm(msg); term > c.term {
+		c.becomeFollower(term, "", now)
+	}
+	switch v := msg.Value.(type) {
+	case *rt.RaftMessage_VoteRequest:
+		c.onVoteRequest(from, v.VoteRequest, now)
+	case *rt.RaftMessage_VoteReply:
+		if c.role == roleCandidate && v.VoteReply.Term == c.term && v.VoteReply.Granted {
+			c.votes[from] = true
+			c.checkVotes(now)
+		}
+	case *rt.RaftMessage_Append:
+		err = c.onAppend(from, v.Append, now)
+	case *rt.RaftMessage_AppendReply:
+		reply := v.AppendReply
+		if c.role == roleLeader && reply.Term == c.term && reply.Success &&
+			c.pending != nil && reply.Height == c.pending.Height {
+			c.acks[from] = true
+			c.checkCommit(now)
+		}
+	}
+	c.updateStatus()
+	return err
+}
+
+//onVoteRequest 每个任期只投一票，并且只投给区块不比自己少的候选节点
+func (c *core) onVoteRequest(from string, req *rt.RaftVoteRequest, now time.Time) {
+	granted := req.Term == c.term && (c.votedFor == "" || c.votedFor == from) && req.LastHeight >= c.lastHeight()
+	if granted {
+		c.votedFor = from
+		c.resetDeadline(now)
+	}
+	c.send(from, &rt.RaftMessage{Value: &rt.RaftMessage_VoteReply{
+		VoteReply: &rt.RaftVoteReply{Term: c.term, Granted: granted},
+	}})
+}
+
+//checkBlock 区块必须是成员节点签名的，新的leader会复制前一个任期的leader签名的区块
+func (c *core) checkBlock(block *types.Block) error {
+	if block.Height != c.parent.Height+1 || !bytes.Equal(block.ParentHash, c.parent.Hash()) {
+		return rt.ErrAppendBlock
+	}
+	if len(block.StateHash) != 0 || !bytes.Equal(block.TxHash, merkle.CalcMerkleRoot(block.Txs)) {
+		return rt.ErrAppendBlock
+	}
+	_, err := verifyBlock(c.members, block)
+	return err
+}
+
+func (c *core) onAppend(from string, req *rt.RaftAppend, now time.Time) error {
+	if req.Term < c.term {
+		c.send(from, &rt.RaftMessage{Value: &rt.RaftMessage_AppendReply{
+			AppendReply: &rt.RaftAppendReply{Term: c.term},
+		}})
+		return nil
+	}
+	if c.role != roleFollower || c.leader != from {
+		c.becomeFollower(req.Term, from, now)
+	}
+	c.resetDeadline(now)
+	//先提交leader已经提交的区块，新的区块的父区块就是这个区块
+	if c.pending != nil && req.CommitHeight == c.pending.Height && bytes.Equal(req.CommitDigest, blockDigest(c.pending)) {
+		block := c.pending
+		c.pending = nil
+		if head := c.commit(c.parent, block); head != nil {
+			c.parent = head
+		}
+	}
+	if req.Block == nil {
+		return nil
+	}
+	reply := &rt.RaftAppendReply{Term: c.term, Height: req.Block.Height}
+	if req.Block.Height == c.parent.Height && req.CommitHeight < req.Block.Height {
+		//已经通过区块同步提交了这个区块
+		reply.Success = true
+	} else if err := c.checkBlock(req.Block); err == nil {
+		c.pending = req.Block
+		reply.Success = true
+	}
+	c.send(from, &rt.RaftMessage{Value: &rt.RaftMessage_AppendReply{AppendReply: reply}})
+	if !reply.Success {
+		return rt.ErrAppendBlock
+	}
+	return nil
+}
+
+func (c *core) updateStatus() {
+	status := &rt.RaftStatus{
+		Term:    c.term,
+		Role:    c.role,
+		Leader:  c.leader,
+		Height:  c.parent.Height,
+		Members: c.members.pubkeys,
+	}
+	c.mu.Lock()
+	c.status = status
+	c.mu.Unlock()
+}
+
+func (c *core) getStatus() *rt.RaftStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.status
+}
@@ -0,0 +1,261 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	rt "github.com/33cn/chain33/system/consensus/raft/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	cfg, _ := types.InitCfg("../../../cmd/chain33/chain33.test.toml")
+	types.Init(cfg.Title, cfg)
+}
+
+type testNetwork struct {
+	cores     []*core
+	down      map[int]bool
+	queue     []*rt.RaftMessage
+	committed map[int][]*types.Block
+}
+
+func newTestNetwork(t *testing.T, n int, observer bool) *testNetwork {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	net := &testNetwork{down: make(map[int]bool), committed: make(map[int][]*types.Block)}
+	var pubkeys []string
+	var privs []crypto.PrivKey
+	for i := 0; i < n; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		privs = append(privs, priv)
+		pubkeys = append(pubkeys, common.ToHex(priv.PubKey().Bytes()))
+	}
+	if observer {
+		privs = append(privs, nil)
+	}
+	parent := &types.Block{Height: 0, BlockTime: time.Now().Unix(), StateHash: []byte("genesis")}
+	for i := range privs {
+		index := i
+		c := newCore(privs[i], 100*time.Millisecond, time.Second)
+		c.broadcast = func(msg *rt.RaftMessage) {
+			if !net.down[index] {
+				net.queue = append(net.queue, msg)
+			}
+		}
+		c.commit = func(parent, block *types.Block) *types.Block {
+			head := *block
+			head.StateHash = []byte("state")
+			net.committed[index] = append(net.committed[index], &head)
+			return &head
+		}
+		c.setHead(parent, newMemberSet(pubkeys), time.Now())
+		net.cores = append(net.cores, c)
+	}
+	return net
+}
+
+func (net *testNetwork) deliver(now time.Time) {
+	for len(net.queue) > 0 {
+		msg := net.queue[0]
+		net.queue = net.queue[1:]
+		data := types.Encode(msg)
+		for i, c := range net.cores {
+			if net.down[i] {
+				continue
+			}
+			var m rt.RaftMessage
+			types.Decode(data, &m)
+			c.handleMessage(&m, now)
+		}
+	}
+}
+
+func (net *testNetwork) leader() int {
+	for i, c := range net.cores {
+		if !net.down[i] && c.role == roleLeader {
+			return i
+		}
+	}
+	return -1
+}
+
+func createTestBlock(parent *types.Block) *types.Block {
+	block := &types.Block{ParentHash: parent.Hash(), Height: parent.Height + 1, BlockTime: parent.BlockTime + 1}
+	block.TxHash = merkle.CalcMerkleRoot(block.Txs)
+	return block
+}
+
+func TestRaftElectionAndCommit(t *testing.T) {
+	net := newTestNetwork(t, 3, true)
+	now := time.Now().Add(3 * time.Second)
+	net.cores[0].tick(now, false, createTestBlock)
+	net.deliver(now)
+	assert.Equal(t, 0, net.leader())
+	assert.Equal(t, int64(1), net.cores[0].term)
+	for i := 1; i < len(net.cores); i++ {
+		assert.Equal(t, roleFollower, net.cores[i].role)
+		assert.Equal(t, net.cores[0].pubkey, net.cores[i].leader)
+	}
+	//leader 有交易的时候复制区块，超过半数收到以后提交
+	net.cores[0].tick(now, true, createTestBlock)
+	net.deliver(now)
+	for i := range net.cores {
+		assert.Equal(t, 1, len(net.committed[i]))
+		assert.Equal(t, int64(1), net.cores[i].parent.Height)
+		assert.Nil(t, net.cores[i].pending)
+	}
+	assert.Equal(t, "leader", net.cores[0].getStatus().Role)
+}
+
+func TestRaftLeaderDown(t *testing.T) {
+	net := newTestNetwork(t, 3, false)
+	now := time.Now().Add(3 * time.Second)
+	net.cores[0].tick(now, false, createTestBlock)
+	net.deliver(now)
+	assert.Equal(t, 0, net.leader())
+
+	//区块只复制给了一半的节点，leader就停止了
+	net.cores[0].tick(now, true, createTestBlock)
+	net.down[2] = true
+	net.queue = net.queue[:1]
+	net.cores[1].handleMessage(net.queue[0], now)
+	net.queue = nil
+	net.down[0] = true
+	net.down[2] = false
+	assert.NotNil(t, net.cores[1].pending)
+
+	//没有收到区块的节点不能当选
+	now = now.Add(3 * time.Second)
+	net.cores[2].tick(now, false, createTestBlock)
+	net.deliver(now)
+	assert.Equal(t, -1, net.leader())
+
+	now = now.Add(3 * time.Second)
+	net.cores[1].tick(now, false, createTestBlock)
+	net.deliver(now)
+	assert.Equal(t, 1, net.leader())
+	//新的leader提交前一个任期复制的区块
+	assert.Equal(t, 1, len(net.committed[1]))
+	assert.Equal(t, 1, len(net.committed[2]))
+}
+
+func TestRaftBadMsg(t *testing.T) {
+	net := newTestNetwork(t, 3, false)
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	msg := &rt.RaftMessage{Value: &rt.RaftMessage_VoteRequest{VoteRequest: &rt.RaftVoteRequest{Term: 10}}}
+	signMsg(priv, msg)
+	assert.Equal(t, rt.ErrNotMember, net.cores[0].handleMessage(msg, time.Now()))
+	signMsg(net.cores[1].priv, msg)
+	msg.GetVoteRequest().Term = 11
+	assert.Equal(t, rt.ErrMsgSign, net.cores[0].handleMessage(msg, time.Now()))
+}
+
+func TestRaftBlockSign(t *testing.T) {
+	net := newTestNetwork(t, 3, false)
+	now := time.Now().Add(3 * time.Second)
+	net.cores[0].tick(now, false, createTestBlock)
+	net.deliver(now)
+	net.cores[0].tick(now, true, createTestBlock)
+	net.deliver(now)
+	//写入的区块增加了stateHash，仍然可以检查leader的签名
+	block := net.committed[1][0]
+	signer, err := verifyBlock(net.cores[1].members, block)
+	assert.Nil(t, err)
+	assert.Equal(t, net.cores[0].pubkey, signer)
+
+	bad := *block
+	bad.BlockTime++
+	_, err = verifyBlock(net.cores[1].members, &bad)
+	assert.Equal(t, rt.ErrBlockSign, err)
+	bad = *block
+	bad.Signature = nil
+	_, err = verifyBlock(net.cores[1].members, &bad)
+	assert.Equal(t, rt.ErrBlockSign, err)
+
+	//不是成员节点签名的区块不能复制
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	next := createTestBlock(net.cores[1].parent)
+	signBlock(priv, next)
+	assert.Equal(t, rt.ErrNotMember, net.cores[1].checkBlock(next))
+	signBlock(net.cores[2].priv, next)
+	assert.Nil(t, net.cores[1].checkBlock(next))
+}
+
+func TestRaftSaveState(t *testing.T) {
+	net := newTestNetwork(t, 3, false)
+	var saved []*rt.RaftState
+	var saveErr error
+	for _, c := range net.cores {
+		c.save = func(state *rt.RaftState) error {
+			if saveErr != nil {
+				return saveErr
+			}
+			saved = append(saved, state)
+			return nil
+		}
+	}
+	now := time.Now().Add(3 * time.Second)
+	net.cores[0].tick(now, false, createTestBlock)
+	net.deliver(now)
+	assert.Equal(t, 0, net.leader())
+	//候选节点和投票的节点都在发送消息之前保存了任期和投票
+	assert.Equal(t, 3, len(saved))
+	for _, state := range saved {
+		assert.Equal(t, int64(1), state.Term)
+		assert.Equal(t, net.cores[0].pubkey, state.VotedFor)
+	}
+
+	//重启以后恢复投票，同一个任期不能再投给其他节点
+	restart := newCore(net.cores[2].priv, 100*time.Millisecond, time.Second)
+	restart.broadcast = func(msg *rt.RaftMessage) { net.queue = append(net.queue, msg) }
+	restart.setHead(net.cores[2].parent, net.cores[2].members, now)
+	restart.setState(saved[2])
+	msg := &rt.RaftMessage{Value: &rt.RaftMessage_VoteRequest{VoteRequest: &rt.RaftVoteRequest{Term: 1}}}
+	signMsg(net.cores[1].priv, msg)
+	assert.Nil(t, restart.handleMessage(msg, now))
+	assert.Equal(t, 1, len(net.queue))
+	assert.False(t, net.queue[0].GetVoteReply().Granted)
+	net.queue = nil
+
+	//保存失败的时候不回复投票
+	saveErr = errors.New("disk full")
+	msg = &rt.RaftMessage{Value: &rt.RaftMessage_VoteRequest{VoteRequest: &rt.RaftVoteRequest{Term: 2}}}
+	signMsg(net.cores[1].priv, msg)
+	assert.Nil(t, net.cores[2].handleMessage(msg, now))
+	assert.Equal(t, 0, len(net.queue))
+}
+
+func TestRaftStateFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raft")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "raft", "state")
+	file, state, err := openState(path)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), state.Term)
+	assert.Nil(t, file.save(&rt.RaftState{Term: 3, VotedFor: "0x01"}))
+	_, state, err = openState(path)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), state.Term)
+	assert.Equal(t, "0x01", state.VotedFor)
+}
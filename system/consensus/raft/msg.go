@@ -0,0 +1,106 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	rt "github.com/33cn/chain33/system/consensus/raft/types"
+	"github.com/33cn/chain33/types"
+)
+
+//memberSet raft 成员节点
+type memberSet struct {
+	pubkeys []string
+	index   map[string]bool
+}
+
+func newMemberSet(pubkeys []string) *memberSet {
+	ms := &memberSet{index: make(map[string]bool)}
+	for _, pubkey := range pubkeys {
+		if ms.index[pubkey] {
+			continue
+		}
+		ms.index[pubkey] = true
+		ms.pubkeys = append(ms.pubkeys, pubkey)
+	}
+	return ms
+}
+
+func (ms *memberSet) has(pubkey string) bool {
+	return ms.index[pubkey]
+}
+
+//quorum 超过半数
+func (ms *memberSet) quorum() int {
+	return len(ms.pubkeys)/2 + 1
+}
+
+//blockDigest leader复制的区块的摘要，不包含执行以后才能确定的stateHash
+func blockDigest(block *types.Block) []byte {
+	return common.Sha256(types.Encode(block))
+}
+
+//proposalHash 区块写入以后增加了stateHash，去掉stateHash和签名以后就是leader签名的区块
+func proposalHash(block *types.Block) []byte {
+	proposal := *block
+	proposal.StateHash = nil
+	proposal.Signature = nil
+	return blockDigest(&proposal)
+}
+
+//signBlock leader对复制的区块签名，写入区块链的时候由CheckBlock检查
+func signBlock(priv crypto.PrivKey, block *types.Block) {
+	block.Signature = &types.Signature{
+		Ty:        types.BlockSignCommit,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(proposalHash(block)).Bytes(),
+	}
+}
+
+//verifyBlock 检查区块的leader签名，返回签名的成员节点公钥
+func verifyBlock(ms *memberSet, block *types.Block) (string, error) {
+	sig := block.GetSignature()
+	if sig == nil || sig.Ty != types.BlockSignCommit {
+		return "", rt.ErrBlockSign
+	}
+	pubkey := common.ToHex(sig.Pubkey)
+	if !ms.has(pubkey) {
+		return "", rt.ErrNotMember
+	}
+	if !types.CheckSign(proposalHash(block), "", &types.Signature{Ty: types.SECP256K1, Pubkey: sig.Pubkey, Signature: sig.Signature}) {
+		return "", rt.ErrBlockSign
+	}
+	return pubkey, nil
+}
+
+func signMsg(priv crypto.PrivKey, msg *rt.RaftMessage) {
+	msg.Sig = nil
+	data := types.Encode(msg)
+	msg.Sig = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(data).Bytes(),
+	}
+}
+
+//verifyMsg 检查签名，返回签名的成员节点公钥
+func verifyMsg(ms *memberSet, msg *rt.RaftMessage) (string, error) {
+	sig := msg.GetSig()
+	if sig == nil {
+		return "", rt.ErrMsgSign
+	}
+	pubkey := common.ToHex(sig.Pubkey)
+	if !ms.has(pubkey) {
+		return "", rt.ErrNotMember
+	}
+	msg.Sig = nil
+	data := types.Encode(msg)
+	msg.Sig = sig
+	if !types.CheckSign(data, "", sig) {
+		return "", rt.ErrMsgSign
+	}
+	return pubkey, nil
+}
@@ -0,0 +1,302 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package raft 基于leader的raft共识，不会分叉，适用于不需要拜占庭容错的私有链和联盟链
+package raft
+
+import (
+	"bytes"
+	"reflect"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	rt "github.com/33cn/chain33/system/consensus/raft/types"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+var rlog = log.New("module", "raft")
+
+const (
+	driverName = "raft"
+	//tickInterval 状态机检查超时和出块的间隔
+	tickInterval = 50 * time.Millisecond
+)
+
+//Client 客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg *subConfig
+	core   *core
+	msgs   chan *rt.RaftMessage
+}
+
+func init() {
+	drivers.Reg(driverName, New)
+	drivers.QueryData.Register(driverName, &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	//Members 成员节点的公钥(hex)
+	Members []string `json:"members"`
+	//MembersKey 不为空的时候，从manage合约的这个配置项读取成员节点，可以动态增加和删除成员
+	MembersKey string `json:"membersKey"`
+	//PrivKey 本节点的私钥，为空表示只跟随leader提交区块
+	PrivKey string `json:"privKey"`
+	//HeartbeatMs leader发送心跳的间隔
+	HeartbeatMs int64 `json:"heartbeatMs"`
+	//ElectionTimeoutMs 没有收到心跳超过这个时间以后发起选举
+	ElectionTimeoutMs int64 `json:"electionTimeoutMs"`
+	//StatePath 成员节点保存任期和投票的文件
+	StatePath string `json:"statePath"`
+}
+
+//New new
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	if subcfg.HeartbeatMs <= 0 {
+		subcfg.HeartbeatMs = 500
+	}
+	if subcfg.ElectionTimeoutMs <= 0 {
+		subcfg.ElectionTimeoutMs = 3000
+	}
+	if subcfg.StatePath == "" {
+		subcfg.StatePath = "datadir/raft/state"
+	}
+	var priv crypto.PrivKey
+	if subcfg.PrivKey != "" {
+		var err error
+		priv, err = loadPrivKey(subcfg.PrivKey)
+		if err != nil {
+			panic(err)
+		}
+	}
+	client := &Client{
+		BaseClient: c,
+		subcfg:     &subcfg,
+		core: newCore(priv, time.Duration(subcfg.HeartbeatMs)*time.Millisecond,
+			time.Duration(subcfg.ElectionTimeoutMs)*time.Millisecond),
+		msgs: make(chan *rt.RaftMessage, 4096),
+	}
+	client.core.broadcast = client.broadcast
+	client.core.commit = client.commitBlock
+	if priv != nil {
+		file, state, err := openState(subcfg.StatePath)
+		if err != nil {
+			panic(err)
+		}
+		client.core.setState(state)
+		client.core.save = file.save
+	}
+	c.SetChild(client)
+	drivers.QueryData.SetThis(driverName, reflect.ValueOf(client))
+	return client
+}
+
+func loadPrivKey(key string) (crypto.PrivKey, error) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	bkey, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(bkey)
+}
+
+//Close close
+func (client *Client) Close() {
+	rlog.Info("consensus raft closed")
+}
+
+//GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+//CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+//ProcEvent 接收p2p转发的共识消息
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	if msg.Ty != types.EventConsensusMsg {
+		return false
+	}
+	data := msg.GetData().(*types.P2PConsensus)
+	if data.Driver != driverName {
+		return true
+	}
+	var rmsg rt.RaftMessage
+	err := types.Decode(data.Data, &rmsg)
+	if err != nil {
+		rlog.Error("ProcEvent", "decode err", err)
+		return true
+	}
+	select {
+	case client.msgs <- &rmsg:
+	default:
+		rlog.Error("ProcEvent", "msg dropped", "queue full")
+	}
+	return true
+}
+
+//CheckBlock 区块必须是父区块的成员节点签名的，raft 不会分叉，区块都是leader复制以后提交的
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	_, err := verifyBlock(client.getMembers(parent), current.Block)
+	return err
+}
+
+func (client *Client) broadcast(msg *rt.RaftMessage) {
+	err := client.BroadcastConsensus(driverName, types.Encode(msg))
+	if err != nil {
+		rlog.Error("broadcast", "err", err)
+	}
+}
+
+//commitBlock 超过半数的节点收到以后写入区块链，写入的区块会被blockchain修改，所以使用复制的区块
+func (client *Client) commitBlock(parent, block *types.Block) *types.Block {
+	var newblock types.Block
+	types.Decode(types.Encode(block), &newblock)
+	err := client.WriteBlock(parent.StateHash, &newblock)
+	if err != nil {
+		rlog.Error("commitBlock", "height", block.Height, "err", err)
+		return nil
+	}
+	return client.GetCurrentBlock()
+}
+
+//getMembers 成员节点由manage合约管理的时候，在每个区块之后重新读取
+func (client *Client) getMembers(parent *types.Block) *memberSet {
+	if client.subcfg.MembersKey != "" {
+		members, err := client.GetManageConfig(client.subcfg.MembersKey, parent.StateHash)
+		if err != nil {
+			rlog.Error("getMembers", "err", err)
+		} else if len(members) > 0 {
+			return newMemberSet(members)
+		}
+	}
+	return newMemberSet(client.subcfg.Members)
+}
+
+func (client *Client) createBlock(parent *types.Block) *types.Block {
+	txs := client.RequestBlockCandidate(&types.ReqBlockCandidate{
+		Height:   parent.Height + 1,
+		MaxCount: client.GetMaxTxNumber(parent),
+	})
+	var newblock types.Block
+	newblock.ParentHash = parent.Hash()
+	newblock.Height = parent.Height + 1
+	client.AddTxsToBlock(&newblock, txs)
+	newblock.Difficulty = types.GetP(0).PowLimitBits
+	newblock.BlockTime = types.Now().Unix()
+	if parent.BlockTime > newblock.BlockTime {
+		newblock.BlockTime = parent.BlockTime
+	}
+	//去掉写入区块的时候会被删除的交易，否则写入的区块和leader签名的区块不一致
+	txs, err := client.execTxs(parent, &newblock)
+	if err != nil {
+		rlog.Error("createBlock execTxs", "height", newblock.Height, "err", err)
+	} else {
+		newblock.Txs = txs
+	}
+	newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
+	return &newblock
+}
+
+//execTxs 在父区块的状态上预先执行区块中的交易，返回写入区块的时候不会被删除的交易
+func (client *Client) execTxs(parent, block *types.Block) ([]*types.Transaction, error) {
+	qclient := client.GetQueueClient()
+	cacheTxs, err := util.CheckTxDup(qclient, types.TxsToCache(block.Txs), block.Height)
+	if err != nil {
+		return nil, err
+	}
+	exec := *block
+	exec.Txs = types.CacheToTxs(cacheTxs)
+	receipts, err := util.ExecTx(qclient, parent.StateHash, &exec)
+	if err != nil {
+		return nil, err
+	}
+	var txs []*types.Transaction
+	for i, receipt := range receipts.Receipts {
+		if receipt.Ty != types.ExecErr {
+			txs = append(txs, exec.Txs[i])
+		}
+	}
+	return txs, nil
+}
+
+//CreateBlock raft 状态机的主循环
+func (client *Client) CreateBlock() {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	for {
+		if client.IsClosed() {
+			break
+		}
+		select {
+		case msg := <-client.msgs:
+			err := client.core.handleMessage(msg, types.Now())
+			if err != nil {
+				rlog.Debug("handleMessage", "err", err)
+			}
+		case <-ticker.C:
+			client.onTick()
+		}
+	}
+}
+
+func (client *Client) onTick() {
+	if !client.IsCaughtUp() {
+		return
+	}
+	now := types.Now()
+	parent := client.GetCurrentBlock()
+	if client.core.parent == nil || !bytes.Equal(client.core.parent.Hash(), parent.Hash()) {
+		client.core.setHead(parent, client.getMembers(parent), now)
+	}
+	client.core.tick(now, client.hasTx(), client.createBlock)
+}
+
+func (client *Client) hasTx() bool {
+	txs := client.RequestTx(1, nil)
+	return len(txs) > 0
+}
+
+//Query_GetRaftStatus 获取当前的共识状态
+func (client *Client) Query_GetRaftStatus(req *types.ReqNil) (types.Message, error) {
+	if client.core == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	return client.core.getStatus(), nil
+}
@@ -0,0 +1,57 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	rt "github.com/33cn/chain33/system/consensus/raft/types"
+	"github.com/33cn/chain33/types"
+)
+
+//stateFile 保存成员节点的任期和投票，先写临时文件，sync以后再替换，崩溃的时候不会留下写了一半的文件
+type stateFile struct {
+	path string
+}
+
+//openState 读取上次保存的任期和投票，文件不存在的时候是初始状态
+func openState(path string) (*stateFile, *rt.RaftState, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, nil, err
+	}
+	state := &rt.RaftState{}
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+	if err == nil {
+		if err := types.Decode(data, state); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &stateFile{path: path}, state, nil
+}
+
+func (s *stateFile) save(state *rt.RaftState) error {
+	tmp := s.path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(types.Encode(state)); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
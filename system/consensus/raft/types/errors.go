@@ -0,0 +1,18 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrNotMember 消息不是成员节点签名的
+	ErrNotMember = errors.New("ErrNotMember")
+	// ErrMsgSign 消息签名错误
+	ErrMsgSign = errors.New("ErrMsgSign")
+	// ErrAppendBlock leader复制的区块不合法
+	ErrAppendBlock = errors.New("ErrAppendBlock")
+	// ErrBlockSign 区块不是成员节点签名的
+	ErrBlockSign = errors.New("ErrBlockSign")
+)
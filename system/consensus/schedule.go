@@ -0,0 +1,43 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"github.com/33cn/chain33/client"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+)
+
+//GetConsensusSchedule 按父区块的状态读取manage合约中height生效的共识和共识参数
+func GetConsensusSchedule(api client.QueueProtocolAPI, height int64, stateHash []byte) (*mty.ConsensusSchedule, error) {
+	values, err := getManageConfig(api, mty.ConsensusScheduleKey, stateHash)
+	if err != nil {
+		return nil, err
+	}
+	return mty.GetConsensusSchedule(values, height), nil
+}
+
+//GetMaxTxNumber 下一个区块最多的交易个数，开启共识切换计划以后可以通过manage合约调低
+func (bc *BaseClient) GetMaxTxNumber(parent *types.Block) int64 {
+	height := parent.Height + 1
+	maxTx := types.GetP(height).MaxTxNumber
+	if !bc.Cfg.Schedule {
+		return maxTx
+	}
+	schedule, err := GetConsensusSchedule(bc.api, height, parent.StateHash)
+	if err != nil {
+		tlog.Error("GetMaxTxNumber", "height", height, "err", err)
+		return maxTx
+	}
+	if schedule.MaxTxNumber > 0 && schedule.MaxTxNumber < maxTx {
+		return schedule.MaxTxNumber
+	}
+	return maxTx
+}
+
+//Stop 停止出块和接收消息，共识切换的时候调用，子共识覆盖的Close一般不会关闭BaseClient
+func (bc *BaseClient) Stop() {
+	bc.Close()
+}
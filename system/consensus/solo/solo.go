@@ -107,14 +107,16 @@ func (client *Client) CreateBlock() {
 			time.Sleep(client.sleepTime)
 		}
 		lastBlock := client.GetCurrentBlock()
-		txs := client.RequestTx(int(types.GetP(lastBlock.Height+1).MaxTxNumber), nil)
+		//mempool 返回的交易已经按照手续费率排序，并且和链上的交易去重
+		txs := client.RequestBlockCandidate(&types.ReqBlockCandidate{
+			Height:   lastBlock.Height + 1,
+			MaxCount: client.GetMaxTxNumber(lastBlock),
+		})
 		if len(txs) == 0 {
 			issleep = true
 			continue
 		}
 		issleep = false
-		//check dup
-		txs = client.CheckTxDup(txs)
 		var newblock types.Block
 		newblock.ParentHash = lastBlock.Hash()
 		newblock.Height = lastBlock.Height + 1
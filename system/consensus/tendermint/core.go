@@ -0,0 +1,640 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tendermint
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	drivers "github.com/33cn/chain33/system/consensus"
+	tmt "github.com/33cn/chain33/system/consensus/tendermint/types"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	stepPropose   = "propose"
+	stepPrevote   = "prevote"
+	stepPrecommit = "precommit"
+	stepCommit    = "commit"
+
+	//maxFutureMsgs 缓存下一个高度的消息个数
+	maxFutureMsgs = 1024
+	//maxCommittedBlocks 保留最近提交的区块，用于检查收到的区块
+	maxCommittedBlocks = 128
+	//maxEvidences 保留的作恶证据个数
+	maxEvidences = 1000
+)
+
+//voteSet 一轮中同一种类型的投票
+type voteSet struct {
+	votes map[string]*tmt.TendermintMessage
+	power map[string]int64
+	total int64
+}
+
+func newVoteSet() *voteSet {
+	return &voteSet{votes: make(map[string]*tmt.TendermintMessage), power: make(map[string]int64)}
+}
+
+//add 每个验证节点只记录第一次投票，对不同区块的重复投票返回之前的投票作为证据
+func (s *voteSet) add(from string, power int64, msg *tmt.TendermintMessage) (conflict *tmt.TendermintMessage, added bool) {
+	if old, ok := s.votes[from]; ok {
+		if bytes.Equal(old.GetVote().BlockHash, msg.GetVote().BlockHash) {
+			return nil, false
+		}
+		return old, false
+	}
+	s.votes[from] = msg
+	s.power[string(msg.GetVote().BlockHash)] += power
+	s.total += power
+	return nil, true
+}
+
+//majority 得到超过2/3投票权的区块，空表示nil
+func (s *voteSet) majority(vs *validatorSet) ([]byte, bool) {
+	for hash, power := range s.power {
+		if vs.hasQuorum(power) {
+			return []byte(hash), true
+		}
+	}
+	return nil, false
+}
+
+type roundVotes struct {
+	prevotes   *voteSet
+	precommits *voteSet
+}
+
+//core tendermint 状态机，每个高度分为多轮，每轮经过propose, prevote, precommit三个阶段，
+//所有的消息和定时器都在同一个goroutine里处理
+type core struct {
+	validators     *validatorSet
+	priv           crypto.PrivKey
+	signTy         int32
+	pubkey         string
+	timeoutPropose time.Duration
+	timeoutVote    time.Duration
+	emptyInterval  time.Duration
+
+	height      int64
+	round       int32
+	step        string
+	parent      *types.Block
+	deadline    time.Time
+	proposal    *tmt.TendermintProposal
+	proposed    bool
+	lockedBlock *types.Block
+	lockedRound int32
+	validBlock  *types.Block
+	validRound  int32
+	rounds      map[int32]*roundVotes
+	blocks      map[string]*types.Block
+	future      []*tmt.TendermintMessage
+	//signed 当前高度本节点签名过的消息，同一轮同一种消息只签名一次
+	signed map[string]*tmt.TendermintMessage
+	//wal 为空表示不记录写前日志，replay 是启动时读取的记录
+	wal    *wal
+	replay []*tmt.TendermintWALRecord
+
+	//stats 为空表示不统计验证节点的出块
+	stats *drivers.ProposerStats
+
+	//broadcast 把消息发送给其他节点
+	broadcast func(msg *tmt.TendermintMessage)
+	//commit 区块得到超过2/3投票权的precommit，写入区块链
+	commit func(parent, block *types.Block)
+	//checkTxs 为空表示不检查，提议区块中的交易写入区块的时候会被删除的话投nil，提交的区块必须和投票的区块一致
+	checkTxs func(parent, block *types.Block) error
+
+	mu        sync.Mutex
+	committed map[int64]*types.Block
+	evidences []*tmt.TendermintEvidence
+	status    *tmt.TendermintStatus
+	//vsProto 当前高度的验证节点，用于查询
+	vsProto *tmt.TendermintValidators
+}
+
+func newCore(priv crypto.PrivKey, signTy int32, timeoutPropose, timeoutVote, emptyInterval time.Duration) *core {
+	c := &core{
+		priv:           priv,
+		signTy:         signTy,
+		timeoutPropose: timeoutPropose,
+		timeoutVote:    timeoutVote,
+		emptyInterval:  emptyInterval,
+		committed:      make(map[int64]*types.Block),
+		status:         &tmt.TendermintStatus{},
+		vsProto:        &tmt.TendermintValidators{},
+	}
+	if priv != nil {
+		c.pubkey = common.ToHex(priv.PubKey().Bytes())
+	}
+	return c
+}
+
+//setWAL 设置写前日志，records在下一个高度开始的时候恢复
+func (c *core) setWAL(w *wal, records []*tmt.TendermintWALRecord) {
+	c.wal = w
+	c.replay = records
+}
+
+func (c *core) isValidator() bool {
+	return c.priv != nil && c.validators.has(c.pubkey)
+}
+
+func (c *core) isProposer() bool {
+	return c.isValidator() && c.validators.proposer(c.height, c.round) == c.pubkey
+}
+
+//roundTimeout 每一轮的超时时间逐渐增加，保证网络恢复以后能够达成共识
+func roundTimeout(base time.Duration, round int32) time.Duration {
+	return base + base/2*time.Duration(round)
+}
+
+//newHeight 父区块写入以后开始下一个高度的共识
+func (c *core) newHeight(parent *types.Block, validators *validatorSet, now time.Time) {
+	c.validators = validators
+	c.mu.Lock()
+	c.vsProto = validators.toProto()
+	c.mu.Unlock()
+	c.parent = parent
+	c.height = parent.Height + 1
+	c.lockedBlock = nil
+	c.lockedRound = -1
+	c.validBlock = nil
+	c.validRound = -1
+	c.rounds = make(map[int32]*roundVotes)
+	c.blocks = make(map[string]*types.Block)
+	c.signed = make(map[string]*tmt.TendermintMessage)
+	c.startRound(0, now)
+	c.restoreWAL(now)
+	future := c.future
+	c.future = nil
+	for _, msg := range future {
+		c.handleMessage(msg, now)
+	}
+}
+
+func (c *core) startRound(round int32, now time.Time) {
+	c.round = round
+	c.step = stepPropose
+	c.proposal = nil
+	c.proposed = false
+	c.deadline = now.Add(roundTimeout(c.timeoutPropose, round))
+	if round == 0 {
+		c.deadline = c.deadline.Add(c.emptyInterval)
+	}
+	c.updateStatus()
+}
+
+//shouldPropose 第0轮在有交易或者空闲超过emptyInterval的时候提议，之后的轮次立即提议
+func (c *core) shouldPropose(hasTx bool, now time.Time) bool {
+	if c.parent == nil || c.step != stepPropose || c.proposed || !c.isProposer() {
+		return false
+	}
+	if c.round > 0 || hasTx || c.validBlock != nil {
+		return true
+	}
+	return now.Sub(time.Unix(c.parent.BlockTime, 0)) >= c.emptyInterval
+}
+
+//propose 有valid的区块的时候必须重新提议这个区块
+func (c *core) propose(block *types.Block, now time.Time) {
+	polRound := int32(-1)
+	if c.validBlock != nil {
+		block = c.validBlock
+		polRound = c.validRound
+	}
+	c.proposed = true
+	c.sendMsg(&tmt.TendermintMessage{Value: &tmt.TendermintMessage_Proposal{
+		Proposal: &tmt.TendermintProposal{Height: c.height, Round: c.round, PolRound: polRound, Block: block},
+	}}, now)
+}
+
+//tick 处理每个阶段的超时
+func (c *core) tick(now time.Time) {
+	if c.parent == nil || c.step == stepCommit || !now.After(c.deadline) {
+		return
+	}
+	switch c.step {
+	case stepPropose:
+		c.enterPrevote(nil, now)
+	case stepPrevote:
+		c.enterPrecommit(nil, now)
+	case stepPrecommit:
+		c.startRound(c.round+1, now)
+	}
+}
+
+func signedKey(msg *tmt.TendermintMessage) string {
+	if p := msg.GetProposal(); p != nil {
+		return fmt.Sprintf("proposal-%d", p.Round)
+	}
+	vote := msg.GetVote()
+	return fmt.Sprintf("vote-%d-%d", vote.GetRound(), vote.GetType())
+}
+
+func msgRound(msg *tmt.TendermintMessage) int32 {
+	if p := msg.GetProposal(); p != nil {
+		return p.Round
+	}
+	return msg.GetVote().GetRound()
+}
+
+//sendMsg 签名的消息先写入写前日志再广播，已经签名过的消息不会重新签名，避免重启以后重复投票
+func (c *core) sendMsg(msg *tmt.TendermintMessage, now time.Time) {
+	if !c.isValidator() {
+		return
+	}
+	key := signedKey(msg)
+	if old, ok := c.signed[key]; ok {
+		msg = old
+	} else {
+		signMsg(c.priv, c.signTy, msg)
+		if c.wal != nil {
+			if err := c.wal.write(&tmt.TendermintWALRecord{Height: c.height, Msg: msg}); err != nil {
+				tlog.Error("sendMsg write wal", "height", c.height, "err", err)
+				return
+			}
+		}
+		c.signed[key] = msg
+	}
+	c.broadcast(msg)
+	c.process(c.pubkey, msg, now)
+}
+
+//saveLock 锁定的区块改变的时候写入写前日志，重启以后不会给其他区块投票
+func (c *core) saveLock() {
+	if c.wal == nil {
+		return
+	}
+	err := c.wal.write(&tmt.TendermintWALRecord{Height: c.height, Lock: &tmt.TendermintLock{
+		LockedRound: c.lockedRound,
+		LockedBlock: c.lockedBlock,
+		ValidRound:  c.validRound,
+		ValidBlock:  c.validBlock,
+	}})
+	if err != nil {
+		tlog.Error("saveLock write wal", "height", c.height, "err", err)
+	}
+}
+
+//restoreWAL 写前日志是当前高度的时候，恢复锁定的区块和签名过的消息，回到崩溃前的轮次并重新广播，
+//否则清空写前日志开始记录新的高度
+func (c *core) restoreWAL(now time.Time) {
+	if c.wal == nil {
+		return
+	}
+	records := c.replay
+	c.replay = nil
+	if len(records) == 0 || records[0].Height != c.height {
+		if err := c.wal.reset(c.height); err != nil {
+			tlog.Error("restoreWAL reset", "height", c.height, "err", err)
+		}
+		return
+	}
+	own := newValidatorSet([]*tmt.TendermintValidator{{PubKey: c.pubkey, Power: 1}})
+	var msgs []*tmt.TendermintMessage
+	for _, record := range records[1:] {
+		if record.Height != c.height {
+			continue
+		}
+		if lock := record.Lock; lock != nil {
+			c.lockedRound, c.lockedBlock = lock.LockedRound, lock.LockedBlock
+			c.validRound, c.validBlock = lock.ValidRound, lock.ValidBlock
+		}
+		if msg := record.Msg; msg != nil {
+			//只恢复本节点签名的这个高度的消息
+			if _, err := verifyMsg(own, msg); err != nil || msgHeight(msg) != c.height {
+				tlog.Error("restoreWAL", "height", c.height, "bad msg", err)
+				continue
+			}
+			c.signed[signedKey(msg)] = msg
+			msgs = append(msgs, msg)
+		}
+	}
+	for _, block := range []*types.Block{c.lockedBlock, c.validBlock} {
+		if block != nil {
+			c.blocks[string(blockDigest(block))] = block
+		}
+	}
+	var round int32
+	for _, msg := range msgs {
+		if r := msgRound(msg); r > round {
+			round = r
+		}
+	}
+	if round > 0 {
+		c.startRound(round, now)
+	}
+	tlog.Info("tendermint restore wal", "height", c.height, "round", round, "msgs", len(msgs), "lockedRound", c.lockedRound)
+	for _, msg := range msgs {
+		if msg.GetProposal() != nil && msgRound(msg) == c.round {
+			c.proposed = true
+		}
+		c.broadcast(msg)
+		c.process(c.pubkey, msg, now)
+	}
+}
+
+func (c *core) sendVote(ty int32, hash []byte, now time.Time) {
+	c.sendMsg(&tmt.TendermintMessage{Value: &tmt.TendermintMessage_Vote{
+		Vote: &tmt.TendermintVote{Height: c.height, Round: c.round, Type: ty, BlockHash: hash},
+	}}, now)
+}
+
+func (c *core) enterPrevote(hash []byte, now time.Time) {
+	c.step = stepPrevote
+	c.deadline = now.Add(roundTimeout(c.timeoutVote, c.round))
+	c.sendVote(tmt.VoteTypePrevote, hash, now)
+}
+
+func (c *core) enterPrecommit(hash []byte, now time.Time) {
+	c.step = stepPrecommit
+	c.deadline = now.Add(roundTimeout(c.timeoutVote, c.round))
+	c.sendVote(tmt.VoteTypePrecommit, hash, now)
+}
+
+func msgHeight(msg *tmt.TendermintMessage) int64 {
+	if p := msg.GetProposal(); p != nil {
+		return p.Height
+	}
+	return msg.GetVote().GetHeight()
+}
+
+//handleMessage 处理其他节点发送的消息
+func (c *core) handleMessage(msg *tmt.TendermintMessage, now time.Time) error {
+	if c.parent == nil {
+		return nil
+	}
+	height := msgHeight(msg)
+	if height == c.height+1 {
+		if len(c.future) < maxFutureMsgs {
+			c.future = append(c.future, msg)
+		}
+		return nil
+	}
+	if height != c.height {
+		return nil
+	}
+	from, err := verifyMsg(c.validators, msg)
+	if err != nil {
+		return err
+	}
+	return c.process(from, msg, now)
+}
+
+func (c *core) process(from string, msg *tmt.TendermintMessage, now time.Time) error {
+	var err error
+	switch v := msg.Value.(type) {
+	case *tmt.TendermintMessage_Proposal:
+		err = c.onProposal(from, v.Proposal, now)
+	case *tmt.TendermintMessage_Vote:
+		err = c.onVote(from, msg, now)
+	}
+	c.updateStatus()
+	return err
+}
+
+func (c *core) checkProposal(from string, p *tmt.TendermintProposal) error {
+	if from != c.validators.proposer(p.Height, p.Round) {
+		return tmt.ErrNotProposer
+	}
+	block := p.Block
+	if block == nil || block.Height != c.height || !bytes.Equal(block.ParentHash, c.parent.Hash()) {
+		return tmt.ErrProposalBlock
+	}
+	if block.BlockTime < c.parent.BlockTime || len(block.StateHash) != 0 || block.Signature != nil || p.PolRound >= p.Round {
+		return tmt.ErrProposalBlock
+	}
+	if int64(len(block.Txs)) > types.GetP(block.Height).MaxTxNumber {
+		return types.ErrManyTx
+	}
+	if !bytes.Equal(block.TxHash, merkle.CalcMerkleRoot(block.Txs)) {
+		return types.ErrCheckTxHash
+	}
+	if !block.CheckSign() {
+		return types.ErrSign
+	}
+	return nil
+}
+
+func (c *core) getRound(round int32) *roundVotes {
+	rv, ok := c.rounds[round]
+	if !ok {
+		rv = &roundVotes{prevotes: newVoteSet(), precommits: newVoteSet()}
+		c.rounds[round] = rv
+	}
+	return rv
+}
+
+func (c *core) onProposal(from string, p *tmt.TendermintProposal, now time.Time) error {
+	if err := c.checkProposal(from, p); err != nil {
+		tlog.Error("onProposal", "height", p.Height, "round", p.Round, "err", err)
+		return err
+	}
+	digest := blockDigest(p.Block)
+	c.blocks[string(digest)] = p.Block
+	if p.Round == c.round && c.step == stepPropose && c.proposal == nil {
+		c.proposal = p
+		hash := c.prevoteHash(p, digest)
+		if hash != nil && c.checkTxs != nil && c.isValidator() {
+			if err := c.checkTxs(c.parent, p.Block); err != nil {
+				tlog.Error("onProposal checkTxs", "height", p.Height, "round", p.Round, "err", err)
+				hash = nil
+			}
+		}
+		c.enterPrevote(hash, now)
+	}
+	//投票可能比提议先到达
+	c.checkVotes(p.Round, now)
+	return nil
+}
+
+//prevoteHash 锁定了区块以后，只有在更新的轮次中这个提议得到了超过2/3的prevote，才能给其他区块投票
+func (c *core) prevoteHash(p *tmt.TendermintProposal, digest []byte) []byte {
+	locked := c.lockedBlock != nil && bytes.Equal(blockDigest(c.lockedBlock), digest)
+	if p.PolRound < 0 {
+		if c.lockedRound < 0 || locked {
+			return digest
+		}
+		return nil
+	}
+	polka, ok := c.getRound(p.PolRound).prevotes.majority(c.validators)
+	if ok && bytes.Equal(polka, digest) && (c.lockedRound <= p.PolRound || locked) {
+		return digest
+	}
+	return nil
+}
+
+//addEvidence 同一个验证节点在同一轮同一种类型的投票只记录一次证据
+func (c *core) addEvidence(pubkey string, a, b *tmt.TendermintMessage) {
+	vote := a.GetVote()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.evidences {
+		v := e.VoteA.GetVote()
+		if e.PubKey == pubkey && v.Height == vote.Height && v.Round == vote.Round && v.Type == vote.Type {
+			return
+		}
+	}
+	tlog.Error("tendermint duplicate vote", "pubkey", pubkey, "height", vote.Height, "round", vote.Round)
+	if len(c.evidences) >= maxEvidences {
+		c.evidences = c.evidences[1:]
+	}
+	c.evidences = append(c.evidences, &tmt.TendermintEvidence{VoteA: a, VoteB: b, PubKey: pubkey})
+}
+
+func (c *core) onVote(from string, msg *tmt.TendermintMessage, now time.Time) error {
+	vote := msg.GetVote()
+	rv := c.getRound(vote.Round)
+	var set *voteSet
+	switch vote.Type {
+	case tmt.VoteTypePrevote:
+		set = rv.prevotes
+	case tmt.VoteTypePrecommit:
+		set = rv.precommits
+	default:
+		return tmt.ErrVoteType
+	}
+	conflict, added := set.add(from, c.validators.power[from], msg)
+	if conflict != nil {
+		c.addEvidence(from, conflict, msg)
+	}
+	if !added {
+		return nil
+	}
+	//超过1/3的投票权已经进入了更新的轮次，直接跳到这一轮
+	if vote.Round > c.round && c.step != stepCommit {
+		power := rv.prevotes.total
+		for pubkey, v := range rv.precommits.votes {
+			if _, ok := rv.prevotes.votes[pubkey]; !ok && v != nil {
+				power += c.validators.power[pubkey]
+			}
+		}
+		if c.validators.hasOneThird(power) {
+			c.startRound(vote.Round, now)
+		}
+	}
+	c.checkVotes(vote.Round, now)
+	return nil
+}
+
+//checkVotes 检查一轮的投票是否达到了超过2/3的投票权
+func (c *core) checkVotes(round int32, now time.Time) {
+	if c.step == stepCommit {
+		return
+	}
+	rv := c.getRound(round)
+	if hash, ok := rv.precommits.majority(c.validators); ok && len(hash) > 0 {
+		if block, ok := c.blocks[string(hash)]; ok {
+			c.commitBlock(round, hash, block)
+			return
+		}
+	}
+	if hash, ok := rv.prevotes.majority(c.validators); ok {
+		block, known := c.blocks[string(hash)]
+		if len(hash) > 0 && known && round >= c.validRound && (round != c.validRound || c.validBlock != block) {
+			c.validBlock = block
+			c.validRound = round
+			c.saveLock()
+		}
+		if round == c.round && c.step == stepPrevote {
+			if len(hash) > 0 && known {
+				c.lockedBlock = block
+				c.lockedRound = round
+				c.saveLock()
+				c.enterPrecommit(hash, now)
+			} else if len(hash) == 0 {
+				c.enterPrecommit(nil, now)
+			}
+		}
+	}
+	if c.step == stepCommit {
+		return
+	}
+	if hash, ok := rv.precommits.majority(c.validators); ok && len(hash) == 0 && round == c.round && c.step == stepPrecommit {
+		c.startRound(round+1, now)
+	}
+}
+
+//commitBlock 把precommit的签名做成提交证明写入区块头，其他节点同步区块的时候检查提交证明
+func (c *core) commitBlock(round int32, hash []byte, block *types.Block) {
+	c.step = stepCommit
+	c.mu.Lock()
+	c.committed[c.height] = block
+	delete(c.committed, c.height-maxCommittedBlocks)
+	c.mu.Unlock()
+	tlog.Info("tendermint commit block", "height", c.height, "round", round, "txs", len(block.Txs))
+	if c.stats != nil {
+		//之前各轮的提议节点都没有出块
+		for r := int32(0); r <= round; r++ {
+			c.stats.Record(c.validators.proposer(c.height, r), r == round)
+		}
+	}
+	c.updateStatus()
+	sig, err := makeCommit(c.validators, c.signTy, c.getRound(round).precommits.votes, round, hash)
+	if err != nil {
+		//没有提交证明的区块不会被接受，等待从其他节点同步这个区块
+		tlog.Error("commitBlock makeCommit", "height", c.height, "round", round, "err", err)
+		return
+	}
+	signed := *block
+	signed.Signature = sig
+	c.commit(c.parent, &signed)
+}
+
+func (c *core) updateStatus() {
+	status := &tmt.TendermintStatus{
+		Height:      c.height,
+		Round:       c.round,
+		Step:        c.step,
+		Proposer:    c.validators.proposer(c.height, c.round),
+		LockedRound: c.lockedRound,
+		ValidRound:  c.validRound,
+	}
+	c.mu.Lock()
+	c.status = status
+	c.mu.Unlock()
+}
+
+func (c *core) getStatus() *tmt.TendermintStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.status
+}
+
+func (c *core) getValidators() *tmt.TendermintValidators {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.vsProto
+}
+
+func (c *core) getEvidences() *tmt.TendermintEvidences {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	evidences := make([]*tmt.TendermintEvidence, len(c.evidences))
+	copy(evidences, c.evidences)
+	return &tmt.TendermintEvidences{Evidences: evidences}
+}
+
+//checkBlock 检查区块和本节点看到的验证节点提交的区块一致
+func (c *core) checkBlock(block *types.Block) error {
+	c.mu.Lock()
+	committed := c.committed[block.Height]
+	c.mu.Unlock()
+	if committed == nil {
+		//没有参与这个高度的共识，比如节点同步历史区块，只检查区块头中的提交证明
+		return nil
+	}
+	if !bytes.Equal(proposalHash(block), blockDigest(committed)) {
+		return tmt.ErrBlockNotCommitted
+	}
+	return nil
+}
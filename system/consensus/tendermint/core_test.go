@@ -0,0 +1,362 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tendermint
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	drivers "github.com/33cn/chain33/system/consensus"
+	tmt "github.com/33cn/chain33/system/consensus/tendermint/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	cfg, _ := types.InitCfg("../../../cmd/chain33/chain33.test.toml")
+	types.Init(cfg.Title, cfg)
+}
+
+type testNetwork struct {
+	cores     []*core
+	down      map[int]bool
+	queue     []*tmt.TendermintMessage
+	committed map[int]*types.Block
+}
+
+func newTestNetwork(t *testing.T, powers []int64, observer bool) *testNetwork {
+	return newSignTestNetwork(t, "ed25519", powers, observer)
+}
+
+func newSignTestNetwork(t *testing.T, signType string, powers []int64, observer bool) *testNetwork {
+	cr, err := crypto.New(signType)
+	assert.Nil(t, err)
+	net := &testNetwork{down: make(map[int]bool), committed: make(map[int]*types.Block)}
+	var validators []*tmt.TendermintValidator
+	var privs []crypto.PrivKey
+	for _, power := range powers {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		privs = append(privs, priv)
+		validators = append(validators, &tmt.TendermintValidator{PubKey: common.ToHex(priv.PubKey().Bytes()), Power: power})
+	}
+	if observer {
+		privs = append(privs, nil)
+	}
+	vs := newValidatorSet(validators)
+	parent := &types.Block{Height: 0, BlockTime: time.Now().Unix()}
+	for i := range privs {
+		index := i
+		c := newCore(privs[i], int32(types.GetSignType("", signType)), time.Second, time.Second, time.Minute)
+		c.broadcast = func(msg *tmt.TendermintMessage) {
+			if !net.down[index] {
+				net.queue = append(net.queue, msg)
+			}
+		}
+		c.commit = func(parent, block *types.Block) {
+			net.committed[index] = block
+		}
+		c.newHeight(parent, vs, time.Now())
+		net.cores = append(net.cores, c)
+	}
+	return net
+}
+
+func (net *testNetwork) deliver(now time.Time) {
+	for len(net.queue) > 0 {
+		msg := net.queue[0]
+		net.queue = net.queue[1:]
+		data := types.Encode(msg)
+		for i, c := range net.cores {
+			if net.down[i] {
+				continue
+			}
+			var m tmt.TendermintMessage
+			types.Decode(data, &m)
+			c.handleMessage(&m, now)
+		}
+	}
+}
+
+func (net *testNetwork) tick(now time.Time) {
+	for i, c := range net.cores {
+		if !net.down[i] {
+			c.tick(now)
+		}
+	}
+	net.deliver(now)
+}
+
+func (net *testNetwork) proposer() int {
+	for i, c := range net.cores {
+		if c.isProposer() {
+			return i
+		}
+	}
+	return -1
+}
+
+func newTestBlock(parent *types.Block) *types.Block {
+	block := &types.Block{ParentHash: parent.Hash(), Height: parent.Height + 1, BlockTime: parent.BlockTime + 1}
+	block.TxHash = merkle.CalcMerkleRoot(block.Txs)
+	return block
+}
+
+func TestValidatorSet(t *testing.T) {
+	vs := newValidatorSet([]*tmt.TendermintValidator{
+		{PubKey: "a", Power: 1}, {PubKey: "b", Power: 2}, {PubKey: "c", Power: 0},
+		{PubKey: "d", Power: 1}, {PubKey: "a", Power: 5},
+	})
+	assert.Equal(t, int64(4), vs.total)
+	assert.False(t, vs.has("c"))
+	assert.True(t, vs.hasQuorum(3))
+	assert.False(t, vs.hasQuorum(2))
+	assert.True(t, vs.hasOneThird(2))
+	assert.False(t, vs.hasOneThird(1))
+	assert.Equal(t, "b", vs.proposer(1, 0))
+	assert.Equal(t, "b", vs.proposer(1, 1))
+	assert.Equal(t, "d", vs.proposer(1, 2))
+	assert.Equal(t, "a", vs.proposer(1, 3))
+	assert.Equal(t, int64(4), vs.toProto().TotalPower)
+	assert.Equal(t, "", newValidatorSet(nil).proposer(1, 0))
+}
+
+func TestTendermintCommit(t *testing.T) {
+	net := newTestNetwork(t, []int64{1, 1, 1, 1}, true)
+	now := time.Now()
+	p := net.proposer()
+	assert.True(t, p >= 0)
+	proposer := net.cores[p]
+	assert.True(t, proposer.shouldPropose(true, now))
+	assert.False(t, proposer.shouldPropose(false, now))
+	proposer.propose(newTestBlock(proposer.parent), now)
+	net.deliver(now)
+	//观察节点也能确定区块
+	assert.Equal(t, 5, len(net.committed))
+	//区块头中的提交证明以外，所有节点确定的区块一致
+	unsigned := func(block *types.Block) *types.Block {
+		b := *block
+		b.Signature = nil
+		return &b
+	}
+	digest := blockDigest(unsigned(net.committed[p]))
+	for i := range net.cores {
+		assert.Equal(t, digest, blockDigest(unsigned(net.committed[i])))
+		assert.Equal(t, stepCommit, net.cores[i].getStatus().Step)
+	}
+	block := net.committed[p]
+	assert.Nil(t, net.cores[0].checkBlock(block))
+	other := *block
+	other.BlockTime++
+	assert.Equal(t, tmt.ErrBlockNotCommitted, net.cores[0].checkBlock(&other))
+	testVerifyCommit(t, net, p)
+}
+
+func TestTendermintBLSCommit(t *testing.T) {
+	net := newSignTestNetwork(t, "bls", []int64{1, 1, 1, 1}, true)
+	now := time.Now()
+	p := net.proposer()
+	proposer := net.cores[p]
+	proposer.propose(newTestBlock(proposer.parent), now)
+	net.deliver(now)
+	assert.Equal(t, 5, len(net.committed))
+	testVerifyCommit(t, net, p)
+}
+
+//testVerifyCommit 所有节点包括观察节点都把precommit的签名做成提交证明写入区块头，修改以后的提交证明不能通过检查
+func testVerifyCommit(t *testing.T, net *testNetwork, p int) {
+	vs := net.cores[p].validators
+	signTy := net.cores[p].signTy
+	for i := range net.cores {
+		block := net.committed[i]
+		assert.Equal(t, int32(types.BlockSignCommit), block.GetSignature().GetTy())
+		assert.True(t, block.CheckSign())
+		assert.Nil(t, verifyCommit(vs, signTy, block))
+	}
+
+	block := net.committed[p]
+	var commit tmt.TendermintCommit
+	assert.Nil(t, types.Decode(block.Signature.Signature, &commit))
+	tamper := func(f func(commit *tmt.TendermintCommit)) *types.Block {
+		c := commit
+		c.Signers = append([]byte{}, commit.Signers...)
+		c.Signatures = append([][]byte{}, commit.Signatures...)
+		f(&c)
+		other := *block
+		other.Signature = &types.Signature{Ty: types.BlockSignCommit, Signature: types.Encode(&c)}
+		return &other
+	}
+	//签名的节点不足2/3的投票权，或者签名的内容和投票不一致
+	assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, tamper(func(c *tmt.TendermintCommit) { c.Signers[0] &= 0x3 })))
+	assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, tamper(func(c *tmt.TendermintCommit) { c.Signers[0] ^= 0x1 })))
+	assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, tamper(func(c *tmt.TendermintCommit) { c.Round++ })))
+	assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, tamper(func(c *tmt.TendermintCommit) { c.BlockHash = []byte("a") })))
+	if len(commit.Signatures) > 0 {
+		assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, tamper(func(c *tmt.TendermintCommit) { c.Signatures = c.Signatures[1:] })))
+		assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, tamper(func(c *tmt.TendermintCommit) {
+			c.Signatures[0], c.Signatures[1] = c.Signatures[1], c.Signatures[0]
+		})))
+	}
+	//同一个高度的提交证明不能用在其他区块上
+	other := *block
+	other.BlockTime++
+	assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, &other))
+	other = *block
+	other.Height++
+	assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, &other))
+	//写入区块以后的stateHash不影响提交证明
+	other = *block
+	other.StateHash = []byte("state")
+	assert.Nil(t, verifyCommit(vs, signTy, &other))
+	//没有提交证明的区块不接受
+	other.Signature = nil
+	assert.Equal(t, tmt.ErrBlockCommit, verifyCommit(vs, signTy, &other))
+}
+
+func TestTendermintProposalTxs(t *testing.T) {
+	net := newTestNetwork(t, []int64{1, 1, 1, 1}, false)
+	now := time.Now()
+	p := net.proposer()
+	//提议区块中的交易写入区块的时候会被删除，其他验证节点投nil
+	for i, c := range net.cores {
+		if i != p {
+			c.checkTxs = func(parent, block *types.Block) error { return tmt.ErrProposalTxs }
+		}
+	}
+	proposer := net.cores[p]
+	proposer.propose(newTestBlock(proposer.parent), now)
+	net.deliver(now)
+	assert.Equal(t, 0, len(net.committed))
+	for i, c := range net.cores {
+		if i != p {
+			assert.Nil(t, c.signed["vote-0-1"].GetVote().BlockHash)
+			assert.Equal(t, int32(1), c.round)
+		}
+	}
+}
+
+func TestTendermintRoundChange(t *testing.T) {
+	net := newTestNetwork(t, []int64{1, 1, 1, 1}, false)
+	now := time.Now()
+	p := net.proposer()
+	net.down[p] = true
+	//提议节点没有提议，超时以后投票给nil，进入下一轮
+	now = now.Add(2 * time.Minute)
+	net.tick(now)
+	newp := -1
+	for i, c := range net.cores {
+		if !net.down[i] {
+			assert.Equal(t, int32(1), c.round)
+			if c.isProposer() {
+				newp = i
+			}
+		}
+	}
+	assert.True(t, newp >= 0 && newp != p)
+	proposer := net.cores[newp]
+	assert.True(t, proposer.shouldPropose(false, now))
+	proposer.propose(newTestBlock(proposer.parent), now)
+	net.deliver(now)
+	assert.Equal(t, 3, len(net.committed))
+}
+
+func TestTendermintWeightedPower(t *testing.T) {
+	//权重最大的节点没有投票，其他节点的投票权不足2/3，不能确定区块
+	net := newTestNetwork(t, []int64{1, 1, 1, 3}, false)
+	net.down[3] = true
+	now := time.Now()
+	for i := 0; i < 6 && net.proposer() == 3; i++ {
+		now = now.Add(2 * time.Minute)
+		net.tick(now)
+	}
+	p := net.proposer()
+	assert.True(t, p >= 0 && p != 3)
+	proposer := net.cores[p]
+	proposer.propose(newTestBlock(proposer.parent), now)
+	net.deliver(now)
+	assert.Equal(t, 0, len(net.committed))
+	assert.Equal(t, stepPrevote, net.cores[0].getStatus().Step)
+}
+
+func TestTendermintEvidence(t *testing.T) {
+	net := newTestNetwork(t, []int64{1, 1, 1, 1}, false)
+	now := time.Now()
+	bad := net.cores[0]
+	for _, hash := range [][]byte{[]byte("a"), []byte("b"), []byte("b")} {
+		msg := &tmt.TendermintMessage{Value: &tmt.TendermintMessage_Vote{
+			Vote: &tmt.TendermintVote{Height: 1, Round: 0, Type: tmt.VoteTypePrevote, BlockHash: hash},
+		}}
+		signMsg(bad.priv, bad.signTy, msg)
+		assert.Nil(t, net.cores[1].handleMessage(msg, now))
+	}
+	evidences := net.cores[1].getEvidences().Evidences
+	assert.Equal(t, 1, len(evidences))
+	assert.Equal(t, bad.pubkey, evidences[0].PubKey)
+	assert.Equal(t, []byte("a"), evidences[0].VoteA.GetVote().BlockHash)
+	assert.Equal(t, []byte("b"), evidences[0].VoteB.GetVote().BlockHash)
+}
+
+func TestTendermintBadMsg(t *testing.T) {
+	net := newTestNetwork(t, []int64{1, 1, 1, 1}, false)
+	now := time.Now()
+	p := net.proposer()
+	//非提议节点的提议
+	other := net.cores[(p+1)%4]
+	msg := &tmt.TendermintMessage{Value: &tmt.TendermintMessage_Proposal{
+		Proposal: &tmt.TendermintProposal{Height: 1, PolRound: -1, Block: newTestBlock(other.parent)},
+	}}
+	signMsg(other.priv, other.signTy, msg)
+	assert.Equal(t, tmt.ErrNotProposer, net.cores[p].handleMessage(msg, now))
+	//修改了签名以后的内容
+	msg.GetProposal().Round = 1
+	assert.Equal(t, tmt.ErrMsgSign, net.cores[p].handleMessage(msg, now))
+	//错误的投票类型
+	msg = &tmt.TendermintMessage{Value: &tmt.TendermintMessage_Vote{Vote: &tmt.TendermintVote{Height: 1, Type: 3}}}
+	signMsg(other.priv, other.signTy, msg)
+	assert.Equal(t, tmt.ErrVoteType, net.cores[p].handleMessage(msg, now))
+}
+
+func TestTendermintProposerStats(t *testing.T) {
+	net := newTestNetwork(t, []int64{1, 1, 1, 1}, false)
+	for _, c := range net.cores {
+		c.stats = drivers.NewProposerStats(driverName, 10, 50)
+	}
+	now := time.Now()
+	p := net.proposer()
+	net.down[p] = true
+	now = now.Add(2 * time.Minute)
+	net.tick(now)
+	newp := -1
+	for i, c := range net.cores {
+		if !net.down[i] && c.isProposer() {
+			newp = i
+		}
+	}
+	assert.True(t, newp >= 0)
+	proposer := net.cores[newp]
+	proposer.propose(newTestBlock(proposer.parent), now)
+	net.deliver(now)
+	stats := make(map[string]*drivers.ProposerStat)
+	for _, stat := range proposer.stats.List() {
+		stats[stat.Proposer] = stat
+	}
+	assert.Equal(t, 2, len(stats))
+	missed := stats[net.cores[p].pubkey]
+	assert.Equal(t, int64(1), missed.Expected)
+	assert.Equal(t, int64(0), missed.Produced)
+	assert.Equal(t, float64(1), missed.MissRate())
+	produced := stats[proposer.pubkey]
+	assert.Equal(t, int64(1), produced.Produced)
+	assert.Equal(t, float64(0), produced.MissRate())
+
+	var buf bytes.Buffer
+	drivers.WriteMetrics(&buf)
+	assert.Contains(t, buf.String(), fmt.Sprintf("chain33_proposer_expected_blocks_total{consensus=\"tendermint\",proposer=%q} 1", proposer.pubkey))
+}
@@ -0,0 +1,224 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tendermint
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	tmt "github.com/33cn/chain33/system/consensus/tendermint/types"
+	"github.com/33cn/chain33/system/crypto/bls"
+	"github.com/33cn/chain33/types"
+)
+
+//validatorSet 带投票权重的验证节点集合
+type validatorSet struct {
+	validators []*tmt.TendermintValidator
+	power      map[string]int64
+	total      int64
+}
+
+func newValidatorSet(validators []*tmt.TendermintValidator) *validatorSet {
+	vs := &validatorSet{power: make(map[string]int64)}
+	for _, v := range validators {
+		if v.Power <= 0 {
+			continue
+		}
+		if _, ok := vs.power[v.PubKey]; ok {
+			continue
+		}
+		if v.Address == "" {
+			if pub, err := common.FromHex(v.PubKey); err == nil {
+				v.Address = address.PubKeyToAddress(pub).String()
+			}
+		}
+		vs.power[v.PubKey] = v.Power
+		vs.total += v.Power
+		vs.validators = append(vs.validators, v)
+	}
+	return vs
+}
+
+func (vs *validatorSet) has(pubkey string) bool {
+	_, ok := vs.power[pubkey]
+	return ok
+}
+
+//hasQuorum 超过2/3的投票权
+func (vs *validatorSet) hasQuorum(power int64) bool {
+	return power*3 > vs.total*2
+}
+
+//hasOneThird 超过1/3的投票权，其中至少有一个正常节点
+func (vs *validatorSet) hasOneThird(power int64) bool {
+	return power*3 > vs.total
+}
+
+//proposer 按照投票权重轮流提议，权重越大的节点提议的次数越多
+func (vs *validatorSet) proposer(height int64, round int32) string {
+	if vs.total == 0 {
+		return ""
+	}
+	index := (height + int64(round)) % vs.total
+	for _, v := range vs.validators {
+		if index < v.Power {
+			return v.PubKey
+		}
+		index -= v.Power
+	}
+	return ""
+}
+
+func (vs *validatorSet) toProto() *tmt.TendermintValidators {
+	return &tmt.TendermintValidators{Validators: vs.validators, TotalPower: vs.total}
+}
+
+//blockDigest 提议区块的摘要，不包含执行以后才能确定的stateHash
+func blockDigest(block *types.Block) []byte {
+	return common.Sha256(types.Encode(block))
+}
+
+//proposalHash 区块写入以后增加了stateHash和提交证明，去掉以后就是验证节点投票的提议区块
+func proposalHash(block *types.Block) []byte {
+	proposal := *block
+	proposal.StateHash = nil
+	proposal.Signature = nil
+	return blockDigest(&proposal)
+}
+
+func signMsg(priv crypto.PrivKey, signTy int32, msg *tmt.TendermintMessage) {
+	msg.Sig = nil
+	data := types.Encode(msg)
+	msg.Sig = &types.Signature{
+		Ty:        signTy,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(data).Bytes(),
+	}
+}
+
+//verifyMsg 检查签名，返回签名的验证节点公钥
+func verifyMsg(vs *validatorSet, msg *tmt.TendermintMessage) (string, error) {
+	sig := msg.GetSig()
+	if sig == nil {
+		return "", tmt.ErrMsgSign
+	}
+	pubkey := common.ToHex(sig.Pubkey)
+	if !vs.has(pubkey) {
+		return "", tmt.ErrNotValidator
+	}
+	msg.Sig = nil
+	data := types.Encode(msg)
+	msg.Sig = sig
+	if !types.CheckSign(data, "", sig) {
+		return "", tmt.ErrMsgSign
+	}
+	return pubkey, nil
+}
+
+//precommitData 验证节点对区块的precommit签名的内容
+func precommitData(height int64, round int32, hash []byte) []byte {
+	return types.Encode(&tmt.TendermintMessage{Value: &tmt.TendermintMessage_Vote{
+		Vote: &tmt.TendermintVote{Height: height, Round: round, Type: tmt.VoteTypePrecommit, BlockHash: hash},
+	}})
+}
+
+func hasSigner(signers []byte, i int) bool {
+	return i/8 < len(signers) && signers[i/8]&(1<<uint(i%8)) != 0
+}
+
+//makeCommit 把对区块的precommit签名做成提交证明，签名的节点必须超过2/3的投票权，bls签名的时候聚合成一个签名
+func makeCommit(vs *validatorSet, signTy int32, votes map[string]*tmt.TendermintMessage, round int32, hash []byte) (*types.Signature, error) {
+	commit := &tmt.TendermintCommit{Round: round, BlockHash: hash, Signers: make([]byte, (len(vs.validators)+7)/8)}
+	var sigs []crypto.Signature
+	var power int64
+	for i, v := range vs.validators {
+		msg, ok := votes[v.PubKey]
+		if !ok || !bytes.Equal(msg.GetVote().BlockHash, hash) || msg.GetSig().GetTy() != signTy {
+			continue
+		}
+		if signTy == bls.ID {
+			sig, err := bls.Driver{}.SignatureFromBytes(msg.GetSig().GetSignature())
+			if err != nil {
+				continue
+			}
+			sigs = append(sigs, sig)
+		} else {
+			commit.Signatures = append(commit.Signatures, msg.GetSig().GetSignature())
+		}
+		commit.Signers[i/8] |= 1 << uint(i%8)
+		power += v.Power
+	}
+	if !vs.hasQuorum(power) {
+		return nil, tmt.ErrBlockCommit
+	}
+	if signTy == bls.ID {
+		agg, err := bls.Aggregate(sigs)
+		if err != nil {
+			return nil, err
+		}
+		commit.Signature = agg.Bytes()
+	}
+	return &types.Signature{Ty: types.BlockSignCommit, Signature: types.Encode(commit)}, nil
+}
+
+//verifyCommit 检查区块头中的提交证明，证明中的区块hash必须是这个区块对应的提议区块的hash，同一个高度的提交证明不能用在其他区块上，
+//没有提交证明的区块不接受
+func verifyCommit(vs *validatorSet, signTy int32, block *types.Block) error {
+	sig := block.GetSignature()
+	if sig == nil || sig.Ty != types.BlockSignCommit {
+		return tmt.ErrBlockCommit
+	}
+	var commit tmt.TendermintCommit
+	if err := types.Decode(sig.Signature, &commit); err != nil {
+		return tmt.ErrBlockCommit
+	}
+	if len(commit.Signers) != (len(vs.validators)+7)/8 || !bytes.Equal(commit.BlockHash, proposalHash(block)) {
+		return tmt.ErrBlockCommit
+	}
+	data := precommitData(block.Height, commit.Round, commit.BlockHash)
+	var pubs []crypto.PubKey
+	var power int64
+	signed := 0
+	for i, v := range vs.validators {
+		if !hasSigner(commit.Signers, i) {
+			continue
+		}
+		b, err := common.FromHex(v.PubKey)
+		if err != nil {
+			return tmt.ErrBlockCommit
+		}
+		if signTy == bls.ID {
+			pub, err := bls.Driver{}.PubKeyFromBytes(b)
+			if err != nil {
+				return tmt.ErrBlockCommit
+			}
+			pubs = append(pubs, pub)
+		} else if signed >= len(commit.Signatures) ||
+			!types.CheckSign(data, "", &types.Signature{Ty: signTy, Pubkey: b, Signature: commit.Signatures[signed]}) {
+			return tmt.ErrBlockCommit
+		}
+		signed++
+		power += v.Power
+	}
+	if !vs.hasQuorum(power) {
+		return tmt.ErrBlockCommit
+	}
+	if signTy != bls.ID {
+		if signed != len(commit.Signatures) {
+			return tmt.ErrBlockCommit
+		}
+		return nil
+	}
+	agg, err := bls.Driver{}.SignatureFromBytes(commit.Signature)
+	if err != nil {
+		return tmt.ErrBlockCommit
+	}
+	if !bls.VerifyAggregateMsg(pubs, data, agg) {
+		return tmt.ErrBlockCommit
+	}
+	return nil
+}
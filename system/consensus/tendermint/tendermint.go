@@ -0,0 +1,489 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tendermint tendermint风格的拜占庭容错共识，验证节点带有投票权重，
+// 每个高度经过propose, prevote, precommit多轮投票，并且收集验证节点重复投票的证据
+package tendermint
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/crypto/hsm"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	tmt "github.com/33cn/chain33/system/consensus/tendermint/types"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	vty "github.com/33cn/chain33/system/dapp/validator/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+var tlog = log.New("module", "tendermint")
+
+const (
+	driverName = "tendermint"
+	//tickInterval 状态机检查超时和出块的间隔
+	tickInterval = 100 * time.Millisecond
+)
+
+//Client 客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg     *subConfig
+	validators *validatorSet
+	core       *core
+	msgs       chan *tmt.TendermintMessage
+	priv       crypto.PrivKey
+	signTy     int32
+	//reported 已经提交过的作恶证据
+	reported map[string]bool
+}
+
+func init() {
+	drivers.Reg(driverName, New)
+	drivers.QueryData.Register(driverName, &Client{})
+}
+
+type validatorConfig struct {
+	PubKey string `json:"pubKey"`
+	Power  int64  `json:"power"`
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	//Validators 验证节点的公钥(hex)和投票权重
+	Validators []*validatorConfig `json:"validators"`
+	//ValidatorsFromChain 按照父区块的状态从validator合约读取验证节点，合约中没有验证节点的时候使用Validators
+	ValidatorsFromChain bool `json:"validatorsFromChain"`
+	//PrivKey 本节点验证节点的私钥，为空表示只跟随共识
+	PrivKey string `json:"privKey"`
+	//Hsm 验证节点的私钥保存在HSM中，使用Labels中的第一个标签，配置以后忽略PrivKey
+	Hsm *types.HSM `json:"hsm"`
+	//ReportEvidence 用验证节点的私钥签名交易，把收集到的作恶证据提交给validator合约
+	ReportEvidence bool `json:"reportEvidence"`
+	//SignType 验证节点的签名类型，默认ed25519，bls的时候区块头中的提交证明是聚合签名
+	SignType string `json:"signType"`
+	//TimeoutProposeMs 等待提议的时间，之后每一轮增加一半
+	TimeoutProposeMs int64 `json:"timeoutProposeMs"`
+	//TimeoutVoteMs 等待prevote和precommit的时间，之后每一轮增加一半
+	TimeoutVoteMs int64 `json:"timeoutVoteMs"`
+	//EmptyBlockIntervalMs 没有交易的时候，提议空块的间隔
+	EmptyBlockIntervalMs int64 `json:"emptyBlockIntervalMs"`
+	//WalPath 验证节点的写前日志，记录当前高度签名过的消息和锁定的区块，崩溃重启以后不会重复投票
+	WalPath string `json:"walPath"`
+	//StatsWindow 按验证节点最近多少次轮到出块统计漏块率
+	StatsWindow int64 `json:"statsWindow"`
+	//MissWarnPercent 验证节点最近的漏块率超过这个百分比的时候报警，0表示不报警
+	MissWarnPercent int64 `json:"missWarnPercent"`
+}
+
+//New new
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	if subcfg.SignType == "" {
+		subcfg.SignType = "ed25519"
+	}
+	if subcfg.TimeoutProposeMs <= 0 {
+		subcfg.TimeoutProposeMs = 3000
+	}
+	if subcfg.TimeoutVoteMs <= 0 {
+		subcfg.TimeoutVoteMs = 1000
+	}
+	if subcfg.EmptyBlockIntervalMs <= 0 {
+		subcfg.EmptyBlockIntervalMs = 30000
+	}
+	if subcfg.WalPath == "" {
+		subcfg.WalPath = "datadir/tendermint/wal"
+	}
+	if subcfg.StatsWindow <= 0 {
+		subcfg.StatsWindow = 100
+	}
+	signTy := int32(types.GetSignType("", subcfg.SignType))
+	var priv crypto.PrivKey
+	if subcfg.Hsm != nil {
+		var err error
+		priv, err = loadHSMSigner(subcfg.SignType, subcfg.Hsm)
+		if err != nil {
+			panic(err)
+		}
+	} else if subcfg.PrivKey != "" {
+		var err error
+		priv, err = loadPrivKey(subcfg.SignType, subcfg.PrivKey)
+		if err != nil {
+			panic(err)
+		}
+	}
+	var validators []*tmt.TendermintValidator
+	for _, v := range subcfg.Validators {
+		validators = append(validators, &tmt.TendermintValidator{PubKey: v.PubKey, Power: v.Power})
+	}
+	client := &Client{
+		BaseClient: c,
+		subcfg:     &subcfg,
+		validators: newValidatorSet(validators),
+		core: newCore(priv, signTy, time.Duration(subcfg.TimeoutProposeMs)*time.Millisecond,
+			time.Duration(subcfg.TimeoutVoteMs)*time.Millisecond,
+			time.Duration(subcfg.EmptyBlockIntervalMs)*time.Millisecond),
+		msgs:     make(chan *tmt.TendermintMessage, 4096),
+		priv:     priv,
+		signTy:   signTy,
+		reported: make(map[string]bool),
+	}
+	client.core.broadcast = client.broadcast
+	client.core.commit = client.commitBlock
+	client.core.checkTxs = client.checkTxs
+	client.core.stats = drivers.NewProposerStats(driverName, int(subcfg.StatsWindow), subcfg.MissWarnPercent)
+	if priv != nil {
+		w, records, err := openWAL(subcfg.WalPath)
+		if err != nil {
+			panic(err)
+		}
+		client.core.setWAL(w, records)
+	}
+	c.SetChild(client)
+	drivers.QueryData.SetThis(driverName, reflect.ValueOf(client))
+	return client
+}
+
+func loadPrivKey(signType, key string) (crypto.PrivKey, error) {
+	cr, err := crypto.New(signType)
+	if err != nil {
+		return nil, err
+	}
+	bkey, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(bkey)
+}
+
+//loadHSMSigner HSM中私钥的签名类型和验证节点的签名类型一致
+func loadHSMSigner(signType string, cfg *types.HSM) (crypto.PrivKey, error) {
+	if len(cfg.Labels) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	hcfg := *cfg
+	hcfg.SignType = signType
+	return hsm.New(&hcfg, hcfg.Labels[0])
+}
+
+//Close close
+func (client *Client) Close() {
+	if client.core.wal != nil {
+		client.core.wal.close()
+	}
+	tlog.Info("consensus tendermint closed")
+}
+
+//GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+//CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+//ProcEvent 接收p2p转发的共识消息
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	if msg.Ty != types.EventConsensusMsg {
+		return false
+	}
+	data := msg.GetData().(*types.P2PConsensus)
+	if data.Driver != driverName {
+		return true
+	}
+	var tmsg tmt.TendermintMessage
+	err := types.Decode(data.Data, &tmsg)
+	if err != nil {
+		tlog.Error("ProcEvent", "decode err", err)
+		return true
+	}
+	select {
+	case client.msgs <- &tmsg:
+	default:
+		tlog.Error("ProcEvent", "msg dropped", "queue full")
+	}
+	return true
+}
+
+//CheckBlock 区块头中必须有父区块的验证节点超过2/3投票权的提交证明，同步的区块和本节点参与共识的区块都要检查
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	if err := verifyCommit(client.getValidators(parent), client.signTy, current.Block); err != nil {
+		return err
+	}
+	return client.core.checkBlock(current.Block)
+}
+
+func (client *Client) broadcast(msg *tmt.TendermintMessage) {
+	err := client.BroadcastConsensus(driverName, types.Encode(msg))
+	if err != nil {
+		tlog.Error("broadcast", "err", err)
+	}
+}
+
+//commitBlock 区块最终确定以后写入区块链，写入的区块会被blockchain修改，所以使用复制的区块
+func (client *Client) commitBlock(parent, block *types.Block) {
+	var newblock types.Block
+	types.Decode(types.Encode(block), &newblock)
+	err := client.WriteBlock(parent.StateHash, &newblock)
+	if err != nil {
+		tlog.Error("commitBlock", "height", block.Height, "err", err)
+	}
+}
+
+//getValidators 每个高度的验证节点由父区块的状态决定，所有节点看到的验证节点一致
+func (client *Client) getValidators(parent *types.Block) *validatorSet {
+	if !client.subcfg.ValidatorsFromChain {
+		return client.validators
+	}
+	msg, err := client.GetAPI().QueryChain(&types.ChainExecutor{
+		Driver:    vty.ValidatorX,
+		FuncName:  vty.FuncNameGetValidators,
+		StateHash: parent.StateHash,
+		Param:     types.Encode(&types.ReqNil{}),
+	})
+	if err != nil {
+		tlog.Error("getValidators", "height", parent.Height, "err", err)
+		return client.validators
+	}
+	var validators []*tmt.TendermintValidator
+	for _, v := range msg.(*vty.ValidatorSet).Validators {
+		//作恶被移出的验证节点不参与共识
+		if v.Jailed {
+			continue
+		}
+		validators = append(validators, &tmt.TendermintValidator{PubKey: v.PubKey, Power: v.Power})
+	}
+	if len(validators) == 0 {
+		return client.validators
+	}
+	return newValidatorSet(validators)
+}
+
+func (client *Client) createBlock(parent *types.Block) *types.Block {
+	txs := client.RequestBlockCandidate(&types.ReqBlockCandidate{
+		Height:   parent.Height + 1,
+		MaxCount: client.GetMaxTxNumber(parent),
+	})
+	var newblock types.Block
+	newblock.ParentHash = parent.Hash()
+	newblock.Height = parent.Height + 1
+	client.AddTxsToBlock(&newblock, txs)
+	newblock.Difficulty = types.GetP(0).PowLimitBits
+	newblock.BlockTime = types.Now().Unix()
+	if parent.BlockTime > newblock.BlockTime {
+		newblock.BlockTime = parent.BlockTime
+	}
+	//去掉写入区块的时候会被删除的交易，其他验证节点才会给这个区块投票
+	txs, err := client.execTxs(parent, &newblock)
+	if err != nil {
+		tlog.Error("createBlock execTxs", "height", newblock.Height, "err", err)
+	} else {
+		newblock.Txs = txs
+	}
+	newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
+	return &newblock
+}
+
+//execTxs 在父区块的状态上预先执行区块中的交易，返回写入区块的时候不会被删除的交易，
+//重复的交易和执行出错的交易在写入区块的时候会被删除，写入的区块就和验证节点投票的区块不一致了
+func (client *Client) execTxs(parent, block *types.Block) ([]*types.Transaction, error) {
+	qclient := client.GetQueueClient()
+	cacheTxs, err := util.CheckTxDup(qclient, types.TxsToCache(block.Txs), block.Height)
+	if err != nil {
+		return nil, err
+	}
+	exec := *block
+	exec.Txs = types.CacheToTxs(cacheTxs)
+	receipts, err := util.ExecTx(qclient, parent.StateHash, &exec)
+	if err != nil {
+		return nil, err
+	}
+	var txs []*types.Transaction
+	for i, receipt := range receipts.Receipts {
+		if receipt.Ty != types.ExecErr {
+			txs = append(txs, exec.Txs[i])
+		}
+	}
+	return txs, nil
+}
+
+//checkTxs 提议区块中的交易都能写入区块
+func (client *Client) checkTxs(parent, block *types.Block) error {
+	txs, err := client.execTxs(parent, block)
+	if err != nil {
+		return err
+	}
+	if len(txs) != len(block.Txs) {
+		return tmt.ErrProposalTxs
+	}
+	return nil
+}
+
+//CreateBlock tendermint 状态机的主循环
+func (client *Client) CreateBlock() {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	for {
+		if client.IsClosed() {
+			break
+		}
+		select {
+		case msg := <-client.msgs:
+			err := client.core.handleMessage(msg, types.Now())
+			if err != nil {
+				tlog.Debug("handleMessage", "err", err)
+			}
+		case <-ticker.C:
+			client.onTick()
+		}
+	}
+}
+
+func (client *Client) onTick() {
+	if !client.IsCaughtUp() {
+		return
+	}
+	now := types.Now()
+	parent := client.GetCurrentBlock()
+	if client.core.parent == nil || client.core.height != parent.Height+1 {
+		client.core.newHeight(parent, client.getValidators(parent), now)
+	}
+	if client.core.shouldPropose(client.hasTx(), now) {
+		client.core.propose(client.createBlock(parent), now)
+	}
+	client.core.tick(now)
+	if client.subcfg.ReportEvidence && client.priv != nil {
+		client.reportEvidences()
+	}
+}
+
+func evidenceKey(e *tmt.TendermintEvidence) string {
+	vote := e.VoteA.GetVote()
+	return fmt.Sprintf("%s-%d-%d-%d", e.PubKey, vote.Height, vote.Round, vote.Type)
+}
+
+//reportEvidences 把新收集到的作恶证据发送到validator合约，罚没的质押奖励给提交证据的地址
+func (client *Client) reportEvidences() {
+	for _, e := range client.core.getEvidences().Evidences {
+		key := evidenceKey(e)
+		if client.reported[key] {
+			continue
+		}
+		client.reported[key] = true
+		tx, err := client.createEvidenceTx(e)
+		if err != nil {
+			tlog.Error("reportEvidences", "err", err)
+			continue
+		}
+		_, err = client.GetAPI().SendTx(tx)
+		if err != nil {
+			tlog.Error("reportEvidences", "SendTx err", err)
+		}
+	}
+}
+
+func (client *Client) createEvidenceTx(e *tmt.TendermintEvidence) (*types.Transaction, error) {
+	action := &vty.ValidatorAction{
+		Ty: vty.ValidatorActionEvidence,
+		Value: &vty.ValidatorAction_Evidence{Evidence: &vty.ValidatorEvidence{
+			VoteA: types.Encode(e.VoteA),
+			VoteB: types.Encode(e.VoteB),
+		}},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(vty.ValidatorX),
+		Payload: types.Encode(action),
+		To:      address.ExecAddress(vty.ValidatorX),
+		Nonce:   client.RandInt64(),
+		ChainID: types.GetChainID(),
+	}
+	fee, err := tx.GetRealFee(types.GInt("MinFee"))
+	if err != nil {
+		return nil, err
+	}
+	tx.Fee = fee
+	tx.Sign(client.signTy, client.priv)
+	return tx, nil
+}
+
+func (client *Client) hasTx() bool {
+	txs := client.RequestTx(1, nil)
+	return len(txs) > 0
+}
+
+//Query_GetValidators 获取当前高度的验证节点和投票权重
+func (client *Client) Query_GetValidators(req *types.ReqNil) (types.Message, error) {
+	if client.core == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	return client.core.getValidators(), nil
+}
+
+//Query_GetEvidences 获取验证节点重复投票的证据
+func (client *Client) Query_GetEvidences(req *types.ReqNil) (types.Message, error) {
+	if client.core == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	return client.core.getEvidences(), nil
+}
+
+//Query_GetStatus 获取当前的共识状态
+func (client *Client) Query_GetStatus(req *types.ReqNil) (types.Message, error) {
+	if client.core == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	return client.core.getStatus(), nil
+}
+
+//Query_GetProposerStats 获取本节点启动以后观察到的验证节点应该出块和实际出块的次数
+func (client *Client) Query_GetProposerStats(req *types.ReqNil) (types.Message, error) {
+	if client.core == nil || client.core.stats == nil {
+		return nil, types.ErrActionNotSupport
+	}
+	reply := &tmt.TendermintProposerStats{}
+	for _, stat := range client.core.stats.List() {
+		s := &tmt.TendermintProposerStat{
+			PubKey:         stat.Proposer,
+			Expected:       stat.Expected,
+			Produced:       stat.Produced,
+			RecentExpected: stat.RecentExpected,
+			RecentMissed:   stat.RecentMissed,
+		}
+		if pub, err := common.FromHex(stat.Proposer); err == nil {
+			s.Address = address.PubKeyToAddress(pub).String()
+		}
+		reply.Stats = append(reply.Stats, s)
+	}
+	return reply, nil
+}
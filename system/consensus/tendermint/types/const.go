@@ -0,0 +1,19 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// vote type
+const (
+	VoteTypePrevote   = 1
+	VoteTypePrecommit = 2
+)
+
+// query func name
+const (
+	FuncNameGetValidators    = "GetValidators"
+	FuncNameGetEvidences     = "GetEvidences"
+	FuncNameGetStatus        = "GetStatus"
+	FuncNameGetProposerStats = "GetProposerStats"
+)
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrNotValidator 消息不是验证节点签名的
+	ErrNotValidator = errors.New("ErrNotValidator")
+	// ErrMsgSign 消息签名错误
+	ErrMsgSign = errors.New("ErrMsgSign")
+	// ErrNotProposer 提议不是由这一轮的提议节点发出的
+	ErrNotProposer = errors.New("ErrNotProposer")
+	// ErrProposalBlock 提议的区块不合法
+	ErrProposalBlock = errors.New("ErrProposalBlock")
+	// ErrVoteType 投票类型错误
+	ErrVoteType = errors.New("ErrVoteType")
+	// ErrBlockNotCommitted 区块和验证节点提交的区块不一致
+	ErrBlockNotCommitted = errors.New("ErrBlockNotCommitted")
+	// ErrBlockCommit 区块头中的提交证明错误
+	ErrBlockCommit = errors.New("ErrBlockCommit")
+	// ErrProposalTxs 提议的区块中有写入区块的时候会被删除的交易
+	ErrProposalTxs = errors.New("ErrProposalTxs")
+)
@@ -0,0 +1,962 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tendermint.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	types "github.com/33cn/chain33/types"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// TendermintMessage 验证节点之间通过p2p广播的消息，sig是对value部分的签名
+type TendermintMessage struct {
+	// Types that are valid to be assigned to Value:
+	//	*TendermintMessage_Proposal
+	//	*TendermintMessage_Vote
+	Value                isTendermintMessage_Value `protobuf_oneof:"value"`
+	Sig                  *types.Signature          `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *TendermintMessage) Reset()         { *m = TendermintMessage{} }
+func (m *TendermintMessage) String() string { return proto.CompactTextString(m) }
+func (*TendermintMessage) ProtoMessage()    {}
+func (*TendermintMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{0}
+}
+
+func (m *TendermintMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintMessage.Unmarshal(m, b)
+}
+func (m *TendermintMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintMessage.Marshal(b, m, deterministic)
+}
+func (m *TendermintMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintMessage.Merge(m, src)
+}
+func (m *TendermintMessage) XXX_Size() int {
+	return xxx_messageInfo_TendermintMessage.Size(m)
+}
+func (m *TendermintMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintMessage proto.InternalMessageInfo
+
+type isTendermintMessage_Value interface {
+	isTendermintMessage_Value()
+}
+
+type TendermintMessage_Proposal struct {
+	Proposal *TendermintProposal `protobuf:"bytes,1,opt,name=proposal,proto3,oneof"`
+}
+
+type TendermintMessage_Vote struct {
+	Vote *TendermintVote `protobuf:"bytes,2,opt,name=vote,proto3,oneof"`
+}
+
+func (*TendermintMessage_Proposal) isTendermintMessage_Value() {}
+
+func (*TendermintMessage_Vote) isTendermintMessage_Value() {}
+
+func (m *TendermintMessage) GetValue() isTendermintMessage_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TendermintMessage) GetProposal() *TendermintProposal {
+	if x, ok := m.GetValue().(*TendermintMessage_Proposal); ok {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (m *TendermintMessage) GetVote() *TendermintVote {
+	if x, ok := m.GetValue().(*TendermintMessage_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+func (m *TendermintMessage) GetSig() *types.Signature {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*TendermintMessage) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _TendermintMessage_OneofMarshaler, _TendermintMessage_OneofUnmarshaler, _TendermintMessage_OneofSizer, []interface{}{
+		(*TendermintMessage_Proposal)(nil),
+		(*TendermintMessage_Vote)(nil),
+	}
+}
+
+func _TendermintMessage_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*TendermintMessage)
+	// value
+	switch x := m.Value.(type) {
+	case *TendermintMessage_Proposal:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Proposal); err != nil {
+			return err
+		}
+	case *TendermintMessage_Vote:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Vote); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("TendermintMessage.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _TendermintMessage_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*TendermintMessage)
+	switch tag {
+	case 1: // value.proposal
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TendermintProposal)
+		err := b.DecodeMessage(msg)
+		m.Value = &TendermintMessage_Proposal{msg}
+		return true, err
+	case 2: // value.vote
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TendermintVote)
+		err := b.DecodeMessage(msg)
+		m.Value = &TendermintMessage_Vote{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _TendermintMessage_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*TendermintMessage)
+	// value
+	switch x := m.Value.(type) {
+	case *TendermintMessage_Proposal:
+		s := proto.Size(x.Proposal)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TendermintMessage_Vote:
+		s := proto.Size(x.Vote)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// TendermintProposal 提议的区块，polRound是提议的区块得到超过2/3 prevote的轮次，没有的时候为-1
+type TendermintProposal struct {
+	Height               int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round                int32        `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	PolRound             int32        `protobuf:"varint,3,opt,name=polRound,proto3" json:"polRound,omitempty"`
+	Block                *types.Block `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TendermintProposal) Reset()         { *m = TendermintProposal{} }
+func (m *TendermintProposal) String() string { return proto.CompactTextString(m) }
+func (*TendermintProposal) ProtoMessage()    {}
+func (*TendermintProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{1}
+}
+
+func (m *TendermintProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintProposal.Unmarshal(m, b)
+}
+func (m *TendermintProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintProposal.Marshal(b, m, deterministic)
+}
+func (m *TendermintProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintProposal.Merge(m, src)
+}
+func (m *TendermintProposal) XXX_Size() int {
+	return xxx_messageInfo_TendermintProposal.Size(m)
+}
+func (m *TendermintProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintProposal proto.InternalMessageInfo
+
+func (m *TendermintProposal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TendermintProposal) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TendermintProposal) GetPolRound() int32 {
+	if m != nil {
+		return m.PolRound
+	}
+	return 0
+}
+
+func (m *TendermintProposal) GetBlock() *types.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+// TendermintVote prevote 和 precommit 投票，blockHash为空表示投nil
+type TendermintVote struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round                int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Type                 int32    `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,4,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TendermintVote) Reset()         { *m = TendermintVote{} }
+func (m *TendermintVote) String() string { return proto.CompactTextString(m) }
+func (*TendermintVote) ProtoMessage()    {}
+func (*TendermintVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{2}
+}
+
+func (m *TendermintVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintVote.Unmarshal(m, b)
+}
+func (m *TendermintVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintVote.Marshal(b, m, deterministic)
+}
+func (m *TendermintVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintVote.Merge(m, src)
+}
+func (m *TendermintVote) XXX_Size() int {
+	return xxx_messageInfo_TendermintVote.Size(m)
+}
+func (m *TendermintVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintVote proto.InternalMessageInfo
+
+func (m *TendermintVote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TendermintVote) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TendermintVote) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *TendermintVote) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+// TendermintCommit 区块头中的提交证明，signers按验证节点的顺序每一位标记一个签名的节点，
+// bls签名的时候signature是这些节点对区块的precommit的聚合签名，其他签名类型的时候signatures按顺序保存每个节点的precommit签名
+type TendermintCommit struct {
+	Round                int32    `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Signers              []byte   `protobuf:"bytes,3,opt,name=signers,proto3" json:"signers,omitempty"`
+	Signature            []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signatures           [][]byte `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TendermintCommit) Reset()         { *m = TendermintCommit{} }
+func (m *TendermintCommit) String() string { return proto.CompactTextString(m) }
+func (*TendermintCommit) ProtoMessage()    {}
+func (*TendermintCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{3}
+}
+
+func (m *TendermintCommit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintCommit.Unmarshal(m, b)
+}
+func (m *TendermintCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintCommit.Marshal(b, m, deterministic)
+}
+func (m *TendermintCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintCommit.Merge(m, src)
+}
+func (m *TendermintCommit) XXX_Size() int {
+	return xxx_messageInfo_TendermintCommit.Size(m)
+}
+func (m *TendermintCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintCommit proto.InternalMessageInfo
+
+func (m *TendermintCommit) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TendermintCommit) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *TendermintCommit) GetSigners() []byte {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *TendermintCommit) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *TendermintCommit) GetSignatures() [][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// TendermintEvidence 同一个验证节点在同一轮对不同的区块投票的证据
+type TendermintEvidence struct {
+	VoteA                *TendermintMessage `protobuf:"bytes,1,opt,name=voteA,proto3" json:"voteA,omitempty"`
+	VoteB                *TendermintMessage `protobuf:"bytes,2,opt,name=voteB,proto3" json:"voteB,omitempty"`
+	PubKey               string             `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TendermintEvidence) Reset()         { *m = TendermintEvidence{} }
+func (m *TendermintEvidence) String() string { return proto.CompactTextString(m) }
+func (*TendermintEvidence) ProtoMessage()    {}
+func (*TendermintEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{4}
+}
+
+func (m *TendermintEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintEvidence.Unmarshal(m, b)
+}
+func (m *TendermintEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintEvidence.Marshal(b, m, deterministic)
+}
+func (m *TendermintEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintEvidence.Merge(m, src)
+}
+func (m *TendermintEvidence) XXX_Size() int {
+	return xxx_messageInfo_TendermintEvidence.Size(m)
+}
+func (m *TendermintEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintEvidence proto.InternalMessageInfo
+
+func (m *TendermintEvidence) GetVoteA() *TendermintMessage {
+	if m != nil {
+		return m.VoteA
+	}
+	return nil
+}
+
+func (m *TendermintEvidence) GetVoteB() *TendermintMessage {
+	if m != nil {
+		return m.VoteB
+	}
+	return nil
+}
+
+func (m *TendermintEvidence) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type TendermintEvidences struct {
+	Evidences            []*TendermintEvidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TendermintEvidences) Reset()         { *m = TendermintEvidences{} }
+func (m *TendermintEvidences) String() string { return proto.CompactTextString(m) }
+func (*TendermintEvidences) ProtoMessage()    {}
+func (*TendermintEvidences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{5}
+}
+
+func (m *TendermintEvidences) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintEvidences.Unmarshal(m, b)
+}
+func (m *TendermintEvidences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintEvidences.Marshal(b, m, deterministic)
+}
+func (m *TendermintEvidences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintEvidences.Merge(m, src)
+}
+func (m *TendermintEvidences) XXX_Size() int {
+	return xxx_messageInfo_TendermintEvidences.Size(m)
+}
+func (m *TendermintEvidences) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintEvidences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintEvidences proto.InternalMessageInfo
+
+func (m *TendermintEvidences) GetEvidences() []*TendermintEvidence {
+	if m != nil {
+		return m.Evidences
+	}
+	return nil
+}
+
+// TendermintValidator 验证节点以及投票权重
+type TendermintValidator struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Power                int64    `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TendermintValidator) Reset()         { *m = TendermintValidator{} }
+func (m *TendermintValidator) String() string { return proto.CompactTextString(m) }
+func (*TendermintValidator) ProtoMessage()    {}
+func (*TendermintValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{6}
+}
+
+func (m *TendermintValidator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintValidator.Unmarshal(m, b)
+}
+func (m *TendermintValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintValidator.Marshal(b, m, deterministic)
+}
+func (m *TendermintValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintValidator.Merge(m, src)
+}
+func (m *TendermintValidator) XXX_Size() int {
+	return xxx_messageInfo_TendermintValidator.Size(m)
+}
+func (m *TendermintValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintValidator proto.InternalMessageInfo
+
+func (m *TendermintValidator) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *TendermintValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *TendermintValidator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type TendermintValidators struct {
+	Validators           []*TendermintValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	TotalPower           int64                  `protobuf:"varint,2,opt,name=totalPower,proto3" json:"totalPower,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *TendermintValidators) Reset()         { *m = TendermintValidators{} }
+func (m *TendermintValidators) String() string { return proto.CompactTextString(m) }
+func (*TendermintValidators) ProtoMessage()    {}
+func (*TendermintValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{7}
+}
+
+func (m *TendermintValidators) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintValidators.Unmarshal(m, b)
+}
+func (m *TendermintValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintValidators.Marshal(b, m, deterministic)
+}
+func (m *TendermintValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintValidators.Merge(m, src)
+}
+func (m *TendermintValidators) XXX_Size() int {
+	return xxx_messageInfo_TendermintValidators.Size(m)
+}
+func (m *TendermintValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintValidators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintValidators proto.InternalMessageInfo
+
+func (m *TendermintValidators) GetValidators() []*TendermintValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *TendermintValidators) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+// TendermintProposerStat 本节点启动以后观察到的验证节点出块统计，recent开头的是最近的统计
+type TendermintProposerStat struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Expected             int64    `protobuf:"varint,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	Produced             int64    `protobuf:"varint,4,opt,name=produced,proto3" json:"produced,omitempty"`
+	RecentExpected       int64    `protobuf:"varint,5,opt,name=recentExpected,proto3" json:"recentExpected,omitempty"`
+	RecentMissed         int64    `protobuf:"varint,6,opt,name=recentMissed,proto3" json:"recentMissed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TendermintProposerStat) Reset()         { *m = TendermintProposerStat{} }
+func (m *TendermintProposerStat) String() string { return proto.CompactTextString(m) }
+func (*TendermintProposerStat) ProtoMessage()    {}
+func (*TendermintProposerStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{8}
+}
+
+func (m *TendermintProposerStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintProposerStat.Unmarshal(m, b)
+}
+func (m *TendermintProposerStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintProposerStat.Marshal(b, m, deterministic)
+}
+func (m *TendermintProposerStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintProposerStat.Merge(m, src)
+}
+func (m *TendermintProposerStat) XXX_Size() int {
+	return xxx_messageInfo_TendermintProposerStat.Size(m)
+}
+func (m *TendermintProposerStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintProposerStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintProposerStat proto.InternalMessageInfo
+
+func (m *TendermintProposerStat) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *TendermintProposerStat) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TendermintProposerStat) GetExpected() int64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *TendermintProposerStat) GetProduced() int64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *TendermintProposerStat) GetRecentExpected() int64 {
+	if m != nil {
+		return m.RecentExpected
+	}
+	return 0
+}
+
+func (m *TendermintProposerStat) GetRecentMissed() int64 {
+	if m != nil {
+		return m.RecentMissed
+	}
+	return 0
+}
+
+type TendermintProposerStats struct {
+	Stats                []*TendermintProposerStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *TendermintProposerStats) Reset()         { *m = TendermintProposerStats{} }
+func (m *TendermintProposerStats) String() string { return proto.CompactTextString(m) }
+func (*TendermintProposerStats) ProtoMessage()    {}
+func (*TendermintProposerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{9}
+}
+
+func (m *TendermintProposerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintProposerStats.Unmarshal(m, b)
+}
+func (m *TendermintProposerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintProposerStats.Marshal(b, m, deterministic)
+}
+func (m *TendermintProposerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintProposerStats.Merge(m, src)
+}
+func (m *TendermintProposerStats) XXX_Size() int {
+	return xxx_messageInfo_TendermintProposerStats.Size(m)
+}
+func (m *TendermintProposerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintProposerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintProposerStats proto.InternalMessageInfo
+
+func (m *TendermintProposerStats) GetStats() []*TendermintProposerStat {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// TendermintStatus 共识状态
+type TendermintStatus struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round                int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Step                 string   `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	Proposer             string   `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	LockedRound          int32    `protobuf:"varint,5,opt,name=lockedRound,proto3" json:"lockedRound,omitempty"`
+	ValidRound           int32    `protobuf:"varint,6,opt,name=validRound,proto3" json:"validRound,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TendermintStatus) Reset()         { *m = TendermintStatus{} }
+func (m *TendermintStatus) String() string { return proto.CompactTextString(m) }
+func (*TendermintStatus) ProtoMessage()    {}
+func (*TendermintStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{10}
+}
+
+func (m *TendermintStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintStatus.Unmarshal(m, b)
+}
+func (m *TendermintStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintStatus.Marshal(b, m, deterministic)
+}
+func (m *TendermintStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintStatus.Merge(m, src)
+}
+func (m *TendermintStatus) XXX_Size() int {
+	return xxx_messageInfo_TendermintStatus.Size(m)
+}
+func (m *TendermintStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintStatus proto.InternalMessageInfo
+
+func (m *TendermintStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TendermintStatus) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TendermintStatus) GetStep() string {
+	if m != nil {
+		return m.Step
+	}
+	return ""
+}
+
+func (m *TendermintStatus) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *TendermintStatus) GetLockedRound() int32 {
+	if m != nil {
+		return m.LockedRound
+	}
+	return 0
+}
+
+func (m *TendermintStatus) GetValidRound() int32 {
+	if m != nil {
+		return m.ValidRound
+	}
+	return 0
+}
+
+// TendermintLock 锁定和valid的区块，改变的时候写入写前日志
+type TendermintLock struct {
+	LockedRound          int32        `protobuf:"varint,1,opt,name=lockedRound,proto3" json:"lockedRound,omitempty"`
+	LockedBlock          *types.Block `protobuf:"bytes,2,opt,name=lockedBlock,proto3" json:"lockedBlock,omitempty"`
+	ValidRound           int32        `protobuf:"varint,3,opt,name=validRound,proto3" json:"validRound,omitempty"`
+	ValidBlock           *types.Block `protobuf:"bytes,4,opt,name=validBlock,proto3" json:"validBlock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *TendermintLock) Reset()         { *m = TendermintLock{} }
+func (m *TendermintLock) String() string { return proto.CompactTextString(m) }
+func (*TendermintLock) ProtoMessage()    {}
+func (*TendermintLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{11}
+}
+
+func (m *TendermintLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintLock.Unmarshal(m, b)
+}
+func (m *TendermintLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintLock.Marshal(b, m, deterministic)
+}
+func (m *TendermintLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintLock.Merge(m, src)
+}
+func (m *TendermintLock) XXX_Size() int {
+	return xxx_messageInfo_TendermintLock.Size(m)
+}
+func (m *TendermintLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintLock proto.InternalMessageInfo
+
+func (m *TendermintLock) GetLockedRound() int32 {
+	if m != nil {
+		return m.LockedRound
+	}
+	return 0
+}
+
+func (m *TendermintLock) GetLockedBlock() *types.Block {
+	if m != nil {
+		return m.LockedBlock
+	}
+	return nil
+}
+
+func (m *TendermintLock) GetValidRound() int32 {
+	if m != nil {
+		return m.ValidRound
+	}
+	return 0
+}
+
+func (m *TendermintLock) GetValidBlock() *types.Block {
+	if m != nil {
+		return m.ValidBlock
+	}
+	return nil
+}
+
+// TendermintWALRecord 写前日志的记录，本节点签名的消息在广播之前写入，只有height的记录表示开始新的高度
+type TendermintWALRecord struct {
+	Height               int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Msg                  *TendermintMessage `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Lock                 *TendermintLock    `protobuf:"bytes,3,opt,name=lock,proto3" json:"lock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TendermintWALRecord) Reset()         { *m = TendermintWALRecord{} }
+func (m *TendermintWALRecord) String() string { return proto.CompactTextString(m) }
+func (*TendermintWALRecord) ProtoMessage()    {}
+func (*TendermintWALRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{12}
+}
+
+func (m *TendermintWALRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TendermintWALRecord.Unmarshal(m, b)
+}
+func (m *TendermintWALRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TendermintWALRecord.Marshal(b, m, deterministic)
+}
+func (m *TendermintWALRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintWALRecord.Merge(m, src)
+}
+func (m *TendermintWALRecord) XXX_Size() int {
+	return xxx_messageInfo_TendermintWALRecord.Size(m)
+}
+func (m *TendermintWALRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintWALRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintWALRecord proto.InternalMessageInfo
+
+func (m *TendermintWALRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TendermintWALRecord) GetMsg() *TendermintMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *TendermintWALRecord) GetLock() *TendermintLock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TendermintMessage)(nil), "types.TendermintMessage")
+	proto.RegisterType((*TendermintProposal)(nil), "types.TendermintProposal")
+	proto.RegisterType((*TendermintVote)(nil), "types.TendermintVote")
+	proto.RegisterType((*TendermintCommit)(nil), "types.TendermintCommit")
+	proto.RegisterType((*TendermintEvidence)(nil), "types.TendermintEvidence")
+	proto.RegisterType((*TendermintEvidences)(nil), "types.TendermintEvidences")
+	proto.RegisterType((*TendermintValidator)(nil), "types.TendermintValidator")
+	proto.RegisterType((*TendermintValidators)(nil), "types.TendermintValidators")
+	proto.RegisterType((*TendermintProposerStat)(nil), "types.TendermintProposerStat")
+	proto.RegisterType((*TendermintProposerStats)(nil), "types.TendermintProposerStats")
+	proto.RegisterType((*TendermintStatus)(nil), "types.TendermintStatus")
+	proto.RegisterType((*TendermintLock)(nil), "types.TendermintLock")
+	proto.RegisterType((*TendermintWALRecord)(nil), "types.TendermintWALRecord")
+}
+
+func init() { proto.RegisterFile("tendermint.proto", fileDescriptor_04f926c8da23c367) }
+
+var fileDescriptor_04f926c8da23c367 = []byte{
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0xe3, 0x38, 0x6d, 0xa6, 0x51, 0x95, 0x2e, 0xa5, 0x98, 0x08, 0x50, 0xb4, 0x07, 0x54,
+	0x7e, 0x94, 0x03, 0x3d, 0x54, 0xe2, 0xd6, 0xa0, 0x4a, 0x95, 0x68, 0xab, 0x6a, 0x8b, 0xca, 0x89,
+	0x83, 0x6b, 0xaf, 0x12, 0xab, 0x89, 0xd7, 0xda, 0xdd, 0x04, 0x7a, 0x45, 0x1c, 0x79, 0x06, 0x8e,
+	0x9c, 0x11, 0x0f, 0xc2, 0x33, 0xa1, 0x1d, 0xaf, 0x9d, 0xcd, 0x9f, 0xa0, 0x37, 0x7f, 0x33, 0xdf,
+	0x7e, 0x33, 0x3b, 0xb3, 0x33, 0x86, 0xb6, 0xe6, 0x59, 0xc2, 0xe5, 0x38, 0xcd, 0x74, 0x2f, 0x97,
+	0x42, 0x0b, 0x12, 0xe8, 0xbb, 0x9c, 0xab, 0x4e, 0xfb, 0x66, 0x24, 0xe2, 0xdb, 0x78, 0x18, 0xa5,
+	0x59, 0xe1, 0xe8, 0xec, 0x6a, 0x19, 0x65, 0x2a, 0x8a, 0x75, 0x2a, 0xac, 0x89, 0xfe, 0xf4, 0x60,
+	0xf7, 0x43, 0x25, 0x70, 0xce, 0x95, 0x8a, 0x06, 0x9c, 0x1c, 0xc1, 0x56, 0x2e, 0x45, 0x2e, 0x54,
+	0x34, 0x0a, 0xbd, 0xae, 0x77, 0xb0, 0xfd, 0xe6, 0x71, 0x0f, 0x45, 0x7b, 0x33, 0xee, 0xa5, 0x25,
+	0x9c, 0x6e, 0xb0, 0x8a, 0x4c, 0x5e, 0x41, 0x7d, 0x2a, 0x34, 0x0f, 0x6b, 0x78, 0xe8, 0xe1, 0xd2,
+	0xa1, 0x6b, 0xa1, 0xf9, 0xe9, 0x06, 0x43, 0x12, 0xa1, 0xe0, 0xab, 0x74, 0x10, 0xfa, 0xc8, 0x6d,
+	0x5b, 0xee, 0x55, 0x3a, 0xc8, 0x22, 0x3d, 0x91, 0x9c, 0x19, 0x67, 0x7f, 0x13, 0x82, 0x69, 0x34,
+	0x9a, 0x70, 0xfa, 0xd5, 0x03, 0xb2, 0x1c, 0x9c, 0xec, 0x43, 0x63, 0xc8, 0xd3, 0xc1, 0x50, 0x63,
+	0x9e, 0x3e, 0xb3, 0x88, 0xec, 0x41, 0x20, 0xc5, 0x24, 0x4b, 0x30, 0x93, 0x80, 0x15, 0x80, 0x74,
+	0x60, 0x2b, 0x17, 0x23, 0x86, 0x0e, 0x1f, 0x1d, 0x15, 0x26, 0x14, 0x02, 0x2c, 0x58, 0x58, 0xc7,
+	0x7c, 0x5a, 0x36, 0x9f, 0xbe, 0xb1, 0xb1, 0xc2, 0x45, 0x73, 0xd8, 0x99, 0xbf, 0xcb, 0x3d, 0xe3,
+	0x13, 0xa8, 0x1b, 0x55, 0x1b, 0x1b, 0xbf, 0xc9, 0x13, 0x68, 0xa2, 0xf8, 0x69, 0xa4, 0x86, 0x18,
+	0xbb, 0xc5, 0x66, 0x06, 0xfa, 0xc3, 0x83, 0xf6, 0x2c, 0xe4, 0x3b, 0x31, 0x1e, 0xa7, 0x8e, 0xb8,
+	0xe7, 0x8a, 0xcf, 0x09, 0xd5, 0x16, 0x84, 0x48, 0x08, 0x9b, 0x2a, 0x1d, 0x64, 0x5c, 0x2a, 0x8c,
+	0xde, 0x62, 0x25, 0x34, 0xe7, 0x54, 0x59, 0xf4, 0x32, 0x81, 0xca, 0x40, 0x9e, 0x01, 0x54, 0x40,
+	0x85, 0x41, 0xd7, 0x3f, 0x68, 0x31, 0xc7, 0x42, 0xbf, 0xcf, 0xf5, 0xe5, 0x64, 0x9a, 0x26, 0x3c,
+	0x8b, 0x39, 0xe9, 0x41, 0x60, 0x7a, 0x7c, 0x6c, 0x9f, 0x4f, 0xb8, 0xf4, 0x12, 0xec, 0x53, 0x63,
+	0x05, 0xad, 0xe4, 0xf7, 0xc3, 0xda, 0xff, 0xf0, 0xfb, 0xa6, 0xee, 0xf9, 0xe4, 0xe6, 0x3d, 0xbf,
+	0xc3, 0xdb, 0x34, 0x99, 0x45, 0xf4, 0x02, 0x1e, 0x2c, 0x67, 0xa3, 0xc8, 0x11, 0x34, 0x79, 0x09,
+	0x42, 0xaf, 0xeb, 0xaf, 0x7c, 0xd1, 0x25, 0x9d, 0xcd, 0xb8, 0xf4, 0x93, 0xab, 0x77, 0x1d, 0x8d,
+	0xd2, 0x24, 0xd2, 0x42, 0x3a, 0xe1, 0x3d, 0x37, 0xbc, 0xe9, 0x4c, 0x2e, 0x3e, 0x73, 0x89, 0xd7,
+	0xf0, 0x59, 0x01, 0x4c, 0xed, 0xa3, 0x24, 0x91, 0x5c, 0x29, 0x9b, 0x6d, 0x09, 0xa9, 0x84, 0xbd,
+	0x15, 0xf2, 0x8a, 0xbc, 0x05, 0x98, 0x56, 0xc8, 0x26, 0xdc, 0x59, 0x9e, 0xa6, 0x92, 0xc2, 0x1c,
+	0xb6, 0xe9, 0x98, 0x16, 0x3a, 0x1a, 0x5d, 0x3a, 0x89, 0x38, 0x16, 0xfa, 0xc7, 0x83, 0xfd, 0xc5,
+	0x49, 0xe2, 0xf2, 0x4a, 0x47, 0x7a, 0xed, 0xb5, 0x9c, 0x0b, 0xd4, 0xe6, 0x2e, 0x60, 0x26, 0x8a,
+	0x7f, 0xc9, 0x79, 0xac, 0x79, 0x31, 0x51, 0x3e, 0xab, 0xb0, 0xf1, 0xe5, 0x52, 0x24, 0x93, 0x98,
+	0x27, 0xf8, 0xae, 0x7c, 0x56, 0x61, 0xf2, 0x1c, 0x76, 0x24, 0x8f, 0x79, 0xa6, 0x4f, 0xca, 0xd3,
+	0x01, 0x32, 0x16, 0xac, 0x84, 0x42, 0xab, 0xb0, 0x9c, 0xa7, 0x4a, 0xf1, 0x24, 0x6c, 0x20, 0x6b,
+	0xce, 0x46, 0x2f, 0xe0, 0xd1, 0xea, 0xfb, 0x28, 0x72, 0x08, 0x81, 0x32, 0x1f, 0xb6, 0x84, 0x4f,
+	0xd7, 0x6c, 0xb1, 0x82, 0xce, 0x0a, 0x2e, 0xfd, 0x3d, 0x37, 0x73, 0xc6, 0x33, 0x51, 0xf7, 0x1f,
+	0x74, 0xa5, 0x79, 0x6e, 0xdb, 0x8d, 0xdf, 0xb6, 0x1c, 0x18, 0x0d, 0xcb, 0xd1, 0x64, 0x15, 0x26,
+	0x5d, 0xd8, 0x36, 0x93, 0xca, 0x93, 0x62, 0x37, 0x05, 0xa8, 0xe5, 0x9a, 0x4c, 0x57, 0xb1, 0xc7,
+	0x05, 0xa1, 0x81, 0x04, 0xc7, 0x42, 0x7f, 0x79, 0xee, 0x6e, 0x3a, 0x13, 0xf1, 0xed, 0xa2, 0xa8,
+	0xb7, 0x2c, 0xda, 0x2b, 0x19, 0xb8, 0xe5, 0xc2, 0xda, 0x8a, 0xcd, 0xe7, 0x12, 0x16, 0x92, 0xf0,
+	0x17, 0x93, 0x20, 0xaf, 0xad, 0xbf, 0xbf, 0x76, 0x91, 0x3a, 0x7e, 0xfa, 0xcd, 0x73, 0x87, 0xeb,
+	0xe3, 0xf1, 0x19, 0xe3, 0xb1, 0x90, 0xc9, 0xda, 0x52, 0xbf, 0x04, 0x7f, 0xac, 0x06, 0xff, 0xdc,
+	0x10, 0x86, 0x44, 0x5e, 0x40, 0x1d, 0x73, 0xf0, 0xd7, 0xfc, 0x88, 0x4c, 0x81, 0x18, 0x52, 0x6e,
+	0x1a, 0xf8, 0x27, 0x3c, 0xfc, 0x3b, 0x00, 0x3b, 0x33, 0xfc, 0xc3, 0x49, 0x07, 0x00, 0x00,
+}
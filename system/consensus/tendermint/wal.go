@@ -0,0 +1,109 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tendermint
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	tmt "github.com/33cn/chain33/system/consensus/tendermint/types"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	//walHeaderSize 每条记录前面是4字节的长度和4字节的crc32
+	walHeaderSize = 8
+	//maxWALRecordSize 记录中包含区块，超过这个大小认为是损坏的记录
+	maxWALRecordSize = 64 * 1024 * 1024
+)
+
+//wal 写前日志，只保存当前高度的记录，每条记录写入以后sync到磁盘
+type wal struct {
+	file *os.File
+}
+
+//openWAL 打开写前日志并读取所有的记录，崩溃时没有写完整的记录会被截掉
+func openWAL(path string) (*wal, []*tmt.TendermintWALRecord, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, nil, err
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	records, offset := readWAL(file)
+	if err := file.Truncate(offset); err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	return &wal{file: file}, records, nil
+}
+
+//readWAL 返回完整的记录和这些记录结束的位置
+func readWAL(r io.Reader) ([]*tmt.TendermintWALRecord, int64) {
+	var records []*tmt.TendermintWALRecord
+	var offset int64
+	header := make([]byte, walHeaderSize)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return records, offset
+		}
+		size := binary.BigEndian.Uint32(header[:4])
+		if size > maxWALRecordSize {
+			tlog.Error("readWAL", "offset", offset, "err", "record too large")
+			return records, offset
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			tlog.Error("readWAL", "offset", offset, "err", err)
+			return records, offset
+		}
+		if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(header[4:]) {
+			tlog.Error("readWAL", "offset", offset, "err", "crc mismatch")
+			return records, offset
+		}
+		var record tmt.TendermintWALRecord
+		if err := types.Decode(data, &record); err != nil {
+			tlog.Error("readWAL", "offset", offset, "err", err)
+			return records, offset
+		}
+		records = append(records, &record)
+		offset += int64(walHeaderSize + len(data))
+	}
+}
+
+func (w *wal) write(record *tmt.TendermintWALRecord) error {
+	data := types.Encode(record)
+	buf := make([]byte, walHeaderSize+len(data))
+	binary.BigEndian.PutUint32(buf[:4], uint32(len(data)))
+	binary.BigEndian.PutUint32(buf[4:walHeaderSize], crc32.ChecksumIEEE(data))
+	copy(buf[walHeaderSize:], data)
+	if _, err := w.file.Write(buf); err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+//reset 开始新的高度，之前高度的区块已经提交，不再需要之前的记录
+func (w *wal) reset(height int64) error {
+	if err := w.file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := w.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return w.write(&tmt.TendermintWALRecord{Height: height})
+}
+
+func (w *wal) close() error {
+	return w.file.Close()
+}
@@ -0,0 +1,137 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tendermint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tmt "github.com/33cn/chain33/system/consensus/tendermint/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWALTornTail(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tendermint-wal")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "wal", "wal")
+	w, records, err := openWAL(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(records))
+	assert.Nil(t, w.reset(5))
+	assert.Nil(t, w.write(&tmt.TendermintWALRecord{Height: 5, Lock: &tmt.TendermintLock{LockedRound: 1}}))
+	w.close()
+
+	//写了一半的记录
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	assert.Nil(t, err)
+	_, err = file.Write([]byte{0, 0, 0, 100, 1, 2, 3})
+	assert.Nil(t, err)
+	file.Close()
+
+	w, records, err = openWAL(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, int64(5), records[0].Height)
+	assert.Equal(t, int32(1), records[1].Lock.LockedRound)
+	assert.Nil(t, w.write(&tmt.TendermintWALRecord{Height: 5, Lock: &tmt.TendermintLock{LockedRound: 2}}))
+	w.close()
+
+	w, records, err = openWAL(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(records))
+	assert.Equal(t, int32(2), records[2].Lock.LockedRound)
+	w.close()
+}
+
+func TestTendermintWALRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tendermint-wal")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "wal")
+
+	net := newTestNetwork(t, []int64{1, 1, 1, 1}, false)
+	now := time.Now()
+	p := net.proposer()
+	w := (p + 1) % 4
+	//用带写前日志的节点替换网络中的节点w
+	net.down[w] = true
+	parent := net.cores[0].parent
+	validators := net.cores[0].validators
+	var sent []*tmt.TendermintMessage
+	newWALCore := func() *core {
+		wal, records, err := openWAL(path)
+		assert.Nil(t, err)
+		c := newCore(net.cores[w].priv, types.ED25519, time.Second, time.Second, time.Minute)
+		c.setWAL(wal, records)
+		c.broadcast = func(msg *tmt.TendermintMessage) {
+			sent = append(sent, msg)
+			net.queue = append(net.queue, msg)
+		}
+		c.commit = func(parent, block *types.Block) {
+			net.committed[w] = block
+		}
+		c.newHeight(parent, validators, now)
+		return c
+	}
+	c := newWALCore()
+	deliver := func(stop func() bool) {
+		for len(net.queue) > 0 && !stop() {
+			msg := net.queue[0]
+			net.queue = net.queue[1:]
+			data := types.Encode(msg)
+			for i, other := range append(net.cores, c) {
+				if i == w {
+					continue
+				}
+				var m tmt.TendermintMessage
+				types.Decode(data, &m)
+				other.handleMessage(&m, now)
+			}
+		}
+	}
+
+	block := newTestBlock(parent)
+	net.cores[p].propose(block, now)
+	//节点w锁定区块并且投出precommit以后崩溃
+	deliver(func() bool { return c.step == stepPrecommit })
+	assert.Equal(t, int32(0), c.lockedRound)
+	assert.Equal(t, 2, len(sent))
+	signed := sent
+	c.wal.close()
+
+	sent = nil
+	c = newWALCore()
+	assert.Equal(t, int32(0), c.lockedRound)
+	assert.Equal(t, blockDigest(block), blockDigest(c.lockedBlock))
+	//重新广播崩溃前签名的消息，签名不变
+	assert.Equal(t, 2, len(sent))
+	for i := range signed {
+		assert.Equal(t, types.Encode(signed[i]), types.Encode(sent[i]))
+	}
+	//超时以后不会重新签名新的投票
+	sent = nil
+	c.tick(now.Add(2 * time.Minute))
+	for _, msg := range sent {
+		if vote := msg.GetVote(); vote != nil && vote.Round == 0 {
+			assert.Equal(t, blockDigest(block), vote.BlockHash)
+		}
+	}
+	deliver(func() bool { return false })
+	committed := *net.committed[w]
+	committed.Signature = nil
+	assert.Equal(t, blockDigest(block), blockDigest(&committed))
+	//新的高度清空写前日志
+	c.newHeight(net.committed[w], validators, now)
+	c.wal.close()
+	_, records, err := openWAL(path)
+	assert.Nil(t, err)
+	assert.True(t, len(records) > 0)
+	assert.Equal(t, int64(2), records[0].Height)
+}
@@ -0,0 +1,350 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bls bls12-381签名，公钥在G1上，签名在G2上，多个签名可以聚合成一个签名
+package bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common/crypto"
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+//const
+const (
+	Name = "bls"
+	ID   = 6
+
+	//PrivKeyLength 私钥是32字节的标量
+	PrivKeyLength = 32
+	//PubKeyLength 压缩格式的G1点
+	PubKeyLength = 48
+	//SignatureLength 压缩格式的G2点
+	SignatureLength = 96
+)
+
+//dst 消息映射到G2的域分隔标签，popDST 用于公钥的持有证明，和普通的签名区分开
+var (
+	dst    = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+	popDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+//Driver 驱动
+type Driver struct{}
+
+//GenKey 生成私钥
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	order := bls12381.NewG1().Q()
+	for {
+		k, err := rand.Int(rand.Reader, order)
+		if err != nil {
+			return nil, err
+		}
+		if k.Sign() > 0 {
+			var priv PrivKeyBLS
+			k.FillBytes(priv[:])
+			return priv, nil
+		}
+	}
+}
+
+//PrivKeyFromBytes 字节转为私钥，私钥必须在(0, r)的范围内
+func (d Driver) PrivKeyFromBytes(b []byte) (crypto.PrivKey, error) {
+	if len(b) != PrivKeyLength {
+		return nil, errors.New("invalid priv key byte")
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Sign() == 0 || k.Cmp(bls12381.NewG1().Q()) >= 0 {
+		return nil, errors.New("invalid priv key byte")
+	}
+	var priv PrivKeyBLS
+	copy(priv[:], b)
+	return priv, nil
+}
+
+//PubKeyFromBytes 字节转为公钥，检查点在正确的子群上，并且不是无穷远点
+func (d Driver) PubKeyFromBytes(b []byte) (crypto.PubKey, error) {
+	if _, err := decodePubKey(b); err != nil {
+		return nil, err
+	}
+	var pub PubKeyBLS
+	copy(pub[:], b)
+	return pub, nil
+}
+
+//SignatureFromBytes 字节转为签名
+func (d Driver) SignatureFromBytes(b []byte) (crypto.Signature, error) {
+	if _, err := decodeSignature(b); err != nil {
+		return nil, err
+	}
+	var sig SignatureBLS
+	copy(sig[:], b)
+	return sig, nil
+}
+
+func decodePubKey(b []byte) (*bls12381.PointG1, error) {
+	if len(b) != PubKeyLength {
+		return nil, errors.New("invalid pub key byte")
+	}
+	g1 := bls12381.NewG1()
+	p, err := g1.FromCompressed(b)
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) {
+		return nil, errors.New("invalid pub key byte")
+	}
+	return p, nil
+}
+
+func decodeSignature(b []byte) (*bls12381.PointG2, error) {
+	if len(b) != SignatureLength {
+		return nil, errors.New("invalid signature byte")
+	}
+	return bls12381.NewG2().FromCompressed(b)
+}
+
+func hashToG2(msg, dst []byte) (*bls12381.PointG2, error) {
+	return bls12381.NewG2().HashToCurve(msg, dst)
+}
+
+func sign(privKey PrivKeyBLS, msg, dst []byte) SignatureBLS {
+	h, err := hashToG2(msg, dst)
+	if err != nil {
+		panic(err)
+	}
+	g2 := bls12381.NewG2()
+	g2.MulScalarBig(h, h, new(big.Int).SetBytes(privKey[:]))
+	var sig SignatureBLS
+	copy(sig[:], g2.ToCompressed(h))
+	return sig
+}
+
+//PrivKeyBLS PrivKey
+type PrivKeyBLS [PrivKeyLength]byte
+
+//Bytes 字节格式
+func (privKey PrivKeyBLS) Bytes() []byte {
+	s := make([]byte, PrivKeyLength)
+	copy(s, privKey[:])
+	return s
+}
+
+//Sign 签名，sig = sk * H(msg)
+func (privKey PrivKeyBLS) Sign(msg []byte) crypto.Signature {
+	return sign(privKey, msg, dst)
+}
+
+//PubKey 公钥，pk = sk * G1
+func (privKey PrivKeyBLS) PubKey() crypto.PubKey {
+	g1 := bls12381.NewG1()
+	p := g1.New()
+	g1.MulScalarBig(p, g1.One(), new(big.Int).SetBytes(privKey[:]))
+	var pub PubKeyBLS
+	copy(pub[:], g1.ToCompressed(p))
+	return pub
+}
+
+//Equals 相等
+func (privKey PrivKeyBLS) Equals(other crypto.PrivKey) bool {
+	if otherBLS, ok := other.(PrivKeyBLS); ok {
+		return bytes.Equal(privKey[:], otherBLS[:])
+	}
+	return false
+}
+
+//PubKeyBLS PubKey
+type PubKeyBLS [PubKeyLength]byte
+
+//Bytes 字节格式
+func (pubKey PubKeyBLS) Bytes() []byte {
+	s := make([]byte, PubKeyLength)
+	copy(s, pubKey[:])
+	return s
+}
+
+//VerifyBytes 验证签名，e(pk, H(msg)) == e(G1, sig)
+func (pubKey PubKeyBLS) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	return VerifyAggregate([]crypto.PubKey{pubKey}, [][]byte{msg}, sig)
+}
+
+//KeyString 公钥字符串格式
+func (pubKey PubKeyBLS) KeyString() string {
+	return fmt.Sprintf("%X", pubKey[:])
+}
+
+//Equals 相等
+func (pubKey PubKeyBLS) Equals(other crypto.PubKey) bool {
+	if otherBLS, ok := other.(PubKeyBLS); ok {
+		return bytes.Equal(pubKey[:], otherBLS[:])
+	}
+	return false
+}
+
+//SignatureBLS Signature
+type SignatureBLS [SignatureLength]byte
+
+//Bytes 字节格式
+func (sig SignatureBLS) Bytes() []byte {
+	s := make([]byte, SignatureLength)
+	copy(s, sig[:])
+	return s
+}
+
+//IsZero 是否是0
+func (sig SignatureBLS) IsZero() bool { return len(sig) == 0 }
+
+func (sig SignatureBLS) String() string {
+	fingerprint := make([]byte, len(sig[:]))
+	copy(fingerprint, sig[:])
+	return fmt.Sprintf("/%X.../", fingerprint)
+}
+
+//Equals 相等
+func (sig SignatureBLS) Equals(other crypto.Signature) bool {
+	if otherBLS, ok := other.(SignatureBLS); ok {
+		return bytes.Equal(sig[:], otherBLS[:])
+	}
+	return false
+}
+
+//Aggregate 把多个签名聚合成一个签名
+func Aggregate(sigs []crypto.Signature) (crypto.Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signature to aggregate")
+	}
+	g2 := bls12381.NewG2()
+	sum := g2.Zero()
+	for _, sig := range sigs {
+		blsSig, ok := sig.(SignatureBLS)
+		if !ok {
+			return nil, errors.New("not bls signature")
+		}
+		p, err := decodeSignature(blsSig[:])
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(sum, sum, p)
+	}
+	var agg SignatureBLS
+	copy(agg[:], g2.ToCompressed(sum))
+	return agg, nil
+}
+
+//AggregatePubKey 把多个公钥聚合成一个公钥，用于验证同一个消息的聚合签名
+func AggregatePubKey(pubs []crypto.PubKey) (crypto.PubKey, error) {
+	if len(pubs) == 0 {
+		return nil, errors.New("no pub key to aggregate")
+	}
+	g1 := bls12381.NewG1()
+	sum := g1.Zero()
+	for _, pub := range pubs {
+		blsPub, ok := pub.(PubKeyBLS)
+		if !ok {
+			return nil, errors.New("not bls pub key")
+		}
+		p, err := decodePubKey(blsPub[:])
+		if err != nil {
+			return nil, err
+		}
+		g1.Add(sum, sum, p)
+	}
+	if g1.IsZero(sum) {
+		return nil, errors.New("aggregate pub key is zero")
+	}
+	var agg PubKeyBLS
+	copy(agg[:], g1.ToCompressed(sum))
+	return agg, nil
+}
+
+//VerifyAggregate 验证聚合签名，第i个公钥签名第i个消息，e(pk1, H(m1))*...*e(pkn, H(mn)) == e(G1, sig)，
+//不同的公钥签名同一个消息的时候，公钥必须是可信的，比如配置的验证节点，否则可以构造抵消其他公钥的恶意公钥
+func VerifyAggregate(pubs []crypto.PubKey, msgs [][]byte, sig crypto.Signature) bool {
+	if len(pubs) == 0 || len(pubs) != len(msgs) {
+		return false
+	}
+	blsSig, ok := sig.(SignatureBLS)
+	if !ok {
+		return false
+	}
+	s, err := decodeSignature(blsSig[:])
+	if err != nil {
+		return false
+	}
+	engine := bls12381.NewEngine()
+	for i, pub := range pubs {
+		blsPub, ok := pub.(PubKeyBLS)
+		if !ok {
+			return false
+		}
+		p, err := decodePubKey(blsPub[:])
+		if err != nil {
+			return false
+		}
+		h, err := hashToG2(msgs[i], dst)
+		if err != nil {
+			return false
+		}
+		engine.AddPair(p, h)
+	}
+	engine.AddPairInv(engine.G1.One(), s)
+	return engine.Check()
+}
+
+//ProvePossession 私钥对自己的公钥签名，证明持有公钥对应的私钥
+func ProvePossession(priv crypto.PrivKey) (crypto.Signature, error) {
+	blsPriv, ok := priv.(PrivKeyBLS)
+	if !ok {
+		return nil, errors.New("not bls priv key")
+	}
+	return sign(blsPriv, blsPriv.PubKey().Bytes(), popDST), nil
+}
+
+//VerifyPossession 检查公钥的持有证明，没有持有证明的公钥可以构造成抵消其他公钥的恶意公钥
+func VerifyPossession(pub crypto.PubKey, proof crypto.Signature) bool {
+	blsPub, ok := pub.(PubKeyBLS)
+	if !ok {
+		return false
+	}
+	blsProof, ok := proof.(SignatureBLS)
+	if !ok {
+		return false
+	}
+	p, err := decodePubKey(blsPub[:])
+	if err != nil {
+		return false
+	}
+	s, err := decodeSignature(blsProof[:])
+	if err != nil {
+		return false
+	}
+	h, err := hashToG2(blsPub[:], popDST)
+	if err != nil {
+		return false
+	}
+	engine := bls12381.NewEngine()
+	engine.AddPair(p, h)
+	engine.AddPairInv(engine.G1.One(), s)
+	return engine.Check()
+}
+
+//VerifyAggregateMsg 验证多个公钥对同一个消息的聚合签名，比如验证节点对同一个区块的投票，
+//公钥必须是配置的可信公钥，或者检查过持有证明的公钥
+func VerifyAggregateMsg(pubs []crypto.PubKey, msg []byte, sig crypto.Signature) bool {
+	pub, err := AggregatePubKey(pubs)
+	if err != nil {
+		return false
+	}
+	return pub.VerifyBytes(msg, sig)
+}
+
+func init() {
+	crypto.Register(Name, &Driver{})
+	crypto.RegisterType(Name, ID)
+}
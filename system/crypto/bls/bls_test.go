@@ -0,0 +1,98 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bls
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func genKeys(t *testing.T, n int) []crypto.PrivKey {
+	var privs []crypto.PrivKey
+	for i := 0; i < n; i++ {
+		priv, err := Driver{}.GenKey()
+		assert.Nil(t, err)
+		privs = append(privs, priv)
+	}
+	return privs
+}
+
+func TestSign(t *testing.T) {
+	d := Driver{}
+	priv := genKeys(t, 1)[0]
+	msg := []byte("hello bls")
+	sig := priv.Sign(msg)
+	assert.Equal(t, SignatureLength, len(sig.Bytes()))
+	assert.Equal(t, PubKeyLength, len(priv.PubKey().Bytes()))
+	assert.True(t, priv.PubKey().VerifyBytes(msg, sig))
+	assert.False(t, priv.PubKey().VerifyBytes([]byte("other"), sig))
+	assert.False(t, genKeys(t, 1)[0].PubKey().VerifyBytes(msg, sig))
+
+	pub, err := d.PubKeyFromBytes(priv.PubKey().Bytes())
+	assert.Nil(t, err)
+	sig2, err := d.SignatureFromBytes(sig.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, pub.VerifyBytes(msg, sig2))
+
+	_, err = d.PrivKeyFromBytes(make([]byte, PrivKeyLength))
+	assert.NotNil(t, err)
+	_, err = d.PubKeyFromBytes(make([]byte, PubKeyLength))
+	assert.NotNil(t, err)
+	_, err = d.SignatureFromBytes(sig.Bytes()[1:])
+	assert.NotNil(t, err)
+}
+
+func TestAggregate(t *testing.T) {
+	privs := genKeys(t, 4)
+	msg := []byte("vote")
+	var pubs []crypto.PubKey
+	var sigs []crypto.Signature
+	var msgs [][]byte
+	var msgSigs []crypto.Signature
+	for i, priv := range privs {
+		pubs = append(pubs, priv.PubKey())
+		sigs = append(sigs, priv.Sign(msg))
+		m := []byte(fmt.Sprintf("msg-%d", i))
+		msgs = append(msgs, m)
+		msgSigs = append(msgSigs, priv.Sign(m))
+	}
+
+	//同一个消息的签名聚合以后用聚合公钥验证
+	agg, err := Aggregate(sigs)
+	assert.Nil(t, err)
+	assert.True(t, VerifyAggregateMsg(pubs, msg, agg))
+	assert.False(t, VerifyAggregateMsg(pubs[:3], msg, agg))
+	aggPub, err := AggregatePubKey(pubs)
+	assert.Nil(t, err)
+	assert.True(t, aggPub.VerifyBytes(msg, agg))
+
+	//不同消息的签名聚合以后逐个配对验证
+	agg, err = Aggregate(msgSigs)
+	assert.Nil(t, err)
+	assert.True(t, VerifyAggregate(pubs, msgs, agg))
+	msgs[0], msgs[1] = msgs[1], msgs[0]
+	assert.False(t, VerifyAggregate(pubs, msgs, agg))
+	assert.False(t, VerifyAggregate(pubs[:3], msgs, agg))
+
+	_, err = Aggregate(nil)
+	assert.NotNil(t, err)
+	_, err = AggregatePubKey(nil)
+	assert.NotNil(t, err)
+}
+
+func TestPossession(t *testing.T) {
+	privs := genKeys(t, 2)
+	proof, err := ProvePossession(privs[0])
+	assert.Nil(t, err)
+	assert.True(t, VerifyPossession(privs[0].PubKey(), proof))
+	assert.False(t, VerifyPossession(privs[1].PubKey(), proof))
+	//普通的签名不能作为持有证明
+	sig := privs[0].Sign(privs[0].PubKey().Bytes())
+	assert.False(t, VerifyPossession(privs[0].PubKey(), sig))
+	assert.False(t, privs[0].PubKey().VerifyBytes(privs[0].PubKey().Bytes(), proof))
+}
@@ -25,9 +25,9 @@ func (d Driver) GenKey() (crypto.PrivKey, error) {
 	return PrivKeyEd25519(*privKeyBytes), nil
 }
 
-//PrivKeyFromBytes 字节转为私钥
+//PrivKeyFromBytes 字节转为私钥，支持32字节的种子和64字节的私钥，公钥总是由种子重新计算
 func (d Driver) PrivKeyFromBytes(b []byte) (privKey crypto.PrivKey, err error) {
-	if len(b) != 64 {
+	if len(b) != 32 && len(b) != 64 {
 		return nil, errors.New("invalid priv key byte")
 	}
 	privKeyBytes := new([64]byte)
@@ -48,6 +48,9 @@ func (d Driver) PubKeyFromBytes(b []byte) (pubKey crypto.PubKey, err error) {
 
 //SignatureFromBytes 字节转为签名
 func (d Driver) SignatureFromBytes(b []byte) (sig crypto.Signature, err error) {
+	if len(b) != 64 {
+		return nil, errors.New("invalid signature byte")
+	}
 	sigBytes := new([64]byte)
 	copy(sigBytes[:], b[:])
 	return SignatureEd25519(*sigBytes), nil
@@ -111,6 +114,40 @@ func (pubKey PubKeyEd25519) VerifyBytes(msg []byte, sig crypto.Signature) bool {
 	return ed25519.Verify(&pubKeyBytes, msg, &sigBytes)
 }
 
+//BatchVerify 相同的公钥只解码一次，然后并行的逐个验证签名，没有使用ed25519的代数批量验证
+func (d Driver) BatchVerify(items []*crypto.BatchItem) bool {
+	parsed := make(map[PubKeyEd25519]*ed25519.PublicKey)
+	pubs := make([]*ed25519.PublicKey, len(items))
+	sigs := make([]*[64]byte, len(items))
+	for i, item := range items {
+		pubKey, ok := item.PubKey.(PubKeyEd25519)
+		if !ok {
+			return false
+		}
+		sig := item.Sig
+		if wrap, ok := sig.(SignatureS); ok {
+			sig = wrap.Signature
+		}
+		sigEd25519, ok := sig.(SignatureEd25519)
+		if !ok {
+			return false
+		}
+		pub, ok := parsed[pubKey]
+		if !ok {
+			pubKeyBytes := [32]byte(pubKey)
+			if pub, ok = ed25519.ParsePublicKey(&pubKeyBytes); !ok {
+				return false
+			}
+			parsed[pubKey] = pub
+		}
+		sigBytes := [64]byte(sigEd25519)
+		pubs[i], sigs[i] = pub, &sigBytes
+	}
+	return crypto.VerifyParallel(len(items), func(i int) bool {
+		return pubs[i].Verify(items[i].Msg, sigs[i])
+	})
+}
+
 //KeyString 公钥字符串格式
 func (pubKey PubKeyEd25519) KeyString() string {
 	return fmt.Sprintf("%X", pubKey[:])
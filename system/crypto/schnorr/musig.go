@@ -0,0 +1,301 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schnorr
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/33cn/chain33/common/crypto"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+//MuSig 的错误
+var (
+	ErrMuSigPubKeys    = errors.New("ErrMuSigPubKeys")
+	ErrMuSigSigner     = errors.New("ErrMuSigSigner")
+	ErrMuSigCommitment = errors.New("ErrMuSigCommitment")
+	ErrMuSigNonce      = errors.New("ErrMuSigNonce")
+	ErrMuSigPartial    = errors.New("ErrMuSigPartial")
+	ErrMuSigStep       = errors.New("ErrMuSigStep")
+)
+
+//keyAgg 排序以后的公钥和每个公钥的系数，X = a1*P1 + ... + an*Pn，ai = H(L || Pi)，L是所有公钥的hash，
+//系数防止签名者构造抵消其他公钥的恶意公钥
+type keyAgg struct {
+	pubs   [][]byte
+	coeffs map[string]*big.Int
+	points map[string]*secp256k1.PublicKey
+	agg    PubKeySchnorr
+}
+
+func newKeyAgg(pubs []crypto.PubKey) (*keyAgg, error) {
+	if len(pubs) == 0 {
+		return nil, ErrMuSigPubKeys
+	}
+	ka := &keyAgg{coeffs: make(map[string]*big.Int), points: make(map[string]*secp256k1.PublicKey)}
+	for _, pub := range pubs {
+		b := pub.Bytes()
+		if len(b) != PubKeyLength {
+			return nil, ErrMuSigPubKeys
+		}
+		if _, ok := ka.points[string(b)]; ok {
+			return nil, ErrMuSigPubKeys
+		}
+		p, err := secp256k1.ParsePubKey(b, curve)
+		if err != nil {
+			return nil, err
+		}
+		ka.points[string(b)] = p
+		ka.pubs = append(ka.pubs, b)
+	}
+	sort.Slice(ka.pubs, func(i, j int) bool { return bytes.Compare(ka.pubs[i], ka.pubs[j]) < 0 })
+	l := taggedHash("chain33/musig/list", ka.pubs...)
+	var x, y *big.Int
+	for _, b := range ka.pubs {
+		a := hashToScalar("chain33/musig/coeff", l, b)
+		ka.coeffs[string(b)] = a
+		p := ka.points[string(b)]
+		px, py := curve.ScalarMult(p.X, p.Y, scalarBytes(a))
+		if x == nil {
+			x, y = px, py
+		} else {
+			x, y = curve.Add(x, y, px, py)
+		}
+	}
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, ErrMuSigPubKeys
+	}
+	copy(ka.agg[:], (&secp256k1.PublicKey{Curve: curve, X: x, Y: y}).SerializeCompressed())
+	return ka, nil
+}
+
+//AggregatePubKey MuSig聚合公钥，和公钥的顺序无关，聚合公钥的地址可以像普通地址一样接收资产
+func AggregatePubKey(pubs []crypto.PubKey) (crypto.PubKey, error) {
+	ka, err := newKeyAgg(pubs)
+	if err != nil {
+		return nil, err
+	}
+	return ka.agg, nil
+}
+
+//MuSig 一个签名者参与的MuSig签名会话，签名分为三轮：
+//1. 交换随机数的承诺 Commitment
+//2. 收到所有承诺以后交换随机数 Nonce
+//3. 收到所有随机数以后交换部分签名 PartialSign，所有的部分签名合成一个聚合公钥的签名
+//一个会话只能签名一次，随机数不能在不同的会话中重复使用
+type MuSig struct {
+	ka          *keyAgg
+	priv        PrivKeySchnorr
+	pub         string
+	msg         []byte
+	k           *big.Int
+	nonce       []byte
+	commitments map[string][]byte
+	nonces      map[string]*secp256k1.PublicKey
+	partials    map[string]*big.Int
+	rx          []byte
+	negate      bool
+	e           *big.Int
+}
+
+//NewMuSig 创建签名会话，pubs是所有签名者的公钥，必须包含priv的公钥
+func NewMuSig(priv crypto.PrivKey, pubs []crypto.PubKey, msg []byte) (*MuSig, error) {
+	var key PrivKeySchnorr
+	if len(priv.Bytes()) != PrivKeyLength {
+		return nil, ErrMuSigSigner
+	}
+	copy(key[:], priv.Bytes())
+	ka, err := newKeyAgg(pubs)
+	if err != nil {
+		return nil, err
+	}
+	pub := string(key.PubKey().Bytes())
+	if _, ok := ka.coeffs[pub]; !ok {
+		return nil, ErrMuSigSigner
+	}
+	//随机数混合私钥和消息，随机数生成器有问题的时候也不会在不同的消息上重复
+	k := hashToScalar("chain33/musig/nonce", crypto.CRandBytes(32), key[:], msg)
+	if k.Sign() == 0 {
+		return nil, ErrMuSigNonce
+	}
+	rx, ry := curve.ScalarBaseMult(scalarBytes(k))
+	m := &MuSig{
+		ka:          ka,
+		priv:        key,
+		pub:         pub,
+		msg:         msg,
+		k:           k,
+		nonce:       (&secp256k1.PublicKey{Curve: curve, X: rx, Y: ry}).SerializeCompressed(),
+		commitments: make(map[string][]byte),
+		nonces:      make(map[string]*secp256k1.PublicKey),
+		partials:    make(map[string]*big.Int),
+	}
+	m.commitments[pub] = m.Commitment()
+	m.nonces[pub] = &secp256k1.PublicKey{Curve: curve, X: rx, Y: ry}
+	return m, nil
+}
+
+func nonceCommitment(nonce []byte) []byte {
+	return taggedHash("chain33/musig/commitment", nonce)
+}
+
+//AggPubKey 聚合公钥
+func (m *MuSig) AggPubKey() crypto.PubKey {
+	return m.ka.agg
+}
+
+//Commitment 本签名者随机数的承诺，第一轮发送给其他签名者
+func (m *MuSig) Commitment() []byte {
+	return nonceCommitment(m.nonce)
+}
+
+//Nonce 本签名者的随机数R = k*G，收到所有签名者的承诺以后才能发送
+func (m *MuSig) Nonce() ([]byte, error) {
+	if len(m.commitments) != len(m.ka.pubs) {
+		return nil, ErrMuSigStep
+	}
+	return m.nonce, nil
+}
+
+func (m *MuSig) checkSigner(pub crypto.PubKey) (string, error) {
+	key := string(pub.Bytes())
+	if _, ok := m.ka.coeffs[key]; !ok {
+		return "", ErrMuSigSigner
+	}
+	return key, nil
+}
+
+//AddCommitment 收到其他签名者随机数的承诺
+func (m *MuSig) AddCommitment(pub crypto.PubKey, commitment []byte) error {
+	key, err := m.checkSigner(pub)
+	if err != nil {
+		return err
+	}
+	if old, ok := m.commitments[key]; ok && !bytes.Equal(old, commitment) {
+		return ErrMuSigCommitment
+	}
+	m.commitments[key] = commitment
+	return nil
+}
+
+//AddNonce 收到其他签名者的随机数，随机数必须和之前收到的承诺一致
+func (m *MuSig) AddNonce(pub crypto.PubKey, nonce []byte) error {
+	key, err := m.checkSigner(pub)
+	if err != nil {
+		return err
+	}
+	if len(m.commitments) != len(m.ka.pubs) {
+		return ErrMuSigStep
+	}
+	if !bytes.Equal(m.commitments[key], nonceCommitment(nonce)) {
+		return ErrMuSigCommitment
+	}
+	r, err := secp256k1.ParsePubKey(nonce, curve)
+	if err != nil {
+		return ErrMuSigNonce
+	}
+	m.nonces[key] = r
+	return nil
+}
+
+//prepare 收到所有随机数以后计算聚合的R，R的y坐标是奇数的时候所有签名者的随机数取反
+func (m *MuSig) prepare() error {
+	if m.e != nil {
+		return nil
+	}
+	if len(m.nonces) != len(m.ka.pubs) {
+		return ErrMuSigStep
+	}
+	var x, y *big.Int
+	for _, b := range m.ka.pubs {
+		r := m.nonces[string(b)]
+		if x == nil {
+			x, y = r.X, r.Y
+		} else {
+			x, y = curve.Add(x, y, r.X, r.Y)
+		}
+	}
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return ErrMuSigNonce
+	}
+	m.rx = scalarBytes(x)
+	m.negate = !isEven(y)
+	m.e = challenge(m.rx, m.ka.agg[:], m.msg)
+	return nil
+}
+
+//PartialSign 本签名者的部分签名 si = ki + e*ai*xi，签名以后随机数被清除，同一个会话不能再签名
+func (m *MuSig) PartialSign() ([]byte, error) {
+	if m.k == nil {
+		return nil, ErrMuSigStep
+	}
+	if err := m.prepare(); err != nil {
+		return nil, err
+	}
+	k := m.k
+	if m.negate {
+		k = new(big.Int).Sub(curve.N, k)
+	}
+	s := new(big.Int).Mul(m.e, m.ka.coeffs[m.pub])
+	s.Mul(s, new(big.Int).SetBytes(m.priv[:]))
+	s.Add(s, k)
+	s.Mod(s, curve.N)
+	m.k = nil
+	m.partials[m.pub] = s
+	return scalarBytes(s), nil
+}
+
+//AddPartial 收到其他签名者的部分签名，检查 si*G = Ri + e*ai*Pi
+func (m *MuSig) AddPartial(pub crypto.PubKey, partial []byte) error {
+	key, err := m.checkSigner(pub)
+	if err != nil {
+		return err
+	}
+	if err := m.prepare(); err != nil {
+		return err
+	}
+	s := new(big.Int).SetBytes(partial)
+	if len(partial) != 32 || s.Cmp(curve.N) >= 0 {
+		return ErrMuSigPartial
+	}
+	r := m.nonces[key]
+	ry := r.Y
+	if m.negate {
+		ry = new(big.Int).Sub(curve.P, r.Y)
+	}
+	p := m.ka.points[key]
+	ea := new(big.Int).Mul(m.e, m.ka.coeffs[key])
+	ea.Mod(ea, curve.N)
+	ex, ey := curve.ScalarMult(p.X, p.Y, scalarBytes(ea))
+	x1, y1 := curve.Add(r.X, ry, ex, ey)
+	x2, y2 := curve.ScalarBaseMult(scalarBytes(s))
+	if x1.Cmp(x2) != 0 || y1.Cmp(y2) != 0 {
+		return ErrMuSigPartial
+	}
+	m.partials[key] = s
+	return nil
+}
+
+//Signature 合成聚合公钥的签名 (R.x, s1 + ... + sn)，验证的方式和单个签名者的签名相同
+func (m *MuSig) Signature() (crypto.Signature, error) {
+	if m.e == nil || len(m.partials) != len(m.ka.pubs) {
+		return nil, ErrMuSigStep
+	}
+	s := new(big.Int)
+	for _, partial := range m.partials {
+		s.Add(s, partial)
+	}
+	s.Mod(s, curve.N)
+	var sig SignatureSchnorr
+	copy(sig[:32], m.rx)
+	copy(sig[32:], scalarBytes(s))
+	if !m.ka.agg.VerifyBytes(m.msg, sig) {
+		return nil, ErrMuSigPartial
+	}
+	return sig, nil
+}
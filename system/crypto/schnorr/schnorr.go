@@ -0,0 +1,257 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package schnorr secp256k1上的schnorr签名，私钥和公钥的格式和secp256k1相同，同一个私钥的地址也相同，
+// 多个签名者可以用MuSig聚合公钥，聚合公钥的签名和单个签名者的签名一样
+package schnorr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common/crypto"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+//const
+const (
+	Name = "schnorr"
+	ID   = 7
+
+	//PrivKeyLength 私钥长度
+	PrivKeyLength = 32
+	//PubKeyLength 压缩格式的公钥长度
+	PubKeyLength = 33
+	//SignatureLength 签名是R的x坐标和s，R的y坐标总是偶数
+	SignatureLength = 64
+)
+
+var curve = secp256k1.S256()
+
+//Driver 驱动
+type Driver struct{}
+
+//GenKey 生成私钥
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	for {
+		k := new(big.Int).SetBytes(crypto.CRandBytes(PrivKeyLength))
+		if k.Sign() > 0 && k.Cmp(curve.N) < 0 {
+			var priv PrivKeySchnorr
+			copy(priv[:], scalarBytes(k))
+			return priv, nil
+		}
+	}
+}
+
+//PrivKeyFromBytes 字节转为私钥，私钥必须在(0, n)的范围内
+func (d Driver) PrivKeyFromBytes(b []byte) (crypto.PrivKey, error) {
+	if len(b) != PrivKeyLength {
+		return nil, errors.New("invalid priv key byte")
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Sign() == 0 || k.Cmp(curve.N) >= 0 {
+		return nil, errors.New("invalid priv key byte")
+	}
+	var priv PrivKeySchnorr
+	copy(priv[:], b)
+	return priv, nil
+}
+
+//PubKeyFromBytes 字节转为公钥
+func (d Driver) PubKeyFromBytes(b []byte) (crypto.PubKey, error) {
+	if len(b) != PubKeyLength {
+		return nil, errors.New("invalid pub key byte")
+	}
+	if _, err := secp256k1.ParsePubKey(b, curve); err != nil {
+		return nil, err
+	}
+	var pub PubKeySchnorr
+	copy(pub[:], b)
+	return pub, nil
+}
+
+//SignatureFromBytes 字节转为签名
+func (d Driver) SignatureFromBytes(b []byte) (crypto.Signature, error) {
+	if len(b) != SignatureLength {
+		return nil, errors.New("invalid signature byte")
+	}
+	var sig SignatureSchnorr
+	copy(sig[:], b)
+	return sig, nil
+}
+
+//taggedHash 不同用途的hash加上不同的标签，避免一种用途的hash被用在另一种用途上
+func taggedHash(tag string, data ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+//hashToScalar hash的结果模n
+func hashToScalar(tag string, data ...[]byte) *big.Int {
+	e := new(big.Int).SetBytes(taggedHash(tag, data...))
+	return e.Mod(e, curve.N)
+}
+
+//challenge e = H(R.x || P || msg)
+func challenge(rx []byte, pub []byte, msg []byte) *big.Int {
+	return hashToScalar("chain33/schnorr/challenge", rx, pub, msg)
+}
+
+//scalarBytes 32字节的大端格式
+func scalarBytes(k *big.Int) []byte {
+	b := make([]byte, 32)
+	kb := k.Bytes()
+	copy(b[32-len(kb):], kb)
+	return b
+}
+
+func isEven(y *big.Int) bool {
+	return y.Bit(0) == 0
+}
+
+//PrivKeySchnorr PrivKey
+type PrivKeySchnorr [PrivKeyLength]byte
+
+//Bytes 字节格式
+func (privKey PrivKeySchnorr) Bytes() []byte {
+	s := make([]byte, PrivKeyLength)
+	copy(s, privKey[:])
+	return s
+}
+
+//Sign 签名，随机数由私钥和消息确定，s = k + e*x
+func (privKey PrivKeySchnorr) Sign(msg []byte) crypto.Signature {
+	x := new(big.Int).SetBytes(privKey[:])
+	pub := privKey.PubKey().Bytes()
+	k := hashToScalar("chain33/schnorr/nonce", privKey[:], msg)
+	if k.Sign() == 0 {
+		panic("schnorr sign: zero nonce")
+	}
+	rx, ry := curve.ScalarBaseMult(scalarBytes(k))
+	if !isEven(ry) {
+		k.Sub(curve.N, k)
+	}
+	e := challenge(scalarBytes(rx), pub, msg)
+	s := new(big.Int).Mul(e, x)
+	s.Add(s, k)
+	s.Mod(s, curve.N)
+	var sig SignatureSchnorr
+	copy(sig[:32], scalarBytes(rx))
+	copy(sig[32:], scalarBytes(s))
+	return sig
+}
+
+//PubKey 私钥生成公钥
+func (privKey PrivKeySchnorr) PubKey() crypto.PubKey {
+	_, pub := secp256k1.PrivKeyFromBytes(curve, privKey[:])
+	var pubSchnorr PubKeySchnorr
+	copy(pubSchnorr[:], pub.SerializeCompressed())
+	return pubSchnorr
+}
+
+//Equals 私钥是否相等
+func (privKey PrivKeySchnorr) Equals(other crypto.PrivKey) bool {
+	if otherSchnorr, ok := other.(PrivKeySchnorr); ok {
+		return bytes.Equal(privKey[:], otherSchnorr[:])
+	}
+	return false
+}
+
+func (privKey PrivKeySchnorr) String() string {
+	return fmt.Sprintf("PrivKeySchnorr{*****}")
+}
+
+//PubKeySchnorr 压缩格式的公钥
+type PubKeySchnorr [PubKeyLength]byte
+
+//Bytes 字节格式
+func (pubKey PubKeySchnorr) Bytes() []byte {
+	s := make([]byte, PubKeyLength)
+	copy(s, pubKey[:])
+	return s
+}
+
+//VerifyBytes 验证签名，R = s*G - e*P，R的y坐标是偶数并且x坐标和签名中的一致
+func (pubKey PubKeySchnorr) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	sigSchnorr, ok := sig.(SignatureSchnorr)
+	if !ok {
+		return false
+	}
+	pub, err := secp256k1.ParsePubKey(pubKey[:], curve)
+	if err != nil {
+		return false
+	}
+	r := new(big.Int).SetBytes(sigSchnorr[:32])
+	s := new(big.Int).SetBytes(sigSchnorr[32:])
+	if r.Cmp(curve.P) >= 0 || s.Cmp(curve.N) >= 0 {
+		return false
+	}
+	e := challenge(sigSchnorr[:32], pubKey[:], msg)
+	sx, sy := curve.ScalarBaseMult(scalarBytes(s))
+	ex, ey := curve.ScalarMult(pub.X, pub.Y, scalarBytes(e))
+	ey.Sub(curve.P, ey)
+	rx, ry := curve.Add(sx, sy, ex, ey)
+	if rx.Sign() == 0 && ry.Sign() == 0 {
+		return false
+	}
+	return isEven(ry) && rx.Cmp(r) == 0
+}
+
+func (pubKey PubKeySchnorr) String() string {
+	return fmt.Sprintf("PubKeySchnorr{%X}", pubKey[:])
+}
+
+//KeyString 公钥字符串格式
+func (pubKey PubKeySchnorr) KeyString() string {
+	return fmt.Sprintf("%X", pubKey[:])
+}
+
+//Equals 公钥相等
+func (pubKey PubKeySchnorr) Equals(other crypto.PubKey) bool {
+	if otherSchnorr, ok := other.(PubKeySchnorr); ok {
+		return bytes.Equal(pubKey[:], otherSchnorr[:])
+	}
+	return false
+}
+
+//SignatureSchnorr Signature
+type SignatureSchnorr [SignatureLength]byte
+
+//Bytes 字节格式
+func (sig SignatureSchnorr) Bytes() []byte {
+	s := make([]byte, SignatureLength)
+	copy(s, sig[:])
+	return s
+}
+
+//IsZero 是否是0
+func (sig SignatureSchnorr) IsZero() bool { return len(sig) == 0 }
+
+func (sig SignatureSchnorr) String() string {
+	fingerprint := make([]byte, len(sig[:]))
+	copy(fingerprint, sig[:])
+	return fmt.Sprintf("/%X.../", fingerprint)
+}
+
+//Equals 相等
+func (sig SignatureSchnorr) Equals(other crypto.Signature) bool {
+	if otherSchnorr, ok := other.(SignatureSchnorr); ok {
+		return bytes.Equal(sig[:], otherSchnorr[:])
+	}
+	return false
+}
+
+func init() {
+	crypto.Register(Name, &Driver{})
+	crypto.RegisterType(Name, ID)
+}
@@ -0,0 +1,130 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schnorr
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/crypto/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+func genKeys(t *testing.T, n int) ([]crypto.PrivKey, []crypto.PubKey) {
+	var privs []crypto.PrivKey
+	var pubs []crypto.PubKey
+	for i := 0; i < n; i++ {
+		priv, err := Driver{}.GenKey()
+		assert.Nil(t, err)
+		privs = append(privs, priv)
+		pubs = append(pubs, priv.PubKey())
+	}
+	return privs, pubs
+}
+
+func TestSign(t *testing.T) {
+	privs, pubs := genKeys(t, 2)
+	msg := []byte("hello schnorr")
+	sig := privs[0].Sign(msg)
+	assert.Equal(t, SignatureLength, len(sig.Bytes()))
+	assert.True(t, pubs[0].VerifyBytes(msg, sig))
+	assert.False(t, pubs[0].VerifyBytes([]byte("other"), sig))
+	assert.False(t, pubs[1].VerifyBytes(msg, sig))
+	bad := sig.(SignatureSchnorr)
+	bad[63] ^= 1
+	assert.False(t, pubs[0].VerifyBytes(msg, bad))
+
+	//私钥和公钥的格式和secp256k1相同
+	secp, err := secp256k1.Driver{}.PrivKeyFromBytes(privs[0].Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, secp.PubKey().Bytes(), pubs[0].Bytes())
+
+	_, err = Driver{}.PrivKeyFromBytes(make([]byte, PrivKeyLength))
+	assert.NotNil(t, err)
+	_, err = Driver{}.SignatureFromBytes(sig.Bytes()[1:])
+	assert.NotNil(t, err)
+}
+
+func newSessions(t *testing.T, privs []crypto.PrivKey, pubs []crypto.PubKey, msg []byte) []*MuSig {
+	var sessions []*MuSig
+	for _, priv := range privs {
+		m, err := NewMuSig(priv, pubs, msg)
+		assert.Nil(t, err)
+		sessions = append(sessions, m)
+	}
+	return sessions
+}
+
+func TestMuSig(t *testing.T) {
+	privs, pubs := genKeys(t, 3)
+	msg := []byte("multisig spend")
+	agg, err := AggregatePubKey(pubs)
+	assert.Nil(t, err)
+	reversed, err := AggregatePubKey([]crypto.PubKey{pubs[2], pubs[1], pubs[0]})
+	assert.Nil(t, err)
+	assert.Equal(t, agg, reversed)
+
+	sessions := newSessions(t, privs, pubs, msg)
+	_, err = sessions[0].Nonce()
+	assert.Equal(t, ErrMuSigStep, err)
+	for i, m := range sessions {
+		for j, other := range sessions {
+			if i != j {
+				assert.Nil(t, m.AddCommitment(pubs[j], other.Commitment()))
+			}
+		}
+	}
+	for i, m := range sessions {
+		for j, other := range sessions {
+			if i != j {
+				nonce, err := other.Nonce()
+				assert.Nil(t, err)
+				assert.Nil(t, m.AddNonce(pubs[j], nonce))
+			}
+		}
+	}
+	var partials [][]byte
+	for _, m := range sessions {
+		partial, err := m.PartialSign()
+		assert.Nil(t, err)
+		partials = append(partials, partial)
+	}
+	_, err = sessions[0].PartialSign()
+	assert.Equal(t, ErrMuSigStep, err)
+
+	m := sessions[0]
+	_, err = m.Signature()
+	assert.Equal(t, ErrMuSigStep, err)
+	bad := append([]byte{}, partials[1]...)
+	bad[31] ^= 1
+	assert.Equal(t, ErrMuSigPartial, m.AddPartial(pubs[1], bad))
+	assert.Equal(t, ErrMuSigPartial, m.AddPartial(pubs[2], partials[1]))
+	assert.Nil(t, m.AddPartial(pubs[1], partials[1]))
+	assert.Nil(t, m.AddPartial(pubs[2], partials[2]))
+	sig, err := m.Signature()
+	assert.Nil(t, err)
+	//聚合签名和单个签名者的签名一样验证
+	assert.True(t, agg.VerifyBytes(msg, sig))
+	assert.False(t, agg.VerifyBytes([]byte("other"), sig))
+}
+
+func TestMuSigBadNonce(t *testing.T) {
+	privs, pubs := genKeys(t, 2)
+	others, otherPubs := genKeys(t, 1)
+	_, err := NewMuSig(others[0], pubs, nil)
+	assert.Equal(t, ErrMuSigSigner, err)
+	_, err = AggregatePubKey([]crypto.PubKey{pubs[0], pubs[0]})
+	assert.Equal(t, ErrMuSigPubKeys, err)
+
+	sessions := newSessions(t, privs, pubs, []byte("msg"))
+	assert.Equal(t, ErrMuSigSigner, sessions[0].AddCommitment(otherPubs[0], sessions[1].Commitment()))
+	//收到所有承诺之前不能接收随机数
+	nonce := sessions[1].nonce
+	assert.Equal(t, ErrMuSigStep, sessions[0].AddNonce(pubs[1], nonce))
+	assert.Nil(t, sessions[0].AddCommitment(pubs[1], sessions[1].Commitment()))
+	//随机数和承诺不一致
+	assert.Equal(t, ErrMuSigCommitment, sessions[0].AddNonce(pubs[1], sessions[0].nonce))
+	assert.Nil(t, sessions[0].AddNonce(pubs[1], nonce))
+}
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/33cn/chain33/common/crypto"
 	secp256k1 "github.com/btcsuite/btcd/btcec"
@@ -53,6 +54,111 @@ func (d Driver) SignatureFromBytes(b []byte) (sig crypto.Signature, err error) {
 	return SignatureSecp256k1(b), nil
 }
 
+//BatchVerify 相同的公钥只解压缩一次，每个签名还是单独并行验证，secp256k1没有代数批量验证
+func (d Driver) BatchVerify(items []*crypto.BatchItem) bool {
+	parsed := make(map[PubKeySecp256k1]*secp256k1.PublicKey)
+	pubs := make([]*secp256k1.PublicKey, len(items))
+	sigs := make([]*secp256k1.Signature, len(items))
+	for i, item := range items {
+		pubKey, ok := item.PubKey.(PubKeySecp256k1)
+		if !ok {
+			return false
+		}
+		sig := item.Sig
+		if wrap, ok := sig.(SignatureS); ok {
+			sig = wrap.Signature
+		}
+		sigSecp256k1, ok := sig.(SignatureSecp256k1)
+		if !ok {
+			return false
+		}
+		pub, ok := parsed[pubKey]
+		if !ok {
+			var err error
+			pub, err = secp256k1.ParsePubKey(pubKey[:], secp256k1.S256())
+			if err != nil {
+				return false
+			}
+			parsed[pubKey] = pub
+		}
+		sig2, err := secp256k1.ParseDERSignature(sigSecp256k1[:], secp256k1.S256())
+		if err != nil {
+			return false
+		}
+		pubs[i], sigs[i] = pub, sig2
+	}
+	return crypto.VerifyParallel(len(items), func(i int) bool {
+		return sigs[i].Verify(crypto.Sha256(items[i].Msg), pubs[i])
+	})
+}
+
+//RecoverableSignLength 可恢复签名的长度，格式为 r(32) + s(32) + v(1)，v是恢复id
+const RecoverableSignLength = 65
+
+//SignRecoverable 生成可以恢复公钥的签名，交易中可以不带公钥
+func (d Driver) SignRecoverable(priv crypto.PrivKey, msg []byte) ([]byte, error) {
+	privKey, ok := priv.(PrivKeySecp256k1)
+	if !ok {
+		return nil, errors.New("invalid secp256k1 priv key")
+	}
+	key, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey[:])
+	//compact 格式为 header(27 + 恢复id + 4) + r + s，4表示恢复出的是压缩公钥
+	compact, err := secp256k1.SignCompact(secp256k1.S256(), key, crypto.Sha256(msg), true)
+	if err != nil {
+		return nil, err
+	}
+	sig := make([]byte, RecoverableSignLength)
+	copy(sig, compact[1:])
+	sig[64] = compact[0] - 27 - 4
+	return sig, nil
+}
+
+//RecoverPubKey 从可恢复的签名中计算公钥
+func (d Driver) RecoverPubKey(msg []byte, sig []byte) (crypto.PubKey, error) {
+	if len(sig) != RecoverableSignLength || sig[64] > 3 {
+		return nil, errors.New("invalid recoverable signature")
+	}
+	compact := make([]byte, RecoverableSignLength)
+	compact[0] = sig[64] + 27 + 4
+	copy(compact[1:], sig[:64])
+	hash := crypto.Sha256(msg)
+	pub, _, err := secp256k1.RecoverCompact(secp256k1.S256(), compact, hash)
+	if err != nil {
+		return nil, err
+	}
+	//r, s 不在合法范围的时候也能计算出公钥，必须再验证一次签名
+	rs := &secp256k1.Signature{R: new(big.Int).SetBytes(sig[:32]), S: new(big.Int).SetBytes(sig[32:64])}
+	if !rs.Verify(hash, pub) {
+		return nil, errors.New("invalid recoverable signature")
+	}
+	var pubSecp256k1 PubKeySecp256k1
+	copy(pubSecp256k1[:], pub.SerializeCompressed())
+	return pubSecp256k1, nil
+}
+
+//Encrypt 使用ECIES用公钥加密数据
+func (d Driver) Encrypt(pub crypto.PubKey, msg []byte) ([]byte, error) {
+	pubKey, ok := pub.(PubKeySecp256k1)
+	if !ok {
+		return nil, errors.New("invalid secp256k1 pub key")
+	}
+	key, err := secp256k1.ParsePubKey(pubKey[:], secp256k1.S256())
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1.Encrypt(key, msg)
+}
+
+//Decrypt 使用ECIES用私钥解密数据
+func (d Driver) Decrypt(priv crypto.PrivKey, data []byte) ([]byte, error) {
+	privKey, ok := priv.(PrivKeySecp256k1)
+	if !ok {
+		return nil, errors.New("invalid secp256k1 priv key")
+	}
+	key, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey[:])
+	return secp256k1.Decrypt(key, data)
+}
+
 //PrivKeySecp256k1 PrivKey
 type PrivKeySecp256k1 [32]byte
 
@@ -0,0 +1,416 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands bridge插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	brty "github.com/33cn/chain33/system/dapp/bridge/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// BridgeCmd bridge command
+func BridgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge",
+		Short: "Cross chain bridge verified by external block headers",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		ConfigCmd(),
+		HeadersCmd(),
+		DepositCmd(),
+		WithdrawCmd(),
+		SignCmd(),
+		QueryChainCmd(),
+		QueryHeaderCmd(),
+		QueryDepositCmd(),
+		QueryWithdrawalCmd(),
+		ListWithdrawalsCmd(),
+		BalanceCmd(),
+	)
+
+	return cmd
+}
+
+func fromHexList(list []string) ([][]byte, error) {
+	var result [][]byte
+	for _, s := range list {
+		b, err := common.FromHex(s)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, b)
+	}
+	return result, nil
+}
+
+// ConfigCmd config external chain
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Create a transaction to config external chain, manager only",
+		Run:   config,
+	}
+	cmd.Flags().StringP("name", "n", "", "external chain name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("verifier", "v", "btc", "header verifier, btc or btcregtest")
+	cmd.Flags().StringP("symbol", "s", "", "symbol of wrapped asset")
+	cmd.MarkFlagRequired("symbol")
+	cmd.Flags().StringP("checkpoint", "p", "", "trusted checkpoint header in hex")
+	cmd.Flags().Int64P("height", "t", 0, "height of checkpoint header")
+	cmd.Flags().Int64P("confirmations", "c", 6, "confirmations of lock transaction")
+	cmd.Flags().StringP("custody", "u", "", "lock script of custody address in hex")
+	cmd.MarkFlagRequired("custody")
+	cmd.Flags().StringSliceP("relayers", "r", nil, "relayer addresses")
+	cmd.MarkFlagRequired("relayers")
+	cmd.Flags().Int32P("threshold", "m", 1, "signatures required to authorize withdrawal")
+	return cmd
+}
+
+func config(cmd *cobra.Command, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	verifier, _ := cmd.Flags().GetString("verifier")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	checkpoint, _ := cmd.Flags().GetString("checkpoint")
+	height, _ := cmd.Flags().GetInt64("height")
+	confirmations, _ := cmd.Flags().GetInt64("confirmations")
+	custody, _ := cmd.Flags().GetString("custody")
+	relayers, _ := cmd.Flags().GetStringSlice("relayers")
+	threshold, _ := cmd.Flags().GetInt32("threshold")
+	raw, err := fromHexList([]string{checkpoint, custody})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	payload := &brty.BridgeChainConfig{
+		Name:             name,
+		Verifier:         verifier,
+		Symbol:           symbol,
+		Checkpoint:       raw[0],
+		CheckpointHeight: height,
+		Confirmations:    confirmations,
+		Custody:          raw[1],
+		Relayers:         relayers,
+		Threshold:        threshold,
+	}
+	if err := brty.CheckChainConfig(payload); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, brty.BridgeX, &brty.BridgeAction{
+		Ty:    brty.BridgeActionConfig,
+		Value: &brty.BridgeAction_Config{Config: payload},
+	})
+}
+
+// HeadersCmd submit headers
+func HeadersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "headers",
+		Short: "Create a transaction to submit headers of external chain",
+		Run:   headers,
+	}
+	cmd.Flags().StringP("chain", "n", "", "external chain name")
+	cmd.MarkFlagRequired("chain")
+	cmd.Flags().StringSliceP("headers", "d", nil, "raw headers in hex, parent first")
+	cmd.MarkFlagRequired("headers")
+	return cmd
+}
+
+func headers(cmd *cobra.Command, args []string) {
+	chain, _ := cmd.Flags().GetString("chain")
+	list, _ := cmd.Flags().GetStringSlice("headers")
+	raw, err := fromHexList(list)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, brty.BridgeX, &brty.BridgeAction{
+		Ty:    brty.BridgeActionHeaders,
+		Value: &brty.BridgeAction_Headers{Headers: &brty.BridgeHeaders{Chain: chain, Headers: raw}},
+	})
+}
+
+// DepositCmd deposit by lock transaction
+func DepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Create a transaction to mint wrapped asset by lock transaction and merkle proof",
+		Run:   deposit,
+	}
+	cmd.Flags().StringP("chain", "n", "", "external chain name")
+	cmd.MarkFlagRequired("chain")
+	cmd.Flags().StringP("block", "b", "", "block hash in internal byte order")
+	cmd.MarkFlagRequired("block")
+	cmd.Flags().StringP("tx", "x", "", "raw lock transaction in hex")
+	cmd.MarkFlagRequired("tx")
+	cmd.Flags().StringSliceP("branch", "r", nil, "merkle branch in hex")
+	cmd.Flags().Int32P("index", "i", 0, "index of transaction in block")
+	return cmd
+}
+
+func deposit(cmd *cobra.Command, args []string) {
+	chain, _ := cmd.Flags().GetString("chain")
+	block, _ := cmd.Flags().GetString("block")
+	tx, _ := cmd.Flags().GetString("tx")
+	branch, _ := cmd.Flags().GetStringSlice("branch")
+	index, _ := cmd.Flags().GetInt32("index")
+	raw, err := fromHexList(append([]string{block, tx}, branch...))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, brty.BridgeX, &brty.BridgeAction{
+		Ty: brty.BridgeActionDeposit,
+		Value: &brty.BridgeAction_Deposit{Deposit: &brty.BridgeDeposit{
+			Chain:     chain,
+			BlockHash: raw[0],
+			Tx:        raw[1],
+			Branch:    raw[2:],
+			Index:     index,
+		}},
+	})
+}
+
+// WithdrawCmd burn wrapped asset
+func WithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Create a transaction to burn wrapped asset for withdrawal on external chain",
+		Run:   withdraw,
+	}
+	cmd.Flags().StringP("chain", "n", "", "external chain name")
+	cmd.MarkFlagRequired("chain")
+	cmd.Flags().StringP("to", "t", "", "address on external chain")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func withdraw(cmd *cobra.Command, args []string) {
+	chain, _ := cmd.Flags().GetString("chain")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	commandtypes.CreateActionTx(cmd, brty.BridgeX, &brty.BridgeAction{
+		Ty:    brty.BridgeActionWithdraw,
+		Value: &brty.BridgeAction_Withdraw{Withdraw: &brty.BridgeWithdraw{Chain: chain, To: to, Amount: commandtypes.FormatAmountDisplay2Value(amount)}},
+	})
+}
+
+// SignCmd sign withdrawal by relayer
+func SignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign",
+		Short: "Create a transaction to sign withdrawal by relayer",
+		Run:   sign,
+	}
+	cmd.Flags().StringP("id", "i", "", "withdrawal id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("key", "k", "", "private key of relayer in hex")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func sign(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	id, _ := cmd.Flags().GetString("id")
+	key, _ := cmd.Flags().GetString("key")
+	buf, err := common.FromHex(key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv, err := cr.PrivKeyFromBytes(buf)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := rpctypes.Query4Jrpc{
+		Execer:   util.GetParaExecName(paraName, brty.BridgeX),
+		FuncName: brty.FuncNameGetWithdrawal,
+		Payload:  types.MustPBToJSON(&types.ReqString{Data: id}),
+	}
+	var w brty.BridgeWithdrawal
+	if err := rpc.Call("Chain33.Query", params, &w); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	sig := &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(brty.AuthorizationData(&w)).Bytes(),
+	}
+	commandtypes.CreateActionTx(cmd, brty.BridgeX, &brty.BridgeAction{
+		Ty:    brty.BridgeActionSign,
+		Value: &brty.BridgeAction_Sign{Sign: &brty.BridgeSign{WithdrawalID: id, Signatures: []*types.Signature{sig}}},
+	})
+}
+
+func queryBridge(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, brty.BridgeX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryChainCmd query external chain
+func QueryChainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain",
+		Short: "Query config and tip of external chain",
+		Run:   queryChain,
+	}
+	cmd.Flags().StringP("chain", "n", "", "external chain name")
+	cmd.MarkFlagRequired("chain")
+	return cmd
+}
+
+func queryChain(cmd *cobra.Command, args []string) {
+	chain, _ := cmd.Flags().GetString("chain")
+	var res brty.BridgeChain
+	queryBridge(cmd, brty.FuncNameGetChain, &types.ReqString{Data: chain}, &res)
+}
+
+// QueryHeaderCmd query header
+func QueryHeaderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "header",
+		Short: "Query header of external chain by hash",
+		Run:   queryHeader,
+	}
+	cmd.Flags().StringP("chain", "n", "", "external chain name")
+	cmd.MarkFlagRequired("chain")
+	cmd.Flags().StringP("hash", "s", "", "block hash in internal byte order")
+	cmd.MarkFlagRequired("hash")
+	return cmd
+}
+
+func queryHeader(cmd *cobra.Command, args []string) {
+	chain, _ := cmd.Flags().GetString("chain")
+	hash, _ := cmd.Flags().GetString("hash")
+	h, err := common.FromHex(hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res brty.BridgeHeader
+	queryBridge(cmd, brty.FuncNameGetHeader, &brty.ReqBridgeHeader{Chain: chain, Hash: h}, &res)
+}
+
+// QueryDepositCmd query deposit
+func QueryDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit_info",
+		Short: "Query deposit by hash of lock transaction",
+		Run:   queryDeposit,
+	}
+	cmd.Flags().StringP("chain", "n", "", "external chain name")
+	cmd.MarkFlagRequired("chain")
+	cmd.Flags().StringP("hash", "s", "", "lock transaction hash in internal byte order")
+	cmd.MarkFlagRequired("hash")
+	return cmd
+}
+
+func queryDeposit(cmd *cobra.Command, args []string) {
+	chain, _ := cmd.Flags().GetString("chain")
+	hash, _ := cmd.Flags().GetString("hash")
+	h, err := common.FromHex(hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res brty.BridgeDepositRecord
+	queryBridge(cmd, brty.FuncNameGetDeposit, &brty.ReqBridgeDeposit{Chain: chain, TxHash: h}, &res)
+}
+
+// QueryWithdrawalCmd query withdrawal
+func QueryWithdrawalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal",
+		Short: "Query withdrawal and relayer signatures by id",
+		Run:   queryWithdrawal,
+	}
+	cmd.Flags().StringP("id", "i", "", "withdrawal id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryWithdrawal(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res brty.BridgeWithdrawal
+	queryBridge(cmd, brty.FuncNameGetWithdrawal, &types.ReqString{Data: id}, &res)
+}
+
+// ListWithdrawalsCmd list withdrawals by status
+func ListWithdrawalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawals",
+		Short: "List withdrawals by status",
+		Run:   listWithdrawals,
+	}
+	cmd.Flags().Int32P("status", "t", brty.WithdrawalPending, "1: pending, 2: authorized")
+	cmd.Flags().StringP("primary", "p", "", "list after this withdrawal id")
+	cmd.Flags().Int32P("count", "c", brty.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listWithdrawals(cmd *cobra.Command, args []string) {
+	status, _ := cmd.Flags().GetInt32("status")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res brty.ReplyBridgeWithdrawals
+	queryBridge(cmd, brty.FuncNameListWithdrawals, &brty.ReqBridgeWithdrawals{Status: status, PrimaryKey: primary, Count: count, Direction: direction}, &res)
+}
+
+// BalanceCmd query wrapped asset balance
+func BalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance",
+		Short: "Query wrapped asset balance of address",
+		Run:   balance,
+	}
+	cmd.Flags().StringP("chain", "n", "", "external chain name")
+	cmd.MarkFlagRequired("chain")
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func balance(cmd *cobra.Command, args []string) {
+	chain, _ := cmd.Flags().GetString("chain")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res types.Account
+	queryBridge(cmd, brty.FuncNameGetBalance, &brty.ReqBridgeBalance{Chain: chain, Addr: addr}, &res)
+}
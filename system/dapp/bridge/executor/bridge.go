@@ -0,0 +1,66 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor bridge执行器，用外部链的区块头和merkle证明验证锁定交易，铸造映射资产，销毁以后由中继签名提取
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	brty "github.com/33cn/chain33/system/dapp/bridge/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.bridge")
+	driverName = brty.BridgeX
+	manageConf = types.ConfSub("manage")
+)
+
+func init() {
+	et := types.LoadExecutorType(driverName)
+	et.InitFuncList(types.ListMethod(&Bridge{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newBridge, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return bridge name
+func GetName() string {
+	return newBridge().GetName()
+}
+
+// Bridge defines Bridge object
+type Bridge struct {
+	drivers.DriverBase
+}
+
+func newBridge() drivers.Driver {
+	b := &Bridge{}
+	b.SetChild(b)
+	b.SetExecutorType(types.LoadExecutorType(driverName))
+	return b
+}
+
+// GetDriverName return a drivername
+func (b *Bridge) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (b *Bridge) CheckReceiptExecOk() bool {
+	return true
+}
+
+//isManager manage合约的超级管理员可以配置外部链
+func isManager(addr string) bool {
+	for _, m := range manageConf.GStrList("superManager") {
+		if addr == m {
+			return true
+		}
+	}
+	return false
+}
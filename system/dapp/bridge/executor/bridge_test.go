@@ -0,0 +1,200 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	brty "github.com/33cn/chain33/system/dapp/bridge/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) (int32, string) {
+	_, detail, err := mock33.SendCallTx(priv, brty.BridgeX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, fmt.Sprintf("%018d", detail.Height*types.MaxTxsPerBlock+detail.Index)
+}
+
+func send(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) int32 {
+	ty, _ := sendTx(t, mock33, priv, action, param)
+	return ty
+}
+
+func query(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(brty.BridgeX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
+
+//mineHeader 按regtest的难度挖出区块头
+func mineHeader(prev, root []byte, time uint32) []byte {
+	raw := make([]byte, 80)
+	binary.LittleEndian.PutUint32(raw[0:4], 1)
+	copy(raw[4:36], prev)
+	copy(raw[36:68], root)
+	binary.LittleEndian.PutUint32(raw[68:72], time)
+	binary.LittleEndian.PutUint32(raw[72:76], 0x207fffff)
+	target := new(big.Int).Lsh(big.NewInt(0x7fffff), 8*(0x20-3))
+	for nonce := uint32(0); ; nonce++ {
+		binary.LittleEndian.PutUint32(raw[76:80], nonce)
+		if new(big.Int).SetBytes(reverse(brty.DoubleSha256(raw))).Cmp(target) <= 0 {
+			return raw
+		}
+	}
+}
+
+func mineChain(prev []byte, n int, time uint32, roots ...[]byte) [][]byte {
+	var headers [][]byte
+	for i := 0; i < n; i++ {
+		root := make([]byte, 32)
+		if i < len(roots) {
+			root = roots[i]
+		}
+		raw := mineHeader(prev, root, time+uint32(i))
+		headers = append(headers, raw)
+		prev = brty.DoubleSha256(raw)
+	}
+	return headers
+}
+
+//lockTx 一个输入，支付给托管脚本的输出和带接收地址的OP_RETURN输出
+func lockTx(custody []byte, amount int64, recipient string) []byte {
+	tx := []byte{1, 0, 0, 0, 1}
+	tx = append(tx, make([]byte, 36)...)
+	tx = append(tx, 0, 0xff, 0xff, 0xff, 0xff, 2)
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint64(value, uint64(amount))
+	tx = append(tx, value...)
+	tx = append(tx, byte(len(custody)))
+	tx = append(tx, custody...)
+	tx = append(tx, make([]byte, 8)...)
+	tx = append(tx, byte(len(recipient)+2), 0x6a, byte(len(recipient)))
+	tx = append(tx, []byte(recipient)...)
+	return append(tx, 0, 0, 0, 0)
+}
+
+func relayerSign(priv crypto.PrivKey, w *brty.BridgeWithdrawal) *types.Signature {
+	return &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: priv.Sign(brty.AuthorizationData(w)).Bytes()}
+}
+
+func TestBridge(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	manager := util.TestPrivkeyList[0]
+	recipient, recipientPriv := util.Genaddress()
+	var relayers []string
+	var relayerPrivs []crypto.PrivKey
+	for i := 0; i < 3; i++ {
+		addr, priv := util.Genaddress()
+		relayers = append(relayers, addr)
+		relayerPrivs = append(relayerPrivs, priv)
+	}
+	for _, to := range []string{recipient, address.PubKeyToAddress(manager.PubKey().Bytes()).String()} {
+		mock33.SendTx(util.CreateCoinsTx(genesis, to, 100*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+
+	custody := []byte{0x00, 0x14, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	checkpoint := mineHeader(make([]byte, 32), make([]byte, 32), 1500000000)
+	config := &brty.BridgeChainConfig{
+		Name:             "btc",
+		Verifier:         "btcregtest",
+		Symbol:           "BTC",
+		Checkpoint:       checkpoint,
+		CheckpointHeight: 100,
+		Confirmations:    3,
+		Custody:          custody,
+		Relayers:         relayers,
+		Threshold:        2,
+	}
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, recipientPriv, "Config", config))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, manager, "Config", &brty.BridgeChainConfig{Name: "btc", Verifier: "eth", Symbol: "BTC", Checkpoint: checkpoint, Confirmations: 3, Custody: custody, Relayers: relayers, Threshold: 2}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, manager, "Config", config))
+
+	//锁定交易在第一个区块的第二个交易
+	tx := lockTx(custody, 5*types.Coin, recipient)
+	other := brty.DoubleSha256([]byte("coinbase"))
+	root := brty.DoubleSha256(append(append([]byte{}, other...), brty.DoubleSha256(tx)...))
+	main := mineChain(brty.DoubleSha256(checkpoint), 4, 1500000600, root)
+	fork := mineChain(brty.DoubleSha256(checkpoint), 3, 1500000601)
+	deposit := &brty.BridgeDeposit{Chain: "btc", BlockHash: brty.DoubleSha256(main[0]), Tx: tx, Branch: [][]byte{other}, Index: 1}
+
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, genesis, "Headers", &brty.BridgeHeaders{Chain: "btc", Headers: main[:2]}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Headers", &brty.BridgeHeaders{Chain: "btc", Headers: main[:2]}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Headers", &brty.BridgeHeaders{Chain: "btc", Headers: main[3:]}))
+	//确认数不够
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Deposit", deposit))
+	//工作量更大的分叉成为最长链
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, genesis, "Headers", &brty.BridgeHeaders{Chain: "btc", Headers: fork}))
+	chain := query(t, mock33, brty.FuncNameGetChain, &types.ReqString{Data: "btc"}).(*brty.BridgeChain)
+	assert.Equal(t, brty.DoubleSha256(fork[2]), chain.Tip)
+	assert.Equal(t, int64(103), chain.TipHeight)
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Deposit", deposit))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, genesis, "Headers", &brty.BridgeHeaders{Chain: "btc", Headers: main[2:]}))
+	chain = query(t, mock33, brty.FuncNameGetChain, &types.ReqString{Data: "btc"}).(*brty.BridgeChain)
+	assert.Equal(t, brty.DoubleSha256(main[3]), chain.Tip)
+
+	bad := *deposit
+	bad.Index = 0
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Deposit", &bad))
+	bad = *deposit
+	bad.Tx = lockTx(custody, 6*types.Coin, recipient)
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Deposit", &bad))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, genesis, "Deposit", deposit))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Deposit", deposit))
+	record := query(t, mock33, brty.FuncNameGetDeposit, &brty.ReqBridgeDeposit{Chain: "btc", TxHash: brty.DoubleSha256(tx)}).(*brty.BridgeDepositRecord)
+	assert.Equal(t, recipient, record.Recipient)
+	balance := func() int64 {
+		return query(t, mock33, brty.FuncNameGetBalance, &brty.ReqBridgeBalance{Chain: "btc", Addr: recipient}).(*types.Account).Balance
+	}
+	assert.Equal(t, 5*types.Coin, balance())
+
+	//销毁以后等待中继签名
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, recipientPriv, "Withdraw", &brty.BridgeWithdraw{Chain: "btc", To: "bc1qexample", Amount: 6 * types.Coin}))
+	ty, id := sendTx(t, mock33, recipientPriv, "Withdraw", &brty.BridgeWithdraw{Chain: "btc", To: "bc1qexample", Amount: 2 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 3*types.Coin, balance())
+	chain = query(t, mock33, brty.FuncNameGetChain, &types.ReqString{Data: "btc"}).(*brty.BridgeChain)
+	assert.Equal(t, 3*types.Coin, chain.Supply)
+	list := query(t, mock33, brty.FuncNameListWithdrawals, &brty.ReqBridgeWithdrawals{Status: brty.WithdrawalPending}).(*brty.ReplyBridgeWithdrawals)
+	assert.Equal(t, 1, len(list.Withdrawals))
+	w := list.Withdrawals[0]
+	assert.Equal(t, id, w.WithdrawalID)
+
+	_, stranger := util.Genaddress()
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Sign", &brty.BridgeSign{WithdrawalID: id, Signatures: []*types.Signature{relayerSign(stranger, w)}}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Sign", &brty.BridgeSign{WithdrawalID: id, Signatures: []*types.Signature{relayerSign(relayerPrivs[0], w), relayerSign(relayerPrivs[0], w)}}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, genesis, "Sign", &brty.BridgeSign{WithdrawalID: id, Signatures: []*types.Signature{relayerSign(relayerPrivs[0], w)}}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Sign", &brty.BridgeSign{WithdrawalID: id, Signatures: []*types.Signature{relayerSign(relayerPrivs[0], w)}}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, genesis, "Sign", &brty.BridgeSign{WithdrawalID: id, Signatures: []*types.Signature{relayerSign(relayerPrivs[2], w)}}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, genesis, "Sign", &brty.BridgeSign{WithdrawalID: id, Signatures: []*types.Signature{relayerSign(relayerPrivs[1], w)}}))
+	w = query(t, mock33, brty.FuncNameGetWithdrawal, &types.ReqString{Data: id}).(*brty.BridgeWithdrawal)
+	assert.Equal(t, int32(brty.WithdrawalAuthorized), w.Status)
+	assert.Equal(t, 2, len(w.Signatures))
+	list = query(t, mock33, brty.FuncNameListWithdrawals, &brty.ReqBridgeWithdrawals{Status: brty.WithdrawalPending}).(*brty.ReplyBridgeWithdrawals)
+	assert.Equal(t, 0, len(list.Withdrawals))
+	list = query(t, mock33, brty.FuncNameListWithdrawals, &brty.ReqBridgeWithdrawals{Status: brty.WithdrawalAuthorized}).(*brty.ReplyBridgeWithdrawals)
+	assert.Equal(t, 1, len(list.Withdrawals))
+}
@@ -0,0 +1,475 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	brty "github.com/33cn/chain33/system/dapp/bridge/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	chainKeyPrefix      = "mavl-" + brty.BridgeX + "-chain-"
+	symbolKeyPrefix     = "mavl-" + brty.BridgeX + "-symbol-"
+	headerKeyPrefix     = "mavl-" + brty.BridgeX + "-header-"
+	depositKeyPrefix    = "mavl-" + brty.BridgeX + "-deposit-"
+	withdrawalKeyPrefix = "mavl-" + brty.BridgeX + "-withdrawal-"
+	statusIndexPrefix   = "LODB-" + brty.BridgeX + "-status-"
+)
+
+func calcChainKey(name string) []byte {
+	return []byte(chainKeyPrefix + name)
+}
+
+func calcSymbolKey(symbol string) []byte {
+	return []byte(symbolKeyPrefix + symbol)
+}
+
+func calcHeaderKey(chain string, hash []byte) []byte {
+	return []byte(headerKeyPrefix + chain + "-" + hex.EncodeToString(hash))
+}
+
+func calcDepositKey(chain string, txHash []byte) []byte {
+	return []byte(depositKeyPrefix + chain + "-" + hex.EncodeToString(txHash))
+}
+
+func calcWithdrawalKey(id string) []byte {
+	return []byte(withdrawalKeyPrefix + id)
+}
+
+func calcStatusIndexPrefix(status int32) string {
+	return fmt.Sprintf("%s%d-", statusIndexPrefix, status)
+}
+
+func calcStatusIndexKey(status int32, id string) []byte {
+	return []byte(calcStatusIndexPrefix(status) + id)
+}
+
+//calcWithdrawalID 提取的id按销毁交易的位置生成
+func calcWithdrawalID(height int64, index int) string {
+	return fmt.Sprintf("%018d", height*types.MaxTxsPerBlock+int64(index))
+}
+
+//wrappedAccount 映射资产的账户按bridge执行器和外部链的symbol创建
+func wrappedAccount(db dbm.KV, symbol string) (*account.DB, error) {
+	return account.NewAccountDB(brty.BridgeX, symbol, db)
+}
+
+// Action bridge交易的执行环境
+type Action struct {
+	db       dbm.KV
+	fromaddr string
+	height   int64
+	index    int
+	kvs      []*types.KeyValue
+	logs     []*types.ReceiptLog
+}
+
+// NewAction new a action object
+func NewAction(b *Bridge, tx *types.Transaction, index int) *Action {
+	return &Action{
+		db:       b.GetStateDB(),
+		fromaddr: tx.From(),
+		height:   b.GetHeight(),
+		index:    index,
+	}
+}
+
+func (a *Action) set(key, value []byte) {
+	a.db.Set(key, value)
+	a.kvs = append(a.kvs, &types.KeyValue{Key: key, Value: value})
+}
+
+func (a *Action) merge(receipt *types.Receipt) {
+	a.kvs = append(a.kvs, receipt.KV...)
+	a.logs = append(a.logs, receipt.Logs...)
+}
+
+func (a *Action) receipt() *types.Receipt {
+	return &types.Receipt{Ty: types.ExecOk, KV: a.kvs, Logs: a.logs}
+}
+
+func getChain(db dbm.KV, name string) (*brty.BridgeChain, error) {
+	value, err := db.Get(calcChainKey(name))
+	if err != nil || len(value) == 0 {
+		return nil, brty.ErrChainNotExist
+	}
+	var chain brty.BridgeChain
+	if err := types.Decode(value, &chain); err != nil {
+		return nil, err
+	}
+	return &chain, nil
+}
+
+func (a *Action) saveChain(prev, chain *brty.BridgeChain) {
+	a.set(calcChainKey(chain.Name), types.Encode(chain))
+	log := &brty.ReceiptBridgeChain{Prev: prev, Current: chain}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: brty.TyLogBridgeChain, Log: types.Encode(log)})
+}
+
+func getHeader(db dbm.KV, chain string, hash []byte) (*brty.BridgeHeader, error) {
+	value, err := db.Get(calcHeaderKey(chain, hash))
+	if err != nil || len(value) == 0 {
+		return nil, brty.ErrHeaderNotExist
+	}
+	var header brty.BridgeHeader
+	if err := types.Decode(value, &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+func (a *Action) saveHeader(header *brty.BridgeHeader) {
+	a.set(calcHeaderKey(header.Chain, header.Hash), types.Encode(header))
+	log := &brty.ReceiptBridgeHeader{Header: header}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: brty.TyLogBridgeHeader, Log: types.Encode(log)})
+}
+
+func getWithdrawal(db dbm.KV, id string) (*brty.BridgeWithdrawal, error) {
+	value, err := db.Get(calcWithdrawalKey(id))
+	if err != nil || len(value) == 0 {
+		return nil, brty.ErrWithdrawalNotExist
+	}
+	var w brty.BridgeWithdrawal
+	if err := types.Decode(value, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func (a *Action) saveWithdrawal(prev, w *brty.BridgeWithdrawal) {
+	a.set(calcWithdrawalKey(w.WithdrawalID), types.Encode(w))
+	log := &brty.ReceiptBridgeWithdrawal{Prev: prev, Current: w}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: brty.TyLogBridgeWithdrawal, Log: types.Encode(log)})
+}
+
+func getDeposit(db dbm.KV, chain string, txHash []byte) (*brty.BridgeDepositRecord, error) {
+	value, err := db.Get(calcDepositKey(chain, txHash))
+	if err != nil || len(value) == 0 {
+		return nil, brty.ErrDepositNotExist
+	}
+	var deposit brty.BridgeDepositRecord
+	if err := types.Decode(value, &deposit); err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+//config 第一次配置的时候保存checkpoint作为最长链的起点，以后只能修改确认数、托管脚本和中继
+func (a *Action) config(payload *brty.BridgeChainConfig) (*types.Receipt, error) {
+	if !isManager(a.fromaddr) {
+		return nil, types.ErrNotAllow
+	}
+	if err := brty.CheckChainConfig(payload); err != nil {
+		return nil, err
+	}
+	prev, err := getChain(a.db, payload.Name)
+	if err != nil && err != brty.ErrChainNotExist {
+		return nil, err
+	}
+	var chain brty.BridgeChain
+	if prev != nil {
+		if payload.Verifier != prev.Verifier || payload.Symbol != prev.Symbol {
+			return nil, brty.ErrChainConfig
+		}
+		chain = *prev
+	} else {
+		if value, err := a.db.Get(calcSymbolKey(payload.Symbol)); err == nil && len(value) > 0 {
+			return nil, brty.ErrChainConfig
+		}
+		verifier, _ := brty.GetVerifier(payload.Verifier)
+		info, err := verifier.ParseHeader(payload.Checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		a.saveHeader(&brty.BridgeHeader{
+			Chain:  payload.Name,
+			Hash:   info.Hash,
+			Prev:   info.Prev,
+			Height: payload.CheckpointHeight,
+			Work:   info.Work.Bytes(),
+			Raw:    payload.Checkpoint,
+		})
+		a.set(calcSymbolKey(payload.Symbol), []byte(payload.Name))
+		chain = brty.BridgeChain{
+			Name:             payload.Name,
+			Verifier:         payload.Verifier,
+			Symbol:           payload.Symbol,
+			CheckpointHeight: payload.CheckpointHeight,
+			Tip:              info.Hash,
+			TipHeight:        payload.CheckpointHeight,
+		}
+	}
+	chain.Confirmations = payload.Confirmations
+	chain.Custody = payload.Custody
+	chain.Relayers = payload.Relayers
+	chain.Threshold = payload.Threshold
+	a.saveChain(prev, &chain)
+	return a.receipt(), nil
+}
+
+//headers 区块头可以接在任何已经保存的区块头后面，累计工作量最大的区块头作为最长链的顶端
+func (a *Action) headers(payload *brty.BridgeHeaders) (*types.Receipt, error) {
+	if len(payload.Headers) == 0 || len(payload.Headers) > brty.MaxHeaders {
+		return nil, brty.ErrHeaderCount
+	}
+	prev, err := getChain(a.db, payload.Chain)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := brty.GetVerifier(prev.Verifier)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := getHeader(a.db, prev.Name, prev.Tip)
+	if err != nil {
+		return nil, err
+	}
+	chain := *prev
+	saved := 0
+	for _, raw := range payload.Headers {
+		info, err := verifier.ParseHeader(raw)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := getHeader(a.db, chain.Name, info.Hash); err == nil {
+			continue
+		}
+		parent, err := getHeader(a.db, chain.Name, info.Prev)
+		if err != nil {
+			return nil, err
+		}
+		parentInfo, err := verifier.ParseHeader(parent.Raw)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifier.CheckHeader(parentInfo, info, parent.Height+1); err != nil {
+			return nil, err
+		}
+		header := &brty.BridgeHeader{
+			Chain:  chain.Name,
+			Hash:   info.Hash,
+			Prev:   info.Prev,
+			Height: parent.Height + 1,
+			Work:   new(big.Int).Add(new(big.Int).SetBytes(parent.Work), info.Work).Bytes(),
+			Raw:    raw,
+		}
+		a.saveHeader(header)
+		saved++
+		if new(big.Int).SetBytes(header.Work).Cmp(new(big.Int).SetBytes(tip.Work)) > 0 {
+			tip = header
+		}
+	}
+	if saved == 0 {
+		return nil, brty.ErrHeaderExist
+	}
+	if !bytes.Equal(tip.Hash, chain.Tip) {
+		chain.Tip = tip.Hash
+		chain.TipHeight = tip.Height
+		a.saveChain(prev, &chain)
+	}
+	return a.receipt(), nil
+}
+
+//checkConfirmations 从最长链的顶端往回找到区块所在的高度，检查区块在最长链上并且确认数足够
+func checkConfirmations(db dbm.KV, chain *brty.BridgeChain, block *brty.BridgeHeader) error {
+	depth := chain.TipHeight - block.Height + 1
+	if depth < chain.Confirmations || depth > brty.MaxConfirmations {
+		return brty.ErrConfirmations
+	}
+	hash := chain.Tip
+	for height := chain.TipHeight; height > block.Height; height-- {
+		header, err := getHeader(db, chain.Name, hash)
+		if err != nil {
+			return err
+		}
+		hash = header.Prev
+	}
+	if !bytes.Equal(hash, block.Hash) {
+		return brty.ErrConfirmations
+	}
+	return nil
+}
+
+//deposit 锁定交易在最长链上确认以后，按支付给托管脚本的金额给接收地址铸造映射资产
+func (a *Action) deposit(payload *brty.BridgeDeposit) (*types.Receipt, error) {
+	prev, err := getChain(a.db, payload.Chain)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := brty.GetVerifier(prev.Verifier)
+	if err != nil {
+		return nil, err
+	}
+	block, err := getHeader(a.db, prev.Name, payload.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	info, err := verifier.ParseHeader(block.Raw)
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := verifier.ParseDeposit(payload.Tx, prev.Custody)
+	if err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(deposit.Recipient); err != nil {
+		return nil, brty.ErrDepositTx
+	}
+	if !verifier.VerifyMerkle(info.MerkleRoot, deposit.TxHash, payload.Branch, payload.Index) {
+		return nil, brty.ErrMerkleProof
+	}
+	if _, err := getDeposit(a.db, prev.Name, deposit.TxHash); err == nil {
+		return nil, brty.ErrDepositExist
+	}
+	if err := checkConfirmations(a.db, prev, block); err != nil {
+		return nil, err
+	}
+	acc, err := wrappedAccount(a.db, prev.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.Mint(deposit.Recipient, deposit.Amount)
+	if err != nil {
+		return nil, err
+	}
+	a.merge(receipt)
+	record := &brty.BridgeDepositRecord{
+		Chain:     prev.Name,
+		TxHash:    deposit.TxHash,
+		BlockHash: block.Hash,
+		Recipient: deposit.Recipient,
+		Amount:    deposit.Amount,
+		Height:    a.height,
+	}
+	a.set(calcDepositKey(prev.Name, deposit.TxHash), types.Encode(record))
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: brty.TyLogBridgeDeposit, Log: types.Encode(&brty.ReceiptBridgeDeposit{Deposit: record})})
+	chain := *prev
+	chain.Supply += deposit.Amount
+	a.saveChain(prev, &chain)
+	return a.receipt(), nil
+}
+
+//withdraw 销毁映射资产，生成等待中继签名的提取
+func (a *Action) withdraw(payload *brty.BridgeWithdraw) (*types.Receipt, error) {
+	if payload.Amount <= 0 {
+		return nil, brty.ErrBridgeAmount
+	}
+	if len(payload.To) == 0 || len(payload.To) > brty.MaxAddressLength {
+		return nil, types.ErrInvalidParam
+	}
+	prev, err := getChain(a.db, payload.Chain)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := wrappedAccount(a.db, prev.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.Burn(a.fromaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	a.merge(receipt)
+	chain := *prev
+	chain.Supply -= payload.Amount
+	a.saveChain(prev, &chain)
+	a.saveWithdrawal(nil, &brty.BridgeWithdrawal{
+		WithdrawalID: calcWithdrawalID(a.height, a.index),
+		Chain:        chain.Name,
+		From:         a.fromaddr,
+		To:           payload.To,
+		Amount:       payload.Amount,
+		Height:       a.height,
+		Status:       brty.WithdrawalPending,
+	})
+	return a.receipt(), nil
+}
+
+//sign 任何人都可以提交中继的签名，签名数达到threshold以后提取授权生效
+func (a *Action) sign(payload *brty.BridgeSign) (*types.Receipt, error) {
+	if len(payload.Signatures) == 0 {
+		return nil, brty.ErrRelayerSignature
+	}
+	prev, err := getWithdrawal(a.db, payload.WithdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Status != brty.WithdrawalPending {
+		return nil, brty.ErrWithdrawalAuthorized
+	}
+	chain, err := getChain(a.db, prev.Chain)
+	if err != nil {
+		return nil, err
+	}
+	relayers := make(map[string]bool)
+	for _, relayer := range chain.Relayers {
+		relayers[relayer] = true
+	}
+	data := brty.AuthorizationData(prev)
+	signed := make(map[string]bool)
+	for _, sig := range prev.Signatures {
+		signed[address.PubKeyToAddr(types.SignPubKey(data, "", sig))] = true
+	}
+	w := *prev
+	w.Signatures = append([]*types.Signature{}, prev.Signatures...)
+	for _, sig := range payload.Signatures {
+		if sig == nil || !types.CheckSign(data, "", sig) {
+			return nil, brty.ErrRelayerSignature
+		}
+		addr := address.PubKeyToAddr(types.SignPubKey(data, "", sig))
+		if !relayers[addr] || signed[addr] {
+			return nil, brty.ErrRelayerSignature
+		}
+		signed[addr] = true
+		w.Signatures = append(w.Signatures, sig)
+	}
+	if len(w.Signatures) >= int(chain.Threshold) {
+		w.Status = brty.WithdrawalAuthorized
+	}
+	a.saveWithdrawal(prev, &w)
+	return a.receipt(), nil
+}
+
+func listCount(count int32) int32 {
+	if count <= 0 {
+		return brty.DefaultListCount
+	}
+	if count > brty.MaxListCount {
+		return brty.MaxListCount
+	}
+	return count
+}
+
+func listWithdrawals(localdb dbm.KVDB, statedb dbm.KV, req *brty.ReqBridgeWithdrawals) (*brty.ReplyBridgeWithdrawals, error) {
+	if req.Status != brty.WithdrawalPending && req.Status != brty.WithdrawalAuthorized {
+		return nil, types.ErrInvalidParam
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcStatusIndexKey(req.Status, req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(calcStatusIndexPrefix(req.Status)), key, listCount(req.Count), req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &brty.ReplyBridgeWithdrawals{}
+	for _, value := range values {
+		w, err := getWithdrawal(statedb, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.Withdrawals = append(reply.Withdrawals, w)
+		reply.PrimaryKey = w.WithdrawalID
+	}
+	return reply, nil
+}
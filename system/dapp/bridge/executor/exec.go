@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	brty "github.com/33cn/chain33/system/dapp/bridge/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Config 管理员配置外部链
+func (b *Bridge) Exec_Config(payload *brty.BridgeChainConfig, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(b, tx, index)
+	return action.config(payload)
+}
+
+// Exec_Headers 提交外部链的区块头
+func (b *Bridge) Exec_Headers(payload *brty.BridgeHeaders, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(b, tx, index)
+	return action.headers(payload)
+}
+
+// Exec_Deposit 验证锁定交易，铸造映射资产
+func (b *Bridge) Exec_Deposit(payload *brty.BridgeDeposit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(b, tx, index)
+	return action.deposit(payload)
+}
+
+// Exec_Withdraw 销毁映射资产，等待中继授权提取
+func (b *Bridge) Exec_Withdraw(payload *brty.BridgeWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(b, tx, index)
+	return action.withdraw(payload)
+}
+
+// Exec_Sign 提交中继对提取的签名
+func (b *Bridge) Exec_Sign(payload *brty.BridgeSign, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(b, tx, index)
+	return action.sign(payload)
+}
@@ -0,0 +1,61 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	brty "github.com/33cn/chain33/system/dapp/bridge/types"
+	"github.com/33cn/chain33/types"
+)
+
+//execLocal 按提取的状态建立索引，状态变化的时候删除旧的索引
+func (b *Bridge) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != brty.TyLogBridgeWithdrawal {
+			continue
+		}
+		var log brty.ReceiptBridgeWithdrawal
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		id := log.Current.WithdrawalID
+		if log.Prev != nil {
+			if log.Prev.Status == log.Current.Status {
+				continue
+			}
+			kvs = append(kvs, &types.KeyValue{Key: calcStatusIndexKey(log.Prev.Status, id), Value: nil})
+		}
+		kvs = append(kvs, &types.KeyValue{Key: calcStatusIndexKey(log.Current.Status, id), Value: []byte(id)})
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+// ExecLocal_Config 配置不会产生新的索引
+func (b *Bridge) ExecLocal_Config(payload *brty.BridgeChainConfig, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return b.execLocal(tx, receipt)
+}
+
+// ExecLocal_Headers 区块头不会产生新的索引
+func (b *Bridge) ExecLocal_Headers(payload *brty.BridgeHeaders, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return b.execLocal(tx, receipt)
+}
+
+// ExecLocal_Deposit 铸造不会产生新的索引
+func (b *Bridge) ExecLocal_Deposit(payload *brty.BridgeDeposit, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return b.execLocal(tx, receipt)
+}
+
+// ExecLocal_Withdraw 添加等待签名的提取的索引
+func (b *Bridge) ExecLocal_Withdraw(payload *brty.BridgeWithdraw, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return b.execLocal(tx, receipt)
+}
+
+// ExecLocal_Sign 授权以后更新提取的状态索引
+func (b *Bridge) ExecLocal_Sign(payload *brty.BridgeSign, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return b.execLocal(tx, receipt)
+}
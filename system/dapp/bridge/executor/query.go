@@ -0,0 +1,52 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/common/address"
+	brty "github.com/33cn/chain33/system/dapp/bridge/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetChain 查询外部链的配置和最长链的顶端
+func (b *Bridge) Query_GetChain(in *types.ReqString) (types.Message, error) {
+	return getChain(b.GetStateDB(), in.Data)
+}
+
+// Query_GetHeader 按hash查询外部链的区块头
+func (b *Bridge) Query_GetHeader(in *brty.ReqBridgeHeader) (types.Message, error) {
+	return getHeader(b.GetStateDB(), in.Chain, in.Hash)
+}
+
+// Query_GetDeposit 按外部链的交易hash查询铸造记录
+func (b *Bridge) Query_GetDeposit(in *brty.ReqBridgeDeposit) (types.Message, error) {
+	return getDeposit(b.GetStateDB(), in.Chain, in.TxHash)
+}
+
+// Query_GetWithdrawal 按id查询提取和中继的签名
+func (b *Bridge) Query_GetWithdrawal(in *types.ReqString) (types.Message, error) {
+	return getWithdrawal(b.GetStateDB(), in.Data)
+}
+
+// Query_ListWithdrawals 按状态列出提取
+func (b *Bridge) Query_ListWithdrawals(in *brty.ReqBridgeWithdrawals) (types.Message, error) {
+	return listWithdrawals(b.GetLocalDB(), b.GetStateDB(), in)
+}
+
+// Query_GetBalance 查询地址的映射资产余额
+func (b *Bridge) Query_GetBalance(in *brty.ReqBridgeBalance) (types.Message, error) {
+	if err := address.CheckAddress(in.Addr); err != nil {
+		return nil, err
+	}
+	chain, err := getChain(b.GetStateDB(), in.Chain)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := wrappedAccount(b.GetStateDB(), chain.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	return acc.LoadAccount(in.Addr), nil
+}
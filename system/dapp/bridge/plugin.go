@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bridge 跨链桥执行器插件
+// 1. 管理员配置外部链的区块头验证器、信任的checkpoint、托管脚本和中继，任何人都可以提交外部链的区块头
+// 2. 锁定交易在累计工作量最大的链上确认以后，用交易和merkle证明给交易中的接收地址铸造映射资产
+// 3. 销毁映射资产生成提取，中继的签名达到门限以后，提取授权在外部链上从托管地址支付
+package bridge
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/bridge/commands"
+	"github.com/33cn/chain33/system/dapp/bridge/executor"
+	"github.com/33cn/chain33/system/dapp/bridge/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.BridgeX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.BridgeCmd,
+		RPC:      nil,
+	})
+}
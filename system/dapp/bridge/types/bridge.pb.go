@@ -0,0 +1,1558 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: bridge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	types "github.com/33cn/chain33/types"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type BridgeAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*BridgeAction_Config
+	//	*BridgeAction_Headers
+	//	*BridgeAction_Deposit
+	//	*BridgeAction_Withdraw
+	//	*BridgeAction_Sign
+	Value                isBridgeAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BridgeAction) Reset()         { *m = BridgeAction{} }
+func (m *BridgeAction) String() string { return proto.CompactTextString(m) }
+func (*BridgeAction) ProtoMessage()    {}
+func (*BridgeAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{0}
+}
+
+func (m *BridgeAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeAction.Unmarshal(m, b)
+}
+func (m *BridgeAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeAction.Marshal(b, m, deterministic)
+}
+func (m *BridgeAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeAction.Merge(m, src)
+}
+func (m *BridgeAction) XXX_Size() int {
+	return xxx_messageInfo_BridgeAction.Size(m)
+}
+func (m *BridgeAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeAction proto.InternalMessageInfo
+
+type isBridgeAction_Value interface {
+	isBridgeAction_Value()
+}
+
+type BridgeAction_Config struct {
+	Config *BridgeChainConfig `protobuf:"bytes,1,opt,name=config,proto3,oneof"`
+}
+
+type BridgeAction_Headers struct {
+	Headers *BridgeHeaders `protobuf:"bytes,2,opt,name=headers,proto3,oneof"`
+}
+
+type BridgeAction_Deposit struct {
+	Deposit *BridgeDeposit `protobuf:"bytes,3,opt,name=deposit,proto3,oneof"`
+}
+
+type BridgeAction_Withdraw struct {
+	Withdraw *BridgeWithdraw `protobuf:"bytes,4,opt,name=withdraw,proto3,oneof"`
+}
+
+type BridgeAction_Sign struct {
+	Sign *BridgeSign `protobuf:"bytes,5,opt,name=sign,proto3,oneof"`
+}
+
+func (*BridgeAction_Config) isBridgeAction_Value() {}
+
+func (*BridgeAction_Headers) isBridgeAction_Value() {}
+
+func (*BridgeAction_Deposit) isBridgeAction_Value() {}
+
+func (*BridgeAction_Withdraw) isBridgeAction_Value() {}
+
+func (*BridgeAction_Sign) isBridgeAction_Value() {}
+
+func (m *BridgeAction) GetValue() isBridgeAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *BridgeAction) GetConfig() *BridgeChainConfig {
+	if x, ok := m.GetValue().(*BridgeAction_Config); ok {
+		return x.Config
+	}
+	return nil
+}
+
+func (m *BridgeAction) GetHeaders() *BridgeHeaders {
+	if x, ok := m.GetValue().(*BridgeAction_Headers); ok {
+		return x.Headers
+	}
+	return nil
+}
+
+func (m *BridgeAction) GetDeposit() *BridgeDeposit {
+	if x, ok := m.GetValue().(*BridgeAction_Deposit); ok {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (m *BridgeAction) GetWithdraw() *BridgeWithdraw {
+	if x, ok := m.GetValue().(*BridgeAction_Withdraw); ok {
+		return x.Withdraw
+	}
+	return nil
+}
+
+func (m *BridgeAction) GetSign() *BridgeSign {
+	if x, ok := m.GetValue().(*BridgeAction_Sign); ok {
+		return x.Sign
+	}
+	return nil
+}
+
+func (m *BridgeAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*BridgeAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _BridgeAction_OneofMarshaler, _BridgeAction_OneofUnmarshaler, _BridgeAction_OneofSizer, []interface{}{
+		(*BridgeAction_Config)(nil),
+		(*BridgeAction_Headers)(nil),
+		(*BridgeAction_Deposit)(nil),
+		(*BridgeAction_Withdraw)(nil),
+		(*BridgeAction_Sign)(nil),
+	}
+}
+
+func _BridgeAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*BridgeAction)
+	// value
+	switch x := m.Value.(type) {
+	case *BridgeAction_Config:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Config); err != nil {
+			return err
+		}
+	case *BridgeAction_Headers:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Headers); err != nil {
+			return err
+		}
+	case *BridgeAction_Deposit:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Deposit); err != nil {
+			return err
+		}
+	case *BridgeAction_Withdraw:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Withdraw); err != nil {
+			return err
+		}
+	case *BridgeAction_Sign:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sign); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("BridgeAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _BridgeAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*BridgeAction)
+	switch tag {
+	case 1: // value.config
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BridgeChainConfig)
+		err := b.DecodeMessage(msg)
+		m.Value = &BridgeAction_Config{msg}
+		return true, err
+	case 2: // value.headers
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BridgeHeaders)
+		err := b.DecodeMessage(msg)
+		m.Value = &BridgeAction_Headers{msg}
+		return true, err
+	case 3: // value.deposit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BridgeDeposit)
+		err := b.DecodeMessage(msg)
+		m.Value = &BridgeAction_Deposit{msg}
+		return true, err
+	case 4: // value.withdraw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BridgeWithdraw)
+		err := b.DecodeMessage(msg)
+		m.Value = &BridgeAction_Withdraw{msg}
+		return true, err
+	case 5: // value.sign
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BridgeSign)
+		err := b.DecodeMessage(msg)
+		m.Value = &BridgeAction_Sign{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _BridgeAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*BridgeAction)
+	// value
+	switch x := m.Value.(type) {
+	case *BridgeAction_Config:
+		s := proto.Size(x.Config)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BridgeAction_Headers:
+		s := proto.Size(x.Headers)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BridgeAction_Deposit:
+		s := proto.Size(x.Deposit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BridgeAction_Withdraw:
+		s := proto.Size(x.Withdraw)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BridgeAction_Sign:
+		s := proto.Size(x.Sign)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//管理员配置外部链，verifier 是区块头验证器的名字，checkpoint 是信任的起始区块头，只在第一次配置的时候设置
+//   symbol        : 铸造的映射资产的symbol
+//   confirmations : 锁定交易所在区块之后需要的区块数(包括所在区块)
+//   custody       : 外部链上托管地址的锁定脚本，支付给这个脚本的输出才算锁定
+//   relayers      : 签名提取授权的中继地址，threshold 个签名以后授权生效
+type BridgeChainConfig struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Verifier             string   `protobuf:"bytes,2,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	Symbol               string   `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Checkpoint           []byte   `protobuf:"bytes,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	CheckpointHeight     int64    `protobuf:"varint,5,opt,name=checkpointHeight,proto3" json:"checkpointHeight,omitempty"`
+	Confirmations        int64    `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Custody              []byte   `protobuf:"bytes,7,opt,name=custody,proto3" json:"custody,omitempty"`
+	Relayers             []string `protobuf:"bytes,8,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	Threshold            int32    `protobuf:"varint,9,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeChainConfig) Reset()         { *m = BridgeChainConfig{} }
+func (m *BridgeChainConfig) String() string { return proto.CompactTextString(m) }
+func (*BridgeChainConfig) ProtoMessage()    {}
+func (*BridgeChainConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{1}
+}
+
+func (m *BridgeChainConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeChainConfig.Unmarshal(m, b)
+}
+func (m *BridgeChainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeChainConfig.Marshal(b, m, deterministic)
+}
+func (m *BridgeChainConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeChainConfig.Merge(m, src)
+}
+func (m *BridgeChainConfig) XXX_Size() int {
+	return xxx_messageInfo_BridgeChainConfig.Size(m)
+}
+func (m *BridgeChainConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeChainConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeChainConfig proto.InternalMessageInfo
+
+func (m *BridgeChainConfig) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BridgeChainConfig) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+func (m *BridgeChainConfig) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *BridgeChainConfig) GetCheckpoint() []byte {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *BridgeChainConfig) GetCheckpointHeight() int64 {
+	if m != nil {
+		return m.CheckpointHeight
+	}
+	return 0
+}
+
+func (m *BridgeChainConfig) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *BridgeChainConfig) GetCustody() []byte {
+	if m != nil {
+		return m.Custody
+	}
+	return nil
+}
+
+func (m *BridgeChainConfig) GetRelayers() []string {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
+}
+
+func (m *BridgeChainConfig) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+//tip 是累计工作量最大的区块头，supply 是还没有提取的映射资产
+type BridgeChain struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Verifier             string   `protobuf:"bytes,2,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	Symbol               string   `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	CheckpointHeight     int64    `protobuf:"varint,4,opt,name=checkpointHeight,proto3" json:"checkpointHeight,omitempty"`
+	Confirmations        int64    `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Custody              []byte   `protobuf:"bytes,6,opt,name=custody,proto3" json:"custody,omitempty"`
+	Relayers             []string `protobuf:"bytes,7,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	Threshold            int32    `protobuf:"varint,8,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Tip                  []byte   `protobuf:"bytes,9,opt,name=tip,proto3" json:"tip,omitempty"`
+	TipHeight            int64    `protobuf:"varint,10,opt,name=tipHeight,proto3" json:"tipHeight,omitempty"`
+	Supply               int64    `protobuf:"varint,11,opt,name=supply,proto3" json:"supply,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeChain) Reset()         { *m = BridgeChain{} }
+func (m *BridgeChain) String() string { return proto.CompactTextString(m) }
+func (*BridgeChain) ProtoMessage()    {}
+func (*BridgeChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{2}
+}
+
+func (m *BridgeChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeChain.Unmarshal(m, b)
+}
+func (m *BridgeChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeChain.Marshal(b, m, deterministic)
+}
+func (m *BridgeChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeChain.Merge(m, src)
+}
+func (m *BridgeChain) XXX_Size() int {
+	return xxx_messageInfo_BridgeChain.Size(m)
+}
+func (m *BridgeChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeChain proto.InternalMessageInfo
+
+func (m *BridgeChain) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BridgeChain) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+func (m *BridgeChain) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *BridgeChain) GetCheckpointHeight() int64 {
+	if m != nil {
+		return m.CheckpointHeight
+	}
+	return 0
+}
+
+func (m *BridgeChain) GetConfirmations() int64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *BridgeChain) GetCustody() []byte {
+	if m != nil {
+		return m.Custody
+	}
+	return nil
+}
+
+func (m *BridgeChain) GetRelayers() []string {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
+}
+
+func (m *BridgeChain) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *BridgeChain) GetTip() []byte {
+	if m != nil {
+		return m.Tip
+	}
+	return nil
+}
+
+func (m *BridgeChain) GetTipHeight() int64 {
+	if m != nil {
+		return m.TipHeight
+	}
+	return 0
+}
+
+func (m *BridgeChain) GetSupply() int64 {
+	if m != nil {
+		return m.Supply
+	}
+	return 0
+}
+
+//任何人都可以提交外部链的区块头，第一个区块头的父区块必须已经保存
+type BridgeHeaders struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Headers              [][]byte `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeHeaders) Reset()         { *m = BridgeHeaders{} }
+func (m *BridgeHeaders) String() string { return proto.CompactTextString(m) }
+func (*BridgeHeaders) ProtoMessage()    {}
+func (*BridgeHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{3}
+}
+
+func (m *BridgeHeaders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeHeaders.Unmarshal(m, b)
+}
+func (m *BridgeHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeHeaders.Marshal(b, m, deterministic)
+}
+func (m *BridgeHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeHeaders.Merge(m, src)
+}
+func (m *BridgeHeaders) XXX_Size() int {
+	return xxx_messageInfo_BridgeHeaders.Size(m)
+}
+func (m *BridgeHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeHeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeHeaders proto.InternalMessageInfo
+
+func (m *BridgeHeaders) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BridgeHeaders) GetHeaders() [][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+//hash 按外部链内部的字节序，work 是从checkpoint开始累计的工作量
+type BridgeHeader struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Prev                 []byte   `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Work                 []byte   `protobuf:"bytes,5,opt,name=work,proto3" json:"work,omitempty"`
+	Raw                  []byte   `protobuf:"bytes,6,opt,name=raw,proto3" json:"raw,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeHeader) Reset()         { *m = BridgeHeader{} }
+func (m *BridgeHeader) String() string { return proto.CompactTextString(m) }
+func (*BridgeHeader) ProtoMessage()    {}
+func (*BridgeHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{4}
+}
+
+func (m *BridgeHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeHeader.Unmarshal(m, b)
+}
+func (m *BridgeHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeHeader.Marshal(b, m, deterministic)
+}
+func (m *BridgeHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeHeader.Merge(m, src)
+}
+func (m *BridgeHeader) XXX_Size() int {
+	return xxx_messageInfo_BridgeHeader.Size(m)
+}
+func (m *BridgeHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeHeader proto.InternalMessageInfo
+
+func (m *BridgeHeader) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BridgeHeader) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BridgeHeader) GetPrev() []byte {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *BridgeHeader) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BridgeHeader) GetWork() []byte {
+	if m != nil {
+		return m.Work
+	}
+	return nil
+}
+
+func (m *BridgeHeader) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
+	}
+	return nil
+}
+
+//提交锁定交易和它在区块中的merkle证明
+type BridgeDeposit struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Tx                   []byte   `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	Branch               [][]byte `protobuf:"bytes,4,rep,name=branch,proto3" json:"branch,omitempty"`
+	Index                int32    `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeDeposit) Reset()         { *m = BridgeDeposit{} }
+func (m *BridgeDeposit) String() string { return proto.CompactTextString(m) }
+func (*BridgeDeposit) ProtoMessage()    {}
+func (*BridgeDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{5}
+}
+
+func (m *BridgeDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeDeposit.Unmarshal(m, b)
+}
+func (m *BridgeDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeDeposit.Marshal(b, m, deterministic)
+}
+func (m *BridgeDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeDeposit.Merge(m, src)
+}
+func (m *BridgeDeposit) XXX_Size() int {
+	return xxx_messageInfo_BridgeDeposit.Size(m)
+}
+func (m *BridgeDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeDeposit proto.InternalMessageInfo
+
+func (m *BridgeDeposit) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BridgeDeposit) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BridgeDeposit) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *BridgeDeposit) GetBranch() [][]byte {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *BridgeDeposit) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type BridgeDepositRecord struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	TxHash               []byte   `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Recipient            string   `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount               int64    `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeDepositRecord) Reset()         { *m = BridgeDepositRecord{} }
+func (m *BridgeDepositRecord) String() string { return proto.CompactTextString(m) }
+func (*BridgeDepositRecord) ProtoMessage()    {}
+func (*BridgeDepositRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{6}
+}
+
+func (m *BridgeDepositRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeDepositRecord.Unmarshal(m, b)
+}
+func (m *BridgeDepositRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeDepositRecord.Marshal(b, m, deterministic)
+}
+func (m *BridgeDepositRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeDepositRecord.Merge(m, src)
+}
+func (m *BridgeDepositRecord) XXX_Size() int {
+	return xxx_messageInfo_BridgeDepositRecord.Size(m)
+}
+func (m *BridgeDepositRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeDepositRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeDepositRecord proto.InternalMessageInfo
+
+func (m *BridgeDepositRecord) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BridgeDepositRecord) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *BridgeDepositRecord) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BridgeDepositRecord) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *BridgeDepositRecord) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *BridgeDepositRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+//销毁映射资产，等待中继签名外部链上的提取
+type BridgeWithdraw struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeWithdraw) Reset()         { *m = BridgeWithdraw{} }
+func (m *BridgeWithdraw) String() string { return proto.CompactTextString(m) }
+func (*BridgeWithdraw) ProtoMessage()    {}
+func (*BridgeWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{7}
+}
+
+func (m *BridgeWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeWithdraw.Unmarshal(m, b)
+}
+func (m *BridgeWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeWithdraw.Marshal(b, m, deterministic)
+}
+func (m *BridgeWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeWithdraw.Merge(m, src)
+}
+func (m *BridgeWithdraw) XXX_Size() int {
+	return xxx_messageInfo_BridgeWithdraw.Size(m)
+}
+func (m *BridgeWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeWithdraw proto.InternalMessageInfo
+
+func (m *BridgeWithdraw) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BridgeWithdraw) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *BridgeWithdraw) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type BridgeSign struct {
+	WithdrawalID         string             `protobuf:"bytes,1,opt,name=withdrawalID,proto3" json:"withdrawalID,omitempty"`
+	Signatures           []*types.Signature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BridgeSign) Reset()         { *m = BridgeSign{} }
+func (m *BridgeSign) String() string { return proto.CompactTextString(m) }
+func (*BridgeSign) ProtoMessage()    {}
+func (*BridgeSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{8}
+}
+
+func (m *BridgeSign) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeSign.Unmarshal(m, b)
+}
+func (m *BridgeSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeSign.Marshal(b, m, deterministic)
+}
+func (m *BridgeSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeSign.Merge(m, src)
+}
+func (m *BridgeSign) XXX_Size() int {
+	return xxx_messageInfo_BridgeSign.Size(m)
+}
+func (m *BridgeSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeSign proto.InternalMessageInfo
+
+func (m *BridgeSign) GetWithdrawalID() string {
+	if m != nil {
+		return m.WithdrawalID
+	}
+	return ""
+}
+
+func (m *BridgeSign) GetSignatures() []*types.Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+type BridgeWithdrawal struct {
+	WithdrawalID         string             `protobuf:"bytes,1,opt,name=withdrawalID,proto3" json:"withdrawalID,omitempty"`
+	Chain                string             `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	From                 string             `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string             `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64              `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height               int64              `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Status               int32              `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Signatures           []*types.Signature `protobuf:"bytes,8,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BridgeWithdrawal) Reset()         { *m = BridgeWithdrawal{} }
+func (m *BridgeWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*BridgeWithdrawal) ProtoMessage()    {}
+func (*BridgeWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{9}
+}
+
+func (m *BridgeWithdrawal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeWithdrawal.Unmarshal(m, b)
+}
+func (m *BridgeWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeWithdrawal.Marshal(b, m, deterministic)
+}
+func (m *BridgeWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeWithdrawal.Merge(m, src)
+}
+func (m *BridgeWithdrawal) XXX_Size() int {
+	return xxx_messageInfo_BridgeWithdrawal.Size(m)
+}
+func (m *BridgeWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeWithdrawal proto.InternalMessageInfo
+
+func (m *BridgeWithdrawal) GetWithdrawalID() string {
+	if m != nil {
+		return m.WithdrawalID
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *BridgeWithdrawal) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *BridgeWithdrawal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BridgeWithdrawal) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *BridgeWithdrawal) GetSignatures() []*types.Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+//中继签名的内容
+type BridgeAuthorization struct {
+	WithdrawalID         string   `protobuf:"bytes,1,opt,name=withdrawalID,proto3" json:"withdrawalID,omitempty"`
+	Chain                string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeAuthorization) Reset()         { *m = BridgeAuthorization{} }
+func (m *BridgeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*BridgeAuthorization) ProtoMessage()    {}
+func (*BridgeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{10}
+}
+
+func (m *BridgeAuthorization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeAuthorization.Unmarshal(m, b)
+}
+func (m *BridgeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeAuthorization.Marshal(b, m, deterministic)
+}
+func (m *BridgeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeAuthorization.Merge(m, src)
+}
+func (m *BridgeAuthorization) XXX_Size() int {
+	return xxx_messageInfo_BridgeAuthorization.Size(m)
+}
+func (m *BridgeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeAuthorization proto.InternalMessageInfo
+
+func (m *BridgeAuthorization) GetWithdrawalID() string {
+	if m != nil {
+		return m.WithdrawalID
+	}
+	return ""
+}
+
+func (m *BridgeAuthorization) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BridgeAuthorization) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *BridgeAuthorization) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ReceiptBridgeChain struct {
+	Prev                 *BridgeChain `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *BridgeChain `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptBridgeChain) Reset()         { *m = ReceiptBridgeChain{} }
+func (m *ReceiptBridgeChain) String() string { return proto.CompactTextString(m) }
+func (*ReceiptBridgeChain) ProtoMessage()    {}
+func (*ReceiptBridgeChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{11}
+}
+
+func (m *ReceiptBridgeChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptBridgeChain.Unmarshal(m, b)
+}
+func (m *ReceiptBridgeChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptBridgeChain.Marshal(b, m, deterministic)
+}
+func (m *ReceiptBridgeChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptBridgeChain.Merge(m, src)
+}
+func (m *ReceiptBridgeChain) XXX_Size() int {
+	return xxx_messageInfo_ReceiptBridgeChain.Size(m)
+}
+func (m *ReceiptBridgeChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptBridgeChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptBridgeChain proto.InternalMessageInfo
+
+func (m *ReceiptBridgeChain) GetPrev() *BridgeChain {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptBridgeChain) GetCurrent() *BridgeChain {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptBridgeHeader struct {
+	Header               *BridgeHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReceiptBridgeHeader) Reset()         { *m = ReceiptBridgeHeader{} }
+func (m *ReceiptBridgeHeader) String() string { return proto.CompactTextString(m) }
+func (*ReceiptBridgeHeader) ProtoMessage()    {}
+func (*ReceiptBridgeHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{12}
+}
+
+func (m *ReceiptBridgeHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptBridgeHeader.Unmarshal(m, b)
+}
+func (m *ReceiptBridgeHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptBridgeHeader.Marshal(b, m, deterministic)
+}
+func (m *ReceiptBridgeHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptBridgeHeader.Merge(m, src)
+}
+func (m *ReceiptBridgeHeader) XXX_Size() int {
+	return xxx_messageInfo_ReceiptBridgeHeader.Size(m)
+}
+func (m *ReceiptBridgeHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptBridgeHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptBridgeHeader proto.InternalMessageInfo
+
+func (m *ReceiptBridgeHeader) GetHeader() *BridgeHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type ReceiptBridgeDeposit struct {
+	Deposit              *BridgeDepositRecord `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReceiptBridgeDeposit) Reset()         { *m = ReceiptBridgeDeposit{} }
+func (m *ReceiptBridgeDeposit) String() string { return proto.CompactTextString(m) }
+func (*ReceiptBridgeDeposit) ProtoMessage()    {}
+func (*ReceiptBridgeDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{13}
+}
+
+func (m *ReceiptBridgeDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptBridgeDeposit.Unmarshal(m, b)
+}
+func (m *ReceiptBridgeDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptBridgeDeposit.Marshal(b, m, deterministic)
+}
+func (m *ReceiptBridgeDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptBridgeDeposit.Merge(m, src)
+}
+func (m *ReceiptBridgeDeposit) XXX_Size() int {
+	return xxx_messageInfo_ReceiptBridgeDeposit.Size(m)
+}
+func (m *ReceiptBridgeDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptBridgeDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptBridgeDeposit proto.InternalMessageInfo
+
+func (m *ReceiptBridgeDeposit) GetDeposit() *BridgeDepositRecord {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+type ReceiptBridgeWithdrawal struct {
+	Prev                 *BridgeWithdrawal `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *BridgeWithdrawal `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptBridgeWithdrawal) Reset()         { *m = ReceiptBridgeWithdrawal{} }
+func (m *ReceiptBridgeWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*ReceiptBridgeWithdrawal) ProtoMessage()    {}
+func (*ReceiptBridgeWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{14}
+}
+
+func (m *ReceiptBridgeWithdrawal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptBridgeWithdrawal.Unmarshal(m, b)
+}
+func (m *ReceiptBridgeWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptBridgeWithdrawal.Marshal(b, m, deterministic)
+}
+func (m *ReceiptBridgeWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptBridgeWithdrawal.Merge(m, src)
+}
+func (m *ReceiptBridgeWithdrawal) XXX_Size() int {
+	return xxx_messageInfo_ReceiptBridgeWithdrawal.Size(m)
+}
+func (m *ReceiptBridgeWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptBridgeWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptBridgeWithdrawal proto.InternalMessageInfo
+
+func (m *ReceiptBridgeWithdrawal) GetPrev() *BridgeWithdrawal {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptBridgeWithdrawal) GetCurrent() *BridgeWithdrawal {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqBridgeHeader struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBridgeHeader) Reset()         { *m = ReqBridgeHeader{} }
+func (m *ReqBridgeHeader) String() string { return proto.CompactTextString(m) }
+func (*ReqBridgeHeader) ProtoMessage()    {}
+func (*ReqBridgeHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{15}
+}
+
+func (m *ReqBridgeHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBridgeHeader.Unmarshal(m, b)
+}
+func (m *ReqBridgeHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBridgeHeader.Marshal(b, m, deterministic)
+}
+func (m *ReqBridgeHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBridgeHeader.Merge(m, src)
+}
+func (m *ReqBridgeHeader) XXX_Size() int {
+	return xxx_messageInfo_ReqBridgeHeader.Size(m)
+}
+func (m *ReqBridgeHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBridgeHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBridgeHeader proto.InternalMessageInfo
+
+func (m *ReqBridgeHeader) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ReqBridgeHeader) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type ReqBridgeDeposit struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	TxHash               []byte   `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBridgeDeposit) Reset()         { *m = ReqBridgeDeposit{} }
+func (m *ReqBridgeDeposit) String() string { return proto.CompactTextString(m) }
+func (*ReqBridgeDeposit) ProtoMessage()    {}
+func (*ReqBridgeDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{16}
+}
+
+func (m *ReqBridgeDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBridgeDeposit.Unmarshal(m, b)
+}
+func (m *ReqBridgeDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBridgeDeposit.Marshal(b, m, deterministic)
+}
+func (m *ReqBridgeDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBridgeDeposit.Merge(m, src)
+}
+func (m *ReqBridgeDeposit) XXX_Size() int {
+	return xxx_messageInfo_ReqBridgeDeposit.Size(m)
+}
+func (m *ReqBridgeDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBridgeDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBridgeDeposit proto.InternalMessageInfo
+
+func (m *ReqBridgeDeposit) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ReqBridgeDeposit) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+type ReqBridgeBalance struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBridgeBalance) Reset()         { *m = ReqBridgeBalance{} }
+func (m *ReqBridgeBalance) String() string { return proto.CompactTextString(m) }
+func (*ReqBridgeBalance) ProtoMessage()    {}
+func (*ReqBridgeBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{17}
+}
+
+func (m *ReqBridgeBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBridgeBalance.Unmarshal(m, b)
+}
+func (m *ReqBridgeBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBridgeBalance.Marshal(b, m, deterministic)
+}
+func (m *ReqBridgeBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBridgeBalance.Merge(m, src)
+}
+func (m *ReqBridgeBalance) XXX_Size() int {
+	return xxx_messageInfo_ReqBridgeBalance.Size(m)
+}
+func (m *ReqBridgeBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBridgeBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBridgeBalance proto.InternalMessageInfo
+
+func (m *ReqBridgeBalance) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ReqBridgeBalance) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReqBridgeWithdrawals struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBridgeWithdrawals) Reset()         { *m = ReqBridgeWithdrawals{} }
+func (m *ReqBridgeWithdrawals) String() string { return proto.CompactTextString(m) }
+func (*ReqBridgeWithdrawals) ProtoMessage()    {}
+func (*ReqBridgeWithdrawals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{18}
+}
+
+func (m *ReqBridgeWithdrawals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBridgeWithdrawals.Unmarshal(m, b)
+}
+func (m *ReqBridgeWithdrawals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBridgeWithdrawals.Marshal(b, m, deterministic)
+}
+func (m *ReqBridgeWithdrawals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBridgeWithdrawals.Merge(m, src)
+}
+func (m *ReqBridgeWithdrawals) XXX_Size() int {
+	return xxx_messageInfo_ReqBridgeWithdrawals.Size(m)
+}
+func (m *ReqBridgeWithdrawals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBridgeWithdrawals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBridgeWithdrawals proto.InternalMessageInfo
+
+func (m *ReqBridgeWithdrawals) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReqBridgeWithdrawals) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqBridgeWithdrawals) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqBridgeWithdrawals) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyBridgeWithdrawals struct {
+	Withdrawals          []*BridgeWithdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	PrimaryKey           string              `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReplyBridgeWithdrawals) Reset()         { *m = ReplyBridgeWithdrawals{} }
+func (m *ReplyBridgeWithdrawals) String() string { return proto.CompactTextString(m) }
+func (*ReplyBridgeWithdrawals) ProtoMessage()    {}
+func (*ReplyBridgeWithdrawals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ed31acb30cd14, []int{19}
+}
+
+func (m *ReplyBridgeWithdrawals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyBridgeWithdrawals.Unmarshal(m, b)
+}
+func (m *ReplyBridgeWithdrawals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyBridgeWithdrawals.Marshal(b, m, deterministic)
+}
+func (m *ReplyBridgeWithdrawals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyBridgeWithdrawals.Merge(m, src)
+}
+func (m *ReplyBridgeWithdrawals) XXX_Size() int {
+	return xxx_messageInfo_ReplyBridgeWithdrawals.Size(m)
+}
+func (m *ReplyBridgeWithdrawals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyBridgeWithdrawals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyBridgeWithdrawals proto.InternalMessageInfo
+
+func (m *ReplyBridgeWithdrawals) GetWithdrawals() []*BridgeWithdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+func (m *ReplyBridgeWithdrawals) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BridgeAction)(nil), "types.BridgeAction")
+	proto.RegisterType((*BridgeChainConfig)(nil), "types.BridgeChainConfig")
+	proto.RegisterType((*BridgeChain)(nil), "types.BridgeChain")
+	proto.RegisterType((*BridgeHeaders)(nil), "types.BridgeHeaders")
+	proto.RegisterType((*BridgeHeader)(nil), "types.BridgeHeader")
+	proto.RegisterType((*BridgeDeposit)(nil), "types.BridgeDeposit")
+	proto.RegisterType((*BridgeDepositRecord)(nil), "types.BridgeDepositRecord")
+	proto.RegisterType((*BridgeWithdraw)(nil), "types.BridgeWithdraw")
+	proto.RegisterType((*BridgeSign)(nil), "types.BridgeSign")
+	proto.RegisterType((*BridgeWithdrawal)(nil), "types.BridgeWithdrawal")
+	proto.RegisterType((*BridgeAuthorization)(nil), "types.BridgeAuthorization")
+	proto.RegisterType((*ReceiptBridgeChain)(nil), "types.ReceiptBridgeChain")
+	proto.RegisterType((*ReceiptBridgeHeader)(nil), "types.ReceiptBridgeHeader")
+	proto.RegisterType((*ReceiptBridgeDeposit)(nil), "types.ReceiptBridgeDeposit")
+	proto.RegisterType((*ReceiptBridgeWithdrawal)(nil), "types.ReceiptBridgeWithdrawal")
+	proto.RegisterType((*ReqBridgeHeader)(nil), "types.ReqBridgeHeader")
+	proto.RegisterType((*ReqBridgeDeposit)(nil), "types.ReqBridgeDeposit")
+	proto.RegisterType((*ReqBridgeBalance)(nil), "types.ReqBridgeBalance")
+	proto.RegisterType((*ReqBridgeWithdrawals)(nil), "types.ReqBridgeWithdrawals")
+	proto.RegisterType((*ReplyBridgeWithdrawals)(nil), "types.ReplyBridgeWithdrawals")
+}
+
+func init() { proto.RegisterFile("bridge.proto", fileDescriptor_1d3ed31acb30cd14) }
+
+var fileDescriptor_1d3ed31acb30cd14 = []byte{
+	// 967 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6e, 0xe4, 0xc4,
+	0x13, 0xce, 0xd8, 0xe3, 0xf9, 0x53, 0x33, 0xbb, 0xbf, 0x49, 0x27, 0xbf, 0xac, 0x15, 0x45, 0xab,
+	0xa8, 0x85, 0x60, 0xc5, 0xa2, 0x68, 0xc9, 0x72, 0x41, 0x20, 0xc1, 0x66, 0xf7, 0x30, 0x08, 0xc4,
+	0xa1, 0xf7, 0xc0, 0xb9, 0xc7, 0xee, 0x8c, 0x9b, 0x78, 0x6c, 0x6f, 0xbb, 0x27, 0x89, 0xb9, 0xc1,
+	0x91, 0x77, 0xe0, 0xc0, 0x13, 0xf0, 0x10, 0xbc, 0x0d, 0x4f, 0x81, 0xba, 0xdc, 0x1e, 0xdb, 0x93,
+	0x71, 0x36, 0x02, 0x6e, 0x5d, 0xd5, 0x55, 0xd5, 0xdf, 0xf7, 0xb5, 0xab, 0xda, 0x30, 0x5d, 0x28,
+	0x19, 0x2e, 0xc5, 0x59, 0xa6, 0x52, 0x9d, 0x12, 0x4f, 0x17, 0x99, 0xc8, 0x8f, 0xf7, 0xb5, 0xe2,
+	0x49, 0xce, 0x03, 0x2d, 0xd3, 0xa4, 0xdc, 0xa1, 0xbf, 0x39, 0x30, 0xbd, 0xc0, 0xd0, 0x57, 0xe8,
+	0x26, 0xe7, 0x30, 0x08, 0xd2, 0xe4, 0x52, 0x2e, 0xfd, 0xde, 0x69, 0xef, 0xd9, 0xe4, 0xdc, 0x3f,
+	0xc3, 0xdc, 0xb3, 0x32, 0xe8, 0x75, 0xc4, 0x65, 0xf2, 0x1a, 0xf7, 0xe7, 0x7b, 0xcc, 0x46, 0x92,
+	0x17, 0x30, 0x8c, 0x04, 0x0f, 0x85, 0xca, 0x7d, 0x07, 0x93, 0x0e, 0x5b, 0x49, 0xf3, 0x72, 0x6f,
+	0xbe, 0xc7, 0xaa, 0x30, 0x93, 0x11, 0x8a, 0x2c, 0xcd, 0xa5, 0xf6, 0xdd, 0x1d, 0x19, 0x6f, 0xca,
+	0x3d, 0x93, 0x61, 0xc3, 0xc8, 0x4b, 0x18, 0xdd, 0x48, 0x1d, 0x85, 0x8a, 0xdf, 0xf8, 0x7d, 0x4c,
+	0xf9, 0x7f, 0x2b, 0xe5, 0x07, 0xbb, 0x39, 0xdf, 0x63, 0x9b, 0x40, 0xf2, 0x11, 0xf4, 0x73, 0xb9,
+	0x4c, 0x7c, 0x0f, 0x13, 0xf6, 0x5b, 0x09, 0x6f, 0xe5, 0x32, 0x99, 0xef, 0x31, 0x0c, 0x20, 0x8f,
+	0xc1, 0xd1, 0x85, 0x3f, 0x38, 0xed, 0x3d, 0xf3, 0x98, 0xa3, 0x8b, 0x8b, 0x21, 0x78, 0xd7, 0x3c,
+	0x5e, 0x0b, 0xfa, 0xbb, 0x03, 0xfb, 0x77, 0xa8, 0x13, 0x02, 0xfd, 0x84, 0xaf, 0x04, 0x4a, 0x34,
+	0x66, 0xb8, 0x26, 0xc7, 0x30, 0xba, 0x16, 0x4a, 0x5e, 0x4a, 0xa1, 0x50, 0x85, 0x31, 0xdb, 0xd8,
+	0xe4, 0x08, 0x06, 0x79, 0xb1, 0x5a, 0xa4, 0x31, 0xb2, 0x1d, 0x33, 0x6b, 0x91, 0xa7, 0x00, 0x41,
+	0x24, 0x82, 0xab, 0x2c, 0x95, 0x89, 0x46, 0x5a, 0x53, 0xd6, 0xf0, 0x90, 0x8f, 0x61, 0x56, 0x5b,
+	0x73, 0x21, 0x97, 0x91, 0x46, 0x2e, 0x2e, 0xbb, 0xe3, 0x27, 0x1f, 0xc0, 0x23, 0xbc, 0x0e, 0xb5,
+	0xe2, 0xe6, 0x22, 0x73, 0x64, 0xe3, 0xb2, 0xb6, 0x93, 0xf8, 0x30, 0x0c, 0xd6, 0xb9, 0x4e, 0xc3,
+	0xc2, 0x1f, 0xe2, 0x71, 0x95, 0x69, 0xf0, 0x2b, 0x11, 0xf3, 0xc2, 0xdc, 0xe2, 0xe8, 0xd4, 0x35,
+	0xf8, 0x2b, 0x9b, 0x9c, 0xc0, 0x58, 0x47, 0x4a, 0xe4, 0x51, 0x1a, 0x87, 0xfe, 0x18, 0x55, 0xaa,
+	0x1d, 0xf4, 0x4f, 0x07, 0x26, 0x0d, 0x8d, 0xfe, 0x33, 0x75, 0x76, 0xb1, 0xef, 0x3f, 0x94, 0xbd,
+	0xf7, 0x1e, 0xf6, 0x83, 0x6e, 0xf6, 0xc3, 0xfb, 0xd8, 0x8f, 0xb6, 0xd8, 0x93, 0x19, 0xb8, 0x5a,
+	0x66, 0xa8, 0xca, 0x94, 0x99, 0x25, 0xc6, 0xcb, 0xcc, 0x02, 0x06, 0xc4, 0x51, 0x3b, 0x90, 0xed,
+	0x3a, 0xcb, 0xe2, 0xc2, 0x9f, 0xe0, 0x96, 0xb5, 0xe8, 0x57, 0xf0, 0xa8, 0xd5, 0x2e, 0xe4, 0x10,
+	0xbc, 0xc0, 0xe8, 0x69, 0x75, 0x2c, 0x0d, 0x43, 0xa1, 0xee, 0x35, 0xd7, 0x50, 0xb0, 0x26, 0xfd,
+	0xb5, 0x57, 0xb5, 0x72, 0x59, 0xa1, 0xa3, 0x00, 0x81, 0x7e, 0xc4, 0xf3, 0x08, 0x6f, 0x61, 0xca,
+	0x70, 0x6d, 0x7c, 0x99, 0x12, 0xd7, 0xa8, 0xff, 0x94, 0xe1, 0xda, 0xe0, 0x8c, 0x9a, 0x9a, 0x5b,
+	0xcb, 0xc4, 0xde, 0xa4, 0xea, 0x0a, 0x05, 0x9e, 0x32, 0x5c, 0x1b, 0x0d, 0x4c, 0x5f, 0x96, 0x9a,
+	0x9a, 0x25, 0xfd, 0xb9, 0x57, 0xd1, 0xb1, 0xbd, 0xdc, 0x81, 0xe6, 0x04, 0xc6, 0x8b, 0x38, 0x0d,
+	0xae, 0xe6, 0x35, 0xa4, 0xda, 0x81, 0x6d, 0x79, 0x6b, 0x51, 0x39, 0xfa, 0xd6, 0x60, 0x5a, 0x28,
+	0x9e, 0x04, 0x91, 0xdf, 0x47, 0xee, 0xd6, 0x32, 0xb5, 0x65, 0x12, 0x8a, 0x5b, 0x04, 0xe5, 0xb1,
+	0xd2, 0xa0, 0x7f, 0xf4, 0xe0, 0xa0, 0x85, 0x81, 0x89, 0x20, 0x55, 0x61, 0x07, 0x92, 0x23, 0x18,
+	0xe8, 0xdb, 0x06, 0x0c, 0x6b, 0xb5, 0x11, 0xba, 0xdb, 0x08, 0x4f, 0x60, 0xac, 0x44, 0x20, 0x33,
+	0x29, 0x6c, 0x03, 0x8f, 0x59, 0xed, 0x30, 0x35, 0xf9, 0x2a, 0x5d, 0x27, 0x55, 0xd7, 0x5a, 0xab,
+	0xa1, 0xed, 0xa0, 0xa9, 0x2d, 0xfd, 0x1e, 0x1e, 0xb7, 0xa7, 0x59, 0x07, 0x56, 0xa3, 0x4b, 0x6a,
+	0xfb, 0xc8, 0xd1, 0x69, 0xe3, 0x1c, 0xb7, 0x79, 0x0e, 0x5d, 0x00, 0xd4, 0xc3, 0x8e, 0x50, 0x98,
+	0x56, 0x93, 0x91, 0xc7, 0xdf, 0xbc, 0xb1, 0x25, 0x5b, 0x3e, 0xf2, 0x02, 0xc0, 0x0c, 0x44, 0xae,
+	0xd7, 0x4a, 0x94, 0x5f, 0xd8, 0xe4, 0x7c, 0x66, 0xe7, 0xe6, 0xdb, 0x6a, 0x83, 0x35, 0x62, 0xe8,
+	0x5f, 0x3d, 0x98, 0xb5, 0x41, 0xf3, 0xf8, 0x41, 0x47, 0x6d, 0xa8, 0x39, 0x5b, 0x9f, 0xe7, 0xa5,
+	0x4a, 0x57, 0x76, 0x14, 0xe0, 0xda, 0xd2, 0xed, 0xef, 0xa0, 0xfb, 0x20, 0x59, 0xb1, 0xe5, 0x34,
+	0xd7, 0xeb, 0x1c, 0x67, 0x9e, 0xc7, 0xac, 0xb5, 0x45, 0x76, 0xf4, 0x00, 0xb2, 0x37, 0xd5, 0x17,
+	0xf5, 0x6a, 0xad, 0xa3, 0x54, 0xc9, 0x9f, 0x70, 0xb0, 0xfc, 0x0b, 0xba, 0x25, 0x35, 0x77, 0x07,
+	0xb5, 0x7e, 0xeb, 0x26, 0x7f, 0x04, 0xc2, 0x44, 0x20, 0x64, 0xa6, 0x9b, 0x93, 0xf6, 0x43, 0xdb,
+	0xb7, 0xe5, 0x53, 0x4d, 0xee, 0x3e, 0xd5, 0xb6, 0x97, 0x3f, 0x31, 0x73, 0x4f, 0x29, 0xf3, 0x8d,
+	0x3a, 0x9d, 0xa1, 0x55, 0x08, 0xbd, 0x80, 0x83, 0xd6, 0x59, 0x76, 0x9c, 0x3c, 0x37, 0xea, 0x9a,
+	0x95, 0x3d, 0xee, 0x60, 0xc7, 0x23, 0xcf, 0x6c, 0x08, 0xfd, 0x0e, 0x0e, 0x5b, 0x35, 0xaa, 0x29,
+	0xf0, 0x59, 0xfd, 0xf0, 0x97, 0x55, 0x8e, 0x77, 0x3d, 0xfc, 0x65, 0xa3, 0x6e, 0x1e, 0x7f, 0x5a,
+	0xc0, 0x93, 0x56, 0xb5, 0xc6, 0x97, 0xf6, 0xbc, 0x25, 0xc1, 0x93, 0x9d, 0xff, 0x04, 0x3c, 0xb6,
+	0x3a, 0x7c, 0xba, 0xad, 0x43, 0x67, 0xfc, 0x46, 0x8c, 0x2f, 0xe0, 0x7f, 0x4c, 0xbc, 0xfb, 0x67,
+	0x73, 0x95, 0x7e, 0x0d, 0xb3, 0x4d, 0xf2, 0xfd, 0x73, 0xb0, 0x63, 0xfa, 0xd0, 0x2f, 0x1b, 0x15,
+	0x2e, 0x78, 0xcc, 0x93, 0x40, 0x74, 0x9f, 0xcf, 0xc3, 0xb0, 0x7a, 0x5d, 0x71, 0x4d, 0x7f, 0xe9,
+	0x99, 0x6b, 0x78, 0xb7, 0xcd, 0x2e, 0x6f, 0x74, 0x44, 0xaf, 0xd5, 0x11, 0x4f, 0x01, 0x32, 0x25,
+	0x57, 0x5c, 0x15, 0xdf, 0x8a, 0xc2, 0x96, 0x6a, 0x78, 0xf0, 0xe8, 0xcd, 0x9c, 0xf1, 0x58, 0x69,
+	0x98, 0x21, 0x18, 0x4a, 0x25, 0xf0, 0x07, 0x12, 0xbf, 0x5b, 0x8f, 0xd5, 0x0e, 0x9a, 0xc3, 0x11,
+	0x13, 0x59, 0x5c, 0xdc, 0x45, 0xf1, 0x39, 0x4c, 0xea, 0x16, 0x31, 0x50, 0xdc, 0xfb, 0xae, 0xa4,
+	0x19, 0xfb, 0x3e, 0xa0, 0x8b, 0x01, 0xfe, 0xde, 0xbe, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0b,
+	0xd1, 0xdc, 0x4c, 0x08, 0x0b, 0x00, 0x00,
+}
@@ -0,0 +1,210 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+)
+
+const (
+	btcHeaderSize     = 80
+	btcRetargetBlocks = 2016
+	btcMaxMoney       = 21000000 * 100000000
+	opReturn          = 0x6a
+)
+
+func init() {
+	RegisterVerifier("btc", &btcVerifier{powLimit: compactToBig(0x1d00ffff), retarget: true})
+	//regtest的难度不调整，用来测试
+	RegisterVerifier("btcregtest", &btcVerifier{powLimit: compactToBig(0x207fffff)})
+}
+
+//btcVerifier 比特币的区块头验证器，难度调整的区块只检查新的难度在父区块难度的4倍以内
+type btcVerifier struct {
+	powLimit *big.Int
+	retarget bool
+}
+
+// DoubleSha256 比特币的区块和交易hash
+func DoubleSha256(data []byte) []byte {
+	h := sha256.Sum256(data)
+	h = sha256.Sum256(h[:])
+	return h[:]
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
+
+//compactToBig 解析区块头中压缩格式的难度目标，负数返回nil
+func compactToBig(bits uint32) *big.Int {
+	if bits&0x00800000 != 0 {
+		return nil
+	}
+	mantissa := big.NewInt(int64(bits & 0x007fffff))
+	exponent := uint(bits >> 24)
+	if exponent <= 3 {
+		return mantissa.Rsh(mantissa, 8*(3-exponent))
+	}
+	return mantissa.Lsh(mantissa, 8*(exponent-3))
+}
+
+func (v *btcVerifier) ParseHeader(raw []byte) (*HeaderInfo, error) {
+	if len(raw) != btcHeaderSize {
+		return nil, ErrHeader
+	}
+	target := compactToBig(binary.LittleEndian.Uint32(raw[72:76]))
+	if target == nil || target.Sign() <= 0 || target.Cmp(v.powLimit) > 0 {
+		return nil, ErrHeader
+	}
+	hash := DoubleSha256(raw)
+	if new(big.Int).SetBytes(reverse(hash)).Cmp(target) > 0 {
+		return nil, ErrHeader
+	}
+	//work = 2^256 / (target + 1)
+	work := new(big.Int).Lsh(big.NewInt(1), 256)
+	work.Div(work, new(big.Int).Add(target, big.NewInt(1)))
+	return &HeaderInfo{
+		Hash:       hash,
+		Prev:       raw[4:36],
+		MerkleRoot: raw[36:68],
+		Time:       int64(binary.LittleEndian.Uint32(raw[68:72])),
+		Target:     target,
+		Work:       work,
+	}, nil
+}
+
+func (v *btcVerifier) CheckHeader(parent, header *HeaderInfo, height int64) error {
+	if !bytes.Equal(header.Prev, parent.Hash) {
+		return ErrHeader
+	}
+	if !v.retarget || height%btcRetargetBlocks != 0 {
+		if header.Target.Cmp(parent.Target) != 0 {
+			return ErrHeader
+		}
+		return nil
+	}
+	min := new(big.Int).Rsh(parent.Target, 2)
+	max := new(big.Int).Lsh(parent.Target, 2)
+	if header.Target.Cmp(min) < 0 || header.Target.Cmp(max) > 0 {
+		return ErrHeader
+	}
+	return nil
+}
+
+//txReader 按比特币的序列化格式读取交易
+type txReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *txReader) read(n uint64) []byte {
+	if r.err != nil || uint64(len(r.data)-r.pos) < n {
+		r.err = ErrDepositTx
+		return nil
+	}
+	b := r.data[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b
+}
+
+func (r *txReader) varint() uint64 {
+	b := r.read(1)
+	if b == nil {
+		return 0
+	}
+	switch b[0] {
+	case 0xfd:
+		if b = r.read(2); b != nil {
+			return uint64(binary.LittleEndian.Uint16(b))
+		}
+	case 0xfe:
+		if b = r.read(4); b != nil {
+			return uint64(binary.LittleEndian.Uint32(b))
+		}
+	case 0xff:
+		if b = r.read(8); b != nil {
+			return binary.LittleEndian.Uint64(b)
+		}
+	default:
+		return uint64(b[0])
+	}
+	return 0
+}
+
+//ParseDeposit 交易必须是不带见证数据的格式，支付给托管脚本的输出合计为锁定的金额，
+//第一个OP_RETURN输出的数据是chain33上的接收地址
+func (v *btcVerifier) ParseDeposit(tx, custody []byte) (*DepositInfo, error) {
+	//64字节的交易可以伪装成merkle树的中间节点
+	if len(tx) == 64 || len(tx) > MaxTxSize {
+		return nil, ErrDepositTx
+	}
+	r := &txReader{data: tx}
+	r.read(4)
+	inputs := r.varint()
+	if inputs == 0 {
+		return nil, ErrDepositTx
+	}
+	for i := uint64(0); i < inputs && r.err == nil; i++ {
+		r.read(36)
+		r.read(r.varint())
+		r.read(4)
+	}
+	info := &DepositInfo{}
+	outputs := r.varint()
+	for i := uint64(0); i < outputs && r.err == nil; i++ {
+		value := r.read(8)
+		script := r.read(r.varint())
+		if r.err != nil {
+			break
+		}
+		amount := binary.LittleEndian.Uint64(value)
+		if amount > btcMaxMoney {
+			return nil, ErrDepositTx
+		}
+		if bytes.Equal(script, custody) {
+			info.Amount += int64(amount)
+			if info.Amount > btcMaxMoney {
+				return nil, ErrDepositTx
+			}
+		}
+		if info.Recipient == "" && len(script) > 2 && script[0] == opReturn && int(script[1]) == len(script)-2 && script[1] <= 75 {
+			info.Recipient = string(script[2:])
+		}
+	}
+	r.read(4)
+	if r.err != nil || r.pos != len(tx) || info.Amount == 0 || info.Recipient == "" {
+		return nil, ErrDepositTx
+	}
+	info.TxHash = DoubleSha256(tx)
+	return info, nil
+}
+
+func (v *btcVerifier) VerifyMerkle(root, txHash []byte, branch [][]byte, index int32) bool {
+	if index < 0 || len(branch) > 32 {
+		return false
+	}
+	h := txHash
+	for _, sibling := range branch {
+		if len(sibling) != 32 {
+			return false
+		}
+		if index&1 == 1 {
+			h = DoubleSha256(append(append([]byte{}, sibling...), h...))
+		} else {
+			h = DoubleSha256(append(append([]byte{}, h...), sibling...))
+		}
+		index >>= 1
+	}
+	return index == 0 && bytes.Equal(h, root)
+}
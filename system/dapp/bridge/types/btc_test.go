@@ -0,0 +1,44 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//比特币的创世区块
+const btcGenesis = "0100000000000000000000000000000000000000000000000000000000000000000000003ba3edfd7a7b12b27ac72c3e67768f617fc81bc3888a51323a9fb8aa4b1e5e4a29ab5f49ffff001d1dac2b7c"
+
+func TestBtcHeader(t *testing.T) {
+	v, err := GetVerifier("btc")
+	assert.Nil(t, err)
+	raw, _ := hex.DecodeString(btcGenesis)
+	info, err := v.ParseHeader(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", hex.EncodeToString(reverse(info.Hash)))
+	assert.Equal(t, "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b", hex.EncodeToString(reverse(info.MerkleRoot)))
+	assert.Equal(t, "100010001", info.Work.Text(16))
+	//创世区块只有一个交易，交易hash就是merkle根
+	assert.True(t, v.VerifyMerkle(info.MerkleRoot, info.MerkleRoot, nil, 0))
+	assert.False(t, v.VerifyMerkle(info.MerkleRoot, info.MerkleRoot, nil, 1))
+
+	//修改nonce以后工作量证明不正确
+	raw[79]++
+	_, err = v.ParseHeader(raw)
+	assert.Equal(t, ErrHeader, err)
+	_, err = v.ParseHeader(raw[:79])
+	assert.Equal(t, ErrHeader, err)
+	_, err = GetVerifier("eth")
+	assert.Equal(t, ErrVerifierNotExist, err)
+}
+
+func TestCompactToBig(t *testing.T) {
+	assert.Equal(t, "ffff0000000000000000000000000000000000000000000000000000", compactToBig(0x1d00ffff).Text(16))
+	assert.Equal(t, "12", compactToBig(0x01120000).Text(16))
+	assert.Nil(t, compactToBig(0x04923456))
+}
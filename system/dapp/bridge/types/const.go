@@ -0,0 +1,49 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// bridge action ty
+const (
+	BridgeActionConfig = iota + 1
+	BridgeActionHeaders
+	BridgeActionDeposit
+	BridgeActionWithdraw
+	BridgeActionSign
+)
+
+// bridge log ty
+const (
+	TyLogBridgeChain      = 610
+	TyLogBridgeHeader     = 611
+	TyLogBridgeDeposit    = 612
+	TyLogBridgeWithdrawal = 613
+)
+
+// withdrawal status
+const (
+	WithdrawalPending = iota + 1
+	WithdrawalAuthorized
+)
+
+// query func name
+const (
+	FuncNameGetChain        = "GetChain"
+	FuncNameGetHeader       = "GetHeader"
+	FuncNameGetDeposit      = "GetDeposit"
+	FuncNameGetWithdrawal   = "GetWithdrawal"
+	FuncNameListWithdrawals = "ListWithdrawals"
+	FuncNameGetBalance      = "GetBalance"
+	//MaxHeaders 一个交易最多提交的区块头
+	MaxHeaders = 100
+	//MaxConfirmations 锁定交易所在的区块必须在最长链最近的这么多个区块中
+	MaxConfirmations = 1000
+	//MaxRelayers 中继地址的最大数量
+	MaxRelayers      = 32
+	MaxNameLength    = 16
+	MaxTxSize        = 100000
+	MaxAddressLength = 128
+	DefaultListCount = 20
+	MaxListCount     = 100
+)
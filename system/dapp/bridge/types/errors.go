@@ -0,0 +1,42 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrChainConfig 外部链的配置不合法
+	ErrChainConfig = errors.New("ErrChainConfig")
+	// ErrChainNotExist 外部链没有配置
+	ErrChainNotExist = errors.New("ErrChainNotExist")
+	// ErrVerifierNotExist 区块头验证器不存在
+	ErrVerifierNotExist = errors.New("ErrVerifierNotExist")
+	// ErrHeader 区块头格式不合法或者工作量证明不正确
+	ErrHeader = errors.New("ErrHeader")
+	// ErrHeaderExist 区块头都已经提交过
+	ErrHeaderExist = errors.New("ErrHeaderExist")
+	// ErrHeaderNotExist 区块头不存在
+	ErrHeaderNotExist = errors.New("ErrHeaderNotExist")
+	// ErrHeaderCount 区块头的数量不合法
+	ErrHeaderCount = errors.New("ErrHeaderCount")
+	// ErrConfirmations 锁定交易所在的区块不在最长链上或者确认数不够
+	ErrConfirmations = errors.New("ErrConfirmations")
+	// ErrMerkleProof 交易的merkle证明不正确
+	ErrMerkleProof = errors.New("ErrMerkleProof")
+	// ErrDepositTx 锁定交易格式不合法，没有支付给托管脚本或者没有接收地址
+	ErrDepositTx = errors.New("ErrDepositTx")
+	// ErrDepositExist 锁定交易已经铸造过
+	ErrDepositExist = errors.New("ErrDepositExist")
+	// ErrDepositNotExist 锁定交易没有铸造
+	ErrDepositNotExist = errors.New("ErrDepositNotExist")
+	// ErrBridgeAmount 金额不合法
+	ErrBridgeAmount = errors.New("ErrBridgeAmount")
+	// ErrWithdrawalNotExist 提取不存在
+	ErrWithdrawalNotExist = errors.New("ErrWithdrawalNotExist")
+	// ErrWithdrawalAuthorized 提取已经授权
+	ErrWithdrawalAuthorized = errors.New("ErrWithdrawalAuthorized")
+	// ErrRelayerSignature 签名不正确，不是中继的签名或者重复签名
+	ErrRelayerSignature = errors.New("ErrRelayerSignature")
+)
@@ -0,0 +1,113 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types bridge插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// BridgeX 执行器名称
+	BridgeX    = "bridge"
+	actionName = map[string]int32{
+		"Config":   BridgeActionConfig,
+		"Headers":  BridgeActionHeaders,
+		"Deposit":  BridgeActionDeposit,
+		"Withdraw": BridgeActionWithdraw,
+		"Sign":     BridgeActionSign,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogBridgeChain:      {Ty: reflect.TypeOf(ReceiptBridgeChain{}), Name: "LogBridgeChain"},
+		TyLogBridgeHeader:     {Ty: reflect.TypeOf(ReceiptBridgeHeader{}), Name: "LogBridgeHeader"},
+		TyLogBridgeDeposit:    {Ty: reflect.TypeOf(ReceiptBridgeDeposit{}), Name: "LogBridgeDeposit"},
+		TyLogBridgeWithdrawal: {Ty: reflect.TypeOf(ReceiptBridgeWithdrawal{}), Name: "LogBridgeWithdrawal"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(BridgeX))
+	types.RegistorExecutor(BridgeX, NewType())
+	types.RegisterDappFork(BridgeX, "Enable", 0)
+}
+
+// BridgeType bridge执行器类型
+type BridgeType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a bridge type object
+func NewType() *BridgeType {
+	c := &BridgeType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return bridge action
+func (b *BridgeType) GetPayload() types.Message {
+	return &BridgeAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (b *BridgeType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (b *BridgeType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (b *BridgeType) GetName() string {
+	return BridgeX
+}
+
+func checkName(name string) bool {
+	if len(name) == 0 || len(name) > MaxNameLength {
+		return false
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckChainConfig 检查外部链的配置，中继地址不能重复，threshold 不能超过中继的数量
+func CheckChainConfig(config *BridgeChainConfig) error {
+	if !checkName(config.Name) || !checkName(config.Symbol) {
+		return ErrChainConfig
+	}
+	if _, err := GetVerifier(config.Verifier); err != nil {
+		return err
+	}
+	if config.CheckpointHeight < 0 || config.Confirmations <= 0 || config.Confirmations > MaxConfirmations || len(config.Custody) == 0 {
+		return ErrChainConfig
+	}
+	if len(config.Relayers) == 0 || len(config.Relayers) > MaxRelayers {
+		return ErrChainConfig
+	}
+	seen := make(map[string]bool)
+	for _, relayer := range config.Relayers {
+		if seen[relayer] || address.CheckAddress(relayer) != nil {
+			return ErrChainConfig
+		}
+		seen[relayer] = true
+	}
+	if config.Threshold <= 0 || int(config.Threshold) > len(config.Relayers) {
+		return ErrChainConfig
+	}
+	return nil
+}
+
+// AuthorizationData 中继签名的提取授权的内容
+func AuthorizationData(w *BridgeWithdrawal) []byte {
+	return types.Encode(&BridgeAuthorization{WithdrawalID: w.WithdrawalID, Chain: w.Chain, To: w.To, Amount: w.Amount})
+}
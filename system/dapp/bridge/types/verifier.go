@@ -0,0 +1,57 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"math/big"
+)
+
+// HeaderInfo 验证器解析出来的区块头，target 和 work 是工作量证明的难度
+type HeaderInfo struct {
+	Hash       []byte
+	Prev       []byte
+	MerkleRoot []byte
+	Time       int64
+	Target     *big.Int
+	Work       *big.Int
+}
+
+// DepositInfo 验证器解析出来的锁定交易，amount 是支付给托管脚本的金额
+type DepositInfo struct {
+	TxHash    []byte
+	Recipient string
+	Amount    int64
+}
+
+// HeaderVerifier 外部链的区块头验证器，新的外部链实现这个接口并注册
+type HeaderVerifier interface {
+	// ParseHeader 解析区块头并检查区块头自身的工作量证明
+	ParseHeader(raw []byte) (*HeaderInfo, error)
+	// CheckHeader 检查区块头可以接在父区块后面，height 是区块头的高度
+	CheckHeader(parent, header *HeaderInfo, height int64) error
+	// ParseDeposit 解析锁定交易
+	ParseDeposit(tx, custody []byte) (*DepositInfo, error)
+	// VerifyMerkle 检查交易在merkle根下的证明
+	VerifyMerkle(root, txHash []byte, branch [][]byte, index int32) bool
+}
+
+var verifiers = make(map[string]HeaderVerifier)
+
+// RegisterVerifier 注册区块头验证器，名字不能重复
+func RegisterVerifier(name string, v HeaderVerifier) {
+	if _, ok := verifiers[name]; ok {
+		panic("bridge verifier exist " + name)
+	}
+	verifiers[name] = v
+}
+
+// GetVerifier 按名字获取区块头验证器
+func GetVerifier(name string) (HeaderVerifier, error) {
+	v, ok := verifiers[name]
+	if !ok {
+		return nil, ErrVerifierNotExist
+	}
+	return v, nil
+}
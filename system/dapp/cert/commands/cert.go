@@ -0,0 +1,133 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands cert插件命令
+package commands
+
+import (
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	cty "github.com/33cn/chain33/system/dapp/cert/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// CACmd cert command, 命令名为ca，cert是生成tls证书的命令
+func CACmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ca",
+		Short: "Certificate issuance and revocation by configured CAs",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		IssueCmd(),
+		RevokeCmd(),
+		QueryCertCmd(),
+		ListCRLCmd(),
+	)
+
+	return cmd
+}
+
+// IssueCmd issue certificate
+func IssueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Create a transaction to issue a certificate to address",
+		Run:   issue,
+	}
+	cmd.Flags().StringP("addr", "a", "", "certificate holder address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("subject", "s", "", "certificate subject")
+	cmd.Flags().Int64P("expire", "e", 0, "expire height, 0 for never")
+	return cmd
+}
+
+func issue(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	subject, _ := cmd.Flags().GetString("subject")
+	expire, _ := cmd.Flags().GetInt64("expire")
+	commandtypes.CreateActionTx(cmd, cty.CertX, &cty.CertAction{
+		Ty:    cty.CertActionIssue,
+		Value: &cty.CertAction_Issue{Issue: &cty.CertIssue{Addr: addr, Subject: subject, ExpireHeight: expire}},
+	})
+}
+
+// RevokeCmd revoke certificate
+func RevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Create a transaction to revoke the certificate of address",
+		Run:   revoke,
+	}
+	cmd.Flags().StringP("addr", "a", "", "certificate holder address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("reason", "r", "", "revoke reason")
+	return cmd
+}
+
+func revoke(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	reason, _ := cmd.Flags().GetString("reason")
+	commandtypes.CreateActionTx(cmd, cty.CertX, &cty.CertAction{
+		Ty:    cty.CertActionRevoke,
+		Value: &cty.CertAction_Revoke{Revoke: &cty.CertRevoke{Addr: addr, Reason: reason}},
+	})
+}
+
+func queryCert(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, cty.CertX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryCertCmd query certificate
+func QueryCertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cert",
+		Short: "Query certificate of address and whether it is valid",
+		Run:   queryCertStatus,
+	}
+	cmd.Flags().StringP("addr", "a", "", "certificate holder address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func queryCertStatus(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	var res cty.ReplyCertStatus
+	queryCert(cmd, cty.FuncNameGetCert, &types.ReqString{Data: addr}, &res)
+}
+
+// ListCRLCmd list certificate revocation list
+func ListCRLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "crl",
+		Short: "List revoked certificates",
+		Run:   listCRL,
+	}
+	cmd.Flags().StringP("issuer", "i", "", "issuer CA address, empty for all")
+	cmd.Flags().StringP("primary", "p", "", "list after this primary key")
+	cmd.Flags().Int32P("count", "c", cty.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listCRL(cmd *cobra.Command, args []string) {
+	issuer, _ := cmd.Flags().GetString("issuer")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res cty.ReplyCRL
+	queryCert(cmd, cty.FuncNameListCRL, &cty.ReqCRL{Issuer: issuer, PrimaryKey: primary, Count: count, Direction: direction}, &res)
+}
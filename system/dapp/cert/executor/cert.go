@@ -0,0 +1,79 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor cert执行器，CA在链上颁发和吊销证书，开启证书验证以后只有持有有效证书的地址可以发交易
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	cty "github.com/33cn/chain33/system/dapp/cert/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.cert")
+	driverName = cty.CertX
+	conf       = types.ConfSub(driverName)
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Cert{}))
+	drivers.RegisterTxCheck(driverName, checkTxCert)
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newCert, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return cert name
+func GetName() string {
+	return newCert().GetName()
+}
+
+// Cert defines Cert object
+type Cert struct {
+	drivers.DriverBase
+}
+
+func newCert() drivers.Driver {
+	c := &Cert{}
+	c.SetChild(c)
+	c.SetExecutorType(types.LoadExecutorType(driverName))
+	return c
+}
+
+// GetDriverName return a drivername
+func (c *Cert) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (c *Cert) CheckReceiptExecOk() bool {
+	return true
+}
+
+//isCertEnable 开启以后所有交易的签名地址都需要有效的证书
+func isCertEnable() bool {
+	return conf.IsEnable("enable")
+}
+
+//getRootCAs 配置的CA地址，CA颁发和吊销证书，CA自己的交易不需要证书
+func getRootCAs() []string {
+	if _, err := conf.G("rootCAs"); err != nil {
+		return nil
+	}
+	return conf.GStrList("rootCAs")
+}
+
+func isCA(addr string, rootCAs []string) bool {
+	for _, ca := range rootCAs {
+		if addr == ca {
+			return true
+		}
+	}
+	return false
+}
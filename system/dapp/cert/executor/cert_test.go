@@ -0,0 +1,106 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	cty "github.com/33cn/chain33/system/dapp/cert/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendCertTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) int32 {
+	_, detail, err := mock33.SendCallTx(priv, cty.CertX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+//sendCoins 返回mempool检查交易的错误
+func sendCoins(mock33 *testnode.Chain33Mock, priv crypto.PrivKey, to string) error {
+	tx := util.CreateCoinsTx(priv, to, types.Coin)
+	reply, err := mock33.GetAPI().SendTx(tx)
+	if err != nil {
+		return err
+	}
+	_, err = mock33.WaitTx(reply.GetMsg())
+	return err
+}
+
+func getCertStatus(t *testing.T, mock33 *testnode.Chain33Mock, addr string) *cty.ReplyCertStatus {
+	msg, err := mock33.GetAPI().Query(cty.CertX, cty.FuncNameGetCert, &types.ReqString{Data: addr})
+	assert.Nil(t, err)
+	return msg.(*cty.ReplyCertStatus)
+}
+
+func TestCert(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	ca := mock33.GetGenesisKey()
+	caAddr := mock33.GetGenesisAddress()
+	addr, priv := util.Genaddress()
+	other, otherPriv := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(ca, addr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(ca, other, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	types.S("config.exec.sub.cert.rootCAs", []interface{}{caAddr})
+	types.S("config.exec.sub.cert.enable", true)
+	defer types.S("config.exec.sub.cert.enable", false)
+
+	//没有证书的地址不能发交易，CA不需要证书
+	assert.Equal(t, cty.ErrCertNotFound, sendCoins(mock33, priv, other))
+	height := mock33.GetLastBlock().Height
+	ty := sendCertTx(t, mock33, ca, "Issue", &cty.CertIssue{Addr: addr, Subject: "org1.member", ExpireHeight: height})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendCertTx(t, mock33, ca, "Issue", &cty.CertIssue{Addr: addr, Subject: "org1.member"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendCertTx(t, mock33, ca, "Issue", &cty.CertIssue{Addr: addr, Subject: "org1.member"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	status := getCertStatus(t, mock33, addr)
+	assert.True(t, status.Valid)
+	assert.Equal(t, caAddr, status.Cert.Issuer)
+	assert.Nil(t, sendCoins(mock33, priv, other))
+
+	//持有证书的普通地址不能颁发证书
+	assert.Equal(t, cty.ErrCertNotFound, sendCoins(mock33, otherPriv, addr))
+	_, detail, err := mock33.SendCallTx(priv, cty.CertX, "Issue", &cty.CertIssue{Addr: other})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+
+	//吊销以后不能发交易，进入证书吊销列表
+	ty = sendCertTx(t, mock33, ca, "Revoke", &cty.CertRevoke{Addr: addr, Reason: "key leaked"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendCertTx(t, mock33, ca, "Revoke", &cty.CertRevoke{Addr: addr})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.Equal(t, cty.ErrCertRevoked, sendCoins(mock33, priv, other))
+	status = getCertStatus(t, mock33, addr)
+	assert.False(t, status.Valid)
+	assert.Equal(t, cty.ErrCertRevoked.Error(), status.Reason)
+	for _, issuer := range []string{"", caAddr} {
+		msg, err := mock33.GetAPI().Query(cty.CertX, cty.FuncNameListCRL, &cty.ReqCRL{Issuer: issuer})
+		assert.Nil(t, err)
+		crl := msg.(*cty.ReplyCRL)
+		assert.Equal(t, 1, len(crl.Entries))
+		assert.Equal(t, addr, crl.Entries[0].Addr)
+		assert.Equal(t, "key leaked", crl.Entries[0].Reason)
+	}
+
+	//重新颁发带过期高度的证书，过期以后不能发交易
+	height = mock33.GetLastBlock().Height
+	ty = sendCertTx(t, mock33, ca, "Issue", &cty.CertIssue{Addr: addr, ExpireHeight: height + 3})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Nil(t, sendCoins(mock33, priv, other))
+	mock33.SendTx(util.CreateCoinsTx(ca, caAddr, types.Coin))
+	assert.Nil(t, mock33.Wait())
+	assert.Equal(t, cty.ErrCertExpired, sendCoins(mock33, priv, other))
+}
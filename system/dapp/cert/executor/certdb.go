@@ -0,0 +1,193 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	cty "github.com/33cn/chain33/system/dapp/cert/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	certKeyPrefix   = "mavl-" + cty.CertX + "-addr-"
+	crlAllPrefix    = "LODB-" + cty.CertX + "-crl-all-"
+	crlIssuerPrefix = "LODB-" + cty.CertX + "-crl-issuer-"
+)
+
+func calcCertKey(addr string) []byte {
+	return []byte(certKeyPrefix + addr)
+}
+
+func calcCRLPrimaryKey(height int64, index int64) string {
+	return fmt.Sprintf("%018d", height*types.MaxTxsPerBlock+index)
+}
+
+func calcCRLAllKey(primary string) []byte {
+	return []byte(crlAllPrefix + primary)
+}
+
+func calcCRLIssuerKey(issuer, primary string) []byte {
+	return []byte(crlIssuerPrefix + issuer + "-" + primary)
+}
+
+// Action cert交易的执行环境
+type Action struct {
+	db       dbm.KV
+	txhash   []byte
+	fromaddr string
+	height   int64
+	index    int
+}
+
+// NewAction new a action object
+func NewAction(c *Cert, tx *types.Transaction, index int) *Action {
+	return &Action{
+		db:       c.GetStateDB(),
+		txhash:   tx.Hash(),
+		fromaddr: tx.From(),
+		height:   c.GetHeight(),
+		index:    index,
+	}
+}
+
+func getCert(db dbm.KV, addr string) (*cty.Certificate, error) {
+	value, err := db.Get(calcCertKey(addr))
+	if err != nil || value == nil {
+		return nil, cty.ErrCertNotFound
+	}
+	var cert cty.Certificate
+	if err := types.Decode(value, &cert); err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+func (a *Action) saveCert(cert *cty.Certificate) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcCertKey(cert.Addr), Value: types.Encode(cert)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func certReceipt(ty int32, prev, current *cty.Certificate) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&cty.ReceiptCert{Prev: prev, Current: current})}
+}
+
+//checkTxCert 开启证书验证以后，交易的签名地址和代付手续费的地址都需要有效的证书
+func checkTxCert(db dbm.KV, tx *types.Transaction, height int64) error {
+	if !isCertEnable() {
+		return nil
+	}
+	rootCAs := getRootCAs()
+	addrs := []string{tx.From()}
+	if tx.FeePayer != nil {
+		addrs = append(addrs, tx.FeeAddr())
+	}
+	for _, addr := range addrs {
+		if isCA(addr, rootCAs) {
+			continue
+		}
+		cert, err := getCert(db, addr)
+		if err != nil {
+			return err
+		}
+		if err := cty.CheckCertificate(cert, rootCAs, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Action) issue(payload *cty.CertIssue) (*types.Receipt, error) {
+	rootCAs := getRootCAs()
+	if !isCA(a.fromaddr, rootCAs) {
+		return nil, cty.ErrNotCA
+	}
+	if err := address.CheckAddress(payload.Addr); err != nil {
+		return nil, cty.ErrCertAddr
+	}
+	if len(payload.Subject) > cty.MaxSubjectLength {
+		return nil, cty.ErrCertSubject
+	}
+	if payload.ExpireHeight != 0 && payload.ExpireHeight <= a.height {
+		return nil, cty.ErrCertExpireHeight
+	}
+	//吊销或者过期以后可以重新颁发
+	prev, err := getCert(a.db, payload.Addr)
+	if err == nil && cty.CheckCertificate(prev, rootCAs, a.height) == nil {
+		return nil, cty.ErrCertExist
+	}
+	cert := &cty.Certificate{
+		Serial:       common.ToHex(a.txhash),
+		Addr:         payload.Addr,
+		Subject:      payload.Subject,
+		Issuer:       a.fromaddr,
+		IssueHeight:  a.height,
+		ExpireHeight: payload.ExpireHeight,
+		Status:       cty.CertStatusIssued,
+	}
+	kv := a.saveCert(cert)
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{certReceipt(cty.TyLogCertIssue, prev, cert)}}, nil
+}
+
+func (a *Action) revoke(payload *cty.CertRevoke) (*types.Receipt, error) {
+	if !isCA(a.fromaddr, getRootCAs()) {
+		return nil, cty.ErrNotCA
+	}
+	if len(payload.Reason) > cty.MaxReasonLength {
+		return nil, cty.ErrCertReason
+	}
+	prev, err := getCert(a.db, payload.Addr)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Issuer != a.fromaddr {
+		return nil, cty.ErrCertIssuer
+	}
+	if prev.Status == cty.CertStatusRevoked {
+		return nil, cty.ErrCertRevoked
+	}
+	cert := *prev
+	cert.Status = cty.CertStatusRevoked
+	cert.RevokeHeight = a.height
+	cert.Reason = payload.Reason
+	kv := a.saveCert(&cert)
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{certReceipt(cty.TyLogCertRevoke, prev, &cert)}}, nil
+}
+
+func listCRL(localdb dbm.KVDB, req *cty.ReqCRL) (*cty.ReplyCRL, error) {
+	count := req.Count
+	if count <= 0 {
+		count = cty.DefaultListCount
+	}
+	if count > cty.MaxListCount {
+		count = cty.MaxListCount
+	}
+	prefix := crlAllPrefix
+	if req.Issuer != "" {
+		prefix = crlIssuerPrefix + req.Issuer + "-"
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = []byte(prefix + req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(prefix), key, count, req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &cty.ReplyCRL{}
+	for _, value := range values {
+		var entry cty.CRLEntry
+		if err := types.Decode(value, &entry); err != nil {
+			return nil, err
+		}
+		reply.Entries = append(reply.Entries, &entry)
+		reply.PrimaryKey = calcCRLPrimaryKey(entry.RevokeHeight, entry.Index)
+	}
+	return reply, nil
+}
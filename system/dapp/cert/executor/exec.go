@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	cty "github.com/33cn/chain33/system/dapp/cert/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Issue CA给地址颁发证书
+func (c *Cert) Exec_Issue(payload *cty.CertIssue, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(c, tx, index)
+	return action.issue(payload)
+}
+
+// Exec_Revoke CA吊销自己颁发的证书
+func (c *Cert) Exec_Revoke(payload *cty.CertRevoke, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(c, tx, index)
+	return action.revoke(payload)
+}
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	cty "github.com/33cn/chain33/system/dapp/cert/types"
+	"github.com/33cn/chain33/types"
+)
+
+// ExecLocal_Revoke 吊销的证书加入证书吊销列表
+func (c *Cert) ExecLocal_Revoke(payload *cty.CertRevoke, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != cty.TyLogCertRevoke {
+			continue
+		}
+		var log cty.ReceiptCert
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		entry := &cty.CRLEntry{
+			Serial:       log.Current.Serial,
+			Addr:         log.Current.Addr,
+			Issuer:       log.Current.Issuer,
+			RevokeHeight: log.Current.RevokeHeight,
+			Reason:       log.Current.Reason,
+			Index:        int64(index),
+		}
+		primary := calcCRLPrimaryKey(entry.RevokeHeight, entry.Index)
+		value := types.Encode(entry)
+		kvs = append(kvs, &types.KeyValue{Key: calcCRLAllKey(primary), Value: value})
+		kvs = append(kvs, &types.KeyValue{Key: calcCRLIssuerKey(entry.Issuer, primary), Value: value})
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
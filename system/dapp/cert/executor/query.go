@@ -0,0 +1,29 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	cty "github.com/33cn/chain33/system/dapp/cert/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetCert 获取地址的证书和当前高度证书是否有效
+func (c *Cert) Query_GetCert(in *types.ReqString) (types.Message, error) {
+	cert, err := getCert(c.GetStateDB(), in.Data)
+	if err != nil {
+		return nil, err
+	}
+	reply := &cty.ReplyCertStatus{Cert: cert, Valid: true}
+	if err := cty.CheckCertificate(cert, getRootCAs(), c.GetHeight()); err != nil {
+		reply.Valid = false
+		reply.Reason = err.Error()
+	}
+	return reply, nil
+}
+
+// Query_ListCRL 列出证书吊销列表
+func (c *Cert) Query_ListCRL(in *cty.ReqCRL) (types.Message, error) {
+	return listCRL(c.GetLocalDB(), in)
+}
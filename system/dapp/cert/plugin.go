@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cert 证书准入执行器插件
+// 1. 配置的CA在链上给地址颁发证书，证书可以设置过期高度，CA可以吊销自己颁发的证书
+// 2. 开启证书验证以后，执行交易之前检查签名地址的证书，没有有效证书的交易不能进入mempool也不会被打包
+// 3. 本地数据库保存证书吊销列表，可以按CA查询
+package cert
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/cert/commands"
+	"github.com/33cn/chain33/system/dapp/cert/executor"
+	"github.com/33cn/chain33/system/dapp/cert/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.CertX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.CACmd,
+		RPC:      nil,
+	})
+}
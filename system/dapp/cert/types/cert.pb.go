@@ -0,0 +1,727 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cert.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type CertAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*CertAction_Issue
+	//	*CertAction_Revoke
+	Value                isCertAction_Value `protobuf_oneof:"value"`
+	Ty                   int32              `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CertAction) Reset()         { *m = CertAction{} }
+func (m *CertAction) String() string { return proto.CompactTextString(m) }
+func (*CertAction) ProtoMessage()    {}
+func (*CertAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{0}
+}
+
+func (m *CertAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertAction.Unmarshal(m, b)
+}
+func (m *CertAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertAction.Marshal(b, m, deterministic)
+}
+func (m *CertAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertAction.Merge(m, src)
+}
+func (m *CertAction) XXX_Size() int {
+	return xxx_messageInfo_CertAction.Size(m)
+}
+func (m *CertAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertAction proto.InternalMessageInfo
+
+type isCertAction_Value interface {
+	isCertAction_Value()
+}
+
+type CertAction_Issue struct {
+	Issue *CertIssue `protobuf:"bytes,1,opt,name=issue,proto3,oneof"`
+}
+
+type CertAction_Revoke struct {
+	Revoke *CertRevoke `protobuf:"bytes,2,opt,name=revoke,proto3,oneof"`
+}
+
+func (*CertAction_Issue) isCertAction_Value() {}
+
+func (*CertAction_Revoke) isCertAction_Value() {}
+
+func (m *CertAction) GetValue() isCertAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *CertAction) GetIssue() *CertIssue {
+	if x, ok := m.GetValue().(*CertAction_Issue); ok {
+		return x.Issue
+	}
+	return nil
+}
+
+func (m *CertAction) GetRevoke() *CertRevoke {
+	if x, ok := m.GetValue().(*CertAction_Revoke); ok {
+		return x.Revoke
+	}
+	return nil
+}
+
+func (m *CertAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CertAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CertAction_OneofMarshaler, _CertAction_OneofUnmarshaler, _CertAction_OneofSizer, []interface{}{
+		(*CertAction_Issue)(nil),
+		(*CertAction_Revoke)(nil),
+	}
+}
+
+func _CertAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CertAction)
+	// value
+	switch x := m.Value.(type) {
+	case *CertAction_Issue:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Issue); err != nil {
+			return err
+		}
+	case *CertAction_Revoke:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Revoke); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CertAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _CertAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CertAction)
+	switch tag {
+	case 1: // value.issue
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CertIssue)
+		err := b.DecodeMessage(msg)
+		m.Value = &CertAction_Issue{msg}
+		return true, err
+	case 2: // value.revoke
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CertRevoke)
+		err := b.DecodeMessage(msg)
+		m.Value = &CertAction_Revoke{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _CertAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CertAction)
+	// value
+	switch x := m.Value.(type) {
+	case *CertAction_Issue:
+		s := proto.Size(x.Issue)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CertAction_Revoke:
+		s := proto.Size(x.Revoke)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//CA给地址颁发证书，expireHeight为0的时候证书不过期
+type CertIssue struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,3,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertIssue) Reset()         { *m = CertIssue{} }
+func (m *CertIssue) String() string { return proto.CompactTextString(m) }
+func (*CertIssue) ProtoMessage()    {}
+func (*CertIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{1}
+}
+
+func (m *CertIssue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertIssue.Unmarshal(m, b)
+}
+func (m *CertIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertIssue.Marshal(b, m, deterministic)
+}
+func (m *CertIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertIssue.Merge(m, src)
+}
+func (m *CertIssue) XXX_Size() int {
+	return xxx_messageInfo_CertIssue.Size(m)
+}
+func (m *CertIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertIssue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertIssue proto.InternalMessageInfo
+
+func (m *CertIssue) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *CertIssue) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *CertIssue) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+//颁发证书的CA吊销证书，吊销的证书进入证书吊销列表
+type CertRevoke struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertRevoke) Reset()         { *m = CertRevoke{} }
+func (m *CertRevoke) String() string { return proto.CompactTextString(m) }
+func (*CertRevoke) ProtoMessage()    {}
+func (*CertRevoke) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{2}
+}
+
+func (m *CertRevoke) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertRevoke.Unmarshal(m, b)
+}
+func (m *CertRevoke) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertRevoke.Marshal(b, m, deterministic)
+}
+func (m *CertRevoke) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertRevoke.Merge(m, src)
+}
+func (m *CertRevoke) XXX_Size() int {
+	return xxx_messageInfo_CertRevoke.Size(m)
+}
+func (m *CertRevoke) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertRevoke.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertRevoke proto.InternalMessageInfo
+
+func (m *CertRevoke) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *CertRevoke) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+//serial 是颁发证书的交易哈希
+type Certificate struct {
+	Serial               string   `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Subject              string   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer               string   `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	IssueHeight          int64    `protobuf:"varint,5,opt,name=issueHeight,proto3" json:"issueHeight,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,6,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	Status               int32    `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	RevokeHeight         int64    `protobuf:"varint,8,opt,name=revokeHeight,proto3" json:"revokeHeight,omitempty"`
+	Reason               string   `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Certificate) Reset()         { *m = Certificate{} }
+func (m *Certificate) String() string { return proto.CompactTextString(m) }
+func (*Certificate) ProtoMessage()    {}
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{3}
+}
+
+func (m *Certificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Certificate.Unmarshal(m, b)
+}
+func (m *Certificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Certificate.Marshal(b, m, deterministic)
+}
+func (m *Certificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Certificate.Merge(m, src)
+}
+func (m *Certificate) XXX_Size() int {
+	return xxx_messageInfo_Certificate.Size(m)
+}
+func (m *Certificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Certificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Certificate proto.InternalMessageInfo
+
+func (m *Certificate) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+func (m *Certificate) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *Certificate) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Certificate) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Certificate) GetIssueHeight() int64 {
+	if m != nil {
+		return m.IssueHeight
+	}
+	return 0
+}
+
+func (m *Certificate) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *Certificate) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Certificate) GetRevokeHeight() int64 {
+	if m != nil {
+		return m.RevokeHeight
+	}
+	return 0
+}
+
+func (m *Certificate) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ReceiptCert struct {
+	Prev                 *Certificate `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *Certificate `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptCert) Reset()         { *m = ReceiptCert{} }
+func (m *ReceiptCert) String() string { return proto.CompactTextString(m) }
+func (*ReceiptCert) ProtoMessage()    {}
+func (*ReceiptCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{4}
+}
+
+func (m *ReceiptCert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptCert.Unmarshal(m, b)
+}
+func (m *ReceiptCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptCert.Marshal(b, m, deterministic)
+}
+func (m *ReceiptCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptCert.Merge(m, src)
+}
+func (m *ReceiptCert) XXX_Size() int {
+	return xxx_messageInfo_ReceiptCert.Size(m)
+}
+func (m *ReceiptCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptCert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptCert proto.InternalMessageInfo
+
+func (m *ReceiptCert) GetPrev() *Certificate {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptCert) GetCurrent() *Certificate {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+//证书吊销列表中的一项
+type CRLEntry struct {
+	Serial               string   `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Issuer               string   `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	RevokeHeight         int64    `protobuf:"varint,4,opt,name=revokeHeight,proto3" json:"revokeHeight,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Index                int64    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CRLEntry) Reset()         { *m = CRLEntry{} }
+func (m *CRLEntry) String() string { return proto.CompactTextString(m) }
+func (*CRLEntry) ProtoMessage()    {}
+func (*CRLEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{5}
+}
+
+func (m *CRLEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CRLEntry.Unmarshal(m, b)
+}
+func (m *CRLEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CRLEntry.Marshal(b, m, deterministic)
+}
+func (m *CRLEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CRLEntry.Merge(m, src)
+}
+func (m *CRLEntry) XXX_Size() int {
+	return xxx_messageInfo_CRLEntry.Size(m)
+}
+func (m *CRLEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CRLEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CRLEntry proto.InternalMessageInfo
+
+func (m *CRLEntry) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+func (m *CRLEntry) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *CRLEntry) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *CRLEntry) GetRevokeHeight() int64 {
+	if m != nil {
+		return m.RevokeHeight
+	}
+	return 0
+}
+
+func (m *CRLEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CRLEntry) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ReplyCertStatus struct {
+	Cert                 *Certificate `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"`
+	Valid                bool         `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Reason               string       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReplyCertStatus) Reset()         { *m = ReplyCertStatus{} }
+func (m *ReplyCertStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplyCertStatus) ProtoMessage()    {}
+func (*ReplyCertStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{6}
+}
+
+func (m *ReplyCertStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyCertStatus.Unmarshal(m, b)
+}
+func (m *ReplyCertStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyCertStatus.Marshal(b, m, deterministic)
+}
+func (m *ReplyCertStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyCertStatus.Merge(m, src)
+}
+func (m *ReplyCertStatus) XXX_Size() int {
+	return xxx_messageInfo_ReplyCertStatus.Size(m)
+}
+func (m *ReplyCertStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyCertStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyCertStatus proto.InternalMessageInfo
+
+func (m *ReplyCertStatus) GetCert() *Certificate {
+	if m != nil {
+		return m.Cert
+	}
+	return nil
+}
+
+func (m *ReplyCertStatus) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ReplyCertStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+//issuer 为空的时候列出所有CA吊销的证书
+type ReqCRL struct {
+	Issuer               string   `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCRL) Reset()         { *m = ReqCRL{} }
+func (m *ReqCRL) String() string { return proto.CompactTextString(m) }
+func (*ReqCRL) ProtoMessage()    {}
+func (*ReqCRL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{7}
+}
+
+func (m *ReqCRL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCRL.Unmarshal(m, b)
+}
+func (m *ReqCRL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCRL.Marshal(b, m, deterministic)
+}
+func (m *ReqCRL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCRL.Merge(m, src)
+}
+func (m *ReqCRL) XXX_Size() int {
+	return xxx_messageInfo_ReqCRL.Size(m)
+}
+func (m *ReqCRL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCRL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCRL proto.InternalMessageInfo
+
+func (m *ReqCRL) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *ReqCRL) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqCRL) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqCRL) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyCRL struct {
+	Entries              []*CRLEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	PrimaryKey           string      `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReplyCRL) Reset()         { *m = ReplyCRL{} }
+func (m *ReplyCRL) String() string { return proto.CompactTextString(m) }
+func (*ReplyCRL) ProtoMessage()    {}
+func (*ReplyCRL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{8}
+}
+
+func (m *ReplyCRL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyCRL.Unmarshal(m, b)
+}
+func (m *ReplyCRL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyCRL.Marshal(b, m, deterministic)
+}
+func (m *ReplyCRL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyCRL.Merge(m, src)
+}
+func (m *ReplyCRL) XXX_Size() int {
+	return xxx_messageInfo_ReplyCRL.Size(m)
+}
+func (m *ReplyCRL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyCRL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyCRL proto.InternalMessageInfo
+
+func (m *ReplyCRL) GetEntries() []*CRLEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ReplyCRL) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CertAction)(nil), "types.CertAction")
+	proto.RegisterType((*CertIssue)(nil), "types.CertIssue")
+	proto.RegisterType((*CertRevoke)(nil), "types.CertRevoke")
+	proto.RegisterType((*Certificate)(nil), "types.Certificate")
+	proto.RegisterType((*ReceiptCert)(nil), "types.ReceiptCert")
+	proto.RegisterType((*CRLEntry)(nil), "types.CRLEntry")
+	proto.RegisterType((*ReplyCertStatus)(nil), "types.ReplyCertStatus")
+	proto.RegisterType((*ReqCRL)(nil), "types.ReqCRL")
+	proto.RegisterType((*ReplyCRL)(nil), "types.ReplyCRL")
+}
+
+func init() { proto.RegisterFile("cert.proto", fileDescriptor_a142e29cbef9b1cf) }
+
+var fileDescriptor_a142e29cbef9b1cf = []byte{
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xad, 0xbf, 0xe3, 0x31, 0xa2, 0xb0, 0x42, 0x95, 0x0f, 0x08, 0x45, 0x3e, 0x54, 0x41, 0xa0,
+	0x1c, 0xca, 0x85, 0x2b, 0x44, 0x48, 0x41, 0xe4, 0xb4, 0x88, 0x23, 0x07, 0xd7, 0x1e, 0xca, 0x42,
+	0xb0, 0xcd, 0x7a, 0x1c, 0xd5, 0x12, 0x57, 0xfe, 0x07, 0x3f, 0x15, 0xed, 0x47, 0xda, 0x35, 0x8d,
+	0x82, 0x7a, 0xf3, 0x9b, 0x79, 0xf3, 0xf1, 0xde, 0xee, 0x1a, 0xa0, 0x42, 0x49, 0xcb, 0x4e, 0xb6,
+	0xd4, 0xb2, 0x88, 0xc6, 0x0e, 0xfb, 0xe2, 0x17, 0xc0, 0x0a, 0x25, 0xbd, 0xa9, 0x48, 0xb4, 0x0d,
+	0x5b, 0x40, 0x24, 0xfa, 0x7e, 0xc0, 0xdc, 0x9b, 0x7b, 0x8b, 0xec, 0xe2, 0xd1, 0x52, 0x93, 0x96,
+	0x8a, 0xf1, 0x5e, 0xc5, 0xd7, 0x27, 0xdc, 0x10, 0xd8, 0x0b, 0x88, 0x25, 0xee, 0xda, 0xef, 0x98,
+	0xfb, 0x9a, 0xfa, 0xd8, 0xa1, 0x72, 0x9d, 0x58, 0x9f, 0x70, 0x4b, 0x61, 0x0f, 0xc1, 0xa7, 0x31,
+	0x0f, 0xe6, 0xde, 0x22, 0xe2, 0x3e, 0x8d, 0x6f, 0x13, 0x88, 0x76, 0xe5, 0x76, 0xc0, 0xe2, 0x33,
+	0xa4, 0x37, 0xbd, 0x19, 0x83, 0xb0, 0xac, 0x6b, 0xa9, 0x67, 0xa7, 0x5c, 0x7f, 0xb3, 0x1c, 0x92,
+	0x7e, 0xb8, 0xfc, 0x86, 0x15, 0xe9, 0x39, 0x29, 0xdf, 0x43, 0x56, 0xc0, 0x03, 0xbc, 0xee, 0x84,
+	0xc4, 0x35, 0x8a, 0xab, 0xaf, 0xa4, 0xbb, 0x07, 0x7c, 0x12, 0x2b, 0x5e, 0x1b, 0x71, 0x66, 0x9f,
+	0x83, 0xfd, 0xcf, 0x94, 0x8c, 0xb2, 0x6f, 0x1b, 0xdb, 0xde, 0xa2, 0xe2, 0xb7, 0x0f, 0x99, 0x2a,
+	0x15, 0x5f, 0x44, 0x55, 0x12, 0x2a, 0x5e, 0x8f, 0x52, 0x94, 0x5b, 0x5b, 0x6d, 0xd1, 0x4d, 0x4f,
+	0xff, 0xf0, 0xce, 0xc1, 0x74, 0xe7, 0x33, 0x88, 0xb5, 0x7b, 0x32, 0x0f, 0x4d, 0x17, 0x83, 0xd8,
+	0x1c, 0x32, 0xfd, 0x65, 0xa5, 0x44, 0x5a, 0x8a, 0x1b, 0xba, 0xa3, 0x36, 0xbe, 0xab, 0x56, 0xef,
+	0x48, 0x25, 0x0d, 0x7d, 0x9e, 0x68, 0xa7, 0x2d, 0x52, 0xb5, 0xe6, 0x1c, 0x6c, 0xed, 0xcc, 0xd4,
+	0xba, 0x31, 0xc7, 0x87, 0x74, 0xe2, 0x43, 0x05, 0x19, 0xc7, 0x0a, 0x45, 0x47, 0xca, 0x0d, 0x76,
+	0x0e, 0x61, 0x27, 0x71, 0x67, 0xaf, 0x07, 0x73, 0xce, 0xdc, 0x1a, 0xc5, 0x75, 0x9e, 0xbd, 0x84,
+	0xa4, 0x1a, 0xa4, 0xc4, 0x86, 0xec, 0xf5, 0x38, 0x44, 0xdd, 0x53, 0x8a, 0x3f, 0x1e, 0xcc, 0x56,
+	0x7c, 0xf3, 0xae, 0x21, 0x39, 0xde, 0xcb, 0xe9, 0x5b, 0x3f, 0x83, 0x89, 0x9f, 0xff, 0x2a, 0x0e,
+	0x8f, 0x2a, 0x8e, 0x5c, 0xc5, 0xec, 0x09, 0x44, 0xa2, 0xa9, 0xf1, 0xda, 0x5a, 0x6c, 0x40, 0x71,
+	0x05, 0xa7, 0x1c, 0xbb, 0xed, 0xa8, 0xf6, 0xff, 0x68, 0x6c, 0x3d, 0x87, 0x50, 0x3d, 0xa7, 0x63,
+	0x5e, 0xa8, 0xbc, 0x6a, 0xb8, 0x2b, 0xb7, 0xa2, 0xd6, 0x9b, 0xcf, 0xb8, 0x01, 0xce, 0xf8, 0x60,
+	0x62, 0x38, 0x41, 0xcc, 0xf1, 0xe7, 0x8a, 0x6f, 0x1c, 0x71, 0xde, 0x44, 0xdc, 0x33, 0x80, 0x4e,
+	0x8a, 0x1f, 0xa5, 0x1c, 0x3f, 0xe0, 0x68, 0xed, 0x70, 0x22, 0x6a, 0x5e, 0xd5, 0x0e, 0x0d, 0xd9,
+	0xf7, 0x66, 0x00, 0x7b, 0x0a, 0x69, 0x2d, 0x24, 0xea, 0x67, 0xae, 0xfd, 0x88, 0xf8, 0x6d, 0xa0,
+	0xf8, 0x04, 0x33, 0x23, 0x8f, 0x6f, 0xd8, 0x73, 0x48, 0xb0, 0x21, 0x29, 0xb0, 0xcf, 0xbd, 0x79,
+	0xb0, 0xc8, 0x2e, 0x4e, 0xf7, 0xd2, 0xec, 0x11, 0xf1, 0x7d, 0xfe, 0x7f, 0xab, 0x5c, 0xc6, 0xfa,
+	0x57, 0xf3, 0xea, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0xf0, 0xd2, 0xfe, 0x78, 0x04, 0x00,
+	0x00,
+}
@@ -0,0 +1,33 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// cert action ty
+const (
+	CertActionIssue = iota + 1
+	CertActionRevoke
+)
+
+// cert log ty
+const (
+	TyLogCertIssue  = 550
+	TyLogCertRevoke = 551
+)
+
+// 证书状态
+const (
+	CertStatusIssued  = 1
+	CertStatusRevoked = 2
+)
+
+// query func name
+const (
+	FuncNameGetCert  = "GetCert"
+	FuncNameListCRL  = "ListCRL"
+	MaxSubjectLength = 256
+	MaxReasonLength  = 256
+	DefaultListCount = 20
+	MaxListCount     = 100
+)
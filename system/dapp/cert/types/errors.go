@@ -0,0 +1,30 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrNotCA 不是配置的CA
+	ErrNotCA = errors.New("ErrNotCA")
+	// ErrCertAddr 证书的地址不合法
+	ErrCertAddr = errors.New("ErrCertAddr")
+	// ErrCertSubject 证书的主题太长
+	ErrCertSubject = errors.New("ErrCertSubject")
+	// ErrCertReason 吊销原因太长
+	ErrCertReason = errors.New("ErrCertReason")
+	// ErrCertExpireHeight 证书的过期高度不合法
+	ErrCertExpireHeight = errors.New("ErrCertExpireHeight")
+	// ErrCertExist 地址已经有有效的证书
+	ErrCertExist = errors.New("ErrCertExist")
+	// ErrCertNotFound 地址没有证书
+	ErrCertNotFound = errors.New("ErrCertNotFound")
+	// ErrCertRevoked 证书已经被吊销
+	ErrCertRevoked = errors.New("ErrCertRevoked")
+	// ErrCertExpired 证书已经过期
+	ErrCertExpired = errors.New("ErrCertExpired")
+	// ErrCertIssuer 证书的颁发者不是配置的CA，或者不是颁发证书的CA吊销证书
+	ErrCertIssuer = errors.New("ErrCertIssuer")
+)
@@ -0,0 +1,79 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types cert插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// CertX 执行器名称
+	CertX      = "cert"
+	actionName = map[string]int32{
+		"Issue":  CertActionIssue,
+		"Revoke": CertActionRevoke,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogCertIssue:  {Ty: reflect.TypeOf(ReceiptCert{}), Name: "LogCertIssue"},
+		TyLogCertRevoke: {Ty: reflect.TypeOf(ReceiptCert{}), Name: "LogCertRevoke"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(CertX))
+	types.RegistorExecutor(CertX, NewType())
+	types.RegisterDappFork(CertX, "Enable", 0)
+}
+
+// CertType cert执行器类型
+type CertType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a cert type object
+func NewType() *CertType {
+	c := &CertType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return cert action
+func (c *CertType) GetPayload() types.Message {
+	return &CertAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (c *CertType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (c *CertType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (c *CertType) GetName() string {
+	return CertX
+}
+
+// CheckCertificate 检查证书在height高度是否有效，颁发证书的CA必须还在配置的CA列表中
+func CheckCertificate(cert *Certificate, rootCAs []string, height int64) error {
+	if cert.Status == CertStatusRevoked {
+		return ErrCertRevoked
+	}
+	if cert.ExpireHeight > 0 && height >= cert.ExpireHeight {
+		return ErrCertExpired
+	}
+	for _, ca := range rootCAs {
+		if ca == cert.Issuer {
+			return nil
+		}
+	}
+	return ErrCertIssuer
+}
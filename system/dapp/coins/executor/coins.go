@@ -17,7 +17,12 @@ EventTransfer -> 转移资产
 // nofee transaction will not pack into block
 
 import (
+	"math"
+
+	"github.com/33cn/chain33/common/address"
 	drivers "github.com/33cn/chain33/system/dapp"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -71,6 +76,37 @@ func (c *Coins) CheckTx(tx *types.Transaction, index int) error {
 	if amount < 0 {
 		return types.ErrAmount
 	}
+	name, v, err := ety.DecodePayloadValue(tx)
+	if err != nil {
+		return err
+	}
+	//manage合约冻结的地址不能转出资产，withdraw 转回自己的账户不受限制
+	if name == "Transfer" || name == "TransferToExec" || name == "TransferBatch" {
+		if mty.IsAddrFrozen(c.GetStateDB(), tx.From()) {
+			return mty.ErrAddrFrozen
+		}
+	}
+	if batch, ok := v.Interface().(*cty.CoinsTransferBatch); ok {
+		return checkTransferBatch(batch)
+	}
+	return nil
+}
+
+//checkTransferBatch 转账数不能超过配置，每一笔转账的金额必须大于0，总额不能溢出
+func checkTransferBatch(batch *cty.CoinsTransferBatch) error {
+	if len(batch.Transfers) == 0 || len(batch.Transfers) > cty.MaxTransferBatch() {
+		return cty.ErrTransferBatchSize
+	}
+	var total int64
+	for _, transfer := range batch.Transfers {
+		if transfer.Amount <= 0 || transfer.Amount > math.MaxInt64-total {
+			return types.ErrAmount
+		}
+		total += transfer.Amount
+		if err := address.CheckAddress(transfer.To); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
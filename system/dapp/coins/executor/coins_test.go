@@ -0,0 +1,133 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func createTransferBatchTx(priv crypto.PrivKey, transfers []*types.AssetsTransfer) *types.Transaction {
+	action := &cty.CoinsAction{Ty: cty.CoinsActionTransferBatch, Value: &cty.CoinsAction_TransferBatch{TransferBatch: &cty.CoinsTransferBatch{Transfers: transfers}}}
+	tx := &types.Transaction{Execer: []byte(cty.CoinsX), Payload: types.Encode(action), Fee: 1e6, To: address.ExecAddress(cty.CoinsX)}
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestTransferBatch(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	from, priv := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(genesis, from, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	//同一个地址可以出现多次，合约地址转入合约账户
+	addr1, _ := util.Genaddress()
+	addr2, _ := util.Genaddress()
+	execAddr := address.ExecAddress("none")
+	hash := mock33.SendTx(createTransferBatchTx(priv, []*types.AssetsTransfer{
+		{To: addr1, Amount: types.Coin},
+		{To: addr2, Amount: 2 * types.Coin},
+		{To: addr1, Amount: types.Coin},
+		{To: execAddr, Amount: types.Coin},
+	}))
+	detail, err := mock33.WaitTx(hash)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	stateHash := mock33.GetLastBlock().StateHash
+	assert.Equal(t, 2*types.Coin, mock33.GetAccount(stateHash, addr1).Balance)
+	assert.Equal(t, 2*types.Coin, mock33.GetAccount(stateHash, addr2).Balance)
+	assert.Equal(t, types.Coin, mock33.GetExecAccount(stateHash, "none", from).Balance)
+	assert.Equal(t, 5*types.Coin-1e6, mock33.GetAccount(stateHash, from).Balance)
+
+	//余额不足的时候整个交易失败，不会部分转账
+	hash = mock33.SendTx(createTransferBatchTx(priv, []*types.AssetsTransfer{
+		{To: addr1, Amount: types.Coin},
+		{To: addr2, Amount: 10 * types.Coin},
+	}))
+	detail, err = mock33.WaitTx(hash)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+	stateHash = mock33.GetLastBlock().StateHash
+	assert.Equal(t, 2*types.Coin, mock33.GetAccount(stateHash, addr1).Balance)
+	assert.Equal(t, 2*types.Coin, mock33.GetAccount(stateHash, addr2).Balance)
+
+	//超过最大转账数
+	var transfers []*types.AssetsTransfer
+	for i := 0; i <= cty.MaxTransferBatch(); i++ {
+		transfers = append(transfers, &types.AssetsTransfer{To: addr1, Amount: 1})
+	}
+	_, err = mock33.GetAPI().SendTx(createTransferBatchTx(priv, transfers))
+	assert.Equal(t, cty.ErrTransferBatchSize, err)
+	_, err = mock33.GetAPI().SendTx(createTransferBatchTx(priv, nil))
+	assert.Equal(t, cty.ErrTransferBatchSize, err)
+	_, err = mock33.GetAPI().SendTx(createTransferBatchTx(priv, []*types.AssetsTransfer{{To: addr1, Amount: 0}}))
+	assert.Equal(t, types.ErrAmount, err)
+}
+
+func createTransferTx(priv crypto.PrivKey, to string, amount int64, note string) *types.Transaction {
+	action := &cty.CoinsAction{Ty: cty.CoinsActionTransfer, Value: &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{To: to, Amount: amount, Note: []byte(note)}}}
+	tx := &types.Transaction{Execer: []byte(cty.CoinsX), Payload: types.Encode(action), Fee: 1e6, To: to}
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestTransferMemo(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	from, priv := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(genesis, from, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	merchant, _ := util.Genaddress()
+	other, _ := util.Genaddress()
+	first := mock33.SendTx(createTransferTx(priv, merchant, types.Coin, "order-1"))
+	_, err := mock33.WaitTx(first)
+	assert.Nil(t, err)
+	hash := mock33.SendTx(createTransferTx(priv, merchant, 2*types.Coin, "order-1-2"))
+	_, err = mock33.WaitTx(hash)
+	assert.Nil(t, err)
+	hash = mock33.SendTx(createTransferBatchTx(priv, []*types.AssetsTransfer{
+		{To: merchant, Amount: 3 * types.Coin, Note: []byte("order-1")},
+		{To: other, Amount: types.Coin, Note: []byte("order-1")},
+	}))
+	detail, err := mock33.WaitTx(hash)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	//备注必须完全相同，默认从最新的记录开始
+	msg, err := mock33.GetAPI().Query(cty.CoinsX, cty.FuncNameGetTxsByMemo, &cty.ReqCoinsMemo{To: merchant, Memo: "order-1"})
+	assert.Nil(t, err)
+	reply := msg.(*cty.ReplyCoinsMemo)
+	assert.Equal(t, 2, len(reply.Records))
+	assert.Equal(t, 3*types.Coin, reply.Records[0].Amount)
+	assert.Equal(t, from, reply.Records[0].From)
+	assert.Equal(t, types.Coin, reply.Records[1].Amount)
+	msg, err = mock33.GetAPI().Query(cty.CoinsX, cty.FuncNameGetTxsByMemo, &cty.ReqCoinsMemo{To: merchant, Memo: "order-1", Count: 1, Direction: 1})
+	assert.Nil(t, err)
+	reply = msg.(*cty.ReplyCoinsMemo)
+	assert.Equal(t, 1, len(reply.Records))
+	assert.Equal(t, common.ToHex(first), reply.Records[0].TxHash)
+	msg, err = mock33.GetAPI().Query(cty.CoinsX, cty.FuncNameGetTxsByMemo, &cty.ReqCoinsMemo{To: merchant, Memo: "order-1", Direction: 1, PrimaryKey: reply.PrimaryKey})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*cty.ReplyCoinsMemo).Records))
+	assert.Equal(t, common.ToHex(hash), msg.(*cty.ReplyCoinsMemo).Records[0].TxHash)
+	_, err = mock33.GetAPI().Query(cty.CoinsX, cty.FuncNameGetTxsByMemo, &cty.ReqCoinsMemo{To: merchant})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
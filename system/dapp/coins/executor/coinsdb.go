@@ -16,9 +16,12 @@ EventTransfer -> 转移资产
 // nofee transaction will not pack into block
 
 import (
+	"encoding/hex"
 	"fmt"
 
+	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -72,3 +75,34 @@ func updateAddrReciver(cachedb dbm.KVDB, addr string, amount int64, isadd bool)
 	//keyvalue
 	return geAddrReciverKV(addr, recv), nil
 }
+
+//calcMemoPrefix 备注可以包含任意字符，用hex编码以后作为key
+func calcMemoPrefix(to string, memo []byte) []byte {
+	return []byte(fmt.Sprintf("LODB-coins-memo:%s-%s-", to, hex.EncodeToString(memo)))
+}
+
+func memoIndex(height, index int64, seq int32) string {
+	return fmt.Sprintf("%018d-%03d", height*types.MaxTxsPerBlock+index, seq)
+}
+
+//memoKV 带备注的转入记录按接收地址和备注索引，i 是批量转账中的序号
+func memoKV(tx *types.Transaction, transfer *types.AssetsTransfer, to string, height int64, index, i int, isadd bool) *types.KeyValue {
+	if len(transfer.Note) == 0 || len(transfer.Note) > cty.MaxMemoIndexLength {
+		return nil
+	}
+	key := append(calcMemoPrefix(to, transfer.Note), memoIndex(height, int64(index), int32(i))...)
+	if !isadd {
+		return &types.KeyValue{Key: key}
+	}
+	record := &cty.CoinsMemoRecord{
+		From:   tx.From(),
+		To:     to,
+		Amount: transfer.Amount,
+		Memo:   string(transfer.Note),
+		TxHash: common.ToHex(tx.Hash()),
+		Height: height,
+		Index:  int64(index),
+		Seq:    int32(i),
+	}
+	return &types.KeyValue{Key: key, Value: types.Encode(record)}
+}
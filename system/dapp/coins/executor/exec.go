@@ -7,6 +7,7 @@ package executor
 import (
 	"github.com/33cn/chain33/common/address"
 	drivers "github.com/33cn/chain33/system/dapp"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -46,6 +47,31 @@ func (c *Coins) Exec_Withdraw(withdraw *types.AssetsWithdraw, tx *types.Transact
 	return nil, types.ErrActionNotSupport
 }
 
+// Exec_TransferBatch 批量转账，任何一笔转账失败的时候整个交易失败
+func (c *Coins) Exec_TransferBatch(batch *cty.CoinsTransferBatch, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(c.GetHeight(), cty.CoinsX, "ForkTransferBatch") {
+		return nil, types.ErrActionNotSupport
+	}
+	from := tx.From()
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	for _, transfer := range batch.Transfers {
+		var r *types.Receipt
+		var err error
+		//to 是 execs 合约地址
+		if drivers.IsDriverAddress(transfer.To, c.GetHeight()) {
+			r, err = c.GetCoinsAccount().TransferToExec(from, transfer.To, transfer.Amount)
+		} else {
+			r, err = c.GetCoinsAccount().Transfer(from, transfer.To, transfer.Amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+		receipt.KV = append(receipt.KV, r.KV...)
+		receipt.Logs = append(receipt.Logs, r.Logs...)
+	}
+	return receipt, nil
+}
+
 // Exec_Genesis genesis of exec
 func (c *Coins) Exec_Genesis(genesis *types.AssetsGenesis, tx *types.Transaction, index int) (*types.Receipt, error) {
 	if c.GetHeight() == 0 {
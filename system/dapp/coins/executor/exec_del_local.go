@@ -5,6 +5,7 @@
 package executor
 
 import (
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -14,7 +15,11 @@ func (c *Coins) ExecDelLocal_Transfer(transfer *types.AssetsTransfer, tx *types.
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs := []*types.KeyValue{kv}
+	if memo := memoKV(tx, transfer, tx.GetRealToAddr(), c.GetHeight(), index, 0, false); memo != nil {
+		kvs = append(kvs, memo)
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
 }
 
 // ExecDelLocal_TransferToExec delete  transfer  of  local exec to exec
@@ -35,3 +40,19 @@ func (c *Coins) ExecDelLocal_Withdraw(withdraw *types.AssetsWithdraw, tx *types.
 	}
 	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
 }
+
+// ExecDelLocal_TransferBatch 回滚批量转账每一个接收地址收到的金额
+func (c *Coins) ExecDelLocal_TransferBatch(batch *cty.CoinsTransferBatch, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	var kvs []*types.KeyValue
+	for i, transfer := range batch.Transfers {
+		kv, err := updateAddrReciver(c.GetLocalDB(), transfer.To, transfer.Amount, false)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+		if memo := memoKV(tx, transfer, transfer.To, c.GetHeight(), index, i, false); memo != nil {
+			kvs = append(kvs, memo)
+		}
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
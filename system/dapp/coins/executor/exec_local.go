@@ -5,6 +5,7 @@
 package executor
 
 import (
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -14,7 +15,11 @@ func (c *Coins) ExecLocal_Transfer(transfer *types.AssetsTransfer, tx *types.Tra
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs := []*types.KeyValue{kv}
+	if memo := memoKV(tx, transfer, tx.GetRealToAddr(), c.GetHeight(), index, 0, true); memo != nil {
+		kvs = append(kvs, memo)
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
 }
 
 // ExecLocal_TransferToExec  transfer of local exec to exec
@@ -44,3 +49,19 @@ func (c *Coins) ExecLocal_Genesis(gen *types.AssetsGenesis, tx *types.Transactio
 	}
 	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
 }
+
+// ExecLocal_TransferBatch 批量转账的每一个接收地址增加收到的金额
+func (c *Coins) ExecLocal_TransferBatch(batch *cty.CoinsTransferBatch, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	var kvs []*types.KeyValue
+	for i, transfer := range batch.Transfers {
+		kv, err := updateAddrReciver(c.GetLocalDB(), transfer.To, transfer.Amount, true)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+		if memo := memoKV(tx, transfer, transfer.To, c.GetHeight(), index, i, true); memo != nil {
+			kvs = append(kvs, memo)
+		}
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
@@ -5,6 +5,7 @@
 package executor
 
 import (
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -28,6 +29,39 @@ func (c *Coins) Query_GetAddrTxsCount(in *types.ReqKey) (types.Message, error) {
 	return c.GetAddrTxsCount(in)
 }
 
+// Query_GetTxsByMemo 按接收地址和备注查询转入记录，direction 为0的时候从最新的记录开始
+func (c *Coins) Query_GetTxsByMemo(in *cty.ReqCoinsMemo) (types.Message, error) {
+	if in.To == "" || in.Memo == "" {
+		return nil, types.ErrInvalidParam
+	}
+	count := in.Count
+	if count <= 0 {
+		count = cty.DefaultMemoCount
+	}
+	if count > cty.MaxMemoCount {
+		count = cty.MaxMemoCount
+	}
+	prefix := calcMemoPrefix(in.To, []byte(in.Memo))
+	var key []byte
+	if in.PrimaryKey != "" {
+		key = append(append([]byte{}, prefix...), in.PrimaryKey...)
+	}
+	values, err := c.GetLocalDB().List(prefix, key, count, in.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &cty.ReplyCoinsMemo{}
+	for _, value := range values {
+		var record cty.CoinsMemoRecord
+		if err := types.Decode(value, &record); err != nil {
+			return nil, err
+		}
+		reply.Records = append(reply.Records, &record)
+		reply.PrimaryKey = memoIndex(record.Height, record.Index, record.Seq)
+	}
+	return reply, nil
+}
+
 // GetAddrReciver get address reciver by address
 func (c *Coins) GetAddrReciver(addr *types.ReqAddr) (types.Message, error) {
 	reciver := types.Int64{}
@@ -0,0 +1,64 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+)
+
+//测试用的版本2只记录调用次数，执行的逻辑和版本1相同
+var execV2Calls, execLocalV2Calls int64
+
+func init() {
+	types.RegisterDappVersion(driverName, 2, 100)
+}
+
+func (c *Coins) Exec_Transfer_V2(transfer *types.AssetsTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	atomic.AddInt64(&execV2Calls, 1)
+	return c.Exec_Transfer(transfer, tx, index)
+}
+
+func (c *Coins) ExecLocal_Transfer_V2(transfer *types.AssetsTransfer, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	atomic.AddInt64(&execLocalV2Calls, 1)
+	return c.ExecLocal_Transfer(transfer, tx, receipt, index)
+}
+
+func TestExecVersion(t *testing.T) {
+	title := types.GetTitle()
+	types.SetTitleOnlyForTest("chain33")
+	defer types.SetTitleOnlyForTest(title)
+	dir, leveldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, leveldb)
+	c := newCoins().(*Coins)
+	c.SetStateDB(kvdb)
+	c.SetLocalDB(kvdb)
+	from, priv := util.Genaddress()
+	to, _ := util.Genaddress()
+	c.GetCoinsAccount().SaveAccount(&types.Account{Addr: from, Balance: 10 * types.Coin})
+
+	//分叉高度之前用原来的方法，之后用版本2的方法，两边的执行结果相同
+	for _, height := range []int64{99, 100} {
+		c.SetEnv(height, 0, 0)
+		exec, local := atomic.LoadInt64(&execV2Calls), atomic.LoadInt64(&execLocalV2Calls)
+		tx := util.CreateCoinsTx(priv, to, types.Coin)
+		receipt, err := c.Exec(tx, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(types.ExecOk), receipt.Ty)
+		_, err = c.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 0)
+		assert.Nil(t, err)
+		called := int64(0)
+		if height >= 100 {
+			called = 1
+		}
+		assert.Equal(t, exec+called, atomic.LoadInt64(&execV2Calls), "height %d", height)
+		assert.Equal(t, local+called, atomic.LoadInt64(&execLocalV2Calls), "height %d", height)
+	}
+	assert.Equal(t, 2*types.Coin, c.GetCoinsAccount().LoadAccount(to).Balance)
+}
@@ -29,6 +29,7 @@ type CoinsAction struct {
 	//	*CoinsAction_Withdraw
 	//	*CoinsAction_Genesis
 	//	*CoinsAction_TransferToExec
+	//	*CoinsAction_TransferBatch
 	Value                isCoinsAction_Value `protobuf_oneof:"value"`
 	Ty                   int32               `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -81,6 +82,10 @@ type CoinsAction_TransferToExec struct {
 	TransferToExec *types.AssetsTransferToExec `protobuf:"bytes,5,opt,name=transferToExec,proto3,oneof"`
 }
 
+type CoinsAction_TransferBatch struct {
+	TransferBatch *CoinsTransferBatch `protobuf:"bytes,6,opt,name=transferBatch,proto3,oneof"`
+}
+
 func (*CoinsAction_Transfer) isCoinsAction_Value() {}
 
 func (*CoinsAction_Withdraw) isCoinsAction_Value() {}
@@ -89,6 +94,8 @@ func (*CoinsAction_Genesis) isCoinsAction_Value() {}
 
 func (*CoinsAction_TransferToExec) isCoinsAction_Value() {}
 
+func (*CoinsAction_TransferBatch) isCoinsAction_Value() {}
+
 func (m *CoinsAction) GetValue() isCoinsAction_Value {
 	if m != nil {
 		return m.Value
@@ -124,6 +131,13 @@ func (m *CoinsAction) GetTransferToExec() *types.AssetsTransferToExec {
 	return nil
 }
 
+func (m *CoinsAction) GetTransferBatch() *CoinsTransferBatch {
+	if x, ok := m.GetValue().(*CoinsAction_TransferBatch); ok {
+		return x.TransferBatch
+	}
+	return nil
+}
+
 func (m *CoinsAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -138,6 +152,7 @@ func (*CoinsAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) e
 		(*CoinsAction_Withdraw)(nil),
 		(*CoinsAction_Genesis)(nil),
 		(*CoinsAction_TransferToExec)(nil),
+		(*CoinsAction_TransferBatch)(nil),
 	}
 }
 
@@ -165,6 +180,11 @@ func _CoinsAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.TransferToExec); err != nil {
 			return err
 		}
+	case *CoinsAction_TransferBatch:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TransferBatch); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("CoinsAction.Value has unexpected type %T", x)
@@ -207,6 +227,14 @@ func _CoinsAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.Value = &CoinsAction_TransferToExec{msg}
 		return true, err
+	case 6: // value.transferBatch
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CoinsTransferBatch)
+		err := b.DecodeMessage(msg)
+		m.Value = &CoinsAction_TransferBatch{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -236,6 +264,11 @@ func _CoinsAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CoinsAction_TransferBatch:
+		s := proto.Size(x.TransferBatch)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -243,26 +276,300 @@ func _CoinsAction_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+//批量转账，所有的转账在一个交易中原子执行，转账数不能超过配置的maxTransferBatch
+type CoinsTransferBatch struct {
+	Transfers            []*types.AssetsTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CoinsTransferBatch) Reset()         { *m = CoinsTransferBatch{} }
+func (m *CoinsTransferBatch) String() string { return proto.CompactTextString(m) }
+func (*CoinsTransferBatch) ProtoMessage()    {}
+func (*CoinsTransferBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{1}
+}
+
+func (m *CoinsTransferBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CoinsTransferBatch.Unmarshal(m, b)
+}
+func (m *CoinsTransferBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CoinsTransferBatch.Marshal(b, m, deterministic)
+}
+func (m *CoinsTransferBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinsTransferBatch.Merge(m, src)
+}
+func (m *CoinsTransferBatch) XXX_Size() int {
+	return xxx_messageInfo_CoinsTransferBatch.Size(m)
+}
+func (m *CoinsTransferBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinsTransferBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoinsTransferBatch proto.InternalMessageInfo
+
+func (m *CoinsTransferBatch) GetTransfers() []*types.AssetsTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+//带备注的转入记录，商户可以把订单号写在备注中，按接收地址和备注查询，seq 是批量转账中的序号
+type CoinsMemoRecord struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo                 string   `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	TxHash               string   `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,7,opt,name=index,proto3" json:"index,omitempty"`
+	Seq                  int32    `protobuf:"varint,8,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CoinsMemoRecord) Reset()         { *m = CoinsMemoRecord{} }
+func (m *CoinsMemoRecord) String() string { return proto.CompactTextString(m) }
+func (*CoinsMemoRecord) ProtoMessage()    {}
+func (*CoinsMemoRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{2}
+}
+
+func (m *CoinsMemoRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CoinsMemoRecord.Unmarshal(m, b)
+}
+func (m *CoinsMemoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CoinsMemoRecord.Marshal(b, m, deterministic)
+}
+func (m *CoinsMemoRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinsMemoRecord.Merge(m, src)
+}
+func (m *CoinsMemoRecord) XXX_Size() int {
+	return xxx_messageInfo_CoinsMemoRecord.Size(m)
+}
+func (m *CoinsMemoRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinsMemoRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoinsMemoRecord proto.InternalMessageInfo
+
+func (m *CoinsMemoRecord) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CoinsMemoRecord) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CoinsMemoRecord) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *CoinsMemoRecord) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *CoinsMemoRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *CoinsMemoRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CoinsMemoRecord) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *CoinsMemoRecord) GetSeq() int32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+//direction 0:降序 1:升序
+type ReqCoinsMemo struct {
+	To                   string   `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Memo                 string   `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCoinsMemo) Reset()         { *m = ReqCoinsMemo{} }
+func (m *ReqCoinsMemo) String() string { return proto.CompactTextString(m) }
+func (*ReqCoinsMemo) ProtoMessage()    {}
+func (*ReqCoinsMemo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{3}
+}
+
+func (m *ReqCoinsMemo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCoinsMemo.Unmarshal(m, b)
+}
+func (m *ReqCoinsMemo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCoinsMemo.Marshal(b, m, deterministic)
+}
+func (m *ReqCoinsMemo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCoinsMemo.Merge(m, src)
+}
+func (m *ReqCoinsMemo) XXX_Size() int {
+	return xxx_messageInfo_ReqCoinsMemo.Size(m)
+}
+func (m *ReqCoinsMemo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCoinsMemo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCoinsMemo proto.InternalMessageInfo
+
+func (m *ReqCoinsMemo) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ReqCoinsMemo) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *ReqCoinsMemo) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqCoinsMemo) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqCoinsMemo) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyCoinsMemo struct {
+	Records              []*CoinsMemoRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	PrimaryKey           string             `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReplyCoinsMemo) Reset()         { *m = ReplyCoinsMemo{} }
+func (m *ReplyCoinsMemo) String() string { return proto.CompactTextString(m) }
+func (*ReplyCoinsMemo) ProtoMessage()    {}
+func (*ReplyCoinsMemo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{4}
+}
+
+func (m *ReplyCoinsMemo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyCoinsMemo.Unmarshal(m, b)
+}
+func (m *ReplyCoinsMemo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyCoinsMemo.Marshal(b, m, deterministic)
+}
+func (m *ReplyCoinsMemo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyCoinsMemo.Merge(m, src)
+}
+func (m *ReplyCoinsMemo) XXX_Size() int {
+	return xxx_messageInfo_ReplyCoinsMemo.Size(m)
+}
+func (m *ReplyCoinsMemo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyCoinsMemo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyCoinsMemo proto.InternalMessageInfo
+
+func (m *ReplyCoinsMemo) GetRecords() []*CoinsMemoRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ReplyCoinsMemo) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CoinsAction)(nil), "types.CoinsAction")
+	proto.RegisterType((*CoinsTransferBatch)(nil), "types.CoinsTransferBatch")
+	proto.RegisterType((*CoinsMemoRecord)(nil), "types.CoinsMemoRecord")
+	proto.RegisterType((*ReqCoinsMemo)(nil), "types.ReqCoinsMemo")
+	proto.RegisterType((*ReplyCoinsMemo)(nil), "types.ReplyCoinsMemo")
 }
 
 func init() { proto.RegisterFile("coins.proto", fileDescriptor_da4483c99519c66a) }
 
 var fileDescriptor_da4483c99519c66a = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0xce, 0xcf, 0xcc,
-	0x2b, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0x12,
-	0x2c, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0x83, 0xc8, 0x28, 0x75, 0x32,
-	0x71, 0x71, 0x3b, 0x83, 0x54, 0x3a, 0x82, 0x45, 0x85, 0x8c, 0xb9, 0x38, 0xc0, 0x8a, 0xd2, 0x52,
-	0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x44, 0xf5, 0xc0, 0x9a, 0xf5, 0x1c, 0x8b, 0x8b,
-	0x53, 0x4b, 0x8a, 0x43, 0xa0, 0x92, 0x1e, 0x0c, 0x41, 0x70, 0x85, 0x20, 0x4d, 0xe5, 0x99, 0x25,
-	0x19, 0x29, 0x45, 0x89, 0xe5, 0x12, 0x2c, 0x58, 0x34, 0x85, 0x43, 0x25, 0x41, 0x9a, 0x60, 0x0a,
-	0x85, 0x0c, 0xb8, 0xd8, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0x25, 0x98, 0xc0, 0x7a, 0x44,
-	0x50, 0xf4, 0xb8, 0x43, 0xe4, 0x3c, 0x18, 0x82, 0x60, 0xca, 0x84, 0x5c, 0xb9, 0xf8, 0x60, 0x56,
-	0x86, 0xe4, 0xbb, 0x56, 0xa4, 0x26, 0x4b, 0xb0, 0x82, 0x35, 0x4a, 0x63, 0x75, 0x21, 0x44, 0x89,
-	0x07, 0x43, 0x10, 0x9a, 0x26, 0x21, 0x3e, 0x2e, 0xa6, 0x92, 0x4a, 0x09, 0x66, 0x05, 0x46, 0x0d,
-	0xd6, 0x20, 0xa6, 0x92, 0x4a, 0x27, 0x76, 0x2e, 0xd6, 0xb2, 0xc4, 0x9c, 0xd2, 0x54, 0x27, 0xf6,
-	0x28, 0x48, 0x38, 0x25, 0xb1, 0x81, 0xc3, 0xc6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x27,
-	0xc9, 0xb3, 0x44, 0x01, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0xed, 0x38, 0xae, 0x27, 0x10, 0x60, 0x55, 0xaa, 0xe5, 0x22, 0x14, 0xf9, 0xa9, 0x4f,
+	0x51, 0x45, 0xbe, 0x20, 0x41, 0x15, 0x46, 0x88, 0x97, 0x51, 0x24, 0x24, 0xde, 0x5c, 0x67, 0x5a,
+	0x5b, 0xaa, 0xbd, 0xe9, 0xee, 0x96, 0xc6, 0x3f, 0xc0, 0x3f, 0xf1, 0x0f, 0x7c, 0x14, 0xf2, 0xd8,
+	0x9b, 0xa4, 0xa1, 0xbc, 0xed, 0x9c, 0x39, 0x67, 0xe7, 0xf8, 0x8c, 0x17, 0xc6, 0xb9, 0x2a, 0x6b,
+	0x33, 0xdb, 0x68, 0x65, 0x95, 0x08, 0x6d, 0xb3, 0x21, 0xf3, 0xf6, 0x95, 0xd5, 0x59, 0x6d, 0xb2,
+	0xdc, 0x96, 0xaa, 0xee, 0x3a, 0xc9, 0x1f, 0x1f, 0xc6, 0x9f, 0x5a, 0xe6, 0x82, 0x51, 0x31, 0x87,
+	0x13, 0x26, 0x5d, 0x93, 0x96, 0xde, 0xd4, 0x3b, 0x1f, 0x7f, 0x7c, 0x3d, 0x63, 0xf1, 0x6c, 0x61,
+	0x0c, 0x59, 0xb3, 0xea, 0x9b, 0xe9, 0x00, 0x77, 0xc4, 0x56, 0xf4, 0x50, 0xda, 0x62, 0xad, 0xb3,
+	0x07, 0x39, 0x7c, 0x42, 0xf4, 0xbd, 0x6f, 0xb6, 0x22, 0x47, 0x14, 0x17, 0x10, 0xdd, 0x50, 0x4d,
+	0xa6, 0x34, 0xd2, 0x67, 0xcd, 0xe9, 0x23, 0xcd, 0xe7, 0xae, 0x97, 0x0e, 0xd0, 0xd1, 0xc4, 0x25,
+	0x4c, 0xdc, 0xc8, 0x95, 0xba, 0xdc, 0x52, 0x2e, 0x43, 0x16, 0xbe, 0x7b, 0xd2, 0x61, 0x47, 0x49,
+	0x07, 0x78, 0x24, 0x12, 0x0b, 0x78, 0xee, 0x90, 0x65, 0x66, 0xf3, 0x42, 0x8e, 0xf8, 0x96, 0x37,
+	0xfd, 0x2d, 0x9c, 0xc6, 0xea, 0x90, 0x90, 0x0e, 0xf0, 0xb1, 0x42, 0x4c, 0xc0, 0xb7, 0x8d, 0x0c,
+	0xa6, 0xde, 0x79, 0x88, 0xbe, 0x6d, 0x96, 0x11, 0x84, 0x3f, 0xb3, 0xdb, 0x7b, 0x4a, 0xbe, 0x80,
+	0xf8, 0x57, 0x2f, 0xe6, 0x10, 0x3b, 0xbd, 0x91, 0xde, 0x34, 0xf8, 0x6f, 0xaa, 0xb8, 0xe7, 0x25,
+	0xbf, 0x3d, 0x78, 0xc1, 0x77, 0x7d, 0xa3, 0x4a, 0x21, 0xe5, 0x4a, 0xaf, 0x85, 0x80, 0xe1, 0xb5,
+	0x56, 0x15, 0x6f, 0x26, 0x46, 0x3e, 0xb3, 0x17, 0xc5, 0x11, 0xc6, 0xe8, 0x5b, 0x25, 0xce, 0x60,
+	0x94, 0x55, 0xea, 0xbe, 0xb6, 0xec, 0x2f, 0xc0, 0xbe, 0x6a, 0xb5, 0x15, 0x55, 0x8a, 0x17, 0x14,
+	0x23, 0x9f, 0x5b, 0xae, 0xdd, 0xa6, 0x99, 0x29, 0x38, 0xc9, 0x18, 0xfb, 0xaa, 0xc5, 0x0b, 0x2a,
+	0x6f, 0x0a, 0xcb, 0xd9, 0x04, 0xd8, 0x57, 0xe2, 0x14, 0xc2, 0xb2, 0x5e, 0xd3, 0x56, 0x46, 0x0c,
+	0x77, 0x85, 0x78, 0x09, 0x81, 0xa1, 0x3b, 0x79, 0xc2, 0x71, 0xb4, 0xc7, 0xe4, 0x97, 0x07, 0xcf,
+	0x90, 0xee, 0x76, 0xf6, 0x7b, 0x93, 0xde, 0xce, 0xa4, 0x33, 0xe3, 0x1f, 0x98, 0xf9, 0x00, 0xb0,
+	0xd1, 0x65, 0x95, 0xe9, 0xe6, 0x2b, 0x75, 0xe1, 0xc6, 0x78, 0x80, 0xb4, 0xc3, 0x73, 0xfe, 0xae,
+	0x21, 0x0f, 0xea, 0x0a, 0xf1, 0x1e, 0xe2, 0x75, 0xa9, 0x89, 0xff, 0x5e, 0xfe, 0x8a, 0x10, 0xf7,
+	0x40, 0x72, 0x05, 0x13, 0xa4, 0xcd, 0x6d, 0xb3, 0x77, 0x72, 0x01, 0x91, 0xe6, 0x30, 0xdd, 0x26,
+	0xce, 0x0e, 0xf7, 0xbe, 0xcf, 0x1a, 0x1d, 0xed, 0xc8, 0x97, 0x7f, 0xec, 0x6b, 0x19, 0xfd, 0xe8,
+	0x9e, 0xd7, 0xd5, 0x88, 0x9f, 0xd4, 0xfc, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x46, 0x97,
+	0xee, 0x7b, 0x03, 0x00, 0x00,
 }
@@ -5,6 +5,7 @@
 package types
 
 import (
+	"errors"
 	"reflect"
 
 	"github.com/33cn/chain33/types"
@@ -19,8 +20,25 @@ const (
 	CoinsActionWithdraw = 3
 	// CoinsActionTransferToExec defines const number coinsactiontransfertoExec
 	CoinsActionTransferToExec = 10
+	// CoinsActionTransferBatch defines const number coinsactiontransferbatch
+	CoinsActionTransferBatch = 11
 )
 
+// DefaultMaxTransferBatch 没有配置maxTransferBatch的时候批量转账最多的转账数
+const DefaultMaxTransferBatch = 100
+
+// 按备注查询转入记录
+const (
+	FuncNameGetTxsByMemo = "GetTxsByMemo"
+	// MaxMemoIndexLength 超过这个长度的备注不建索引
+	MaxMemoIndexLength = 128
+	DefaultMemoCount   = 20
+	MaxMemoCount       = 100
+)
+
+// ErrTransferBatchSize 批量转账的转账数为0或者超过maxTransferBatch
+var ErrTransferBatchSize = errors.New("ErrTransferBatchSize")
+
 var (
 	// CoinsX defines a global string
 	CoinsX = "coins"
@@ -31,6 +49,7 @@ var (
 		"TransferToExec": CoinsActionTransferToExec,
 		"Withdraw":       CoinsActionWithdraw,
 		"Genesis":        CoinsActionGenesis,
+		"TransferBatch":  CoinsActionTransferBatch,
 	}
 	logmap = make(map[int64]*types.LogInfo)
 )
@@ -40,6 +59,16 @@ func init() {
 	types.RegistorExecutor("coins", NewType())
 
 	types.RegisterDappFork(CoinsX, "Enable", 0)
+	types.RegisterDappFork(CoinsX, "ForkTransferBatch", 0)
+}
+
+// MaxTransferBatch 批量转账最多的转账数，在exec.sub.coins中配置
+func MaxTransferBatch() int {
+	max := types.ConfSub(CoinsX).GInt("maxTransferBatch")
+	if max <= 0 {
+		return DefaultMaxTransferBatch
+	}
+	return int(max)
 }
 
 // CoinsType defines exec type
@@ -105,6 +134,9 @@ func (c *CoinsType) decodePayloadValue(tx *types.Transaction) (string, reflect.V
 	case CoinsActionGenesis:
 		name = "Genesis"
 		value = action.GetGenesis()
+	case CoinsActionTransferBatch:
+		name = "TransferBatch"
+		value = action.GetTransferBatch()
 	}
 	if value == nil {
 		return "", reflect.ValueOf(nil), types.ErrActionNotSupport
@@ -133,12 +165,31 @@ func (c *CoinsType) RPC_Default_Process(action string, msg interface{}) (*types.
 	return tx, err
 }
 
+// GetAmount 批量转账的总额，实现types.Amounter
+func (m *CoinsTransferBatch) GetAmount() int64 {
+	var amount int64
+	for _, transfer := range m.GetTransfers() {
+		amount += transfer.Amount
+	}
+	return amount
+}
+
 // GetAssets return asset list
 func (c *CoinsType) GetAssets(tx *types.Transaction) ([]*types.Asset, error) {
-	assets, err := c.ExecTypeBase.GetAssets(tx)
-	if err != nil || len(assets) == 0 {
+	_, v, err := c.DecodePayloadValue(tx)
+	if err != nil {
 		return nil, err
 	}
+	var assets []*types.Asset
+	//批量转账按总额返回一个资产
+	if batch, ok := v.Interface().(*CoinsTransferBatch); ok {
+		assets = []*types.Asset{{Exec: string(tx.Execer), Amount: batch.GetAmount()}}
+	} else {
+		assets, err = c.ExecTypeBase.GetAssets(tx)
+		if err != nil || len(assets) == 0 {
+			return nil, err
+		}
+	}
 	if assets[0].Symbol == "" {
 		assets[0].Symbol = types.GetCoinSymbol()
 	}
@@ -64,3 +64,25 @@ func TestCoinsPb(t *testing.T) {
 	err = _CoinsAction_OneofMarshaler(ca, b)
 	assert.NoError(t, err)
 }
+
+func TestCoinsTransferBatch(t *testing.T) {
+	ty := NewType()
+	batch := &CoinsTransferBatch{Transfers: []*types.AssetsTransfer{
+		{To: "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP", Amount: 10},
+		{To: "1Q8hGLfoGe63efeWa8fJ4Pnukhkngt6poK", Amount: 20},
+	}}
+	assert.Equal(t, int64(30), batch.GetAmount())
+	action := &CoinsAction{Ty: CoinsActionTransferBatch, Value: &CoinsAction_TransferBatch{batch}}
+	tx := &types.Transaction{Execer: []byte(CoinsX), Payload: types.Encode(action)}
+	name, val, err := ty.DecodePayloadValue(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, "TransferBatch", name)
+	assert.Equal(t, 2, len(val.Interface().(*CoinsTransferBatch).GetTransfers()))
+
+	assets, err := ty.GetAssets(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(assets))
+	assert.Equal(t, int64(30), assets[0].Amount)
+	assert.Equal(t, "BTY", assets[0].Symbol)
+	assert.Equal(t, DefaultMaxTransferBatch, MaxTransferBatch())
+}
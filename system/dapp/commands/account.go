@@ -71,13 +71,20 @@ func GetAccountListCmd() *cobra.Command {
 		Short: "Get account list",
 		Run:   listAccount,
 	}
+	addListAccountFlags(cmd)
 	return cmd
 }
 
+func addListAccountFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("format", "f", "", "address format, base58 or bech32 (default: wallet config)")
+}
+
 func listAccount(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	format, _ := cmd.Flags().GetString("format")
+	params := types.ReqAccountList{AddrFormat: format}
 	var res rpctypes.WalletAccounts
-	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetAccounts", nil, &res)
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetAccounts", params, &res)
 	ctx.SetResultCb(parseListAccountRes)
 	ctx.Run()
 }
@@ -136,11 +143,14 @@ func balance(cmd *cobra.Command, args []string) {
 	addr, _ := cmd.Flags().GetString("addr")
 	execer, _ := cmd.Flags().GetString("exec")
 	height, _ := cmd.Flags().GetInt("height")
-	err := address.CheckAddress(addr)
-	if err != nil {
-		if err = address.CheckMultiSignAddress(addr); err != nil {
-			fmt.Fprintln(os.Stderr, types.ErrInvalidAddress)
-			return
+	//bech32格式的地址由节点转换
+	if !address.IsBech32(addr) {
+		err := address.CheckAddress(addr)
+		if err != nil {
+			if err = address.CheckMultiSignAddress(addr); err != nil {
+				fmt.Fprintln(os.Stderr, types.ErrInvalidAddress)
+				return
+			}
 		}
 	}
 	if execer == "" && height == -1 {
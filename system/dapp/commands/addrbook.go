@@ -0,0 +1,255 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	"github.com/33cn/chain33/types"
+)
+
+// NetAddrBookCmd inspect and edit the p2p address book
+func NetAddrBookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addrbook",
+		Short: "Inspect and edit the p2p address book",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		AddrBookListCmd(),
+		AddrBookRemoveCmd(),
+		AddrBookSeedCmd(),
+	)
+	return cmd
+}
+
+// AddrBookListCmd list known addresses with the connection stats
+func AddrBookListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List known addresses with attempt and success stats",
+		Run:   addrBookList,
+	}
+	cmd.Flags().BoolP("failed", "f", false, "only list addresses with failed attempts")
+	return cmd
+}
+
+func addrBookList(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	failed, _ := cmd.Flags().GetBool("failed")
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res types.AddrBookEntries
+	if err := rpc.Call("Chain33.GetAddrBook", nil, &res); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if failed {
+		entries := res.Entries[:0]
+		for _, entry := range res.Entries {
+			if entry.Attempts > 0 {
+				entries = append(entries, entry)
+			}
+		}
+		res.Entries = entries
+	}
+	printOutput(&res, func(w io.Writer) {
+		fmt.Fprintln(w, "ADDR\tATTEMPTS\tLAST ATTEMPT\tLAST SUCCESS\tSTATE")
+		for _, entry := range res.Entries {
+			state := "-"
+			if entry.Connected {
+				state = "connected"
+			} else if entry.Banned {
+				state = "banned"
+			}
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", entry.Addr, entry.Attempts,
+				formatUnixOrNever(entry.LastAttempt), formatUnixOrNever(entry.LastSuccess), state)
+		}
+	})
+}
+
+func formatUnixOrNever(t int64) string {
+	if t == 0 {
+		return "never"
+	}
+	return formatBlockTime(t)
+}
+
+// AddrBookRemoveCmd remove given or stale addresses
+func AddrBookRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove addresses, or the stale ones that are not connected",
+		Run:   addrBookRemove,
+	}
+	cmd.Flags().StringSliceP("addr", "a", nil, "addresses to remove, ip:port, separated by comma")
+	cmd.Flags().Int64P("attempts", "n", 0, "remove addresses failed at least n times in a row")
+	cmd.Flags().DurationP("stale", "s", 0, "remove addresses not connected successfully for the duration, e.g. 72h")
+	return cmd
+}
+
+func addrBookRemove(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addrs, _ := cmd.Flags().GetStringSlice("addr")
+	attempts, _ := cmd.Flags().GetInt64("attempts")
+	stale, _ := cmd.Flags().GetDuration("stale")
+	if len(addrs) == 0 && attempts <= 0 && stale <= 0 {
+		fmt.Fprintln(os.Stderr, "one of --addr, --attempts and --stale is required")
+		return
+	}
+	params := &types.ReqAddrBookRemove{Addrs: addrs, MinAttempts: attempts, StaleTime: int64(stale / time.Second)}
+	var res types.ReplyAddrBookEdit
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.RemoveAddrBook", params, &res)
+	ctx.Run()
+}
+
+// AddrBookSeedCmd add addresses from a file or url
+func AddrBookSeedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Add addresses to the address book from a file or url",
+		Long: `Add addresses to the address book from a file or url, the content can be
+one ip:port per line (lines starting with # are ignored), a json array of
+ip:port, or an addrbook json with the addrs field.`,
+		Run: addrBookSeed,
+	}
+	cmd.Flags().StringP("file", "f", "", "file of addresses, - for stdin")
+	cmd.Flags().StringP("url", "u", "", "http url of addresses")
+	cmd.Flags().BoolP("dial", "d", false, "connect to the new addresses at once")
+	return cmd
+}
+
+func addrBookSeed(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	file, _ := cmd.Flags().GetString("file")
+	url, _ := cmd.Flags().GetString("url")
+	dial, _ := cmd.Flags().GetBool("dial")
+	if (file == "") == (url == "") {
+		fmt.Fprintln(os.Stderr, "one of --file and --url is required")
+		return
+	}
+	data, err := readSeedSource(file, url)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	addrs, err := parseSeedAddrs(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if len(addrs) == 0 {
+		fmt.Fprintln(os.Stderr, "no address found")
+		return
+	}
+	params := &types.ReqAddrBookSeed{Addrs: addrs, Dial: dial}
+	var res types.ReplyAddrBookEdit
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SeedAddrBook", params, &res)
+	ctx.Run()
+}
+
+func readSeedSource(file, url string) ([]byte, error) {
+	if file == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	if file != "" {
+		return ioutil.ReadFile(file)
+	}
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s: %s", url, resp.Status)
+	}
+	return ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+//seedAddr addrbook.json中的地址是NetAddress对象，也支持直接写ip:port
+type seedAddr string
+
+func (a *seedAddr) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*a = seedAddr(s)
+		return nil
+	}
+	var netaddr struct {
+		IP   string
+		Port uint16
+	}
+	if err := json.Unmarshal(data, &netaddr); err != nil {
+		return err
+	}
+	if netaddr.IP == "" {
+		return errors.New("address without ip")
+	}
+	*a = seedAddr(net.JoinHostPort(netaddr.IP, strconv.Itoa(int(netaddr.Port))))
+	return nil
+}
+
+//parseSeedAddrs 解析json数组、addrbook.json或者每行一个地址的文本，去掉重复的地址
+func parseSeedAddrs(data []byte) ([]string, error) {
+	var raw []seedAddr
+	trimmed := strings.TrimSpace(string(data))
+	switch {
+	case strings.HasPrefix(trimmed, "["):
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	case strings.HasPrefix(trimmed, "{"):
+		var book struct {
+			Addrs []struct {
+				Addr seedAddr `json:"addr"`
+			} `json:"addrs"`
+		}
+		if err := json.Unmarshal(data, &book); err != nil {
+			return nil, err
+		}
+		for _, ka := range book.Addrs {
+			raw = append(raw, ka.Addr)
+		}
+	default:
+		for _, line := range strings.Split(trimmed, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			raw = append(raw, seedAddr(line))
+		}
+	}
+	seen := make(map[string]bool)
+	var addrs []string
+	for _, addr := range raw {
+		s := strings.TrimSpace(string(addr))
+		if s == "" || seen[s] {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(s); err != nil {
+			return nil, fmt.Errorf("invalid address %s: %v", s, err)
+		}
+		seen[s] = true
+		addrs = append(addrs, s)
+	}
+	return addrs, nil
+}
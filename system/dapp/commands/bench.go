@@ -0,0 +1,334 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	"github.com/spf13/cobra"
+)
+
+// BenchCmd benchmark command
+func BenchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bench",
+		Short: "Benchmark and load test of node",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		BenchTxCmd(),
+	)
+
+	return cmd
+}
+
+// BenchTxCmd send synthetic txs at given rate
+func BenchTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Send signed txs to none executor at given tps, report achieved tps, confirmation latency and mempool backlog",
+		Run:   benchTx,
+	}
+	cmd.Flags().StringP("key", "k", "", "private key of the sender, the account pays the fee of every tx")
+	cmd.Flags().StringP("addr", "a", "", "sender address in the wallet, the wallet should be unlocked")
+	cmd.Flags().Int("tps", 100, "txs sent per second")
+	cmd.Flags().DurationP("duration", "d", 30*time.Second, "time to send txs")
+	cmd.Flags().Int("payload-size", 64, "random payload bytes of every tx")
+	cmd.Flags().IntP("workers", "w", 8, "concurrent rpc requests to send txs")
+	cmd.Flags().Duration("wait", time.Minute, "time to wait for confirmations after sending")
+	cmd.Flags().StringP("exec", "e", types.NoneX, "execer of the txs, should accept any payload")
+	return cmd
+}
+
+//benchState 发送和确认的统计，pending 保存已经发送还没有打包的交易的发送时间
+type benchState struct {
+	mu        sync.Mutex
+	pending   map[string]time.Time
+	latencies []time.Duration
+	lastConf  time.Time
+	sent      int64
+	failed    int64
+	skipped   int64
+	mempool   int
+	maxPool   int
+	blocks    int64
+	errOnce   sync.Once
+}
+
+func (s *benchState) add(hash string, at time.Time) {
+	s.mu.Lock()
+	s.pending[hash] = at
+	s.mu.Unlock()
+}
+
+func (s *benchState) remove(hash string) {
+	s.mu.Lock()
+	delete(s.pending, hash)
+	s.mu.Unlock()
+}
+
+func (s *benchState) confirm(hashes []string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, hash := range hashes {
+		if sendAt, ok := s.pending[hash]; ok {
+			delete(s.pending, hash)
+			s.latencies = append(s.latencies, at.Sub(sendAt))
+			s.lastConf = at
+		}
+	}
+}
+
+func (s *benchState) pendingCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.pending)
+}
+
+func benchTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	key, _ := cmd.Flags().GetString("key")
+	addr, _ := cmd.Flags().GetString("addr")
+	tps, _ := cmd.Flags().GetInt("tps")
+	duration, _ := cmd.Flags().GetDuration("duration")
+	payloadSize, _ := cmd.Flags().GetInt("payload-size")
+	workers, _ := cmd.Flags().GetInt("workers")
+	wait, _ := cmd.Flags().GetDuration("wait")
+	execer, _ := cmd.Flags().GetString("exec")
+	if tps <= 0 || workers <= 0 || duration <= 0 || payloadSize < 0 {
+		fmt.Fprintln(os.Stderr, "tps, workers and duration should be positive")
+		return
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv, err := benchPrivKey(rpc, key, addr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var last rpctypes.Header
+	if err := rpc.Call("Chain33.GetLastHeader", nil, &last); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	state := &benchState{pending: make(map[string]time.Time)}
+	start := time.Now()
+	done := make(chan struct{})
+	watched := make(chan struct{})
+	go func() {
+		benchWatch(rpc, state, last.Height+1, done)
+		close(watched)
+	}()
+	//按照tps产生发送任务，所有worker都在忙的时候丢弃任务，统计为skipped
+	jobs := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				benchSend(rpc, state, priv, execer, payloadSize, duration+wait)
+			}
+		}()
+	}
+	ticker := time.NewTicker(time.Second / time.Duration(tps))
+	report := time.NewTicker(time.Second)
+	deadline := time.After(duration)
+sending:
+	for {
+		select {
+		case <-ticker.C:
+			select {
+			case jobs <- struct{}{}:
+			default:
+				atomic.AddInt64(&state.skipped, 1)
+			}
+		case <-report.C:
+			benchProgress(state, start)
+		case <-deadline:
+			break sending
+		}
+	}
+	ticker.Stop()
+	close(jobs)
+	wg.Wait()
+	sendTime := time.Since(start)
+	for waitEnd := time.Now().Add(wait); state.pendingCount() > 0 && time.Now().Before(waitEnd); {
+		<-report.C
+		benchProgress(state, start)
+	}
+	report.Stop()
+	close(done)
+	<-watched
+	printBenchResult(newBenchResult(state, start, sendTime))
+}
+
+func benchPrivKey(rpc *jsonclient.JSONClient, key, addr string) (crypto.PrivKey, error) {
+	if key == "" {
+		if addr == "" {
+			return nil, fmt.Errorf("key or addr is required")
+		}
+		var res types.ReplyString
+		if err := rpc.Call("Chain33.DumpPrivkey", types.ReqString{Data: addr}, &res); err != nil {
+			return nil, err
+		}
+		key = res.Data
+	}
+	c, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	return c.PrivKeyFromBytes(keyBytes)
+}
+
+//benchSend 每笔交易的nonce和payload都是随机的，交易的hash不会重复
+func benchSend(rpc *jsonclient.JSONClient, state *benchState, priv crypto.PrivKey, execer string, payloadSize int, expire time.Duration) {
+	payload := make([]byte, payloadSize)
+	rand.Read(payload)
+	tx := &types.Transaction{Payload: payload, To: address.ExecAddress(execer)}
+	tx, err := types.FormatTx(execer, tx)
+	if err == nil {
+		tx.SetExpire(expire + 2*time.Minute)
+		tx.Sign(types.SECP256K1, priv)
+		//发送之前记录，节点可能在rpc返回之前已经打包了交易
+		hash := common.ToHex(tx.Hash())
+		state.add(hash, time.Now())
+		var reply string
+		err = rpc.Call("Chain33.SendTransaction", rpctypes.RawParm{Data: common.ToHex(types.Encode(tx))}, &reply)
+		if err == nil {
+			atomic.AddInt64(&state.sent, 1)
+			return
+		}
+		state.remove(hash)
+	}
+	atomic.AddInt64(&state.failed, 1)
+	//节点拒绝交易的原因一般都相同，只输出第一个错误
+	state.errOnce.Do(func() { fmt.Fprintln(os.Stderr, "send tx:", err) })
+}
+
+//benchWatch 轮询新的区块，交易被打包的时间按发现区块的时间计算，同时记录mempool中的交易数
+func benchWatch(rpc *jsonclient.JSONClient, state *benchState, next int64, done chan struct{}) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		var last rpctypes.Header
+		if err := rpc.Call("Chain33.GetLastHeader", nil, &last); err != nil {
+			continue
+		}
+		for next <= last.Height {
+			end := next + watchBatch - 1
+			if end > last.Height {
+				end = last.Height
+			}
+			var blocks rpctypes.BlockDetails
+			if err := rpc.Call("Chain33.GetBlocks", rpctypes.BlockParam{Start: next, End: end}, &blocks); err != nil {
+				break
+			}
+			now := time.Now()
+			for _, item := range blocks.Items {
+				var hashes []string
+				for _, tx := range item.Block.Txs {
+					hashes = append(hashes, tx.Hash)
+				}
+				state.confirm(hashes, now)
+			}
+			atomic.AddInt64(&state.blocks, int64(len(blocks.Items)))
+			next = end + 1
+		}
+		var mempool rpctypes.ReplyTxList
+		if err := rpc.Call("Chain33.GetMempool", nil, &mempool); err == nil {
+			state.mu.Lock()
+			state.mempool = len(mempool.Txs)
+			if state.mempool > state.maxPool {
+				state.maxPool = state.mempool
+			}
+			state.mu.Unlock()
+		}
+	}
+}
+
+func benchProgress(state *benchState, start time.Time) {
+	state.mu.Lock()
+	confirmed, pending, mempool := len(state.latencies), len(state.pending), state.mempool
+	state.mu.Unlock()
+	fmt.Fprintf(os.Stderr, "%s sent=%d failed=%d skipped=%d confirmed=%d pending=%d mempool=%d\n",
+		time.Since(start).Round(time.Second), atomic.LoadInt64(&state.sent), atomic.LoadInt64(&state.failed),
+		atomic.LoadInt64(&state.skipped), confirmed, pending, mempool)
+}
+
+func newBenchResult(state *benchState, start time.Time, sendTime time.Duration) *commandtypes.BenchTxResult {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	res := &commandtypes.BenchTxResult{
+		Sent:        state.sent,
+		Failed:      state.failed,
+		Skipped:     state.skipped,
+		Confirmed:   int64(len(state.latencies)),
+		Unconfirmed: int64(len(state.pending)),
+		Blocks:      state.blocks,
+		SendSeconds: sendTime.Seconds(),
+		MaxMempool:  state.maxPool,
+		LastMempool: state.mempool,
+	}
+	if sendTime > 0 {
+		res.SendTPS = float64(res.Sent) / sendTime.Seconds()
+	}
+	if res.Confirmed > 0 {
+		res.ConfirmTPS = float64(res.Confirmed) / state.lastConf.Sub(start).Seconds()
+		sort.Slice(state.latencies, func(i, j int) bool { return state.latencies[i] < state.latencies[j] })
+		res.LatencyP50 = latencyPercentile(state.latencies, 50)
+		res.LatencyP90 = latencyPercentile(state.latencies, 90)
+		res.LatencyP99 = latencyPercentile(state.latencies, 99)
+		res.LatencyMax = state.latencies[len(state.latencies)-1].Seconds()
+	}
+	return res
+}
+
+//latencyPercentile sorted已经从小到大排序，返回秒数
+func latencyPercentile(sorted []time.Duration, p int) float64 {
+	i := (len(sorted)*p+99)/100 - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i].Seconds()
+}
+
+func printBenchResult(res *commandtypes.BenchTxResult) {
+	printOutput(res, func(w io.Writer) {
+		fmt.Fprintf(w, "Sent:\t%d (failed %d, skipped %d)\n", res.Sent, res.Failed, res.Skipped)
+		fmt.Fprintf(w, "Confirmed:\t%d (unconfirmed %d, in %d blocks)\n", res.Confirmed, res.Unconfirmed, res.Blocks)
+		fmt.Fprintf(w, "SendTPS:\t%.2f\n", res.SendTPS)
+		fmt.Fprintf(w, "ConfirmTPS:\t%.2f\n", res.ConfirmTPS)
+		fmt.Fprintf(w, "Latency:\tp50 %.3fs, p90 %.3fs, p99 %.3fs, max %.3fs\n", res.LatencyP50, res.LatencyP90, res.LatencyP99, res.LatencyMax)
+		fmt.Fprintf(w, "Mempool:\tmax %d, last %d\n", res.MaxMempool, res.LastMempool)
+	})
+}
@@ -6,6 +6,7 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -27,6 +28,8 @@ func BlockCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBlocksCmd(),
+		GetBlockHeaderCmd(),
+		GetBlockTxsCmd(),
 		GetBlockHashCmd(),
 		GetBlockOverviewCmd(),
 		GetHeadersCmd(),
@@ -82,7 +85,16 @@ func blockBodyCmd(cmd *cobra.Command, args []string) {
 	var res rpctypes.BlockDetails
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetBlocks", params, &res)
 	ctx.SetResultCb(parseBlockDetail)
-	ctx.Run()
+	result, err := ctx.RunResult()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(result, func(w io.Writer) {
+		for _, item := range res.Items {
+			renderBlock(w, item)
+		}
+	})
 }
 
 func parseBlockDetail(res interface{}) (interface{}, error) {
@@ -105,6 +117,107 @@ func parseBlockDetail(res interface{}) (interface{}, error) {
 	return result, nil
 }
 
+// GetBlockHeaderCmd get header of a block by height or hash
+func GetBlockHeaderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "header",
+		Short: "Get block header by height or hash, last header by default",
+		Run:   blockHeaderByHeightOrHash,
+	}
+	addBlockHeightOrHashFlags(cmd)
+	return cmd
+}
+
+func addBlockHeightOrHashFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64P("height", "t", -1, "block height, -1 for the last block")
+	cmd.Flags().StringP("hash", "s", "", "block hash, height is ignored if set")
+}
+
+//getBlockHeader hash优先，没有指定高度的时候返回最新的区块头
+func getBlockHeader(rpcLaddr string, height int64, hash string) (*rpctypes.Header, error) {
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	if hash != "" {
+		var res rpctypes.BlockOverview
+		if err := rpc.Call("Chain33.GetBlockOverview", rpctypes.QueryParm{Hash: hash}, &res); err != nil {
+			return nil, err
+		}
+		return res.Head, nil
+	}
+	if height < 0 {
+		var res rpctypes.Header
+		if err := rpc.Call("Chain33.GetLastHeader", nil, &res); err != nil {
+			return nil, err
+		}
+		return &res, nil
+	}
+	var res rpctypes.Headers
+	if err := rpc.Call("Chain33.GetHeaders", types.ReqBlocks{Start: height, End: height}, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Items) == 0 {
+		return nil, types.ErrBlockNotFound
+	}
+	return res.Items[0], nil
+}
+
+func blockHeaderByHeightOrHash(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	hash, _ := cmd.Flags().GetString("hash")
+	header, err := getBlockHeader(rpcLaddr, height, hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(header, func(w io.Writer) {
+		renderHeader(w, header)
+	})
+}
+
+// GetBlockTxsCmd get transactions and receipts of a block
+func GetBlockTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "txs",
+		Short: "Get transactions of block by height or hash, last block by default",
+		Run:   blockTxs,
+	}
+	addBlockHeightOrHashFlags(cmd)
+	return cmd
+}
+
+func blockTxs(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	hash, _ := cmd.Flags().GetString("hash")
+	header, err := getBlockHeader(rpcLaddr, height, hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := rpctypes.BlockParam{Start: header.Height, End: header.Height, Isdetail: true}
+	var res rpctypes.BlockDetails
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetBlocks", params, &res)
+	result, err := ctx.RunResult()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if len(res.Items) == 0 || res.Items[0] == nil {
+		fmt.Fprintln(os.Stderr, types.ErrBlockNotFound)
+		return
+	}
+	item := res.Items[0]
+	printOutput(result, func(w io.Writer) {
+		fmt.Fprintf(w, "Height:\t%d\n", header.Height)
+		fmt.Fprintf(w, "Hash:\t%s\n", header.Hash)
+		fmt.Fprintf(w, "TxCount:\t%d\n\n", len(item.Block.Txs))
+		renderTxTable(w, item.Block.Txs, item.Receipts)
+	})
+}
+
 // GetBlockHashCmd get hash of a block
 func GetBlockHashCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,241 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	"github.com/spf13/cobra"
+)
+
+// ChainCmd chain command
+func ChainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain",
+		Short: "Chain overview",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		ChainStatusCmd(),
+		ChainWatchCmd(),
+		ChainDumpCmd(),
+		ChainRestoreCmd(),
+	)
+
+	return cmd
+}
+
+// ChainStatusCmd get status of node and chain
+func ChainStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Get last block, sync, network and mempool status of node",
+		Run:   chainStatus,
+	}
+	return cmd
+}
+
+func chainStatus(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	status, err := getChainStatus(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(status, func(w io.Writer) {
+		h := status.LastHeader
+		fmt.Fprintf(w, "Title:\t%s\n", status.Version.GetTitle())
+		fmt.Fprintf(w, "Version:\t%s (chain33 %s, localdb %s)\n", status.Version.GetApp(),
+			status.Version.GetChain33(), status.Version.GetLocalDb())
+		fmt.Fprintf(w, "Height:\t%d\n", h.Height)
+		fmt.Fprintf(w, "Hash:\t%s\n", h.Hash)
+		fmt.Fprintf(w, "BlockTime:\t%s (%s ago)\n", formatBlockTime(h.BlockTime),
+			time.Since(time.Unix(h.BlockTime, 0)).Round(time.Second))
+		fmt.Fprintf(w, "Synced:\t%t\n", status.IsSync)
+		fmt.Fprintf(w, "NtpClockSynced:\t%t\n", status.IsNtpClockSync)
+		fmt.Fprintf(w, "Peers:\t%d outbound, %d inbound\n", status.NetInfo.Outbounds, status.NetInfo.Inbounds)
+		fmt.Fprintf(w, "ExternalAddr:\t%s\n", status.NetInfo.Externaladdr)
+		fmt.Fprintf(w, "MempoolTxs:\t%d\n", status.MempoolSize)
+	})
+}
+
+func getChainStatus(rpcLaddr string) (*commandtypes.ChainStatusResult, error) {
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	status := &commandtypes.ChainStatusResult{
+		Version:    &types.VersionInfo{},
+		LastHeader: &rpctypes.Header{},
+		NetInfo:    &rpctypes.NodeNetinfo{},
+	}
+	if err := rpc.Call("Chain33.Version", nil, status.Version); err != nil {
+		return nil, err
+	}
+	if err := rpc.Call("Chain33.GetLastHeader", nil, status.LastHeader); err != nil {
+		return nil, err
+	}
+	if err := rpc.Call("Chain33.IsSync", nil, &status.IsSync); err != nil {
+		return nil, err
+	}
+	if err := rpc.Call("Chain33.IsNtpClockSync", nil, &status.IsNtpClockSync); err != nil {
+		return nil, err
+	}
+	if err := rpc.Call("Chain33.GetNetInfo", nil, status.NetInfo); err != nil {
+		return nil, err
+	}
+	var mempool rpctypes.ReplyTxList
+	if err := rpc.Call("Chain33.GetMempool", nil, &mempool); err != nil {
+		return nil, err
+	}
+	status.MempoolSize = len(mempool.Txs)
+	return status, nil
+}
+
+//watchBatch 每次最多从节点获取的区块数，落后很多的时候分批追上
+const watchBatch = 100
+
+// ChainWatchCmd print new blocks one line per block
+func ChainWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch new blocks, optionally only blocks with txs of address or execer",
+		Run:   chainWatch,
+	}
+	cmd.Flags().StringP("addr", "a", "", "only show blocks with txs from or to the address")
+	cmd.Flags().StringP("exec", "e", "", "only show blocks with txs of the execer")
+	cmd.Flags().Int64P("start", "s", -1, "start height, -1 for the next block")
+	cmd.Flags().Int64P("interval", "i", 1000, "interval in milliseconds to poll the last block")
+	return cmd
+}
+
+//watchFilter addr和exec都为空的时候所有交易都匹配
+type watchFilter struct {
+	addr string
+	exec string
+}
+
+func (f *watchFilter) empty() bool {
+	return f.addr == "" && f.exec == ""
+}
+
+func (f *watchFilter) match(tx *rpctypes.Transaction) bool {
+	if f.addr != "" && tx.From != f.addr && tx.To != f.addr {
+		return false
+	}
+	if f.exec != "" && string(types.GetRealExecName([]byte(tx.Execer))) != f.exec {
+		return false
+	}
+	return true
+}
+
+//chainWatch 节点没有推送接口，按间隔轮询最新的区块头，每个新区块输出一行，节点回滚以后从回滚的高度重新输出
+func chainWatch(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	execer, _ := cmd.Flags().GetString("exec")
+	next, _ := cmd.Flags().GetInt64("start")
+	interval, _ := cmd.Flags().GetInt64("interval")
+	if interval <= 0 {
+		interval = 1000
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	filter := &watchFilter{addr: addr, exec: execer}
+	for ; ; time.Sleep(time.Duration(interval) * time.Millisecond) {
+		var last rpctypes.Header
+		if err := rpc.Call("Chain33.GetLastHeader", nil, &last); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+		if next < 0 || next > last.Height+1 {
+			next = last.Height + 1
+		}
+		for next <= last.Height {
+			end := next + watchBatch - 1
+			if end > last.Height {
+				end = last.Height
+			}
+			if err := watchBlocks(rpc, filter, next, end); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				break
+			}
+			next = end + 1
+		}
+	}
+}
+
+func watchBlocks(rpc *jsonclient.JSONClient, filter *watchFilter, start, end int64) error {
+	var headers rpctypes.Headers
+	if err := rpc.Call("Chain33.GetHeaders", types.ReqBlocks{Start: start, End: end}, &headers); err != nil {
+		return err
+	}
+	var blocks rpctypes.BlockDetails
+	if !filter.empty() {
+		params := rpctypes.BlockParam{Start: start, End: end}
+		if err := rpc.Call("Chain33.GetBlocks", params, &blocks); err != nil {
+			return err
+		}
+		if len(blocks.Items) != len(headers.Items) {
+			return types.ErrBlockNotFound
+		}
+	}
+	for i, h := range headers.Items {
+		line := &commandtypes.WatchBlockResult{
+			Height:    h.Height,
+			Hash:      h.Hash,
+			BlockTime: h.BlockTime,
+			TxCount:   h.TxCount,
+		}
+		if !filter.empty() {
+			for _, tx := range blocks.Items[i].Block.Txs {
+				if filter.match(tx) {
+					line.Matched = append(line.Matched, tx.Hash)
+				}
+			}
+			if len(line.Matched) == 0 {
+				continue
+			}
+		}
+		printWatchLine(line)
+	}
+	return nil
+}
+
+//printWatchLine json格式每行是一个区块的json，yaml格式每个区块是一个文档
+func printWatchLine(line *commandtypes.WatchBlockResult) {
+	switch jsonclient.GetOutputFormat() {
+	case jsonclient.OutputJSON:
+		data, err := json.Marshal(line)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		fmt.Println(string(data))
+		return
+	case jsonclient.OutputYAML:
+		fmt.Println("---")
+		jsonclient.PrintResult(line)
+		return
+	}
+	text := fmt.Sprintf("%s  height=%d  hash=%s  txs=%d", formatBlockTime(line.BlockTime), line.Height, line.Hash, line.TxCount)
+	if len(line.Matched) > 0 {
+		text += fmt.Sprintf("  matched=%d %s", len(line.Matched), strings.Join(line.Matched, ","))
+	}
+	fmt.Println(text)
+}
@@ -9,9 +9,15 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"math"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
 	"github.com/33cn/chain33/types"
 	"github.com/spf13/cobra"
@@ -26,9 +32,11 @@ func CoinsCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		CreateRawTransferCmd(),
+		CreateRawTransferBatchCmd(),
 		CreateRawWithdrawCmd(),
 		CreateRawSendToExecCmd(),
 		CreateTxGroupCmd(),
+		QueryTxsByMemoCmd(),
 	)
 	return cmd
 }
@@ -63,7 +71,92 @@ func createTransfer(cmd *cobra.Command, args []string) {
 		fmt.Fprintln(os.Stderr, err)
 		return
 	}
-	fmt.Println(txHex)
+	jsonclient.PrintString(txHex)
+}
+
+// CreateRawTransferBatchCmd create raw transfer batch tx
+func CreateRawTransferBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_batch",
+		Short: "Create a transaction transferring to multiple receivers atomically",
+		Run:   createTransferBatch,
+	}
+	addCreateTransferBatchFlags(cmd)
+	return cmd
+}
+
+func addCreateTransferBatchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("transfers", "t", "", "receiver:amount pairs, separated by space")
+	cmd.Flags().StringP("file", "f", "", "name of file which contains receiver:amount pairs, separated by new line")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+}
+
+//parseTransfers 解析receiver:amount格式的转账，文件中的空行忽略
+func parseTransfers(cmd *cobra.Command) ([]string, error) {
+	transfers, _ := cmd.Flags().GetString("transfers")
+	file, _ := cmd.Flags().GetString("file")
+	if transfers != "" {
+		return strings.Fields(transfers), nil
+	}
+	if file == "" {
+		return nil, fmt.Errorf("please input -t or -f; else, input -h to see help")
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var pairs []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			pairs = append(pairs, line)
+		}
+	}
+	return pairs, scanner.Err()
+}
+
+func createTransferBatch(cmd *cobra.Command, args []string) {
+	note, _ := cmd.Flags().GetString("note")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	pairs, err := parseTransfers(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	batch := &cty.CoinsTransferBatch{}
+	for _, pair := range pairs {
+		fields := strings.Split(pair, ":")
+		if len(fields) != 2 {
+			fmt.Fprintln(os.Stderr, "invalid transfer", pair)
+			return
+		}
+		if err := address.CheckAddress(fields[0]); err != nil {
+			fmt.Fprintln(os.Stderr, types.ErrInvalidAddress, fields[0])
+			return
+		}
+		amount, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		amountInt64 := int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4
+		batch.Transfers = append(batch.Transfers, &types.AssetsTransfer{Amount: amountInt64, Note: []byte(note), To: fields[0]})
+	}
+	if len(batch.Transfers) > cty.MaxTransferBatch() {
+		fmt.Fprintln(os.Stderr, cty.ErrTransferBatchSize)
+		return
+	}
+	action := &cty.CoinsAction{Ty: cty.CoinsActionTransferBatch, Value: &cty.CoinsAction_TransferBatch{TransferBatch: batch}}
+	execer := getRealExecName(paraName, cty.CoinsX)
+	tx := &types.Transaction{Execer: []byte(execer), Payload: types.Encode(action), To: address.ExecAddress(execer)}
+	tx, err = types.FormatTx(execer, tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	jsonclient.PrintString(hex.EncodeToString(types.Encode(tx)))
 }
 
 // CreateRawWithdrawCmd  create raw withdraw tx
@@ -103,7 +196,7 @@ func createWithdraw(cmd *cobra.Command, args []string) {
 		fmt.Fprintln(os.Stderr, err)
 		return
 	}
-	fmt.Println(txHex)
+	jsonclient.PrintString(txHex)
 }
 
 // CreateRawSendToExecCmd  create send to exec
@@ -143,7 +236,7 @@ func sendToExec(cmd *cobra.Command, args []string) {
 		fmt.Fprintln(os.Stderr, err)
 		return
 	}
-	fmt.Println(txHex)
+	jsonclient.PrintString(txHex)
 }
 
 // CreateTxGroupCmd create tx group
@@ -222,5 +315,53 @@ func createTxGroup(cmd *cobra.Command, args []string) {
 	}
 	newtx := group.Tx()
 	grouptx := hex.EncodeToString(types.Encode(newtx))
-	fmt.Println(grouptx)
+	jsonclient.PrintString(grouptx)
+}
+
+// QueryTxsByMemoCmd query transfers by receiver and memo
+func QueryTxsByMemoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "memo_txs",
+		Short: "Query transfers to an address with the given note",
+		Run:   queryTxsByMemo,
+	}
+	addQueryTxsByMemoFlags(cmd)
+	return cmd
+}
+
+func addQueryTxsByMemoFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("to", "t", "", "receiver account address")
+	cmd.MarkFlagRequired("to")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+	cmd.MarkFlagRequired("note")
+
+	cmd.Flags().StringP("primary", "p", "", "primary key of the last record in previous page")
+	cmd.Flags().Int32P("count", "c", cty.DefaultMemoCount, "query count")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0: desc, 1: asc")
+}
+
+func queryTxsByMemo(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	to, _ := cmd.Flags().GetString("to")
+	note, _ := cmd.Flags().GetString("note")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	req := &cty.ReqCoinsMemo{
+		To:         to,
+		Memo:       note,
+		PrimaryKey: primary,
+		Count:      count,
+		Direction:  direction,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = types.ExecName(cty.CoinsX)
+	params.FuncName = cty.FuncNameGetTxsByMemo
+	params.Payload = types.MustPBToJSON(req)
+
+	var res cty.ReplyCoinsMemo
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
 }
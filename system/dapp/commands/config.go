@@ -0,0 +1,426 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	tml "github.com/BurntSushi/toml"
+	"github.com/spf13/cobra"
+)
+
+// ConfigCmd config command
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Node configuration",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		ConfigInitCmd(),
+	)
+
+	return cmd
+}
+
+//节点配置可选的网络、共识和裁剪模式
+var (
+	configNetworks  = []string{"mainnet", "testnet", "local"}
+	configConsensus = []string{"solo", "raft", "tendermint", "pbft", "dpos"}
+	configPrunings  = []string{"archive", "prune", "checkpoint"}
+)
+
+// ConfigInitCmd generate node config file
+func ConfigInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Generate a node config file, interactively with -i",
+		Run:   configInit,
+	}
+	addConfigInitFlags(cmd)
+	return cmd
+}
+
+func addConfigInitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolP("interactive", "i", false, "ask for every option, the flag value is the default answer")
+	cmd.Flags().StringP("file", "f", "chain33.toml", "config file to write")
+	cmd.Flags().Bool("force", false, "overwrite the config file if it exists")
+	cmd.Flags().StringP("network", "n", "testnet", "network, "+strings.Join(configNetworks, "/"))
+	cmd.Flags().String("title", "chain33", "chain title, local network always uses local")
+	cmd.Flags().StringP("datadir", "d", "datadir", "data directory of blockchain, state and wallet")
+	cmd.Flags().StringP("consensus", "c", "solo", "consensus, "+strings.Join(configConsensus, "/"))
+	cmd.Flags().String("genesis", "14KEKbYtKKQm4wMthSK9J4La4nAiidGozt", "genesis address")
+	cmd.Flags().String("rpc_bind", "localhost:8801", "jsonrpc bind address")
+	cmd.Flags().String("grpc_bind", "localhost:8802", "grpc bind address")
+	cmd.Flags().String("rpc_whitelist", "127.0.0.1", "ips allowed to access rpc, separated by comma, * for all")
+	cmd.Flags().String("seeds", "", "p2p seeds ip:port, separated by comma")
+	cmd.Flags().Int32("p2p_port", 13802, "p2p listen port")
+	cmd.Flags().StringP("pruning", "p", "archive", "state pruning mode, "+strings.Join(configPrunings, "/"))
+	cmd.Flags().Int64("prune_height", 10000, "prune the state older than this many blocks")
+	cmd.Flags().Int64("prune_checkpoint", 100000, "keep the state at heights of this interval in checkpoint mode")
+}
+
+//nodeConfig 生成配置文件需要的选项
+type nodeConfig struct {
+	Network         string
+	Title           string
+	TestNet         bool
+	DataDir         string
+	Consensus       string
+	Genesis         string
+	RPCBind         string
+	GRPCBind        string
+	RPCWhitelist    []string
+	Seeds           []string
+	P2PPort         int32
+	P2PEnable       bool
+	SingleMode      bool
+	Pruning         string
+	PruneHeight     int64
+	PruneCheckpoint int64
+}
+
+func configInit(cmd *cobra.Command, args []string) {
+	file, _ := cmd.Flags().GetString("file")
+	force, _ := cmd.Flags().GetBool("force")
+	interactive, _ := cmd.Flags().GetBool("interactive")
+	in := bufio.NewReader(os.Stdin)
+	ask := func(name string) string {
+		value := cmd.Flags().Lookup(name).Value.String()
+		if interactive {
+			return prompt(in, name, value)
+		}
+		return value
+	}
+	conf, err := readNodeConfig(ask)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := renderNodeConfig(conf)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if _, err := os.Stat(file); err == nil && !force {
+		fmt.Fprintln(os.Stderr, file, "already exists, use --force to overwrite")
+		return
+	}
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println("config written to", file)
+}
+
+//prompt 回车使用默认值
+func prompt(in *bufio.Reader, name, def string) string {
+	fmt.Printf("%s [%s]: ", name, def)
+	line, err := in.ReadString('\n')
+	line = strings.TrimSpace(line)
+	if err != nil || line == "" {
+		return def
+	}
+	return line
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func readNodeConfig(ask func(name string) string) (*nodeConfig, error) {
+	conf := &nodeConfig{
+		Network:   ask("network"),
+		Title:     ask("title"),
+		DataDir:   filepath.ToSlash(ask("datadir")),
+		Consensus: ask("consensus"),
+		Genesis:   ask("genesis"),
+		RPCBind:   ask("rpc_bind"),
+		GRPCBind:  ask("grpc_bind"),
+		Pruning:   ask("pruning"),
+	}
+	conf.RPCWhitelist = splitList(ask("rpc_whitelist"))
+	if conf.Network != "local" {
+		conf.Seeds = splitList(ask("seeds"))
+		port, err := strconv.ParseInt(ask("p2p_port"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("p2p_port: %v", err)
+		}
+		conf.P2PPort = int32(port)
+	}
+	var err error
+	if conf.Pruning != "archive" {
+		if conf.PruneHeight, err = strconv.ParseInt(ask("prune_height"), 10, 64); err != nil {
+			return nil, fmt.Errorf("prune_height: %v", err)
+		}
+	}
+	if conf.Pruning == "checkpoint" {
+		if conf.PruneCheckpoint, err = strconv.ParseInt(ask("prune_checkpoint"), 10, 64); err != nil {
+			return nil, fmt.Errorf("prune_checkpoint: %v", err)
+		}
+	}
+	if err := conf.check(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func inList(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isLoopback(bind string) bool {
+	host, _, _ := net.SplitHostPort(bind)
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+//check 检查选项之间的组合，同时根据网络设置相关的选项
+func (conf *nodeConfig) check() error {
+	if !inList(configNetworks, conf.Network) {
+		return fmt.Errorf("network %s not in %v", conf.Network, configNetworks)
+	}
+	if !inList(configConsensus, conf.Consensus) {
+		return fmt.Errorf("consensus %s not in %v", conf.Consensus, configConsensus)
+	}
+	if !inList(configPrunings, conf.Pruning) {
+		return fmt.Errorf("pruning %s not in %v", conf.Pruning, configPrunings)
+	}
+	if conf.DataDir == "" {
+		return errors.New("datadir is empty")
+	}
+	if err := address.CheckAddress(conf.Genesis); err != nil {
+		return fmt.Errorf("genesis %s: %v", conf.Genesis, err)
+	}
+	switch conf.Network {
+	case "local":
+		//本地网络只有一个节点，不需要p2p
+		if conf.Consensus != "solo" {
+			return errors.New("local network only supports solo consensus")
+		}
+		conf.Title = "local"
+		conf.TestNet = true
+		conf.SingleMode = true
+	case "testnet":
+		conf.TestNet = true
+		conf.P2PEnable = true
+	case "mainnet":
+		if conf.Consensus == "solo" {
+			return errors.New("solo consensus is only for testing, choose another consensus for mainnet")
+		}
+		conf.P2PEnable = true
+	}
+	if conf.Title == "" || (conf.Title == "local" && conf.Network != "local") {
+		return fmt.Errorf("title %q is invalid for %s", conf.Title, conf.Network)
+	}
+	for _, bind := range []string{conf.RPCBind, conf.GRPCBind} {
+		if _, _, err := net.SplitHostPort(bind); err != nil {
+			return fmt.Errorf("rpc bind %s: %v", bind, err)
+		}
+	}
+	if conf.RPCBind == conf.GRPCBind {
+		return errors.New("rpc_bind and grpc_bind are the same address")
+	}
+	if len(conf.RPCWhitelist) == 0 {
+		return errors.New("rpc_whitelist is empty")
+	}
+	//对外开放的rpc允许所有ip访问的时候，任何人都可以调用钱包的接口
+	if inList(conf.RPCWhitelist, "*") && (!isLoopback(conf.RPCBind) || !isLoopback(conf.GRPCBind)) {
+		return errors.New("rpc bound to a public address must not use whitelist *")
+	}
+	for _, seed := range conf.Seeds {
+		if _, _, err := net.SplitHostPort(seed); err != nil {
+			return fmt.Errorf("seed %s: %v", seed, err)
+		}
+	}
+	if conf.P2PEnable && (conf.P2PPort <= 0 || conf.P2PPort > 65535) {
+		return fmt.Errorf("p2p_port %d is invalid", conf.P2PPort)
+	}
+	if conf.Pruning != "archive" && conf.PruneHeight <= 0 {
+		return errors.New("prune_height must be positive")
+	}
+	if conf.Pruning == "checkpoint" && (conf.PruneCheckpoint <= 0 || conf.PruneCheckpoint%conf.PruneHeight != 0) {
+		return errors.New("prune_checkpoint must be a positive multiple of prune_height")
+	}
+	return nil
+}
+
+//renderNodeConfig 生成的配置必须能被节点解析
+func renderNodeConfig(conf *nodeConfig) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := nodeConfigTemplate.Execute(buf, conf); err != nil {
+		return nil, err
+	}
+	var cfg types.Config
+	if _, err := tml.Decode(buf.String(), &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Title != conf.Title || cfg.Consensus == nil || cfg.Consensus.Name != conf.Consensus {
+		return nil, errors.New("generated config mismatch")
+	}
+	return buf.Bytes(), nil
+}
+
+var nodeConfigTemplate = template.Must(template.New("config").Funcs(template.FuncMap{
+	"list": func(items []string) string {
+		quoted := make([]string, len(items))
+		for i, item := range items {
+			quoted[i] = strconv.Quote(item)
+		}
+		return "[" + strings.Join(quoted, ",") + "]"
+	},
+}).Parse(`# 由 cli config init 生成，网络: {{.Network}}
+Title="{{.Title}}"
+TestNet={{.TestNet}}
+FixTime=false
+CoinSymbol="bty"
+
+[log]
+loglevel = "info"
+logConsoleLevel = "info"
+logFile = "logs/chain33.log"
+maxFileSize = 300
+maxBackups = 100
+maxAge = 28
+localTime = true
+compress = true
+
+[blockchain]
+defCacheSize=128
+maxFetchBlockNum=128
+timeoutSeconds=5
+batchBlockNum=128
+driver="leveldb"
+dbPath="{{.DataDir}}"
+dbCache=64
+isStrongConsistency=false
+# 是否为单节点
+singleMode={{.SingleMode}}
+batchsync=false
+isRecordBlockSequence=true
+isParaChain=false
+enableTxQuickIndex=false
+
+[p2p]
+# 是否启动P2P服务
+enable={{.P2PEnable}}
+port={{.P2PPort}}
+seeds={{list .Seeds}}
+isSeed=false
+serverStart=true
+innerSeedEnable={{.P2PEnable}}
+useGithub={{.P2PEnable}}
+innerBounds=300
+msgCacheSize=10240
+driver="leveldb"
+dbPath="{{.DataDir}}/addrbook"
+dbCache=4
+grpcLogFile="grpc33.log"
+
+[rpc]
+jrpcBindAddr="{{.RPCBind}}"
+grpcBindAddr="{{.GRPCBind}}"
+# 白名单列表，允许访问的IP地址，“*”允许所有IP访问
+whitelist={{list .RPCWhitelist}}
+jrpcFuncWhitelist=["*"]
+grpcFuncWhitelist=["*"]
+enableTLS=false
+
+[mempool]
+name="timeline"
+poolCacheSize=10240
+minTxFee=100000
+maxTxNumPerAccount=100
+maxTxFee=1000000000
+
+[consensus]
+name="{{.Consensus}}"
+minerstart=true
+genesisBlockTime=1514533394
+genesis="{{.Genesis}}"
+
+[consensus.sub.{{.Consensus}}]
+genesis="{{.Genesis}}"
+genesisBlockTime=1514533394
+{{- if eq .Consensus "solo"}}
+hotkeyAddr="{{.Genesis}}"
+waitTxMs=10
+{{- else if eq .Consensus "raft"}}
+# 成员节点的公钥，所有节点配置相同
+members=[]
+# 本节点的私钥，为空表示只跟随leader
+privKey=""
+{{- else if eq .Consensus "dpos"}}
+delegateNum=21
+blockInterval=3
+# 本节点受托人的私钥，为空表示不出块
+privKey=""
+bootstrapDelegates=["{{.Genesis}}"]
+{{- else}}
+# 验证节点的公钥，所有节点配置相同
+validators=[]
+# 本节点验证节点的私钥，为空表示只跟随共识
+privKey=""
+{{- end}}
+
+[store]
+name="mavl"
+driver="leveldb"
+dbPath="{{.DataDir}}/mavltree"
+dbCache=128
+localdbVersion="1.0.0"
+storedbVersion="1.0.0"
+
+[store.sub.mavl]
+enableMavlPrefix=false
+enableMVCC=false
+# 裁剪模式: {{.Pruning}}
+enableMavlPrune={{ne .Pruning "archive"}}
+pruneHeight={{if .PruneHeight}}{{.PruneHeight}}{{else}}10000{{end}}
+pruneCheckpoint={{.PruneCheckpoint}}
+enableMemTree=false
+enableMemVal=false
+
+[wallet]
+minFee=100000
+driver="leveldb"
+dbPath="{{.DataDir}}/wallet"
+dbCache=16
+signType="secp256k1"
+
+[exec]
+isFree=false
+minExecFee=100000
+maxExecFee=1000000000
+enableStat=false
+enableMVCC=false
+`))
@@ -0,0 +1,454 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	"github.com/spf13/cobra"
+)
+
+//dumpMagic 导出文件的头，后面是连续的区块，每个区块是4字节的长度加上序列化的区块
+var dumpMagic = []byte("chain33dump\x01")
+
+var (
+	errDumpFormat  = errors.New("not a chain dump file")
+	errDumpBroken  = errors.New("block hash does not match")
+	errDumpMissing = errors.New("blocks missing in dump file")
+)
+
+// ChainDumpCmd dump blocks to file
+func ChainDumpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Dump blocks to file, verified with block hashes of node",
+		Run:   chainDump,
+	}
+	cmd.Flags().StringP("file", "f", "", "dump file")
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().Int64P("start", "s", 0, "start height")
+	cmd.Flags().Int64P("end", "e", -1, "end height, -1 for the last block")
+	cmd.Flags().BoolP("resume", "r", false, "continue an interrupted dump from the last block in file")
+	return cmd
+}
+
+// ChainRestoreCmd restore blocks from dump file
+func ChainRestoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore blocks from dump file, blocks already on node are skipped",
+		Run:   chainRestore,
+	}
+	cmd.Flags().StringP("file", "f", "", "dump file")
+	cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+//blockHashMatch 命令行没有节点的fork配置，新旧两种hash有一个一致就认为是这个区块
+func blockHashMatch(block *types.Block, hash []byte) bool {
+	return bytes.Equal(block.HashNew(), hash) || bytes.Equal(block.HashOld(), hash)
+}
+
+//verifyDumpBlock 检查区块中的交易和区块头一致，并且和前一个区块连在一起
+func verifyDumpBlock(prev, block *types.Block) error {
+	if !bytes.Equal(merkle.CalcMerkleRoot(block.Txs), block.TxHash) {
+		return fmt.Errorf("block %d: tx hash does not match", block.Height)
+	}
+	if prev == nil {
+		return nil
+	}
+	if block.Height != prev.Height+1 {
+		return fmt.Errorf("block %d after block %d: %v", block.Height, prev.Height, errDumpMissing)
+	}
+	if !blockHashMatch(prev, block.ParentHash) {
+		return fmt.Errorf("block %d: parent hash does not match block %d", block.Height, prev.Height)
+	}
+	return nil
+}
+
+func writeDumpBlock(w io.Writer, block *types.Block) error {
+	data := types.Encode(block)
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(data)))
+	if _, err := w.Write(size[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+//readDumpBlock 文件正好结束返回io.EOF，最后一个区块没有写完整返回io.ErrUnexpectedEOF
+func readDumpBlock(r io.Reader) (*types.Block, int64, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, 0, err
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if int64(n) > int64(types.MaxBlockSize)*2 {
+		return nil, 0, errDumpFormat
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, 0, err
+	}
+	var block types.Block
+	if err := types.Decode(data, &block); err != nil {
+		return nil, 0, err
+	}
+	return &block, int64(len(size) + len(data)), nil
+}
+
+//scanDump 依次检查文件中的区块，fn返回错误的时候停止，返回完整区块的结束位置和最后一个区块
+func scanDump(r io.Reader, fn func(block *types.Block) error) (int64, *types.Block, error) {
+	magic := make([]byte, len(dumpMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, dumpMagic) {
+		return 0, nil, errDumpFormat
+	}
+	offset := int64(len(dumpMagic))
+	var prev *types.Block
+	for {
+		block, n, err := readDumpBlock(r)
+		if err != nil {
+			return offset, prev, err
+		}
+		if err := verifyDumpBlock(prev, block); err != nil {
+			return offset, prev, err
+		}
+		if fn != nil {
+			if err := fn(block); err != nil {
+				return offset, prev, err
+			}
+		}
+		offset += n
+		prev = block
+	}
+}
+
+//progressBar 在stderr上显示进度，不影响stdout的结果输出
+type progressBar struct {
+	name  string
+	total int64
+	done  int64
+	begin time.Time
+	drawn time.Time
+}
+
+func newProgressBar(name string, total int64) *progressBar {
+	return &progressBar{name: name, total: total, begin: time.Now()}
+}
+
+func (p *progressBar) add(n int64) {
+	p.done += n
+	//逐个导入区块的时候不用每个区块都刷新一次
+	if p.done < p.total && time.Since(p.drawn) < 200*time.Millisecond {
+		return
+	}
+	p.drawn = time.Now()
+	const width = 40
+	percent := float64(1)
+	if p.total > 0 {
+		percent = float64(p.done) / float64(p.total)
+	}
+	filled := int(percent * width)
+	rate := float64(p.done) / time.Since(p.begin).Seconds()
+	fmt.Fprintf(os.Stderr, "\r%s [%s%s] %3.0f%% %d/%d blocks %.1f blocks/s", p.name,
+		strings.Repeat("=", filled), strings.Repeat(" ", width-filled), percent*100, p.done, p.total, rate)
+}
+
+func (p *progressBar) finish() {
+	fmt.Fprintln(os.Stderr)
+}
+
+func getNodeBlockHash(rpc *jsonclient.JSONClient, height int64) ([]byte, error) {
+	var reply rpctypes.ReplyHash
+	if err := rpc.Call("Chain33.GetBlockHash", types.ReqInt{Height: height}, &reply); err != nil {
+		return nil, err
+	}
+	return common.FromHex(reply.Hash)
+}
+
+//openDump 续传的时候去掉最后没有写完整的区块，从文件中最后一个区块的下一个高度开始导出
+func openDump(file string, resume bool) (*os.File, *types.Block, error) {
+	if !resume {
+		f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if os.IsExist(err) {
+			return nil, nil, fmt.Errorf("%s already exists, use --resume to continue the dump", file)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := f.Write(dumpMagic); err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return f, nil, nil
+	}
+	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if info.Size() == 0 {
+		if _, err := f.Write(dumpMagic); err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return f, nil, nil
+	}
+	offset, last, err := scanDump(bufio.NewReader(f), nil)
+	if err != io.EOF && err != io.ErrUnexpectedEOF {
+		f.Close()
+		return nil, nil, err
+	}
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return f, last, nil
+}
+
+func chainDump(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	file, _ := cmd.Flags().GetString("file")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	resume, _ := cmd.Flags().GetBool("resume")
+	result, err := dumpBlocks(rpcLaddr, file, start, end, resume)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(result, func(w io.Writer) {
+		fmt.Fprintf(w, "File:\t%s\n", result.File)
+		fmt.Fprintf(w, "Heights:\t%d - %d\n", result.Start, result.End)
+		fmt.Fprintf(w, "Dumped:\t%d\n", result.Dumped)
+		fmt.Fprintf(w, "Resumed:\t%t\n", result.Resumed)
+	})
+}
+
+func dumpBlocks(rpcLaddr, file string, start, end int64, resume bool) (*commandtypes.ChainDumpResult, error) {
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	var last rpctypes.Header
+	if err := rpc.Call("Chain33.GetLastHeader", nil, &last); err != nil {
+		return nil, err
+	}
+	if end < 0 || end > last.Height {
+		end = last.Height
+	}
+	if start < 0 || start > end {
+		return nil, types.ErrInvalidParam
+	}
+	f, prev, err := openDump(file, resume)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	result := &commandtypes.ChainDumpResult{File: file, Start: start, End: end}
+	next := start
+	if prev != nil {
+		//文件中已有的区块必须还在节点的链上，否则节点已经回滚，续传的区块连不上
+		hash, err := getNodeBlockHash(rpc, prev.Height)
+		if err != nil {
+			return nil, err
+		}
+		if !blockHashMatch(prev, hash) {
+			return nil, fmt.Errorf("block %d in file is not on the chain of node: %v", prev.Height, errDumpBroken)
+		}
+		result.Resumed = true
+		next = prev.Height + 1
+	}
+	w := bufio.NewWriter(f)
+	bar := newProgressBar("dump", end-next+1)
+	defer bar.finish()
+	for next <= end {
+		batchEnd := next + watchBatch - 1
+		if batchEnd > end {
+			batchEnd = end
+		}
+		blocks, err := exportBlocks(rpc, next, batchEnd)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range blocks {
+			if err := verifyDumpBlock(prev, block); err != nil {
+				return nil, err
+			}
+			if err := writeDumpBlock(w, block); err != nil {
+				return nil, err
+			}
+			prev = block
+		}
+		//每批写完再flush，中断的时候最多丢掉最后没有写完整的区块
+		if err := w.Flush(); err != nil {
+			return nil, err
+		}
+		result.Dumped += int64(len(blocks))
+		bar.add(int64(len(blocks)))
+		next = batchEnd + 1
+	}
+	return result, nil
+}
+
+//exportBlocks 导出的区块和节点的区块头的hash比较，保证导出的区块是节点链上的区块
+func exportBlocks(rpc *jsonclient.JSONClient, start, end int64) ([]*types.Block, error) {
+	var raw rpctypes.ReplyRawBlocks
+	if err := rpc.Call("Chain33.ExportBlocks", rpctypes.BlockParam{Start: start, End: end}, &raw); err != nil {
+		return nil, err
+	}
+	var headers rpctypes.Headers
+	if err := rpc.Call("Chain33.GetHeaders", types.ReqBlocks{Start: start, End: end}, &headers); err != nil {
+		return nil, err
+	}
+	if len(raw.Blocks) != int(end-start+1) || len(headers.Items) != len(raw.Blocks) {
+		return nil, types.ErrBlockNotFound
+	}
+	blocks := make([]*types.Block, len(raw.Blocks))
+	for i, item := range raw.Blocks {
+		data, err := common.FromHex(item)
+		if err != nil {
+			return nil, err
+		}
+		var block types.Block
+		if err := types.Decode(data, &block); err != nil {
+			return nil, err
+		}
+		hash, err := common.FromHex(headers.Items[i].Hash)
+		if err != nil {
+			return nil, err
+		}
+		if block.Height != start+int64(i) || !blockHashMatch(&block, hash) {
+			return nil, fmt.Errorf("block %d: %v", start+int64(i), errDumpBroken)
+		}
+		blocks[i] = &block
+	}
+	return blocks, nil
+}
+
+func chainRestore(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	file, _ := cmd.Flags().GetString("file")
+	result, err := restoreBlocks(rpcLaddr, file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(result, func(w io.Writer) {
+		fmt.Fprintf(w, "File:\t%s\n", result.File)
+		fmt.Fprintf(w, "Heights:\t%d - %d\n", result.Start, result.End)
+		fmt.Fprintf(w, "Imported:\t%d\n", result.Imported)
+		fmt.Fprintf(w, "Skipped:\t%d\n", result.Skipped)
+		fmt.Fprintf(w, "Hash:\t%s\n", result.Hash)
+	})
+}
+
+//restoreBlocks 先检查整个文件，再从节点的下一个高度开始导入，中断以后重新执行就从中断的地方继续
+func restoreBlocks(rpcLaddr, file string) (*commandtypes.ChainRestoreResult, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var first *types.Block
+	_, last, err := scanDump(bufio.NewReader(f), func(block *types.Block) error {
+		if first == nil {
+			first = block
+		}
+		return nil
+	})
+	if err == io.ErrUnexpectedEOF {
+		return nil, fmt.Errorf("last block in file is incomplete, resume the dump first: %v", err)
+	}
+	if err != io.EOF {
+		return nil, err
+	}
+	if first == nil {
+		return nil, errDumpMissing
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	var header rpctypes.Header
+	if err := rpc.Call("Chain33.GetLastHeader", nil, &header); err != nil {
+		return nil, err
+	}
+	if first.Height > header.Height+1 {
+		return nil, fmt.Errorf("node height %d, file starts at %d: %v", header.Height, first.Height, errDumpMissing)
+	}
+	result := &commandtypes.ChainRestoreResult{File: file, Start: first.Height, End: last.Height}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	bar := newProgressBar("restore", last.Height-first.Height+1)
+	defer bar.finish()
+	_, _, err = scanDump(bufio.NewReader(f), func(block *types.Block) error {
+		defer bar.add(1)
+		if block.Height <= header.Height {
+			result.Skipped++
+			//节点上已有的区块只检查和节点链上的最后一个区块是否一致
+			if block.Height < header.Height && block.Height != last.Height {
+				return nil
+			}
+			hash, err := getNodeBlockHash(rpc, block.Height)
+			if err != nil {
+				return err
+			}
+			if !blockHashMatch(block, hash) {
+				return fmt.Errorf("block %d is different from the node: %v", block.Height, errDumpBroken)
+			}
+			return nil
+		}
+		var reply rpctypes.Reply
+		params := rpctypes.RawParm{Data: common.ToHex(types.Encode(block))}
+		if err := rpc.Call("Chain33.ImportBlock", params, &reply); err != nil {
+			return err
+		}
+		if !reply.IsOk && reply.Msg != types.ErrBlockExist.Error() {
+			return fmt.Errorf("import block %d: %s", block.Height, reply.Msg)
+		}
+		result.Imported++
+		return nil
+	})
+	if err != io.EOF {
+		return nil, err
+	}
+	hash, err := getNodeBlockHash(rpc, last.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !blockHashMatch(last, hash) {
+		return nil, fmt.Errorf("block %d on node after restore: %v", last.Height, errDumpBroken)
+	}
+	result.Hash = common.ToHex(hash)
+	return result, nil
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/rpc/jsonclient"
 	"github.com/33cn/chain33/types"
 	"github.com/spf13/cobra"
 )
@@ -52,12 +53,12 @@ func addGetAddrFlags(cmd *cobra.Command) {
 func getAddrByExec(cmd *cobra.Command, args []string) {
 	execer, _ := cmd.Flags().GetString("exec")
 	if ok := types.IsAllowExecName([]byte(execer), []byte(execer)); !ok {
-		fmt.Println(types.ErrExecNameNotAllow.Error())
+		fmt.Fprintln(os.Stderr, types.ErrExecNameNotAllow)
 		return
 	}
 	addrResult := address.ExecAddress(execer)
 	result := addrResult
-	fmt.Println(result)
+	jsonclient.PrintString(result)
 }
 
 // UserDataCmd  create user data
@@ -81,15 +82,15 @@ func addUserDataFlags(cmd *cobra.Command) {
 func addUserData(cmd *cobra.Command, args []string) {
 	execer, err := cmd.Flags().GetString("exec")
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(os.Stderr, err)
 		return
 	}
 	if !strings.HasPrefix(execer, "user.") {
-		fmt.Println(`user defined executor should start with "user."`)
+		fmt.Fprintln(os.Stderr, `user defined executor should start with "user."`)
 		return
 	}
 	if len(execer) > 50 {
-		fmt.Println("executor name too long")
+		fmt.Fprintln(os.Stderr, "executor name too long")
 		return
 	}
 	addrResult := address.ExecAddress(execer)
@@ -99,7 +100,7 @@ func addUserData(cmd *cobra.Command, args []string) {
 		data = "#" + topic + "#" + data
 	}
 	if data == "" {
-		fmt.Println("write empty data")
+		fmt.Fprintln(os.Stderr, "write empty data")
 		return
 	}
 	tx := &types.Transaction{
@@ -116,5 +117,5 @@ func addUserData(cmd *cobra.Command, args []string) {
 	tx.Nonce = random.Int63()
 	//tx.Sign(int32(wallet.SignType), privKey)
 	txHex := types.Encode(tx)
-	fmt.Println(hex.EncodeToString(txHex))
+	jsonclient.PrintString(hex.EncodeToString(txHex))
 }
@@ -0,0 +1,132 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+)
+
+//printOutput 浏览器命令默认按table格式由render按列对齐输出，json和yaml格式输出rpc的原始结果
+func printOutput(result interface{}, render func(w io.Writer)) {
+	output := jsonclient.GetOutputFormat()
+	if output != "" && output != jsonclient.OutputTable {
+		jsonclient.PrintResult(result)
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	render(w)
+	w.Flush()
+}
+
+func formatBlockTime(t int64) string {
+	return time.Unix(t, 0).Format("2006-01-02 15:04:05")
+}
+
+func formatCoins(amount int64) string {
+	return strconv.FormatFloat(float64(amount)/float64(types.Coin), 'f', 4, 64)
+}
+
+//txActionAmount rpc返回的交易中没有action和amount，用本地注册的执行器类型从payload解析
+func txActionAmount(tx *rpctypes.Transaction) (string, int64) {
+	payload, err := common.FromHex(tx.RawPayload)
+	if err != nil {
+		return "unknown", 0
+	}
+	raw := &types.Transaction{Execer: []byte(tx.Execer), Payload: payload, To: tx.To}
+	amount, err := raw.Amount()
+	if err != nil {
+		amount = 0
+	}
+	return raw.ActionName(), amount
+}
+
+func receiptResult(receipt *rpctypes.ReceiptDataResult) string {
+	if receipt == nil {
+		return "-"
+	}
+	return receipt.TyName
+}
+
+func renderHeader(w io.Writer, h *rpctypes.Header) {
+	fmt.Fprintf(w, "Height:\t%d\n", h.Height)
+	fmt.Fprintf(w, "Hash:\t%s\n", h.Hash)
+	fmt.Fprintf(w, "ParentHash:\t%s\n", h.ParentHash)
+	fmt.Fprintf(w, "StateHash:\t%s\n", h.StateHash)
+	fmt.Fprintf(w, "TxHash:\t%s\n", h.TxHash)
+	fmt.Fprintf(w, "BlockTime:\t%s\n", formatBlockTime(h.BlockTime))
+	fmt.Fprintf(w, "TxCount:\t%d\n", h.TxCount)
+	fmt.Fprintf(w, "Version:\t%d\n", h.Version)
+	fmt.Fprintf(w, "Difficulty:\t%d\n", h.Difficulty)
+}
+
+//renderTxTable receipts为空的时候不显示执行结果
+func renderTxTable(w io.Writer, txs []*rpctypes.Transaction, receipts []*rpctypes.ReceiptDataResult) {
+	fmt.Fprintln(w, "INDEX\tHASH\tEXECER\tACTION\tFROM\tTO\tAMOUNT\tFEE\tRESULT")
+	for i, tx := range txs {
+		action, amount := txActionAmount(tx)
+		var receipt *rpctypes.ReceiptDataResult
+		if i < len(receipts) {
+			receipt = receipts[i]
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", i, tx.Hash, tx.Execer, action,
+			tx.From, tx.To, formatCoins(amount), formatCoins(tx.Fee), receiptResult(receipt))
+	}
+}
+
+func renderBlock(w io.Writer, detail *rpctypes.BlockDetail) {
+	b := detail.Block
+	fmt.Fprintf(w, "Height:\t%d\n", b.Height)
+	fmt.Fprintf(w, "ParentHash:\t%s\n", b.ParentHash)
+	fmt.Fprintf(w, "StateHash:\t%s\n", b.StateHash)
+	fmt.Fprintf(w, "TxHash:\t%s\n", b.TxHash)
+	fmt.Fprintf(w, "BlockTime:\t%s\n", formatBlockTime(b.BlockTime))
+	fmt.Fprintf(w, "TxCount:\t%d\n", len(b.Txs))
+	if len(b.Txs) > 0 {
+		fmt.Fprintln(w)
+		renderTxTable(w, b.Txs, detail.Receipts)
+	}
+	fmt.Fprintln(w)
+}
+
+func renderTxDetail(w io.Writer, detail *rpctypes.TransactionDetail) {
+	tx := detail.Tx
+	fmt.Fprintf(w, "Hash:\t%s\n", tx.Hash)
+	fmt.Fprintf(w, "Height:\t%d\n", detail.Height)
+	fmt.Fprintf(w, "Index:\t%d\n", detail.Index)
+	fmt.Fprintf(w, "BlockTime:\t%s\n", formatBlockTime(detail.Blocktime))
+	fmt.Fprintf(w, "Execer:\t%s\n", tx.Execer)
+	fmt.Fprintf(w, "Action:\t%s\n", detail.ActionName)
+	fmt.Fprintf(w, "From:\t%s\n", detail.Fromaddr)
+	fmt.Fprintf(w, "To:\t%s\n", tx.To)
+	fmt.Fprintf(w, "Amount:\t%s\n", formatCoins(detail.Amount))
+	fmt.Fprintf(w, "Fee:\t%s\n", formatCoins(tx.Fee))
+	fmt.Fprintf(w, "Nonce:\t%d\n", tx.Nonce)
+	fmt.Fprintf(w, "Expire:\t%d\n", tx.Expire)
+	if tx.GroupCount > 0 {
+		fmt.Fprintf(w, "GroupCount:\t%d\n", tx.GroupCount)
+	}
+	for _, asset := range detail.Assets {
+		fmt.Fprintf(w, "Asset:\t%s %s %s\n", asset.Exec, asset.Symbol, formatCoins(asset.Amount))
+	}
+	fmt.Fprintf(w, "Result:\t%s\n", receiptResult(detail.Receipt))
+	if detail.Receipt != nil {
+		for _, log := range detail.Receipt.Logs {
+			fmt.Fprintf(w, "Log:\t%s\t%s\n", log.TyName, string(log.Log))
+		}
+	}
+	if len(tx.Payload) > 0 {
+		fmt.Fprintf(w, "Payload:\t%s\n", string(tx.Payload))
+	}
+}
@@ -0,0 +1,540 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"github.com/spf13/cobra"
+)
+
+//keyPasswordEnv keystore文件的口令从这个环境变量读取，没有设置的时候提示输入，不从命令行参数读取
+const keyPasswordEnv = "CHAIN33_KEYSTORE_PASSWORD"
+
+var errKeyCanceled = errors.New("canceled")
+
+//stdinReader 标准输入不是终端的时候，私钥、口令和确认按行从标准输入读取
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// KeyCmd key management
+func KeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key",
+		Short: "Manage keys in wallet of node, or key files in keystore directory on offline machines",
+		Long: "Manage keys in wallet of node, or key files in keystore directory on offline machines.\n" +
+			"Private keys are read from prompt or stdin, keystore password is read from env " + keyPasswordEnv + " or prompt.",
+		Args: cobra.MinimumNArgs(1),
+	}
+	cmd.PersistentFlags().String("keystore", "", "keystore directory, manage key files in it instead of the wallet")
+	cmd.AddCommand(
+		KeyImportCmd(),
+		KeyExportCmd(),
+		KeyListCmd(),
+		KeyDeleteCmd(),
+		KeyShowCmd(),
+	)
+	return cmd
+}
+
+//readSecret 终端上关闭回显读取，不会留在屏幕和shell历史中
+func readSecret(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if isTerminal(fd) {
+		fmt.Fprint(os.Stderr, prompt)
+		line, err := readNoEcho(fd)
+		fmt.Fprintln(os.Stderr)
+		return strings.TrimSpace(line), err
+	}
+	line, err := stdinReader.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	return strings.TrimSpace(line), err
+}
+
+//keyPassword 新建keystore文件的时候需要输入两次
+func keyPassword(repeat bool) ([]byte, error) {
+	if password := os.Getenv(keyPasswordEnv); password != "" {
+		return []byte(password), nil
+	}
+	password, err := readSecret("Keystore password: ")
+	if err != nil {
+		return nil, err
+	}
+	if !repeat {
+		return []byte(password), nil
+	}
+	if len(password) < 8 {
+		return nil, errors.New("password should be at least 8 characters")
+	}
+	again, err := readSecret("Repeat password: ")
+	if err != nil {
+		return nil, err
+	}
+	if again != password {
+		return nil, errors.New("passwords do not match")
+	}
+	return []byte(password), nil
+}
+
+//confirmAction 没有终端的时候不能确认，需要加上--yes
+func confirmAction(msg string, yes bool) error {
+	if yes {
+		return nil
+	}
+	if !isTerminal(int(os.Stdin.Fd())) {
+		return errors.New("confirmation required, add --yes to run without prompt")
+	}
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", msg)
+	answer, err := stdinReader.ReadString('\n')
+	if err != nil {
+		return errKeyCanceled
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	}
+	return errKeyCanceled
+}
+
+func keystoreFile(dir, addr string) string {
+	return filepath.Join(dir, addr+".json")
+}
+
+func keystoreResult(ks *wcom.KeyStore, file string) *commandtypes.KeyResult {
+	return &commandtypes.KeyResult{Addr: ks.Address, Label: ks.Label, SignType: ks.SignType, File: file}
+}
+
+func renderKey(w io.Writer, key *commandtypes.KeyResult) {
+	fmt.Fprintf(w, "Addr:\t%s\n", key.Addr)
+	fields := []struct{ name, value string }{
+		{"Label", key.Label},
+		{"SignType", key.SignType},
+		{"File", key.File},
+		{"Balance", key.Balance},
+		{"Frozen", key.Frozen},
+		{"PrivKey", key.PrivKey},
+	}
+	for _, f := range fields {
+		if f.value != "" {
+			fmt.Fprintf(w, "%s:\t%s\n", f.name, f.value)
+		}
+	}
+}
+
+func printKey(key *commandtypes.KeyResult, err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(key, func(w io.Writer) {
+		renderKey(w, key)
+	})
+}
+
+// KeyImportCmd import private key to wallet or keystore directory
+func KeyImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import private key from prompt, stdin or keystore file",
+		Run:   keyImport,
+	}
+	cmd.Flags().StringP("label", "l", "", "label of key, default label in keystore file")
+	cmd.Flags().StringP("file", "f", "", "keystore file to import")
+	cmd.Flags().StringP("sign_type", "t", "secp256k1", "sign type of the private key")
+	return cmd
+}
+
+func keyImport(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	dir, _ := cmd.Flags().GetString("keystore")
+	label, _ := cmd.Flags().GetString("label")
+	file, _ := cmd.Flags().GetString("file")
+	signType, _ := cmd.Flags().GetString("sign_type")
+	printKey(importKeyTo(rpcLaddr, dir, file, label, signType))
+}
+
+func importKeyTo(rpcLaddr, dir, file, label, signType string) (*commandtypes.KeyResult, error) {
+	var ks *wcom.KeyStore
+	var priv []byte
+	if file != "" {
+		var err error
+		ks, err = wcom.ReadKeyStore(file)
+		if err != nil {
+			return nil, err
+		}
+		if label == "" {
+			label = ks.Label
+		}
+		ks.Label = label
+		//复制到keystore目录不用解密，文件中的口令不变
+		if dir != "" {
+			dst := keystoreFile(dir, ks.Address)
+			if err := wcom.WriteKeyStore(dst, ks); err != nil {
+				return nil, err
+			}
+			return keystoreResult(ks, dst), nil
+		}
+		password, err := keyPassword(false)
+		if err != nil {
+			return nil, err
+		}
+		priv, err = ks.Decrypt(password)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		key, err := readSecret("Private key: ")
+		if err != nil {
+			return nil, err
+		}
+		priv, err = common.FromHex(key)
+		if err != nil || len(priv) == 0 {
+			return nil, types.ErrFromHex
+		}
+	}
+	if dir != "" {
+		password, err := keyPassword(true)
+		if err != nil {
+			return nil, err
+		}
+		ks, err = wcom.NewKeyStore(signType, priv, password, label)
+		if err != nil {
+			return nil, err
+		}
+		dst := keystoreFile(dir, ks.Address)
+		if err := wcom.WriteKeyStore(dst, ks); err != nil {
+			return nil, err
+		}
+		return keystoreResult(ks, dst), nil
+	}
+	if label == "" {
+		return nil, errors.New("label is required to import key to wallet")
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	var res types.WalletAccount
+	params := types.ReqWalletImportPrivkey{Privkey: common.ToHex(priv), Label: label}
+	if err := rpc.Call("Chain33.ImportPrivkey", params, &res); err != nil {
+		return nil, err
+	}
+	//钱包按自己的签名类型计算地址，和keystore文件中的签名类型不同的时候地址会不一样
+	if ks != nil && res.GetAcc().GetAddr() != ks.Address {
+		fmt.Fprintf(os.Stderr, "warning: imported as %s, sign type of wallet is different from %s\n", res.GetAcc().GetAddr(), ks.SignType)
+	}
+	return &commandtypes.KeyResult{Addr: res.GetAcc().GetAddr(), Label: res.GetLabel()}, nil
+}
+
+// KeyExportCmd export private key to keystore file or print it
+func KeyExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export private key to keystore file, or print it in plain text after confirmation",
+		Run:   keyExport,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address of key")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("file", "f", "", "keystore file to write, print private key when not set")
+	cmd.Flags().StringP("sign_type", "t", "secp256k1", "sign type of the wallet key")
+	cmd.Flags().BoolP("yes", "y", false, "do not prompt for confirmation")
+	return cmd
+}
+
+func keyExport(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	dir, _ := cmd.Flags().GetString("keystore")
+	addr, _ := cmd.Flags().GetString("addr")
+	file, _ := cmd.Flags().GetString("file")
+	signType, _ := cmd.Flags().GetString("sign_type")
+	yes, _ := cmd.Flags().GetBool("yes")
+	printKey(exportKey(rpcLaddr, dir, addr, file, signType, yes))
+}
+
+func exportKey(rpcLaddr, dir, addr, file, signType string, yes bool) (*commandtypes.KeyResult, error) {
+	if err := address.CheckAddress(addr); err != nil {
+		return nil, err
+	}
+	if file == "" {
+		if err := confirmAction(fmt.Sprintf("Print private key of %s in plain text?", addr), yes); err != nil {
+			return nil, err
+		}
+	}
+	var priv []byte
+	result := &commandtypes.KeyResult{Addr: addr}
+	if dir != "" {
+		ks, err := wcom.ReadKeyStore(keystoreFile(dir, addr))
+		if err != nil {
+			return nil, err
+		}
+		//导出到文件直接复制，不用解密
+		if file != "" {
+			if err := wcom.WriteKeyStore(file, ks); err != nil {
+				return nil, err
+			}
+			return keystoreResult(ks, file), nil
+		}
+		password, err := keyPassword(false)
+		if err != nil {
+			return nil, err
+		}
+		priv, err = ks.Decrypt(password)
+		if err != nil {
+			return nil, err
+		}
+		result = keystoreResult(ks, "")
+	} else {
+		rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+		if err != nil {
+			return nil, err
+		}
+		var res types.ReplyString
+		if err := rpc.Call("Chain33.DumpPrivkey", types.ReqString{Data: addr}, &res); err != nil {
+			return nil, err
+		}
+		priv, err = common.FromHex(res.Data)
+		if err != nil {
+			return nil, err
+		}
+		if acc, err := findWalletKey(rpc, addr, ""); err == nil {
+			result.Label = acc.Label
+		}
+	}
+	if file == "" {
+		result.PrivKey = common.ToHex(priv)
+		return result, nil
+	}
+	password, err := keyPassword(true)
+	if err != nil {
+		return nil, err
+	}
+	ks, err := wcom.NewKeyStore(signType, priv, password, result.Label)
+	if err != nil {
+		return nil, err
+	}
+	if ks.Address != addr {
+		return nil, fmt.Errorf("address of key with sign type %s is %s, set sign type of wallet", signType, ks.Address)
+	}
+	if err := wcom.WriteKeyStore(file, ks); err != nil {
+		return nil, err
+	}
+	return keystoreResult(ks, file), nil
+}
+
+// KeyListCmd list keys
+func KeyListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List keys in wallet or keystore directory",
+		Run:   keyList,
+	}
+	return cmd
+}
+
+func keyList(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	dir, _ := cmd.Flags().GetString("keystore")
+	var result *commandtypes.KeyListResult
+	var err error
+	if dir != "" {
+		result, err = listKeystore(dir)
+	} else {
+		result, err = listWalletKeys(rpcLaddr)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(result, func(w io.Writer) {
+		if dir != "" {
+			fmt.Fprintln(w, "ADDR\tLABEL\tSIGNTYPE\tFILE")
+			for _, k := range result.Keys {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", k.Addr, k.Label, k.SignType, k.File)
+			}
+			return
+		}
+		fmt.Fprintln(w, "ADDR\tLABEL\tBALANCE\tFROZEN")
+		for _, k := range result.Keys {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", k.Addr, k.Label, k.Balance, k.Frozen)
+		}
+	})
+}
+
+//listKeystore 目录中不是keystore格式的json文件跳过
+func listKeystore(dir string) (*commandtypes.KeyListResult, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	result := &commandtypes.KeyListResult{}
+	for _, file := range files {
+		ks, err := wcom.ReadKeyStore(file)
+		if err != nil {
+			continue
+		}
+		result.Keys = append(result.Keys, keystoreResult(ks, file))
+	}
+	return result, nil
+}
+
+func listWalletKeys(rpcLaddr string) (*commandtypes.KeyListResult, error) {
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	var res rpctypes.WalletAccounts
+	if err := rpc.Call("Chain33.GetAccounts", types.ReqAccountList{}, &res); err != nil {
+		return nil, err
+	}
+	result := &commandtypes.KeyListResult{}
+	for _, w := range res.Wallets {
+		result.Keys = append(result.Keys, walletKeyResult(w))
+	}
+	return result, nil
+}
+
+func walletKeyResult(w *rpctypes.WalletAccount) *commandtypes.KeyResult {
+	return &commandtypes.KeyResult{
+		Addr:    w.Acc.Addr,
+		Label:   w.Label,
+		Balance: formatCoins(w.Acc.Balance),
+		Frozen:  formatCoins(w.Acc.Frozen),
+	}
+}
+
+//findWalletKey 按地址或者标签查找钱包中的账户
+func findWalletKey(rpc *jsonclient.JSONClient, addr, label string) (*commandtypes.KeyResult, error) {
+	var res rpctypes.WalletAccounts
+	if err := rpc.Call("Chain33.GetAccounts", types.ReqAccountList{}, &res); err != nil {
+		return nil, err
+	}
+	for _, w := range res.Wallets {
+		if (addr != "" && w.Acc.Addr == addr) || (label != "" && w.Label == label) {
+			return walletKeyResult(w), nil
+		}
+	}
+	return nil, types.ErrAccountNotExist
+}
+
+// KeyDeleteCmd delete key from wallet or keystore directory
+func KeyDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete key from wallet or keystore directory after confirmation",
+		Run:   keyDelete,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address of key")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().BoolP("yes", "y", false, "do not prompt for confirmation")
+	return cmd
+}
+
+func keyDelete(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	dir, _ := cmd.Flags().GetString("keystore")
+	addr, _ := cmd.Flags().GetString("addr")
+	yes, _ := cmd.Flags().GetBool("yes")
+	printKey(deleteKey(rpcLaddr, dir, addr, yes))
+}
+
+func deleteKey(rpcLaddr, dir, addr string, yes bool) (*commandtypes.KeyResult, error) {
+	if err := address.CheckAddress(addr); err != nil {
+		return nil, err
+	}
+	msg := fmt.Sprintf("Delete key of %s? It can not be recovered unless exported or generated from seed", addr)
+	if dir != "" {
+		file := keystoreFile(dir, addr)
+		ks, err := wcom.ReadKeyStore(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := confirmAction(msg, yes); err != nil {
+			return nil, err
+		}
+		if err := os.Remove(file); err != nil {
+			return nil, err
+		}
+		return keystoreResult(ks, file), nil
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	key, err := findWalletKey(rpc, addr, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := confirmAction(msg, yes); err != nil {
+		return nil, err
+	}
+	var res rpctypes.Reply
+	if err := rpc.Call("Chain33.DeleteAccount", types.ReqString{Data: addr}, &res); err != nil {
+		return nil, err
+	}
+	if !res.IsOk {
+		return nil, errors.New(res.Msg)
+	}
+	return key, nil
+}
+
+// KeyShowCmd show key of wallet, keystore directory or keystore file
+func KeyShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show key by address or label, or show a keystore file without decrypting it",
+		Run:   keyShow,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address of key")
+	cmd.Flags().StringP("label", "l", "", "label of key in wallet")
+	cmd.Flags().StringP("file", "f", "", "keystore file")
+	return cmd
+}
+
+func keyShow(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	dir, _ := cmd.Flags().GetString("keystore")
+	addr, _ := cmd.Flags().GetString("addr")
+	label, _ := cmd.Flags().GetString("label")
+	file, _ := cmd.Flags().GetString("file")
+	printKey(showKey(rpcLaddr, dir, addr, label, file))
+}
+
+func showKey(rpcLaddr, dir, addr, label, file string) (*commandtypes.KeyResult, error) {
+	if file == "" && dir != "" {
+		if addr == "" {
+			return nil, errors.New("addr is required to show key in keystore directory")
+		}
+		file = keystoreFile(dir, addr)
+	}
+	if file != "" {
+		ks, err := wcom.ReadKeyStore(file)
+		if err != nil {
+			return nil, err
+		}
+		return keystoreResult(ks, file), nil
+	}
+	if addr == "" && label == "" {
+		return nil, errors.New("addr or label is required")
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	return findWalletKey(rpc, addr, label)
+}
@@ -5,10 +5,17 @@
 package commands
 
 import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
 )
 
 // NetCmd net command
@@ -26,6 +33,15 @@ func NetCmd() *cobra.Command {
 		GetNetInfoCmd(),
 		GetFatalFailureCmd(),
 		GetTimeStausCmd(),
+		NetPeersCmd(),
+		NetAddPeerCmd(),
+		NetRemovePeerCmd(),
+		NetBanPeerCmd(),
+		NetUnbanPeerCmd(),
+		NetBannedPeersCmd(),
+		NetSelfCmd(),
+		NetSyncCmd(),
+		NetAddrBookCmd(),
 	)
 
 	return cmd
@@ -132,3 +148,242 @@ func timestatus(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetTimeStatus", nil, &res)
 	ctx.Run()
 }
+
+// NetPeersCmd list connected peers with direction, version and latency
+func NetPeersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "peers",
+		Short: "List connected peers with direction, version and latency",
+		Run:   netPeers,
+	}
+	return cmd
+}
+
+func netPeers(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res types.NetPeers
+	if err := rpc.Call("Chain33.GetNetPeers", nil, &res); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(&res, func(w io.Writer) {
+		fmt.Fprintln(w, "ADDR\tDIRECTION\tVERSION\tLATENCY\tHEIGHT\tNODEID")
+		for _, peer := range res.Peers {
+			direction, latency, height := "outbound", fmt.Sprintf("%dms", peer.Latency), fmt.Sprint(peer.Height)
+			if peer.Inbound {
+				//连接本节点的节点不发送ping，没有延时和高度
+				direction, latency, height = "inbound", "-", "-"
+			}
+			version := fmt.Sprint(peer.Version)
+			if peer.Softversion != "" {
+				version += " (" + peer.Softversion + ")"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", peer.Addr, direction, version, latency, height, shortNodeID(peer.Name))
+		}
+	})
+}
+
+//shortNodeID 节点ID是公钥的hex，列表中只显示前16个字符
+func shortNodeID(id string) string {
+	if len(id) > 16 {
+		return id[:16] + "..."
+	}
+	return id
+}
+
+func addPeerAddrFlag(cmd *cobra.Command, usage string) {
+	cmd.Flags().StringP("addr", "a", "", usage)
+	cmd.MarkFlagRequired("addr")
+}
+
+func runPeerAdmin(cmd *cobra.Command, method string, banTime int64) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	params := &types.ReqNetPeer{Addr: addr, BanTime: banTime}
+	var res rpctypes.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, method, params, &res)
+	ctx.Run()
+}
+
+// NetAddPeerCmd connect to a peer
+func NetAddPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Connect to a peer, remove it from the blacklist if banned",
+		Run: func(cmd *cobra.Command, args []string) {
+			runPeerAdmin(cmd, "Chain33.AddPeer", 0)
+		},
+	}
+	addPeerAddrFlag(cmd, "peer address, ip:port")
+	return cmd
+}
+
+// NetRemovePeerCmd disconnect a peer
+func NetRemovePeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Disconnect an outbound peer and remove it from the address book",
+		Run: func(cmd *cobra.Command, args []string) {
+			runPeerAdmin(cmd, "Chain33.RemovePeer", 0)
+		},
+	}
+	addPeerAddrFlag(cmd, "peer address, ip:port")
+	return cmd
+}
+
+// NetBanPeerCmd add a peer to the blacklist
+func NetBanPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ban",
+		Short: "Disconnect a peer and add it to the blacklist",
+		Run: func(cmd *cobra.Command, args []string) {
+			duration, _ := cmd.Flags().GetDuration("time")
+			if duration < 0 {
+				fmt.Fprintln(os.Stderr, "ban time can not be negative")
+				return
+			}
+			runPeerAdmin(cmd, "Chain33.BanPeer", int64(duration/time.Second))
+		},
+	}
+	addPeerAddrFlag(cmd, "peer address, ip:port or ip, inbound connections from the ip are refused too")
+	cmd.Flags().DurationP("time", "t", time.Hour*24, "ban duration, 0 means permanent")
+	return cmd
+}
+
+// NetUnbanPeerCmd remove a peer from the blacklist
+func NetUnbanPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unban",
+		Short: "Remove a peer from the blacklist",
+		Run: func(cmd *cobra.Command, args []string) {
+			runPeerAdmin(cmd, "Chain33.UnbanPeer", 0)
+		},
+	}
+	addPeerAddrFlag(cmd, "peer address, ip:port or ip")
+	return cmd
+}
+
+// NetBannedPeersCmd list the blacklist
+func NetBannedPeersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bans",
+		Short: "List banned peers",
+		Run:   netBannedPeers,
+	}
+	return cmd
+}
+
+func netBannedPeers(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res types.NetBannedPeers
+	if err := rpc.Call("Chain33.GetBannedPeers", nil, &res); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(&res, func(w io.Writer) {
+		fmt.Fprintln(w, "ADDR\tUNTIL")
+		for _, peer := range res.Peers {
+			until := "permanent"
+			if peer.Deadline != 0 {
+				until = formatBlockTime(peer.Deadline)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", peer.Addr, until)
+		}
+	})
+}
+
+// NetSelfCmd show node id and addresses of the node
+func NetSelfCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self",
+		Short: "Show node ID and addresses of this node",
+		Run:   netSelf,
+	}
+	return cmd
+}
+
+func netSelf(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res types.NetSelfInfo
+	if err := rpc.Call("Chain33.GetSelfInfo", nil, &res); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(&res, func(w io.Writer) {
+		fmt.Fprintf(w, "NodeID:\t%s\n", res.NodeID)
+		fmt.Fprintf(w, "LocalAddr:\t%s\n", res.Localaddr)
+		fmt.Fprintf(w, "ExternalAddr:\t%s\n", res.Externaladdr)
+		fmt.Fprintf(w, "Service:\t%t\n", res.Service)
+		fmt.Fprintf(w, "Version:\t%d (%s)\n", res.Version, res.Softversion)
+		for _, seed := range res.Seeds {
+			fmt.Fprintf(w, "Seed:\t%s\n", seed)
+		}
+	})
+}
+
+// NetSyncCmd show sync status against the connected peers
+func NetSyncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Show local height against the best height of the connected peers",
+		Run:   netSync,
+	}
+	return cmd
+}
+
+func netSync(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var header rpctypes.Header
+	if err := rpc.Call("Chain33.GetLastHeader", nil, &header); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var peers types.NetPeers
+	if err := rpc.Call("Chain33.GetNetPeers", nil, &peers); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	res := &commandtypes.NetSyncResult{Height: header.Height}
+	if err := rpc.Call("Chain33.IsSync", nil, &res.IsSync); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	for _, peer := range peers.Peers {
+		if peer.Inbound {
+			continue
+		}
+		res.Peers++
+		if peer.Height > res.PeerHeight {
+			res.PeerHeight = peer.Height
+		}
+	}
+	if res.PeerHeight > res.Height {
+		res.Behind = res.PeerHeight - res.Height
+	}
+	printOutput(res, func(w io.Writer) {
+		fmt.Fprintf(w, "Height:\t%d\n", res.Height)
+		fmt.Fprintf(w, "PeerHeight:\t%d (best of %d outbound peers)\n", res.PeerHeight, res.Peers)
+		fmt.Fprintf(w, "Behind:\t%d\n", res.Behind)
+		fmt.Fprintf(w, "Synced:\t%t\n", res.IsSync)
+	})
+}
@@ -6,6 +6,7 @@ package commands
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
@@ -56,10 +57,10 @@ func genSeed(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GenSeed", params, &res)
 	_, err := ctx.RunResult()
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(os.Stderr, err)
 		return
 	}
-	fmt.Println(res.Seed)
+	jsonclient.PrintString(res.Seed)
 }
 
 // GetSeedCmd get seed
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/rpc/jsonclient"
 )
 
 // OneStepSend one step send
@@ -53,6 +55,27 @@ func OneStepSend(args []string) {
 			}
 		}
 	}
+	//创建和签名的交易需要原样传给下一个命令，输出格式只用于最后的结果
+	var output string
+	size = len(params)
+	for i, v := range params {
+		if v == "--output" {
+			if i < size-1 {
+				output = params[i+1]
+				params = append(params[:i], params[i+2:]...)
+			}
+			break
+		}
+		if strings.HasPrefix(v, "--output=") {
+			output = strings.TrimPrefix(v, "--output=")
+			params = append(params[:i], params[i+1:]...)
+			break
+		}
+	}
+	if err := jsonclient.SetOutputFormat(output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
 	var isAddr bool
 	err := address.CheckAddress(key)
 	if err != nil {
@@ -71,7 +94,7 @@ func OneStepSend(args []string) {
 		fmt.Fprintln(os.Stderr, err)
 	}
 	if errCreate.String() != "" {
-		fmt.Println(errCreate.String())
+		fmt.Fprintln(os.Stderr, errCreate.String())
 		return
 	}
 
@@ -98,7 +121,7 @@ func OneStepSend(args []string) {
 		fmt.Fprintln(os.Stderr, err)
 	}
 	if errSign.String() != "" {
-		fmt.Println(errSign.String())
+		fmt.Fprintln(os.Stderr, errSign.String())
 		return
 	}
 	//fmt.Println("signedTx", outSign.String(), errSign.String())
@@ -118,11 +141,11 @@ func OneStepSend(args []string) {
 		fmt.Fprintln(os.Stderr, err)
 	}
 	if errSend.String() != "" {
-		fmt.Println(errSend.String())
+		fmt.Fprintln(os.Stderr, errSend.String())
 		return
 	}
 	bufSend := outSend.Bytes()
-	fmt.Println(string(bufSend[:len(bufSend)-1]))
+	jsonclient.PrintString(string(bufSend[:len(bufSend)-1]))
 }
 
 func loadHelp() {
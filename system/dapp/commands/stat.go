@@ -5,7 +5,6 @@
 package commands
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
@@ -204,13 +203,7 @@ func totalCoins(cmd *cobra.Command, args []string) {
 
 	}
 
-	data, err := json.MarshalIndent(resp, "", "    ")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		return
-	}
-
-	fmt.Println(string(data))
+	jsonclient.PrintResult(resp)
 }
 
 // GetTicketStatCmd get ticket stat
@@ -240,7 +233,6 @@ func ticketStat(cmd *cobra.Command, args []string) {
 	}
 
 	key := []byte("Statistics:TicketStat:Addr:" + addr)
-	fmt.Println(string(key))
 	params := types.LocalDBGet{Keys: [][]byte{key}}
 	var res types.TicketStatistic
 	err = rpc.Call("Chain33.QueryTicketStat", params, &res)
@@ -254,13 +246,7 @@ func ticketStat(cmd *cobra.Command, args []string) {
 	resp.TotalMinerCount = res.TotalMinerCount
 	resp.TotalCloseCount = res.TotalCancleCount
 
-	data, err := json.MarshalIndent(resp, "", "    ")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		return
-	}
-
-	fmt.Println(string(data))
+	jsonclient.PrintResult(resp)
 }
 
 // GetTicketInfoCmd get a ticket information
@@ -290,7 +276,6 @@ func ticketInfo(cmd *cobra.Command, args []string) {
 	}
 
 	key := []byte("Statistics:TicketInfo:TicketId:" + ticketID)
-	fmt.Println(string(key))
 	params := types.LocalDBGet{Keys: [][]byte{key}}
 	var res types.TicketMinerInfo
 	err = rpc.Call("Chain33.QueryTicketInfo", params, &res)
@@ -324,13 +309,7 @@ func ticketInfo(cmd *cobra.Command, args []string) {
 	resp.MinerValue = res.MinerValue
 	resp.MinerAddress = res.MinerAddress
 
-	data, err := json.MarshalIndent(resp, "", "    ")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		return
-	}
-
-	fmt.Println(string(data))
+	jsonclient.PrintResult(resp)
 }
 
 // GetTicketInfoListCmd get ticket information list
@@ -378,8 +357,6 @@ func ticketInfoList(cmd *cobra.Command, args []string) {
 	if ticketID != "" && createTime != "" {
 		key = []byte("Statistics:TicketInfoOrder:Addr:" + addr + ":CreateTime:" + createTime + ":TicketId:" + ticketID)
 	}
-	fmt.Println(string(prefix))
-	fmt.Println(string(key))
 	params := types.LocalDBList{Prefix: prefix, Key: key, Direction: direction, Count: count}
 	var res []types.TicketMinerInfo
 	err = rpc.Call("Chain33.QueryTicketInfoList", params, &res)
@@ -423,13 +400,7 @@ func ticketInfoList(cmd *cobra.Command, args []string) {
 		resp = append(resp, ticket)
 	}
 
-	data, err := json.MarshalIndent(resp, "", "    ")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		return
-	}
-
-	fmt.Println(string(data))
+	jsonclient.PrintResult(resp)
 }
 
 // GetMinerStatCmd get miner stat
@@ -778,13 +749,7 @@ func execBalance(cmd *cobra.Command, args []string) {
 		convertReplyToResult(&replys, &resp, types.TokenPrecision)
 	}
 
-	data, err := json.MarshalIndent(resp, "", "    ")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		return
-	}
-
-	fmt.Println(string(data))
+	jsonclient.PrintResult(resp)
 }
 
 func convertReplyToResult(reply *types.ReplyGetExecBalance, result *commandtypes.GetExecBalanceResult, precision int64) {
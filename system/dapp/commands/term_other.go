@@ -0,0 +1,18 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd,!dragonfly
+
+package commands
+
+import "errors"
+
+//其他平台不能关闭回显，口令只能从环境变量或者标准输入读取
+func isTerminal(fd int) bool {
+	return false
+}
+
+func readNoEcho(fd int) (string, error) {
+	return "", errors.New("terminal not supported")
+}
@@ -0,0 +1,49 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux darwin freebsd netbsd openbsd dragonfly
+
+package commands
+
+import (
+	"io"
+
+	"golang.org/x/sys/unix"
+)
+
+func isTerminal(fd int) bool {
+	_, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	return err == nil
+}
+
+//readNoEcho 关闭回显读取一行，用于输入口令和私钥，读完以后恢复终端设置
+func readNoEcho(fd int) (string, error) {
+	termios, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	if err != nil {
+		return "", err
+	}
+	old := *termios
+	termios.Lflag &^= unix.ECHO
+	termios.Lflag |= unix.ICANON | unix.ISIG
+	if err := unix.IoctlSetTermios(fd, ioctlWriteTermios, termios); err != nil {
+		return "", err
+	}
+	defer unix.IoctlSetTermios(fd, ioctlWriteTermios, &old)
+	//逐个字节读取，不在终端输入上留下缓存
+	var line []byte
+	var b [1]byte
+	for {
+		n, err := unix.Read(fd, b[:])
+		if err != nil {
+			return "", err
+		}
+		if n == 0 && len(line) == 0 {
+			return "", io.EOF
+		}
+		if n == 0 || b[0] == '\n' {
+			return string(line), nil
+		}
+		line = append(line, b[0])
+	}
+}
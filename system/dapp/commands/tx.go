@@ -5,11 +5,17 @@
 package commands
 
 import (
+	"encoding/base64"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
@@ -27,12 +33,15 @@ func TxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		QueryTxCmd(),
+		GetTxCmd(),
 		QueryTxByAddrCmd(),
 		QueryTxsByHashesCmd(),
 		GetRawTxCmd(),
 		DecodeTxCmd(),
 		GetAddrOverviewCmd(),
 		ReWriteRawTxCmd(),
+		SignTxCmd(),
+		BroadcastTxCmd(),
 	)
 
 	return cmd
@@ -113,6 +122,37 @@ func queryTx(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+// GetTxCmd get tx detail by hash with formatted output
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get transaction detail by hash",
+		Run:   getTx,
+	}
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+	return cmd
+}
+
+func getTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+	params := rpctypes.QueryParm{
+		Hash: hash,
+	}
+	var res rpctypes.TransactionDetail
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.QueryTransaction", params, &res)
+	ctx.SetResultCb(parseQueryTxRes)
+	result, err := ctx.RunResult()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	printOutput(result, func(w io.Writer) {
+		renderTxDetail(w, &res)
+	})
+}
+
 func parseQueryTxRes(arg interface{}) (interface{}, error) {
 	res := arg.(*rpctypes.TransactionDetail)
 	amountResult := strconv.FormatFloat(float64(res.Amount)/float64(types.Coin), 'f', 4, 64)
@@ -217,7 +257,7 @@ func getTxHexByHash(cmd *cobra.Command, args []string) {
 func DecodeTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "decode",
-		Short: "Decode a hex format transaction",
+		Short: "Decode a hex or base64 format transaction offline",
 		Run:   decodeTx,
 	}
 	addDecodeTxFlags(cmd)
@@ -225,31 +265,58 @@ func DecodeTxCmd() *cobra.Command {
 }
 
 func addDecodeTxFlags(cmd *cobra.Command) {
-	cmd.Flags().StringP("data", "d", "", "transaction content")
+	cmd.Flags().StringP("data", "d", "", "transaction content, hex or base64")
 	cmd.MarkFlagRequired("data")
 }
 
+//decodeTx 不需要连接节点，payload由本地注册的执行器类型解析，交易组按组内的每个交易显示
 func decodeTx(cmd *cobra.Command, args []string) {
-	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	data, _ := cmd.Flags().GetString("data")
-	params := types.ReqDecodeRawTransaction{
-		TxHex: data,
+	tx, err := decodeRawTx(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
 	}
-
-	var res rpctypes.ReplyTxList
-	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.DecodeRawTransaction", params, &res)
-	ctx.SetResultCb(parseReplyTxList)
-	ctx.Run()
+	txs := []*types.Transaction{tx}
+	group, err := tx.GetTxGroup()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if group != nil {
+		txs = group.GetTxs()
+	}
+	var result commandtypes.TxListResult
+	for _, tx := range txs {
+		rpctx, err := rpctypes.DecodeTx(tx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		res := commandtypes.DecodeTransaction(rpctx)
+		res.ActionName = tx.ActionName()
+		if amount, err := tx.Amount(); err == nil && amount != 0 {
+			res.Amount = strconv.FormatFloat(float64(amount)/float64(types.Coin), 'f', 4, 64)
+		}
+		result.Txs = append(result.Txs, res)
+	}
+	jsonclient.PrintResult(&result)
 }
 
-func parseReplyTxList(view interface{}) (interface{}, error) {
-	replyTxList := view.(*rpctypes.ReplyTxList)
-	var commandtxs commandtypes.TxListResult
-	for _, cmdtx := range replyTxList.Txs {
-		txResult := commandtypes.DecodeTransaction(cmdtx)
-		commandtxs.Txs = append(commandtxs.Txs, txResult)
+//decodeRawTx 先按hex解析，失败的时候按base64解析
+func decodeRawTx(data string) (*types.Transaction, error) {
+	data = strings.TrimSpace(data)
+	raw, err := common.FromHex(data)
+	if err != nil {
+		if raw, err = base64.StdEncoding.DecodeString(data); err != nil {
+			return nil, types.ErrDecode
+		}
 	}
-	return &commandtxs, nil
+	var tx types.Transaction
+	if err := types.Decode(raw, &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
 }
 
 // GetAddrOverviewCmd get overview of an address
@@ -343,3 +410,188 @@ func reWriteRawTx(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ReWriteRawTx", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// SignTxCmd sign raw transaction, offline without node connection
+func SignTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign",
+		Short: "Sign raw transaction with private key, offline or by node",
+		Run:   signTx,
+	}
+	addSignTxFlags(cmd)
+	return cmd
+}
+
+func addSignTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("data", "d", "", "raw transaction data")
+	cmd.MarkFlagRequired("data")
+	cmd.Flags().StringP("key", "k", "", "private key")
+	cmd.Flags().String("keyfile", "", "file which contains the hex private key")
+	cmd.Flags().Bool("offline", false, "sign locally without node connection")
+	cmd.Flags().StringP("sign_type", "t", "secp256k1", "sign type of the private key, used when offline")
+	cmd.Flags().Int32P("index", "i", 0, "transaction index of group to be signed, 0 for all")
+	cmd.Flags().StringP("expire", "e", "120s", "transaction expire time")
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee (optional), calculated by tx size with MinFee when offline")
+	cmd.Flags().Bool("fee_payer", false, "sign as fee payer of a transaction already signed by sender")
+	cmd.Flags().String("fee_payer_pubkey", "", "hex public key of the fee payer, signed by sender")
+}
+
+func signTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	data, _ := cmd.Flags().GetString("data")
+	key, _ := cmd.Flags().GetString("key")
+	keyfile, _ := cmd.Flags().GetString("keyfile")
+	offline, _ := cmd.Flags().GetBool("offline")
+	signType, _ := cmd.Flags().GetString("sign_type")
+	index, _ := cmd.Flags().GetInt32("index")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	feePayer, _ := cmd.Flags().GetBool("fee_payer")
+	feePayerPubkey, _ := cmd.Flags().GetString("fee_payer_pubkey")
+	expire, _ := cmd.Flags().GetString("expire")
+	expire, err := commandtypes.CheckExpireOpt(expire)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if keyfile != "" {
+		b, err := ioutil.ReadFile(keyfile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		key = strings.TrimSpace(string(b))
+	}
+	if key == "" {
+		fmt.Fprintln(os.Stderr, types.ErrNoPrivKeyOrAddr)
+		return
+	}
+	feeInt64 := int64(fee*1e4) * 1e4
+	if !offline {
+		params := types.ReqSignRawTx{
+			Privkey:        key,
+			TxHex:          data,
+			Expire:         expire,
+			Index:          index,
+			Fee:            feeInt64,
+			FeePayer:       feePayer,
+			FeePayerPubkey: feePayerPubkey,
+		}
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SignRawTx", params, nil)
+		ctx.RunWithoutMarshal()
+		return
+	}
+	signed, err := signTxOffline(data, key, signType, expire, index, feeInt64, feePayer, feePayerPubkey)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(signed)
+}
+
+//signTxOffline 和钱包的SignRawTx一样处理交易组和代付手续费，手续费为0的时候按交易大小和本地配置的MinFee计算
+func signTxOffline(data, key, signType, expire string, index int32, fee int64, feePayer bool, feePayerPubkey string) (string, error) {
+	ty := types.GetSignType("", signType)
+	c, err := crypto.New(types.GetSignName("", ty))
+	if err != nil {
+		return "", err
+	}
+	keybytes, err := common.FromHex(key)
+	if err != nil || len(keybytes) == 0 {
+		return "", types.ErrFromHex
+	}
+	priv, err := c.PrivKeyFromBytes(keybytes)
+	if err != nil {
+		return "", err
+	}
+	tx, err := decodeRawTx(data)
+	if err != nil {
+		return "", err
+	}
+	if feePayer {
+		if tx.GetSignature() == nil || tx.GroupCount > 0 {
+			return "", types.ErrSign
+		}
+		tx.SignFeePayer(int32(ty), priv)
+		return common.ToHex(types.Encode(tx)), nil
+	}
+	group, err := tx.GetTxGroup()
+	if err != nil {
+		return "", err
+	}
+	if group == nil {
+		expireValue, err := types.ParseExpire(expire)
+		if err != nil {
+			return "", err
+		}
+		tx.SetExpire(time.Duration(expireValue))
+		if fee != 0 {
+			tx.Fee = fee
+		}
+		if err := tx.SetRealFee(types.GInt("MinFee")); err != nil {
+			return "", err
+		}
+		if tx.ChainID == 0 {
+			tx.ChainID = types.GetChainID()
+		}
+		if feePayerPubkey != "" {
+			pub, err := common.FromHex(feePayerPubkey)
+			if err != nil || len(pub) == 0 {
+				return "", types.ErrFromHex
+			}
+			tx.SetFeePayer(pub)
+		}
+		tx.Sign(int32(ty), priv)
+		return common.ToHex(types.Encode(tx)), nil
+	}
+	if feePayerPubkey != "" {
+		return "", types.ErrInvalidParam
+	}
+	if int(index) > len(group.GetTxs()) {
+		return "", types.ErrIndex
+	}
+	for i := range group.Txs {
+		if index > 0 && i != int(index)-1 {
+			continue
+		}
+		if err := group.SignN(i, int32(ty), priv); err != nil {
+			return "", err
+		}
+	}
+	return common.ToHex(types.Encode(group.Tx())), nil
+}
+
+// BroadcastTxCmd broadcast signed transaction
+func BroadcastTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast",
+		Short: "Broadcast a signed transaction to node",
+		Run:   broadcastTx,
+	}
+	addBroadcastTxFlags(cmd)
+	return cmd
+}
+
+func addBroadcastTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("data", "d", "", "signed transaction, hex or base64")
+	cmd.MarkFlagRequired("data")
+}
+
+//broadcastTx 广播之前在本地检查签名，避免把没有签名或者签名错误的交易发给节点
+func broadcastTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	data, _ := cmd.Flags().GetString("data")
+	tx, err := decodeRawTx(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if !tx.CheckSign() {
+		fmt.Fprintln(os.Stderr, types.ErrSign)
+		return
+	}
+	params := rpctypes.RawParm{
+		Data: common.ToHex(types.Encode(tx)),
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SendTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
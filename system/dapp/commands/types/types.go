@@ -7,6 +7,7 @@ package types
 
 import (
 	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
 )
 
 // AccountsResult defines accountsresult command
@@ -45,6 +46,7 @@ type TxListResult struct {
 // TxResult defines txresult command
 type TxResult struct {
 	Execer     string              `json:"execer"`
+	ActionName string              `json:"actionname,omitempty"`
 	Payload    interface{}         `json:"payload"`
 	RawPayload string              `json:"rawpayload"`
 	Signature  *rpctypes.Signature `json:"signature"`
@@ -58,6 +60,97 @@ type TxResult struct {
 	Header     string              `json:"header,omitempty"`
 	Next       string              `json:"next,omitempty"`
 	Hash       string              `json:"hash,omitempty"`
+	FeePayer   *rpctypes.Signature `json:"feePayer,omitempty"`
+	FeeAddr    string              `json:"feeAddr,omitempty"`
+	ChainID    int32               `json:"chainID,omitempty"`
+}
+
+// ChainStatusResult defines status of node and chain
+type ChainStatusResult struct {
+	Version        *types.VersionInfo    `json:"version"`
+	LastHeader     *rpctypes.Header      `json:"lastHeader"`
+	IsSync         bool                  `json:"isSync"`
+	IsNtpClockSync bool                  `json:"isNtpClockSync"`
+	NetInfo        *rpctypes.NodeNetinfo `json:"netInfo"`
+	MempoolSize    int                   `json:"mempoolSize"`
+}
+
+// WatchBlockResult defines one block line of chain watch command
+type WatchBlockResult struct {
+	Height    int64    `json:"height"`
+	Hash      string   `json:"hash"`
+	BlockTime int64    `json:"blockTime"`
+	TxCount   int64    `json:"txCount"`
+	Matched   []string `json:"matched,omitempty"`
+}
+
+// NetSyncResult defines sync status of net sync command
+type NetSyncResult struct {
+	Height     int64 `json:"height"`
+	PeerHeight int64 `json:"peerHeight"`
+	Behind     int64 `json:"behind"`
+	IsSync     bool  `json:"isSync"`
+	Peers      int   `json:"peers"`
+}
+
+// BenchTxResult defines result of bench tx command, latency in seconds
+type BenchTxResult struct {
+	Sent        int64   `json:"sent"`
+	Failed      int64   `json:"failed"`
+	Skipped     int64   `json:"skipped"`
+	Confirmed   int64   `json:"confirmed"`
+	Unconfirmed int64   `json:"unconfirmed"`
+	Blocks      int64   `json:"blocks"`
+	SendSeconds float64 `json:"sendSeconds"`
+	SendTPS     float64 `json:"sendTPS"`
+	ConfirmTPS  float64 `json:"confirmTPS"`
+	LatencyP50  float64 `json:"latencyP50"`
+	LatencyP90  float64 `json:"latencyP90"`
+	LatencyP99  float64 `json:"latencyP99"`
+	LatencyMax  float64 `json:"latencyMax"`
+	MaxMempool  int     `json:"maxMempool"`
+	LastMempool int     `json:"lastMempool"`
+}
+
+// ChainDumpResult defines result of chain dump command
+type ChainDumpResult struct {
+	File    string `json:"file"`
+	Start   int64  `json:"start"`
+	End     int64  `json:"end"`
+	Dumped  int64  `json:"dumped"`
+	Resumed bool   `json:"resumed"`
+}
+
+// ChainRestoreResult defines result of chain restore command
+type ChainRestoreResult struct {
+	File     string `json:"file"`
+	Start    int64  `json:"start"`
+	End      int64  `json:"end"`
+	Imported int64  `json:"imported"`
+	Skipped  int64  `json:"skipped"`
+	Hash     string `json:"hash"`
+}
+
+// KeyResult defines result of key commands, fields unknown to wallet or keystore are omitted
+type KeyResult struct {
+	Addr     string `json:"addr"`
+	Label    string `json:"label,omitempty"`
+	SignType string `json:"signType,omitempty"`
+	File     string `json:"file,omitempty"`
+	Balance  string `json:"balance,omitempty"`
+	Frozen   string `json:"frozen,omitempty"`
+	PrivKey  string `json:"privKey,omitempty"`
+}
+
+// KeyListResult defines result of key list command
+type KeyListResult struct {
+	Keys []*KeyResult `json:"keys"`
+}
+
+// ValidatorProofResult defines result of validator prove command
+type ValidatorProofResult struct {
+	PubKey string `json:"pubKey"`
+	Proof  string `json:"proof"`
 }
 
 // ReceiptAccountTransfer defines receipt account transfer
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	rpctypes "github.com/33cn/chain33/rpc/types"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -84,6 +85,21 @@ func TestCreateRawTx(t *testing.T) {
 	assert.Equal(t, types.ErrExecNameNotMatch, err)
 }
 
+func TestFormatActionTx(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("paraName", "", "")
+	action := &cty.CoinsAction{Ty: cty.CoinsActionTransfer, Value: &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Amount: 1}}}
+	tx, err := FormatActionTx(cmd, "coins", action)
+	assert.Nil(t, err)
+	assert.Equal(t, "coins", string(tx.Execer))
+	assert.Equal(t, types.Encode(action), tx.Payload)
+
+	cmd.Flags().Set("paraName", "user.p.test.")
+	tx, err = FormatActionTx(cmd, "coins", action)
+	assert.Nil(t, err)
+	assert.Equal(t, "user.p.test.coins", string(tx.Execer))
+}
+
 func TestGetExecAddr(t *testing.T) {
 	_, err := GetExecAddr("coins")
 	assert.Nil(t, err)
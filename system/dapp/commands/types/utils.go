@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"os"
 	"time"
 )
 
@@ -43,6 +44,9 @@ func DecodeTransaction(tx *rpctypes.Transaction) *TxResult {
 		Header:     tx.Header,
 		Next:       tx.Next,
 		Hash:       tx.Hash,
+		FeePayer:   tx.FeePayer,
+		FeeAddr:    tx.FeeAddr,
+		ChainID:    tx.ChainID,
 	}
 	return result
 }
@@ -129,6 +133,23 @@ func CreateRawTx(cmd *cobra.Command, to string, amount float64, note string, isW
 	return hex.EncodeToString(txHex), nil
 }
 
+// FormatActionTx 构造执行器action的交易，平行链的时候执行器名加上paraName前缀
+func FormatActionTx(cmd *cobra.Command, execer string, action types.Message) (*types.Transaction, error) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	return types.FormatTx(getRealExecName(paraName, execer), tx)
+}
+
+// CreateActionTx 构造执行器action的交易，输出交易的hex
+func CreateActionTx(cmd *cobra.Command, execer string, action types.Message) {
+	tx, err := FormatActionTx(cmd, execer, action)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	jsonclient.PrintString(hex.EncodeToString(types.Encode(tx)))
+}
+
 // GetExecAddr get exec address func
 func GetExecAddr(exec string) (string, error) {
 	if ok := types.IsAllowExecName([]byte(exec), []byte(exec)); !ok {
@@ -314,6 +314,8 @@ func addSignRawTxFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("expire", "e", "120s", "transaction expire time")
 	cmd.Flags().Float64P("fee", "f", 0, "transaction fee (optional)")
 	cmd.Flags().StringP("to", "t", "", "new to addr (optional)")
+	cmd.Flags().Bool("fee_payer", false, "sign as fee payer of a transaction already signed by sender")
+	cmd.Flags().String("fee_payer_pubkey", "", "hex public key of the fee payer, signed by sender")
 
 	// A duration string is a possibly signed sequence of
 	// decimal numbers, each with optional fraction and a unit suffix,
@@ -354,6 +356,8 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	index, _ := cmd.Flags().GetInt32("index")
 	to, _ := cmd.Flags().GetString("to")
 	fee, _ := cmd.Flags().GetFloat64("fee")
+	feePayer, _ := cmd.Flags().GetBool("fee_payer")
+	feePayerPubkey, _ := cmd.Flags().GetString("fee_payer_pubkey")
 	expire, _ := cmd.Flags().GetString("expire")
 	expire, err := commandtypes.CheckExpireOpt(expire)
 	if err != nil {
@@ -362,13 +366,15 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	}
 	feeInt64 := int64(fee * 1e4)
 	params := types.ReqSignRawTx{
-		Addr:      addr,
-		Privkey:   key,
-		TxHex:     data,
-		Expire:    expire,
-		Index:     index,
-		Fee:       feeInt64 * 1e4,
-		NewToAddr: to,
+		Addr:           addr,
+		Privkey:        key,
+		TxHex:          data,
+		Expire:         expire,
+		Index:          index,
+		Fee:            feeInt64 * 1e4,
+		NewToAddr:      to,
+		FeePayer:       feePayer,
+		FeePayerPubkey: feePayerPubkey,
 	}
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SignRawTx", params, nil)
 	ctx.RunWithoutMarshal()
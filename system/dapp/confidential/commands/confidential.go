@@ -0,0 +1,315 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands confidential插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	cty "github.com/33cn/chain33/system/dapp/confidential/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// ConfidentialCmd confidential command
+func ConfidentialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confidential",
+		Short: "Confidential transfer with hidden amounts",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		DepositCmd(),
+		TransferCmd(),
+		WithdrawCmd(),
+		QueryNoteCmd(),
+		ListNotesCmd(),
+		DecodeNoteCmd(),
+	)
+
+	return cmd
+}
+
+//getKey 交易的签名私钥，构造交易时用来解密输入的note和给自己加密找零
+func getKey(cmd *cobra.Command) ([]byte, error) {
+	key, _ := cmd.Flags().GetString("key")
+	return common.FromHex(key)
+}
+
+func queryConfidential(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, cty.ConfidentialX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+func getNote(cmd *cobra.Command, id string) (*cty.ConfidentialNote, error) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, cty.ConfidentialX)
+	params.FuncName = cty.FuncNameGetNote
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: id})
+
+	var note cty.ConfidentialNote
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &note)
+	if _, err := ctx.RunResult(); err != nil {
+		return nil, err
+	}
+	return &note, nil
+}
+
+//loadInputs 查询输入的note并用私钥解密金额
+func loadInputs(cmd *cobra.Command, priv []byte) ([]*cty.InputNote, int64, error) {
+	ids, _ := cmd.Flags().GetString("notes")
+	var inputs []*cty.InputNote
+	var total int64
+	for _, id := range strings.Split(ids, ",") {
+		note, err := getNote(cmd, strings.TrimSpace(id))
+		if err != nil {
+			return nil, 0, err
+		}
+		secret, err := cty.OpenNote(priv, note)
+		if err != nil {
+			return nil, 0, err
+		}
+		inputs = append(inputs, &cty.InputNote{Note: note, Secret: secret})
+		total += secret.Amount
+	}
+	return inputs, total, nil
+}
+
+//changeOutput 输入金额多出的部分找零给自己
+func changeOutput(priv []byte, outputs []*cty.OutputSpec, change int64) []*cty.OutputSpec {
+	if change > 0 {
+		outputs = append(outputs, &cty.OutputSpec{PubKey: cty.PubKeyFromPriv(priv), Amount: change})
+	}
+	return outputs
+}
+
+// DepositCmd deposit public asset into a note
+func DepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Create a transaction to turn public asset into a confidential note",
+		Run:   deposit,
+	}
+	cmd.Flags().StringP("key", "k", "", "private key of sender")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().StringP("exec", "e", "coins", "asset executor")
+	cmd.Flags().StringP("symbol", "s", "", "asset symbol, empty for coins")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("receiver", "r", "", "public key of receiver, default sender")
+	return cmd
+}
+
+func deposit(cmd *cobra.Command, args []string) {
+	exec, _ := cmd.Flags().GetString("exec")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	receiver, _ := cmd.Flags().GetString("receiver")
+	priv, err := getKey(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	pub := cty.PubKeyFromPriv(priv)
+	if receiver != "" {
+		if pub, err = common.FromHex(receiver); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+	}
+	payload, err := cty.CreateDeposit(priv, exec, symbol, commandtypes.FormatAmountDisplay2Value(amount), pub)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, cty.ConfidentialX, &cty.ConfidentialAction{
+		Ty:    cty.ConfidentialActionDeposit,
+		Value: &cty.ConfidentialAction_Deposit{Deposit: payload},
+	})
+}
+
+// TransferCmd transfer notes to receiver
+func TransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "Create a transaction to spend notes to receiver, change goes back to sender",
+		Run:   transfer,
+	}
+	cmd.Flags().StringP("key", "k", "", "private key of sender")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().StringP("notes", "n", "", "input note ids, separated by comma")
+	cmd.MarkFlagRequired("notes")
+	cmd.Flags().StringP("receiver", "r", "", "public key of receiver")
+	cmd.MarkFlagRequired("receiver")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func transfer(cmd *cobra.Command, args []string) {
+	receiver, _ := cmd.Flags().GetString("receiver")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	priv, err := getKey(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	pub, err := common.FromHex(receiver)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	inputs, total, err := loadInputs(cmd, priv)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	value := commandtypes.FormatAmountDisplay2Value(amount)
+	outputs := changeOutput(priv, []*cty.OutputSpec{{PubKey: pub, Amount: value}}, total-value)
+	payload, err := cty.CreateTransfer(priv, inputs, outputs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, cty.ConfidentialX, &cty.ConfidentialAction{
+		Ty:    cty.ConfidentialActionTransfer,
+		Value: &cty.ConfidentialAction_Transfer{Transfer: payload},
+	})
+}
+
+// WithdrawCmd withdraw notes to public asset
+func WithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Create a transaction to turn notes back into public asset",
+		Run:   withdraw,
+	}
+	cmd.Flags().StringP("key", "k", "", "private key of sender")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().StringP("notes", "n", "", "input note ids, separated by comma")
+	cmd.MarkFlagRequired("notes")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("to", "t", "", "receiver address, default sender")
+	return cmd
+}
+
+func withdraw(cmd *cobra.Command, args []string) {
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	to, _ := cmd.Flags().GetString("to")
+	priv, err := getKey(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	inputs, total, err := loadInputs(cmd, priv)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	value := commandtypes.FormatAmountDisplay2Value(amount)
+	payload, err := cty.CreateWithdraw(priv, inputs, changeOutput(priv, nil, total-value), value, to)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, cty.ConfidentialX, &cty.ConfidentialAction{
+		Ty:    cty.ConfidentialActionWithdraw,
+		Value: &cty.ConfidentialAction_Withdraw{Withdraw: payload},
+	})
+}
+
+// QueryNoteCmd query note
+func QueryNoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "note",
+		Short: "Query note by id",
+		Run:   queryNote,
+	}
+	cmd.Flags().StringP("id", "i", "", "note id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryNote(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res cty.ConfidentialNote
+	queryConfidential(cmd, cty.FuncNameGetNote, &types.ReqString{Data: id}, &res)
+}
+
+// ListNotesCmd list notes of address
+func ListNotesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notes",
+		Short: "List notes owned by address",
+		Run:   listNotes,
+	}
+	cmd.Flags().StringP("addr", "a", "", "owner address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("primary", "p", "", "list after this note id")
+	cmd.Flags().Int32P("count", "c", cty.DefaultListCount, "max count")
+	cmd.Flags().BoolP("unspent", "u", false, "only list unspent notes")
+	return cmd
+}
+
+func listNotes(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	unspent, _ := cmd.Flags().GetBool("unspent")
+	var res cty.ReplyConfidentialNotes
+	queryConfidential(cmd, cty.FuncNameListNotes, &cty.ReqConfidentialNotes{Owner: addr, PrimaryKey: primary, Count: count, UnspentOnly: unspent}, &res)
+}
+
+// DecodeNoteCmd decode amount of note with private key
+func DecodeNoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode",
+		Short: "Decode amount of note with private key of receiver or sender",
+		Run:   decodeNote,
+	}
+	cmd.Flags().StringP("key", "k", "", "private key")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().StringP("id", "i", "", "note id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func decodeNote(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	priv, err := getKey(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	note, err := getNote(cmd, id)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	secret, err := cty.OpenNote(priv, note)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(commandtypes.FormatAmountValue2Display(secret.Amount))
+}
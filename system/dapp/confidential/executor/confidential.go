@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor confidential执行器，金额隐藏在Pedersen承诺中，范围证明保证金额不为负，只有发送者和接收者可以解密金额
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	cty "github.com/33cn/chain33/system/dapp/confidential/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.confidential")
+	driverName = cty.ConfidentialX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Confidential{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newConfidential, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return confidential name
+func GetName() string {
+	return newConfidential().GetName()
+}
+
+// Confidential defines Confidential object
+type Confidential struct {
+	drivers.DriverBase
+}
+
+func newConfidential() drivers.Driver {
+	c := &Confidential{}
+	c.SetChild(c)
+	c.SetExecutorType(types.LoadExecutorType(driverName))
+	return c
+}
+
+// GetDriverName return a drivername
+func (c *Confidential) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (c *Confidential) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,159 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	cty "github.com/33cn/chain33/system/dapp/confidential/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var execAddr = address.ExecAddress(cty.ConfidentialX)
+
+type testEnv struct {
+	t     *testing.T
+	c     *Confidential
+	index int
+}
+
+func newTestEnv(t *testing.T) (*testEnv, func()) {
+	dir, leveldb, kvdb := util.CreateTestDB()
+	c := newConfidential().(*Confidential)
+	c.SetStateDB(kvdb)
+	c.SetLocalDB(kvdb)
+	c.SetEnv(10, 0, 0)
+	return &testEnv{t: t, c: c}, func() { util.CloseTestDB(dir, leveldb) }
+}
+
+func (env *testEnv) action(priv crypto.PrivKey) *Action {
+	tx := &types.Transaction{Execer: []byte(cty.ConfidentialX), To: execAddr}
+	tx.Sign(types.SECP256K1, priv)
+	env.index++
+	return NewAction(env.c, tx, env.index)
+}
+
+//input 用私钥解密note作为构造交易的输入
+func (env *testEnv) input(priv crypto.PrivKey, id string) *cty.InputNote {
+	note, err := getNote(env.c.GetStateDB(), id)
+	assert.Nil(env.t, err)
+	secret, err := cty.OpenNote(priv.Bytes(), note)
+	assert.Nil(env.t, err)
+	return &cty.InputNote{Note: note, Secret: secret}
+}
+
+func (env *testEnv) balance(addr string) int64 {
+	return env.c.GetCoinsAccount().LoadExecAccount(addr, execAddr).Balance
+}
+
+func TestConfidential(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	alice, alicePriv := util.Genaddress()
+	bob, bobPriv := util.Genaddress()
+	_, err := env.c.GetCoinsAccount().ExecDeposit(alice, execAddr, 10*types.Coin)
+	assert.Nil(t, err)
+
+	//存入以后公开资产转到资金池，note只有承诺
+	deposit, err := cty.CreateDeposit(alicePriv.Bytes(), "coins", "", 10*types.Coin, alicePriv.PubKey().Bytes())
+	assert.Nil(t, err)
+	deposit.Amount = 11 * types.Coin
+	_, err = env.action(alicePriv).deposit(deposit)
+	assert.Equal(t, cty.ErrBalanceProof, err)
+	deposit.Amount = 10 * types.Coin
+	receipt, err := env.action(alicePriv).deposit(deposit)
+	assert.Nil(t, err)
+	noteID := calcNoteID(10, env.index, 0)
+	assert.Equal(t, int64(0), env.balance(alice))
+	assert.Equal(t, 10*types.Coin, env.balance(calcPoolAddr()))
+	assert.Equal(t, 10*types.Coin, env.input(alicePriv, noteID).Secret.Amount)
+	set, err := env.c.execLocal(&types.Transaction{Execer: []byte(cty.ConfidentialX)}, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs})
+	assert.Nil(t, err)
+	assert.Equal(t, calcOwnerIndexKey(alice, noteID), set.KV[0].Key)
+
+	//转给bob 3，找零7，篡改输出以后余额证明不通过
+	inputs := []*cty.InputNote{env.input(alicePriv, noteID)}
+	transfer, err := cty.CreateTransfer(alicePriv.Bytes(), inputs, []*cty.OutputSpec{{PubKey: bobPriv.PubKey().Bytes(), Amount: 3 * types.Coin}, {PubKey: alicePriv.PubKey().Bytes(), Amount: 7 * types.Coin}})
+	assert.Nil(t, err)
+	_, err = env.action(bobPriv).transfer(transfer)
+	assert.Equal(t, cty.ErrNoteOwner, err)
+	other, err := cty.CreateTransfer(alicePriv.Bytes(), inputs, []*cty.OutputSpec{{PubKey: bobPriv.PubKey().Bytes(), Amount: 10 * types.Coin}})
+	assert.Nil(t, err)
+	forged := *transfer
+	forged.Outputs = []*cty.ConfidentialOutput{other.Outputs[0]}
+	_, err = env.action(alicePriv).transfer(&forged)
+	assert.Equal(t, cty.ErrBalanceProof, err)
+	_, err = env.action(alicePriv).transfer(transfer)
+	assert.Nil(t, err)
+	bobNote, changeNote := calcNoteID(10, env.index, 0), calcNoteID(10, env.index, 1)
+	_, err = env.action(alicePriv).transfer(transfer)
+	assert.Equal(t, cty.ErrNoteSpent, err)
+	assert.Equal(t, 3*types.Coin, env.input(bobPriv, bobNote).Secret.Amount)
+	assert.Equal(t, 3*types.Coin, env.input(alicePriv, bobNote).Secret.Amount)
+
+	//bob取出2，找零1，公开资产从资金池转到bob
+	withdraw, err := cty.CreateWithdraw(bobPriv.Bytes(), []*cty.InputNote{env.input(bobPriv, bobNote)}, []*cty.OutputSpec{{PubKey: bobPriv.PubKey().Bytes(), Amount: types.Coin}}, 2*types.Coin, "")
+	assert.Nil(t, err)
+	withdraw.Amount = 3 * types.Coin
+	_, err = env.action(bobPriv).withdraw(withdraw)
+	assert.Equal(t, cty.ErrBalanceProof, err)
+	withdraw.Amount = 2 * types.Coin
+	_, err = env.action(bobPriv).withdraw(withdraw)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.Coin, env.balance(bob))
+	assert.Equal(t, 8*types.Coin, env.balance(calcPoolAddr()))
+
+	//同一个交易里重复使用同一个note不允许
+	_, err = env.action(alicePriv).withdraw(&cty.ConfidentialWithdraw{Inputs: []string{changeNote, changeNote}, Amount: 1})
+	assert.Equal(t, cty.ErrInputCount, err)
+	note, err := getNote(env.c.GetStateDB(), bobNote)
+	assert.Nil(t, err)
+	assert.True(t, note.Spent)
+	assert.Equal(t, bob, note.Owner)
+	assert.False(t, env.input(alicePriv, changeNote).Note.Spent)
+}
+
+func TestListNotes(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	alice, alicePriv := util.Genaddress()
+	_, err := env.c.GetCoinsAccount().ExecDeposit(alice, execAddr, 10*types.Coin)
+	assert.Nil(t, err)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		deposit, err := cty.CreateDeposit(alicePriv.Bytes(), "coins", "", types.Coin, alicePriv.PubKey().Bytes())
+		assert.Nil(t, err)
+		a := env.action(alicePriv)
+		receipt, err := a.deposit(deposit)
+		assert.Nil(t, err)
+		ids = append(ids, calcNoteID(10, env.index, 0))
+		tx := &types.Transaction{Execer: []byte(cty.ConfidentialX), Nonce: int64(i)}
+		set, err := env.c.execLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs})
+		assert.Nil(t, err)
+		for _, kv := range set.KV {
+			env.c.GetLocalDB().Set(kv.Key, kv.Value)
+		}
+	}
+	withdraw, err := cty.CreateWithdraw(alicePriv.Bytes(), []*cty.InputNote{env.input(alicePriv, ids[0])}, nil, types.Coin, "")
+	assert.Nil(t, err)
+	_, err = env.action(alicePriv).withdraw(withdraw)
+	assert.Nil(t, err)
+
+	reply, err := listNotes(env.c.GetLocalDB(), env.c.GetStateDB(), &cty.ReqConfidentialNotes{Owner: alice, Count: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(reply.Notes))
+	assert.Equal(t, ids[0], reply.Notes[0].Id)
+	reply, err = listNotes(env.c.GetLocalDB(), env.c.GetStateDB(), &cty.ReqConfidentialNotes{Owner: alice, PrimaryKey: reply.PrimaryKey})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(reply.Notes))
+	assert.Equal(t, ids[2], reply.Notes[0].Id)
+	reply, err = listNotes(env.c.GetLocalDB(), env.c.GetStateDB(), &cty.ReqConfidentialNotes{Owner: alice, UnspentOnly: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(reply.Notes))
+}
@@ -0,0 +1,306 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	cty "github.com/33cn/chain33/system/dapp/confidential/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	noteKeyPrefix    = "mavl-" + cty.ConfidentialX + "-note-"
+	ownerIndexPrefix = "LODB-" + cty.ConfidentialX + "-owner-"
+)
+
+func calcNoteKey(id string) []byte {
+	return []byte(noteKeyPrefix + id)
+}
+
+func calcOwnerIndexKey(owner, id string) []byte {
+	return []byte(ownerIndexPrefix + owner + "-" + id)
+}
+
+//calcNoteID note的id按交易的位置和输出的序号生成
+func calcNoteID(height int64, index int, i int) string {
+	return fmt.Sprintf("%018d-%02d", height*types.MaxTxsPerBlock+int64(index), i)
+}
+
+//calcPoolAddr 所有note对应的公开资产存在这个地址中，没有对应的私钥
+func calcPoolAddr() string {
+	return address.ExecAddress(cty.ConfidentialX + "-pool")
+}
+
+// Action confidential交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(c *Confidential, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: c.GetCoinsAccount(),
+		db:           c.GetStateDB(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       c.GetHeight(),
+		index:        index,
+	}
+}
+
+//assetAccount coins用执行器的coins账户，其他资产按执行器和symbol创建账户
+func (a *Action) assetAccount(exec, symbol string) (*account.DB, error) {
+	if exec == "coins" {
+		return a.coinsAccount, nil
+	}
+	return account.NewAccountDB(exec, symbol, a.db)
+}
+
+func getNote(db dbm.KV, id string) (*cty.ConfidentialNote, error) {
+	value, err := db.Get(calcNoteKey(id))
+	if err != nil || value == nil {
+		return nil, cty.ErrNoteNotExist
+	}
+	var note cty.ConfidentialNote
+	if err := types.Decode(value, &note); err != nil {
+		return nil, err
+	}
+	return &note, nil
+}
+
+func (a *Action) saveNote(prev, note *cty.ConfidentialNote) (*types.KeyValue, *types.ReceiptLog) {
+	kv := &types.KeyValue{Key: calcNoteKey(note.Id), Value: types.Encode(note)}
+	a.db.Set(kv.Key, kv.Value)
+	log := &types.ReceiptLog{Ty: cty.TyLogConfidentialNote, Log: types.Encode(&cty.ReceiptConfidentialNote{Prev: prev, Current: note})}
+	return kv, log
+}
+
+func outputCommitments(outputs []*cty.ConfidentialOutput) [][]byte {
+	var commitments [][]byte
+	for _, out := range outputs {
+		commitments = append(commitments, out.Commitment)
+	}
+	return commitments
+}
+
+//checkOutput 检查输出的格式，存入的输出金额是公开的，不需要范围证明
+func checkOutput(out *cty.ConfidentialOutput, withRange bool) error {
+	for _, p := range [][]byte{out.PubKey, out.Commitment, out.Ephemeral} {
+		if len(p) != 33 {
+			return cty.ErrPoint
+		}
+	}
+	for _, note := range [][]byte{out.ReceiverNote, out.SenderNote} {
+		if len(note) == 0 || len(note) > cty.MaxNoteLength {
+			return cty.ErrNoteLength
+		}
+	}
+	if withRange {
+		return cty.VerifyRangeProof(out.Commitment, out.RangeProof)
+	}
+	return nil
+}
+
+//loadInputs 检查输入的note都属于交易的发送者，没有花费，并且是同一种资产
+func (a *Action) loadInputs(inputs []string) ([]*cty.ConfidentialNote, [][]byte, error) {
+	if len(inputs) == 0 || len(inputs) > cty.MaxInputs {
+		return nil, nil, cty.ErrInputCount
+	}
+	seen := make(map[string]bool)
+	var notes []*cty.ConfidentialNote
+	var commitments [][]byte
+	for _, id := range inputs {
+		if seen[id] {
+			return nil, nil, cty.ErrInputCount
+		}
+		seen[id] = true
+		note, err := getNote(a.db, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if note.Owner != a.fromaddr {
+			return nil, nil, cty.ErrNoteOwner
+		}
+		if note.Spent {
+			return nil, nil, cty.ErrNoteSpent
+		}
+		if len(notes) > 0 && (note.AssetExec != notes[0].AssetExec || note.AssetSymbol != notes[0].AssetSymbol) {
+			return nil, nil, cty.ErrNoteAsset
+		}
+		notes = append(notes, note)
+		commitments = append(commitments, note.Commitment)
+	}
+	return notes, commitments, nil
+}
+
+//spend 把输入的note标记为已花费
+func (a *Action) spend(notes []*cty.ConfidentialNote, receipt *types.Receipt) {
+	for _, note := range notes {
+		spent := *note
+		spent.Spent = true
+		spent.SpentHeight = a.height
+		kv, log := a.saveNote(note, &spent)
+		receipt.KV = append(receipt.KV, kv)
+		receipt.Logs = append(receipt.Logs, log)
+	}
+}
+
+//create 生成新的note，拥有者是输出公钥对应的地址
+func (a *Action) create(outputs []*cty.ConfidentialOutput, assetExec, assetSymbol string, receipt *types.Receipt) {
+	for i, out := range outputs {
+		note := &cty.ConfidentialNote{
+			Id:           calcNoteID(a.height, a.index, i),
+			Owner:        address.PubKeyToAddr(out.PubKey),
+			AssetExec:    assetExec,
+			AssetSymbol:  assetSymbol,
+			PubKey:       out.PubKey,
+			Commitment:   out.Commitment,
+			Ephemeral:    out.Ephemeral,
+			ReceiverNote: out.ReceiverNote,
+			SenderNote:   out.SenderNote,
+			Height:       a.height,
+		}
+		kv, log := a.saveNote(nil, note)
+		receipt.KV = append(receipt.KV, kv)
+		receipt.Logs = append(receipt.Logs, log)
+	}
+}
+
+func (a *Action) deposit(payload *cty.ConfidentialDeposit) (*types.Receipt, error) {
+	if payload.Amount <= 0 {
+		return nil, cty.ErrAmount
+	}
+	if payload.Output == nil {
+		return nil, cty.ErrOutputCount
+	}
+	if err := checkOutput(payload.Output, false); err != nil {
+		return nil, err
+	}
+	outputs := []*cty.ConfidentialOutput{payload.Output}
+	if err := cty.VerifyBalance(nil, [][]byte{payload.Output.Commitment}, payload.Amount, 0, payload.Proof, cty.BalanceContext(nil, outputs, payload.Amount, 0, "")); err != nil {
+		return nil, err
+	}
+	exec, symbol := payload.AssetExec, payload.AssetSymbol
+	if exec == "" {
+		exec = "coins"
+	}
+	if exec == "coins" {
+		symbol = types.GetCoinSymbol()
+	}
+	acc, err := a.assetAccount(exec, symbol)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.ExecTransfer(a.fromaddr, calcPoolAddr(), a.execaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	a.create(outputs, exec, symbol, receipt)
+	return receipt, nil
+}
+
+func (a *Action) transfer(payload *cty.ConfidentialTransfer) (*types.Receipt, error) {
+	if len(payload.Outputs) == 0 || len(payload.Outputs) > cty.MaxOutputs {
+		return nil, cty.ErrOutputCount
+	}
+	for _, out := range payload.Outputs {
+		if err := checkOutput(out, true); err != nil {
+			return nil, err
+		}
+	}
+	notes, inputs, err := a.loadInputs(payload.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	if err := cty.VerifyBalance(inputs, outputCommitments(payload.Outputs), 0, 0, payload.Proof, cty.BalanceContext(payload.Inputs, payload.Outputs, 0, 0, "")); err != nil {
+		return nil, err
+	}
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	a.spend(notes, receipt)
+	a.create(payload.Outputs, notes[0].AssetExec, notes[0].AssetSymbol, receipt)
+	return receipt, nil
+}
+
+func (a *Action) withdraw(payload *cty.ConfidentialWithdraw) (*types.Receipt, error) {
+	if payload.Amount <= 0 {
+		return nil, cty.ErrAmount
+	}
+	if len(payload.Outputs) > cty.MaxOutputs {
+		return nil, cty.ErrOutputCount
+	}
+	to := payload.To
+	if to == "" {
+		to = a.fromaddr
+	}
+	if err := address.CheckAddress(to); err != nil {
+		return nil, err
+	}
+	for _, out := range payload.Outputs {
+		if err := checkOutput(out, true); err != nil {
+			return nil, err
+		}
+	}
+	notes, inputs, err := a.loadInputs(payload.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	if err := cty.VerifyBalance(inputs, outputCommitments(payload.Outputs), 0, payload.Amount, payload.Proof, cty.BalanceContext(payload.Inputs, payload.Outputs, 0, payload.Amount, payload.To)); err != nil {
+		return nil, err
+	}
+	acc, err := a.assetAccount(notes[0].AssetExec, notes[0].AssetSymbol)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.ExecTransfer(calcPoolAddr(), to, a.execaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	a.spend(notes, receipt)
+	a.create(payload.Outputs, notes[0].AssetExec, notes[0].AssetSymbol, receipt)
+	return receipt, nil
+}
+
+func listNotes(localdb dbm.KVDB, statedb dbm.KV, req *cty.ReqConfidentialNotes) (*cty.ReplyConfidentialNotes, error) {
+	if req.Owner == "" {
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count <= 0 {
+		count = cty.DefaultListCount
+	}
+	if count > cty.MaxListCount {
+		count = cty.MaxListCount
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcOwnerIndexKey(req.Owner, req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(ownerIndexPrefix+req.Owner+"-"), key, count, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &cty.ReplyConfidentialNotes{}
+	for _, value := range values {
+		note, err := getNote(statedb, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.PrimaryKey = note.Id
+		if req.UnspentOnly && note.Spent {
+			continue
+		}
+		reply.Notes = append(reply.Notes, note)
+	}
+	return reply, nil
+}
@@ -0,0 +1,28 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	cty "github.com/33cn/chain33/system/dapp/confidential/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Deposit 把执行器中的公开资产转成隐私note
+func (c *Confidential) Exec_Deposit(payload *cty.ConfidentialDeposit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(c, tx, index)
+	return action.deposit(payload)
+}
+
+// Exec_Transfer 花费自己的note，生成新的隐私输出
+func (c *Confidential) Exec_Transfer(payload *cty.ConfidentialTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(c, tx, index)
+	return action.transfer(payload)
+}
+
+// Exec_Withdraw 花费自己的note，把公开的金额转回执行器中的公开账户
+func (c *Confidential) Exec_Withdraw(payload *cty.ConfidentialWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(c, tx, index)
+	return action.withdraw(payload)
+}
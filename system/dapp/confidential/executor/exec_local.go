@@ -0,0 +1,46 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	cty "github.com/33cn/chain33/system/dapp/confidential/types"
+	"github.com/33cn/chain33/types"
+)
+
+//execLocal 新生成的note添加拥有者的索引
+func (c *Confidential) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != cty.TyLogConfidentialNote {
+			continue
+		}
+		var log cty.ReceiptConfidentialNote
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		if log.Prev == nil {
+			kvs = append(kvs, &types.KeyValue{Key: calcOwnerIndexKey(log.Current.Owner, log.Current.Id), Value: []byte(log.Current.Id)})
+		}
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+// ExecLocal_Deposit 添加note的索引
+func (c *Confidential) ExecLocal_Deposit(payload *cty.ConfidentialDeposit, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.execLocal(tx, receipt)
+}
+
+// ExecLocal_Transfer 添加note的索引
+func (c *Confidential) ExecLocal_Transfer(payload *cty.ConfidentialTransfer, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.execLocal(tx, receipt)
+}
+
+// ExecLocal_Withdraw 添加找零note的索引
+func (c *Confidential) ExecLocal_Withdraw(payload *cty.ConfidentialWithdraw, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.execLocal(tx, receipt)
+}
@@ -0,0 +1,20 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	cty "github.com/33cn/chain33/system/dapp/confidential/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetNote 按id查询note，金额需要用私钥解密
+func (c *Confidential) Query_GetNote(in *types.ReqString) (types.Message, error) {
+	return getNote(c.GetStateDB(), in.Data)
+}
+
+// Query_ListNotes 列出地址拥有的note
+func (c *Confidential) Query_ListNotes(in *cty.ReqConfidentialNotes) (types.Message, error) {
+	return listNotes(c.GetLocalDB(), c.GetStateDB(), in)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package confidential 隐藏金额的隐私转账执行器插件
+// 1. 公开资产存入执行器以后生成note，note只公开金额的Pedersen承诺，金额和盲因子用ECDH加密给接收者和发送者
+// 2. 转账花费自己的note生成新的note，每个输出带范围证明，余额证明保证输入和输出的金额相等
+// 3. 取出的时候公开取出的金额，找零继续以note的形式保存，本地数据库按拥有者索引note
+package confidential
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/confidential/commands"
+	"github.com/33cn/chain33/system/dapp/confidential/executor"
+	"github.com/33cn/chain33/system/dapp/confidential/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.ConfidentialX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.ConfidentialCmd,
+		RPC:      nil,
+	})
+}
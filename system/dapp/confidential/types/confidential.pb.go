@@ -0,0 +1,1049 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: confidential.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ConfidentialAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*ConfidentialAction_Deposit
+	//	*ConfidentialAction_Transfer
+	//	*ConfidentialAction_Withdraw
+	Value                isConfidentialAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                      `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ConfidentialAction) Reset()         { *m = ConfidentialAction{} }
+func (m *ConfidentialAction) String() string { return proto.CompactTextString(m) }
+func (*ConfidentialAction) ProtoMessage()    {}
+func (*ConfidentialAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{0}
+}
+
+func (m *ConfidentialAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfidentialAction.Unmarshal(m, b)
+}
+func (m *ConfidentialAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfidentialAction.Marshal(b, m, deterministic)
+}
+func (m *ConfidentialAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfidentialAction.Merge(m, src)
+}
+func (m *ConfidentialAction) XXX_Size() int {
+	return xxx_messageInfo_ConfidentialAction.Size(m)
+}
+func (m *ConfidentialAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfidentialAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfidentialAction proto.InternalMessageInfo
+
+type isConfidentialAction_Value interface {
+	isConfidentialAction_Value()
+}
+
+type ConfidentialAction_Deposit struct {
+	Deposit *ConfidentialDeposit `protobuf:"bytes,1,opt,name=deposit,proto3,oneof"`
+}
+
+type ConfidentialAction_Transfer struct {
+	Transfer *ConfidentialTransfer `protobuf:"bytes,2,opt,name=transfer,proto3,oneof"`
+}
+
+type ConfidentialAction_Withdraw struct {
+	Withdraw *ConfidentialWithdraw `protobuf:"bytes,3,opt,name=withdraw,proto3,oneof"`
+}
+
+func (*ConfidentialAction_Deposit) isConfidentialAction_Value() {}
+
+func (*ConfidentialAction_Transfer) isConfidentialAction_Value() {}
+
+func (*ConfidentialAction_Withdraw) isConfidentialAction_Value() {}
+
+func (m *ConfidentialAction) GetValue() isConfidentialAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ConfidentialAction) GetDeposit() *ConfidentialDeposit {
+	if x, ok := m.GetValue().(*ConfidentialAction_Deposit); ok {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (m *ConfidentialAction) GetTransfer() *ConfidentialTransfer {
+	if x, ok := m.GetValue().(*ConfidentialAction_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *ConfidentialAction) GetWithdraw() *ConfidentialWithdraw {
+	if x, ok := m.GetValue().(*ConfidentialAction_Withdraw); ok {
+		return x.Withdraw
+	}
+	return nil
+}
+
+func (m *ConfidentialAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ConfidentialAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ConfidentialAction_OneofMarshaler, _ConfidentialAction_OneofUnmarshaler, _ConfidentialAction_OneofSizer, []interface{}{
+		(*ConfidentialAction_Deposit)(nil),
+		(*ConfidentialAction_Transfer)(nil),
+		(*ConfidentialAction_Withdraw)(nil),
+	}
+}
+
+func _ConfidentialAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ConfidentialAction)
+	// value
+	switch x := m.Value.(type) {
+	case *ConfidentialAction_Deposit:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Deposit); err != nil {
+			return err
+		}
+	case *ConfidentialAction_Transfer:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Transfer); err != nil {
+			return err
+		}
+	case *ConfidentialAction_Withdraw:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Withdraw); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ConfidentialAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ConfidentialAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ConfidentialAction)
+	switch tag {
+	case 1: // value.deposit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfidentialDeposit)
+		err := b.DecodeMessage(msg)
+		m.Value = &ConfidentialAction_Deposit{msg}
+		return true, err
+	case 2: // value.transfer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfidentialTransfer)
+		err := b.DecodeMessage(msg)
+		m.Value = &ConfidentialAction_Transfer{msg}
+		return true, err
+	case 3: // value.withdraw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfidentialWithdraw)
+		err := b.DecodeMessage(msg)
+		m.Value = &ConfidentialAction_Withdraw{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ConfidentialAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ConfidentialAction)
+	// value
+	switch x := m.Value.(type) {
+	case *ConfidentialAction_Deposit:
+		s := proto.Size(x.Deposit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ConfidentialAction_Transfer:
+		s := proto.Size(x.Transfer)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ConfidentialAction_Withdraw:
+		s := proto.Size(x.Withdraw)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//第i位的承诺是 b*2^i*H + r*G，OR证明b是0或者1，所有位的承诺之和等于输出的承诺
+type BitProof struct {
+	Commitment           []byte   `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	E0                   []byte   `protobuf:"bytes,2,opt,name=e0,proto3" json:"e0,omitempty"`
+	E1                   []byte   `protobuf:"bytes,3,opt,name=e1,proto3" json:"e1,omitempty"`
+	S0                   []byte   `protobuf:"bytes,4,opt,name=s0,proto3" json:"s0,omitempty"`
+	S1                   []byte   `protobuf:"bytes,5,opt,name=s1,proto3" json:"s1,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BitProof) Reset()         { *m = BitProof{} }
+func (m *BitProof) String() string { return proto.CompactTextString(m) }
+func (*BitProof) ProtoMessage()    {}
+func (*BitProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{1}
+}
+
+func (m *BitProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BitProof.Unmarshal(m, b)
+}
+func (m *BitProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BitProof.Marshal(b, m, deterministic)
+}
+func (m *BitProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BitProof.Merge(m, src)
+}
+func (m *BitProof) XXX_Size() int {
+	return xxx_messageInfo_BitProof.Size(m)
+}
+func (m *BitProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BitProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BitProof proto.InternalMessageInfo
+
+func (m *BitProof) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *BitProof) GetE0() []byte {
+	if m != nil {
+		return m.E0
+	}
+	return nil
+}
+
+func (m *BitProof) GetE1() []byte {
+	if m != nil {
+		return m.E1
+	}
+	return nil
+}
+
+func (m *BitProof) GetS0() []byte {
+	if m != nil {
+		return m.S0
+	}
+	return nil
+}
+
+func (m *BitProof) GetS1() []byte {
+	if m != nil {
+		return m.S1
+	}
+	return nil
+}
+
+type RangeProof struct {
+	Bits                 []*BitProof `protobuf:"bytes,1,rep,name=bits,proto3" json:"bits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RangeProof) Reset()         { *m = RangeProof{} }
+func (m *RangeProof) String() string { return proto.CompactTextString(m) }
+func (*RangeProof) ProtoMessage()    {}
+func (*RangeProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{2}
+}
+
+func (m *RangeProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RangeProof.Unmarshal(m, b)
+}
+func (m *RangeProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RangeProof.Marshal(b, m, deterministic)
+}
+func (m *RangeProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeProof.Merge(m, src)
+}
+func (m *RangeProof) XXX_Size() int {
+	return xxx_messageInfo_RangeProof.Size(m)
+}
+func (m *RangeProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeProof proto.InternalMessageInfo
+
+func (m *RangeProof) GetBits() []*BitProof {
+	if m != nil {
+		return m.Bits
+	}
+	return nil
+}
+
+//承诺 C = v*H + r*G，拥有者是pubKey对应的地址
+//金额和盲因子用ECDH的密钥加密，接收者和发送者都可以解密
+type ConfidentialOutput struct {
+	PubKey               []byte      `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Commitment           []byte      `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	RangeProof           *RangeProof `protobuf:"bytes,3,opt,name=rangeProof,proto3" json:"rangeProof,omitempty"`
+	Ephemeral            []byte      `protobuf:"bytes,4,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	ReceiverNote         []byte      `protobuf:"bytes,5,opt,name=receiverNote,proto3" json:"receiverNote,omitempty"`
+	SenderNote           []byte      `protobuf:"bytes,6,opt,name=senderNote,proto3" json:"senderNote,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ConfidentialOutput) Reset()         { *m = ConfidentialOutput{} }
+func (m *ConfidentialOutput) String() string { return proto.CompactTextString(m) }
+func (*ConfidentialOutput) ProtoMessage()    {}
+func (*ConfidentialOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{3}
+}
+
+func (m *ConfidentialOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfidentialOutput.Unmarshal(m, b)
+}
+func (m *ConfidentialOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfidentialOutput.Marshal(b, m, deterministic)
+}
+func (m *ConfidentialOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfidentialOutput.Merge(m, src)
+}
+func (m *ConfidentialOutput) XXX_Size() int {
+	return xxx_messageInfo_ConfidentialOutput.Size(m)
+}
+func (m *ConfidentialOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfidentialOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfidentialOutput proto.InternalMessageInfo
+
+func (m *ConfidentialOutput) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *ConfidentialOutput) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *ConfidentialOutput) GetRangeProof() *RangeProof {
+	if m != nil {
+		return m.RangeProof
+	}
+	return nil
+}
+
+func (m *ConfidentialOutput) GetEphemeral() []byte {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return nil
+}
+
+func (m *ConfidentialOutput) GetReceiverNote() []byte {
+	if m != nil {
+		return m.ReceiverNote
+	}
+	return nil
+}
+
+func (m *ConfidentialOutput) GetSenderNote() []byte {
+	if m != nil {
+		return m.SenderNote
+	}
+	return nil
+}
+
+//输入承诺之和减去输出承诺之和，再减去公开金额对应的部分，只剩下 k*G，证明知道k
+type BalanceProof struct {
+	R                    []byte   `protobuf:"bytes,1,opt,name=r,proto3" json:"r,omitempty"`
+	S                    []byte   `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceProof) Reset()         { *m = BalanceProof{} }
+func (m *BalanceProof) String() string { return proto.CompactTextString(m) }
+func (*BalanceProof) ProtoMessage()    {}
+func (*BalanceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{4}
+}
+
+func (m *BalanceProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceProof.Unmarshal(m, b)
+}
+func (m *BalanceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceProof.Marshal(b, m, deterministic)
+}
+func (m *BalanceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceProof.Merge(m, src)
+}
+func (m *BalanceProof) XXX_Size() int {
+	return xxx_messageInfo_BalanceProof.Size(m)
+}
+func (m *BalanceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceProof proto.InternalMessageInfo
+
+func (m *BalanceProof) GetR() []byte {
+	if m != nil {
+		return m.R
+	}
+	return nil
+}
+
+func (m *BalanceProof) GetS() []byte {
+	if m != nil {
+		return m.S
+	}
+	return nil
+}
+
+//把执行器中的公开资产转成隐私的note，金额是公开的
+type ConfidentialDeposit struct {
+	AssetExec            string              `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string              `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Amount               int64               `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Output               *ConfidentialOutput `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	Proof                *BalanceProof       `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ConfidentialDeposit) Reset()         { *m = ConfidentialDeposit{} }
+func (m *ConfidentialDeposit) String() string { return proto.CompactTextString(m) }
+func (*ConfidentialDeposit) ProtoMessage()    {}
+func (*ConfidentialDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{5}
+}
+
+func (m *ConfidentialDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfidentialDeposit.Unmarshal(m, b)
+}
+func (m *ConfidentialDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfidentialDeposit.Marshal(b, m, deterministic)
+}
+func (m *ConfidentialDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfidentialDeposit.Merge(m, src)
+}
+func (m *ConfidentialDeposit) XXX_Size() int {
+	return xxx_messageInfo_ConfidentialDeposit.Size(m)
+}
+func (m *ConfidentialDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfidentialDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfidentialDeposit proto.InternalMessageInfo
+
+func (m *ConfidentialDeposit) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ConfidentialDeposit) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *ConfidentialDeposit) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ConfidentialDeposit) GetOutput() *ConfidentialOutput {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *ConfidentialDeposit) GetProof() *BalanceProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type ConfidentialTransfer struct {
+	Inputs               []string              `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs              []*ConfidentialOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Proof                *BalanceProof         `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ConfidentialTransfer) Reset()         { *m = ConfidentialTransfer{} }
+func (m *ConfidentialTransfer) String() string { return proto.CompactTextString(m) }
+func (*ConfidentialTransfer) ProtoMessage()    {}
+func (*ConfidentialTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{6}
+}
+
+func (m *ConfidentialTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfidentialTransfer.Unmarshal(m, b)
+}
+func (m *ConfidentialTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfidentialTransfer.Marshal(b, m, deterministic)
+}
+func (m *ConfidentialTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfidentialTransfer.Merge(m, src)
+}
+func (m *ConfidentialTransfer) XXX_Size() int {
+	return xxx_messageInfo_ConfidentialTransfer.Size(m)
+}
+func (m *ConfidentialTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfidentialTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfidentialTransfer proto.InternalMessageInfo
+
+func (m *ConfidentialTransfer) GetInputs() []string {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *ConfidentialTransfer) GetOutputs() []*ConfidentialOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *ConfidentialTransfer) GetProof() *BalanceProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+//把隐私的note转回执行器中的公开资产，找零通过outputs
+type ConfidentialWithdraw struct {
+	Inputs               []string              `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs              []*ConfidentialOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Amount               int64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	To                   string                `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Proof                *BalanceProof         `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ConfidentialWithdraw) Reset()         { *m = ConfidentialWithdraw{} }
+func (m *ConfidentialWithdraw) String() string { return proto.CompactTextString(m) }
+func (*ConfidentialWithdraw) ProtoMessage()    {}
+func (*ConfidentialWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{7}
+}
+
+func (m *ConfidentialWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfidentialWithdraw.Unmarshal(m, b)
+}
+func (m *ConfidentialWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfidentialWithdraw.Marshal(b, m, deterministic)
+}
+func (m *ConfidentialWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfidentialWithdraw.Merge(m, src)
+}
+func (m *ConfidentialWithdraw) XXX_Size() int {
+	return xxx_messageInfo_ConfidentialWithdraw.Size(m)
+}
+func (m *ConfidentialWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfidentialWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfidentialWithdraw proto.InternalMessageInfo
+
+func (m *ConfidentialWithdraw) GetInputs() []string {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *ConfidentialWithdraw) GetOutputs() []*ConfidentialOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *ConfidentialWithdraw) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ConfidentialWithdraw) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ConfidentialWithdraw) GetProof() *BalanceProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+//note中加密的内容
+type ConfidentialSecret struct {
+	Amount               int64    `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Blind                []byte   `protobuf:"bytes,2,opt,name=blind,proto3" json:"blind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfidentialSecret) Reset()         { *m = ConfidentialSecret{} }
+func (m *ConfidentialSecret) String() string { return proto.CompactTextString(m) }
+func (*ConfidentialSecret) ProtoMessage()    {}
+func (*ConfidentialSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{8}
+}
+
+func (m *ConfidentialSecret) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfidentialSecret.Unmarshal(m, b)
+}
+func (m *ConfidentialSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfidentialSecret.Marshal(b, m, deterministic)
+}
+func (m *ConfidentialSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfidentialSecret.Merge(m, src)
+}
+func (m *ConfidentialSecret) XXX_Size() int {
+	return xxx_messageInfo_ConfidentialSecret.Size(m)
+}
+func (m *ConfidentialSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfidentialSecret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfidentialSecret proto.InternalMessageInfo
+
+func (m *ConfidentialSecret) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ConfidentialSecret) GetBlind() []byte {
+	if m != nil {
+		return m.Blind
+	}
+	return nil
+}
+
+type ConfidentialNote struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AssetExec            string   `protobuf:"bytes,3,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,4,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,5,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Commitment           []byte   `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Ephemeral            []byte   `protobuf:"bytes,7,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	ReceiverNote         []byte   `protobuf:"bytes,8,opt,name=receiverNote,proto3" json:"receiverNote,omitempty"`
+	SenderNote           []byte   `protobuf:"bytes,9,opt,name=senderNote,proto3" json:"senderNote,omitempty"`
+	Height               int64    `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	Spent                bool     `protobuf:"varint,11,opt,name=spent,proto3" json:"spent,omitempty"`
+	SpentHeight          int64    `protobuf:"varint,12,opt,name=spentHeight,proto3" json:"spentHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfidentialNote) Reset()         { *m = ConfidentialNote{} }
+func (m *ConfidentialNote) String() string { return proto.CompactTextString(m) }
+func (*ConfidentialNote) ProtoMessage()    {}
+func (*ConfidentialNote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{9}
+}
+
+func (m *ConfidentialNote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfidentialNote.Unmarshal(m, b)
+}
+func (m *ConfidentialNote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfidentialNote.Marshal(b, m, deterministic)
+}
+func (m *ConfidentialNote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfidentialNote.Merge(m, src)
+}
+func (m *ConfidentialNote) XXX_Size() int {
+	return xxx_messageInfo_ConfidentialNote.Size(m)
+}
+func (m *ConfidentialNote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfidentialNote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfidentialNote proto.InternalMessageInfo
+
+func (m *ConfidentialNote) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ConfidentialNote) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ConfidentialNote) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ConfidentialNote) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *ConfidentialNote) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *ConfidentialNote) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *ConfidentialNote) GetEphemeral() []byte {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return nil
+}
+
+func (m *ConfidentialNote) GetReceiverNote() []byte {
+	if m != nil {
+		return m.ReceiverNote
+	}
+	return nil
+}
+
+func (m *ConfidentialNote) GetSenderNote() []byte {
+	if m != nil {
+		return m.SenderNote
+	}
+	return nil
+}
+
+func (m *ConfidentialNote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConfidentialNote) GetSpent() bool {
+	if m != nil {
+		return m.Spent
+	}
+	return false
+}
+
+func (m *ConfidentialNote) GetSpentHeight() int64 {
+	if m != nil {
+		return m.SpentHeight
+	}
+	return 0
+}
+
+type ReceiptConfidentialNote struct {
+	Prev                 *ConfidentialNote `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ConfidentialNote `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptConfidentialNote) Reset()         { *m = ReceiptConfidentialNote{} }
+func (m *ReceiptConfidentialNote) String() string { return proto.CompactTextString(m) }
+func (*ReceiptConfidentialNote) ProtoMessage()    {}
+func (*ReceiptConfidentialNote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{10}
+}
+
+func (m *ReceiptConfidentialNote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptConfidentialNote.Unmarshal(m, b)
+}
+func (m *ReceiptConfidentialNote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptConfidentialNote.Marshal(b, m, deterministic)
+}
+func (m *ReceiptConfidentialNote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptConfidentialNote.Merge(m, src)
+}
+func (m *ReceiptConfidentialNote) XXX_Size() int {
+	return xxx_messageInfo_ReceiptConfidentialNote.Size(m)
+}
+func (m *ReceiptConfidentialNote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptConfidentialNote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptConfidentialNote proto.InternalMessageInfo
+
+func (m *ReceiptConfidentialNote) GetPrev() *ConfidentialNote {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptConfidentialNote) GetCurrent() *ConfidentialNote {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqConfidentialNotes struct {
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	UnspentOnly          bool     `protobuf:"varint,4,opt,name=unspentOnly,proto3" json:"unspentOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqConfidentialNotes) Reset()         { *m = ReqConfidentialNotes{} }
+func (m *ReqConfidentialNotes) String() string { return proto.CompactTextString(m) }
+func (*ReqConfidentialNotes) ProtoMessage()    {}
+func (*ReqConfidentialNotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{11}
+}
+
+func (m *ReqConfidentialNotes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqConfidentialNotes.Unmarshal(m, b)
+}
+func (m *ReqConfidentialNotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqConfidentialNotes.Marshal(b, m, deterministic)
+}
+func (m *ReqConfidentialNotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqConfidentialNotes.Merge(m, src)
+}
+func (m *ReqConfidentialNotes) XXX_Size() int {
+	return xxx_messageInfo_ReqConfidentialNotes.Size(m)
+}
+func (m *ReqConfidentialNotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqConfidentialNotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqConfidentialNotes proto.InternalMessageInfo
+
+func (m *ReqConfidentialNotes) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqConfidentialNotes) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqConfidentialNotes) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqConfidentialNotes) GetUnspentOnly() bool {
+	if m != nil {
+		return m.UnspentOnly
+	}
+	return false
+}
+
+type ReplyConfidentialNotes struct {
+	Notes                []*ConfidentialNote `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	PrimaryKey           string              `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReplyConfidentialNotes) Reset()         { *m = ReplyConfidentialNotes{} }
+func (m *ReplyConfidentialNotes) String() string { return proto.CompactTextString(m) }
+func (*ReplyConfidentialNotes) ProtoMessage()    {}
+func (*ReplyConfidentialNotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f74ca4a15510d5, []int{12}
+}
+
+func (m *ReplyConfidentialNotes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyConfidentialNotes.Unmarshal(m, b)
+}
+func (m *ReplyConfidentialNotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyConfidentialNotes.Marshal(b, m, deterministic)
+}
+func (m *ReplyConfidentialNotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyConfidentialNotes.Merge(m, src)
+}
+func (m *ReplyConfidentialNotes) XXX_Size() int {
+	return xxx_messageInfo_ReplyConfidentialNotes.Size(m)
+}
+func (m *ReplyConfidentialNotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyConfidentialNotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyConfidentialNotes proto.InternalMessageInfo
+
+func (m *ReplyConfidentialNotes) GetNotes() []*ConfidentialNote {
+	if m != nil {
+		return m.Notes
+	}
+	return nil
+}
+
+func (m *ReplyConfidentialNotes) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ConfidentialAction)(nil), "types.ConfidentialAction")
+	proto.RegisterType((*BitProof)(nil), "types.BitProof")
+	proto.RegisterType((*RangeProof)(nil), "types.RangeProof")
+	proto.RegisterType((*ConfidentialOutput)(nil), "types.ConfidentialOutput")
+	proto.RegisterType((*BalanceProof)(nil), "types.BalanceProof")
+	proto.RegisterType((*ConfidentialDeposit)(nil), "types.ConfidentialDeposit")
+	proto.RegisterType((*ConfidentialTransfer)(nil), "types.ConfidentialTransfer")
+	proto.RegisterType((*ConfidentialWithdraw)(nil), "types.ConfidentialWithdraw")
+	proto.RegisterType((*ConfidentialSecret)(nil), "types.ConfidentialSecret")
+	proto.RegisterType((*ConfidentialNote)(nil), "types.ConfidentialNote")
+	proto.RegisterType((*ReceiptConfidentialNote)(nil), "types.ReceiptConfidentialNote")
+	proto.RegisterType((*ReqConfidentialNotes)(nil), "types.ReqConfidentialNotes")
+	proto.RegisterType((*ReplyConfidentialNotes)(nil), "types.ReplyConfidentialNotes")
+}
+
+func init() { proto.RegisterFile("confidential.proto", fileDescriptor_c3f74ca4a15510d5) }
+
+var fileDescriptor_c3f74ca4a15510d5 = []byte{
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xae, 0x9d, 0x38, 0x3f, 0x27, 0x51, 0xef, 0xbd, 0xd3, 0xaa, 0xcd, 0x05, 0x54, 0x45, 0x66,
+	0x13, 0x40, 0x54, 0x75, 0x2b, 0x21, 0xb1, 0x24, 0x80, 0x54, 0x09, 0x89, 0xa2, 0x29, 0x12, 0x6b,
+	0xc7, 0x99, 0x36, 0x83, 0xec, 0x19, 0x33, 0x33, 0x6e, 0xf1, 0x9e, 0x35, 0x8f, 0xc3, 0x4b, 0xf0,
+	0x08, 0xac, 0xe0, 0x49, 0xd0, 0xfc, 0xb8, 0x71, 0x7e, 0xda, 0x08, 0x89, 0x9d, 0xbf, 0xe3, 0xef,
+	0xf8, 0x7c, 0xe7, 0x9b, 0x73, 0x3c, 0x80, 0x12, 0xce, 0x2e, 0xe8, 0x94, 0x30, 0x45, 0xe3, 0xf4,
+	0x30, 0x17, 0x5c, 0x71, 0x14, 0xa8, 0x32, 0x27, 0x32, 0xfc, 0xe1, 0x01, 0x7a, 0x59, 0x7b, 0xfb,
+	0x22, 0x51, 0x94, 0x33, 0xf4, 0x0c, 0xda, 0x53, 0x92, 0x73, 0x49, 0xd5, 0xc0, 0x1b, 0x7a, 0xa3,
+	0xde, 0xf1, 0xbd, 0x43, 0xc3, 0x3f, 0xac, 0x73, 0x5f, 0x59, 0xc6, 0xe9, 0x16, 0xae, 0xc8, 0xe8,
+	0x39, 0x74, 0x94, 0x88, 0x99, 0xbc, 0x20, 0x62, 0xe0, 0x9b, 0xc4, 0xfb, 0x6b, 0x12, 0xdf, 0x3b,
+	0xca, 0xe9, 0x16, 0xbe, 0xa1, 0xeb, 0xd4, 0x6b, 0xaa, 0x66, 0x53, 0x11, 0x5f, 0x0f, 0x1a, 0xb7,
+	0xa6, 0x7e, 0x70, 0x14, 0x9d, 0x5a, 0xd1, 0xd1, 0x36, 0xf8, 0xaa, 0x1c, 0x34, 0x87, 0xde, 0x28,
+	0xc0, 0xbe, 0x2a, 0xc7, 0x6d, 0x08, 0xae, 0xe2, 0xb4, 0x20, 0xe1, 0x47, 0xe8, 0x8c, 0xa9, 0x7a,
+	0x27, 0x38, 0xbf, 0x40, 0x07, 0x00, 0x09, 0xcf, 0x32, 0xaa, 0x32, 0xc2, 0x6c, 0x57, 0x7d, 0x5c,
+	0x8b, 0xe8, 0x8f, 0x90, 0x23, 0x23, 0xba, 0x8f, 0x7d, 0x72, 0x64, 0x70, 0x64, 0x94, 0x68, 0x1c,
+	0x69, 0x2c, 0x8f, 0x4c, 0x91, 0x3e, 0xf6, 0xa5, 0x79, 0x2f, 0xa3, 0x41, 0xe0, 0x70, 0x14, 0x46,
+	0x00, 0x38, 0x66, 0x97, 0xc4, 0x56, 0x7b, 0x08, 0xcd, 0x09, 0x55, 0x72, 0xe0, 0x0d, 0x1b, 0xa3,
+	0xde, 0xf1, 0x3f, 0xae, 0x93, 0x4a, 0x0c, 0x36, 0x2f, 0xc3, 0x9f, 0x4b, 0xe6, 0x9f, 0x15, 0x2a,
+	0x2f, 0x14, 0xda, 0x83, 0x56, 0x5e, 0x4c, 0xde, 0x90, 0xd2, 0xa9, 0x74, 0x68, 0xa9, 0x03, 0x7f,
+	0xa5, 0x83, 0x08, 0x40, 0xdc, 0x28, 0x70, 0x1e, 0xfe, 0xe7, 0x2a, 0xcf, 0xa5, 0xe1, 0x1a, 0x09,
+	0x3d, 0x80, 0x2e, 0xc9, 0x67, 0x24, 0x23, 0x22, 0x4e, 0x5d, 0x6f, 0xf3, 0x00, 0x0a, 0xa1, 0x2f,
+	0x48, 0x42, 0xe8, 0x15, 0x11, 0x6f, 0xb9, 0x22, 0xae, 0xd9, 0x85, 0x98, 0x16, 0x25, 0x09, 0x9b,
+	0x3a, 0x46, 0xcb, 0x8a, 0x9a, 0x47, 0xc2, 0xc7, 0xd0, 0x1f, 0xc7, 0x69, 0xcc, 0x12, 0x57, 0xb1,
+	0x0f, 0x9e, 0x70, 0x7d, 0x79, 0x42, 0x23, 0xe9, 0x3a, 0xf1, 0x64, 0xf8, 0xdd, 0x83, 0x9d, 0x35,
+	0x03, 0xa6, 0x55, 0xc6, 0x52, 0x12, 0xf5, 0xfa, 0x33, 0x49, 0x4c, 0x6e, 0x17, 0xcf, 0x03, 0x68,
+	0x08, 0x3d, 0x03, 0xce, 0xcb, 0x6c, 0xc2, 0x53, 0xf3, 0xb5, 0x2e, 0xae, 0x87, 0xb4, 0xa1, 0x71,
+	0xc6, 0x0b, 0xa6, 0x8c, 0x29, 0x0d, 0xec, 0x10, 0x8a, 0xa0, 0xc5, 0x8d, 0xe5, 0xa6, 0xf5, 0xde,
+	0xf1, 0xff, 0x6b, 0x06, 0xce, 0x9e, 0x09, 0x76, 0x44, 0xf4, 0x08, 0x82, 0xdc, 0xd8, 0x1b, 0x98,
+	0x8c, 0x9d, 0xea, 0x60, 0x6b, 0x2d, 0x62, 0xcb, 0x08, 0xbf, 0x7a, 0xb0, 0xbb, 0x6e, 0xea, 0xb5,
+	0x1c, 0xca, 0xf2, 0xc2, 0x4d, 0x47, 0x17, 0x3b, 0x84, 0x4e, 0xa0, 0x6d, 0xab, 0x68, 0x4b, 0x1a,
+	0x77, 0xeb, 0xa9, 0x98, 0x73, 0x41, 0x8d, 0x8d, 0x82, 0xbe, 0x2d, 0x09, 0xaa, 0x76, 0xe9, 0xef,
+	0x0a, 0xba, 0xcd, 0x6c, 0xbd, 0xa4, 0xdc, 0x18, 0xdd, 0xc5, 0xbe, 0xe2, 0x7f, 0xe2, 0xe4, 0x78,
+	0x71, 0x4d, 0xce, 0x49, 0x22, 0x88, 0xaa, 0x15, 0xf2, 0x16, 0x0a, 0xed, 0x42, 0x30, 0x49, 0x29,
+	0x9b, 0xba, 0xb9, 0xb2, 0x20, 0xfc, 0xe5, 0xc3, 0xbf, 0xf5, 0x8f, 0x98, 0xe1, 0xdd, 0x06, 0x9f,
+	0x4e, 0xdd, 0x44, 0xf9, 0x74, 0xaa, 0x53, 0xf9, 0x35, 0x73, 0xff, 0xae, 0x2e, 0xb6, 0x60, 0x71,
+	0xfc, 0x1a, 0x1b, 0xc6, 0xaf, 0xb9, 0x76, 0xfc, 0xdc, 0x3e, 0x07, 0x77, 0xec, 0x73, 0x6b, 0x65,
+	0x9f, 0x17, 0x96, 0xb3, 0xbd, 0x69, 0x39, 0x3b, 0x1b, 0x97, 0xb3, 0xbb, 0xbc, 0x9c, 0x5a, 0xd9,
+	0x8c, 0xd0, 0xcb, 0x99, 0x1a, 0x80, 0xb5, 0xd0, 0x22, 0xed, 0x83, 0xcc, 0xb5, 0xa8, 0xde, 0xd0,
+	0x1b, 0x75, 0xb0, 0x05, 0xba, 0x53, 0xf3, 0x70, 0x6a, 0x53, 0xfa, 0x26, 0xa5, 0x1e, 0x0a, 0x4b,
+	0xd8, 0xc7, 0xba, 0x7e, 0xae, 0x56, 0xac, 0x7e, 0x02, 0xcd, 0x5c, 0x90, 0x2b, 0x77, 0x9d, 0xec,
+	0xaf, 0x19, 0x24, 0x4d, 0xc3, 0x86, 0x84, 0x22, 0x68, 0x27, 0x85, 0x10, 0xd5, 0x6f, 0xee, 0x0e,
+	0x7e, 0xc5, 0x0b, 0xbf, 0x78, 0xb0, 0x8b, 0xc9, 0xa7, 0x65, 0x82, 0x9c, 0x9f, 0xa9, 0x57, 0x3f,
+	0xd3, 0x03, 0x80, 0x5c, 0xd0, 0x2c, 0x16, 0xa5, 0x3e, 0x17, 0x7b, 0xdc, 0xb5, 0x88, 0xce, 0x4a,
+	0x6e, 0x86, 0x38, 0xc0, 0x16, 0x68, 0x07, 0x0a, 0x66, 0x1a, 0x3e, 0x63, 0xa9, 0xbd, 0x71, 0x3a,
+	0xb8, 0x1e, 0x0a, 0x2f, 0x61, 0x0f, 0x93, 0x3c, 0x2d, 0x57, 0x75, 0x3c, 0x85, 0x80, 0xe9, 0x07,
+	0x77, 0x25, 0xdc, 0xda, 0x91, 0x65, 0x6d, 0x12, 0x38, 0x69, 0x99, 0x6b, 0xfc, 0xe4, 0x77, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x2b, 0x07, 0x18, 0x62, 0xdc, 0x07, 0x00, 0x00,
+}
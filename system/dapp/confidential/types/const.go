@@ -0,0 +1,33 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// confidential action ty
+const (
+	ConfidentialActionDeposit = iota + 1
+	ConfidentialActionTransfer
+	ConfidentialActionWithdraw
+)
+
+// confidential log ty
+const (
+	TyLogConfidentialNote = 560
+)
+
+// query func name
+const (
+	FuncNameGetNote   = "GetNote"
+	FuncNameListNotes = "ListNotes"
+	//RangeBits 范围证明的位数，输出的金额在 [0, 2^63) 之间
+	RangeBits = 63
+	//MaxInputs 一个交易最多花费的note数
+	MaxInputs = 16
+	//MaxOutputs 一个交易最多的输出数，每个输出的范围证明大约10K
+	MaxOutputs = 4
+	//MaxNoteLength 加密的note的最大长度
+	MaxNoteLength    = 128
+	DefaultListCount = 20
+	MaxListCount     = 100
+)
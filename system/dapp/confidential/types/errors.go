@@ -0,0 +1,38 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrPoint 椭圆曲线上的点不合法
+	ErrPoint = errors.New("ErrPoint")
+	// ErrScalar 标量不合法
+	ErrScalar = errors.New("ErrScalar")
+	// ErrRangeProof 范围证明验证失败
+	ErrRangeProof = errors.New("ErrRangeProof")
+	// ErrBalanceProof 余额证明验证失败
+	ErrBalanceProof = errors.New("ErrBalanceProof")
+	// ErrBalance 输入的金额和输出的金额不相等
+	ErrBalance = errors.New("ErrBalance")
+	// ErrNoteNotExist note不存在
+	ErrNoteNotExist = errors.New("ErrNoteNotExist")
+	// ErrNoteSpent note已经花费
+	ErrNoteSpent = errors.New("ErrNoteSpent")
+	// ErrNoteOwner 不是note的拥有者
+	ErrNoteOwner = errors.New("ErrNoteOwner")
+	// ErrNoteAsset 输入的note不是同一种资产
+	ErrNoteAsset = errors.New("ErrNoteAsset")
+	// ErrNoteLength 加密的note长度不合法
+	ErrNoteLength = errors.New("ErrNoteLength")
+	// ErrDecodeNote 解密note失败
+	ErrDecodeNote = errors.New("ErrDecodeNote")
+	// ErrInputCount 输入的数量不合法或者有重复的输入
+	ErrInputCount = errors.New("ErrInputCount")
+	// ErrOutputCount 输出的数量不合法
+	ErrOutputCount = errors.New("ErrOutputCount")
+	// ErrAmount 金额不合法
+	ErrAmount = errors.New("ErrAmount")
+)
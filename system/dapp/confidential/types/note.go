@@ -0,0 +1,221 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+	"math/big"
+
+	"github.com/33cn/chain33/types"
+)
+
+// InputNote 构造交易时花费的note，secret 是用私钥解密出来的金额和盲因子
+type InputNote struct {
+	Note   *ConfidentialNote
+	Secret *ConfidentialSecret
+}
+
+// OutputSpec 构造交易时的输出，pubKey 是接收者的公钥
+type OutputSpec struct {
+	PubKey []byte
+	Amount int64
+}
+
+//noteKey ECDH的共享点的x坐标求哈希作为AES的密钥
+func noteKey(pub *point, k *big.Int) []byte {
+	shared := mulPoint(pub, k)
+	x := make([]byte, 32)
+	b := shared.x.Bytes()
+	copy(x[32-len(b):], b)
+	key := sha256.Sum256(append(x, []byte("chain33-confidential-note")...))
+	return key[:]
+}
+
+func sealNote(key []byte, secret *ConfidentialSecret) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, types.Encode(secret), nil), nil
+}
+
+func openNote(key, data []byte) (*ConfidentialSecret, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, ErrDecodeNote
+	}
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrDecodeNote
+	}
+	var secret ConfidentialSecret
+	if err := types.Decode(plain, &secret); err != nil {
+		return nil, ErrDecodeNote
+	}
+	return &secret, nil
+}
+
+// OpenNote 接收者或者发送者用私钥解密note，并检查解密的金额和盲因子与承诺一致
+func OpenNote(priv []byte, note *ConfidentialNote) (*ConfidentialSecret, error) {
+	ephemeral, err := decodePoint(note.Ephemeral)
+	if err != nil {
+		return nil, err
+	}
+	c, err := decodePoint(note.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	key := noteKey(ephemeral, new(big.Int).SetBytes(priv))
+	for _, data := range [][]byte{note.ReceiverNote, note.SenderNote} {
+		secret, err := openNote(key, data)
+		if err != nil {
+			continue
+		}
+		blind, err := decodeScalar(secret.Blind)
+		if err != nil || secret.Amount < 0 || !commit(secret.Amount, blind).equal(c) {
+			return nil, ErrDecodeNote
+		}
+		return secret, nil
+	}
+	return nil, ErrDecodeNote
+}
+
+//newOutput 生成输出的承诺，用一次性的密钥分别给接收者和发送者加密金额和盲因子
+func newOutput(senderPub []byte, spec *OutputSpec, withRange bool) (*ConfidentialOutput, *big.Int, error) {
+	if spec.Amount < 0 {
+		return nil, nil, ErrAmount
+	}
+	receiver, err := decodePoint(spec.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	sender, err := decodePoint(senderPub)
+	if err != nil {
+		return nil, nil, err
+	}
+	blind, err := randScalar()
+	if err != nil {
+		return nil, nil, err
+	}
+	e, err := randScalar()
+	if err != nil {
+		return nil, nil, err
+	}
+	out := &ConfidentialOutput{
+		PubKey:     spec.PubKey,
+		Commitment: encodePoint(commit(spec.Amount, blind)),
+		Ephemeral:  encodePoint(baseMul(e)),
+	}
+	secret := &ConfidentialSecret{Amount: spec.Amount, Blind: encodeScalar(blind)}
+	if out.ReceiverNote, err = sealNote(noteKey(receiver, e), secret); err != nil {
+		return nil, nil, err
+	}
+	if out.SenderNote, err = sealNote(noteKey(sender, e), secret); err != nil {
+		return nil, nil, err
+	}
+	if withRange {
+		if out.RangeProof, err = proveRange(spec.Amount, blind, out.Commitment); err != nil {
+			return nil, nil, err
+		}
+	}
+	return out, blind, nil
+}
+
+// PubKeyFromPriv secp256k1私钥对应的压缩公钥
+func PubKeyFromPriv(priv []byte) []byte {
+	return encodePoint(baseMul(new(big.Int).SetBytes(priv)))
+}
+
+//buildSpend 检查输入输出的金额，生成输出和余额证明，k 是输入和输出盲因子的差
+func buildSpend(priv []byte, inputs []*InputNote, specs []*OutputSpec, pubOut int64, to string) ([]string, []*ConfidentialOutput, *BalanceProof, error) {
+	var ids []string
+	var total int64
+	k := new(big.Int)
+	for _, in := range inputs {
+		blind, err := decodeScalar(in.Secret.Blind)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		ids = append(ids, in.Note.Id)
+		total += in.Secret.Amount
+		k.Add(k, blind)
+	}
+	total -= pubOut
+	var outputs []*ConfidentialOutput
+	for _, spec := range specs {
+		out, blind, err := newOutput(PubKeyFromPriv(priv), spec, true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		outputs = append(outputs, out)
+		total -= spec.Amount
+		k.Sub(k, blind)
+	}
+	if total != 0 {
+		return nil, nil, nil, ErrBalance
+	}
+	proof, err := proveBalance(k, BalanceContext(ids, outputs, 0, pubOut, to))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return ids, outputs, proof, nil
+}
+
+// CreateDeposit 构造把公开资产转成隐私note的交易内容
+func CreateDeposit(priv []byte, assetExec, assetSymbol string, amount int64, receiverPub []byte) (*ConfidentialDeposit, error) {
+	if amount <= 0 {
+		return nil, ErrAmount
+	}
+	out, blind, err := newOutput(PubKeyFromPriv(priv), &OutputSpec{PubKey: receiverPub, Amount: amount}, false)
+	if err != nil {
+		return nil, err
+	}
+	outputs := []*ConfidentialOutput{out}
+	proof, err := proveBalance(new(big.Int).Neg(blind), BalanceContext(nil, outputs, amount, 0, ""))
+	if err != nil {
+		return nil, err
+	}
+	return &ConfidentialDeposit{AssetExec: assetExec, AssetSymbol: assetSymbol, Amount: amount, Output: out, Proof: proof}, nil
+}
+
+// CreateTransfer 构造隐私转账的交易内容，输入和输出的金额必须相等
+func CreateTransfer(priv []byte, inputs []*InputNote, outputs []*OutputSpec) (*ConfidentialTransfer, error) {
+	ids, outs, proof, err := buildSpend(priv, inputs, outputs, 0, "")
+	if err != nil {
+		return nil, err
+	}
+	return &ConfidentialTransfer{Inputs: ids, Outputs: outs, Proof: proof}, nil
+}
+
+// CreateWithdraw 构造把隐私note转回公开资产的交易内容，outputs 是找零
+func CreateWithdraw(priv []byte, inputs []*InputNote, outputs []*OutputSpec, amount int64, to string) (*ConfidentialWithdraw, error) {
+	if amount <= 0 {
+		return nil, ErrAmount
+	}
+	ids, outs, proof, err := buildSpend(priv, inputs, outputs, amount, to)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfidentialWithdraw{Inputs: ids, Outputs: outs, Amount: amount, To: to, Proof: proof}, nil
+}
@@ -0,0 +1,328 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+//承诺使用secp256k1曲线，G是曲线的基点，H由哈希生成，没有人知道H相对G的离散对数
+var (
+	curve    = btcec.S256()
+	pointG   = &point{x: curve.Gx, y: curve.Gy}
+	pointH   = generatorH()
+	pow2H    = powersOfH()
+	infinity = &point{x: new(big.Int), y: new(big.Int)}
+)
+
+type point struct {
+	x, y *big.Int
+}
+
+func (p *point) isInfinity() bool {
+	return p.x.Sign() == 0 && p.y.Sign() == 0
+}
+
+func (p *point) equal(q *point) bool {
+	return p.x.Cmp(q.x) == 0 && p.y.Cmp(q.y) == 0
+}
+
+func addPoint(a, b *point) *point {
+	x, y := curve.Add(a.x, a.y, b.x, b.y)
+	return &point{x: x, y: y}
+}
+
+func negPoint(a *point) *point {
+	if a.isInfinity() {
+		return a
+	}
+	return &point{x: a.x, y: new(big.Int).Sub(curve.P, a.y)}
+}
+
+func subPoint(a, b *point) *point {
+	return addPoint(a, negPoint(b))
+}
+
+func mulPoint(a *point, k *big.Int) *point {
+	if a.isInfinity() {
+		return a
+	}
+	x, y := curve.ScalarMult(a.x, a.y, new(big.Int).Mod(k, curve.N).Bytes())
+	return &point{x: x, y: y}
+}
+
+func baseMul(k *big.Int) *point {
+	x, y := curve.ScalarBaseMult(new(big.Int).Mod(k, curve.N).Bytes())
+	return &point{x: x, y: y}
+}
+
+//encodePoint 压缩格式编码，无穷远点编码为33个0，只用于计算哈希
+func encodePoint(p *point) []byte {
+	if p.isInfinity() {
+		return make([]byte, 33)
+	}
+	return (&btcec.PublicKey{Curve: curve, X: p.x, Y: p.y}).SerializeCompressed()
+}
+
+func decodePoint(b []byte) (*point, error) {
+	if len(b) != 33 {
+		return nil, ErrPoint
+	}
+	pub, err := btcec.ParsePubKey(b, curve)
+	if err != nil {
+		return nil, ErrPoint
+	}
+	return &point{x: pub.X, y: pub.Y}, nil
+}
+
+func encodeScalar(k *big.Int) []byte {
+	b := make([]byte, 32)
+	v := new(big.Int).Mod(k, curve.N).Bytes()
+	copy(b[32-len(v):], v)
+	return b
+}
+
+func decodeScalar(b []byte) (*big.Int, error) {
+	if len(b) != 32 {
+		return nil, ErrScalar
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Cmp(curve.N) >= 0 {
+		return nil, ErrScalar
+	}
+	return k, nil
+}
+
+func randScalar() (*big.Int, error) {
+	k, err := rand.Int(rand.Reader, new(big.Int).Sub(curve.N, big.NewInt(1)))
+	if err != nil {
+		return nil, err
+	}
+	return k.Add(k, big.NewInt(1)), nil
+}
+
+func hashToScalar(parts ...[]byte) *big.Int {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write(part)
+	}
+	return new(big.Int).Mod(new(big.Int).SetBytes(h.Sum(nil)), curve.N)
+}
+
+//generatorH 对G的编码和计数器求哈希，第一个能解压成曲线上的点的哈希作为H的x坐标
+func generatorH() *point {
+	seed := append(encodePoint(pointG), []byte("chain33-confidential-H")...)
+	var counter [4]byte
+	for i := uint32(0); ; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+		x := sha256.Sum256(append(seed, counter[:]...))
+		if p, err := decodePoint(append([]byte{0x02}, x[:]...)); err == nil {
+			return p
+		}
+	}
+}
+
+func powersOfH() []*point {
+	powers := make([]*point, RangeBits)
+	powers[0] = pointH
+	for i := 1; i < RangeBits; i++ {
+		powers[i] = addPoint(powers[i-1], powers[i-1])
+	}
+	return powers
+}
+
+//commit 计算 v*H + r*G
+func commit(v int64, r *big.Int) *point {
+	return addPoint(mulPoint(pointH, big.NewInt(v)), baseMul(r))
+}
+
+//sumCommitments 解码承诺并求和
+func sumCommitments(commitments [][]byte) (*point, error) {
+	sum := infinity
+	for _, c := range commitments {
+		p, err := decodePoint(c)
+		if err != nil {
+			return nil, err
+		}
+		sum = addPoint(sum, p)
+	}
+	return sum, nil
+}
+
+func bitChallenge(c []byte, i int, ci, a0, a1 *point) *big.Int {
+	var index [4]byte
+	binary.BigEndian.PutUint32(index[:], uint32(i))
+	return hashToScalar([]byte("chain33-confidential-bit"), c, index[:], encodePoint(ci), encodePoint(a0), encodePoint(a1))
+}
+
+//proveRange 把金额按位拆开，每一位的承诺用OR证明值是0或者2^i，各位盲因子之和等于r
+func proveRange(v int64, r *big.Int, c []byte) (*RangeProof, error) {
+	if v < 0 {
+		return nil, ErrAmount
+	}
+	proof := &RangeProof{}
+	sum := new(big.Int)
+	for i := 0; i < RangeBits; i++ {
+		var ri *big.Int
+		if i < RangeBits-1 {
+			k, err := randScalar()
+			if err != nil {
+				return nil, err
+			}
+			ri = k
+			sum.Add(sum, ri)
+		} else {
+			ri = new(big.Int).Mod(new(big.Int).Sub(r, sum), curve.N)
+		}
+		bit := (v >> uint(i)) & 1
+		ci := baseMul(ri)
+		if bit == 1 {
+			ci = addPoint(ci, pow2H[i])
+		}
+		p := [2]*point{ci, subPoint(ci, pow2H[i])}
+		//actual是真实的分支，fake分支的挑战和响应随机选取
+		actual, fake := bit, 1-bit
+		var e, s [2]*big.Int
+		var a [2]*point
+		var err error
+		if e[fake], err = randScalar(); err != nil {
+			return nil, err
+		}
+		if s[fake], err = randScalar(); err != nil {
+			return nil, err
+		}
+		a[fake] = subPoint(baseMul(s[fake]), mulPoint(p[fake], e[fake]))
+		k, err := randScalar()
+		if err != nil {
+			return nil, err
+		}
+		a[actual] = baseMul(k)
+		challenge := bitChallenge(c, i, ci, a[0], a[1])
+		e[actual] = new(big.Int).Mod(new(big.Int).Sub(challenge, e[fake]), curve.N)
+		s[actual] = new(big.Int).Mod(new(big.Int).Add(k, new(big.Int).Mul(e[actual], ri)), curve.N)
+		proof.Bits = append(proof.Bits, &BitProof{
+			Commitment: encodePoint(ci),
+			E0:         encodeScalar(e[0]),
+			E1:         encodeScalar(e[1]),
+			S0:         encodeScalar(s[0]),
+			S1:         encodeScalar(s[1]),
+		})
+	}
+	return proof, nil
+}
+
+//VerifyRangeProof 验证承诺的值在 [0, 2^RangeBits) 之间
+func VerifyRangeProof(c []byte, proof *RangeProof) error {
+	cp, err := decodePoint(c)
+	if err != nil {
+		return err
+	}
+	if proof == nil || len(proof.Bits) != RangeBits {
+		return ErrRangeProof
+	}
+	sum := infinity
+	for i, bp := range proof.Bits {
+		ci, err := decodePoint(bp.Commitment)
+		if err != nil {
+			return ErrRangeProof
+		}
+		var e, s [2]*big.Int
+		for j, b := range [][]byte{bp.E0, bp.E1, bp.S0, bp.S1} {
+			k, err := decodeScalar(b)
+			if err != nil {
+				return ErrRangeProof
+			}
+			if j < 2 {
+				e[j] = k
+			} else {
+				s[j-2] = k
+			}
+		}
+		p := [2]*point{ci, subPoint(ci, pow2H[i])}
+		a0 := subPoint(baseMul(s[0]), mulPoint(p[0], e[0]))
+		a1 := subPoint(baseMul(s[1]), mulPoint(p[1], e[1]))
+		challenge := bitChallenge(c, i, ci, a0, a1)
+		if new(big.Int).Mod(new(big.Int).Add(e[0], e[1]), curve.N).Cmp(challenge) != 0 {
+			return ErrRangeProof
+		}
+		sum = addPoint(sum, ci)
+	}
+	if !sum.equal(cp) {
+		return ErrRangeProof
+	}
+	return nil
+}
+
+func balanceChallenge(excess, r *point, ctx []byte) *big.Int {
+	return hashToScalar([]byte("chain33-confidential-balance"), encodePoint(excess), encodePoint(r), ctx)
+}
+
+//proveBalance 证明知道k，excess = k*G
+func proveBalance(k *big.Int, ctx []byte) (*BalanceProof, error) {
+	t, err := randScalar()
+	if err != nil {
+		return nil, err
+	}
+	r := baseMul(t)
+	e := balanceChallenge(baseMul(k), r, ctx)
+	s := new(big.Int).Mod(new(big.Int).Add(t, new(big.Int).Mul(e, k)), curve.N)
+	return &BalanceProof{R: encodePoint(r), S: encodeScalar(s)}, nil
+}
+
+//VerifyBalance 验证 输入承诺 + pubIn*H - 输出承诺 - pubOut*H 是G的倍数，也就是输入输出的金额相等
+func VerifyBalance(inputs, outputs [][]byte, pubIn, pubOut int64, proof *BalanceProof, ctx []byte) error {
+	if pubIn < 0 || pubOut < 0 {
+		return ErrAmount
+	}
+	in, err := sumCommitments(inputs)
+	if err != nil {
+		return err
+	}
+	out, err := sumCommitments(outputs)
+	if err != nil {
+		return err
+	}
+	excess := subPoint(addPoint(in, mulPoint(pointH, big.NewInt(pubIn))), addPoint(out, mulPoint(pointH, big.NewInt(pubOut))))
+	if proof == nil {
+		return ErrBalanceProof
+	}
+	r, err := decodePoint(proof.R)
+	if err != nil {
+		return ErrBalanceProof
+	}
+	s, err := decodeScalar(proof.S)
+	if err != nil {
+		return ErrBalanceProof
+	}
+	e := balanceChallenge(excess, r, ctx)
+	if !baseMul(s).equal(addPoint(r, mulPoint(excess, e))) {
+		return ErrBalanceProof
+	}
+	return nil
+}
+
+//BalanceContext 余额证明绑定的交易内容，防止证明被用到其他的输入输出上
+func BalanceContext(inputs []string, outputs []*ConfidentialOutput, pubIn, pubOut int64, to string) []byte {
+	h := sha256.New()
+	for _, id := range inputs {
+		h.Write([]byte(id))
+		h.Write([]byte{0})
+	}
+	for _, out := range outputs {
+		h.Write(out.PubKey)
+		h.Write(out.Commitment)
+	}
+	var amount [16]byte
+	binary.BigEndian.PutUint64(amount[:8], uint64(pubIn))
+	binary.BigEndian.PutUint64(amount[8:], uint64(pubOut))
+	h.Write(amount[:])
+	h.Write([]byte(to))
+	return h.Sum(nil)
+}
@@ -0,0 +1,88 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeProof(t *testing.T) {
+	for _, v := range []int64{0, 1, 1e8, 1<<RangeBits - 1} {
+		r, err := randScalar()
+		assert.Nil(t, err)
+		c := encodePoint(commit(v, r))
+		proof, err := proveRange(v, r, c)
+		assert.Nil(t, err)
+		assert.Nil(t, VerifyRangeProof(c, proof))
+	}
+
+	r, _ := randScalar()
+	c := encodePoint(commit(5, r))
+	proof, err := proveRange(5, r, c)
+	assert.Nil(t, err)
+	//证明不能用到其他的承诺上
+	other := encodePoint(commit(5, new(big.Int).Add(r, big.NewInt(1))))
+	assert.Equal(t, ErrRangeProof, VerifyRangeProof(other, proof))
+	proof.Bits[3].S0, proof.Bits[3].S1 = proof.Bits[3].S1, proof.Bits[3].S0
+	assert.Equal(t, ErrRangeProof, VerifyRangeProof(c, proof))
+	proof.Bits = proof.Bits[1:]
+	assert.Equal(t, ErrRangeProof, VerifyRangeProof(c, proof))
+
+	//负数的承诺等于一个超过范围的数，无法生成合法的证明
+	neg := encodePoint(commit(-1, r))
+	_, err = proveRange(-1, r, neg)
+	assert.Equal(t, ErrAmount, err)
+	fake, _ := proveRange(1<<RangeBits-1, r, neg)
+	assert.Equal(t, ErrRangeProof, VerifyRangeProof(neg, fake))
+}
+
+func TestNote(t *testing.T) {
+	_, alice := util.Genaddress()
+	_, bob := util.Genaddress()
+	_, eve := util.Genaddress()
+	alicePub := PubKeyFromPriv(alice.Bytes())
+	assert.Equal(t, alice.PubKey().Bytes(), alicePub)
+
+	deposit, err := CreateDeposit(alice.Bytes(), "coins", "", 10, alicePub)
+	assert.Nil(t, err)
+	ctx := BalanceContext(nil, []*ConfidentialOutput{deposit.Output}, 10, 0, "")
+	assert.Nil(t, VerifyBalance(nil, [][]byte{deposit.Output.Commitment}, 10, 0, deposit.Proof, ctx))
+	assert.Equal(t, ErrBalanceProof, VerifyBalance(nil, [][]byte{deposit.Output.Commitment}, 11, 0, deposit.Proof, ctx))
+
+	note := &ConfidentialNote{Id: "1", Commitment: deposit.Output.Commitment, Ephemeral: deposit.Output.Ephemeral,
+		ReceiverNote: deposit.Output.ReceiverNote, SenderNote: deposit.Output.SenderNote}
+	secret, err := OpenNote(alice.Bytes(), note)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), secret.Amount)
+	_, err = OpenNote(eve.Bytes(), note)
+	assert.Equal(t, ErrDecodeNote, err)
+
+	//转给bob 3，找零7，发送者和接收者都能解密
+	inputs := []*InputNote{{Note: note, Secret: secret}}
+	_, err = CreateTransfer(alice.Bytes(), inputs, []*OutputSpec{{PubKey: bob.PubKey().Bytes(), Amount: 3}})
+	assert.Equal(t, ErrBalance, err)
+	transfer, err := CreateTransfer(alice.Bytes(), inputs, []*OutputSpec{{PubKey: bob.PubKey().Bytes(), Amount: 3}, {PubKey: alicePub, Amount: 7}})
+	assert.Nil(t, err)
+	out := transfer.Outputs[0]
+	ctx = BalanceContext(transfer.Inputs, transfer.Outputs, 0, 0, "")
+	assert.Nil(t, VerifyBalance([][]byte{note.Commitment}, [][]byte{out.Commitment, transfer.Outputs[1].Commitment}, 0, 0, transfer.Proof, ctx))
+	assert.Nil(t, VerifyRangeProof(out.Commitment, out.RangeProof))
+	received := &ConfidentialNote{Commitment: out.Commitment, Ephemeral: out.Ephemeral, ReceiverNote: out.ReceiverNote, SenderNote: out.SenderNote}
+	for _, priv := range [][]byte{alice.Bytes(), bob.Bytes()} {
+		secret, err := OpenNote(priv, received)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), secret.Amount)
+	}
+
+	withdraw, err := CreateWithdraw(alice.Bytes(), inputs, []*OutputSpec{{PubKey: alicePub, Amount: 4}}, 6, "")
+	assert.Nil(t, err)
+	ctx = BalanceContext(withdraw.Inputs, withdraw.Outputs, 0, 6, "")
+	assert.Nil(t, VerifyBalance([][]byte{note.Commitment}, [][]byte{withdraw.Outputs[0].Commitment}, 0, 6, withdraw.Proof, ctx))
+	assert.Equal(t, ErrBalanceProof, VerifyBalance([][]byte{note.Commitment}, [][]byte{withdraw.Outputs[0].Commitment}, 0, 7, withdraw.Proof, ctx))
+}
@@ -0,0 +1,63 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types confidential插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// ConfidentialX 执行器名称
+	ConfidentialX = "confidential"
+	actionName    = map[string]int32{
+		"Deposit":  ConfidentialActionDeposit,
+		"Transfer": ConfidentialActionTransfer,
+		"Withdraw": ConfidentialActionWithdraw,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogConfidentialNote: {Ty: reflect.TypeOf(ReceiptConfidentialNote{}), Name: "LogConfidentialNote"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(ConfidentialX))
+	types.RegistorExecutor(ConfidentialX, NewType())
+	types.RegisterDappFork(ConfidentialX, "Enable", 0)
+}
+
+// ConfidentialType confidential执行器类型
+type ConfidentialType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a confidential type object
+func NewType() *ConfidentialType {
+	c := &ConfidentialType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return confidential action
+func (c *ConfidentialType) GetPayload() types.Message {
+	return &ConfidentialAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (c *ConfidentialType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (c *ConfidentialType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (c *ConfidentialType) GetName() string {
+	return ConfidentialX
+}
@@ -0,0 +1,231 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands did插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	dty "github.com/33cn/chain33/system/dapp/did/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// DidCmd did command
+func DidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did",
+		Short: "Decentralized identity management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		RegisterCmd(),
+		RotateKeyCmd(),
+		AttestCmd(),
+		RevokeCmd(),
+		ResolveCmd(),
+		VerifyCmd(),
+	)
+
+	return cmd
+}
+
+func addDocumentFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceP("keys", "k", nil, "public keys in id:hex format, secp256k1 by default, id:ty:hex for other sign type")
+	cmd.Flags().StringSliceP("services", "s", nil, "services in id:type:endpoint format")
+}
+
+//getDocument 解析命令行中的公钥和服务，endpoint中可以有冒号
+func getDocument(cmd *cobra.Command) ([]*dty.DidPublicKey, []*dty.DidService, error) {
+	keys, _ := cmd.Flags().GetStringSlice("keys")
+	services, _ := cmd.Flags().GetStringSlice("services")
+	var publicKeys []*dty.DidPublicKey
+	for _, key := range keys {
+		fields := strings.Split(key, ":")
+		ty := types.SECP256K1
+		if len(fields) == 3 {
+			ty = types.GetSignType("", fields[1])
+		} else if len(fields) != 2 {
+			return nil, nil, fmt.Errorf("invalid key %s", key)
+		}
+		pub, err := common.FromHex(fields[len(fields)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+		publicKeys = append(publicKeys, &dty.DidPublicKey{Id: fields[0], Ty: int32(ty), PubKey: pub})
+	}
+	var didServices []*dty.DidService
+	for _, service := range services {
+		fields := strings.SplitN(service, ":", 3)
+		if len(fields) != 3 {
+			return nil, nil, fmt.Errorf("invalid service %s", service)
+		}
+		didServices = append(didServices, &dty.DidService{Id: fields[0], Type: fields[1], Endpoint: fields[2]})
+	}
+	return publicKeys, didServices, nil
+}
+
+// RegisterCmd register did
+func RegisterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register",
+		Short: "Create a transaction to register did of sender address",
+		Run:   register,
+	}
+	addDocumentFlags(cmd)
+	return cmd
+}
+
+func register(cmd *cobra.Command, args []string) {
+	keys, services, err := getDocument(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, dty.DidX, &dty.DidAction{
+		Ty:    dty.DidActionRegister,
+		Value: &dty.DidAction_Register{Register: &dty.DidRegister{PublicKeys: keys, Services: services}},
+	})
+}
+
+// RotateKeyCmd rotate keys
+func RotateKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate_key",
+		Short: "Create a transaction to replace keys and services of did",
+		Run:   rotateKey,
+	}
+	cmd.Flags().StringP("did", "d", "", "did")
+	cmd.MarkFlagRequired("did")
+	cmd.Flags().StringP("controller", "c", "", "new controller address, empty to keep current controller")
+	addDocumentFlags(cmd)
+	return cmd
+}
+
+func rotateKey(cmd *cobra.Command, args []string) {
+	did, _ := cmd.Flags().GetString("did")
+	controller, _ := cmd.Flags().GetString("controller")
+	keys, services, err := getDocument(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, dty.DidX, &dty.DidAction{
+		Ty:    dty.DidActionRotateKey,
+		Value: &dty.DidAction_RotateKey{RotateKey: &dty.DidRotateKey{Did: did, Controller: controller, PublicKeys: keys, Services: services}},
+	})
+}
+
+// AttestCmd attest claim
+func AttestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest",
+		Short: "Create a transaction to add claim attestation to did by issuer",
+		Run:   attest,
+	}
+	cmd.Flags().StringP("did", "d", "", "did")
+	cmd.MarkFlagRequired("did")
+	cmd.Flags().StringP("claim", "t", "", "claim type")
+	cmd.MarkFlagRequired("claim")
+	cmd.Flags().StringP("hash", "s", "", "claim hash in hex")
+	cmd.MarkFlagRequired("hash")
+	cmd.Flags().Int64P("expire", "e", 0, "expire height, 0 for never")
+	return cmd
+}
+
+func attest(cmd *cobra.Command, args []string) {
+	did, _ := cmd.Flags().GetString("did")
+	claim, _ := cmd.Flags().GetString("claim")
+	hash, _ := cmd.Flags().GetString("hash")
+	expire, _ := cmd.Flags().GetInt64("expire")
+	claimHash, err := common.FromHex(hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, dty.DidX, &dty.DidAction{
+		Ty:    dty.DidActionAttest,
+		Value: &dty.DidAction_Attest{Attest: &dty.DidAttest{Did: did, ClaimType: claim, ClaimHash: claimHash, ExpireHeight: expire}},
+	})
+}
+
+// RevokeCmd revoke attestation
+func RevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Create a transaction to revoke attestation by issuer",
+		Run:   revoke,
+	}
+	cmd.Flags().StringP("id", "i", "", "attestation id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func revoke(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, dty.DidX, &dty.DidAction{
+		Ty:    dty.DidActionRevoke,
+		Value: &dty.DidAction_Revoke{Revoke: &dty.DidRevoke{Id: id}},
+	})
+}
+
+func queryDid(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, dty.DidX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// ResolveCmd resolve did document
+func ResolveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve",
+		Short: "Resolve did document with attestations",
+		Run:   resolve,
+	}
+	cmd.Flags().StringP("did", "d", "", "did")
+	cmd.MarkFlagRequired("did")
+	return cmd
+}
+
+func resolve(cmd *cobra.Command, args []string) {
+	did, _ := cmd.Flags().GetString("did")
+	var res dty.ReplyDidDocument
+	queryDid(cmd, dty.FuncNameResolve, &types.ReqString{Data: did}, &res)
+}
+
+// VerifyCmd verify claim
+func VerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify did has valid attestation of claim type",
+		Run:   verify,
+	}
+	cmd.Flags().StringP("did", "d", "", "did")
+	cmd.MarkFlagRequired("did")
+	cmd.Flags().StringP("claim", "t", "", "claim type")
+	cmd.MarkFlagRequired("claim")
+	return cmd
+}
+
+func verify(cmd *cobra.Command, args []string) {
+	did, _ := cmd.Flags().GetString("did")
+	claim, _ := cmd.Flags().GetString("claim")
+	var res dty.ReplyDidVerify
+	queryDid(cmd, dty.FuncNameVerify, &dty.ReqDidVerify{Did: did, ClaimType: claim}, &res)
+}
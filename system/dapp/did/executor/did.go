@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor did执行器，负责DID的注册，公钥轮换和声明的添加撤销
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	dty "github.com/33cn/chain33/system/dapp/did/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.did")
+	driverName = dty.DidX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Did{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newDid, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return did name
+func GetName() string {
+	return newDid().GetName()
+}
+
+// Did defines Did object
+type Did struct {
+	drivers.DriverBase
+}
+
+func newDid() drivers.Driver {
+	d := &Did{}
+	d.SetChild(d)
+	d.SetExecutorType(types.LoadExecutorType(driverName))
+	return d
+}
+
+// GetDriverName return a drivername
+func (d *Did) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (d *Did) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,126 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dty "github.com/33cn/chain33/system/dapp/did/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, execer, action string, param types.Message) (int32, string) {
+	hash, detail, err := mock33.SendCallTx(priv, execer, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, common.ToHex(hash)
+}
+
+func queryDid(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(dty.DidX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func verify(t *testing.T, mock33 *testnode.Chain33Mock, did, claimType string) bool {
+	return queryDid(t, mock33, dty.FuncNameVerify, &dty.ReqDidVerify{Did: did, ClaimType: claimType}).(*dty.ReplyDidVerify).Verified
+}
+
+func TestDid(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	//manage合约的超级管理员
+	manager := util.TestPrivkeyList[0]
+	addr, priv := util.Genaddress()
+	issuer, issuerPriv := util.Genaddress()
+	newController, newPriv := util.Genaddress()
+	for _, to := range []string{addr, issuer, newController, address.PubKeyToAddress(manager.PubKey().Bytes()).String()} {
+		mock33.SendTx(util.CreateCoinsTx(genesis, to, 10*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	did := dty.AddressDid(addr)
+	keys := []*dty.DidPublicKey{{Id: "key-1", Ty: types.SECP256K1, PubKey: priv.PubKey().Bytes()}}
+
+	ty, _ := sendTx(t, mock33, priv, dty.DidX, "Register", &dty.DidRegister{PublicKeys: []*dty.DidPublicKey{{Id: "key-1", Ty: types.SECP256K1, PubKey: []byte("bad")}}})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendTx(t, mock33, priv, dty.DidX, "Register", &dty.DidRegister{PublicKeys: append(keys, keys[0])})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendTx(t, mock33, priv, dty.DidX, "Register", &dty.DidRegister{PublicKeys: keys, Services: []*dty.DidService{{Id: "hub", Type: "Hub", Endpoint: "https://hub"}}})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendTx(t, mock33, priv, dty.DidX, "Register", &dty.DidRegister{})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//没有在manage合约中配置的发行者不能添加声明
+	attest := &dty.DidAttest{Did: did, ClaimType: "kyc", ClaimHash: []byte("claim")}
+	ty, _ = sendTx(t, mock33, issuerPriv, dty.DidX, "Attest", attest)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendTx(t, mock33, manager, "manage", "Modify", &types.ModifyConfig{Key: dty.IssuerKey, Value: issuer, Op: "add"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendTx(t, mock33, issuerPriv, dty.DidX, "Attest", &dty.DidAttest{Did: dty.AddressDid(issuer), ClaimType: "kyc", ClaimHash: []byte("claim")})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.False(t, verify(t, mock33, did, "kyc"))
+	ty, id := sendTx(t, mock33, issuerPriv, dty.DidX, "Attest", attest)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.True(t, verify(t, mock33, did, "kyc"))
+	assert.False(t, verify(t, mock33, did, "accredited"))
+
+	//过期的声明无效
+	ty, _ = sendTx(t, mock33, issuerPriv, dty.DidX, "Attest", &dty.DidAttest{Did: did, ClaimType: "accredited", ClaimHash: []byte("a"), ExpireHeight: mock33.GetLastBlock().Height + 3})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.True(t, verify(t, mock33, did, "accredited"))
+	for i := 0; i < 2; i++ {
+		mock33.SendTx(util.CreateCoinsTx(genesis, addr, types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	assert.False(t, verify(t, mock33, did, "accredited"))
+
+	reply := queryDid(t, mock33, dty.FuncNameResolve, &types.ReqString{Data: did}).(*dty.ReplyDidDocument)
+	assert.Equal(t, addr, reply.Document.Controller)
+	assert.Equal(t, keys[0].PubKey, reply.Document.PublicKeys[0].PubKey)
+	assert.Equal(t, 2, len(reply.Attestations))
+	assert.Equal(t, issuer, reply.Attestations[0].Issuer)
+
+	//只有发行者可以撤销
+	ty, _ = sendTx(t, mock33, priv, dty.DidX, "Revoke", &dty.DidRevoke{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendTx(t, mock33, issuerPriv, dty.DidX, "Revoke", &dty.DidRevoke{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendTx(t, mock33, issuerPriv, dty.DidX, "Revoke", &dty.DidRevoke{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.False(t, verify(t, mock33, did, "kyc"))
+	attestation := queryDid(t, mock33, dty.FuncNameGetAttestation, &types.ReqString{Data: id}).(*dty.DidAttestation)
+	assert.True(t, attestation.Revoked)
+
+	//发行者从配置中删除以后声明无效
+	ty, _ = sendTx(t, mock33, issuerPriv, dty.DidX, "Attest", attest)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.True(t, verify(t, mock33, did, "kyc"))
+	ty, _ = sendTx(t, mock33, manager, "manage", "Modify", &types.ModifyConfig{Key: dty.IssuerKey, Value: issuer, Op: "delete"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.False(t, verify(t, mock33, did, "kyc"))
+
+	//轮换公钥并且交给新的controller
+	ty, _ = sendTx(t, mock33, newPriv, dty.DidX, "RotateKey", &dty.DidRotateKey{Did: did, Controller: newController})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	newKeys := []*dty.DidPublicKey{{Id: "key-2", Ty: types.SECP256K1, PubKey: newPriv.PubKey().Bytes()}}
+	ty, _ = sendTx(t, mock33, priv, dty.DidX, "RotateKey", &dty.DidRotateKey{Did: did, Controller: newController, PublicKeys: newKeys})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendTx(t, mock33, priv, dty.DidX, "RotateKey", &dty.DidRotateKey{Did: did, PublicKeys: keys})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	reply = queryDid(t, mock33, dty.FuncNameResolve, &types.ReqString{Data: did}).(*dty.ReplyDidDocument)
+	assert.Equal(t, newController, reply.Document.Controller)
+	assert.Equal(t, "key-2", reply.Document.PublicKeys[0].Id)
+	assert.Equal(t, 0, len(reply.Document.Services))
+	assert.Equal(t, int64(2), reply.Document.Version)
+}
@@ -0,0 +1,310 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	dty "github.com/33cn/chain33/system/dapp/did/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	documentKeyPrefix    = "mavl-" + dty.DidX + "-document-"
+	attestationKeyPrefix = "mavl-" + dty.DidX + "-attestation-"
+)
+
+func calcDocumentKey(did string) []byte {
+	return []byte(documentKeyPrefix + did)
+}
+
+func calcAttestationKey(id string) []byte {
+	return []byte(attestationKeyPrefix + id)
+}
+
+// Action did交易的执行环境
+type Action struct {
+	db       dbm.KV
+	txhash   []byte
+	fromaddr string
+	height   int64
+	index    int
+}
+
+// NewAction new a action object
+func NewAction(d *Did, tx *types.Transaction, index int) *Action {
+	return &Action{
+		db:       d.GetStateDB(),
+		txhash:   tx.Hash(),
+		fromaddr: tx.From(),
+		height:   d.GetHeight(),
+		index:    index,
+	}
+}
+
+//getIssuers manage合约中配置的发行者，和manage合约一样先读新的key
+func getIssuers(db dbm.KV, height int64) ([]string, error) {
+	value, err := db.Get([]byte(types.ManageKey(dty.IssuerKey)))
+	if err != nil || value == nil {
+		value, err = db.Get([]byte(types.ConfigKey(dty.IssuerKey)))
+	}
+	if err != nil || value == nil {
+		return nil, nil
+	}
+	var item types.ConfigItem
+	err = types.Decode(value, &item)
+	if err != nil {
+		return nil, err
+	}
+	return types.GetConfigValues(&item, height), nil
+}
+
+func isIssuer(db dbm.KV, addr string, height int64) (bool, error) {
+	issuers, err := getIssuers(db, height)
+	if err != nil {
+		return false, err
+	}
+	for _, issuer := range issuers {
+		if issuer == addr {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func getDocument(db dbm.KV, did string) (*dty.DidDocument, error) {
+	value, err := db.Get(calcDocumentKey(did))
+	if err != nil || value == nil {
+		return nil, dty.ErrDidNotExist
+	}
+	var doc dty.DidDocument
+	err = types.Decode(value, &doc)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func getAttestation(db dbm.KV, id string) (*dty.DidAttestation, error) {
+	value, err := db.Get(calcAttestationKey(id))
+	if err != nil || value == nil {
+		return nil, dty.ErrAttestationNotExist
+	}
+	var attestation dty.DidAttestation
+	err = types.Decode(value, &attestation)
+	if err != nil {
+		return nil, err
+	}
+	return &attestation, nil
+}
+
+// VerifyClaim 获取DID在height高度有效的claimType声明，其他执行器可以用自己的状态数据库读取
+// 有效的声明没有撤销和过期，并且发行者还在manage合约的配置中
+func VerifyClaim(db dbm.KV, did, claimType string, height int64) (*dty.DidAttestation, error) {
+	doc, err := getDocument(db, did)
+	if err != nil {
+		return nil, err
+	}
+	issuers, err := getIssuers(db, height)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(doc.Attestations) - 1; i >= 0; i-- {
+		attestation, err := getAttestation(db, doc.Attestations[i])
+		if err != nil {
+			return nil, err
+		}
+		if attestation.ClaimType != claimType || attestation.Revoked {
+			continue
+		}
+		if attestation.ExpireHeight > 0 && height >= attestation.ExpireHeight {
+			continue
+		}
+		for _, issuer := range issuers {
+			if issuer == attestation.Issuer {
+				return attestation, nil
+			}
+		}
+	}
+	return nil, dty.ErrAttestationNotExist
+}
+
+//checkPublicKeys 公钥的id不能重复，公钥必须可以按签名类型解析
+func checkPublicKeys(keys []*dty.DidPublicKey) error {
+	if len(keys) > dty.MaxPublicKeys {
+		return dty.ErrPublicKey
+	}
+	ids := make(map[string]bool)
+	for _, key := range keys {
+		if key.Id == "" || len(key.Id) > dty.MaxIDLength || ids[key.Id] {
+			return dty.ErrPublicKey
+		}
+		ids[key.Id] = true
+		cr, err := crypto.New(types.GetSignName("", int(key.Ty)))
+		if err != nil {
+			return dty.ErrPublicKey
+		}
+		if _, err := cr.PubKeyFromBytes(key.PubKey); err != nil {
+			return dty.ErrPublicKey
+		}
+	}
+	return nil
+}
+
+func checkServices(services []*dty.DidService) error {
+	if len(services) > dty.MaxServices {
+		return dty.ErrService
+	}
+	ids := make(map[string]bool)
+	for _, service := range services {
+		if service.Id == "" || len(service.Id) > dty.MaxIDLength || ids[service.Id] {
+			return dty.ErrService
+		}
+		ids[service.Id] = true
+		if len(service.Type) > dty.MaxIDLength || len(service.Endpoint) > dty.MaxEndpointLength {
+			return dty.ErrService
+		}
+	}
+	return nil
+}
+
+func (a *Action) saveDocument(doc *dty.DidDocument) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcDocumentKey(doc.Did), Value: types.Encode(doc)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (a *Action) saveAttestation(attestation *dty.DidAttestation) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcAttestationKey(attestation.Id), Value: types.Encode(attestation)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func documentReceipt(ty int32, prev, current *dty.DidDocument) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&dty.ReceiptDidDocument{Prev: prev, Current: current})}
+}
+
+func attestationReceipt(ty int32, prev, current *dty.DidAttestation) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&dty.ReceiptDidAttestation{Prev: prev, Current: current})}
+}
+
+func (a *Action) register(payload *dty.DidRegister) (*types.Receipt, error) {
+	did := dty.AddressDid(a.fromaddr)
+	if _, err := getDocument(a.db, did); err == nil {
+		return nil, dty.ErrDidExist
+	}
+	if err := checkPublicKeys(payload.PublicKeys); err != nil {
+		return nil, err
+	}
+	if err := checkServices(payload.Services); err != nil {
+		return nil, err
+	}
+	doc := &dty.DidDocument{
+		Did:          did,
+		Controller:   a.fromaddr,
+		PublicKeys:   payload.PublicKeys,
+		Services:     payload.Services,
+		Version:      1,
+		CreateHeight: a.height,
+		UpdateHeight: a.height,
+	}
+	kv := []*types.KeyValue{a.saveDocument(doc)}
+	logs := []*types.ReceiptLog{documentReceipt(dty.TyLogDidRegister, nil, doc)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) rotateKey(payload *dty.DidRotateKey) (*types.Receipt, error) {
+	prev, err := getDocument(a.db, payload.Did)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Controller != a.fromaddr {
+		return nil, dty.ErrNotController
+	}
+	if payload.Controller != "" {
+		if err := address.CheckAddress(payload.Controller); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkPublicKeys(payload.PublicKeys); err != nil {
+		return nil, err
+	}
+	if err := checkServices(payload.Services); err != nil {
+		return nil, err
+	}
+	doc := *prev
+	if payload.Controller != "" {
+		doc.Controller = payload.Controller
+	}
+	doc.PublicKeys = payload.PublicKeys
+	doc.Services = payload.Services
+	doc.Version++
+	doc.UpdateHeight = a.height
+	kv := []*types.KeyValue{a.saveDocument(&doc)}
+	logs := []*types.ReceiptLog{documentReceipt(dty.TyLogDidRotateKey, prev, &doc)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) attest(payload *dty.DidAttest) (*types.Receipt, error) {
+	ok, err := isIssuer(a.db, a.fromaddr, a.height)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, dty.ErrNotIssuer
+	}
+	if payload.ClaimType == "" || len(payload.ClaimType) > dty.MaxIDLength {
+		return nil, dty.ErrClaim
+	}
+	if len(payload.ClaimHash) == 0 || len(payload.ClaimHash) > dty.MaxClaimHashLength {
+		return nil, dty.ErrClaim
+	}
+	if payload.ExpireHeight != 0 && payload.ExpireHeight <= a.height {
+		return nil, dty.ErrClaim
+	}
+	prev, err := getDocument(a.db, payload.Did)
+	if err != nil {
+		return nil, err
+	}
+	if len(prev.Attestations) >= dty.MaxAttestations {
+		return nil, dty.ErrTooManyAttestations
+	}
+	attestation := &dty.DidAttestation{
+		Id:           common.ToHex(a.txhash),
+		Did:          payload.Did,
+		Issuer:       a.fromaddr,
+		ClaimType:    payload.ClaimType,
+		ClaimHash:    payload.ClaimHash,
+		IssueHeight:  a.height,
+		ExpireHeight: payload.ExpireHeight,
+	}
+	doc := *prev
+	doc.Attestations = append(append([]string{}, prev.Attestations...), attestation.Id)
+	kv := []*types.KeyValue{a.saveAttestation(attestation), a.saveDocument(&doc)}
+	logs := []*types.ReceiptLog{attestationReceipt(dty.TyLogDidAttest, nil, attestation)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//revoke 发行者从配置中删除以后仍然可以撤销自己的声明
+func (a *Action) revoke(payload *dty.DidRevoke) (*types.Receipt, error) {
+	prev, err := getAttestation(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Issuer != a.fromaddr {
+		return nil, dty.ErrNotIssuer
+	}
+	if prev.Revoked {
+		return nil, dty.ErrRevoked
+	}
+	attestation := *prev
+	attestation.Revoked = true
+	attestation.RevokeHeight = a.height
+	kv := []*types.KeyValue{a.saveAttestation(&attestation)}
+	logs := []*types.ReceiptLog{attestationReceipt(dty.TyLogDidRevoke, prev, &attestation)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
@@ -0,0 +1,34 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	dty "github.com/33cn/chain33/system/dapp/did/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Register 注册DID
+func (d *Did) Exec_Register(payload *dty.DidRegister, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(d, tx, index)
+	return action.register(payload)
+}
+
+// Exec_RotateKey 轮换DID的公钥
+func (d *Did) Exec_RotateKey(payload *dty.DidRotateKey, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(d, tx, index)
+	return action.rotateKey(payload)
+}
+
+// Exec_Attest 添加声明
+func (d *Did) Exec_Attest(payload *dty.DidAttest, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(d, tx, index)
+	return action.attest(payload)
+}
+
+// Exec_Revoke 撤销声明
+func (d *Did) Exec_Revoke(payload *dty.DidRevoke, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(d, tx, index)
+	return action.revoke(payload)
+}
@@ -0,0 +1,45 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	dty "github.com/33cn/chain33/system/dapp/did/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_Resolve 解析DID文档和文档中的声明
+func (d *Did) Query_Resolve(in *types.ReqString) (types.Message, error) {
+	db := d.GetStateDB()
+	doc, err := getDocument(db, in.Data)
+	if err != nil {
+		return nil, err
+	}
+	reply := &dty.ReplyDidDocument{Document: doc}
+	for _, id := range doc.Attestations {
+		attestation, err := getAttestation(db, id)
+		if err != nil {
+			return nil, err
+		}
+		reply.Attestations = append(reply.Attestations, attestation)
+	}
+	return reply, nil
+}
+
+// Query_GetAttestation 获取声明
+func (d *Did) Query_GetAttestation(in *types.ReqString) (types.Message, error) {
+	return getAttestation(d.GetStateDB(), in.Data)
+}
+
+// Query_Verify 验证DID是否有有效的声明
+func (d *Did) Query_Verify(in *dty.ReqDidVerify) (types.Message, error) {
+	attestation, err := VerifyClaim(d.GetStateDB(), in.Did, in.ClaimType, d.GetHeight())
+	if err == dty.ErrAttestationNotExist {
+		return &dty.ReplyDidVerify{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &dty.ReplyDidVerify{Verified: true, Attestation: attestation}, nil
+}
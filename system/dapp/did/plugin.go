@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package did 去中心化身份执行器插件
+// 1. 地址注册did:chain33:<addr>标识，controller可以轮换公钥或者把DID交给新的controller
+// 2. manage合约中配置的发行者可以给DID添加或者撤销声明，声明的内容只上链hash
+// 3. 查询可以解析DID文档，需要KYC的合约可以用VerifyClaim检查DID的声明
+package did
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/did/commands"
+	"github.com/33cn/chain33/system/dapp/did/executor"
+	"github.com/33cn/chain33/system/dapp/did/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.DidX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.DidCmd,
+		RPC:      nil,
+	})
+}
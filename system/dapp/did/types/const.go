@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// did action ty
+const (
+	DidActionRegister = iota + 1
+	DidActionRotateKey
+	DidActionAttest
+	DidActionRevoke
+)
+
+// did log ty
+const (
+	TyLogDidRegister  = 510
+	TyLogDidRotateKey = 511
+	TyLogDidAttest    = 512
+	TyLogDidRevoke    = 513
+)
+
+// DidPrefix chain33上的DID标识的前缀
+const DidPrefix = "did:chain33:"
+
+// IssuerKey manage合约中配置的声明发行者列表
+const IssuerKey = "did-issuers"
+
+// query func name
+const (
+	FuncNameResolve        = "Resolve"
+	FuncNameGetAttestation = "GetAttestation"
+	FuncNameVerify         = "Verify"
+	MaxPublicKeys          = 16
+	MaxServices            = 16
+	MaxIDLength            = 64
+	MaxEndpointLength      = 256
+	MaxClaimHashLength     = 64
+	MaxAttestations        = 256
+)
@@ -0,0 +1,1069 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: did.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DidAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*DidAction_Register
+	//	*DidAction_RotateKey
+	//	*DidAction_Attest
+	//	*DidAction_Revoke
+	Value                isDidAction_Value `protobuf_oneof:"value"`
+	Ty                   int32             `protobuf:"varint,5,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DidAction) Reset()         { *m = DidAction{} }
+func (m *DidAction) String() string { return proto.CompactTextString(m) }
+func (*DidAction) ProtoMessage()    {}
+func (*DidAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{0}
+}
+
+func (m *DidAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidAction.Unmarshal(m, b)
+}
+func (m *DidAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidAction.Marshal(b, m, deterministic)
+}
+func (m *DidAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidAction.Merge(m, src)
+}
+func (m *DidAction) XXX_Size() int {
+	return xxx_messageInfo_DidAction.Size(m)
+}
+func (m *DidAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidAction proto.InternalMessageInfo
+
+type isDidAction_Value interface {
+	isDidAction_Value()
+}
+
+type DidAction_Register struct {
+	Register *DidRegister `protobuf:"bytes,1,opt,name=register,proto3,oneof"`
+}
+
+type DidAction_RotateKey struct {
+	RotateKey *DidRotateKey `protobuf:"bytes,2,opt,name=rotateKey,proto3,oneof"`
+}
+
+type DidAction_Attest struct {
+	Attest *DidAttest `protobuf:"bytes,3,opt,name=attest,proto3,oneof"`
+}
+
+type DidAction_Revoke struct {
+	Revoke *DidRevoke `protobuf:"bytes,4,opt,name=revoke,proto3,oneof"`
+}
+
+func (*DidAction_Register) isDidAction_Value() {}
+
+func (*DidAction_RotateKey) isDidAction_Value() {}
+
+func (*DidAction_Attest) isDidAction_Value() {}
+
+func (*DidAction_Revoke) isDidAction_Value() {}
+
+func (m *DidAction) GetValue() isDidAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *DidAction) GetRegister() *DidRegister {
+	if x, ok := m.GetValue().(*DidAction_Register); ok {
+		return x.Register
+	}
+	return nil
+}
+
+func (m *DidAction) GetRotateKey() *DidRotateKey {
+	if x, ok := m.GetValue().(*DidAction_RotateKey); ok {
+		return x.RotateKey
+	}
+	return nil
+}
+
+func (m *DidAction) GetAttest() *DidAttest {
+	if x, ok := m.GetValue().(*DidAction_Attest); ok {
+		return x.Attest
+	}
+	return nil
+}
+
+func (m *DidAction) GetRevoke() *DidRevoke {
+	if x, ok := m.GetValue().(*DidAction_Revoke); ok {
+		return x.Revoke
+	}
+	return nil
+}
+
+func (m *DidAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DidAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DidAction_OneofMarshaler, _DidAction_OneofUnmarshaler, _DidAction_OneofSizer, []interface{}{
+		(*DidAction_Register)(nil),
+		(*DidAction_RotateKey)(nil),
+		(*DidAction_Attest)(nil),
+		(*DidAction_Revoke)(nil),
+	}
+}
+
+func _DidAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DidAction)
+	// value
+	switch x := m.Value.(type) {
+	case *DidAction_Register:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Register); err != nil {
+			return err
+		}
+	case *DidAction_RotateKey:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RotateKey); err != nil {
+			return err
+		}
+	case *DidAction_Attest:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Attest); err != nil {
+			return err
+		}
+	case *DidAction_Revoke:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Revoke); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DidAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DidAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DidAction)
+	switch tag {
+	case 1: // value.register
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DidRegister)
+		err := b.DecodeMessage(msg)
+		m.Value = &DidAction_Register{msg}
+		return true, err
+	case 2: // value.rotateKey
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DidRotateKey)
+		err := b.DecodeMessage(msg)
+		m.Value = &DidAction_RotateKey{msg}
+		return true, err
+	case 3: // value.attest
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DidAttest)
+		err := b.DecodeMessage(msg)
+		m.Value = &DidAction_Attest{msg}
+		return true, err
+	case 4: // value.revoke
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DidRevoke)
+		err := b.DecodeMessage(msg)
+		m.Value = &DidAction_Revoke{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DidAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DidAction)
+	// value
+	switch x := m.Value.(type) {
+	case *DidAction_Register:
+		s := proto.Size(x.Register)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DidAction_RotateKey:
+		s := proto.Size(x.RotateKey)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DidAction_Attest:
+		s := proto.Size(x.Attest)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DidAction_Revoke:
+		s := proto.Size(x.Revoke)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//DID文档中的公钥，ty为签名类型
+type DidPublicKey struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ty                   int32    `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DidPublicKey) Reset()         { *m = DidPublicKey{} }
+func (m *DidPublicKey) String() string { return proto.CompactTextString(m) }
+func (*DidPublicKey) ProtoMessage()    {}
+func (*DidPublicKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{1}
+}
+
+func (m *DidPublicKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidPublicKey.Unmarshal(m, b)
+}
+func (m *DidPublicKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidPublicKey.Marshal(b, m, deterministic)
+}
+func (m *DidPublicKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidPublicKey.Merge(m, src)
+}
+func (m *DidPublicKey) XXX_Size() int {
+	return xxx_messageInfo_DidPublicKey.Size(m)
+}
+func (m *DidPublicKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidPublicKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidPublicKey proto.InternalMessageInfo
+
+func (m *DidPublicKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DidPublicKey) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *DidPublicKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+type DidService struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Endpoint             string   `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DidService) Reset()         { *m = DidService{} }
+func (m *DidService) String() string { return proto.CompactTextString(m) }
+func (*DidService) ProtoMessage()    {}
+func (*DidService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{2}
+}
+
+func (m *DidService) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidService.Unmarshal(m, b)
+}
+func (m *DidService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidService.Marshal(b, m, deterministic)
+}
+func (m *DidService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidService.Merge(m, src)
+}
+func (m *DidService) XXX_Size() int {
+	return xxx_messageInfo_DidService.Size(m)
+}
+func (m *DidService) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidService.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidService proto.InternalMessageInfo
+
+func (m *DidService) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DidService) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DidService) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+//注册DID，标识为did:chain33:交易发送者的地址，交易发送者是DID的controller
+type DidRegister struct {
+	PublicKeys           []*DidPublicKey `protobuf:"bytes,1,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
+	Services             []*DidService   `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DidRegister) Reset()         { *m = DidRegister{} }
+func (m *DidRegister) String() string { return proto.CompactTextString(m) }
+func (*DidRegister) ProtoMessage()    {}
+func (*DidRegister) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{3}
+}
+
+func (m *DidRegister) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidRegister.Unmarshal(m, b)
+}
+func (m *DidRegister) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidRegister.Marshal(b, m, deterministic)
+}
+func (m *DidRegister) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidRegister.Merge(m, src)
+}
+func (m *DidRegister) XXX_Size() int {
+	return xxx_messageInfo_DidRegister.Size(m)
+}
+func (m *DidRegister) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidRegister.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidRegister proto.InternalMessageInfo
+
+func (m *DidRegister) GetPublicKeys() []*DidPublicKey {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *DidRegister) GetServices() []*DidService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+//controller轮换DID的公钥，controller不为空的时候同时把DID交给新的controller
+type DidRotateKey struct {
+	Did                  string          `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Controller           string          `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	PublicKeys           []*DidPublicKey `protobuf:"bytes,3,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
+	Services             []*DidService   `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DidRotateKey) Reset()         { *m = DidRotateKey{} }
+func (m *DidRotateKey) String() string { return proto.CompactTextString(m) }
+func (*DidRotateKey) ProtoMessage()    {}
+func (*DidRotateKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{4}
+}
+
+func (m *DidRotateKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidRotateKey.Unmarshal(m, b)
+}
+func (m *DidRotateKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidRotateKey.Marshal(b, m, deterministic)
+}
+func (m *DidRotateKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidRotateKey.Merge(m, src)
+}
+func (m *DidRotateKey) XXX_Size() int {
+	return xxx_messageInfo_DidRotateKey.Size(m)
+}
+func (m *DidRotateKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidRotateKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidRotateKey proto.InternalMessageInfo
+
+func (m *DidRotateKey) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *DidRotateKey) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *DidRotateKey) GetPublicKeys() []*DidPublicKey {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *DidRotateKey) GetServices() []*DidService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+//manage合约中配置的发行者给DID添加可验证的声明，声明的内容只上链hash
+type DidAttest struct {
+	Did                  string   `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	ClaimType            string   `protobuf:"bytes,2,opt,name=claimType,proto3" json:"claimType,omitempty"`
+	ClaimHash            []byte   `protobuf:"bytes,3,opt,name=claimHash,proto3" json:"claimHash,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,4,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DidAttest) Reset()         { *m = DidAttest{} }
+func (m *DidAttest) String() string { return proto.CompactTextString(m) }
+func (*DidAttest) ProtoMessage()    {}
+func (*DidAttest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{5}
+}
+
+func (m *DidAttest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidAttest.Unmarshal(m, b)
+}
+func (m *DidAttest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidAttest.Marshal(b, m, deterministic)
+}
+func (m *DidAttest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidAttest.Merge(m, src)
+}
+func (m *DidAttest) XXX_Size() int {
+	return xxx_messageInfo_DidAttest.Size(m)
+}
+func (m *DidAttest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidAttest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidAttest proto.InternalMessageInfo
+
+func (m *DidAttest) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *DidAttest) GetClaimType() string {
+	if m != nil {
+		return m.ClaimType
+	}
+	return ""
+}
+
+func (m *DidAttest) GetClaimHash() []byte {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return nil
+}
+
+func (m *DidAttest) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+//发行者撤销自己添加的声明
+type DidRevoke struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DidRevoke) Reset()         { *m = DidRevoke{} }
+func (m *DidRevoke) String() string { return proto.CompactTextString(m) }
+func (*DidRevoke) ProtoMessage()    {}
+func (*DidRevoke) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{6}
+}
+
+func (m *DidRevoke) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidRevoke.Unmarshal(m, b)
+}
+func (m *DidRevoke) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidRevoke.Marshal(b, m, deterministic)
+}
+func (m *DidRevoke) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidRevoke.Merge(m, src)
+}
+func (m *DidRevoke) XXX_Size() int {
+	return xxx_messageInfo_DidRevoke.Size(m)
+}
+func (m *DidRevoke) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidRevoke.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidRevoke proto.InternalMessageInfo
+
+func (m *DidRevoke) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DidDocument struct {
+	Did                  string          `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Controller           string          `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	PublicKeys           []*DidPublicKey `protobuf:"bytes,3,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
+	Services             []*DidService   `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	Attestations         []string        `protobuf:"bytes,5,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	Version              int64           `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	CreateHeight         int64           `protobuf:"varint,7,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	UpdateHeight         int64           `protobuf:"varint,8,opt,name=updateHeight,proto3" json:"updateHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DidDocument) Reset()         { *m = DidDocument{} }
+func (m *DidDocument) String() string { return proto.CompactTextString(m) }
+func (*DidDocument) ProtoMessage()    {}
+func (*DidDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{7}
+}
+
+func (m *DidDocument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidDocument.Unmarshal(m, b)
+}
+func (m *DidDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidDocument.Marshal(b, m, deterministic)
+}
+func (m *DidDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidDocument.Merge(m, src)
+}
+func (m *DidDocument) XXX_Size() int {
+	return xxx_messageInfo_DidDocument.Size(m)
+}
+func (m *DidDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidDocument proto.InternalMessageInfo
+
+func (m *DidDocument) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *DidDocument) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *DidDocument) GetPublicKeys() []*DidPublicKey {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *DidDocument) GetServices() []*DidService {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+func (m *DidDocument) GetAttestations() []string {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *DidDocument) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DidDocument) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+func (m *DidDocument) GetUpdateHeight() int64 {
+	if m != nil {
+		return m.UpdateHeight
+	}
+	return 0
+}
+
+type DidAttestation struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Did                  string   `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	Issuer               string   `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClaimType            string   `protobuf:"bytes,4,opt,name=claimType,proto3" json:"claimType,omitempty"`
+	ClaimHash            []byte   `protobuf:"bytes,5,opt,name=claimHash,proto3" json:"claimHash,omitempty"`
+	IssueHeight          int64    `protobuf:"varint,6,opt,name=issueHeight,proto3" json:"issueHeight,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,7,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	Revoked              bool     `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevokeHeight         int64    `protobuf:"varint,9,opt,name=revokeHeight,proto3" json:"revokeHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DidAttestation) Reset()         { *m = DidAttestation{} }
+func (m *DidAttestation) String() string { return proto.CompactTextString(m) }
+func (*DidAttestation) ProtoMessage()    {}
+func (*DidAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{8}
+}
+
+func (m *DidAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DidAttestation.Unmarshal(m, b)
+}
+func (m *DidAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DidAttestation.Marshal(b, m, deterministic)
+}
+func (m *DidAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidAttestation.Merge(m, src)
+}
+func (m *DidAttestation) XXX_Size() int {
+	return xxx_messageInfo_DidAttestation.Size(m)
+}
+func (m *DidAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidAttestation proto.InternalMessageInfo
+
+func (m *DidAttestation) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DidAttestation) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *DidAttestation) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *DidAttestation) GetClaimType() string {
+	if m != nil {
+		return m.ClaimType
+	}
+	return ""
+}
+
+func (m *DidAttestation) GetClaimHash() []byte {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return nil
+}
+
+func (m *DidAttestation) GetIssueHeight() int64 {
+	if m != nil {
+		return m.IssueHeight
+	}
+	return 0
+}
+
+func (m *DidAttestation) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *DidAttestation) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func (m *DidAttestation) GetRevokeHeight() int64 {
+	if m != nil {
+		return m.RevokeHeight
+	}
+	return 0
+}
+
+type ReceiptDidDocument struct {
+	Prev                 *DidDocument `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *DidDocument `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptDidDocument) Reset()         { *m = ReceiptDidDocument{} }
+func (m *ReceiptDidDocument) String() string { return proto.CompactTextString(m) }
+func (*ReceiptDidDocument) ProtoMessage()    {}
+func (*ReceiptDidDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{9}
+}
+
+func (m *ReceiptDidDocument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptDidDocument.Unmarshal(m, b)
+}
+func (m *ReceiptDidDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptDidDocument.Marshal(b, m, deterministic)
+}
+func (m *ReceiptDidDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptDidDocument.Merge(m, src)
+}
+func (m *ReceiptDidDocument) XXX_Size() int {
+	return xxx_messageInfo_ReceiptDidDocument.Size(m)
+}
+func (m *ReceiptDidDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptDidDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptDidDocument proto.InternalMessageInfo
+
+func (m *ReceiptDidDocument) GetPrev() *DidDocument {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptDidDocument) GetCurrent() *DidDocument {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptDidAttestation struct {
+	Prev                 *DidAttestation `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *DidAttestation `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReceiptDidAttestation) Reset()         { *m = ReceiptDidAttestation{} }
+func (m *ReceiptDidAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReceiptDidAttestation) ProtoMessage()    {}
+func (*ReceiptDidAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{10}
+}
+
+func (m *ReceiptDidAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptDidAttestation.Unmarshal(m, b)
+}
+func (m *ReceiptDidAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptDidAttestation.Marshal(b, m, deterministic)
+}
+func (m *ReceiptDidAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptDidAttestation.Merge(m, src)
+}
+func (m *ReceiptDidAttestation) XXX_Size() int {
+	return xxx_messageInfo_ReceiptDidAttestation.Size(m)
+}
+func (m *ReceiptDidAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptDidAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptDidAttestation proto.InternalMessageInfo
+
+func (m *ReceiptDidAttestation) GetPrev() *DidAttestation {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptDidAttestation) GetCurrent() *DidAttestation {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReplyDidDocument struct {
+	Document             *DidDocument      `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Attestations         []*DidAttestation `protobuf:"bytes,2,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplyDidDocument) Reset()         { *m = ReplyDidDocument{} }
+func (m *ReplyDidDocument) String() string { return proto.CompactTextString(m) }
+func (*ReplyDidDocument) ProtoMessage()    {}
+func (*ReplyDidDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{11}
+}
+
+func (m *ReplyDidDocument) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyDidDocument.Unmarshal(m, b)
+}
+func (m *ReplyDidDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyDidDocument.Marshal(b, m, deterministic)
+}
+func (m *ReplyDidDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyDidDocument.Merge(m, src)
+}
+func (m *ReplyDidDocument) XXX_Size() int {
+	return xxx_messageInfo_ReplyDidDocument.Size(m)
+}
+func (m *ReplyDidDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyDidDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyDidDocument proto.InternalMessageInfo
+
+func (m *ReplyDidDocument) GetDocument() *DidDocument {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (m *ReplyDidDocument) GetAttestations() []*DidAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+//验证DID是否有发行者还在配置中的，没有过期和撤销的claimType声明
+type ReqDidVerify struct {
+	Did                  string   `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	ClaimType            string   `protobuf:"bytes,2,opt,name=claimType,proto3" json:"claimType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqDidVerify) Reset()         { *m = ReqDidVerify{} }
+func (m *ReqDidVerify) String() string { return proto.CompactTextString(m) }
+func (*ReqDidVerify) ProtoMessage()    {}
+func (*ReqDidVerify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{12}
+}
+
+func (m *ReqDidVerify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqDidVerify.Unmarshal(m, b)
+}
+func (m *ReqDidVerify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqDidVerify.Marshal(b, m, deterministic)
+}
+func (m *ReqDidVerify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqDidVerify.Merge(m, src)
+}
+func (m *ReqDidVerify) XXX_Size() int {
+	return xxx_messageInfo_ReqDidVerify.Size(m)
+}
+func (m *ReqDidVerify) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqDidVerify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqDidVerify proto.InternalMessageInfo
+
+func (m *ReqDidVerify) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *ReqDidVerify) GetClaimType() string {
+	if m != nil {
+		return m.ClaimType
+	}
+	return ""
+}
+
+type ReplyDidVerify struct {
+	Verified             bool            `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	Attestation          *DidAttestation `protobuf:"bytes,2,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyDidVerify) Reset()         { *m = ReplyDidVerify{} }
+func (m *ReplyDidVerify) String() string { return proto.CompactTextString(m) }
+func (*ReplyDidVerify) ProtoMessage()    {}
+func (*ReplyDidVerify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41649caf91b6313, []int{13}
+}
+
+func (m *ReplyDidVerify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyDidVerify.Unmarshal(m, b)
+}
+func (m *ReplyDidVerify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyDidVerify.Marshal(b, m, deterministic)
+}
+func (m *ReplyDidVerify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyDidVerify.Merge(m, src)
+}
+func (m *ReplyDidVerify) XXX_Size() int {
+	return xxx_messageInfo_ReplyDidVerify.Size(m)
+}
+func (m *ReplyDidVerify) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyDidVerify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyDidVerify proto.InternalMessageInfo
+
+func (m *ReplyDidVerify) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *ReplyDidVerify) GetAttestation() *DidAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DidAction)(nil), "types.DidAction")
+	proto.RegisterType((*DidPublicKey)(nil), "types.DidPublicKey")
+	proto.RegisterType((*DidService)(nil), "types.DidService")
+	proto.RegisterType((*DidRegister)(nil), "types.DidRegister")
+	proto.RegisterType((*DidRotateKey)(nil), "types.DidRotateKey")
+	proto.RegisterType((*DidAttest)(nil), "types.DidAttest")
+	proto.RegisterType((*DidRevoke)(nil), "types.DidRevoke")
+	proto.RegisterType((*DidDocument)(nil), "types.DidDocument")
+	proto.RegisterType((*DidAttestation)(nil), "types.DidAttestation")
+	proto.RegisterType((*ReceiptDidDocument)(nil), "types.ReceiptDidDocument")
+	proto.RegisterType((*ReceiptDidAttestation)(nil), "types.ReceiptDidAttestation")
+	proto.RegisterType((*ReplyDidDocument)(nil), "types.ReplyDidDocument")
+	proto.RegisterType((*ReqDidVerify)(nil), "types.ReqDidVerify")
+	proto.RegisterType((*ReplyDidVerify)(nil), "types.ReplyDidVerify")
+}
+
+func init() { proto.RegisterFile("did.proto", fileDescriptor_f41649caf91b6313) }
+
+var fileDescriptor_f41649caf91b6313 = []byte{
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x6e, 0x9c, 0x3f, 0xfb, 0x24, 0x8a, 0x7a, 0xe7, 0xaa, 0x95, 0xd5, 0x7b, 0x85, 0xa2, 0x59,
+	0xa0, 0x82, 0x20, 0x20, 0xba, 0x40, 0x6c, 0x90, 0x8a, 0x22, 0x14, 0x09, 0x16, 0x68, 0x40, 0xec,
+	0x5d, 0xcf, 0xa1, 0x1d, 0x48, 0x6d, 0x77, 0x3c, 0x8e, 0xc8, 0xa2, 0x2f, 0xc0, 0x73, 0xb0, 0xe1,
+	0xb9, 0x78, 0x11, 0x34, 0x3f, 0xb6, 0x27, 0x09, 0x2d, 0xea, 0x8e, 0xdd, 0xcc, 0xf1, 0xf7, 0xcd,
+	0xf9, 0xce, 0x77, 0xce, 0x8c, 0x21, 0xe2, 0x82, 0xcf, 0x0a, 0x99, 0xab, 0x9c, 0xf4, 0xd5, 0xba,
+	0xc0, 0x92, 0xfe, 0xec, 0x40, 0x34, 0x17, 0xfc, 0x34, 0x55, 0x22, 0xcf, 0xc8, 0x53, 0x08, 0x25,
+	0x9e, 0x8b, 0x52, 0xa1, 0x8c, 0x3b, 0xd3, 0xce, 0xf1, 0xe8, 0x19, 0x99, 0x19, 0xdc, 0x6c, 0x2e,
+	0x38, 0x73, 0x5f, 0x16, 0x7b, 0xac, 0x41, 0x91, 0x13, 0x88, 0x64, 0xae, 0x12, 0x85, 0x6f, 0x70,
+	0x1d, 0x07, 0x86, 0xf2, 0xaf, 0x47, 0xa9, 0x3f, 0x2d, 0xf6, 0x58, 0x8b, 0x23, 0x0f, 0x61, 0x90,
+	0x28, 0x85, 0xa5, 0x8a, 0xbb, 0x86, 0xb1, 0xdf, 0x32, 0x4e, 0x4d, 0x7c, 0xb1, 0xc7, 0x1c, 0x42,
+	0x63, 0x25, 0xae, 0xf2, 0x2f, 0x18, 0xf7, 0xb6, 0xb1, 0xcc, 0xc4, 0x35, 0xd6, 0x22, 0xc8, 0x04,
+	0x02, 0xb5, 0x8e, 0xfb, 0xd3, 0xce, 0x71, 0x9f, 0x05, 0x6a, 0xfd, 0x6a, 0x08, 0xfd, 0x55, 0xb2,
+	0xac, 0x90, 0xbe, 0x86, 0xf1, 0x5c, 0xf0, 0x77, 0xd5, 0xd9, 0x52, 0xa4, 0x5a, 0xc0, 0x04, 0x02,
+	0xc1, 0x4d, 0x85, 0x11, 0x0b, 0x04, 0x77, 0xc4, 0xa0, 0x26, 0x92, 0x43, 0x18, 0x14, 0xd5, 0x99,
+	0x2e, 0x49, 0x0b, 0x1c, 0x33, 0xb7, 0xa3, 0x6f, 0x01, 0xe6, 0x82, 0xbf, 0x47, 0xb9, 0x12, 0x29,
+	0xee, 0x9c, 0x42, 0xa0, 0xa7, 0xb5, 0x99, 0x73, 0x22, 0x66, 0xd6, 0xe4, 0x08, 0x42, 0xcc, 0x78,
+	0x91, 0x8b, 0xcc, 0x16, 0x1b, 0xb1, 0x66, 0x4f, 0xaf, 0x60, 0xe4, 0xd9, 0x4a, 0x4e, 0x00, 0x8a,
+	0x5a, 0x61, 0x19, 0x77, 0xa6, 0xdd, 0x4d, 0x2f, 0x1b, 0xf5, 0xcc, 0x83, 0x91, 0xc7, 0x10, 0x96,
+	0x56, 0x4e, 0x19, 0x07, 0x86, 0xf2, 0x4f, 0x4b, 0x71, 0x42, 0x59, 0x03, 0xa1, 0xdf, 0x3b, 0xc6,
+	0x89, 0xa6, 0x2f, 0x64, 0x1f, 0xba, 0xbc, 0x29, 0x42, 0x2f, 0xc9, 0x3d, 0x80, 0x34, 0xcf, 0x94,
+	0xcc, 0x97, 0x4b, 0x94, 0xae, 0x16, 0x2f, 0xb2, 0x25, 0xb3, 0x7b, 0x77, 0x99, 0xbd, 0x3f, 0xcb,
+	0xbc, 0xb6, 0x43, 0x69, 0x27, 0x60, 0x57, 0xe2, 0xff, 0x10, 0xa5, 0xcb, 0x44, 0x5c, 0x7e, 0x68,
+	0xdd, 0x6e, 0x03, 0xcd, 0xd7, 0x45, 0x52, 0x5e, 0xb8, 0xfe, 0xb5, 0x01, 0x42, 0x61, 0x8c, 0x5f,
+	0x0b, 0x21, 0x71, 0x81, 0xe2, 0xfc, 0x42, 0x99, 0xa9, 0xea, 0xb2, 0x8d, 0x18, 0xfd, 0xcf, 0xa4,
+	0x67, 0xcd, 0x50, 0xf9, 0x5d, 0xa6, 0x3f, 0x02, 0xd3, 0xb6, 0x79, 0x9e, 0x56, 0x97, 0x98, 0xa9,
+	0xbf, 0xd3, 0x41, 0x5d, 0xa6, 0xbd, 0x40, 0x89, 0xbe, 0xd8, 0x65, 0xdc, 0x9f, 0x76, 0x8f, 0x23,
+	0xb6, 0x11, 0x23, 0x31, 0x0c, 0x57, 0x28, 0x4b, 0x91, 0x67, 0xf1, 0xc0, 0xb8, 0x50, 0x6f, 0x35,
+	0x3b, 0x95, 0x98, 0xa8, 0xda, 0xa4, 0xa1, 0x35, 0xc9, 0x8f, 0x69, 0x4c, 0x55, 0xf0, 0x16, 0x13,
+	0x5a, 0x8c, 0x1f, 0xa3, 0xdf, 0x02, 0x98, 0x34, 0x8d, 0x34, 0x59, 0x77, 0x2e, 0x8d, 0xb3, 0x2f,
+	0x68, 0xed, 0x3b, 0x84, 0x81, 0x28, 0xcb, 0x0a, 0xa5, 0xbb, 0x30, 0x6e, 0xb7, 0xd9, 0xf5, 0xde,
+	0xad, 0x5d, 0xef, 0x6f, 0x77, 0x7d, 0x0a, 0x23, 0x73, 0x8a, 0xd3, 0x6a, 0xcb, 0xf5, 0x43, 0x3b,
+	0x73, 0x31, 0xdc, 0x9d, 0x0b, 0x6d, 0x98, 0x7d, 0x69, 0xb8, 0xa9, 0x36, 0x64, 0xf5, 0x56, 0xb3,
+	0xed, 0xd2, 0xb1, 0x23, 0xcb, 0xf6, 0x63, 0xf4, 0x33, 0x10, 0x86, 0x29, 0x8a, 0x42, 0xf9, 0xe3,
+	0x73, 0x1f, 0x7a, 0x85, 0xc4, 0xd5, 0xee, 0x73, 0x5b, 0x23, 0x98, 0xf9, 0x4e, 0x1e, 0xc1, 0x30,
+	0xad, 0xa4, 0xc4, 0x4c, 0xb9, 0x67, 0xf6, 0x77, 0xd0, 0x1a, 0x42, 0x4b, 0x38, 0x68, 0x73, 0xf9,
+	0xf6, 0x3f, 0xd8, 0x48, 0x77, 0xb0, 0xfd, 0xf0, 0x1a, 0x90, 0xcb, 0xf8, 0x64, 0x3b, 0xe3, 0x0d,
+	0xe8, 0x26, 0xe9, 0x35, 0xec, 0x33, 0x2c, 0x96, 0x6b, 0xbf, 0xbc, 0x19, 0x84, 0xdc, 0xad, 0x6f,
+	0x29, 0xb1, 0xc1, 0x90, 0x17, 0x5b, 0x73, 0x6b, 0xdf, 0xb4, 0x1b, 0x32, 0x6f, 0x40, 0xe9, 0x4b,
+	0x18, 0x33, 0xbc, 0x9a, 0x0b, 0xfe, 0x11, 0xa5, 0xf8, 0xb4, 0xbe, 0xeb, 0xbb, 0x41, 0x11, 0x26,
+	0xb5, 0x7c, 0x77, 0xc2, 0x11, 0x84, 0x2b, 0xbd, 0x12, 0x68, 0x8f, 0x09, 0x59, 0xb3, 0x27, 0xcf,
+	0x61, 0xe4, 0x65, 0xbf, 0xdd, 0x21, 0x1f, 0x79, 0x36, 0x30, 0xff, 0xdf, 0x93, 0x5f, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0x17, 0x25, 0xa9, 0x79, 0x8c, 0x07, 0x00, 0x00,
+}
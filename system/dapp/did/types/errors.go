@@ -0,0 +1,30 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrDidExist DID已经注册
+	ErrDidExist = errors.New("ErrDidExist")
+	// ErrDidNotExist DID不存在
+	ErrDidNotExist = errors.New("ErrDidNotExist")
+	// ErrNotController 不是DID的controller
+	ErrNotController = errors.New("ErrNotController")
+	// ErrPublicKey 公钥不合法或者id重复
+	ErrPublicKey = errors.New("ErrPublicKey")
+	// ErrService 服务不合法或者id重复
+	ErrService = errors.New("ErrService")
+	// ErrNotIssuer 不是manage合约中配置的发行者
+	ErrNotIssuer = errors.New("ErrNotIssuer")
+	// ErrClaim 声明的类型或者hash不合法
+	ErrClaim = errors.New("ErrClaim")
+	// ErrTooManyAttestations DID的声明太多
+	ErrTooManyAttestations = errors.New("ErrTooManyAttestations")
+	// ErrAttestationNotExist 声明不存在
+	ErrAttestationNotExist = errors.New("ErrAttestationNotExist")
+	// ErrRevoked 声明已经撤销
+	ErrRevoked = errors.New("ErrRevoked")
+)
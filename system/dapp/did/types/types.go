@@ -0,0 +1,72 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types did插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// DidX 执行器名称
+	DidX       = "did"
+	actionName = map[string]int32{
+		"Register":  DidActionRegister,
+		"RotateKey": DidActionRotateKey,
+		"Attest":    DidActionAttest,
+		"Revoke":    DidActionRevoke,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogDidRegister:  {Ty: reflect.TypeOf(ReceiptDidDocument{}), Name: "LogDidRegister"},
+		TyLogDidRotateKey: {Ty: reflect.TypeOf(ReceiptDidDocument{}), Name: "LogDidRotateKey"},
+		TyLogDidAttest:    {Ty: reflect.TypeOf(ReceiptDidAttestation{}), Name: "LogDidAttest"},
+		TyLogDidRevoke:    {Ty: reflect.TypeOf(ReceiptDidAttestation{}), Name: "LogDidRevoke"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(DidX))
+	types.RegistorExecutor(DidX, NewType())
+	types.RegisterDappFork(DidX, "Enable", 0)
+}
+
+// DidType did执行器类型
+type DidType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a did type object
+func NewType() *DidType {
+	c := &DidType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return did action
+func (d *DidType) GetPayload() types.Message {
+	return &DidAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (d *DidType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (d *DidType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (d *DidType) GetName() string {
+	return DidX
+}
+
+// AddressDid 地址对应的DID标识
+func AddressDid(addr string) string {
+	return DidPrefix + addr
+}
@@ -0,0 +1,171 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands dpos插件命令
+package commands
+
+import (
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// DposCmd dpos command
+func DposCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dpos",
+		Short: "Delegated proof of stake management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		RegistCmd(),
+		CancelRegistCmd(),
+		VoteCmd(),
+		CancelVoteCmd(),
+		QueryDelegateCmd(),
+		TopDelegatesCmd(),
+	)
+
+	return cmd
+}
+
+// RegistCmd regist delegate
+func RegistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "regist",
+		Short: "Create a transaction to regist as delegate",
+		Run:   regist,
+	}
+	cmd.Flags().StringP("name", "n", "", "delegate name")
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func regist(cmd *cobra.Command, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	commandtypes.CreateActionTx(cmd, dty.DposX, &dty.DposAction{
+		Ty:    dty.DposActionRegist,
+		Value: &dty.DposAction_Regist{Regist: &dty.DposRegist{Name: name}},
+	})
+}
+
+// CancelRegistCmd cancel regist delegate
+func CancelRegistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel_regist",
+		Short: "Create a transaction to cancel delegate regist",
+		Run:   cancelRegist,
+	}
+	return cmd
+}
+
+func cancelRegist(cmd *cobra.Command, args []string) {
+	commandtypes.CreateActionTx(cmd, dty.DposX, &dty.DposAction{
+		Ty:    dty.DposActionCancelRegist,
+		Value: &dty.DposAction_CancelRegist{CancelRegist: &dty.DposCancelRegist{}},
+	})
+}
+
+func addVoteFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("delegate", "d", "", "delegate address")
+	cmd.MarkFlagRequired("delegate")
+	cmd.Flags().Float64P("amount", "a", 0, "vote amount")
+	cmd.MarkFlagRequired("amount")
+}
+
+// VoteCmd vote for delegate
+func VoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote",
+		Short: "Create a transaction to vote for delegate, coins should be transferred to dpos first",
+		Run:   vote,
+	}
+	addVoteFlags(cmd)
+	return cmd
+}
+
+func vote(cmd *cobra.Command, args []string) {
+	delegate, _ := cmd.Flags().GetString("delegate")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	commandtypes.CreateActionTx(cmd, dty.DposX, &dty.DposAction{
+		Ty:    dty.DposActionVote,
+		Value: &dty.DposAction_Vote{Vote: &dty.DposVote{Delegate: delegate, Amount: int64(amount*types.InputPrecision) * types.Multiple1E4}},
+	})
+}
+
+// CancelVoteCmd cancel vote
+func CancelVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel_vote",
+		Short: "Create a transaction to cancel vote for delegate",
+		Run:   cancelVote,
+	}
+	addVoteFlags(cmd)
+	return cmd
+}
+
+func cancelVote(cmd *cobra.Command, args []string) {
+	delegate, _ := cmd.Flags().GetString("delegate")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	commandtypes.CreateActionTx(cmd, dty.DposX, &dty.DposAction{
+		Ty:    dty.DposActionCancelVote,
+		Value: &dty.DposAction_CancelVote{CancelVote: &dty.DposCancelVote{Delegate: delegate, Amount: int64(amount*types.InputPrecision) * types.Multiple1E4}},
+	})
+}
+
+// QueryDelegateCmd query delegate
+func QueryDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate",
+		Short: "Query delegate info",
+		Run:   queryDelegate,
+	}
+	cmd.Flags().StringP("addr", "a", "", "delegate address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func queryDelegate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	addr, _ := cmd.Flags().GetString("addr")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, dty.DposX)
+	params.FuncName = dty.FuncNameGetDelegate
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: addr})
+
+	var res dty.DposDelegate
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// TopDelegatesCmd query top delegates
+func TopDelegatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Query delegates ordered by votes",
+		Run:   topDelegates,
+	}
+	cmd.Flags().Int32P("count", "c", dty.DefaultDelegateNum, "delegate count")
+	return cmd
+}
+
+func topDelegates(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	count, _ := cmd.Flags().GetInt32("count")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, dty.DposX)
+	params.FuncName = dty.FuncNameGetTopDelegates
+	params.Payload = types.MustPBToJSON(&dty.ReqDposTopDelegates{Count: count})
+
+	var res dty.ReplyDposDelegates
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -0,0 +1,69 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor dpos执行器，负责受托人的注册，投票以及出块奖励
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.dpos")
+	driverName = dty.DposX
+	conf       = types.ConfSub(driverName)
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Dpos{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newDpos, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return dpos name
+func GetName() string {
+	return newDpos().GetName()
+}
+
+// Dpos defines Dpos object
+type Dpos struct {
+	drivers.DriverBase
+}
+
+func newDpos() drivers.Driver {
+	d := &Dpos{}
+	d.SetChild(d)
+	d.SetExecutorType(types.LoadExecutorType(driverName))
+	return d
+}
+
+// GetDriverName return a drivername
+func (d *Dpos) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx check transaction
+func (d *Dpos) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (d *Dpos) CheckReceiptExecOk() bool {
+	return true
+}
+
+//getBlockReward 每个区块奖励给出块受托人的coins，没有配置的时候不奖励
+func getBlockReward() int64 {
+	if _, err := conf.G("blockReward"); err != nil {
+		return 0
+	}
+	return conf.GInt("blockReward")
+}
@@ -0,0 +1,73 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendDposTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) int32 {
+	_, detail, err := mock33.SendCallTx(priv, dty.DposX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+func queryDpos(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(dty.DposX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func TestDposRegistAndVote(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	priv := mock33.GetGenesisKey()
+	addr := mock33.GetGenesisAddress()
+
+	mock33.SendTx(util.CreateCoinsTx(priv, address.ExecAddress(dty.DposX), 100*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	ty := sendDposTx(t, mock33, priv, "Regist", &dty.DposRegist{Name: "genesis"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	//重复注册
+	ty = sendDposTx(t, mock33, priv, "Regist", &dty.DposRegist{Name: "genesis"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	ty = sendDposTx(t, mock33, priv, "Vote", &dty.DposVote{Delegate: addr, Amount: 10 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	delegate := queryDpos(t, mock33, dty.FuncNameGetDelegate, &types.ReqString{Data: addr}).(*dty.DposDelegate)
+	assert.Equal(t, "genesis", delegate.Name)
+	assert.Equal(t, 10*types.Coin, delegate.Votes)
+	acc := mock33.GetExecAccount(mock33.GetLastBlock().StateHash, dty.DposX, addr)
+	assert.Equal(t, 10*types.Coin, acc.Frozen)
+
+	//投票不能超过可用余额
+	ty = sendDposTx(t, mock33, priv, "Vote", &dty.DposVote{Delegate: addr, Amount: 1000 * types.Coin})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendDposTx(t, mock33, priv, "CancelVote", &dty.DposCancelVote{Delegate: addr, Amount: 4 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	voter := queryDpos(t, mock33, dty.FuncNameGetVoter, &dty.ReqDposVoter{Voter: addr, Delegate: addr}).(*dty.DposVoter)
+	assert.Equal(t, 6*types.Coin, voter.Amount)
+
+	top := queryDpos(t, mock33, dty.FuncNameGetTopDelegates, &dty.ReqDposTopDelegates{Count: 1}).(*dty.ReplyDposDelegates)
+	assert.Equal(t, 1, len(top.Delegates))
+	assert.Equal(t, 6*types.Coin, top.Delegates[0].Votes)
+
+	ty = sendDposTx(t, mock33, priv, "CancelRegist", &dty.DposCancelRegist{})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	top = queryDpos(t, mock33, dty.FuncNameGetTopDelegates, &dty.ReqDposTopDelegates{Count: 1}).(*dty.ReplyDposDelegates)
+	assert.Equal(t, 0, len(top.Delegates))
+}
@@ -0,0 +1,323 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"sort"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	delegateListKey   = []byte("mavl-" + dty.DposX + "-delegates")
+	delegateKeyPrefix = "mavl-" + dty.DposX + "-delegate-"
+	voterKeyPrefix    = "mavl-" + dty.DposX + "-voter-"
+)
+
+func calcDelegateKey(addr string) []byte {
+	return []byte(delegateKeyPrefix + addr)
+}
+
+func calcVoterKey(voter, delegate string) []byte {
+	return []byte(voterKeyPrefix + voter + "-" + delegate)
+}
+
+// Action dpos交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(d *Dpos, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: d.GetCoinsAccount(),
+		db:           d.GetStateDB(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       d.GetHeight(),
+		index:        index,
+	}
+}
+
+func getDelegate(db dbm.KV, addr string) (*dty.DposDelegate, error) {
+	value, err := db.Get(calcDelegateKey(addr))
+	if err != nil || value == nil {
+		return nil, dty.ErrDelegateNotExist
+	}
+	var delegate dty.DposDelegate
+	err = types.Decode(value, &delegate)
+	if err != nil {
+		return nil, err
+	}
+	return &delegate, nil
+}
+
+// GetDelegate 获取受托人，其他执行器可以用自己的状态数据库读取受托人的得票数
+func GetDelegate(db dbm.KV, addr string) (*dty.DposDelegate, error) {
+	return getDelegate(db, addr)
+}
+
+func getDelegateList(db dbm.KV) (*dty.DposDelegateList, error) {
+	value, err := db.Get(delegateListKey)
+	if err != nil || value == nil {
+		return &dty.DposDelegateList{}, nil
+	}
+	var list dty.DposDelegateList
+	err = types.Decode(value, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func getVoter(db dbm.KV, voter, delegate string) (*dty.DposVoter, error) {
+	value, err := db.Get(calcVoterKey(voter, delegate))
+	if err != nil || value == nil {
+		return &dty.DposVoter{Voter: voter, Delegate: delegate}, nil
+	}
+	var v dty.DposVoter
+	err = types.Decode(value, &v)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+//getAllDelegates 获取所有注册过的受托人
+func getAllDelegates(db dbm.KV) ([]*dty.DposDelegate, error) {
+	list, err := getDelegateList(db)
+	if err != nil {
+		return nil, err
+	}
+	var delegates []*dty.DposDelegate
+	for _, addr := range list.Addrs {
+		delegate, err := getDelegate(db, addr)
+		if err != nil {
+			return nil, err
+		}
+		delegates = append(delegates, delegate)
+	}
+	return delegates, nil
+}
+
+//getTopDelegates 按得票数从高到低排列，得票相同的先注册的优先，最后按地址排序保证确定性
+func getTopDelegates(db dbm.KV, count int) ([]*dty.DposDelegate, error) {
+	all, err := getAllDelegates(db)
+	if err != nil {
+		return nil, err
+	}
+	var delegates []*dty.DposDelegate
+	for _, delegate := range all {
+		if delegate.Active {
+			delegates = append(delegates, delegate)
+		}
+	}
+	sort.Slice(delegates, func(i, j int) bool {
+		if delegates[i].Votes != delegates[j].Votes {
+			return delegates[i].Votes > delegates[j].Votes
+		}
+		if delegates[i].RegistHeight != delegates[j].RegistHeight {
+			return delegates[i].RegistHeight < delegates[j].RegistHeight
+		}
+		return delegates[i].Addr < delegates[j].Addr
+	})
+	if count > 0 && len(delegates) > count {
+		delegates = delegates[:count]
+	}
+	return delegates, nil
+}
+
+func (a *Action) saveDelegate(delegate *dty.DposDelegate) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcDelegateKey(delegate.Addr), Value: types.Encode(delegate)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (a *Action) saveVoter(voter *dty.DposVoter) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcVoterKey(voter.Voter, voter.Delegate), Value: types.Encode(voter)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func delegateReceipt(ty int32, prev, current *dty.DposDelegate) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&dty.ReceiptDposDelegate{Prev: prev, Current: current})}
+}
+
+func voterReceipt(ty int32, prev, current *dty.DposVoter) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&dty.ReceiptDposVote{Prev: prev, Current: current})}
+}
+
+func (a *Action) regist(payload *dty.DposRegist) (*types.Receipt, error) {
+	if len(payload.Name) == 0 || len(payload.Name) > dty.MaxDelegateNameLength {
+		return nil, dty.ErrDelegateName
+	}
+	var kv []*types.KeyValue
+	prev, err := getDelegate(a.db, a.fromaddr)
+	//只有出块统计记录的受托人没有名称，还没有注册过
+	registered := err == nil && prev.Name != ""
+	if registered && prev.Active {
+		return nil, dty.ErrDelegateExist
+	}
+	current := &dty.DposDelegate{Addr: a.fromaddr}
+	if err == nil {
+		//重新注册的时候保留原来的投票以及出块记录
+		copyDelegate := *prev
+		current = &copyDelegate
+	}
+	if !registered {
+		list, err := getDelegateList(a.db)
+		if err != nil {
+			return nil, err
+		}
+		list.Addrs = append(list.Addrs, a.fromaddr)
+		value := types.Encode(list)
+		a.db.Set(delegateListKey, value)
+		kv = append(kv, &types.KeyValue{Key: delegateListKey, Value: value})
+	}
+	current.Name = payload.Name
+	current.Active = true
+	current.RegistHeight = a.height
+	kv = append(kv, a.saveDelegate(current))
+	log := delegateReceipt(dty.TyLogDposRegist, prev, current)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func (a *Action) cancelRegist(payload *dty.DposCancelRegist) (*types.Receipt, error) {
+	prev, err := getDelegate(a.db, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if !prev.Active {
+		return nil, dty.ErrDelegateNotActive
+	}
+	current := *prev
+	current.Active = false
+	kv := []*types.KeyValue{a.saveDelegate(&current)}
+	log := delegateReceipt(dty.TyLogDposCancelRegist, prev, &current)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func (a *Action) vote(payload *dty.DposVote) (*types.Receipt, error) {
+	if !types.CheckAmount(payload.Amount) {
+		return nil, types.ErrAmount
+	}
+	delegate, err := getDelegate(a.db, payload.Delegate)
+	if err != nil {
+		return nil, err
+	}
+	if !delegate.Active {
+		return nil, dty.ErrDelegateNotActive
+	}
+	receipt, err := a.coinsAccount.ExecFrozen(a.fromaddr, a.execaddr, payload.Amount)
+	if err != nil {
+		clog.Error("dpos vote", "addr", a.fromaddr, "execaddr", a.execaddr, "amount", payload.Amount, "err", err)
+		return nil, err
+	}
+	prevVoter, err := getVoter(a.db, a.fromaddr, payload.Delegate)
+	if err != nil {
+		return nil, err
+	}
+	voter := *prevVoter
+	voter.Amount += payload.Amount
+	current := *delegate
+	current.Votes += payload.Amount
+
+	kv := append(receipt.KV, a.saveVoter(&voter), a.saveDelegate(&current))
+	logs := append(receipt.Logs, voterReceipt(dty.TyLogDposVote, prevVoter, &voter))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) cancelVote(payload *dty.DposCancelVote) (*types.Receipt, error) {
+	if !types.CheckAmount(payload.Amount) {
+		return nil, types.ErrAmount
+	}
+	prevVoter, err := getVoter(a.db, a.fromaddr, payload.Delegate)
+	if err != nil {
+		return nil, err
+	}
+	if prevVoter.Amount < payload.Amount {
+		return nil, dty.ErrVoteNotEnough
+	}
+	delegate, err := getDelegate(a.db, payload.Delegate)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := a.coinsAccount.ExecActive(a.fromaddr, a.execaddr, payload.Amount)
+	if err != nil {
+		clog.Error("dpos cancel vote", "addr", a.fromaddr, "execaddr", a.execaddr, "amount", payload.Amount, "err", err)
+		return nil, err
+	}
+	voter := *prevVoter
+	voter.Amount -= payload.Amount
+	current := *delegate
+	current.Votes -= payload.Amount
+
+	kv := append(receipt.KV, a.saveVoter(&voter), a.saveDelegate(&current))
+	logs := append(receipt.Logs, voterReceipt(dty.TyLogDposCancelVote, prevVoter, &voter))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//loadStatDelegate 出块统计使用，创世配置的受托人可能没有注册
+func (a *Action) loadStatDelegate(addr string) *dty.DposDelegate {
+	delegate, err := getDelegate(a.db, addr)
+	if err != nil {
+		return &dty.DposDelegate{Addr: addr}
+	}
+	return delegate
+}
+
+func (a *Action) miner(payload *dty.DposMiner) (*types.Receipt, error) {
+	var kv []*types.KeyValue
+	var logs []*types.ReceiptLog
+	//同一个受托人可能漏掉多个时间片
+	missed := make(map[string]int64)
+	var addrs []string
+	for _, addr := range payload.Missed {
+		if _, ok := missed[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+		missed[addr]++
+	}
+	for _, addr := range addrs {
+		prev := a.loadStatDelegate(addr)
+		current := *prev
+		current.MissedBlocks += missed[addr]
+		kv = append(kv, a.saveDelegate(&current))
+		logs = append(logs, delegateReceipt(dty.TyLogDposMiner, prev, &current))
+	}
+	prev := a.loadStatDelegate(a.fromaddr)
+	current := *prev
+	current.ProducedBlocks++
+	kv = append(kv, a.saveDelegate(&current))
+	logs = append(logs, delegateReceipt(dty.TyLogDposMiner, prev, &current))
+
+	reward := getBlockReward()
+	if reward > 0 {
+		receipt1, err := a.coinsAccount.ExecIssueCoins(a.execaddr, reward)
+		if err != nil {
+			clog.Error("dpos miner", "ExecIssueCoins err", err)
+			return nil, err
+		}
+		receipt2, err := a.coinsAccount.ExecDeposit(a.fromaddr, a.execaddr, reward)
+		if err != nil {
+			clog.Error("dpos miner", "ExecDeposit err", err)
+			return nil, err
+		}
+		kv = append(kv, receipt1.KV...)
+		kv = append(kv, receipt2.KV...)
+		logs = append(logs, receipt1.Logs...)
+		logs = append(logs, receipt2.Logs...)
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
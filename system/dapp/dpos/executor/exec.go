@@ -0,0 +1,43 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Regist 注册受托人
+func (d *Dpos) Exec_Regist(payload *dty.DposRegist, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(d, tx, index)
+	return action.regist(payload)
+}
+
+// Exec_CancelRegist 取消注册受托人
+func (d *Dpos) Exec_CancelRegist(payload *dty.DposCancelRegist, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(d, tx, index)
+	return action.cancelRegist(payload)
+}
+
+// Exec_Vote 给受托人投票
+func (d *Dpos) Exec_Vote(payload *dty.DposVote, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(d, tx, index)
+	return action.vote(payload)
+}
+
+// Exec_CancelVote 取消投票
+func (d *Dpos) Exec_CancelVote(payload *dty.DposCancelVote, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(d, tx, index)
+	return action.cancelVote(payload)
+}
+
+// Exec_Miner 出块交易，记录出块和漏块，并发放出块奖励
+func (d *Dpos) Exec_Miner(payload *dty.DposMiner, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if index != 0 {
+		return nil, dty.ErrMinerTxIndex
+	}
+	action := NewAction(d, tx, index)
+	return action.miner(payload)
+}
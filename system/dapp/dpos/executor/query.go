@@ -0,0 +1,38 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetDelegate 获取受托人信息
+func (d *Dpos) Query_GetDelegate(in *types.ReqString) (types.Message, error) {
+	return getDelegate(d.GetStateDB(), in.Data)
+}
+
+// Query_GetTopDelegates 获取得票最多的受托人，共识模块按这个顺序轮流出块
+func (d *Dpos) Query_GetTopDelegates(in *dty.ReqDposTopDelegates) (types.Message, error) {
+	delegates, err := getTopDelegates(d.GetStateDB(), int(in.Count))
+	if err != nil {
+		return nil, err
+	}
+	return &dty.ReplyDposDelegates{Delegates: delegates}, nil
+}
+
+// Query_GetAllDelegates 获取所有注册过的受托人
+func (d *Dpos) Query_GetAllDelegates(in *types.ReqNil) (types.Message, error) {
+	delegates, err := getAllDelegates(d.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	return &dty.ReplyDposDelegates{Delegates: delegates}, nil
+}
+
+// Query_GetVoter 获取投票人给受托人的投票
+func (d *Dpos) Query_GetVoter(in *dty.ReqDposVoter) (types.Message, error) {
+	return getVoter(d.GetStateDB(), in.Voter, in.Delegate)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dpos dpos共识配套的执行器插件
+// 1. 受托人注册和取消注册
+// 2. 给受托人投票和取消投票
+// 3. 记录受托人的出块和漏块，发放出块奖励
+package dpos
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/dpos/commands"
+	"github.com/33cn/chain33/system/dapp/dpos/executor"
+	"github.com/33cn/chain33/system/dapp/dpos/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.DposX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.DposCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// dpos action ty
+const (
+	DposActionRegist = iota + 1
+	DposActionCancelRegist
+	DposActionVote
+	DposActionCancelVote
+	DposActionMiner
+)
+
+// dpos log ty
+const (
+	TyLogDposRegist       = 420
+	TyLogDposCancelRegist = 421
+	TyLogDposVote         = 422
+	TyLogDposCancelVote   = 423
+	TyLogDposMiner        = 424
+)
+
+// query func name
+const (
+	FuncNameGetDelegate      = "GetDelegate"
+	FuncNameGetTopDelegates  = "GetTopDelegates"
+	FuncNameGetVoter         = "GetVoter"
+	FuncNameGetAllDelegates  = "GetAllDelegates"
+	MaxDelegateNameLength    = 64
+	DefaultDelegateNum       = 21
+	DefaultBlockIntervalTime = 3
+)
@@ -0,0 +1,950 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: dpos.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DposAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*DposAction_Regist
+	//	*DposAction_CancelRegist
+	//	*DposAction_Vote
+	//	*DposAction_CancelVote
+	//	*DposAction_Miner
+	Value                isDposAction_Value `protobuf_oneof:"value"`
+	Ty                   int32              `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DposAction) Reset()         { *m = DposAction{} }
+func (m *DposAction) String() string { return proto.CompactTextString(m) }
+func (*DposAction) ProtoMessage()    {}
+func (*DposAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{0}
+}
+
+func (m *DposAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposAction.Unmarshal(m, b)
+}
+func (m *DposAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposAction.Marshal(b, m, deterministic)
+}
+func (m *DposAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposAction.Merge(m, src)
+}
+func (m *DposAction) XXX_Size() int {
+	return xxx_messageInfo_DposAction.Size(m)
+}
+func (m *DposAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposAction proto.InternalMessageInfo
+
+type isDposAction_Value interface {
+	isDposAction_Value()
+}
+
+type DposAction_Regist struct {
+	Regist *DposRegist `protobuf:"bytes,1,opt,name=regist,proto3,oneof"`
+}
+
+type DposAction_CancelRegist struct {
+	CancelRegist *DposCancelRegist `protobuf:"bytes,2,opt,name=cancelRegist,proto3,oneof"`
+}
+
+type DposAction_Vote struct {
+	Vote *DposVote `protobuf:"bytes,3,opt,name=vote,proto3,oneof"`
+}
+
+type DposAction_CancelVote struct {
+	CancelVote *DposCancelVote `protobuf:"bytes,4,opt,name=cancelVote,proto3,oneof"`
+}
+
+type DposAction_Miner struct {
+	Miner *DposMiner `protobuf:"bytes,5,opt,name=miner,proto3,oneof"`
+}
+
+func (*DposAction_Regist) isDposAction_Value() {}
+
+func (*DposAction_CancelRegist) isDposAction_Value() {}
+
+func (*DposAction_Vote) isDposAction_Value() {}
+
+func (*DposAction_CancelVote) isDposAction_Value() {}
+
+func (*DposAction_Miner) isDposAction_Value() {}
+
+func (m *DposAction) GetValue() isDposAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *DposAction) GetRegist() *DposRegist {
+	if x, ok := m.GetValue().(*DposAction_Regist); ok {
+		return x.Regist
+	}
+	return nil
+}
+
+func (m *DposAction) GetCancelRegist() *DposCancelRegist {
+	if x, ok := m.GetValue().(*DposAction_CancelRegist); ok {
+		return x.CancelRegist
+	}
+	return nil
+}
+
+func (m *DposAction) GetVote() *DposVote {
+	if x, ok := m.GetValue().(*DposAction_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+func (m *DposAction) GetCancelVote() *DposCancelVote {
+	if x, ok := m.GetValue().(*DposAction_CancelVote); ok {
+		return x.CancelVote
+	}
+	return nil
+}
+
+func (m *DposAction) GetMiner() *DposMiner {
+	if x, ok := m.GetValue().(*DposAction_Miner); ok {
+		return x.Miner
+	}
+	return nil
+}
+
+func (m *DposAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DposAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DposAction_OneofMarshaler, _DposAction_OneofUnmarshaler, _DposAction_OneofSizer, []interface{}{
+		(*DposAction_Regist)(nil),
+		(*DposAction_CancelRegist)(nil),
+		(*DposAction_Vote)(nil),
+		(*DposAction_CancelVote)(nil),
+		(*DposAction_Miner)(nil),
+	}
+}
+
+func _DposAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DposAction)
+	// value
+	switch x := m.Value.(type) {
+	case *DposAction_Regist:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Regist); err != nil {
+			return err
+		}
+	case *DposAction_CancelRegist:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CancelRegist); err != nil {
+			return err
+		}
+	case *DposAction_Vote:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Vote); err != nil {
+			return err
+		}
+	case *DposAction_CancelVote:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CancelVote); err != nil {
+			return err
+		}
+	case *DposAction_Miner:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Miner); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DposAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DposAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DposAction)
+	switch tag {
+	case 1: // value.regist
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DposRegist)
+		err := b.DecodeMessage(msg)
+		m.Value = &DposAction_Regist{msg}
+		return true, err
+	case 2: // value.cancelRegist
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DposCancelRegist)
+		err := b.DecodeMessage(msg)
+		m.Value = &DposAction_CancelRegist{msg}
+		return true, err
+	case 3: // value.vote
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DposVote)
+		err := b.DecodeMessage(msg)
+		m.Value = &DposAction_Vote{msg}
+		return true, err
+	case 4: // value.cancelVote
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DposCancelVote)
+		err := b.DecodeMessage(msg)
+		m.Value = &DposAction_CancelVote{msg}
+		return true, err
+	case 5: // value.miner
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DposMiner)
+		err := b.DecodeMessage(msg)
+		m.Value = &DposAction_Miner{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DposAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DposAction)
+	// value
+	switch x := m.Value.(type) {
+	case *DposAction_Regist:
+		s := proto.Size(x.Regist)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DposAction_CancelRegist:
+		s := proto.Size(x.CancelRegist)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DposAction_Vote:
+		s := proto.Size(x.Vote)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DposAction_CancelVote:
+		s := proto.Size(x.CancelVote)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DposAction_Miner:
+		s := proto.Size(x.Miner)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//注册成为候选的受托人
+type DposRegist struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposRegist) Reset()         { *m = DposRegist{} }
+func (m *DposRegist) String() string { return proto.CompactTextString(m) }
+func (*DposRegist) ProtoMessage()    {}
+func (*DposRegist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{1}
+}
+
+func (m *DposRegist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposRegist.Unmarshal(m, b)
+}
+func (m *DposRegist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposRegist.Marshal(b, m, deterministic)
+}
+func (m *DposRegist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposRegist.Merge(m, src)
+}
+func (m *DposRegist) XXX_Size() int {
+	return xxx_messageInfo_DposRegist.Size(m)
+}
+func (m *DposRegist) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposRegist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposRegist proto.InternalMessageInfo
+
+func (m *DposRegist) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+//取消受托人的注册
+type DposCancelRegist struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposCancelRegist) Reset()         { *m = DposCancelRegist{} }
+func (m *DposCancelRegist) String() string { return proto.CompactTextString(m) }
+func (*DposCancelRegist) ProtoMessage()    {}
+func (*DposCancelRegist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{2}
+}
+
+func (m *DposCancelRegist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposCancelRegist.Unmarshal(m, b)
+}
+func (m *DposCancelRegist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposCancelRegist.Marshal(b, m, deterministic)
+}
+func (m *DposCancelRegist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposCancelRegist.Merge(m, src)
+}
+func (m *DposCancelRegist) XXX_Size() int {
+	return xxx_messageInfo_DposCancelRegist.Size(m)
+}
+func (m *DposCancelRegist) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposCancelRegist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposCancelRegist proto.InternalMessageInfo
+
+//给受托人投票，投票的coins会被冻结在dpos合约中
+type DposVote struct {
+	Delegate             string   `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposVote) Reset()         { *m = DposVote{} }
+func (m *DposVote) String() string { return proto.CompactTextString(m) }
+func (*DposVote) ProtoMessage()    {}
+func (*DposVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{3}
+}
+
+func (m *DposVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposVote.Unmarshal(m, b)
+}
+func (m *DposVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposVote.Marshal(b, m, deterministic)
+}
+func (m *DposVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposVote.Merge(m, src)
+}
+func (m *DposVote) XXX_Size() int {
+	return xxx_messageInfo_DposVote.Size(m)
+}
+func (m *DposVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposVote proto.InternalMessageInfo
+
+func (m *DposVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *DposVote) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//取消投票，解冻投票的coins
+type DposCancelVote struct {
+	Delegate             string   `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposCancelVote) Reset()         { *m = DposCancelVote{} }
+func (m *DposCancelVote) String() string { return proto.CompactTextString(m) }
+func (*DposCancelVote) ProtoMessage()    {}
+func (*DposCancelVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{4}
+}
+
+func (m *DposCancelVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposCancelVote.Unmarshal(m, b)
+}
+func (m *DposCancelVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposCancelVote.Marshal(b, m, deterministic)
+}
+func (m *DposCancelVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposCancelVote.Merge(m, src)
+}
+func (m *DposCancelVote) XXX_Size() int {
+	return xxx_messageInfo_DposCancelVote.Size(m)
+}
+func (m *DposCancelVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposCancelVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposCancelVote proto.InternalMessageInfo
+
+func (m *DposCancelVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *DposCancelVote) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//区块的第一笔交易，由轮到出块的受托人签名
+// 	 slot : 出块的时间片
+// 	 missed : 上一个区块到本区块之间没有出块的受托人
+type DposMiner struct {
+	Slot                 int64    `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Missed               []string `protobuf:"bytes,2,rep,name=missed,proto3" json:"missed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposMiner) Reset()         { *m = DposMiner{} }
+func (m *DposMiner) String() string { return proto.CompactTextString(m) }
+func (*DposMiner) ProtoMessage()    {}
+func (*DposMiner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{5}
+}
+
+func (m *DposMiner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposMiner.Unmarshal(m, b)
+}
+func (m *DposMiner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposMiner.Marshal(b, m, deterministic)
+}
+func (m *DposMiner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposMiner.Merge(m, src)
+}
+func (m *DposMiner) XXX_Size() int {
+	return xxx_messageInfo_DposMiner.Size(m)
+}
+func (m *DposMiner) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposMiner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposMiner proto.InternalMessageInfo
+
+func (m *DposMiner) GetSlot() int64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *DposMiner) GetMissed() []string {
+	if m != nil {
+		return m.Missed
+	}
+	return nil
+}
+
+type DposDelegate struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Votes                int64    `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	RegistHeight         int64    `protobuf:"varint,4,opt,name=registHeight,proto3" json:"registHeight,omitempty"`
+	Active               bool     `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	ProducedBlocks       int64    `protobuf:"varint,6,opt,name=producedBlocks,proto3" json:"producedBlocks,omitempty"`
+	MissedBlocks         int64    `protobuf:"varint,7,opt,name=missedBlocks,proto3" json:"missedBlocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposDelegate) Reset()         { *m = DposDelegate{} }
+func (m *DposDelegate) String() string { return proto.CompactTextString(m) }
+func (*DposDelegate) ProtoMessage()    {}
+func (*DposDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{6}
+}
+
+func (m *DposDelegate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposDelegate.Unmarshal(m, b)
+}
+func (m *DposDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposDelegate.Marshal(b, m, deterministic)
+}
+func (m *DposDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposDelegate.Merge(m, src)
+}
+func (m *DposDelegate) XXX_Size() int {
+	return xxx_messageInfo_DposDelegate.Size(m)
+}
+func (m *DposDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposDelegate proto.InternalMessageInfo
+
+func (m *DposDelegate) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *DposDelegate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DposDelegate) GetVotes() int64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *DposDelegate) GetRegistHeight() int64 {
+	if m != nil {
+		return m.RegistHeight
+	}
+	return 0
+}
+
+func (m *DposDelegate) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *DposDelegate) GetProducedBlocks() int64 {
+	if m != nil {
+		return m.ProducedBlocks
+	}
+	return 0
+}
+
+func (m *DposDelegate) GetMissedBlocks() int64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+type DposDelegateList struct {
+	Addrs                []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposDelegateList) Reset()         { *m = DposDelegateList{} }
+func (m *DposDelegateList) String() string { return proto.CompactTextString(m) }
+func (*DposDelegateList) ProtoMessage()    {}
+func (*DposDelegateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{7}
+}
+
+func (m *DposDelegateList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposDelegateList.Unmarshal(m, b)
+}
+func (m *DposDelegateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposDelegateList.Marshal(b, m, deterministic)
+}
+func (m *DposDelegateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposDelegateList.Merge(m, src)
+}
+func (m *DposDelegateList) XXX_Size() int {
+	return xxx_messageInfo_DposDelegateList.Size(m)
+}
+func (m *DposDelegateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposDelegateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposDelegateList proto.InternalMessageInfo
+
+func (m *DposDelegateList) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+type DposVoter struct {
+	Voter                string   `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Delegate             string   `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DposVoter) Reset()         { *m = DposVoter{} }
+func (m *DposVoter) String() string { return proto.CompactTextString(m) }
+func (*DposVoter) ProtoMessage()    {}
+func (*DposVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{8}
+}
+
+func (m *DposVoter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposVoter.Unmarshal(m, b)
+}
+func (m *DposVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposVoter.Marshal(b, m, deterministic)
+}
+func (m *DposVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposVoter.Merge(m, src)
+}
+func (m *DposVoter) XXX_Size() int {
+	return xxx_messageInfo_DposVoter.Size(m)
+}
+func (m *DposVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposVoter proto.InternalMessageInfo
+
+func (m *DposVoter) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *DposVoter) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *DposVoter) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ReceiptDposDelegate struct {
+	Prev                 *DposDelegate `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *DposDelegate `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReceiptDposDelegate) Reset()         { *m = ReceiptDposDelegate{} }
+func (m *ReceiptDposDelegate) String() string { return proto.CompactTextString(m) }
+func (*ReceiptDposDelegate) ProtoMessage()    {}
+func (*ReceiptDposDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{9}
+}
+
+func (m *ReceiptDposDelegate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptDposDelegate.Unmarshal(m, b)
+}
+func (m *ReceiptDposDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptDposDelegate.Marshal(b, m, deterministic)
+}
+func (m *ReceiptDposDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptDposDelegate.Merge(m, src)
+}
+func (m *ReceiptDposDelegate) XXX_Size() int {
+	return xxx_messageInfo_ReceiptDposDelegate.Size(m)
+}
+func (m *ReceiptDposDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptDposDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptDposDelegate proto.InternalMessageInfo
+
+func (m *ReceiptDposDelegate) GetPrev() *DposDelegate {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptDposDelegate) GetCurrent() *DposDelegate {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptDposVote struct {
+	Prev                 *DposVoter `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *DposVoter `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ReceiptDposVote) Reset()         { *m = ReceiptDposVote{} }
+func (m *ReceiptDposVote) String() string { return proto.CompactTextString(m) }
+func (*ReceiptDposVote) ProtoMessage()    {}
+func (*ReceiptDposVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{10}
+}
+
+func (m *ReceiptDposVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptDposVote.Unmarshal(m, b)
+}
+func (m *ReceiptDposVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptDposVote.Marshal(b, m, deterministic)
+}
+func (m *ReceiptDposVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptDposVote.Merge(m, src)
+}
+func (m *ReceiptDposVote) XXX_Size() int {
+	return xxx_messageInfo_ReceiptDposVote.Size(m)
+}
+func (m *ReceiptDposVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptDposVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptDposVote proto.InternalMessageInfo
+
+func (m *ReceiptDposVote) GetPrev() *DposVoter {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptDposVote) GetCurrent() *DposVoter {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqDposTopDelegates struct {
+	Count                int32    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqDposTopDelegates) Reset()         { *m = ReqDposTopDelegates{} }
+func (m *ReqDposTopDelegates) String() string { return proto.CompactTextString(m) }
+func (*ReqDposTopDelegates) ProtoMessage()    {}
+func (*ReqDposTopDelegates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{11}
+}
+
+func (m *ReqDposTopDelegates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqDposTopDelegates.Unmarshal(m, b)
+}
+func (m *ReqDposTopDelegates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqDposTopDelegates.Marshal(b, m, deterministic)
+}
+func (m *ReqDposTopDelegates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqDposTopDelegates.Merge(m, src)
+}
+func (m *ReqDposTopDelegates) XXX_Size() int {
+	return xxx_messageInfo_ReqDposTopDelegates.Size(m)
+}
+func (m *ReqDposTopDelegates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqDposTopDelegates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqDposTopDelegates proto.InternalMessageInfo
+
+func (m *ReqDposTopDelegates) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReqDposVoter struct {
+	Voter                string   `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Delegate             string   `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqDposVoter) Reset()         { *m = ReqDposVoter{} }
+func (m *ReqDposVoter) String() string { return proto.CompactTextString(m) }
+func (*ReqDposVoter) ProtoMessage()    {}
+func (*ReqDposVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{12}
+}
+
+func (m *ReqDposVoter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqDposVoter.Unmarshal(m, b)
+}
+func (m *ReqDposVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqDposVoter.Marshal(b, m, deterministic)
+}
+func (m *ReqDposVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqDposVoter.Merge(m, src)
+}
+func (m *ReqDposVoter) XXX_Size() int {
+	return xxx_messageInfo_ReqDposVoter.Size(m)
+}
+func (m *ReqDposVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqDposVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqDposVoter proto.InternalMessageInfo
+
+func (m *ReqDposVoter) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *ReqDposVoter) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+type ReplyDposDelegates struct {
+	Delegates            []*DposDelegate `protobuf:"bytes,1,rep,name=delegates,proto3" json:"delegates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyDposDelegates) Reset()         { *m = ReplyDposDelegates{} }
+func (m *ReplyDposDelegates) String() string { return proto.CompactTextString(m) }
+func (*ReplyDposDelegates) ProtoMessage()    {}
+func (*ReplyDposDelegates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851230c94abfd546, []int{13}
+}
+
+func (m *ReplyDposDelegates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyDposDelegates.Unmarshal(m, b)
+}
+func (m *ReplyDposDelegates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyDposDelegates.Marshal(b, m, deterministic)
+}
+func (m *ReplyDposDelegates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyDposDelegates.Merge(m, src)
+}
+func (m *ReplyDposDelegates) XXX_Size() int {
+	return xxx_messageInfo_ReplyDposDelegates.Size(m)
+}
+func (m *ReplyDposDelegates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyDposDelegates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyDposDelegates proto.InternalMessageInfo
+
+func (m *ReplyDposDelegates) GetDelegates() []*DposDelegate {
+	if m != nil {
+		return m.Delegates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DposAction)(nil), "types.DposAction")
+	proto.RegisterType((*DposRegist)(nil), "types.DposRegist")
+	proto.RegisterType((*DposCancelRegist)(nil), "types.DposCancelRegist")
+	proto.RegisterType((*DposVote)(nil), "types.DposVote")
+	proto.RegisterType((*DposCancelVote)(nil), "types.DposCancelVote")
+	proto.RegisterType((*DposMiner)(nil), "types.DposMiner")
+	proto.RegisterType((*DposDelegate)(nil), "types.DposDelegate")
+	proto.RegisterType((*DposDelegateList)(nil), "types.DposDelegateList")
+	proto.RegisterType((*DposVoter)(nil), "types.DposVoter")
+	proto.RegisterType((*ReceiptDposDelegate)(nil), "types.ReceiptDposDelegate")
+	proto.RegisterType((*ReceiptDposVote)(nil), "types.ReceiptDposVote")
+	proto.RegisterType((*ReqDposTopDelegates)(nil), "types.ReqDposTopDelegates")
+	proto.RegisterType((*ReqDposVoter)(nil), "types.ReqDposVoter")
+	proto.RegisterType((*ReplyDposDelegates)(nil), "types.ReplyDposDelegates")
+}
+
+func init() { proto.RegisterFile("dpos.proto", fileDescriptor_851230c94abfd546) }
+
+var fileDescriptor_851230c94abfd546 = []byte{
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x8f, 0xd3, 0x30,
+	0x10, 0x6d, 0x92, 0xa6, 0xdb, 0x0e, 0x55, 0xb7, 0x78, 0x17, 0x88, 0x38, 0x55, 0x16, 0x1f, 0x15,
+	0x2b, 0x2a, 0x01, 0x87, 0x3d, 0x81, 0x60, 0xa9, 0x44, 0x0f, 0x70, 0xb1, 0x80, 0x7b, 0x70, 0xac,
+	0x12, 0x91, 0xc6, 0xc1, 0x76, 0x2b, 0xf5, 0xb7, 0xf0, 0xb7, 0xf8, 0x41, 0xc8, 0x63, 0xbb, 0x9b,
+	0x74, 0xd9, 0xcb, 0xde, 0x32, 0x33, 0xef, 0x75, 0xfc, 0xde, 0xb3, 0x0b, 0x50, 0x34, 0x52, 0x2f,
+	0x1a, 0x25, 0x8d, 0x24, 0xa9, 0xd9, 0x37, 0x42, 0xd3, 0x3f, 0x31, 0xc0, 0xb2, 0x91, 0xfa, 0x03,
+	0x37, 0xa5, 0xac, 0xc9, 0x05, 0x0c, 0x94, 0x58, 0x97, 0xda, 0x64, 0xd1, 0x2c, 0x9a, 0xdf, 0x7b,
+	0x7d, 0x7f, 0x81, 0xb0, 0x85, 0x85, 0x30, 0x1c, 0xac, 0x7a, 0xcc, 0x43, 0xc8, 0x5b, 0x18, 0xf3,
+	0xbc, 0xe6, 0xa2, 0x72, 0x93, 0x2c, 0x46, 0xca, 0xa3, 0x16, 0xe5, 0x63, 0x6b, 0xbc, 0xea, 0xb1,
+	0x0e, 0x9c, 0x3c, 0x85, 0xfe, 0x4e, 0x1a, 0x91, 0x25, 0x48, 0x3b, 0x6d, 0xd1, 0xbe, 0x4b, 0x23,
+	0x56, 0x3d, 0x86, 0x63, 0x72, 0x09, 0xe0, 0x68, 0xb6, 0x9b, 0xf5, 0x11, 0xfc, 0xe0, 0xc6, 0x0e,
+	0x4f, 0x69, 0x41, 0xc9, 0x1c, 0xd2, 0x4d, 0x59, 0x0b, 0x95, 0xa5, 0xc8, 0x99, 0xb6, 0x38, 0x5f,
+	0x6c, 0x7f, 0xd5, 0x63, 0x0e, 0x40, 0x26, 0x10, 0x9b, 0x7d, 0x36, 0x98, 0x45, 0xf3, 0x94, 0xc5,
+	0x66, 0x7f, 0x75, 0x02, 0xe9, 0x2e, 0xaf, 0xb6, 0x82, 0xce, 0x9c, 0x39, 0xfe, 0xc0, 0x04, 0xfa,
+	0x75, 0xbe, 0x11, 0x68, 0xcd, 0x88, 0xe1, 0x37, 0x25, 0x30, 0x3d, 0x16, 0x4a, 0xdf, 0xc1, 0x30,
+	0xa8, 0x20, 0x8f, 0x61, 0x58, 0x88, 0x4a, 0xac, 0x73, 0x13, 0x78, 0x87, 0x9a, 0x3c, 0x84, 0x41,
+	0xbe, 0x91, 0xdb, 0xda, 0x39, 0x97, 0x30, 0x5f, 0xd1, 0x25, 0x4c, 0xba, 0xc2, 0xee, 0xf4, 0x2b,
+	0x97, 0x30, 0x3a, 0x48, 0xb5, 0x47, 0xd7, 0x95, 0x74, 0xa9, 0x26, 0x0c, 0xbf, 0x2d, 0x71, 0x53,
+	0x6a, 0x2d, 0x8a, 0x2c, 0x9e, 0x25, 0xf3, 0x11, 0xf3, 0x15, 0xfd, 0x1b, 0xc1, 0xd8, 0x32, 0x97,
+	0x61, 0x03, 0x81, 0x7e, 0x5e, 0x14, 0x2a, 0xe8, 0xb6, 0xdf, 0x07, 0x2f, 0xe2, 0x6b, 0x2f, 0xc8,
+	0x39, 0xa4, 0x36, 0x31, 0x8d, 0x89, 0x26, 0xcc, 0x15, 0x84, 0xc2, 0xd8, 0xdd, 0x97, 0x95, 0x28,
+	0xd7, 0x3f, 0x0d, 0x26, 0x98, 0xb0, 0x4e, 0x0f, 0x35, 0x70, 0x53, 0xee, 0x04, 0x66, 0x35, 0x64,
+	0xbe, 0x22, 0xcf, 0x60, 0xd2, 0x28, 0x59, 0x6c, 0xb9, 0x28, 0xae, 0x2a, 0xc9, 0x7f, 0x69, 0x0c,
+	0x29, 0x61, 0x47, 0x5d, 0xbb, 0xc3, 0x1d, 0xde, 0xa3, 0x4e, 0xdc, 0x8e, 0x76, 0x8f, 0xce, 0x5d,
+	0x52, 0x41, 0xd5, 0x67, 0x9b, 0xe8, 0x39, 0xa4, 0x56, 0x8d, 0xce, 0x22, 0x74, 0xc0, 0x15, 0xf4,
+	0x9b, 0x73, 0xce, 0x3a, 0xaf, 0x82, 0xa8, 0xa0, 0xde, 0x15, 0x9d, 0x40, 0xe2, 0x5b, 0x03, 0x49,
+	0x3a, 0x81, 0x6c, 0xe0, 0x8c, 0x09, 0x2e, 0xca, 0xc6, 0x74, 0xdc, 0x7d, 0x0e, 0xfd, 0x46, 0x89,
+	0x9d, 0x7f, 0x70, 0x67, 0xad, 0x5b, 0x1a, 0x20, 0x0c, 0x01, 0xe4, 0x25, 0x9c, 0xf0, 0xad, 0x52,
+	0xa2, 0x0e, 0x2f, 0xed, 0xbf, 0xd8, 0x80, 0xa1, 0x1c, 0x4e, 0x5b, 0xeb, 0xf0, 0x1a, 0x3d, 0xe9,
+	0xac, 0x9a, 0x1e, 0xbd, 0x38, 0xe5, 0xf7, 0xbc, 0x38, 0xde, 0x73, 0x13, 0x78, 0x58, 0x72, 0x61,
+	0x35, 0xfd, 0xb6, 0x83, 0xaf, 0xb2, 0x09, 0x67, 0xd0, 0xd6, 0x34, 0x8e, 0x0e, 0x44, 0xf8, 0xa6,
+	0x5c, 0x41, 0xdf, 0xc3, 0xd8, 0x83, 0xef, 0x68, 0x2d, 0xfd, 0x04, 0x84, 0x89, 0xa6, 0xda, 0xb7,
+	0x15, 0x6b, 0xf2, 0x0a, 0x46, 0x01, 0xe1, 0x92, 0xbc, 0xc5, 0x9a, 0x6b, 0xd4, 0x8f, 0x01, 0xfe,
+	0x09, 0xbe, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0xba, 0x51, 0xf7, 0x3d, 0x12, 0x05, 0x00, 0x00,
+}
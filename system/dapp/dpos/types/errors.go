@@ -0,0 +1,30 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrDelegateExist 受托人已经注册
+	ErrDelegateExist = errors.New("ErrDelegateExist")
+	// ErrDelegateNotExist 受托人不存在
+	ErrDelegateNotExist = errors.New("ErrDelegateNotExist")
+	// ErrDelegateNotActive 受托人已经取消注册
+	ErrDelegateNotActive = errors.New("ErrDelegateNotActive")
+	// ErrDelegateName 受托人名称不合法
+	ErrDelegateName = errors.New("ErrDelegateName")
+	// ErrVoteNotEnough 取消的投票超过已经投的票
+	ErrVoteNotEnough = errors.New("ErrVoteNotEnough")
+	// ErrMinerTxIndex 挖矿交易必须是区块的第一笔交易
+	ErrMinerTxIndex = errors.New("ErrMinerTxIndex")
+	// ErrMinerTx 区块中没有合法的挖矿交易
+	ErrMinerTx = errors.New("ErrMinerTx")
+	// ErrMinerSlot 挖矿交易的时间片和区块时间不匹配
+	ErrMinerSlot = errors.New("ErrMinerSlot")
+	// ErrMinerNotProducer 不是当前时间片的出块人
+	ErrMinerNotProducer = errors.New("ErrMinerNotProducer")
+	// ErrMinerMissed 漏块的受托人列表不正确
+	ErrMinerMissed = errors.New("ErrMinerMissed")
+)
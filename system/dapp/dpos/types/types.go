@@ -0,0 +1,69 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types dpos插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// DposX 执行器名称
+	DposX      = "dpos"
+	actionName = map[string]int32{
+		"Regist":       DposActionRegist,
+		"CancelRegist": DposActionCancelRegist,
+		"Vote":         DposActionVote,
+		"CancelVote":   DposActionCancelVote,
+		"Miner":        DposActionMiner,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogDposRegist:       {Ty: reflect.TypeOf(ReceiptDposDelegate{}), Name: "LogDposRegist"},
+		TyLogDposCancelRegist: {Ty: reflect.TypeOf(ReceiptDposDelegate{}), Name: "LogDposCancelRegist"},
+		TyLogDposVote:         {Ty: reflect.TypeOf(ReceiptDposVote{}), Name: "LogDposVote"},
+		TyLogDposCancelVote:   {Ty: reflect.TypeOf(ReceiptDposVote{}), Name: "LogDposCancelVote"},
+		TyLogDposMiner:        {Ty: reflect.TypeOf(ReceiptDposDelegate{}), Name: "LogDposMiner"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(DposX))
+	types.RegistorExecutor(DposX, NewType())
+	types.RegisterDappFork(DposX, "Enable", 0)
+}
+
+// DposType dpos执行器类型
+type DposType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a dpos type object
+func NewType() *DposType {
+	c := &DposType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return dpos action
+func (d *DposType) GetPayload() types.Message {
+	return &DposAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (d *DposType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (d *DposType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (d *DposType) GetName() string {
+	return DposX
+}
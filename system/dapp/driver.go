@@ -11,6 +11,7 @@ package dapp
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
 
 	"github.com/33cn/chain33/account"
@@ -64,6 +65,7 @@ type Driver interface {
 	SetExecutorAPI(queueapi client.QueueProtocolAPI, chain33api types.Chain33Client)
 	SetTxs(txs []*types.Transaction)
 	SetReceipt(receipts []*types.ReceiptData)
+	SetGasMeter(meter *types.GasMeter)
 
 	//GetTxs and TxGroup
 	GetTxs() []*types.Transaction
@@ -72,6 +74,7 @@ type Driver interface {
 	GetFuncMap() map[string]reflect.Method
 	GetExecutorType() types.ExecutorType
 	CheckReceiptExecOk() bool
+	AutoRollback() bool
 	ExecutorOrder() int64
 }
 
@@ -95,6 +98,7 @@ type DriverBase struct {
 	txs                  []*types.Transaction
 	receipts             []*types.ReceiptData
 	ety                  types.ExecutorType
+	gasMeter             *types.GasMeter
 }
 
 // GetPayloadValue define get payload func
@@ -204,12 +208,28 @@ func (d *DriverBase) ExecLocal(tx *types.Transaction, receipt *types.ReceiptData
 	if lset != nil && lset.KV != nil {
 		set.KV = append(set.KV, lset.KV...)
 	}
+	//自动回滚的执行器，记录每个key原来的值，回滚区块的时候恢复
+	if d.child.AutoRollback() && len(set.KV) > 0 {
+		set.KV = d.AddRollbackKV(tx, tx.Execer, set.KV)
+	}
 	return &set, nil
 }
 
 // ExecDelLocal local execdel
 func (d *DriverBase) ExecDelLocal(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
+	//自动回滚的执行器直接恢复ExecLocal记录的原来的值
+	//没有回滚记录的交易(ExecLocal没有写入数据，或者在自动回滚之前执行的区块)仍然调用执行器的ExecDelLocal
+	if d.child.AutoRollback() {
+		kvs, err := d.DelRollbackKV(tx, tx.Execer)
+		if err != nil {
+			return nil, err
+		}
+		if len(kvs) > 0 {
+			set.KV = kvs
+			return &set, nil
+		}
+	}
 	lset, err := d.callLocal("ExecDelLocal_", tx, receipt, index)
 	if err != nil {
 		blog.Error("call ExecDelLocal", "execer", string(tx.Execer), "err", err)
@@ -245,8 +265,8 @@ func (d *DriverBase) callLocal(prefix string, tx *types.Transaction, receipt *ty
 		return nil, err
 	}
 	//call action
-	funcname := prefix + name
 	funcmap := d.child.GetFuncMap()
+	funcname := d.versionFuncName(funcmap, prefix+name)
 	if _, ok := funcmap[funcname]; !ok {
 		return nil, types.ErrActionNotSupport
 	}
@@ -274,6 +294,17 @@ func (d *DriverBase) callLocal(prefix string, tx *types.Transaction, receipt *ty
 	return set, err
 }
 
+//versionFuncName 按当前高度生效的执行器版本选择方法，从生效的版本往下找到第一个实现了的版本
+func (d *DriverBase) versionFuncName(funcmap map[string]reflect.Method, funcname string) string {
+	for version := types.GetDappVersion(d.child.GetDriverName(), d.GetHeight()); version > 1; version-- {
+		name := fmt.Sprintf("%s_V%d", funcname, version)
+		if _, ok := funcmap[name]; ok {
+			return name
+		}
+	}
+	return funcname
+}
+
 // CheckAddress check address
 func CheckAddress(addr string, height int64) error {
 	if IsDriverAddress(addr, height) {
@@ -315,7 +346,7 @@ func (d *DriverBase) Exec(tx *types.Transaction, index int) (receipt *types.Rece
 		return nil, err
 	}
 	funcmap := d.child.GetFuncMap()
-	funcname := "Exec_" + name
+	funcname := d.versionFuncName(funcmap, "Exec_"+name)
 	if _, ok := funcmap[funcname]; !ok {
 		return nil, types.ErrActionNotSupport
 	}
@@ -397,6 +428,16 @@ func (d *DriverBase) SetReceipt(receipts []*types.ReceiptData) {
 	d.receipts = receipts
 }
 
+// SetGasMeter set gas meter
+func (d *DriverBase) SetGasMeter(meter *types.GasMeter) {
+	d.gasMeter = meter
+}
+
+// GetGasMeter 当前交易的gas计量，执行器自己计量的执行步数可以计入这里
+func (d *DriverBase) GetGasMeter() *types.GasMeter {
+	return d.gasMeter
+}
+
 // GetStateDB set statedb
 func (d *DriverBase) GetStateDB() dbm.KV {
 	return d.statedb
@@ -495,6 +536,13 @@ func (d *DriverBase) CheckReceiptExecOk() bool {
 	return false
 }
 
+//AutoRollback 是否由框架自动回滚ExecLocal写入的localdb，默认自动回滚
+//ExecLocal的时候保存每个key原来的值，ExecDelLocal的时候恢复，执行器不需要自己实现ExecDelLocal
+//需要自己处理回滚的执行器重载这个函数返回false
+func (d *DriverBase) AutoRollback() bool {
+	return true
+}
+
 //AddRollbackKV add rollback kv
 func (d *DriverBase) AddRollbackKV(tx *types.Transaction, execer []byte, kvs []*types.KeyValue) []*types.KeyValue {
 	k := types.CalcRollbackKey(types.GetRealExecName(execer), tx.Hash())
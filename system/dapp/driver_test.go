@@ -1,6 +1,7 @@
 package dapp
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type demoApp struct {
@@ -42,6 +44,8 @@ func (none *noneApp) GetDriverName() string {
 func init() {
 	Register("none", newnoneApp, 0)
 	Register("demo", newdemoApp, 1)
+	types.RegisterDappVersion("demo", 2, 10)
+	types.RegisterDappVersion("demo", 3, 20)
 }
 
 func TestReigister(t *testing.T) {
@@ -135,6 +139,7 @@ func TestDriverBase(t *testing.T) {
 
 	tx := &types.Transaction{Execer: []byte("demo"), To: ExecAddress("demo"), GroupCount: 1}
 	t.Log("addr:", ExecAddress("demo"))
+	demo.SetLocalDB(kvdb)
 	_, err := demo.ExecLocal(tx, nil, 0)
 	assert.NoError(t, err)
 	_, err = demo.ExecDelLocal(tx, nil, 0)
@@ -166,6 +171,7 @@ func TestDriverBase(t *testing.T) {
 	assert.True(t, demo.CheckSignatureData(tx, 0))
 	assert.NotNil(t, demo.GetCoinsAccount())
 	assert.False(t, demo.CheckReceiptExecOk())
+	assert.True(t, demo.AutoRollback())
 
 	err = CheckAddress("1HUiTRFvp6HvW6eacgV9EoBSgroRDiUsMs", 0)
 	assert.NoError(t, err)
@@ -228,3 +234,63 @@ func TestDriverBase_Query(t *testing.T) {
 	_, err = demo.Query("", nil)
 	assert.Equal(t, types.ErrActionNotSupport, err)
 }
+
+func TestQueryListState(t *testing.T) {
+	demo := newdemoApp().(*demoApp)
+	api := &mocks.QueueProtocolAPI{}
+	demo.SetAPI(api)
+	api.On("GetLastHeader").Return(&types.Header{StateHash: []byte("state")}, nil)
+	var list *types.StoreList
+	api.On("StoreList", mock.Anything).Return(&types.StoreListReply{
+		Keys:    [][]byte{[]byte("mavl-demo-a-1"), []byte("mavl-demo-a-2")},
+		Values:  [][]byte{[]byte("v1"), []byte("v2")},
+		NextKey: []byte("mavl-demo-a-3"),
+	}, nil).Run(func(args mock.Arguments) {
+		list = args.Get(0).(*types.StoreList)
+	})
+	api.On("StoreGetProof", mock.Anything).Return(&types.StoreProof{Exists: true}, nil)
+
+	reply, err := demo.Query_ListState(&types.ReqListState{Prefix: []byte("a-"), Direction: 1, Proof: true})
+	assert.Nil(t, err)
+	resp := reply.(*types.ReplyListState)
+	assert.Equal(t, []byte("state"), resp.StateHash)
+	assert.Equal(t, 2, len(resp.KVs))
+	assert.Equal(t, 2, len(resp.Proofs))
+	assert.Equal(t, []byte("mavl-demo-a-3"), resp.NextKey)
+	assert.Equal(t, []byte("mavl-demo-a-"), list.Start)
+	assert.Equal(t, []byte("mavl-demo-a."), list.End)
+	assert.Equal(t, int64(defaultListStateCount), list.Count)
+	assert.False(t, list.Reverse)
+
+	//逆序翻页的时候start作为包含的上界
+	_, err = demo.Query_ListState(&types.ReqListState{Prefix: []byte("a-"), Start: []byte("mavl-demo-a-3"), Count: 2, StateHash: []byte("old")})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("old"), list.StateHash)
+	assert.Equal(t, []byte("mavl-demo-a-"), list.Start)
+	assert.Equal(t, []byte("mavl-demo-a-3\x00"), list.End)
+	assert.True(t, list.Reverse)
+
+	_, err = demo.Query_ListState(&types.ReqListState{Start: []byte("mavl-other-a")})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = demo.Query_ListState(&types.ReqListState{Count: maxListStateCount + 1})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestVersionFuncName(t *testing.T) {
+	title := types.GetTitle()
+	types.SetTitleOnlyForTest("chain33")
+	defer types.SetTitleOnlyForTest(title)
+	demo := newdemoApp().(*demoApp)
+	funcmap := map[string]reflect.Method{"Exec_Create": {}, "Exec_Create_V2": {}, "ExecLocal_Create": {}}
+
+	demo.SetEnv(9, 0, 0)
+	assert.Equal(t, "Exec_Create", demo.versionFuncName(funcmap, "Exec_Create"))
+	demo.SetEnv(10, 0, 0)
+	assert.Equal(t, "Exec_Create_V2", demo.versionFuncName(funcmap, "Exec_Create"))
+	assert.Equal(t, "ExecLocal_Create", demo.versionFuncName(funcmap, "ExecLocal_Create"))
+	//版本3没有实现的时候使用版本2
+	demo.SetEnv(20, 0, 0)
+	assert.Equal(t, "Exec_Create_V2", demo.versionFuncName(funcmap, "Exec_Create"))
+	funcmap["Exec_Create_V3"] = reflect.Method{}
+	assert.Equal(t, "Exec_Create_V3", demo.versionFuncName(funcmap, "Exec_Create"))
+}
@@ -0,0 +1,198 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands escrow插件命令
+package commands
+
+import (
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	ety "github.com/33cn/chain33/system/dapp/escrow/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// EscrowCmd escrow command
+func EscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow",
+		Short: "Escrow management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CreateCmd(),
+		ReleaseCmd(),
+		RefundCmd(),
+		DisputeCmd(),
+		ResolveCmd(),
+		ClaimCmd(),
+		QueryEscrowCmd(),
+	)
+
+	return cmd
+}
+
+func addIDFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("id", "i", "", "escrow id")
+	cmd.MarkFlagRequired("id")
+}
+
+// CreateCmd create escrow
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a transaction to lock coins in escrow contract for seller",
+		Run:   create,
+	}
+	cmd.Flags().StringP("seller", "s", "", "seller address")
+	cmd.MarkFlagRequired("seller")
+	cmd.Flags().StringP("arbiter", "r", "", "optional arbiter address")
+	cmd.Flags().Float64P("amount", "a", 0, "locked amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().Int64P("timeout", "t", 1000, "blocks before release to seller by default")
+	cmd.Flags().Int64P("dispute_timeout", "d", 1000, "blocks for arbiter to resolve before refund to buyer by default")
+	cmd.Flags().StringP("memo", "m", "", "memo")
+	return cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	seller, _ := cmd.Flags().GetString("seller")
+	arbiter, _ := cmd.Flags().GetString("arbiter")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	timeout, _ := cmd.Flags().GetInt64("timeout")
+	disputeTimeout, _ := cmd.Flags().GetInt64("dispute_timeout")
+	memo, _ := cmd.Flags().GetString("memo")
+	commandtypes.CreateActionTx(cmd, ety.EscrowX, &ety.EscrowAction{
+		Ty: ety.EscrowActionCreate,
+		Value: &ety.EscrowAction_Create{Create: &ety.EscrowCreate{Seller: seller, Arbiter: arbiter, Amount: commandtypes.FormatAmountDisplay2Value(amount),
+			Timeout: timeout, DisputeTimeout: disputeTimeout, Memo: memo}},
+	})
+}
+
+// ReleaseCmd release escrow
+func ReleaseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "Create a transaction to release escrow to seller by buyer",
+		Run:   release,
+	}
+	addIDFlags(cmd)
+	return cmd
+}
+
+func release(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, ety.EscrowX, &ety.EscrowAction{
+		Ty:    ety.EscrowActionRelease,
+		Value: &ety.EscrowAction_Release{Release: &ety.EscrowRelease{Id: id}},
+	})
+}
+
+// RefundCmd refund escrow
+func RefundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund",
+		Short: "Create a transaction to refund escrow to buyer by seller",
+		Run:   refund,
+	}
+	addIDFlags(cmd)
+	return cmd
+}
+
+func refund(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, ety.EscrowX, &ety.EscrowAction{
+		Ty:    ety.EscrowActionRefund,
+		Value: &ety.EscrowAction_Refund{Refund: &ety.EscrowRefund{Id: id}},
+	})
+}
+
+// DisputeCmd dispute escrow
+func DisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute",
+		Short: "Create a transaction to dispute escrow for arbiter to resolve",
+		Run:   dispute,
+	}
+	addIDFlags(cmd)
+	cmd.Flags().StringP("reason", "r", "", "dispute reason")
+	return cmd
+}
+
+func dispute(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	reason, _ := cmd.Flags().GetString("reason")
+	commandtypes.CreateActionTx(cmd, ety.EscrowX, &ety.EscrowAction{
+		Ty:    ety.EscrowActionDispute,
+		Value: &ety.EscrowAction_Dispute{Dispute: &ety.EscrowDispute{Id: id, Reason: reason}},
+	})
+}
+
+// ResolveCmd resolve escrow
+func ResolveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve",
+		Short: "Create a transaction to split disputed escrow by arbiter",
+		Run:   resolve,
+	}
+	addIDFlags(cmd)
+	cmd.Flags().Float64P("seller_amount", "a", 0, "amount to seller, the rest refund to buyer")
+	return cmd
+}
+
+func resolve(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	amount, _ := cmd.Flags().GetFloat64("seller_amount")
+	commandtypes.CreateActionTx(cmd, ety.EscrowX, &ety.EscrowAction{
+		Ty:    ety.EscrowActionResolve,
+		Value: &ety.EscrowAction_Resolve{Resolve: &ety.EscrowResolve{Id: id, SellerAmount: commandtypes.FormatAmountDisplay2Value(amount)}},
+	})
+}
+
+// ClaimCmd claim escrow
+func ClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Short: "Create a transaction to settle escrow by default after timeout",
+		Run:   claim,
+	}
+	addIDFlags(cmd)
+	return cmd
+}
+
+func claim(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, ety.EscrowX, &ety.EscrowAction{
+		Ty:    ety.EscrowActionClaim,
+		Value: &ety.EscrowAction_Claim{Claim: &ety.EscrowClaim{Id: id}},
+	})
+}
+
+// QueryEscrowCmd query escrow
+func QueryEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query escrow",
+		Run:   queryEscrow,
+	}
+	addIDFlags(cmd)
+	return cmd
+}
+
+func queryEscrow(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	id, _ := cmd.Flags().GetString("id")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, ety.EscrowX)
+	params.FuncName = ety.FuncNameGetEscrow
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: id})
+
+	var res ety.Escrow
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
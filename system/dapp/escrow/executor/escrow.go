@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor escrow执行器，负责托管的创建，放款，退款，争议和裁决
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	ety "github.com/33cn/chain33/system/dapp/escrow/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.escrow")
+	driverName = ety.EscrowX
+)
+
+func init() {
+	et := types.LoadExecutorType(driverName)
+	et.InitFuncList(types.ListMethod(&Escrow{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newEscrow, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return escrow name
+func GetName() string {
+	return newEscrow().GetName()
+}
+
+// Escrow defines Escrow object
+type Escrow struct {
+	drivers.DriverBase
+}
+
+func newEscrow() drivers.Driver {
+	e := &Escrow{}
+	e.SetChild(e)
+	e.SetExecutorType(types.LoadExecutorType(driverName))
+	return e
+}
+
+// GetDriverName return a drivername
+func (e *Escrow) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (e *Escrow) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,118 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	ety "github.com/33cn/chain33/system/dapp/escrow/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendEscrowTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) (int32, string) {
+	hash, detail, err := mock33.SendCallTx(priv, ety.EscrowX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, common.ToHex(hash)
+}
+
+func getEscrow(t *testing.T, mock33 *testnode.Chain33Mock, id string) *ety.Escrow {
+	msg, err := mock33.GetAPI().Query(ety.EscrowX, ety.FuncNameGetEscrow, &types.ReqString{Data: id})
+	assert.Nil(t, err)
+	return msg.(*ety.Escrow)
+}
+
+func TestEscrow(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	buyer := mock33.GetGenesisKey()
+	buyerAddr := mock33.GetGenesisAddress()
+	seller, sellerPriv := util.Genaddress()
+	arbiter, arbiterPriv := util.Genaddress()
+	for _, to := range []string{seller, arbiter} {
+		mock33.SendTx(util.CreateCoinsTx(buyer, to, 10*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	mock33.SendTx(util.CreateCoinsTx(buyer, address.ExecAddress(ety.EscrowX), 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	ty, _ := sendEscrowTx(t, mock33, buyer, "Create", &ety.EscrowCreate{Seller: seller, Amount: types.Coin, Timeout: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, buyer, "Create", &ety.EscrowCreate{Seller: buyerAddr, Amount: types.Coin, Timeout: ety.MinTimeout})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, buyer, "Create", &ety.EscrowCreate{Seller: seller, Amount: 20 * types.Coin, Timeout: ety.MinTimeout})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//没有仲裁者的托管不能争议，卖方退款
+	ty, id := sendEscrowTx(t, mock33, buyer, "Create", &ety.EscrowCreate{Seller: seller, Amount: 2 * types.Coin, Timeout: ety.MinTimeout})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 8*types.Coin, mock33.GetExecBalance(ety.EscrowX, buyerAddr))
+	assert.Equal(t, 2*types.Coin, mock33.GetExecBalance(ety.EscrowX, getEscrow(t, mock33, id).Addr))
+	ty, _ = sendEscrowTx(t, mock33, buyer, "Dispute", &ety.EscrowDispute{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, buyer, "Refund", &ety.EscrowRefund{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, sellerPriv, "Refund", &ety.EscrowRefund{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 10*types.Coin, mock33.GetExecBalance(ety.EscrowX, buyerAddr))
+	ty, _ = sendEscrowTx(t, mock33, buyer, "Release", &ety.EscrowRelease{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//争议以后仲裁者按比例裁决
+	create := &ety.EscrowCreate{Seller: seller, Arbiter: arbiter, Amount: 3 * types.Coin, Timeout: ety.MinTimeout, DisputeTimeout: ety.MinTimeout}
+	ty, id = sendEscrowTx(t, mock33, buyer, "Create", create)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendEscrowTx(t, mock33, sellerPriv, "Release", &ety.EscrowRelease{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, arbiterPriv, "Resolve", &ety.EscrowResolve{Id: id, SellerAmount: types.Coin})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, sellerPriv, "Dispute", &ety.EscrowDispute{Id: id, Reason: "not paid"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendEscrowTx(t, mock33, buyer, "Resolve", &ety.EscrowResolve{Id: id, SellerAmount: types.Coin})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, arbiterPriv, "Resolve", &ety.EscrowResolve{Id: id, SellerAmount: 4 * types.Coin})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, arbiterPriv, "Resolve", &ety.EscrowResolve{Id: id, SellerAmount: types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	escrow := getEscrow(t, mock33, id)
+	assert.Equal(t, int32(ety.EscrowStatusSettled), escrow.Status)
+	assert.Equal(t, 2*types.Coin, escrow.BuyerAmount)
+	assert.Equal(t, types.Coin, mock33.GetExecBalance(ety.EscrowX, seller))
+	assert.Equal(t, 9*types.Coin, mock33.GetExecBalance(ety.EscrowX, buyerAddr))
+
+	//超时没有争议默认放款给卖方
+	ty, id = sendEscrowTx(t, mock33, buyer, "Create", create)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendEscrowTx(t, mock33, arbiterPriv, "Claim", &ety.EscrowClaim{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.Nil(t, mock33.CreateBlocks(ety.MinTimeout))
+	ty, _ = sendEscrowTx(t, mock33, buyer, "Dispute", &ety.EscrowDispute{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, arbiterPriv, "Claim", &ety.EscrowClaim{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 4*types.Coin, mock33.GetExecBalance(ety.EscrowX, seller))
+
+	//仲裁者超时没有裁决默认退款给买方
+	ty, id = sendEscrowTx(t, mock33, buyer, "Create", create)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendEscrowTx(t, mock33, buyer, "Dispute", &ety.EscrowDispute{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 3*types.Coin, mock33.GetExecBalance(ety.EscrowX, buyerAddr))
+	assert.Nil(t, mock33.CreateBlocks(ety.MinTimeout))
+	ty, _ = sendEscrowTx(t, mock33, arbiterPriv, "Resolve", &ety.EscrowResolve{Id: id, SellerAmount: types.Coin})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendEscrowTx(t, mock33, sellerPriv, "Claim", &ety.EscrowClaim{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 6*types.Coin, mock33.GetExecBalance(ety.EscrowX, buyerAddr))
+	assert.Equal(t, 4*types.Coin, mock33.GetExecBalance(ety.EscrowX, seller))
+}
@@ -0,0 +1,254 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	ety "github.com/33cn/chain33/system/dapp/escrow/types"
+	"github.com/33cn/chain33/types"
+)
+
+var escrowKeyPrefix = "mavl-" + ety.EscrowX + "-escrow-"
+
+func calcEscrowKey(id string) []byte {
+	return []byte(escrowKeyPrefix + id)
+}
+
+//calcEscrowAddr 托管的coins存在由托管id生成的地址中，没有对应的私钥
+func calcEscrowAddr(id string) string {
+	return address.ExecAddress(ety.EscrowX + "-" + id)
+}
+
+// Action escrow交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(e *Escrow, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: e.GetCoinsAccount(),
+		db:           e.GetStateDB(),
+		txhash:       tx.Hash(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       e.GetHeight(),
+		index:        index,
+	}
+}
+
+func getEscrow(db dbm.KV, id string) (*ety.Escrow, error) {
+	value, err := db.Get(calcEscrowKey(id))
+	if err != nil || value == nil {
+		return nil, ety.ErrEscrowNotExist
+	}
+	var escrow ety.Escrow
+	err = types.Decode(value, &escrow)
+	if err != nil {
+		return nil, err
+	}
+	return &escrow, nil
+}
+
+func (a *Action) saveEscrow(escrow *ety.Escrow) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcEscrowKey(escrow.Id), Value: types.Encode(escrow)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func escrowReceipt(ty int32, prev, current *ety.Escrow) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&ety.ReceiptEscrow{Prev: prev, Current: current})}
+}
+
+func checkTimeout(timeout int64) error {
+	if timeout < ety.MinTimeout || timeout > ety.MaxTimeout {
+		return ety.ErrTimeout
+	}
+	return nil
+}
+
+func (a *Action) create(payload *ety.EscrowCreate) (*types.Receipt, error) {
+	if err := address.CheckAddress(payload.Seller); err != nil || payload.Seller == a.fromaddr {
+		return nil, ety.ErrParty
+	}
+	if payload.Arbiter != "" {
+		if err := address.CheckAddress(payload.Arbiter); err != nil || payload.Arbiter == a.fromaddr || payload.Arbiter == payload.Seller {
+			return nil, ety.ErrParty
+		}
+	}
+	if payload.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+	if err := checkTimeout(payload.Timeout); err != nil {
+		return nil, err
+	}
+	if payload.Arbiter != "" {
+		if err := checkTimeout(payload.DisputeTimeout); err != nil {
+			return nil, err
+		}
+	}
+	if len(payload.Memo) > ety.MaxMemoLength {
+		return nil, ety.ErrMemoTooLong
+	}
+	id := common.ToHex(a.txhash)
+	escrow := &ety.Escrow{
+		Id:             id,
+		Buyer:          a.fromaddr,
+		Seller:         payload.Seller,
+		Arbiter:        payload.Arbiter,
+		Amount:         payload.Amount,
+		Memo:           payload.Memo,
+		Status:         ety.EscrowStatusLocked,
+		CreateHeight:   a.height,
+		TimeoutHeight:  a.height + payload.Timeout,
+		DisputeTimeout: payload.DisputeTimeout,
+		Addr:           calcEscrowAddr(id),
+	}
+	receipt, err := a.coinsAccount.ExecTransfer(a.fromaddr, escrow.Addr, a.execaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	kv := append(receipt.KV, a.saveEscrow(escrow))
+	logs := append(receipt.Logs, escrowReceipt(ety.TyLogEscrowCreate, nil, escrow))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//release 买方在争议中也可以放款
+func (a *Action) release(payload *ety.EscrowRelease) (*types.Receipt, error) {
+	escrow, err := getEscrow(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if escrow.Status == ety.EscrowStatusSettled {
+		return nil, ety.ErrEscrowStatus
+	}
+	if a.fromaddr != escrow.Buyer {
+		return nil, ety.ErrNoPermission
+	}
+	return a.settle(escrow, escrow.Amount)
+}
+
+//refund 卖方在争议中也可以退款
+func (a *Action) refund(payload *ety.EscrowRefund) (*types.Receipt, error) {
+	escrow, err := getEscrow(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if escrow.Status == ety.EscrowStatusSettled {
+		return nil, ety.ErrEscrowStatus
+	}
+	if a.fromaddr != escrow.Seller {
+		return nil, ety.ErrNoPermission
+	}
+	return a.settle(escrow, 0)
+}
+
+func (a *Action) dispute(payload *ety.EscrowDispute) (*types.Receipt, error) {
+	escrow, err := getEscrow(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if escrow.Status != ety.EscrowStatusLocked {
+		return nil, ety.ErrEscrowStatus
+	}
+	if a.fromaddr != escrow.Buyer && a.fromaddr != escrow.Seller {
+		return nil, ety.ErrNoPermission
+	}
+	if escrow.Arbiter == "" {
+		return nil, ety.ErrNoArbiter
+	}
+	if a.height >= escrow.TimeoutHeight {
+		return nil, ety.ErrTimeoutReached
+	}
+	if len(payload.Reason) > ety.MaxMemoLength {
+		return nil, ety.ErrMemoTooLong
+	}
+	prev := *escrow
+	escrow.Status = ety.EscrowStatusDisputed
+	escrow.Disputer = a.fromaddr
+	escrow.DisputeReason = payload.Reason
+	escrow.ResolveHeight = a.height + escrow.DisputeTimeout
+	kv := []*types.KeyValue{a.saveEscrow(escrow)}
+	logs := []*types.ReceiptLog{escrowReceipt(ety.TyLogEscrowDispute, &prev, escrow)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) resolve(payload *ety.EscrowResolve) (*types.Receipt, error) {
+	escrow, err := getEscrow(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if escrow.Status != ety.EscrowStatusDisputed {
+		return nil, ety.ErrEscrowStatus
+	}
+	if a.fromaddr != escrow.Arbiter {
+		return nil, ety.ErrNoPermission
+	}
+	if a.height >= escrow.ResolveHeight {
+		return nil, ety.ErrTimeoutReached
+	}
+	if payload.SellerAmount < 0 || payload.SellerAmount > escrow.Amount {
+		return nil, ety.ErrSellerAmount
+	}
+	return a.settle(escrow, payload.SellerAmount)
+}
+
+//claim 没有争议的托管超时放款给卖方，仲裁者超时没有裁决的托管退款给买方
+func (a *Action) claim(payload *ety.EscrowClaim) (*types.Receipt, error) {
+	escrow, err := getEscrow(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	switch escrow.Status {
+	case ety.EscrowStatusLocked:
+		if a.height < escrow.TimeoutHeight {
+			return nil, ety.ErrTimeoutNotReached
+		}
+		return a.settle(escrow, escrow.Amount)
+	case ety.EscrowStatusDisputed:
+		if a.height < escrow.ResolveHeight {
+			return nil, ety.ErrTimeoutNotReached
+		}
+		return a.settle(escrow, 0)
+	}
+	return nil, ety.ErrEscrowStatus
+}
+
+//settle 卖方得到sellerAmount，剩余的退给买方，都转到双方在escrow合约中的账户
+func (a *Action) settle(escrow *ety.Escrow, sellerAmount int64) (*types.Receipt, error) {
+	prev := *escrow
+	var kv []*types.KeyValue
+	var logs []*types.ReceiptLog
+	for _, pay := range []struct {
+		to     string
+		amount int64
+	}{{escrow.Seller, sellerAmount}, {escrow.Buyer, escrow.Amount - sellerAmount}} {
+		if pay.amount == 0 {
+			continue
+		}
+		receipt, err := a.coinsAccount.ExecTransfer(escrow.Addr, pay.to, a.execaddr, pay.amount)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+	}
+	escrow.Status = ety.EscrowStatusSettled
+	escrow.SellerAmount = sellerAmount
+	escrow.BuyerAmount = escrow.Amount - sellerAmount
+	escrow.SettleHeight = a.height
+	kv = append(kv, a.saveEscrow(escrow))
+	logs = append(logs, escrowReceipt(ety.TyLogEscrowSettle, &prev, escrow))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
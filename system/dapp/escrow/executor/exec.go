@@ -0,0 +1,46 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	ety "github.com/33cn/chain33/system/dapp/escrow/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Create 创建托管
+func (e *Escrow) Exec_Create(payload *ety.EscrowCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(e, tx, index)
+	return action.create(payload)
+}
+
+// Exec_Release 买方放款
+func (e *Escrow) Exec_Release(payload *ety.EscrowRelease, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(e, tx, index)
+	return action.release(payload)
+}
+
+// Exec_Refund 卖方退款
+func (e *Escrow) Exec_Refund(payload *ety.EscrowRefund, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(e, tx, index)
+	return action.refund(payload)
+}
+
+// Exec_Dispute 发起争议
+func (e *Escrow) Exec_Dispute(payload *ety.EscrowDispute, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(e, tx, index)
+	return action.dispute(payload)
+}
+
+// Exec_Resolve 仲裁者裁决
+func (e *Escrow) Exec_Resolve(payload *ety.EscrowResolve, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(e, tx, index)
+	return action.resolve(payload)
+}
+
+// Exec_Claim 超时结算
+func (e *Escrow) Exec_Claim(payload *ety.EscrowClaim, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(e, tx, index)
+	return action.claim(payload)
+}
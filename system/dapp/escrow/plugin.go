@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package escrow 托管执行器插件
+// 1. 买方把coins锁定在托管中，卖方和可选的仲裁者在创建的时候指定
+// 2. 买方可以放款给卖方，卖方可以退款给买方，有仲裁者的时候双方都可以发起争议，由仲裁者按比例裁决
+// 3. 超时没有争议的托管默认放款给卖方，争议以后仲裁者超时没有裁决的托管默认退款给买方
+package escrow
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/escrow/commands"
+	"github.com/33cn/chain33/system/dapp/escrow/executor"
+	"github.com/33cn/chain33/system/dapp/escrow/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.EscrowX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.EscrowCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// escrow action ty
+const (
+	EscrowActionCreate = iota + 1
+	EscrowActionRelease
+	EscrowActionRefund
+	EscrowActionDispute
+	EscrowActionResolve
+	EscrowActionClaim
+)
+
+// escrow log ty
+const (
+	TyLogEscrowCreate  = 520
+	TyLogEscrowDispute = 521
+	TyLogEscrowSettle  = 522
+)
+
+// escrow status
+const (
+	EscrowStatusLocked = iota + 1
+	EscrowStatusDisputed
+	EscrowStatusSettled
+)
+
+// query func name
+const (
+	FuncNameGetEscrow = "GetEscrow"
+	MinTimeout        = 10
+	MaxTimeout        = 1000000
+	MaxMemoLength     = 256
+)
@@ -0,0 +1,30 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrEscrowNotExist 托管不存在
+	ErrEscrowNotExist = errors.New("ErrEscrowNotExist")
+	// ErrParty 卖方或者仲裁者的地址不合法
+	ErrParty = errors.New("ErrParty")
+	// ErrTimeout 超时区块数不合法
+	ErrTimeout = errors.New("ErrTimeout")
+	// ErrMemoTooLong 备注太长
+	ErrMemoTooLong = errors.New("ErrMemoTooLong")
+	// ErrNoPermission 没有权限做这个操作
+	ErrNoPermission = errors.New("ErrNoPermission")
+	// ErrEscrowStatus 托管的状态不允许这个操作
+	ErrEscrowStatus = errors.New("ErrEscrowStatus")
+	// ErrNoArbiter 没有仲裁者的托管不能发起争议
+	ErrNoArbiter = errors.New("ErrNoArbiter")
+	// ErrTimeoutReached 已经超时
+	ErrTimeoutReached = errors.New("ErrTimeoutReached")
+	// ErrTimeoutNotReached 还没有超时
+	ErrTimeoutNotReached = errors.New("ErrTimeoutNotReached")
+	// ErrSellerAmount 裁决的金额不合法
+	ErrSellerAmount = errors.New("ErrSellerAmount")
+)
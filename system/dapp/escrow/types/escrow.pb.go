@@ -0,0 +1,874 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: escrow.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type EscrowAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*EscrowAction_Create
+	//	*EscrowAction_Release
+	//	*EscrowAction_Refund
+	//	*EscrowAction_Dispute
+	//	*EscrowAction_Resolve
+	//	*EscrowAction_Claim
+	Value                isEscrowAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                `protobuf:"varint,7,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EscrowAction) Reset()         { *m = EscrowAction{} }
+func (m *EscrowAction) String() string { return proto.CompactTextString(m) }
+func (*EscrowAction) ProtoMessage()    {}
+func (*EscrowAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{0}
+}
+
+func (m *EscrowAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowAction.Unmarshal(m, b)
+}
+func (m *EscrowAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowAction.Marshal(b, m, deterministic)
+}
+func (m *EscrowAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowAction.Merge(m, src)
+}
+func (m *EscrowAction) XXX_Size() int {
+	return xxx_messageInfo_EscrowAction.Size(m)
+}
+func (m *EscrowAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowAction proto.InternalMessageInfo
+
+type isEscrowAction_Value interface {
+	isEscrowAction_Value()
+}
+
+type EscrowAction_Create struct {
+	Create *EscrowCreate `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type EscrowAction_Release struct {
+	Release *EscrowRelease `protobuf:"bytes,2,opt,name=release,proto3,oneof"`
+}
+
+type EscrowAction_Refund struct {
+	Refund *EscrowRefund `protobuf:"bytes,3,opt,name=refund,proto3,oneof"`
+}
+
+type EscrowAction_Dispute struct {
+	Dispute *EscrowDispute `protobuf:"bytes,4,opt,name=dispute,proto3,oneof"`
+}
+
+type EscrowAction_Resolve struct {
+	Resolve *EscrowResolve `protobuf:"bytes,5,opt,name=resolve,proto3,oneof"`
+}
+
+type EscrowAction_Claim struct {
+	Claim *EscrowClaim `protobuf:"bytes,6,opt,name=claim,proto3,oneof"`
+}
+
+func (*EscrowAction_Create) isEscrowAction_Value() {}
+
+func (*EscrowAction_Release) isEscrowAction_Value() {}
+
+func (*EscrowAction_Refund) isEscrowAction_Value() {}
+
+func (*EscrowAction_Dispute) isEscrowAction_Value() {}
+
+func (*EscrowAction_Resolve) isEscrowAction_Value() {}
+
+func (*EscrowAction_Claim) isEscrowAction_Value() {}
+
+func (m *EscrowAction) GetValue() isEscrowAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *EscrowAction) GetCreate() *EscrowCreate {
+	if x, ok := m.GetValue().(*EscrowAction_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (m *EscrowAction) GetRelease() *EscrowRelease {
+	if x, ok := m.GetValue().(*EscrowAction_Release); ok {
+		return x.Release
+	}
+	return nil
+}
+
+func (m *EscrowAction) GetRefund() *EscrowRefund {
+	if x, ok := m.GetValue().(*EscrowAction_Refund); ok {
+		return x.Refund
+	}
+	return nil
+}
+
+func (m *EscrowAction) GetDispute() *EscrowDispute {
+	if x, ok := m.GetValue().(*EscrowAction_Dispute); ok {
+		return x.Dispute
+	}
+	return nil
+}
+
+func (m *EscrowAction) GetResolve() *EscrowResolve {
+	if x, ok := m.GetValue().(*EscrowAction_Resolve); ok {
+		return x.Resolve
+	}
+	return nil
+}
+
+func (m *EscrowAction) GetClaim() *EscrowClaim {
+	if x, ok := m.GetValue().(*EscrowAction_Claim); ok {
+		return x.Claim
+	}
+	return nil
+}
+
+func (m *EscrowAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*EscrowAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _EscrowAction_OneofMarshaler, _EscrowAction_OneofUnmarshaler, _EscrowAction_OneofSizer, []interface{}{
+		(*EscrowAction_Create)(nil),
+		(*EscrowAction_Release)(nil),
+		(*EscrowAction_Refund)(nil),
+		(*EscrowAction_Dispute)(nil),
+		(*EscrowAction_Resolve)(nil),
+		(*EscrowAction_Claim)(nil),
+	}
+}
+
+func _EscrowAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*EscrowAction)
+	// value
+	switch x := m.Value.(type) {
+	case *EscrowAction_Create:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Create); err != nil {
+			return err
+		}
+	case *EscrowAction_Release:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Release); err != nil {
+			return err
+		}
+	case *EscrowAction_Refund:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Refund); err != nil {
+			return err
+		}
+	case *EscrowAction_Dispute:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Dispute); err != nil {
+			return err
+		}
+	case *EscrowAction_Resolve:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Resolve); err != nil {
+			return err
+		}
+	case *EscrowAction_Claim:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Claim); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("EscrowAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _EscrowAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*EscrowAction)
+	switch tag {
+	case 1: // value.create
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(EscrowCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &EscrowAction_Create{msg}
+		return true, err
+	case 2: // value.release
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(EscrowRelease)
+		err := b.DecodeMessage(msg)
+		m.Value = &EscrowAction_Release{msg}
+		return true, err
+	case 3: // value.refund
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(EscrowRefund)
+		err := b.DecodeMessage(msg)
+		m.Value = &EscrowAction_Refund{msg}
+		return true, err
+	case 4: // value.dispute
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(EscrowDispute)
+		err := b.DecodeMessage(msg)
+		m.Value = &EscrowAction_Dispute{msg}
+		return true, err
+	case 5: // value.resolve
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(EscrowResolve)
+		err := b.DecodeMessage(msg)
+		m.Value = &EscrowAction_Resolve{msg}
+		return true, err
+	case 6: // value.claim
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(EscrowClaim)
+		err := b.DecodeMessage(msg)
+		m.Value = &EscrowAction_Claim{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _EscrowAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*EscrowAction)
+	// value
+	switch x := m.Value.(type) {
+	case *EscrowAction_Create:
+		s := proto.Size(x.Create)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *EscrowAction_Release:
+		s := proto.Size(x.Release)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *EscrowAction_Refund:
+		s := proto.Size(x.Refund)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *EscrowAction_Dispute:
+		s := proto.Size(x.Dispute)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *EscrowAction_Resolve:
+		s := proto.Size(x.Resolve)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *EscrowAction_Claim:
+		s := proto.Size(x.Claim)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//买方锁定amount，amount从买方在escrow合约中的coins转入托管
+//   timeout        : 超时以后没有争议的托管默认放款给卖方
+//   disputeTimeout : 争议以后仲裁者没有裁决的托管默认退款给买方
+//   arbiter        : 可选的仲裁者，没有仲裁者的托管不能发起争议
+type EscrowCreate struct {
+	Seller               string   `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	Arbiter              string   `protobuf:"bytes,2,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Timeout              int64    `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	DisputeTimeout       int64    `protobuf:"varint,5,opt,name=disputeTimeout,proto3" json:"disputeTimeout,omitempty"`
+	Memo                 string   `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EscrowCreate) Reset()         { *m = EscrowCreate{} }
+func (m *EscrowCreate) String() string { return proto.CompactTextString(m) }
+func (*EscrowCreate) ProtoMessage()    {}
+func (*EscrowCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{1}
+}
+
+func (m *EscrowCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowCreate.Unmarshal(m, b)
+}
+func (m *EscrowCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowCreate.Marshal(b, m, deterministic)
+}
+func (m *EscrowCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowCreate.Merge(m, src)
+}
+func (m *EscrowCreate) XXX_Size() int {
+	return xxx_messageInfo_EscrowCreate.Size(m)
+}
+func (m *EscrowCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowCreate proto.InternalMessageInfo
+
+func (m *EscrowCreate) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EscrowCreate) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
+	}
+	return ""
+}
+
+func (m *EscrowCreate) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *EscrowCreate) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *EscrowCreate) GetDisputeTimeout() int64 {
+	if m != nil {
+		return m.DisputeTimeout
+	}
+	return 0
+}
+
+func (m *EscrowCreate) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+//买方放款给卖方
+type EscrowRelease struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EscrowRelease) Reset()         { *m = EscrowRelease{} }
+func (m *EscrowRelease) String() string { return proto.CompactTextString(m) }
+func (*EscrowRelease) ProtoMessage()    {}
+func (*EscrowRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{2}
+}
+
+func (m *EscrowRelease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowRelease.Unmarshal(m, b)
+}
+func (m *EscrowRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowRelease.Marshal(b, m, deterministic)
+}
+func (m *EscrowRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowRelease.Merge(m, src)
+}
+func (m *EscrowRelease) XXX_Size() int {
+	return xxx_messageInfo_EscrowRelease.Size(m)
+}
+func (m *EscrowRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowRelease proto.InternalMessageInfo
+
+func (m *EscrowRelease) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+//卖方退款给买方
+type EscrowRefund struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EscrowRefund) Reset()         { *m = EscrowRefund{} }
+func (m *EscrowRefund) String() string { return proto.CompactTextString(m) }
+func (*EscrowRefund) ProtoMessage()    {}
+func (*EscrowRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{3}
+}
+
+func (m *EscrowRefund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowRefund.Unmarshal(m, b)
+}
+func (m *EscrowRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowRefund.Marshal(b, m, deterministic)
+}
+func (m *EscrowRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowRefund.Merge(m, src)
+}
+func (m *EscrowRefund) XXX_Size() int {
+	return xxx_messageInfo_EscrowRefund.Size(m)
+}
+func (m *EscrowRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowRefund proto.InternalMessageInfo
+
+func (m *EscrowRefund) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+//买方或者卖方在超时之前发起争议，由仲裁者裁决
+type EscrowDispute struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EscrowDispute) Reset()         { *m = EscrowDispute{} }
+func (m *EscrowDispute) String() string { return proto.CompactTextString(m) }
+func (*EscrowDispute) ProtoMessage()    {}
+func (*EscrowDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{4}
+}
+
+func (m *EscrowDispute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowDispute.Unmarshal(m, b)
+}
+func (m *EscrowDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowDispute.Marshal(b, m, deterministic)
+}
+func (m *EscrowDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowDispute.Merge(m, src)
+}
+func (m *EscrowDispute) XXX_Size() int {
+	return xxx_messageInfo_EscrowDispute.Size(m)
+}
+func (m *EscrowDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowDispute proto.InternalMessageInfo
+
+func (m *EscrowDispute) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EscrowDispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+//仲裁者裁决卖方得到sellerAmount，剩余的退给买方
+type EscrowResolve struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SellerAmount         int64    `protobuf:"varint,2,opt,name=sellerAmount,proto3" json:"sellerAmount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EscrowResolve) Reset()         { *m = EscrowResolve{} }
+func (m *EscrowResolve) String() string { return proto.CompactTextString(m) }
+func (*EscrowResolve) ProtoMessage()    {}
+func (*EscrowResolve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{5}
+}
+
+func (m *EscrowResolve) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowResolve.Unmarshal(m, b)
+}
+func (m *EscrowResolve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowResolve.Marshal(b, m, deterministic)
+}
+func (m *EscrowResolve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowResolve.Merge(m, src)
+}
+func (m *EscrowResolve) XXX_Size() int {
+	return xxx_messageInfo_EscrowResolve.Size(m)
+}
+func (m *EscrowResolve) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowResolve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowResolve proto.InternalMessageInfo
+
+func (m *EscrowResolve) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EscrowResolve) GetSellerAmount() int64 {
+	if m != nil {
+		return m.SellerAmount
+	}
+	return 0
+}
+
+//超时以后任何人都可以按默认规则结算
+type EscrowClaim struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EscrowClaim) Reset()         { *m = EscrowClaim{} }
+func (m *EscrowClaim) String() string { return proto.CompactTextString(m) }
+func (*EscrowClaim) ProtoMessage()    {}
+func (*EscrowClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{6}
+}
+
+func (m *EscrowClaim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscrowClaim.Unmarshal(m, b)
+}
+func (m *EscrowClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscrowClaim.Marshal(b, m, deterministic)
+}
+func (m *EscrowClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowClaim.Merge(m, src)
+}
+func (m *EscrowClaim) XXX_Size() int {
+	return xxx_messageInfo_EscrowClaim.Size(m)
+}
+func (m *EscrowClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowClaim proto.InternalMessageInfo
+
+func (m *EscrowClaim) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type Escrow struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Buyer                string   `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller               string   `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Arbiter              string   `protobuf:"bytes,4,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	Amount               int64    `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo                 string   `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	Status               int32    `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreateHeight         int64    `protobuf:"varint,8,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	TimeoutHeight        int64    `protobuf:"varint,9,opt,name=timeoutHeight,proto3" json:"timeoutHeight,omitempty"`
+	DisputeTimeout       int64    `protobuf:"varint,10,opt,name=disputeTimeout,proto3" json:"disputeTimeout,omitempty"`
+	Disputer             string   `protobuf:"bytes,11,opt,name=disputer,proto3" json:"disputer,omitempty"`
+	DisputeReason        string   `protobuf:"bytes,12,opt,name=disputeReason,proto3" json:"disputeReason,omitempty"`
+	ResolveHeight        int64    `protobuf:"varint,13,opt,name=resolveHeight,proto3" json:"resolveHeight,omitempty"`
+	SellerAmount         int64    `protobuf:"varint,14,opt,name=sellerAmount,proto3" json:"sellerAmount,omitempty"`
+	BuyerAmount          int64    `protobuf:"varint,15,opt,name=buyerAmount,proto3" json:"buyerAmount,omitempty"`
+	SettleHeight         int64    `protobuf:"varint,16,opt,name=settleHeight,proto3" json:"settleHeight,omitempty"`
+	Addr                 string   `protobuf:"bytes,17,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{7}
+}
+
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Escrow.Unmarshal(m, b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return xxx_messageInfo_Escrow.Size(m)
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Escrow) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *Escrow) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *Escrow) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
+	}
+	return ""
+}
+
+func (m *Escrow) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Escrow) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *Escrow) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Escrow) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+func (m *Escrow) GetTimeoutHeight() int64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+func (m *Escrow) GetDisputeTimeout() int64 {
+	if m != nil {
+		return m.DisputeTimeout
+	}
+	return 0
+}
+
+func (m *Escrow) GetDisputer() string {
+	if m != nil {
+		return m.Disputer
+	}
+	return ""
+}
+
+func (m *Escrow) GetDisputeReason() string {
+	if m != nil {
+		return m.DisputeReason
+	}
+	return ""
+}
+
+func (m *Escrow) GetResolveHeight() int64 {
+	if m != nil {
+		return m.ResolveHeight
+	}
+	return 0
+}
+
+func (m *Escrow) GetSellerAmount() int64 {
+	if m != nil {
+		return m.SellerAmount
+	}
+	return 0
+}
+
+func (m *Escrow) GetBuyerAmount() int64 {
+	if m != nil {
+		return m.BuyerAmount
+	}
+	return 0
+}
+
+func (m *Escrow) GetSettleHeight() int64 {
+	if m != nil {
+		return m.SettleHeight
+	}
+	return 0
+}
+
+func (m *Escrow) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReceiptEscrow struct {
+	Prev                 *Escrow  `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *Escrow  `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptEscrow) Reset()         { *m = ReceiptEscrow{} }
+func (m *ReceiptEscrow) String() string { return proto.CompactTextString(m) }
+func (*ReceiptEscrow) ProtoMessage()    {}
+func (*ReceiptEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89c81597814471f3, []int{8}
+}
+
+func (m *ReceiptEscrow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptEscrow.Unmarshal(m, b)
+}
+func (m *ReceiptEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptEscrow.Marshal(b, m, deterministic)
+}
+func (m *ReceiptEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptEscrow.Merge(m, src)
+}
+func (m *ReceiptEscrow) XXX_Size() int {
+	return xxx_messageInfo_ReceiptEscrow.Size(m)
+}
+func (m *ReceiptEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptEscrow proto.InternalMessageInfo
+
+func (m *ReceiptEscrow) GetPrev() *Escrow {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptEscrow) GetCurrent() *Escrow {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EscrowAction)(nil), "types.EscrowAction")
+	proto.RegisterType((*EscrowCreate)(nil), "types.EscrowCreate")
+	proto.RegisterType((*EscrowRelease)(nil), "types.EscrowRelease")
+	proto.RegisterType((*EscrowRefund)(nil), "types.EscrowRefund")
+	proto.RegisterType((*EscrowDispute)(nil), "types.EscrowDispute")
+	proto.RegisterType((*EscrowResolve)(nil), "types.EscrowResolve")
+	proto.RegisterType((*EscrowClaim)(nil), "types.EscrowClaim")
+	proto.RegisterType((*Escrow)(nil), "types.Escrow")
+	proto.RegisterType((*ReceiptEscrow)(nil), "types.ReceiptEscrow")
+}
+
+func init() { proto.RegisterFile("escrow.proto", fileDescriptor_89c81597814471f3) }
+
+var fileDescriptor_89c81597814471f3 = []byte{
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x8f, 0xd3, 0x40,
+	0x0c, 0x6d, 0xd3, 0xa6, 0xdd, 0xba, 0x1f, 0xc0, 0xb0, 0x42, 0x23, 0x24, 0xa0, 0x44, 0x08, 0x56,
+	0x48, 0x54, 0x08, 0x0e, 0x9c, 0x97, 0x05, 0xa9, 0xe7, 0x11, 0x37, 0x4e, 0x69, 0x62, 0x20, 0x52,
+	0xda, 0x54, 0x93, 0x49, 0x51, 0xff, 0x14, 0x7f, 0x83, 0x3f, 0xc5, 0x01, 0x8d, 0xed, 0xec, 0x36,
+	0x4d, 0xf6, 0x36, 0xb6, 0x5f, 0xc6, 0x7e, 0xef, 0x39, 0x03, 0x33, 0x2c, 0x13, 0x5b, 0xfc, 0x5e,
+	0xed, 0x6d, 0xe1, 0x0a, 0x15, 0xba, 0xe3, 0x1e, 0xcb, 0xe8, 0x6f, 0x00, 0xb3, 0xaf, 0x94, 0xbf,
+	0x4e, 0x5c, 0x56, 0xec, 0xd4, 0x3b, 0x18, 0x25, 0x16, 0x63, 0x87, 0xba, 0xbf, 0xec, 0x5f, 0x4d,
+	0x3f, 0x3c, 0x5e, 0x11, 0x70, 0xc5, 0xa0, 0x1b, 0x2a, 0xad, 0x7b, 0x46, 0x40, 0xea, 0x3d, 0x8c,
+	0x2d, 0xe6, 0x18, 0x97, 0xa8, 0x03, 0xc2, 0x5f, 0x36, 0xf0, 0x86, 0x6b, 0xeb, 0x9e, 0xa9, 0x61,
+	0xbe, 0x81, 0xc5, 0x1f, 0xd5, 0x2e, 0xd5, 0x83, 0x8e, 0x06, 0x86, 0x4a, 0xbe, 0x01, 0x83, 0x7c,
+	0x83, 0x34, 0x2b, 0xf7, 0x95, 0x43, 0x3d, 0xec, 0x68, 0xf0, 0x85, 0x6b, 0xbe, 0x81, 0xc0, 0x78,
+	0xa4, 0xb2, 0xc8, 0x0f, 0xa8, 0xc3, 0xce, 0x91, 0xa8, 0xc6, 0x23, 0xd1, 0x51, 0xbd, 0x85, 0x30,
+	0xc9, 0xe3, 0x6c, 0xab, 0x47, 0x84, 0x57, 0x4d, 0xca, 0xbe, 0xb2, 0xee, 0x19, 0x86, 0xa8, 0x05,
+	0x04, 0xee, 0xa8, 0xc7, 0xcb, 0xfe, 0x55, 0x68, 0x02, 0x77, 0xfc, 0x3c, 0x86, 0xf0, 0x10, 0xe7,
+	0x15, 0x46, 0x7f, 0xfa, 0xb5, 0x92, 0x2c, 0x92, 0x7a, 0x02, 0xa3, 0x12, 0xf3, 0x1c, 0x2d, 0x29,
+	0x39, 0x31, 0x12, 0x29, 0x0d, 0xe3, 0xd8, 0x6e, 0x32, 0x87, 0x96, 0x24, 0x9b, 0x98, 0x3a, 0xf4,
+	0x5f, 0xc4, 0xdb, 0xa2, 0xda, 0x39, 0x92, 0x66, 0x60, 0x24, 0xf2, 0x5f, 0xb8, 0x6c, 0x8b, 0x45,
+	0xe5, 0x48, 0x83, 0x81, 0xa9, 0x43, 0xf5, 0x1a, 0x16, 0x42, 0xfb, 0x9b, 0x00, 0x42, 0x02, 0x9c,
+	0x65, 0x95, 0x82, 0xe1, 0x16, 0xb7, 0x05, 0x11, 0x9c, 0x18, 0x3a, 0x47, 0x2f, 0x60, 0xde, 0x30,
+	0xc9, 0x53, 0xcb, 0x52, 0x19, 0x36, 0xc8, 0xd2, 0xe8, 0x79, 0x4d, 0x88, 0x4d, 0x69, 0xd5, 0x3f,
+	0xd5, 0x17, 0x88, 0x09, 0xe7, 0x00, 0xcf, 0xc7, 0x62, 0x5c, 0x16, 0x3b, 0x21, 0x2a, 0x51, 0x74,
+	0x73, 0xd7, 0x99, 0x0d, 0x38, 0xff, 0x30, 0x82, 0x19, 0x8b, 0x75, 0xcd, 0x72, 0x04, 0x44, 0xaa,
+	0x91, 0x8b, 0x9e, 0xc1, 0xf4, 0xc4, 0xa0, 0xd6, 0x70, 0xff, 0x06, 0x30, 0xe2, 0x7a, 0xeb, 0xf6,
+	0x4b, 0x08, 0x37, 0xd5, 0xf1, 0x56, 0x7e, 0x0e, 0x4e, 0xec, 0x1a, 0xdc, 0x67, 0xd7, 0xf0, 0x3e,
+	0xbb, 0xc2, 0x86, 0x5d, 0x1d, 0x62, 0xd3, 0xed, 0x2e, 0x76, 0x55, 0x29, 0xab, 0x23, 0x91, 0x67,
+	0xca, 0x7f, 0xd2, 0x1a, 0xb3, 0x9f, 0xbf, 0x9c, 0xbe, 0x60, 0xa6, 0xa7, 0x39, 0xf5, 0x0a, 0xe6,
+	0xe2, 0xb7, 0x80, 0x26, 0x04, 0x6a, 0x26, 0x3b, 0x56, 0x01, 0x3a, 0x57, 0xe1, 0x29, 0x5c, 0x48,
+	0xc6, 0xea, 0x29, 0x4d, 0x78, 0x1b, 0xfb, 0x4e, 0x72, 0x36, 0xec, 0xdb, 0x8c, 0x00, 0xcd, 0xa4,
+	0x47, 0xc9, 0x9f, 0x23, 0xf3, 0xcc, 0x79, 0x9e, 0x46, 0xb2, 0xe5, 0xe1, 0xa2, 0xed, 0xa1, 0x5a,
+	0xc2, 0x94, 0xc4, 0x17, 0xc8, 0x03, 0x82, 0x9c, 0xa6, 0xf8, 0x16, 0xe7, 0xf2, 0xba, 0xd5, 0xc3,
+	0xfa, 0x96, 0xbb, 0x9c, 0xd7, 0x3b, 0x4e, 0x53, 0xab, 0x1f, 0xb1, 0xde, 0xfe, 0x1c, 0x7d, 0x87,
+	0xb9, 0xc1, 0x04, 0xb3, 0xbd, 0x93, 0x25, 0x78, 0x09, 0xc3, 0xbd, 0xc5, 0x83, 0xbc, 0x6a, 0xf3,
+	0xe6, 0x93, 0x40, 0x25, 0xf5, 0x06, 0xc6, 0x49, 0x65, 0x2d, 0xca, 0xc2, 0xb5, 0x50, 0x75, 0x75,
+	0x33, 0xa2, 0x27, 0xf4, 0xe3, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xea, 0x09, 0x3e, 0x52,
+	0x05, 0x00, 0x00,
+}
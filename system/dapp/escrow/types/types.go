@@ -0,0 +1,68 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types escrow插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// EscrowX 执行器名称
+	EscrowX    = "escrow"
+	actionName = map[string]int32{
+		"Create":  EscrowActionCreate,
+		"Release": EscrowActionRelease,
+		"Refund":  EscrowActionRefund,
+		"Dispute": EscrowActionDispute,
+		"Resolve": EscrowActionResolve,
+		"Claim":   EscrowActionClaim,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogEscrowCreate:  {Ty: reflect.TypeOf(ReceiptEscrow{}), Name: "LogEscrowCreate"},
+		TyLogEscrowDispute: {Ty: reflect.TypeOf(ReceiptEscrow{}), Name: "LogEscrowDispute"},
+		TyLogEscrowSettle:  {Ty: reflect.TypeOf(ReceiptEscrow{}), Name: "LogEscrowSettle"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(EscrowX))
+	types.RegistorExecutor(EscrowX, NewType())
+	types.RegisterDappFork(EscrowX, "Enable", 0)
+}
+
+// EscrowType escrow执行器类型
+type EscrowType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a escrow type object
+func NewType() *EscrowType {
+	c := &EscrowType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return escrow action
+func (e *EscrowType) GetPayload() types.Message {
+	return &EscrowAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (e *EscrowType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (e *EscrowType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (e *EscrowType) GetName() string {
+	return EscrowX
+}
@@ -0,0 +1,226 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands exchange插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	ety "github.com/33cn/chain33/system/dapp/exchange/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// ExchangeCmd exchange command
+func ExchangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange",
+		Short: "On-chain order book exchange",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		LimitOrderCmd(),
+		RevokeOrderCmd(),
+		QueryOrderCmd(),
+		ListOrdersCmd(),
+		DepthCmd(),
+		ListTradesCmd(),
+		PairWhitelistCmd(),
+	)
+
+	return cmd
+}
+
+func addPairFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("base_exec", "", "coins", "base asset executor")
+	cmd.Flags().StringP("base_symbol", "", "", "base asset symbol, empty for coins")
+	cmd.Flags().StringP("quote_exec", "", "", "quote asset executor")
+	cmd.MarkFlagRequired("quote_exec")
+	cmd.Flags().StringP("quote_symbol", "", "", "quote asset symbol")
+}
+
+func getPair(cmd *cobra.Command) (*ety.ExchangeAsset, *ety.ExchangeAsset) {
+	baseExec, _ := cmd.Flags().GetString("base_exec")
+	baseSymbol, _ := cmd.Flags().GetString("base_symbol")
+	quoteExec, _ := cmd.Flags().GetString("quote_exec")
+	quoteSymbol, _ := cmd.Flags().GetString("quote_symbol")
+	return &ety.ExchangeAsset{Execer: baseExec, Symbol: baseSymbol}, &ety.ExchangeAsset{Execer: quoteExec, Symbol: quoteSymbol}
+}
+
+// LimitOrderCmd place limit order
+func LimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit",
+		Short: "Create a transaction to place a limit order",
+		Run:   limitOrder,
+	}
+	addPairFlags(cmd)
+	cmd.Flags().StringP("op", "o", "", "buy or sell")
+	cmd.MarkFlagRequired("op")
+	cmd.Flags().Float64P("price", "p", 0, "quote amount of one base asset")
+	cmd.MarkFlagRequired("price")
+	cmd.Flags().Float64P("amount", "a", 0, "base asset amount")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func limitOrder(cmd *cobra.Command, args []string) {
+	opName, _ := cmd.Flags().GetString("op")
+	price, _ := cmd.Flags().GetFloat64("price")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	var op int32
+	switch opName {
+	case "buy":
+		op = ety.OpBuy
+	case "sell":
+		op = ety.OpSell
+	default:
+		fmt.Fprintln(os.Stderr, ety.ErrOrderOp)
+		return
+	}
+	base, quote := getPair(cmd)
+	commandtypes.CreateActionTx(cmd, ety.ExchangeX, &ety.ExchangeAction{
+		Ty: ety.ExchangeActionLimitOrder,
+		Value: &ety.ExchangeAction_LimitOrder{LimitOrder: &ety.ExchangeLimitOrder{Base: base, Quote: quote, Op: op,
+			Price: commandtypes.FormatAmountDisplay2Value(price), Amount: commandtypes.FormatAmountDisplay2Value(amount)}},
+	})
+}
+
+// RevokeOrderCmd revoke order
+func RevokeOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Create a transaction to revoke an open order",
+		Run:   revokeOrder,
+	}
+	cmd.Flags().StringP("id", "i", "", "order id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func revokeOrder(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, ety.ExchangeX, &ety.ExchangeAction{
+		Ty:    ety.ExchangeActionRevokeOrder,
+		Value: &ety.ExchangeAction_RevokeOrder{RevokeOrder: &ety.ExchangeRevokeOrder{OrderID: id}},
+	})
+}
+
+func queryExchange(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, ety.ExchangeX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryOrderCmd query order
+func QueryOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order",
+		Short: "Query order by id",
+		Run:   queryOrder,
+	}
+	cmd.Flags().StringP("id", "i", "", "order id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryOrder(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res ety.ExchangeOrder
+	queryExchange(cmd, ety.FuncNameGetOrder, &types.ReqString{Data: id}, &res)
+}
+
+// ListOrdersCmd list orders of address
+func ListOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders",
+		Short: "List orders of address",
+		Run:   listOrders,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("primary", "p", "", "list after this order id")
+	cmd.Flags().Int32P("count", "c", ety.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listOrders(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res ety.ReplyExchangeOrders
+	queryExchange(cmd, ety.FuncNameListOrders, &ety.ReqExchangeOrders{Addr: addr, PrimaryKey: primary, Count: count, Direction: direction}, &res)
+}
+
+// DepthCmd query depth of pair
+func DepthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "depth",
+		Short: "Query bids and asks of pair",
+		Run:   depth,
+	}
+	addPairFlags(cmd)
+	cmd.Flags().Int32P("count", "c", ety.DefaultListCount, "max price levels")
+	return cmd
+}
+
+func depth(cmd *cobra.Command, args []string) {
+	count, _ := cmd.Flags().GetInt32("count")
+	base, quote := getPair(cmd)
+	var res ety.ReplyExchangeDepth
+	queryExchange(cmd, ety.FuncNameGetDepth, &ety.ReqExchangeDepth{Base: base, Quote: quote, Count: count}, &res)
+}
+
+// ListTradesCmd list trades of pair
+func ListTradesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades",
+		Short: "List trades of pair",
+		Run:   listTrades,
+	}
+	addPairFlags(cmd)
+	cmd.Flags().StringP("primary", "p", "", "list after this primary key")
+	cmd.Flags().Int32P("count", "c", ety.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listTrades(cmd *cobra.Command, args []string) {
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	base, quote := getPair(cmd)
+	var res ety.ReplyExchangeTrades
+	queryExchange(cmd, ety.FuncNameListTrades, &ety.ReqExchangeTrades{Base: base, Quote: quote, PrimaryKey: primary, Count: count, Direction: direction}, &res)
+}
+
+// PairWhitelistCmd query pair whitelist
+func PairWhitelistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pairs",
+		Short: "Query pair whitelist configured in manage",
+		Run:   pairWhitelist,
+	}
+	return cmd
+}
+
+func pairWhitelist(cmd *cobra.Command, args []string) {
+	var res ety.ReplyExchangePairs
+	queryExchange(cmd, ety.FuncNameGetPairWhitelist, &types.ReqNil{}, &res)
+}
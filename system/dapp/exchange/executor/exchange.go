@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor exchange执行器，维护链上的订单簿，按价格时间优先撮合
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	ety "github.com/33cn/chain33/system/dapp/exchange/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.exchange")
+	driverName = ety.ExchangeX
+)
+
+func init() {
+	et := types.LoadExecutorType(driverName)
+	et.InitFuncList(types.ListMethod(&Exchange{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newExchange, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return exchange name
+func GetName() string {
+	return newExchange().GetName()
+}
+
+// Exchange defines Exchange object
+type Exchange struct {
+	drivers.DriverBase
+}
+
+func newExchange() drivers.Driver {
+	e := &Exchange{}
+	e.SetChild(e)
+	e.SetExecutorType(types.LoadExecutorType(driverName))
+	return e
+}
+
+// GetDriverName return a drivername
+func (e *Exchange) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (e *Exchange) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,265 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	ety "github.com/33cn/chain33/system/dapp/exchange/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	baseAsset  = &ety.ExchangeAsset{Execer: "coins"}
+	quoteAsset = &ety.ExchangeAsset{Execer: "token", Symbol: "CCNY"}
+	execAddr   = address.ExecAddress(ety.ExchangeX)
+	feeAddr, _ = util.Genaddress()
+)
+
+type testEnv struct {
+	t     *testing.T
+	e     *Exchange
+	base  *account.DB
+	quote *account.DB
+	index int
+}
+
+func newTestEnv(t *testing.T) (*testEnv, func()) {
+	dir, leveldb, kvdb := util.CreateTestDB()
+	e := newExchange().(*Exchange)
+	e.SetStateDB(kvdb)
+	e.SetLocalDB(kvdb)
+	e.SetEnv(10, 0, 0)
+	quote, err := account.NewAccountDB(quoteAsset.Execer, quoteAsset.Symbol, kvdb)
+	assert.Nil(t, err)
+	return &testEnv{t: t, e: e, base: e.GetCoinsAccount(), quote: quote}, func() { util.CloseTestDB(dir, leveldb) }
+}
+
+func (env *testEnv) deposit(acc *account.DB, addr string, amount int64) {
+	_, err := acc.ExecDeposit(addr, execAddr, amount)
+	assert.Nil(env.t, err)
+}
+
+func (env *testEnv) action(priv crypto.PrivKey) (*Action, *types.Transaction) {
+	tx := &types.Transaction{Execer: []byte(ety.ExchangeX), To: execAddr}
+	tx.Sign(types.SECP256K1, priv)
+	env.index++
+	a := NewAction(env.e, tx, env.index)
+	a.feeAddr, a.makerRate, a.takerRate = feeAddr, 100, 200
+	return a, tx
+}
+
+func (env *testEnv) limit(priv crypto.PrivKey, op int32, price, amount int64) (*types.Receipt, error) {
+	a, _ := env.action(priv)
+	return a.limitOrder(&ety.ExchangeLimitOrder{Base: baseAsset, Quote: quoteAsset, Op: op, Price: price, Amount: amount})
+}
+
+func (env *testEnv) revoke(priv crypto.PrivKey, id string) (*types.Receipt, error) {
+	a, _ := env.action(priv)
+	return a.revokeOrder(&ety.ExchangeRevokeOrder{OrderID: id})
+}
+
+func (env *testEnv) order(id string) *ety.ExchangeOrder {
+	order, err := getOrder(env.e.GetStateDB(), id)
+	assert.Nil(env.t, err)
+	return order
+}
+
+func (env *testEnv) depth() *ety.ReplyExchangeDepth {
+	depth, err := getDepth(env.e.GetStateDB(), &ety.ReqExchangeDepth{Base: baseAsset, Quote: quoteAsset})
+	assert.Nil(env.t, err)
+	return depth
+}
+
+func TestLimitOrderCheck(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	_, priv := util.Genaddress()
+	a, _ := env.action(priv)
+	_, err := a.limitOrder(&ety.ExchangeLimitOrder{Base: baseAsset, Quote: baseAsset, Op: ety.OpBuy, Price: 1, Amount: types.Coin})
+	assert.Equal(t, ety.ErrAsset, err)
+	_, err = a.limitOrder(&ety.ExchangeLimitOrder{Base: &ety.ExchangeAsset{Execer: "coins", Symbol: "CCNY"}, Quote: quoteAsset, Op: ety.OpBuy, Price: 1, Amount: types.Coin})
+	assert.Equal(t, ety.ErrAsset, err)
+	_, err = env.limit(priv, 3, types.Coin, types.Coin)
+	assert.Equal(t, ety.ErrOrderOp, err)
+	_, err = env.limit(priv, ety.OpBuy, 0, types.Coin)
+	assert.Equal(t, ety.ErrOrderPrice, err)
+	_, err = env.limit(priv, ety.OpBuy, 1, 1)
+	assert.Equal(t, ety.ErrOrderAmount, err)
+	_, err = env.limit(priv, ety.OpBuy, types.Coin, types.Coin)
+	assert.Equal(t, types.ErrNoBalance, err)
+}
+
+func TestPairWhitelist(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	addr, priv := util.Genaddress()
+	env.deposit(env.base, addr, 10*types.Coin)
+	limit := func(whitelist bool) error {
+		a, _ := env.action(priv)
+		a.whitelist = whitelist
+		_, err := a.limitOrder(&ety.ExchangeLimitOrder{Base: baseAsset, Quote: quoteAsset, Op: ety.OpSell, Price: types.Coin, Amount: types.Coin})
+		return err
+	}
+	assert.Nil(t, limit(false))
+	assert.Equal(t, ety.ErrPairNotAllowed, limit(true))
+
+	//交易对的名字用默认的coins symbol
+	pair := ety.PairKey(ety.NormalizeAsset(baseAsset), quoteAsset)
+	item := &types.ConfigItem{
+		Key:   types.ManageKey(ety.PairWhitelistKey),
+		Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{"coins:" + types.GetCoinSymbol() + "/token:USDT", pair}}},
+	}
+	env.e.GetStateDB().Set([]byte(item.Key), types.Encode(item))
+	assert.Nil(t, limit(true))
+	pairs, err := env.e.Query_GetPairWhitelist(&types.ReqNil{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"coins:" + types.GetCoinSymbol() + "/token:USDT", pair}, pairs.(*ety.ReplyExchangePairs).Pairs)
+}
+
+func TestMatch(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	seller, sellerPriv := util.Genaddress()
+	buyer, buyerPriv := util.Genaddress()
+	env.deposit(env.base, seller, 20*types.Coin)
+	env.deposit(env.quote, buyer, 30*types.Coin)
+
+	_, err := env.limit(sellerPriv, ety.OpSell, 2*types.Coin, 10*types.Coin)
+	assert.Nil(t, err)
+	sellID := calcOrderID(10, env.index)
+	_, err = env.limit(sellerPriv, ety.OpSell, 15e7, 5*types.Coin)
+	assert.Nil(t, err)
+	depth := env.depth()
+	assert.Equal(t, 2, len(depth.Asks))
+	assert.Equal(t, int64(15e7), depth.Asks[0].Price)
+	assert.Equal(t, 0, len(depth.Bids))
+
+	//吃掉1.5的卖单和2的卖单的一部分，按卖单的价格成交，剩余冻结的资产解冻
+	receipt, err := env.limit(buyerPriv, ety.OpBuy, 2*types.Coin, 12*types.Coin)
+	assert.Nil(t, err)
+	buyID := calcOrderID(10, env.index)
+	buy := env.order(buyID)
+	assert.Equal(t, int32(ety.StatusCompleted), buy.Status)
+	assert.Equal(t, int64(0), buy.Frozen)
+	assert.Equal(t, int64(24e5), buy.Fee)
+	sell := env.order(sellID)
+	assert.Equal(t, int32(ety.StatusOrdered), sell.Status)
+	assert.Equal(t, 7*types.Coin, sell.Executed)
+	assert.Equal(t, 3*types.Coin, sell.Frozen)
+
+	assert.Equal(t, 12*types.Coin-24e5, env.base.LoadExecAccount(buyer, execAddr).Balance)
+	assert.Equal(t, int64(85e7), env.quote.LoadExecAccount(buyer, execAddr).Balance)
+	assert.Equal(t, int64(0), env.quote.LoadExecAccount(buyer, execAddr).Frozen)
+	assert.Equal(t, 5*types.Coin, env.base.LoadExecAccount(seller, execAddr).Balance)
+	assert.Equal(t, 3*types.Coin, env.base.LoadExecAccount(seller, execAddr).Frozen)
+	assert.Equal(t, int64(215e7-215e4), env.quote.LoadExecAccount(seller, execAddr).Balance)
+	assert.Equal(t, int64(24e5), env.base.LoadExecAccount(feeAddr, execAddr).Balance)
+	assert.Equal(t, int64(215e4), env.quote.LoadExecAccount(feeAddr, execAddr).Balance)
+
+	depth = env.depth()
+	assert.Equal(t, 1, len(depth.Asks))
+	assert.Equal(t, &ety.ExchangePriceLevel{Price: 2 * types.Coin, Amount: 3 * types.Coin, Orders: 1}, depth.Asks[0])
+
+	//新订单添加地址索引，每次成交保存一条记录
+	tx := &types.Transaction{Execer: []byte(ety.ExchangeX)}
+	set, err := env.e.execLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs})
+	assert.Nil(t, err)
+	var orders, trades int
+	for _, kv := range set.KV {
+		if string(kv.Key) == string(calcAddrIndexKey(buyer, buyID)) {
+			orders++
+		}
+		if len(kv.Key) > len(tradeIndexPrefix) && string(kv.Key[:len(tradeIndexPrefix)]) == tradeIndexPrefix {
+			trades++
+		}
+	}
+	assert.Equal(t, 1, orders)
+	assert.Equal(t, 2, trades)
+
+	_, err = env.revoke(buyerPriv, sellID)
+	assert.Equal(t, ety.ErrOrderNotOwner, err)
+	_, err = env.revoke(sellerPriv, sellID)
+	assert.Nil(t, err)
+	assert.Equal(t, 8*types.Coin, env.base.LoadExecAccount(seller, execAddr).Balance)
+	assert.Equal(t, int64(0), env.base.LoadExecAccount(seller, execAddr).Frozen)
+	_, err = env.revoke(sellerPriv, sellID)
+	assert.Equal(t, ety.ErrOrderClosed, err)
+	_, err = env.revoke(sellerPriv, buyID)
+	assert.Equal(t, ety.ErrOrderNotOwner, err)
+	depth = env.depth()
+	assert.Equal(t, 0, len(depth.Asks))
+}
+
+func TestSelfTrade(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	addr, priv := util.Genaddress()
+	env.deposit(env.base, addr, 10*types.Coin)
+	env.deposit(env.quote, addr, 10*types.Coin)
+	_, err := env.limit(priv, ety.OpSell, types.Coin, 5*types.Coin)
+	assert.Nil(t, err)
+	sellID := calcOrderID(10, env.index)
+
+	//和自己的卖单交叉的时候撤销卖单，买单进入订单簿
+	_, err = env.limit(priv, ety.OpBuy, types.Coin, 5*types.Coin)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(ety.StatusRevoked), env.order(sellID).Status)
+	assert.Equal(t, 10*types.Coin, env.base.LoadExecAccount(addr, execAddr).Balance)
+	assert.Equal(t, 5*types.Coin, env.quote.LoadExecAccount(addr, execAddr).Frozen)
+	depth := env.depth()
+	assert.Equal(t, 0, len(depth.Asks))
+	assert.Equal(t, 1, len(depth.Bids))
+}
+
+func TestAutoRollback(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	seller, sellerPriv := util.Genaddress()
+	env.deposit(env.base, seller, 20*types.Coin)
+
+	param := &ety.ExchangeLimitOrder{Base: baseAsset, Quote: quoteAsset, Op: ety.OpSell, Price: 2 * types.Coin, Amount: 10 * types.Coin}
+	txdata, err := types.CallCreateTx(ety.ExchangeX, "LimitOrder", param)
+	assert.Nil(t, err)
+	tx := &types.Transaction{}
+	assert.Nil(t, types.Decode(txdata, tx))
+	tx.Sign(types.SECP256K1, sellerPriv)
+	receipt, err := env.limit(sellerPriv, param.Op, param.Price, param.Amount)
+	assert.Nil(t, err)
+	id := calcOrderID(10, env.index)
+	key := calcAddrIndexKey(seller, id)
+	db := env.e.GetLocalDB()
+
+	//ExecLocal的时候框架自动保存回滚的记录
+	data := &types.ReceiptData{Ty: types.ExecOk, Logs: receipt.Logs}
+	set, err := env.e.ExecLocal(tx, data, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(set.KV))
+	rollbackKey := types.CalcRollbackKey(tx.Execer, tx.Hash())
+	assert.Equal(t, rollbackKey, set.KV[1].Key)
+	for _, kv := range set.KV {
+		db.Set(kv.Key, kv.Value)
+	}
+	value, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(id), value)
+
+	//回滚区块的时候恢复原来的值，并删除回滚的记录
+	set, err = env.e.ExecDelLocal(tx, data, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(set.KV))
+	for _, kv := range set.KV {
+		db.Set(kv.Key, kv.Value)
+	}
+	value, _ = db.Get(key)
+	assert.Empty(t, value)
+	value, _ = db.Get(rollbackKey)
+	assert.Empty(t, value)
+}
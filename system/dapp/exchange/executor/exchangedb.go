@@ -0,0 +1,650 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	ety "github.com/33cn/chain33/system/dapp/exchange/types"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	orderKeyPrefix   = "mavl-exchange-order-"
+	pricesKeyPrefix  = "mavl-exchange-prices-"
+	queueKeyPrefix   = "mavl-exchange-queue-"
+	addrIndexPrefix  = "LODB-exchange-addr-"
+	tradeIndexPrefix = "LODB-exchange-trade-"
+)
+
+func calcOrderKey(id string) []byte {
+	return []byte(orderKeyPrefix + id)
+}
+
+func calcPricesKey(pair string, op int32) []byte {
+	return []byte(fmt.Sprintf("%s%s-%d", pricesKeyPrefix, pair, op))
+}
+
+func calcQueueKey(pair string, op int32, price int64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%d-%020d", queueKeyPrefix, pair, op, price))
+}
+
+func calcAddrIndexKey(addr, id string) []byte {
+	return []byte(addrIndexPrefix + addr + "-" + id)
+}
+
+func calcTradeIndexKey(pair string, trade *ety.ExchangeTrade) []byte {
+	return []byte(tradeIndexPrefix + pair + "-" + tradePrimaryKey(trade))
+}
+
+func tradePrimaryKey(trade *ety.ExchangeTrade) string {
+	return fmt.Sprintf("%018d-%05d", trade.Height*types.MaxTxsPerBlock+trade.Index, trade.Seq)
+}
+
+//calcOrderID 订单号按区块高度和交易序号生成，订单号的顺序就是挂单的时间顺序
+func calcOrderID(height int64, index int) string {
+	return fmt.Sprintf("%018d", height*types.MaxTxsPerBlock+int64(index))
+}
+
+func oppositeOp(op int32) int32 {
+	if op == ety.OpBuy {
+		return ety.OpSell
+	}
+	return ety.OpBuy
+}
+
+// Action exchange交易的执行环境，同一个key在一个交易中多次修改的时候只保留最后的值
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+	kvs          []*types.KeyValue
+	kvIndex      map[string]int
+	logs         []*types.ReceiptLog
+	tradeSeq     int32
+	feeAddr      string
+	makerRate    int64
+	takerRate    int64
+	whitelist    bool
+}
+
+// NewAction new a action object
+func NewAction(e *Exchange, tx *types.Transaction, index int) *Action {
+	feeAddr, makerRate, takerRate := ety.FeeConfig()
+	return &Action{
+		coinsAccount: e.GetCoinsAccount(),
+		db:           e.GetStateDB(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       e.GetHeight(),
+		index:        index,
+		kvIndex:      make(map[string]int),
+		feeAddr:      feeAddr,
+		makerRate:    makerRate,
+		takerRate:    takerRate,
+		whitelist:    ety.PairWhitelistEnabled(),
+	}
+}
+
+//assetAccount coins用执行器的coins账户，其他资产按执行器和symbol创建账户
+func (a *Action) assetAccount(asset *ety.ExchangeAsset) (*account.DB, error) {
+	if asset.Execer == "coins" {
+		return a.coinsAccount, nil
+	}
+	return account.NewAccountDB(asset.Execer, asset.Symbol, a.db)
+}
+
+func (a *Action) addKV(kv *types.KeyValue) {
+	if i, ok := a.kvIndex[string(kv.Key)]; ok {
+		a.kvs[i] = kv
+		return
+	}
+	a.kvIndex[string(kv.Key)] = len(a.kvs)
+	a.kvs = append(a.kvs, kv)
+}
+
+func (a *Action) set(key, value []byte) {
+	a.db.Set(key, value)
+	a.addKV(&types.KeyValue{Key: key, Value: value})
+}
+
+func (a *Action) merge(receipt *types.Receipt) {
+	for _, kv := range receipt.KV {
+		a.addKV(kv)
+	}
+	a.logs = append(a.logs, receipt.Logs...)
+}
+
+func (a *Action) receipt() *types.Receipt {
+	return &types.Receipt{Ty: types.ExecOk, KV: a.kvs, Logs: a.logs}
+}
+
+//transferFrozen 从冻结的资产中转账，金额为0的时候不转账，转给自己的时候解冻
+func (a *Action) transferFrozen(acc *account.DB, from, to string, amount int64) error {
+	if amount == 0 {
+		return nil
+	}
+	var receipt *types.Receipt
+	var err error
+	if from == to {
+		receipt, err = acc.ExecActive(from, a.execaddr, amount)
+	} else {
+		receipt, err = acc.ExecTransferFrozen(from, to, a.execaddr, amount)
+	}
+	if err != nil {
+		return err
+	}
+	a.merge(receipt)
+	return nil
+}
+
+func (a *Action) active(acc *account.DB, addr string, amount int64) error {
+	if amount == 0 {
+		return nil
+	}
+	receipt, err := acc.ExecActive(addr, a.execaddr, amount)
+	if err != nil {
+		return err
+	}
+	a.merge(receipt)
+	return nil
+}
+
+func getPairWhitelist(db dbm.KV, height int64) ([]string, error) {
+	value, err := db.Get([]byte(types.ManageKey(ety.PairWhitelistKey)))
+	if err != nil || value == nil {
+		value, err = db.Get([]byte(types.ConfigKey(ety.PairWhitelistKey)))
+	}
+	if err != nil || value == nil {
+		return nil, nil
+	}
+	var item types.ConfigItem
+	err = types.Decode(value, &item)
+	if err != nil {
+		return nil, err
+	}
+	return types.GetConfigValues(&item, height), nil
+}
+
+//checkPair 开启白名单的时候交易对必须在manage合约的配置中
+func (a *Action) checkPair(pair string) error {
+	if !a.whitelist {
+		return nil
+	}
+	pairs, err := getPairWhitelist(a.db, a.height)
+	if err != nil {
+		return err
+	}
+	for _, p := range pairs {
+		if p == pair {
+			return nil
+		}
+	}
+	return ety.ErrPairNotAllowed
+}
+
+func getOrder(db dbm.KV, id string) (*ety.ExchangeOrder, error) {
+	value, err := db.Get(calcOrderKey(id))
+	if err != nil || len(value) == 0 {
+		return nil, ety.ErrOrderNotExist
+	}
+	var order ety.ExchangeOrder
+	if err := types.Decode(value, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (a *Action) saveOrder(prev, order *ety.ExchangeOrder) {
+	order.UpdateHeight = a.height
+	a.set(calcOrderKey(order.OrderID), types.Encode(order))
+	log := &ety.ReceiptExchangeOrder{Prev: prev, Current: order}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: ety.TyLogExchangeOrder, Log: types.Encode(log)})
+}
+
+func getPrices(db dbm.KV, pair string, op int32) ([]int64, error) {
+	value, err := db.Get(calcPricesKey(pair, op))
+	if err != nil || len(value) == 0 {
+		return nil, nil
+	}
+	var prices ety.ExchangePrices
+	if err := types.Decode(value, &prices); err != nil {
+		return nil, err
+	}
+	return prices.Prices, nil
+}
+
+func (a *Action) savePrices(pair string, op int32, prices []int64) {
+	a.set(calcPricesKey(pair, op), types.Encode(&ety.ExchangePrices{Prices: prices}))
+}
+
+func getQueue(db dbm.KV, pair string, op int32, price int64) ([]string, error) {
+	value, err := db.Get(calcQueueKey(pair, op, price))
+	if err != nil || len(value) == 0 {
+		return nil, nil
+	}
+	var queue ety.ExchangeOrderQueue
+	if err := types.Decode(value, &queue); err != nil {
+		return nil, err
+	}
+	return queue.OrderIDs, nil
+}
+
+func (a *Action) saveQueue(pair string, op int32, price int64, ids []string) {
+	a.set(calcQueueKey(pair, op, price), types.Encode(&ety.ExchangeOrderQueue{OrderIDs: ids}))
+}
+
+//bestPrice 买盘的最优价格是最高价，卖盘是最低价
+func bestPrice(prices []int64, op int32) int64 {
+	if op == ety.OpBuy {
+		return prices[len(prices)-1]
+	}
+	return prices[0]
+}
+
+func removePrice(prices []int64, price int64) []int64 {
+	i := sort.Search(len(prices), func(i int) bool { return prices[i] >= price })
+	if i < len(prices) && prices[i] == price {
+		return append(prices[:i], prices[i+1:]...)
+	}
+	return prices
+}
+
+func insertPrice(prices []int64, price int64) []int64 {
+	i := sort.Search(len(prices), func(i int) bool { return prices[i] >= price })
+	if i < len(prices) && prices[i] == price {
+		return prices
+	}
+	prices = append(prices, 0)
+	copy(prices[i+1:], prices[i:])
+	prices[i] = price
+	return prices
+}
+
+func crossed(taker *ety.ExchangeOrder, price int64) bool {
+	if taker.Op == ety.OpBuy {
+		return price <= taker.Price
+	}
+	return price >= taker.Price
+}
+
+func (a *Action) limitOrder(payload *ety.ExchangeLimitOrder) (*types.Receipt, error) {
+	base, quote := ety.NormalizeAsset(payload.Base), ety.NormalizeAsset(payload.Quote)
+	if base == nil || quote == nil || (base.Execer == quote.Execer && base.Symbol == quote.Symbol) {
+		return nil, ety.ErrAsset
+	}
+	if err := a.checkPair(ety.PairKey(base, quote)); err != nil {
+		return nil, err
+	}
+	if payload.Op != ety.OpBuy && payload.Op != ety.OpSell {
+		return nil, ety.ErrOrderOp
+	}
+	if payload.Price <= 0 {
+		return nil, ety.ErrOrderPrice
+	}
+	if payload.Amount <= 0 {
+		return nil, ety.ErrOrderAmount
+	}
+	total, err := ety.CalcQuote(payload.Amount, payload.Price)
+	if err != nil || total <= 0 {
+		return nil, ety.ErrOrderAmount
+	}
+	order := &ety.ExchangeOrder{
+		OrderID: calcOrderID(a.height, a.index),
+		Addr:    a.fromaddr,
+		Base:    base,
+		Quote:   quote,
+		Op:      payload.Op,
+		Price:   payload.Price,
+		Amount:  payload.Amount,
+		Status:  ety.StatusOrdered,
+		Height:  a.height,
+		Index:   int64(a.index),
+	}
+	//买单冻结quote资产，卖单冻结base资产
+	frozenAsset, frozen := quote, total
+	if order.Op == ety.OpSell {
+		frozenAsset, frozen = base, order.Amount
+	}
+	acc, err := a.assetAccount(frozenAsset)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.ExecFrozen(a.fromaddr, a.execaddr, frozen)
+	if err != nil {
+		return nil, err
+	}
+	a.merge(receipt)
+	order.Frozen = frozen
+	if err := a.match(order); err != nil {
+		return nil, err
+	}
+	return a.receipt(), nil
+}
+
+//match 按价格优先，同价格时间优先的顺序和对手盘撮合，成交价格是对手订单的价格
+func (a *Action) match(taker *ety.ExchangeOrder) error {
+	pair := ety.PairKey(taker.Base, taker.Quote)
+	makerOp := oppositeOp(taker.Op)
+	prices, err := getPrices(a.db, pair, makerOp)
+	if err != nil {
+		return err
+	}
+	pricesChanged := false
+	count := 0
+	for taker.Executed < taker.Amount && len(prices) > 0 && count < ety.MaxMatchCount {
+		price := bestPrice(prices, makerOp)
+		if !crossed(taker, price) {
+			break
+		}
+		queue, err := getQueue(a.db, pair, makerOp, price)
+		if err != nil {
+			return err
+		}
+		for len(queue) > 0 && taker.Executed < taker.Amount && count < ety.MaxMatchCount {
+			count++
+			maker, err := getOrder(a.db, queue[0])
+			if err != nil {
+				return err
+			}
+			//不和自己的订单成交，撤销之前的订单
+			if maker.Addr == taker.Addr {
+				if err := a.closeOrder(maker, ety.StatusRevoked); err != nil {
+					return err
+				}
+				queue = queue[1:]
+				continue
+			}
+			if err := a.trade(taker, maker, price); err != nil {
+				return err
+			}
+			if maker.Status != ety.StatusOrdered {
+				queue = queue[1:]
+			}
+		}
+		a.saveQueue(pair, makerOp, price, queue)
+		if len(queue) == 0 {
+			prices = removePrice(prices, price)
+			pricesChanged = true
+		}
+	}
+	if pricesChanged {
+		a.savePrices(pair, makerOp, prices)
+	}
+	if taker.Executed == taker.Amount {
+		return a.closeOrder(taker, ety.StatusCompleted)
+	}
+	//撮合次数达到上限的时候剩余部分可能还和对手盘交叉，不能进入订单簿
+	if count >= ety.MaxMatchCount {
+		return a.closeOrder(taker, ety.StatusRevoked)
+	}
+	return a.addToBook(pair, taker)
+}
+
+func (a *Action) addToBook(pair string, order *ety.ExchangeOrder) error {
+	prices, err := getPrices(a.db, pair, order.Op)
+	if err != nil {
+		return err
+	}
+	queue, err := getQueue(a.db, pair, order.Op, order.Price)
+	if err != nil {
+		return err
+	}
+	if len(queue) == 0 {
+		a.savePrices(pair, order.Op, insertPrice(prices, order.Price))
+	}
+	a.saveQueue(pair, order.Op, order.Price, append(queue, order.OrderID))
+	a.saveOrder(nil, order)
+	return nil
+}
+
+//trade taker和maker按maker的价格成交，手续费从各自收到的资产中扣除
+func (a *Action) trade(taker, maker *ety.ExchangeOrder, price int64) error {
+	amount := taker.Amount - taker.Executed
+	if left := maker.Amount - maker.Executed; left < amount {
+		amount = left
+	}
+	quoteAmount, err := ety.CalcQuote(amount, price)
+	if err != nil {
+		return err
+	}
+	prevMaker := *maker
+	buyer, seller := taker, maker
+	buyerRate, sellerRate := a.takerRate, a.makerRate
+	if taker.Op == ety.OpSell {
+		buyer, seller = maker, taker
+		buyerRate, sellerRate = a.makerRate, a.takerRate
+	}
+	buyerFee := ety.CalcFee(amount, buyerRate)
+	sellerFee := ety.CalcFee(quoteAmount, sellerRate)
+	baseAcc, err := a.assetAccount(taker.Base)
+	if err != nil {
+		return err
+	}
+	quoteAcc, err := a.assetAccount(taker.Quote)
+	if err != nil {
+		return err
+	}
+	if err := a.transferFrozen(baseAcc, seller.Addr, buyer.Addr, amount-buyerFee); err != nil {
+		return err
+	}
+	if err := a.transferFrozen(baseAcc, seller.Addr, a.feeAddr, buyerFee); err != nil {
+		return err
+	}
+	if err := a.transferFrozen(quoteAcc, buyer.Addr, seller.Addr, quoteAmount-sellerFee); err != nil {
+		return err
+	}
+	if err := a.transferFrozen(quoteAcc, buyer.Addr, a.feeAddr, sellerFee); err != nil {
+		return err
+	}
+	seller.Frozen -= amount
+	buyer.Frozen -= quoteAmount
+	buyer.Fee += buyerFee
+	seller.Fee += sellerFee
+	taker.Executed += amount
+	maker.Executed += amount
+
+	trade := &ety.ExchangeTrade{
+		Pair:        ety.PairKey(taker.Base, taker.Quote),
+		Price:       price,
+		Amount:      amount,
+		QuoteAmount: quoteAmount,
+		Buyer:       buyer.Addr,
+		Seller:      seller.Addr,
+		BuyOrderID:  buyer.OrderID,
+		SellOrderID: seller.OrderID,
+		TakerOp:     taker.Op,
+		BuyerFee:    buyerFee,
+		SellerFee:   sellerFee,
+		Height:      a.height,
+		Index:       int64(a.index),
+		Seq:         a.tradeSeq,
+	}
+	a.tradeSeq++
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: ety.TyLogExchangeTrade, Log: types.Encode(trade)})
+	if maker.Executed == maker.Amount {
+		return a.finishOrder(&prevMaker, maker, ety.StatusCompleted)
+	}
+	a.saveOrder(&prevMaker, maker)
+	return nil
+}
+
+//closeOrder 结束一个订单，taker 没有进入订单簿的时候prev为空
+func (a *Action) closeOrder(order *ety.ExchangeOrder, status int32) error {
+	var prev *ety.ExchangeOrder
+	if order.Height != a.height || order.Index != int64(a.index) {
+		copyOrder := *order
+		prev = &copyOrder
+	}
+	return a.finishOrder(prev, order, status)
+}
+
+//finishOrder 解冻订单剩余的冻结资产，买单按成交价成交以后会有剩余
+func (a *Action) finishOrder(prev, order *ety.ExchangeOrder, status int32) error {
+	frozenAsset := order.Quote
+	if order.Op == ety.OpSell {
+		frozenAsset = order.Base
+	}
+	acc, err := a.assetAccount(frozenAsset)
+	if err != nil {
+		return err
+	}
+	if err := a.active(acc, order.Addr, order.Frozen); err != nil {
+		return err
+	}
+	order.Frozen = 0
+	order.Status = status
+	a.saveOrder(prev, order)
+	return nil
+}
+
+func (a *Action) revokeOrder(payload *ety.ExchangeRevokeOrder) (*types.Receipt, error) {
+	order, err := getOrder(a.db, payload.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Addr != a.fromaddr {
+		return nil, ety.ErrOrderNotOwner
+	}
+	if order.Status != ety.StatusOrdered {
+		return nil, ety.ErrOrderClosed
+	}
+	pair := ety.PairKey(order.Base, order.Quote)
+	queue, err := getQueue(a.db, pair, order.Op, order.Price)
+	if err != nil {
+		return nil, err
+	}
+	for i, id := range queue {
+		if id == order.OrderID {
+			queue = append(queue[:i], queue[i+1:]...)
+			break
+		}
+	}
+	a.saveQueue(pair, order.Op, order.Price, queue)
+	if len(queue) == 0 {
+		prices, err := getPrices(a.db, pair, order.Op)
+		if err != nil {
+			return nil, err
+		}
+		a.savePrices(pair, order.Op, removePrice(prices, order.Price))
+	}
+	if err := a.closeOrder(order, ety.StatusRevoked); err != nil {
+		return nil, err
+	}
+	return a.receipt(), nil
+}
+
+func listCount(count int32) int32 {
+	if count <= 0 {
+		return ety.DefaultListCount
+	}
+	if count > ety.MaxListCount {
+		return ety.MaxListCount
+	}
+	return count
+}
+
+func listOrders(localdb dbm.KVDB, statedb dbm.KV, req *ety.ReqExchangeOrders) (*ety.ReplyExchangeOrders, error) {
+	if req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcAddrIndexKey(req.Addr, req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(addrIndexPrefix+req.Addr+"-"), key, listCount(req.Count), req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &ety.ReplyExchangeOrders{}
+	for _, value := range values {
+		order, err := getOrder(statedb, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.Orders = append(reply.Orders, order)
+		reply.PrimaryKey = order.OrderID
+	}
+	return reply, nil
+}
+
+func listTrades(localdb dbm.KVDB, req *ety.ReqExchangeTrades) (*ety.ReplyExchangeTrades, error) {
+	base, quote := ety.NormalizeAsset(req.Base), ety.NormalizeAsset(req.Quote)
+	if base == nil || quote == nil {
+		return nil, ety.ErrAsset
+	}
+	prefix := tradeIndexPrefix + ety.PairKey(base, quote) + "-"
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = []byte(prefix + req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(prefix), key, listCount(req.Count), req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &ety.ReplyExchangeTrades{}
+	for _, value := range values {
+		var trade ety.ExchangeTrade
+		if err := types.Decode(value, &trade); err != nil {
+			return nil, err
+		}
+		reply.Trades = append(reply.Trades, &trade)
+		reply.PrimaryKey = tradePrimaryKey(&trade)
+	}
+	return reply, nil
+}
+
+//depthLevels 按最优价格的顺序汇总每个价格上剩余未成交的数量
+func depthLevels(db dbm.KV, pair string, op int32, count int32) ([]*ety.ExchangePriceLevel, error) {
+	prices, err := getPrices(db, pair, op)
+	if err != nil {
+		return nil, err
+	}
+	var levels []*ety.ExchangePriceLevel
+	for len(prices) > 0 && int32(len(levels)) < count {
+		price := bestPrice(prices, op)
+		prices = removePrice(prices, price)
+		queue, err := getQueue(db, pair, op, price)
+		if err != nil {
+			return nil, err
+		}
+		level := &ety.ExchangePriceLevel{Price: price}
+		for _, id := range queue {
+			order, err := getOrder(db, id)
+			if err != nil {
+				return nil, err
+			}
+			level.Amount += order.Amount - order.Executed
+			level.Orders++
+		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+func getDepth(db dbm.KV, req *ety.ReqExchangeDepth) (*ety.ReplyExchangeDepth, error) {
+	base, quote := ety.NormalizeAsset(req.Base), ety.NormalizeAsset(req.Quote)
+	if base == nil || quote == nil {
+		return nil, ety.ErrAsset
+	}
+	pair := ety.PairKey(base, quote)
+	count := listCount(req.Count)
+	bids, err := depthLevels(db, pair, ety.OpBuy, count)
+	if err != nil {
+		return nil, err
+	}
+	asks, err := depthLevels(db, pair, ety.OpSell, count)
+	if err != nil {
+		return nil, err
+	}
+	return &ety.ReplyExchangeDepth{Bids: bids, Asks: asks}, nil
+}
@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	ety "github.com/33cn/chain33/system/dapp/exchange/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_LimitOrder 挂限价单，先和对手盘撮合，剩余的部分进入订单簿
+func (e *Exchange) Exec_LimitOrder(payload *ety.ExchangeLimitOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(e, tx, index)
+	return action.limitOrder(payload)
+}
+
+// Exec_RevokeOrder 撤销还没有完全成交的订单
+func (e *Exchange) Exec_RevokeOrder(payload *ety.ExchangeRevokeOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(e, tx, index)
+	return action.revokeOrder(payload)
+}
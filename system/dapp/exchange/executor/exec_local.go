@@ -0,0 +1,47 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	ety "github.com/33cn/chain33/system/dapp/exchange/types"
+	"github.com/33cn/chain33/types"
+)
+
+//execLocal 新订单添加地址索引，成交记录按交易对保存
+func (e *Exchange) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		switch item.Ty {
+		case ety.TyLogExchangeOrder:
+			var log ety.ReceiptExchangeOrder
+			if err := types.Decode(item.Log, &log); err != nil {
+				return nil, err
+			}
+			if log.Prev == nil {
+				kvs = append(kvs, &types.KeyValue{Key: calcAddrIndexKey(log.Current.Addr, log.Current.OrderID), Value: []byte(log.Current.OrderID)})
+			}
+		case ety.TyLogExchangeTrade:
+			var trade ety.ExchangeTrade
+			if err := types.Decode(item.Log, &trade); err != nil {
+				return nil, err
+			}
+			kvs = append(kvs, &types.KeyValue{Key: calcTradeIndexKey(trade.Pair, &trade), Value: item.Log})
+		}
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+// ExecLocal_LimitOrder 添加订单索引和成交记录
+func (e *Exchange) ExecLocal_LimitOrder(payload *ety.ExchangeLimitOrder, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return e.execLocal(tx, receipt)
+}
+
+// ExecLocal_RevokeOrder 撤单不会产生新的索引
+func (e *Exchange) ExecLocal_RevokeOrder(payload *ety.ExchangeRevokeOrder, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return e.execLocal(tx, receipt)
+}
@@ -0,0 +1,39 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	ety "github.com/33cn/chain33/system/dapp/exchange/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetOrder 按订单号查询订单
+func (e *Exchange) Query_GetOrder(in *types.ReqString) (types.Message, error) {
+	return getOrder(e.GetStateDB(), in.Data)
+}
+
+// Query_ListOrders 列出地址的订单
+func (e *Exchange) Query_ListOrders(in *ety.ReqExchangeOrders) (types.Message, error) {
+	return listOrders(e.GetLocalDB(), e.GetStateDB(), in)
+}
+
+// Query_GetDepth 查询交易对的买卖盘深度
+func (e *Exchange) Query_GetDepth(in *ety.ReqExchangeDepth) (types.Message, error) {
+	return getDepth(e.GetStateDB(), in)
+}
+
+// Query_ListTrades 列出交易对的成交记录
+func (e *Exchange) Query_ListTrades(in *ety.ReqExchangeTrades) (types.Message, error) {
+	return listTrades(e.GetLocalDB(), in)
+}
+
+// Query_GetPairWhitelist 查询交易对白名单
+func (e *Exchange) Query_GetPairWhitelist(in *types.ReqNil) (types.Message, error) {
+	pairs, err := getPairWhitelist(e.GetStateDB(), e.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	return &ety.ReplyExchangePairs{Whitelist: ety.PairWhitelistEnabled(), Pairs: pairs}, nil
+}
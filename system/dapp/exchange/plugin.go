@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package exchange 链上订单簿交易执行器插件
+// 1. 用户先把资产转入exchange执行器，挂限价单的时候冻结要卖出的资产
+// 2. 新订单按价格优先、时间优先和对手盘撮合，按对手订单的价格成交，剩余部分进入订单簿
+// 3. 本地数据库按地址索引订单，按交易对保存成交记录
+package exchange
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/exchange/commands"
+	"github.com/33cn/chain33/system/dapp/exchange/executor"
+	"github.com/33cn/chain33/system/dapp/exchange/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.ExchangeX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.ExchangeCmd,
+		RPC:      nil,
+	})
+}
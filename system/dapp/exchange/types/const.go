@@ -0,0 +1,50 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// exchange action ty
+const (
+	ExchangeActionLimitOrder = iota + 1
+	ExchangeActionRevokeOrder
+)
+
+// exchange log ty
+const (
+	TyLogExchangeOrder = 540
+	TyLogExchangeTrade = 541
+)
+
+// 买卖方向
+const (
+	OpBuy  = 1
+	OpSell = 2
+)
+
+// 订单状态
+const (
+	StatusOrdered = iota
+	StatusCompleted
+	StatusRevoked
+)
+
+// PairWhitelistKey manage合约中配置的交易对白名单，每一项是PairKey生成的名字，比如 coins:bty/token:CCNY
+const PairWhitelistKey = "exchange-pairs"
+
+// query func name
+const (
+	FuncNameGetOrder         = "GetOrder"
+	FuncNameListOrders       = "ListOrders"
+	FuncNameGetDepth         = "GetDepth"
+	FuncNameListTrades       = "ListTrades"
+	FuncNameGetPairWhitelist = "GetPairWhitelist"
+	//PriceBase 价格的精度
+	PriceBase = 100000000
+	//FeeRateBase 手续费率的精度，配置的费率为 rate/FeeRateBase
+	FeeRateBase = 100000
+	//MaxMatchCount 一个订单最多撮合的对手订单数，超过的部分撤销
+	MaxMatchCount    = 100
+	DefaultListCount = 20
+	MaxListCount     = 100
+)
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrAsset 交易对的资产不合法
+	ErrAsset = errors.New("ErrAsset")
+	// ErrOrderOp 买卖方向不合法
+	ErrOrderOp = errors.New("ErrOrderOp")
+	// ErrOrderPrice 价格不合法
+	ErrOrderPrice = errors.New("ErrOrderPrice")
+	// ErrOrderAmount 数量不合法，或者成交金额太小
+	ErrOrderAmount = errors.New("ErrOrderAmount")
+	// ErrOrderNotExist 订单不存在
+	ErrOrderNotExist = errors.New("ErrOrderNotExist")
+	// ErrOrderNotOwner 不是订单的创建者
+	ErrOrderNotOwner = errors.New("ErrOrderNotOwner")
+	// ErrOrderClosed 订单已经成交或者撤销
+	ErrOrderClosed = errors.New("ErrOrderClosed")
+	// ErrPairNotAllowed 交易对不在白名单中
+	ErrPairNotAllowed = errors.New("ErrPairNotAllowed")
+)
@@ -0,0 +1,1262 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: exchange.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ExchangeAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*ExchangeAction_LimitOrder
+	//	*ExchangeAction_RevokeOrder
+	Value                isExchangeAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                  `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ExchangeAction) Reset()         { *m = ExchangeAction{} }
+func (m *ExchangeAction) String() string { return proto.CompactTextString(m) }
+func (*ExchangeAction) ProtoMessage()    {}
+func (*ExchangeAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{0}
+}
+
+func (m *ExchangeAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeAction.Unmarshal(m, b)
+}
+func (m *ExchangeAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeAction.Marshal(b, m, deterministic)
+}
+func (m *ExchangeAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeAction.Merge(m, src)
+}
+func (m *ExchangeAction) XXX_Size() int {
+	return xxx_messageInfo_ExchangeAction.Size(m)
+}
+func (m *ExchangeAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeAction proto.InternalMessageInfo
+
+type isExchangeAction_Value interface {
+	isExchangeAction_Value()
+}
+
+type ExchangeAction_LimitOrder struct {
+	LimitOrder *ExchangeLimitOrder `protobuf:"bytes,1,opt,name=limitOrder,proto3,oneof"`
+}
+
+type ExchangeAction_RevokeOrder struct {
+	RevokeOrder *ExchangeRevokeOrder `protobuf:"bytes,2,opt,name=revokeOrder,proto3,oneof"`
+}
+
+func (*ExchangeAction_LimitOrder) isExchangeAction_Value() {}
+
+func (*ExchangeAction_RevokeOrder) isExchangeAction_Value() {}
+
+func (m *ExchangeAction) GetValue() isExchangeAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ExchangeAction) GetLimitOrder() *ExchangeLimitOrder {
+	if x, ok := m.GetValue().(*ExchangeAction_LimitOrder); ok {
+		return x.LimitOrder
+	}
+	return nil
+}
+
+func (m *ExchangeAction) GetRevokeOrder() *ExchangeRevokeOrder {
+	if x, ok := m.GetValue().(*ExchangeAction_RevokeOrder); ok {
+		return x.RevokeOrder
+	}
+	return nil
+}
+
+func (m *ExchangeAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ExchangeAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ExchangeAction_OneofMarshaler, _ExchangeAction_OneofUnmarshaler, _ExchangeAction_OneofSizer, []interface{}{
+		(*ExchangeAction_LimitOrder)(nil),
+		(*ExchangeAction_RevokeOrder)(nil),
+	}
+}
+
+func _ExchangeAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ExchangeAction)
+	// value
+	switch x := m.Value.(type) {
+	case *ExchangeAction_LimitOrder:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LimitOrder); err != nil {
+			return err
+		}
+	case *ExchangeAction_RevokeOrder:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RevokeOrder); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ExchangeAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ExchangeAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ExchangeAction)
+	switch tag {
+	case 1: // value.limitOrder
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ExchangeLimitOrder)
+		err := b.DecodeMessage(msg)
+		m.Value = &ExchangeAction_LimitOrder{msg}
+		return true, err
+	case 2: // value.revokeOrder
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ExchangeRevokeOrder)
+		err := b.DecodeMessage(msg)
+		m.Value = &ExchangeAction_RevokeOrder{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ExchangeAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ExchangeAction)
+	// value
+	switch x := m.Value.(type) {
+	case *ExchangeAction_LimitOrder:
+		s := proto.Size(x.LimitOrder)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExchangeAction_RevokeOrder:
+		s := proto.Size(x.RevokeOrder)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//交易对中的资产，coins的symbol为空的时候使用默认的symbol
+type ExchangeAsset struct {
+	Execer               string   `protobuf:"bytes,1,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangeAsset) Reset()         { *m = ExchangeAsset{} }
+func (m *ExchangeAsset) String() string { return proto.CompactTextString(m) }
+func (*ExchangeAsset) ProtoMessage()    {}
+func (*ExchangeAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{1}
+}
+
+func (m *ExchangeAsset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeAsset.Unmarshal(m, b)
+}
+func (m *ExchangeAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeAsset.Marshal(b, m, deterministic)
+}
+func (m *ExchangeAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeAsset.Merge(m, src)
+}
+func (m *ExchangeAsset) XXX_Size() int {
+	return xxx_messageInfo_ExchangeAsset.Size(m)
+}
+func (m *ExchangeAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeAsset proto.InternalMessageInfo
+
+func (m *ExchangeAsset) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ExchangeAsset) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+//限价单，用quote资产买卖base资产，价格是一个base资产换多少quote资产，精度为1e8
+type ExchangeLimitOrder struct {
+	Base                 *ExchangeAsset `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote                *ExchangeAsset `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Op                   int32          `protobuf:"varint,3,opt,name=op,proto3" json:"op,omitempty"`
+	Price                int64          `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               int64          `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExchangeLimitOrder) Reset()         { *m = ExchangeLimitOrder{} }
+func (m *ExchangeLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*ExchangeLimitOrder) ProtoMessage()    {}
+func (*ExchangeLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{2}
+}
+
+func (m *ExchangeLimitOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeLimitOrder.Unmarshal(m, b)
+}
+func (m *ExchangeLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeLimitOrder.Marshal(b, m, deterministic)
+}
+func (m *ExchangeLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeLimitOrder.Merge(m, src)
+}
+func (m *ExchangeLimitOrder) XXX_Size() int {
+	return xxx_messageInfo_ExchangeLimitOrder.Size(m)
+}
+func (m *ExchangeLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeLimitOrder proto.InternalMessageInfo
+
+func (m *ExchangeLimitOrder) GetBase() *ExchangeAsset {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExchangeLimitOrder) GetQuote() *ExchangeAsset {
+	if m != nil {
+		return m.Quote
+	}
+	return nil
+}
+
+func (m *ExchangeLimitOrder) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+func (m *ExchangeLimitOrder) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ExchangeLimitOrder) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ExchangeRevokeOrder struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangeRevokeOrder) Reset()         { *m = ExchangeRevokeOrder{} }
+func (m *ExchangeRevokeOrder) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRevokeOrder) ProtoMessage()    {}
+func (*ExchangeRevokeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{3}
+}
+
+func (m *ExchangeRevokeOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeRevokeOrder.Unmarshal(m, b)
+}
+func (m *ExchangeRevokeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeRevokeOrder.Marshal(b, m, deterministic)
+}
+func (m *ExchangeRevokeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRevokeOrder.Merge(m, src)
+}
+func (m *ExchangeRevokeOrder) XXX_Size() int {
+	return xxx_messageInfo_ExchangeRevokeOrder.Size(m)
+}
+func (m *ExchangeRevokeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRevokeOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRevokeOrder proto.InternalMessageInfo
+
+func (m *ExchangeRevokeOrder) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+//frozen 是订单剩余冻结的资产，买单冻结quote资产，卖单冻结base资产
+type ExchangeOrder struct {
+	OrderID              string         `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Addr                 string         `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Base                 *ExchangeAsset `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	Quote                *ExchangeAsset `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	Op                   int32          `protobuf:"varint,5,opt,name=op,proto3" json:"op,omitempty"`
+	Price                int64          `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               int64          `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Executed             int64          `protobuf:"varint,8,opt,name=executed,proto3" json:"executed,omitempty"`
+	Frozen               int64          `protobuf:"varint,9,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Status               int32          `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	Height               int64          `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64          `protobuf:"varint,12,opt,name=index,proto3" json:"index,omitempty"`
+	Fee                  int64          `protobuf:"varint,13,opt,name=fee,proto3" json:"fee,omitempty"`
+	UpdateHeight         int64          `protobuf:"varint,14,opt,name=updateHeight,proto3" json:"updateHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExchangeOrder) Reset()         { *m = ExchangeOrder{} }
+func (m *ExchangeOrder) String() string { return proto.CompactTextString(m) }
+func (*ExchangeOrder) ProtoMessage()    {}
+func (*ExchangeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{4}
+}
+
+func (m *ExchangeOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeOrder.Unmarshal(m, b)
+}
+func (m *ExchangeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeOrder.Marshal(b, m, deterministic)
+}
+func (m *ExchangeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeOrder.Merge(m, src)
+}
+func (m *ExchangeOrder) XXX_Size() int {
+	return xxx_messageInfo_ExchangeOrder.Size(m)
+}
+func (m *ExchangeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeOrder proto.InternalMessageInfo
+
+func (m *ExchangeOrder) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *ExchangeOrder) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ExchangeOrder) GetBase() *ExchangeAsset {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExchangeOrder) GetQuote() *ExchangeAsset {
+	if m != nil {
+		return m.Quote
+	}
+	return nil
+}
+
+func (m *ExchangeOrder) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetExecuted() int64 {
+	if m != nil {
+		return m.Executed
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetFrozen() int64 {
+	if m != nil {
+		return m.Frozen
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *ExchangeOrder) GetUpdateHeight() int64 {
+	if m != nil {
+		return m.UpdateHeight
+	}
+	return 0
+}
+
+//一个价格上按时间排列的订单
+type ExchangeOrderQueue struct {
+	OrderIDs             []string `protobuf:"bytes,1,rep,name=orderIDs,proto3" json:"orderIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangeOrderQueue) Reset()         { *m = ExchangeOrderQueue{} }
+func (m *ExchangeOrderQueue) String() string { return proto.CompactTextString(m) }
+func (*ExchangeOrderQueue) ProtoMessage()    {}
+func (*ExchangeOrderQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{5}
+}
+
+func (m *ExchangeOrderQueue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeOrderQueue.Unmarshal(m, b)
+}
+func (m *ExchangeOrderQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeOrderQueue.Marshal(b, m, deterministic)
+}
+func (m *ExchangeOrderQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeOrderQueue.Merge(m, src)
+}
+func (m *ExchangeOrderQueue) XXX_Size() int {
+	return xxx_messageInfo_ExchangeOrderQueue.Size(m)
+}
+func (m *ExchangeOrderQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeOrderQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeOrderQueue proto.InternalMessageInfo
+
+func (m *ExchangeOrderQueue) GetOrderIDs() []string {
+	if m != nil {
+		return m.OrderIDs
+	}
+	return nil
+}
+
+//交易对一边的价格，按从小到大的顺序排列
+type ExchangePrices struct {
+	Prices               []int64  `protobuf:"varint,1,rep,packed,name=prices,proto3" json:"prices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangePrices) Reset()         { *m = ExchangePrices{} }
+func (m *ExchangePrices) String() string { return proto.CompactTextString(m) }
+func (*ExchangePrices) ProtoMessage()    {}
+func (*ExchangePrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{6}
+}
+
+func (m *ExchangePrices) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangePrices.Unmarshal(m, b)
+}
+func (m *ExchangePrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangePrices.Marshal(b, m, deterministic)
+}
+func (m *ExchangePrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangePrices.Merge(m, src)
+}
+func (m *ExchangePrices) XXX_Size() int {
+	return xxx_messageInfo_ExchangePrices.Size(m)
+}
+func (m *ExchangePrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangePrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangePrices proto.InternalMessageInfo
+
+func (m *ExchangePrices) GetPrices() []int64 {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+//buyerFee 是base资产，sellerFee 是quote资产
+type ExchangeTrade struct {
+	Pair                 string   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Price                int64    `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	QuoteAmount          int64    `protobuf:"varint,4,opt,name=quoteAmount,proto3" json:"quoteAmount,omitempty"`
+	Buyer                string   `protobuf:"bytes,5,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller               string   `protobuf:"bytes,6,opt,name=seller,proto3" json:"seller,omitempty"`
+	BuyOrderID           string   `protobuf:"bytes,7,opt,name=buyOrderID,proto3" json:"buyOrderID,omitempty"`
+	SellOrderID          string   `protobuf:"bytes,8,opt,name=sellOrderID,proto3" json:"sellOrderID,omitempty"`
+	TakerOp              int32    `protobuf:"varint,9,opt,name=takerOp,proto3" json:"takerOp,omitempty"`
+	BuyerFee             int64    `protobuf:"varint,10,opt,name=buyerFee,proto3" json:"buyerFee,omitempty"`
+	SellerFee            int64    `protobuf:"varint,11,opt,name=sellerFee,proto3" json:"sellerFee,omitempty"`
+	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,13,opt,name=index,proto3" json:"index,omitempty"`
+	Seq                  int32    `protobuf:"varint,14,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangeTrade) Reset()         { *m = ExchangeTrade{} }
+func (m *ExchangeTrade) String() string { return proto.CompactTextString(m) }
+func (*ExchangeTrade) ProtoMessage()    {}
+func (*ExchangeTrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{7}
+}
+
+func (m *ExchangeTrade) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeTrade.Unmarshal(m, b)
+}
+func (m *ExchangeTrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeTrade.Marshal(b, m, deterministic)
+}
+func (m *ExchangeTrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeTrade.Merge(m, src)
+}
+func (m *ExchangeTrade) XXX_Size() int {
+	return xxx_messageInfo_ExchangeTrade.Size(m)
+}
+func (m *ExchangeTrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeTrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeTrade proto.InternalMessageInfo
+
+func (m *ExchangeTrade) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *ExchangeTrade) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ExchangeTrade) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ExchangeTrade) GetQuoteAmount() int64 {
+	if m != nil {
+		return m.QuoteAmount
+	}
+	return 0
+}
+
+func (m *ExchangeTrade) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ExchangeTrade) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ExchangeTrade) GetBuyOrderID() string {
+	if m != nil {
+		return m.BuyOrderID
+	}
+	return ""
+}
+
+func (m *ExchangeTrade) GetSellOrderID() string {
+	if m != nil {
+		return m.SellOrderID
+	}
+	return ""
+}
+
+func (m *ExchangeTrade) GetTakerOp() int32 {
+	if m != nil {
+		return m.TakerOp
+	}
+	return 0
+}
+
+func (m *ExchangeTrade) GetBuyerFee() int64 {
+	if m != nil {
+		return m.BuyerFee
+	}
+	return 0
+}
+
+func (m *ExchangeTrade) GetSellerFee() int64 {
+	if m != nil {
+		return m.SellerFee
+	}
+	return 0
+}
+
+func (m *ExchangeTrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExchangeTrade) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ExchangeTrade) GetSeq() int32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ReceiptExchangeOrder struct {
+	Prev                 *ExchangeOrder `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ExchangeOrder `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReceiptExchangeOrder) Reset()         { *m = ReceiptExchangeOrder{} }
+func (m *ReceiptExchangeOrder) String() string { return proto.CompactTextString(m) }
+func (*ReceiptExchangeOrder) ProtoMessage()    {}
+func (*ReceiptExchangeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{8}
+}
+
+func (m *ReceiptExchangeOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptExchangeOrder.Unmarshal(m, b)
+}
+func (m *ReceiptExchangeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptExchangeOrder.Marshal(b, m, deterministic)
+}
+func (m *ReceiptExchangeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptExchangeOrder.Merge(m, src)
+}
+func (m *ReceiptExchangeOrder) XXX_Size() int {
+	return xxx_messageInfo_ReceiptExchangeOrder.Size(m)
+}
+func (m *ReceiptExchangeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptExchangeOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptExchangeOrder proto.InternalMessageInfo
+
+func (m *ReceiptExchangeOrder) GetPrev() *ExchangeOrder {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptExchangeOrder) GetCurrent() *ExchangeOrder {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqExchangeDepth struct {
+	Base                 *ExchangeAsset `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote                *ExchangeAsset `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Count                int32          `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReqExchangeDepth) Reset()         { *m = ReqExchangeDepth{} }
+func (m *ReqExchangeDepth) String() string { return proto.CompactTextString(m) }
+func (*ReqExchangeDepth) ProtoMessage()    {}
+func (*ReqExchangeDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{9}
+}
+
+func (m *ReqExchangeDepth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqExchangeDepth.Unmarshal(m, b)
+}
+func (m *ReqExchangeDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqExchangeDepth.Marshal(b, m, deterministic)
+}
+func (m *ReqExchangeDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqExchangeDepth.Merge(m, src)
+}
+func (m *ReqExchangeDepth) XXX_Size() int {
+	return xxx_messageInfo_ReqExchangeDepth.Size(m)
+}
+func (m *ReqExchangeDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqExchangeDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqExchangeDepth proto.InternalMessageInfo
+
+func (m *ReqExchangeDepth) GetBase() *ExchangeAsset {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReqExchangeDepth) GetQuote() *ExchangeAsset {
+	if m != nil {
+		return m.Quote
+	}
+	return nil
+}
+
+func (m *ReqExchangeDepth) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ExchangePriceLevel struct {
+	Price                int64    `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Orders               int32    `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangePriceLevel) Reset()         { *m = ExchangePriceLevel{} }
+func (m *ExchangePriceLevel) String() string { return proto.CompactTextString(m) }
+func (*ExchangePriceLevel) ProtoMessage()    {}
+func (*ExchangePriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{10}
+}
+
+func (m *ExchangePriceLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangePriceLevel.Unmarshal(m, b)
+}
+func (m *ExchangePriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangePriceLevel.Marshal(b, m, deterministic)
+}
+func (m *ExchangePriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangePriceLevel.Merge(m, src)
+}
+func (m *ExchangePriceLevel) XXX_Size() int {
+	return xxx_messageInfo_ExchangePriceLevel.Size(m)
+}
+func (m *ExchangePriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangePriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangePriceLevel proto.InternalMessageInfo
+
+func (m *ExchangePriceLevel) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ExchangePriceLevel) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ExchangePriceLevel) GetOrders() int32 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
+}
+
+//bids 价格从高到低，asks 价格从低到高
+type ReplyExchangeDepth struct {
+	Bids                 []*ExchangePriceLevel `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks                 []*ExchangePriceLevel `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReplyExchangeDepth) Reset()         { *m = ReplyExchangeDepth{} }
+func (m *ReplyExchangeDepth) String() string { return proto.CompactTextString(m) }
+func (*ReplyExchangeDepth) ProtoMessage()    {}
+func (*ReplyExchangeDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{11}
+}
+
+func (m *ReplyExchangeDepth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyExchangeDepth.Unmarshal(m, b)
+}
+func (m *ReplyExchangeDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyExchangeDepth.Marshal(b, m, deterministic)
+}
+func (m *ReplyExchangeDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyExchangeDepth.Merge(m, src)
+}
+func (m *ReplyExchangeDepth) XXX_Size() int {
+	return xxx_messageInfo_ReplyExchangeDepth.Size(m)
+}
+func (m *ReplyExchangeDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyExchangeDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyExchangeDepth proto.InternalMessageInfo
+
+func (m *ReplyExchangeDepth) GetBids() []*ExchangePriceLevel {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *ReplyExchangeDepth) GetAsks() []*ExchangePriceLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+type ReqExchangeTrades struct {
+	Base                 *ExchangeAsset `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote                *ExchangeAsset `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	PrimaryKey           string         `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32          `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32          `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReqExchangeTrades) Reset()         { *m = ReqExchangeTrades{} }
+func (m *ReqExchangeTrades) String() string { return proto.CompactTextString(m) }
+func (*ReqExchangeTrades) ProtoMessage()    {}
+func (*ReqExchangeTrades) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{12}
+}
+
+func (m *ReqExchangeTrades) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqExchangeTrades.Unmarshal(m, b)
+}
+func (m *ReqExchangeTrades) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqExchangeTrades.Marshal(b, m, deterministic)
+}
+func (m *ReqExchangeTrades) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqExchangeTrades.Merge(m, src)
+}
+func (m *ReqExchangeTrades) XXX_Size() int {
+	return xxx_messageInfo_ReqExchangeTrades.Size(m)
+}
+func (m *ReqExchangeTrades) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqExchangeTrades.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqExchangeTrades proto.InternalMessageInfo
+
+func (m *ReqExchangeTrades) GetBase() *ExchangeAsset {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReqExchangeTrades) GetQuote() *ExchangeAsset {
+	if m != nil {
+		return m.Quote
+	}
+	return nil
+}
+
+func (m *ReqExchangeTrades) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqExchangeTrades) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqExchangeTrades) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyExchangeTrades struct {
+	Trades               []*ExchangeTrade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	PrimaryKey           string           `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplyExchangeTrades) Reset()         { *m = ReplyExchangeTrades{} }
+func (m *ReplyExchangeTrades) String() string { return proto.CompactTextString(m) }
+func (*ReplyExchangeTrades) ProtoMessage()    {}
+func (*ReplyExchangeTrades) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{13}
+}
+
+func (m *ReplyExchangeTrades) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyExchangeTrades.Unmarshal(m, b)
+}
+func (m *ReplyExchangeTrades) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyExchangeTrades.Marshal(b, m, deterministic)
+}
+func (m *ReplyExchangeTrades) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyExchangeTrades.Merge(m, src)
+}
+func (m *ReplyExchangeTrades) XXX_Size() int {
+	return xxx_messageInfo_ReplyExchangeTrades.Size(m)
+}
+func (m *ReplyExchangeTrades) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyExchangeTrades.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyExchangeTrades proto.InternalMessageInfo
+
+func (m *ReplyExchangeTrades) GetTrades() []*ExchangeTrade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *ReplyExchangeTrades) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type ReqExchangeOrders struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqExchangeOrders) Reset()         { *m = ReqExchangeOrders{} }
+func (m *ReqExchangeOrders) String() string { return proto.CompactTextString(m) }
+func (*ReqExchangeOrders) ProtoMessage()    {}
+func (*ReqExchangeOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{14}
+}
+
+func (m *ReqExchangeOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqExchangeOrders.Unmarshal(m, b)
+}
+func (m *ReqExchangeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqExchangeOrders.Marshal(b, m, deterministic)
+}
+func (m *ReqExchangeOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqExchangeOrders.Merge(m, src)
+}
+func (m *ReqExchangeOrders) XXX_Size() int {
+	return xxx_messageInfo_ReqExchangeOrders.Size(m)
+}
+func (m *ReqExchangeOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqExchangeOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqExchangeOrders proto.InternalMessageInfo
+
+func (m *ReqExchangeOrders) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqExchangeOrders) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqExchangeOrders) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqExchangeOrders) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyExchangeOrders struct {
+	Orders               []*ExchangeOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	PrimaryKey           string           `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplyExchangeOrders) Reset()         { *m = ReplyExchangeOrders{} }
+func (m *ReplyExchangeOrders) String() string { return proto.CompactTextString(m) }
+func (*ReplyExchangeOrders) ProtoMessage()    {}
+func (*ReplyExchangeOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{15}
+}
+
+func (m *ReplyExchangeOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyExchangeOrders.Unmarshal(m, b)
+}
+func (m *ReplyExchangeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyExchangeOrders.Marshal(b, m, deterministic)
+}
+func (m *ReplyExchangeOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyExchangeOrders.Merge(m, src)
+}
+func (m *ReplyExchangeOrders) XXX_Size() int {
+	return xxx_messageInfo_ReplyExchangeOrders.Size(m)
+}
+func (m *ReplyExchangeOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyExchangeOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyExchangeOrders proto.InternalMessageInfo
+
+func (m *ReplyExchangeOrders) GetOrders() []*ExchangeOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *ReplyExchangeOrders) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+//manage合约中配置的交易对白名单，whitelist为false的时候不限制交易对
+type ReplyExchangePairs struct {
+	Whitelist            bool     `protobuf:"varint,1,opt,name=whitelist,proto3" json:"whitelist,omitempty"`
+	Pairs                []string `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyExchangePairs) Reset()         { *m = ReplyExchangePairs{} }
+func (m *ReplyExchangePairs) String() string { return proto.CompactTextString(m) }
+func (*ReplyExchangePairs) ProtoMessage()    {}
+func (*ReplyExchangePairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{16}
+}
+
+func (m *ReplyExchangePairs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyExchangePairs.Unmarshal(m, b)
+}
+func (m *ReplyExchangePairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyExchangePairs.Marshal(b, m, deterministic)
+}
+func (m *ReplyExchangePairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyExchangePairs.Merge(m, src)
+}
+func (m *ReplyExchangePairs) XXX_Size() int {
+	return xxx_messageInfo_ReplyExchangePairs.Size(m)
+}
+func (m *ReplyExchangePairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyExchangePairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyExchangePairs proto.InternalMessageInfo
+
+func (m *ReplyExchangePairs) GetWhitelist() bool {
+	if m != nil {
+		return m.Whitelist
+	}
+	return false
+}
+
+func (m *ReplyExchangePairs) GetPairs() []string {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ExchangeAction)(nil), "types.ExchangeAction")
+	proto.RegisterType((*ExchangeAsset)(nil), "types.ExchangeAsset")
+	proto.RegisterType((*ExchangeLimitOrder)(nil), "types.ExchangeLimitOrder")
+	proto.RegisterType((*ExchangeRevokeOrder)(nil), "types.ExchangeRevokeOrder")
+	proto.RegisterType((*ExchangeOrder)(nil), "types.ExchangeOrder")
+	proto.RegisterType((*ExchangeOrderQueue)(nil), "types.ExchangeOrderQueue")
+	proto.RegisterType((*ExchangePrices)(nil), "types.ExchangePrices")
+	proto.RegisterType((*ExchangeTrade)(nil), "types.ExchangeTrade")
+	proto.RegisterType((*ReceiptExchangeOrder)(nil), "types.ReceiptExchangeOrder")
+	proto.RegisterType((*ReqExchangeDepth)(nil), "types.ReqExchangeDepth")
+	proto.RegisterType((*ExchangePriceLevel)(nil), "types.ExchangePriceLevel")
+	proto.RegisterType((*ReplyExchangeDepth)(nil), "types.ReplyExchangeDepth")
+	proto.RegisterType((*ReqExchangeTrades)(nil), "types.ReqExchangeTrades")
+	proto.RegisterType((*ReplyExchangeTrades)(nil), "types.ReplyExchangeTrades")
+	proto.RegisterType((*ReqExchangeOrders)(nil), "types.ReqExchangeOrders")
+	proto.RegisterType((*ReplyExchangeOrders)(nil), "types.ReplyExchangeOrders")
+	proto.RegisterType((*ReplyExchangePairs)(nil), "types.ReplyExchangePairs")
+}
+
+func init() { proto.RegisterFile("exchange.proto", fileDescriptor_e0328a4f16f87ea1) }
+
+var fileDescriptor_e0328a4f16f87ea1 = []byte{
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5d, 0x8f, 0xdc, 0x34,
+	0x14, 0x6d, 0xbe, 0x66, 0x36, 0x77, 0x3f, 0x54, 0xdc, 0x55, 0x15, 0x2a, 0x54, 0x8d, 0xfc, 0x34,
+	0x42, 0x65, 0x41, 0xf0, 0x88, 0x04, 0x2a, 0x2a, 0x68, 0x10, 0x95, 0xb6, 0x58, 0x3c, 0xf1, 0x96,
+	0x49, 0x6e, 0x3b, 0xd1, 0x66, 0x26, 0x59, 0xc7, 0x19, 0x36, 0x20, 0x21, 0xf1, 0x67, 0x10, 0x7f,
+	0x82, 0xdf, 0xc5, 0x2b, 0xf2, 0xb5, 0xf3, 0x35, 0x9b, 0x6a, 0xfa, 0xb2, 0x6f, 0x3e, 0xd7, 0xc7,
+	0xf1, 0xbd, 0xe7, 0x1e, 0xdb, 0x81, 0x0b, 0xbc, 0x4b, 0x36, 0xf1, 0xee, 0x1d, 0x5e, 0x95, 0xb2,
+	0x50, 0x05, 0x0b, 0x54, 0x53, 0x62, 0xc5, 0xff, 0x76, 0xe0, 0xe2, 0x7b, 0x3b, 0xf3, 0x32, 0x51,
+	0x59, 0xb1, 0x63, 0x5f, 0x03, 0xe4, 0xd9, 0x36, 0x53, 0xd7, 0x32, 0x45, 0x19, 0x39, 0x0b, 0x67,
+	0x79, 0xfa, 0xe5, 0xc7, 0x57, 0x44, 0xbf, 0x6a, 0xa9, 0xaf, 0x3b, 0xc2, 0xea, 0x91, 0x18, 0xd0,
+	0xd9, 0x37, 0x70, 0x2a, 0x71, 0x5f, 0xdc, 0xa0, 0x59, 0xed, 0xd2, 0xea, 0x67, 0x07, 0xab, 0x45,
+	0xcf, 0x58, 0x3d, 0x12, 0xc3, 0x05, 0xec, 0x02, 0x5c, 0xd5, 0x44, 0xde, 0xc2, 0x59, 0x06, 0xc2,
+	0x55, 0xcd, 0x77, 0x73, 0x08, 0xf6, 0x71, 0x5e, 0x23, 0xff, 0x16, 0xce, 0xbb, 0x3c, 0xab, 0x0a,
+	0x15, 0x7b, 0x0a, 0x33, 0xbc, 0xc3, 0xc4, 0xa6, 0x18, 0x0a, 0x8b, 0x74, 0xbc, 0x6a, 0xb6, 0xeb,
+	0x22, 0xa7, 0xcd, 0x43, 0x61, 0x11, 0xff, 0xc7, 0x01, 0x76, 0x3f, 0x7d, 0xb6, 0x04, 0x7f, 0x1d,
+	0x57, 0x68, 0xeb, 0xbc, 0x3c, 0xc8, 0x94, 0xb6, 0x12, 0xc4, 0x60, 0x9f, 0x42, 0x70, 0x5b, 0x17,
+	0x0a, 0x6d, 0x51, 0xd3, 0x54, 0x43, 0xd1, 0x65, 0x14, 0x65, 0x5b, 0x46, 0x51, 0xb2, 0x4b, 0x08,
+	0x4a, 0x99, 0x25, 0x18, 0xf9, 0x0b, 0x67, 0xe9, 0x09, 0x03, 0x74, 0xaa, 0xf1, 0xb6, 0xa8, 0x77,
+	0x2a, 0x0a, 0x28, 0x6c, 0x11, 0xff, 0x1c, 0x9e, 0x4c, 0x48, 0xc5, 0x22, 0x98, 0x17, 0x7a, 0xf0,
+	0xe3, 0x2b, 0x5b, 0x72, 0x0b, 0xf9, 0x5f, 0x5e, 0xaf, 0xce, 0x11, 0x2e, 0x63, 0xe0, 0xc7, 0x69,
+	0x2a, 0xad, 0x3a, 0x34, 0xee, 0x44, 0xf0, 0x3e, 0x5c, 0x04, 0xff, 0x43, 0x45, 0x08, 0xee, 0x8b,
+	0x30, 0x9b, 0x16, 0x61, 0x3e, 0x14, 0x81, 0x3d, 0x83, 0x13, 0xdd, 0xd1, 0x5a, 0x61, 0x1a, 0x9d,
+	0xd0, 0x4c, 0x87, 0xf5, 0x9a, 0xb7, 0xb2, 0xf8, 0x1d, 0x77, 0x51, 0x68, 0xd6, 0x18, 0x44, 0xbd,
+	0x57, 0xb1, 0xaa, 0xab, 0x08, 0x68, 0x57, 0x8b, 0x74, 0x7c, 0x83, 0xd9, 0xbb, 0x8d, 0x8a, 0x4e,
+	0x0d, 0xdf, 0x20, 0x9d, 0x51, 0xb6, 0x4b, 0xf1, 0x2e, 0x3a, 0x33, 0x19, 0x11, 0x60, 0x8f, 0xc1,
+	0x7b, 0x8b, 0x18, 0x9d, 0x53, 0x4c, 0x0f, 0x19, 0x87, 0xb3, 0xba, 0x4c, 0x63, 0x85, 0x2b, 0xf3,
+	0x95, 0x0b, 0x9a, 0x1a, 0xc5, 0xf8, 0x17, 0xbd, 0xbd, 0xa8, 0x05, 0x3f, 0xd7, 0x58, 0xa3, 0xae,
+	0xc2, 0x0a, 0x5f, 0x45, 0xce, 0xc2, 0x5b, 0x86, 0xa2, 0xc3, 0x7c, 0xd9, 0x1f, 0xbd, 0x37, 0x5a,
+	0x0a, 0xca, 0x93, 0x44, 0x31, 0x5c, 0x4f, 0x58, 0xc4, 0xff, 0x73, 0xfb, 0xfe, 0xfe, 0x22, 0xe3,
+	0x14, 0x75, 0x17, 0xcb, 0x38, 0x6b, 0xbd, 0x4f, 0xe3, 0x5e, 0x5f, 0x77, 0x5a, 0x5f, 0x6f, 0xa4,
+	0xef, 0x02, 0x4e, 0xa9, 0x4d, 0x2f, 0xcd, 0xa4, 0x31, 0xe6, 0x30, 0xa4, 0xbf, 0xb7, 0xae, 0x1b,
+	0x94, 0xd4, 0xc2, 0x50, 0x18, 0x40, 0x1a, 0x63, 0x9e, 0xa3, 0xa4, 0x36, 0xea, 0xf3, 0x45, 0x88,
+	0x3d, 0x07, 0x58, 0xd7, 0xcd, 0xb5, 0x35, 0xdd, 0x9c, 0xe6, 0x06, 0x11, 0xbd, 0x9f, 0x66, 0xb6,
+	0x84, 0x13, 0x22, 0x0c, 0x43, 0xda, 0xb3, 0x2a, 0xbe, 0x41, 0x79, 0x5d, 0x52, 0x5b, 0x03, 0xd1,
+	0x42, 0xad, 0x22, 0x6d, 0xfe, 0x03, 0x22, 0x75, 0xd6, 0x13, 0x1d, 0x66, 0x9f, 0x40, 0x68, 0x32,
+	0xd0, 0x93, 0xa6, 0xbd, 0x7d, 0x60, 0xd0, 0xf9, 0xb3, 0xe9, 0xce, 0x9f, 0x1f, 0x74, 0xbe, 0xc2,
+	0x5b, 0x6a, 0x6f, 0x20, 0xf4, 0x90, 0x97, 0x70, 0x29, 0x30, 0xc1, 0xac, 0x54, 0xe3, 0xf3, 0xb5,
+	0x04, 0xbf, 0x94, 0xb8, 0x7f, 0xcf, 0xb5, 0x41, 0x1c, 0x41, 0x0c, 0x76, 0x05, 0xf3, 0xa4, 0x96,
+	0x12, 0x77, 0xea, 0x3d, 0x17, 0x87, 0x21, 0xb7, 0x24, 0xfe, 0x27, 0x3c, 0x16, 0x78, 0xdb, 0x4e,
+	0xbe, 0xc2, 0x52, 0x6d, 0x1e, 0xe8, 0x92, 0xba, 0x84, 0x20, 0xe9, 0x8c, 0x11, 0x08, 0x03, 0xf8,
+	0xaf, 0xbd, 0x8f, 0xc9, 0x95, 0xaf, 0x71, 0x8f, 0x79, 0xef, 0x2d, 0x67, 0xda, 0x5b, 0xee, 0xc8,
+	0x5b, 0x4f, 0x61, 0x46, 0x2e, 0xaf, 0xec, 0xa7, 0x2d, 0xe2, 0x12, 0x98, 0xc0, 0x32, 0x6f, 0xc6,
+	0xd5, 0x7d, 0x06, 0xfe, 0x3a, 0x4b, 0x8d, 0xe7, 0xef, 0x3f, 0x35, 0x7d, 0x12, 0x82, 0x68, 0x9a,
+	0x1e, 0x57, 0x37, 0x55, 0xe4, 0x1e, 0xa5, 0x6b, 0x1a, 0xff, 0xd7, 0x81, 0x8f, 0x06, 0x82, 0xd2,
+	0xf1, 0xa9, 0x1e, 0x48, 0xd1, 0xe7, 0x00, 0xa5, 0xcc, 0xb6, 0xb1, 0x6c, 0x7e, 0x42, 0xf3, 0x8a,
+	0x85, 0x62, 0x10, 0xe9, 0x15, 0xf7, 0x07, 0x8a, 0x6b, 0x07, 0xa7, 0x99, 0x44, 0x7a, 0x7d, 0xed,
+	0x75, 0xd9, 0x07, 0x78, 0x02, 0x4f, 0x46, 0x9a, 0xd9, 0x02, 0x5e, 0xc0, 0x4c, 0xd1, 0xc8, 0xca,
+	0x76, 0x98, 0x17, 0xd1, 0x84, 0xe5, 0x1c, 0x24, 0xe6, 0x1e, 0x26, 0xc6, 0xff, 0x18, 0x69, 0x44,
+	0x8e, 0xac, 0xba, 0x97, 0xc2, 0x19, 0xbc, 0x14, 0x47, 0x3e, 0x34, 0xed, 0xa9, 0x71, 0x85, 0xfe,
+	0xb1, 0x0a, 0xed, 0xf6, 0x2f, 0x3a, 0x13, 0x4d, 0x57, 0x68, 0xce, 0x8d, 0xe5, 0x1c, 0xad, 0x70,
+	0x75, 0x60, 0xbd, 0x37, 0x71, 0x26, 0x2b, 0x9d, 0xd8, 0x6f, 0x9b, 0x4c, 0x61, 0x9e, 0x55, 0x8a,
+	0xea, 0x3c, 0x11, 0x7d, 0x80, 0x4c, 0xaf, 0x69, 0x64, 0xb5, 0x50, 0x18, 0xb0, 0x9e, 0xd1, 0x0f,
+	0xd4, 0x57, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x5a, 0x54, 0x02, 0x52, 0x09, 0x00, 0x00,
+}
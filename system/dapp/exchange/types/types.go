@@ -0,0 +1,125 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types exchange插件相关的定义
+package types
+
+import (
+	"math/big"
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// ExchangeX 执行器名称
+	ExchangeX  = "exchange"
+	actionName = map[string]int32{
+		"LimitOrder":  ExchangeActionLimitOrder,
+		"RevokeOrder": ExchangeActionRevokeOrder,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogExchangeOrder: {Ty: reflect.TypeOf(ReceiptExchangeOrder{}), Name: "LogExchangeOrder"},
+		TyLogExchangeTrade: {Ty: reflect.TypeOf(ExchangeTrade{}), Name: "LogExchangeTrade"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(ExchangeX))
+	types.RegistorExecutor(ExchangeX, NewType())
+	types.RegisterDappFork(ExchangeX, "Enable", 0)
+}
+
+// ExchangeType exchange执行器类型
+type ExchangeType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a exchange type object
+func NewType() *ExchangeType {
+	c := &ExchangeType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return exchange action
+func (e *ExchangeType) GetPayload() types.Message {
+	return &ExchangeAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (e *ExchangeType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (e *ExchangeType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (e *ExchangeType) GetName() string {
+	return ExchangeX
+}
+
+// NormalizeAsset coins的symbol为空的时候使用默认的symbol，coins只有默认的symbol
+func NormalizeAsset(asset *ExchangeAsset) *ExchangeAsset {
+	if asset == nil || asset.Execer == "" {
+		return nil
+	}
+	if asset.Execer == "coins" {
+		if asset.Symbol != "" && asset.Symbol != types.GetCoinSymbol() {
+			return nil
+		}
+		return &ExchangeAsset{Execer: asset.Execer, Symbol: types.GetCoinSymbol()}
+	}
+	if asset.Symbol == "" {
+		return nil
+	}
+	return &ExchangeAsset{Execer: asset.Execer, Symbol: asset.Symbol}
+}
+
+// PairKey 交易对的名字，资产需要先经过NormalizeAsset
+func PairKey(base, quote *ExchangeAsset) string {
+	return base.Execer + ":" + base.Symbol + "/" + quote.Execer + ":" + quote.Symbol
+}
+
+// CalcQuote 按价格计算base资产对应的quote资产，向下取整，溢出的时候返回ErrOrderAmount
+func CalcQuote(amount, price int64) (int64, error) {
+	v := new(big.Int).Mul(big.NewInt(amount), big.NewInt(price))
+	v.Div(v, big.NewInt(PriceBase))
+	if !v.IsInt64() {
+		return 0, ErrOrderAmount
+	}
+	return v.Int64(), nil
+}
+
+// CalcFee 按费率计算手续费，向下取整
+func CalcFee(amount, rate int64) int64 {
+	v := new(big.Int).Mul(big.NewInt(amount), big.NewInt(rate))
+	return v.Div(v, big.NewInt(FeeRateBase)).Int64()
+}
+
+// FeeConfig 手续费配置，费率超过范围的时候按0处理，没有配置收费地址的时候不收手续费
+func FeeConfig() (addr string, makerRate, takerRate int64) {
+	conf := types.ConfSub(ExchangeX)
+	addr = conf.GStr("feeAddr")
+	if addr == "" {
+		return "", 0, 0
+	}
+	makerRate = conf.GInt("makerFeeRate")
+	takerRate = conf.GInt("takerFeeRate")
+	if makerRate < 0 || makerRate >= FeeRateBase {
+		makerRate = 0
+	}
+	if takerRate < 0 || takerRate >= FeeRateBase {
+		takerRate = 0
+	}
+	return addr, makerRate, takerRate
+}
+
+// PairWhitelistEnabled 配置了pairWhitelist的时候只能在manage合约配置的交易对上挂单
+func PairWhitelistEnabled() bool {
+	return types.ConfSub(ExchangeX).IsEnable("pairWhitelist")
+}
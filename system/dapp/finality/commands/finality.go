@@ -0,0 +1,130 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands finality插件命令
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	fty "github.com/33cn/chain33/system/dapp/finality/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// FinalityCmd finality command
+func FinalityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality",
+		Short: "Finality checkpoints signed by committee",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		SignCmd(),
+		FinalizedCmd(),
+		CheckpointCmd(),
+	)
+
+	return cmd
+}
+
+// SignCmd sign checkpoint
+func SignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign",
+		Short: "Create a transaction to sign checkpoint block by committee member",
+		Run:   sign,
+	}
+	cmd.Flags().Int64P("height", "t", 0, "checkpoint block height")
+	cmd.MarkFlagRequired("height")
+	cmd.Flags().StringP("hash", "s", "", "checkpoint block hash, query from node if not set")
+	return cmd
+}
+
+func sign(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	height, _ := cmd.Flags().GetInt64("height")
+	hash, _ := cmd.Flags().GetString("hash")
+	if hash == "" {
+		rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		var res rpctypes.ReplyHash
+		err = rpc.Call("Chain33.GetBlockHash", types.ReqInt{Height: height}, &res)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		hash = res.Hash
+	}
+	blockHash, err := common.FromHex(hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &fty.FinalityAction{
+		Ty:    fty.FinalityActionSign,
+		Value: &fty.FinalityAction_Sign{Sign: &fty.FinalitySign{Height: height, Hash: blockHash}},
+	}
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	tx, err = types.FormatTx(util.GetParaExecName(paraName, fty.FinalityX), tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	jsonclient.PrintString(hex.EncodeToString(types.Encode(tx)))
+}
+
+// FinalizedCmd query last finalized checkpoint
+func FinalizedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalized",
+		Short: "Query last finalized checkpoint",
+		Run:   finalized,
+	}
+	return cmd
+}
+
+func finalized(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res fty.FinalityCheckpoint
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetFinalizedCheckpoint", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+// CheckpointCmd query checkpoint signers
+func CheckpointCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint",
+		Short: "Query signers of checkpoint at height",
+		Run:   checkpoint,
+	}
+	cmd.Flags().Int64P("height", "t", 0, "checkpoint block height")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func checkpoint(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	height, _ := cmd.Flags().GetInt64("height")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, fty.FinalityX)
+	params.FuncName = fty.FuncNameGetCheckpoint
+	params.Payload = types.MustPBToJSON(&types.ReqInt{Height: height})
+
+	var res fty.FinalityCheckpoint
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -0,0 +1,16 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	fty "github.com/33cn/chain33/system/dapp/finality/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Sign 委员会成员对检查点区块签名
+func (f *Finality) Exec_Sign(payload *fty.FinalitySign, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(f, tx, index)
+	return action.sign(payload)
+}
@@ -0,0 +1,95 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor finality执行器，记录委员会对检查点区块的签名和最终确认的检查点
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	fty "github.com/33cn/chain33/system/dapp/finality/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.finality")
+	driverName = fty.FinalityX
+	conf       = types.ConfSub(driverName)
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Finality{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newFinality, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return finality name
+func GetName() string {
+	return newFinality().GetName()
+}
+
+// Finality defines Finality object
+type Finality struct {
+	drivers.DriverBase
+}
+
+func newFinality() drivers.Driver {
+	f := &Finality{}
+	f.SetChild(f)
+	f.SetExecutorType(types.LoadExecutorType(driverName))
+	return f
+}
+
+// GetDriverName return a drivername
+func (f *Finality) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx check transaction
+func (f *Finality) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (f *Finality) CheckReceiptExecOk() bool {
+	return true
+}
+
+//getCommittee 检查点委员会成员的地址
+func getCommittee() []string {
+	if _, err := conf.G("committee"); err != nil {
+		return nil
+	}
+	return conf.GStrList("committee")
+}
+
+func isCommittee(addr string) bool {
+	for _, m := range getCommittee() {
+		if addr == m {
+			return true
+		}
+	}
+	return false
+}
+
+//getCheckpointInterval 检查点的高度必须是interval的整数倍
+func getCheckpointInterval() int64 {
+	if _, err := conf.G("checkpointInterval"); err != nil {
+		return fty.DefaultCheckpointInterval
+	}
+	interval := conf.GInt("checkpointInterval")
+	if interval <= 0 {
+		return fty.DefaultCheckpointInterval
+	}
+	return interval
+}
+
+//isQuorum 超过2/3的委员会成员签名
+func isQuorum(signers, committee int) bool {
+	return committee > 0 && signers*3 > committee*2
+}
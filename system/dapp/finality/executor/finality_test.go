@@ -0,0 +1,79 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	fty "github.com/33cn/chain33/system/dapp/finality/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendSignTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, height int64, hash []byte) int32 {
+	_, detail, err := mock33.SendCallTx(priv, fty.FinalityX, "Sign", &fty.FinalitySign{Height: height, Hash: hash})
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+func getFinalized(t *testing.T, mock33 *testnode.Chain33Mock) *fty.FinalityCheckpoint {
+	msg, err := mock33.GetAPI().Query(fty.FinalityX, fty.FuncNameGetFinalized, &types.ReqNil{})
+	assert.Nil(t, err)
+	return msg.(*fty.FinalityCheckpoint)
+}
+
+func TestFinalitySign(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	//委员会成员是 TestPrivkeyList 的前三个地址
+	for _, priv := range []crypto.PrivKey{util.TestPrivkeyList[0], util.TestPrivkeyList[2], util.TestPrivkeyList[3]} {
+		mock33.SendTx(util.CreateCoinsTx(genesis, address.PubKeyToAddress(priv.PubKey().Bytes()).String(), 100*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	hash := mock33.GetBlock(2).Hash()
+
+	ty := sendSignTx(t, mock33, genesis, 2, hash)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendSignTx(t, mock33, genesis, 2, hash)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	//不是委员会成员
+	ty = sendSignTx(t, mock33, util.TestPrivkeyList[3], 2, hash)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	//高度不是检查点
+	ty = sendSignTx(t, mock33, util.TestPrivkeyList[0], 3, mock33.GetBlock(3).Hash())
+	assert.Equal(t, int32(types.ExecPack), ty)
+	//区块哈希不在链上
+	ty = sendSignTx(t, mock33, util.TestPrivkeyList[0], 2, mock33.GetBlock(1).Hash())
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	ty = sendSignTx(t, mock33, util.TestPrivkeyList[0], 2, hash)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, int64(0), getFinalized(t, mock33).Height)
+
+	ty = sendSignTx(t, mock33, util.TestPrivkeyList[2], 2, hash)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	finalized := getFinalized(t, mock33)
+	assert.Equal(t, int64(2), finalized.Height)
+	assert.Equal(t, hash, finalized.Hash)
+	assert.Equal(t, 3, len(finalized.Signers))
+	assert.True(t, finalized.Final)
+
+	//已经最终确认的检查点不能再签名
+	ty = sendSignTx(t, mock33, util.TestPrivkeyList[2], 2, hash)
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	var checkpoint fty.FinalityCheckpoint
+	err := mock33.GetJSONC().Call("Chain33.GetFinalizedCheckpoint", &types.ReqNil{}, &checkpoint)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), checkpoint.Height)
+}
@@ -0,0 +1,134 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/33cn/chain33/client/api"
+	dbm "github.com/33cn/chain33/common/db"
+	fty "github.com/33cn/chain33/system/dapp/finality/types"
+	"github.com/33cn/chain33/types"
+)
+
+var finalizedKey = []byte("mavl-" + fty.FinalityX + "-finalized")
+
+func checkpointKey(height int64) []byte {
+	return []byte(fmt.Sprintf("mavl-%s-checkpoint-%020d", fty.FinalityX, height))
+}
+
+// Action finality交易的执行环境
+type Action struct {
+	api      api.ExecutorAPI
+	db       dbm.KV
+	fromaddr string
+	height   int64
+	index    int
+}
+
+// NewAction new a action object
+func NewAction(f *Finality, tx *types.Transaction, index int) *Action {
+	return &Action{
+		api:      f.GetExecutorAPI(),
+		db:       f.GetStateDB(),
+		fromaddr: tx.From(),
+		height:   f.GetHeight(),
+		index:    index,
+	}
+}
+
+func getCheckpointFromKey(db dbm.KV, key []byte) (*fty.FinalityCheckpoint, error) {
+	value, err := db.Get(key)
+	if err != nil || value == nil {
+		return &fty.FinalityCheckpoint{}, nil
+	}
+	var checkpoint fty.FinalityCheckpoint
+	err = types.Decode(value, &checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+func getFinalized(db dbm.KV) (*fty.FinalityCheckpoint, error) {
+	return getCheckpointFromKey(db, finalizedKey)
+}
+
+func getCheckpoint(db dbm.KV, height int64) (*fty.FinalityCheckpoint, error) {
+	return getCheckpointFromKey(db, checkpointKey(height))
+}
+
+func (a *Action) saveCheckpoint(key []byte, checkpoint *fty.FinalityCheckpoint) *types.KeyValue {
+	kv := &types.KeyValue{Key: key, Value: types.Encode(checkpoint)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func finalityReceipt(ty int32, prev, current *fty.FinalityCheckpoint) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&fty.ReceiptFinality{Prev: prev, Current: current})}
+}
+
+//checkBlockHash 检查点区块必须在当前链上
+func (a *Action) checkBlockHash(height int64, hash []byte) error {
+	details, err := a.api.GetBlockByHashes(&types.ReqHashes{Hashes: [][]byte{hash}})
+	if err != nil {
+		if a.api.IsErr() {
+			return err
+		}
+		return fty.ErrCheckpointHash
+	}
+	if len(details.Items) != 1 || details.Items[0] == nil || details.Items[0].Block.Height != height {
+		return fty.ErrCheckpointHash
+	}
+	return nil
+}
+
+func (a *Action) sign(payload *fty.FinalitySign) (*types.Receipt, error) {
+	committee := getCommittee()
+	if !isCommittee(a.fromaddr) {
+		return nil, fty.ErrNotCommittee
+	}
+	if payload.Height <= 0 || payload.Height >= a.height || payload.Height%getCheckpointInterval() != 0 {
+		return nil, fty.ErrCheckpointHeight
+	}
+	finalized, err := getFinalized(a.db)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Height <= finalized.Height {
+		return nil, fty.ErrCheckpointHeight
+	}
+	if err := a.checkBlockHash(payload.Height, payload.Hash); err != nil {
+		clog.Error("finality sign", "height", payload.Height, "addr", a.fromaddr, "err", err)
+		return nil, err
+	}
+	prev, err := getCheckpoint(a.db, payload.Height)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Height != 0 && !bytes.Equal(prev.Hash, payload.Hash) {
+		return nil, fty.ErrCheckpointHash
+	}
+	for _, signer := range prev.Signers {
+		if signer == a.fromaddr {
+			return nil, fty.ErrCheckpointSigned
+		}
+	}
+	current := &fty.FinalityCheckpoint{
+		Height:  payload.Height,
+		Hash:    payload.Hash,
+		Signers: append(append([]string{}, prev.Signers...), a.fromaddr),
+	}
+	current.Final = isQuorum(len(current.Signers), len(committee))
+
+	kv := []*types.KeyValue{a.saveCheckpoint(checkpointKey(current.Height), current)}
+	logs := []*types.ReceiptLog{finalityReceipt(fty.TyLogFinalitySign, prev, current)}
+	if current.Final {
+		kv = append(kv, a.saveCheckpoint(finalizedKey, current))
+		logs = append(logs, finalityReceipt(fty.TyLogFinalityFinalize, finalized, current))
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
@@ -0,0 +1,23 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	fty "github.com/33cn/chain33/system/dapp/finality/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetFinalized 获取最后一个最终确认的检查点，没有的时候高度为0
+func (f *Finality) Query_GetFinalized(in *types.ReqNil) (types.Message, error) {
+	return getFinalized(f.GetStateDB())
+}
+
+// Query_GetCheckpoint 获取指定高度的检查点签名情况
+func (f *Finality) Query_GetCheckpoint(in *types.ReqInt) (types.Message, error) {
+	if in.Height <= 0 || in.Height%getCheckpointInterval() != 0 {
+		return nil, fty.ErrCheckpointHeight
+	}
+	return getCheckpoint(f.GetStateDB(), in.Height)
+}
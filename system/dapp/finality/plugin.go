@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package finality 由委员会签名的最终确认检查点
+// 1. 委员会成员(exec.sub.finality.committee)每隔checkpointInterval个区块对检查点区块签名
+// 2. 超过2/3的成员对同一个区块签名以后，检查点成为最终确认的检查点
+// 3. blockchain不会回滚到最终确认的检查点之前，交易所可以按最终确认的高度确认充值
+package finality
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/finality/commands"
+	"github.com/33cn/chain33/system/dapp/finality/executor"
+	"github.com/33cn/chain33/system/dapp/finality/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.FinalityX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.FinalityCmd,
+		RPC:      nil,
+	})
+}
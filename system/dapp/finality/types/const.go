@@ -0,0 +1,24 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// finality action ty
+const (
+	FinalityActionSign = iota + 1
+)
+
+// finality log ty
+const (
+	TyLogFinalitySign     = 440
+	TyLogFinalityFinalize = 441
+)
+
+// query func name
+const (
+	FuncNameGetFinalized  = "GetFinalized"
+	FuncNameGetCheckpoint = "GetCheckpoint"
+	//DefaultCheckpointInterval 默认每隔多少个区块一个检查点
+	DefaultCheckpointInterval = 100
+)
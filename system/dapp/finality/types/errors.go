@@ -0,0 +1,18 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrNotCommittee 不是检查点委员会的成员
+	ErrNotCommittee = errors.New("ErrNotCommittee")
+	// ErrCheckpointHeight 检查点高度不合法
+	ErrCheckpointHeight = errors.New("ErrCheckpointHeight")
+	// ErrCheckpointHash 检查点区块哈希和已有签名不一致
+	ErrCheckpointHash = errors.New("ErrCheckpointHash")
+	// ErrCheckpointSigned 已经签名过这个检查点
+	ErrCheckpointSigned = errors.New("ErrCheckpointSigned")
+)
@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: finality.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type FinalityAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*FinalityAction_Sign
+	Value                isFinalityAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                  `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *FinalityAction) Reset()         { *m = FinalityAction{} }
+func (m *FinalityAction) String() string { return proto.CompactTextString(m) }
+func (*FinalityAction) ProtoMessage()    {}
+func (*FinalityAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0144d353a635b215, []int{0}
+}
+
+func (m *FinalityAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityAction.Unmarshal(m, b)
+}
+func (m *FinalityAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityAction.Marshal(b, m, deterministic)
+}
+func (m *FinalityAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityAction.Merge(m, src)
+}
+func (m *FinalityAction) XXX_Size() int {
+	return xxx_messageInfo_FinalityAction.Size(m)
+}
+func (m *FinalityAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityAction proto.InternalMessageInfo
+
+type isFinalityAction_Value interface {
+	isFinalityAction_Value()
+}
+
+type FinalityAction_Sign struct {
+	Sign *FinalitySign `protobuf:"bytes,1,opt,name=sign,proto3,oneof"`
+}
+
+func (*FinalityAction_Sign) isFinalityAction_Value() {}
+
+func (m *FinalityAction) GetValue() isFinalityAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *FinalityAction) GetSign() *FinalitySign {
+	if x, ok := m.GetValue().(*FinalityAction_Sign); ok {
+		return x.Sign
+	}
+	return nil
+}
+
+func (m *FinalityAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*FinalityAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _FinalityAction_OneofMarshaler, _FinalityAction_OneofUnmarshaler, _FinalityAction_OneofSizer, []interface{}{
+		(*FinalityAction_Sign)(nil),
+	}
+}
+
+func _FinalityAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*FinalityAction)
+	// value
+	switch x := m.Value.(type) {
+	case *FinalityAction_Sign:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sign); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("FinalityAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _FinalityAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*FinalityAction)
+	switch tag {
+	case 1: // value.sign
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(FinalitySign)
+		err := b.DecodeMessage(msg)
+		m.Value = &FinalityAction_Sign{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _FinalityAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*FinalityAction)
+	// value
+	switch x := m.Value.(type) {
+	case *FinalityAction_Sign:
+		s := proto.Size(x.Sign)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//委员会成员对检查点区块的签名，交易的签名即为成员的签名
+type FinalitySign struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalitySign) Reset()         { *m = FinalitySign{} }
+func (m *FinalitySign) String() string { return proto.CompactTextString(m) }
+func (*FinalitySign) ProtoMessage()    {}
+func (*FinalitySign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0144d353a635b215, []int{1}
+}
+
+func (m *FinalitySign) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalitySign.Unmarshal(m, b)
+}
+func (m *FinalitySign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalitySign.Marshal(b, m, deterministic)
+}
+func (m *FinalitySign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalitySign.Merge(m, src)
+}
+func (m *FinalitySign) XXX_Size() int {
+	return xxx_messageInfo_FinalitySign.Size(m)
+}
+func (m *FinalitySign) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalitySign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalitySign proto.InternalMessageInfo
+
+func (m *FinalitySign) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FinalitySign) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+//检查点，超过2/3的委员会成员签名以后成为最终确认的检查点
+type FinalityCheckpoint struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Signers              []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	Final                bool     `protobuf:"varint,4,opt,name=final,proto3" json:"final,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalityCheckpoint) Reset()         { *m = FinalityCheckpoint{} }
+func (m *FinalityCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*FinalityCheckpoint) ProtoMessage()    {}
+func (*FinalityCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0144d353a635b215, []int{2}
+}
+
+func (m *FinalityCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityCheckpoint.Unmarshal(m, b)
+}
+func (m *FinalityCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityCheckpoint.Marshal(b, m, deterministic)
+}
+func (m *FinalityCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityCheckpoint.Merge(m, src)
+}
+func (m *FinalityCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_FinalityCheckpoint.Size(m)
+}
+func (m *FinalityCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityCheckpoint proto.InternalMessageInfo
+
+func (m *FinalityCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FinalityCheckpoint) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *FinalityCheckpoint) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *FinalityCheckpoint) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+type ReceiptFinality struct {
+	Prev                 *FinalityCheckpoint `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *FinalityCheckpoint `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReceiptFinality) Reset()         { *m = ReceiptFinality{} }
+func (m *ReceiptFinality) String() string { return proto.CompactTextString(m) }
+func (*ReceiptFinality) ProtoMessage()    {}
+func (*ReceiptFinality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0144d353a635b215, []int{3}
+}
+
+func (m *ReceiptFinality) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptFinality.Unmarshal(m, b)
+}
+func (m *ReceiptFinality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptFinality.Marshal(b, m, deterministic)
+}
+func (m *ReceiptFinality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptFinality.Merge(m, src)
+}
+func (m *ReceiptFinality) XXX_Size() int {
+	return xxx_messageInfo_ReceiptFinality.Size(m)
+}
+func (m *ReceiptFinality) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptFinality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptFinality proto.InternalMessageInfo
+
+func (m *ReceiptFinality) GetPrev() *FinalityCheckpoint {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptFinality) GetCurrent() *FinalityCheckpoint {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FinalityAction)(nil), "types.FinalityAction")
+	proto.RegisterType((*FinalitySign)(nil), "types.FinalitySign")
+	proto.RegisterType((*FinalityCheckpoint)(nil), "types.FinalityCheckpoint")
+	proto.RegisterType((*ReceiptFinality)(nil), "types.ReceiptFinality")
+}
+
+func init() { proto.RegisterFile("finality.proto", fileDescriptor_0144d353a635b215) }
+
+var fileDescriptor_0144d353a635b215 = []byte{
+	// 247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x4f, 0x4b, 0xc4, 0x30,
+	0x14, 0xc4, 0xed, 0xbf, 0xad, 0x3e, 0x97, 0x0a, 0x4f, 0x91, 0x78, 0x2b, 0x3d, 0xd5, 0x83, 0x3d,
+	0xb8, 0x37, 0x6f, 0x2a, 0x88, 0xe7, 0xe8, 0x17, 0xa8, 0x25, 0x36, 0xc1, 0x25, 0x0d, 0xe9, 0x6b,
+	0xa1, 0xdf, 0x5e, 0x7c, 0x36, 0x28, 0x1e, 0x84, 0xbd, 0x65, 0xc8, 0x6f, 0x26, 0xc3, 0x04, 0x8a,
+	0x77, 0x63, 0xdb, 0xbd, 0xa1, 0xa5, 0x71, 0x7e, 0xa0, 0x01, 0x33, 0x5a, 0x9c, 0x1a, 0xab, 0x57,
+	0x28, 0x9e, 0xd6, 0x8b, 0xfb, 0x8e, 0xcc, 0x60, 0xf1, 0x1a, 0xd2, 0xd1, 0xf4, 0x56, 0x44, 0x65,
+	0x54, 0x9f, 0xde, 0x9e, 0x37, 0xcc, 0x35, 0x01, 0x7a, 0x31, 0xbd, 0x7d, 0x3e, 0x92, 0x8c, 0x60,
+	0x01, 0x31, 0x2d, 0x22, 0x2e, 0xa3, 0x3a, 0x93, 0x31, 0x2d, 0x0f, 0x39, 0x64, 0x73, 0xbb, 0x9f,
+	0x54, 0x75, 0x07, 0xdb, 0xdf, 0x06, 0xbc, 0x84, 0x8d, 0x56, 0xa6, 0xd7, 0xc4, 0xa9, 0x89, 0x5c,
+	0x15, 0x22, 0xa4, 0xba, 0x1d, 0x35, 0x47, 0x6c, 0x25, 0x9f, 0x2b, 0x07, 0x18, 0xbc, 0x8f, 0x5a,
+	0x75, 0x1f, 0x6e, 0x30, 0x96, 0x0e, 0x49, 0x40, 0x01, 0xf9, 0x57, 0x3d, 0xe5, 0x47, 0x91, 0x94,
+	0x49, 0x7d, 0x22, 0x83, 0xc4, 0x0b, 0xc8, 0x78, 0x06, 0x91, 0x96, 0x51, 0x7d, 0x2c, 0xbf, 0x45,
+	0x35, 0xc1, 0x99, 0x54, 0x9d, 0x32, 0x8e, 0xc2, 0xc3, 0x78, 0x03, 0xa9, 0xf3, 0x6a, 0x5e, 0x47,
+	0xb8, 0xfa, 0x33, 0xc2, 0x4f, 0x2f, 0xc9, 0x18, 0xee, 0x20, 0xef, 0x26, 0xef, 0x95, 0x25, 0x2e,
+	0xf2, 0xaf, 0x23, 0x90, 0x6f, 0x1b, 0xfe, 0x88, 0xdd, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd6,
+	0xc8, 0xb6, 0xd9, 0x9a, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,62 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types finality插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// FinalityX 执行器名称
+	FinalityX  = "finality"
+	actionName = map[string]int32{
+		"Sign": FinalityActionSign,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogFinalitySign:     {Ty: reflect.TypeOf(ReceiptFinality{}), Name: "LogFinalitySign"},
+		TyLogFinalityFinalize: {Ty: reflect.TypeOf(ReceiptFinality{}), Name: "LogFinalityFinalize"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(FinalityX))
+	types.RegistorExecutor(FinalityX, NewType())
+	types.RegisterDappFork(FinalityX, "Enable", 0)
+}
+
+// FinalityType finality执行器类型
+type FinalityType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a finality type object
+func NewType() *FinalityType {
+	c := &FinalityType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return finality action
+func (f *FinalityType) GetPayload() types.Message {
+	return &FinalityAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (f *FinalityType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (f *FinalityType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (f *FinalityType) GetName() string {
+	return FinalityX
+}
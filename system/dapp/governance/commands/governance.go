@@ -0,0 +1,355 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands governance插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	gty "github.com/33cn/chain33/system/dapp/governance/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// GovernanceCmd governance command
+func GovernanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "governance",
+		Aliases: []string{"gov"},
+		Short:   "On-chain governance proposal and voting",
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		ProposeCmd(),
+		VoteCmd(),
+		ExecuteCmd(),
+		UnlockCmd(),
+		QueryProposalCmd(),
+		ListProposalsCmd(),
+		QueryVoteCmd(),
+		ResultCmd(),
+		TemplatesCmd(),
+	)
+
+	return cmd
+}
+
+// ProposeCmd create proposal
+func ProposeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose",
+		Short: "Create a transaction to create text or manage config change proposal",
+		Run:   propose,
+	}
+	cmd.Flags().StringP("title", "t", "", "proposal title, generated from the config change of template if not set")
+	cmd.Flags().StringP("desc", "d", "", "proposal description")
+	cmd.Flags().StringP("mode", "m", gty.VoteModeStake, "vote mode, stake or dpos")
+	cmd.Flags().Int64P("quorum", "q", 0, "minimum total vote weight")
+	cmd.Flags().Int32P("threshold", "r", 50, "percentage of yes in yes and no to pass")
+	cmd.Flags().Int64P("end", "e", 0, "height voting ends at")
+	cmd.MarkFlagRequired("end")
+	cmd.Flags().StringP("key", "k", "", "manage config key to change, empty for text proposal")
+	cmd.Flags().StringP("value", "v", "", "manage config value")
+	cmd.Flags().StringP("op", "o", "add", "manage config operation, add or delete")
+	cmd.Flags().Int64P("exec", "x", 0, "height the config change takes effect")
+	cmd.Flags().String("template", "", "generate key and value of manage config change from template, see templates command")
+	cmd.Flags().StringArrayP("param", "p", nil, "template param in name=value format, can be repeated")
+	return cmd
+}
+
+func propose(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	desc, _ := cmd.Flags().GetString("desc")
+	mode, _ := cmd.Flags().GetString("mode")
+	quorum, _ := cmd.Flags().GetInt64("quorum")
+	threshold, _ := cmd.Flags().GetInt32("threshold")
+	end, _ := cmd.Flags().GetInt64("end")
+	key, _ := cmd.Flags().GetString("key")
+	value, _ := cmd.Flags().GetString("value")
+	op, _ := cmd.Flags().GetString("op")
+	exec, _ := cmd.Flags().GetInt64("exec")
+	template, _ := cmd.Flags().GetString("template")
+	params, _ := cmd.Flags().GetStringArray("param")
+	if template != "" {
+		var err error
+		if key, value, err = applyTemplate(template, params); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+	}
+	if title == "" && key != "" {
+		title = fmt.Sprintf("%s %s %s", op, key, value)
+	}
+	if title == "" {
+		fmt.Fprintln(os.Stderr, "title is required for text proposal")
+		return
+	}
+	payload := &gty.GovernancePropose{
+		Title:         title,
+		Description:   desc,
+		VoteMode:      mode,
+		Quorum:        quorum,
+		Threshold:     threshold,
+		EndHeight:     end,
+		ExecuteHeight: exec,
+	}
+	if key != "" {
+		payload.ParamChange = &types.ModifyConfig{Key: key, Value: value, Op: op}
+	}
+	commandtypes.CreateActionTx(cmd, gty.GovernanceX, &gty.GovernanceAction{
+		Ty:    gty.GovernanceActionPropose,
+		Value: &gty.GovernanceAction_Propose{Propose: payload},
+	})
+}
+
+// VoteCmd vote proposal
+func VoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote",
+		Short: "Create a transaction to vote proposal",
+		Run:   vote,
+	}
+	cmd.Flags().Int64P("id", "i", 0, "proposal id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().Int32P("option", "o", gty.VoteYes, "1 yes, 2 no, 3 abstain")
+	cmd.Flags().Float64P("amount", "a", 0, "coins to freeze as vote weight for stake mode")
+	return cmd
+}
+
+func vote(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetInt64("id")
+	option, _ := cmd.Flags().GetInt32("option")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	commandtypes.CreateActionTx(cmd, gty.GovernanceX, &gty.GovernanceAction{
+		Ty: gty.GovernanceActionVote,
+		Value: &gty.GovernanceAction_Vote{Vote: &gty.GovernanceVote{
+			ProposalID: id,
+			Option:     option,
+			Amount:     int64(amount*types.InputPrecision) * types.Multiple1E4,
+		}},
+	})
+}
+
+// ExecuteCmd tally and execute proposal
+func ExecuteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute",
+		Short: "Create a transaction to tally proposal and execute passed config change",
+		Run:   execute,
+	}
+	cmd.Flags().Int64P("id", "i", 0, "proposal id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func execute(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetInt64("id")
+	commandtypes.CreateActionTx(cmd, gty.GovernanceX, &gty.GovernanceAction{
+		Ty:    gty.GovernanceActionExecute,
+		Value: &gty.GovernanceAction_Execute{Execute: &gty.GovernanceExecute{ProposalID: id}},
+	})
+}
+
+// UnlockCmd unlock vote coins
+func UnlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock",
+		Short: "Create a transaction to unlock coins frozen by vote",
+		Run:   unlock,
+	}
+	cmd.Flags().Int64P("id", "i", 0, "proposal id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func unlock(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetInt64("id")
+	commandtypes.CreateActionTx(cmd, gty.GovernanceX, &gty.GovernanceAction{
+		Ty:    gty.GovernanceActionUnlock,
+		Value: &gty.GovernanceAction_Unlock{Unlock: &gty.GovernanceUnlock{ProposalID: id}},
+	})
+}
+
+func queryGovernance(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, gty.GovernanceX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryProposalCmd query proposal
+func QueryProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal",
+		Short: "Query proposal and vote tally",
+		Run:   queryProposal,
+	}
+	cmd.Flags().Int64P("id", "i", 0, "proposal id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryProposal(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetInt64("id")
+	var res gty.GovernanceProposal
+	queryGovernance(cmd, gty.FuncNameGetProposal, &types.Int64{Data: id}, &res)
+}
+
+// ListProposalsCmd list proposals
+func ListProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List proposals from newest",
+		Run:   listProposals,
+	}
+	cmd.Flags().Int64P("start", "s", 0, "start proposal id, 0 for newest")
+	cmd.Flags().Int32P("count", "c", gty.DefaultListProposalCount, "proposal count")
+	cmd.Flags().Int32P("status", "t", 0, "1 voting, 2 passed, 3 rejected, 4 executed, 0 for all")
+	return cmd
+}
+
+func listProposals(cmd *cobra.Command, args []string) {
+	start, _ := cmd.Flags().GetInt64("start")
+	count, _ := cmd.Flags().GetInt32("count")
+	status, _ := cmd.Flags().GetInt32("status")
+	var res gty.ReplyGovernanceProposals
+	queryGovernance(cmd, gty.FuncNameListProposals, &gty.ReqGovernanceProposals{Start: start, Count: count, Status: status}, &res)
+}
+
+// QueryVoteCmd query vote
+func QueryVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote_info",
+		Short: "Query vote of voter on proposal",
+		Run:   queryVote,
+	}
+	cmd.Flags().Int64P("id", "i", 0, "proposal id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("voter", "v", "", "voter address")
+	cmd.MarkFlagRequired("voter")
+	return cmd
+}
+
+func queryVote(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetInt64("id")
+	voter, _ := cmd.Flags().GetString("voter")
+	var res gty.GovernanceVoteRecord
+	queryGovernance(cmd, gty.FuncNameGetVote, &gty.ReqGovernanceVote{ProposalID: id, Voter: voter}, &res)
+}
+
+var proposalStatusName = map[int32]string{
+	gty.ProposalStatusVoting:   "voting",
+	gty.ProposalStatusPassed:   "passed",
+	gty.ProposalStatusRejected: "rejected",
+	gty.ProposalStatusExecuted: "executed",
+}
+
+//proposalResult 投票的统计结果，投票中的提案按当前的票数给出是否会通过
+type proposalResult struct {
+	ID            int64  `json:"id"`
+	Title         string `json:"title"`
+	Status        string `json:"status"`
+	ParamChange   string `json:"paramChange"`
+	Yes           string `json:"yes"`
+	No            string `json:"no"`
+	Abstain       string `json:"abstain"`
+	Total         string `json:"total"`
+	Quorum        string `json:"quorum"`
+	QuorumReached bool   `json:"quorumReached"`
+	YesPercent    string `json:"yesPercent"`
+	Threshold     int32  `json:"threshold"`
+	Passing       bool   `json:"passing"`
+	EndHeight     int64  `json:"endHeight"`
+	BlocksLeft    int64  `json:"blocksLeft"`
+	ExecuteHeight int64  `json:"executeHeight"`
+}
+
+// ResultCmd show proposal result
+func ResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "result",
+		Short: "Show vote tally of proposal, whether it reaches quorum and passes",
+		Run:   proposalResultRun,
+	}
+	cmd.Flags().Int64P("id", "i", 0, "proposal id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func proposalResultRun(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	id, _ := cmd.Flags().GetInt64("id")
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := rpctypes.Query4Jrpc{
+		Execer:   util.GetParaExecName(paraName, gty.GovernanceX),
+		FuncName: gty.FuncNameGetProposal,
+		Payload:  types.MustPBToJSON(&types.Int64{Data: id}),
+	}
+	var proposal gty.GovernanceProposal
+	if err := rpc.Call("Chain33.Query", params, &proposal); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var header rpctypes.Header
+	if err := rpc.Call("Chain33.GetLastHeader", nil, &header); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	jsonclient.PrintResult(newProposalResult(&proposal, header.Height))
+}
+
+//newProposalResult stake模式的权重是冻结的coins，按coins显示，dpos模式的权重是票数
+func newProposalResult(p *gty.GovernanceProposal, height int64) *proposalResult {
+	weight := func(w int64) string {
+		if p.VoteMode == gty.VoteModeStake {
+			return strconv.FormatFloat(float64(w)/float64(types.Coin), 'f', 4, 64)
+		}
+		return strconv.FormatInt(w, 10)
+	}
+	total := p.Yes + p.No + p.Abstain
+	res := &proposalResult{
+		ID:            p.Id,
+		Title:         p.Title,
+		Status:        proposalStatusName[p.Status],
+		Yes:           weight(p.Yes),
+		No:            weight(p.No),
+		Abstain:       weight(p.Abstain),
+		Total:         weight(total),
+		Quorum:        weight(p.Quorum),
+		QuorumReached: total > 0 && total >= p.Quorum,
+		YesPercent:    "0.00",
+		Threshold:     p.Threshold,
+		Passing:       gty.IsPassed(p),
+		EndHeight:     p.EndHeight,
+		ExecuteHeight: p.ExecuteHeight,
+	}
+	if change := p.ParamChange; change != nil {
+		res.ParamChange = fmt.Sprintf("%s %s %s", change.Op, change.Key, change.Value)
+	}
+	if p.Yes+p.No > 0 {
+		res.YesPercent = strconv.FormatFloat(float64(p.Yes)*100/float64(p.Yes+p.No), 'f', 2, 64)
+	}
+	if p.Status == gty.ProposalStatusVoting && p.EndHeight > height {
+		res.BlocksLeft = p.EndHeight - height
+	}
+	return res
+}
@@ -0,0 +1,157 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/33cn/chain33/common/address"
+	dty "github.com/33cn/chain33/system/dapp/did/types"
+	ety "github.com/33cn/chain33/system/dapp/exchange/types"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	"github.com/spf13/cobra"
+)
+
+//paramTemplate 参数修改提案的模板，由参数生成manage合约的配置项和配置值，不用手写配置值的格式
+type paramTemplate struct {
+	key    string
+	params []string
+	usage  string
+	value  func(params map[string]string) (string, error)
+}
+
+var paramTemplates = map[string]*paramTemplate{
+	"consensus-schedule": {
+		key:    mty.ConsensusScheduleKey,
+		params: []string{"height", "consensus", "maxTxNumber"},
+		usage:  "switch consensus or max tx number of block at height, set consensus or maxTxNumber",
+		value:  consensusScheduleValue,
+	},
+	"exchange-pair": {
+		key:    ety.PairWhitelistKey,
+		params: []string{"base", "quote"},
+		usage:  "exchange pair whitelist, assets in execer:symbol format, e.g. base=coins:bty quote=token:CCNY",
+		value:  exchangePairValue,
+	},
+	"oracle-publisher": {
+		key:    oty.PublisherKey,
+		params: []string{"addr"},
+		usage:  "oracle data feed publisher",
+		value:  addrValue,
+	},
+	"did-issuer": {
+		key:    dty.IssuerKey,
+		params: []string{"addr"},
+		usage:  "did claim issuer",
+		value:  addrValue,
+	},
+}
+
+func consensusScheduleValue(params map[string]string) (string, error) {
+	if params["height"] == "" {
+		return "", errors.New("height is required")
+	}
+	var value string
+	switch {
+	case params["consensus"] != "" && params["maxTxNumber"] != "":
+		return "", errors.New("set only one of consensus and maxTxNumber")
+	case params["consensus"] != "":
+		value = params["height"] + ":" + params["consensus"]
+	case params["maxTxNumber"] != "":
+		value = params["height"] + ":maxTxNumber=" + params["maxTxNumber"]
+	default:
+		return "", errors.New("consensus or maxTxNumber is required")
+	}
+	if _, err := mty.ParseConsensusSchedule(value); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+func parseExchangeAsset(s string) (*ety.ExchangeAsset, error) {
+	items := strings.SplitN(s, ":", 2)
+	if len(items) != 2 || items[0] == "" || items[1] == "" {
+		return nil, fmt.Errorf("asset %s should be execer:symbol", s)
+	}
+	return &ety.ExchangeAsset{Execer: items[0], Symbol: items[1]}, nil
+}
+
+func exchangePairValue(params map[string]string) (string, error) {
+	base, err := parseExchangeAsset(params["base"])
+	if err != nil {
+		return "", err
+	}
+	quote, err := parseExchangeAsset(params["quote"])
+	if err != nil {
+		return "", err
+	}
+	return ety.PairKey(base, quote), nil
+}
+
+func addrValue(params map[string]string) (string, error) {
+	if err := address.CheckAddress(params["addr"]); err != nil {
+		return "", fmt.Errorf("invalid addr %s: %v", params["addr"], err)
+	}
+	return params["addr"], nil
+}
+
+//applyTemplate 参数的格式为name=value，不认识的参数返回错误
+func applyTemplate(name string, args []string) (key, value string, err error) {
+	tmpl, ok := paramTemplates[name]
+	if !ok {
+		return "", "", fmt.Errorf("unknown template %s, see templates command", name)
+	}
+	params := make(map[string]string)
+	for _, arg := range args {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) != 2 {
+			return "", "", fmt.Errorf("param %s should be name=value", arg)
+		}
+		known := false
+		for _, p := range tmpl.params {
+			known = known || p == kv[0]
+		}
+		if !known {
+			return "", "", fmt.Errorf("unknown param %s of template %s, params: %s", kv[0], name, strings.Join(tmpl.params, ", "))
+		}
+		params[kv[0]] = strings.TrimSpace(kv[1])
+	}
+	value, err = tmpl.value(params)
+	if err != nil {
+		return "", "", err
+	}
+	return tmpl.key, value, nil
+}
+
+// TemplatesCmd list param change templates
+func TemplatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "List templates of manage config change proposals",
+		Run:   listTemplates,
+	}
+	return cmd
+}
+
+func listTemplates(cmd *cobra.Command, args []string) {
+	var names []string
+	for name := range paramTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TEMPLATE\tKEY\tPARAMS\tUSAGE")
+	for _, name := range names {
+		tmpl := paramTemplates[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", name, tmpl.key, strings.Join(tmpl.params, ","), tmpl.usage)
+	}
+	w.Flush()
+}
@@ -0,0 +1,34 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	gty "github.com/33cn/chain33/system/dapp/governance/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Propose 创建提案
+func (g *Governance) Exec_Propose(payload *gty.GovernancePropose, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(g, tx, index)
+	return action.propose(payload)
+}
+
+// Exec_Vote 对提案投票
+func (g *Governance) Exec_Vote(payload *gty.GovernanceVote, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(g, tx, index)
+	return action.vote(payload)
+}
+
+// Exec_Execute 投票截止以后计票，执行通过的参数修改提案
+func (g *Governance) Exec_Execute(payload *gty.GovernanceExecute, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(g, tx, index)
+	return action.execute(payload)
+}
+
+// Exec_Unlock 解冻投票冻结的coins
+func (g *Governance) Exec_Unlock(payload *gty.GovernanceUnlock, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(g, tx, index)
+	return action.unlock(payload)
+}
@@ -0,0 +1,60 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor governance执行器，负责提案的创建，投票，计票和执行
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	gty "github.com/33cn/chain33/system/dapp/governance/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.governance")
+	driverName = gty.GovernanceX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Governance{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newGovernance, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return governance name
+func GetName() string {
+	return newGovernance().GetName()
+}
+
+// Governance defines Governance object
+type Governance struct {
+	drivers.DriverBase
+}
+
+func newGovernance() drivers.Driver {
+	g := &Governance{}
+	g.SetChild(g)
+	g.SetExecutorType(types.LoadExecutorType(driverName))
+	return g
+}
+
+// GetDriverName return a drivername
+func (g *Governance) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx check transaction
+func (g *Governance) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (g *Governance) CheckReceiptExecOk() bool {
+	return true
+}
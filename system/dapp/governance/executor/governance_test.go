@@ -0,0 +1,156 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dty "github.com/33cn/chain33/system/dapp/dpos/types"
+	gty "github.com/33cn/chain33/system/dapp/governance/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, execer, action string, param types.Message) int32 {
+	_, detail, err := mock33.SendCallTx(priv, execer, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+func sendGovernanceTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) int32 {
+	return sendTx(t, mock33, priv, gty.GovernanceX, action, param)
+}
+
+func getProposal(t *testing.T, mock33 *testnode.Chain33Mock, id int64) *gty.GovernanceProposal {
+	msg, err := mock33.GetAPI().Query(gty.GovernanceX, gty.FuncNameGetProposal, &types.Int64{Data: id})
+	assert.Nil(t, err)
+	return msg.(*gty.GovernanceProposal)
+}
+
+func TestGovernanceStake(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	genesisAddr := mock33.GetGenesisAddress()
+	addr, priv := util.Genaddress()
+	execAddr := address.ExecAddress(gty.GovernanceX)
+	mock33.SendTx(util.CreateCoinsTx(genesis, addr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(genesis, execAddr, 20*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(priv, execAddr, 5*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	height := mock33.GetLastBlock().Height
+	change := &types.ModifyConfig{Key: "governance-test", Value: "v1", Op: "add"}
+	propose := &gty.GovernancePropose{
+		Title:         "add v1",
+		ParamChange:   change,
+		VoteMode:      gty.VoteModeStake,
+		Quorum:        5 * types.Coin,
+		Threshold:     60,
+		EndHeight:     height + 8,
+		ExecuteHeight: height + 14,
+	}
+	//生效高度不能小于投票截止高度
+	propose.ExecuteHeight = height
+	ty := sendGovernanceTx(t, mock33, genesis, "Propose", propose)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	propose.ExecuteHeight = height + 14
+	ty = sendGovernanceTx(t, mock33, genesis, "Propose", propose)
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	ty = sendGovernanceTx(t, mock33, genesis, "Vote", &gty.GovernanceVote{ProposalID: 1, Option: gty.VoteYes, Amount: 10 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendGovernanceTx(t, mock33, genesis, "Vote", &gty.GovernanceVote{ProposalID: 1, Option: gty.VoteNo, Amount: types.Coin})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendGovernanceTx(t, mock33, priv, "Vote", &gty.GovernanceVote{ProposalID: 1, Option: gty.VoteNo, Amount: 3 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	acc := mock33.GetExecAccount(mock33.GetLastBlock().StateHash, gty.GovernanceX, genesisAddr)
+	assert.Equal(t, 10*types.Coin, acc.Frozen)
+	proposal := getProposal(t, mock33, 1)
+	assert.Equal(t, 10*types.Coin, proposal.Yes)
+	assert.Equal(t, 3*types.Coin, proposal.No)
+
+	//投票截止之前不能计票和解冻
+	ty = sendGovernanceTx(t, mock33, genesis, "Execute", &gty.GovernanceExecute{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendGovernanceTx(t, mock33, genesis, "Unlock", &gty.GovernanceUnlock{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	assert.Nil(t, mock33.CreateBlocksTo(height+8))
+	ty = sendGovernanceTx(t, mock33, genesis, "Execute", &gty.GovernanceExecute{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, int32(gty.ProposalStatusPassed), getProposal(t, mock33, 1).Status)
+	//没有到生效高度
+	ty = sendGovernanceTx(t, mock33, genesis, "Execute", &gty.GovernanceExecute{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	assert.Nil(t, mock33.CreateBlocksTo(height+14))
+	ty = sendGovernanceTx(t, mock33, priv, "Execute", &gty.GovernanceExecute{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, int32(gty.ProposalStatusExecuted), getProposal(t, mock33, 1).Status)
+	msg, err := mock33.GetAPI().Query("manage", "GetConfigItem", &types.ReqString{Data: "governance-test"})
+	assert.Nil(t, err)
+	assert.Equal(t, "[v1]", msg.(*types.ReplyConfig).Value)
+
+	ty = sendGovernanceTx(t, mock33, genesis, "Unlock", &gty.GovernanceUnlock{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendGovernanceTx(t, mock33, genesis, "Unlock", &gty.GovernanceUnlock{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	acc = mock33.GetExecAccount(mock33.GetLastBlock().StateHash, gty.GovernanceX, genesisAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, 20*types.Coin, acc.Balance)
+
+	//其他合约不能修改manage合约的配置
+	ty = sendTx(t, mock33, genesis, "manage", "Modify", &types.ModifyConfig{Key: "governance-test", Value: "v2", Op: "add"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+}
+
+func TestGovernanceDpos(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	addr, priv := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(genesis, addr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(genesis, address.ExecAddress(dty.DposX), 100*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	ty := sendTx(t, mock33, genesis, dty.DposX, "Regist", &dty.DposRegist{Name: "genesis"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendTx(t, mock33, genesis, dty.DposX, "Vote", &dty.DposVote{Delegate: mock33.GetGenesisAddress(), Amount: 30 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	height := mock33.GetLastBlock().Height
+	ty = sendGovernanceTx(t, mock33, priv, "Propose", &gty.GovernancePropose{Title: "text", VoteMode: gty.VoteModeDpos, Threshold: 50, EndHeight: height + 4})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	//不是受托人不能投票
+	ty = sendGovernanceTx(t, mock33, priv, "Vote", &gty.GovernanceVote{ProposalID: 1, Option: gty.VoteYes})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendGovernanceTx(t, mock33, genesis, "Vote", &gty.GovernanceVote{ProposalID: 1, Option: gty.VoteNo})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 30*types.Coin, getProposal(t, mock33, 1).No)
+
+	assert.Nil(t, mock33.CreateBlocksTo(height+4))
+	ty = sendGovernanceTx(t, mock33, genesis, "Vote", &gty.GovernanceVote{ProposalID: 1, Option: gty.VoteYes})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendGovernanceTx(t, mock33, priv, "Execute", &gty.GovernanceExecute{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, int32(gty.ProposalStatusRejected), getProposal(t, mock33, 1).Status)
+	ty = sendGovernanceTx(t, mock33, priv, "Execute", &gty.GovernanceExecute{ProposalID: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	msg, err := mock33.GetAPI().Query(gty.GovernanceX, gty.FuncNameListProposals, &gty.ReqGovernanceProposals{Status: gty.ProposalStatusRejected})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*gty.ReplyGovernanceProposals).Proposals))
+}
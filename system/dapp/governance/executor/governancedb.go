@@ -0,0 +1,321 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	dpos "github.com/33cn/chain33/system/dapp/dpos/executor"
+	gty "github.com/33cn/chain33/system/dapp/governance/types"
+	manage "github.com/33cn/chain33/system/dapp/manage/executor"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	proposalCountKey  = []byte("mavl-" + gty.GovernanceX + "-count")
+	proposalKeyPrefix = "mavl-" + gty.GovernanceX + "-proposal-"
+	voteKeyPrefix     = "mavl-" + gty.GovernanceX + "-vote-"
+)
+
+func calcProposalKey(id int64) []byte {
+	return []byte(fmt.Sprintf("%s%018d", proposalKeyPrefix, id))
+}
+
+func calcVoteKey(id int64, voter string) []byte {
+	return []byte(fmt.Sprintf("%s%018d-%s", voteKeyPrefix, id, voter))
+}
+
+// Action governance交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(g *Governance, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: g.GetCoinsAccount(),
+		db:           g.GetStateDB(),
+		txhash:       tx.Hash(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       g.GetHeight(),
+		index:        index,
+	}
+}
+
+func getProposalCount(db dbm.KV) (int64, error) {
+	value, err := db.Get(proposalCountKey)
+	if err != nil || value == nil {
+		return 0, nil
+	}
+	var count types.Int64
+	err = types.Decode(value, &count)
+	if err != nil {
+		return 0, err
+	}
+	return count.Data, nil
+}
+
+func getProposal(db dbm.KV, id int64) (*gty.GovernanceProposal, error) {
+	value, err := db.Get(calcProposalKey(id))
+	if err != nil || value == nil {
+		return nil, gty.ErrProposalNotExist
+	}
+	var proposal gty.GovernanceProposal
+	err = types.Decode(value, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+func getVote(db dbm.KV, id int64, voter string) (*gty.GovernanceVoteRecord, error) {
+	value, err := db.Get(calcVoteKey(id, voter))
+	if err != nil || value == nil {
+		return nil, gty.ErrVoteNotExist
+	}
+	var vote gty.GovernanceVoteRecord
+	err = types.Decode(value, &vote)
+	if err != nil {
+		return nil, err
+	}
+	return &vote, nil
+}
+
+func listProposals(db dbm.KV, req *gty.ReqGovernanceProposals) ([]*gty.GovernanceProposal, error) {
+	total, err := getProposalCount(db)
+	if err != nil {
+		return nil, err
+	}
+	count := int(req.Count)
+	if count <= 0 {
+		count = gty.DefaultListProposalCount
+	}
+	if count > gty.MaxListProposalCount {
+		count = gty.MaxListProposalCount
+	}
+	start := req.Start
+	if start <= 0 || start > total {
+		start = total
+	}
+	var proposals []*gty.GovernanceProposal
+	for id := start; id > 0 && len(proposals) < count; id-- {
+		proposal, err := getProposal(db, id)
+		if err != nil {
+			return nil, err
+		}
+		if req.Status != 0 && proposal.Status != req.Status {
+			continue
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, nil
+}
+
+func (a *Action) saveProposal(proposal *gty.GovernanceProposal) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcProposalKey(proposal.Id), Value: types.Encode(proposal)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (a *Action) saveVote(vote *gty.GovernanceVoteRecord) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcVoteKey(vote.ProposalID, vote.Voter), Value: types.Encode(vote)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func proposalReceipt(ty int32, prev, current *gty.GovernanceProposal) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&gty.ReceiptGovernanceProposal{Prev: prev, Current: current})}
+}
+
+func voteReceipt(ty int32, prev, current *gty.GovernanceVoteRecord) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&gty.ReceiptGovernanceVote{Prev: prev, Current: current})}
+}
+
+func (a *Action) propose(payload *gty.GovernancePropose) (*types.Receipt, error) {
+	if len(payload.Title) == 0 || len(payload.Title) > gty.MaxTitleLength || len(payload.Description) > gty.MaxDescriptionLength {
+		return nil, gty.ErrTitle
+	}
+	if payload.VoteMode != gty.VoteModeStake && payload.VoteMode != gty.VoteModeDpos {
+		return nil, gty.ErrVoteMode
+	}
+	if payload.Threshold <= 0 || payload.Threshold > 100 || payload.Quorum < 0 {
+		return nil, gty.ErrThreshold
+	}
+	if payload.EndHeight <= a.height {
+		return nil, gty.ErrHeight
+	}
+	executeHeight := int64(0)
+	if change := payload.ParamChange; change != nil {
+		if len(change.Key) == 0 || (change.Op != "add" && change.Op != "delete") {
+			return nil, gty.ErrParamChange
+		}
+		if payload.ExecuteHeight < payload.EndHeight {
+			return nil, gty.ErrHeight
+		}
+		executeHeight = payload.ExecuteHeight
+	}
+	count, err := getProposalCount(a.db)
+	if err != nil {
+		return nil, err
+	}
+	proposal := &gty.GovernanceProposal{
+		Id:            count + 1,
+		Proposer:      a.fromaddr,
+		Title:         payload.Title,
+		Description:   payload.Description,
+		ParamChange:   payload.ParamChange,
+		VoteMode:      payload.VoteMode,
+		Quorum:        payload.Quorum,
+		Threshold:     payload.Threshold,
+		StartHeight:   a.height,
+		EndHeight:     payload.EndHeight,
+		ExecuteHeight: executeHeight,
+		Status:        gty.ProposalStatusVoting,
+	}
+	countKV := &types.KeyValue{Key: proposalCountKey, Value: types.Encode(&types.Int64{Data: proposal.Id})}
+	a.db.Set(countKV.Key, countKV.Value)
+	kv := []*types.KeyValue{countKV, a.saveProposal(proposal)}
+	logs := []*types.ReceiptLog{proposalReceipt(gty.TyLogGovernancePropose, nil, proposal)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//voteWeight stake模式冻结投票的coins，dpos模式只有受托人可以投票，权重是受托人的得票数
+func (a *Action) voteWeight(mode string, amount int64) (int64, *types.Receipt, error) {
+	if mode == gty.VoteModeDpos {
+		delegate, err := dpos.GetDelegate(a.db, a.fromaddr)
+		if err != nil || delegate.Votes <= 0 {
+			return 0, nil, gty.ErrNoVoteWeight
+		}
+		return delegate.Votes, nil, nil
+	}
+	if amount <= 0 {
+		return 0, nil, gty.ErrNoVoteWeight
+	}
+	receipt, err := a.coinsAccount.ExecFrozen(a.fromaddr, a.execaddr, amount)
+	if err != nil {
+		return 0, nil, err
+	}
+	return amount, receipt, nil
+}
+
+func (a *Action) vote(payload *gty.GovernanceVote) (*types.Receipt, error) {
+	proposal, err := getProposal(a.db, payload.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != gty.ProposalStatusVoting || a.height >= proposal.EndHeight {
+		return nil, gty.ErrVotingClosed
+	}
+	if payload.Option != gty.VoteYes && payload.Option != gty.VoteNo && payload.Option != gty.VoteAbstain {
+		return nil, gty.ErrVoteOption
+	}
+	if _, err := getVote(a.db, proposal.Id, a.fromaddr); err == nil {
+		return nil, gty.ErrVoted
+	}
+	weight, receipt, err := a.voteWeight(proposal.VoteMode, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	vote := &gty.GovernanceVoteRecord{
+		ProposalID: proposal.Id,
+		Voter:      a.fromaddr,
+		Option:     payload.Option,
+		Weight:     weight,
+		Height:     a.height,
+	}
+	switch payload.Option {
+	case gty.VoteYes:
+		proposal.Yes += weight
+	case gty.VoteNo:
+		proposal.No += weight
+	default:
+		proposal.Abstain += weight
+	}
+	var kv []*types.KeyValue
+	var logs []*types.ReceiptLog
+	if receipt != nil {
+		kv = append(kv, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+	}
+	kv = append(kv, a.saveVote(vote), a.saveProposal(proposal))
+	logs = append(logs, voteReceipt(gty.TyLogGovernanceVote, nil, vote))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//execute 投票截止以后先计票，通过的参数修改提案到了生效高度以后修改manage合约的配置
+func (a *Action) execute(payload *gty.GovernanceExecute) (*types.Receipt, error) {
+	proposal, err := getProposal(a.db, payload.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	if a.height < proposal.EndHeight {
+		return nil, gty.ErrVotingNotClosed
+	}
+	var kv []*types.KeyValue
+	var logs []*types.ReceiptLog
+	if proposal.Status == gty.ProposalStatusVoting {
+		prev := *proposal
+		proposal.Status = gty.ProposalStatusRejected
+		if gty.IsPassed(proposal) {
+			proposal.Status = gty.ProposalStatusPassed
+		}
+		logs = append(logs, proposalReceipt(gty.TyLogGovernanceTally, &prev, proposal))
+	}
+	if proposal.Status == gty.ProposalStatusPassed && proposal.ParamChange != nil && a.height >= proposal.ExecuteHeight {
+		receipt, err := manage.ModifyConfig(a.db, proposal.ParamChange, a.height, a.txhash)
+		if err != nil {
+			return nil, err
+		}
+		prev := *proposal
+		proposal.Status = gty.ProposalStatusExecuted
+		kv = append(kv, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+		logs = append(logs, proposalReceipt(gty.TyLogGovernanceExecute, &prev, proposal))
+	}
+	if len(logs) == 0 {
+		return nil, gty.ErrNotExecutable
+	}
+	kv = append(kv, a.saveProposal(proposal))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) unlock(payload *gty.GovernanceUnlock) (*types.Receipt, error) {
+	proposal, err := getProposal(a.db, payload.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.VoteMode != gty.VoteModeStake {
+		return nil, gty.ErrVoteMode
+	}
+	if a.height < proposal.EndHeight {
+		return nil, gty.ErrVotingNotClosed
+	}
+	vote, err := getVote(a.db, proposal.Id, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if vote.Unlocked {
+		return nil, gty.ErrUnlocked
+	}
+	receipt, err := a.coinsAccount.ExecActive(a.fromaddr, a.execaddr, vote.Weight)
+	if err != nil {
+		return nil, err
+	}
+	prev := *vote
+	vote.Unlocked = true
+	kv := append(receipt.KV, a.saveVote(vote))
+	logs := append(receipt.Logs, voteReceipt(gty.TyLogGovernanceUnlock, &prev, vote))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
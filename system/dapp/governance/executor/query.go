@@ -0,0 +1,29 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	gty "github.com/33cn/chain33/system/dapp/governance/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetProposal 获取提案以及当前的计票结果
+func (g *Governance) Query_GetProposal(in *types.Int64) (types.Message, error) {
+	return getProposal(g.GetStateDB(), in.Data)
+}
+
+// Query_ListProposals 按id从大到小列出提案，可以按状态过滤
+func (g *Governance) Query_ListProposals(in *gty.ReqGovernanceProposals) (types.Message, error) {
+	proposals, err := listProposals(g.GetStateDB(), in)
+	if err != nil {
+		return nil, err
+	}
+	return &gty.ReplyGovernanceProposals{Proposals: proposals}, nil
+}
+
+// Query_GetVote 获取投票记录
+func (g *Governance) Query_GetVote(in *gty.ReqGovernanceVote) (types.Message, error) {
+	return getVote(g.GetStateDB(), in.ProposalID, in.Voter)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package governance 链上治理执行器插件
+// 1. 任何人都可以创建文本提案或者修改manage合约配置的提案
+// 2. 按冻结的coins或者dpos受托人的得票数计票，投票截止以后按quorum和threshold统计结果
+// 3. 通过的参数修改提案在指定的高度以后修改manage合约的配置
+package governance
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/governance/commands"
+	"github.com/33cn/chain33/system/dapp/governance/executor"
+	"github.com/33cn/chain33/system/dapp/governance/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.GovernanceX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.GovernanceCmd,
+		RPC:      nil,
+	})
+}
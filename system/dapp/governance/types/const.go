@@ -0,0 +1,54 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// governance action ty
+const (
+	GovernanceActionPropose = iota + 1
+	GovernanceActionVote
+	GovernanceActionExecute
+	GovernanceActionUnlock
+)
+
+// governance log ty
+const (
+	TyLogGovernancePropose = 470
+	TyLogGovernanceVote    = 471
+	TyLogGovernanceTally   = 472
+	TyLogGovernanceExecute = 473
+	TyLogGovernanceUnlock  = 474
+)
+
+// vote mode
+const (
+	VoteModeStake = "stake"
+	VoteModeDpos  = "dpos"
+)
+
+// vote option
+const (
+	VoteYes = iota + 1
+	VoteNo
+	VoteAbstain
+)
+
+// proposal status
+const (
+	ProposalStatusVoting = iota + 1
+	ProposalStatusPassed
+	ProposalStatusRejected
+	ProposalStatusExecuted
+)
+
+// query func name
+const (
+	FuncNameGetProposal      = "GetProposal"
+	FuncNameListProposals    = "ListProposals"
+	FuncNameGetVote          = "GetVote"
+	MaxTitleLength           = 128
+	MaxDescriptionLength     = 1024
+	DefaultListProposalCount = 20
+	MaxListProposalCount     = 100
+)
@@ -0,0 +1,38 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrProposalNotExist 提案不存在
+	ErrProposalNotExist = errors.New("ErrProposalNotExist")
+	// ErrTitle 提案的标题不合法
+	ErrTitle = errors.New("ErrTitle")
+	// ErrParamChange 参数修改的key或者操作不合法
+	ErrParamChange = errors.New("ErrParamChange")
+	// ErrVoteMode 只支持stake和dpos计票
+	ErrVoteMode = errors.New("ErrVoteMode")
+	// ErrThreshold 通过的百分比必须在1到100之间
+	ErrThreshold = errors.New("ErrThreshold")
+	// ErrHeight 投票截止高度或者生效高度不合法
+	ErrHeight = errors.New("ErrHeight")
+	// ErrVoteOption 投票选项不合法
+	ErrVoteOption = errors.New("ErrVoteOption")
+	// ErrVotingClosed 投票已经截止
+	ErrVotingClosed = errors.New("ErrVotingClosed")
+	// ErrVotingNotClosed 投票还没有截止
+	ErrVotingNotClosed = errors.New("ErrVotingNotClosed")
+	// ErrVoted 已经对提案投过票
+	ErrVoted = errors.New("ErrVoted")
+	// ErrNoVoteWeight 没有投票的权重
+	ErrNoVoteWeight = errors.New("ErrNoVoteWeight")
+	// ErrVoteNotExist 没有对提案投票
+	ErrVoteNotExist = errors.New("ErrVoteNotExist")
+	// ErrUnlocked 投票冻结的coins已经解冻
+	ErrUnlocked = errors.New("ErrUnlocked")
+	// ErrNotExecutable 提案不能执行
+	ErrNotExecutable = errors.New("ErrNotExecutable")
+)
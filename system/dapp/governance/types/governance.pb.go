@@ -0,0 +1,1011 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: governance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	types "github.com/33cn/chain33/types"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type GovernanceAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*GovernanceAction_Propose
+	//	*GovernanceAction_Vote
+	//	*GovernanceAction_Execute
+	//	*GovernanceAction_Unlock
+	Value                isGovernanceAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                    `protobuf:"varint,5,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GovernanceAction) Reset()         { *m = GovernanceAction{} }
+func (m *GovernanceAction) String() string { return proto.CompactTextString(m) }
+func (*GovernanceAction) ProtoMessage()    {}
+func (*GovernanceAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{0}
+}
+
+func (m *GovernanceAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceAction.Unmarshal(m, b)
+}
+func (m *GovernanceAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceAction.Marshal(b, m, deterministic)
+}
+func (m *GovernanceAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceAction.Merge(m, src)
+}
+func (m *GovernanceAction) XXX_Size() int {
+	return xxx_messageInfo_GovernanceAction.Size(m)
+}
+func (m *GovernanceAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceAction proto.InternalMessageInfo
+
+type isGovernanceAction_Value interface {
+	isGovernanceAction_Value()
+}
+
+type GovernanceAction_Propose struct {
+	Propose *GovernancePropose `protobuf:"bytes,1,opt,name=propose,proto3,oneof"`
+}
+
+type GovernanceAction_Vote struct {
+	Vote *GovernanceVote `protobuf:"bytes,2,opt,name=vote,proto3,oneof"`
+}
+
+type GovernanceAction_Execute struct {
+	Execute *GovernanceExecute `protobuf:"bytes,3,opt,name=execute,proto3,oneof"`
+}
+
+type GovernanceAction_Unlock struct {
+	Unlock *GovernanceUnlock `protobuf:"bytes,4,opt,name=unlock,proto3,oneof"`
+}
+
+func (*GovernanceAction_Propose) isGovernanceAction_Value() {}
+
+func (*GovernanceAction_Vote) isGovernanceAction_Value() {}
+
+func (*GovernanceAction_Execute) isGovernanceAction_Value() {}
+
+func (*GovernanceAction_Unlock) isGovernanceAction_Value() {}
+
+func (m *GovernanceAction) GetValue() isGovernanceAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *GovernanceAction) GetPropose() *GovernancePropose {
+	if x, ok := m.GetValue().(*GovernanceAction_Propose); ok {
+		return x.Propose
+	}
+	return nil
+}
+
+func (m *GovernanceAction) GetVote() *GovernanceVote {
+	if x, ok := m.GetValue().(*GovernanceAction_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+func (m *GovernanceAction) GetExecute() *GovernanceExecute {
+	if x, ok := m.GetValue().(*GovernanceAction_Execute); ok {
+		return x.Execute
+	}
+	return nil
+}
+
+func (m *GovernanceAction) GetUnlock() *GovernanceUnlock {
+	if x, ok := m.GetValue().(*GovernanceAction_Unlock); ok {
+		return x.Unlock
+	}
+	return nil
+}
+
+func (m *GovernanceAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*GovernanceAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _GovernanceAction_OneofMarshaler, _GovernanceAction_OneofUnmarshaler, _GovernanceAction_OneofSizer, []interface{}{
+		(*GovernanceAction_Propose)(nil),
+		(*GovernanceAction_Vote)(nil),
+		(*GovernanceAction_Execute)(nil),
+		(*GovernanceAction_Unlock)(nil),
+	}
+}
+
+func _GovernanceAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*GovernanceAction)
+	// value
+	switch x := m.Value.(type) {
+	case *GovernanceAction_Propose:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Propose); err != nil {
+			return err
+		}
+	case *GovernanceAction_Vote:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Vote); err != nil {
+			return err
+		}
+	case *GovernanceAction_Execute:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Execute); err != nil {
+			return err
+		}
+	case *GovernanceAction_Unlock:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Unlock); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("GovernanceAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _GovernanceAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*GovernanceAction)
+	switch tag {
+	case 1: // value.propose
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GovernancePropose)
+		err := b.DecodeMessage(msg)
+		m.Value = &GovernanceAction_Propose{msg}
+		return true, err
+	case 2: // value.vote
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GovernanceVote)
+		err := b.DecodeMessage(msg)
+		m.Value = &GovernanceAction_Vote{msg}
+		return true, err
+	case 3: // value.execute
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GovernanceExecute)
+		err := b.DecodeMessage(msg)
+		m.Value = &GovernanceAction_Execute{msg}
+		return true, err
+	case 4: // value.unlock
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GovernanceUnlock)
+		err := b.DecodeMessage(msg)
+		m.Value = &GovernanceAction_Unlock{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _GovernanceAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*GovernanceAction)
+	// value
+	switch x := m.Value.(type) {
+	case *GovernanceAction_Propose:
+		s := proto.Size(x.Propose)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *GovernanceAction_Vote:
+		s := proto.Size(x.Vote)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *GovernanceAction_Execute:
+		s := proto.Size(x.Execute)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *GovernanceAction_Unlock:
+		s := proto.Size(x.Unlock)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//创建提案，paramChange为空的时候是文本提案
+//   voteMode : stake按投票冻结的coins计票，dpos按受托人的得票数计票
+//   quorum : 投票的总权重不小于quorum的时候提案才有效
+//   threshold : 赞成的权重占赞成和反对权重的百分比不小于threshold的时候提案通过
+//   endHeight : 投票截止高度，不包括这个高度
+//   executeHeight : 参数修改提案生效的高度，不小于endHeight
+type GovernancePropose struct {
+	Title                string              `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ParamChange          *types.ModifyConfig `protobuf:"bytes,3,opt,name=paramChange,proto3" json:"paramChange,omitempty"`
+	VoteMode             string              `protobuf:"bytes,4,opt,name=voteMode,proto3" json:"voteMode,omitempty"`
+	Quorum               int64               `protobuf:"varint,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Threshold            int32               `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	EndHeight            int64               `protobuf:"varint,7,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	ExecuteHeight        int64               `protobuf:"varint,8,opt,name=executeHeight,proto3" json:"executeHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GovernancePropose) Reset()         { *m = GovernancePropose{} }
+func (m *GovernancePropose) String() string { return proto.CompactTextString(m) }
+func (*GovernancePropose) ProtoMessage()    {}
+func (*GovernancePropose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{1}
+}
+
+func (m *GovernancePropose) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernancePropose.Unmarshal(m, b)
+}
+func (m *GovernancePropose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernancePropose.Marshal(b, m, deterministic)
+}
+func (m *GovernancePropose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernancePropose.Merge(m, src)
+}
+func (m *GovernancePropose) XXX_Size() int {
+	return xxx_messageInfo_GovernancePropose.Size(m)
+}
+func (m *GovernancePropose) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernancePropose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernancePropose proto.InternalMessageInfo
+
+func (m *GovernancePropose) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *GovernancePropose) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *GovernancePropose) GetParamChange() *types.ModifyConfig {
+	if m != nil {
+		return m.ParamChange
+	}
+	return nil
+}
+
+func (m *GovernancePropose) GetVoteMode() string {
+	if m != nil {
+		return m.VoteMode
+	}
+	return ""
+}
+
+func (m *GovernancePropose) GetQuorum() int64 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+func (m *GovernancePropose) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *GovernancePropose) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GovernancePropose) GetExecuteHeight() int64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+//投票，stake模式冻结amount的coins作为权重，coins需要先转到governance合约
+type GovernanceVote struct {
+	ProposalID           int64    `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Option               int32    `protobuf:"varint,2,opt,name=option,proto3" json:"option,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernanceVote) Reset()         { *m = GovernanceVote{} }
+func (m *GovernanceVote) String() string { return proto.CompactTextString(m) }
+func (*GovernanceVote) ProtoMessage()    {}
+func (*GovernanceVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{2}
+}
+
+func (m *GovernanceVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceVote.Unmarshal(m, b)
+}
+func (m *GovernanceVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceVote.Marshal(b, m, deterministic)
+}
+func (m *GovernanceVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceVote.Merge(m, src)
+}
+func (m *GovernanceVote) XXX_Size() int {
+	return xxx_messageInfo_GovernanceVote.Size(m)
+}
+func (m *GovernanceVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceVote proto.InternalMessageInfo
+
+func (m *GovernanceVote) GetProposalID() int64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+func (m *GovernanceVote) GetOption() int32 {
+	if m != nil {
+		return m.Option
+	}
+	return 0
+}
+
+func (m *GovernanceVote) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//投票截止以后统计结果，通过的参数修改提案在executeHeight以后修改manage合约的配置
+type GovernanceExecute struct {
+	ProposalID           int64    `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernanceExecute) Reset()         { *m = GovernanceExecute{} }
+func (m *GovernanceExecute) String() string { return proto.CompactTextString(m) }
+func (*GovernanceExecute) ProtoMessage()    {}
+func (*GovernanceExecute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{3}
+}
+
+func (m *GovernanceExecute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceExecute.Unmarshal(m, b)
+}
+func (m *GovernanceExecute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceExecute.Marshal(b, m, deterministic)
+}
+func (m *GovernanceExecute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceExecute.Merge(m, src)
+}
+func (m *GovernanceExecute) XXX_Size() int {
+	return xxx_messageInfo_GovernanceExecute.Size(m)
+}
+func (m *GovernanceExecute) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceExecute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceExecute proto.InternalMessageInfo
+
+func (m *GovernanceExecute) GetProposalID() int64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+//投票截止以后解冻stake模式投票冻结的coins
+type GovernanceUnlock struct {
+	ProposalID           int64    `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernanceUnlock) Reset()         { *m = GovernanceUnlock{} }
+func (m *GovernanceUnlock) String() string { return proto.CompactTextString(m) }
+func (*GovernanceUnlock) ProtoMessage()    {}
+func (*GovernanceUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{4}
+}
+
+func (m *GovernanceUnlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceUnlock.Unmarshal(m, b)
+}
+func (m *GovernanceUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceUnlock.Marshal(b, m, deterministic)
+}
+func (m *GovernanceUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceUnlock.Merge(m, src)
+}
+func (m *GovernanceUnlock) XXX_Size() int {
+	return xxx_messageInfo_GovernanceUnlock.Size(m)
+}
+func (m *GovernanceUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceUnlock proto.InternalMessageInfo
+
+func (m *GovernanceUnlock) GetProposalID() int64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+type GovernanceProposal struct {
+	Id                   int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer             string              `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Title                string              `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string              `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ParamChange          *types.ModifyConfig `protobuf:"bytes,5,opt,name=paramChange,proto3" json:"paramChange,omitempty"`
+	VoteMode             string              `protobuf:"bytes,6,opt,name=voteMode,proto3" json:"voteMode,omitempty"`
+	Quorum               int64               `protobuf:"varint,7,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Threshold            int32               `protobuf:"varint,8,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	StartHeight          int64               `protobuf:"varint,9,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight            int64               `protobuf:"varint,10,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	ExecuteHeight        int64               `protobuf:"varint,11,opt,name=executeHeight,proto3" json:"executeHeight,omitempty"`
+	Yes                  int64               `protobuf:"varint,12,opt,name=yes,proto3" json:"yes,omitempty"`
+	No                   int64               `protobuf:"varint,13,opt,name=no,proto3" json:"no,omitempty"`
+	Abstain              int64               `protobuf:"varint,14,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	Status               int32               `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GovernanceProposal) Reset()         { *m = GovernanceProposal{} }
+func (m *GovernanceProposal) String() string { return proto.CompactTextString(m) }
+func (*GovernanceProposal) ProtoMessage()    {}
+func (*GovernanceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{5}
+}
+
+func (m *GovernanceProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceProposal.Unmarshal(m, b)
+}
+func (m *GovernanceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceProposal.Marshal(b, m, deterministic)
+}
+func (m *GovernanceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceProposal.Merge(m, src)
+}
+func (m *GovernanceProposal) XXX_Size() int {
+	return xxx_messageInfo_GovernanceProposal.Size(m)
+}
+func (m *GovernanceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceProposal proto.InternalMessageInfo
+
+func (m *GovernanceProposal) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *GovernanceProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *GovernanceProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *GovernanceProposal) GetParamChange() *types.ModifyConfig {
+	if m != nil {
+		return m.ParamChange
+	}
+	return nil
+}
+
+func (m *GovernanceProposal) GetVoteMode() string {
+	if m != nil {
+		return m.VoteMode
+	}
+	return ""
+}
+
+func (m *GovernanceProposal) GetQuorum() int64 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetExecuteHeight() int64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetYes() int64 {
+	if m != nil {
+		return m.Yes
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetNo() int64 {
+	if m != nil {
+		return m.No
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetAbstain() int64 {
+	if m != nil {
+		return m.Abstain
+	}
+	return 0
+}
+
+func (m *GovernanceProposal) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+type GovernanceVoteRecord struct {
+	ProposalID           int64    `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Voter                string   `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Option               int32    `protobuf:"varint,3,opt,name=option,proto3" json:"option,omitempty"`
+	Weight               int64    `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Unlocked             bool     `protobuf:"varint,6,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GovernanceVoteRecord) Reset()         { *m = GovernanceVoteRecord{} }
+func (m *GovernanceVoteRecord) String() string { return proto.CompactTextString(m) }
+func (*GovernanceVoteRecord) ProtoMessage()    {}
+func (*GovernanceVoteRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{6}
+}
+
+func (m *GovernanceVoteRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GovernanceVoteRecord.Unmarshal(m, b)
+}
+func (m *GovernanceVoteRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GovernanceVoteRecord.Marshal(b, m, deterministic)
+}
+func (m *GovernanceVoteRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceVoteRecord.Merge(m, src)
+}
+func (m *GovernanceVoteRecord) XXX_Size() int {
+	return xxx_messageInfo_GovernanceVoteRecord.Size(m)
+}
+func (m *GovernanceVoteRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceVoteRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceVoteRecord proto.InternalMessageInfo
+
+func (m *GovernanceVoteRecord) GetProposalID() int64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+func (m *GovernanceVoteRecord) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *GovernanceVoteRecord) GetOption() int32 {
+	if m != nil {
+		return m.Option
+	}
+	return 0
+}
+
+func (m *GovernanceVoteRecord) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *GovernanceVoteRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GovernanceVoteRecord) GetUnlocked() bool {
+	if m != nil {
+		return m.Unlocked
+	}
+	return false
+}
+
+type ReceiptGovernanceProposal struct {
+	Prev                 *GovernanceProposal `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *GovernanceProposal `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReceiptGovernanceProposal) Reset()         { *m = ReceiptGovernanceProposal{} }
+func (m *ReceiptGovernanceProposal) String() string { return proto.CompactTextString(m) }
+func (*ReceiptGovernanceProposal) ProtoMessage()    {}
+func (*ReceiptGovernanceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{7}
+}
+
+func (m *ReceiptGovernanceProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptGovernanceProposal.Unmarshal(m, b)
+}
+func (m *ReceiptGovernanceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptGovernanceProposal.Marshal(b, m, deterministic)
+}
+func (m *ReceiptGovernanceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptGovernanceProposal.Merge(m, src)
+}
+func (m *ReceiptGovernanceProposal) XXX_Size() int {
+	return xxx_messageInfo_ReceiptGovernanceProposal.Size(m)
+}
+func (m *ReceiptGovernanceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptGovernanceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptGovernanceProposal proto.InternalMessageInfo
+
+func (m *ReceiptGovernanceProposal) GetPrev() *GovernanceProposal {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptGovernanceProposal) GetCurrent() *GovernanceProposal {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptGovernanceVote struct {
+	Prev                 *GovernanceVoteRecord `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *GovernanceVoteRecord `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReceiptGovernanceVote) Reset()         { *m = ReceiptGovernanceVote{} }
+func (m *ReceiptGovernanceVote) String() string { return proto.CompactTextString(m) }
+func (*ReceiptGovernanceVote) ProtoMessage()    {}
+func (*ReceiptGovernanceVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{8}
+}
+
+func (m *ReceiptGovernanceVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptGovernanceVote.Unmarshal(m, b)
+}
+func (m *ReceiptGovernanceVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptGovernanceVote.Marshal(b, m, deterministic)
+}
+func (m *ReceiptGovernanceVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptGovernanceVote.Merge(m, src)
+}
+func (m *ReceiptGovernanceVote) XXX_Size() int {
+	return xxx_messageInfo_ReceiptGovernanceVote.Size(m)
+}
+func (m *ReceiptGovernanceVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptGovernanceVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptGovernanceVote proto.InternalMessageInfo
+
+func (m *ReceiptGovernanceVote) GetPrev() *GovernanceVoteRecord {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptGovernanceVote) GetCurrent() *GovernanceVoteRecord {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqGovernanceVote struct {
+	ProposalID           int64    `protobuf:"varint,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Voter                string   `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqGovernanceVote) Reset()         { *m = ReqGovernanceVote{} }
+func (m *ReqGovernanceVote) String() string { return proto.CompactTextString(m) }
+func (*ReqGovernanceVote) ProtoMessage()    {}
+func (*ReqGovernanceVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{9}
+}
+
+func (m *ReqGovernanceVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqGovernanceVote.Unmarshal(m, b)
+}
+func (m *ReqGovernanceVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqGovernanceVote.Marshal(b, m, deterministic)
+}
+func (m *ReqGovernanceVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqGovernanceVote.Merge(m, src)
+}
+func (m *ReqGovernanceVote) XXX_Size() int {
+	return xxx_messageInfo_ReqGovernanceVote.Size(m)
+}
+func (m *ReqGovernanceVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqGovernanceVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqGovernanceVote proto.InternalMessageInfo
+
+func (m *ReqGovernanceVote) GetProposalID() int64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+func (m *ReqGovernanceVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+//从start开始按id从大到小列出提案，start为0的时候从最新的提案开始
+type ReqGovernanceProposals struct {
+	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Status               int32    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqGovernanceProposals) Reset()         { *m = ReqGovernanceProposals{} }
+func (m *ReqGovernanceProposals) String() string { return proto.CompactTextString(m) }
+func (*ReqGovernanceProposals) ProtoMessage()    {}
+func (*ReqGovernanceProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{10}
+}
+
+func (m *ReqGovernanceProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqGovernanceProposals.Unmarshal(m, b)
+}
+func (m *ReqGovernanceProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqGovernanceProposals.Marshal(b, m, deterministic)
+}
+func (m *ReqGovernanceProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqGovernanceProposals.Merge(m, src)
+}
+func (m *ReqGovernanceProposals) XXX_Size() int {
+	return xxx_messageInfo_ReqGovernanceProposals.Size(m)
+}
+func (m *ReqGovernanceProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqGovernanceProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqGovernanceProposals proto.InternalMessageInfo
+
+func (m *ReqGovernanceProposals) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ReqGovernanceProposals) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqGovernanceProposals) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+type ReplyGovernanceProposals struct {
+	Proposals            []*GovernanceProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReplyGovernanceProposals) Reset()         { *m = ReplyGovernanceProposals{} }
+func (m *ReplyGovernanceProposals) String() string { return proto.CompactTextString(m) }
+func (*ReplyGovernanceProposals) ProtoMessage()    {}
+func (*ReplyGovernanceProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{11}
+}
+
+func (m *ReplyGovernanceProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyGovernanceProposals.Unmarshal(m, b)
+}
+func (m *ReplyGovernanceProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyGovernanceProposals.Marshal(b, m, deterministic)
+}
+func (m *ReplyGovernanceProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyGovernanceProposals.Merge(m, src)
+}
+func (m *ReplyGovernanceProposals) XXX_Size() int {
+	return xxx_messageInfo_ReplyGovernanceProposals.Size(m)
+}
+func (m *ReplyGovernanceProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyGovernanceProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyGovernanceProposals proto.InternalMessageInfo
+
+func (m *ReplyGovernanceProposals) GetProposals() []*GovernanceProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GovernanceAction)(nil), "types.GovernanceAction")
+	proto.RegisterType((*GovernancePropose)(nil), "types.GovernancePropose")
+	proto.RegisterType((*GovernanceVote)(nil), "types.GovernanceVote")
+	proto.RegisterType((*GovernanceExecute)(nil), "types.GovernanceExecute")
+	proto.RegisterType((*GovernanceUnlock)(nil), "types.GovernanceUnlock")
+	proto.RegisterType((*GovernanceProposal)(nil), "types.GovernanceProposal")
+	proto.RegisterType((*GovernanceVoteRecord)(nil), "types.GovernanceVoteRecord")
+	proto.RegisterType((*ReceiptGovernanceProposal)(nil), "types.ReceiptGovernanceProposal")
+	proto.RegisterType((*ReceiptGovernanceVote)(nil), "types.ReceiptGovernanceVote")
+	proto.RegisterType((*ReqGovernanceVote)(nil), "types.ReqGovernanceVote")
+	proto.RegisterType((*ReqGovernanceProposals)(nil), "types.ReqGovernanceProposals")
+	proto.RegisterType((*ReplyGovernanceProposals)(nil), "types.ReplyGovernanceProposals")
+}
+
+func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
+
+var fileDescriptor_e18a03da5266c714 = []byte{
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0x6e, 0xe2, 0x38, 0x1f, 0x93, 0xb7, 0x79, 0xdb, 0xa5, 0x2d, 0xdb, 0x82, 0x50, 0x64, 0x71,
+	0xa8, 0x84, 0x28, 0xa2, 0xa5, 0xe2, 0x0c, 0x05, 0xd1, 0x1e, 0x2a, 0xa1, 0x45, 0x70, 0xe2, 0xc0,
+	0xd6, 0xde, 0x26, 0x16, 0xee, 0xae, 0xbb, 0x5e, 0x07, 0x72, 0xea, 0xdf, 0xe0, 0x67, 0x70, 0xe7,
+	0x87, 0x71, 0x45, 0xfb, 0xe1, 0xc4, 0xce, 0xa7, 0xe0, 0x96, 0x67, 0xf6, 0x19, 0xef, 0xcc, 0x33,
+	0xcf, 0x4e, 0x60, 0x6b, 0x20, 0x46, 0x4c, 0x72, 0xca, 0x43, 0x76, 0x94, 0x4a, 0xa1, 0x04, 0xf2,
+	0xd5, 0x38, 0x65, 0xd9, 0x41, 0x8f, 0x7d, 0x67, 0x61, 0xae, 0x84, 0xb4, 0xe1, 0xe0, 0x77, 0x0d,
+	0xb6, 0xde, 0x4d, 0xb8, 0xaf, 0x42, 0x15, 0x0b, 0x8e, 0x5e, 0x40, 0x2b, 0x95, 0x22, 0x15, 0x19,
+	0xc3, 0xb5, 0x7e, 0xed, 0xb0, 0x7b, 0x8c, 0x8f, 0x4c, 0xf6, 0xd1, 0x94, 0xf9, 0xde, 0x9e, 0x9f,
+	0x6f, 0x90, 0x82, 0x8a, 0x9e, 0x40, 0x63, 0x24, 0x14, 0xc3, 0x75, 0x93, 0xb2, 0x3b, 0x97, 0xf2,
+	0x49, 0x28, 0xcd, 0x37, 0x24, 0x7d, 0x85, 0xad, 0x84, 0x61, 0x6f, 0xc9, 0x15, 0x6f, 0xed, 0xb9,
+	0xbe, 0xc2, 0x51, 0xd1, 0x73, 0x68, 0xe6, 0x3c, 0x11, 0xe1, 0x57, 0xdc, 0x30, 0x49, 0xf7, 0xe7,
+	0x92, 0x3e, 0x9a, 0xe3, 0xf3, 0x0d, 0xe2, 0x88, 0xa8, 0x07, 0x75, 0x35, 0xc6, 0x7e, 0xbf, 0x76,
+	0xe8, 0x93, 0xba, 0x1a, 0xbf, 0x6e, 0x81, 0x3f, 0xa2, 0x49, 0xce, 0x82, 0x1f, 0x75, 0xd8, 0x9e,
+	0xeb, 0x07, 0xed, 0x80, 0xaf, 0x62, 0x95, 0xd8, 0xc6, 0x3b, 0xc4, 0x02, 0xd4, 0x87, 0x6e, 0xc4,
+	0xb2, 0x50, 0xc6, 0xa9, 0xd6, 0xc7, 0x74, 0xd8, 0x21, 0xe5, 0x10, 0x3a, 0x85, 0x6e, 0x4a, 0x25,
+	0xbd, 0x39, 0x1b, 0x52, 0x3e, 0x28, 0x7a, 0xba, 0xe7, 0xca, 0xbb, 0x14, 0x51, 0x7c, 0x3d, 0x3e,
+	0x13, 0xfc, 0x3a, 0x1e, 0x90, 0x32, 0x0f, 0x1d, 0x40, 0x5b, 0xcb, 0x71, 0x29, 0x22, 0x66, 0x5a,
+	0xea, 0x90, 0x09, 0x46, 0x7b, 0xd0, 0xbc, 0xcd, 0x85, 0xcc, 0x6f, 0x4c, 0xf5, 0x1e, 0x71, 0x08,
+	0x3d, 0x84, 0x8e, 0x1a, 0x4a, 0x96, 0x0d, 0x45, 0x12, 0xe1, 0xa6, 0x69, 0x6c, 0x1a, 0xd0, 0xa7,
+	0x8c, 0x47, 0xe7, 0x2c, 0x1e, 0x0c, 0x15, 0x6e, 0x99, 0xc4, 0x69, 0x00, 0x3d, 0x86, 0x4d, 0xa7,
+	0xa5, 0x63, 0xb4, 0x0d, 0xa3, 0x1a, 0x0c, 0xbe, 0x40, 0xaf, 0x3a, 0x36, 0xf4, 0x08, 0xc0, 0x8e,
+	0x99, 0x26, 0x17, 0x6f, 0x8c, 0x36, 0x1e, 0x29, 0x45, 0x74, 0xad, 0x62, 0xaa, 0x8d, 0x4f, 0x1c,
+	0xd2, 0x71, 0x7a, 0x23, 0x72, 0xae, 0x8c, 0x22, 0x1e, 0x71, 0x28, 0x38, 0x29, 0x6b, 0xef, 0x06,
+	0xbd, 0xee, 0x92, 0xe0, 0xb8, 0x6c, 0x55, 0x3b, 0xe8, 0xb5, 0x39, 0xbf, 0x3c, 0x40, 0xb3, 0x53,
+	0xa6, 0x89, 0x76, 0x45, 0x1c, 0x39, 0x7a, 0x3d, 0x8e, 0xf4, 0x1c, 0x9c, 0x8d, 0xa5, 0x9b, 0xee,
+	0x04, 0x4f, 0x2d, 0xe1, 0xad, 0xb0, 0x44, 0x63, 0xad, 0x25, 0xfc, 0x7f, 0xb0, 0x44, 0x73, 0xa9,
+	0x25, 0x5a, 0xcb, 0x2d, 0xd1, 0x9e, 0xb5, 0x44, 0x1f, 0xba, 0x99, 0xa2, 0x52, 0xb9, 0x91, 0x77,
+	0x4c, 0x6a, 0x39, 0x54, 0x35, 0x0d, 0xac, 0x35, 0x4d, 0x77, 0x81, 0x69, 0xd0, 0x16, 0x78, 0x63,
+	0x96, 0xe1, 0xff, 0xcc, 0x99, 0xfe, 0xa9, 0x45, 0xe6, 0x02, 0x6f, 0x5a, 0x91, 0xb9, 0x40, 0x18,
+	0x5a, 0xf4, 0x2a, 0x53, 0x34, 0xe6, 0xb8, 0x67, 0x82, 0x05, 0xd4, 0x7d, 0x65, 0x8a, 0xaa, 0x3c,
+	0xc3, 0xff, 0x5b, 0xfb, 0x58, 0x14, 0xfc, 0xac, 0xc1, 0x4e, 0xd5, 0x89, 0x84, 0x85, 0x42, 0x46,
+	0x6b, 0xfd, 0xb8, 0x03, 0xbe, 0x16, 0xad, 0x18, 0xa6, 0x05, 0x25, 0x97, 0x7a, 0xb3, 0x2e, 0xfd,
+	0x66, 0x3b, 0x6b, 0x58, 0x59, 0x2d, 0xd2, 0xf1, 0xa1, 0x8d, 0xbb, 0x17, 0x68, 0x91, 0x1e, 0x91,
+	0xdd, 0x2e, 0xcc, 0x3e, 0xc0, 0x36, 0x99, 0xe0, 0xe0, 0x0e, 0xf6, 0x09, 0x0b, 0x59, 0x9c, 0xaa,
+	0x05, 0xb6, 0x7b, 0x0a, 0x8d, 0x54, 0xb2, 0x91, 0xdb, 0xaa, 0xfb, 0x4b, 0xb6, 0x2a, 0x4d, 0x88,
+	0xa1, 0xa1, 0x13, 0x68, 0x85, 0xb9, 0x94, 0x8c, 0x2b, 0xb7, 0x54, 0x57, 0x64, 0x14, 0xcc, 0xe0,
+	0x0e, 0x76, 0xe7, 0x0a, 0x30, 0x6f, 0xf8, 0x59, 0xe5, 0xf2, 0x07, 0x0b, 0xf7, 0xb3, 0x95, 0xd7,
+	0x5d, 0x7f, 0x3a, 0x7b, 0xfd, 0xca, 0x9c, 0x49, 0x01, 0x17, 0xb0, 0x4d, 0xd8, 0xed, 0x5f, 0x2e,
+	0x90, 0x85, 0x03, 0x0b, 0x3e, 0xc3, 0x5e, 0xe5, 0x53, 0x45, 0xb7, 0x99, 0xe6, 0x1b, 0x03, 0xbb,
+	0x4f, 0x59, 0xa0, 0xa3, 0xa1, 0xd9, 0x36, 0x76, 0x0b, 0x59, 0x50, 0x72, 0x97, 0x57, 0x71, 0xd7,
+	0x07, 0xc0, 0x84, 0xa5, 0xc9, 0x78, 0xd1, 0xf7, 0x5f, 0x42, 0xa7, 0xa8, 0x2e, 0xc3, 0xb5, 0xbe,
+	0xb7, 0x5a, 0xfc, 0x29, 0xf7, 0xaa, 0x69, 0xfe, 0x57, 0x4f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff,
+	0xae, 0x35, 0x05, 0x39, 0x82, 0x07, 0x00, 0x00,
+}
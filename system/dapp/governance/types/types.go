@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types governance插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// GovernanceX 执行器名称
+	GovernanceX = "governance"
+	actionName  = map[string]int32{
+		"Propose": GovernanceActionPropose,
+		"Vote":    GovernanceActionVote,
+		"Execute": GovernanceActionExecute,
+		"Unlock":  GovernanceActionUnlock,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogGovernancePropose: {Ty: reflect.TypeOf(ReceiptGovernanceProposal{}), Name: "LogGovernancePropose"},
+		TyLogGovernanceVote:    {Ty: reflect.TypeOf(ReceiptGovernanceVote{}), Name: "LogGovernanceVote"},
+		TyLogGovernanceTally:   {Ty: reflect.TypeOf(ReceiptGovernanceProposal{}), Name: "LogGovernanceTally"},
+		TyLogGovernanceExecute: {Ty: reflect.TypeOf(ReceiptGovernanceProposal{}), Name: "LogGovernanceExecute"},
+		TyLogGovernanceUnlock:  {Ty: reflect.TypeOf(ReceiptGovernanceVote{}), Name: "LogGovernanceUnlock"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(GovernanceX))
+	types.RegistorExecutor(GovernanceX, NewType())
+	types.RegisterDappFork(GovernanceX, "Enable", 0)
+}
+
+// GovernanceType governance执行器类型
+type GovernanceType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a governance type object
+func NewType() *GovernanceType {
+	c := &GovernanceType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return governance action
+func (g *GovernanceType) GetPayload() types.Message {
+	return &GovernanceAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (g *GovernanceType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (g *GovernanceType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (g *GovernanceType) GetName() string {
+	return GovernanceX
+}
+
+// IsPassed 总权重达到quorum并且赞成占赞成和反对的百分比不小于threshold
+func IsPassed(proposal *GovernanceProposal) bool {
+	total := proposal.Yes + proposal.No + proposal.Abstain
+	if total == 0 || total < proposal.Quorum || proposal.Yes == 0 {
+		return false
+	}
+	return proposal.Yes*100 >= (proposal.Yes+proposal.No)*int64(proposal.Threshold)
+}
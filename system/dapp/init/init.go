@@ -6,7 +6,30 @@
 package init
 
 import (
-	_ "github.com/33cn/chain33/system/dapp/coins"  // register coins package
-	_ "github.com/33cn/chain33/system/dapp/manage" // register manage package
-	_ "github.com/33cn/chain33/system/dapp/none"   // register none package
+	_ "github.com/33cn/chain33/system/dapp/bridge"       // register bridge package
+	_ "github.com/33cn/chain33/system/dapp/cert"         // register cert package
+	_ "github.com/33cn/chain33/system/dapp/coins"        // register coins package
+	_ "github.com/33cn/chain33/system/dapp/confidential" // register confidential package
+	_ "github.com/33cn/chain33/system/dapp/did"          // register did package
+	_ "github.com/33cn/chain33/system/dapp/dpos"         // register dpos package
+	_ "github.com/33cn/chain33/system/dapp/escrow"       // register escrow package
+	_ "github.com/33cn/chain33/system/dapp/exchange"     // register exchange package
+	_ "github.com/33cn/chain33/system/dapp/finality"     // register finality package
+	_ "github.com/33cn/chain33/system/dapp/governance"   // register governance package
+	_ "github.com/33cn/chain33/system/dapp/js"           // register js package
+	_ "github.com/33cn/chain33/system/dapp/keymigrate"   // register keymigrate package
+	_ "github.com/33cn/chain33/system/dapp/lottery"      // register lottery package
+	_ "github.com/33cn/chain33/system/dapp/manage"       // register manage package
+	_ "github.com/33cn/chain33/system/dapp/multiaction"  // register multiaction package
+	_ "github.com/33cn/chain33/system/dapp/multisig"     // register multisig package
+	_ "github.com/33cn/chain33/system/dapp/nft"          // register nft package
+	_ "github.com/33cn/chain33/system/dapp/none"         // register none package
+	_ "github.com/33cn/chain33/system/dapp/oracle"       // register oracle package
+	_ "github.com/33cn/chain33/system/dapp/paychan"      // register paychan package
+	_ "github.com/33cn/chain33/system/dapp/prediction"   // register prediction package
+	_ "github.com/33cn/chain33/system/dapp/stablecoin"   // register stablecoin package
+	_ "github.com/33cn/chain33/system/dapp/storage"      // register storage package
+	_ "github.com/33cn/chain33/system/dapp/unfreeze"     // register unfreeze package
+	_ "github.com/33cn/chain33/system/dapp/validator"    // register validator package
+	_ "github.com/33cn/chain33/system/dapp/vesting"      // register vesting package
 )
@@ -0,0 +1,147 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands js插件命令
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	jsty "github.com/33cn/chain33/system/dapp/js/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// JsCmd js command
+func JsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "js",
+		Short: "JavaScript contract",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CreateContractCmd(),
+		CallContractCmd(),
+		QueryContractCmd(),
+		GetContractCmd(),
+	)
+
+	return cmd
+}
+
+func queryJs(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, jsty.JsX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+func addCallFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("funcname", "f", "", "function name")
+	cmd.MarkFlagRequired("funcname")
+	cmd.Flags().StringP("args", "a", "", "arguments in json")
+}
+
+func getCall(cmd *cobra.Command) *jsty.JsCall {
+	name, _ := cmd.Flags().GetString("name")
+	funcname, _ := cmd.Flags().GetString("funcname")
+	args, _ := cmd.Flags().GetString("args")
+	return &jsty.JsCall{Name: name, Funcname: funcname, Args: args}
+}
+
+// CreateContractCmd deploy contract
+func CreateContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a transaction to deploy contract, init is called with args if defined",
+		Run:   createContract,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("code", "c", "", "path of the contract code file")
+	cmd.MarkFlagRequired("code")
+	cmd.Flags().StringP("args", "a", "", "arguments of init in json")
+	return cmd
+}
+
+func createContract(cmd *cobra.Command, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	path, _ := cmd.Flags().GetString("code")
+	initArgs, _ := cmd.Flags().GetString("args")
+	code, err := ioutil.ReadFile(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, jsty.JsX, &jsty.JsAction{
+		Ty:    jsty.JsActionCreate,
+		Value: &jsty.JsAction_Create{Create: &jsty.JsCreate{Name: name, Code: string(code), Args: initArgs}},
+	})
+}
+
+// CallContractCmd call contract
+func CallContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call",
+		Short: "Create a transaction to call contract function",
+		Run:   callContract,
+	}
+	addCallFlags(cmd)
+	return cmd
+}
+
+func callContract(cmd *cobra.Command, args []string) {
+	commandtypes.CreateActionTx(cmd, jsty.JsX, &jsty.JsAction{
+		Ty:    jsty.JsActionCall,
+		Value: &jsty.JsAction_Call{Call: getCall(cmd)},
+	})
+}
+
+// QueryContractCmd call contract function read only
+func QueryContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Call contract function read only",
+		Run:   queryContract,
+	}
+	addCallFlags(cmd)
+	return cmd
+}
+
+func queryContract(cmd *cobra.Command, args []string) {
+	var res jsty.ReplyJsQuery
+	queryJs(cmd, jsty.FuncNameQuery, getCall(cmd), &res)
+}
+
+// GetContractCmd query contract
+func GetContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract",
+		Short: "Query contract code by name",
+		Run:   getContract,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func getContract(cmd *cobra.Command, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	var res jsty.JsContract
+	queryJs(cmd, jsty.FuncNameGetContract, &types.ReqString{Data: name}, &res)
+}
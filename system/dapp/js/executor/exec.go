@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	jsty "github.com/33cn/chain33/system/dapp/js/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Create 部署合约，如果定义了init函数就执行init
+func (j *Js) Exec_Create(payload *jsty.JsCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(j, tx, index)
+	return action.create(payload)
+}
+
+// Exec_Call 调用合约的函数
+func (j *Js) Exec_Call(payload *jsty.JsCall, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(j, tx, index)
+	return action.call(payload)
+}
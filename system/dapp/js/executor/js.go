@@ -0,0 +1,67 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor js执行器，在确定性的沙箱中执行用户部署的JavaScript合约，按循环和函数调用计量gas
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	jsty "github.com/33cn/chain33/system/dapp/js/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.js")
+	driverName = jsty.JsX
+	conf       = types.ConfSub(driverName)
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Js{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newJs, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return js name
+func GetName() string {
+	return newJs().GetName()
+}
+
+// Js defines Js object
+type Js struct {
+	drivers.DriverBase
+}
+
+func newJs() drivers.Driver {
+	j := &Js{}
+	j.SetChild(j)
+	j.SetExecutorType(types.LoadExecutorType(driverName))
+	return j
+}
+
+// GetDriverName return a drivername
+func (j *Js) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (j *Js) CheckReceiptExecOk() bool {
+	return true
+}
+
+//getMaxGas 每个交易最多消耗的gas，没有配置的时候使用默认值
+func getMaxGas() int64 {
+	if _, err := conf.G("maxGas"); err != nil {
+		return jsty.DefaultMaxGas
+	}
+	if gas := conf.GInt("maxGas"); gas > 0 {
+		return gas
+	}
+	return jsty.DefaultMaxGas
+}
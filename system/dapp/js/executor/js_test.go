@@ -0,0 +1,231 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	jsty "github.com/33cn/chain33/system/dapp/js/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const counterCode = `
+function init(args) {
+	state.set("count", args.start);
+	state.set("owner", ctx.caller);
+}
+
+function add(args) {
+	var count = state.get("count") + args.n;
+	state.set("count", count);
+	emit("added", {n: args.n, count: count, caller: ctx.caller});
+	return count;
+}
+
+function get() {
+	return {count: state.get("count"), owner: state.get("owner"), height: ctx.height};
+}
+
+function _reset() {
+	state.set("count", 0);
+}
+
+function fail(args) {
+	state.set("count", -1);
+	throw new Error(args.msg);
+}
+
+function reset() {
+	state.del("count");
+}
+`
+
+const testMaxGas = 20000
+
+type testEnv struct {
+	t     *testing.T
+	j     *Js
+	index int
+}
+
+func newTestEnv(t *testing.T) (*testEnv, func()) {
+	dir, leveldb, kvdb := util.CreateTestDB()
+	j := newJs().(*Js)
+	j.SetStateDB(kvdb)
+	j.SetLocalDB(kvdb)
+	j.SetEnv(10, 1539918074, 0)
+	return &testEnv{t: t, j: j}, func() { util.CloseTestDB(dir, leveldb) }
+}
+
+func (env *testEnv) action(priv crypto.PrivKey) *Action {
+	tx := &types.Transaction{Execer: []byte(jsty.JsX), To: address.ExecAddress(jsty.JsX)}
+	tx.Sign(types.SECP256K1, priv)
+	env.index++
+	a := NewAction(env.j, tx, env.index)
+	a.maxGas = testMaxGas
+	return a
+}
+
+func (env *testEnv) create(priv crypto.PrivKey, name, code, args string) (*types.Receipt, error) {
+	return env.action(priv).create(&jsty.JsCreate{Name: name, Code: code, Args: args})
+}
+
+func (env *testEnv) call(priv crypto.PrivKey, name, funcname, args string) (*types.Receipt, error) {
+	return env.action(priv).call(&jsty.JsCall{Name: name, Funcname: funcname, Args: args})
+}
+
+func (env *testEnv) query(name, funcname, args string) (*jsty.ReplyJsQuery, error) {
+	return query(env.j.GetStateDB(), &jsty.JsCall{Name: name, Funcname: funcname, Args: args}, env.j.GetHeight(), testMaxGas)
+}
+
+func TestContract(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	creator, priv := util.Genaddress()
+	caller, callerPriv := util.Genaddress()
+
+	_, err := env.create(priv, "Counter", counterCode, "")
+	assert.Equal(t, jsty.ErrContractName, err)
+	_, err = env.create(priv, "counter", "function (", "")
+	assert.Equal(t, jsty.ErrCodeSyntax, errors.Cause(err))
+	receipt, err := env.create(priv, "counter", counterCode, `{"start": 5}`)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(receipt.KV))
+	_, err = env.create(priv, "counter", counterCode, "")
+	assert.Equal(t, jsty.ErrContractExist, err)
+
+	//调用结果和事件写入收据
+	receipt, err = env.call(callerPriv, "counter", "add", `{"n": 3}`)
+	assert.Nil(t, err)
+	var call jsty.ReceiptJsCall
+	var event jsty.JsEvent
+	assert.Equal(t, int32(jsty.TyLogJsCall), receipt.Logs[0].Ty)
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &call))
+	assert.Equal(t, "8", call.Result)
+	assert.True(t, call.GasUsed > 0)
+	assert.Equal(t, int32(jsty.TyLogJsEvent), receipt.Logs[1].Ty)
+	assert.Nil(t, types.Decode(receipt.Logs[1].Log, &event))
+	assert.Equal(t, "added", event.Event)
+	assert.Equal(t, `{"caller":"`+caller+`","count":8,"n":3}`, event.Data)
+
+	reply, err := env.query("counter", "get", "")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"count":8,"height":10,"owner":"`+creator+`"}`, reply.Result)
+	_, err = env.query("counter", "add", `{"n": 1}`)
+	assert.Equal(t, jsty.ErrJsThrow, errors.Cause(err))
+
+	//下划线开头的函数、init和不存在的函数都不能调用
+	for _, funcname := range []string{"_reset", "init", "toString", "parseInt", ""} {
+		_, err = env.call(callerPriv, "counter", funcname, "")
+		assert.Equal(t, jsty.ErrFuncNotExist, err, funcname)
+	}
+	_, err = env.call(callerPriv, "counter", "fail", `{"msg": "bad"}`)
+	assert.Equal(t, jsty.ErrJsThrow, errors.Cause(err))
+	assert.Contains(t, err.Error(), "bad")
+	_, err = env.call(callerPriv, "counter", "add", `{"n": `)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = env.call(callerPriv, "other", "add", "")
+	assert.Equal(t, jsty.ErrContractNotExist, err)
+
+	receipt, err = env.call(callerPriv, "counter", "reset", "")
+	assert.Nil(t, err)
+	assert.Nil(t, receipt.KV[0].Value)
+	reply, err = env.query("counter", "get", "")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"count":null,"height":10,"owner":"`+creator+`"}`, reply.Result)
+}
+
+func TestSandbox(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	_, priv := util.Genaddress()
+
+	code := `
+function globals() {
+	return [typeof eval, typeof Function, typeof Date, typeof Math.random, typeof console, typeof (function() {}).constructor];
+}
+function loop() {
+	while (true) {}
+}
+function swallow() {
+	for (;;) {
+		try {
+			while (true) {}
+		} catch (e) {
+		} finally {
+			continue;
+		}
+	}
+}
+function recurse() {
+	try {
+		recurse();
+	} catch (e) {
+		recurse();
+	}
+}
+function override() {
+	this["#gas"] = function() {};
+	while (true) {}
+}
+function deep(args) {
+	if (args.n > 0) {
+		return deep({n: args.n - 1});
+	}
+	return 0;
+}
+`
+	_, err := env.create(priv, "sandbox", code, "")
+	assert.Nil(t, err)
+	reply, err := env.query("sandbox", "globals", "")
+	assert.Nil(t, err)
+	assert.Equal(t, `["undefined","undefined","undefined","undefined","undefined","undefined"]`, reply.Result)
+
+	//gas用完以后合约不能捕获异常继续执行
+	for _, funcname := range []string{"loop", "swallow", "recurse", "override"} {
+		_, err = env.call(priv, "sandbox", funcname, "")
+		assert.Equal(t, jsty.ErrOutOfGas, err, funcname)
+	}
+	receipt, err := env.call(priv, "sandbox", "deep", `{"n": 10}`)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(receipt.KV))
+	_, err = env.call(priv, "sandbox", "deep", `{"n": 1000}`)
+	assert.Equal(t, jsty.ErrJsThrow, errors.Cause(err))
+
+	_, err = env.create(priv, "with", `function f() { with ({}) {} }`, "")
+	assert.Equal(t, jsty.ErrCodeSyntax, err)
+	_, err = env.create(priv, "readonly", `function get() { state.set("a", 1); }`, "")
+	assert.Nil(t, err)
+	_, err = env.query("readonly", "get", "")
+	assert.Equal(t, jsty.ErrJsThrow, errors.Cause(err))
+	assert.Contains(t, err.Error(), jsty.ErrReadOnly.Error())
+}
+
+func TestTxGas(t *testing.T) {
+	env, closer := newTestEnv(t)
+	defer closer()
+	_, priv := util.Genaddress()
+	_, err := env.create(priv, "counter", counterCode, `{"start": 5}`)
+	assert.Nil(t, err)
+
+	//合约的执行步数计入交易的gas，交易剩余的gas比maxGas少的时候按交易的gas限制
+	meter := types.NewGasMeter(testMaxGas * 10)
+	env.j.SetGasMeter(meter)
+	receipt, err := env.call(priv, "counter", "add", `{"n": 3}`)
+	assert.Nil(t, err)
+	var call jsty.ReceiptJsCall
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &call))
+	assert.Equal(t, call.GasUsed, meter.Used())
+
+	meter.Reset(10)
+	_, err = env.call(priv, "counter", "add", `{"n": 3}`)
+	assert.Equal(t, jsty.ErrOutOfGas, err)
+	assert.Equal(t, int64(0), meter.Remaining())
+}
@@ -0,0 +1,176 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	jsty "github.com/33cn/chain33/system/dapp/js/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	contractKeyPrefix = "mavl-" + jsty.JsX + "-contract-"
+	stateKeyPrefix    = "mavl-" + jsty.JsX + "-state-"
+)
+
+func calcContractKey(name string) []byte {
+	return []byte(contractKeyPrefix + name)
+}
+
+// Action js交易的执行环境
+type Action struct {
+	db        dbm.KV
+	fromaddr  string
+	txhash    string
+	height    int64
+	blocktime int64
+	index     int
+	maxGas    int64
+	gas       *types.GasMeter
+}
+
+// NewAction new a action object
+func NewAction(j *Js, tx *types.Transaction, index int) *Action {
+	return &Action{
+		db:        j.GetStateDB(),
+		fromaddr:  tx.From(),
+		txhash:    common.ToHex(tx.Hash()),
+		height:    j.GetHeight(),
+		blocktime: j.GetBlockTime(),
+		index:     index,
+		maxGas:    getMaxGas(),
+		gas:       j.GetGasMeter(),
+	}
+}
+
+func getContract(db dbm.KV, name string) (*jsty.JsContract, error) {
+	value, err := db.Get(calcContractKey(name))
+	if err != nil || value == nil {
+		return nil, jsty.ErrContractNotExist
+	}
+	var contract jsty.JsContract
+	if err := types.Decode(value, &contract); err != nil {
+		return nil, err
+	}
+	return &contract, nil
+}
+
+func (a *Action) context() *callContext {
+	return &callContext{caller: a.fromaddr, height: a.height, blockTime: a.blocktime, txHash: a.txhash}
+}
+
+//execute 执行合约，把状态的修改、调用结果和事件写入收据
+func (a *Action) execute(contract *jsty.JsContract, funcname, args string, receipt *types.Receipt) error {
+	program, _, err := compile(contract.Code)
+	if err != nil {
+		return err
+	}
+	//合约的执行步数计入交易的gas，不能超过交易剩余的gas
+	gasLimit := a.maxGas
+	if a.gas.Limited() && a.gas.Remaining() < gasLimit {
+		gasLimit = a.gas.Remaining()
+	}
+	s, err := newSandbox(a.db, contract.Name, funcname, a.context(), gasLimit, false)
+	if err != nil {
+		return err
+	}
+	result, err := s.run(program, args)
+	if gasErr := a.gas.Consume(s.gasUsed); gasErr != nil && err == nil {
+		err = gasErr
+	}
+	if err != nil {
+		return err
+	}
+	receipt.KV = append(receipt.KV, s.kvs...)
+	log := &jsty.ReceiptJsCall{Name: contract.Name, Funcname: funcname, Caller: a.fromaddr, Result: result, GasUsed: s.gasUsed}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: jsty.TyLogJsCall, Log: types.Encode(log)})
+	for _, event := range s.events {
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: jsty.TyLogJsEvent, Log: types.Encode(event)})
+	}
+	return nil
+}
+
+func (a *Action) create(payload *jsty.JsCreate) (*types.Receipt, error) {
+	if err := jsty.CheckName(payload.Name); err != nil {
+		return nil, err
+	}
+	if _, err := getContract(a.db, payload.Name); err == nil {
+		return nil, jsty.ErrContractExist
+	}
+	_, funcs, err := compile(payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	contract := &jsty.JsContract{Name: payload.Name, Code: payload.Code, Creator: a.fromaddr, Height: a.height}
+	kv := &types.KeyValue{Key: calcContractKey(contract.Name), Value: types.Encode(contract)}
+	a.db.Set(kv.Key, kv.Value)
+	receipt := &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: jsty.TyLogJsCreate, Log: types.Encode(contract)})
+	funcname := ""
+	if funcs[jsty.InitFunc] {
+		funcname = jsty.InitFunc
+	}
+	if err := a.execute(contract, funcname, payload.Args, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+//checkFuncname 只能调用顶层声明的函数，init和下划线开头的函数不能调用
+func checkFuncname(code, funcname string) error {
+	if funcname == "" || funcname == jsty.InitFunc || strings.HasPrefix(funcname, "_") {
+		return jsty.ErrFuncNotExist
+	}
+	_, funcs, err := compile(code)
+	if err != nil {
+		return err
+	}
+	if !funcs[funcname] {
+		return jsty.ErrFuncNotExist
+	}
+	return nil
+}
+
+func (a *Action) call(payload *jsty.JsCall) (*types.Receipt, error) {
+	contract, err := getContract(a.db, payload.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFuncname(contract.Code, payload.Funcname); err != nil {
+		return nil, err
+	}
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if err := a.execute(contract, payload.Funcname, payload.Args, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+//query 只读的方式调用合约的函数，不能修改状态和发送事件
+func query(db dbm.KV, req *jsty.JsCall, height, maxGas int64) (*jsty.ReplyJsQuery, error) {
+	contract, err := getContract(db, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFuncname(contract.Code, req.Funcname); err != nil {
+		return nil, err
+	}
+	program, _, err := compile(contract.Code)
+	if err != nil {
+		return nil, err
+	}
+	s, err := newSandbox(db, contract.Name, req.Funcname, &callContext{height: height}, maxGas, true)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.run(program, req.Args)
+	if err != nil {
+		return nil, err
+	}
+	return &jsty.ReplyJsQuery{Result: result, GasUsed: s.gasUsed}, nil
+}
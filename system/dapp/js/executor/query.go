@@ -0,0 +1,20 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	jsty "github.com/33cn/chain33/system/dapp/js/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetContract 查询合约的代码和部署信息
+func (j *Js) Query_GetContract(in *types.ReqString) (types.Message, error) {
+	return getContract(j.GetStateDB(), in.Data)
+}
+
+// Query_Query 只读调用合约的函数，返回json格式的结果
+func (j *Js) Query_Query(in *jsty.JsCall) (types.Message, error) {
+	return query(j.GetStateDB(), in, j.GetHeight(), getMaxGas())
+}
@@ -0,0 +1,337 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	jsty "github.com/33cn/chain33/system/dapp/js/types"
+	"github.com/33cn/chain33/types"
+	"github.com/pkg/errors"
+	"github.com/robertkrimen/otto"
+	"github.com/robertkrimen/otto/ast"
+	"github.com/robertkrimen/otto/parser"
+)
+
+//gasFunc 插入到循环体和函数体开头的计量函数，#不是合法的标识符，合约代码无法引用或者覆盖
+const gasFunc = "#gas"
+
+//prelude 删除不确定的和可以绕过计量动态执行代码的全局对象
+const prelude = `(function(global) {
+	Object.defineProperty(Object.getPrototypeOf(function() {}), "constructor", {value: undefined, writable: false, configurable: false});
+	delete global.eval;
+	delete global.Function;
+	delete global.Date;
+	delete global.console;
+	delete Math.random;
+	Object.defineProperty(global, "#gas", {writable: false, configurable: false});
+})(this);`
+
+type gasVisitor struct {
+	err error
+}
+
+func gasStatement() ast.Statement {
+	return &ast.ExpressionStatement{Expression: &ast.CallExpression{Callee: &ast.Identifier{Name: gasFunc}}}
+}
+
+func withGas(body ast.Statement) ast.Statement {
+	return &ast.BlockStatement{List: []ast.Statement{gasStatement(), body}}
+}
+
+//Enter 每次循环、函数调用都消耗gas，catch和finally的开头也消耗gas，gas用完以后异常不能被合约吞掉
+func (v *gasVisitor) Enter(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case *ast.ForStatement:
+		n.Body = withGas(n.Body)
+	case *ast.ForInStatement:
+		n.Body = withGas(n.Body)
+	case *ast.WhileStatement:
+		n.Body = withGas(n.Body)
+	case *ast.DoWhileStatement:
+		n.Body = withGas(n.Body)
+	case *ast.FunctionLiteral:
+		if body, ok := n.Body.(*ast.BlockStatement); ok {
+			body.List = append([]ast.Statement{gasStatement()}, body.List...)
+		}
+	case *ast.CatchStatement:
+		n.Body = withGas(n.Body)
+	case *ast.TryStatement:
+		if n.Finally != nil {
+			n.Finally = withGas(n.Finally)
+		}
+	case *ast.WithStatement:
+		//with会改变标识符的查找，可以覆盖计量函数
+		v.err = jsty.ErrCodeSyntax
+		return nil
+	}
+	return v
+}
+
+func (v *gasVisitor) Exit(n ast.Node) {}
+
+//compile 解析合约代码并插入计量函数，返回顶层声明的函数名
+func compile(code string) (*ast.Program, map[string]bool, error) {
+	if len(code) > jsty.MaxCodeSize {
+		return nil, nil, jsty.ErrCodeSize
+	}
+	program, err := parser.ParseFile(nil, "", code, 0)
+	if err != nil {
+		return nil, nil, errors.Wrap(jsty.ErrCodeSyntax, err.Error())
+	}
+	v := &gasVisitor{}
+	ast.Walk(v, program)
+	if v.err != nil {
+		return nil, nil, v.err
+	}
+	funcs := make(map[string]bool)
+	for _, decl := range program.DeclarationList {
+		if fn, ok := decl.(*ast.FunctionDeclaration); ok && fn.Function.Name != nil {
+			funcs[fn.Function.Name.Name] = true
+		}
+	}
+	return program, funcs, nil
+}
+
+func calcStateKey(name, key string) []byte {
+	return []byte(stateKeyPrefix + name + "-" + key)
+}
+
+//callContext 合约可以读取的调用信息
+type callContext struct {
+	caller    string
+	height    int64
+	blockTime int64
+	txHash    string
+}
+
+//sandbox 执行合约的环境，合约只能通过state、emit和ctx访问链上的数据
+type sandbox struct {
+	vm        *otto.Otto
+	db        dbm.KV
+	name      string
+	funcname  string
+	readOnly  bool
+	gasLimit  int64
+	gasUsed   int64
+	outOfGas  bool
+	parse     otto.Value
+	stringify otto.Value
+	kvs       []*types.KeyValue
+	kvIndex   map[string]int
+	events    []*jsty.JsEvent
+}
+
+func newSandbox(db dbm.KV, name, funcname string, ctx *callContext, gasLimit int64, readOnly bool) (*sandbox, error) {
+	s := &sandbox{
+		vm:       otto.New(),
+		db:       db,
+		name:     name,
+		funcname: funcname,
+		readOnly: readOnly,
+		gasLimit: gasLimit,
+		kvIndex:  make(map[string]int),
+	}
+	s.vm.SetStackDepthLimit(jsty.MaxStackDepth)
+	var err error
+	//保存原始的JSON函数，合约覆盖JSON以后不影响状态的编码
+	if s.parse, err = s.vm.Run("JSON.parse"); err != nil {
+		return nil, err
+	}
+	if s.stringify, err = s.vm.Run("JSON.stringify"); err != nil {
+		return nil, err
+	}
+	if err = s.vm.Set(gasFunc, func(call otto.FunctionCall) otto.Value {
+		s.useGas(jsty.GasStep)
+		return otto.UndefinedValue()
+	}); err != nil {
+		return nil, err
+	}
+	state, _ := s.vm.Object("({})")
+	state.Set("get", s.stateGet)
+	state.Set("set", s.stateSet)
+	state.Set("del", s.stateDel)
+	context, _ := s.vm.Object("({})")
+	context.Set("contract", name)
+	context.Set("funcname", funcname)
+	context.Set("caller", ctx.caller)
+	context.Set("height", ctx.height)
+	context.Set("blocktime", ctx.blockTime)
+	context.Set("txhash", ctx.txHash)
+	s.vm.Set("state", state)
+	s.vm.Set("ctx", context)
+	s.vm.Set("emit", s.emit)
+	if _, err = s.vm.Run(prelude); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+//throw 抛出合约可以捕获的异常
+func (s *sandbox) throw(err error) {
+	panic(s.vm.MakeCustomError(err.Error(), err.Error()))
+}
+
+func (s *sandbox) useGas(gas int64) {
+	if s.outOfGas || s.gasUsed+gas > s.gasLimit {
+		s.outOfGas = true
+		s.gasUsed = s.gasLimit
+		s.throw(jsty.ErrOutOfGas)
+	}
+	s.gasUsed += gas
+}
+
+func (s *sandbox) key(call otto.FunctionCall) []byte {
+	key := call.Argument(0)
+	if !key.IsString() || len(key.String()) == 0 || len(key.String()) > jsty.MaxKeyLength {
+		s.throw(jsty.ErrStateKey)
+	}
+	return calcStateKey(s.name, key.String())
+}
+
+//encode 用原始的JSON.stringify编码，undefined和函数不能保存
+func (s *sandbox) encode(value otto.Value) string {
+	data, err := s.stringify.Call(otto.UndefinedValue(), value)
+	if err != nil {
+		s.throw(jsty.ErrStateValue)
+	}
+	if !data.IsString() {
+		s.throw(jsty.ErrStateValue)
+	}
+	return data.String()
+}
+
+func (s *sandbox) stateGet(call otto.FunctionCall) otto.Value {
+	key := s.key(call)
+	s.useGas(jsty.GasStateGet)
+	value, err := s.db.Get(key)
+	if err != nil || len(value) == 0 {
+		return otto.NullValue()
+	}
+	s.useGas(int64(len(value)) * jsty.GasPerByte)
+	result, err := s.parse.Call(otto.UndefinedValue(), string(value))
+	if err != nil {
+		s.throw(jsty.ErrStateValue)
+	}
+	return result
+}
+
+func (s *sandbox) setKV(key, value []byte) {
+	s.db.Set(key, value)
+	kv := &types.KeyValue{Key: key, Value: value}
+	if i, ok := s.kvIndex[string(key)]; ok {
+		s.kvs[i] = kv
+		return
+	}
+	s.kvIndex[string(key)] = len(s.kvs)
+	s.kvs = append(s.kvs, kv)
+}
+
+func (s *sandbox) stateSet(call otto.FunctionCall) otto.Value {
+	if s.readOnly {
+		s.throw(jsty.ErrReadOnly)
+	}
+	key := s.key(call)
+	value := s.encode(call.Argument(1))
+	if len(value) > jsty.MaxValueSize {
+		s.throw(jsty.ErrStateValue)
+	}
+	s.useGas(jsty.GasStateSet + int64(len(key)+len(value))*jsty.GasPerByte)
+	s.setKV(key, []byte(value))
+	return otto.UndefinedValue()
+}
+
+func (s *sandbox) stateDel(call otto.FunctionCall) otto.Value {
+	if s.readOnly {
+		s.throw(jsty.ErrReadOnly)
+	}
+	key := s.key(call)
+	s.useGas(jsty.GasStateSet)
+	s.setKV(key, nil)
+	return otto.UndefinedValue()
+}
+
+func (s *sandbox) emit(call otto.FunctionCall) otto.Value {
+	if s.readOnly {
+		s.throw(jsty.ErrReadOnly)
+	}
+	if len(s.events) >= jsty.MaxEvents {
+		s.throw(jsty.ErrTooManyEvents)
+	}
+	event := call.Argument(0)
+	if !event.IsString() || len(event.String()) == 0 || len(event.String()) > jsty.MaxKeyLength {
+		s.throw(jsty.ErrStateKey)
+	}
+	data := s.encode(call.Argument(1))
+	if len(data) > jsty.MaxValueSize {
+		s.throw(jsty.ErrStateValue)
+	}
+	s.useGas(jsty.GasEmit + int64(len(data))*jsty.GasPerByte)
+	s.events = append(s.events, &jsty.JsEvent{Name: s.name, Funcname: s.funcname, Event: event.String(), Data: data})
+	return otto.UndefinedValue()
+}
+
+//check gas用完的时候不管合约是否捕获了异常都返回ErrOutOfGas
+func (s *sandbox) check(err error) error {
+	if s.outOfGas {
+		return jsty.ErrOutOfGas
+	}
+	if err != nil {
+		return errors.Wrap(jsty.ErrJsThrow, err.Error())
+	}
+	return nil
+}
+
+//run 执行合约的顶层代码，然后用json格式的参数调用函数，返回值编码成json，funcname为空的时候只执行顶层代码
+func (s *sandbox) run(program *ast.Program, args string) (result string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			clog.Error("js run panic", "contract", s.name, "funcname", s.funcname, "err", r)
+			result, err = "", jsty.ErrJsThrow
+			if s.outOfGas {
+				err = jsty.ErrOutOfGas
+			}
+		}
+	}()
+	if len(args) > jsty.MaxArgsSize {
+		return "", jsty.ErrArgsSize
+	}
+	argsValue := otto.UndefinedValue()
+	if args != "" {
+		if argsValue, err = s.parse.Call(otto.UndefinedValue(), args); err != nil {
+			return "", types.ErrInvalidParam
+		}
+	}
+	if _, err = s.vm.Run(program); err != nil {
+		return "", s.check(err)
+	}
+	if s.funcname == "" {
+		return "", s.check(nil)
+	}
+	fn, err := s.vm.Get(s.funcname)
+	if err != nil || !fn.IsFunction() {
+		return "", jsty.ErrFuncNotExist
+	}
+	value, err := fn.Call(otto.UndefinedValue(), argsValue)
+	if err != nil {
+		return "", s.check(err)
+	}
+	if value.IsUndefined() {
+		return "", s.check(nil)
+	}
+	data, err := s.stringify.Call(otto.UndefinedValue(), value)
+	if err != nil {
+		return "", s.check(err)
+	}
+	if data.IsString() {
+		result = data.String()
+	}
+	if s.gasUsed+int64(len(result))*jsty.GasPerByte > s.gasLimit {
+		s.outOfGas = true
+		s.gasUsed = s.gasLimit
+	} else {
+		s.gasUsed += int64(len(result)) * jsty.GasPerByte
+	}
+	return result, s.check(nil)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package js JavaScript合约执行器插件
+// 1. 用户部署JavaScript合约，合约在删除了不确定的全局对象的沙箱中执行，只能通过state、emit和ctx访问链上的数据
+// 2. 解析合约代码的时候在每个循环体和函数体的开头插入计量函数，gas用完以后交易失败并且合约不能捕获
+// 3. 合约的状态保存在状态数据库中，调用结果和事件写入收据，查询的时候只读执行合约的函数
+package js
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/js/commands"
+	"github.com/33cn/chain33/system/dapp/js/executor"
+	"github.com/33cn/chain33/system/dapp/js/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.JsX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.JsCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// js action ty
+const (
+	JsActionCreate = iota + 1
+	JsActionCall
+)
+
+// js log ty
+const (
+	TyLogJsCreate = 570
+	TyLogJsCall   = 571
+	TyLogJsEvent  = 572
+)
+
+// query func name
+const (
+	FuncNameGetContract = "GetContract"
+	FuncNameQuery       = "Query"
+)
+
+// 合约的限制
+const (
+	MaxNameLength = 32
+	MaxCodeSize   = 64 * 1024
+	MaxArgsSize   = 16 * 1024
+	MaxKeyLength  = 128
+	MaxValueSize  = 16 * 1024
+	MaxEvents     = 32
+	MaxStackDepth = 64
+	//InitFunc 部署的时候调用的函数，部署以后不能再调用
+	InitFunc = "init"
+)
+
+// gas的消耗，每次循环和函数调用消耗GasStep，读写状态和发送事件按字节额外消耗
+const (
+	DefaultMaxGas = 1000000
+	GasStep       = 1
+	GasStateGet   = 20
+	GasStateSet   = 100
+	GasEmit       = 50
+	GasPerByte    = 1
+)
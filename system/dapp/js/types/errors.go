@@ -0,0 +1,36 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrContractName 合约名不合法
+	ErrContractName = errors.New("ErrContractName")
+	// ErrContractExist 合约已经存在
+	ErrContractExist = errors.New("ErrContractExist")
+	// ErrContractNotExist 合约不存在
+	ErrContractNotExist = errors.New("ErrContractNotExist")
+	// ErrCodeSize 合约代码超过长度限制
+	ErrCodeSize = errors.New("ErrCodeSize")
+	// ErrCodeSyntax 合约代码解析失败，或者使用了不允许的语法
+	ErrCodeSyntax = errors.New("ErrCodeSyntax")
+	// ErrArgsSize 参数超过长度限制
+	ErrArgsSize = errors.New("ErrArgsSize")
+	// ErrFuncNotExist 函数不存在或者不能调用
+	ErrFuncNotExist = errors.New("ErrFuncNotExist")
+	// ErrOutOfGas 执行消耗的gas超过限制
+	ErrOutOfGas = errors.New("ErrOutOfGas")
+	// ErrJsThrow 合约执行的时候抛出异常
+	ErrJsThrow = errors.New("ErrJsThrow")
+	// ErrStateKey 状态的key不合法
+	ErrStateKey = errors.New("ErrStateKey")
+	// ErrStateValue 状态的value超过长度限制
+	ErrStateValue = errors.New("ErrStateValue")
+	// ErrReadOnly 查询的时候不能修改状态和发送事件
+	ErrReadOnly = errors.New("ErrReadOnly")
+	// ErrTooManyEvents 一次调用发送的事件过多
+	ErrTooManyEvents = errors.New("ErrTooManyEvents")
+)
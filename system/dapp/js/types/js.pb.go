@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: js.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type JsAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*JsAction_Create
+	//	*JsAction_Call
+	Value                isJsAction_Value `protobuf_oneof:"value"`
+	Ty                   int32            `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *JsAction) Reset()         { *m = JsAction{} }
+func (m *JsAction) String() string { return proto.CompactTextString(m) }
+func (*JsAction) ProtoMessage()    {}
+func (*JsAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{0}
+}
+
+func (m *JsAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsAction.Unmarshal(m, b)
+}
+func (m *JsAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsAction.Marshal(b, m, deterministic)
+}
+func (m *JsAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsAction.Merge(m, src)
+}
+func (m *JsAction) XXX_Size() int {
+	return xxx_messageInfo_JsAction.Size(m)
+}
+func (m *JsAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsAction proto.InternalMessageInfo
+
+type isJsAction_Value interface {
+	isJsAction_Value()
+}
+
+type JsAction_Create struct {
+	Create *JsCreate `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type JsAction_Call struct {
+	Call *JsCall `protobuf:"bytes,2,opt,name=call,proto3,oneof"`
+}
+
+func (*JsAction_Create) isJsAction_Value() {}
+
+func (*JsAction_Call) isJsAction_Value() {}
+
+func (m *JsAction) GetValue() isJsAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *JsAction) GetCreate() *JsCreate {
+	if x, ok := m.GetValue().(*JsAction_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (m *JsAction) GetCall() *JsCall {
+	if x, ok := m.GetValue().(*JsAction_Call); ok {
+		return x.Call
+	}
+	return nil
+}
+
+func (m *JsAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*JsAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _JsAction_OneofMarshaler, _JsAction_OneofUnmarshaler, _JsAction_OneofSizer, []interface{}{
+		(*JsAction_Create)(nil),
+		(*JsAction_Call)(nil),
+	}
+}
+
+func _JsAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*JsAction)
+	// value
+	switch x := m.Value.(type) {
+	case *JsAction_Create:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Create); err != nil {
+			return err
+		}
+	case *JsAction_Call:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Call); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("JsAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _JsAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*JsAction)
+	switch tag {
+	case 1: // value.create
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(JsCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &JsAction_Create{msg}
+		return true, err
+	case 2: // value.call
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(JsCall)
+		err := b.DecodeMessage(msg)
+		m.Value = &JsAction_Call{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _JsAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*JsAction)
+	// value
+	switch x := m.Value.(type) {
+	case *JsAction_Create:
+		s := proto.Size(x.Create)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *JsAction_Call:
+		s := proto.Size(x.Call)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//部署合约，合约名不能重复，部署的时候如果定义了init函数会用args调用
+type JsCreate struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Args                 string   `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsCreate) Reset()         { *m = JsCreate{} }
+func (m *JsCreate) String() string { return proto.CompactTextString(m) }
+func (*JsCreate) ProtoMessage()    {}
+func (*JsCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{1}
+}
+
+func (m *JsCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsCreate.Unmarshal(m, b)
+}
+func (m *JsCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsCreate.Marshal(b, m, deterministic)
+}
+func (m *JsCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsCreate.Merge(m, src)
+}
+func (m *JsCreate) XXX_Size() int {
+	return xxx_messageInfo_JsCreate.Size(m)
+}
+func (m *JsCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsCreate proto.InternalMessageInfo
+
+func (m *JsCreate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsCreate) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *JsCreate) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+//调用合约的函数，args是json格式的参数，函数名以下划线开头的不能调用
+type JsCall struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Funcname             string   `protobuf:"bytes,2,opt,name=funcname,proto3" json:"funcname,omitempty"`
+	Args                 string   `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsCall) Reset()         { *m = JsCall{} }
+func (m *JsCall) String() string { return proto.CompactTextString(m) }
+func (*JsCall) ProtoMessage()    {}
+func (*JsCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{2}
+}
+
+func (m *JsCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsCall.Unmarshal(m, b)
+}
+func (m *JsCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsCall.Marshal(b, m, deterministic)
+}
+func (m *JsCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsCall.Merge(m, src)
+}
+func (m *JsCall) XXX_Size() int {
+	return xxx_messageInfo_JsCall.Size(m)
+}
+func (m *JsCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsCall proto.InternalMessageInfo
+
+func (m *JsCall) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsCall) GetFuncname() string {
+	if m != nil {
+		return m.Funcname
+	}
+	return ""
+}
+
+func (m *JsCall) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+type JsContract struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Creator              string   `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsContract) Reset()         { *m = JsContract{} }
+func (m *JsContract) String() string { return proto.CompactTextString(m) }
+func (*JsContract) ProtoMessage()    {}
+func (*JsContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{3}
+}
+
+func (m *JsContract) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsContract.Unmarshal(m, b)
+}
+func (m *JsContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsContract.Marshal(b, m, deterministic)
+}
+func (m *JsContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsContract.Merge(m, src)
+}
+func (m *JsContract) XXX_Size() int {
+	return xxx_messageInfo_JsContract.Size(m)
+}
+func (m *JsContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsContract proto.InternalMessageInfo
+
+func (m *JsContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsContract) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *JsContract) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *JsContract) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+//合约执行的结果，result是函数返回值的json
+type ReceiptJsCall struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Funcname             string   `protobuf:"bytes,2,opt,name=funcname,proto3" json:"funcname,omitempty"`
+	Caller               string   `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	Result               string   `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	GasUsed              int64    `protobuf:"varint,5,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptJsCall) Reset()         { *m = ReceiptJsCall{} }
+func (m *ReceiptJsCall) String() string { return proto.CompactTextString(m) }
+func (*ReceiptJsCall) ProtoMessage()    {}
+func (*ReceiptJsCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{4}
+}
+
+func (m *ReceiptJsCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptJsCall.Unmarshal(m, b)
+}
+func (m *ReceiptJsCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptJsCall.Marshal(b, m, deterministic)
+}
+func (m *ReceiptJsCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptJsCall.Merge(m, src)
+}
+func (m *ReceiptJsCall) XXX_Size() int {
+	return xxx_messageInfo_ReceiptJsCall.Size(m)
+}
+func (m *ReceiptJsCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptJsCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptJsCall proto.InternalMessageInfo
+
+func (m *ReceiptJsCall) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReceiptJsCall) GetFuncname() string {
+	if m != nil {
+		return m.Funcname
+	}
+	return ""
+}
+
+func (m *ReceiptJsCall) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *ReceiptJsCall) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *ReceiptJsCall) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+//合约调用emit产生的事件，data是json格式
+type JsEvent struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Funcname             string   `protobuf:"bytes,2,opt,name=funcname,proto3" json:"funcname,omitempty"`
+	Event                string   `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Data                 string   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsEvent) Reset()         { *m = JsEvent{} }
+func (m *JsEvent) String() string { return proto.CompactTextString(m) }
+func (*JsEvent) ProtoMessage()    {}
+func (*JsEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{5}
+}
+
+func (m *JsEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsEvent.Unmarshal(m, b)
+}
+func (m *JsEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsEvent.Marshal(b, m, deterministic)
+}
+func (m *JsEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsEvent.Merge(m, src)
+}
+func (m *JsEvent) XXX_Size() int {
+	return xxx_messageInfo_JsEvent.Size(m)
+}
+func (m *JsEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsEvent proto.InternalMessageInfo
+
+func (m *JsEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JsEvent) GetFuncname() string {
+	if m != nil {
+		return m.Funcname
+	}
+	return ""
+}
+
+func (m *JsEvent) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *JsEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type ReplyJsQuery struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	GasUsed              int64    `protobuf:"varint,2,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyJsQuery) Reset()         { *m = ReplyJsQuery{} }
+func (m *ReplyJsQuery) String() string { return proto.CompactTextString(m) }
+func (*ReplyJsQuery) ProtoMessage()    {}
+func (*ReplyJsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d11539bc790542aa, []int{6}
+}
+
+func (m *ReplyJsQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyJsQuery.Unmarshal(m, b)
+}
+func (m *ReplyJsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyJsQuery.Marshal(b, m, deterministic)
+}
+func (m *ReplyJsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyJsQuery.Merge(m, src)
+}
+func (m *ReplyJsQuery) XXX_Size() int {
+	return xxx_messageInfo_ReplyJsQuery.Size(m)
+}
+func (m *ReplyJsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyJsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyJsQuery proto.InternalMessageInfo
+
+func (m *ReplyJsQuery) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *ReplyJsQuery) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*JsAction)(nil), "types.JsAction")
+	proto.RegisterType((*JsCreate)(nil), "types.JsCreate")
+	proto.RegisterType((*JsCall)(nil), "types.JsCall")
+	proto.RegisterType((*JsContract)(nil), "types.JsContract")
+	proto.RegisterType((*ReceiptJsCall)(nil), "types.ReceiptJsCall")
+	proto.RegisterType((*JsEvent)(nil), "types.JsEvent")
+	proto.RegisterType((*ReplyJsQuery)(nil), "types.ReplyJsQuery")
+}
+
+func init() { proto.RegisterFile("js.proto", fileDescriptor_d11539bc790542aa) }
+
+var fileDescriptor_d11539bc790542aa = []byte{
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x4a, 0xfb, 0x40,
+	0x14, 0xc5, 0x9b, 0xb4, 0x49, 0x9b, 0xfb, 0xff, 0x57, 0x61, 0x90, 0x12, 0x5c, 0x95, 0xb8, 0xa9,
+	0x9b, 0x2e, 0xf4, 0x05, 0xd4, 0xa2, 0x94, 0xe0, 0xc6, 0x01, 0x1f, 0x60, 0x9c, 0xde, 0x7e, 0xc8,
+	0x98, 0x84, 0x99, 0x49, 0x21, 0x8f, 0xe0, 0x5b, 0xcb, 0xdc, 0x4c, 0xfc, 0x80, 0x0a, 0xea, 0xee,
+	0x9e, 0x93, 0x9b, 0x73, 0x7e, 0x5c, 0x06, 0x46, 0xcf, 0x66, 0x5e, 0xe9, 0xd2, 0x96, 0x2c, 0xb2,
+	0x4d, 0x85, 0x26, 0x33, 0x30, 0xca, 0xcd, 0xb5, 0xb4, 0xbb, 0xb2, 0x60, 0xe7, 0x10, 0x4b, 0x8d,
+	0xc2, 0x62, 0x1a, 0x4c, 0x83, 0xd9, 0xbf, 0x8b, 0xe3, 0x39, 0xed, 0xcc, 0x73, 0xb3, 0x20, 0x7b,
+	0xd9, 0xe3, 0x7e, 0x81, 0x9d, 0xc1, 0x40, 0x0a, 0xa5, 0xd2, 0x90, 0x16, 0xc7, 0x1f, 0x8b, 0x42,
+	0xa9, 0x65, 0x8f, 0xd3, 0x47, 0x76, 0x04, 0xa1, 0x6d, 0xd2, 0xfe, 0x34, 0x98, 0x45, 0x3c, 0xb4,
+	0xcd, 0xcd, 0x10, 0xa2, 0xbd, 0x50, 0x35, 0x66, 0x77, 0xae, 0xb4, 0xcd, 0x64, 0x0c, 0x06, 0x85,
+	0x78, 0x69, 0x2b, 0x13, 0x4e, 0xb3, 0xf3, 0x64, 0xb9, 0x42, 0x4a, 0x4f, 0x38, 0xcd, 0xce, 0x13,
+	0x7a, 0x63, 0x28, 0x2e, 0xe1, 0x34, 0x67, 0xf7, 0x10, 0xb7, 0x95, 0x07, 0x53, 0x4e, 0x61, 0xb4,
+	0xae, 0x0b, 0x49, 0x7e, 0x9b, 0xf4, 0xae, 0x0f, 0xa6, 0xad, 0x01, 0x72, 0xb3, 0x28, 0x0b, 0xab,
+	0x85, 0xb4, 0x3f, 0xe6, 0x4a, 0x61, 0x48, 0x37, 0x29, 0xb5, 0x0f, 0xeb, 0x24, 0x9b, 0x40, 0xbc,
+	0xc5, 0xdd, 0x66, 0x6b, 0xd3, 0xc1, 0x34, 0x98, 0xf5, 0xb9, 0x57, 0xd9, 0x6b, 0x00, 0x63, 0x8e,
+	0x12, 0x77, 0x95, 0xfd, 0x23, 0xfd, 0x04, 0x62, 0x77, 0x60, 0xec, 0x2a, 0xbd, 0x72, 0xbe, 0x46,
+	0x53, 0xab, 0xb6, 0x31, 0xe1, 0x5e, 0x39, 0xc6, 0x8d, 0x30, 0x8f, 0x06, 0x57, 0x69, 0x44, 0x28,
+	0x9d, 0xcc, 0x24, 0x0c, 0x73, 0x73, 0xbb, 0xc7, 0xc2, 0xfe, 0x1a, 0xe2, 0x04, 0x22, 0x74, 0x3f,
+	0x7a, 0x86, 0x56, 0xb8, 0x94, 0x95, 0xb0, 0xc2, 0x03, 0xd0, 0x9c, 0x5d, 0xc1, 0x7f, 0x8e, 0x95,
+	0x6a, 0x72, 0xf3, 0x50, 0xa3, 0x6e, 0x3e, 0x61, 0x06, 0xdf, 0x61, 0x86, 0x5f, 0x30, 0x9f, 0x62,
+	0x7a, 0xb3, 0x97, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0x97, 0x55, 0x1c, 0xbf, 0x02, 0x00,
+	0x00,
+}
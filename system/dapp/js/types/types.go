@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types js插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// JsX 执行器名称
+	JsX        = "js"
+	actionName = map[string]int32{
+		"Create": JsActionCreate,
+		"Call":   JsActionCall,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogJsCreate: {Ty: reflect.TypeOf(JsContract{}), Name: "LogJsCreate"},
+		TyLogJsCall:   {Ty: reflect.TypeOf(ReceiptJsCall{}), Name: "LogJsCall"},
+		TyLogJsEvent:  {Ty: reflect.TypeOf(JsEvent{}), Name: "LogJsEvent"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(JsX))
+	types.RegistorExecutor(JsX, NewType())
+	types.RegisterDappFork(JsX, "Enable", 0)
+}
+
+// JsType js执行器类型
+type JsType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a js type object
+func NewType() *JsType {
+	j := &JsType{}
+	j.SetChild(j)
+	return j
+}
+
+// GetPayload return js action
+func (j *JsType) GetPayload() types.Message {
+	return &JsAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (j *JsType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (j *JsType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (j *JsType) GetName() string {
+	return JsX
+}
+
+// CheckName 合约名由小写字母、数字和下划线组成，以小写字母开头
+func CheckName(name string) error {
+	if len(name) == 0 || len(name) > MaxNameLength || name[0] < 'a' || name[0] > 'z' {
+		return ErrContractName
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') && c != '_' {
+			return ErrContractName
+		}
+	}
+	return nil
+}
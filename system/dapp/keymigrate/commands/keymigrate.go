@@ -0,0 +1,113 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands keymigrate插件命令
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	kty "github.com/33cn/chain33/system/dapp/keymigrate/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// KeyMigrateCmd keymigrate command
+func KeyMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keymigrate",
+		Short: "Migrate address to a successor key of another sign type",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		MigrateCmd(),
+		QueryMigrationCmd(),
+	)
+
+	return cmd
+}
+
+// MigrateCmd migrate address to a new key
+func MigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Create a transaction to authorize a successor key for address, sign it with the old key",
+		Run:   migrate,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address to migrate")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("key", "k", "", "private key of the successor, used to prove possession")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().StringP("sign_type", "t", "sm2", "sign type of the successor key")
+	cmd.Flags().BoolP("revoke", "r", false, "revoke the old key of address")
+	return cmd
+}
+
+func migrate(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	addr, _ := cmd.Flags().GetString("addr")
+	key, _ := cmd.Flags().GetString("key")
+	signType, _ := cmd.Flags().GetString("sign_type")
+	revoke, _ := cmd.Flags().GetBool("revoke")
+	c, err := crypto.New(types.GetSignName("", types.GetSignType("", signType)))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	b, err := common.FromHex(key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv, err := c.PrivKeyFromBytes(b)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &kty.KeyMigrateAction{
+		Ty:    kty.KeyMigrateActionMigrate,
+		Value: &kty.KeyMigrateAction_Migrate{Migrate: kty.SignMigrate(addr, int32(types.GetSignType("", signType)), priv, revoke)},
+	}
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	tx, err = types.FormatTx(util.GetParaExecName(paraName, kty.KeyMigrateX), tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	jsonclient.PrintString(hex.EncodeToString(types.Encode(tx)))
+}
+
+// QueryMigrationCmd query migration of address
+func QueryMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query the successor key of address",
+		Run:   queryMigration,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func queryMigration(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	addr, _ := cmd.Flags().GetString("addr")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, kty.KeyMigrateX)
+	params.FuncName = kty.FuncNameGetKeyMigration
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: addr})
+
+	var res kty.KeyMigration
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
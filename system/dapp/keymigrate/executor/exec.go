@@ -0,0 +1,16 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	kty "github.com/33cn/chain33/system/dapp/keymigrate/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Migrate 交易的签名地址授权新的公钥
+func (k *KeyMigrate) Exec_Migrate(payload *kty.KeyMigrate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(k, tx)
+	return action.migrate(payload)
+}
@@ -0,0 +1,56 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor keymigrate执行器，地址授权一个不同签名算法的新公钥作为继承者，新私钥可以代表原来的地址签名交易
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	kty "github.com/33cn/chain33/system/dapp/keymigrate/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.keymigrate")
+	driverName = kty.KeyMigrateX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&KeyMigrate{}))
+	drivers.RegisterTxCheck(driverName, checkTxKeyMigration)
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newKeyMigrate, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return keymigrate name
+func GetName() string {
+	return newKeyMigrate().GetName()
+}
+
+// KeyMigrate defines KeyMigrate object
+type KeyMigrate struct {
+	drivers.DriverBase
+}
+
+func newKeyMigrate() drivers.Driver {
+	k := &KeyMigrate{}
+	k.SetChild(k)
+	k.SetExecutorType(types.LoadExecutorType(driverName))
+	return k
+}
+
+// GetDriverName return a drivername
+func (k *KeyMigrate) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (k *KeyMigrate) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,78 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	kty "github.com/33cn/chain33/system/dapp/keymigrate/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendMigrateTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, param *kty.KeyMigrate) int32 {
+	_, detail, err := mock33.SendCallTx(priv, kty.KeyMigrateX, "Migrate", param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+//sendCoins 返回mempool检查交易的错误，addr不为空的时候用新私钥代表addr签名
+func sendCoins(mock33 *testnode.Chain33Mock, ty int32, priv crypto.PrivKey, addr, to string) error {
+	tx := util.CreateCoinsTx(priv, to, types.Coin)
+	if addr != "" {
+		tx.SignWithAddr(ty, priv, addr)
+	}
+	reply, err := mock33.GetAPI().SendTx(tx)
+	if err != nil {
+		return err
+	}
+	_, err = mock33.WaitTx(reply.GetMsg())
+	return err
+}
+
+func TestKeyMigrate(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	addr, priv := util.Genaddress()
+	other, _ := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(mock33.GetGenesisKey(), addr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	c, err := crypto.New("sm2")
+	assert.Nil(t, err)
+	newPriv, err := c.GenKey()
+	assert.Nil(t, err)
+
+	//迁移之前新私钥不能代表地址签名，证明签名的地址不对的时候迁移失败
+	assert.Equal(t, kty.ErrKeyMigrationNotFound, sendCoins(mock33, types.SM2, newPriv, addr, other))
+	ty := sendMigrateTx(t, mock33, priv, kty.SignMigrate(other, types.SM2, newPriv, false))
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendMigrateTx(t, mock33, priv, kty.SignMigrate(addr, types.SM2, newPriv, false))
+	assert.Equal(t, int32(types.ExecOk), ty)
+	msg, err := mock33.GetAPI().Query(kty.KeyMigrateX, kty.FuncNameGetKeyMigration, &types.ReqString{Data: addr})
+	assert.Nil(t, err)
+	m := msg.(*kty.KeyMigration)
+	assert.Equal(t, int32(types.SM2), m.SignTy)
+	assert.Equal(t, newPriv.PubKey().Bytes(), m.PubKey)
+
+	//新私钥和原私钥都可以代表地址签名，别的私钥不行
+	assert.Nil(t, sendCoins(mock33, types.SM2, newPriv, addr, other))
+	assert.Nil(t, sendCoins(mock33, types.SECP256K1, priv, "", other))
+	_, fakePriv := util.Genaddress()
+	assert.Equal(t, kty.ErrSuccessorKey, sendCoins(mock33, types.SECP256K1, fakePriv, addr, other))
+	acc := mock33.GetAccount(mock33.GetLastBlock().StateHash, other)
+	assert.Equal(t, 2*types.Coin, acc.Balance)
+
+	//作废原私钥以后只有新私钥可以签名
+	ty = sendMigrateTx(t, mock33, priv, kty.SignMigrate(addr, types.SM2, newPriv, true))
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, kty.ErrOldKeyRevoked, sendCoins(mock33, types.SECP256K1, priv, "", other))
+	assert.Nil(t, sendCoins(mock33, types.SM2, newPriv, addr, other))
+}
@@ -0,0 +1,114 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	kty "github.com/33cn/chain33/system/dapp/keymigrate/types"
+	"github.com/33cn/chain33/types"
+)
+
+var migrationKeyPrefix = "mavl-" + kty.KeyMigrateX + "-addr-"
+
+func calcMigrationKey(addr string) []byte {
+	return []byte(migrationKeyPrefix + addr)
+}
+
+// Action keymigrate交易的执行环境
+type Action struct {
+	db       dbm.KV
+	txhash   []byte
+	fromaddr string
+	pubKey   []byte
+	height   int64
+}
+
+// NewAction new a action object
+func NewAction(k *KeyMigrate, tx *types.Transaction) *Action {
+	return &Action{
+		db:       k.GetStateDB(),
+		txhash:   tx.Hash(),
+		fromaddr: tx.From(),
+		pubKey:   tx.PubKey(),
+		height:   k.GetHeight(),
+	}
+}
+
+func getMigration(db dbm.KV, addr string) (*kty.KeyMigration, error) {
+	value, err := db.Get(calcMigrationKey(addr))
+	if err != nil || value == nil {
+		return nil, kty.ErrKeyMigrationNotFound
+	}
+	var m kty.KeyMigration
+	if err := types.Decode(value, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+//checkTxKeyMigration 签名中有原地址的时候签名的公钥必须是迁移记录中的新公钥，
+//迁移时作废了原私钥的地址不能再用原私钥签名或者代付手续费，分叉之前没有迁移记录，不做检查
+func checkTxKeyMigration(db dbm.KV, tx *types.Transaction, height int64) error {
+	if !types.IsFork(height, "ForkKeyMigration") {
+		return nil
+	}
+	var addrs []string
+	if sign := tx.GetSignature(); sign.GetAddr() != "" {
+		m, err := getMigration(db, sign.Addr)
+		if err != nil {
+			return err
+		}
+		if m.SignTy != sign.Ty || !bytes.Equal(m.PubKey, tx.PubKey()) {
+			return kty.ErrSuccessorKey
+		}
+	} else {
+		addrs = append(addrs, tx.From())
+	}
+	if tx.FeePayer != nil {
+		addrs = append(addrs, tx.FeeAddr())
+	}
+	for _, addr := range addrs {
+		m, err := getMigration(db, addr)
+		if err == kty.ErrKeyMigrationNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if m.RevokeOld {
+			return kty.ErrOldKeyRevoked
+		}
+	}
+	return nil
+}
+
+//migrate 再次迁移的时候覆盖原来的记录，作废了原私钥以后只能由新私钥发起
+func (a *Action) migrate(payload *kty.KeyMigrate) (*types.Receipt, error) {
+	if !types.IsFork(a.height, "ForkKeyMigration") {
+		return nil, types.ErrActionNotSupport
+	}
+	if err := kty.CheckKeyMigrate(a.fromaddr, a.pubKey, payload); err != nil {
+		return nil, err
+	}
+	prev, err := getMigration(a.db, a.fromaddr)
+	if err != nil && err != kty.ErrKeyMigrationNotFound {
+		return nil, err
+	}
+	current := &kty.KeyMigration{
+		Addr:      a.fromaddr,
+		SignTy:    payload.SignTy,
+		PubKey:    payload.PubKey,
+		RevokeOld: payload.RevokeOld,
+		Height:    a.height,
+		TxHash:    common.ToHex(a.txhash),
+	}
+	kv := &types.KeyValue{Key: calcMigrationKey(a.fromaddr), Value: types.Encode(current)}
+	a.db.Set(kv.Key, kv.Value)
+	log := &types.ReceiptLog{Ty: kty.TyLogKeyMigrate, Log: types.Encode(&kty.ReceiptKeyMigration{Prev: prev, Current: current})}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}, nil
+}
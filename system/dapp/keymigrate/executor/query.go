@@ -0,0 +1,14 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetKeyMigration 获取地址的迁移记录
+func (k *KeyMigrate) Query_GetKeyMigration(in *types.ReqString) (types.Message, error) {
+	return getMigration(k.GetStateDB(), in.Data)
+}
@@ -0,0 +1,27 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package keymigrate 密钥迁移执行器插件
+// 1. 地址用原私钥签名迁移交易，授权一个新的公钥作为继承者，新公钥可以是不同的签名算法，比如从secp256k1迁移到sm2
+// 2. 迁移交易中带有新私钥的签名证明持有新私钥，签名的数据包含地址和链标识
+// 3. 新私钥签名的交易在签名中填写原地址，节点检查链上的迁移记录以后按原地址执行
+// 4. 迁移的时候可以作废原私钥，作废以后原私钥不能再代表这个地址签名
+package keymigrate
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/keymigrate/commands"
+	"github.com/33cn/chain33/system/dapp/keymigrate/executor"
+	"github.com/33cn/chain33/system/dapp/keymigrate/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.KeyMigrateX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.KeyMigrateCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,20 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// keymigrate action ty
+const (
+	KeyMigrateActionMigrate = iota + 1
+)
+
+// keymigrate log ty
+const (
+	TyLogKeyMigrate = 630
+)
+
+// query func name
+const (
+	FuncNameGetKeyMigration = "GetKeyMigration"
+)
@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrKeyMigrationNotFound 地址没有迁移记录
+	ErrKeyMigrationNotFound = errors.New("ErrKeyMigrationNotFound")
+	// ErrMigrateSignTy 新公钥的签名类型不支持
+	ErrMigrateSignTy = errors.New("ErrMigrateSignTy")
+	// ErrMigrateProof 新私钥对迁移数据的签名验证失败
+	ErrMigrateProof = errors.New("ErrMigrateProof")
+	// ErrMigrateSameKey 新公钥和交易签名的公钥相同
+	ErrMigrateSameKey = errors.New("ErrMigrateSameKey")
+	// ErrSuccessorKey 交易的签名公钥不是地址迁移记录中的公钥
+	ErrSuccessorKey = errors.New("ErrSuccessorKey")
+	// ErrOldKeyRevoked 地址原来的私钥已经在迁移的时候作废
+	ErrOldKeyRevoked = errors.New("ErrOldKeyRevoked")
+)
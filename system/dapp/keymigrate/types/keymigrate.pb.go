@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: keymigrate.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type KeyMigrateAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*KeyMigrateAction_Migrate
+	Value                isKeyMigrateAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                    `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *KeyMigrateAction) Reset()         { *m = KeyMigrateAction{} }
+func (m *KeyMigrateAction) String() string { return proto.CompactTextString(m) }
+func (*KeyMigrateAction) ProtoMessage()    {}
+func (*KeyMigrateAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16f893cf763eec22, []int{0}
+}
+
+func (m *KeyMigrateAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyMigrateAction.Unmarshal(m, b)
+}
+func (m *KeyMigrateAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyMigrateAction.Marshal(b, m, deterministic)
+}
+func (m *KeyMigrateAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyMigrateAction.Merge(m, src)
+}
+func (m *KeyMigrateAction) XXX_Size() int {
+	return xxx_messageInfo_KeyMigrateAction.Size(m)
+}
+func (m *KeyMigrateAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyMigrateAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyMigrateAction proto.InternalMessageInfo
+
+type isKeyMigrateAction_Value interface {
+	isKeyMigrateAction_Value()
+}
+
+type KeyMigrateAction_Migrate struct {
+	Migrate *KeyMigrate `protobuf:"bytes,1,opt,name=migrate,proto3,oneof"`
+}
+
+func (*KeyMigrateAction_Migrate) isKeyMigrateAction_Value() {}
+
+func (m *KeyMigrateAction) GetValue() isKeyMigrateAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *KeyMigrateAction) GetMigrate() *KeyMigrate {
+	if x, ok := m.GetValue().(*KeyMigrateAction_Migrate); ok {
+		return x.Migrate
+	}
+	return nil
+}
+
+func (m *KeyMigrateAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*KeyMigrateAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _KeyMigrateAction_OneofMarshaler, _KeyMigrateAction_OneofUnmarshaler, _KeyMigrateAction_OneofSizer, []interface{}{
+		(*KeyMigrateAction_Migrate)(nil),
+	}
+}
+
+func _KeyMigrateAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*KeyMigrateAction)
+	// value
+	switch x := m.Value.(type) {
+	case *KeyMigrateAction_Migrate:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Migrate); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("KeyMigrateAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _KeyMigrateAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*KeyMigrateAction)
+	switch tag {
+	case 1: // value.migrate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(KeyMigrate)
+		err := b.DecodeMessage(msg)
+		m.Value = &KeyMigrateAction_Migrate{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _KeyMigrateAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*KeyMigrateAction)
+	// value
+	switch x := m.Value.(type) {
+	case *KeyMigrateAction_Migrate:
+		s := proto.Size(x.Migrate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//交易的签名地址授权一个新的公钥作为继承者，新公钥可以是不同的签名算法
+//signature 是新私钥对 MigrateSignData 的签名，证明持有新私钥
+//revokeOld 为true的时候原来的私钥不能再代表这个地址签名
+type KeyMigrate struct {
+	SignTy               int32    `protobuf:"varint,1,opt,name=signTy,proto3" json:"signTy,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	RevokeOld            bool     `protobuf:"varint,4,opt,name=revokeOld,proto3" json:"revokeOld,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyMigrate) Reset()         { *m = KeyMigrate{} }
+func (m *KeyMigrate) String() string { return proto.CompactTextString(m) }
+func (*KeyMigrate) ProtoMessage()    {}
+func (*KeyMigrate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16f893cf763eec22, []int{1}
+}
+
+func (m *KeyMigrate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyMigrate.Unmarshal(m, b)
+}
+func (m *KeyMigrate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyMigrate.Marshal(b, m, deterministic)
+}
+func (m *KeyMigrate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyMigrate.Merge(m, src)
+}
+func (m *KeyMigrate) XXX_Size() int {
+	return xxx_messageInfo_KeyMigrate.Size(m)
+}
+func (m *KeyMigrate) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyMigrate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyMigrate proto.InternalMessageInfo
+
+func (m *KeyMigrate) GetSignTy() int32 {
+	if m != nil {
+		return m.SignTy
+	}
+	return 0
+}
+
+func (m *KeyMigrate) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *KeyMigrate) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *KeyMigrate) GetRevokeOld() bool {
+	if m != nil {
+		return m.RevokeOld
+	}
+	return false
+}
+
+//地址的迁移记录，再次迁移的时候覆盖
+type KeyMigration struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	SignTy               int32    `protobuf:"varint,2,opt,name=signTy,proto3" json:"signTy,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	RevokeOld            bool     `protobuf:"varint,4,opt,name=revokeOld,proto3" json:"revokeOld,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash               string   `protobuf:"bytes,6,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyMigration) Reset()         { *m = KeyMigration{} }
+func (m *KeyMigration) String() string { return proto.CompactTextString(m) }
+func (*KeyMigration) ProtoMessage()    {}
+func (*KeyMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16f893cf763eec22, []int{2}
+}
+
+func (m *KeyMigration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyMigration.Unmarshal(m, b)
+}
+func (m *KeyMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyMigration.Marshal(b, m, deterministic)
+}
+func (m *KeyMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyMigration.Merge(m, src)
+}
+func (m *KeyMigration) XXX_Size() int {
+	return xxx_messageInfo_KeyMigration.Size(m)
+}
+func (m *KeyMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyMigration proto.InternalMessageInfo
+
+func (m *KeyMigration) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *KeyMigration) GetSignTy() int32 {
+	if m != nil {
+		return m.SignTy
+	}
+	return 0
+}
+
+func (m *KeyMigration) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *KeyMigration) GetRevokeOld() bool {
+	if m != nil {
+		return m.RevokeOld
+	}
+	return false
+}
+
+func (m *KeyMigration) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *KeyMigration) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type ReceiptKeyMigration struct {
+	Prev                 *KeyMigration `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *KeyMigration `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReceiptKeyMigration) Reset()         { *m = ReceiptKeyMigration{} }
+func (m *ReceiptKeyMigration) String() string { return proto.CompactTextString(m) }
+func (*ReceiptKeyMigration) ProtoMessage()    {}
+func (*ReceiptKeyMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16f893cf763eec22, []int{3}
+}
+
+func (m *ReceiptKeyMigration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptKeyMigration.Unmarshal(m, b)
+}
+func (m *ReceiptKeyMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptKeyMigration.Marshal(b, m, deterministic)
+}
+func (m *ReceiptKeyMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptKeyMigration.Merge(m, src)
+}
+func (m *ReceiptKeyMigration) XXX_Size() int {
+	return xxx_messageInfo_ReceiptKeyMigration.Size(m)
+}
+func (m *ReceiptKeyMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptKeyMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptKeyMigration proto.InternalMessageInfo
+
+func (m *ReceiptKeyMigration) GetPrev() *KeyMigration {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptKeyMigration) GetCurrent() *KeyMigration {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*KeyMigrateAction)(nil), "types.KeyMigrateAction")
+	proto.RegisterType((*KeyMigrate)(nil), "types.KeyMigrate")
+	proto.RegisterType((*KeyMigration)(nil), "types.KeyMigration")
+	proto.RegisterType((*ReceiptKeyMigration)(nil), "types.ReceiptKeyMigration")
+}
+
+func init() { proto.RegisterFile("keymigrate.proto", fileDescriptor_16f893cf763eec22) }
+
+var fileDescriptor_16f893cf763eec22 = []byte{
+	// 283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x75, 0x91, 0xcf, 0x4e, 0xc3, 0x30,
+	0x0c, 0xc6, 0xe9, 0xdf, 0x31, 0x33, 0xa1, 0x91, 0x49, 0x28, 0x07, 0x0e, 0xa8, 0x17, 0xb8, 0xd0,
+	0x03, 0x3c, 0x01, 0x9c, 0x26, 0x21, 0x34, 0x29, 0xda, 0x89, 0x5b, 0xd6, 0x5a, 0x6d, 0xb4, 0xad,
+	0xad, 0xd2, 0x74, 0x5a, 0xdf, 0x86, 0x47, 0x25, 0x09, 0x19, 0x85, 0x49, 0xbd, 0xd9, 0xfe, 0x7e,
+	0xb1, 0xbf, 0xd8, 0x30, 0xdf, 0x62, 0xbf, 0x17, 0x85, 0xe4, 0x0a, 0xd3, 0x46, 0xd6, 0xaa, 0x26,
+	0x91, 0xea, 0x1b, 0x6c, 0x93, 0x4f, 0x98, 0xbf, 0x63, 0xff, 0xf1, 0x23, 0xbd, 0x66, 0x4a, 0xd4,
+	0x15, 0x79, 0x82, 0x89, 0x63, 0xa9, 0x77, 0xef, 0x3d, 0x5e, 0x3d, 0xdf, 0xa4, 0x16, 0x4e, 0x07,
+	0x72, 0x79, 0xc1, 0x4e, 0x0c, 0xb9, 0x06, 0x5f, 0xf5, 0xd4, 0xd7, 0x64, 0xc4, 0x74, 0xf4, 0x36,
+	0x81, 0xe8, 0xc0, 0x77, 0x1d, 0x26, 0x47, 0x80, 0xe1, 0x05, 0xb9, 0x85, 0xb8, 0x15, 0x45, 0xb5,
+	0xee, 0x6d, 0xd3, 0x88, 0xb9, 0xcc, 0xd4, 0x9b, 0x6e, 0xa3, 0x41, 0xdb, 0x62, 0xc6, 0x5c, 0x46,
+	0xee, 0x60, 0x6a, 0x08, 0xae, 0x3a, 0x89, 0x34, 0xb0, 0xd2, 0x50, 0x30, 0xaa, 0xc4, 0x43, 0xbd,
+	0xc5, 0xd5, 0x2e, 0xa7, 0xa1, 0x56, 0x2f, 0xd9, 0x50, 0x48, 0xbe, 0x3c, 0x98, 0xfd, 0x8e, 0x36,
+	0x5f, 0x22, 0x10, 0xf2, 0x3c, 0x97, 0x76, 0xf4, 0x94, 0xd9, 0xf8, 0x8f, 0x21, 0x7f, 0xc4, 0x50,
+	0x70, 0x6e, 0x68, 0x7c, 0xa4, 0x79, 0x55, 0xa2, 0x28, 0x4a, 0x45, 0x23, 0x2d, 0x05, 0xcc, 0x65,
+	0xa6, 0xae, 0x8e, 0x4b, 0xde, 0x96, 0x34, 0xb6, 0xb3, 0x5d, 0x96, 0xec, 0x61, 0xc1, 0x30, 0x43,
+	0xd1, 0xa8, 0x7f, 0x46, 0x1f, 0x20, 0x6c, 0x74, 0x53, 0xb7, 0xf8, 0xc5, 0xf9, 0xe2, 0x35, 0xc2,
+	0x2c, 0x60, 0x8e, 0x94, 0x75, 0x52, 0x62, 0xa5, 0xac, 0xfd, 0x11, 0xf6, 0xc4, 0x6c, 0x62, 0x7b,
+	0xf5, 0x97, 0x6f, 0x13, 0x32, 0x4a, 0x5b, 0x09, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,97 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types keymigrate插件相关的定义
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// KeyMigrateX 执行器名称
+	KeyMigrateX = "keymigrate"
+	actionName  = map[string]int32{
+		"Migrate": KeyMigrateActionMigrate,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogKeyMigrate: {Ty: reflect.TypeOf(ReceiptKeyMigration{}), Name: "LogKeyMigrate"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(KeyMigrateX))
+	types.RegistorExecutor(KeyMigrateX, NewType())
+	types.RegisterDappFork(KeyMigrateX, "Enable", 0)
+}
+
+// KeyMigrateType keymigrate执行器类型
+type KeyMigrateType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a keymigrate type object
+func NewType() *KeyMigrateType {
+	c := &KeyMigrateType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return keymigrate action
+func (k *KeyMigrateType) GetPayload() types.Message {
+	return &KeyMigrateAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (k *KeyMigrateType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (k *KeyMigrateType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (k *KeyMigrateType) GetName() string {
+	return KeyMigrateX
+}
+
+//MigrateSignData 新私钥证明持有权签名的数据，包含链标识防止在别的链上重放
+func MigrateSignData(addr string) []byte {
+	return []byte(fmt.Sprintf("%s-migrate-%s-%d", KeyMigrateX, addr, types.GetChainID()))
+}
+
+//SignMigrate 用新私钥生成迁移addr的参数
+func SignMigrate(addr string, signTy int32, priv crypto.PrivKey, revokeOld bool) *KeyMigrate {
+	return &KeyMigrate{
+		SignTy:    signTy,
+		PubKey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(MigrateSignData(addr)).Bytes(),
+		RevokeOld: revokeOld,
+	}
+}
+
+//CheckKeyMigrate 检查新公钥的签名类型和持有权证明，多重签名不能作为继承者
+func CheckKeyMigrate(addr string, oldPubKey []byte, m *KeyMigrate) error {
+	if m.SignTy == types.MultiSigSign || len(m.PubKey) == 0 {
+		return ErrMigrateSignTy
+	}
+	if _, err := crypto.New(types.GetSignName("", int(m.SignTy))); err != nil {
+		return ErrMigrateSignTy
+	}
+	if bytes.Equal(m.PubKey, oldPubKey) {
+		return ErrMigrateSameKey
+	}
+	sign := &types.Signature{Ty: m.SignTy, Pubkey: m.PubKey, Signature: m.Signature}
+	if !types.CheckSign(MigrateSignData(addr), "", sign) {
+		return ErrMigrateProof
+	}
+	return nil
+}
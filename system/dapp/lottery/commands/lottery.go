@@ -0,0 +1,262 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands lottery插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	lty "github.com/33cn/chain33/system/dapp/lottery/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// LotteryCmd lottery command
+func LotteryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lottery",
+		Short: "Lottery with block hash based draws",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CreateCmd(),
+		BuyCmd(),
+		DrawCmd(),
+		CloseCmd(),
+		QueryLotteryCmd(),
+		QueryRoundCmd(),
+		ListRoundsCmd(),
+		ListPurchasesCmd(),
+	)
+
+	return cmd
+}
+
+// CreateCmd create lottery
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a transaction to create a lottery",
+		Run:   create,
+	}
+	cmd.Flags().Float64P("price", "p", 0, "ticket price")
+	cmd.MarkFlagRequired("price")
+	cmd.Flags().Int64P("purchase", "b", 0, "purchase blocks of each round")
+	cmd.MarkFlagRequired("purchase")
+	cmd.Flags().Int64P("delay", "d", 1, "blocks between purchase end and draw block")
+	cmd.Flags().Int32P("digits", "n", 0, "digits of lottery number")
+	cmd.MarkFlagRequired("digits")
+	cmd.Flags().IntSliceP("ratios", "r", nil, "prize ratios in per mille, from jackpot to the lowest tier")
+	cmd.MarkFlagRequired("ratios")
+	cmd.Flags().Float64P("fund", "f", 0, "initial fund of pool")
+	return cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	price, _ := cmd.Flags().GetFloat64("price")
+	purchase, _ := cmd.Flags().GetInt64("purchase")
+	delay, _ := cmd.Flags().GetInt64("delay")
+	digits, _ := cmd.Flags().GetInt32("digits")
+	ratios, _ := cmd.Flags().GetIntSlice("ratios")
+	fund, _ := cmd.Flags().GetFloat64("fund")
+	var prizeRatios []int32
+	for _, ratio := range ratios {
+		prizeRatios = append(prizeRatios, int32(ratio))
+	}
+	payload := &lty.LotteryCreate{
+		TicketPrice:    commandtypes.FormatAmountDisplay2Value(price),
+		PurchaseBlocks: purchase,
+		DrawDelay:      delay,
+		Digits:         digits,
+		PrizeRatios:    prizeRatios,
+		Fund:           commandtypes.FormatAmountDisplay2Value(fund),
+	}
+	if err := lty.CheckCreate(payload); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, lty.LotteryX, &lty.LotteryAction{
+		Ty:    lty.LotteryActionCreate,
+		Value: &lty.LotteryAction_Create{Create: payload},
+	})
+}
+
+// BuyCmd buy tickets
+func BuyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy",
+		Short: "Create a transaction to buy tickets of current round",
+		Run:   buy,
+	}
+	cmd.Flags().StringP("id", "i", "", "lottery id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().Int64P("number", "n", 0, "lottery number")
+	cmd.MarkFlagRequired("number")
+	cmd.Flags().Int64P("count", "c", 1, "ticket count")
+	return cmd
+}
+
+func buy(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	number, _ := cmd.Flags().GetInt64("number")
+	count, _ := cmd.Flags().GetInt64("count")
+	commandtypes.CreateActionTx(cmd, lty.LotteryX, &lty.LotteryAction{
+		Ty:    lty.LotteryActionBuy,
+		Value: &lty.LotteryAction_Buy{Buy: &lty.LotteryBuy{LotteryID: id, Number: number, Count: count}},
+	})
+}
+
+// DrawCmd draw current round
+func DrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draw",
+		Short: "Create a transaction to draw current round",
+		Run:   draw,
+	}
+	cmd.Flags().StringP("id", "i", "", "lottery id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func draw(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, lty.LotteryX, &lty.LotteryAction{
+		Ty:    lty.LotteryActionDraw,
+		Value: &lty.LotteryAction_Draw{Draw: &lty.LotteryDraw{LotteryID: id}},
+	})
+}
+
+// CloseCmd close lottery
+func CloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close",
+		Short: "Create a transaction to close lottery and take back the pool",
+		Run:   closeLottery,
+	}
+	cmd.Flags().StringP("id", "i", "", "lottery id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func closeLottery(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, lty.LotteryX, &lty.LotteryAction{
+		Ty:    lty.LotteryActionClose,
+		Value: &lty.LotteryAction_Close{Close: &lty.LotteryClose{LotteryID: id}},
+	})
+}
+
+func queryLottery(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, lty.LotteryX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryLotteryCmd query lottery
+func QueryLotteryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Query lottery by id",
+		Run:   queryInfo,
+	}
+	cmd.Flags().StringP("id", "i", "", "lottery id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryInfo(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res lty.Lottery
+	queryLottery(cmd, lty.FuncNameGetLottery, &types.ReqString{Data: id}, &res)
+}
+
+// QueryRoundCmd query round
+func QueryRoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "round",
+		Short: "Query draw result of round",
+		Run:   queryRound,
+	}
+	cmd.Flags().StringP("id", "i", "", "lottery id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().Int64P("round", "r", 0, "round, 0 for current round")
+	return cmd
+}
+
+func queryRound(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	round, _ := cmd.Flags().GetInt64("round")
+	var res lty.LotteryRound
+	queryLottery(cmd, lty.FuncNameGetRound, &lty.ReqLotteryRound{LotteryID: id, Round: round}, &res)
+}
+
+// ListRoundsCmd list rounds
+func ListRoundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rounds",
+		Short: "List round history of lottery",
+		Run:   listRounds,
+	}
+	cmd.Flags().StringP("id", "i", "", "lottery id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().Int64P("round", "r", 0, "list from this round, 0 for latest or first round")
+	cmd.Flags().Int32P("count", "c", lty.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listRounds(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	round, _ := cmd.Flags().GetInt64("round")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res lty.ReplyLotteryRounds
+	queryLottery(cmd, lty.FuncNameListRounds, &lty.ReqLotteryRounds{LotteryID: id, Round: round, Count: count, Direction: direction}, &res)
+}
+
+// ListPurchasesCmd list purchases of round or address
+func ListPurchasesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purchases",
+		Short: "List purchases of round, or of address if addr is set",
+		Run:   listPurchases,
+	}
+	cmd.Flags().StringP("id", "i", "", "lottery id")
+	cmd.Flags().Int64P("round", "r", 0, "round")
+	cmd.Flags().Int64P("seq", "s", 0, "list from this sequence of round")
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.Flags().StringP("primary", "p", "", "list after this primary key of address")
+	cmd.Flags().Int32P("count", "c", lty.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listPurchases(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	round, _ := cmd.Flags().GetInt64("round")
+	seq, _ := cmd.Flags().GetInt64("seq")
+	addr, _ := cmd.Flags().GetString("addr")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res lty.ReplyLotteryPurchases
+	if addr != "" {
+		queryLottery(cmd, lty.FuncNameListAddrPurchases, &lty.ReqLotteryAddrPurchases{Addr: addr, PrimaryKey: primary, Count: count, Direction: direction}, &res)
+		return
+	}
+	queryLottery(cmd, lty.FuncNameListPurchases, &lty.ReqLotteryPurchases{LotteryID: id, Round: round, Seq: seq, Count: count}, &res)
+}
@@ -0,0 +1,34 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	lty "github.com/33cn/chain33/system/dapp/lottery/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Create 创建彩票，开始第一期
+func (l *Lottery) Exec_Create(payload *lty.LotteryCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(l, tx, index)
+	return action.create(payload)
+}
+
+// Exec_Buy 购买当前一期的彩票
+func (l *Lottery) Exec_Buy(payload *lty.LotteryBuy, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(l, tx, index)
+	return action.buy(payload)
+}
+
+// Exec_Draw 开奖当前一期，派奖以后开始下一期
+func (l *Lottery) Exec_Draw(payload *lty.LotteryDraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(l, tx, index)
+	return action.draw(payload)
+}
+
+// Exec_Close 创建者关闭彩票
+func (l *Lottery) Exec_Close(payload *lty.LotteryClose, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(l, tx, index)
+	return action.close(payload)
+}
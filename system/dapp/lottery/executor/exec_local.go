@@ -0,0 +1,49 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	lty "github.com/33cn/chain33/system/dapp/lottery/types"
+	"github.com/33cn/chain33/types"
+)
+
+//execLocal 购买记录按地址索引
+func (l *Lottery) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != lty.TyLogLotteryPurchase {
+			continue
+		}
+		var purchase lty.LotteryPurchase
+		if err := types.Decode(item.Log, &purchase); err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, &types.KeyValue{Key: calcAddrIndexKey(purchase.Addr, purchasePrimaryKey(&purchase)), Value: item.Log})
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+// ExecLocal_Create 创建彩票不会产生索引
+func (l *Lottery) ExecLocal_Create(payload *lty.LotteryCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return l.execLocal(tx, receipt)
+}
+
+// ExecLocal_Buy 添加购买记录的索引
+func (l *Lottery) ExecLocal_Buy(payload *lty.LotteryBuy, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return l.execLocal(tx, receipt)
+}
+
+// ExecLocal_Draw 开奖不会产生索引
+func (l *Lottery) ExecLocal_Draw(payload *lty.LotteryDraw, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return l.execLocal(tx, receipt)
+}
+
+// ExecLocal_Close 关闭彩票不会产生索引
+func (l *Lottery) ExecLocal_Close(payload *lty.LotteryClose, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return l.execLocal(tx, receipt)
+}
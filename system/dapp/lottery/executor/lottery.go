@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor lottery执行器，按期销售彩票，用开奖高度区块的hash生成开奖号码，按奖级派奖
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	lty "github.com/33cn/chain33/system/dapp/lottery/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.lottery")
+	driverName = lty.LotteryX
+)
+
+func init() {
+	et := types.LoadExecutorType(driverName)
+	et.InitFuncList(types.ListMethod(&Lottery{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newLottery, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return lottery name
+func GetName() string {
+	return newLottery().GetName()
+}
+
+// Lottery defines Lottery object
+type Lottery struct {
+	drivers.DriverBase
+}
+
+func newLottery() drivers.Driver {
+	l := &Lottery{}
+	l.SetChild(l)
+	l.SetExecutorType(types.LoadExecutorType(driverName))
+	return l
+}
+
+// GetDriverName return a drivername
+func (l *Lottery) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (l *Lottery) CheckReceiptExecOk() bool {
+	return true
+}
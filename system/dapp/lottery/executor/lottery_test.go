@@ -0,0 +1,201 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	lty "github.com/33cn/chain33/system/dapp/lottery/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendLotteryTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) (int32, int64) {
+	_, detail, err := mock33.SendCallTx(priv, lty.LotteryX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, detail.Height*types.MaxTxsPerBlock + detail.Index
+}
+
+func query(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(lty.LotteryX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func getLottery(t *testing.T, mock33 *testnode.Chain33Mock, id string) *lty.Lottery {
+	return query(t, mock33, lty.FuncNameGetLottery, &types.ReqString{Data: id}).(*lty.Lottery)
+}
+
+func getRound(t *testing.T, mock33 *testnode.Chain33Mock, id string, round int64) *lty.LotteryRound {
+	return query(t, mock33, lty.FuncNameGetRound, &lty.ReqLotteryRound{LotteryID: id, Round: round}).(*lty.LotteryRound)
+}
+
+func TestLottery(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	creator := mock33.GetGenesisKey()
+	creatorAddr := mock33.GetGenesisAddress()
+	p1, p1Priv := util.Genaddress()
+	p2, p2Priv := util.Genaddress()
+	for _, to := range []string{p1, p2} {
+		mock33.SendTx(util.CreateCoinsTx(creator, to, 100*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	for _, priv := range []crypto.PrivKey{creator, p1Priv, p2Priv} {
+		mock33.SendTx(util.CreateCoinsTx(priv, address.ExecAddress(lty.LotteryX), 50*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+
+	create := &lty.LotteryCreate{TicketPrice: types.Coin, PurchaseBlocks: 20, DrawDelay: 2, Digits: 2, PrizeRatios: []int32{500, 200}, Fund: 10 * types.Coin}
+	for _, bad := range []*lty.LotteryCreate{
+		{TicketPrice: types.Coin, PurchaseBlocks: 20, DrawDelay: 2, Digits: 1, PrizeRatios: []int32{500, 200}},
+		{TicketPrice: types.Coin, PurchaseBlocks: 20, DrawDelay: 2, Digits: 2, PrizeRatios: []int32{800, 201}},
+		{TicketPrice: types.Coin, PurchaseBlocks: 20, DrawDelay: 0, Digits: 2, PrizeRatios: []int32{500}},
+		{TicketPrice: types.Coin, PurchaseBlocks: 20, DrawDelay: 2, Digits: 2, PrizeRatios: []int32{500}, Fund: 100 * types.Coin},
+	} {
+		ty, _ := sendLotteryTx(t, mock33, creator, "Create", bad)
+		assert.Equal(t, int32(types.ExecPack), ty)
+	}
+	ty, pos := sendLotteryTx(t, mock33, creator, "Create", create)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	id := fmt.Sprintf("%018d", pos)
+	lottery := getLottery(t, mock33, id)
+	assert.Equal(t, creatorAddr, lottery.Creator)
+	assert.Equal(t, int64(1), lottery.Round)
+	assert.Equal(t, 40*types.Coin, mock33.GetExecBalance(lty.LotteryX, creatorAddr))
+
+	//p1 买 0-4，p2 每个号码买两注 5-9，开奖号码的个位一定有一笔购买中奖
+	for n := int64(0); n < 10; n++ {
+		priv, count := p1Priv, int64(1)
+		if n >= 5 {
+			priv, count = p2Priv, 2
+		}
+		ty, _ = sendLotteryTx(t, mock33, priv, "Buy", &lty.LotteryBuy{LotteryID: id, Number: n, Count: count})
+		assert.Equal(t, int32(types.ExecOk), ty)
+	}
+	for _, bad := range []*lty.LotteryBuy{
+		{LotteryID: id, Number: 100, Count: 1},
+		{LotteryID: id, Number: 1, Count: 0},
+		{LotteryID: "1", Number: 1, Count: 1},
+	} {
+		ty, _ = sendLotteryTx(t, mock33, p1Priv, "Buy", bad)
+		assert.Equal(t, int32(types.ExecPack), ty)
+	}
+	ty, _ = sendLotteryTx(t, mock33, creator, "Draw", &lty.LotteryDraw{LotteryID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendLotteryTx(t, mock33, p1Priv, "Close", &lty.LotteryClose{LotteryID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendLotteryTx(t, mock33, creator, "Close", &lty.LotteryClose{LotteryID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	round := getRound(t, mock33, id, 0)
+	assert.Equal(t, int64(10), round.Purchases)
+	assert.Equal(t, int64(15), round.Tickets)
+	assert.Equal(t, 25*types.Coin, getLottery(t, mock33, id).Pool)
+
+	//停止购买以后到开奖高度之前不能购买也不能开奖
+	assert.Nil(t, mock33.CreateBlocksTo(round.EndHeight))
+	ty, _ = sendLotteryTx(t, mock33, p1Priv, "Buy", &lty.LotteryBuy{LotteryID: id, Number: 1, Count: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.Nil(t, mock33.CreateBlocksTo(round.DrawHeight-1))
+	ty, _ = sendLotteryTx(t, mock33, p1Priv, "Draw", &lty.LotteryDraw{LotteryID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendLotteryTx(t, mock33, p1Priv, "Draw", &lty.LotteryDraw{LotteryID: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	//开奖号码可以用开奖区块的hash验证，个位相同的购买中二等奖，完全相同中头奖
+	round = getRound(t, mock33, id, 1)
+	assert.Equal(t, int32(lty.RoundDrawn), round.Status)
+	hash, err := mock33.GetAPI().GetBlockHash(&types.ReqInt{Height: round.DrawHeight})
+	assert.Nil(t, err)
+	assert.Equal(t, randHash(hash.Hash, id, 1), round.RandHash)
+	assert.Equal(t, new(big.Int).Mod(new(big.Int).SetBytes(round.RandHash), big.NewInt(100)).Int64(), round.LuckyNumber)
+	assert.Equal(t, 25*types.Coin, round.PrizePool)
+	assert.Equal(t, 1, len(round.Winners))
+	winner := round.Winners[0]
+	assert.Equal(t, round.LuckyNumber%10, winner.Number)
+	tier, prize := int32(1), 5*types.Coin
+	if round.LuckyNumber < 10 {
+		tier, prize = 0, 25*types.Coin/2
+	}
+	assert.Equal(t, tier, winner.Tier)
+	assert.Equal(t, prize, winner.Prize)
+	assert.Equal(t, prize, round.PrizePaid)
+	if winner.Number < 5 {
+		assert.Equal(t, p1, winner.Addr)
+		assert.Equal(t, 45*types.Coin+prize, mock33.GetExecBalance(lty.LotteryX, p1))
+	} else {
+		assert.Equal(t, p2, winner.Addr)
+		assert.Equal(t, 40*types.Coin+prize, mock33.GetExecBalance(lty.LotteryX, p2))
+	}
+	lottery = getLottery(t, mock33, id)
+	assert.Equal(t, int64(2), lottery.Round)
+	assert.Equal(t, 25*types.Coin-prize, lottery.Pool)
+
+	//过了开奖高度以后购买先自动开奖，没有人购买的一期奖池全部滚入下一期
+	round = getRound(t, mock33, id, 2)
+	assert.Nil(t, mock33.CreateBlocksTo(round.DrawHeight))
+	ty, _ = sendLotteryTx(t, mock33, p1Priv, "Buy", &lty.LotteryBuy{LotteryID: id, Number: 42, Count: 3})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	round = getRound(t, mock33, id, 2)
+	assert.Equal(t, int32(lty.RoundDrawn), round.Status)
+	assert.Equal(t, 0, len(round.Winners))
+	round = getRound(t, mock33, id, 0)
+	assert.Equal(t, int64(3), round.Round)
+	assert.Equal(t, int64(1), round.Purchases)
+	assert.Equal(t, 28*types.Coin-prize, getLottery(t, mock33, id).Pool)
+
+	rounds := query(t, mock33, lty.FuncNameListRounds, &lty.ReqLotteryRounds{LotteryID: id}).(*lty.ReplyLotteryRounds)
+	assert.Equal(t, 3, len(rounds.Rounds))
+	assert.Equal(t, int64(3), rounds.Rounds[0].Round)
+	rounds = query(t, mock33, lty.FuncNameListRounds, &lty.ReqLotteryRounds{LotteryID: id, Round: 2, Count: 5, Direction: 1}).(*lty.ReplyLotteryRounds)
+	assert.Equal(t, 2, len(rounds.Rounds))
+	assert.Equal(t, int64(2), rounds.Rounds[0].Round)
+	purchases := query(t, mock33, lty.FuncNameListPurchases, &lty.ReqLotteryPurchases{LotteryID: id, Round: 1, Seq: 8}).(*lty.ReplyLotteryPurchases)
+	assert.Equal(t, 2, len(purchases.Purchases))
+	assert.Equal(t, p2, purchases.Purchases[0].Addr)
+	purchases = query(t, mock33, lty.FuncNameListAddrPurchases, &lty.ReqLotteryAddrPurchases{Addr: p1, Count: 4}).(*lty.ReplyLotteryPurchases)
+	assert.Equal(t, 4, len(purchases.Purchases))
+	assert.Equal(t, int64(42), purchases.Purchases[0].Number)
+	purchases = query(t, mock33, lty.FuncNameListAddrPurchases, &lty.ReqLotteryAddrPurchases{Addr: p1, PrimaryKey: purchases.PrimaryKey}).(*lty.ReplyLotteryPurchases)
+	assert.Equal(t, 2, len(purchases.Purchases))
+
+	//开奖以后当前一期没有人购买的时候创建者可以关闭，奖池退回
+	round = getRound(t, mock33, id, 3)
+	assert.Nil(t, mock33.CreateBlocksTo(round.DrawHeight))
+	ty, _ = sendLotteryTx(t, mock33, creator, "Draw", &lty.LotteryDraw{LotteryID: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	pool := getLottery(t, mock33, id).Pool
+	paid := getRound(t, mock33, id, 3).PrizePaid
+	assert.Equal(t, 28*types.Coin-prize-paid, pool)
+	ty, _ = sendLotteryTx(t, mock33, creator, "Close", &lty.LotteryClose{LotteryID: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 40*types.Coin+pool, mock33.GetExecBalance(lty.LotteryX, creatorAddr))
+	lottery = getLottery(t, mock33, id)
+	assert.Equal(t, int32(lty.StatusClosed), lottery.Status)
+	assert.Equal(t, int64(0), lottery.Pool)
+	ty, _ = sendLotteryTx(t, mock33, p1Priv, "Buy", &lty.LotteryBuy{LotteryID: id, Number: 1, Count: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+}
+
+func randHash(blockHash []byte, id string, round int64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(round))
+	h := sha256.New()
+	h.Write(blockHash)
+	h.Write([]byte(id))
+	h.Write(buf[:])
+	return h.Sum(nil)
+}
@@ -0,0 +1,492 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client/api"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	lty "github.com/33cn/chain33/system/dapp/lottery/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	lotteryKeyPrefix  = "mavl-" + lty.LotteryX + "-info-"
+	roundKeyPrefix    = "mavl-" + lty.LotteryX + "-round-"
+	purchaseKeyPrefix = "mavl-" + lty.LotteryX + "-buy-"
+	addrIndexPrefix   = "LODB-" + lty.LotteryX + "-addr-"
+)
+
+func calcLotteryKey(id string) []byte {
+	return []byte(lotteryKeyPrefix + id)
+}
+
+func calcRoundKey(id string, round int64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%010d", roundKeyPrefix, id, round))
+}
+
+func calcPurchaseKey(id string, round, seq int64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%010d-%06d", purchaseKeyPrefix, id, round, seq))
+}
+
+func calcAddrIndexKey(addr, primary string) []byte {
+	return []byte(addrIndexPrefix + addr + "-" + primary)
+}
+
+func purchasePrimaryKey(purchase *lty.LotteryPurchase) string {
+	return fmt.Sprintf("%018d", purchase.Height*types.MaxTxsPerBlock+purchase.Index)
+}
+
+//calcLotteryID 彩票的id按创建交易的位置生成
+func calcLotteryID(height int64, index int) string {
+	return fmt.Sprintf("%018d", height*types.MaxTxsPerBlock+int64(index))
+}
+
+//calcPoolAddr 每个彩票的奖池是一个没有私钥的地址
+func calcPoolAddr(id string) string {
+	return address.ExecAddress(lty.LotteryX + "-pool-" + id)
+}
+
+//calcRandHash 开奖区块的hash和彩票id、期数一起求哈希，不同彩票同一个开奖高度的号码不同
+func calcRandHash(blockHash []byte, id string, round int64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(round))
+	h := sha256.New()
+	h.Write(blockHash)
+	h.Write([]byte(id))
+	h.Write(buf[:])
+	return h.Sum(nil)
+}
+
+func calcLuckyNumber(randHash []byte, digits int32) int64 {
+	n := new(big.Int).SetBytes(randHash)
+	return n.Mod(n, big.NewInt(lty.NumberRange(digits))).Int64()
+}
+
+//matchTier 号码中的最高奖级，第i个奖级要和开奖号码的后 digits-i 位相同，没有中奖返回-1
+func matchTier(number, lucky int64, digits int32, tiers int) int {
+	for i := 0; i < tiers; i++ {
+		mod := lty.NumberRange(digits - int32(i))
+		if number%mod == lucky%mod {
+			return i
+		}
+	}
+	return -1
+}
+
+//tierPrize 奖级分到的奖金平分给这个奖级的每一注，向下取整，余下的留在奖池
+func tierPrize(pool int64, ratio int32, tickets int64) int64 {
+	if tickets == 0 {
+		return 0
+	}
+	v := new(big.Int).Mul(big.NewInt(pool), big.NewInt(int64(ratio)))
+	v.Div(v, big.NewInt(lty.RatioBase))
+	return v.Div(v, big.NewInt(tickets)).Int64()
+}
+
+// Action lottery交易的执行环境，同一个key在一个交易中多次修改的时候只保留最后的值
+type Action struct {
+	coinsAccount *account.DB
+	api          api.ExecutorAPI
+	db           dbm.KV
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+	kvs          []*types.KeyValue
+	kvIndex      map[string]int
+	logs         []*types.ReceiptLog
+}
+
+// NewAction new a action object
+func NewAction(l *Lottery, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: l.GetCoinsAccount(),
+		api:          l.GetExecutorAPI(),
+		db:           l.GetStateDB(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       l.GetHeight(),
+		index:        index,
+		kvIndex:      make(map[string]int),
+	}
+}
+
+func (a *Action) addKV(kv *types.KeyValue) {
+	if i, ok := a.kvIndex[string(kv.Key)]; ok {
+		a.kvs[i] = kv
+		return
+	}
+	a.kvIndex[string(kv.Key)] = len(a.kvs)
+	a.kvs = append(a.kvs, kv)
+}
+
+func (a *Action) set(key, value []byte) {
+	a.db.Set(key, value)
+	a.addKV(&types.KeyValue{Key: key, Value: value})
+}
+
+func (a *Action) receipt() *types.Receipt {
+	return &types.Receipt{Ty: types.ExecOk, KV: a.kvs, Logs: a.logs}
+}
+
+//transfer 在lottery执行器的coins账户之间转账，金额为0的时候不转账
+func (a *Action) transfer(from, to string, amount int64) error {
+	if amount == 0 {
+		return nil
+	}
+	receipt, err := a.coinsAccount.ExecTransfer(from, to, a.execaddr, amount)
+	if err != nil {
+		return err
+	}
+	for _, kv := range receipt.KV {
+		a.addKV(kv)
+	}
+	a.logs = append(a.logs, receipt.Logs...)
+	return nil
+}
+
+func getLottery(db dbm.KV, id string) (*lty.Lottery, error) {
+	value, err := db.Get(calcLotteryKey(id))
+	if err != nil || len(value) == 0 {
+		return nil, lty.ErrLotteryNotExist
+	}
+	var lottery lty.Lottery
+	if err := types.Decode(value, &lottery); err != nil {
+		return nil, err
+	}
+	return &lottery, nil
+}
+
+func (a *Action) saveLottery(prev, lottery *lty.Lottery) {
+	a.set(calcLotteryKey(lottery.LotteryID), types.Encode(lottery))
+	log := &lty.ReceiptLottery{Prev: prev, Current: lottery}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: lty.TyLogLottery, Log: types.Encode(log)})
+}
+
+func getRound(db dbm.KV, id string, round int64) (*lty.LotteryRound, error) {
+	value, err := db.Get(calcRoundKey(id, round))
+	if err != nil || len(value) == 0 {
+		return nil, lty.ErrRoundNotExist
+	}
+	var r lty.LotteryRound
+	if err := types.Decode(value, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (a *Action) saveRound(round *lty.LotteryRound) {
+	a.set(calcRoundKey(round.LotteryID, round.Round), types.Encode(round))
+}
+
+func getPurchase(db dbm.KV, id string, round, seq int64) (*lty.LotteryPurchase, error) {
+	value, err := db.Get(calcPurchaseKey(id, round, seq))
+	if err != nil || len(value) == 0 {
+		return nil, lty.ErrRoundNotExist
+	}
+	var purchase lty.LotteryPurchase
+	if err := types.Decode(value, &purchase); err != nil {
+		return nil, err
+	}
+	return &purchase, nil
+}
+
+//newRound 新的一期从当前高度开始购买
+func (a *Action) newRound(lottery *lty.Lottery) *lty.LotteryRound {
+	end := a.height + lottery.PurchaseBlocks
+	return &lty.LotteryRound{
+		LotteryID:   lottery.LotteryID,
+		Round:       lottery.Round,
+		StartHeight: a.height,
+		EndHeight:   end,
+		DrawHeight:  end + lottery.DrawDelay,
+		Status:      lty.RoundPurchasing,
+	}
+}
+
+//blockHash 开奖区块一定在当前区块之前，查询失败的时候只有api出错才需要重试
+func (a *Action) blockHash(height int64) ([]byte, error) {
+	reply, err := a.api.GetBlockHash(&types.ReqInt{Height: height})
+	if err != nil {
+		if a.api.IsErr() {
+			return nil, err
+		}
+		return nil, lty.ErrDrawHeight
+	}
+	return reply.Hash, nil
+}
+
+func (a *Action) create(payload *lty.LotteryCreate) (*types.Receipt, error) {
+	if err := lty.CheckCreate(payload); err != nil {
+		return nil, err
+	}
+	lottery := &lty.Lottery{
+		LotteryID:      calcLotteryID(a.height, a.index),
+		Creator:        a.fromaddr,
+		TicketPrice:    payload.TicketPrice,
+		PurchaseBlocks: payload.PurchaseBlocks,
+		DrawDelay:      payload.DrawDelay,
+		Digits:         payload.Digits,
+		PrizeRatios:    payload.PrizeRatios,
+		Pool:           payload.Fund,
+		Round:          1,
+		Status:         lty.StatusOpen,
+		Height:         a.height,
+	}
+	if err := a.transfer(a.fromaddr, calcPoolAddr(lottery.LotteryID), payload.Fund); err != nil {
+		return nil, err
+	}
+	a.saveLottery(nil, lottery)
+	a.saveRound(a.newRound(lottery))
+	return a.receipt(), nil
+}
+
+//drawRound 开奖并派奖，每一注只按中的最高奖级领奖，没有人中的奖级和取整余下的奖金留在奖池，然后开始下一期
+func (a *Action) drawRound(lottery *lty.Lottery, round *lty.LotteryRound) (*lty.Lottery, *lty.LotteryRound, error) {
+	hash, err := a.blockHash(round.DrawHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	round.RandHash = calcRandHash(hash, lottery.LotteryID, round.Round)
+	round.LuckyNumber = calcLuckyNumber(round.RandHash, lottery.Digits)
+	var purchases []*lty.LotteryPurchase
+	var tiers []int
+	tickets := make([]int64, len(lottery.PrizeRatios))
+	for seq := int64(0); seq < round.Purchases; seq++ {
+		purchase, err := getPurchase(a.db, lottery.LotteryID, round.Round, seq)
+		if err != nil {
+			return nil, nil, err
+		}
+		tier := matchTier(purchase.Number, round.LuckyNumber, lottery.Digits, len(lottery.PrizeRatios))
+		if tier >= 0 {
+			tickets[tier] += purchase.Count
+		}
+		purchases = append(purchases, purchase)
+		tiers = append(tiers, tier)
+	}
+	prizes := make([]int64, len(lottery.PrizeRatios))
+	for i, ratio := range lottery.PrizeRatios {
+		prizes[i] = tierPrize(lottery.Pool, ratio, tickets[i])
+	}
+	poolAddr := calcPoolAddr(lottery.LotteryID)
+	round.PrizePool = lottery.Pool
+	for i, purchase := range purchases {
+		if tiers[i] < 0 || prizes[tiers[i]] == 0 {
+			continue
+		}
+		prize := prizes[tiers[i]] * purchase.Count
+		if err := a.transfer(poolAddr, purchase.Addr, prize); err != nil {
+			return nil, nil, err
+		}
+		round.Winners = append(round.Winners, &lty.LotteryWinner{Addr: purchase.Addr, Number: purchase.Number, Count: purchase.Count, Tier: int32(tiers[i]), Prize: prize})
+		round.PrizePaid += prize
+	}
+	round.Status = lty.RoundDrawn
+	round.DrawnHeight = a.height
+	a.saveRound(round)
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: lty.TyLogLotteryDraw, Log: types.Encode(&lty.ReceiptLotteryDraw{Round: round})})
+
+	prev := *lottery
+	next := prev
+	next.Pool -= round.PrizePaid
+	next.Round++
+	a.saveLottery(&prev, &next)
+	nextRound := a.newRound(&next)
+	a.saveRound(nextRound)
+	return &next, nextRound, nil
+}
+
+//loadCurrent 读取没有关闭的彩票和当前一期
+func (a *Action) loadCurrent(id string) (*lty.Lottery, *lty.LotteryRound, error) {
+	lottery, err := getLottery(a.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lottery.Status != lty.StatusOpen {
+		return nil, nil, lty.ErrLotteryClosed
+	}
+	round, err := getRound(a.db, id, lottery.Round)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lottery, round, nil
+}
+
+//buy 上一期过了开奖高度还没有开奖的时候先自动开奖，再购买新的一期
+func (a *Action) buy(payload *lty.LotteryBuy) (*types.Receipt, error) {
+	lottery, round, err := a.loadCurrent(payload.LotteryID)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Number < 0 || payload.Number >= lty.NumberRange(lottery.Digits) {
+		return nil, lty.ErrLotteryNumber
+	}
+	if payload.Count <= 0 || payload.Count > lty.MaxTicketCount || lottery.TicketPrice > math.MaxInt64/payload.Count {
+		return nil, lty.ErrTicketCount
+	}
+	if a.height > round.DrawHeight {
+		if lottery, round, err = a.drawRound(lottery, round); err != nil {
+			return nil, err
+		}
+	}
+	if a.height > round.EndHeight {
+		return nil, lty.ErrPurchaseClosed
+	}
+	if round.Purchases >= lty.MaxPurchases {
+		return nil, lty.ErrTooManyPurchases
+	}
+	amount := lottery.TicketPrice * payload.Count
+	if err := a.transfer(a.fromaddr, calcPoolAddr(lottery.LotteryID), amount); err != nil {
+		return nil, err
+	}
+	purchase := &lty.LotteryPurchase{
+		LotteryID: lottery.LotteryID,
+		Round:     round.Round,
+		Seq:       round.Purchases,
+		Addr:      a.fromaddr,
+		Number:    payload.Number,
+		Count:     payload.Count,
+		Amount:    amount,
+		Height:    a.height,
+		Index:     int64(a.index),
+	}
+	a.set(calcPurchaseKey(lottery.LotteryID, round.Round, purchase.Seq), types.Encode(purchase))
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: lty.TyLogLotteryPurchase, Log: types.Encode(purchase)})
+	round.Purchases++
+	round.Tickets += payload.Count
+	round.Sales += amount
+	a.saveRound(round)
+	prev := *lottery
+	lottery.Pool += amount
+	a.saveLottery(&prev, lottery)
+	return a.receipt(), nil
+}
+
+func (a *Action) draw(payload *lty.LotteryDraw) (*types.Receipt, error) {
+	lottery, round, err := a.loadCurrent(payload.LotteryID)
+	if err != nil {
+		return nil, err
+	}
+	if a.height <= round.DrawHeight {
+		return nil, lty.ErrDrawHeight
+	}
+	if _, _, err := a.drawRound(lottery, round); err != nil {
+		return nil, err
+	}
+	return a.receipt(), nil
+}
+
+//close 只有当前一期没有人购买的时候创建者才能关闭彩票，奖池退回给创建者
+func (a *Action) close(payload *lty.LotteryClose) (*types.Receipt, error) {
+	lottery, round, err := a.loadCurrent(payload.LotteryID)
+	if err != nil {
+		return nil, err
+	}
+	if lottery.Creator != a.fromaddr {
+		return nil, lty.ErrNotCreator
+	}
+	if round.Purchases > 0 {
+		return nil, lty.ErrRoundNotEmpty
+	}
+	if err := a.transfer(calcPoolAddr(lottery.LotteryID), lottery.Creator, lottery.Pool); err != nil {
+		return nil, err
+	}
+	prev := *lottery
+	lottery.Pool = 0
+	lottery.Status = lty.StatusClosed
+	a.saveLottery(&prev, lottery)
+	return a.receipt(), nil
+}
+
+func listCount(count int32) int32 {
+	if count <= 0 {
+		return lty.DefaultListCount
+	}
+	if count > lty.MaxListCount {
+		return lty.MaxListCount
+	}
+	return count
+}
+
+//listRounds 按期数列出历史，round为0的时候倒序从最新的一期开始，正序从第一期开始
+func listRounds(db dbm.KV, req *lty.ReqLotteryRounds) (*lty.ReplyLotteryRounds, error) {
+	lottery, err := getLottery(db, req.LotteryID)
+	if err != nil {
+		return nil, err
+	}
+	step := int64(-1)
+	if req.Direction == dbm.ListASC {
+		step = 1
+	}
+	round := req.Round
+	if round <= 0 {
+		round = lottery.Round
+		if step > 0 {
+			round = 1
+		}
+	}
+	reply := &lty.ReplyLotteryRounds{}
+	for count := listCount(req.Count); count > 0 && round >= 1 && round <= lottery.Round; count-- {
+		r, err := getRound(db, req.LotteryID, round)
+		if err != nil {
+			return nil, err
+		}
+		reply.Rounds = append(reply.Rounds, r)
+		round += step
+	}
+	return reply, nil
+}
+
+//listPurchases 按购买的顺序列出一期的购买记录，从seq开始
+func listPurchases(db dbm.KV, req *lty.ReqLotteryPurchases) (*lty.ReplyLotteryPurchases, error) {
+	round, err := getRound(db, req.LotteryID, req.Round)
+	if err != nil {
+		return nil, err
+	}
+	if req.Seq < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	reply := &lty.ReplyLotteryPurchases{}
+	for seq, count := req.Seq, listCount(req.Count); seq < round.Purchases && count > 0; seq, count = seq+1, count-1 {
+		purchase, err := getPurchase(db, req.LotteryID, req.Round, seq)
+		if err != nil {
+			return nil, err
+		}
+		reply.Purchases = append(reply.Purchases, purchase)
+	}
+	return reply, nil
+}
+
+func listAddrPurchases(localdb dbm.KVDB, req *lty.ReqLotteryAddrPurchases) (*lty.ReplyLotteryPurchases, error) {
+	if req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcAddrIndexKey(req.Addr, req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(addrIndexPrefix+req.Addr+"-"), key, listCount(req.Count), req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &lty.ReplyLotteryPurchases{}
+	for _, value := range values {
+		var purchase lty.LotteryPurchase
+		if err := types.Decode(value, &purchase); err != nil {
+			return nil, err
+		}
+		reply.Purchases = append(reply.Purchases, &purchase)
+		reply.PrimaryKey = purchasePrimaryKey(&purchase)
+	}
+	return reply, nil
+}
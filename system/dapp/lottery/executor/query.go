@@ -0,0 +1,43 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	lty "github.com/33cn/chain33/system/dapp/lottery/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetLottery 按id查询彩票
+func (l *Lottery) Query_GetLottery(in *types.ReqString) (types.Message, error) {
+	return getLottery(l.GetStateDB(), in.Data)
+}
+
+// Query_GetRound 查询一期的开奖结果，round为0的时候查询当前一期
+func (l *Lottery) Query_GetRound(in *lty.ReqLotteryRound) (types.Message, error) {
+	round := in.Round
+	if round <= 0 {
+		lottery, err := getLottery(l.GetStateDB(), in.LotteryID)
+		if err != nil {
+			return nil, err
+		}
+		round = lottery.Round
+	}
+	return getRound(l.GetStateDB(), in.LotteryID, round)
+}
+
+// Query_ListRounds 按期数列出历史开奖结果
+func (l *Lottery) Query_ListRounds(in *lty.ReqLotteryRounds) (types.Message, error) {
+	return listRounds(l.GetStateDB(), in)
+}
+
+// Query_ListPurchases 列出一期的购买记录
+func (l *Lottery) Query_ListPurchases(in *lty.ReqLotteryPurchases) (types.Message, error) {
+	return listPurchases(l.GetStateDB(), in)
+}
+
+// Query_ListAddrPurchases 列出地址的购买记录
+func (l *Lottery) Query_ListAddrPurchases(in *lty.ReqLotteryAddrPurchases) (types.Message, error) {
+	return listAddrPurchases(l.GetLocalDB(), in)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lottery 彩票执行器插件
+// 1. 创建者设置票价、每期的购买区块数、号码位数和各个奖级的分配比例，可以注入初始奖池
+// 2. 停止购买以后再过 drawDelay 个区块，用这个区块的hash生成开奖号码，购买的时候无法预知
+// 3. 每一注按中的最高奖级平分奖金，没有人中的奖金留在奖池滚入下一期，开奖以后自动开始下一期
+package lottery
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/lottery/commands"
+	"github.com/33cn/chain33/system/dapp/lottery/executor"
+	"github.com/33cn/chain33/system/dapp/lottery/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.LotteryX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.LotteryCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// lottery action ty
+const (
+	LotteryActionCreate = iota + 1
+	LotteryActionBuy
+	LotteryActionDraw
+	LotteryActionClose
+)
+
+// lottery log ty
+const (
+	TyLogLottery         = 580
+	TyLogLotteryPurchase = 581
+	TyLogLotteryDraw     = 582
+)
+
+// 彩票状态
+const (
+	StatusOpen = iota
+	StatusClosed
+)
+
+// 每一期的状态
+const (
+	RoundPurchasing = iota
+	RoundDrawn
+)
+
+// query func name
+const (
+	FuncNameGetLottery        = "GetLottery"
+	FuncNameGetRound          = "GetRound"
+	FuncNameListRounds        = "ListRounds"
+	FuncNameListPurchases     = "ListPurchases"
+	FuncNameListAddrPurchases = "ListAddrPurchases"
+	//MaxDigits 号码的最大位数
+	MaxDigits = 6
+	//RatioBase 奖级分配比例的精度，所有奖级的比例之和不能超过RatioBase
+	RatioBase = 1000
+	//MaxPurchases 一期最多的购买次数，开奖的时候要遍历所有的购买记录
+	MaxPurchases = 1000
+	//MaxTicketCount 一次购买的最大注数
+	MaxTicketCount = 10000
+	//MaxPurchaseBlocks 一期最多的购买区块数
+	MaxPurchaseBlocks = 100000
+	//MaxDrawDelay 停止购买到开奖区块的最大间隔
+	MaxDrawDelay     = 1000
+	DefaultListCount = 20
+	MaxListCount     = 100
+)
@@ -0,0 +1,34 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrLotteryParam 创建彩票的参数不合法
+	ErrLotteryParam = errors.New("ErrLotteryParam")
+	// ErrPrizeRatio 奖级的比例不合法，或者比例之和超过RatioBase
+	ErrPrizeRatio = errors.New("ErrPrizeRatio")
+	// ErrLotteryNotExist 彩票不存在
+	ErrLotteryNotExist = errors.New("ErrLotteryNotExist")
+	// ErrLotteryClosed 彩票已经关闭
+	ErrLotteryClosed = errors.New("ErrLotteryClosed")
+	// ErrRoundNotExist 这一期不存在
+	ErrRoundNotExist = errors.New("ErrRoundNotExist")
+	// ErrPurchaseClosed 当前一期已经停止购买
+	ErrPurchaseClosed = errors.New("ErrPurchaseClosed")
+	// ErrTooManyPurchases 当前一期的购买次数超过上限
+	ErrTooManyPurchases = errors.New("ErrTooManyPurchases")
+	// ErrLotteryNumber 号码超出位数的范围
+	ErrLotteryNumber = errors.New("ErrLotteryNumber")
+	// ErrTicketCount 购买的注数不合法
+	ErrTicketCount = errors.New("ErrTicketCount")
+	// ErrDrawHeight 还没有到开奖高度
+	ErrDrawHeight = errors.New("ErrDrawHeight")
+	// ErrNotCreator 不是彩票的创建者
+	ErrNotCreator = errors.New("ErrNotCreator")
+	// ErrRoundNotEmpty 当前一期已经有人购买，不能关闭
+	ErrRoundNotEmpty = errors.New("ErrRoundNotEmpty")
+)
@@ -0,0 +1,1396 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: lottery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type LotteryAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*LotteryAction_Create
+	//	*LotteryAction_Buy
+	//	*LotteryAction_Draw
+	//	*LotteryAction_Close
+	Value                isLotteryAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                 `protobuf:"varint,5,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *LotteryAction) Reset()         { *m = LotteryAction{} }
+func (m *LotteryAction) String() string { return proto.CompactTextString(m) }
+func (*LotteryAction) ProtoMessage()    {}
+func (*LotteryAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{0}
+}
+
+func (m *LotteryAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LotteryAction.Unmarshal(m, b)
+}
+func (m *LotteryAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LotteryAction.Marshal(b, m, deterministic)
+}
+func (m *LotteryAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotteryAction.Merge(m, src)
+}
+func (m *LotteryAction) XXX_Size() int {
+	return xxx_messageInfo_LotteryAction.Size(m)
+}
+func (m *LotteryAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotteryAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotteryAction proto.InternalMessageInfo
+
+type isLotteryAction_Value interface {
+	isLotteryAction_Value()
+}
+
+type LotteryAction_Create struct {
+	Create *LotteryCreate `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type LotteryAction_Buy struct {
+	Buy *LotteryBuy `protobuf:"bytes,2,opt,name=buy,proto3,oneof"`
+}
+
+type LotteryAction_Draw struct {
+	Draw *LotteryDraw `protobuf:"bytes,3,opt,name=draw,proto3,oneof"`
+}
+
+type LotteryAction_Close struct {
+	Close *LotteryClose `protobuf:"bytes,4,opt,name=close,proto3,oneof"`
+}
+
+func (*LotteryAction_Create) isLotteryAction_Value() {}
+
+func (*LotteryAction_Buy) isLotteryAction_Value() {}
+
+func (*LotteryAction_Draw) isLotteryAction_Value() {}
+
+func (*LotteryAction_Close) isLotteryAction_Value() {}
+
+func (m *LotteryAction) GetValue() isLotteryAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *LotteryAction) GetCreate() *LotteryCreate {
+	if x, ok := m.GetValue().(*LotteryAction_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (m *LotteryAction) GetBuy() *LotteryBuy {
+	if x, ok := m.GetValue().(*LotteryAction_Buy); ok {
+		return x.Buy
+	}
+	return nil
+}
+
+func (m *LotteryAction) GetDraw() *LotteryDraw {
+	if x, ok := m.GetValue().(*LotteryAction_Draw); ok {
+		return x.Draw
+	}
+	return nil
+}
+
+func (m *LotteryAction) GetClose() *LotteryClose {
+	if x, ok := m.GetValue().(*LotteryAction_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+func (m *LotteryAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*LotteryAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _LotteryAction_OneofMarshaler, _LotteryAction_OneofUnmarshaler, _LotteryAction_OneofSizer, []interface{}{
+		(*LotteryAction_Create)(nil),
+		(*LotteryAction_Buy)(nil),
+		(*LotteryAction_Draw)(nil),
+		(*LotteryAction_Close)(nil),
+	}
+}
+
+func _LotteryAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*LotteryAction)
+	// value
+	switch x := m.Value.(type) {
+	case *LotteryAction_Create:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Create); err != nil {
+			return err
+		}
+	case *LotteryAction_Buy:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Buy); err != nil {
+			return err
+		}
+	case *LotteryAction_Draw:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Draw); err != nil {
+			return err
+		}
+	case *LotteryAction_Close:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Close); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("LotteryAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _LotteryAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*LotteryAction)
+	switch tag {
+	case 1: // value.create
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LotteryCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &LotteryAction_Create{msg}
+		return true, err
+	case 2: // value.buy
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LotteryBuy)
+		err := b.DecodeMessage(msg)
+		m.Value = &LotteryAction_Buy{msg}
+		return true, err
+	case 3: // value.draw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LotteryDraw)
+		err := b.DecodeMessage(msg)
+		m.Value = &LotteryAction_Draw{msg}
+		return true, err
+	case 4: // value.close
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(LotteryClose)
+		err := b.DecodeMessage(msg)
+		m.Value = &LotteryAction_Close{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _LotteryAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*LotteryAction)
+	// value
+	switch x := m.Value.(type) {
+	case *LotteryAction_Create:
+		s := proto.Size(x.Create)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *LotteryAction_Buy:
+		s := proto.Size(x.Buy)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *LotteryAction_Draw:
+		s := proto.Size(x.Draw)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *LotteryAction_Close:
+		s := proto.Size(x.Close)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//创建彩票，prizeRatios 是各个奖级分配奖池的千分比，第一个是全部数字都猜中的头奖，
+//第i个奖级要猜中号码的后 digits-i 位，fund 是创建者注入的初始奖池
+type LotteryCreate struct {
+	TicketPrice          int64    `protobuf:"varint,1,opt,name=ticketPrice,proto3" json:"ticketPrice,omitempty"`
+	PurchaseBlocks       int64    `protobuf:"varint,2,opt,name=purchaseBlocks,proto3" json:"purchaseBlocks,omitempty"`
+	DrawDelay            int64    `protobuf:"varint,3,opt,name=drawDelay,proto3" json:"drawDelay,omitempty"`
+	Digits               int32    `protobuf:"varint,4,opt,name=digits,proto3" json:"digits,omitempty"`
+	PrizeRatios          []int32  `protobuf:"varint,5,rep,packed,name=prizeRatios,proto3" json:"prizeRatios,omitempty"`
+	Fund                 int64    `protobuf:"varint,6,opt,name=fund,proto3" json:"fund,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LotteryCreate) Reset()         { *m = LotteryCreate{} }
+func (m *LotteryCreate) String() string { return proto.CompactTextString(m) }
+func (*LotteryCreate) ProtoMessage()    {}
+func (*LotteryCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{1}
+}
+
+func (m *LotteryCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LotteryCreate.Unmarshal(m, b)
+}
+func (m *LotteryCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LotteryCreate.Marshal(b, m, deterministic)
+}
+func (m *LotteryCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotteryCreate.Merge(m, src)
+}
+func (m *LotteryCreate) XXX_Size() int {
+	return xxx_messageInfo_LotteryCreate.Size(m)
+}
+func (m *LotteryCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotteryCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotteryCreate proto.InternalMessageInfo
+
+func (m *LotteryCreate) GetTicketPrice() int64 {
+	if m != nil {
+		return m.TicketPrice
+	}
+	return 0
+}
+
+func (m *LotteryCreate) GetPurchaseBlocks() int64 {
+	if m != nil {
+		return m.PurchaseBlocks
+	}
+	return 0
+}
+
+func (m *LotteryCreate) GetDrawDelay() int64 {
+	if m != nil {
+		return m.DrawDelay
+	}
+	return 0
+}
+
+func (m *LotteryCreate) GetDigits() int32 {
+	if m != nil {
+		return m.Digits
+	}
+	return 0
+}
+
+func (m *LotteryCreate) GetPrizeRatios() []int32 {
+	if m != nil {
+		return m.PrizeRatios
+	}
+	return nil
+}
+
+func (m *LotteryCreate) GetFund() int64 {
+	if m != nil {
+		return m.Fund
+	}
+	return 0
+}
+
+//购买当前一期的彩票，同一个号码买count注
+type LotteryBuy struct {
+	LotteryID            string   `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	Number               int64    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LotteryBuy) Reset()         { *m = LotteryBuy{} }
+func (m *LotteryBuy) String() string { return proto.CompactTextString(m) }
+func (*LotteryBuy) ProtoMessage()    {}
+func (*LotteryBuy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{2}
+}
+
+func (m *LotteryBuy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LotteryBuy.Unmarshal(m, b)
+}
+func (m *LotteryBuy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LotteryBuy.Marshal(b, m, deterministic)
+}
+func (m *LotteryBuy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotteryBuy.Merge(m, src)
+}
+func (m *LotteryBuy) XXX_Size() int {
+	return xxx_messageInfo_LotteryBuy.Size(m)
+}
+func (m *LotteryBuy) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotteryBuy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotteryBuy proto.InternalMessageInfo
+
+func (m *LotteryBuy) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+func (m *LotteryBuy) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *LotteryBuy) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+//开奖当前一期，任何人都可以在开奖高度之后发起
+type LotteryDraw struct {
+	LotteryID            string   `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LotteryDraw) Reset()         { *m = LotteryDraw{} }
+func (m *LotteryDraw) String() string { return proto.CompactTextString(m) }
+func (*LotteryDraw) ProtoMessage()    {}
+func (*LotteryDraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{3}
+}
+
+func (m *LotteryDraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LotteryDraw.Unmarshal(m, b)
+}
+func (m *LotteryDraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LotteryDraw.Marshal(b, m, deterministic)
+}
+func (m *LotteryDraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotteryDraw.Merge(m, src)
+}
+func (m *LotteryDraw) XXX_Size() int {
+	return xxx_messageInfo_LotteryDraw.Size(m)
+}
+func (m *LotteryDraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotteryDraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotteryDraw proto.InternalMessageInfo
+
+func (m *LotteryDraw) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+//创建者关闭彩票，取回奖池
+type LotteryClose struct {
+	LotteryID            string   `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LotteryClose) Reset()         { *m = LotteryClose{} }
+func (m *LotteryClose) String() string { return proto.CompactTextString(m) }
+func (*LotteryClose) ProtoMessage()    {}
+func (*LotteryClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{4}
+}
+
+func (m *LotteryClose) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LotteryClose.Unmarshal(m, b)
+}
+func (m *LotteryClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LotteryClose.Marshal(b, m, deterministic)
+}
+func (m *LotteryClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotteryClose.Merge(m, src)
+}
+func (m *LotteryClose) XXX_Size() int {
+	return xxx_messageInfo_LotteryClose.Size(m)
+}
+func (m *LotteryClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotteryClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotteryClose proto.InternalMessageInfo
+
+func (m *LotteryClose) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+//pool 是奖池的金额，包括没有开奖的这一期的销售额
+type Lottery struct {
+	LotteryID            string   `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	TicketPrice          int64    `protobuf:"varint,3,opt,name=ticketPrice,proto3" json:"ticketPrice,omitempty"`
+	PurchaseBlocks       int64    `protobuf:"varint,4,opt,name=purchaseBlocks,proto3" json:"purchaseBlocks,omitempty"`
+	DrawDelay            int64    `protobuf:"varint,5,opt,name=drawDelay,proto3" json:"drawDelay,omitempty"`
+	Digits               int32    `protobuf:"varint,6,opt,name=digits,proto3" json:"digits,omitempty"`
+	PrizeRatios          []int32  `protobuf:"varint,7,rep,packed,name=prizeRatios,proto3" json:"prizeRatios,omitempty"`
+	Pool                 int64    `protobuf:"varint,8,opt,name=pool,proto3" json:"pool,omitempty"`
+	Round                int64    `protobuf:"varint,9,opt,name=round,proto3" json:"round,omitempty"`
+	Status               int32    `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	Height               int64    `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Lottery) Reset()         { *m = Lottery{} }
+func (m *Lottery) String() string { return proto.CompactTextString(m) }
+func (*Lottery) ProtoMessage()    {}
+func (*Lottery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{5}
+}
+
+func (m *Lottery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Lottery.Unmarshal(m, b)
+}
+func (m *Lottery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Lottery.Marshal(b, m, deterministic)
+}
+func (m *Lottery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lottery.Merge(m, src)
+}
+func (m *Lottery) XXX_Size() int {
+	return xxx_messageInfo_Lottery.Size(m)
+}
+func (m *Lottery) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lottery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lottery proto.InternalMessageInfo
+
+func (m *Lottery) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+func (m *Lottery) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Lottery) GetTicketPrice() int64 {
+	if m != nil {
+		return m.TicketPrice
+	}
+	return 0
+}
+
+func (m *Lottery) GetPurchaseBlocks() int64 {
+	if m != nil {
+		return m.PurchaseBlocks
+	}
+	return 0
+}
+
+func (m *Lottery) GetDrawDelay() int64 {
+	if m != nil {
+		return m.DrawDelay
+	}
+	return 0
+}
+
+func (m *Lottery) GetDigits() int32 {
+	if m != nil {
+		return m.Digits
+	}
+	return 0
+}
+
+func (m *Lottery) GetPrizeRatios() []int32 {
+	if m != nil {
+		return m.PrizeRatios
+	}
+	return nil
+}
+
+func (m *Lottery) GetPool() int64 {
+	if m != nil {
+		return m.Pool
+	}
+	return 0
+}
+
+func (m *Lottery) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Lottery) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Lottery) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+//一期彩票，endHeight 之后停止购买，用 drawHeight 高度区块的hash生成开奖号码
+type LotteryRound struct {
+	LotteryID            string           `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	Round                int64            `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	StartHeight          int64            `protobuf:"varint,3,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight            int64            `protobuf:"varint,4,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	DrawHeight           int64            `protobuf:"varint,5,opt,name=drawHeight,proto3" json:"drawHeight,omitempty"`
+	Status               int32            `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Purchases            int64            `protobuf:"varint,7,opt,name=purchases,proto3" json:"purchases,omitempty"`
+	Tickets              int64            `protobuf:"varint,8,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	Sales                int64            `protobuf:"varint,9,opt,name=sales,proto3" json:"sales,omitempty"`
+	LuckyNumber          int64            `protobuf:"varint,10,opt,name=luckyNumber,proto3" json:"luckyNumber,omitempty"`
+	RandHash             []byte           `protobuf:"bytes,11,opt,name=randHash,proto3" json:"randHash,omitempty"`
+	PrizePool            int64            `protobuf:"varint,12,opt,name=prizePool,proto3" json:"prizePool,omitempty"`
+	PrizePaid            int64            `protobuf:"varint,13,opt,name=prizePaid,proto3" json:"prizePaid,omitempty"`
+	Winners              []*LotteryWinner `protobuf:"bytes,14,rep,name=winners,proto3" json:"winners,omitempty"`
+	DrawnHeight          int64            `protobuf:"varint,15,opt,name=drawnHeight,proto3" json:"drawnHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *LotteryRound) Reset()         { *m = LotteryRound{} }
+func (m *LotteryRound) String() string { return proto.CompactTextString(m) }
+func (*LotteryRound) ProtoMessage()    {}
+func (*LotteryRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{6}
+}
+
+func (m *LotteryRound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LotteryRound.Unmarshal(m, b)
+}
+func (m *LotteryRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LotteryRound.Marshal(b, m, deterministic)
+}
+func (m *LotteryRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotteryRound.Merge(m, src)
+}
+func (m *LotteryRound) XXX_Size() int {
+	return xxx_messageInfo_LotteryRound.Size(m)
+}
+func (m *LotteryRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotteryRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotteryRound proto.InternalMessageInfo
+
+func (m *LotteryRound) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+func (m *LotteryRound) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetDrawHeight() int64 {
+	if m != nil {
+		return m.DrawHeight
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetPurchases() int64 {
+	if m != nil {
+		return m.Purchases
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetTickets() int64 {
+	if m != nil {
+		return m.Tickets
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetSales() int64 {
+	if m != nil {
+		return m.Sales
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetLuckyNumber() int64 {
+	if m != nil {
+		return m.LuckyNumber
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetRandHash() []byte {
+	if m != nil {
+		return m.RandHash
+	}
+	return nil
+}
+
+func (m *LotteryRound) GetPrizePool() int64 {
+	if m != nil {
+		return m.PrizePool
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetPrizePaid() int64 {
+	if m != nil {
+		return m.PrizePaid
+	}
+	return 0
+}
+
+func (m *LotteryRound) GetWinners() []*LotteryWinner {
+	if m != nil {
+		return m.Winners
+	}
+	return nil
+}
+
+func (m *LotteryRound) GetDrawnHeight() int64 {
+	if m != nil {
+		return m.DrawnHeight
+	}
+	return 0
+}
+
+type LotteryPurchase struct {
+	LotteryID            string   `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	Round                int64    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	Addr                 string   `protobuf:"bytes,4,opt,name=addr,proto3" json:"addr,omitempty"`
+	Number               int64    `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"`
+	Count                int64    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Amount               int64    `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height               int64    `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,9,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LotteryPurchase) Reset()         { *m = LotteryPurchase{} }
+func (m *LotteryPurchase) String() string { return proto.CompactTextString(m) }
+func (*LotteryPurchase) ProtoMessage()    {}
+func (*LotteryPurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{7}
+}
+
+func (m *LotteryPurchase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LotteryPurchase.Unmarshal(m, b)
+}
+func (m *LotteryPurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LotteryPurchase.Marshal(b, m, deterministic)
+}
+func (m *LotteryPurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotteryPurchase.Merge(m, src)
+}
+func (m *LotteryPurchase) XXX_Size() int {
+	return xxx_messageInfo_LotteryPurchase.Size(m)
+}
+func (m *LotteryPurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotteryPurchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotteryPurchase proto.InternalMessageInfo
+
+func (m *LotteryPurchase) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+func (m *LotteryPurchase) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *LotteryPurchase) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *LotteryPurchase) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *LotteryPurchase) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *LotteryPurchase) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LotteryPurchase) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *LotteryPurchase) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LotteryPurchase) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+//tier 从0开始，0是头奖，prize 是这一笔购买的全部奖金
+type LotteryWinner struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Number               int64    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Tier                 int32    `protobuf:"varint,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	Prize                int64    `protobuf:"varint,5,opt,name=prize,proto3" json:"prize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LotteryWinner) Reset()         { *m = LotteryWinner{} }
+func (m *LotteryWinner) String() string { return proto.CompactTextString(m) }
+func (*LotteryWinner) ProtoMessage()    {}
+func (*LotteryWinner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{8}
+}
+
+func (m *LotteryWinner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LotteryWinner.Unmarshal(m, b)
+}
+func (m *LotteryWinner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LotteryWinner.Marshal(b, m, deterministic)
+}
+func (m *LotteryWinner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LotteryWinner.Merge(m, src)
+}
+func (m *LotteryWinner) XXX_Size() int {
+	return xxx_messageInfo_LotteryWinner.Size(m)
+}
+func (m *LotteryWinner) XXX_DiscardUnknown() {
+	xxx_messageInfo_LotteryWinner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LotteryWinner proto.InternalMessageInfo
+
+func (m *LotteryWinner) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *LotteryWinner) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *LotteryWinner) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LotteryWinner) GetTier() int32 {
+	if m != nil {
+		return m.Tier
+	}
+	return 0
+}
+
+func (m *LotteryWinner) GetPrize() int64 {
+	if m != nil {
+		return m.Prize
+	}
+	return 0
+}
+
+type ReceiptLottery struct {
+	Prev                 *Lottery `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *Lottery `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptLottery) Reset()         { *m = ReceiptLottery{} }
+func (m *ReceiptLottery) String() string { return proto.CompactTextString(m) }
+func (*ReceiptLottery) ProtoMessage()    {}
+func (*ReceiptLottery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{9}
+}
+
+func (m *ReceiptLottery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptLottery.Unmarshal(m, b)
+}
+func (m *ReceiptLottery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptLottery.Marshal(b, m, deterministic)
+}
+func (m *ReceiptLottery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptLottery.Merge(m, src)
+}
+func (m *ReceiptLottery) XXX_Size() int {
+	return xxx_messageInfo_ReceiptLottery.Size(m)
+}
+func (m *ReceiptLottery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptLottery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptLottery proto.InternalMessageInfo
+
+func (m *ReceiptLottery) GetPrev() *Lottery {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptLottery) GetCurrent() *Lottery {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptLotteryDraw struct {
+	Round                *LotteryRound `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReceiptLotteryDraw) Reset()         { *m = ReceiptLotteryDraw{} }
+func (m *ReceiptLotteryDraw) String() string { return proto.CompactTextString(m) }
+func (*ReceiptLotteryDraw) ProtoMessage()    {}
+func (*ReceiptLotteryDraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{10}
+}
+
+func (m *ReceiptLotteryDraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptLotteryDraw.Unmarshal(m, b)
+}
+func (m *ReceiptLotteryDraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptLotteryDraw.Marshal(b, m, deterministic)
+}
+func (m *ReceiptLotteryDraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptLotteryDraw.Merge(m, src)
+}
+func (m *ReceiptLotteryDraw) XXX_Size() int {
+	return xxx_messageInfo_ReceiptLotteryDraw.Size(m)
+}
+func (m *ReceiptLotteryDraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptLotteryDraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptLotteryDraw proto.InternalMessageInfo
+
+func (m *ReceiptLotteryDraw) GetRound() *LotteryRound {
+	if m != nil {
+		return m.Round
+	}
+	return nil
+}
+
+type ReqLotteryRound struct {
+	LotteryID            string   `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	Round                int64    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqLotteryRound) Reset()         { *m = ReqLotteryRound{} }
+func (m *ReqLotteryRound) String() string { return proto.CompactTextString(m) }
+func (*ReqLotteryRound) ProtoMessage()    {}
+func (*ReqLotteryRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{11}
+}
+
+func (m *ReqLotteryRound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqLotteryRound.Unmarshal(m, b)
+}
+func (m *ReqLotteryRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqLotteryRound.Marshal(b, m, deterministic)
+}
+func (m *ReqLotteryRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqLotteryRound.Merge(m, src)
+}
+func (m *ReqLotteryRound) XXX_Size() int {
+	return xxx_messageInfo_ReqLotteryRound.Size(m)
+}
+func (m *ReqLotteryRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqLotteryRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqLotteryRound proto.InternalMessageInfo
+
+func (m *ReqLotteryRound) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+func (m *ReqLotteryRound) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+//从round开始按方向列出各期，round为0的时候从最新的一期开始
+type ReqLotteryRounds struct {
+	LotteryID            string   `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	Round                int64    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqLotteryRounds) Reset()         { *m = ReqLotteryRounds{} }
+func (m *ReqLotteryRounds) String() string { return proto.CompactTextString(m) }
+func (*ReqLotteryRounds) ProtoMessage()    {}
+func (*ReqLotteryRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{12}
+}
+
+func (m *ReqLotteryRounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqLotteryRounds.Unmarshal(m, b)
+}
+func (m *ReqLotteryRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqLotteryRounds.Marshal(b, m, deterministic)
+}
+func (m *ReqLotteryRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqLotteryRounds.Merge(m, src)
+}
+func (m *ReqLotteryRounds) XXX_Size() int {
+	return xxx_messageInfo_ReqLotteryRounds.Size(m)
+}
+func (m *ReqLotteryRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqLotteryRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqLotteryRounds proto.InternalMessageInfo
+
+func (m *ReqLotteryRounds) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+func (m *ReqLotteryRounds) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ReqLotteryRounds) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqLotteryRounds) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyLotteryRounds struct {
+	Rounds               []*LotteryRound `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyLotteryRounds) Reset()         { *m = ReplyLotteryRounds{} }
+func (m *ReplyLotteryRounds) String() string { return proto.CompactTextString(m) }
+func (*ReplyLotteryRounds) ProtoMessage()    {}
+func (*ReplyLotteryRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{13}
+}
+
+func (m *ReplyLotteryRounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyLotteryRounds.Unmarshal(m, b)
+}
+func (m *ReplyLotteryRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyLotteryRounds.Marshal(b, m, deterministic)
+}
+func (m *ReplyLotteryRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyLotteryRounds.Merge(m, src)
+}
+func (m *ReplyLotteryRounds) XXX_Size() int {
+	return xxx_messageInfo_ReplyLotteryRounds.Size(m)
+}
+func (m *ReplyLotteryRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyLotteryRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyLotteryRounds proto.InternalMessageInfo
+
+func (m *ReplyLotteryRounds) GetRounds() []*LotteryRound {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
+type ReqLotteryPurchases struct {
+	LotteryID            string   `protobuf:"bytes,1,opt,name=lotteryID,proto3" json:"lotteryID,omitempty"`
+	Round                int64    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Seq                  int64    `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqLotteryPurchases) Reset()         { *m = ReqLotteryPurchases{} }
+func (m *ReqLotteryPurchases) String() string { return proto.CompactTextString(m) }
+func (*ReqLotteryPurchases) ProtoMessage()    {}
+func (*ReqLotteryPurchases) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{14}
+}
+
+func (m *ReqLotteryPurchases) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqLotteryPurchases.Unmarshal(m, b)
+}
+func (m *ReqLotteryPurchases) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqLotteryPurchases.Marshal(b, m, deterministic)
+}
+func (m *ReqLotteryPurchases) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqLotteryPurchases.Merge(m, src)
+}
+func (m *ReqLotteryPurchases) XXX_Size() int {
+	return xxx_messageInfo_ReqLotteryPurchases.Size(m)
+}
+func (m *ReqLotteryPurchases) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqLotteryPurchases.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqLotteryPurchases proto.InternalMessageInfo
+
+func (m *ReqLotteryPurchases) GetLotteryID() string {
+	if m != nil {
+		return m.LotteryID
+	}
+	return ""
+}
+
+func (m *ReqLotteryPurchases) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ReqLotteryPurchases) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *ReqLotteryPurchases) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReqLotteryAddrPurchases struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqLotteryAddrPurchases) Reset()         { *m = ReqLotteryAddrPurchases{} }
+func (m *ReqLotteryAddrPurchases) String() string { return proto.CompactTextString(m) }
+func (*ReqLotteryAddrPurchases) ProtoMessage()    {}
+func (*ReqLotteryAddrPurchases) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{15}
+}
+
+func (m *ReqLotteryAddrPurchases) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqLotteryAddrPurchases.Unmarshal(m, b)
+}
+func (m *ReqLotteryAddrPurchases) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqLotteryAddrPurchases.Marshal(b, m, deterministic)
+}
+func (m *ReqLotteryAddrPurchases) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqLotteryAddrPurchases.Merge(m, src)
+}
+func (m *ReqLotteryAddrPurchases) XXX_Size() int {
+	return xxx_messageInfo_ReqLotteryAddrPurchases.Size(m)
+}
+func (m *ReqLotteryAddrPurchases) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqLotteryAddrPurchases.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqLotteryAddrPurchases proto.InternalMessageInfo
+
+func (m *ReqLotteryAddrPurchases) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqLotteryAddrPurchases) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqLotteryAddrPurchases) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqLotteryAddrPurchases) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyLotteryPurchases struct {
+	Purchases            []*LotteryPurchase `protobuf:"bytes,1,rep,name=purchases,proto3" json:"purchases,omitempty"`
+	PrimaryKey           string             `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReplyLotteryPurchases) Reset()         { *m = ReplyLotteryPurchases{} }
+func (m *ReplyLotteryPurchases) String() string { return proto.CompactTextString(m) }
+func (*ReplyLotteryPurchases) ProtoMessage()    {}
+func (*ReplyLotteryPurchases) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cce7afd61783b10, []int{16}
+}
+
+func (m *ReplyLotteryPurchases) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyLotteryPurchases.Unmarshal(m, b)
+}
+func (m *ReplyLotteryPurchases) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyLotteryPurchases.Marshal(b, m, deterministic)
+}
+func (m *ReplyLotteryPurchases) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyLotteryPurchases.Merge(m, src)
+}
+func (m *ReplyLotteryPurchases) XXX_Size() int {
+	return xxx_messageInfo_ReplyLotteryPurchases.Size(m)
+}
+func (m *ReplyLotteryPurchases) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyLotteryPurchases.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyLotteryPurchases proto.InternalMessageInfo
+
+func (m *ReplyLotteryPurchases) GetPurchases() []*LotteryPurchase {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+func (m *ReplyLotteryPurchases) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LotteryAction)(nil), "types.LotteryAction")
+	proto.RegisterType((*LotteryCreate)(nil), "types.LotteryCreate")
+	proto.RegisterType((*LotteryBuy)(nil), "types.LotteryBuy")
+	proto.RegisterType((*LotteryDraw)(nil), "types.LotteryDraw")
+	proto.RegisterType((*LotteryClose)(nil), "types.LotteryClose")
+	proto.RegisterType((*Lottery)(nil), "types.Lottery")
+	proto.RegisterType((*LotteryRound)(nil), "types.LotteryRound")
+	proto.RegisterType((*LotteryPurchase)(nil), "types.LotteryPurchase")
+	proto.RegisterType((*LotteryWinner)(nil), "types.LotteryWinner")
+	proto.RegisterType((*ReceiptLottery)(nil), "types.ReceiptLottery")
+	proto.RegisterType((*ReceiptLotteryDraw)(nil), "types.ReceiptLotteryDraw")
+	proto.RegisterType((*ReqLotteryRound)(nil), "types.ReqLotteryRound")
+	proto.RegisterType((*ReqLotteryRounds)(nil), "types.ReqLotteryRounds")
+	proto.RegisterType((*ReplyLotteryRounds)(nil), "types.ReplyLotteryRounds")
+	proto.RegisterType((*ReqLotteryPurchases)(nil), "types.ReqLotteryPurchases")
+	proto.RegisterType((*ReqLotteryAddrPurchases)(nil), "types.ReqLotteryAddrPurchases")
+	proto.RegisterType((*ReplyLotteryPurchases)(nil), "types.ReplyLotteryPurchases")
+}
+
+func init() { proto.RegisterFile("lottery.proto", fileDescriptor_2cce7afd61783b10) }
+
+var fileDescriptor_2cce7afd61783b10 = []byte{
+	// 870 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xef, 0x64, 0x32, 0x49, 0xf3, 0xd2, 0xa6, 0x8b, 0x77, 0x59, 0x46, 0x68, 0xb5, 0x8a, 0x2c,
+	0x81, 0x82, 0x8a, 0x72, 0x00, 0xee, 0xa8, 0xa5, 0x48, 0x41, 0x20, 0x54, 0xf9, 0x02, 0x27, 0x24,
+	0x77, 0xc6, 0x6c, 0xad, 0x4e, 0x67, 0xa6, 0xb6, 0x67, 0xcb, 0x2c, 0x27, 0x3e, 0x18, 0x47, 0xbe,
+	0x01, 0xe2, 0xca, 0x57, 0x41, 0x7e, 0xf6, 0x64, 0x9c, 0x10, 0x9a, 0x55, 0xd9, 0x9b, 0xdf, 0x7b,
+	0xbf, 0xbc, 0x3f, 0xbf, 0x9f, 0xe7, 0x39, 0x70, 0x5c, 0x54, 0xc6, 0x08, 0xd5, 0x2e, 0x6b, 0x55,
+	0x99, 0x8a, 0x24, 0xa6, 0xad, 0x85, 0xa6, 0x7f, 0x45, 0x70, 0xfc, 0x9d, 0x0b, 0x9c, 0x65, 0x46,
+	0x56, 0x25, 0x59, 0xc2, 0x28, 0x53, 0x82, 0x1b, 0x91, 0x46, 0xf3, 0x68, 0x31, 0xfd, 0xec, 0xd9,
+	0x12, 0x91, 0x4b, 0x8f, 0xfa, 0x0a, 0x63, 0xab, 0x03, 0xe6, 0x51, 0xe4, 0x23, 0x88, 0xaf, 0x9a,
+	0x36, 0x1d, 0x20, 0xf8, 0xbd, 0x4d, 0xf0, 0x79, 0xd3, 0xae, 0x0e, 0x98, 0x8d, 0x93, 0x05, 0x0c,
+	0x73, 0xc5, 0xef, 0xd3, 0x18, 0x71, 0x64, 0x13, 0x77, 0xa1, 0xf8, 0xfd, 0xea, 0x80, 0x21, 0x82,
+	0x9c, 0x42, 0x92, 0x15, 0x95, 0x16, 0xe9, 0x10, 0xa1, 0x4f, 0xb7, 0xea, 0xdb, 0xd0, 0xea, 0x80,
+	0x39, 0x0c, 0x99, 0xc1, 0xc0, 0xb4, 0x69, 0x32, 0x8f, 0x16, 0x09, 0x1b, 0x98, 0xf6, 0x7c, 0x0c,
+	0xc9, 0x6b, 0x5e, 0x34, 0x82, 0xfe, 0xd1, 0x0f, 0xe6, 0x5a, 0x26, 0x73, 0x98, 0x1a, 0x99, 0xdd,
+	0x08, 0x73, 0xa9, 0x64, 0xe6, 0xa6, 0x8b, 0x59, 0xe8, 0x22, 0x1f, 0xc3, 0xac, 0x6e, 0x54, 0x76,
+	0xcd, 0xb5, 0x38, 0x2f, 0xaa, 0xec, 0x46, 0xe3, 0x54, 0x31, 0xdb, 0xf2, 0x92, 0x17, 0x30, 0xb1,
+	0x9d, 0x5e, 0x88, 0x82, 0xb7, 0x38, 0x50, 0xcc, 0x7a, 0x07, 0x79, 0x0e, 0xa3, 0x5c, 0xbe, 0x92,
+	0x46, 0xe3, 0x00, 0x09, 0xf3, 0x96, 0xad, 0x5f, 0x2b, 0xf9, 0x46, 0x30, 0x6e, 0x64, 0xa5, 0xd3,
+	0x64, 0x1e, 0x2f, 0x12, 0x16, 0xba, 0x08, 0x81, 0xe1, 0xcf, 0x4d, 0x99, 0xa7, 0x23, 0x4c, 0x89,
+	0x67, 0xfa, 0x23, 0x40, 0x4f, 0xa6, 0xad, 0xec, 0x65, 0xfc, 0xe6, 0x02, 0x27, 0x98, 0xb0, 0xde,
+	0x61, 0x2b, 0x97, 0xcd, 0xed, 0x95, 0x50, 0xbe, 0x6f, 0x6f, 0x91, 0x67, 0x90, 0x64, 0x55, 0x53,
+	0x1a, 0xdf, 0xab, 0x33, 0xe8, 0x29, 0x4c, 0x03, 0xfa, 0x1f, 0x4e, 0x4d, 0x3f, 0x85, 0xa3, 0x50,
+	0x80, 0x3d, 0xe8, 0xdf, 0x07, 0x30, 0xf6, 0xf0, 0x3d, 0x2d, 0xa7, 0x30, 0xc6, 0x7b, 0x54, 0xb9,
+	0x9e, 0x27, 0xac, 0x33, 0xb7, 0xe5, 0x8a, 0xdf, 0x46, 0xae, 0xe1, 0x7e, 0xb9, 0x92, 0xff, 0x96,
+	0x6b, 0xf4, 0x90, 0x5c, 0xe3, 0x9d, 0x72, 0xd5, 0x55, 0x55, 0xa4, 0x87, 0x4e, 0x2e, 0x7b, 0xb6,
+	0x54, 0xab, 0xca, 0x6a, 0x38, 0x71, 0x54, 0xa3, 0x61, 0x6b, 0x68, 0xc3, 0x4d, 0xa3, 0x53, 0x70,
+	0x35, 0x9c, 0x65, 0xfd, 0xd7, 0x42, 0xbe, 0xba, 0x36, 0xe9, 0xd4, 0x09, 0xe6, 0x2c, 0xfa, 0x67,
+	0xbc, 0xa6, 0x9b, 0x61, 0x82, 0x87, 0x49, 0x5c, 0x17, 0x1d, 0x84, 0x45, 0xe7, 0x30, 0xd5, 0x86,
+	0x2b, 0xb3, 0x72, 0x15, 0x3c, 0x81, 0x81, 0xcb, 0x66, 0x15, 0x65, 0xee, 0xe3, 0x8e, 0xbb, 0xde,
+	0x41, 0x5e, 0x02, 0x58, 0x96, 0x7c, 0xd8, 0xf1, 0x16, 0x78, 0x82, 0xa1, 0x46, 0x1b, 0x43, 0xbd,
+	0x80, 0x49, 0x27, 0x80, 0xa5, 0x0d, 0xb3, 0xae, 0x1d, 0x56, 0x70, 0xa7, 0xa1, 0xf6, 0xbc, 0x75,
+	0xa6, 0x9d, 0x42, 0xf3, 0x42, 0xe8, 0x8e, 0x3a, 0x34, 0xec, 0x14, 0x45, 0x93, 0xdd, 0xb4, 0xdf,
+	0xbb, 0x8b, 0x0d, 0x6e, 0x8a, 0xc0, 0x45, 0x3e, 0x84, 0x43, 0xc5, 0xcb, 0x7c, 0xc5, 0xf5, 0x35,
+	0xd2, 0x78, 0xc4, 0xd6, 0x36, 0xf6, 0x62, 0x15, 0xbb, 0xb4, 0x3a, 0x1d, 0xf9, 0x5e, 0x3a, 0x47,
+	0x1f, 0xe5, 0x32, 0x4f, 0x8f, 0xc3, 0x28, 0x97, 0x39, 0x59, 0xc2, 0xf8, 0x5e, 0x96, 0xa5, 0x50,
+	0x3a, 0x9d, 0xcd, 0xe3, 0x7f, 0x6f, 0xc2, 0x1f, 0x30, 0xc8, 0x3a, 0x90, 0xed, 0xd4, 0xb2, 0x53,
+	0x7a, 0xc2, 0x4e, 0x5c, 0xa7, 0x81, 0x8b, 0xfe, 0x1d, 0xc1, 0x89, 0xff, 0xf1, 0xa5, 0x27, 0xe4,
+	0x51, 0xca, 0x3e, 0x81, 0x58, 0x8b, 0x3b, 0xaf, 0xa8, 0x3d, 0xda, 0xab, 0xc8, 0xf3, 0x5c, 0xa1,
+	0x88, 0x13, 0x86, 0xe7, 0x60, 0x1b, 0x24, 0xbb, 0xb7, 0xc1, 0x28, 0xd8, 0x06, 0x16, 0xcd, 0x6f,
+	0xd1, 0xed, 0x24, 0xf3, 0x56, 0x70, 0x45, 0x0f, 0xc3, 0x2b, 0x6a, 0xb3, 0xc8, 0x32, 0x17, 0xbf,
+	0x74, 0x6a, 0xa1, 0x41, 0x7f, 0x5d, 0x2f, 0x5d, 0xc7, 0xce, 0xba, 0xb1, 0x68, 0x67, 0x63, 0x6f,
+	0xb1, 0xa6, 0x6c, 0x06, 0x23, 0x85, 0xf2, 0xcb, 0x14, 0xcf, 0x16, 0x89, 0x3a, 0xf9, 0xc9, 0x9c,
+	0x41, 0x7f, 0x82, 0x19, 0x13, 0x99, 0x90, 0xb5, 0xe9, 0x76, 0x0f, 0x85, 0x61, 0xad, 0xc4, 0x6b,
+	0xff, 0x92, 0xcd, 0x36, 0xf5, 0x63, 0x18, 0x23, 0x0b, 0x18, 0x67, 0x8d, 0x52, 0xa2, 0x34, 0xfe,
+	0x0d, 0xdb, 0x86, 0x75, 0x61, 0xfa, 0x25, 0x90, 0xcd, 0xfc, 0xb8, 0x37, 0x3f, 0xe9, 0x24, 0x8a,
+	0x76, 0x3d, 0x57, 0xf8, 0xf9, 0x7a, 0xdd, 0xe8, 0xd7, 0x70, 0xc2, 0xc4, 0xdd, 0xff, 0xfd, 0xb0,
+	0xe9, 0x1b, 0x78, 0xb2, 0x95, 0x46, 0x3f, 0xea, 0x1a, 0x6d, 0xf0, 0x9d, 0x74, 0x7c, 0xdb, 0x6d,
+	0x29, 0x95, 0xc0, 0x3f, 0x03, 0x9e, 0xf4, 0xde, 0x41, 0xcf, 0x2c, 0x07, 0x75, 0xd1, 0x6e, 0x56,
+	0x3f, 0x85, 0x11, 0xa6, 0xd4, 0x69, 0x84, 0x5f, 0xca, 0x4e, 0x12, 0x3c, 0x84, 0xde, 0xc1, 0xd3,
+	0xbe, 0xfd, 0xcb, 0xf5, 0x62, 0x78, 0x37, 0x1f, 0xc2, 0x7a, 0xa6, 0x61, 0x30, 0x13, 0xfd, 0x2d,
+	0x82, 0x0f, 0xfa, 0x9a, 0x67, 0x79, 0xae, 0xfa, 0xba, 0xbb, 0x6e, 0xe8, 0x4b, 0x80, 0x5a, 0xc9,
+	0x5b, 0xae, 0xda, 0x6f, 0x45, 0xeb, 0x1f, 0xa6, 0xc0, 0xf3, 0x28, 0xe6, 0x6e, 0xe1, 0xfd, 0x90,
+	0xb9, 0xbe, 0x81, 0x2f, 0xc2, 0x7d, 0xe9, 0xf8, 0x7b, 0xbe, 0xc9, 0x5f, 0x87, 0x0d, 0xf7, 0xe8,
+	0x9e, 0x16, 0xaf, 0x46, 0xf8, 0x37, 0xef, 0xf3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x35, 0x33,
+	0x50, 0x6e, 0xf7, 0x09, 0x00, 0x00,
+}
@@ -0,0 +1,105 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types lottery插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// LotteryX 执行器名称
+	LotteryX   = "lottery"
+	actionName = map[string]int32{
+		"Create": LotteryActionCreate,
+		"Buy":    LotteryActionBuy,
+		"Draw":   LotteryActionDraw,
+		"Close":  LotteryActionClose,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogLottery:         {Ty: reflect.TypeOf(ReceiptLottery{}), Name: "LogLottery"},
+		TyLogLotteryPurchase: {Ty: reflect.TypeOf(LotteryPurchase{}), Name: "LogLotteryPurchase"},
+		TyLogLotteryDraw:     {Ty: reflect.TypeOf(ReceiptLotteryDraw{}), Name: "LogLotteryDraw"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(LotteryX))
+	types.RegistorExecutor(LotteryX, NewType())
+	types.RegisterDappFork(LotteryX, "Enable", 0)
+}
+
+// LotteryType lottery执行器类型
+type LotteryType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a lottery type object
+func NewType() *LotteryType {
+	c := &LotteryType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return lottery action
+func (l *LotteryType) GetPayload() types.Message {
+	return &LotteryAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (l *LotteryType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (l *LotteryType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (l *LotteryType) GetName() string {
+	return LotteryX
+}
+
+// CheckCreate 检查创建彩票的参数，奖级数不能超过号码的位数
+func CheckCreate(payload *LotteryCreate) error {
+	if payload.TicketPrice <= 0 || payload.Fund < 0 {
+		return ErrLotteryParam
+	}
+	if payload.PurchaseBlocks <= 0 || payload.PurchaseBlocks > MaxPurchaseBlocks {
+		return ErrLotteryParam
+	}
+	if payload.DrawDelay <= 0 || payload.DrawDelay > MaxDrawDelay {
+		return ErrLotteryParam
+	}
+	if payload.Digits <= 0 || payload.Digits > MaxDigits {
+		return ErrLotteryParam
+	}
+	if len(payload.PrizeRatios) == 0 || len(payload.PrizeRatios) > int(payload.Digits) {
+		return ErrPrizeRatio
+	}
+	var sum int32
+	for _, ratio := range payload.PrizeRatios {
+		if ratio < 0 || ratio > RatioBase {
+			return ErrPrizeRatio
+		}
+		sum += ratio
+	}
+	if sum == 0 || sum > RatioBase {
+		return ErrPrizeRatio
+	}
+	return nil
+}
+
+// NumberRange 号码的范围是 [0, 10^digits)
+func NumberRange(digits int32) int64 {
+	n := int64(1)
+	for i := int32(0); i < digits; i++ {
+		n *= 10
+	}
+	return n
+}
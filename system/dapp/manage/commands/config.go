@@ -30,6 +30,17 @@ func ConfigCmd() *cobra.Command {
 	cmd.AddCommand(
 		ConfigTxCmd(),
 		QueryConfigCmd(),
+		FreezeCmd(),
+		UnfreezeCmd(),
+		FreezeStatusCmd(),
+		FreezeRecordsCmd(),
+		ApproveCmd(),
+		ProposalCmd(),
+		PendingProposalsCmd(),
+		ScheduledConfigsCmd(),
+		RollbackCmd(),
+		ConfigHistoryCmd(),
+		ConfigAtHeightCmd(),
 	)
 
 	return cmd
@@ -56,6 +67,8 @@ func addConfigTxFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("value", "v", "", "operating object")
 	cmd.MarkFlagRequired("value")
 
+	cmd.Flags().Int64P("effective_height", "e", 0, "height the modification takes effect, 0 means immediately")
+
 }
 
 func configTx(cmd *cobra.Command, args []string) {
@@ -63,8 +76,9 @@ func configTx(cmd *cobra.Command, args []string) {
 	key, _ := cmd.Flags().GetString("config_key")
 	op, _ := cmd.Flags().GetString("operation")
 	opAddr, _ := cmd.Flags().GetString("value")
+	effectiveHeight, _ := cmd.Flags().GetInt64("effective_height")
 
-	v := &types.ModifyConfig{Key: key, Op: op, Value: opAddr, Addr: ""}
+	v := &types.ModifyConfig{Key: key, Op: op, Value: opAddr, Addr: "", EffectiveHeight: effectiveHeight}
 	modify := &pty.ManageAction{
 		Ty:    pty.ManageActionModifyConfig,
 		Value: &pty.ManageAction_Modify{Modify: v},
@@ -77,7 +91,7 @@ func configTx(cmd *cobra.Command, args []string) {
 		return
 	}
 	txHex := types.Encode(tx)
-	fmt.Println(hex.EncodeToString(txHex))
+	jsonclient.PrintString(hex.EncodeToString(txHex))
 }
 
 // QueryConfigCmd  query config
@@ -112,3 +126,319 @@ func queryConfig(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 	ctx.Run()
 }
+
+// FreezeCmd 冻结地址
+func FreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Freeze address, frozen address can not transfer out",
+		Run:   freezeTx,
+	}
+	addFreezeFlags(cmd)
+	return cmd
+}
+
+// UnfreezeCmd 解冻地址
+func UnfreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze",
+		Short: "Unfreeze address",
+		Run:   freezeTx,
+	}
+	addFreezeFlags(cmd)
+	return cmd
+}
+
+func addFreezeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("reason", "r", "", "reason of the operation")
+}
+
+func freezeTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	addr, _ := cmd.Flags().GetString("addr")
+	reason, _ := cmd.Flags().GetString("reason")
+
+	v := &pty.ManageFreeze{Addr: addr, Reason: reason}
+	action := &pty.ManageAction{Ty: pty.ManageActionFreeze, Value: &pty.ManageAction_Freeze{Freeze: v}}
+	if cmd.Name() == "unfreeze" {
+		action = &pty.ManageAction{Ty: pty.ManageActionUnfreeze, Value: &pty.ManageAction_Unfreeze{Unfreeze: v}}
+	}
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	var err error
+	tx, err = types.FormatTx(util.GetParaExecName(paraName, "manage"), tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	txHex := types.Encode(tx)
+	jsonclient.PrintString(hex.EncodeToString(txHex))
+}
+
+// FreezeStatusCmd 查询地址的冻结状态
+func FreezeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze_status",
+		Short: "Query freeze status of address",
+		Run:   freezeStatus,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func freezeStatus(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	addr, _ := cmd.Flags().GetString("addr")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = pty.FuncNameGetFreezeStatus
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: addr})
+
+	var res pty.FreezeRecord
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// FreezeRecordsCmd 查询冻结和解冻的操作记录
+func FreezeRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze_records",
+		Short: "List freeze and unfreeze records",
+		Run:   freezeRecords,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address, list records of all addresses if empty")
+	cmd.Flags().StringP("primary", "p", "", "primary key of last page")
+	cmd.Flags().Int32P("count", "c", pty.DefaultListCount, "count")
+	cmd.Flags().Int32P("direction", "d", 0, "0:desc 1:asc")
+	return cmd
+}
+
+func freezeRecords(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	addr, _ := cmd.Flags().GetString("addr")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	req := &pty.ReqFreezeRecords{Addr: addr, PrimaryKey: primary, Count: count, Direction: direction}
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = pty.FuncNameListFreezeRecord
+	params.Payload = types.MustPBToJSON(req)
+
+	var res pty.ReplyFreezeRecords
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// ApproveCmd 确认配置修改提案
+func ApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Approve config modification proposal",
+		Run:   approveTx,
+	}
+	cmd.Flags().StringP("proposal", "p", "", "proposal id")
+	cmd.MarkFlagRequired("proposal")
+	return cmd
+}
+
+func approveTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	id, _ := cmd.Flags().GetString("proposal")
+
+	action := &pty.ManageAction{Ty: pty.ManageActionApprove, Value: &pty.ManageAction_Approve{Approve: &pty.ManageApprove{ProposalID: id}}}
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	var err error
+	tx, err = types.FormatTx(util.GetParaExecName(paraName, "manage"), tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	txHex := types.Encode(tx)
+	jsonclient.PrintString(hex.EncodeToString(txHex))
+}
+
+// ProposalCmd 查询配置修改提案
+func ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal",
+		Short: "Query config modification proposal",
+		Run:   proposal,
+	}
+	cmd.Flags().StringP("proposal", "p", "", "proposal id")
+	cmd.MarkFlagRequired("proposal")
+	return cmd
+}
+
+func proposal(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	id, _ := cmd.Flags().GetString("proposal")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = pty.FuncNameGetProposal
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: id})
+
+	var res pty.ManageProposal
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// PendingProposalsCmd 查询等待确认的配置修改提案
+func PendingProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending_proposals",
+		Short: "List config modification proposals waiting for approval",
+		Run:   pendingProposals,
+	}
+	cmd.Flags().StringP("primary", "p", "", "primary key of last page")
+	cmd.Flags().Int32P("count", "c", pty.DefaultListCount, "count")
+	cmd.Flags().Int32P("direction", "d", 1, "0:desc 1:asc")
+	return cmd
+}
+
+func pendingProposals(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	req := &pty.ReqManageProposals{PrimaryKey: primary, Count: count, Direction: direction}
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = pty.FuncNameListProposals
+	params.Payload = types.MustPBToJSON(req)
+
+	var res pty.ReplyManageProposals
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// ScheduledConfigsCmd 查询还没有生效的计划修改
+func ScheduledConfigsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled",
+		Short: "List scheduled config modifications not yet effective",
+		Run:   scheduledConfigs,
+	}
+	cmd.Flags().StringP("primary", "p", "", "primary key of last page")
+	cmd.Flags().Int32P("count", "c", pty.DefaultListCount, "count")
+	cmd.Flags().Int32P("direction", "d", 1, "0:desc 1:asc")
+	return cmd
+}
+
+func scheduledConfigs(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	req := &pty.ReqManageSchedules{PrimaryKey: primary, Count: count, Direction: direction}
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = pty.FuncNameListSchedules
+	params.Payload = types.MustPBToJSON(req)
+
+	var res pty.ReplyManageSchedules
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// RollbackCmd 把配置项恢复到某个高度的值
+func RollbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback config item to the value at height",
+		Run:   rollbackTx,
+	}
+	cmd.Flags().StringP("key", "k", "", "config key")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().Int64P("height", "t", 0, "height of the value to restore")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func rollbackTx(cmd *cobra.Command, args []string) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	key, _ := cmd.Flags().GetString("key")
+	height, _ := cmd.Flags().GetInt64("height")
+
+	v := &pty.ManageRollback{Key: key, Height: height}
+	action := &pty.ManageAction{Ty: pty.ManageActionRollback, Value: &pty.ManageAction_Rollback{Rollback: v}}
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	var err error
+	tx, err = types.FormatTx(util.GetParaExecName(paraName, "manage"), tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	txHex := types.Encode(tx)
+	jsonclient.PrintString(hex.EncodeToString(txHex))
+}
+
+// ConfigHistoryCmd 查询配置项的修改记录
+func ConfigHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List modification history of config item",
+		Run:   configHistory,
+	}
+	cmd.Flags().StringP("key", "k", "", "config key")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().Int64P("seq", "s", 0, "seq of last page")
+	cmd.Flags().Int32P("count", "c", pty.DefaultListCount, "count")
+	cmd.Flags().Int32P("direction", "d", 0, "0:desc 1:asc")
+	return cmd
+}
+
+func configHistory(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	key, _ := cmd.Flags().GetString("key")
+	seq, _ := cmd.Flags().GetInt64("seq")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	req := &pty.ReqConfigHistory{Key: key, Seq: seq, Count: count, Direction: direction}
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = pty.FuncNameGetHistory
+	params.Payload = types.MustPBToJSON(req)
+
+	var res pty.ReplyConfigHistory
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// ConfigAtHeightCmd 查询配置项在某个高度的值
+func ConfigAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query_at",
+		Short: "Query config item at height",
+		Run:   configAtHeight,
+	}
+	cmd.Flags().StringP("key", "k", "", "config key")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().Int64P("height", "t", 0, "height")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func configAtHeight(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	key, _ := cmd.Flags().GetString("key")
+	height, _ := cmd.Flags().GetInt64("height")
+	req := &pty.ReqConfigAtHeight{Key: key, Height: height}
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = pty.FuncNameGetConfigAt
+	params.Payload = types.MustPBToJSON(req)
+
+	var res types.ReplyConfig
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -31,7 +31,43 @@ func (c *Manage) Exec_Modify(manageAction *types.ModifyConfig, tx *types.Transac
 			return nil, err
 		}
 	}
-	action := NewAction(c, tx)
+	action := NewAction(c, tx, index)
 	return action.modifyConfig(manageAction)
 
 }
+
+// Exec_Approve 超级管理员确认配置修改提案
+func (c *Manage) Exec_Approve(approve *mty.ManageApprove, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(c.GetHeight(), mty.ManageX, "ForkManageApprove") {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(c, tx, index)
+	return action.approve(approve)
+}
+
+// Exec_Rollback 超级管理员把配置项恢复到某个高度的值
+func (c *Manage) Exec_Rollback(rollback *mty.ManageRollback, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(c.GetHeight(), mty.ManageX, "ForkManageHistory") {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(c, tx, index)
+	return action.rollback(rollback)
+}
+
+// Exec_Freeze 冻结地址
+func (c *Manage) Exec_Freeze(freeze *mty.ManageFreeze, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(c.GetHeight(), mty.ManageX, "ForkManageFreeze") {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(c, tx, index)
+	return action.freeze(freeze, true)
+}
+
+// Exec_Unfreeze 解冻地址
+func (c *Manage) Exec_Unfreeze(freeze *mty.ManageFreeze, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(c.GetHeight(), mty.ManageX, "ForkManageFreeze") {
+		return nil, types.ErrActionNotSupport
+	}
+	action := NewAction(c, tx, index)
+	return action.freeze(freeze, false)
+}
@@ -15,6 +15,37 @@ func localKey(key string) []byte {
 	return []byte(fmt.Sprintf("LODB-manage-%s", key))
 }
 
+const (
+	freezeLogPrefix       = "LODB-manage-freezelog-all-"
+	addrFreezeLogPrefix   = "LODB-manage-freezelog-addr-"
+	pendingProposalPrefix = "LODB-manage-proposal-pending-"
+	schedulePrefix        = "LODB-manage-schedule-"
+)
+
+func scheduleIndex(schedule *pty.ManageSchedule) string {
+	return fmt.Sprintf("%018d-%018d", schedule.Modify.EffectiveHeight, schedule.Height*types.MaxTxsPerBlock+schedule.Index)
+}
+
+func calcScheduleKey(schedule *pty.ManageSchedule) []byte {
+	return []byte(schedulePrefix + scheduleIndex(schedule))
+}
+
+func calcPendingProposalKey(id string) []byte {
+	return []byte(pendingProposalPrefix + id)
+}
+
+func heightIndex(record *pty.FreezeRecord) string {
+	return fmt.Sprintf("%018d", record.Height*types.MaxTxsPerBlock+record.Index)
+}
+
+func calcFreezeLogKey(record *pty.FreezeRecord) []byte {
+	return []byte(freezeLogPrefix + heightIndex(record))
+}
+
+func calcAddrFreezeLogKey(addr string, record *pty.FreezeRecord) []byte {
+	return []byte(addrFreezeLogPrefix + addr + "-" + heightIndex(record))
+}
+
 // ExecDelLocal_Modify defines  execdellocal modify func
 func (c *Manage) ExecDelLocal_Modify(transfer *types.ModifyConfig, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	set := &types.LocalDBSet{}
@@ -32,5 +63,35 @@ func (c *Manage) ExecDelLocal_Modify(transfer *types.ModifyConfig, tx *types.Tra
 			clog.Debug("ExecDelLocal to savelogs", "config ", key, "value", receipt.Prev)
 		}
 	}
+	proposals, err := proposalLocal(receipt, true)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, proposals.KV...)
+	schedules, err := scheduleLocal(receipt, index, true)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, schedules.KV...)
 	return set, nil
 }
+
+// ExecDelLocal_Rollback 回滚配置恢复
+func (c *Manage) ExecDelLocal_Rollback(rollback *pty.ManageRollback, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.ExecDelLocal_Modify(nil, tx, receipt, index)
+}
+
+// ExecDelLocal_Approve 回滚确认提案
+func (c *Manage) ExecDelLocal_Approve(approve *pty.ManageApprove, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.ExecDelLocal_Modify(nil, tx, receipt, index)
+}
+
+// ExecDelLocal_Freeze 删除冻结记录
+func (c *Manage) ExecDelLocal_Freeze(freeze *pty.ManageFreeze, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return freezeLocal(receipt, true)
+}
+
+// ExecDelLocal_Unfreeze 删除解冻记录
+func (c *Manage) ExecDelLocal_Unfreeze(freeze *pty.ManageFreeze, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return freezeLocal(receipt, true)
+}
@@ -25,5 +25,110 @@ func (c *Manage) ExecLocal_Modify(transfer *types.ModifyConfig, tx *types.Transa
 			clog.Debug("ExecLocal to savelogs", "config ", key, "value", receipt.Current)
 		}
 	}
+	proposals, err := proposalLocal(receipt, false)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, proposals.KV...)
+	schedules, err := scheduleLocal(receipt, index, false)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, schedules.KV...)
+	return set, nil
+}
+
+// ExecLocal_Rollback 回滚和修改配置一样保存配置项
+func (c *Manage) ExecLocal_Rollback(rollback *pty.ManageRollback, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.ExecLocal_Modify(nil, tx, receipt, index)
+}
+
+// ExecLocal_Approve 确认数达到要求的时候和修改配置一样保存配置项，同时更新待确认提案的索引
+func (c *Manage) ExecLocal_Approve(approve *pty.ManageApprove, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.ExecLocal_Modify(nil, tx, receipt, index)
+}
+
+// ExecLocal_Freeze 保存冻结记录
+func (c *Manage) ExecLocal_Freeze(freeze *pty.ManageFreeze, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return freezeLocal(receipt, false)
+}
+
+// ExecLocal_Unfreeze 保存解冻记录
+func (c *Manage) ExecLocal_Unfreeze(freeze *pty.ManageFreeze, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return freezeLocal(receipt, false)
+}
+
+//freezeLocal 冻结和解冻记录同时按地址和全局索引，用于审计查询
+func freezeLocal(receipt *types.ReceiptData, isDel bool) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	if receipt.Ty != types.ExecOk {
+		return set, nil
+	}
+	for _, item := range receipt.Logs {
+		if item.Ty != pty.TyLogManageFreeze && item.Ty != pty.TyLogManageUnfreeze {
+			continue
+		}
+		var log pty.ReceiptFreeze
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		var value []byte
+		if !isDel {
+			value = types.Encode(log.Current)
+		}
+		set.KV = append(set.KV, &types.KeyValue{Key: calcFreezeLogKey(log.Current), Value: value})
+		set.KV = append(set.KV, &types.KeyValue{Key: calcAddrFreezeLogKey(log.Current.Addr, log.Current), Value: value})
+	}
+	return set, nil
+}
+
+//proposalLocal 按提案id索引待确认的提案，提案生效以后删除索引
+func proposalLocal(receipt *types.ReceiptData, isDel bool) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	if receipt.Ty != types.ExecOk {
+		return set, nil
+	}
+	for _, item := range receipt.Logs {
+		if item.Ty != pty.TyLogManageProposal {
+			continue
+		}
+		var log pty.ReceiptManageProposal
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		proposal := log.Current
+		if isDel {
+			proposal = log.Prev
+		}
+		var value []byte
+		if proposal != nil && proposal.Status == pty.ProposalPending {
+			value = types.Encode(proposal)
+		}
+		set.KV = append(set.KV, &types.KeyValue{Key: calcPendingProposalKey(log.Current.ProposalID), Value: value})
+	}
+	return set, nil
+}
+
+//scheduleLocal 按生效高度索引计划修改，收据中没有交易的序号，保存索引的时候补上，生效以后的记录在查询的时候过滤
+func scheduleLocal(receipt *types.ReceiptData, index int, isDel bool) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	if receipt.Ty != types.ExecOk {
+		return set, nil
+	}
+	for _, item := range receipt.Logs {
+		if item.Ty != pty.TyLogManageSchedule {
+			continue
+		}
+		var schedule pty.ManageSchedule
+		if err := types.Decode(item.Log, &schedule); err != nil {
+			return nil, err
+		}
+		schedule.Index = int64(index)
+		var value []byte
+		if !isDel {
+			value = types.Encode(&schedule)
+		}
+		set.KV = append(set.KV, &types.KeyValue{Key: calcScheduleKey(&schedule), Value: value})
+	}
 	return set, nil
 }
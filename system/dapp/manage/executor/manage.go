@@ -6,6 +6,8 @@
 package executor
 
 import (
+	"bytes"
+
 	log "github.com/33cn/chain33/common/log/log15"
 	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
@@ -64,6 +66,26 @@ func IsSuperManager(addr string) bool {
 	return false
 }
 
+//approveThreshold 配置修改需要确认的超级管理员数量，不超过超级管理员的数量，小于2的时候直接修改
+func approveThreshold() int {
+	threshold := int(conf.GInt("approveThreshold"))
+	if n := len(conf.GStrList("superManager")); threshold > n {
+		threshold = n
+	}
+	return threshold
+}
+
+// IsFriend governance合约执行通过的参数修改提案的时候可以修改manage合约的配置
+func (c *Manage) IsFriend(myexec, writekey []byte, othertx *types.Transaction) bool {
+	if !c.AllowIsSame(myexec) {
+		return false
+	}
+	if !bytes.HasPrefix(writekey, []byte(types.ManageKey(""))) {
+		return false
+	}
+	return string(types.GetParaExec(othertx.Execer)) == "governance" && othertx.ActionName() == "execute"
+}
+
 // CheckReceiptExecOk return true to check if receipt ty is ok
 func (c *Manage) CheckReceiptExecOk() bool {
 	return true
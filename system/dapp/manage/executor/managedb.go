@@ -5,6 +5,12 @@
 package executor
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	pty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
@@ -13,13 +19,15 @@ import (
 // Action attribute
 type Action struct {
 	db       dbm.KV
+	txhash   []byte
 	fromaddr string
 	height   int64
+	index    int
 }
 
 // NewAction new a action object
-func NewAction(m *Manage, tx *types.Transaction) *Action {
-	return &Action{db: m.GetStateDB(), fromaddr: tx.From(), height: m.GetHeight()}
+func NewAction(m *Manage, tx *types.Transaction, index int) *Action {
+	return &Action{db: m.GetStateDB(), txhash: tx.Hash(), fromaddr: tx.From(), height: m.GetHeight(), index: index}
 
 }
 
@@ -35,20 +43,84 @@ func (m *Action) modifyConfig(modify *types.ModifyConfig) (*types.Receipt, error
 	if !IsSuperManager(m.fromaddr) {
 		return nil, pty.ErrNoPrivilege
 	}
+	//提案确认的时候已经过了生效高度就直接修改，所以只在提出的时候检查生效高度
+	if modify.EffectiveHeight != 0 && modify.EffectiveHeight <= m.height {
+		return nil, pty.ErrEffectiveHeight
+	}
+	if types.IsDappFork(m.height, pty.ManageX, "ForkManageApprove") && approveThreshold() > 1 {
+		if err := checkModify(modify, m.height); err != nil {
+			return nil, err
+		}
+		return m.propose(modify)
+	}
+	return ModifyConfig(m.db, modify, m.height, m.txhash)
+}
+
+func checkModify(modify *types.ModifyConfig, height int64) error {
 	if len(modify.Key) == 0 {
-		return nil, pty.ErrBadConfigKey
+		return pty.ErrBadConfigKey
+	}
+	if modify.Op == "rollback" && types.IsDappFork(height, pty.ManageX, "ForkManageHistory") {
+		return checkRollback(modify, height)
 	}
 	if modify.Op != "add" && modify.Op != "delete" {
-		return nil, pty.ErrBadConfigOp
+		return pty.ErrBadConfigOp
+	}
+	//冻结状态和配置项保存在相同的前缀下，不能通过修改配置改变冻结状态
+	if types.IsDappFork(height, pty.ManageX, "ForkManageFreeze") && pty.IsFreezeKey(modify.Key) {
+		return pty.ErrBadConfigKey
+	}
+	if types.IsDappFork(height, pty.ManageX, "ForkManageApprove") && pty.IsProposalKey(modify.Key) {
+		return pty.ErrBadConfigKey
+	}
+	if types.IsDappFork(height, pty.ManageX, "ForkManageHistory") && pty.IsHistoryKey(modify.Key) {
+		return pty.ErrBadConfigKey
+	}
+	if modify.EffectiveHeight != 0 && !types.IsDappFork(height, pty.ManageX, "ForkManageSchedule") {
+		return pty.ErrEffectiveHeight
+	}
+	//共识切换计划只能修改还没有生效的高度，保证所有节点按相同的状态切换
+	if modify.Key == pty.ConsensusScheduleKey {
+		schedule, err := pty.ParseConsensusSchedule(modify.Value)
+		if err != nil {
+			return err
+		}
+		if schedule.Height <= height || schedule.Height < modify.EffectiveHeight {
+			return pty.ErrBadConfigValue
+		}
+	}
+	return nil
+}
+
+//checkRollback 回滚的value是要恢复的高度，共识切换计划不能回滚
+func checkRollback(modify *types.ModifyConfig, height int64) error {
+	if len(modify.Key) == 0 || modify.Key == pty.ConsensusScheduleKey || pty.IsFreezeKey(modify.Key) ||
+		pty.IsProposalKey(modify.Key) || pty.IsHistoryKey(modify.Key) {
+		return pty.ErrBadConfigKey
+	}
+	if modify.EffectiveHeight != 0 {
+		return pty.ErrEffectiveHeight
+	}
+	target, err := strconv.ParseInt(modify.Value, 10, 64)
+	if err != nil || target < 0 || target >= height {
+		return pty.ErrBadConfigValue
+	}
+	return nil
+}
+
+// ModifyConfig 修改配置项，不检查权限，governance合约执行通过的提案的时候也用这个函数修改配置
+func ModifyConfig(db dbm.KV, modify *types.ModifyConfig, height int64, txhash []byte) (*types.Receipt, error) {
+	if err := checkModify(modify, height); err != nil {
+		return nil, err
 	}
 
 	var item types.ConfigItem
-	value, err := m.db.Get([]byte(types.ManageKey(modify.Key)))
+	value, err := db.Get([]byte(types.ManageKey(modify.Key)))
 	if err != nil {
 		value = nil
 	}
 	if value == nil {
-		value, err = m.db.Get([]byte(types.ConfigKey(modify.Key)))
+		value, err = db.Get([]byte(types.ConfigKey(modify.Key)))
 		if err != nil {
 			value = nil
 		}
@@ -71,48 +143,195 @@ func (m *Action) modifyConfig(modify *types.ModifyConfig) (*types.Receipt, error
 	copyItem := item
 	copyItem.Value = &types.ConfigItem_Arr{Arr: &copyValue}
 
-	switch modify.Op {
-	case "add":
-		item.GetArr().Value = append(item.GetArr().Value, modify.Value)
-		item.Addr = modify.Addr
-		clog.Info("modifyConfig", "add key", modify.Key, "from", copyItem.GetArr().Value, "to", item.GetArr().Value)
-
-	case "delete":
-		item.Addr = modify.Addr
-		item.GetArr().Value = make([]string, 0)
-		for _, value := range copyItem.GetArr().Value {
-			clog.Info("modifyConfig", "key delete", modify.Key, "current", value)
-			if value != modify.Value {
-				item.GetArr().Value = append(item.GetArr().Value, value)
+	//已经生效的计划修改先合并到配置中
+	if len(item.Scheduled) > 0 {
+		item.GetArr().Value = types.GetConfigValues(&item, height)
+		var pending []*types.ModifyConfig
+		for _, scheduled := range item.Scheduled {
+			if scheduled.EffectiveHeight > height {
+				pending = append(pending, scheduled)
 			}
 		}
-		clog.Info("modifyConfig", "delete key", modify.Key, "from", copyItem.GetArr().Value, "to", item.GetArr().Value)
-		/*
-			case "assign":
-				if item.Ty == types.ConfigItemIntConfig {
-					intvalue, err := strconv.Atoi(modify.Value)
-					if err != nil {
-						clog.Error("modifyConfig", "key", modify.Key, "strconv.Atoi", err)
-					}
-					item.GetInt().Value = int32(intvalue)
-					clog.Info("modifyConfig", "key", modify.Key, "from", copyItem.GetInt().Value, "to", item.GetInt().Value)
-				} else {
-					item.GetStr().Value = modify.Value
-					clog.Info("modifyConfig", "key", modify.Key, "from", copyItem.GetStr().Value, "to", item.GetStr().Value)
-				}*/
+		item.Scheduled = pending
+	}
+
+	scheduled := modify.EffectiveHeight > height
+	if modify.Op == "rollback" {
+		target, _ := strconv.ParseInt(modify.Value, 10, 64)
+		values, err := pty.GetConfigAtHeight(db, modify.Key, target)
+		if err != nil {
+			return nil, err
+		}
+		item.GetArr().Value = append(make([]string, 0), values...)
+		item.Scheduled = nil
+		item.Addr = modify.Addr
+		clog.Info("modifyConfig", "rollback key", modify.Key, "height", target, "from", copyItem.GetArr().Value, "to", item.GetArr().Value)
+	} else if scheduled {
+		//生效高度相同的修改按交易的顺序执行
+		i := sort.Search(len(item.Scheduled), func(i int) bool {
+			return item.Scheduled[i].EffectiveHeight > modify.EffectiveHeight
+		})
+		item.Scheduled = append(append(append([]*types.ModifyConfig{}, item.Scheduled[:i]...), modify), item.Scheduled[i:]...)
+		clog.Info("modifyConfig", "schedule key", modify.Key, "op", modify.Op, "value", modify.Value, "height", modify.EffectiveHeight)
+	} else {
+		item.GetArr().Value = types.ApplyConfigModify(item.GetArr().Value, modify)
+		item.Addr = modify.Addr
+		clog.Info("modifyConfig", modify.Op+" key", modify.Key, "from", copyItem.GetArr().Value, "to", item.GetArr().Value)
 	}
 
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
-	key := types.ManaeKeyWithHeigh(modify.Key, m.height)
+	key := types.ManaeKeyWithHeigh(modify.Key, height)
 	valueSave := types.Encode(&item)
-	err = m.db.Set([]byte(key), valueSave)
+	err = db.Set([]byte(key), valueSave)
 	if err != nil {
 		return nil, err
 	}
 	kv = append(kv, &types.KeyValue{Key: []byte(key), Value: valueSave})
 	log := types.ReceiptConfig{Prev: &copyItem, Current: &item}
 	logs = append(logs, &types.ReceiptLog{Ty: pty.TyLogModifyConfig, Log: types.Encode(&log)})
+	if scheduled {
+		logs = append(logs, &types.ReceiptLog{Ty: pty.TyLogManageSchedule, Log: types.Encode(&pty.ManageSchedule{Modify: modify, Height: height})})
+	}
+	if types.IsDappFork(height, pty.ManageX, "ForkManageHistory") {
+		history, err := saveHistory(db, &pty.ConfigHistory{Key: modify.Key, Height: height, TxHash: common.ToHex(txhash), Modify: modify, Prev: &copyItem, Current: &item})
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, history...)
+	}
 	receipt := &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
 }
+
+//saveHistory 保存配置项的修改记录，回滚和按高度查询配置的时候使用
+func saveHistory(db dbm.KV, history *pty.ConfigHistory) ([]*types.KeyValue, error) {
+	count, err := pty.GetHistoryCount(db, history.Key)
+	if err != nil {
+		return nil, err
+	}
+	history.Seq = count + 1
+	kvs := []*types.KeyValue{
+		{Key: pty.CalcHistoryKey(history.Key, history.Seq), Value: types.Encode(history)},
+		{Key: pty.CalcHistoryCountKey(history.Key), Value: types.Encode(&types.Int64{Data: history.Seq})},
+	}
+	for _, kv := range kvs {
+		if err := db.Set(kv.Key, kv.Value); err != nil {
+			return nil, err
+		}
+	}
+	return kvs, nil
+}
+
+//rollback 超级管理员把配置项恢复到某个高度的值，和修改配置一样需要确认
+func (m *Action) rollback(rollback *pty.ManageRollback) (*types.Receipt, error) {
+	return m.modifyConfig(&types.ModifyConfig{Key: rollback.Key, Op: "rollback", Value: strconv.FormatInt(rollback.Height, 10)})
+}
+
+//freeze 冻结或者解冻地址，只有超级管理员可以操作
+func (m *Action) freeze(freeze *pty.ManageFreeze, frozen bool) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	if err := address.CheckAddress(freeze.Addr); err != nil {
+		return nil, err
+	}
+	prev, err := pty.GetFreezeRecord(m.db, freeze.Addr)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	if prev == nil {
+		prev = &pty.FreezeRecord{Addr: freeze.Addr}
+	}
+	if frozen && prev.Frozen {
+		return nil, pty.ErrAddrFrozen
+	}
+	if !frozen && !prev.Frozen {
+		return nil, pty.ErrAddrNotFrozen
+	}
+	current := &pty.FreezeRecord{
+		Addr:     freeze.Addr,
+		Frozen:   frozen,
+		Reason:   freeze.Reason,
+		Operator: m.fromaddr,
+		Height:   m.height,
+		Index:    int64(m.index),
+		TxHash:   common.ToHex(m.txhash),
+	}
+	kv := &types.KeyValue{Key: pty.CalcFreezeKey(freeze.Addr), Value: types.Encode(current)}
+	if err := m.db.Set(kv.Key, kv.Value); err != nil {
+		return nil, err
+	}
+	logTy := int32(pty.TyLogManageFreeze)
+	if !frozen {
+		logTy = pty.TyLogManageUnfreeze
+	}
+	log := &types.ReceiptLog{Ty: logTy, Log: types.Encode(&pty.ReceiptFreeze{Prev: prev, Current: current})}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func (m *Action) saveProposal(prev, current *pty.ManageProposal) (*types.KeyValue, *types.ReceiptLog, error) {
+	kv := &types.KeyValue{Key: pty.CalcProposalKey(current.ProposalID), Value: types.Encode(current)}
+	if err := m.db.Set(kv.Key, kv.Value); err != nil {
+		return nil, nil, err
+	}
+	log := &types.ReceiptLog{Ty: pty.TyLogManageProposal, Log: types.Encode(&pty.ReceiptManageProposal{Prev: prev, Current: current})}
+	return kv, log, nil
+}
+
+//propose 需要多个超级管理员确认的时候，配置修改先保存为提案，提出者自动确认
+func (m *Action) propose(modify *types.ModifyConfig) (*types.Receipt, error) {
+	proposal := &pty.ManageProposal{
+		ProposalID: fmt.Sprintf("%018d", m.height*types.MaxTxsPerBlock+int64(m.index)),
+		Modify:     modify,
+		Proposer:   m.fromaddr,
+		Approvals:  []string{m.fromaddr},
+		Status:     pty.ProposalPending,
+		Height:     m.height,
+	}
+	kv, log, err := m.saveProposal(nil, proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+//approve 超级管理员确认提案，确认数达到approveThreshold的时候修改配置，已经不是超级管理员的确认不计算在内
+func (m *Action) approve(approve *pty.ManageApprove) (*types.Receipt, error) {
+	if !IsSuperManager(m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	prev, err := pty.GetProposal(m.db, approve.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Status != pty.ProposalPending {
+		return nil, pty.ErrProposalApplied
+	}
+	count := 1
+	for _, addr := range prev.Approvals {
+		if addr == m.fromaddr {
+			return nil, pty.ErrProposalApproved
+		}
+		if IsSuperManager(addr) {
+			count++
+		}
+	}
+	current := *prev
+	current.Approvals = append(append([]string{}, prev.Approvals...), m.fromaddr)
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if count >= approveThreshold() {
+		if receipt, err = ModifyConfig(m.db, prev.Modify, m.height, m.txhash); err != nil {
+			return nil, err
+		}
+		current.Status = pty.ProposalApplied
+		current.ApplyHeight = m.height
+	}
+	kv, log, err := m.saveProposal(prev, &current)
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, kv)
+	receipt.Logs = append(receipt.Logs, log)
+	return receipt, nil
+}
@@ -7,6 +7,8 @@ package executor
 import (
 	"fmt"
 
+	dbm "github.com/33cn/chain33/common/db"
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
 )
 
@@ -36,7 +38,7 @@ func (c *Manage) Query_GetConfigItem(in *types.ReqString) (types.Message, error)
 			clog.Error("modifyConfig", "get db key", in.Data)
 			return nil, err // types.ErrBadConfigValue
 		}
-		reply.Value = fmt.Sprint(item.GetArr().Value)
+		reply.Value = fmt.Sprint(types.GetConfigValues(&item, c.GetHeight()))
 	} else { // if config item not exist
 		reply.Value = ""
 	}
@@ -44,3 +46,159 @@ func (c *Manage) Query_GetConfigItem(in *types.ReqString) (types.Message, error)
 
 	return &reply, nil
 }
+
+// Query_GetFreezeStatus 查询地址当前的冻结状态
+func (c *Manage) Query_GetFreezeStatus(in *types.ReqString) (types.Message, error) {
+	record, err := pty.GetFreezeRecord(c.GetStateDB(), in.Data)
+	if err == types.ErrNotFound {
+		return &pty.FreezeRecord{Addr: in.Data}, nil
+	}
+	return record, err
+}
+
+// Query_ListFreezeRecords 查询冻结和解冻的操作记录，direction 为0的时候从最新的记录开始
+func (c *Manage) Query_ListFreezeRecords(in *pty.ReqFreezeRecords) (types.Message, error) {
+	count := in.Count
+	if count <= 0 {
+		count = pty.DefaultListCount
+	}
+	if count > pty.MaxListCount {
+		count = pty.MaxListCount
+	}
+	prefix := freezeLogPrefix
+	if in.Addr != "" {
+		prefix = addrFreezeLogPrefix + in.Addr + "-"
+	}
+	var key []byte
+	if in.PrimaryKey != "" {
+		key = []byte(prefix + in.PrimaryKey)
+	}
+	values, err := c.GetLocalDB().List([]byte(prefix), key, count, in.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &pty.ReplyFreezeRecords{}
+	for _, value := range values {
+		var record pty.FreezeRecord
+		if err := types.Decode(value, &record); err != nil {
+			return nil, err
+		}
+		reply.Records = append(reply.Records, &record)
+		reply.PrimaryKey = heightIndex(&record)
+	}
+	return reply, nil
+}
+
+// Query_GetProposal 查询配置修改提案
+func (c *Manage) Query_GetProposal(in *types.ReqString) (types.Message, error) {
+	return pty.GetProposal(c.GetStateDB(), in.Data)
+}
+
+// Query_ListPendingProposals 查询等待确认的配置修改提案，按提出的先后排序
+func (c *Manage) Query_ListPendingProposals(in *pty.ReqManageProposals) (types.Message, error) {
+	count := in.Count
+	if count <= 0 {
+		count = pty.DefaultListCount
+	}
+	if count > pty.MaxListCount {
+		count = pty.MaxListCount
+	}
+	var key []byte
+	if in.PrimaryKey != "" {
+		key = calcPendingProposalKey(in.PrimaryKey)
+	}
+	values, err := c.GetLocalDB().List([]byte(pendingProposalPrefix), key, count, in.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &pty.ReplyManageProposals{}
+	for _, value := range values {
+		var proposal pty.ManageProposal
+		if err := types.Decode(value, &proposal); err != nil {
+			return nil, err
+		}
+		reply.Proposals = append(reply.Proposals, &proposal)
+		reply.PrimaryKey = proposal.ProposalID
+	}
+	return reply, nil
+}
+
+// Query_ListScheduledConfigs 查询还没有生效的计划修改，按生效高度排序
+func (c *Manage) Query_ListScheduledConfigs(in *pty.ReqManageSchedules) (types.Message, error) {
+	count := in.Count
+	if count <= 0 {
+		count = pty.DefaultListCount
+	}
+	if count > pty.MaxListCount {
+		count = pty.MaxListCount
+	}
+	var key []byte
+	if in.PrimaryKey != "" {
+		key = []byte(schedulePrefix + in.PrimaryKey)
+	} else if in.Direction == dbm.ListASC {
+		//升序的时候从最后一条已经生效的记录之后开始
+		seek := []byte(fmt.Sprintf("%s%018d", schedulePrefix, c.GetHeight()+1))
+		last, err := c.GetLocalDB().List([]byte(schedulePrefix), seek, 1, dbm.ListSeek)
+		if err == nil && len(last) == 2 {
+			key = last[0]
+		}
+	}
+	values, err := c.GetLocalDB().List([]byte(schedulePrefix), key, count, in.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &pty.ReplyManageSchedules{}
+	for _, value := range values {
+		var schedule pty.ManageSchedule
+		if err := types.Decode(value, &schedule); err != nil {
+			return nil, err
+		}
+		if schedule.Modify.EffectiveHeight <= c.GetHeight() {
+			break
+		}
+		reply.Schedules = append(reply.Schedules, &schedule)
+		reply.PrimaryKey = scheduleIndex(&schedule)
+	}
+	return reply, nil
+}
+
+// Query_GetConfigHistory 查询配置项的修改记录，direction 为0的时候从最新的记录开始
+func (c *Manage) Query_GetConfigHistory(in *pty.ReqConfigHistory) (types.Message, error) {
+	count := in.Count
+	if count <= 0 {
+		count = pty.DefaultListCount
+	}
+	if count > pty.MaxListCount {
+		count = pty.MaxListCount
+	}
+	total, err := pty.GetHistoryCount(c.GetStateDB(), in.Key)
+	if err != nil {
+		return nil, err
+	}
+	seq, step := in.Seq+1, int64(1)
+	if in.Direction == dbm.ListDESC {
+		seq, step = in.Seq-1, -1
+		if in.Seq == 0 {
+			seq = total
+		}
+	}
+	reply := &pty.ReplyConfigHistory{}
+	for ; seq >= 1 && seq <= total && len(reply.Records) < int(count); seq += step {
+		history, err := pty.GetHistory(c.GetStateDB(), in.Key, seq)
+		if err != nil {
+			return nil, err
+		}
+		reply.Records = append(reply.Records, history)
+		reply.Seq = seq
+	}
+	return reply, nil
+}
+
+// Query_GetConfigAtHeight 查询配置项在某个高度生效的值
+func (c *Manage) Query_GetConfigAtHeight(in *pty.ReqConfigAtHeight) (types.Message, error) {
+	values, err := pty.GetConfigAtHeight(c.GetStateDB(), in.Key, in.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplyConfig{Key: in.Key, Value: fmt.Sprint(values)}, nil
+}
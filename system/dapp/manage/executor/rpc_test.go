@@ -3,7 +3,9 @@ package executor
 import (
 	"testing"
 
+	"github.com/33cn/chain33/common/crypto"
 	rpctypes "github.com/33cn/chain33/rpc/types"
+	pty "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/util/testnode"
@@ -218,3 +220,250 @@ func TestModify(t *testing.T) {
 	_, err = manager.ExecLocal_Modify(nil, nil, receipt, 0)
 	assert.NoError(t, err)
 }
+
+func sendManageTx(t *testing.T, mocker *testnode.Chain33Mock, priv crypto.PrivKey, action string, payload types.Message) int32 {
+	req := &rpctypes.CreateTxIn{
+		Execer:     "manage",
+		ActionName: action,
+		Payload:    types.MustPBToJSON(payload),
+	}
+	var txhex string
+	err := mocker.GetJSONC().Call("Chain33.CreateTransaction", req, &txhex)
+	assert.Nil(t, err)
+	hash, err := mocker.SendAndSign(priv, txhex)
+	assert.Nil(t, err)
+	txinfo, err := mocker.WaitTx(hash)
+	assert.Nil(t, err)
+	return txinfo.Receipt.Ty
+}
+
+func TestManageFreeze(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	mocker := testnode.NewWithConfig(cfg, sub, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	err := mocker.SendHot()
+	assert.Nil(t, err)
+	addr, priv := util.Genaddress()
+	mocker.SendTx(util.CreateCoinsTx(mocker.GetHotKey(), addr, 10*types.Coin))
+	assert.Nil(t, mocker.Wait())
+
+	//只有超级管理员可以冻结
+	ty := sendManageTx(t, mocker, priv, "Freeze", &pty.ManageFreeze{Addr: addr, Reason: "test"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Freeze", &pty.ManageFreeze{Addr: addr, Reason: "test"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Freeze", &pty.ManageFreeze{Addr: addr})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	//不能通过修改配置改变冻结状态
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: pty.FreezeKeyPrefix + addr, Op: "add", Value: "1"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//冻结的地址不能转出
+	_, err = mocker.GetAPI().SendTx(util.CreateCoinsTx(priv, mocker.GetHotAddress(), types.Coin))
+	assert.Equal(t, pty.ErrAddrFrozen, err)
+	msg, err := mocker.GetAPI().Query("manage", pty.FuncNameGetFreezeStatus, &types.ReqString{Data: addr})
+	assert.Nil(t, err)
+	assert.True(t, msg.(*pty.FreezeRecord).Frozen)
+	assert.Equal(t, mocker.GetHotAddress(), msg.(*pty.FreezeRecord).Operator)
+
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Unfreeze", &pty.ManageFreeze{Addr: addr, Reason: "done"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Unfreeze", &pty.ManageFreeze{Addr: addr})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	hash := mocker.SendTx(util.CreateCoinsTx(priv, mocker.GetHotAddress(), types.Coin))
+	txinfo, err := mocker.WaitTx(hash)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), txinfo.Receipt.Ty)
+
+	//审计查询，默认从最新的记录开始
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameListFreezeRecord, &pty.ReqFreezeRecords{})
+	assert.Nil(t, err)
+	records := msg.(*pty.ReplyFreezeRecords).Records
+	assert.Equal(t, 2, len(records))
+	assert.False(t, records[0].Frozen)
+	assert.Equal(t, "done", records[0].Reason)
+	assert.True(t, records[1].Frozen)
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameListFreezeRecord, &pty.ReqFreezeRecords{Addr: addr, Direction: 1, Count: 1})
+	assert.Nil(t, err)
+	reply := msg.(*pty.ReplyFreezeRecords)
+	assert.Equal(t, 1, len(reply.Records))
+	assert.True(t, reply.Records[0].Frozen)
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameListFreezeRecord, &pty.ReqFreezeRecords{Addr: addr, Direction: 1, PrimaryKey: reply.PrimaryKey})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*pty.ReplyFreezeRecords).Records))
+	assert.False(t, msg.(*pty.ReplyFreezeRecords).Records[0].Frozen)
+}
+
+func TestManageApprove(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	mocker := testnode.NewWithConfig(cfg, sub, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	err := mocker.SendHot()
+	assert.Nil(t, err)
+	addr, priv := util.Genaddress()
+	other, otherPriv := util.Genaddress()
+	mocker.SendTx(util.CreateCoinsTx(mocker.GetHotKey(), addr, 10*types.Coin))
+	assert.Nil(t, mocker.Wait())
+	mocker.SendTx(util.CreateCoinsTx(mocker.GetHotKey(), other, 10*types.Coin))
+	assert.Nil(t, mocker.Wait())
+
+	managers, _ := conf.G("superManager")
+	types.S("config.exec.sub.manage.superManager", []interface{}{mocker.GetHotAddress(), addr})
+	types.S("config.exec.sub.manage.approveThreshold", int64(2))
+	defer types.S("config.exec.sub.manage.superManager", managers)
+	defer types.S("config.exec.sub.manage.approveThreshold", int64(0))
+
+	//修改配置先生成提案，配置不变
+	ty := sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "APP"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	msg, err := mocker.GetAPI().Query("manage", "GetConfigItem", &types.ReqString{Data: "token-blacklist"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", msg.(*types.ReplyConfig).Value)
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameListProposals, &pty.ReqManageProposals{Direction: 1})
+	assert.Nil(t, err)
+	proposals := msg.(*pty.ReplyManageProposals).Proposals
+	assert.Equal(t, 1, len(proposals))
+	id := proposals[0].ProposalID
+	assert.Equal(t, []string{mocker.GetHotAddress()}, proposals[0].Approvals)
+
+	//不是超级管理员和重复确认都失败
+	ty = sendManageTx(t, mocker, otherPriv, "Approve", &pty.ManageApprove{ProposalID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Approve", &pty.ManageApprove{ProposalID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendManageTx(t, mocker, priv, "Approve", &pty.ManageApprove{ProposalID: "000000000000000001"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//达到确认数以后修改配置
+	ty = sendManageTx(t, mocker, priv, "Approve", &pty.ManageApprove{ProposalID: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	msg, err = mocker.GetAPI().Query("manage", "GetConfigItem", &types.ReqString{Data: "token-blacklist"})
+	assert.Nil(t, err)
+	assert.Equal(t, "[APP]", msg.(*types.ReplyConfig).Value)
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameGetProposal, &types.ReqString{Data: id})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.ProposalApplied), msg.(*pty.ManageProposal).Status)
+	ty = sendManageTx(t, mocker, priv, "Approve", &pty.ManageApprove{ProposalID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameListProposals, &pty.ReqManageProposals{Direction: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(msg.(*pty.ReplyManageProposals).Proposals))
+}
+
+func TestManageSchedule(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	mocker := testnode.NewWithConfig(cfg, sub, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	err := mocker.SendHot()
+	assert.Nil(t, err)
+
+	getItem := func() string {
+		msg, err := mocker.GetAPI().Query("manage", "GetConfigItem", &types.ReqString{Data: "token-blacklist"})
+		assert.Nil(t, err)
+		return msg.(*types.ReplyConfig).Value
+	}
+	height := mocker.GetLastBlock().Height
+	ty := sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "OLD"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "NEW", EffectiveHeight: height})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//同一个高度生效的修改按交易的顺序执行
+	height = mocker.GetLastBlock().Height
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "NEW", EffectiveHeight: height + 6})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: "token-blacklist", Op: "delete", Value: "OLD", EffectiveHeight: height + 4})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "MID", EffectiveHeight: height + 4})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, "[OLD]", getItem())
+	msg, err := mocker.GetAPI().Query("manage", pty.FuncNameListSchedules, &pty.ReqManageSchedules{Direction: 1})
+	assert.Nil(t, err)
+	schedules := msg.(*pty.ReplyManageSchedules).Schedules
+	assert.Equal(t, 3, len(schedules))
+	assert.Equal(t, "OLD", schedules[0].Modify.Value)
+	assert.Equal(t, "MID", schedules[1].Modify.Value)
+	assert.Equal(t, "NEW", schedules[2].Modify.Value)
+
+	for mocker.GetLastBlock().Height < height+4 {
+		assert.Nil(t, mocker.SendHot())
+	}
+	assert.Equal(t, "[MID]", getItem())
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameListSchedules, &pty.ReqManageSchedules{Direction: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*pty.ReplyManageSchedules).Schedules))
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameListSchedules, &pty.ReqManageSchedules{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*pty.ReplyManageSchedules).Schedules))
+
+	//再次修改的时候合并已经生效的计划修改
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "NOW"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, "[MID NOW]", getItem())
+	for mocker.GetLastBlock().Height < height+6 {
+		assert.Nil(t, mocker.SendHot())
+	}
+	assert.Equal(t, "[MID NOW NEW]", getItem())
+}
+
+func TestManageHistory(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	mocker := testnode.NewWithConfig(cfg, sub, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	err := mocker.SendHot()
+	assert.Nil(t, err)
+	addr, priv := util.Genaddress()
+	mocker.SendTx(util.CreateCoinsTx(mocker.GetHotKey(), addr, 10*types.Coin))
+	assert.Nil(t, mocker.Wait())
+
+	var heights []int64
+	for _, value := range []string{"A", "B", "C"} {
+		ty := sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: value})
+		assert.Equal(t, int32(types.ExecOk), ty)
+		heights = append(heights, mocker.GetLastBlock().Height)
+	}
+	ty := sendManageTx(t, mocker, mocker.GetHotKey(), "Modify", &types.ModifyConfig{Key: pty.HistoryKeyPrefix + "count-token-blacklist", Op: "add", Value: "1"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//修改记录和历史高度的配置
+	msg, err := mocker.GetAPI().Query("manage", pty.FuncNameGetHistory, &pty.ReqConfigHistory{Key: "token-blacklist", Count: 2})
+	assert.Nil(t, err)
+	reply := msg.(*pty.ReplyConfigHistory)
+	assert.Equal(t, 2, len(reply.Records))
+	assert.Equal(t, int64(3), reply.Records[0].Seq)
+	assert.Equal(t, "C", reply.Records[0].Modify.Value)
+	assert.Equal(t, heights[2], reply.Records[0].Height)
+	assert.Equal(t, []string{"A", "B"}, reply.Records[0].Prev.GetArr().Value)
+	assert.NotEqual(t, "", reply.Records[0].TxHash)
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameGetHistory, &pty.ReqConfigHistory{Key: "token-blacklist", Seq: reply.Seq})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*pty.ReplyConfigHistory).Records))
+	assert.Equal(t, "A", msg.(*pty.ReplyConfigHistory).Records[0].Modify.Value)
+	for i, expect := range []string{"[]", "[A]", "[A B]", "[A B C]"} {
+		msg, err = mocker.GetAPI().Query("manage", pty.FuncNameGetConfigAt, &pty.ReqConfigAtHeight{Key: "token-blacklist", Height: heights[0] - 1 + int64(i)})
+		assert.Nil(t, err)
+		assert.Equal(t, expect, msg.(*types.ReplyConfig).Value)
+	}
+
+	//只有超级管理员可以回滚，不能回滚到当前和以后的高度
+	ty = sendManageTx(t, mocker, priv, "Rollback", &pty.ManageRollback{Key: "token-blacklist", Height: heights[0]})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Rollback", &pty.ManageRollback{Key: "token-blacklist", Height: heights[2] + 100})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendManageTx(t, mocker, mocker.GetHotKey(), "Rollback", &pty.ManageRollback{Key: "token-blacklist", Height: heights[0]})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	msg, err = mocker.GetAPI().Query("manage", "GetConfigItem", &types.ReqString{Data: "token-blacklist"})
+	assert.Nil(t, err)
+	assert.Equal(t, "[A]", msg.(*types.ReplyConfig).Value)
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameGetHistory, &pty.ReqConfigHistory{Key: "token-blacklist", Count: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), msg.(*pty.ReplyConfigHistory).Records[0].Seq)
+	assert.Equal(t, "rollback", msg.(*pty.ReplyConfigHistory).Records[0].Modify.Op)
+	msg, err = mocker.GetAPI().Query("manage", pty.FuncNameGetConfigAt, &pty.ReqConfigAtHeight{Key: "token-blacklist", Height: heights[2]})
+	assert.Nil(t, err)
+	assert.Equal(t, "[A B C]", msg.(*types.ReplyConfig).Value)
+}
@@ -7,11 +7,38 @@ package types
 // ManageActionModifyConfig manager action
 const (
 	ManageActionModifyConfig = iota
+	ManageActionFreeze
+	ManageActionUnfreeze
+	ManageActionApprove
+	ManageActionRollback
 )
 
 // TyLogModifyConfig log
 const (
-	TyLogModifyConfig = 410
+	TyLogModifyConfig   = 410
+	TyLogManageFreeze   = 411
+	TyLogManageUnfreeze = 412
+	TyLogManageProposal = 413
+	TyLogManageSchedule = 414
+)
+
+// 配置修改提案的状态
+const (
+	ProposalPending = iota + 1
+	ProposalApplied
+)
+
+// 冻结记录查询
+const (
+	FuncNameGetFreezeStatus  = "GetFreezeStatus"
+	FuncNameListFreezeRecord = "ListFreezeRecords"
+	FuncNameGetProposal      = "GetProposal"
+	FuncNameListProposals    = "ListPendingProposals"
+	FuncNameListSchedules    = "ListScheduledConfigs"
+	FuncNameGetHistory       = "GetConfigHistory"
+	FuncNameGetConfigAt      = "GetConfigAtHeight"
+	DefaultListCount         = 20
+	MaxListCount             = 100
 )
 
 // ConfigItemArrayConfig config Item
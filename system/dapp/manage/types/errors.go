@@ -15,4 +15,18 @@ var (
 	ErrBadConfigOp = errors.New("ErrBadConfigOp")
 	// ErrBadConfigValue defines a err string errbadconfigvalue
 	ErrBadConfigValue = errors.New("ErrBadConfigValue")
+	// ErrAddrFrozen 地址已经被冻结
+	ErrAddrFrozen = errors.New("ErrAddrFrozen")
+	// ErrAddrNotFrozen 地址没有被冻结
+	ErrAddrNotFrozen = errors.New("ErrAddrNotFrozen")
+	// ErrProposalNotExist 配置修改提案不存在
+	ErrProposalNotExist = errors.New("ErrProposalNotExist")
+	// ErrProposalApplied 提案已经生效
+	ErrProposalApplied = errors.New("ErrProposalApplied")
+	// ErrProposalApproved 已经确认过这个提案
+	ErrProposalApproved = errors.New("ErrProposalApproved")
+	// ErrEffectiveHeight 配置修改的生效高度不在当前高度之后
+	ErrEffectiveHeight = errors.New("ErrEffectiveHeight")
+	// ErrHistoryNotExist 配置项的修改记录不存在
+	ErrHistoryNotExist = errors.New("ErrHistoryNotExist")
 )
@@ -0,0 +1,50 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// FreezeKeyPrefix 地址冻结状态保存在manage合约中，配置项不能使用这个前缀
+const FreezeKeyPrefix = "freeze-"
+
+// CalcFreezeKey 地址冻结状态的key
+func CalcFreezeKey(addr string) []byte {
+	return []byte(types.ManageKey(FreezeKeyPrefix + addr))
+}
+
+// IsFreezeKey 配置项的名字是否和冻结状态冲突
+func IsFreezeKey(key string) bool {
+	return strings.HasPrefix(key, FreezeKeyPrefix)
+}
+
+// GetFreezeRecord 获取地址最近一次的冻结或者解冻记录，没有记录的时候返回ErrNotFound
+func GetFreezeRecord(db dbm.KV, addr string) (*FreezeRecord, error) {
+	value, err := db.Get(CalcFreezeKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, types.ErrNotFound
+	}
+	var record FreezeRecord
+	if err := types.Decode(value, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// IsAddrFrozen 地址是否被manage合约冻结，coins等资产合约用它阻止冻结地址转出资产
+func IsAddrFrozen(db dbm.KV, addr string) bool {
+	record, err := GetFreezeRecord(db, addr)
+	if err != nil {
+		return false
+	}
+	return record.Frozen
+}
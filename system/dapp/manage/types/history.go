@@ -0,0 +1,114 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// HistoryKeyPrefix 配置项的修改记录保存在manage合约中，配置项不能使用这个前缀
+const HistoryKeyPrefix = "history-"
+
+// CalcHistoryCountKey 配置项修改记录的数量
+func CalcHistoryCountKey(key string) []byte {
+	return []byte(types.ManageKey(HistoryKeyPrefix + "count-" + key))
+}
+
+// CalcHistoryKey 配置项的第seq次修改记录
+func CalcHistoryKey(key string, seq int64) []byte {
+	return []byte(types.ManageKey(fmt.Sprintf("%srecord-%018d-%s", HistoryKeyPrefix, seq, key)))
+}
+
+// IsHistoryKey 配置项的名字是否和修改记录冲突
+func IsHistoryKey(key string) bool {
+	return strings.HasPrefix(key, HistoryKeyPrefix)
+}
+
+// GetHistoryCount 配置项修改记录的数量
+func GetHistoryCount(db dbm.KV, key string) (int64, error) {
+	value, err := db.Get(CalcHistoryCountKey(key))
+	if err != nil || len(value) == 0 {
+		return 0, nil
+	}
+	var count types.Int64
+	if err := types.Decode(value, &count); err != nil {
+		return 0, err
+	}
+	return count.Data, nil
+}
+
+// GetHistory 获取配置项的第seq次修改记录
+func GetHistory(db dbm.KV, key string, seq int64) (*ConfigHistory, error) {
+	value, err := db.Get(CalcHistoryKey(key, seq))
+	if err != nil || len(value) == 0 {
+		return nil, ErrHistoryNotExist
+	}
+	var history ConfigHistory
+	if err := types.Decode(value, &history); err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// GetConfigItem 读取配置项，和manage合约一样先读新的key，不存在的时候返回nil
+func GetConfigItem(db dbm.KV, key string) (*types.ConfigItem, error) {
+	value, err := db.Get([]byte(types.ManageKey(key)))
+	if err != nil || value == nil {
+		value, err = db.Get([]byte(types.ConfigKey(key)))
+	}
+	if err != nil || value == nil {
+		return nil, nil
+	}
+	var item types.ConfigItem
+	if err := types.Decode(value, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// GetConfigAtHeight 配置项在height高度生效的值，没有修改记录的时候用当前的配置项计算
+func GetConfigAtHeight(db dbm.KV, key string, height int64) ([]string, error) {
+	count, err := GetHistoryCount(db, key)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		item, err := GetConfigItem(db, key)
+		if err != nil || item == nil {
+			return nil, err
+		}
+		return types.GetConfigValues(item, height), nil
+	}
+	//修改记录按高度排序，找到第一个在height之后的修改
+	var searchErr error
+	n := sort.Search(int(count), func(i int) bool {
+		history, err := GetHistory(db, key, int64(i)+1)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return history.Height > height
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if n == 0 {
+		first, err := GetHistory(db, key, 1)
+		if err != nil {
+			return nil, err
+		}
+		return types.GetConfigValues(first.Prev, height), nil
+	}
+	history, err := GetHistory(db, key, int64(n))
+	if err != nil {
+		return nil, err
+	}
+	return types.GetConfigValues(history.Current, height), nil
+}
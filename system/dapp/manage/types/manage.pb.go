@@ -25,6 +25,10 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type ManageAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*ManageAction_Modify
+	//	*ManageAction_Freeze
+	//	*ManageAction_Unfreeze
+	//	*ManageAction_Approve
+	//	*ManageAction_Rollback
 	Value                isManageAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                `protobuf:"varint,2,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -65,8 +69,32 @@ type ManageAction_Modify struct {
 	Modify *types.ModifyConfig `protobuf:"bytes,1,opt,name=modify,proto3,oneof"`
 }
 
+type ManageAction_Freeze struct {
+	Freeze *ManageFreeze `protobuf:"bytes,3,opt,name=freeze,proto3,oneof"`
+}
+
+type ManageAction_Unfreeze struct {
+	Unfreeze *ManageFreeze `protobuf:"bytes,4,opt,name=unfreeze,proto3,oneof"`
+}
+
+type ManageAction_Approve struct {
+	Approve *ManageApprove `protobuf:"bytes,5,opt,name=approve,proto3,oneof"`
+}
+
+type ManageAction_Rollback struct {
+	Rollback *ManageRollback `protobuf:"bytes,6,opt,name=rollback,proto3,oneof"`
+}
+
 func (*ManageAction_Modify) isManageAction_Value() {}
 
+func (*ManageAction_Freeze) isManageAction_Value() {}
+
+func (*ManageAction_Unfreeze) isManageAction_Value() {}
+
+func (*ManageAction_Approve) isManageAction_Value() {}
+
+func (*ManageAction_Rollback) isManageAction_Value() {}
+
 func (m *ManageAction) GetValue() isManageAction_Value {
 	if m != nil {
 		return m.Value
@@ -81,6 +109,34 @@ func (m *ManageAction) GetModify() *types.ModifyConfig {
 	return nil
 }
 
+func (m *ManageAction) GetFreeze() *ManageFreeze {
+	if x, ok := m.GetValue().(*ManageAction_Freeze); ok {
+		return x.Freeze
+	}
+	return nil
+}
+
+func (m *ManageAction) GetUnfreeze() *ManageFreeze {
+	if x, ok := m.GetValue().(*ManageAction_Unfreeze); ok {
+		return x.Unfreeze
+	}
+	return nil
+}
+
+func (m *ManageAction) GetApprove() *ManageApprove {
+	if x, ok := m.GetValue().(*ManageAction_Approve); ok {
+		return x.Approve
+	}
+	return nil
+}
+
+func (m *ManageAction) GetRollback() *ManageRollback {
+	if x, ok := m.GetValue().(*ManageAction_Rollback); ok {
+		return x.Rollback
+	}
+	return nil
+}
+
 func (m *ManageAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -92,6 +148,10 @@ func (m *ManageAction) GetTy() int32 {
 func (*ManageAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ManageAction_OneofMarshaler, _ManageAction_OneofUnmarshaler, _ManageAction_OneofSizer, []interface{}{
 		(*ManageAction_Modify)(nil),
+		(*ManageAction_Freeze)(nil),
+		(*ManageAction_Unfreeze)(nil),
+		(*ManageAction_Approve)(nil),
+		(*ManageAction_Rollback)(nil),
 	}
 }
 
@@ -104,6 +164,26 @@ func _ManageAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Modify); err != nil {
 			return err
 		}
+	case *ManageAction_Freeze:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Freeze); err != nil {
+			return err
+		}
+	case *ManageAction_Unfreeze:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Unfreeze); err != nil {
+			return err
+		}
+	case *ManageAction_Approve:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Approve); err != nil {
+			return err
+		}
+	case *ManageAction_Rollback:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Rollback); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ManageAction.Value has unexpected type %T", x)
@@ -122,6 +202,38 @@ func _ManageAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.Value = &ManageAction_Modify{msg}
 		return true, err
+	case 3: // value.freeze
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ManageFreeze)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_Freeze{msg}
+		return true, err
+	case 4: // value.unfreeze
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ManageFreeze)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_Unfreeze{msg}
+		return true, err
+	case 5: // value.approve
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ManageApprove)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_Approve{msg}
+		return true, err
+	case 6: // value.rollback
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ManageRollback)
+		err := b.DecodeMessage(msg)
+		m.Value = &ManageAction_Rollback{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -136,6 +248,26 @@ func _ManageAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ManageAction_Freeze:
+		s := proto.Size(x.Freeze)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_Unfreeze:
+		s := proto.Size(x.Unfreeze)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_Approve:
+		s := proto.Size(x.Approve)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ManageAction_Rollback:
+		s := proto.Size(x.Rollback)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -143,21 +275,1104 @@ func _ManageAction_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+//冻结或者解冻地址，冻结的地址不能转出资产
+type ManageFreeze struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManageFreeze) Reset()         { *m = ManageFreeze{} }
+func (m *ManageFreeze) String() string { return proto.CompactTextString(m) }
+func (*ManageFreeze) ProtoMessage()    {}
+func (*ManageFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{1}
+}
+
+func (m *ManageFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManageFreeze.Unmarshal(m, b)
+}
+func (m *ManageFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManageFreeze.Marshal(b, m, deterministic)
+}
+func (m *ManageFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManageFreeze.Merge(m, src)
+}
+func (m *ManageFreeze) XXX_Size() int {
+	return xxx_messageInfo_ManageFreeze.Size(m)
+}
+func (m *ManageFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManageFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManageFreeze proto.InternalMessageInfo
+
+func (m *ManageFreeze) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ManageFreeze) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+//地址的冻结状态，同时也是冻结和解冻的操作记录
+type FreezeRecord struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Frozen               bool     `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator             string   `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	TxHash               string   `protobuf:"bytes,7,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FreezeRecord) Reset()         { *m = FreezeRecord{} }
+func (m *FreezeRecord) String() string { return proto.CompactTextString(m) }
+func (*FreezeRecord) ProtoMessage()    {}
+func (*FreezeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{2}
+}
+
+func (m *FreezeRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeRecord.Unmarshal(m, b)
+}
+func (m *FreezeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeRecord.Marshal(b, m, deterministic)
+}
+func (m *FreezeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeRecord.Merge(m, src)
+}
+func (m *FreezeRecord) XXX_Size() int {
+	return xxx_messageInfo_FreezeRecord.Size(m)
+}
+func (m *FreezeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeRecord proto.InternalMessageInfo
+
+func (m *FreezeRecord) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *FreezeRecord) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *FreezeRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FreezeRecord) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *FreezeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FreezeRecord) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *FreezeRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type ReceiptFreeze struct {
+	Prev                 *FreezeRecord `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *FreezeRecord `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReceiptFreeze) Reset()         { *m = ReceiptFreeze{} }
+func (m *ReceiptFreeze) String() string { return proto.CompactTextString(m) }
+func (*ReceiptFreeze) ProtoMessage()    {}
+func (*ReceiptFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{3}
+}
+
+func (m *ReceiptFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptFreeze.Unmarshal(m, b)
+}
+func (m *ReceiptFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptFreeze.Marshal(b, m, deterministic)
+}
+func (m *ReceiptFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptFreeze.Merge(m, src)
+}
+func (m *ReceiptFreeze) XXX_Size() int {
+	return xxx_messageInfo_ReceiptFreeze.Size(m)
+}
+func (m *ReceiptFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptFreeze proto.InternalMessageInfo
+
+func (m *ReceiptFreeze) GetPrev() *FreezeRecord {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptFreeze) GetCurrent() *FreezeRecord {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+//addr 为空的时候查询所有地址的冻结和解冻记录，direction 0:降序 1:升序
+type ReqFreezeRecords struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqFreezeRecords) Reset()         { *m = ReqFreezeRecords{} }
+func (m *ReqFreezeRecords) String() string { return proto.CompactTextString(m) }
+func (*ReqFreezeRecords) ProtoMessage()    {}
+func (*ReqFreezeRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{4}
+}
+
+func (m *ReqFreezeRecords) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqFreezeRecords.Unmarshal(m, b)
+}
+func (m *ReqFreezeRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqFreezeRecords.Marshal(b, m, deterministic)
+}
+func (m *ReqFreezeRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqFreezeRecords.Merge(m, src)
+}
+func (m *ReqFreezeRecords) XXX_Size() int {
+	return xxx_messageInfo_ReqFreezeRecords.Size(m)
+}
+func (m *ReqFreezeRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqFreezeRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqFreezeRecords proto.InternalMessageInfo
+
+func (m *ReqFreezeRecords) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqFreezeRecords) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqFreezeRecords) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqFreezeRecords) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyFreezeRecords struct {
+	Records              []*FreezeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	PrimaryKey           string          `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyFreezeRecords) Reset()         { *m = ReplyFreezeRecords{} }
+func (m *ReplyFreezeRecords) String() string { return proto.CompactTextString(m) }
+func (*ReplyFreezeRecords) ProtoMessage()    {}
+func (*ReplyFreezeRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{5}
+}
+
+func (m *ReplyFreezeRecords) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyFreezeRecords.Unmarshal(m, b)
+}
+func (m *ReplyFreezeRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyFreezeRecords.Marshal(b, m, deterministic)
+}
+func (m *ReplyFreezeRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyFreezeRecords.Merge(m, src)
+}
+func (m *ReplyFreezeRecords) XXX_Size() int {
+	return xxx_messageInfo_ReplyFreezeRecords.Size(m)
+}
+func (m *ReplyFreezeRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyFreezeRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyFreezeRecords proto.InternalMessageInfo
+
+func (m *ReplyFreezeRecords) GetRecords() []*FreezeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ReplyFreezeRecords) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+//approveThreshold 大于1的时候，超级管理员的配置修改先成为等待确认的提案
+type ManageApprove struct {
+	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManageApprove) Reset()         { *m = ManageApprove{} }
+func (m *ManageApprove) String() string { return proto.CompactTextString(m) }
+func (*ManageApprove) ProtoMessage()    {}
+func (*ManageApprove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{6}
+}
+
+func (m *ManageApprove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManageApprove.Unmarshal(m, b)
+}
+func (m *ManageApprove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManageApprove.Marshal(b, m, deterministic)
+}
+func (m *ManageApprove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManageApprove.Merge(m, src)
+}
+func (m *ManageApprove) XXX_Size() int {
+	return xxx_messageInfo_ManageApprove.Size(m)
+}
+func (m *ManageApprove) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManageApprove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManageApprove proto.InternalMessageInfo
+
+func (m *ManageApprove) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+//approvals 是已经确认的超级管理员，提出者自动确认
+type ManageProposal struct {
+	ProposalID           string              `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Modify               *types.ModifyConfig `protobuf:"bytes,2,opt,name=modify,proto3" json:"modify,omitempty"`
+	Proposer             string              `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Approvals            []string            `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Status               int32               `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Height               int64               `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	ApplyHeight          int64               `protobuf:"varint,7,opt,name=applyHeight,proto3" json:"applyHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ManageProposal) Reset()         { *m = ManageProposal{} }
+func (m *ManageProposal) String() string { return proto.CompactTextString(m) }
+func (*ManageProposal) ProtoMessage()    {}
+func (*ManageProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{7}
+}
+
+func (m *ManageProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManageProposal.Unmarshal(m, b)
+}
+func (m *ManageProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManageProposal.Marshal(b, m, deterministic)
+}
+func (m *ManageProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManageProposal.Merge(m, src)
+}
+func (m *ManageProposal) XXX_Size() int {
+	return xxx_messageInfo_ManageProposal.Size(m)
+}
+func (m *ManageProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManageProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManageProposal proto.InternalMessageInfo
+
+func (m *ManageProposal) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *ManageProposal) GetModify() *types.ModifyConfig {
+	if m != nil {
+		return m.Modify
+	}
+	return nil
+}
+
+func (m *ManageProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *ManageProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *ManageProposal) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ManageProposal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ManageProposal) GetApplyHeight() int64 {
+	if m != nil {
+		return m.ApplyHeight
+	}
+	return 0
+}
+
+type ReceiptManageProposal struct {
+	Prev                 *ManageProposal `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ManageProposal `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReceiptManageProposal) Reset()         { *m = ReceiptManageProposal{} }
+func (m *ReceiptManageProposal) String() string { return proto.CompactTextString(m) }
+func (*ReceiptManageProposal) ProtoMessage()    {}
+func (*ReceiptManageProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{8}
+}
+
+func (m *ReceiptManageProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptManageProposal.Unmarshal(m, b)
+}
+func (m *ReceiptManageProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptManageProposal.Marshal(b, m, deterministic)
+}
+func (m *ReceiptManageProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptManageProposal.Merge(m, src)
+}
+func (m *ReceiptManageProposal) XXX_Size() int {
+	return xxx_messageInfo_ReceiptManageProposal.Size(m)
+}
+func (m *ReceiptManageProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptManageProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptManageProposal proto.InternalMessageInfo
+
+func (m *ReceiptManageProposal) GetPrev() *ManageProposal {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptManageProposal) GetCurrent() *ManageProposal {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+//列出等待确认的提案，direction 0:降序 1:升序
+type ReqManageProposals struct {
+	PrimaryKey           string   `protobuf:"bytes,1,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqManageProposals) Reset()         { *m = ReqManageProposals{} }
+func (m *ReqManageProposals) String() string { return proto.CompactTextString(m) }
+func (*ReqManageProposals) ProtoMessage()    {}
+func (*ReqManageProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{9}
+}
+
+func (m *ReqManageProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqManageProposals.Unmarshal(m, b)
+}
+func (m *ReqManageProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqManageProposals.Marshal(b, m, deterministic)
+}
+func (m *ReqManageProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqManageProposals.Merge(m, src)
+}
+func (m *ReqManageProposals) XXX_Size() int {
+	return xxx_messageInfo_ReqManageProposals.Size(m)
+}
+func (m *ReqManageProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqManageProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqManageProposals proto.InternalMessageInfo
+
+func (m *ReqManageProposals) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqManageProposals) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqManageProposals) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyManageProposals struct {
+	Proposals            []*ManageProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	PrimaryKey           string            `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplyManageProposals) Reset()         { *m = ReplyManageProposals{} }
+func (m *ReplyManageProposals) String() string { return proto.CompactTextString(m) }
+func (*ReplyManageProposals) ProtoMessage()    {}
+func (*ReplyManageProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{10}
+}
+
+func (m *ReplyManageProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyManageProposals.Unmarshal(m, b)
+}
+func (m *ReplyManageProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyManageProposals.Marshal(b, m, deterministic)
+}
+func (m *ReplyManageProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyManageProposals.Merge(m, src)
+}
+func (m *ReplyManageProposals) XXX_Size() int {
+	return xxx_messageInfo_ReplyManageProposals.Size(m)
+}
+func (m *ReplyManageProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyManageProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyManageProposals proto.InternalMessageInfo
+
+func (m *ReplyManageProposals) GetProposals() []*ManageProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *ReplyManageProposals) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+//生效高度在后面的配置修改，height 和 index 是修改交易所在的位置
+type ManageSchedule struct {
+	Modify               *types.ModifyConfig `protobuf:"bytes,1,opt,name=modify,proto3" json:"modify,omitempty"`
+	Height               int64               `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64               `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ManageSchedule) Reset()         { *m = ManageSchedule{} }
+func (m *ManageSchedule) String() string { return proto.CompactTextString(m) }
+func (*ManageSchedule) ProtoMessage()    {}
+func (*ManageSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{11}
+}
+
+func (m *ManageSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManageSchedule.Unmarshal(m, b)
+}
+func (m *ManageSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManageSchedule.Marshal(b, m, deterministic)
+}
+func (m *ManageSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManageSchedule.Merge(m, src)
+}
+func (m *ManageSchedule) XXX_Size() int {
+	return xxx_messageInfo_ManageSchedule.Size(m)
+}
+func (m *ManageSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManageSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManageSchedule proto.InternalMessageInfo
+
+func (m *ManageSchedule) GetModify() *types.ModifyConfig {
+	if m != nil {
+		return m.Modify
+	}
+	return nil
+}
+
+func (m *ManageSchedule) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ManageSchedule) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+//列出还没有生效的计划修改，direction 0:降序 1:升序
+type ReqManageSchedules struct {
+	PrimaryKey           string   `protobuf:"bytes,1,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqManageSchedules) Reset()         { *m = ReqManageSchedules{} }
+func (m *ReqManageSchedules) String() string { return proto.CompactTextString(m) }
+func (*ReqManageSchedules) ProtoMessage()    {}
+func (*ReqManageSchedules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{12}
+}
+
+func (m *ReqManageSchedules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqManageSchedules.Unmarshal(m, b)
+}
+func (m *ReqManageSchedules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqManageSchedules.Marshal(b, m, deterministic)
+}
+func (m *ReqManageSchedules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqManageSchedules.Merge(m, src)
+}
+func (m *ReqManageSchedules) XXX_Size() int {
+	return xxx_messageInfo_ReqManageSchedules.Size(m)
+}
+func (m *ReqManageSchedules) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqManageSchedules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqManageSchedules proto.InternalMessageInfo
+
+func (m *ReqManageSchedules) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqManageSchedules) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqManageSchedules) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyManageSchedules struct {
+	Schedules            []*ManageSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	PrimaryKey           string            `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplyManageSchedules) Reset()         { *m = ReplyManageSchedules{} }
+func (m *ReplyManageSchedules) String() string { return proto.CompactTextString(m) }
+func (*ReplyManageSchedules) ProtoMessage()    {}
+func (*ReplyManageSchedules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{13}
+}
+
+func (m *ReplyManageSchedules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyManageSchedules.Unmarshal(m, b)
+}
+func (m *ReplyManageSchedules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyManageSchedules.Marshal(b, m, deterministic)
+}
+func (m *ReplyManageSchedules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyManageSchedules.Merge(m, src)
+}
+func (m *ReplyManageSchedules) XXX_Size() int {
+	return xxx_messageInfo_ReplyManageSchedules.Size(m)
+}
+func (m *ReplyManageSchedules) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyManageSchedules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyManageSchedules proto.InternalMessageInfo
+
+func (m *ReplyManageSchedules) GetSchedules() []*ManageSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *ReplyManageSchedules) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+//把配置项恢复到height高度生效的值，同时取消还没有生效的计划修改
+type ManageRollback struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManageRollback) Reset()         { *m = ManageRollback{} }
+func (m *ManageRollback) String() string { return proto.CompactTextString(m) }
+func (*ManageRollback) ProtoMessage()    {}
+func (*ManageRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{14}
+}
+
+func (m *ManageRollback) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManageRollback.Unmarshal(m, b)
+}
+func (m *ManageRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManageRollback.Marshal(b, m, deterministic)
+}
+func (m *ManageRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManageRollback.Merge(m, src)
+}
+func (m *ManageRollback) XXX_Size() int {
+	return xxx_messageInfo_ManageRollback.Size(m)
+}
+func (m *ManageRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManageRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManageRollback proto.InternalMessageInfo
+
+func (m *ManageRollback) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ManageRollback) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+//配置项的一次修改，seq 是这个配置项修改的序号，从1开始
+type ConfigHistory struct {
+	Key                  string              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Seq                  int64               `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Height               int64               `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash               string              `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Modify               *types.ModifyConfig `protobuf:"bytes,5,opt,name=modify,proto3" json:"modify,omitempty"`
+	Prev                 *types.ConfigItem   `protobuf:"bytes,6,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *types.ConfigItem   `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ConfigHistory) Reset()         { *m = ConfigHistory{} }
+func (m *ConfigHistory) String() string { return proto.CompactTextString(m) }
+func (*ConfigHistory) ProtoMessage()    {}
+func (*ConfigHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{15}
+}
+
+func (m *ConfigHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigHistory.Unmarshal(m, b)
+}
+func (m *ConfigHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigHistory.Marshal(b, m, deterministic)
+}
+func (m *ConfigHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigHistory.Merge(m, src)
+}
+func (m *ConfigHistory) XXX_Size() int {
+	return xxx_messageInfo_ConfigHistory.Size(m)
+}
+func (m *ConfigHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigHistory proto.InternalMessageInfo
+
+func (m *ConfigHistory) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ConfigHistory) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *ConfigHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConfigHistory) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ConfigHistory) GetModify() *types.ModifyConfig {
+	if m != nil {
+		return m.Modify
+	}
+	return nil
+}
+
+func (m *ConfigHistory) GetPrev() *types.ConfigItem {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ConfigHistory) GetCurrent() *types.ConfigItem {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+//从seq之后开始列出修改记录，seq为0的时候从最新或者最早的记录开始，direction 0:降序 1:升序
+type ReqConfigHistory struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Seq                  int64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqConfigHistory) Reset()         { *m = ReqConfigHistory{} }
+func (m *ReqConfigHistory) String() string { return proto.CompactTextString(m) }
+func (*ReqConfigHistory) ProtoMessage()    {}
+func (*ReqConfigHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{16}
+}
+
+func (m *ReqConfigHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqConfigHistory.Unmarshal(m, b)
+}
+func (m *ReqConfigHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqConfigHistory.Marshal(b, m, deterministic)
+}
+func (m *ReqConfigHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqConfigHistory.Merge(m, src)
+}
+func (m *ReqConfigHistory) XXX_Size() int {
+	return xxx_messageInfo_ReqConfigHistory.Size(m)
+}
+func (m *ReqConfigHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqConfigHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqConfigHistory proto.InternalMessageInfo
+
+func (m *ReqConfigHistory) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReqConfigHistory) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *ReqConfigHistory) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqConfigHistory) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyConfigHistory struct {
+	Records              []*ConfigHistory `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Seq                  int64            `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplyConfigHistory) Reset()         { *m = ReplyConfigHistory{} }
+func (m *ReplyConfigHistory) String() string { return proto.CompactTextString(m) }
+func (*ReplyConfigHistory) ProtoMessage()    {}
+func (*ReplyConfigHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{17}
+}
+
+func (m *ReplyConfigHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyConfigHistory.Unmarshal(m, b)
+}
+func (m *ReplyConfigHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyConfigHistory.Marshal(b, m, deterministic)
+}
+func (m *ReplyConfigHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyConfigHistory.Merge(m, src)
+}
+func (m *ReplyConfigHistory) XXX_Size() int {
+	return xxx_messageInfo_ReplyConfigHistory.Size(m)
+}
+func (m *ReplyConfigHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyConfigHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyConfigHistory proto.InternalMessageInfo
+
+func (m *ReplyConfigHistory) GetRecords() []*ConfigHistory {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ReplyConfigHistory) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ReqConfigAtHeight struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqConfigAtHeight) Reset()         { *m = ReqConfigAtHeight{} }
+func (m *ReqConfigAtHeight) String() string { return proto.CompactTextString(m) }
+func (*ReqConfigAtHeight) ProtoMessage()    {}
+func (*ReqConfigAtHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{18}
+}
+
+func (m *ReqConfigAtHeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqConfigAtHeight.Unmarshal(m, b)
+}
+func (m *ReqConfigAtHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqConfigAtHeight.Marshal(b, m, deterministic)
+}
+func (m *ReqConfigAtHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqConfigAtHeight.Merge(m, src)
+}
+func (m *ReqConfigAtHeight) XXX_Size() int {
+	return xxx_messageInfo_ReqConfigAtHeight.Size(m)
+}
+func (m *ReqConfigAtHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqConfigAtHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqConfigAtHeight proto.InternalMessageInfo
+
+func (m *ReqConfigAtHeight) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReqConfigAtHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ManageAction)(nil), "types.ManageAction")
+	proto.RegisterType((*ManageFreeze)(nil), "types.ManageFreeze")
+	proto.RegisterType((*FreezeRecord)(nil), "types.FreezeRecord")
+	proto.RegisterType((*ReceiptFreeze)(nil), "types.ReceiptFreeze")
+	proto.RegisterType((*ReqFreezeRecords)(nil), "types.ReqFreezeRecords")
+	proto.RegisterType((*ReplyFreezeRecords)(nil), "types.ReplyFreezeRecords")
+	proto.RegisterType((*ManageApprove)(nil), "types.ManageApprove")
+	proto.RegisterType((*ManageProposal)(nil), "types.ManageProposal")
+	proto.RegisterType((*ReceiptManageProposal)(nil), "types.ReceiptManageProposal")
+	proto.RegisterType((*ReqManageProposals)(nil), "types.ReqManageProposals")
+	proto.RegisterType((*ReplyManageProposals)(nil), "types.ReplyManageProposals")
+	proto.RegisterType((*ManageSchedule)(nil), "types.ManageSchedule")
+	proto.RegisterType((*ReqManageSchedules)(nil), "types.ReqManageSchedules")
+	proto.RegisterType((*ReplyManageSchedules)(nil), "types.ReplyManageSchedules")
+	proto.RegisterType((*ManageRollback)(nil), "types.ManageRollback")
+	proto.RegisterType((*ConfigHistory)(nil), "types.ConfigHistory")
+	proto.RegisterType((*ReqConfigHistory)(nil), "types.ReqConfigHistory")
+	proto.RegisterType((*ReplyConfigHistory)(nil), "types.ReplyConfigHistory")
+	proto.RegisterType((*ReqConfigAtHeight)(nil), "types.ReqConfigAtHeight")
 }
 
 func init() { proto.RegisterFile("manage.proto", fileDescriptor_519fa8ed5ffbbc8f) }
 
 var fileDescriptor_519fa8ed5ffbbc8f = []byte{
-	// 136 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xc9, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x96,
-	0xe2, 0x4b, 0xad, 0x48, 0x4d, 0x2e, 0x2d, 0xc9, 0x2f, 0x82, 0x08, 0x2b, 0x85, 0x71, 0xf1, 0xf8,
-	0x82, 0x95, 0x39, 0x26, 0x97, 0x64, 0xe6, 0xe7, 0x09, 0xe9, 0x72, 0xb1, 0xe5, 0xe6, 0xa7, 0x64,
-	0xa6, 0x55, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x09, 0xeb, 0x81, 0xf5, 0xe9, 0xf9, 0x82,
-	0x05, 0x9d, 0xf3, 0xf3, 0xd2, 0x32, 0xd3, 0x3d, 0x18, 0x82, 0xa0, 0x8a, 0x84, 0xf8, 0xb8, 0x98,
-	0x42, 0x2a, 0x25, 0x98, 0x14, 0x18, 0x35, 0x58, 0x83, 0x98, 0x42, 0x2a, 0x9d, 0xd8, 0xb9, 0x58,
-	0xcb, 0x12, 0x73, 0x4a, 0x53, 0x93, 0xd8, 0xc0, 0xc6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xf1, 0x53, 0xa6, 0x25, 0x85, 0x00, 0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xad, 0xe3, 0x38, 0x3f, 0xd3, 0x1f, 0xb5, 0xfb, 0xa5, 0x9f, 0xac, 0x0a, 0xa1, 0xc8, 0x12,
+	0xa2, 0xa8, 0x6a, 0x0a, 0xe4, 0xae, 0x12, 0x17, 0x05, 0x84, 0x52, 0xa1, 0x4a, 0x68, 0xa9, 0xb8,
+	0x77, 0x9d, 0x49, 0x62, 0xe2, 0x78, 0xdd, 0xf5, 0xa6, 0xaa, 0xfb, 0x32, 0xbc, 0x04, 0x8f, 0xc4,
+	0x0d, 0x6f, 0x81, 0xbc, 0xbb, 0xfe, 0x0b, 0x31, 0x01, 0x2e, 0xb8, 0xf3, 0xcc, 0x9e, 0xb3, 0xbb,
+	0x73, 0xf6, 0xcc, 0x24, 0xb0, 0xb3, 0x70, 0x43, 0x77, 0x8a, 0x83, 0x88, 0x33, 0xc1, 0x88, 0x25,
+	0x92, 0x08, 0xe3, 0xa3, 0x3d, 0xbc, 0x47, 0x6f, 0x29, 0x18, 0x57, 0x69, 0xe7, 0x4b, 0x03, 0x76,
+	0xae, 0x24, 0xee, 0xc2, 0x13, 0x3e, 0x0b, 0xc9, 0x29, 0xb4, 0x16, 0x6c, 0xec, 0x4f, 0x12, 0xdb,
+	0xe8, 0x1b, 0xc7, 0xdb, 0x2f, 0xff, 0x1b, 0x48, 0xe2, 0xe0, 0x4a, 0x26, 0xdf, 0xb0, 0x70, 0xe2,
+	0x4f, 0x47, 0x5b, 0x54, 0x83, 0x52, 0xf8, 0x84, 0x23, 0x3e, 0xa0, 0x6d, 0x56, 0xe1, 0x72, 0xcf,
+	0x77, 0x72, 0x29, 0x85, 0x2b, 0x10, 0x79, 0x01, 0x9d, 0x65, 0xa8, 0x09, 0xcd, 0x5f, 0x11, 0x72,
+	0x18, 0x79, 0x0e, 0x6d, 0x37, 0x8a, 0x38, 0xbb, 0x43, 0xdb, 0x92, 0x8c, 0x5e, 0x85, 0x71, 0xa1,
+	0xd6, 0x46, 0x5b, 0x34, 0x83, 0x91, 0x21, 0x74, 0x38, 0x0b, 0x82, 0x1b, 0xd7, 0x9b, 0xdb, 0x2d,
+	0x49, 0x39, 0xac, 0x50, 0xa8, 0x5e, 0x4c, 0x8f, 0xc9, 0x80, 0x64, 0x0f, 0x1a, 0xd7, 0x89, 0xdd,
+	0xe8, 0x1b, 0xc7, 0x16, 0x6d, 0x5c, 0x27, 0xaf, 0xdb, 0x60, 0xdd, 0xb9, 0xc1, 0x12, 0x9d, 0xf3,
+	0x4c, 0x20, 0x75, 0x37, 0x42, 0xa0, 0xe9, 0x8e, 0xc7, 0x5c, 0xca, 0xd3, 0xa5, 0xf2, 0x9b, 0xfc,
+	0x0f, 0x2d, 0x8e, 0x6e, 0xcc, 0x42, 0xb9, 0x41, 0x97, 0xea, 0xc8, 0xf9, 0x6a, 0xc0, 0x8e, 0xa2,
+	0x51, 0xf4, 0x18, 0x1f, 0xd7, 0x91, 0x27, 0x9c, 0x3d, 0xa0, 0x22, 0x77, 0xa8, 0x8e, 0x4a, 0x9b,
+	0x9a, 0xe5, 0x4d, 0xc9, 0x11, 0x74, 0x58, 0x84, 0xdc, 0x15, 0x8c, 0x4b, 0x0d, 0xbb, 0x34, 0x8f,
+	0x53, 0xce, 0x0c, 0xfd, 0xe9, 0x4c, 0x48, 0xad, 0x4c, 0xaa, 0x23, 0xd2, 0x03, 0xcb, 0x0f, 0xc7,
+	0x78, 0x2f, 0xf5, 0x30, 0xa9, 0x0a, 0x52, 0xb4, 0xb8, 0x1f, 0xb9, 0xf1, 0xcc, 0x6e, 0xab, 0x13,
+	0x54, 0xe4, 0x4c, 0x61, 0x97, 0xa2, 0x87, 0x7e, 0x24, 0x74, 0xcd, 0x4f, 0xa1, 0x19, 0x71, 0xbc,
+	0x5b, 0xb1, 0x44, 0xb9, 0x32, 0x2a, 0x01, 0xe4, 0x14, 0xda, 0xde, 0x92, 0x73, 0x0c, 0x85, 0x2c,
+	0xa6, 0x06, 0x9b, 0x61, 0x9c, 0x07, 0xd8, 0xa7, 0x78, 0x5b, 0x5e, 0x8b, 0xd7, 0x4a, 0xf4, 0x18,
+	0x20, 0xe2, 0xfe, 0xc2, 0xe5, 0xc9, 0x7b, 0x4c, 0xb4, 0xc6, 0xa5, 0x4c, 0x5a, 0x9e, 0xc7, 0x96,
+	0xa1, 0x90, 0x4a, 0x59, 0x54, 0x05, 0xe4, 0x11, 0x74, 0xc7, 0x3e, 0x47, 0xe9, 0x6b, 0xa9, 0x94,
+	0x45, 0x8b, 0x84, 0xe3, 0x01, 0xa1, 0x18, 0x05, 0x49, 0xf5, 0xf4, 0x53, 0x68, 0x73, 0xf5, 0x69,
+	0x1b, 0x7d, 0xb3, 0xb6, 0x00, 0x8d, 0xd9, 0x74, 0x31, 0xe7, 0x0c, 0x76, 0x2b, 0x36, 0x55, 0x04,
+	0x16, 0xb1, 0xd8, 0x0d, 0x2e, 0xdf, 0xea, 0x1a, 0x4b, 0x19, 0xe7, 0xbb, 0x01, 0x7b, 0x8a, 0xf1,
+	0x41, 0x27, 0x37, 0x51, 0xc8, 0x49, 0xde, 0xb1, 0x8d, 0xda, 0x8e, 0xcd, 0xfb, 0xf5, 0x08, 0x3a,
+	0x8a, 0x8a, 0x5c, 0xdb, 0x2a, 0x8f, 0x53, 0xbd, 0x54, 0x0b, 0xb9, 0x41, 0x6c, 0x37, 0xfb, 0xe6,
+	0x71, 0x97, 0x16, 0x89, 0xd4, 0x2c, 0xb1, 0x70, 0xc5, 0x32, 0x96, 0xd6, 0xb2, 0xa8, 0x8e, 0x4a,
+	0x96, 0x6b, 0x55, 0x2c, 0xd7, 0x87, 0x6d, 0x37, 0x8a, 0x82, 0x64, 0xa4, 0x16, 0xdb, 0x72, 0xb1,
+	0x9c, 0x72, 0x62, 0x38, 0xd4, 0x36, 0x5b, 0xa9, 0xf8, 0x59, 0xc5, 0x6e, 0xd5, 0xe6, 0xcd, 0x40,
+	0xda, 0x70, 0x67, 0xab, 0x86, 0xab, 0x41, 0xe7, 0x96, 0x9b, 0xa5, 0xcf, 0x7e, 0x5b, 0x5d, 0x5d,
+	0x7d, 0x47, 0xa3, 0xde, 0x60, 0x8d, 0x5a, 0x83, 0x99, 0xab, 0x06, 0x9b, 0x43, 0x4f, 0x1a, 0x6c,
+	0xf5, 0xac, 0x21, 0x74, 0xb3, 0xd7, 0xcb, 0x4c, 0x56, 0x73, 0xe9, 0x02, 0xb7, 0xd1, 0x68, 0xf3,
+	0xcc, 0x36, 0x1f, 0xbd, 0x19, 0x8e, 0x97, 0x01, 0x96, 0x6c, 0x61, 0x6c, 0xb6, 0x45, 0xf1, 0x88,
+	0x8d, 0xf5, 0x73, 0xc3, 0x2c, 0xcd, 0x8d, 0x8a, 0x86, 0xd9, 0x79, 0xff, 0x42, 0xc3, 0xe2, 0xac,
+	0x21, 0x74, 0xe3, 0x2c, 0x58, 0xab, 0x61, 0x06, 0xa5, 0x05, 0x6e, 0xa3, 0x86, 0xe7, 0x99, 0x86,
+	0xd9, 0x0f, 0x04, 0xd9, 0x07, 0x73, 0x9e, 0xd7, 0x92, 0x7e, 0xd6, 0x09, 0xe5, 0x7c, 0x33, 0x60,
+	0x57, 0xff, 0x38, 0xfa, 0xb1, 0x60, 0x3c, 0x59, 0xc3, 0xdd, 0x07, 0x33, 0xc6, 0x5b, 0x4d, 0x4c,
+	0x3f, 0x4b, 0xbb, 0x99, 0x15, 0xd9, 0x8b, 0xc1, 0xdc, 0x2c, 0x0f, 0xe6, 0xd2, 0x9b, 0x5a, 0x9b,
+	0xdf, 0xf4, 0x89, 0xee, 0x22, 0xf5, 0x13, 0x78, 0xa0, 0xa1, 0x0a, 0x74, 0x29, 0x70, 0xa1, 0x3b,
+	0xe8, 0xa4, 0xe8, 0xa0, 0x76, 0x1d, 0x32, 0xef, 0x9e, 0xcf, 0x72, 0x60, 0xff, 0x79, 0xa1, 0x7f,
+	0x33, 0xa0, 0x3f, 0xe9, 0x01, 0x5d, 0x3d, 0x6d, 0xb0, 0x3a, 0xa0, 0x7b, 0x95, 0xeb, 0x6a, 0x58,
+	0x31, 0xa1, 0x7f, 0xba, 0x8b, 0xf3, 0x0a, 0x0e, 0xf2, 0x1a, 0x2e, 0x84, 0x9a, 0x45, 0xbf, 0xff,
+	0xd2, 0x37, 0x2d, 0xf9, 0xc7, 0x69, 0xf8, 0x23, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x9e, 0x5c, 0x89,
+	0x5f, 0x09, 0x00, 0x00,
 }
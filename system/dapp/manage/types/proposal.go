@@ -0,0 +1,38 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// ProposalKeyPrefix 配置修改提案保存在manage合约中，配置项不能使用这个前缀
+const ProposalKeyPrefix = "proposal-"
+
+// CalcProposalKey 配置修改提案的key
+func CalcProposalKey(id string) []byte {
+	return []byte(types.ManageKey(ProposalKeyPrefix + id))
+}
+
+// IsProposalKey 配置项的名字是否和提案冲突
+func IsProposalKey(key string) bool {
+	return strings.HasPrefix(key, ProposalKeyPrefix)
+}
+
+// GetProposal 获取配置修改提案
+func GetProposal(db dbm.KV, id string) (*ManageProposal, error) {
+	value, err := db.Get(CalcProposalKey(id))
+	if err != nil || len(value) == 0 {
+		return nil, ErrProposalNotExist
+	}
+	var proposal ManageProposal
+	if err := types.Decode(value, &proposal); err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
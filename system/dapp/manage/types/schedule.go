@@ -0,0 +1,79 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ConsensusScheduleKey manage合约中共识切换计划的配置项
+// 每一项的格式为 "高度:共识名" 或者 "高度:参数=值"，例如 "10000:pbft", "10000:maxTxNumber=5000"
+const ConsensusScheduleKey = "consensus-schedule"
+
+// ConsensusSchedule 某个高度生效的共识和共识参数
+type ConsensusSchedule struct {
+	Height      int64
+	Consensus   string
+	MaxTxNumber int64
+}
+
+// ParseConsensusSchedule 解析一项共识切换计划
+func ParseConsensusSchedule(value string) (*ConsensusSchedule, error) {
+	items := strings.SplitN(value, ":", 2)
+	if len(items) != 2 {
+		return nil, ErrBadConfigValue
+	}
+	height, err := strconv.ParseInt(items[0], 10, 64)
+	if err != nil || height <= 0 {
+		return nil, ErrBadConfigValue
+	}
+	schedule := &ConsensusSchedule{Height: height}
+	kv := strings.SplitN(items[1], "=", 2)
+	if len(kv) == 1 {
+		if kv[0] == "" {
+			return nil, ErrBadConfigValue
+		}
+		schedule.Consensus = kv[0]
+		return schedule, nil
+	}
+	switch kv[0] {
+	case "maxTxNumber":
+		schedule.MaxTxNumber, err = strconv.ParseInt(kv[1], 10, 64)
+		if err != nil || schedule.MaxTxNumber <= 0 {
+			return nil, ErrBadConfigValue
+		}
+	default:
+		return nil, ErrBadConfigValue
+	}
+	return schedule, nil
+}
+
+// GetConsensusSchedule 按高度顺序合并不超过height的切换计划，不合法的计划忽略
+func GetConsensusSchedule(values []string, height int64) *ConsensusSchedule {
+	var schedules []*ConsensusSchedule
+	for _, value := range values {
+		schedule, err := ParseConsensusSchedule(value)
+		if err != nil || schedule.Height > height {
+			continue
+		}
+		schedules = append(schedules, schedule)
+	}
+	sort.SliceStable(schedules, func(i, j int) bool {
+		return schedules[i].Height < schedules[j].Height
+	})
+	current := &ConsensusSchedule{}
+	for _, schedule := range schedules {
+		current.Height = schedule.Height
+		if schedule.Consensus != "" {
+			current.Consensus = schedule.Consensus
+		}
+		if schedule.MaxTxNumber > 0 {
+			current.MaxTxNumber = schedule.MaxTxNumber
+		}
+	}
+	return current
+}
@@ -16,11 +16,19 @@ var (
 	// ManageX defines a global string
 	ManageX    = "manage"
 	actionName = map[string]int32{
-		"Modify": ManageActionModifyConfig,
+		"Modify":   ManageActionModifyConfig,
+		"Freeze":   ManageActionFreeze,
+		"Unfreeze": ManageActionUnfreeze,
+		"Approve":  ManageActionApprove,
+		"Rollback": ManageActionRollback,
 	}
 	logmap = map[int64]*types.LogInfo{
 		// 这里reflect.TypeOf类型必须是proto.Message类型，且是交易的回持结构
-		TyLogModifyConfig: {Ty: reflect.TypeOf(types.ReceiptConfig{}), Name: "LogModifyConfig"},
+		TyLogModifyConfig:   {Ty: reflect.TypeOf(types.ReceiptConfig{}), Name: "LogModifyConfig"},
+		TyLogManageFreeze:   {Ty: reflect.TypeOf(ReceiptFreeze{}), Name: "LogManageFreeze"},
+		TyLogManageUnfreeze: {Ty: reflect.TypeOf(ReceiptFreeze{}), Name: "LogManageUnfreeze"},
+		TyLogManageProposal: {Ty: reflect.TypeOf(ReceiptManageProposal{}), Name: "LogManageProposal"},
+		TyLogManageSchedule: {Ty: reflect.TypeOf(ManageSchedule{}), Name: "LogManageSchedule"},
 	}
 )
 
@@ -30,6 +38,10 @@ func init() {
 
 	types.RegisterDappFork(ManageX, "Enable", 120000)
 	types.RegisterDappFork(ManageX, "ForkManageExec", 400000)
+	types.RegisterDappFork(ManageX, "ForkManageFreeze", 0)
+	types.RegisterDappFork(ManageX, "ForkManageApprove", 0)
+	types.RegisterDappFork(ManageX, "ForkManageSchedule", 0)
+	types.RegisterDappFork(ManageX, "ForkManageHistory", 0)
 }
 
 // ManageType defines managetype
@@ -51,6 +63,19 @@ func (m *ManageType) GetPayload() types.Message {
 
 // ActionName return action a string name
 func (m ManageType) ActionName(tx *types.Transaction) string {
+	var action ManageAction
+	if err := types.Decode(tx.Payload, &action); err == nil {
+		switch action.Value.(type) {
+		case *ManageAction_Freeze:
+			return "freeze"
+		case *ManageAction_Unfreeze:
+			return "unfreeze"
+		case *ManageAction_Approve:
+			return "approve"
+		case *ManageAction_Rollback:
+			return "rollback"
+		}
+	}
 	return "config"
 }
 
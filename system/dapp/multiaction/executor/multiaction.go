@@ -0,0 +1,52 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor multiaction执行器，交易中的操作由系统拆开交给每个操作的执行器执行，这个执行器本身不执行任何操作
+package executor
+
+import (
+	drivers "github.com/33cn/chain33/system/dapp"
+	_ "github.com/33cn/chain33/system/dapp/multiaction/types" // register multiaction type
+	"github.com/33cn/chain33/types"
+)
+
+var driverName = types.MultiActionX
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newMultiAction, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return multiaction name
+func GetName() string {
+	return newMultiAction().GetName()
+}
+
+// MultiAction defines MultiAction object
+type MultiAction struct {
+	drivers.DriverBase
+}
+
+func newMultiAction() drivers.Driver {
+	m := &MultiAction{}
+	m.SetChild(m)
+	m.SetExecutorType(types.LoadExecutorType(driverName))
+	return m
+}
+
+// GetDriverName return a drivername
+func (m *MultiAction) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx 检查交易中的操作，每个操作的检查由系统交给操作的执行器
+func (m *MultiAction) CheckTx(tx *types.Transaction, index int) error {
+	_, err := tx.GetMultiActionTxs()
+	return err
+}
+
+// Exec 多操作交易由系统拆开执行，分叉之前交给这个执行器的交易都执行失败
+func (m *MultiAction) Exec(tx *types.Transaction, index int) (*types.Receipt, error) {
+	return nil, types.ErrActionNotSupport
+}
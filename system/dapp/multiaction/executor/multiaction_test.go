@@ -0,0 +1,78 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+//sendMultiAction 把几笔转账合并成一笔多操作交易发送，返回交易的收据
+func sendMultiAction(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, to []string, amount []int64) *rpctypes.ReceiptDataResult {
+	var actions []*types.Transaction
+	for i := range to {
+		actions = append(actions, util.CreateCoinsTx(priv, to[i], amount[i]))
+	}
+	tx, err := types.CreateMultiActionTx(actions, 0, 0)
+	require.Nil(t, err)
+	tx.Sign(types.SECP256K1, priv)
+	reply, err := mock33.GetAPI().SendTx(tx)
+	require.Nil(t, err)
+	detail, err := mock33.WaitTx(reply.GetMsg())
+	require.Nil(t, err)
+	return detail.Receipt
+}
+
+//countMark 收据中操作的标记个数
+func countMark(receipt *rpctypes.ReceiptDataResult) (n int) {
+	for _, l := range receipt.Logs {
+		if l.Ty == types.TyLogMultiAction {
+			n++
+		}
+	}
+	return n
+}
+
+func TestMultiAction(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	addr, priv := util.Genaddress()
+	to1, _ := util.Genaddress()
+	to2, _ := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(mock33.GetGenesisKey(), addr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	balance := func(addr string) int64 {
+		return mock33.GetAccount(mock33.GetLastBlock().StateHash, addr).Balance
+	}
+
+	//两个操作都执行成功
+	receipt := sendMultiAction(t, mock33, priv, []string{to1, to2}, []int64{types.Coin, 2 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), receipt.Ty)
+	assert.Equal(t, 2, countMark(receipt))
+	assert.Equal(t, types.Coin, balance(to1))
+	assert.Equal(t, 2*types.Coin, balance(to2))
+	left := balance(addr)
+	assert.True(t, left < 7*types.Coin)
+
+	//第二个操作失败，第一个操作也回滚，只收取手续费
+	receipt = sendMultiAction(t, mock33, priv, []string{to1, to2}, []int64{types.Coin, 100 * types.Coin})
+	assert.Equal(t, int32(types.ExecPack), receipt.Ty)
+	assert.Equal(t, 1, countMark(receipt))
+	assert.Equal(t, int32(types.TyLogErr), receipt.Logs[len(receipt.Logs)-1].Ty)
+	assert.Equal(t, types.Coin, balance(to1))
+	assert.Equal(t, 2*types.Coin, balance(to2))
+	assert.True(t, balance(addr) < left)
+	assert.True(t, balance(addr) > left-types.Coin)
+}
@@ -0,0 +1,25 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multiaction 多操作交易执行器插件
+// 1. 一笔交易中包含多个执行器的操作，只签名一次，手续费由这笔交易支付
+// 2. 执行的时候系统按顺序把每个操作交给操作的执行器执行，任何一个操作失败，所有的操作都会回滚
+// 3. 收据中每个操作的日志之前有一个标记，ExecLocal 的时候按标记把日志拆给每个操作
+package multiaction
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/multiaction/executor"
+	"github.com/33cn/chain33/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.MultiActionX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      nil,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types multiaction插件相关的定义，交易格式和日志定义在系统的types中
+package types
+
+import (
+	"github.com/33cn/chain33/types"
+)
+
+var actionName = map[string]int32{}
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(types.MultiActionX))
+	types.RegistorExecutor(types.MultiActionX, NewType())
+	types.RegisterDappFork(types.MultiActionX, "Enable", 0)
+}
+
+// MultiActionType multiaction执行器类型
+type MultiActionType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a multiaction type object
+func NewType() *MultiActionType {
+	c := &MultiActionType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return multiaction payload
+func (m *MultiActionType) GetPayload() types.Message {
+	return &types.MultiAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (m *MultiActionType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap 操作的标记日志是系统日志，每个操作的日志由操作的执行器解析
+func (m *MultiActionType) GetLogMap() map[int64]*types.LogInfo {
+	return map[int64]*types.LogInfo{}
+}
+
+// GetName reset name
+func (m *MultiActionType) GetName() string {
+	return types.MultiActionX
+}
+
+// ActionName 多操作交易只有一种action
+func (m *MultiActionType) ActionName(tx *types.Transaction) string {
+	return "multiAction"
+}
+
+// Amount 转账金额在每个操作中，多操作交易本身没有金额
+func (m *MultiActionType) Amount(tx *types.Transaction) (int64, error) {
+	return 0, nil
+}
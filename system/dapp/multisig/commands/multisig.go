@@ -0,0 +1,272 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands multisig插件命令
+package commands
+
+import (
+	"strings"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// MultiSigCmd multisig command
+func MultiSigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Multisig account management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CreateAccountCmd(),
+		DepositCmd(),
+		ProposeTransferCmd(),
+		ProposeModifyCmd(),
+		ConfirmCmd(),
+		RevokeCmd(),
+		QueryAccountCmd(),
+		QueryProposalCmd(),
+		ListProposalsCmd(),
+		PSTCmd(),
+	)
+
+	return cmd
+}
+
+func addOwnersFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("owners", "o", "", "owner addresses, separated by comma")
+	cmd.MarkFlagRequired("owners")
+	cmd.Flags().Int64P("required", "r", 0, "confirmations required to execute a proposal")
+	cmd.MarkFlagRequired("required")
+}
+
+func getOwnersFlags(cmd *cobra.Command) ([]string, int64) {
+	owners, _ := cmd.Flags().GetString("owners")
+	required, _ := cmd.Flags().GetInt64("required")
+	return strings.Split(owners, ","), required
+}
+
+// CreateAccountCmd create multisig account
+func CreateAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a transaction to create multisig account",
+		Run:   createAccount,
+	}
+	addOwnersFlags(cmd)
+	return cmd
+}
+
+func createAccount(cmd *cobra.Command, args []string) {
+	owners, required := getOwnersFlags(cmd)
+	commandtypes.CreateActionTx(cmd, mty.MultiSigX, &mty.MultiSigAction{
+		Ty:    mty.MultiSigActionAccountCreate,
+		Value: &mty.MultiSigAction_AccountCreate{AccountCreate: &mty.MultiSigAccountCreate{Owners: owners, Required: required}},
+	})
+}
+
+// DepositCmd deposit to multisig account
+func DepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Create a transaction to deposit to multisig account, coins should be transferred to multisig first",
+		Run:   deposit,
+	}
+	cmd.Flags().StringP("account", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("account")
+	cmd.Flags().Float64P("amount", "m", 0, "deposit amount")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func deposit(cmd *cobra.Command, args []string) {
+	account, _ := cmd.Flags().GetString("account")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	commandtypes.CreateActionTx(cmd, mty.MultiSigX, &mty.MultiSigAction{
+		Ty:    mty.MultiSigActionDeposit,
+		Value: &mty.MultiSigAction_Deposit{Deposit: &mty.MultiSigDeposit{Account: account, Amount: int64(amount*types.InputPrecision) * types.Multiple1E4}},
+	})
+}
+
+func addProposeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("account", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("account")
+	cmd.Flags().StringP("note", "n", "", "proposal note")
+}
+
+// ProposeTransferCmd propose transfer
+func ProposeTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose_transfer",
+		Short: "Create a transaction to propose a transfer from multisig account",
+		Run:   proposeTransfer,
+	}
+	addProposeFlags(cmd)
+	cmd.Flags().StringP("to", "t", "", "receiver address")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().Float64P("amount", "m", 0, "transfer amount")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func proposeTransfer(cmd *cobra.Command, args []string) {
+	account, _ := cmd.Flags().GetString("account")
+	note, _ := cmd.Flags().GetString("note")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	transfer := &mty.MultiSigTransfer{To: to, Amount: int64(amount*types.InputPrecision) * types.Multiple1E4}
+	commandtypes.CreateActionTx(cmd, mty.MultiSigX, &mty.MultiSigAction{
+		Ty:    mty.MultiSigActionPropose,
+		Value: &mty.MultiSigAction_Propose{Propose: &mty.MultiSigPropose{Account: account, Transfer: transfer, Note: note}},
+	})
+}
+
+// ProposeModifyCmd propose modify owners
+func ProposeModifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose_modify",
+		Short: "Create a transaction to propose new owners and required confirmations",
+		Run:   proposeModify,
+	}
+	addProposeFlags(cmd)
+	addOwnersFlags(cmd)
+	return cmd
+}
+
+func proposeModify(cmd *cobra.Command, args []string) {
+	account, _ := cmd.Flags().GetString("account")
+	note, _ := cmd.Flags().GetString("note")
+	owners, required := getOwnersFlags(cmd)
+	modify := &mty.MultiSigModify{Owners: owners, Required: required}
+	commandtypes.CreateActionTx(cmd, mty.MultiSigX, &mty.MultiSigAction{
+		Ty:    mty.MultiSigActionPropose,
+		Value: &mty.MultiSigAction_Propose{Propose: &mty.MultiSigPropose{Account: account, Modify: modify, Note: note}},
+	})
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("account", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("account")
+	cmd.Flags().Int64P("id", "i", 0, "proposal id")
+	cmd.MarkFlagRequired("id")
+}
+
+// ConfirmCmd confirm proposal
+func ConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Create a transaction to confirm a proposal",
+		Run:   confirm,
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func confirm(cmd *cobra.Command, args []string) {
+	account, _ := cmd.Flags().GetString("account")
+	id, _ := cmd.Flags().GetInt64("id")
+	commandtypes.CreateActionTx(cmd, mty.MultiSigX, &mty.MultiSigAction{
+		Ty:    mty.MultiSigActionConfirm,
+		Value: &mty.MultiSigAction_Confirm{Confirm: &mty.MultiSigConfirm{Account: account, ProposalID: id}},
+	})
+}
+
+// RevokeCmd revoke confirmation
+func RevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Create a transaction to revoke the confirmation of a proposal",
+		Run:   revoke,
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func revoke(cmd *cobra.Command, args []string) {
+	account, _ := cmd.Flags().GetString("account")
+	id, _ := cmd.Flags().GetInt64("id")
+	commandtypes.CreateActionTx(cmd, mty.MultiSigX, &mty.MultiSigAction{
+		Ty:    mty.MultiSigActionRevoke,
+		Value: &mty.MultiSigAction_Revoke{Revoke: &mty.MultiSigRevoke{Account: account, ProposalID: id}},
+	})
+}
+
+func queryMultiSig(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, mty.MultiSigX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryAccountCmd query multisig account
+func QueryAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account",
+		Short: "Query multisig account info",
+		Run:   queryAccount,
+	}
+	cmd.Flags().StringP("account", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("account")
+	return cmd
+}
+
+func queryAccount(cmd *cobra.Command, args []string) {
+	account, _ := cmd.Flags().GetString("account")
+	var res mty.MultiSigAccount
+	queryMultiSig(cmd, mty.FuncNameGetAccount, &types.ReqString{Data: account}, &res)
+}
+
+// QueryProposalCmd query proposal
+func QueryProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal",
+		Short: "Query proposal info",
+		Run:   queryProposal,
+	}
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func queryProposal(cmd *cobra.Command, args []string) {
+	account, _ := cmd.Flags().GetString("account")
+	id, _ := cmd.Flags().GetInt64("id")
+	var res mty.MultiSigProposal
+	queryMultiSig(cmd, mty.FuncNameGetProposal, &mty.ReqMultiSigProposal{Account: account, ProposalID: id}, &res)
+}
+
+// ListProposalsCmd list proposals
+func ListProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List proposals of multisig account, newest first",
+		Run:   listProposals,
+	}
+	cmd.Flags().StringP("account", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("account")
+	cmd.Flags().Int64P("start", "s", 0, "start proposal id, 0 for the newest")
+	cmd.Flags().Int32P("count", "c", mty.DefaultListProposalCount, "proposal count")
+	cmd.Flags().BoolP("pending", "p", false, "only list pending proposals")
+	return cmd
+}
+
+func listProposals(cmd *cobra.Command, args []string) {
+	account, _ := cmd.Flags().GetString("account")
+	start, _ := cmd.Flags().GetInt64("start")
+	count, _ := cmd.Flags().GetInt32("count")
+	pending, _ := cmd.Flags().GetBool("pending")
+	var res mty.ReplyMultiSigProposals
+	queryMultiSig(cmd, mty.FuncNameListProposals, &mty.ReqMultiSigProposals{Account: account, Start: start, Count: count, PendingOnly: pending}, &res)
+}
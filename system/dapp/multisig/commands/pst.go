@@ -0,0 +1,483 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/spf13/cobra"
+)
+
+//pst 部分签名的交易(partially signed transaction)文件，签名者轮流或者各自离线签名，最后合并发送
+//1. create 根据公钥和门限生成多重签名脚本，资产转到脚本的地址
+//2. propose 把脚本地址作为发送者的未签名交易写入pst文件
+//3. confirm 签名者用自己的私钥在pst文件中加上部分签名
+//4. execute 合并所有的pst文件，签名个数达到门限的时候发送交易
+
+//pstScript 多重签名脚本文件
+type pstScript struct {
+	Address   string   `json:"address"`
+	Threshold int32    `json:"threshold"`
+	SignTy    int32    `json:"signTy"`
+	PubKeys   []string `json:"pubKeys"`
+}
+
+//pstFile 只有tx是有效的数据，其他字段方便查看，读取的时候忽略
+type pstFile struct {
+	Address   string  `json:"address"`
+	Threshold int32   `json:"threshold"`
+	Signed    []int32 `json:"signed"`
+	Hash      string  `json:"hash"`
+	Tx        string  `json:"tx"`
+}
+
+// PSTCmd partially signed transaction workflow
+func PSTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pst",
+		Short: "M-of-N multisig script spend with partially signed transaction files",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		PSTCreateCmd(),
+		PSTProposeCmd(),
+		PSTConfirmCmd(),
+		PSTExecuteCmd(),
+		PSTStatusCmd(),
+	)
+	return cmd
+}
+
+// PSTCreateCmd create multisig script
+func PSTCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a M-of-N multisig script, coins sent to its address need threshold signatures to spend",
+		Run:   pstCreate,
+	}
+	cmd.Flags().StringP("pubkeys", "k", "", "owner public keys in hex, separated by comma, the order changes the address")
+	cmd.MarkFlagRequired("pubkeys")
+	cmd.Flags().Int32P("threshold", "m", 0, "signatures required to spend")
+	cmd.MarkFlagRequired("threshold")
+	cmd.Flags().StringP("sign_type", "t", "secp256k1", "sign type of the owner keys")
+	cmd.Flags().StringP("out", "o", "", "script file to write, print to stdout if not set")
+	return cmd
+}
+
+func pstCreate(cmd *cobra.Command, args []string) {
+	pubkeys, _ := cmd.Flags().GetString("pubkeys")
+	threshold, _ := cmd.Flags().GetInt32("threshold")
+	signType, _ := cmd.Flags().GetString("sign_type")
+	output, _ := cmd.Flags().GetString("out")
+	signTy := types.GetSignType("", signType)
+	if signTy == types.Invalid {
+		fmt.Fprintln(os.Stderr, "unknown sign type", signType)
+		return
+	}
+	var pubs [][]byte
+	for _, key := range strings.Split(pubkeys, ",") {
+		pub, err := common.FromHex(strings.TrimSpace(key))
+		if err != nil || len(pub) == 0 {
+			fmt.Fprintln(os.Stderr, "invalid public key", key)
+			return
+		}
+		pubs = append(pubs, pub)
+	}
+	script, err := types.NewMultiSigScript(threshold, int32(signTy), pubs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if err := writeJSON(output, toPSTScript(script)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+func toPSTScript(script *types.MultiSigScript) *pstScript {
+	s := &pstScript{Address: script.Address(), Threshold: script.Threshold, SignTy: script.SignTy}
+	for _, pub := range script.PubKeys {
+		s.PubKeys = append(s.PubKeys, common.ToHex(pub))
+	}
+	return s
+}
+
+func readPSTScript(file string) (*types.MultiSigScript, error) {
+	var s pstScript
+	if err := readJSON(file, &s); err != nil {
+		return nil, err
+	}
+	var pubs [][]byte
+	for _, key := range s.PubKeys {
+		pub, err := common.FromHex(key)
+		if err != nil {
+			return nil, err
+		}
+		pubs = append(pubs, pub)
+	}
+	return types.NewMultiSigScript(s.Threshold, s.SignTy, pubs)
+}
+
+// PSTProposeCmd propose transaction
+func PSTProposeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose",
+		Short: "Write an unsigned transaction sent from the script address into a pst file",
+		Run:   pstPropose,
+	}
+	cmd.Flags().StringP("script", "s", "", "script file created by pst create")
+	cmd.MarkFlagRequired("script")
+	cmd.Flags().StringP("data", "d", "", "unsigned raw transaction")
+	cmd.MarkFlagRequired("data")
+	cmd.Flags().StringP("expire", "e", "1h", "transaction expire time, leave enough time to collect signatures")
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee, estimated with threshold signatures if not set")
+	cmd.Flags().StringP("out", "o", "", "pst file to write")
+	cmd.MarkFlagRequired("out")
+	return cmd
+}
+
+func pstPropose(cmd *cobra.Command, args []string) {
+	scriptFile, _ := cmd.Flags().GetString("script")
+	data, _ := cmd.Flags().GetString("data")
+	expire, _ := cmd.Flags().GetString("expire")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	output, _ := cmd.Flags().GetString("out")
+	script, err := readPSTScript(scriptFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	expireTime, err := time.ParseDuration(expire)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	raw, err := common.FromHex(strings.TrimSpace(data))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var tx types.Transaction
+	if err := types.Decode(raw, &tx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if tx.GroupCount > 0 {
+		fmt.Fprintln(os.Stderr, "transaction group is not supported")
+		return
+	}
+	tx.SetExpire(expireTime)
+	if err := setPSTFee(&tx, script, int64(fee*types.InputPrecision)*types.Multiple1E4); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	tx.ResetFeePayer()
+	tx.Signature = &types.Signature{Ty: types.MultiSigSign, MultiSigScript: script}
+	if err := writePST(output, &tx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+//setPSTFee 多重签名比普通签名大，按门限个签名估算手续费，交易中原来的手续费更高的时候保留
+func setPSTFee(tx *types.Transaction, script *types.MultiSigScript, fee int64) error {
+	if fee > 0 {
+		tx.Fee = fee
+		return nil
+	}
+	estimate := *tx
+	estimate.FeePayer = nil
+	estimate.Signature = &types.Signature{Ty: types.MultiSigSign, MultiSigScript: script}
+	for i := int32(0); i < script.Threshold; i++ {
+		estimate.Signature.PartialSigs = append(estimate.Signature.PartialSigs, &types.MultiSigPartial{Index: i, Signature: make([]byte, 128)})
+	}
+	realFee, err := estimate.GetRealFee(types.GInt("MinFee"))
+	if err != nil {
+		return err
+	}
+	if realFee > tx.Fee {
+		tx.Fee = realFee
+	}
+	return nil
+}
+
+// PSTConfirmCmd sign pst file
+func PSTConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Add the partial signature of an owner to a pst file",
+		Run:   pstConfirm,
+	}
+	cmd.Flags().StringP("file", "f", "", "pst file to sign")
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().StringP("key", "k", "", "private key of the owner")
+	cmd.Flags().StringP("addr", "a", "", "owner address in the wallet, the wallet should be unlocked")
+	cmd.Flags().StringP("out", "o", "", "pst file to write, overwrite the input file if not set")
+	return cmd
+}
+
+func pstConfirm(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	file, _ := cmd.Flags().GetString("file")
+	key, _ := cmd.Flags().GetString("key")
+	addr, _ := cmd.Flags().GetString("addr")
+	output, _ := cmd.Flags().GetString("out")
+	if output == "" {
+		output = file
+	}
+	tx, err := readPST(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if key == "" {
+		if addr == "" {
+			fmt.Fprintln(os.Stderr, "key or addr is required")
+			return
+		}
+		if key, err = dumpPrivkey(rpcLaddr, addr); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+	}
+	script := tx.Signature.MultiSigScript
+	c, err := crypto.New(types.GetSignName(string(tx.Execer), int(script.SignTy)))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	keyBytes, err := common.FromHex(key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv, err := c.PrivKeyFromBytes(keyBytes)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	index := -1
+	for i, pub := range script.PubKeys {
+		if bytes.Equal(pub, priv.PubKey().Bytes()) {
+			index = i
+		}
+	}
+	if index < 0 {
+		fmt.Fprintln(os.Stderr, "the key is not an owner of", script.Address())
+		return
+	}
+	if err := tx.AddMultiSig(script, index, priv); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if err := writePST(output, tx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+func dumpPrivkey(rpcLaddr, addr string) (string, error) {
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return "", err
+	}
+	var res types.ReplyString
+	if err := rpc.Call("Chain33.DumpPrivkey", types.ReqString{Data: addr}, &res); err != nil {
+		return "", err
+	}
+	return res.Data, nil
+}
+
+// PSTExecuteCmd merge and send
+func PSTExecuteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute",
+		Short: "Merge the partial signatures of pst files and send the transaction",
+		Run:   pstExecute,
+	}
+	cmd.Flags().StringP("files", "f", "", "pst files signed by owners, separated by comma")
+	cmd.MarkFlagRequired("files")
+	cmd.Flags().StringP("out", "o", "", "write the merged pst file instead of sending")
+	return cmd
+}
+
+func pstExecute(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	files, _ := cmd.Flags().GetString("files")
+	output, _ := cmd.Flags().GetString("out")
+	tx, err := mergePST(strings.Split(files, ","))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if output != "" {
+		if err := writePST(output, tx); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		return
+	}
+	script := tx.Signature.MultiSigScript
+	if len(tx.Signature.PartialSigs) < int(script.Threshold) {
+		fmt.Fprintf(os.Stderr, "%d of %d signatures collected\n", len(tx.Signature.PartialSigs), script.Threshold)
+		return
+	}
+	params := rpctypes.RawParm{
+		Data: common.ToHex(types.Encode(tx)),
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SendTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+//pstStatus 每个公钥是否已经签名
+type pstStatus struct {
+	Address   string      `json:"address"`
+	Threshold int32       `json:"threshold"`
+	Signed    int         `json:"signed"`
+	Ready     bool        `json:"ready"`
+	Hash      string      `json:"hash"`
+	Owners    []*pstOwner `json:"owners"`
+	Height    int64       `json:"height"`
+}
+
+type pstOwner struct {
+	Index  int32  `json:"index"`
+	Pubkey string `json:"pubkey"`
+	Addr   string `json:"addr"`
+	Signed bool   `json:"signed"`
+}
+
+// PSTStatusCmd show signatures
+func PSTStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show collected signatures of pst files, and whether the transaction is packed",
+		Run:   pstStatusRun,
+	}
+	cmd.Flags().StringP("files", "f", "", "pst files, separated by comma")
+	cmd.MarkFlagRequired("files")
+	cmd.Flags().BoolP("query", "q", false, "query the transaction on chain, height is -1 if not packed")
+	return cmd
+}
+
+func pstStatusRun(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	files, _ := cmd.Flags().GetString("files")
+	query, _ := cmd.Flags().GetBool("query")
+	tx, err := mergePST(strings.Split(files, ","))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	script := tx.Signature.MultiSigScript
+	status := &pstStatus{
+		Address:   script.Address(),
+		Threshold: script.Threshold,
+		Signed:    len(tx.Signature.PartialSigs),
+		Ready:     len(tx.Signature.PartialSigs) >= int(script.Threshold),
+		Hash:      common.ToHex(tx.Hash()),
+	}
+	signed := make(map[int32]bool)
+	for _, partial := range tx.Signature.PartialSigs {
+		signed[partial.Index] = true
+	}
+	for i, pub := range script.PubKeys {
+		status.Owners = append(status.Owners, &pstOwner{Index: int32(i), Pubkey: common.ToHex(pub),
+			Addr: address.PubKeyToAddr(pub), Signed: signed[int32(i)]})
+	}
+	status.Height = -1
+	if query {
+		rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		var detail rpctypes.TransactionDetail
+		if err := rpc.Call("Chain33.QueryTransaction", rpctypes.QueryParm{Hash: status.Hash}, &detail); err == nil {
+			status.Height = detail.Height
+		}
+	}
+	jsonclient.PrintResult(status)
+}
+
+func mergePST(files []string) (*types.Transaction, error) {
+	var txs []*types.Transaction
+	for _, file := range files {
+		tx, err := readPST(strings.TrimSpace(file))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		txs = append(txs, tx)
+	}
+	return types.MergeMultiSig(txs)
+}
+
+func readPST(file string) (*types.Transaction, error) {
+	var pst pstFile
+	if err := readJSON(file, &pst); err != nil {
+		return nil, err
+	}
+	raw, err := common.FromHex(pst.Tx)
+	if err != nil {
+		return nil, err
+	}
+	var tx types.Transaction
+	if err := types.Decode(raw, &tx); err != nil {
+		return nil, err
+	}
+	if tx.GetSignature().GetTy() != types.MultiSigSign || tx.Signature.GetMultiSigScript().Check() != nil {
+		return nil, errors.New("not a pst file")
+	}
+	return &tx, nil
+}
+
+func writePST(file string, tx *types.Transaction) error {
+	script := tx.Signature.MultiSigScript
+	pst := &pstFile{
+		Address:   script.Address(),
+		Threshold: script.Threshold,
+		Signed:    []int32{},
+		Hash:      common.ToHex(tx.Hash()),
+		Tx:        common.ToHex(types.Encode(tx)),
+	}
+	for _, partial := range tx.Signature.PartialSigs {
+		pst.Signed = append(pst.Signed, partial.Index)
+	}
+	if err := writeJSON(file, pst); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%s: %d of %d signatures\n", file, len(pst.Signed), pst.Threshold)
+	return nil
+}
+
+func readJSON(file string, v interface{}) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+//writeJSON file为空的时候按设置的格式输出到标准输出
+func writeJSON(file string, v interface{}) error {
+	if file == "" {
+		jsonclient.PrintResult(v)
+		return nil
+	}
+	data, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(data, '\n'), 0600)
+}
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_AccountCreate 创建多重签名账户
+func (m *MultiSig) Exec_AccountCreate(payload *mty.MultiSigAccountCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx, index)
+	return action.accountCreate(payload)
+}
+
+// Exec_Deposit 存入多重签名账户
+func (m *MultiSig) Exec_Deposit(payload *mty.MultiSigDeposit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx, index)
+	return action.deposit(payload)
+}
+
+// Exec_Propose 提出提案
+func (m *MultiSig) Exec_Propose(payload *mty.MultiSigPropose, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx, index)
+	return action.propose(payload)
+}
+
+// Exec_Confirm 确认提案
+func (m *MultiSig) Exec_Confirm(payload *mty.MultiSigConfirm, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx, index)
+	return action.confirm(payload)
+}
+
+// Exec_Revoke 撤销对提案的确认
+func (m *MultiSig) Exec_Revoke(payload *mty.MultiSigRevoke, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx, index)
+	return action.revoke(payload)
+}
@@ -0,0 +1,60 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor multisig执行器，负责多重签名账户的创建，提案的确认和执行
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.multisig")
+	driverName = mty.MultiSigX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&MultiSig{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newMultiSig, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return multisig name
+func GetName() string {
+	return newMultiSig().GetName()
+}
+
+// MultiSig defines MultiSig object
+type MultiSig struct {
+	drivers.DriverBase
+}
+
+func newMultiSig() drivers.Driver {
+	m := &MultiSig{}
+	m.SetChild(m)
+	m.SetExecutorType(types.LoadExecutorType(driverName))
+	return m
+}
+
+// GetDriverName return a drivername
+func (m *MultiSig) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx check transaction
+func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (m *MultiSig) CheckReceiptExecOk() bool {
+	return true
+}
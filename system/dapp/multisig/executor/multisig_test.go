@@ -0,0 +1,119 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendMultiSigTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) (int32, []byte) {
+	hash, detail, err := mock33.SendCallTx(priv, mty.MultiSigX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, hash
+}
+
+func queryMultiSig(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(mty.MultiSigX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func TestMultiSig(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	priv := mock33.GetGenesisKey()
+	addr := mock33.GetGenesisAddress()
+	addr1, priv1 := util.Genaddress()
+	addr2, priv2 := util.Genaddress()
+	receiver, _ := util.Genaddress()
+	execAddr := address.ExecAddress(mty.MultiSigX)
+
+	mock33.SendTx(util.CreateCoinsTx(priv, addr1, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(priv, addr2, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(priv, execAddr, 100*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	//确认数超过owners数量
+	ty, _ := sendMultiSigTx(t, mock33, priv, "AccountCreate", &mty.MultiSigAccountCreate{Owners: []string{addr, addr1}, Required: 3})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, hash := sendMultiSigTx(t, mock33, priv, "AccountCreate", &mty.MultiSigAccountCreate{Owners: []string{addr, addr1, addr2}, Required: 2})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	account := address.ExecAddress(mty.MultiSigX + "-" + common.ToHex(hash))
+	acc := queryMultiSig(t, mock33, mty.FuncNameGetAccount, &types.ReqString{Data: account}).(*mty.MultiSigAccount)
+	assert.Equal(t, int64(2), acc.Required)
+	assert.Equal(t, addr, acc.Creator)
+
+	ty, _ = sendMultiSigTx(t, mock33, priv, "Deposit", &mty.MultiSigDeposit{Account: account, Amount: 50 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 50*types.Coin, mock33.GetExecAccount(mock33.GetLastBlock().StateHash, mty.MultiSigX, account).Balance)
+
+	//不是owner不能提案
+	transfer := &mty.MultiSigTransfer{To: receiver, Amount: 20 * types.Coin}
+	ty, _ = sendMultiSigTx(t, mock33, priv, "Propose", &mty.MultiSigPropose{Account: account, Transfer: transfer, Modify: &mty.MultiSigModify{}})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendMultiSigTx(t, mock33, priv1, "Propose", &mty.MultiSigPropose{Account: account, Transfer: transfer, Note: "pay"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	pending := queryMultiSig(t, mock33, mty.FuncNameListProposals, &mty.ReqMultiSigProposals{Account: account, PendingOnly: true}).(*mty.ReplyMultiSigProposals)
+	assert.Equal(t, 1, len(pending.Proposals))
+	assert.Equal(t, []string{addr1}, pending.Proposals[0].Confirmations)
+
+	//撤销所有确认以后提案取消
+	ty, _ = sendMultiSigTx(t, mock33, priv1, "Revoke", &mty.MultiSigRevoke{Account: account, ProposalID: 1})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	proposal := queryMultiSig(t, mock33, mty.FuncNameGetProposal, &mty.ReqMultiSigProposal{Account: account, ProposalID: 1}).(*mty.MultiSigProposal)
+	assert.Equal(t, int32(mty.ProposalStatusCancelled), proposal.Status)
+	ty, _ = sendMultiSigTx(t, mock33, priv2, "Confirm", &mty.MultiSigConfirm{Account: account, ProposalID: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//第二个owner确认以后执行转账
+	ty, _ = sendMultiSigTx(t, mock33, priv1, "Propose", &mty.MultiSigPropose{Account: account, Transfer: transfer})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendMultiSigTx(t, mock33, priv1, "Confirm", &mty.MultiSigConfirm{Account: account, ProposalID: 2})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendMultiSigTx(t, mock33, priv2, "Confirm", &mty.MultiSigConfirm{Account: account, ProposalID: 2})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	proposal = queryMultiSig(t, mock33, mty.FuncNameGetProposal, &mty.ReqMultiSigProposal{Account: account, ProposalID: 2}).(*mty.MultiSigProposal)
+	assert.Equal(t, int32(mty.ProposalStatusExecuted), proposal.Status)
+	stateHash := mock33.GetLastBlock().StateHash
+	assert.Equal(t, 30*types.Coin, mock33.GetExecAccount(stateHash, mty.MultiSigX, account).Balance)
+	assert.Equal(t, 20*types.Coin, mock33.GetExecAccount(stateHash, mty.MultiSigX, receiver).Balance)
+
+	//修改owners，只需要一个确认
+	modify := &mty.MultiSigModify{Owners: []string{addr, addr1}, Required: 1}
+	ty, _ = sendMultiSigTx(t, mock33, priv, "Propose", &mty.MultiSigPropose{Account: account, Modify: modify})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendMultiSigTx(t, mock33, priv1, "Confirm", &mty.MultiSigConfirm{Account: account, ProposalID: 3})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	acc = queryMultiSig(t, mock33, mty.FuncNameGetAccount, &types.ReqString{Data: account}).(*mty.MultiSigAccount)
+	assert.Equal(t, []string{addr, addr1}, acc.Owners)
+	assert.Equal(t, int64(1), acc.Required)
+	assert.Equal(t, int64(3), acc.ProposalCount)
+	ty, _ = sendMultiSigTx(t, mock33, priv2, "Propose", &mty.MultiSigPropose{Account: account, Transfer: transfer})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	//余额不够的时候执行失败
+	ty, _ = sendMultiSigTx(t, mock33, priv, "Propose", &mty.MultiSigPropose{Account: account, Transfer: &mty.MultiSigTransfer{To: receiver, Amount: 100 * types.Coin}})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	all := queryMultiSig(t, mock33, mty.FuncNameListProposals, &mty.ReqMultiSigProposals{Account: account, Count: 2}).(*mty.ReplyMultiSigProposals)
+	assert.Equal(t, 2, len(all.Proposals))
+	assert.Equal(t, int64(3), all.Proposals[0].Id)
+	assert.Equal(t, int64(2), all.Proposals[1].Id)
+	pending = queryMultiSig(t, mock33, mty.FuncNameListProposals, &mty.ReqMultiSigProposals{Account: account, PendingOnly: true}).(*mty.ReplyMultiSigProposals)
+	assert.Equal(t, 0, len(pending.Proposals))
+}
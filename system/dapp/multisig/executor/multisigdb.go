@@ -0,0 +1,349 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	accountKeyPrefix  = "mavl-" + mty.MultiSigX + "-account-"
+	proposalKeyPrefix = "mavl-" + mty.MultiSigX + "-proposal-"
+)
+
+func calcAccountKey(addr string) []byte {
+	return []byte(accountKeyPrefix + addr)
+}
+
+func calcProposalKey(addr string, id int64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%018d", proposalKeyPrefix, addr, id))
+}
+
+//calcAccountAddr 多重签名账户的地址由创建交易的hash生成，没有对应的私钥
+func calcAccountAddr(txhash []byte) string {
+	return address.ExecAddress(mty.MultiSigX + "-" + common.ToHex(txhash))
+}
+
+// Action multisig交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(m *MultiSig, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: m.GetCoinsAccount(),
+		db:           m.GetStateDB(),
+		txhash:       tx.Hash(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       m.GetHeight(),
+		index:        index,
+	}
+}
+
+func getAccount(db dbm.KV, addr string) (*mty.MultiSigAccount, error) {
+	value, err := db.Get(calcAccountKey(addr))
+	if err != nil || value == nil {
+		return nil, mty.ErrAccountNotExist
+	}
+	var acc mty.MultiSigAccount
+	err = types.Decode(value, &acc)
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func getProposal(db dbm.KV, addr string, id int64) (*mty.MultiSigProposal, error) {
+	value, err := db.Get(calcProposalKey(addr, id))
+	if err != nil || value == nil {
+		return nil, mty.ErrProposalNotExist
+	}
+	var proposal mty.MultiSigProposal
+	err = types.Decode(value, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+//listProposals 从start开始按id从大到小列出提案
+func listProposals(db dbm.KV, req *mty.ReqMultiSigProposals) ([]*mty.MultiSigProposal, error) {
+	acc, err := getAccount(db, req.Account)
+	if err != nil {
+		return nil, err
+	}
+	count := int(req.Count)
+	if count <= 0 {
+		count = mty.DefaultListProposalCount
+	}
+	if count > mty.MaxListProposalCount {
+		count = mty.MaxListProposalCount
+	}
+	start := req.Start
+	if start <= 0 || start > acc.ProposalCount {
+		start = acc.ProposalCount
+	}
+	var proposals []*mty.MultiSigProposal
+	for id := start; id > 0 && len(proposals) < count; id-- {
+		proposal, err := getProposal(db, req.Account, id)
+		if err != nil {
+			return nil, err
+		}
+		if req.PendingOnly && proposal.Status != mty.ProposalStatusPending {
+			continue
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, nil
+}
+
+func contains(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+//checkOwners owners不能为空，不能重复，确认数在1和owners数量之间
+func checkOwners(owners []string, required int64) error {
+	if len(owners) == 0 || len(owners) > mty.MaxOwners {
+		return mty.ErrOwners
+	}
+	for i, owner := range owners {
+		if address.CheckAddress(owner) != nil || contains(owners[:i], owner) {
+			return mty.ErrOwners
+		}
+	}
+	if required <= 0 || required > int64(len(owners)) {
+		return mty.ErrRequired
+	}
+	return nil
+}
+
+//countConfirmations 只统计当前还是owner的确认
+func countConfirmations(acc *mty.MultiSigAccount, proposal *mty.MultiSigProposal) int64 {
+	var n int64
+	for _, addr := range proposal.Confirmations {
+		if contains(acc.Owners, addr) {
+			n++
+		}
+	}
+	return n
+}
+
+func copyProposal(proposal *mty.MultiSigProposal) *mty.MultiSigProposal {
+	current := *proposal
+	current.Confirmations = append([]string{}, proposal.Confirmations...)
+	return &current
+}
+
+func (a *Action) saveAccount(acc *mty.MultiSigAccount) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcAccountKey(acc.Addr), Value: types.Encode(acc)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (a *Action) saveProposal(proposal *mty.MultiSigProposal) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcProposalKey(proposal.Account, proposal.Id), Value: types.Encode(proposal)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func accountReceipt(ty int32, prev, current *mty.MultiSigAccount) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&mty.ReceiptMultiSigAccount{Prev: prev, Current: current})}
+}
+
+func proposalReceipt(ty int32, prev, current *mty.MultiSigProposal) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&mty.ReceiptMultiSigProposal{Prev: prev, Current: current})}
+}
+
+func (a *Action) accountCreate(payload *mty.MultiSigAccountCreate) (*types.Receipt, error) {
+	if err := checkOwners(payload.Owners, payload.Required); err != nil {
+		return nil, err
+	}
+	addr := calcAccountAddr(a.txhash)
+	if _, err := getAccount(a.db, addr); err == nil {
+		return nil, mty.ErrAccountExist
+	}
+	acc := &mty.MultiSigAccount{
+		Addr:         addr,
+		Owners:       payload.Owners,
+		Required:     payload.Required,
+		Creator:      a.fromaddr,
+		CreateHeight: a.height,
+	}
+	kv := []*types.KeyValue{a.saveAccount(acc)}
+	log := accountReceipt(mty.TyLogMultiSigAccountCreate, nil, acc)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func (a *Action) deposit(payload *mty.MultiSigDeposit) (*types.Receipt, error) {
+	if !types.CheckAmount(payload.Amount) {
+		return nil, types.ErrAmount
+	}
+	if _, err := getAccount(a.db, payload.Account); err != nil {
+		return nil, err
+	}
+	receipt, err := a.coinsAccount.ExecTransfer(a.fromaddr, payload.Account, a.execaddr, payload.Amount)
+	if err != nil {
+		clog.Error("multisig deposit", "addr", a.fromaddr, "account", payload.Account, "amount", payload.Amount, "err", err)
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (a *Action) propose(payload *mty.MultiSigPropose) (*types.Receipt, error) {
+	if (payload.Transfer == nil) == (payload.Modify == nil) {
+		return nil, mty.ErrProposal
+	}
+	if payload.Transfer != nil {
+		if !types.CheckAmount(payload.Transfer.Amount) {
+			return nil, types.ErrAmount
+		}
+		if err := address.CheckAddress(payload.Transfer.To); err != nil {
+			return nil, err
+		}
+	}
+	if payload.Modify != nil {
+		if err := checkOwners(payload.Modify.Owners, payload.Modify.Required); err != nil {
+			return nil, err
+		}
+	}
+	if len(payload.Note) > mty.MaxNoteLength {
+		return nil, mty.ErrNoteTooLong
+	}
+	prevAcc, err := getAccount(a.db, payload.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(prevAcc.Owners, a.fromaddr) {
+		return nil, mty.ErrNotOwner
+	}
+	acc := *prevAcc
+	acc.ProposalCount++
+	proposal := &mty.MultiSigProposal{
+		Account:       acc.Addr,
+		Id:            acc.ProposalCount,
+		Proposer:      a.fromaddr,
+		Transfer:      payload.Transfer,
+		Modify:        payload.Modify,
+		Note:          payload.Note,
+		Confirmations: []string{a.fromaddr},
+		Status:        mty.ProposalStatusPending,
+		CreateHeight:  a.height,
+	}
+	receipt, err := a.execute(&acc, proposal)
+	if err != nil {
+		return nil, err
+	}
+	kv := []*types.KeyValue{a.saveAccount(&acc)}
+	logs := []*types.ReceiptLog{proposalReceipt(mty.TyLogMultiSigPropose, nil, proposal)}
+	kv = append(kv, receipt.KV...)
+	logs = append(logs, receipt.Logs...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) confirm(payload *mty.MultiSigConfirm) (*types.Receipt, error) {
+	acc, err := getAccount(a.db, payload.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(acc.Owners, a.fromaddr) {
+		return nil, mty.ErrNotOwner
+	}
+	prev, err := getProposal(a.db, payload.Account, payload.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Status != mty.ProposalStatusPending {
+		return nil, mty.ErrProposalNotPending
+	}
+	if contains(prev.Confirmations, a.fromaddr) {
+		return nil, mty.ErrConfirmed
+	}
+	current := copyProposal(prev)
+	current.Confirmations = append(current.Confirmations, a.fromaddr)
+	receipt, err := a.execute(acc, current)
+	if err != nil {
+		return nil, err
+	}
+	logs := append([]*types.ReceiptLog{proposalReceipt(mty.TyLogMultiSigConfirm, prev, current)}, receipt.Logs...)
+	return &types.Receipt{Ty: types.ExecOk, KV: receipt.KV, Logs: logs}, nil
+}
+
+func (a *Action) revoke(payload *mty.MultiSigRevoke) (*types.Receipt, error) {
+	prev, err := getProposal(a.db, payload.Account, payload.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Status != mty.ProposalStatusPending {
+		return nil, mty.ErrProposalNotPending
+	}
+	if !contains(prev.Confirmations, a.fromaddr) {
+		return nil, mty.ErrNotConfirmed
+	}
+	current := copyProposal(prev)
+	current.Confirmations = current.Confirmations[:0]
+	for _, addr := range prev.Confirmations {
+		if addr != a.fromaddr {
+			current.Confirmations = append(current.Confirmations, addr)
+		}
+	}
+	//所有的确认都撤销以后提案取消
+	if len(current.Confirmations) == 0 {
+		current.Status = mty.ProposalStatusCancelled
+	}
+	kv := []*types.KeyValue{a.saveProposal(current)}
+	log := proposalReceipt(mty.TyLogMultiSigRevoke, prev, current)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+//execute 保存提案，确认数达到要求的时候执行提案，修改账户的时候acc会被更新
+func (a *Action) execute(acc *mty.MultiSigAccount, proposal *mty.MultiSigProposal) (*types.Receipt, error) {
+	if countConfirmations(acc, proposal) < acc.Required {
+		return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{a.saveProposal(proposal)}}, nil
+	}
+	var kv []*types.KeyValue
+	var logs []*types.ReceiptLog
+	if proposal.Transfer != nil {
+		receipt, err := a.coinsAccount.ExecTransfer(acc.Addr, proposal.Transfer.To, a.execaddr, proposal.Transfer.Amount)
+		if err != nil {
+			clog.Error("multisig execute", "account", acc.Addr, "id", proposal.Id, "err", err)
+			return nil, err
+		}
+		kv = append(kv, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+	}
+	if proposal.Modify != nil {
+		prevAcc := *acc
+		acc.Owners = proposal.Modify.Owners
+		acc.Required = proposal.Modify.Required
+		kv = append(kv, a.saveAccount(acc))
+		logs = append(logs, accountReceipt(mty.TyLogMultiSigAccountModify, &prevAcc, acc))
+	}
+	executed := copyProposal(proposal)
+	executed.Status = mty.ProposalStatusExecuted
+	executed.ExecuteHeight = a.height
+	kv = append(kv, a.saveProposal(executed))
+	logs = append(logs, proposalReceipt(mty.TyLogMultiSigExecute, proposal, executed))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
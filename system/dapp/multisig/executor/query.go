@@ -0,0 +1,29 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetAccount 获取多重签名账户
+func (m *MultiSig) Query_GetAccount(in *types.ReqString) (types.Message, error) {
+	return getAccount(m.GetStateDB(), in.Data)
+}
+
+// Query_GetProposal 获取提案
+func (m *MultiSig) Query_GetProposal(in *mty.ReqMultiSigProposal) (types.Message, error) {
+	return getProposal(m.GetStateDB(), in.Account, in.ProposalID)
+}
+
+// Query_ListProposals 按id从大到小列出提案，可以只列出等待确认的提案
+func (m *MultiSig) Query_ListProposals(in *mty.ReqMultiSigProposals) (types.Message, error) {
+	proposals, err := listProposals(m.GetStateDB(), in)
+	if err != nil {
+		return nil, err
+	}
+	return &mty.ReplyMultiSigProposals{Proposals: proposals}, nil
+}
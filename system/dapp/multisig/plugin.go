@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multisig 多重签名账户执行器插件
+// 1. 创建多重签名账户，设置owners和确认数
+// 2. owner提出转账或者修改账户的提案，其他owner确认或者撤销确认
+// 3. 确认数达到要求的时候执行提案
+package multisig
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/multisig/commands"
+	"github.com/33cn/chain33/system/dapp/multisig/executor"
+	"github.com/33cn/chain33/system/dapp/multisig/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.MultiSigX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.MultiSigCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// multisig action ty
+const (
+	MultiSigActionAccountCreate = iota + 1
+	MultiSigActionDeposit
+	MultiSigActionPropose
+	MultiSigActionConfirm
+	MultiSigActionRevoke
+)
+
+// multisig log ty
+const (
+	TyLogMultiSigAccountCreate = 450
+	TyLogMultiSigAccountModify = 451
+	TyLogMultiSigPropose       = 452
+	TyLogMultiSigConfirm       = 453
+	TyLogMultiSigRevoke        = 454
+	TyLogMultiSigExecute       = 455
+)
+
+// proposal status
+const (
+	ProposalStatusPending = iota + 1
+	ProposalStatusExecuted
+	ProposalStatusCancelled
+)
+
+// query func name
+const (
+	FuncNameGetAccount       = "GetAccount"
+	FuncNameGetProposal      = "GetProposal"
+	FuncNameListProposals    = "ListProposals"
+	MaxOwners                = 20
+	MaxNoteLength            = 256
+	DefaultListProposalCount = 20
+	MaxListProposalCount     = 100
+)
@@ -0,0 +1,32 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrAccountExist 多重签名账户已经存在
+	ErrAccountExist = errors.New("ErrAccountExist")
+	// ErrAccountNotExist 多重签名账户不存在
+	ErrAccountNotExist = errors.New("ErrAccountNotExist")
+	// ErrOwners owners为空，重复，超过上限或者地址不合法
+	ErrOwners = errors.New("ErrOwners")
+	// ErrRequired 确认数必须在1和owners数量之间
+	ErrRequired = errors.New("ErrRequired")
+	// ErrNotOwner 不是多重签名账户的owner
+	ErrNotOwner = errors.New("ErrNotOwner")
+	// ErrProposal 提案必须并且只能有一个transfer或者modify
+	ErrProposal = errors.New("ErrProposal")
+	// ErrProposalNotExist 提案不存在
+	ErrProposalNotExist = errors.New("ErrProposalNotExist")
+	// ErrProposalNotPending 提案已经执行或者取消
+	ErrProposalNotPending = errors.New("ErrProposalNotPending")
+	// ErrConfirmed 已经确认过提案
+	ErrConfirmed = errors.New("ErrConfirmed")
+	// ErrNotConfirmed 没有确认过提案
+	ErrNotConfirmed = errors.New("ErrNotConfirmed")
+	// ErrNoteTooLong 提案的备注太长
+	ErrNoteTooLong = errors.New("ErrNoteTooLong")
+)
@@ -0,0 +1,1128 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: multisig.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type MultiSigAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*MultiSigAction_AccountCreate
+	//	*MultiSigAction_Deposit
+	//	*MultiSigAction_Propose
+	//	*MultiSigAction_Confirm
+	//	*MultiSigAction_Revoke
+	Value                isMultiSigAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                  `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *MultiSigAction) Reset()         { *m = MultiSigAction{} }
+func (m *MultiSigAction) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAction) ProtoMessage()    {}
+func (*MultiSigAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{0}
+}
+
+func (m *MultiSigAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAction.Unmarshal(m, b)
+}
+func (m *MultiSigAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAction.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAction.Merge(m, src)
+}
+func (m *MultiSigAction) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAction.Size(m)
+}
+func (m *MultiSigAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAction proto.InternalMessageInfo
+
+type isMultiSigAction_Value interface {
+	isMultiSigAction_Value()
+}
+
+type MultiSigAction_AccountCreate struct {
+	AccountCreate *MultiSigAccountCreate `protobuf:"bytes,1,opt,name=accountCreate,proto3,oneof"`
+}
+
+type MultiSigAction_Deposit struct {
+	Deposit *MultiSigDeposit `protobuf:"bytes,2,opt,name=deposit,proto3,oneof"`
+}
+
+type MultiSigAction_Propose struct {
+	Propose *MultiSigPropose `protobuf:"bytes,3,opt,name=propose,proto3,oneof"`
+}
+
+type MultiSigAction_Confirm struct {
+	Confirm *MultiSigConfirm `protobuf:"bytes,4,opt,name=confirm,proto3,oneof"`
+}
+
+type MultiSigAction_Revoke struct {
+	Revoke *MultiSigRevoke `protobuf:"bytes,5,opt,name=revoke,proto3,oneof"`
+}
+
+func (*MultiSigAction_AccountCreate) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_Deposit) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_Propose) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_Confirm) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_Revoke) isMultiSigAction_Value() {}
+
+func (m *MultiSigAction) GetValue() isMultiSigAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetAccountCreate() *MultiSigAccountCreate {
+	if x, ok := m.GetValue().(*MultiSigAction_AccountCreate); ok {
+		return x.AccountCreate
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetDeposit() *MultiSigDeposit {
+	if x, ok := m.GetValue().(*MultiSigAction_Deposit); ok {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetPropose() *MultiSigPropose {
+	if x, ok := m.GetValue().(*MultiSigAction_Propose); ok {
+		return x.Propose
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetConfirm() *MultiSigConfirm {
+	if x, ok := m.GetValue().(*MultiSigAction_Confirm); ok {
+		return x.Confirm
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetRevoke() *MultiSigRevoke {
+	if x, ok := m.GetValue().(*MultiSigAction_Revoke); ok {
+		return x.Revoke
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*MultiSigAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _MultiSigAction_OneofMarshaler, _MultiSigAction_OneofUnmarshaler, _MultiSigAction_OneofSizer, []interface{}{
+		(*MultiSigAction_AccountCreate)(nil),
+		(*MultiSigAction_Deposit)(nil),
+		(*MultiSigAction_Propose)(nil),
+		(*MultiSigAction_Confirm)(nil),
+		(*MultiSigAction_Revoke)(nil),
+	}
+}
+
+func _MultiSigAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*MultiSigAction)
+	// value
+	switch x := m.Value.(type) {
+	case *MultiSigAction_AccountCreate:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AccountCreate); err != nil {
+			return err
+		}
+	case *MultiSigAction_Deposit:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Deposit); err != nil {
+			return err
+		}
+	case *MultiSigAction_Propose:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Propose); err != nil {
+			return err
+		}
+	case *MultiSigAction_Confirm:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Confirm); err != nil {
+			return err
+		}
+	case *MultiSigAction_Revoke:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Revoke); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("MultiSigAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _MultiSigAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*MultiSigAction)
+	switch tag {
+	case 1: // value.accountCreate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigAccountCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_AccountCreate{msg}
+		return true, err
+	case 2: // value.deposit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigDeposit)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_Deposit{msg}
+		return true, err
+	case 3: // value.propose
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigPropose)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_Propose{msg}
+		return true, err
+	case 4: // value.confirm
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigConfirm)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_Confirm{msg}
+		return true, err
+	case 5: // value.revoke
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigRevoke)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_Revoke{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _MultiSigAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*MultiSigAction)
+	// value
+	switch x := m.Value.(type) {
+	case *MultiSigAction_AccountCreate:
+		s := proto.Size(x.AccountCreate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *MultiSigAction_Deposit:
+		s := proto.Size(x.Deposit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *MultiSigAction_Propose:
+		s := proto.Size(x.Propose)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *MultiSigAction_Confirm:
+		s := proto.Size(x.Confirm)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *MultiSigAction_Revoke:
+		s := proto.Size(x.Revoke)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//创建多重签名账户，owners中required个确认以后执行提案
+type MultiSigAccountCreate struct {
+	Owners               []string `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	Required             int64    `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigAccountCreate) Reset()         { *m = MultiSigAccountCreate{} }
+func (m *MultiSigAccountCreate) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAccountCreate) ProtoMessage()    {}
+func (*MultiSigAccountCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{1}
+}
+
+func (m *MultiSigAccountCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAccountCreate.Unmarshal(m, b)
+}
+func (m *MultiSigAccountCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAccountCreate.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAccountCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccountCreate.Merge(m, src)
+}
+func (m *MultiSigAccountCreate) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAccountCreate.Size(m)
+}
+func (m *MultiSigAccountCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAccountCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAccountCreate proto.InternalMessageInfo
+
+func (m *MultiSigAccountCreate) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigAccountCreate) GetRequired() int64 {
+	if m != nil {
+		return m.Required
+	}
+	return 0
+}
+
+//把在multisig合约中的coins存入多重签名账户，coins需要先转到multisig合约
+type MultiSigDeposit struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigDeposit) Reset()         { *m = MultiSigDeposit{} }
+func (m *MultiSigDeposit) String() string { return proto.CompactTextString(m) }
+func (*MultiSigDeposit) ProtoMessage()    {}
+func (*MultiSigDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{2}
+}
+
+func (m *MultiSigDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigDeposit.Unmarshal(m, b)
+}
+func (m *MultiSigDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigDeposit.Marshal(b, m, deterministic)
+}
+func (m *MultiSigDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigDeposit.Merge(m, src)
+}
+func (m *MultiSigDeposit) XXX_Size() int {
+	return xxx_messageInfo_MultiSigDeposit.Size(m)
+}
+func (m *MultiSigDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigDeposit proto.InternalMessageInfo
+
+func (m *MultiSigDeposit) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MultiSigDeposit) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//多重签名账户转账，转到to在multisig合约中的账户
+type MultiSigTransfer struct {
+	To                   string   `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigTransfer) Reset()         { *m = MultiSigTransfer{} }
+func (m *MultiSigTransfer) String() string { return proto.CompactTextString(m) }
+func (*MultiSigTransfer) ProtoMessage()    {}
+func (*MultiSigTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{3}
+}
+
+func (m *MultiSigTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigTransfer.Unmarshal(m, b)
+}
+func (m *MultiSigTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigTransfer.Marshal(b, m, deterministic)
+}
+func (m *MultiSigTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTransfer.Merge(m, src)
+}
+func (m *MultiSigTransfer) XXX_Size() int {
+	return xxx_messageInfo_MultiSigTransfer.Size(m)
+}
+func (m *MultiSigTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigTransfer proto.InternalMessageInfo
+
+func (m *MultiSigTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MultiSigTransfer) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//修改多重签名账户的owners和确认数
+type MultiSigModify struct {
+	Owners               []string `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	Required             int64    `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigModify) Reset()         { *m = MultiSigModify{} }
+func (m *MultiSigModify) String() string { return proto.CompactTextString(m) }
+func (*MultiSigModify) ProtoMessage()    {}
+func (*MultiSigModify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{4}
+}
+
+func (m *MultiSigModify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigModify.Unmarshal(m, b)
+}
+func (m *MultiSigModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigModify.Marshal(b, m, deterministic)
+}
+func (m *MultiSigModify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigModify.Merge(m, src)
+}
+func (m *MultiSigModify) XXX_Size() int {
+	return xxx_messageInfo_MultiSigModify.Size(m)
+}
+func (m *MultiSigModify) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigModify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigModify proto.InternalMessageInfo
+
+func (m *MultiSigModify) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigModify) GetRequired() int64 {
+	if m != nil {
+		return m.Required
+	}
+	return 0
+}
+
+//提案，transfer和modify只能设置一个，提案的owner同时确认
+type MultiSigPropose struct {
+	Account              string            `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Transfer             *MultiSigTransfer `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Modify               *MultiSigModify   `protobuf:"bytes,3,opt,name=modify,proto3" json:"modify,omitempty"`
+	Note                 string            `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MultiSigPropose) Reset()         { *m = MultiSigPropose{} }
+func (m *MultiSigPropose) String() string { return proto.CompactTextString(m) }
+func (*MultiSigPropose) ProtoMessage()    {}
+func (*MultiSigPropose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{5}
+}
+
+func (m *MultiSigPropose) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigPropose.Unmarshal(m, b)
+}
+func (m *MultiSigPropose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigPropose.Marshal(b, m, deterministic)
+}
+func (m *MultiSigPropose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigPropose.Merge(m, src)
+}
+func (m *MultiSigPropose) XXX_Size() int {
+	return xxx_messageInfo_MultiSigPropose.Size(m)
+}
+func (m *MultiSigPropose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigPropose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigPropose proto.InternalMessageInfo
+
+func (m *MultiSigPropose) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MultiSigPropose) GetTransfer() *MultiSigTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return nil
+}
+
+func (m *MultiSigPropose) GetModify() *MultiSigModify {
+	if m != nil {
+		return m.Modify
+	}
+	return nil
+}
+
+func (m *MultiSigPropose) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+//确认提案，确认数达到required的时候执行提案
+type MultiSigConfirm struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ProposalID           int64    `protobuf:"varint,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigConfirm) Reset()         { *m = MultiSigConfirm{} }
+func (m *MultiSigConfirm) String() string { return proto.CompactTextString(m) }
+func (*MultiSigConfirm) ProtoMessage()    {}
+func (*MultiSigConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{6}
+}
+
+func (m *MultiSigConfirm) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigConfirm.Unmarshal(m, b)
+}
+func (m *MultiSigConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigConfirm.Marshal(b, m, deterministic)
+}
+func (m *MultiSigConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigConfirm.Merge(m, src)
+}
+func (m *MultiSigConfirm) XXX_Size() int {
+	return xxx_messageInfo_MultiSigConfirm.Size(m)
+}
+func (m *MultiSigConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigConfirm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigConfirm proto.InternalMessageInfo
+
+func (m *MultiSigConfirm) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MultiSigConfirm) GetProposalID() int64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+//撤销自己对提案的确认，所有确认都撤销以后提案取消
+type MultiSigRevoke struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ProposalID           int64    `protobuf:"varint,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigRevoke) Reset()         { *m = MultiSigRevoke{} }
+func (m *MultiSigRevoke) String() string { return proto.CompactTextString(m) }
+func (*MultiSigRevoke) ProtoMessage()    {}
+func (*MultiSigRevoke) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{7}
+}
+
+func (m *MultiSigRevoke) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigRevoke.Unmarshal(m, b)
+}
+func (m *MultiSigRevoke) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigRevoke.Marshal(b, m, deterministic)
+}
+func (m *MultiSigRevoke) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigRevoke.Merge(m, src)
+}
+func (m *MultiSigRevoke) XXX_Size() int {
+	return xxx_messageInfo_MultiSigRevoke.Size(m)
+}
+func (m *MultiSigRevoke) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigRevoke.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigRevoke proto.InternalMessageInfo
+
+func (m *MultiSigRevoke) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MultiSigRevoke) GetProposalID() int64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+type MultiSigAccount struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Owners               []string `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty"`
+	Required             int64    `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Creator              string   `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreateHeight         int64    `protobuf:"varint,5,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	ProposalCount        int64    `protobuf:"varint,6,opt,name=proposalCount,proto3" json:"proposalCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigAccount) Reset()         { *m = MultiSigAccount{} }
+func (m *MultiSigAccount) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAccount) ProtoMessage()    {}
+func (*MultiSigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{8}
+}
+
+func (m *MultiSigAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAccount.Unmarshal(m, b)
+}
+func (m *MultiSigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAccount.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccount.Merge(m, src)
+}
+func (m *MultiSigAccount) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAccount.Size(m)
+}
+func (m *MultiSigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAccount proto.InternalMessageInfo
+
+func (m *MultiSigAccount) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *MultiSigAccount) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigAccount) GetRequired() int64 {
+	if m != nil {
+		return m.Required
+	}
+	return 0
+}
+
+func (m *MultiSigAccount) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MultiSigAccount) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccount) GetProposalCount() int64 {
+	if m != nil {
+		return m.ProposalCount
+	}
+	return 0
+}
+
+type MultiSigProposal struct {
+	Account              string            `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Id                   int64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer             string            `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Transfer             *MultiSigTransfer `protobuf:"bytes,4,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Modify               *MultiSigModify   `protobuf:"bytes,5,opt,name=modify,proto3" json:"modify,omitempty"`
+	Note                 string            `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Confirmations        []string          `protobuf:"bytes,7,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Status               int32             `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreateHeight         int64             `protobuf:"varint,9,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	ExecuteHeight        int64             `protobuf:"varint,10,opt,name=executeHeight,proto3" json:"executeHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MultiSigProposal) Reset()         { *m = MultiSigProposal{} }
+func (m *MultiSigProposal) String() string { return proto.CompactTextString(m) }
+func (*MultiSigProposal) ProtoMessage()    {}
+func (*MultiSigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{9}
+}
+
+func (m *MultiSigProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigProposal.Unmarshal(m, b)
+}
+func (m *MultiSigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigProposal.Marshal(b, m, deterministic)
+}
+func (m *MultiSigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigProposal.Merge(m, src)
+}
+func (m *MultiSigProposal) XXX_Size() int {
+	return xxx_messageInfo_MultiSigProposal.Size(m)
+}
+func (m *MultiSigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigProposal proto.InternalMessageInfo
+
+func (m *MultiSigProposal) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MultiSigProposal) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MultiSigProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MultiSigProposal) GetTransfer() *MultiSigTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return nil
+}
+
+func (m *MultiSigProposal) GetModify() *MultiSigModify {
+	if m != nil {
+		return m.Modify
+	}
+	return nil
+}
+
+func (m *MultiSigProposal) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *MultiSigProposal) GetConfirmations() []string {
+	if m != nil {
+		return m.Confirmations
+	}
+	return nil
+}
+
+func (m *MultiSigProposal) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *MultiSigProposal) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+func (m *MultiSigProposal) GetExecuteHeight() int64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+type ReceiptMultiSigAccount struct {
+	Prev                 *MultiSigAccount `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *MultiSigAccount `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptMultiSigAccount) Reset()         { *m = ReceiptMultiSigAccount{} }
+func (m *ReceiptMultiSigAccount) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigAccount) ProtoMessage()    {}
+func (*ReceiptMultiSigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{10}
+}
+
+func (m *ReceiptMultiSigAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Unmarshal(m, b)
+}
+func (m *ReceiptMultiSigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiSigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigAccount.Merge(m, src)
+}
+func (m *ReceiptMultiSigAccount) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Size(m)
+}
+func (m *ReceiptMultiSigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiSigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiSigAccount proto.InternalMessageInfo
+
+func (m *ReceiptMultiSigAccount) GetPrev() *MultiSigAccount {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigAccount) GetCurrent() *MultiSigAccount {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptMultiSigProposal struct {
+	Prev                 *MultiSigProposal `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *MultiSigProposal `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptMultiSigProposal) Reset()         { *m = ReceiptMultiSigProposal{} }
+func (m *ReceiptMultiSigProposal) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigProposal) ProtoMessage()    {}
+func (*ReceiptMultiSigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{11}
+}
+
+func (m *ReceiptMultiSigProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiSigProposal.Unmarshal(m, b)
+}
+func (m *ReceiptMultiSigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiSigProposal.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiSigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigProposal.Merge(m, src)
+}
+func (m *ReceiptMultiSigProposal) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiSigProposal.Size(m)
+}
+func (m *ReceiptMultiSigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiSigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiSigProposal proto.InternalMessageInfo
+
+func (m *ReceiptMultiSigProposal) GetPrev() *MultiSigProposal {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigProposal) GetCurrent() *MultiSigProposal {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqMultiSigProposal struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ProposalID           int64    `protobuf:"varint,2,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMultiSigProposal) Reset()         { *m = ReqMultiSigProposal{} }
+func (m *ReqMultiSigProposal) String() string { return proto.CompactTextString(m) }
+func (*ReqMultiSigProposal) ProtoMessage()    {}
+func (*ReqMultiSigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{12}
+}
+
+func (m *ReqMultiSigProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMultiSigProposal.Unmarshal(m, b)
+}
+func (m *ReqMultiSigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMultiSigProposal.Marshal(b, m, deterministic)
+}
+func (m *ReqMultiSigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigProposal.Merge(m, src)
+}
+func (m *ReqMultiSigProposal) XXX_Size() int {
+	return xxx_messageInfo_ReqMultiSigProposal.Size(m)
+}
+func (m *ReqMultiSigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMultiSigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMultiSigProposal proto.InternalMessageInfo
+
+func (m *ReqMultiSigProposal) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *ReqMultiSigProposal) GetProposalID() int64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+//从start开始按id从大到小列出提案，start为0的时候从最新的提案开始
+type ReqMultiSigProposals struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Start                int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	PendingOnly          bool     `protobuf:"varint,4,opt,name=pendingOnly,proto3" json:"pendingOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMultiSigProposals) Reset()         { *m = ReqMultiSigProposals{} }
+func (m *ReqMultiSigProposals) String() string { return proto.CompactTextString(m) }
+func (*ReqMultiSigProposals) ProtoMessage()    {}
+func (*ReqMultiSigProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{13}
+}
+
+func (m *ReqMultiSigProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMultiSigProposals.Unmarshal(m, b)
+}
+func (m *ReqMultiSigProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMultiSigProposals.Marshal(b, m, deterministic)
+}
+func (m *ReqMultiSigProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigProposals.Merge(m, src)
+}
+func (m *ReqMultiSigProposals) XXX_Size() int {
+	return xxx_messageInfo_ReqMultiSigProposals.Size(m)
+}
+func (m *ReqMultiSigProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMultiSigProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMultiSigProposals proto.InternalMessageInfo
+
+func (m *ReqMultiSigProposals) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *ReqMultiSigProposals) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ReqMultiSigProposals) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqMultiSigProposals) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
+}
+
+type ReplyMultiSigProposals struct {
+	Proposals            []*MultiSigProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReplyMultiSigProposals) Reset()         { *m = ReplyMultiSigProposals{} }
+func (m *ReplyMultiSigProposals) String() string { return proto.CompactTextString(m) }
+func (*ReplyMultiSigProposals) ProtoMessage()    {}
+func (*ReplyMultiSigProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{14}
+}
+
+func (m *ReplyMultiSigProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMultiSigProposals.Unmarshal(m, b)
+}
+func (m *ReplyMultiSigProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMultiSigProposals.Marshal(b, m, deterministic)
+}
+func (m *ReplyMultiSigProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigProposals.Merge(m, src)
+}
+func (m *ReplyMultiSigProposals) XXX_Size() int {
+	return xxx_messageInfo_ReplyMultiSigProposals.Size(m)
+}
+func (m *ReplyMultiSigProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMultiSigProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMultiSigProposals proto.InternalMessageInfo
+
+func (m *ReplyMultiSigProposals) GetProposals() []*MultiSigProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultiSigAction)(nil), "types.MultiSigAction")
+	proto.RegisterType((*MultiSigAccountCreate)(nil), "types.MultiSigAccountCreate")
+	proto.RegisterType((*MultiSigDeposit)(nil), "types.MultiSigDeposit")
+	proto.RegisterType((*MultiSigTransfer)(nil), "types.MultiSigTransfer")
+	proto.RegisterType((*MultiSigModify)(nil), "types.MultiSigModify")
+	proto.RegisterType((*MultiSigPropose)(nil), "types.MultiSigPropose")
+	proto.RegisterType((*MultiSigConfirm)(nil), "types.MultiSigConfirm")
+	proto.RegisterType((*MultiSigRevoke)(nil), "types.MultiSigRevoke")
+	proto.RegisterType((*MultiSigAccount)(nil), "types.MultiSigAccount")
+	proto.RegisterType((*MultiSigProposal)(nil), "types.MultiSigProposal")
+	proto.RegisterType((*ReceiptMultiSigAccount)(nil), "types.ReceiptMultiSigAccount")
+	proto.RegisterType((*ReceiptMultiSigProposal)(nil), "types.ReceiptMultiSigProposal")
+	proto.RegisterType((*ReqMultiSigProposal)(nil), "types.ReqMultiSigProposal")
+	proto.RegisterType((*ReqMultiSigProposals)(nil), "types.ReqMultiSigProposals")
+	proto.RegisterType((*ReplyMultiSigProposals)(nil), "types.ReplyMultiSigProposals")
+}
+
+func init() { proto.RegisterFile("multisig.proto", fileDescriptor_62b8b91adf3febfa) }
+
+var fileDescriptor_62b8b91adf3febfa = []byte{
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x6e, 0x9c, 0xc4, 0x89, 0xa7, 0x34, 0xa0, 0xa5, 0x4d, 0x2d, 0x84, 0x50, 0x64, 0x71, 0xa8,
+	0x40, 0x14, 0x68, 0xc5, 0x85, 0x1b, 0x24, 0x87, 0x42, 0x55, 0xb5, 0x5a, 0x78, 0x01, 0x63, 0x6f,
+	0xc3, 0x0a, 0xc7, 0xeb, 0xae, 0xd7, 0x01, 0x5f, 0x78, 0x19, 0x5e, 0x03, 0xde, 0x83, 0xc7, 0x41,
+	0xfb, 0x63, 0x3b, 0x76, 0x9d, 0xa0, 0xc0, 0x2d, 0x33, 0xf3, 0xcd, 0xec, 0xcc, 0x37, 0x9f, 0x27,
+	0x30, 0x5a, 0x64, 0x91, 0xa0, 0x29, 0x9d, 0x1f, 0x27, 0x9c, 0x09, 0x86, 0xfa, 0x22, 0x4f, 0x48,
+	0xea, 0xfd, 0xb4, 0x60, 0x74, 0x21, 0x23, 0x1f, 0xe8, 0xfc, 0x4d, 0x20, 0x28, 0x8b, 0xd1, 0x0c,
+	0xf6, 0xfc, 0x20, 0x60, 0x59, 0x2c, 0xa6, 0x9c, 0xf8, 0x82, 0xb8, 0x9d, 0x49, 0xe7, 0x68, 0xf7,
+	0xe4, 0xe1, 0xb1, 0xca, 0x38, 0xae, 0xd0, 0x2b, 0x98, 0xb3, 0x1d, 0x5c, 0x4f, 0x42, 0x27, 0x30,
+	0x08, 0x49, 0xc2, 0x52, 0x2a, 0x5c, 0x4b, 0xe5, 0x8f, 0x1b, 0xf9, 0x33, 0x1d, 0x3d, 0xdb, 0xc1,
+	0x05, 0x50, 0xe6, 0x24, 0x9c, 0x25, 0x2c, 0x25, 0x6e, 0xb7, 0x35, 0xe7, 0x4a, 0x47, 0x65, 0x8e,
+	0x01, 0xca, 0x9c, 0x80, 0xc5, 0xd7, 0x94, 0x2f, 0xdc, 0x5e, 0x6b, 0xce, 0x54, 0x47, 0x65, 0x8e,
+	0x01, 0xa2, 0xe7, 0x60, 0x73, 0xb2, 0x64, 0x5f, 0x88, 0xdb, 0x57, 0x29, 0x07, 0x8d, 0x14, 0xac,
+	0x82, 0x67, 0x3b, 0xd8, 0xc0, 0xd0, 0x08, 0x2c, 0x91, 0xbb, 0xf6, 0xa4, 0x73, 0xd4, 0xc7, 0x96,
+	0xc8, 0xdf, 0x0e, 0xa0, 0xbf, 0xf4, 0xa3, 0x8c, 0x78, 0xe7, 0x70, 0xd0, 0xca, 0x07, 0x1a, 0x83,
+	0xcd, 0xbe, 0xc6, 0x84, 0xa7, 0x6e, 0x67, 0xd2, 0x3d, 0x72, 0xb0, 0xb1, 0xd0, 0x03, 0x18, 0x72,
+	0x72, 0x93, 0x51, 0x4e, 0x42, 0xc5, 0x4b, 0x17, 0x97, 0xb6, 0x37, 0x85, 0xbb, 0x0d, 0x72, 0x90,
+	0x0b, 0x03, 0x43, 0xab, 0xda, 0x82, 0x83, 0x0b, 0x53, 0x3e, 0xe0, 0x2f, 0x54, 0x40, 0x97, 0x31,
+	0x96, 0xf7, 0x1a, 0xee, 0x15, 0x45, 0x3e, 0x72, 0x3f, 0x4e, 0xaf, 0x09, 0x57, 0xed, 0x33, 0x53,
+	0xc0, 0x12, 0x6c, 0x6d, 0xee, 0xac, 0xd2, 0xc2, 0x05, 0x0b, 0xe9, 0x75, 0xfe, 0x4f, 0x63, 0xfc,
+	0xe8, 0x54, 0x73, 0x98, 0x85, 0x6d, 0x98, 0xe3, 0x14, 0x86, 0xc2, 0xf4, 0x69, 0x84, 0x72, 0xd8,
+	0xd8, 0x46, 0x31, 0x06, 0x2e, 0x81, 0xe8, 0x19, 0xd8, 0x0b, 0xd5, 0xa0, 0xd1, 0x49, 0x73, 0x81,
+	0xba, 0x7b, 0x6c, 0x40, 0x08, 0x41, 0x2f, 0x66, 0x82, 0x28, 0x81, 0x38, 0x58, 0xfd, 0xf6, 0xce,
+	0xab, 0x26, 0x8d, 0x42, 0x36, 0x34, 0xf9, 0x08, 0x40, 0xeb, 0xcd, 0x8f, 0xde, 0xcd, 0xcc, 0xc0,
+	0x2b, 0x1e, 0xef, 0x7d, 0x45, 0x9c, 0xd6, 0xce, 0x7f, 0xd4, 0xfa, 0xb5, 0x42, 0x9f, 0xd1, 0x94,
+	0x1c, 0xc0, 0x0f, 0x43, 0x6e, 0x4a, 0xa9, 0xdf, 0x2b, 0xab, 0xb1, 0xd6, 0xae, 0xa6, 0x5b, 0x5f,
+	0x8d, 0xec, 0x2a, 0x90, 0xfa, 0x64, 0xdc, 0x70, 0x51, 0x98, 0xc8, 0x83, 0x3b, 0x81, 0xfe, 0x92,
+	0x09, 0x9d, 0x7f, 0x16, 0xea, 0xc3, 0xe8, 0xe2, 0x9a, 0x0f, 0x3d, 0x86, 0xbd, 0xa2, 0xcf, 0xa9,
+	0x9a, 0xcc, 0x56, 0xa0, 0xba, 0xd3, 0xfb, 0x6d, 0x55, 0x0a, 0xbc, 0x32, 0x91, 0x0d, 0x74, 0x8c,
+	0xc0, 0xa2, 0x85, 0x86, 0x2c, 0x1a, 0xca, 0xf6, 0xcd, 0xa7, 0xcd, 0x55, 0xfb, 0x0e, 0x2e, 0xed,
+	0x9a, 0x56, 0x7a, 0xdb, 0x6b, 0xa5, 0xbf, 0x8d, 0x56, 0xec, 0x4a, 0x2b, 0x72, 0x70, 0x73, 0x3a,
+	0x7c, 0x79, 0x21, 0x53, 0x77, 0xa0, 0x18, 0xaf, 0x3b, 0xe5, 0x42, 0x52, 0xe1, 0x8b, 0x2c, 0x75,
+	0x87, 0xea, 0x50, 0x18, 0xeb, 0x16, 0xb5, 0x4e, 0x3b, 0xb5, 0xe4, 0x1b, 0x09, 0xb2, 0x12, 0x04,
+	0x9a, 0xda, 0x9a, 0xd3, 0x5b, 0xc2, 0x18, 0x93, 0x80, 0xd0, 0x44, 0x34, 0x05, 0xf2, 0x04, 0x7a,
+	0x09, 0x27, 0x4b, 0x73, 0xaa, 0xc7, 0xed, 0xa7, 0x1a, 0x2b, 0x0c, 0x7a, 0x01, 0x83, 0x20, 0xe3,
+	0x9c, 0xc4, 0xeb, 0x2e, 0x73, 0x01, 0x2f, 0x60, 0x5e, 0x0e, 0x87, 0x8d, 0x77, 0xcb, 0xc5, 0x3e,
+	0xad, 0x3d, 0x7c, 0xd8, 0x7a, 0xaf, 0xfd, 0xc8, 0xbc, 0xfc, 0xb2, 0xf9, 0xf2, 0x5a, 0x7c, 0xf9,
+	0xf4, 0x25, 0xdc, 0xc7, 0xe4, 0x66, 0x0b, 0x3d, 0xfd, 0xed, 0xf3, 0xfa, 0x0e, 0xfb, 0x2d, 0x05,
+	0xd3, 0x0d, 0x15, 0xf7, 0xa1, 0x9f, 0x0a, 0x9f, 0x17, 0xc7, 0x52, 0x1b, 0xd2, 0xab, 0xd1, 0x5d,
+	0xb5, 0x6c, 0x6d, 0xa0, 0x09, 0xec, 0x26, 0x24, 0x0e, 0x69, 0x3c, 0xbf, 0x8c, 0xa3, 0x5c, 0x89,
+	0x74, 0x88, 0x57, 0x5d, 0xde, 0xa5, 0xdc, 0x61, 0x12, 0xe5, 0xb7, 0x3b, 0x78, 0x05, 0x4e, 0xd1,
+	0xa7, 0x3e, 0xb7, 0x1b, 0xf8, 0xa9, 0x90, 0x9f, 0x6c, 0xf5, 0x7f, 0x7e, 0xfa, 0x27, 0x00, 0x00,
+	0xff, 0xff, 0xfd, 0x8d, 0x3b, 0x16, 0xe1, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,70 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types multisig插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// MultiSigX 执行器名称
+	MultiSigX  = "multisig"
+	actionName = map[string]int32{
+		"AccountCreate": MultiSigActionAccountCreate,
+		"Deposit":       MultiSigActionDeposit,
+		"Propose":       MultiSigActionPropose,
+		"Confirm":       MultiSigActionConfirm,
+		"Revoke":        MultiSigActionRevoke,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogMultiSigAccountCreate: {Ty: reflect.TypeOf(ReceiptMultiSigAccount{}), Name: "LogMultiSigAccountCreate"},
+		TyLogMultiSigAccountModify: {Ty: reflect.TypeOf(ReceiptMultiSigAccount{}), Name: "LogMultiSigAccountModify"},
+		TyLogMultiSigPropose:       {Ty: reflect.TypeOf(ReceiptMultiSigProposal{}), Name: "LogMultiSigPropose"},
+		TyLogMultiSigConfirm:       {Ty: reflect.TypeOf(ReceiptMultiSigProposal{}), Name: "LogMultiSigConfirm"},
+		TyLogMultiSigRevoke:        {Ty: reflect.TypeOf(ReceiptMultiSigProposal{}), Name: "LogMultiSigRevoke"},
+		TyLogMultiSigExecute:       {Ty: reflect.TypeOf(ReceiptMultiSigProposal{}), Name: "LogMultiSigExecute"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(MultiSigX))
+	types.RegistorExecutor(MultiSigX, NewType())
+	types.RegisterDappFork(MultiSigX, "Enable", 0)
+}
+
+// MultiSigType multisig执行器类型
+type MultiSigType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a multisig type object
+func NewType() *MultiSigType {
+	c := &MultiSigType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return multisig action
+func (m *MultiSigType) GetPayload() types.Message {
+	return &MultiSigAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (m *MultiSigType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (m *MultiSigType) GetName() string {
+	return MultiSigX
+}
@@ -0,0 +1,267 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands nft插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	nty "github.com/33cn/chain33/system/dapp/nft/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// NftCmd nft command
+func NftCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft",
+		Short: "Non-fungible token management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CollectionCreateCmd(),
+		MintCmd(),
+		TransferCmd(),
+		BurnCmd(),
+		ApproveCmd(),
+		SetOperatorCmd(),
+		QueryCollectionCmd(),
+		QueryTokenCmd(),
+		ListTokensCmd(),
+	)
+
+	return cmd
+}
+
+func addTokenFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("collection", "c", "", "collection symbol")
+	cmd.MarkFlagRequired("collection")
+	cmd.Flags().StringP("id", "i", "", "token id")
+	cmd.MarkFlagRequired("id")
+}
+
+// CollectionCreateCmd create collection
+func CollectionCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection_create",
+		Short: "Create a transaction to create collection",
+		Run:   collectionCreate,
+	}
+	cmd.Flags().StringP("symbol", "s", "", "collection symbol, upper case letters and digits")
+	cmd.MarkFlagRequired("symbol")
+	cmd.Flags().StringP("name", "n", "", "collection name")
+	cmd.Flags().StringP("desc", "d", "", "collection description")
+	return cmd
+}
+
+func collectionCreate(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	name, _ := cmd.Flags().GetString("name")
+	desc, _ := cmd.Flags().GetString("desc")
+	commandtypes.CreateActionTx(cmd, nty.NftX, &nty.NftAction{
+		Ty:    nty.NftActionCollectionCreate,
+		Value: &nty.NftAction_CollectionCreate{CollectionCreate: &nty.NftCollectionCreate{Symbol: symbol, Name: name, Description: desc}},
+	})
+}
+
+// MintCmd mint token
+func MintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint",
+		Short: "Create a transaction to mint token, only for collection creator",
+		Run:   mint,
+	}
+	addTokenFlags(cmd)
+	cmd.Flags().StringP("to", "t", "", "token owner")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().StringP("uri", "u", "", "metadata uri")
+	cmd.Flags().StringP("hash", "s", "", "content hash in hex")
+	return cmd
+}
+
+func mint(cmd *cobra.Command, args []string) {
+	collection, _ := cmd.Flags().GetString("collection")
+	id, _ := cmd.Flags().GetString("id")
+	to, _ := cmd.Flags().GetString("to")
+	uri, _ := cmd.Flags().GetString("uri")
+	hash, _ := cmd.Flags().GetString("hash")
+	contentHash, err := common.FromHex(hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, nty.NftX, &nty.NftAction{
+		Ty:    nty.NftActionMint,
+		Value: &nty.NftAction_Mint{Mint: &nty.NftMint{Collection: collection, TokenID: id, To: to, Uri: uri, ContentHash: contentHash}},
+	})
+}
+
+// TransferCmd transfer token
+func TransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "Create a transaction to transfer token",
+		Run:   transfer,
+	}
+	addTokenFlags(cmd)
+	cmd.Flags().StringP("to", "t", "", "receiver address")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func transfer(cmd *cobra.Command, args []string) {
+	collection, _ := cmd.Flags().GetString("collection")
+	id, _ := cmd.Flags().GetString("id")
+	to, _ := cmd.Flags().GetString("to")
+	commandtypes.CreateActionTx(cmd, nty.NftX, &nty.NftAction{
+		Ty:    nty.NftActionTransfer,
+		Value: &nty.NftAction_Transfer{Transfer: &nty.NftTransfer{Collection: collection, TokenID: id, To: to}},
+	})
+}
+
+// BurnCmd burn token
+func BurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn",
+		Short: "Create a transaction to burn token",
+		Run:   burn,
+	}
+	addTokenFlags(cmd)
+	return cmd
+}
+
+func burn(cmd *cobra.Command, args []string) {
+	collection, _ := cmd.Flags().GetString("collection")
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, nty.NftX, &nty.NftAction{
+		Ty:    nty.NftActionBurn,
+		Value: &nty.NftAction_Burn{Burn: &nty.NftBurn{Collection: collection, TokenID: id}},
+	})
+}
+
+// ApproveCmd approve token
+func ApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Create a transaction to approve address to transfer token",
+		Run:   approve,
+	}
+	addTokenFlags(cmd)
+	cmd.Flags().StringP("operator", "o", "", "approved address, empty to clear approval")
+	return cmd
+}
+
+func approve(cmd *cobra.Command, args []string) {
+	collection, _ := cmd.Flags().GetString("collection")
+	id, _ := cmd.Flags().GetString("id")
+	operator, _ := cmd.Flags().GetString("operator")
+	commandtypes.CreateActionTx(cmd, nty.NftX, &nty.NftAction{
+		Ty:    nty.NftActionApprove,
+		Value: &nty.NftAction_Approve{Approve: &nty.NftApprove{Collection: collection, TokenID: id, Operator: operator}},
+	})
+}
+
+// SetOperatorCmd set operator
+func SetOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set_operator",
+		Short: "Create a transaction to set operator of all tokens in collection",
+		Run:   setOperator,
+	}
+	cmd.Flags().StringP("collection", "c", "", "collection symbol")
+	cmd.MarkFlagRequired("collection")
+	cmd.Flags().StringP("operator", "o", "", "operator address")
+	cmd.MarkFlagRequired("operator")
+	cmd.Flags().BoolP("approved", "a", true, "approve or revoke operator")
+	return cmd
+}
+
+func setOperator(cmd *cobra.Command, args []string) {
+	collection, _ := cmd.Flags().GetString("collection")
+	operator, _ := cmd.Flags().GetString("operator")
+	approved, _ := cmd.Flags().GetBool("approved")
+	commandtypes.CreateActionTx(cmd, nty.NftX, &nty.NftAction{
+		Ty:    nty.NftActionSetOperator,
+		Value: &nty.NftAction_SetOperator{SetOperator: &nty.NftSetOperator{Collection: collection, Operator: operator, Approved: approved}},
+	})
+}
+
+func queryNft(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, nty.NftX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryCollectionCmd query collection
+func QueryCollectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection",
+		Short: "Query collection",
+		Run:   queryCollection,
+	}
+	cmd.Flags().StringP("collection", "c", "", "collection symbol")
+	cmd.MarkFlagRequired("collection")
+	return cmd
+}
+
+func queryCollection(cmd *cobra.Command, args []string) {
+	collection, _ := cmd.Flags().GetString("collection")
+	var res nty.NftCollection
+	queryNft(cmd, nty.FuncNameGetCollection, &types.ReqString{Data: collection}, &res)
+}
+
+// QueryTokenCmd query token
+func QueryTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Query token",
+		Run:   queryToken,
+	}
+	addTokenFlags(cmd)
+	return cmd
+}
+
+func queryToken(cmd *cobra.Command, args []string) {
+	collection, _ := cmd.Flags().GetString("collection")
+	id, _ := cmd.Flags().GetString("id")
+	var res nty.NftToken
+	queryNft(cmd, nty.FuncNameGetToken, &nty.ReqNftToken{Collection: collection, TokenID: id}, &res)
+}
+
+// ListTokensCmd list tokens
+func ListTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List tokens by owner or collection",
+		Run:   listTokens,
+	}
+	cmd.Flags().StringP("owner", "o", "", "owner address")
+	cmd.Flags().StringP("collection", "c", "", "collection symbol")
+	cmd.Flags().StringP("key", "k", "", "primary key returned by last list")
+	cmd.Flags().Int32P("count", "n", nty.DefaultListCount, "token count")
+	return cmd
+}
+
+func listTokens(cmd *cobra.Command, args []string) {
+	owner, _ := cmd.Flags().GetString("owner")
+	collection, _ := cmd.Flags().GetString("collection")
+	key, _ := cmd.Flags().GetString("key")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res nty.ReplyNftTokens
+	queryNft(cmd, nty.FuncNameListTokens, &nty.ReqNftTokens{Owner: owner, Collection: collection, PrimaryKey: key, Count: count}, &res)
+}
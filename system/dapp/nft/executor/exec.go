@@ -0,0 +1,46 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	nty "github.com/33cn/chain33/system/dapp/nft/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_CollectionCreate 创建集合
+func (n *Nft) Exec_CollectionCreate(payload *nty.NftCollectionCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(n, tx, index)
+	return action.collectionCreate(payload)
+}
+
+// Exec_Mint 铸造token
+func (n *Nft) Exec_Mint(payload *nty.NftMint, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(n, tx, index)
+	return action.mint(payload)
+}
+
+// Exec_Transfer 转让token
+func (n *Nft) Exec_Transfer(payload *nty.NftTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(n, tx, index)
+	return action.transfer(payload)
+}
+
+// Exec_Burn 销毁token
+func (n *Nft) Exec_Burn(payload *nty.NftBurn, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(n, tx, index)
+	return action.burn(payload)
+}
+
+// Exec_Approve 授权转让单个token
+func (n *Nft) Exec_Approve(payload *nty.NftApprove, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(n, tx, index)
+	return action.approve(payload)
+}
+
+// Exec_SetOperator 设置可以转让所有token的操作员
+func (n *Nft) Exec_SetOperator(payload *nty.NftSetOperator, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(n, tx, index)
+	return action.setOperator(payload)
+}
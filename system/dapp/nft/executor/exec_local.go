@@ -0,0 +1,64 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	nty "github.com/33cn/chain33/system/dapp/nft/types"
+	"github.com/33cn/chain33/types"
+)
+
+// ExecLocal_Mint 添加owner和集合的索引
+func (n *Nft) ExecLocal_Mint(payload *nty.NftMint, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return n.execLocal(tx, receipt)
+}
+
+// ExecLocal_Transfer 修改owner的索引
+func (n *Nft) ExecLocal_Transfer(payload *nty.NftTransfer, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return n.execLocal(tx, receipt)
+}
+
+// ExecLocal_Burn 删除owner和集合的索引
+func (n *Nft) ExecLocal_Burn(payload *nty.NftBurn, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return n.execLocal(tx, receipt)
+}
+
+//execLocal 按token的变化修改索引，回滚的时候恢复原来的索引
+func (n *Nft) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != nty.TyLogNftMint && item.Ty != nty.TyLogNftTransfer && item.Ty != nty.TyLogNftBurn {
+			continue
+		}
+		var log nty.ReceiptNftToken
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, tokenIndex(log.Prev, log.Current)...)
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+//tokenIndex owner变化的时候删除原来owner的索引，铸造和销毁的时候修改集合的索引
+func tokenIndex(prev, current *nty.NftToken) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	if prev != nil && (current == nil || prev.Owner != current.Owner) {
+		kvs = append(kvs, &types.KeyValue{Key: calcOwnerIndexKey(prev.Owner, prev.Collection, prev.TokenID)})
+	}
+	if prev != nil && current == nil {
+		kvs = append(kvs, &types.KeyValue{Key: calcCollectionIndexKey(prev.Collection, prev.TokenID)})
+	}
+	if current != nil && (prev == nil || prev.Owner != current.Owner) {
+		value := types.Encode(&nty.ReqNftToken{Collection: current.Collection, TokenID: current.TokenID})
+		kvs = append(kvs, &types.KeyValue{Key: calcOwnerIndexKey(current.Owner, current.Collection, current.TokenID), Value: value})
+	}
+	if prev == nil && current != nil {
+		value := types.Encode(&nty.ReqNftToken{Collection: current.Collection, TokenID: current.TokenID})
+		kvs = append(kvs, &types.KeyValue{Key: calcCollectionIndexKey(current.Collection, current.TokenID), Value: value})
+	}
+	return kvs
+}
@@ -0,0 +1,60 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor nft执行器，负责集合的创建，token的铸造，转让，销毁和授权
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	nty "github.com/33cn/chain33/system/dapp/nft/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.nft")
+	driverName = nty.NftX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Nft{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newNft, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return nft name
+func GetName() string {
+	return newNft().GetName()
+}
+
+// Nft defines Nft object
+type Nft struct {
+	drivers.DriverBase
+}
+
+func newNft() drivers.Driver {
+	n := &Nft{}
+	n.SetChild(n)
+	n.SetExecutorType(types.LoadExecutorType(driverName))
+	return n
+}
+
+// GetDriverName return a drivername
+func (n *Nft) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx check transaction
+func (n *Nft) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (n *Nft) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,120 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	nty "github.com/33cn/chain33/system/dapp/nft/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendNftTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) int32 {
+	_, detail, err := mock33.SendCallTx(priv, nty.NftX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+func queryNft(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(nty.NftX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func listTokenIDs(t *testing.T, mock33 *testnode.Chain33Mock, req *nty.ReqNftTokens) []string {
+	reply := queryNft(t, mock33, nty.FuncNameListTokens, req).(*nty.ReplyNftTokens)
+	var ids []string
+	for _, token := range reply.Tokens {
+		ids = append(ids, token.Collection+"/"+token.TokenID)
+	}
+	return ids
+}
+
+func TestNft(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	genesisAddr := mock33.GetGenesisAddress()
+	addr, priv := util.Genaddress()
+	operAddr, operPriv := util.Genaddress()
+	for _, to := range []string{addr, operAddr} {
+		mock33.SendTx(util.CreateCoinsTx(genesis, to, 10*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+
+	ty := sendNftTx(t, mock33, genesis, "CollectionCreate", &nty.NftCollectionCreate{Symbol: "art", Name: "art"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendNftTx(t, mock33, genesis, "CollectionCreate", &nty.NftCollectionCreate{Symbol: "ART", Name: "art"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendNftTx(t, mock33, priv, "CollectionCreate", &nty.NftCollectionCreate{Symbol: "ART"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendNftTx(t, mock33, priv, "CollectionCreate", &nty.NftCollectionCreate{Symbol: "GAME"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	//只有集合的创建者可以铸造
+	ty = sendNftTx(t, mock33, priv, "Mint", &nty.NftMint{Collection: "ART", TokenID: "1", To: addr})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	for _, id := range []string{"1", "2", "3"} {
+		ty = sendNftTx(t, mock33, genesis, "Mint", &nty.NftMint{Collection: "ART", TokenID: id, To: addr, Uri: "ipfs://" + id, ContentHash: []byte(id)})
+		assert.Equal(t, int32(types.ExecOk), ty)
+	}
+	ty = sendNftTx(t, mock33, genesis, "Mint", &nty.NftMint{Collection: "ART", TokenID: "1", To: addr})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendNftTx(t, mock33, priv, "Mint", &nty.NftMint{Collection: "GAME", TokenID: "sword", To: addr})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	collection := queryNft(t, mock33, nty.FuncNameGetCollection, &types.ReqString{Data: "ART"}).(*nty.NftCollection)
+	assert.Equal(t, int64(3), collection.Supply)
+	token := queryNft(t, mock33, nty.FuncNameGetToken, &nty.ReqNftToken{Collection: "ART", TokenID: "2"}).(*nty.NftToken)
+	assert.Equal(t, addr, token.Owner)
+	assert.Equal(t, "ipfs://2", token.Uri)
+
+	//不是owner不能转让
+	ty = sendNftTx(t, mock33, genesis, "Transfer", &nty.NftTransfer{Collection: "ART", TokenID: "1", To: genesisAddr})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendNftTx(t, mock33, priv, "Transfer", &nty.NftTransfer{Collection: "ART", TokenID: "1", To: genesisAddr})
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	//授权单个token，转让以后授权失效
+	ty = sendNftTx(t, mock33, priv, "Approve", &nty.NftApprove{Collection: "ART", TokenID: "2", Operator: operAddr})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendNftTx(t, mock33, operPriv, "Transfer", &nty.NftTransfer{Collection: "ART", TokenID: "2", To: operAddr})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	token = queryNft(t, mock33, nty.FuncNameGetToken, &nty.ReqNftToken{Collection: "ART", TokenID: "2"}).(*nty.NftToken)
+	assert.Equal(t, operAddr, token.Owner)
+	assert.Equal(t, "", token.Approved)
+
+	//操作员可以转让集合中owner的所有token
+	ty = sendNftTx(t, mock33, operPriv, "Burn", &nty.NftBurn{Collection: "ART", TokenID: "3"})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendNftTx(t, mock33, priv, "SetOperator", &nty.NftSetOperator{Collection: "ART", Operator: operAddr, Approved: true})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendNftTx(t, mock33, operPriv, "Burn", &nty.NftBurn{Collection: "ART", TokenID: "3"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	_, err := mock33.GetAPI().Query(nty.NftX, nty.FuncNameGetToken, &nty.ReqNftToken{Collection: "ART", TokenID: "3"})
+	assert.Equal(t, nty.ErrTokenNotExist, err)
+	//销毁的tokenID不能再铸造
+	ty = sendNftTx(t, mock33, genesis, "Mint", &nty.NftMint{Collection: "ART", TokenID: "3", To: addr})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	collection = queryNft(t, mock33, nty.FuncNameGetCollection, &types.ReqString{Data: "ART"}).(*nty.NftCollection)
+	assert.Equal(t, int64(2), collection.Supply)
+	assert.Equal(t, int64(3), collection.Minted)
+
+	assert.Equal(t, []string{"ART/1", "ART/2"}, listTokenIDs(t, mock33, &nty.ReqNftTokens{Collection: "ART"}))
+	assert.Equal(t, []string{"ART/1"}, listTokenIDs(t, mock33, &nty.ReqNftTokens{Owner: genesisAddr}))
+	assert.Equal(t, []string{"ART/2"}, listTokenIDs(t, mock33, &nty.ReqNftTokens{Owner: operAddr, Collection: "ART"}))
+	assert.Equal(t, []string{"GAME/sword"}, listTokenIDs(t, mock33, &nty.ReqNftTokens{Owner: addr}))
+
+	//分页
+	reply := queryNft(t, mock33, nty.FuncNameListTokens, &nty.ReqNftTokens{Collection: "ART", Count: 1}).(*nty.ReplyNftTokens)
+	assert.Equal(t, 1, len(reply.Tokens))
+	assert.Equal(t, []string{"ART/2"}, listTokenIDs(t, mock33, &nty.ReqNftTokens{Collection: "ART", PrimaryKey: reply.PrimaryKey}))
+}
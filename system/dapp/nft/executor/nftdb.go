@@ -0,0 +1,332 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	nty "github.com/33cn/chain33/system/dapp/nft/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	collectionKeyPrefix      = "mavl-" + nty.NftX + "-collection-"
+	tokenKeyPrefix           = "mavl-" + nty.NftX + "-token-"
+	operatorKeyPrefix        = "mavl-" + nty.NftX + "-operator-"
+	ownerIndexPrefix         = "LODB-" + nty.NftX + "-owner-"
+	collectionIndexKeyPrefix = "LODB-" + nty.NftX + "-collection-"
+)
+
+func calcCollectionKey(symbol string) []byte {
+	return []byte(collectionKeyPrefix + symbol)
+}
+
+func calcTokenKey(collection, tokenID string) []byte {
+	return []byte(tokenKeyPrefix + collection + "-" + tokenID)
+}
+
+func calcOperatorKey(collection, owner, operator string) []byte {
+	return []byte(operatorKeyPrefix + collection + "-" + owner + "-" + operator)
+}
+
+//calcOwnerIndexKey 集合的symbol不包括-，owner的索引按集合和tokenID排序
+func calcOwnerIndexKey(owner, collection, tokenID string) []byte {
+	return []byte(ownerIndexPrefix + owner + "-" + collection + "-" + tokenID)
+}
+
+func calcCollectionIndexKey(collection, tokenID string) []byte {
+	return []byte(collectionIndexKeyPrefix + collection + "-" + tokenID)
+}
+
+// Action nft交易的执行环境
+type Action struct {
+	db       dbm.KV
+	fromaddr string
+	height   int64
+	index    int
+}
+
+// NewAction new a action object
+func NewAction(n *Nft, tx *types.Transaction, index int) *Action {
+	return &Action{
+		db:       n.GetStateDB(),
+		fromaddr: tx.From(),
+		height:   n.GetHeight(),
+		index:    index,
+	}
+}
+
+func getCollection(db dbm.KV, symbol string) (*nty.NftCollection, error) {
+	value, err := db.Get(calcCollectionKey(symbol))
+	if err != nil || value == nil {
+		return nil, nty.ErrCollectionNotExist
+	}
+	var collection nty.NftCollection
+	err = types.Decode(value, &collection)
+	if err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+func getToken(db dbm.KV, collection, tokenID string) (*nty.NftToken, error) {
+	value, err := db.Get(calcTokenKey(collection, tokenID))
+	if err != nil || value == nil {
+		return nil, nty.ErrTokenNotExist
+	}
+	var token nty.NftToken
+	err = types.Decode(value, &token)
+	if err != nil {
+		return nil, err
+	}
+	//销毁的token没有owner
+	if token.Owner == "" {
+		return nil, nty.ErrTokenNotExist
+	}
+	return &token, nil
+}
+
+func getOperator(db dbm.KV, collection, owner, operator string) (*nty.NftOperator, error) {
+	value, err := db.Get(calcOperatorKey(collection, owner, operator))
+	if err != nil || value == nil {
+		return &nty.NftOperator{Collection: collection, Owner: owner, Operator: operator}, nil
+	}
+	var op nty.NftOperator
+	err = types.Decode(value, &op)
+	if err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
+
+//listTokens 本地数据库中的索引只记录tokenID，token从状态数据库中读取
+func listTokens(localdb dbm.KVDB, statedb dbm.KV, req *nty.ReqNftTokens) (*nty.ReplyNftTokens, error) {
+	var prefix string
+	switch {
+	case req.Owner != "" && req.Collection != "":
+		prefix = ownerIndexPrefix + req.Owner + "-" + req.Collection + "-"
+	case req.Owner != "":
+		prefix = ownerIndexPrefix + req.Owner + "-"
+	case req.Collection != "":
+		prefix = collectionIndexKeyPrefix + req.Collection + "-"
+	default:
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count <= 0 {
+		count = nty.DefaultListCount
+	}
+	if count > nty.MaxListCount {
+		count = nty.MaxListCount
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = []byte(prefix + req.PrimaryKey)
+		if req.Owner != "" && req.Collection == "" {
+			key = []byte(ownerIndexPrefix + req.Owner + "-" + req.PrimaryKey)
+		}
+	}
+	values, err := localdb.List([]byte(prefix), key, count, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &nty.ReplyNftTokens{}
+	for _, value := range values {
+		var index nty.ReqNftToken
+		if err := types.Decode(value, &index); err != nil {
+			return nil, err
+		}
+		token, err := getToken(statedb, index.Collection, index.TokenID)
+		if err != nil {
+			return nil, err
+		}
+		reply.Tokens = append(reply.Tokens, token)
+		reply.PrimaryKey = index.TokenID
+		if req.Owner != "" && req.Collection == "" {
+			reply.PrimaryKey = index.Collection + "-" + index.TokenID
+		}
+	}
+	return reply, nil
+}
+
+func checkSymbol(symbol string) bool {
+	if len(symbol) == 0 || len(symbol) > nty.MaxSymbolLength {
+		return false
+	}
+	for _, c := range symbol {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *Action) saveCollection(collection *nty.NftCollection) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcCollectionKey(collection.Symbol), Value: types.Encode(collection)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (a *Action) saveToken(token *nty.NftToken) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcTokenKey(token.Collection, token.TokenID), Value: types.Encode(token)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func tokenReceipt(ty int32, prev, current *nty.NftToken) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&nty.ReceiptNftToken{Prev: prev, Current: current})}
+}
+
+func (a *Action) collectionCreate(payload *nty.NftCollectionCreate) (*types.Receipt, error) {
+	if !checkSymbol(payload.Symbol) {
+		return nil, nty.ErrSymbol
+	}
+	if len(payload.Name) > nty.MaxDescriptionLength || len(payload.Description) > nty.MaxDescriptionLength {
+		return nil, nty.ErrDataTooLong
+	}
+	if _, err := getCollection(a.db, payload.Symbol); err == nil {
+		return nil, nty.ErrCollectionExist
+	}
+	collection := &nty.NftCollection{
+		Symbol:       payload.Symbol,
+		Name:         payload.Name,
+		Description:  payload.Description,
+		Creator:      a.fromaddr,
+		CreateHeight: a.height,
+	}
+	kv := []*types.KeyValue{a.saveCollection(collection)}
+	log := &types.ReceiptLog{Ty: nty.TyLogNftCollectionCreate, Log: types.Encode(&nty.ReceiptNftCollection{Current: collection})}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func (a *Action) mint(payload *nty.NftMint) (*types.Receipt, error) {
+	collection, err := getCollection(a.db, payload.Collection)
+	if err != nil {
+		return nil, err
+	}
+	if collection.Creator != a.fromaddr {
+		return nil, nty.ErrNotMinter
+	}
+	if len(payload.TokenID) == 0 || len(payload.TokenID) > nty.MaxTokenIDLength {
+		return nil, nty.ErrTokenID
+	}
+	if len(payload.Uri) > nty.MaxURILength || len(payload.ContentHash) > nty.MaxContentHashLength {
+		return nil, nty.ErrDataTooLong
+	}
+	if err := address.CheckAddress(payload.To); err != nil {
+		return nil, err
+	}
+	//销毁的tokenID也不能再铸造
+	if value, err := a.db.Get(calcTokenKey(payload.Collection, payload.TokenID)); err == nil && value != nil {
+		return nil, nty.ErrTokenExist
+	}
+	token := &nty.NftToken{
+		Collection:  payload.Collection,
+		TokenID:     payload.TokenID,
+		Owner:       payload.To,
+		Uri:         payload.Uri,
+		ContentHash: payload.ContentHash,
+		MintHeight:  a.height,
+	}
+	current := *collection
+	current.Supply++
+	current.Minted++
+	kv := []*types.KeyValue{a.saveToken(token), a.saveCollection(&current)}
+	logs := []*types.ReceiptLog{tokenReceipt(nty.TyLogNftMint, nil, token)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//checkAuthority owner，被授权转让这个token的地址或者owner的操作员
+func (a *Action) checkAuthority(token *nty.NftToken) error {
+	if token.Owner == a.fromaddr || (token.Approved != "" && token.Approved == a.fromaddr) {
+		return nil
+	}
+	op, err := getOperator(a.db, token.Collection, token.Owner, a.fromaddr)
+	if err != nil {
+		return err
+	}
+	if op.Approved {
+		return nil
+	}
+	return nty.ErrNotOwner
+}
+
+func (a *Action) transfer(payload *nty.NftTransfer) (*types.Receipt, error) {
+	token, err := getToken(a.db, payload.Collection, payload.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.checkAuthority(token); err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(payload.To); err != nil {
+		return nil, err
+	}
+	current := *token
+	current.Owner = payload.To
+	current.Approved = ""
+	kv := []*types.KeyValue{a.saveToken(&current)}
+	logs := []*types.ReceiptLog{tokenReceipt(nty.TyLogNftTransfer, token, &current)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) burn(payload *nty.NftBurn) (*types.Receipt, error) {
+	token, err := getToken(a.db, payload.Collection, payload.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.checkAuthority(token); err != nil {
+		return nil, err
+	}
+	collection, err := getCollection(a.db, token.Collection)
+	if err != nil {
+		return nil, err
+	}
+	//销毁以后保留没有owner的token，tokenID不能再铸造
+	current := *collection
+	current.Supply--
+	kv := []*types.KeyValue{a.saveToken(&nty.NftToken{Collection: token.Collection, TokenID: token.TokenID}), a.saveCollection(&current)}
+	logs := []*types.ReceiptLog{tokenReceipt(nty.TyLogNftBurn, token, nil)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) approve(payload *nty.NftApprove) (*types.Receipt, error) {
+	token, err := getToken(a.db, payload.Collection, payload.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	if token.Owner != a.fromaddr {
+		return nil, nty.ErrNotOwner
+	}
+	if payload.Operator != "" {
+		if err := address.CheckAddress(payload.Operator); err != nil {
+			return nil, err
+		}
+	}
+	current := *token
+	current.Approved = payload.Operator
+	kv := []*types.KeyValue{a.saveToken(&current)}
+	logs := []*types.ReceiptLog{tokenReceipt(nty.TyLogNftApprove, token, &current)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) setOperator(payload *nty.NftSetOperator) (*types.Receipt, error) {
+	if _, err := getCollection(a.db, payload.Collection); err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(payload.Operator); err != nil {
+		return nil, err
+	}
+	prev, err := getOperator(a.db, payload.Collection, a.fromaddr, payload.Operator)
+	if err != nil {
+		return nil, err
+	}
+	current := *prev
+	current.Approved = payload.Approved
+	kv := &types.KeyValue{Key: calcOperatorKey(current.Collection, current.Owner, current.Operator), Value: types.Encode(&current)}
+	a.db.Set(kv.Key, kv.Value)
+	log := &types.ReceiptLog{Ty: nty.TyLogNftSetOperator, Log: types.Encode(&nty.ReceiptNftOperator{Prev: prev, Current: &current})}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}, nil
+}
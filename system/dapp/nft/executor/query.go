@@ -0,0 +1,30 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	nty "github.com/33cn/chain33/system/dapp/nft/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetCollection 获取集合
+func (n *Nft) Query_GetCollection(in *types.ReqString) (types.Message, error) {
+	return getCollection(n.GetStateDB(), in.Data)
+}
+
+// Query_GetToken 获取token
+func (n *Nft) Query_GetToken(in *nty.ReqNftToken) (types.Message, error) {
+	return getToken(n.GetStateDB(), in.Collection, in.TokenID)
+}
+
+// Query_ListTokens 按owner或者集合列出token
+func (n *Nft) Query_ListTokens(in *nty.ReqNftTokens) (types.Message, error) {
+	return listTokens(n.GetLocalDB(), n.GetStateDB(), in)
+}
+
+// Query_GetOperator 获取owner是否设置了操作员
+func (n *Nft) Query_GetOperator(in *nty.ReqNftOperator) (types.Message, error) {
+	return getOperator(n.GetStateDB(), in.Collection, in.Owner, in.Operator)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package nft 非同质化token执行器插件
+// 1. 任何人都可以创建集合，集合的创建者铸造token，token带有uri和内容的hash
+// 2. token可以转让和销毁，owner可以授权其他地址转让单个token或者所有token
+// 3. 本地数据库按owner和集合索引token，支持列出owner或者集合的所有token
+package nft
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/nft/commands"
+	"github.com/33cn/chain33/system/dapp/nft/executor"
+	"github.com/33cn/chain33/system/dapp/nft/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.NftX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.NftCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// nft action ty
+const (
+	NftActionCollectionCreate = iota + 1
+	NftActionMint
+	NftActionTransfer
+	NftActionBurn
+	NftActionApprove
+	NftActionSetOperator
+)
+
+// nft log ty
+const (
+	TyLogNftCollectionCreate = 480
+	TyLogNftMint             = 481
+	TyLogNftTransfer         = 482
+	TyLogNftBurn             = 483
+	TyLogNftApprove          = 484
+	TyLogNftSetOperator      = 485
+)
+
+// query func name
+const (
+	FuncNameGetCollection = "GetCollection"
+	FuncNameGetToken      = "GetToken"
+	FuncNameListTokens    = "ListTokens"
+	FuncNameGetOperator   = "GetOperator"
+	MaxSymbolLength       = 16
+	MaxTokenIDLength      = 64
+	MaxURILength          = 256
+	MaxContentHashLength  = 64
+	MaxDescriptionLength  = 256
+	DefaultListCount      = 20
+	MaxListCount          = 100
+)
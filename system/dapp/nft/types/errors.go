@@ -0,0 +1,28 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrSymbol 集合的symbol只能是大写字母和数字
+	ErrSymbol = errors.New("ErrSymbol")
+	// ErrCollectionExist 集合已经存在
+	ErrCollectionExist = errors.New("ErrCollectionExist")
+	// ErrCollectionNotExist 集合不存在
+	ErrCollectionNotExist = errors.New("ErrCollectionNotExist")
+	// ErrTokenID tokenID不合法
+	ErrTokenID = errors.New("ErrTokenID")
+	// ErrTokenExist token已经存在
+	ErrTokenExist = errors.New("ErrTokenExist")
+	// ErrTokenNotExist token不存在
+	ErrTokenNotExist = errors.New("ErrTokenNotExist")
+	// ErrDataTooLong uri或者描述太长
+	ErrDataTooLong = errors.New("ErrDataTooLong")
+	// ErrNotMinter 只有集合的创建者可以铸造
+	ErrNotMinter = errors.New("ErrNotMinter")
+	// ErrNotOwner 不是token的owner，也没有被授权
+	ErrNotOwner = errors.New("ErrNotOwner")
+)
@@ -0,0 +1,1315 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: nft.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type NftAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*NftAction_CollectionCreate
+	//	*NftAction_Mint
+	//	*NftAction_Transfer
+	//	*NftAction_Burn
+	//	*NftAction_Approve
+	//	*NftAction_SetOperator
+	Value                isNftAction_Value `protobuf_oneof:"value"`
+	Ty                   int32             `protobuf:"varint,7,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *NftAction) Reset()         { *m = NftAction{} }
+func (m *NftAction) String() string { return proto.CompactTextString(m) }
+func (*NftAction) ProtoMessage()    {}
+func (*NftAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{0}
+}
+
+func (m *NftAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftAction.Unmarshal(m, b)
+}
+func (m *NftAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftAction.Marshal(b, m, deterministic)
+}
+func (m *NftAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftAction.Merge(m, src)
+}
+func (m *NftAction) XXX_Size() int {
+	return xxx_messageInfo_NftAction.Size(m)
+}
+func (m *NftAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftAction proto.InternalMessageInfo
+
+type isNftAction_Value interface {
+	isNftAction_Value()
+}
+
+type NftAction_CollectionCreate struct {
+	CollectionCreate *NftCollectionCreate `protobuf:"bytes,1,opt,name=collectionCreate,proto3,oneof"`
+}
+
+type NftAction_Mint struct {
+	Mint *NftMint `protobuf:"bytes,2,opt,name=mint,proto3,oneof"`
+}
+
+type NftAction_Transfer struct {
+	Transfer *NftTransfer `protobuf:"bytes,3,opt,name=transfer,proto3,oneof"`
+}
+
+type NftAction_Burn struct {
+	Burn *NftBurn `protobuf:"bytes,4,opt,name=burn,proto3,oneof"`
+}
+
+type NftAction_Approve struct {
+	Approve *NftApprove `protobuf:"bytes,5,opt,name=approve,proto3,oneof"`
+}
+
+type NftAction_SetOperator struct {
+	SetOperator *NftSetOperator `protobuf:"bytes,6,opt,name=setOperator,proto3,oneof"`
+}
+
+func (*NftAction_CollectionCreate) isNftAction_Value() {}
+
+func (*NftAction_Mint) isNftAction_Value() {}
+
+func (*NftAction_Transfer) isNftAction_Value() {}
+
+func (*NftAction_Burn) isNftAction_Value() {}
+
+func (*NftAction_Approve) isNftAction_Value() {}
+
+func (*NftAction_SetOperator) isNftAction_Value() {}
+
+func (m *NftAction) GetValue() isNftAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *NftAction) GetCollectionCreate() *NftCollectionCreate {
+	if x, ok := m.GetValue().(*NftAction_CollectionCreate); ok {
+		return x.CollectionCreate
+	}
+	return nil
+}
+
+func (m *NftAction) GetMint() *NftMint {
+	if x, ok := m.GetValue().(*NftAction_Mint); ok {
+		return x.Mint
+	}
+	return nil
+}
+
+func (m *NftAction) GetTransfer() *NftTransfer {
+	if x, ok := m.GetValue().(*NftAction_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *NftAction) GetBurn() *NftBurn {
+	if x, ok := m.GetValue().(*NftAction_Burn); ok {
+		return x.Burn
+	}
+	return nil
+}
+
+func (m *NftAction) GetApprove() *NftApprove {
+	if x, ok := m.GetValue().(*NftAction_Approve); ok {
+		return x.Approve
+	}
+	return nil
+}
+
+func (m *NftAction) GetSetOperator() *NftSetOperator {
+	if x, ok := m.GetValue().(*NftAction_SetOperator); ok {
+		return x.SetOperator
+	}
+	return nil
+}
+
+func (m *NftAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*NftAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _NftAction_OneofMarshaler, _NftAction_OneofUnmarshaler, _NftAction_OneofSizer, []interface{}{
+		(*NftAction_CollectionCreate)(nil),
+		(*NftAction_Mint)(nil),
+		(*NftAction_Transfer)(nil),
+		(*NftAction_Burn)(nil),
+		(*NftAction_Approve)(nil),
+		(*NftAction_SetOperator)(nil),
+	}
+}
+
+func _NftAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*NftAction)
+	// value
+	switch x := m.Value.(type) {
+	case *NftAction_CollectionCreate:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CollectionCreate); err != nil {
+			return err
+		}
+	case *NftAction_Mint:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Mint); err != nil {
+			return err
+		}
+	case *NftAction_Transfer:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Transfer); err != nil {
+			return err
+		}
+	case *NftAction_Burn:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Burn); err != nil {
+			return err
+		}
+	case *NftAction_Approve:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Approve); err != nil {
+			return err
+		}
+	case *NftAction_SetOperator:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SetOperator); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("NftAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _NftAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*NftAction)
+	switch tag {
+	case 1: // value.collectionCreate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(NftCollectionCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &NftAction_CollectionCreate{msg}
+		return true, err
+	case 2: // value.mint
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(NftMint)
+		err := b.DecodeMessage(msg)
+		m.Value = &NftAction_Mint{msg}
+		return true, err
+	case 3: // value.transfer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(NftTransfer)
+		err := b.DecodeMessage(msg)
+		m.Value = &NftAction_Transfer{msg}
+		return true, err
+	case 4: // value.burn
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(NftBurn)
+		err := b.DecodeMessage(msg)
+		m.Value = &NftAction_Burn{msg}
+		return true, err
+	case 5: // value.approve
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(NftApprove)
+		err := b.DecodeMessage(msg)
+		m.Value = &NftAction_Approve{msg}
+		return true, err
+	case 6: // value.setOperator
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(NftSetOperator)
+		err := b.DecodeMessage(msg)
+		m.Value = &NftAction_SetOperator{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _NftAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*NftAction)
+	// value
+	switch x := m.Value.(type) {
+	case *NftAction_CollectionCreate:
+		s := proto.Size(x.CollectionCreate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *NftAction_Mint:
+		s := proto.Size(x.Mint)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *NftAction_Transfer:
+		s := proto.Size(x.Transfer)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *NftAction_Burn:
+		s := proto.Size(x.Burn)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *NftAction_Approve:
+		s := proto.Size(x.Approve)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *NftAction_SetOperator:
+		s := proto.Size(x.SetOperator)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//创建集合，symbol只能是大写字母和数字，集合的创建者可以铸造
+type NftCollectionCreate struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftCollectionCreate) Reset()         { *m = NftCollectionCreate{} }
+func (m *NftCollectionCreate) String() string { return proto.CompactTextString(m) }
+func (*NftCollectionCreate) ProtoMessage()    {}
+func (*NftCollectionCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{1}
+}
+
+func (m *NftCollectionCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftCollectionCreate.Unmarshal(m, b)
+}
+func (m *NftCollectionCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftCollectionCreate.Marshal(b, m, deterministic)
+}
+func (m *NftCollectionCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftCollectionCreate.Merge(m, src)
+}
+func (m *NftCollectionCreate) XXX_Size() int {
+	return xxx_messageInfo_NftCollectionCreate.Size(m)
+}
+func (m *NftCollectionCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftCollectionCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftCollectionCreate proto.InternalMessageInfo
+
+func (m *NftCollectionCreate) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *NftCollectionCreate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NftCollectionCreate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+//铸造，tokenID在集合中唯一，contentHash是uri指向的内容的hash
+type NftMint struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Uri                  string   `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	ContentHash          []byte   `protobuf:"bytes,5,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftMint) Reset()         { *m = NftMint{} }
+func (m *NftMint) String() string { return proto.CompactTextString(m) }
+func (*NftMint) ProtoMessage()    {}
+func (*NftMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{2}
+}
+
+func (m *NftMint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftMint.Unmarshal(m, b)
+}
+func (m *NftMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftMint.Marshal(b, m, deterministic)
+}
+func (m *NftMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftMint.Merge(m, src)
+}
+func (m *NftMint) XXX_Size() int {
+	return xxx_messageInfo_NftMint.Size(m)
+}
+func (m *NftMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftMint proto.InternalMessageInfo
+
+func (m *NftMint) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *NftMint) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NftMint) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *NftMint) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *NftMint) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+//转让，owner，被授权的地址或者owner的操作员可以转让
+type NftTransfer struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftTransfer) Reset()         { *m = NftTransfer{} }
+func (m *NftTransfer) String() string { return proto.CompactTextString(m) }
+func (*NftTransfer) ProtoMessage()    {}
+func (*NftTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{3}
+}
+
+func (m *NftTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftTransfer.Unmarshal(m, b)
+}
+func (m *NftTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftTransfer.Marshal(b, m, deterministic)
+}
+func (m *NftTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftTransfer.Merge(m, src)
+}
+func (m *NftTransfer) XXX_Size() int {
+	return xxx_messageInfo_NftTransfer.Size(m)
+}
+func (m *NftTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftTransfer proto.InternalMessageInfo
+
+func (m *NftTransfer) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *NftTransfer) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NftTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+//销毁，owner，被授权的地址或者owner的操作员可以销毁
+type NftBurn struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftBurn) Reset()         { *m = NftBurn{} }
+func (m *NftBurn) String() string { return proto.CompactTextString(m) }
+func (*NftBurn) ProtoMessage()    {}
+func (*NftBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{4}
+}
+
+func (m *NftBurn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftBurn.Unmarshal(m, b)
+}
+func (m *NftBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftBurn.Marshal(b, m, deterministic)
+}
+func (m *NftBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftBurn.Merge(m, src)
+}
+func (m *NftBurn) XXX_Size() int {
+	return xxx_messageInfo_NftBurn.Size(m)
+}
+func (m *NftBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftBurn proto.InternalMessageInfo
+
+func (m *NftBurn) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *NftBurn) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+//授权一个地址转让指定的token，operator为空的时候取消授权，转让以后授权失效
+type NftApprove struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	Operator             string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftApprove) Reset()         { *m = NftApprove{} }
+func (m *NftApprove) String() string { return proto.CompactTextString(m) }
+func (*NftApprove) ProtoMessage()    {}
+func (*NftApprove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{5}
+}
+
+func (m *NftApprove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftApprove.Unmarshal(m, b)
+}
+func (m *NftApprove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftApprove.Marshal(b, m, deterministic)
+}
+func (m *NftApprove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftApprove.Merge(m, src)
+}
+func (m *NftApprove) XXX_Size() int {
+	return xxx_messageInfo_NftApprove.Size(m)
+}
+func (m *NftApprove) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftApprove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftApprove proto.InternalMessageInfo
+
+func (m *NftApprove) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *NftApprove) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NftApprove) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+//设置操作员，操作员可以转让owner在集合中的所有token
+type NftSetOperator struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Operator             string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Approved             bool     `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftSetOperator) Reset()         { *m = NftSetOperator{} }
+func (m *NftSetOperator) String() string { return proto.CompactTextString(m) }
+func (*NftSetOperator) ProtoMessage()    {}
+func (*NftSetOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{6}
+}
+
+func (m *NftSetOperator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftSetOperator.Unmarshal(m, b)
+}
+func (m *NftSetOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftSetOperator.Marshal(b, m, deterministic)
+}
+func (m *NftSetOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftSetOperator.Merge(m, src)
+}
+func (m *NftSetOperator) XXX_Size() int {
+	return xxx_messageInfo_NftSetOperator.Size(m)
+}
+func (m *NftSetOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftSetOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftSetOperator proto.InternalMessageInfo
+
+func (m *NftSetOperator) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *NftSetOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *NftSetOperator) GetApproved() bool {
+	if m != nil {
+		return m.Approved
+	}
+	return false
+}
+
+type NftCollection struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Creator              string   `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Supply               int64    `protobuf:"varint,5,opt,name=supply,proto3" json:"supply,omitempty"`
+	Minted               int64    `protobuf:"varint,6,opt,name=minted,proto3" json:"minted,omitempty"`
+	CreateHeight         int64    `protobuf:"varint,7,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftCollection) Reset()         { *m = NftCollection{} }
+func (m *NftCollection) String() string { return proto.CompactTextString(m) }
+func (*NftCollection) ProtoMessage()    {}
+func (*NftCollection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{7}
+}
+
+func (m *NftCollection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftCollection.Unmarshal(m, b)
+}
+func (m *NftCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftCollection.Marshal(b, m, deterministic)
+}
+func (m *NftCollection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftCollection.Merge(m, src)
+}
+func (m *NftCollection) XXX_Size() int {
+	return xxx_messageInfo_NftCollection.Size(m)
+}
+func (m *NftCollection) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftCollection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftCollection proto.InternalMessageInfo
+
+func (m *NftCollection) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *NftCollection) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NftCollection) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *NftCollection) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *NftCollection) GetSupply() int64 {
+	if m != nil {
+		return m.Supply
+	}
+	return 0
+}
+
+func (m *NftCollection) GetMinted() int64 {
+	if m != nil {
+		return m.Minted
+	}
+	return 0
+}
+
+func (m *NftCollection) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+type NftToken struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Uri                  string   `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	ContentHash          []byte   `protobuf:"bytes,5,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	Approved             string   `protobuf:"bytes,6,opt,name=approved,proto3" json:"approved,omitempty"`
+	MintHeight           int64    `protobuf:"varint,7,opt,name=mintHeight,proto3" json:"mintHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftToken) Reset()         { *m = NftToken{} }
+func (m *NftToken) String() string { return proto.CompactTextString(m) }
+func (*NftToken) ProtoMessage()    {}
+func (*NftToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{8}
+}
+
+func (m *NftToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftToken.Unmarshal(m, b)
+}
+func (m *NftToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftToken.Marshal(b, m, deterministic)
+}
+func (m *NftToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftToken.Merge(m, src)
+}
+func (m *NftToken) XXX_Size() int {
+	return xxx_messageInfo_NftToken.Size(m)
+}
+func (m *NftToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftToken proto.InternalMessageInfo
+
+func (m *NftToken) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *NftToken) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NftToken) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *NftToken) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *NftToken) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *NftToken) GetApproved() string {
+	if m != nil {
+		return m.Approved
+	}
+	return ""
+}
+
+func (m *NftToken) GetMintHeight() int64 {
+	if m != nil {
+		return m.MintHeight
+	}
+	return 0
+}
+
+type NftOperator struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Operator             string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Approved             bool     `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NftOperator) Reset()         { *m = NftOperator{} }
+func (m *NftOperator) String() string { return proto.CompactTextString(m) }
+func (*NftOperator) ProtoMessage()    {}
+func (*NftOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{9}
+}
+
+func (m *NftOperator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NftOperator.Unmarshal(m, b)
+}
+func (m *NftOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NftOperator.Marshal(b, m, deterministic)
+}
+func (m *NftOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NftOperator.Merge(m, src)
+}
+func (m *NftOperator) XXX_Size() int {
+	return xxx_messageInfo_NftOperator.Size(m)
+}
+func (m *NftOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_NftOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NftOperator proto.InternalMessageInfo
+
+func (m *NftOperator) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *NftOperator) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *NftOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *NftOperator) GetApproved() bool {
+	if m != nil {
+		return m.Approved
+	}
+	return false
+}
+
+type ReceiptNftCollection struct {
+	Prev                 *NftCollection `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *NftCollection `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReceiptNftCollection) Reset()         { *m = ReceiptNftCollection{} }
+func (m *ReceiptNftCollection) String() string { return proto.CompactTextString(m) }
+func (*ReceiptNftCollection) ProtoMessage()    {}
+func (*ReceiptNftCollection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{10}
+}
+
+func (m *ReceiptNftCollection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptNftCollection.Unmarshal(m, b)
+}
+func (m *ReceiptNftCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptNftCollection.Marshal(b, m, deterministic)
+}
+func (m *ReceiptNftCollection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptNftCollection.Merge(m, src)
+}
+func (m *ReceiptNftCollection) XXX_Size() int {
+	return xxx_messageInfo_ReceiptNftCollection.Size(m)
+}
+func (m *ReceiptNftCollection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptNftCollection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptNftCollection proto.InternalMessageInfo
+
+func (m *ReceiptNftCollection) GetPrev() *NftCollection {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptNftCollection) GetCurrent() *NftCollection {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+//铸造的时候prev为空，销毁的时候current为空
+type ReceiptNftToken struct {
+	Prev                 *NftToken `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *NftToken `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReceiptNftToken) Reset()         { *m = ReceiptNftToken{} }
+func (m *ReceiptNftToken) String() string { return proto.CompactTextString(m) }
+func (*ReceiptNftToken) ProtoMessage()    {}
+func (*ReceiptNftToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{11}
+}
+
+func (m *ReceiptNftToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptNftToken.Unmarshal(m, b)
+}
+func (m *ReceiptNftToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptNftToken.Marshal(b, m, deterministic)
+}
+func (m *ReceiptNftToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptNftToken.Merge(m, src)
+}
+func (m *ReceiptNftToken) XXX_Size() int {
+	return xxx_messageInfo_ReceiptNftToken.Size(m)
+}
+func (m *ReceiptNftToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptNftToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptNftToken proto.InternalMessageInfo
+
+func (m *ReceiptNftToken) GetPrev() *NftToken {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptNftToken) GetCurrent() *NftToken {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptNftOperator struct {
+	Prev                 *NftOperator `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *NftOperator `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptNftOperator) Reset()         { *m = ReceiptNftOperator{} }
+func (m *ReceiptNftOperator) String() string { return proto.CompactTextString(m) }
+func (*ReceiptNftOperator) ProtoMessage()    {}
+func (*ReceiptNftOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{12}
+}
+
+func (m *ReceiptNftOperator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptNftOperator.Unmarshal(m, b)
+}
+func (m *ReceiptNftOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptNftOperator.Marshal(b, m, deterministic)
+}
+func (m *ReceiptNftOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptNftOperator.Merge(m, src)
+}
+func (m *ReceiptNftOperator) XXX_Size() int {
+	return xxx_messageInfo_ReceiptNftOperator.Size(m)
+}
+func (m *ReceiptNftOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptNftOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptNftOperator proto.InternalMessageInfo
+
+func (m *ReceiptNftOperator) GetPrev() *NftOperator {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptNftOperator) GetCurrent() *NftOperator {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqNftToken struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqNftToken) Reset()         { *m = ReqNftToken{} }
+func (m *ReqNftToken) String() string { return proto.CompactTextString(m) }
+func (*ReqNftToken) ProtoMessage()    {}
+func (*ReqNftToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{13}
+}
+
+func (m *ReqNftToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqNftToken.Unmarshal(m, b)
+}
+func (m *ReqNftToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqNftToken.Marshal(b, m, deterministic)
+}
+func (m *ReqNftToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqNftToken.Merge(m, src)
+}
+func (m *ReqNftToken) XXX_Size() int {
+	return xxx_messageInfo_ReqNftToken.Size(m)
+}
+func (m *ReqNftToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqNftToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqNftToken proto.InternalMessageInfo
+
+func (m *ReqNftToken) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *ReqNftToken) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+//按owner或者集合列出token，按tokenID从小到大排列
+//   primaryKey : 从上一次返回的primaryKey之后开始列出，为空的时候从头开始
+type ReqNftTokens struct {
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqNftTokens) Reset()         { *m = ReqNftTokens{} }
+func (m *ReqNftTokens) String() string { return proto.CompactTextString(m) }
+func (*ReqNftTokens) ProtoMessage()    {}
+func (*ReqNftTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{14}
+}
+
+func (m *ReqNftTokens) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqNftTokens.Unmarshal(m, b)
+}
+func (m *ReqNftTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqNftTokens.Marshal(b, m, deterministic)
+}
+func (m *ReqNftTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqNftTokens.Merge(m, src)
+}
+func (m *ReqNftTokens) XXX_Size() int {
+	return xxx_messageInfo_ReqNftTokens.Size(m)
+}
+func (m *ReqNftTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqNftTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqNftTokens proto.InternalMessageInfo
+
+func (m *ReqNftTokens) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqNftTokens) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *ReqNftTokens) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqNftTokens) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyNftTokens struct {
+	Tokens               []*NftToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	PrimaryKey           string      `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReplyNftTokens) Reset()         { *m = ReplyNftTokens{} }
+func (m *ReplyNftTokens) String() string { return proto.CompactTextString(m) }
+func (*ReplyNftTokens) ProtoMessage()    {}
+func (*ReplyNftTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{15}
+}
+
+func (m *ReplyNftTokens) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyNftTokens.Unmarshal(m, b)
+}
+func (m *ReplyNftTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyNftTokens.Marshal(b, m, deterministic)
+}
+func (m *ReplyNftTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyNftTokens.Merge(m, src)
+}
+func (m *ReplyNftTokens) XXX_Size() int {
+	return xxx_messageInfo_ReplyNftTokens.Size(m)
+}
+func (m *ReplyNftTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyNftTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyNftTokens proto.InternalMessageInfo
+
+func (m *ReplyNftTokens) GetTokens() []*NftToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ReplyNftTokens) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type ReqNftOperator struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Operator             string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqNftOperator) Reset()         { *m = ReqNftOperator{} }
+func (m *ReqNftOperator) String() string { return proto.CompactTextString(m) }
+func (*ReqNftOperator) ProtoMessage()    {}
+func (*ReqNftOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eeacf31cf2574f3b, []int{16}
+}
+
+func (m *ReqNftOperator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqNftOperator.Unmarshal(m, b)
+}
+func (m *ReqNftOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqNftOperator.Marshal(b, m, deterministic)
+}
+func (m *ReqNftOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqNftOperator.Merge(m, src)
+}
+func (m *ReqNftOperator) XXX_Size() int {
+	return xxx_messageInfo_ReqNftOperator.Size(m)
+}
+func (m *ReqNftOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqNftOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqNftOperator proto.InternalMessageInfo
+
+func (m *ReqNftOperator) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *ReqNftOperator) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqNftOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NftAction)(nil), "types.NftAction")
+	proto.RegisterType((*NftCollectionCreate)(nil), "types.NftCollectionCreate")
+	proto.RegisterType((*NftMint)(nil), "types.NftMint")
+	proto.RegisterType((*NftTransfer)(nil), "types.NftTransfer")
+	proto.RegisterType((*NftBurn)(nil), "types.NftBurn")
+	proto.RegisterType((*NftApprove)(nil), "types.NftApprove")
+	proto.RegisterType((*NftSetOperator)(nil), "types.NftSetOperator")
+	proto.RegisterType((*NftCollection)(nil), "types.NftCollection")
+	proto.RegisterType((*NftToken)(nil), "types.NftToken")
+	proto.RegisterType((*NftOperator)(nil), "types.NftOperator")
+	proto.RegisterType((*ReceiptNftCollection)(nil), "types.ReceiptNftCollection")
+	proto.RegisterType((*ReceiptNftToken)(nil), "types.ReceiptNftToken")
+	proto.RegisterType((*ReceiptNftOperator)(nil), "types.ReceiptNftOperator")
+	proto.RegisterType((*ReqNftToken)(nil), "types.ReqNftToken")
+	proto.RegisterType((*ReqNftTokens)(nil), "types.ReqNftTokens")
+	proto.RegisterType((*ReplyNftTokens)(nil), "types.ReplyNftTokens")
+	proto.RegisterType((*ReqNftOperator)(nil), "types.ReqNftOperator")
+}
+
+func init() { proto.RegisterFile("nft.proto", fileDescriptor_eeacf31cf2574f3b) }
+
+var fileDescriptor_eeacf31cf2574f3b = []byte{
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xd4, 0x3a,
+	0x14, 0x9d, 0x64, 0xbe, 0xef, 0xf4, 0x4d, 0xfb, 0xfc, 0xfa, 0x9e, 0xa2, 0x2e, 0xaa, 0x91, 0x1f,
+	0x82, 0x22, 0xc1, 0x08, 0xc1, 0x8a, 0x65, 0x5b, 0x24, 0x82, 0x10, 0x45, 0x32, 0x48, 0x88, 0x65,
+	0x26, 0xf5, 0xd0, 0xc0, 0x8c, 0xed, 0x3a, 0x4e, 0x51, 0xe0, 0x07, 0xf0, 0xdf, 0x60, 0xcf, 0xef,
+	0x41, 0x76, 0x9c, 0xc4, 0x49, 0x47, 0x80, 0xda, 0xb2, 0xcb, 0xf5, 0x3d, 0x3e, 0xe7, 0xfa, 0xde,
+	0x63, 0xcf, 0xc0, 0x98, 0x2d, 0xd5, 0x5c, 0x48, 0xae, 0x38, 0xea, 0xab, 0x5c, 0xd0, 0x14, 0x7f,
+	0xf7, 0x61, 0x7c, 0xb2, 0x54, 0x87, 0xb1, 0x4a, 0x38, 0x43, 0x21, 0xec, 0xc4, 0x7c, 0xb5, 0xa2,
+	0x26, 0x3a, 0x96, 0x34, 0x52, 0x34, 0xf0, 0x66, 0xde, 0xc1, 0xe4, 0xe1, 0xde, 0xdc, 0xe0, 0xe7,
+	0x27, 0x4b, 0x75, 0xdc, 0x42, 0x84, 0x1d, 0x72, 0x69, 0x17, 0xba, 0x05, 0xbd, 0x75, 0xc2, 0x54,
+	0xe0, 0x9b, 0xdd, 0xd3, 0x7a, 0xf7, 0x8b, 0x84, 0xa9, 0xb0, 0x43, 0x4c, 0x16, 0x3d, 0x80, 0x91,
+	0x92, 0x11, 0x4b, 0x97, 0x54, 0x06, 0x5d, 0x83, 0x44, 0x35, 0xf2, 0xb5, 0xcd, 0x84, 0x1d, 0x52,
+	0xa1, 0x34, 0xef, 0x22, 0x93, 0x2c, 0xe8, 0xb5, 0x79, 0x8f, 0x32, 0xc9, 0x34, 0xaf, 0xce, 0xa2,
+	0xfb, 0x30, 0x8c, 0x84, 0x90, 0xfc, 0x82, 0x06, 0x7d, 0x03, 0xfc, 0xbb, 0x06, 0x1e, 0x16, 0x89,
+	0xb0, 0x43, 0x4a, 0x0c, 0x7a, 0x0c, 0x93, 0x94, 0xaa, 0x97, 0x82, 0xca, 0x48, 0x71, 0x19, 0x0c,
+	0xcc, 0x96, 0x7f, 0xeb, 0x2d, 0xaf, 0xea, 0x64, 0xd8, 0x21, 0x2e, 0x16, 0x4d, 0xc1, 0x57, 0x79,
+	0x30, 0x9c, 0x79, 0x07, 0x7d, 0xe2, 0xab, 0xfc, 0x68, 0x08, 0xfd, 0x8b, 0x68, 0x95, 0x51, 0x1c,
+	0xc3, 0x3f, 0x1b, 0x7a, 0x85, 0xfe, 0x83, 0x41, 0x9a, 0xaf, 0x17, 0x7c, 0x65, 0xfa, 0x3a, 0x26,
+	0x36, 0x42, 0x08, 0x7a, 0x2c, 0x5a, 0x53, 0xd3, 0xaf, 0x31, 0x31, 0xdf, 0x68, 0x06, 0x93, 0x53,
+	0x9a, 0xc6, 0x32, 0x11, 0x9a, 0xc0, 0x34, 0x68, 0x4c, 0xdc, 0x25, 0xfc, 0xc5, 0x83, 0xa1, 0xed,
+	0x29, 0xda, 0x07, 0xa8, 0xa7, 0x60, 0xd9, 0x9d, 0x15, 0x14, 0xc0, 0x50, 0xf1, 0x0f, 0x94, 0x3d,
+	0x7b, 0x62, 0x45, 0xca, 0xd0, 0x9c, 0x81, 0x5b, 0x7a, 0x5f, 0x71, 0xb4, 0x03, 0xdd, 0x4c, 0x26,
+	0xa6, 0xc5, 0x63, 0xa2, 0x3f, 0x75, 0x25, 0x31, 0x67, 0x8a, 0x32, 0x15, 0x46, 0xe9, 0x99, 0xe9,
+	0xe9, 0x16, 0x71, 0x97, 0xf0, 0x1b, 0x98, 0x38, 0x23, 0xbb, 0xb9, 0x62, 0xf0, 0xb1, 0x39, 0xa1,
+	0x9e, 0xee, 0xd5, 0x49, 0xf1, 0x02, 0xa0, 0x9e, 0xfc, 0x35, 0x8a, 0xdb, 0x83, 0x11, 0x2f, 0x5d,
+	0x52, 0x94, 0x58, 0xc5, 0xf8, 0x0c, 0xa6, 0x4d, 0xab, 0xfc, 0x52, 0xc7, 0x65, 0xf3, 0x9b, 0x6c,
+	0x3a, 0x67, 0xdd, 0x79, 0x6a, 0x94, 0x46, 0xa4, 0x8a, 0xf1, 0x37, 0x0f, 0xfe, 0x6a, 0x78, 0xeb,
+	0x66, 0x5d, 0xa5, 0xcf, 0x1f, 0x6b, 0xb7, 0x72, 0x69, 0x3d, 0x50, 0x86, 0x46, 0x27, 0x13, 0x62,
+	0x95, 0x1b, 0x0b, 0x74, 0x89, 0x8d, 0xf4, 0xba, 0xbe, 0xcf, 0xf4, 0xd4, 0xdc, 0x9d, 0x2e, 0xb1,
+	0x11, 0xc2, 0xb0, 0x15, 0x17, 0x6f, 0x04, 0x4d, 0xde, 0x9d, 0x29, 0x73, 0x4f, 0xba, 0xa4, 0xb1,
+	0x86, 0xbf, 0x7a, 0x30, 0xd2, 0xd6, 0xd1, 0x2d, 0xbe, 0xc6, 0x68, 0x76, 0xa1, 0xcf, 0x3f, 0x32,
+	0x5a, 0xce, 0xa5, 0x08, 0xae, 0x62, 0xe5, 0x46, 0xeb, 0x07, 0xc5, 0x58, 0xca, 0x58, 0xd7, 0xa7,
+	0x8f, 0xd6, 0x38, 0x8e, 0xb3, 0x82, 0x3f, 0x9b, 0x6b, 0xf0, 0xdb, 0x0e, 0xa8, 0x8a, 0xf6, 0xdd,
+	0xa2, 0x7f, 0xe2, 0xb2, 0x46, 0x71, 0xbd, 0x96, 0x2f, 0x04, 0xec, 0x12, 0x1a, 0xd3, 0x44, 0xa8,
+	0xa6, 0x3b, 0x0e, 0xa0, 0x27, 0x24, 0xbd, 0xb0, 0x2f, 0xf9, 0xee, 0xa6, 0x97, 0x9c, 0x18, 0x04,
+	0x9a, 0xc3, 0x30, 0xce, 0xa4, 0xa4, 0xd5, 0xc3, 0xbd, 0x19, 0x5c, 0x82, 0x70, 0x04, 0xdb, 0xb5,
+	0x62, 0x31, 0xc1, 0xff, 0x1b, 0x62, 0xdb, 0xce, 0x73, 0xae, 0xd3, 0x56, 0xe7, 0x6e, 0x5b, 0xe7,
+	0x12, 0xae, 0x92, 0x78, 0x0f, 0xa8, 0x96, 0xa8, 0x1a, 0x7b, 0xbb, 0xa1, 0xe2, 0xfc, 0x68, 0x94,
+	0x08, 0x2b, 0x74, 0xaf, 0x2d, 0xb4, 0x09, 0x5a, 0x69, 0x3d, 0x85, 0x09, 0xa1, 0xe7, 0xd7, 0x37,
+	0x23, 0xfe, 0x04, 0x5b, 0x0e, 0x51, 0x5a, 0xcf, 0xd9, 0x73, 0xe7, 0xdc, 0xe4, 0xf7, 0x2f, 0xf1,
+	0xef, 0x03, 0x08, 0x99, 0xac, 0x23, 0x99, 0x3f, 0xa7, 0xb9, 0x75, 0x82, 0xb3, 0xa2, 0x59, 0x63,
+	0x9e, 0x31, 0x65, 0x8c, 0xd0, 0x27, 0x45, 0x80, 0xdf, 0xc2, 0x94, 0x50, 0xb1, 0xca, 0x6b, 0xf5,
+	0x3b, 0x30, 0x30, 0x85, 0xa5, 0x81, 0x37, 0xeb, 0x6e, 0x6a, 0xb6, 0x4d, 0xb7, 0x04, 0xfd, 0xb6,
+	0x20, 0x5e, 0x68, 0xea, 0xf3, 0x3f, 0x6a, 0xf0, 0xc5, 0xc0, 0xfc, 0x3d, 0x79, 0xf4, 0x23, 0x00,
+	0x00, 0xff, 0xff, 0x02, 0xa6, 0xe0, 0xae, 0xab, 0x08, 0x00, 0x00,
+}
@@ -0,0 +1,71 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types nft插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// NftX 执行器名称
+	NftX       = "nft"
+	actionName = map[string]int32{
+		"CollectionCreate": NftActionCollectionCreate,
+		"Mint":             NftActionMint,
+		"Transfer":         NftActionTransfer,
+		"Burn":             NftActionBurn,
+		"Approve":          NftActionApprove,
+		"SetOperator":      NftActionSetOperator,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogNftCollectionCreate: {Ty: reflect.TypeOf(ReceiptNftCollection{}), Name: "LogNftCollectionCreate"},
+		TyLogNftMint:             {Ty: reflect.TypeOf(ReceiptNftToken{}), Name: "LogNftMint"},
+		TyLogNftTransfer:         {Ty: reflect.TypeOf(ReceiptNftToken{}), Name: "LogNftTransfer"},
+		TyLogNftBurn:             {Ty: reflect.TypeOf(ReceiptNftToken{}), Name: "LogNftBurn"},
+		TyLogNftApprove:          {Ty: reflect.TypeOf(ReceiptNftToken{}), Name: "LogNftApprove"},
+		TyLogNftSetOperator:      {Ty: reflect.TypeOf(ReceiptNftOperator{}), Name: "LogNftSetOperator"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(NftX))
+	types.RegistorExecutor(NftX, NewType())
+	types.RegisterDappFork(NftX, "Enable", 0)
+}
+
+// NftType nft执行器类型
+type NftType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a nft type object
+func NewType() *NftType {
+	c := &NftType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return nft action
+func (n *NftType) GetPayload() types.Message {
+	return &NftAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (n *NftType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (n *NftType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (n *NftType) GetName() string {
+	return NftX
+}
@@ -0,0 +1,159 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands oracle插件命令
+package commands
+
+import (
+	"time"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// OracleCmd oracle command
+func OracleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle",
+		Short: "Oracle data feed management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		FeedCreateCmd(),
+		PublishCmd(),
+		QueryFeedCmd(),
+		QueryRoundCmd(),
+		QueryAttestationCmd(),
+	)
+
+	return cmd
+}
+
+// FeedCreateCmd create feed
+func FeedCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feed_create",
+		Short: "Create a transaction to create data feed, only for manager",
+		Run:   feedCreate,
+	}
+	cmd.Flags().StringP("name", "n", "", "feed name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("rule", "r", oty.RuleMedian, "aggregation rule, median or quorum")
+	cmd.Flags().Int32P("quorum", "q", 1, "publishers required for one round")
+	cmd.Flags().StringP("desc", "d", "", "feed description")
+	return cmd
+}
+
+func feedCreate(cmd *cobra.Command, args []string) {
+	name, _ := cmd.Flags().GetString("name")
+	rule, _ := cmd.Flags().GetString("rule")
+	quorum, _ := cmd.Flags().GetInt32("quorum")
+	desc, _ := cmd.Flags().GetString("desc")
+	commandtypes.CreateActionTx(cmd, oty.OracleX, &oty.OracleAction{
+		Ty:    oty.OracleActionFeedCreate,
+		Value: &oty.OracleAction_FeedCreate{FeedCreate: &oty.OracleFeedCreate{Name: name, Rule: rule, Quorum: quorum, Description: desc}},
+	})
+}
+
+// PublishCmd publish data point
+func PublishCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish",
+		Short: "Create a transaction to publish data point of current round",
+		Run:   publish,
+	}
+	cmd.Flags().StringP("feed", "f", "", "feed name")
+	cmd.MarkFlagRequired("feed")
+	cmd.Flags().Int64P("round", "r", 0, "current round of feed")
+	cmd.MarkFlagRequired("round")
+	cmd.Flags().Int64P("value", "v", 0, "value for median feed")
+	cmd.Flags().StringP("data", "d", "", "data for quorum feed")
+	return cmd
+}
+
+func publish(cmd *cobra.Command, args []string) {
+	feed, _ := cmd.Flags().GetString("feed")
+	round, _ := cmd.Flags().GetInt64("round")
+	value, _ := cmd.Flags().GetInt64("value")
+	data, _ := cmd.Flags().GetString("data")
+	point := &oty.OracleDataPoint{Feed: feed, Round: round, Value: value, Data: data, Timestamp: time.Now().Unix()}
+	commandtypes.CreateActionTx(cmd, oty.OracleX, &oty.OracleAction{
+		Ty:    oty.OracleActionPublish,
+		Value: &oty.OracleAction_Publish{Publish: &oty.OraclePublish{Point: point}},
+	})
+}
+
+func queryOracle(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, oty.OracleX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryFeedCmd query feed
+func QueryFeedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feed",
+		Short: "Query data feed and latest attestation",
+		Run:   queryFeed,
+	}
+	cmd.Flags().StringP("feed", "f", "", "feed name")
+	cmd.MarkFlagRequired("feed")
+	return cmd
+}
+
+func queryFeed(cmd *cobra.Command, args []string) {
+	feed, _ := cmd.Flags().GetString("feed")
+	var res oty.OracleFeed
+	queryOracle(cmd, oty.FuncNameGetFeed, &types.ReqString{Data: feed}, &res)
+}
+
+// QueryRoundCmd query current round
+func QueryRoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "round",
+		Short: "Query submissions of current round",
+		Run:   queryRound,
+	}
+	cmd.Flags().StringP("feed", "f", "", "feed name")
+	cmd.MarkFlagRequired("feed")
+	return cmd
+}
+
+func queryRound(cmd *cobra.Command, args []string) {
+	feed, _ := cmd.Flags().GetString("feed")
+	var res oty.OracleRound
+	queryOracle(cmd, oty.FuncNameGetRound, &types.ReqString{Data: feed}, &res)
+}
+
+// QueryAttestationCmd query attestation
+func QueryAttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestation",
+		Short: "Query aggregated value of round",
+		Run:   queryAttestation,
+	}
+	cmd.Flags().StringP("feed", "f", "", "feed name")
+	cmd.MarkFlagRequired("feed")
+	cmd.Flags().Int64P("round", "r", 0, "round, 0 for latest")
+	return cmd
+}
+
+func queryAttestation(cmd *cobra.Command, args []string) {
+	feed, _ := cmd.Flags().GetString("feed")
+	round, _ := cmd.Flags().GetInt64("round")
+	var res oty.OracleAttestation
+	queryOracle(cmd, oty.FuncNameGetAttestation, &oty.ReqOracleAttestation{Feed: feed, Round: round}, &res)
+}
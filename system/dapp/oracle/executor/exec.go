@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_FeedCreate 创建数据源
+func (o *Oracle) Exec_FeedCreate(payload *oty.OracleFeedCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(o, tx, index)
+	return action.feedCreate(payload)
+}
+
+// Exec_Publish 提交数据，达到发布者数量要求的时候聚合
+func (o *Oracle) Exec_Publish(payload *oty.OraclePublish, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(o, tx, index)
+	return action.publish(payload)
+}
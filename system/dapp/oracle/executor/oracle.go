@@ -0,0 +1,85 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor oracle执行器，负责数据源的创建，发布者数据的提交和聚合
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.oracle")
+	driverName = oty.OracleX
+	manageConf = types.ConfSub("manage")
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Oracle{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newOracle, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return oracle name
+func GetName() string {
+	return newOracle().GetName()
+}
+
+// Oracle defines Oracle object
+type Oracle struct {
+	drivers.DriverBase
+}
+
+func newOracle() drivers.Driver {
+	o := &Oracle{}
+	o.SetChild(o)
+	o.SetExecutorType(types.LoadExecutorType(driverName))
+	return o
+}
+
+// GetDriverName return a drivername
+func (o *Oracle) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx 检查转发的数据的签名
+func (o *Oracle) CheckTx(tx *types.Transaction, index int) error {
+	var action oty.OracleAction
+	if err := types.Decode(tx.Payload, &action); err != nil {
+		return err
+	}
+	if action.Ty != oty.OracleActionPublish {
+		return nil
+	}
+	point := action.GetPublish().GetPoint()
+	if point == nil {
+		return types.ErrInvalidParam
+	}
+	if point.Signature != nil && !types.CheckSign(oty.SignData(point), "", point.Signature) {
+		return oty.ErrSignature
+	}
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (o *Oracle) CheckReceiptExecOk() bool {
+	return true
+}
+
+//isManager manage合约的超级管理员可以创建数据源
+func isManager(addr string) bool {
+	for _, m := range manageConf.GStrList("superManager") {
+		if addr == m {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, execer, action string, param types.Message) int32 {
+	_, detail, err := mock33.SendCallTx(priv, execer, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+func publish(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, point *oty.OracleDataPoint) int32 {
+	return sendTx(t, mock33, priv, oty.OracleX, "Publish", &oty.OraclePublish{Point: point})
+}
+
+func queryOracle(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(oty.OracleX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func TestOracle(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	//manage合约的超级管理员
+	manager := util.TestPrivkeyList[0]
+	var addrs []string
+	var privs []crypto.PrivKey
+	for i := 0; i < 3; i++ {
+		addr, priv := util.Genaddress()
+		addrs = append(addrs, addr)
+		privs = append(privs, priv)
+	}
+	for _, addr := range append(addrs, address.PubKeyToAddress(manager.PubKey().Bytes()).String()) {
+		mock33.SendTx(util.CreateCoinsTx(genesis, addr, 10*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+
+	ty := sendTx(t, mock33, genesis, oty.OracleX, "FeedCreate", &oty.OracleFeedCreate{Name: "BTC-USD", Rule: oty.RuleMedian, Quorum: 3})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendTx(t, mock33, manager, oty.OracleX, "FeedCreate", &oty.OracleFeedCreate{Name: "BTC-USD", Rule: oty.RuleMedian, Quorum: 3})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendTx(t, mock33, manager, oty.OracleX, "FeedCreate", &oty.OracleFeedCreate{Name: "ELECTION", Rule: oty.RuleQuorum, Quorum: 2})
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	//没有在manage合约中配置的发布者不能提交
+	ty = publish(t, mock33, privs[0], &oty.OracleDataPoint{Feed: "BTC-USD", Round: 1, Value: 100})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	for _, addr := range addrs {
+		ty = sendTx(t, mock33, manager, "manage", "Modify", &types.ModifyConfig{Key: oty.PublisherKey, Value: addr, Op: "add"})
+		assert.Equal(t, int32(types.ExecOk), ty)
+	}
+
+	ty = publish(t, mock33, privs[0], &oty.OracleDataPoint{Feed: "BTC-USD", Round: 1, Value: 100})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = publish(t, mock33, privs[0], &oty.OracleDataPoint{Feed: "BTC-USD", Round: 1, Value: 110})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = publish(t, mock33, privs[1], &oty.OracleDataPoint{Feed: "BTC-USD", Round: 2, Value: 300})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = publish(t, mock33, privs[1], &oty.OracleDataPoint{Feed: "BTC-USD", Round: 1, Value: 300})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	round := queryOracle(t, mock33, oty.FuncNameGetRound, &types.ReqString{Data: "BTC-USD"}).(*oty.OracleRound)
+	assert.Equal(t, 2, len(round.Submissions))
+	_, err := mock33.GetAPI().Query(oty.OracleX, oty.FuncNameGetAttestation, &oty.ReqOracleAttestation{Feed: "BTC-USD"})
+	assert.Equal(t, oty.ErrAttestationNotExist, err)
+
+	//其他人转发发布者签名的数据
+	point := &oty.OracleDataPoint{Feed: "BTC-USD", Round: 1, Value: 200, Timestamp: 1}
+	point.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: privs[2].PubKey().Bytes(), Signature: privs[2].Sign(oty.SignData(point)).Bytes()}
+	ty = publish(t, mock33, genesis, point)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	attestation := queryOracle(t, mock33, oty.FuncNameGetAttestation, &oty.ReqOracleAttestation{Feed: "BTC-USD"}).(*oty.OracleAttestation)
+	assert.Equal(t, int64(200), attestation.Value)
+	assert.Equal(t, int64(1), attestation.Round)
+	assert.Equal(t, addrs, attestation.Publishers)
+	feed := queryOracle(t, mock33, oty.FuncNameGetFeed, &types.ReqString{Data: "BTC-USD"}).(*oty.OracleFeed)
+	assert.Equal(t, int64(2), feed.Round)
+	assert.Equal(t, attestation, feed.Latest)
+
+	//相同的数据达到quorum
+	ty = publish(t, mock33, privs[0], &oty.OracleDataPoint{Feed: "ELECTION", Round: 1, Data: "A"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = publish(t, mock33, privs[1], &oty.OracleDataPoint{Feed: "ELECTION", Round: 1, Data: "B"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = publish(t, mock33, privs[2], &oty.OracleDataPoint{Feed: "ELECTION", Round: 1, Data: "A"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	attestation = queryOracle(t, mock33, oty.FuncNameGetAttestation, &oty.ReqOracleAttestation{Feed: "ELECTION", Round: 1}).(*oty.OracleAttestation)
+	assert.Equal(t, "A", attestation.Data)
+	assert.Equal(t, []string{addrs[0], addrs[2]}, attestation.Publishers)
+}
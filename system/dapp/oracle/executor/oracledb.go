@@ -0,0 +1,278 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	feedKeyPrefix        = "mavl-" + oty.OracleX + "-feed-"
+	roundKeyPrefix       = "mavl-" + oty.OracleX + "-round-"
+	attestationKeyPrefix = "mavl-" + oty.OracleX + "-attestation-"
+)
+
+func calcFeedKey(name string) []byte {
+	return []byte(feedKeyPrefix + name)
+}
+
+func calcRoundKey(name string, round int64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%018d", roundKeyPrefix, name, round))
+}
+
+func calcAttestationKey(name string, round int64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%018d", attestationKeyPrefix, name, round))
+}
+
+// Action oracle交易的执行环境
+type Action struct {
+	db       dbm.KV
+	fromaddr string
+	height   int64
+	index    int
+}
+
+// NewAction new a action object
+func NewAction(o *Oracle, tx *types.Transaction, index int) *Action {
+	return &Action{
+		db:       o.GetStateDB(),
+		fromaddr: tx.From(),
+		height:   o.GetHeight(),
+		index:    index,
+	}
+}
+
+//getPublishers manage合约中配置的发布者，和manage合约一样先读新的key
+func getPublishers(db dbm.KV, height int64) ([]string, error) {
+	value, err := db.Get([]byte(types.ManageKey(oty.PublisherKey)))
+	if err != nil || value == nil {
+		value, err = db.Get([]byte(types.ConfigKey(oty.PublisherKey)))
+	}
+	if err != nil || value == nil {
+		return nil, nil
+	}
+	var item types.ConfigItem
+	err = types.Decode(value, &item)
+	if err != nil {
+		return nil, err
+	}
+	return types.GetConfigValues(&item, height), nil
+}
+
+func getFeed(db dbm.KV, name string) (*oty.OracleFeed, error) {
+	value, err := db.Get(calcFeedKey(name))
+	if err != nil || value == nil {
+		return nil, oty.ErrFeedNotExist
+	}
+	var feed oty.OracleFeed
+	err = types.Decode(value, &feed)
+	if err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+func getRound(db dbm.KV, name string, round int64) (*oty.OracleRound, error) {
+	value, err := db.Get(calcRoundKey(name, round))
+	if err != nil || value == nil {
+		return &oty.OracleRound{Feed: name, Round: round}, nil
+	}
+	var r oty.OracleRound
+	err = types.Decode(value, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func getAttestation(db dbm.KV, name string, round int64) (*oty.OracleAttestation, error) {
+	value, err := db.Get(calcAttestationKey(name, round))
+	if err != nil || value == nil {
+		return nil, oty.ErrAttestationNotExist
+	}
+	var attestation oty.OracleAttestation
+	err = types.Decode(value, &attestation)
+	if err != nil {
+		return nil, err
+	}
+	return &attestation, nil
+}
+
+// GetLatestAttestation 获取数据源最新的聚合结果，其他执行器可以用自己的状态数据库读取
+func GetLatestAttestation(db dbm.KV, name string) (*oty.OracleAttestation, error) {
+	feed, err := getFeed(db, name)
+	if err != nil {
+		return nil, err
+	}
+	if feed.Latest == nil {
+		return nil, oty.ErrAttestationNotExist
+	}
+	return feed.Latest, nil
+}
+
+func contains(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+//aggregate 只统计当前还是发布者的数据，达到数量要求的时候按规则聚合，否则返回nil
+func aggregate(feed *oty.OracleFeed, round *oty.OracleRound, publishers []string) *oty.OracleAttestation {
+	var subs []*oty.OracleSubmission
+	for _, sub := range round.Submissions {
+		if contains(publishers, sub.Publisher) {
+			subs = append(subs, sub)
+		}
+	}
+	if len(subs) < int(feed.Quorum) {
+		return nil
+	}
+	attestation := &oty.OracleAttestation{Feed: feed.Name, Round: round.Round}
+	switch feed.Rule {
+	case oty.RuleMedian:
+		values := make([]int64, len(subs))
+		for i, sub := range subs {
+			values[i] = sub.Value
+			attestation.Publishers = append(attestation.Publishers, sub.Publisher)
+		}
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+		mid := len(values) / 2
+		attestation.Value = values[mid]
+		if len(values)%2 == 0 {
+			attestation.Value = values[mid-1] + (values[mid]-values[mid-1])/2
+		}
+		return attestation
+	case oty.RuleQuorum:
+		//按提交的顺序找到第一个有quorum个发布者相同的数据
+		for _, sub := range subs {
+			var same []string
+			for _, other := range subs {
+				if other.Value == sub.Value && other.Data == sub.Data {
+					same = append(same, other.Publisher)
+				}
+			}
+			if len(same) >= int(feed.Quorum) {
+				attestation.Value = sub.Value
+				attestation.Data = sub.Data
+				attestation.Publishers = same
+				return attestation
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Action) saveFeed(feed *oty.OracleFeed) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcFeedKey(feed.Name), Value: types.Encode(feed)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (a *Action) feedCreate(payload *oty.OracleFeedCreate) (*types.Receipt, error) {
+	if !isManager(a.fromaddr) {
+		return nil, types.ErrNotAllow
+	}
+	if len(payload.Name) == 0 || len(payload.Name) > oty.MaxFeedNameLength {
+		return nil, oty.ErrFeedName
+	}
+	if payload.Rule != oty.RuleMedian && payload.Rule != oty.RuleQuorum {
+		return nil, oty.ErrRule
+	}
+	if payload.Quorum <= 0 || payload.Quorum > oty.MaxQuorum {
+		return nil, oty.ErrQuorum
+	}
+	if len(payload.Description) > oty.MaxDataLength {
+		return nil, oty.ErrDataTooLong
+	}
+	if _, err := getFeed(a.db, payload.Name); err == nil {
+		return nil, oty.ErrFeedExist
+	}
+	feed := &oty.OracleFeed{
+		Name:        payload.Name,
+		Rule:        payload.Rule,
+		Quorum:      payload.Quorum,
+		Description: payload.Description,
+		Creator:     a.fromaddr,
+		Round:       1,
+	}
+	kv := []*types.KeyValue{a.saveFeed(feed)}
+	log := &types.ReceiptLog{Ty: oty.TyLogOracleFeedCreate, Log: types.Encode(&oty.ReceiptOracleFeed{Current: feed})}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func (a *Action) publish(payload *oty.OraclePublish) (*types.Receipt, error) {
+	point := payload.Point
+	if point == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if len(point.Data) > oty.MaxDataLength {
+		return nil, oty.ErrDataTooLong
+	}
+	//转发的数据由签名的发布者提交
+	publisher := a.fromaddr
+	if point.Signature != nil {
+		if !types.CheckSign(oty.SignData(point), "", point.Signature) {
+			return nil, oty.ErrSignature
+		}
+		publisher = address.PubKeyToAddr(types.SignPubKey(oty.SignData(point), "", point.Signature))
+	}
+	publishers, err := getPublishers(a.db, a.height)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(publishers, publisher) {
+		return nil, oty.ErrNotPublisher
+	}
+	feed, err := getFeed(a.db, point.Feed)
+	if err != nil {
+		return nil, err
+	}
+	if point.Round != feed.Round {
+		return nil, oty.ErrRound
+	}
+	round, err := getRound(a.db, feed.Name, feed.Round)
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range round.Submissions {
+		if sub.Publisher == publisher {
+			return nil, oty.ErrPublished
+		}
+	}
+	sub := &oty.OracleSubmission{
+		Publisher: publisher,
+		Value:     point.Value,
+		Data:      point.Data,
+		Timestamp: point.Timestamp,
+		Height:    a.height,
+	}
+	round.Submissions = append(round.Submissions, sub)
+	roundKV := &types.KeyValue{Key: calcRoundKey(feed.Name, feed.Round), Value: types.Encode(round)}
+	a.db.Set(roundKV.Key, roundKV.Value)
+	kv := []*types.KeyValue{roundKV}
+	logs := []*types.ReceiptLog{{Ty: oty.TyLogOraclePublish, Log: types.Encode(&oty.ReceiptOraclePublish{Feed: feed.Name, Round: feed.Round, Submission: sub})}}
+
+	attestation := aggregate(feed, round, publishers)
+	if attestation != nil {
+		attestation.Height = a.height
+		attestKV := &types.KeyValue{Key: calcAttestationKey(feed.Name, attestation.Round), Value: types.Encode(attestation)}
+		a.db.Set(attestKV.Key, attestKV.Value)
+		current := *feed
+		current.Round++
+		current.Latest = attestation
+		kv = append(kv, attestKV, a.saveFeed(&current))
+		logs = append(logs, &types.ReceiptLog{Ty: oty.TyLogOracleAttest, Log: types.Encode(&oty.ReceiptOracleAttestation{Attestation: attestation})})
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
@@ -0,0 +1,32 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetFeed 获取数据源以及最新的聚合结果
+func (o *Oracle) Query_GetFeed(in *types.ReqString) (types.Message, error) {
+	return getFeed(o.GetStateDB(), in.Data)
+}
+
+// Query_GetRound 获取正在进行的一轮已经提交的数据
+func (o *Oracle) Query_GetRound(in *types.ReqString) (types.Message, error) {
+	feed, err := getFeed(o.GetStateDB(), in.Data)
+	if err != nil {
+		return nil, err
+	}
+	return getRound(o.GetStateDB(), feed.Name, feed.Round)
+}
+
+// Query_GetAttestation 获取指定一轮的聚合结果，round为0的时候获取最新的
+func (o *Oracle) Query_GetAttestation(in *oty.ReqOracleAttestation) (types.Message, error) {
+	if in.Round == 0 {
+		return GetLatestAttestation(o.GetStateDB(), in.Feed)
+	}
+	return getAttestation(o.GetStateDB(), in.Feed, in.Round)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package oracle 链下数据预言机执行器插件
+// 1. manage合约的超级管理员创建数据源，设置聚合规则
+// 2. manage合约中配置的发布者按轮提交签名的数据
+// 3. 达到发布者数量要求的时候聚合数据，其他执行器可以读取最新的聚合结果
+package oracle
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/oracle/commands"
+	"github.com/33cn/chain33/system/dapp/oracle/executor"
+	"github.com/33cn/chain33/system/dapp/oracle/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.OracleX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.OracleCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// oracle action ty
+const (
+	OracleActionFeedCreate = iota + 1
+	OracleActionPublish
+)
+
+// oracle log ty
+const (
+	TyLogOracleFeedCreate = 460
+	TyLogOraclePublish    = 461
+	TyLogOracleAttest     = 462
+)
+
+// aggregation rule
+const (
+	RuleMedian = "median"
+	RuleQuorum = "quorum"
+)
+
+// PublisherKey manage合约中配置的数据发布者列表
+const PublisherKey = "oracle-publishers"
+
+// query func name
+const (
+	FuncNameGetFeed        = "GetFeed"
+	FuncNameGetRound       = "GetRound"
+	FuncNameGetAttestation = "GetAttestation"
+	MaxFeedNameLength      = 64
+	MaxDataLength          = 256
+	MaxQuorum              = 64
+)
@@ -0,0 +1,32 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrFeedExist 数据源已经存在
+	ErrFeedExist = errors.New("ErrFeedExist")
+	// ErrFeedNotExist 数据源不存在
+	ErrFeedNotExist = errors.New("ErrFeedNotExist")
+	// ErrFeedName 数据源名称不合法
+	ErrFeedName = errors.New("ErrFeedName")
+	// ErrRule 聚合规则只支持median和quorum
+	ErrRule = errors.New("ErrRule")
+	// ErrQuorum 发布者数量不合法
+	ErrQuorum = errors.New("ErrQuorum")
+	// ErrNotPublisher 不是manage合约中配置的发布者
+	ErrNotPublisher = errors.New("ErrNotPublisher")
+	// ErrRound 只能提交正在进行的一轮的数据
+	ErrRound = errors.New("ErrRound")
+	// ErrPublished 发布者在这一轮已经提交过数据
+	ErrPublished = errors.New("ErrPublished")
+	// ErrSignature 数据的签名不正确
+	ErrSignature = errors.New("ErrSignature")
+	// ErrDataTooLong 数据太长
+	ErrDataTooLong = errors.New("ErrDataTooLong")
+	// ErrAttestationNotExist 还没有聚合结果
+	ErrAttestationNotExist = errors.New("ErrAttestationNotExist")
+)
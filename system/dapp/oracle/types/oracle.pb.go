@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: oracle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	types "github.com/33cn/chain33/types"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type OracleAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*OracleAction_FeedCreate
+	//	*OracleAction_Publish
+	Value                isOracleAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OracleAction) Reset()         { *m = OracleAction{} }
+func (m *OracleAction) String() string { return proto.CompactTextString(m) }
+func (*OracleAction) ProtoMessage()    {}
+func (*OracleAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{0}
+}
+
+func (m *OracleAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleAction.Unmarshal(m, b)
+}
+func (m *OracleAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleAction.Marshal(b, m, deterministic)
+}
+func (m *OracleAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleAction.Merge(m, src)
+}
+func (m *OracleAction) XXX_Size() int {
+	return xxx_messageInfo_OracleAction.Size(m)
+}
+func (m *OracleAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleAction proto.InternalMessageInfo
+
+type isOracleAction_Value interface {
+	isOracleAction_Value()
+}
+
+type OracleAction_FeedCreate struct {
+	FeedCreate *OracleFeedCreate `protobuf:"bytes,1,opt,name=feedCreate,proto3,oneof"`
+}
+
+type OracleAction_Publish struct {
+	Publish *OraclePublish `protobuf:"bytes,2,opt,name=publish,proto3,oneof"`
+}
+
+func (*OracleAction_FeedCreate) isOracleAction_Value() {}
+
+func (*OracleAction_Publish) isOracleAction_Value() {}
+
+func (m *OracleAction) GetValue() isOracleAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *OracleAction) GetFeedCreate() *OracleFeedCreate {
+	if x, ok := m.GetValue().(*OracleAction_FeedCreate); ok {
+		return x.FeedCreate
+	}
+	return nil
+}
+
+func (m *OracleAction) GetPublish() *OraclePublish {
+	if x, ok := m.GetValue().(*OracleAction_Publish); ok {
+		return x.Publish
+	}
+	return nil
+}
+
+func (m *OracleAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*OracleAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _OracleAction_OneofMarshaler, _OracleAction_OneofUnmarshaler, _OracleAction_OneofSizer, []interface{}{
+		(*OracleAction_FeedCreate)(nil),
+		(*OracleAction_Publish)(nil),
+	}
+}
+
+func _OracleAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*OracleAction)
+	// value
+	switch x := m.Value.(type) {
+	case *OracleAction_FeedCreate:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FeedCreate); err != nil {
+			return err
+		}
+	case *OracleAction_Publish:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Publish); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("OracleAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _OracleAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*OracleAction)
+	switch tag {
+	case 1: // value.feedCreate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(OracleFeedCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &OracleAction_FeedCreate{msg}
+		return true, err
+	case 2: // value.publish
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(OraclePublish)
+		err := b.DecodeMessage(msg)
+		m.Value = &OracleAction_Publish{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _OracleAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*OracleAction)
+	// value
+	switch x := m.Value.(type) {
+	case *OracleAction_FeedCreate:
+		s := proto.Size(x.FeedCreate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *OracleAction_Publish:
+		s := proto.Size(x.Publish)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//创建数据源，只有manage合约的超级管理员可以创建
+//   rule : 聚合规则，median取中位数，quorum取至少quorum个发布者相同的data
+//   quorum : 一轮需要的发布者数量
+type OracleFeedCreate struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rule                 string   `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Quorum               int32    `protobuf:"varint,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OracleFeedCreate) Reset()         { *m = OracleFeedCreate{} }
+func (m *OracleFeedCreate) String() string { return proto.CompactTextString(m) }
+func (*OracleFeedCreate) ProtoMessage()    {}
+func (*OracleFeedCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{1}
+}
+
+func (m *OracleFeedCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleFeedCreate.Unmarshal(m, b)
+}
+func (m *OracleFeedCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleFeedCreate.Marshal(b, m, deterministic)
+}
+func (m *OracleFeedCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleFeedCreate.Merge(m, src)
+}
+func (m *OracleFeedCreate) XXX_Size() int {
+	return xxx_messageInfo_OracleFeedCreate.Size(m)
+}
+func (m *OracleFeedCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleFeedCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleFeedCreate proto.InternalMessageInfo
+
+func (m *OracleFeedCreate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OracleFeedCreate) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *OracleFeedCreate) GetQuorum() int32 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+func (m *OracleFeedCreate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+//发布者的数据，可以由发布者自己发送交易，也可以由其他人转发发布者签名的数据
+type OracleDataPoint struct {
+	Feed                 string           `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	Round                int64            `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Value                int64            `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Data                 string           `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp            int64            `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature            *types.Signature `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *OracleDataPoint) Reset()         { *m = OracleDataPoint{} }
+func (m *OracleDataPoint) String() string { return proto.CompactTextString(m) }
+func (*OracleDataPoint) ProtoMessage()    {}
+func (*OracleDataPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{2}
+}
+
+func (m *OracleDataPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleDataPoint.Unmarshal(m, b)
+}
+func (m *OracleDataPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleDataPoint.Marshal(b, m, deterministic)
+}
+func (m *OracleDataPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleDataPoint.Merge(m, src)
+}
+func (m *OracleDataPoint) XXX_Size() int {
+	return xxx_messageInfo_OracleDataPoint.Size(m)
+}
+func (m *OracleDataPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleDataPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleDataPoint proto.InternalMessageInfo
+
+func (m *OracleDataPoint) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *OracleDataPoint) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *OracleDataPoint) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *OracleDataPoint) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *OracleDataPoint) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *OracleDataPoint) GetSignature() *types.Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type OraclePublish struct {
+	Point                *OracleDataPoint `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *OraclePublish) Reset()         { *m = OraclePublish{} }
+func (m *OraclePublish) String() string { return proto.CompactTextString(m) }
+func (*OraclePublish) ProtoMessage()    {}
+func (*OraclePublish) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{3}
+}
+
+func (m *OraclePublish) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OraclePublish.Unmarshal(m, b)
+}
+func (m *OraclePublish) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OraclePublish.Marshal(b, m, deterministic)
+}
+func (m *OraclePublish) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePublish.Merge(m, src)
+}
+func (m *OraclePublish) XXX_Size() int {
+	return xxx_messageInfo_OraclePublish.Size(m)
+}
+func (m *OraclePublish) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePublish.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePublish proto.InternalMessageInfo
+
+func (m *OraclePublish) GetPoint() *OracleDataPoint {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+type OracleSubmission struct {
+	Publisher            string   `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Value                int64    `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Data                 string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OracleSubmission) Reset()         { *m = OracleSubmission{} }
+func (m *OracleSubmission) String() string { return proto.CompactTextString(m) }
+func (*OracleSubmission) ProtoMessage()    {}
+func (*OracleSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{4}
+}
+
+func (m *OracleSubmission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleSubmission.Unmarshal(m, b)
+}
+func (m *OracleSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleSubmission.Marshal(b, m, deterministic)
+}
+func (m *OracleSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleSubmission.Merge(m, src)
+}
+func (m *OracleSubmission) XXX_Size() int {
+	return xxx_messageInfo_OracleSubmission.Size(m)
+}
+func (m *OracleSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleSubmission proto.InternalMessageInfo
+
+func (m *OracleSubmission) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
+	}
+	return ""
+}
+
+func (m *OracleSubmission) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *OracleSubmission) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *OracleSubmission) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *OracleSubmission) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+//一轮的聚合结果
+type OracleAttestation struct {
+	Feed                 string   `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	Round                int64    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Value                int64    `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Data                 string   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Publishers           []string `protobuf:"bytes,5,rep,name=publishers,proto3" json:"publishers,omitempty"`
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OracleAttestation) Reset()         { *m = OracleAttestation{} }
+func (m *OracleAttestation) String() string { return proto.CompactTextString(m) }
+func (*OracleAttestation) ProtoMessage()    {}
+func (*OracleAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{5}
+}
+
+func (m *OracleAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleAttestation.Unmarshal(m, b)
+}
+func (m *OracleAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleAttestation.Marshal(b, m, deterministic)
+}
+func (m *OracleAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleAttestation.Merge(m, src)
+}
+func (m *OracleAttestation) XXX_Size() int {
+	return xxx_messageInfo_OracleAttestation.Size(m)
+}
+func (m *OracleAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleAttestation proto.InternalMessageInfo
+
+func (m *OracleAttestation) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *OracleAttestation) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *OracleAttestation) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *OracleAttestation) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *OracleAttestation) GetPublishers() []string {
+	if m != nil {
+		return m.Publishers
+	}
+	return nil
+}
+
+func (m *OracleAttestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type OracleFeed struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rule                 string             `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Quorum               int32              `protobuf:"varint,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Description          string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Creator              string             `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Round                int64              `protobuf:"varint,6,opt,name=round,proto3" json:"round,omitempty"`
+	Latest               *OracleAttestation `protobuf:"bytes,7,opt,name=latest,proto3" json:"latest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *OracleFeed) Reset()         { *m = OracleFeed{} }
+func (m *OracleFeed) String() string { return proto.CompactTextString(m) }
+func (*OracleFeed) ProtoMessage()    {}
+func (*OracleFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{6}
+}
+
+func (m *OracleFeed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleFeed.Unmarshal(m, b)
+}
+func (m *OracleFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleFeed.Marshal(b, m, deterministic)
+}
+func (m *OracleFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleFeed.Merge(m, src)
+}
+func (m *OracleFeed) XXX_Size() int {
+	return xxx_messageInfo_OracleFeed.Size(m)
+}
+func (m *OracleFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleFeed proto.InternalMessageInfo
+
+func (m *OracleFeed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OracleFeed) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *OracleFeed) GetQuorum() int32 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+func (m *OracleFeed) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *OracleFeed) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *OracleFeed) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *OracleFeed) GetLatest() *OracleAttestation {
+	if m != nil {
+		return m.Latest
+	}
+	return nil
+}
+
+//正在进行的一轮提交的数据
+type OracleRound struct {
+	Feed                 string              `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	Round                int64               `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Submissions          []*OracleSubmission `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *OracleRound) Reset()         { *m = OracleRound{} }
+func (m *OracleRound) String() string { return proto.CompactTextString(m) }
+func (*OracleRound) ProtoMessage()    {}
+func (*OracleRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{7}
+}
+
+func (m *OracleRound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleRound.Unmarshal(m, b)
+}
+func (m *OracleRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleRound.Marshal(b, m, deterministic)
+}
+func (m *OracleRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleRound.Merge(m, src)
+}
+func (m *OracleRound) XXX_Size() int {
+	return xxx_messageInfo_OracleRound.Size(m)
+}
+func (m *OracleRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleRound proto.InternalMessageInfo
+
+func (m *OracleRound) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *OracleRound) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *OracleRound) GetSubmissions() []*OracleSubmission {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+type ReceiptOracleFeed struct {
+	Prev                 *OracleFeed `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *OracleFeed `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReceiptOracleFeed) Reset()         { *m = ReceiptOracleFeed{} }
+func (m *ReceiptOracleFeed) String() string { return proto.CompactTextString(m) }
+func (*ReceiptOracleFeed) ProtoMessage()    {}
+func (*ReceiptOracleFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{8}
+}
+
+func (m *ReceiptOracleFeed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptOracleFeed.Unmarshal(m, b)
+}
+func (m *ReceiptOracleFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptOracleFeed.Marshal(b, m, deterministic)
+}
+func (m *ReceiptOracleFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptOracleFeed.Merge(m, src)
+}
+func (m *ReceiptOracleFeed) XXX_Size() int {
+	return xxx_messageInfo_ReceiptOracleFeed.Size(m)
+}
+func (m *ReceiptOracleFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptOracleFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptOracleFeed proto.InternalMessageInfo
+
+func (m *ReceiptOracleFeed) GetPrev() *OracleFeed {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptOracleFeed) GetCurrent() *OracleFeed {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptOraclePublish struct {
+	Feed                 string            `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	Round                int64             `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Submission           *OracleSubmission `protobuf:"bytes,3,opt,name=submission,proto3" json:"submission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptOraclePublish) Reset()         { *m = ReceiptOraclePublish{} }
+func (m *ReceiptOraclePublish) String() string { return proto.CompactTextString(m) }
+func (*ReceiptOraclePublish) ProtoMessage()    {}
+func (*ReceiptOraclePublish) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{9}
+}
+
+func (m *ReceiptOraclePublish) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptOraclePublish.Unmarshal(m, b)
+}
+func (m *ReceiptOraclePublish) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptOraclePublish.Marshal(b, m, deterministic)
+}
+func (m *ReceiptOraclePublish) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptOraclePublish.Merge(m, src)
+}
+func (m *ReceiptOraclePublish) XXX_Size() int {
+	return xxx_messageInfo_ReceiptOraclePublish.Size(m)
+}
+func (m *ReceiptOraclePublish) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptOraclePublish.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptOraclePublish proto.InternalMessageInfo
+
+func (m *ReceiptOraclePublish) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *ReceiptOraclePublish) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ReceiptOraclePublish) GetSubmission() *OracleSubmission {
+	if m != nil {
+		return m.Submission
+	}
+	return nil
+}
+
+type ReceiptOracleAttestation struct {
+	Attestation          *OracleAttestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReceiptOracleAttestation) Reset()         { *m = ReceiptOracleAttestation{} }
+func (m *ReceiptOracleAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReceiptOracleAttestation) ProtoMessage()    {}
+func (*ReceiptOracleAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{10}
+}
+
+func (m *ReceiptOracleAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptOracleAttestation.Unmarshal(m, b)
+}
+func (m *ReceiptOracleAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptOracleAttestation.Marshal(b, m, deterministic)
+}
+func (m *ReceiptOracleAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptOracleAttestation.Merge(m, src)
+}
+func (m *ReceiptOracleAttestation) XXX_Size() int {
+	return xxx_messageInfo_ReceiptOracleAttestation.Size(m)
+}
+func (m *ReceiptOracleAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptOracleAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptOracleAttestation proto.InternalMessageInfo
+
+func (m *ReceiptOracleAttestation) GetAttestation() *OracleAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+//round为0的时候返回最新的聚合结果
+type ReqOracleAttestation struct {
+	Feed                 string   `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	Round                int64    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqOracleAttestation) Reset()         { *m = ReqOracleAttestation{} }
+func (m *ReqOracleAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReqOracleAttestation) ProtoMessage()    {}
+func (*ReqOracleAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{11}
+}
+
+func (m *ReqOracleAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqOracleAttestation.Unmarshal(m, b)
+}
+func (m *ReqOracleAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqOracleAttestation.Marshal(b, m, deterministic)
+}
+func (m *ReqOracleAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqOracleAttestation.Merge(m, src)
+}
+func (m *ReqOracleAttestation) XXX_Size() int {
+	return xxx_messageInfo_ReqOracleAttestation.Size(m)
+}
+func (m *ReqOracleAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqOracleAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqOracleAttestation proto.InternalMessageInfo
+
+func (m *ReqOracleAttestation) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *ReqOracleAttestation) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OracleAction)(nil), "types.OracleAction")
+	proto.RegisterType((*OracleFeedCreate)(nil), "types.OracleFeedCreate")
+	proto.RegisterType((*OracleDataPoint)(nil), "types.OracleDataPoint")
+	proto.RegisterType((*OraclePublish)(nil), "types.OraclePublish")
+	proto.RegisterType((*OracleSubmission)(nil), "types.OracleSubmission")
+	proto.RegisterType((*OracleAttestation)(nil), "types.OracleAttestation")
+	proto.RegisterType((*OracleFeed)(nil), "types.OracleFeed")
+	proto.RegisterType((*OracleRound)(nil), "types.OracleRound")
+	proto.RegisterType((*ReceiptOracleFeed)(nil), "types.ReceiptOracleFeed")
+	proto.RegisterType((*ReceiptOraclePublish)(nil), "types.ReceiptOraclePublish")
+	proto.RegisterType((*ReceiptOracleAttestation)(nil), "types.ReceiptOracleAttestation")
+	proto.RegisterType((*ReqOracleAttestation)(nil), "types.ReqOracleAttestation")
+}
+
+func init() { proto.RegisterFile("oracle.proto", fileDescriptor_b544994cdab50f02) }
+
+var fileDescriptor_b544994cdab50f02 = []byte{
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0x8a, 0xdb, 0x3c,
+	0x10, 0x5d, 0xc7, 0xb1, 0x83, 0x27, 0xfb, 0x7d, 0xdd, 0x88, 0x90, 0x8a, 0xb2, 0x94, 0x20, 0x28,
+	0x04, 0x5a, 0xc2, 0xd2, 0x5e, 0x94, 0x2d, 0x14, 0xfa, 0x47, 0xd9, 0xbb, 0x2e, 0x5a, 0xe8, 0xbd,
+	0xe2, 0xa8, 0x89, 0x21, 0xb1, 0xbd, 0x92, 0xbc, 0x90, 0xb7, 0xe8, 0x45, 0xef, 0xfb, 0x10, 0x7d,
+	0x91, 0x3e, 0x52, 0xd1, 0x58, 0x8e, 0xe5, 0xfd, 0x29, 0x0b, 0x65, 0xef, 0x34, 0xa3, 0x23, 0xcf,
+	0x9c, 0x33, 0x47, 0x32, 0x1c, 0x16, 0x4a, 0xa4, 0x1b, 0x39, 0x2f, 0x55, 0x61, 0x0a, 0x12, 0x99,
+	0x5d, 0x29, 0xf5, 0x93, 0x91, 0x51, 0x22, 0xd7, 0x22, 0x35, 0x59, 0x91, 0xd7, 0x3b, 0xec, 0x47,
+	0x00, 0x87, 0x5f, 0x10, 0xfa, 0x1e, 0xd3, 0xe4, 0x14, 0xe0, 0x9b, 0x94, 0xcb, 0x8f, 0x4a, 0x0a,
+	0x23, 0x69, 0x30, 0x0d, 0x66, 0xc3, 0x97, 0x8f, 0xe7, 0x78, 0x7e, 0x5e, 0x03, 0x3f, 0xef, 0xb7,
+	0xcf, 0x0e, 0xb8, 0x07, 0x26, 0x27, 0x30, 0x28, 0xab, 0xc5, 0x26, 0xd3, 0x6b, 0xda, 0xc3, 0x73,
+	0xe3, 0xce, 0xb9, 0xf3, 0x7a, 0xef, 0xec, 0x80, 0x37, 0x30, 0xf2, 0x3f, 0xf4, 0xcc, 0x8e, 0x86,
+	0xd3, 0x60, 0x16, 0xf1, 0x9e, 0xd9, 0x7d, 0x18, 0x40, 0x74, 0x25, 0x36, 0x95, 0x64, 0x06, 0x8e,
+	0xae, 0x17, 0x23, 0x04, 0xfa, 0xb9, 0xd8, 0xd6, 0x3d, 0x25, 0x1c, 0xd7, 0x36, 0xa7, 0xaa, 0x8d,
+	0xc4, 0x7a, 0x09, 0xc7, 0x35, 0x99, 0x40, 0x7c, 0x59, 0x15, 0xaa, 0xda, 0xba, 0x0f, 0xbb, 0x88,
+	0x4c, 0x61, 0xb8, 0x94, 0x3a, 0x55, 0x59, 0x69, 0x89, 0xd2, 0x3e, 0x1e, 0xf1, 0x53, 0xec, 0x57,
+	0x00, 0x8f, 0xea, 0xb2, 0x9f, 0x84, 0x11, 0xe7, 0x45, 0x96, 0x1b, 0x5b, 0xc1, 0x52, 0x6c, 0xaa,
+	0xda, 0x35, 0x19, 0x43, 0xa4, 0x8a, 0x2a, 0x5f, 0x62, 0xd9, 0x90, 0xd7, 0x81, 0xcd, 0x62, 0xf3,
+	0x58, 0x36, 0xe4, 0x75, 0x60, 0xcf, 0x2f, 0x85, 0x11, 0xae, 0x1c, 0xae, 0xc9, 0x31, 0x24, 0x26,
+	0xdb, 0x4a, 0x6d, 0xc4, 0xb6, 0xa4, 0x11, 0xa2, 0xdb, 0x04, 0x99, 0x43, 0xa2, 0xb3, 0x55, 0x2e,
+	0x4c, 0xa5, 0x24, 0x8d, 0x51, 0xc8, 0x23, 0x27, 0xe4, 0x45, 0x93, 0xe7, 0x2d, 0x84, 0xbd, 0x85,
+	0xff, 0x3a, 0x02, 0x93, 0x17, 0x10, 0x95, 0xb6, 0x77, 0x37, 0xbd, 0x49, 0x67, 0x0a, 0x7b, 0x66,
+	0xbc, 0x06, 0xb1, 0xef, 0x41, 0xa3, 0xf5, 0x45, 0xb5, 0xd8, 0x66, 0x5a, 0x5b, 0x17, 0x1c, 0x43,
+	0xe2, 0x66, 0x24, 0x95, 0xa3, 0xde, 0x26, 0x5a, 0xa6, 0xbd, 0xdb, 0x98, 0x86, 0x77, 0x31, 0xed,
+	0x5f, 0x67, 0x3a, 0x81, 0x78, 0x2d, 0xb3, 0xd5, 0xda, 0x38, 0x11, 0x5c, 0xc4, 0x7e, 0x06, 0x30,
+	0x72, 0xa6, 0x34, 0xc6, 0x62, 0xd1, 0x99, 0x0f, 0x31, 0x89, 0xa7, 0x00, 0x7b, 0x5a, 0x9a, 0x46,
+	0xd3, 0x70, 0x96, 0x70, 0x2f, 0xe3, 0x75, 0x18, 0x77, 0x3a, 0xfc, 0x1d, 0x00, 0xb4, 0x06, 0x7d,
+	0x78, 0x6b, 0x12, 0x0a, 0x83, 0xd4, 0x5e, 0x83, 0x42, 0xa1, 0x56, 0x09, 0x6f, 0xc2, 0x56, 0x82,
+	0xd8, 0x97, 0xe0, 0x04, 0xe2, 0x8d, 0xb0, 0xda, 0xd1, 0x01, 0x9a, 0x80, 0x76, 0x4c, 0xe0, 0xc9,
+	0xca, 0x1d, 0x8e, 0x29, 0x18, 0xd6, 0x9b, 0x1c, 0x3f, 0x70, 0x7f, 0xb5, 0x4f, 0x61, 0xa8, 0xf7,
+	0xce, 0xd1, 0x34, 0x9c, 0x86, 0x37, 0x9e, 0x8c, 0xd6, 0x59, 0xdc, 0xc7, 0xb2, 0x15, 0x8c, 0xb8,
+	0x4c, 0x65, 0x56, 0x1a, 0x4f, 0xcc, 0x67, 0xd0, 0x2f, 0x95, 0xbc, 0x72, 0xee, 0x1d, 0xdd, 0x78,
+	0x7b, 0x38, 0x6e, 0x93, 0xe7, 0x30, 0x48, 0x2b, 0xa5, 0x64, 0x6e, 0xdc, 0x6b, 0x73, 0x0b, 0xb2,
+	0x41, 0xb0, 0x1d, 0x8c, 0x3b, 0x85, 0x9a, 0xab, 0x72, 0x7f, 0x96, 0xaf, 0x01, 0xda, 0xce, 0x71,
+	0x7c, 0x7f, 0x21, 0xe9, 0x41, 0xd9, 0x57, 0xa0, 0x9d, 0xd2, 0xbe, 0xa5, 0xdf, 0xc0, 0x50, 0xb4,
+	0xa1, 0x63, 0x7c, 0xf7, 0xa8, 0x7c, 0x30, 0x7b, 0x67, 0x29, 0x5d, 0xfe, 0xc3, 0x35, 0x59, 0xc4,
+	0xf8, 0x0b, 0x78, 0xf5, 0x27, 0x00, 0x00, 0xff, 0xff, 0xde, 0x6f, 0x29, 0xfa, 0x2c, 0x06, 0x00,
+	0x00,
+}
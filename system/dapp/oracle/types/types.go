@@ -0,0 +1,71 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types oracle插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// OracleX 执行器名称
+	OracleX    = "oracle"
+	actionName = map[string]int32{
+		"FeedCreate": OracleActionFeedCreate,
+		"Publish":    OracleActionPublish,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogOracleFeedCreate: {Ty: reflect.TypeOf(ReceiptOracleFeed{}), Name: "LogOracleFeedCreate"},
+		TyLogOraclePublish:    {Ty: reflect.TypeOf(ReceiptOraclePublish{}), Name: "LogOraclePublish"},
+		TyLogOracleAttest:     {Ty: reflect.TypeOf(ReceiptOracleAttestation{}), Name: "LogOracleAttest"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(OracleX))
+	types.RegistorExecutor(OracleX, NewType())
+	types.RegisterDappFork(OracleX, "Enable", 0)
+}
+
+// OracleType oracle执行器类型
+type OracleType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a oracle type object
+func NewType() *OracleType {
+	c := &OracleType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return oracle action
+func (o *OracleType) GetPayload() types.Message {
+	return &OracleAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (o *OracleType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (o *OracleType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (o *OracleType) GetName() string {
+	return OracleX
+}
+
+//SignData 发布者签名的数据，不包括签名
+func SignData(point *OracleDataPoint) []byte {
+	data := *point
+	data.Signature = nil
+	return types.Encode(&data)
+}
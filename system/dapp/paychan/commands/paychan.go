@@ -0,0 +1,293 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands paychan插件命令
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	pty "github.com/33cn/chain33/system/dapp/paychan/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// PaychanCmd paychan command
+func PaychanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paychan",
+		Short: "Payment channel management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		OpenCmd(),
+		DepositCmd(),
+		SignStateCmd(),
+		CloseCmd(),
+		ChallengeCmd(),
+		CooperativeCloseCmd(),
+		SettleCmd(),
+		QueryChannelCmd(),
+	)
+
+	return cmd
+}
+
+// OpenCmd open channel
+func OpenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open",
+		Short: "Create a transaction to open channel with coins in paychan contract",
+		Run:   open,
+	}
+	cmd.Flags().StringP("counterparty", "c", "", "counterparty address")
+	cmd.MarkFlagRequired("counterparty")
+	cmd.Flags().Float64P("amount", "a", 0, "deposit amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().Int64P("period", "p", 100, "dispute period in blocks")
+	return cmd
+}
+
+func open(cmd *cobra.Command, args []string) {
+	counterparty, _ := cmd.Flags().GetString("counterparty")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	period, _ := cmd.Flags().GetInt64("period")
+	commandtypes.CreateActionTx(cmd, pty.PaychanX, &pty.PaychanAction{
+		Ty:    pty.PaychanActionOpen,
+		Value: &pty.PaychanAction_Open{Open: &pty.PaychanOpen{Counterparty: counterparty, Amount: commandtypes.FormatAmountDisplay2Value(amount), DisputePeriod: period}},
+	})
+}
+
+// DepositCmd deposit channel
+func DepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Create a transaction to deposit coins to channel",
+		Run:   deposit,
+	}
+	cmd.Flags().StringP("channel", "i", "", "channel id")
+	cmd.MarkFlagRequired("channel")
+	cmd.Flags().Float64P("amount", "a", 0, "deposit amount")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func deposit(cmd *cobra.Command, args []string) {
+	channel, _ := cmd.Flags().GetString("channel")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	commandtypes.CreateActionTx(cmd, pty.PaychanX, &pty.PaychanAction{
+		Ty:    pty.PaychanActionDeposit,
+		Value: &pty.PaychanAction_Deposit{Deposit: &pty.PaychanDeposit{ChannelID: channel, Amount: commandtypes.FormatAmountDisplay2Value(amount)}},
+	})
+}
+
+func addStateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("state", "s", "", "signed channel state in hex")
+	cmd.MarkFlagRequired("state")
+}
+
+func decodeState(cmd *cobra.Command) (*pty.PaychanState, error) {
+	data, _ := cmd.Flags().GetString("state")
+	buf, err := common.FromHex(data)
+	if err != nil {
+		return nil, err
+	}
+	var state pty.PaychanState
+	err = types.Decode(buf, &state)
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// SignStateCmd sign state
+func SignStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign_state",
+		Short: "Sign off-chain channel state, append signature to state if given",
+		Run:   signState,
+	}
+	cmd.Flags().StringP("state", "s", "", "channel state in hex to add signature")
+	cmd.Flags().StringP("channel", "i", "", "channel id")
+	cmd.Flags().Int64P("nonce", "n", 0, "state nonce")
+	cmd.Flags().Float64P("a", "a", 0, "balance of channel opener")
+	cmd.Flags().Float64P("b", "b", 0, "balance of counterparty")
+	cmd.Flags().StringP("key", "k", "", "private key in hex")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func signState(cmd *cobra.Command, args []string) {
+	state := &pty.PaychanState{}
+	if data, _ := cmd.Flags().GetString("state"); data != "" {
+		var err error
+		state, err = decodeState(cmd)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+	} else {
+		state.ChannelID, _ = cmd.Flags().GetString("channel")
+		state.Nonce, _ = cmd.Flags().GetInt64("nonce")
+		balanceA, _ := cmd.Flags().GetFloat64("a")
+		balanceB, _ := cmd.Flags().GetFloat64("b")
+		state.BalanceA = commandtypes.FormatAmountDisplay2Value(balanceA)
+		state.BalanceB = commandtypes.FormatAmountDisplay2Value(balanceB)
+	}
+	key, _ := cmd.Flags().GetString("key")
+	priv, err := decodePrivKey(key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	state.Signatures = append(state.Signatures, &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(pty.SignData(state)).Bytes(),
+	})
+	jsonclient.PrintString(hex.EncodeToString(types.Encode(state)))
+}
+
+func decodePrivKey(key string) (crypto.PrivKey, error) {
+	buf, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(buf)
+}
+
+// CloseCmd close channel
+func CloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close",
+		Short: "Create a transaction to close channel and start dispute period",
+		Run:   closeChannel,
+	}
+	cmd.Flags().StringP("channel", "i", "", "channel id")
+	cmd.MarkFlagRequired("channel")
+	cmd.Flags().StringP("state", "s", "", "latest signed channel state in hex, empty to close with deposits")
+	return cmd
+}
+
+func closeChannel(cmd *cobra.Command, args []string) {
+	channel, _ := cmd.Flags().GetString("channel")
+	payload := &pty.PaychanClose{ChannelID: channel}
+	if data, _ := cmd.Flags().GetString("state"); data != "" {
+		state, err := decodeState(cmd)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		payload.State = state
+	}
+	commandtypes.CreateActionTx(cmd, pty.PaychanX, &pty.PaychanAction{
+		Ty:    pty.PaychanActionClose,
+		Value: &pty.PaychanAction_Close{Close: payload},
+	})
+}
+
+// ChallengeCmd challenge channel
+func ChallengeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge",
+		Short: "Create a transaction to submit newer state in dispute period",
+		Run:   challenge,
+	}
+	addStateFlags(cmd)
+	return cmd
+}
+
+func challenge(cmd *cobra.Command, args []string) {
+	state, err := decodeState(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, pty.PaychanX, &pty.PaychanAction{
+		Ty:    pty.PaychanActionChallenge,
+		Value: &pty.PaychanAction_Challenge{Challenge: &pty.PaychanChallenge{State: state}},
+	})
+}
+
+// CooperativeCloseCmd cooperative close channel
+func CooperativeCloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cooperative_close",
+		Short: "Create a transaction to settle channel with state signed by both parties",
+		Run:   cooperativeClose,
+	}
+	addStateFlags(cmd)
+	return cmd
+}
+
+func cooperativeClose(cmd *cobra.Command, args []string) {
+	state, err := decodeState(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, pty.PaychanX, &pty.PaychanAction{
+		Ty:    pty.PaychanActionCooperativeClose,
+		Value: &pty.PaychanAction_CooperativeClose{CooperativeClose: &pty.PaychanCooperativeClose{State: state}},
+	})
+}
+
+// SettleCmd settle channel
+func SettleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle",
+		Short: "Create a transaction to settle channel after dispute period",
+		Run:   settle,
+	}
+	cmd.Flags().StringP("channel", "i", "", "channel id")
+	cmd.MarkFlagRequired("channel")
+	return cmd
+}
+
+func settle(cmd *cobra.Command, args []string) {
+	channel, _ := cmd.Flags().GetString("channel")
+	commandtypes.CreateActionTx(cmd, pty.PaychanX, &pty.PaychanAction{
+		Ty:    pty.PaychanActionSettle,
+		Value: &pty.PaychanAction_Settle{Settle: &pty.PaychanSettle{ChannelID: channel}},
+	})
+}
+
+// QueryChannelCmd query channel
+func QueryChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel",
+		Short: "Query channel",
+		Run:   queryChannel,
+	}
+	cmd.Flags().StringP("channel", "i", "", "channel id")
+	cmd.MarkFlagRequired("channel")
+	return cmd
+}
+
+func queryChannel(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	channel, _ := cmd.Flags().GetString("channel")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, pty.PaychanX)
+	params.FuncName = pty.FuncNameGetChannel
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: channel})
+
+	var res pty.PaymentChannel
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -0,0 +1,46 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	pty "github.com/33cn/chain33/system/dapp/paychan/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Open 打开通道
+func (p *Paychan) Exec_Open(payload *pty.PaychanOpen, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.open(payload)
+}
+
+// Exec_Deposit 存入通道
+func (p *Paychan) Exec_Deposit(payload *pty.PaychanDeposit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.deposit(payload)
+}
+
+// Exec_Close 单方面关闭通道，进入争议期
+func (p *Paychan) Exec_Close(payload *pty.PaychanClose, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.close(payload)
+}
+
+// Exec_Challenge 争议期内提交更新的状态
+func (p *Paychan) Exec_Challenge(payload *pty.PaychanChallenge, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.challenge(payload)
+}
+
+// Exec_CooperativeClose 按双方签名的状态立即结算
+func (p *Paychan) Exec_CooperativeClose(payload *pty.PaychanCooperativeClose, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.cooperativeClose(payload)
+}
+
+// Exec_Settle 争议期结束以后结算
+func (p *Paychan) Exec_Settle(payload *pty.PaychanSettle, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.settle(payload)
+}
@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor paychan执行器，负责通道的打开，存入，关闭，争议和结算
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	pty "github.com/33cn/chain33/system/dapp/paychan/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.paychan")
+	driverName = pty.PaychanX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Paychan{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newPaychan, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return paychan name
+func GetName() string {
+	return newPaychan().GetName()
+}
+
+// Paychan defines Paychan object
+type Paychan struct {
+	drivers.DriverBase
+}
+
+func newPaychan() drivers.Driver {
+	p := &Paychan{}
+	p.SetChild(p)
+	p.SetExecutorType(types.LoadExecutorType(driverName))
+	return p
+}
+
+// GetDriverName return a drivername
+func (p *Paychan) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx 检查通道状态的签名
+func (p *Paychan) CheckTx(tx *types.Transaction, index int) error {
+	var action pty.PaychanAction
+	if err := types.Decode(tx.Payload, &action); err != nil {
+		return err
+	}
+	var state *pty.PaychanState
+	switch action.Ty {
+	case pty.PaychanActionClose:
+		state = action.GetClose().GetState()
+	case pty.PaychanActionChallenge:
+		state = action.GetChallenge().GetState()
+	case pty.PaychanActionCooperativeClose:
+		state = action.GetCooperativeClose().GetState()
+	}
+	if state == nil {
+		return nil
+	}
+	_, err := stateSigners(state)
+	return err
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (p *Paychan) CheckReceiptExecOk() bool {
+	return true
+}
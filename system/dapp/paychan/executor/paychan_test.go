@@ -0,0 +1,130 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	pty "github.com/33cn/chain33/system/dapp/paychan/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendPaychanTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) (int32, []byte) {
+	hash, detail, err := mock33.SendCallTx(priv, pty.PaychanX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, hash
+}
+
+func getChannel(t *testing.T, mock33 *testnode.Chain33Mock, id string) *pty.PaymentChannel {
+	msg, err := mock33.GetAPI().Query(pty.PaychanX, pty.FuncNameGetChannel, &types.ReqString{Data: id})
+	assert.Nil(t, err)
+	return msg.(*pty.PaymentChannel)
+}
+
+func signState(state *pty.PaychanState, privs ...crypto.PrivKey) *pty.PaychanState {
+	for _, priv := range privs {
+		state.Signatures = append(state.Signatures, &types.Signature{
+			Ty:        types.SECP256K1,
+			Pubkey:    priv.PubKey().Bytes(),
+			Signature: priv.Sign(pty.SignData(state)).Bytes(),
+		})
+	}
+	return state
+}
+
+func setupChannel(t *testing.T, mock33 *testnode.Chain33Mock) (string, crypto.PrivKey, crypto.PrivKey) {
+	genesis := mock33.GetGenesisKey()
+	addrB, privB := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(genesis, addrB, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	execAddr := address.ExecAddress(pty.PaychanX)
+	mock33.SendTx(util.CreateCoinsTx(genesis, execAddr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(privB, execAddr, 5*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	ty, _ := sendPaychanTx(t, mock33, genesis, "Open", &pty.PaychanOpen{Counterparty: addrB, Amount: 6 * types.Coin, DisputePeriod: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, hash := sendPaychanTx(t, mock33, genesis, "Open", &pty.PaychanOpen{Counterparty: addrB, Amount: 6 * types.Coin, DisputePeriod: pty.MinDisputePeriod})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	id := common.ToHex(hash)
+	ty, _ = sendPaychanTx(t, mock33, privB, "Deposit", &pty.PaychanDeposit{ChannelID: id, Amount: 2 * types.Coin})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	channel := getChannel(t, mock33, id)
+	assert.Equal(t, 6*types.Coin, channel.BalanceA)
+	assert.Equal(t, 2*types.Coin, channel.BalanceB)
+	assert.Equal(t, 8*types.Coin, mock33.GetExecBalance(pty.PaychanX, channel.Addr))
+	return id, genesis, privB
+}
+
+func TestPaychanCooperativeClose(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	id, privA, privB := setupChannel(t, mock33)
+	addrA := mock33.GetGenesisAddress()
+	addrB := address.PubKeyToAddress(privB.PubKey().Bytes()).String()
+
+	//只有发送者签名的状态不能结算
+	state := &pty.PaychanState{ChannelID: id, Nonce: 3, BalanceA: 3 * types.Coin, BalanceB: 5 * types.Coin}
+	ty, _ := sendPaychanTx(t, mock33, privA, "CooperativeClose", &pty.PaychanCooperativeClose{State: signState(state, privA)})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	//余额和存入的总额不一致
+	bad := signState(&pty.PaychanState{ChannelID: id, Nonce: 3, BalanceA: 3 * types.Coin, BalanceB: 6 * types.Coin}, privB)
+	ty, _ = sendPaychanTx(t, mock33, privA, "CooperativeClose", &pty.PaychanCooperativeClose{State: bad})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	state.Signatures = nil
+	ty, _ = sendPaychanTx(t, mock33, privA, "CooperativeClose", &pty.PaychanCooperativeClose{State: signState(state, privB)})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, int32(pty.ChannelStatusSettled), getChannel(t, mock33, id).Status)
+	assert.Equal(t, 7*types.Coin, mock33.GetExecBalance(pty.PaychanX, addrA))
+	assert.Equal(t, 8*types.Coin, mock33.GetExecBalance(pty.PaychanX, addrB))
+}
+
+func TestPaychanDispute(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	id, privA, privB := setupChannel(t, mock33)
+	addrA := mock33.GetGenesisAddress()
+	addrB := address.PubKeyToAddress(privB.PubKey().Bytes()).String()
+
+	//A用旧的状态关闭，B在争议期内提交更新的状态
+	old := signState(&pty.PaychanState{ChannelID: id, Nonce: 1, BalanceA: 5 * types.Coin, BalanceB: 3 * types.Coin}, privB)
+	latest := signState(&pty.PaychanState{ChannelID: id, Nonce: 2, BalanceA: 2 * types.Coin, BalanceB: 6 * types.Coin}, privA)
+	ty, _ := sendPaychanTx(t, mock33, privA, "Close", &pty.PaychanClose{ChannelID: id, State: old})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	channel := getChannel(t, mock33, id)
+	assert.Equal(t, int32(pty.ChannelStatusClosing), channel.Status)
+	ty, _ = sendPaychanTx(t, mock33, privB, "Deposit", &pty.PaychanDeposit{ChannelID: id, Amount: types.Coin})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendPaychanTx(t, mock33, privA, "Settle", &pty.PaychanSettle{ChannelID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendPaychanTx(t, mock33, privB, "Challenge", &pty.PaychanChallenge{State: latest})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendPaychanTx(t, mock33, privB, "Challenge", &pty.PaychanChallenge{State: latest})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.Equal(t, int64(2), getChannel(t, mock33, id).Nonce)
+
+	for mock33.GetLastBlock().Height < channel.CloseHeight {
+		mock33.SendTx(util.CreateCoinsTx(privA, mock33.GetHotAddress(), types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	ty, _ = sendPaychanTx(t, mock33, privB, "Settle", &pty.PaychanSettle{ChannelID: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 6*types.Coin, mock33.GetExecBalance(pty.PaychanX, addrA))
+	assert.Equal(t, 9*types.Coin, mock33.GetExecBalance(pty.PaychanX, addrB))
+	ty, _ = sendPaychanTx(t, mock33, privB, "Settle", &pty.PaychanSettle{ChannelID: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+}
@@ -0,0 +1,295 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	pty "github.com/33cn/chain33/system/dapp/paychan/types"
+	"github.com/33cn/chain33/types"
+)
+
+var channelKeyPrefix = "mavl-" + pty.PaychanX + "-channel-"
+
+func calcChannelKey(id string) []byte {
+	return []byte(channelKeyPrefix + id)
+}
+
+//calcChannelAddr 通道的coins存在由通道id生成的地址中，没有对应的私钥
+func calcChannelAddr(id string) string {
+	return address.ExecAddress(pty.PaychanX + "-" + id)
+}
+
+// Action paychan交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(p *Paychan, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: p.GetCoinsAccount(),
+		db:           p.GetStateDB(),
+		txhash:       tx.Hash(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       p.GetHeight(),
+		index:        index,
+	}
+}
+
+func getChannel(db dbm.KV, id string) (*pty.PaymentChannel, error) {
+	value, err := db.Get(calcChannelKey(id))
+	if err != nil || value == nil {
+		return nil, pty.ErrChannelNotExist
+	}
+	var channel pty.PaymentChannel
+	err = types.Decode(value, &channel)
+	if err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+//stateSigners 检查状态的签名，返回签名的地址
+func stateSigners(state *pty.PaychanState) ([]string, error) {
+	if len(state.Signatures) > pty.MaxChannelSignatures {
+		return nil, pty.ErrStateSignature
+	}
+	data := pty.SignData(state)
+	var signers []string
+	for _, sig := range state.Signatures {
+		if !types.CheckSign(data, "", sig) {
+			return nil, pty.ErrStateSignature
+		}
+		signers = append(signers, address.PubKeyToAddr(types.SignPubKey(data, "", sig)))
+	}
+	return signers, nil
+}
+
+func contains(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+//checkState 交易的发送者也认可这个状态，发送者和签名的地址必须包括通道的双方
+func (a *Action) checkState(channel *pty.PaymentChannel, state *pty.PaychanState) error {
+	if state.ChannelID != channel.Id {
+		return pty.ErrChannelNotExist
+	}
+	if state.BalanceA < 0 || state.BalanceB < 0 || state.BalanceA+state.BalanceB != channel.DepositA+channel.DepositB {
+		return pty.ErrStateBalance
+	}
+	signers, err := stateSigners(state)
+	if err != nil {
+		return err
+	}
+	signers = append(signers, a.fromaddr)
+	if !contains(signers, channel.PartyA) || !contains(signers, channel.PartyB) {
+		return pty.ErrStateSignature
+	}
+	return nil
+}
+
+func (a *Action) saveChannel(channel *pty.PaymentChannel) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcChannelKey(channel.Id), Value: types.Encode(channel)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func channelReceipt(ty int32, prev, current *pty.PaymentChannel) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&pty.ReceiptPaychan{Prev: prev, Current: current})}
+}
+
+func (a *Action) open(payload *pty.PaychanOpen) (*types.Receipt, error) {
+	if err := address.CheckAddress(payload.Counterparty); err != nil || payload.Counterparty == a.fromaddr {
+		return nil, pty.ErrCounterparty
+	}
+	if payload.DisputePeriod < pty.MinDisputePeriod || payload.DisputePeriod > pty.MaxDisputePeriod {
+		return nil, pty.ErrDisputePeriod
+	}
+	id := common.ToHex(a.txhash)
+	channel := &pty.PaymentChannel{
+		Id:            id,
+		PartyA:        a.fromaddr,
+		PartyB:        payload.Counterparty,
+		DepositA:      payload.Amount,
+		DisputePeriod: payload.DisputePeriod,
+		Status:        pty.ChannelStatusOpen,
+		BalanceA:      payload.Amount,
+		OpenHeight:    a.height,
+		Addr:          calcChannelAddr(id),
+	}
+	receipt, err := a.coinsAccount.ExecTransfer(a.fromaddr, channel.Addr, a.execaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	kv := append(receipt.KV, a.saveChannel(channel))
+	logs := append(receipt.Logs, channelReceipt(pty.TyLogPaychanOpen, nil, channel))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) deposit(payload *pty.PaychanDeposit) (*types.Receipt, error) {
+	channel, err := getChannel(a.db, payload.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Status != pty.ChannelStatusOpen {
+		return nil, pty.ErrChannelStatus
+	}
+	if a.fromaddr != channel.PartyA && a.fromaddr != channel.PartyB {
+		return nil, pty.ErrNotParty
+	}
+	receipt, err := a.coinsAccount.ExecTransfer(a.fromaddr, channel.Addr, a.execaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	prev := *channel
+	if a.fromaddr == channel.PartyA {
+		channel.DepositA += payload.Amount
+		channel.BalanceA += payload.Amount
+	} else {
+		channel.DepositB += payload.Amount
+		channel.BalanceB += payload.Amount
+	}
+	kv := append(receipt.KV, a.saveChannel(channel))
+	logs := append(receipt.Logs, channelReceipt(pty.TyLogPaychanDeposit, &prev, channel))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//close 单方面关闭，没有提交状态的时候双方各自取回存入的金额
+func (a *Action) close(payload *pty.PaychanClose) (*types.Receipt, error) {
+	channel, err := getChannel(a.db, payload.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Status != pty.ChannelStatusOpen {
+		return nil, pty.ErrChannelStatus
+	}
+	if a.fromaddr != channel.PartyA && a.fromaddr != channel.PartyB {
+		return nil, pty.ErrNotParty
+	}
+	prev := *channel
+	if payload.State != nil {
+		if err := a.checkState(channel, payload.State); err != nil {
+			return nil, err
+		}
+		channel.Nonce = payload.State.Nonce
+		channel.BalanceA = payload.State.BalanceA
+		channel.BalanceB = payload.State.BalanceB
+	}
+	channel.Status = pty.ChannelStatusClosing
+	channel.Closer = a.fromaddr
+	channel.CloseHeight = a.height + channel.DisputePeriod
+	kv := []*types.KeyValue{a.saveChannel(channel)}
+	logs := []*types.ReceiptLog{channelReceipt(pty.TyLogPaychanClose, &prev, channel)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) challenge(payload *pty.PaychanChallenge) (*types.Receipt, error) {
+	if payload.State == nil {
+		return nil, types.ErrInvalidParam
+	}
+	channel, err := getChannel(a.db, payload.State.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Status != pty.ChannelStatusClosing {
+		return nil, pty.ErrChannelStatus
+	}
+	if a.height >= channel.CloseHeight {
+		return nil, pty.ErrDisputeOver
+	}
+	if err := a.checkState(channel, payload.State); err != nil {
+		return nil, err
+	}
+	if payload.State.Nonce <= channel.Nonce {
+		return nil, pty.ErrStateNonce
+	}
+	prev := *channel
+	channel.Nonce = payload.State.Nonce
+	channel.BalanceA = payload.State.BalanceA
+	channel.BalanceB = payload.State.BalanceB
+	kv := []*types.KeyValue{a.saveChannel(channel)}
+	logs := []*types.ReceiptLog{channelReceipt(pty.TyLogPaychanChallenge, &prev, channel)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) cooperativeClose(payload *pty.PaychanCooperativeClose) (*types.Receipt, error) {
+	if payload.State == nil {
+		return nil, types.ErrInvalidParam
+	}
+	channel, err := getChannel(a.db, payload.State.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Status == pty.ChannelStatusSettled {
+		return nil, pty.ErrChannelStatus
+	}
+	if err := a.checkState(channel, payload.State); err != nil {
+		return nil, err
+	}
+	if payload.State.Nonce < channel.Nonce {
+		return nil, pty.ErrStateNonce
+	}
+	channel.Nonce = payload.State.Nonce
+	channel.BalanceA = payload.State.BalanceA
+	channel.BalanceB = payload.State.BalanceB
+	return a.payout(channel)
+}
+
+func (a *Action) settle(payload *pty.PaychanSettle) (*types.Receipt, error) {
+	channel, err := getChannel(a.db, payload.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.Status != pty.ChannelStatusClosing {
+		return nil, pty.ErrChannelStatus
+	}
+	if a.height < channel.CloseHeight {
+		return nil, pty.ErrDisputeNotOver
+	}
+	return a.payout(channel)
+}
+
+//payout 按通道的余额转给双方在paychan合约中的账户
+func (a *Action) payout(channel *pty.PaymentChannel) (*types.Receipt, error) {
+	prev, err := getChannel(a.db, channel.Id)
+	if err != nil {
+		return nil, err
+	}
+	var kv []*types.KeyValue
+	var logs []*types.ReceiptLog
+	for _, pay := range []struct {
+		to     string
+		amount int64
+	}{{channel.PartyA, channel.BalanceA}, {channel.PartyB, channel.BalanceB}} {
+		if pay.amount == 0 {
+			continue
+		}
+		receipt, err := a.coinsAccount.ExecTransfer(channel.Addr, pay.to, a.execaddr, pay.amount)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+	}
+	channel.Status = pty.ChannelStatusSettled
+	kv = append(kv, a.saveChannel(channel))
+	logs = append(logs, channelReceipt(pty.TyLogPaychanSettle, prev, channel))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
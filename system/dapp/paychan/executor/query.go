@@ -0,0 +1,14 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetChannel 获取通道
+func (p *Paychan) Query_GetChannel(in *types.ReqString) (types.Message, error) {
+	return getChannel(p.GetStateDB(), in.Data)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package paychan 支付通道执行器插件
+// 1. 发起者存入coins打开通道，双向通道的对方也可以存入
+// 2. 双方在链下交换签名的余额状态，不需要每次支付都上链
+// 3. 双方签名的状态可以立即结算，单方面关闭以后对方可以在争议期内提交更新的状态
+package paychan
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/paychan/commands"
+	"github.com/33cn/chain33/system/dapp/paychan/executor"
+	"github.com/33cn/chain33/system/dapp/paychan/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.PaychanX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.PaychanCmd,
+		RPC:      nil,
+	})
+}
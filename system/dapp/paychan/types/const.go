@@ -0,0 +1,39 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// paychan action ty
+const (
+	PaychanActionOpen = iota + 1
+	PaychanActionDeposit
+	PaychanActionClose
+	PaychanActionChallenge
+	PaychanActionCooperativeClose
+	PaychanActionSettle
+)
+
+// paychan log ty
+const (
+	TyLogPaychanOpen      = 490
+	TyLogPaychanDeposit   = 491
+	TyLogPaychanClose     = 492
+	TyLogPaychanChallenge = 493
+	TyLogPaychanSettle    = 494
+)
+
+// channel status
+const (
+	ChannelStatusOpen = iota + 1
+	ChannelStatusClosing
+	ChannelStatusSettled
+)
+
+// query func name
+const (
+	FuncNameGetChannel   = "GetChannel"
+	MinDisputePeriod     = 10
+	MaxDisputePeriod     = 100000
+	MaxChannelSignatures = 2
+)
@@ -0,0 +1,30 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrChannelNotExist 通道不存在
+	ErrChannelNotExist = errors.New("ErrChannelNotExist")
+	// ErrCounterparty 对方地址不合法
+	ErrCounterparty = errors.New("ErrCounterparty")
+	// ErrDisputePeriod 争议期不合法
+	ErrDisputePeriod = errors.New("ErrDisputePeriod")
+	// ErrNotParty 不是通道的参与方
+	ErrNotParty = errors.New("ErrNotParty")
+	// ErrChannelStatus 通道的状态不允许这个操作
+	ErrChannelStatus = errors.New("ErrChannelStatus")
+	// ErrStateSignature 状态没有通道双方的签名
+	ErrStateSignature = errors.New("ErrStateSignature")
+	// ErrStateBalance 状态的余额和存入的总额不一致
+	ErrStateBalance = errors.New("ErrStateBalance")
+	// ErrStateNonce 状态的nonce不比当前的大
+	ErrStateNonce = errors.New("ErrStateNonce")
+	// ErrDisputeNotOver 争议期还没有结束
+	ErrDisputeNotOver = errors.New("ErrDisputeNotOver")
+	// ErrDisputeOver 争议期已经结束
+	ErrDisputeOver = errors.New("ErrDisputeOver")
+)
@@ -0,0 +1,902 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: paychan.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	types "github.com/33cn/chain33/types"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type PaychanAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*PaychanAction_Open
+	//	*PaychanAction_Deposit
+	//	*PaychanAction_Close
+	//	*PaychanAction_Challenge
+	//	*PaychanAction_CooperativeClose
+	//	*PaychanAction_Settle
+	Value                isPaychanAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                 `protobuf:"varint,7,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PaychanAction) Reset()         { *m = PaychanAction{} }
+func (m *PaychanAction) String() string { return proto.CompactTextString(m) }
+func (*PaychanAction) ProtoMessage()    {}
+func (*PaychanAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{0}
+}
+
+func (m *PaychanAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaychanAction.Unmarshal(m, b)
+}
+func (m *PaychanAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaychanAction.Marshal(b, m, deterministic)
+}
+func (m *PaychanAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaychanAction.Merge(m, src)
+}
+func (m *PaychanAction) XXX_Size() int {
+	return xxx_messageInfo_PaychanAction.Size(m)
+}
+func (m *PaychanAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaychanAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaychanAction proto.InternalMessageInfo
+
+type isPaychanAction_Value interface {
+	isPaychanAction_Value()
+}
+
+type PaychanAction_Open struct {
+	Open *PaychanOpen `protobuf:"bytes,1,opt,name=open,proto3,oneof"`
+}
+
+type PaychanAction_Deposit struct {
+	Deposit *PaychanDeposit `protobuf:"bytes,2,opt,name=deposit,proto3,oneof"`
+}
+
+type PaychanAction_Close struct {
+	Close *PaychanClose `protobuf:"bytes,3,opt,name=close,proto3,oneof"`
+}
+
+type PaychanAction_Challenge struct {
+	Challenge *PaychanChallenge `protobuf:"bytes,4,opt,name=challenge,proto3,oneof"`
+}
+
+type PaychanAction_CooperativeClose struct {
+	CooperativeClose *PaychanCooperativeClose `protobuf:"bytes,5,opt,name=cooperativeClose,proto3,oneof"`
+}
+
+type PaychanAction_Settle struct {
+	Settle *PaychanSettle `protobuf:"bytes,6,opt,name=settle,proto3,oneof"`
+}
+
+func (*PaychanAction_Open) isPaychanAction_Value() {}
+
+func (*PaychanAction_Deposit) isPaychanAction_Value() {}
+
+func (*PaychanAction_Close) isPaychanAction_Value() {}
+
+func (*PaychanAction_Challenge) isPaychanAction_Value() {}
+
+func (*PaychanAction_CooperativeClose) isPaychanAction_Value() {}
+
+func (*PaychanAction_Settle) isPaychanAction_Value() {}
+
+func (m *PaychanAction) GetValue() isPaychanAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *PaychanAction) GetOpen() *PaychanOpen {
+	if x, ok := m.GetValue().(*PaychanAction_Open); ok {
+		return x.Open
+	}
+	return nil
+}
+
+func (m *PaychanAction) GetDeposit() *PaychanDeposit {
+	if x, ok := m.GetValue().(*PaychanAction_Deposit); ok {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (m *PaychanAction) GetClose() *PaychanClose {
+	if x, ok := m.GetValue().(*PaychanAction_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+func (m *PaychanAction) GetChallenge() *PaychanChallenge {
+	if x, ok := m.GetValue().(*PaychanAction_Challenge); ok {
+		return x.Challenge
+	}
+	return nil
+}
+
+func (m *PaychanAction) GetCooperativeClose() *PaychanCooperativeClose {
+	if x, ok := m.GetValue().(*PaychanAction_CooperativeClose); ok {
+		return x.CooperativeClose
+	}
+	return nil
+}
+
+func (m *PaychanAction) GetSettle() *PaychanSettle {
+	if x, ok := m.GetValue().(*PaychanAction_Settle); ok {
+		return x.Settle
+	}
+	return nil
+}
+
+func (m *PaychanAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*PaychanAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _PaychanAction_OneofMarshaler, _PaychanAction_OneofUnmarshaler, _PaychanAction_OneofSizer, []interface{}{
+		(*PaychanAction_Open)(nil),
+		(*PaychanAction_Deposit)(nil),
+		(*PaychanAction_Close)(nil),
+		(*PaychanAction_Challenge)(nil),
+		(*PaychanAction_CooperativeClose)(nil),
+		(*PaychanAction_Settle)(nil),
+	}
+}
+
+func _PaychanAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*PaychanAction)
+	// value
+	switch x := m.Value.(type) {
+	case *PaychanAction_Open:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Open); err != nil {
+			return err
+		}
+	case *PaychanAction_Deposit:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Deposit); err != nil {
+			return err
+		}
+	case *PaychanAction_Close:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Close); err != nil {
+			return err
+		}
+	case *PaychanAction_Challenge:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Challenge); err != nil {
+			return err
+		}
+	case *PaychanAction_CooperativeClose:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CooperativeClose); err != nil {
+			return err
+		}
+	case *PaychanAction_Settle:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Settle); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("PaychanAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _PaychanAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*PaychanAction)
+	switch tag {
+	case 1: // value.open
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PaychanOpen)
+		err := b.DecodeMessage(msg)
+		m.Value = &PaychanAction_Open{msg}
+		return true, err
+	case 2: // value.deposit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PaychanDeposit)
+		err := b.DecodeMessage(msg)
+		m.Value = &PaychanAction_Deposit{msg}
+		return true, err
+	case 3: // value.close
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PaychanClose)
+		err := b.DecodeMessage(msg)
+		m.Value = &PaychanAction_Close{msg}
+		return true, err
+	case 4: // value.challenge
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PaychanChallenge)
+		err := b.DecodeMessage(msg)
+		m.Value = &PaychanAction_Challenge{msg}
+		return true, err
+	case 5: // value.cooperativeClose
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PaychanCooperativeClose)
+		err := b.DecodeMessage(msg)
+		m.Value = &PaychanAction_CooperativeClose{msg}
+		return true, err
+	case 6: // value.settle
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PaychanSettle)
+		err := b.DecodeMessage(msg)
+		m.Value = &PaychanAction_Settle{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _PaychanAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*PaychanAction)
+	// value
+	switch x := m.Value.(type) {
+	case *PaychanAction_Open:
+		s := proto.Size(x.Open)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PaychanAction_Deposit:
+		s := proto.Size(x.Deposit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PaychanAction_Close:
+		s := proto.Size(x.Close)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PaychanAction_Challenge:
+		s := proto.Size(x.Challenge)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PaychanAction_CooperativeClose:
+		s := proto.Size(x.CooperativeClose)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PaychanAction_Settle:
+		s := proto.Size(x.Settle)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//打开通道，amount从发起者在paychan合约中的coins转入通道
+//   disputePeriod : 单方面关闭以后对方可以提交更新的状态的区块数
+type PaychanOpen struct {
+	Counterparty         string   `protobuf:"bytes,1,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	DisputePeriod        int64    `protobuf:"varint,3,opt,name=disputePeriod,proto3" json:"disputePeriod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaychanOpen) Reset()         { *m = PaychanOpen{} }
+func (m *PaychanOpen) String() string { return proto.CompactTextString(m) }
+func (*PaychanOpen) ProtoMessage()    {}
+func (*PaychanOpen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{1}
+}
+
+func (m *PaychanOpen) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaychanOpen.Unmarshal(m, b)
+}
+func (m *PaychanOpen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaychanOpen.Marshal(b, m, deterministic)
+}
+func (m *PaychanOpen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaychanOpen.Merge(m, src)
+}
+func (m *PaychanOpen) XXX_Size() int {
+	return xxx_messageInfo_PaychanOpen.Size(m)
+}
+func (m *PaychanOpen) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaychanOpen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaychanOpen proto.InternalMessageInfo
+
+func (m *PaychanOpen) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *PaychanOpen) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PaychanOpen) GetDisputePeriod() int64 {
+	if m != nil {
+		return m.DisputePeriod
+	}
+	return 0
+}
+
+//通道的双方都可以存入，单向通道只有发起者存入
+type PaychanDeposit struct {
+	ChannelID            string   `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaychanDeposit) Reset()         { *m = PaychanDeposit{} }
+func (m *PaychanDeposit) String() string { return proto.CompactTextString(m) }
+func (*PaychanDeposit) ProtoMessage()    {}
+func (*PaychanDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{2}
+}
+
+func (m *PaychanDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaychanDeposit.Unmarshal(m, b)
+}
+func (m *PaychanDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaychanDeposit.Marshal(b, m, deterministic)
+}
+func (m *PaychanDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaychanDeposit.Merge(m, src)
+}
+func (m *PaychanDeposit) XXX_Size() int {
+	return xxx_messageInfo_PaychanDeposit.Size(m)
+}
+func (m *PaychanDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaychanDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaychanDeposit proto.InternalMessageInfo
+
+func (m *PaychanDeposit) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *PaychanDeposit) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//链下交换的通道状态，nonce递增，双方的余额之和等于存入的总额
+type PaychanState struct {
+	ChannelID            string             `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Nonce                int64              `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	BalanceA             int64              `protobuf:"varint,3,opt,name=balanceA,proto3" json:"balanceA,omitempty"`
+	BalanceB             int64              `protobuf:"varint,4,opt,name=balanceB,proto3" json:"balanceB,omitempty"`
+	Signatures           []*types.Signature `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PaychanState) Reset()         { *m = PaychanState{} }
+func (m *PaychanState) String() string { return proto.CompactTextString(m) }
+func (*PaychanState) ProtoMessage()    {}
+func (*PaychanState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{3}
+}
+
+func (m *PaychanState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaychanState.Unmarshal(m, b)
+}
+func (m *PaychanState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaychanState.Marshal(b, m, deterministic)
+}
+func (m *PaychanState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaychanState.Merge(m, src)
+}
+func (m *PaychanState) XXX_Size() int {
+	return xxx_messageInfo_PaychanState.Size(m)
+}
+func (m *PaychanState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaychanState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaychanState proto.InternalMessageInfo
+
+func (m *PaychanState) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *PaychanState) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PaychanState) GetBalanceA() int64 {
+	if m != nil {
+		return m.BalanceA
+	}
+	return 0
+}
+
+func (m *PaychanState) GetBalanceB() int64 {
+	if m != nil {
+		return m.BalanceB
+	}
+	return 0
+}
+
+func (m *PaychanState) GetSignatures() []*types.Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+//单方面关闭，state为空的时候按存入的金额关闭
+type PaychanClose struct {
+	ChannelID            string        `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	State                *PaychanState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PaychanClose) Reset()         { *m = PaychanClose{} }
+func (m *PaychanClose) String() string { return proto.CompactTextString(m) }
+func (*PaychanClose) ProtoMessage()    {}
+func (*PaychanClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{4}
+}
+
+func (m *PaychanClose) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaychanClose.Unmarshal(m, b)
+}
+func (m *PaychanClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaychanClose.Marshal(b, m, deterministic)
+}
+func (m *PaychanClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaychanClose.Merge(m, src)
+}
+func (m *PaychanClose) XXX_Size() int {
+	return xxx_messageInfo_PaychanClose.Size(m)
+}
+func (m *PaychanClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaychanClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaychanClose proto.InternalMessageInfo
+
+func (m *PaychanClose) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *PaychanClose) GetState() *PaychanState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+//争议期内提交nonce更大的状态
+type PaychanChallenge struct {
+	State                *PaychanState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PaychanChallenge) Reset()         { *m = PaychanChallenge{} }
+func (m *PaychanChallenge) String() string { return proto.CompactTextString(m) }
+func (*PaychanChallenge) ProtoMessage()    {}
+func (*PaychanChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{5}
+}
+
+func (m *PaychanChallenge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaychanChallenge.Unmarshal(m, b)
+}
+func (m *PaychanChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaychanChallenge.Marshal(b, m, deterministic)
+}
+func (m *PaychanChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaychanChallenge.Merge(m, src)
+}
+func (m *PaychanChallenge) XXX_Size() int {
+	return xxx_messageInfo_PaychanChallenge.Size(m)
+}
+func (m *PaychanChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaychanChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaychanChallenge proto.InternalMessageInfo
+
+func (m *PaychanChallenge) GetState() *PaychanState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+//双方签名的状态立即结算
+type PaychanCooperativeClose struct {
+	State                *PaychanState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PaychanCooperativeClose) Reset()         { *m = PaychanCooperativeClose{} }
+func (m *PaychanCooperativeClose) String() string { return proto.CompactTextString(m) }
+func (*PaychanCooperativeClose) ProtoMessage()    {}
+func (*PaychanCooperativeClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{6}
+}
+
+func (m *PaychanCooperativeClose) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaychanCooperativeClose.Unmarshal(m, b)
+}
+func (m *PaychanCooperativeClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaychanCooperativeClose.Marshal(b, m, deterministic)
+}
+func (m *PaychanCooperativeClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaychanCooperativeClose.Merge(m, src)
+}
+func (m *PaychanCooperativeClose) XXX_Size() int {
+	return xxx_messageInfo_PaychanCooperativeClose.Size(m)
+}
+func (m *PaychanCooperativeClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaychanCooperativeClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaychanCooperativeClose proto.InternalMessageInfo
+
+func (m *PaychanCooperativeClose) GetState() *PaychanState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+//争议期结束以后任何人都可以结算
+type PaychanSettle struct {
+	ChannelID            string   `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaychanSettle) Reset()         { *m = PaychanSettle{} }
+func (m *PaychanSettle) String() string { return proto.CompactTextString(m) }
+func (*PaychanSettle) ProtoMessage()    {}
+func (*PaychanSettle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{7}
+}
+
+func (m *PaychanSettle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaychanSettle.Unmarshal(m, b)
+}
+func (m *PaychanSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaychanSettle.Marshal(b, m, deterministic)
+}
+func (m *PaychanSettle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaychanSettle.Merge(m, src)
+}
+func (m *PaychanSettle) XXX_Size() int {
+	return xxx_messageInfo_PaychanSettle.Size(m)
+}
+func (m *PaychanSettle) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaychanSettle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaychanSettle proto.InternalMessageInfo
+
+func (m *PaychanSettle) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+type PaymentChannel struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PartyA               string   `protobuf:"bytes,2,opt,name=partyA,proto3" json:"partyA,omitempty"`
+	PartyB               string   `protobuf:"bytes,3,opt,name=partyB,proto3" json:"partyB,omitempty"`
+	DepositA             int64    `protobuf:"varint,4,opt,name=depositA,proto3" json:"depositA,omitempty"`
+	DepositB             int64    `protobuf:"varint,5,opt,name=depositB,proto3" json:"depositB,omitempty"`
+	DisputePeriod        int64    `protobuf:"varint,6,opt,name=disputePeriod,proto3" json:"disputePeriod,omitempty"`
+	Status               int32    `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Nonce                int64    `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	BalanceA             int64    `protobuf:"varint,9,opt,name=balanceA,proto3" json:"balanceA,omitempty"`
+	BalanceB             int64    `protobuf:"varint,10,opt,name=balanceB,proto3" json:"balanceB,omitempty"`
+	Closer               string   `protobuf:"bytes,11,opt,name=closer,proto3" json:"closer,omitempty"`
+	CloseHeight          int64    `protobuf:"varint,12,opt,name=closeHeight,proto3" json:"closeHeight,omitempty"`
+	OpenHeight           int64    `protobuf:"varint,13,opt,name=openHeight,proto3" json:"openHeight,omitempty"`
+	Addr                 string   `protobuf:"bytes,14,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaymentChannel) Reset()         { *m = PaymentChannel{} }
+func (m *PaymentChannel) String() string { return proto.CompactTextString(m) }
+func (*PaymentChannel) ProtoMessage()    {}
+func (*PaymentChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{8}
+}
+
+func (m *PaymentChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentChannel.Unmarshal(m, b)
+}
+func (m *PaymentChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentChannel.Marshal(b, m, deterministic)
+}
+func (m *PaymentChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentChannel.Merge(m, src)
+}
+func (m *PaymentChannel) XXX_Size() int {
+	return xxx_messageInfo_PaymentChannel.Size(m)
+}
+func (m *PaymentChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentChannel proto.InternalMessageInfo
+
+func (m *PaymentChannel) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PaymentChannel) GetPartyA() string {
+	if m != nil {
+		return m.PartyA
+	}
+	return ""
+}
+
+func (m *PaymentChannel) GetPartyB() string {
+	if m != nil {
+		return m.PartyB
+	}
+	return ""
+}
+
+func (m *PaymentChannel) GetDepositA() int64 {
+	if m != nil {
+		return m.DepositA
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetDepositB() int64 {
+	if m != nil {
+		return m.DepositB
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetDisputePeriod() int64 {
+	if m != nil {
+		return m.DisputePeriod
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetBalanceA() int64 {
+	if m != nil {
+		return m.BalanceA
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetBalanceB() int64 {
+	if m != nil {
+		return m.BalanceB
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetCloser() string {
+	if m != nil {
+		return m.Closer
+	}
+	return ""
+}
+
+func (m *PaymentChannel) GetCloseHeight() int64 {
+	if m != nil {
+		return m.CloseHeight
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetOpenHeight() int64 {
+	if m != nil {
+		return m.OpenHeight
+	}
+	return 0
+}
+
+func (m *PaymentChannel) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReceiptPaychan struct {
+	Prev                 *PaymentChannel `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *PaymentChannel `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReceiptPaychan) Reset()         { *m = ReceiptPaychan{} }
+func (m *ReceiptPaychan) String() string { return proto.CompactTextString(m) }
+func (*ReceiptPaychan) ProtoMessage()    {}
+func (*ReceiptPaychan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c968c774a3b9dede, []int{9}
+}
+
+func (m *ReceiptPaychan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptPaychan.Unmarshal(m, b)
+}
+func (m *ReceiptPaychan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptPaychan.Marshal(b, m, deterministic)
+}
+func (m *ReceiptPaychan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptPaychan.Merge(m, src)
+}
+func (m *ReceiptPaychan) XXX_Size() int {
+	return xxx_messageInfo_ReceiptPaychan.Size(m)
+}
+func (m *ReceiptPaychan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptPaychan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptPaychan proto.InternalMessageInfo
+
+func (m *ReceiptPaychan) GetPrev() *PaymentChannel {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptPaychan) GetCurrent() *PaymentChannel {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PaychanAction)(nil), "types.PaychanAction")
+	proto.RegisterType((*PaychanOpen)(nil), "types.PaychanOpen")
+	proto.RegisterType((*PaychanDeposit)(nil), "types.PaychanDeposit")
+	proto.RegisterType((*PaychanState)(nil), "types.PaychanState")
+	proto.RegisterType((*PaychanClose)(nil), "types.PaychanClose")
+	proto.RegisterType((*PaychanChallenge)(nil), "types.PaychanChallenge")
+	proto.RegisterType((*PaychanCooperativeClose)(nil), "types.PaychanCooperativeClose")
+	proto.RegisterType((*PaychanSettle)(nil), "types.PaychanSettle")
+	proto.RegisterType((*PaymentChannel)(nil), "types.PaymentChannel")
+	proto.RegisterType((*ReceiptPaychan)(nil), "types.ReceiptPaychan")
+}
+
+func init() { proto.RegisterFile("paychan.proto", fileDescriptor_c968c774a3b9dede) }
+
+var fileDescriptor_c968c774a3b9dede = []byte{
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6b, 0xdb, 0x4e,
+	0x10, 0xf5, 0x3f, 0xd9, 0xf1, 0x38, 0x36, 0xf9, 0xed, 0x2f, 0x4d, 0x44, 0x28, 0xc1, 0x88, 0x1e,
+	0x1c, 0x4a, 0xdd, 0x36, 0x3d, 0xf4, 0xd4, 0x83, 0x95, 0x50, 0x54, 0x28, 0x34, 0x6c, 0x0e, 0x3d,
+	0x6f, 0xa4, 0x21, 0x11, 0x28, 0xbb, 0x62, 0xb5, 0x0e, 0xf8, 0xda, 0xcf, 0xd3, 0x2f, 0xd6, 0x6f,
+	0x51, 0x34, 0x5a, 0x59, 0x7f, 0x92, 0x38, 0xf4, 0xa6, 0x99, 0x79, 0x6f, 0xb4, 0x33, 0xef, 0xed,
+	0xc2, 0x34, 0x15, 0x9b, 0xf0, 0x4e, 0xc8, 0x65, 0xaa, 0x95, 0x51, 0xcc, 0x31, 0x9b, 0x14, 0xb3,
+	0x93, 0xff, 0x8c, 0x16, 0x32, 0x13, 0xa1, 0x89, 0x95, 0xad, 0x78, 0x7f, 0x7a, 0x30, 0xbd, 0x2a,
+	0xb0, 0x2b, 0xca, 0xb3, 0x05, 0x0c, 0x54, 0x8a, 0xd2, 0xed, 0xce, 0xbb, 0x8b, 0xc9, 0x39, 0x5b,
+	0x12, 0x75, 0x69, 0x31, 0x3f, 0x52, 0x94, 0x41, 0x87, 0x13, 0x82, 0x7d, 0x84, 0x51, 0x84, 0xa9,
+	0xca, 0x62, 0xe3, 0xf6, 0x08, 0xfc, 0xaa, 0x09, 0xbe, 0x2c, 0x8a, 0x41, 0x87, 0x97, 0x38, 0xf6,
+	0x16, 0x9c, 0x30, 0x51, 0x19, 0xba, 0x7d, 0x22, 0xfc, 0xdf, 0x24, 0x5c, 0xe4, 0xa5, 0xa0, 0xc3,
+	0x0b, 0x0c, 0xfb, 0x0c, 0xe3, 0xf0, 0x4e, 0x24, 0x09, 0xca, 0x5b, 0x74, 0x07, 0x44, 0x38, 0x6e,
+	0x11, 0xca, 0x72, 0xd0, 0xe1, 0x15, 0x96, 0x7d, 0x87, 0x83, 0x50, 0xa9, 0x14, 0xb5, 0x30, 0xf1,
+	0x03, 0x52, 0x57, 0xd7, 0x21, 0xfe, 0x69, 0x8b, 0xdf, 0x42, 0x05, 0x1d, 0xfe, 0x88, 0xc9, 0x96,
+	0x30, 0xcc, 0xd0, 0x98, 0x04, 0xdd, 0x21, 0xf5, 0x38, 0x6c, 0xf6, 0xb8, 0xa6, 0x5a, 0xd0, 0xe1,
+	0x16, 0xc5, 0x66, 0xd0, 0x33, 0x1b, 0x77, 0x34, 0xef, 0x2e, 0x1c, 0xde, 0x33, 0x1b, 0x7f, 0x04,
+	0xce, 0x83, 0x48, 0xd6, 0xe8, 0x29, 0x98, 0xd4, 0xd6, 0xc8, 0x3c, 0xd8, 0x0f, 0xd5, 0x5a, 0x1a,
+	0xd4, 0xa9, 0xd0, 0x66, 0x43, 0x0b, 0x1f, 0xf3, 0x46, 0x8e, 0x1d, 0xc1, 0x50, 0xdc, 0xe7, 0x09,
+	0xda, 0x70, 0x9f, 0xdb, 0x88, 0xbd, 0x81, 0x69, 0x14, 0x67, 0xe9, 0xda, 0xe0, 0x15, 0xea, 0x58,
+	0x45, 0xb4, 0xcf, 0x3e, 0x6f, 0x26, 0xbd, 0xaf, 0x30, 0x6b, 0x4a, 0xc1, 0x5e, 0xd3, 0x4a, 0xa5,
+	0xc4, 0xe4, 0xdb, 0xa5, 0xfd, 0x61, 0x95, 0x78, 0xee, 0x6f, 0xde, 0xef, 0x2e, 0xec, 0x97, 0xd3,
+	0x1a, 0x61, 0xf0, 0x85, 0x36, 0x87, 0xe0, 0x48, 0x25, 0x43, 0xb4, 0x5d, 0x8a, 0x80, 0x9d, 0xc0,
+	0xde, 0x8d, 0x48, 0x84, 0x0c, 0x71, 0x65, 0x4f, 0xbb, 0x8d, 0x6b, 0x35, 0x9f, 0x84, 0xae, 0x6a,
+	0x3e, 0xfb, 0x00, 0x90, 0xc5, 0xb7, 0x52, 0x98, 0xb5, 0xc6, 0xcc, 0x75, 0xe6, 0xfd, 0xc5, 0xe4,
+	0xfc, 0xc0, 0x4a, 0x70, 0x5d, 0x16, 0x78, 0x0d, 0xe3, 0xfd, 0xdc, 0x9e, 0xb6, 0x10, 0x70, 0xf7,
+	0x69, 0xcf, 0xc0, 0xc9, 0xf2, 0xa1, 0xac, 0x87, 0x5b, 0x96, 0xa4, 0x79, 0x79, 0x81, 0xf0, 0xbe,
+	0xc0, 0x41, 0xdb, 0x78, 0x15, 0xbd, 0xfb, 0x22, 0xfd, 0x12, 0x8e, 0x9f, 0xf1, 0xdd, 0xbf, 0x74,
+	0x79, 0xb7, 0xbd, 0xb0, 0x85, 0xf3, 0x76, 0x8f, 0xe7, 0xfd, 0xea, 0x93, 0x09, 0xee, 0x51, 0x9a,
+	0x8b, 0x22, 0x99, 0x1b, 0x34, 0x8e, 0x2c, 0xb2, 0x17, 0x47, 0xb9, 0xec, 0xe4, 0xb6, 0x15, 0xad,
+	0x60, 0xcc, 0x6d, 0xb4, 0xcd, 0xfb, 0xa4, 0x57, 0x99, 0xf7, 0x73, 0xb5, 0xec, 0x7d, 0x5e, 0x95,
+	0x6a, 0x95, 0x71, 0xad, 0xe6, 0xd3, 0x95, 0xab, 0x6a, 0xfe, 0x63, 0xd3, 0x0e, 0x9f, 0x30, 0x6d,
+	0xfe, 0xd7, 0x7c, 0xd0, 0x75, 0x66, 0xaf, 0x90, 0x8d, 0x2a, 0x57, 0xed, 0x3d, 0xe7, 0xaa, 0xf1,
+	0x0e, 0x57, 0x41, 0xcb, 0x55, 0x47, 0x30, 0xa4, 0x47, 0x46, 0xbb, 0x93, 0x62, 0xb6, 0x22, 0x62,
+	0x73, 0x98, 0xd0, 0x57, 0x80, 0xf1, 0xed, 0x9d, 0x71, 0xf7, 0x89, 0x56, 0x4f, 0xb1, 0x53, 0x80,
+	0xfc, 0xf5, 0xb3, 0x80, 0x29, 0x01, 0x6a, 0x19, 0xc6, 0x60, 0x20, 0xa2, 0x48, 0xbb, 0x33, 0xea,
+	0x4b, 0xdf, 0x5e, 0x02, 0x33, 0x8e, 0x21, 0xc6, 0xa9, 0xb1, 0xd2, 0xb1, 0x33, 0x18, 0xa4, 0x1a,
+	0x1f, 0xac, 0xde, 0xb5, 0x87, 0xb3, 0x26, 0x14, 0x27, 0x08, 0x7b, 0x0f, 0xa3, 0x70, 0xad, 0x35,
+	0xca, 0x27, 0x9e, 0xd9, 0x3a, 0xba, 0x44, 0xdd, 0x0c, 0xe9, 0x69, 0xff, 0xf4, 0x37, 0x00, 0x00,
+	0xff, 0xff, 0x17, 0x75, 0xc9, 0xb8, 0x05, 0x06, 0x00, 0x00,
+}
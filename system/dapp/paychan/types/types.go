@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types paychan插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// PaychanX 执行器名称
+	PaychanX   = "paychan"
+	actionName = map[string]int32{
+		"Open":             PaychanActionOpen,
+		"Deposit":          PaychanActionDeposit,
+		"Close":            PaychanActionClose,
+		"Challenge":        PaychanActionChallenge,
+		"CooperativeClose": PaychanActionCooperativeClose,
+		"Settle":           PaychanActionSettle,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogPaychanOpen:      {Ty: reflect.TypeOf(ReceiptPaychan{}), Name: "LogPaychanOpen"},
+		TyLogPaychanDeposit:   {Ty: reflect.TypeOf(ReceiptPaychan{}), Name: "LogPaychanDeposit"},
+		TyLogPaychanClose:     {Ty: reflect.TypeOf(ReceiptPaychan{}), Name: "LogPaychanClose"},
+		TyLogPaychanChallenge: {Ty: reflect.TypeOf(ReceiptPaychan{}), Name: "LogPaychanChallenge"},
+		TyLogPaychanSettle:    {Ty: reflect.TypeOf(ReceiptPaychan{}), Name: "LogPaychanSettle"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(PaychanX))
+	types.RegistorExecutor(PaychanX, NewType())
+	types.RegisterDappFork(PaychanX, "Enable", 0)
+}
+
+// PaychanType paychan执行器类型
+type PaychanType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a paychan type object
+func NewType() *PaychanType {
+	c := &PaychanType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return paychan action
+func (p *PaychanType) GetPayload() types.Message {
+	return &PaychanAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (p *PaychanType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (p *PaychanType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (p *PaychanType) GetName() string {
+	return PaychanX
+}
+
+//SignData 通道双方签名的数据，不包括签名
+func SignData(state *PaychanState) []byte {
+	data := *state
+	data.Signatures = nil
+	return types.Encode(&data)
+}
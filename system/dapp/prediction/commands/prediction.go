@@ -0,0 +1,264 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands prediction插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	pty "github.com/33cn/chain33/system/dapp/prediction/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// PredictionCmd prediction command
+func PredictionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prediction",
+		Short: "Prediction markets resolved by oracle feeds",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CreateCmd(),
+		BuyCmd(),
+		SellCmd(),
+		ResolveCmd(),
+		ClaimCmd(),
+		QueryMarketCmd(),
+		QueryPositionCmd(),
+		ListMarketsCmd(),
+		ListPositionsCmd(),
+	)
+
+	return cmd
+}
+
+// CreateCmd create market
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a transaction to create a market",
+		Run:   create,
+	}
+	cmd.Flags().StringP("question", "q", "", "question of market")
+	cmd.MarkFlagRequired("question")
+	cmd.Flags().StringSliceP("outcomes", "o", []string{"yes", "no"}, "outcomes, the oracle value is the index of outcome")
+	cmd.Flags().StringP("feed", "f", "", "oracle feed name")
+	cmd.MarkFlagRequired("feed")
+	cmd.Flags().Int64P("close", "c", 0, "height after which trading is closed")
+	cmd.MarkFlagRequired("close")
+	cmd.Flags().Int32P("fee", "r", 0, "fee rate of creator in per mille")
+	return cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	question, _ := cmd.Flags().GetString("question")
+	outcomes, _ := cmd.Flags().GetStringSlice("outcomes")
+	feed, _ := cmd.Flags().GetString("feed")
+	closeHeight, _ := cmd.Flags().GetInt64("close")
+	fee, _ := cmd.Flags().GetInt32("fee")
+	payload := &pty.PredictionCreate{Question: question, Outcomes: outcomes, Feed: feed, CloseHeight: closeHeight, FeeRate: fee}
+	if err := pty.CheckCreate(payload); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, pty.PredictionX, &pty.PredictionAction{
+		Ty:    pty.PredictionActionCreate,
+		Value: &pty.PredictionAction_Create{Create: payload},
+	})
+}
+
+func addTradeFlags(cmd *cobra.Command, name, usage string) {
+	cmd.Flags().StringP("id", "i", "", "market id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().Int32P("outcome", "o", 0, "index of outcome")
+	cmd.MarkFlagRequired("outcome")
+	cmd.Flags().Float64P(name, "a", 0, usage)
+	cmd.MarkFlagRequired(name)
+}
+
+// BuyCmd buy shares
+func BuyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy",
+		Short: "Create a transaction to buy shares of outcome",
+		Run:   buy,
+	}
+	addTradeFlags(cmd, "amount", "coins to pay, one coin for one share")
+	return cmd
+}
+
+func buy(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	outcome, _ := cmd.Flags().GetInt32("outcome")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	commandtypes.CreateActionTx(cmd, pty.PredictionX, &pty.PredictionAction{
+		Ty:    pty.PredictionActionBuy,
+		Value: &pty.PredictionAction_Buy{Buy: &pty.PredictionBuy{MarketID: id, Outcome: outcome, Amount: commandtypes.FormatAmountDisplay2Value(amount)}},
+	})
+}
+
+// SellCmd sell shares
+func SellCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell",
+		Short: "Create a transaction to sell shares of outcome before close",
+		Run:   sell,
+	}
+	addTradeFlags(cmd, "shares", "shares to sell")
+	return cmd
+}
+
+func sell(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	outcome, _ := cmd.Flags().GetInt32("outcome")
+	shares, _ := cmd.Flags().GetFloat64("shares")
+	commandtypes.CreateActionTx(cmd, pty.PredictionX, &pty.PredictionAction{
+		Ty:    pty.PredictionActionSell,
+		Value: &pty.PredictionAction_Sell{Sell: &pty.PredictionSell{MarketID: id, Outcome: outcome, Shares: commandtypes.FormatAmountDisplay2Value(shares)}},
+	})
+}
+
+// ResolveCmd resolve market
+func ResolveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve",
+		Short: "Create a transaction to resolve market by oracle",
+		Run:   resolve,
+	}
+	cmd.Flags().StringP("id", "i", "", "market id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func resolve(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, pty.PredictionX, &pty.PredictionAction{
+		Ty:    pty.PredictionActionResolve,
+		Value: &pty.PredictionAction_Resolve{Resolve: &pty.PredictionResolve{MarketID: id}},
+	})
+}
+
+// ClaimCmd claim payout
+func ClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Short: "Create a transaction to claim payout of resolved market",
+		Run:   claim,
+	}
+	cmd.Flags().StringP("id", "i", "", "market id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func claim(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, pty.PredictionX, &pty.PredictionAction{
+		Ty:    pty.PredictionActionClaim,
+		Value: &pty.PredictionAction_Claim{Claim: &pty.PredictionClaim{MarketID: id}},
+	})
+}
+
+func queryPrediction(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, pty.PredictionX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryMarketCmd query market
+func QueryMarketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market",
+		Short: "Query market by id",
+		Run:   queryMarket,
+	}
+	cmd.Flags().StringP("id", "i", "", "market id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryMarket(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res pty.PredictionMarket
+	queryPrediction(cmd, pty.FuncNameGetMarket, &types.ReqString{Data: id}, &res)
+}
+
+// QueryPositionCmd query position
+func QueryPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position",
+		Short: "Query position of address in market",
+		Run:   queryPosition,
+	}
+	cmd.Flags().StringP("id", "i", "", "market id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func queryPosition(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res pty.PredictionPosition
+	queryPrediction(cmd, pty.FuncNameGetPosition, &pty.ReqPredictionPosition{MarketID: id, Addr: addr}, &res)
+}
+
+// ListMarketsCmd list open markets
+func ListMarketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "markets",
+		Short: "List markets not resolved",
+		Run:   listMarkets,
+	}
+	cmd.Flags().StringP("primary", "p", "", "list after this market id")
+	cmd.Flags().Int32P("count", "c", pty.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listMarkets(cmd *cobra.Command, args []string) {
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res pty.ReplyPredictionMarkets
+	queryPrediction(cmd, pty.FuncNameListOpenMarkets, &pty.ReqPredictionMarkets{PrimaryKey: primary, Count: count, Direction: direction}, &res)
+}
+
+// ListPositionsCmd list positions of address
+func ListPositionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions",
+		Short: "List positions of address",
+		Run:   listPositions,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("primary", "p", "", "list after this market id")
+	cmd.Flags().Int32P("count", "c", pty.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listPositions(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res pty.ReplyPredictionPositions
+	queryPrediction(cmd, pty.FuncNameListPositions, &pty.ReqPredictionPositions{Addr: addr, PrimaryKey: primary, Count: count, Direction: direction}, &res)
+}
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	pty "github.com/33cn/chain33/system/dapp/prediction/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Create 创建预测市场
+func (p *Prediction) Exec_Create(payload *pty.PredictionCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.create(payload)
+}
+
+// Exec_Buy 买入一个结果的份额
+func (p *Prediction) Exec_Buy(payload *pty.PredictionBuy, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.buy(payload)
+}
+
+// Exec_Sell 停止交易之前卖出份额
+func (p *Prediction) Exec_Sell(payload *pty.PredictionSell, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.sell(payload)
+}
+
+// Exec_Resolve 按oracle的结果结算市场
+func (p *Prediction) Exec_Resolve(payload *pty.PredictionResolve, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.resolve(payload)
+}
+
+// Exec_Claim 领取奖金或者作废市场的退款
+func (p *Prediction) Exec_Claim(payload *pty.PredictionClaim, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(p, tx, index)
+	return action.claim(payload)
+}
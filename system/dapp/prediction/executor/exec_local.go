@@ -0,0 +1,67 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	pty "github.com/33cn/chain33/system/dapp/prediction/types"
+	"github.com/33cn/chain33/types"
+)
+
+//execLocal 新市场加入未结算的索引，结算以后删除，第一次持仓的时候添加地址索引
+func (p *Prediction) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		switch item.Ty {
+		case pty.TyLogPredictionMarket:
+			var log pty.ReceiptPredictionMarket
+			if err := types.Decode(item.Log, &log); err != nil {
+				return nil, err
+			}
+			id := log.Current.MarketID
+			if log.Prev == nil {
+				kvs = append(kvs, &types.KeyValue{Key: calcOpenIndexKey(id), Value: []byte(id)})
+			} else if log.Prev.Status == pty.StatusOpen && log.Current.Status != pty.StatusOpen {
+				kvs = append(kvs, &types.KeyValue{Key: calcOpenIndexKey(id), Value: nil})
+			}
+		case pty.TyLogPredictionPosition:
+			var log pty.ReceiptPredictionPosition
+			if err := types.Decode(item.Log, &log); err != nil {
+				return nil, err
+			}
+			if log.Prev == nil {
+				kvs = append(kvs, &types.KeyValue{Key: calcAddrIndexKey(log.Current.Addr, log.Current.MarketID), Value: []byte(log.Current.MarketID)})
+			}
+		}
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+// ExecLocal_Create 添加未结算市场的索引
+func (p *Prediction) ExecLocal_Create(payload *pty.PredictionCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return p.execLocal(tx, receipt)
+}
+
+// ExecLocal_Buy 添加持仓的地址索引
+func (p *Prediction) ExecLocal_Buy(payload *pty.PredictionBuy, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return p.execLocal(tx, receipt)
+}
+
+// ExecLocal_Sell 卖出不会产生新的索引
+func (p *Prediction) ExecLocal_Sell(payload *pty.PredictionSell, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return p.execLocal(tx, receipt)
+}
+
+// ExecLocal_Resolve 删除未结算市场的索引
+func (p *Prediction) ExecLocal_Resolve(payload *pty.PredictionResolve, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return p.execLocal(tx, receipt)
+}
+
+// ExecLocal_Claim 领取不会产生新的索引
+func (p *Prediction) ExecLocal_Claim(payload *pty.PredictionClaim, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return p.execLocal(tx, receipt)
+}
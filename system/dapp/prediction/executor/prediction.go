@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor prediction执行器，按oracle数据源的结果结算的预测市场
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	pty "github.com/33cn/chain33/system/dapp/prediction/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.prediction")
+	driverName = pty.PredictionX
+)
+
+func init() {
+	et := types.LoadExecutorType(driverName)
+	et.InitFuncList(types.ListMethod(&Prediction{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newPrediction, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return prediction name
+func GetName() string {
+	return newPrediction().GetName()
+}
+
+// Prediction defines Prediction object
+type Prediction struct {
+	drivers.DriverBase
+}
+
+func newPrediction() drivers.Driver {
+	p := &Prediction{}
+	p.SetChild(p)
+	p.SetExecutorType(types.LoadExecutorType(driverName))
+	return p
+}
+
+// GetDriverName return a drivername
+func (p *Prediction) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (p *Prediction) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,151 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	pty "github.com/33cn/chain33/system/dapp/prediction/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, execer, action string, param types.Message) (int32, string) {
+	_, detail, err := mock33.SendCallTx(priv, execer, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, fmt.Sprintf("%018d", detail.Height*types.MaxTxsPerBlock+detail.Index)
+}
+
+func send(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) int32 {
+	ty, _ := sendTx(t, mock33, priv, pty.PredictionX, action, param)
+	return ty
+}
+
+func query(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(pty.PredictionX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func getMarket(t *testing.T, mock33 *testnode.Chain33Mock, id string) *pty.PredictionMarket {
+	return query(t, mock33, pty.FuncNameGetMarket, &types.ReqString{Data: id}).(*pty.PredictionMarket)
+}
+
+func TestPrediction(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	creator := mock33.GetGenesisKey()
+	creatorAddr := mock33.GetGenesisAddress()
+	//manage合约的超级管理员创建数据源，配置发布者
+	manager := util.TestPrivkeyList[0]
+	publisher, publisherPriv := util.Genaddress()
+	p1, p1Priv := util.Genaddress()
+	p2, p2Priv := util.Genaddress()
+	for _, to := range []string{p1, p2, publisher, address.PubKeyToAddress(manager.PubKey().Bytes()).String()} {
+		mock33.SendTx(util.CreateCoinsTx(creator, to, 100*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	for _, priv := range []crypto.PrivKey{p1Priv, p2Priv} {
+		mock33.SendTx(util.CreateCoinsTx(priv, address.ExecAddress(pty.PredictionX), 50*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	ty, _ := sendTx(t, mock33, manager, oty.OracleX, "FeedCreate", &oty.OracleFeedCreate{Name: "MATCH", Rule: oty.RuleMedian, Quorum: 1})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendTx(t, mock33, manager, "manage", "Modify", &types.ModifyConfig{Key: oty.PublisherKey, Value: publisher, Op: "add"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	round := int64(1)
+	attest := func(value int64) {
+		ty, _ := sendTx(t, mock33, publisherPriv, oty.OracleX, "Publish", &oty.OraclePublish{Point: &oty.OracleDataPoint{Feed: "MATCH", Round: round, Value: value}})
+		assert.Equal(t, int32(types.ExecOk), ty)
+		round++
+	}
+
+	closeHeight := mock33.GetLastBlock().Height + 20
+	create := &pty.PredictionCreate{Question: "who wins", Outcomes: []string{"home", "draw", "away"}, Feed: "MATCH", CloseHeight: closeHeight, FeeRate: 20}
+	for _, bad := range []*pty.PredictionCreate{
+		{Question: "who wins", Outcomes: []string{"home"}, Feed: "MATCH", CloseHeight: closeHeight},
+		{Question: "who wins", Outcomes: []string{"home", "home"}, Feed: "MATCH", CloseHeight: closeHeight},
+		{Question: "who wins", Outcomes: []string{"yes", "no"}, Feed: "NONE", CloseHeight: closeHeight},
+		{Question: "who wins", Outcomes: []string{"yes", "no"}, Feed: "MATCH", CloseHeight: 1},
+		{Question: "who wins", Outcomes: []string{"yes", "no"}, Feed: "MATCH", CloseHeight: closeHeight, FeeRate: 101},
+	} {
+		assert.Equal(t, int32(types.ExecPack), send(t, mock33, creator, "Create", bad))
+	}
+	ty, id := sendTx(t, mock33, creator, pty.PredictionX, "Create", create)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, voidID := sendTx(t, mock33, creator, pty.PredictionX, "Create", &pty.PredictionCreate{Question: "rain", Outcomes: []string{"yes", "no"}, Feed: "MATCH", CloseHeight: closeHeight})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	markets := query(t, mock33, pty.FuncNameListOpenMarkets, &pty.ReqPredictionMarkets{}).(*pty.ReplyPredictionMarkets)
+	assert.Equal(t, 2, len(markets.Markets))
+	assert.Equal(t, voidID, markets.Markets[0].MarketID)
+
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p1Priv, "Buy", &pty.PredictionBuy{MarketID: id, Outcome: 0, Amount: 10 * types.Coin}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p1Priv, "Buy", &pty.PredictionBuy{MarketID: id, Outcome: 2, Amount: 5 * types.Coin}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p2Priv, "Buy", &pty.PredictionBuy{MarketID: id, Outcome: 0, Amount: 30 * types.Coin}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p2Priv, "Buy", &pty.PredictionBuy{MarketID: id, Outcome: 1, Amount: 15 * types.Coin}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p2Priv, "Buy", &pty.PredictionBuy{MarketID: voidID, Outcome: 1, Amount: 5 * types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Buy", &pty.PredictionBuy{MarketID: id, Outcome: 3, Amount: types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Buy", &pty.PredictionBuy{MarketID: id, Outcome: 0, Amount: 50 * types.Coin}))
+	//卖出部分份额
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Sell", &pty.PredictionSell{MarketID: id, Outcome: 2, Shares: 6 * types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Sell", &pty.PredictionSell{MarketID: id, Outcome: 1, Shares: types.Coin}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p1Priv, "Sell", &pty.PredictionSell{MarketID: id, Outcome: 2, Shares: 3 * types.Coin}))
+	assert.Equal(t, 38*types.Coin, mock33.GetExecBalance(pty.PredictionX, p1))
+	market := getMarket(t, mock33, id)
+	assert.Equal(t, []int64{40 * types.Coin, 15 * types.Coin, 2 * types.Coin}, market.Shares)
+	assert.Equal(t, 57*types.Coin, market.Pool)
+	position := query(t, mock33, pty.FuncNameGetPosition, &pty.ReqPredictionPosition{MarketID: id, Addr: p1}).(*pty.PredictionPosition)
+	assert.Equal(t, []int64{10 * types.Coin, 0, 2 * types.Coin}, position.Shares)
+
+	//停止交易之前的oracle结果不能用来结算
+	attest(0)
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Resolve", &pty.PredictionResolve{MarketID: id}))
+	assert.Nil(t, mock33.CreateBlocksTo(closeHeight))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Buy", &pty.PredictionBuy{MarketID: id, Outcome: 0, Amount: types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Resolve", &pty.PredictionResolve{MarketID: id}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Claim", &pty.PredictionClaim{MarketID: id}))
+	attest(0)
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p1Priv, "Resolve", &pty.PredictionResolve{MarketID: id}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Resolve", &pty.PredictionResolve{MarketID: id}))
+	market = getMarket(t, mock33, id)
+	assert.Equal(t, int32(pty.StatusResolved), market.Status)
+	assert.Equal(t, int32(0), market.Outcome)
+	fee := 57 * types.Coin * 20 / 1000
+	assert.Equal(t, fee, market.Fee)
+	assert.Equal(t, fee, mock33.GetExecBalance(pty.PredictionX, creatorAddr))
+
+	//赢的份额按比例分配扣除手续费以后的资金
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p1Priv, "Claim", &pty.PredictionClaim{MarketID: id}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Claim", &pty.PredictionClaim{MarketID: id}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p2Priv, "Claim", &pty.PredictionClaim{MarketID: id}))
+	p1Payout := (57*types.Coin - fee) / 4
+	p2Payout := (57*types.Coin - fee) * 3 / 4
+	assert.Equal(t, 38*types.Coin+p1Payout, mock33.GetExecBalance(pty.PredictionX, p1))
+	assert.Equal(t, p2Payout, mock33.GetExecBalance(pty.PredictionX, p2))
+	assert.Equal(t, 57*types.Coin-fee-p1Payout-p2Payout, mock33.GetExecBalance(pty.PredictionX, address.ExecAddress(pty.PredictionX+"-market-"+id)))
+
+	//结果超出范围的时候作废市场，退回所有的份额
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p1Priv, "Resolve", &pty.PredictionResolve{MarketID: voidID}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, p1Priv, "Claim", &pty.PredictionClaim{MarketID: voidID}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, p2Priv, "Claim", &pty.PredictionClaim{MarketID: voidID}))
+	assert.Equal(t, 5*types.Coin+p2Payout, mock33.GetExecBalance(pty.PredictionX, p2))
+	assert.Equal(t, int32(pty.StatusVoided), getMarket(t, mock33, voidID).Status)
+
+	markets = query(t, mock33, pty.FuncNameListOpenMarkets, &pty.ReqPredictionMarkets{}).(*pty.ReplyPredictionMarkets)
+	assert.Equal(t, 0, len(markets.Markets))
+	positions := query(t, mock33, pty.FuncNameListPositions, &pty.ReqPredictionPositions{Addr: p2}).(*pty.ReplyPredictionPositions)
+	assert.Equal(t, 2, len(positions.Positions))
+	assert.True(t, positions.Positions[0].Claimed)
+	assert.Equal(t, 5*types.Coin, positions.Positions[0].Payout)
+}
@@ -0,0 +1,383 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	oracle "github.com/33cn/chain33/system/dapp/oracle/executor"
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	pty "github.com/33cn/chain33/system/dapp/prediction/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	marketKeyPrefix   = "mavl-" + pty.PredictionX + "-market-"
+	positionKeyPrefix = "mavl-" + pty.PredictionX + "-position-"
+	openIndexPrefix   = "LODB-" + pty.PredictionX + "-open-"
+	addrIndexPrefix   = "LODB-" + pty.PredictionX + "-addr-"
+)
+
+func calcMarketKey(id string) []byte {
+	return []byte(marketKeyPrefix + id)
+}
+
+func calcPositionKey(id, addr string) []byte {
+	return []byte(positionKeyPrefix + id + "-" + addr)
+}
+
+func calcOpenIndexKey(id string) []byte {
+	return []byte(openIndexPrefix + id)
+}
+
+func calcAddrIndexKey(addr, id string) []byte {
+	return []byte(addrIndexPrefix + addr + "-" + id)
+}
+
+//calcMarketID 市场的id按创建交易的位置生成
+func calcMarketID(height int64, index int) string {
+	return fmt.Sprintf("%018d", height*types.MaxTxsPerBlock+int64(index))
+}
+
+//calcMarketAddr 每个市场的资金存在一个没有私钥的地址中
+func calcMarketAddr(id string) string {
+	return address.ExecAddress(pty.PredictionX + "-market-" + id)
+}
+
+//calcPayout 赢的份额按比例分配扣除手续费以后的资金，向下取整
+func calcPayout(shares, pool, total int64) int64 {
+	v := new(big.Int).Mul(big.NewInt(shares), big.NewInt(pool))
+	return v.Div(v, big.NewInt(total)).Int64()
+}
+
+// Action prediction交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+	kvs          []*types.KeyValue
+	logs         []*types.ReceiptLog
+}
+
+// NewAction new a action object
+func NewAction(p *Prediction, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: p.GetCoinsAccount(),
+		db:           p.GetStateDB(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       p.GetHeight(),
+		index:        index,
+	}
+}
+
+func (a *Action) receipt() *types.Receipt {
+	return &types.Receipt{Ty: types.ExecOk, KV: a.kvs, Logs: a.logs}
+}
+
+//transfer 在prediction执行器的coins账户之间转账，金额为0的时候不转账
+func (a *Action) transfer(from, to string, amount int64) error {
+	if amount == 0 {
+		return nil
+	}
+	receipt, err := a.coinsAccount.ExecTransfer(from, to, a.execaddr, amount)
+	if err != nil {
+		return err
+	}
+	a.kvs = append(a.kvs, receipt.KV...)
+	a.logs = append(a.logs, receipt.Logs...)
+	return nil
+}
+
+func getMarket(db dbm.KV, id string) (*pty.PredictionMarket, error) {
+	value, err := db.Get(calcMarketKey(id))
+	if err != nil || len(value) == 0 {
+		return nil, pty.ErrMarketNotExist
+	}
+	var market pty.PredictionMarket
+	if err := types.Decode(value, &market); err != nil {
+		return nil, err
+	}
+	return &market, nil
+}
+
+func (a *Action) saveMarket(prev, market *pty.PredictionMarket) {
+	kv := &types.KeyValue{Key: calcMarketKey(market.MarketID), Value: types.Encode(market)}
+	a.db.Set(kv.Key, kv.Value)
+	a.kvs = append(a.kvs, kv)
+	log := &pty.ReceiptPredictionMarket{Prev: prev, Current: market}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: pty.TyLogPredictionMarket, Log: types.Encode(log)})
+}
+
+//getPosition 没有持仓的时候返回nil
+func getPosition(db dbm.KV, id, addr string) (*pty.PredictionPosition, error) {
+	value, err := db.Get(calcPositionKey(id, addr))
+	if err != nil || len(value) == 0 {
+		return nil, nil
+	}
+	var position pty.PredictionPosition
+	if err := types.Decode(value, &position); err != nil {
+		return nil, err
+	}
+	return &position, nil
+}
+
+func (a *Action) savePosition(prev, position *pty.PredictionPosition) {
+	kv := &types.KeyValue{Key: calcPositionKey(position.MarketID, position.Addr), Value: types.Encode(position)}
+	a.db.Set(kv.Key, kv.Value)
+	a.kvs = append(a.kvs, kv)
+	log := &pty.ReceiptPredictionPosition{Prev: prev, Current: position}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: pty.TyLogPredictionPosition, Log: types.Encode(log)})
+}
+
+func copyMarket(market *pty.PredictionMarket) *pty.PredictionMarket {
+	m := *market
+	m.Shares = append([]int64{}, market.Shares...)
+	return &m
+}
+
+func copyPosition(position *pty.PredictionPosition) *pty.PredictionPosition {
+	p := *position
+	p.Shares = append([]int64{}, position.Shares...)
+	return &p
+}
+
+//loadTrading 读取还在交易的市场，检查结果的序号
+func (a *Action) loadTrading(id string, outcome int32) (*pty.PredictionMarket, error) {
+	market, err := getMarket(a.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if market.Status != pty.StatusOpen || a.height > market.CloseHeight {
+		return nil, pty.ErrMarketClosed
+	}
+	if outcome < 0 || int(outcome) >= len(market.Outcomes) {
+		return nil, pty.ErrOutcome
+	}
+	return market, nil
+}
+
+func (a *Action) create(payload *pty.PredictionCreate) (*types.Receipt, error) {
+	if err := pty.CheckCreate(payload); err != nil {
+		return nil, err
+	}
+	if payload.CloseHeight <= a.height {
+		return nil, pty.ErrCloseHeight
+	}
+	//数据源必须存在，可以还没有聚合结果
+	if _, err := oracle.GetLatestAttestation(a.db, payload.Feed); err != nil && err != oty.ErrAttestationNotExist {
+		return nil, err
+	}
+	market := &pty.PredictionMarket{
+		MarketID:    calcMarketID(a.height, a.index),
+		Creator:     a.fromaddr,
+		Question:    payload.Question,
+		Outcomes:    payload.Outcomes,
+		Feed:        payload.Feed,
+		CloseHeight: payload.CloseHeight,
+		FeeRate:     payload.FeeRate,
+		Shares:      make([]int64, len(payload.Outcomes)),
+		Status:      pty.StatusOpen,
+		Height:      a.height,
+	}
+	a.saveMarket(nil, market)
+	return a.receipt(), nil
+}
+
+func (a *Action) buy(payload *pty.PredictionBuy) (*types.Receipt, error) {
+	market, err := a.loadTrading(payload.MarketID, payload.Outcome)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Amount <= 0 {
+		return nil, pty.ErrShares
+	}
+	position, err := getPosition(a.db, market.MarketID, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.transfer(a.fromaddr, calcMarketAddr(market.MarketID), payload.Amount); err != nil {
+		return nil, err
+	}
+	current := &pty.PredictionPosition{MarketID: market.MarketID, Addr: a.fromaddr, Shares: make([]int64, len(market.Outcomes))}
+	if position != nil {
+		current = copyPosition(position)
+	}
+	current.Shares[payload.Outcome] += payload.Amount
+	a.savePosition(position, current)
+	next := copyMarket(market)
+	next.Shares[payload.Outcome] += payload.Amount
+	next.Pool += payload.Amount
+	a.saveMarket(market, next)
+	return a.receipt(), nil
+}
+
+func (a *Action) sell(payload *pty.PredictionSell) (*types.Receipt, error) {
+	market, err := a.loadTrading(payload.MarketID, payload.Outcome)
+	if err != nil {
+		return nil, err
+	}
+	position, err := getPosition(a.db, market.MarketID, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Shares <= 0 || position == nil || position.Shares[payload.Outcome] < payload.Shares {
+		return nil, pty.ErrShares
+	}
+	if err := a.transfer(calcMarketAddr(market.MarketID), a.fromaddr, payload.Shares); err != nil {
+		return nil, err
+	}
+	current := copyPosition(position)
+	current.Shares[payload.Outcome] -= payload.Shares
+	a.savePosition(position, current)
+	next := copyMarket(market)
+	next.Shares[payload.Outcome] -= payload.Shares
+	next.Pool -= payload.Shares
+	a.saveMarket(market, next)
+	return a.receipt(), nil
+}
+
+//resolve 用停止交易以后的聚合结果结算，结果超出范围、没有人买中或者超时没有结果的时候作废市场
+func (a *Action) resolve(payload *pty.PredictionResolve) (*types.Receipt, error) {
+	market, err := getMarket(a.db, payload.MarketID)
+	if err != nil {
+		return nil, err
+	}
+	if market.Status != pty.StatusOpen {
+		return nil, pty.ErrMarketResolved
+	}
+	if a.height <= market.CloseHeight {
+		return nil, pty.ErrMarketNotClosed
+	}
+	next := copyMarket(market)
+	next.Status = pty.StatusVoided
+	next.Outcome = -1
+	next.ResolveHeight = a.height
+	attestation, err := oracle.GetLatestAttestation(a.db, market.Feed)
+	if err != nil && err != oty.ErrAttestationNotExist {
+		return nil, err
+	}
+	if attestation == nil || attestation.Height <= market.CloseHeight {
+		if a.height <= market.CloseHeight+pty.ResolveTimeout {
+			return nil, pty.ErrNoAttestation
+		}
+	} else if attestation.Value >= 0 && attestation.Value < int64(len(market.Outcomes)) {
+		next.Outcome = int32(attestation.Value)
+		if market.Shares[next.Outcome] > 0 {
+			next.Status = pty.StatusResolved
+			next.Fee = market.Pool * int64(market.FeeRate) / pty.FeeRateBase
+		}
+	}
+	if err := a.transfer(calcMarketAddr(market.MarketID), market.Creator, next.Fee); err != nil {
+		return nil, err
+	}
+	a.saveMarket(market, next)
+	return a.receipt(), nil
+}
+
+//claim 结算的市场按赢的份额分配，作废的市场退回所有的份额
+func (a *Action) claim(payload *pty.PredictionClaim) (*types.Receipt, error) {
+	market, err := getMarket(a.db, payload.MarketID)
+	if err != nil {
+		return nil, err
+	}
+	if market.Status == pty.StatusOpen {
+		return nil, pty.ErrMarketNotResolved
+	}
+	position, err := getPosition(a.db, market.MarketID, a.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if position == nil {
+		return nil, pty.ErrNoPayout
+	}
+	if position.Claimed {
+		return nil, pty.ErrClaimed
+	}
+	var payout int64
+	if market.Status == pty.StatusResolved {
+		payout = calcPayout(position.Shares[market.Outcome], market.Pool-market.Fee, market.Shares[market.Outcome])
+	} else {
+		for _, shares := range position.Shares {
+			payout += shares
+		}
+	}
+	if payout == 0 {
+		return nil, pty.ErrNoPayout
+	}
+	if err := a.transfer(calcMarketAddr(market.MarketID), a.fromaddr, payout); err != nil {
+		return nil, err
+	}
+	current := copyPosition(position)
+	current.Claimed = true
+	current.Payout = payout
+	a.savePosition(position, current)
+	return a.receipt(), nil
+}
+
+func listCount(count int32) int32 {
+	if count <= 0 {
+		return pty.DefaultListCount
+	}
+	if count > pty.MaxListCount {
+		return pty.MaxListCount
+	}
+	return count
+}
+
+func listOpenMarkets(localdb dbm.KVDB, statedb dbm.KV, req *pty.ReqPredictionMarkets) (*pty.ReplyPredictionMarkets, error) {
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcOpenIndexKey(req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(openIndexPrefix), key, listCount(req.Count), req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &pty.ReplyPredictionMarkets{}
+	for _, value := range values {
+		market, err := getMarket(statedb, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.Markets = append(reply.Markets, market)
+		reply.PrimaryKey = market.MarketID
+	}
+	return reply, nil
+}
+
+func listPositions(localdb dbm.KVDB, statedb dbm.KV, req *pty.ReqPredictionPositions) (*pty.ReplyPredictionPositions, error) {
+	if req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcAddrIndexKey(req.Addr, req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(addrIndexPrefix+req.Addr+"-"), key, listCount(req.Count), req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &pty.ReplyPredictionPositions{}
+	for _, value := range values {
+		position, err := getPosition(statedb, string(value), req.Addr)
+		if err != nil {
+			return nil, err
+		}
+		if position == nil {
+			return nil, types.ErrNotFound
+		}
+		reply.Positions = append(reply.Positions, position)
+		reply.PrimaryKey = position.MarketID
+	}
+	return reply, nil
+}
@@ -0,0 +1,37 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	pty "github.com/33cn/chain33/system/dapp/prediction/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetMarket 按id查询市场
+func (p *Prediction) Query_GetMarket(in *types.ReqString) (types.Message, error) {
+	return getMarket(p.GetStateDB(), in.Data)
+}
+
+// Query_GetPosition 查询地址在市场中的持仓
+func (p *Prediction) Query_GetPosition(in *pty.ReqPredictionPosition) (types.Message, error) {
+	position, err := getPosition(p.GetStateDB(), in.MarketID, in.Addr)
+	if err != nil {
+		return nil, err
+	}
+	if position == nil {
+		return nil, types.ErrNotFound
+	}
+	return position, nil
+}
+
+// Query_ListOpenMarkets 列出还没有结算的市场
+func (p *Prediction) Query_ListOpenMarkets(in *pty.ReqPredictionMarkets) (types.Message, error) {
+	return listOpenMarkets(p.GetLocalDB(), p.GetStateDB(), in)
+}
+
+// Query_ListPositions 列出地址的持仓
+func (p *Prediction) Query_ListPositions(in *pty.ReqPredictionPositions) (types.Message, error) {
+	return listPositions(p.GetLocalDB(), p.GetStateDB(), in)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package prediction 预测市场执行器插件
+// 1. 创建者设置问题、结果和用来结算的oracle数据源，两个结果的就是二元市场
+// 2. 停止交易之前用coins按1:1买入结果的份额，也可以按原价卖出
+// 3. 停止交易以后数据源的聚合结果是赢的结果的序号，赢的份额按比例分配整个市场的资金
+package prediction
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/prediction/commands"
+	"github.com/33cn/chain33/system/dapp/prediction/executor"
+	"github.com/33cn/chain33/system/dapp/prediction/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.PredictionX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.PredictionCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// prediction action ty
+const (
+	PredictionActionCreate = iota + 1
+	PredictionActionBuy
+	PredictionActionSell
+	PredictionActionResolve
+	PredictionActionClaim
+)
+
+// prediction log ty
+const (
+	TyLogPredictionMarket   = 590
+	TyLogPredictionPosition = 591
+)
+
+// 市场状态，停止交易以后到结算之前还是StatusOpen
+const (
+	StatusOpen = iota
+	StatusResolved
+	StatusVoided
+)
+
+// query func name
+const (
+	FuncNameGetMarket       = "GetMarket"
+	FuncNameGetPosition     = "GetPosition"
+	FuncNameListOpenMarkets = "ListOpenMarkets"
+	FuncNameListPositions   = "ListPositions"
+	MaxQuestionLength       = 256
+	MaxOutcomeLength        = 64
+	MaxOutcomes             = 16
+	//FeeRateBase 手续费率的精度，MaxFeeRate 是10%
+	FeeRateBase = 1000
+	MaxFeeRate  = 100
+	//ResolveTimeout 停止交易以后超过这么多区块oracle还没有结果的时候作废市场，退回所有的份额
+	ResolveTimeout   = 100000
+	DefaultListCount = 20
+	MaxListCount     = 100
+)
@@ -0,0 +1,38 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrQuestion 问题为空或者太长
+	ErrQuestion = errors.New("ErrQuestion")
+	// ErrOutcomes 结果的数量或者名字不合法
+	ErrOutcomes = errors.New("ErrOutcomes")
+	// ErrCloseHeight 停止交易的高度必须在当前高度之后
+	ErrCloseHeight = errors.New("ErrCloseHeight")
+	// ErrFeeRate 手续费率超过范围
+	ErrFeeRate = errors.New("ErrFeeRate")
+	// ErrMarketNotExist 市场不存在
+	ErrMarketNotExist = errors.New("ErrMarketNotExist")
+	// ErrMarketClosed 市场已经停止交易
+	ErrMarketClosed = errors.New("ErrMarketClosed")
+	// ErrMarketNotClosed 市场还没有停止交易
+	ErrMarketNotClosed = errors.New("ErrMarketNotClosed")
+	// ErrMarketResolved 市场已经结算
+	ErrMarketResolved = errors.New("ErrMarketResolved")
+	// ErrMarketNotResolved 市场还没有结算
+	ErrMarketNotResolved = errors.New("ErrMarketNotResolved")
+	// ErrOutcome 结果的序号超出范围
+	ErrOutcome = errors.New("ErrOutcome")
+	// ErrShares 份额或者金额不合法，或者超过持有的份额
+	ErrShares = errors.New("ErrShares")
+	// ErrNoAttestation 数据源在停止交易以后还没有聚合结果
+	ErrNoAttestation = errors.New("ErrNoAttestation")
+	// ErrClaimed 已经领取过
+	ErrClaimed = errors.New("ErrClaimed")
+	// ErrNoPayout 没有可以领取的奖金
+	ErrNoPayout = errors.New("ErrNoPayout")
+)
@@ -0,0 +1,1176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: prediction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type PredictionAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*PredictionAction_Create
+	//	*PredictionAction_Buy
+	//	*PredictionAction_Sell
+	//	*PredictionAction_Resolve
+	//	*PredictionAction_Claim
+	Value                isPredictionAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                    `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *PredictionAction) Reset()         { *m = PredictionAction{} }
+func (m *PredictionAction) String() string { return proto.CompactTextString(m) }
+func (*PredictionAction) ProtoMessage()    {}
+func (*PredictionAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{0}
+}
+
+func (m *PredictionAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionAction.Unmarshal(m, b)
+}
+func (m *PredictionAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionAction.Marshal(b, m, deterministic)
+}
+func (m *PredictionAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionAction.Merge(m, src)
+}
+func (m *PredictionAction) XXX_Size() int {
+	return xxx_messageInfo_PredictionAction.Size(m)
+}
+func (m *PredictionAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionAction proto.InternalMessageInfo
+
+type isPredictionAction_Value interface {
+	isPredictionAction_Value()
+}
+
+type PredictionAction_Create struct {
+	Create *PredictionCreate `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type PredictionAction_Buy struct {
+	Buy *PredictionBuy `protobuf:"bytes,2,opt,name=buy,proto3,oneof"`
+}
+
+type PredictionAction_Sell struct {
+	Sell *PredictionSell `protobuf:"bytes,3,opt,name=sell,proto3,oneof"`
+}
+
+type PredictionAction_Resolve struct {
+	Resolve *PredictionResolve `protobuf:"bytes,4,opt,name=resolve,proto3,oneof"`
+}
+
+type PredictionAction_Claim struct {
+	Claim *PredictionClaim `protobuf:"bytes,5,opt,name=claim,proto3,oneof"`
+}
+
+func (*PredictionAction_Create) isPredictionAction_Value() {}
+
+func (*PredictionAction_Buy) isPredictionAction_Value() {}
+
+func (*PredictionAction_Sell) isPredictionAction_Value() {}
+
+func (*PredictionAction_Resolve) isPredictionAction_Value() {}
+
+func (*PredictionAction_Claim) isPredictionAction_Value() {}
+
+func (m *PredictionAction) GetValue() isPredictionAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *PredictionAction) GetCreate() *PredictionCreate {
+	if x, ok := m.GetValue().(*PredictionAction_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (m *PredictionAction) GetBuy() *PredictionBuy {
+	if x, ok := m.GetValue().(*PredictionAction_Buy); ok {
+		return x.Buy
+	}
+	return nil
+}
+
+func (m *PredictionAction) GetSell() *PredictionSell {
+	if x, ok := m.GetValue().(*PredictionAction_Sell); ok {
+		return x.Sell
+	}
+	return nil
+}
+
+func (m *PredictionAction) GetResolve() *PredictionResolve {
+	if x, ok := m.GetValue().(*PredictionAction_Resolve); ok {
+		return x.Resolve
+	}
+	return nil
+}
+
+func (m *PredictionAction) GetClaim() *PredictionClaim {
+	if x, ok := m.GetValue().(*PredictionAction_Claim); ok {
+		return x.Claim
+	}
+	return nil
+}
+
+func (m *PredictionAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*PredictionAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _PredictionAction_OneofMarshaler, _PredictionAction_OneofUnmarshaler, _PredictionAction_OneofSizer, []interface{}{
+		(*PredictionAction_Create)(nil),
+		(*PredictionAction_Buy)(nil),
+		(*PredictionAction_Sell)(nil),
+		(*PredictionAction_Resolve)(nil),
+		(*PredictionAction_Claim)(nil),
+	}
+}
+
+func _PredictionAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*PredictionAction)
+	// value
+	switch x := m.Value.(type) {
+	case *PredictionAction_Create:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Create); err != nil {
+			return err
+		}
+	case *PredictionAction_Buy:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Buy); err != nil {
+			return err
+		}
+	case *PredictionAction_Sell:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sell); err != nil {
+			return err
+		}
+	case *PredictionAction_Resolve:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Resolve); err != nil {
+			return err
+		}
+	case *PredictionAction_Claim:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Claim); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("PredictionAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _PredictionAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*PredictionAction)
+	switch tag {
+	case 1: // value.create
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PredictionCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &PredictionAction_Create{msg}
+		return true, err
+	case 2: // value.buy
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PredictionBuy)
+		err := b.DecodeMessage(msg)
+		m.Value = &PredictionAction_Buy{msg}
+		return true, err
+	case 3: // value.sell
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PredictionSell)
+		err := b.DecodeMessage(msg)
+		m.Value = &PredictionAction_Sell{msg}
+		return true, err
+	case 4: // value.resolve
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PredictionResolve)
+		err := b.DecodeMessage(msg)
+		m.Value = &PredictionAction_Resolve{msg}
+		return true, err
+	case 5: // value.claim
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PredictionClaim)
+		err := b.DecodeMessage(msg)
+		m.Value = &PredictionAction_Claim{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _PredictionAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*PredictionAction)
+	// value
+	switch x := m.Value.(type) {
+	case *PredictionAction_Create:
+		s := proto.Size(x.Create)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PredictionAction_Buy:
+		s := proto.Size(x.Buy)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PredictionAction_Sell:
+		s := proto.Size(x.Sell)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PredictionAction_Resolve:
+		s := proto.Size(x.Resolve)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PredictionAction_Claim:
+		s := proto.Size(x.Claim)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//创建市场，两个结果的就是二元市场，closeHeight 之后停止交易，
+//用oracle数据源 feed 在停止交易以后的聚合结果作为结果的序号，feeRate 是创建者收取的千分比手续费
+type PredictionCreate struct {
+	Question             string   `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Outcomes             []string `protobuf:"bytes,2,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	Feed                 string   `protobuf:"bytes,3,opt,name=feed,proto3" json:"feed,omitempty"`
+	CloseHeight          int64    `protobuf:"varint,4,opt,name=closeHeight,proto3" json:"closeHeight,omitempty"`
+	FeeRate              int32    `protobuf:"varint,5,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredictionCreate) Reset()         { *m = PredictionCreate{} }
+func (m *PredictionCreate) String() string { return proto.CompactTextString(m) }
+func (*PredictionCreate) ProtoMessage()    {}
+func (*PredictionCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{1}
+}
+
+func (m *PredictionCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionCreate.Unmarshal(m, b)
+}
+func (m *PredictionCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionCreate.Marshal(b, m, deterministic)
+}
+func (m *PredictionCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionCreate.Merge(m, src)
+}
+func (m *PredictionCreate) XXX_Size() int {
+	return xxx_messageInfo_PredictionCreate.Size(m)
+}
+func (m *PredictionCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionCreate proto.InternalMessageInfo
+
+func (m *PredictionCreate) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *PredictionCreate) GetOutcomes() []string {
+	if m != nil {
+		return m.Outcomes
+	}
+	return nil
+}
+
+func (m *PredictionCreate) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *PredictionCreate) GetCloseHeight() int64 {
+	if m != nil {
+		return m.CloseHeight
+	}
+	return 0
+}
+
+func (m *PredictionCreate) GetFeeRate() int32 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+//用amount的coins买入一个结果的amount份额
+type PredictionBuy struct {
+	MarketID             string   `protobuf:"bytes,1,opt,name=marketID,proto3" json:"marketID,omitempty"`
+	Outcome              int32    `protobuf:"varint,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredictionBuy) Reset()         { *m = PredictionBuy{} }
+func (m *PredictionBuy) String() string { return proto.CompactTextString(m) }
+func (*PredictionBuy) ProtoMessage()    {}
+func (*PredictionBuy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{2}
+}
+
+func (m *PredictionBuy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionBuy.Unmarshal(m, b)
+}
+func (m *PredictionBuy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionBuy.Marshal(b, m, deterministic)
+}
+func (m *PredictionBuy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionBuy.Merge(m, src)
+}
+func (m *PredictionBuy) XXX_Size() int {
+	return xxx_messageInfo_PredictionBuy.Size(m)
+}
+func (m *PredictionBuy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionBuy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionBuy proto.InternalMessageInfo
+
+func (m *PredictionBuy) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PredictionBuy) GetOutcome() int32 {
+	if m != nil {
+		return m.Outcome
+	}
+	return 0
+}
+
+func (m *PredictionBuy) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//停止交易之前按买入的价格卖出份额
+type PredictionSell struct {
+	MarketID             string   `protobuf:"bytes,1,opt,name=marketID,proto3" json:"marketID,omitempty"`
+	Outcome              int32    `protobuf:"varint,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Shares               int64    `protobuf:"varint,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredictionSell) Reset()         { *m = PredictionSell{} }
+func (m *PredictionSell) String() string { return proto.CompactTextString(m) }
+func (*PredictionSell) ProtoMessage()    {}
+func (*PredictionSell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{3}
+}
+
+func (m *PredictionSell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionSell.Unmarshal(m, b)
+}
+func (m *PredictionSell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionSell.Marshal(b, m, deterministic)
+}
+func (m *PredictionSell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionSell.Merge(m, src)
+}
+func (m *PredictionSell) XXX_Size() int {
+	return xxx_messageInfo_PredictionSell.Size(m)
+}
+func (m *PredictionSell) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionSell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionSell proto.InternalMessageInfo
+
+func (m *PredictionSell) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PredictionSell) GetOutcome() int32 {
+	if m != nil {
+		return m.Outcome
+	}
+	return 0
+}
+
+func (m *PredictionSell) GetShares() int64 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+//停止交易以后任何人都可以按oracle的结果结算市场
+type PredictionResolve struct {
+	MarketID             string   `protobuf:"bytes,1,opt,name=marketID,proto3" json:"marketID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredictionResolve) Reset()         { *m = PredictionResolve{} }
+func (m *PredictionResolve) String() string { return proto.CompactTextString(m) }
+func (*PredictionResolve) ProtoMessage()    {}
+func (*PredictionResolve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{4}
+}
+
+func (m *PredictionResolve) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionResolve.Unmarshal(m, b)
+}
+func (m *PredictionResolve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionResolve.Marshal(b, m, deterministic)
+}
+func (m *PredictionResolve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionResolve.Merge(m, src)
+}
+func (m *PredictionResolve) XXX_Size() int {
+	return xxx_messageInfo_PredictionResolve.Size(m)
+}
+func (m *PredictionResolve) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionResolve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionResolve proto.InternalMessageInfo
+
+func (m *PredictionResolve) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+//结算以后领取自己的奖金
+type PredictionClaim struct {
+	MarketID             string   `protobuf:"bytes,1,opt,name=marketID,proto3" json:"marketID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredictionClaim) Reset()         { *m = PredictionClaim{} }
+func (m *PredictionClaim) String() string { return proto.CompactTextString(m) }
+func (*PredictionClaim) ProtoMessage()    {}
+func (*PredictionClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{5}
+}
+
+func (m *PredictionClaim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionClaim.Unmarshal(m, b)
+}
+func (m *PredictionClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionClaim.Marshal(b, m, deterministic)
+}
+func (m *PredictionClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionClaim.Merge(m, src)
+}
+func (m *PredictionClaim) XXX_Size() int {
+	return xxx_messageInfo_PredictionClaim.Size(m)
+}
+func (m *PredictionClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionClaim proto.InternalMessageInfo
+
+func (m *PredictionClaim) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+//shares 是每个结果的总份额，pool 是市场中所有的coins
+type PredictionMarket struct {
+	MarketID             string   `protobuf:"bytes,1,opt,name=marketID,proto3" json:"marketID,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Question             string   `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	Outcomes             []string `protobuf:"bytes,4,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	Feed                 string   `protobuf:"bytes,5,opt,name=feed,proto3" json:"feed,omitempty"`
+	CloseHeight          int64    `protobuf:"varint,6,opt,name=closeHeight,proto3" json:"closeHeight,omitempty"`
+	FeeRate              int32    `protobuf:"varint,7,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
+	Shares               []int64  `protobuf:"varint,8,rep,packed,name=shares,proto3" json:"shares,omitempty"`
+	Pool                 int64    `protobuf:"varint,9,opt,name=pool,proto3" json:"pool,omitempty"`
+	Status               int32    `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	Outcome              int32    `protobuf:"varint,11,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Fee                  int64    `protobuf:"varint,12,opt,name=fee,proto3" json:"fee,omitempty"`
+	Height               int64    `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
+	ResolveHeight        int64    `protobuf:"varint,14,opt,name=resolveHeight,proto3" json:"resolveHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredictionMarket) Reset()         { *m = PredictionMarket{} }
+func (m *PredictionMarket) String() string { return proto.CompactTextString(m) }
+func (*PredictionMarket) ProtoMessage()    {}
+func (*PredictionMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{6}
+}
+
+func (m *PredictionMarket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionMarket.Unmarshal(m, b)
+}
+func (m *PredictionMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionMarket.Marshal(b, m, deterministic)
+}
+func (m *PredictionMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionMarket.Merge(m, src)
+}
+func (m *PredictionMarket) XXX_Size() int {
+	return xxx_messageInfo_PredictionMarket.Size(m)
+}
+func (m *PredictionMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionMarket proto.InternalMessageInfo
+
+func (m *PredictionMarket) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PredictionMarket) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PredictionMarket) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *PredictionMarket) GetOutcomes() []string {
+	if m != nil {
+		return m.Outcomes
+	}
+	return nil
+}
+
+func (m *PredictionMarket) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *PredictionMarket) GetCloseHeight() int64 {
+	if m != nil {
+		return m.CloseHeight
+	}
+	return 0
+}
+
+func (m *PredictionMarket) GetFeeRate() int32 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *PredictionMarket) GetShares() []int64 {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *PredictionMarket) GetPool() int64 {
+	if m != nil {
+		return m.Pool
+	}
+	return 0
+}
+
+func (m *PredictionMarket) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PredictionMarket) GetOutcome() int32 {
+	if m != nil {
+		return m.Outcome
+	}
+	return 0
+}
+
+func (m *PredictionMarket) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *PredictionMarket) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PredictionMarket) GetResolveHeight() int64 {
+	if m != nil {
+		return m.ResolveHeight
+	}
+	return 0
+}
+
+type PredictionPosition struct {
+	MarketID             string   `protobuf:"bytes,1,opt,name=marketID,proto3" json:"marketID,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Shares               []int64  `protobuf:"varint,3,rep,packed,name=shares,proto3" json:"shares,omitempty"`
+	Claimed              bool     `protobuf:"varint,4,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	Payout               int64    `protobuf:"varint,5,opt,name=payout,proto3" json:"payout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredictionPosition) Reset()         { *m = PredictionPosition{} }
+func (m *PredictionPosition) String() string { return proto.CompactTextString(m) }
+func (*PredictionPosition) ProtoMessage()    {}
+func (*PredictionPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{7}
+}
+
+func (m *PredictionPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionPosition.Unmarshal(m, b)
+}
+func (m *PredictionPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionPosition.Marshal(b, m, deterministic)
+}
+func (m *PredictionPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionPosition.Merge(m, src)
+}
+func (m *PredictionPosition) XXX_Size() int {
+	return xxx_messageInfo_PredictionPosition.Size(m)
+}
+func (m *PredictionPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionPosition proto.InternalMessageInfo
+
+func (m *PredictionPosition) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PredictionPosition) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PredictionPosition) GetShares() []int64 {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *PredictionPosition) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
+func (m *PredictionPosition) GetPayout() int64 {
+	if m != nil {
+		return m.Payout
+	}
+	return 0
+}
+
+type ReceiptPredictionMarket struct {
+	Prev                 *PredictionMarket `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *PredictionMarket `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptPredictionMarket) Reset()         { *m = ReceiptPredictionMarket{} }
+func (m *ReceiptPredictionMarket) String() string { return proto.CompactTextString(m) }
+func (*ReceiptPredictionMarket) ProtoMessage()    {}
+func (*ReceiptPredictionMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{8}
+}
+
+func (m *ReceiptPredictionMarket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptPredictionMarket.Unmarshal(m, b)
+}
+func (m *ReceiptPredictionMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptPredictionMarket.Marshal(b, m, deterministic)
+}
+func (m *ReceiptPredictionMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptPredictionMarket.Merge(m, src)
+}
+func (m *ReceiptPredictionMarket) XXX_Size() int {
+	return xxx_messageInfo_ReceiptPredictionMarket.Size(m)
+}
+func (m *ReceiptPredictionMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptPredictionMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptPredictionMarket proto.InternalMessageInfo
+
+func (m *ReceiptPredictionMarket) GetPrev() *PredictionMarket {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptPredictionMarket) GetCurrent() *PredictionMarket {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptPredictionPosition struct {
+	Prev                 *PredictionPosition `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *PredictionPosition `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReceiptPredictionPosition) Reset()         { *m = ReceiptPredictionPosition{} }
+func (m *ReceiptPredictionPosition) String() string { return proto.CompactTextString(m) }
+func (*ReceiptPredictionPosition) ProtoMessage()    {}
+func (*ReceiptPredictionPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{9}
+}
+
+func (m *ReceiptPredictionPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptPredictionPosition.Unmarshal(m, b)
+}
+func (m *ReceiptPredictionPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptPredictionPosition.Marshal(b, m, deterministic)
+}
+func (m *ReceiptPredictionPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptPredictionPosition.Merge(m, src)
+}
+func (m *ReceiptPredictionPosition) XXX_Size() int {
+	return xxx_messageInfo_ReceiptPredictionPosition.Size(m)
+}
+func (m *ReceiptPredictionPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptPredictionPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptPredictionPosition proto.InternalMessageInfo
+
+func (m *ReceiptPredictionPosition) GetPrev() *PredictionPosition {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptPredictionPosition) GetCurrent() *PredictionPosition {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqPredictionPosition struct {
+	MarketID             string   `protobuf:"bytes,1,opt,name=marketID,proto3" json:"marketID,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqPredictionPosition) Reset()         { *m = ReqPredictionPosition{} }
+func (m *ReqPredictionPosition) String() string { return proto.CompactTextString(m) }
+func (*ReqPredictionPosition) ProtoMessage()    {}
+func (*ReqPredictionPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{10}
+}
+
+func (m *ReqPredictionPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqPredictionPosition.Unmarshal(m, b)
+}
+func (m *ReqPredictionPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqPredictionPosition.Marshal(b, m, deterministic)
+}
+func (m *ReqPredictionPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqPredictionPosition.Merge(m, src)
+}
+func (m *ReqPredictionPosition) XXX_Size() int {
+	return xxx_messageInfo_ReqPredictionPosition.Size(m)
+}
+func (m *ReqPredictionPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqPredictionPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqPredictionPosition proto.InternalMessageInfo
+
+func (m *ReqPredictionPosition) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *ReqPredictionPosition) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReqPredictionMarkets struct {
+	PrimaryKey           string   `protobuf:"bytes,1,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqPredictionMarkets) Reset()         { *m = ReqPredictionMarkets{} }
+func (m *ReqPredictionMarkets) String() string { return proto.CompactTextString(m) }
+func (*ReqPredictionMarkets) ProtoMessage()    {}
+func (*ReqPredictionMarkets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{11}
+}
+
+func (m *ReqPredictionMarkets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqPredictionMarkets.Unmarshal(m, b)
+}
+func (m *ReqPredictionMarkets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqPredictionMarkets.Marshal(b, m, deterministic)
+}
+func (m *ReqPredictionMarkets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqPredictionMarkets.Merge(m, src)
+}
+func (m *ReqPredictionMarkets) XXX_Size() int {
+	return xxx_messageInfo_ReqPredictionMarkets.Size(m)
+}
+func (m *ReqPredictionMarkets) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqPredictionMarkets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqPredictionMarkets proto.InternalMessageInfo
+
+func (m *ReqPredictionMarkets) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqPredictionMarkets) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqPredictionMarkets) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyPredictionMarkets struct {
+	Markets              []*PredictionMarket `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	PrimaryKey           string              `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReplyPredictionMarkets) Reset()         { *m = ReplyPredictionMarkets{} }
+func (m *ReplyPredictionMarkets) String() string { return proto.CompactTextString(m) }
+func (*ReplyPredictionMarkets) ProtoMessage()    {}
+func (*ReplyPredictionMarkets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{12}
+}
+
+func (m *ReplyPredictionMarkets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyPredictionMarkets.Unmarshal(m, b)
+}
+func (m *ReplyPredictionMarkets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyPredictionMarkets.Marshal(b, m, deterministic)
+}
+func (m *ReplyPredictionMarkets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyPredictionMarkets.Merge(m, src)
+}
+func (m *ReplyPredictionMarkets) XXX_Size() int {
+	return xxx_messageInfo_ReplyPredictionMarkets.Size(m)
+}
+func (m *ReplyPredictionMarkets) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyPredictionMarkets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyPredictionMarkets proto.InternalMessageInfo
+
+func (m *ReplyPredictionMarkets) GetMarkets() []*PredictionMarket {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+func (m *ReplyPredictionMarkets) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type ReqPredictionPositions struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqPredictionPositions) Reset()         { *m = ReqPredictionPositions{} }
+func (m *ReqPredictionPositions) String() string { return proto.CompactTextString(m) }
+func (*ReqPredictionPositions) ProtoMessage()    {}
+func (*ReqPredictionPositions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{13}
+}
+
+func (m *ReqPredictionPositions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqPredictionPositions.Unmarshal(m, b)
+}
+func (m *ReqPredictionPositions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqPredictionPositions.Marshal(b, m, deterministic)
+}
+func (m *ReqPredictionPositions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqPredictionPositions.Merge(m, src)
+}
+func (m *ReqPredictionPositions) XXX_Size() int {
+	return xxx_messageInfo_ReqPredictionPositions.Size(m)
+}
+func (m *ReqPredictionPositions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqPredictionPositions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqPredictionPositions proto.InternalMessageInfo
+
+func (m *ReqPredictionPositions) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqPredictionPositions) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqPredictionPositions) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqPredictionPositions) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyPredictionPositions struct {
+	Positions            []*PredictionPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	PrimaryKey           string                `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReplyPredictionPositions) Reset()         { *m = ReplyPredictionPositions{} }
+func (m *ReplyPredictionPositions) String() string { return proto.CompactTextString(m) }
+func (*ReplyPredictionPositions) ProtoMessage()    {}
+func (*ReplyPredictionPositions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_430b55197713f541, []int{14}
+}
+
+func (m *ReplyPredictionPositions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyPredictionPositions.Unmarshal(m, b)
+}
+func (m *ReplyPredictionPositions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyPredictionPositions.Marshal(b, m, deterministic)
+}
+func (m *ReplyPredictionPositions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyPredictionPositions.Merge(m, src)
+}
+func (m *ReplyPredictionPositions) XXX_Size() int {
+	return xxx_messageInfo_ReplyPredictionPositions.Size(m)
+}
+func (m *ReplyPredictionPositions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyPredictionPositions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyPredictionPositions proto.InternalMessageInfo
+
+func (m *ReplyPredictionPositions) GetPositions() []*PredictionPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *ReplyPredictionPositions) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PredictionAction)(nil), "types.PredictionAction")
+	proto.RegisterType((*PredictionCreate)(nil), "types.PredictionCreate")
+	proto.RegisterType((*PredictionBuy)(nil), "types.PredictionBuy")
+	proto.RegisterType((*PredictionSell)(nil), "types.PredictionSell")
+	proto.RegisterType((*PredictionResolve)(nil), "types.PredictionResolve")
+	proto.RegisterType((*PredictionClaim)(nil), "types.PredictionClaim")
+	proto.RegisterType((*PredictionMarket)(nil), "types.PredictionMarket")
+	proto.RegisterType((*PredictionPosition)(nil), "types.PredictionPosition")
+	proto.RegisterType((*ReceiptPredictionMarket)(nil), "types.ReceiptPredictionMarket")
+	proto.RegisterType((*ReceiptPredictionPosition)(nil), "types.ReceiptPredictionPosition")
+	proto.RegisterType((*ReqPredictionPosition)(nil), "types.ReqPredictionPosition")
+	proto.RegisterType((*ReqPredictionMarkets)(nil), "types.ReqPredictionMarkets")
+	proto.RegisterType((*ReplyPredictionMarkets)(nil), "types.ReplyPredictionMarkets")
+	proto.RegisterType((*ReqPredictionPositions)(nil), "types.ReqPredictionPositions")
+	proto.RegisterType((*ReplyPredictionPositions)(nil), "types.ReplyPredictionPositions")
+}
+
+func init() { proto.RegisterFile("prediction.proto", fileDescriptor_430b55197713f541) }
+
+var fileDescriptor_430b55197713f541 = []byte{
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdf, 0x6b, 0xd4, 0x40,
+	0x10, 0xbe, 0x5c, 0x92, 0xbb, 0xde, 0xd4, 0xd6, 0xba, 0xf4, 0xc7, 0x56, 0x44, 0x8e, 0xe0, 0x43,
+	0xa1, 0xf4, 0xa4, 0x56, 0xf0, 0xd9, 0x2a, 0x78, 0x22, 0x42, 0x59, 0x9f, 0x15, 0xd2, 0xdc, 0xd4,
+	0xc6, 0xe6, 0x9a, 0x34, 0xbb, 0x29, 0xe4, 0x49, 0xff, 0x02, 0xff, 0x00, 0xfd, 0x5f, 0x45, 0x76,
+	0x92, 0xbd, 0x24, 0x97, 0x5e, 0x0a, 0xfa, 0x72, 0xec, 0xb7, 0xf3, 0x65, 0x66, 0xbe, 0xf9, 0x76,
+	0x97, 0x83, 0xad, 0x24, 0xc5, 0x59, 0x18, 0xa8, 0x30, 0xbe, 0x9e, 0x24, 0x69, 0xac, 0x62, 0xe6,
+	0xaa, 0x3c, 0x41, 0xe9, 0xfd, 0xee, 0xc3, 0xd6, 0xd9, 0x22, 0xf6, 0x9a, 0x7e, 0xd9, 0x31, 0x0c,
+	0x82, 0x14, 0x7d, 0x85, 0xdc, 0x1a, 0x5b, 0x07, 0xeb, 0x2f, 0xf6, 0x26, 0x44, 0x9e, 0x54, 0xc4,
+	0x37, 0x14, 0x9e, 0xf6, 0x44, 0x49, 0x64, 0x07, 0x60, 0x9f, 0x67, 0x39, 0xef, 0x13, 0x7f, 0xbb,
+	0xc5, 0x3f, 0xcd, 0xf2, 0x69, 0x4f, 0x68, 0x0a, 0x3b, 0x04, 0x47, 0x62, 0x14, 0x71, 0x9b, 0xa8,
+	0x3b, 0x2d, 0xea, 0x27, 0x8c, 0xa2, 0x69, 0x4f, 0x10, 0x89, 0xbd, 0x84, 0x61, 0x8a, 0x32, 0x8e,
+	0x6e, 0x91, 0x3b, 0xc4, 0xe7, 0x2d, 0xbe, 0x28, 0xe2, 0xd3, 0x9e, 0x30, 0x54, 0x36, 0x01, 0x37,
+	0x88, 0xfc, 0x70, 0xce, 0x5d, 0xfa, 0x66, 0xb7, 0xdd, 0xbe, 0x8e, 0x4e, 0x7b, 0xa2, 0xa0, 0xb1,
+	0x4d, 0xe8, 0xab, 0x9c, 0x0f, 0xc6, 0xd6, 0x81, 0x2b, 0xfa, 0x2a, 0x3f, 0x1d, 0x82, 0x7b, 0xeb,
+	0x47, 0x19, 0x7a, 0xbf, 0xac, 0xfa, 0x74, 0x0a, 0xd1, 0xec, 0x31, 0xac, 0xdd, 0x64, 0x28, 0xf5,
+	0x0e, 0xcd, 0x67, 0x24, 0x16, 0x58, 0xc7, 0xe2, 0x4c, 0x05, 0xf1, 0x1c, 0x25, 0xef, 0x8f, 0x6d,
+	0x1d, 0x33, 0x98, 0x31, 0x70, 0x2e, 0x10, 0x67, 0x24, 0x7c, 0x24, 0x68, 0xcd, 0xc6, 0xb0, 0x1e,
+	0x44, 0xb1, 0xc4, 0x29, 0x86, 0x5f, 0x2f, 0x15, 0x69, 0xb4, 0x45, 0x7d, 0x8b, 0x71, 0x18, 0x5e,
+	0x20, 0x0a, 0x6d, 0x86, 0x4b, 0x0d, 0x1a, 0xe8, 0x7d, 0x86, 0x8d, 0xc6, 0x80, 0x75, 0xf1, 0xb9,
+	0x9f, 0x5e, 0xa1, 0x7a, 0xff, 0xd6, 0x34, 0x66, 0xb0, 0x4e, 0x53, 0x36, 0x42, 0x1e, 0xb9, 0xc2,
+	0x40, 0xb6, 0x0b, 0x03, 0x7f, 0x1e, 0x67, 0xd7, 0x8a, 0x1a, 0xb3, 0x45, 0x89, 0xbc, 0x2f, 0xb0,
+	0xd9, 0x34, 0xe5, 0xdf, 0xf3, 0xcb, 0x4b, 0x3f, 0x45, 0x69, 0xf2, 0x17, 0xc8, 0x7b, 0x0e, 0x8f,
+	0x5a, 0x26, 0x76, 0x95, 0xf0, 0x8e, 0xe0, 0xe1, 0x92, 0x83, 0x9d, 0xf4, 0x3f, 0x8d, 0x93, 0xfd,
+	0x91, 0xb6, 0xef, 0x93, 0x40, 0x87, 0x39, 0x4e, 0x49, 0xc2, 0x48, 0x18, 0xd8, 0x70, 0xdc, 0xee,
+	0x70, 0xdc, 0x59, 0xe1, 0xb8, 0xbb, 0xda, 0xf1, 0x41, 0xa7, 0xe3, 0xc3, 0x86, 0xe3, 0xb5, 0x51,
+	0xae, 0x8d, 0xed, 0x6a, 0x94, 0xba, 0x4e, 0x12, 0xc7, 0x11, 0x1f, 0x51, 0x32, 0x5a, 0x13, 0x57,
+	0xf9, 0x2a, 0x93, 0x1c, 0x28, 0x49, 0x89, 0xea, 0x46, 0xad, 0x37, 0x8d, 0xda, 0x02, 0xfb, 0x02,
+	0x91, 0x3f, 0xa0, 0x24, 0x7a, 0xa9, 0x73, 0x5c, 0x16, 0x6d, 0x6e, 0x14, 0xd6, 0x15, 0x88, 0x3d,
+	0x83, 0x8d, 0xf2, 0xaa, 0x95, 0x2a, 0x36, 0x29, 0xdc, 0xdc, 0xf4, 0x7e, 0x5a, 0xc0, 0x2a, 0x03,
+	0xce, 0x62, 0x19, 0x9a, 0x81, 0xad, 0xb4, 0x80, 0x81, 0xe3, 0xcf, 0x66, 0x66, 0xfe, 0xb4, 0x6e,
+	0x9c, 0x9f, 0xba, 0x68, 0x6d, 0x97, 0x3e, 0x04, 0x38, 0xa3, 0x6b, 0xb3, 0x26, 0x0c, 0xd4, 0x5f,
+	0x24, 0x7e, 0x1e, 0x67, 0x8a, 0x06, 0x6f, 0x8b, 0x12, 0x79, 0x39, 0xec, 0x09, 0x0c, 0x30, 0x4c,
+	0x54, 0xeb, 0x5c, 0x1c, 0x82, 0x93, 0xa4, 0x78, 0xbb, 0xf2, 0xbd, 0x2b, 0x68, 0x82, 0x48, 0xec,
+	0x18, 0x86, 0x41, 0x96, 0xa6, 0x78, 0xad, 0xca, 0xf7, 0x6e, 0x25, 0xdf, 0xf0, 0xbc, 0xef, 0xb0,
+	0xdf, 0x2a, 0xbd, 0x98, 0xc8, 0x51, 0xa3, 0xf8, 0x7e, 0x2b, 0x99, 0x21, 0x96, 0xe5, 0x4f, 0x96,
+	0xcb, 0x77, 0x7c, 0xb1, 0x68, 0xe0, 0x1d, 0xec, 0x08, 0xbc, 0xf9, 0x7f, 0x3b, 0xbc, 0x6f, 0xb0,
+	0xdd, 0x48, 0x54, 0x28, 0x95, 0xec, 0x29, 0x40, 0x92, 0x86, 0x73, 0x3f, 0xcd, 0x3f, 0x60, 0x5e,
+	0x66, 0xaa, 0xed, 0xb0, 0x6d, 0x70, 0x03, 0x7a, 0x65, 0x8a, 0xe7, 0xa1, 0x00, 0xec, 0x09, 0x8c,
+	0x66, 0x61, 0x8a, 0xc1, 0xe2, 0x6a, 0xb9, 0xa2, 0xda, 0xf0, 0xae, 0x60, 0x57, 0x60, 0x12, 0xe5,
+	0xed, 0x6a, 0xc7, 0x30, 0x2c, 0xba, 0x94, 0xdc, 0x1a, 0xdb, 0x9d, 0x16, 0xcc, 0xef, 0x6c, 0xb0,
+	0xbf, 0xdc, 0xa0, 0xf7, 0xc3, 0xd2, 0xd5, 0xee, 0x18, 0x91, 0x5c, 0xcc, 0xc1, 0xaa, 0x1d, 0xcb,
+	0x7b, 0xd2, 0x55, 0x7a, 0xed, 0x95, 0x7a, 0x9d, 0x65, 0xbd, 0x12, 0xf8, 0x92, 0xde, 0xaa, 0x87,
+	0x57, 0x30, 0x4a, 0x0c, 0x28, 0x35, 0x77, 0xf8, 0x5e, 0x71, 0xef, 0x6b, 0xf4, 0x7c, 0x40, 0xff,
+	0x07, 0x4e, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x05, 0xf9, 0xef, 0x2b, 0x23, 0x08, 0x00, 0x00,
+}
@@ -0,0 +1,87 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types prediction插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// PredictionX 执行器名称
+	PredictionX = "prediction"
+	actionName  = map[string]int32{
+		"Create":  PredictionActionCreate,
+		"Buy":     PredictionActionBuy,
+		"Sell":    PredictionActionSell,
+		"Resolve": PredictionActionResolve,
+		"Claim":   PredictionActionClaim,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogPredictionMarket:   {Ty: reflect.TypeOf(ReceiptPredictionMarket{}), Name: "LogPredictionMarket"},
+		TyLogPredictionPosition: {Ty: reflect.TypeOf(ReceiptPredictionPosition{}), Name: "LogPredictionPosition"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(PredictionX))
+	types.RegistorExecutor(PredictionX, NewType())
+	types.RegisterDappFork(PredictionX, "Enable", 0)
+}
+
+// PredictionType prediction执行器类型
+type PredictionType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a prediction type object
+func NewType() *PredictionType {
+	c := &PredictionType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return prediction action
+func (p *PredictionType) GetPayload() types.Message {
+	return &PredictionAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (p *PredictionType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (p *PredictionType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (p *PredictionType) GetName() string {
+	return PredictionX
+}
+
+// CheckCreate 检查市场的问题和结果，结果的名字不能重复
+func CheckCreate(payload *PredictionCreate) error {
+	if len(payload.Question) == 0 || len(payload.Question) > MaxQuestionLength {
+		return ErrQuestion
+	}
+	if len(payload.Outcomes) < 2 || len(payload.Outcomes) > MaxOutcomes {
+		return ErrOutcomes
+	}
+	seen := make(map[string]bool)
+	for _, outcome := range payload.Outcomes {
+		if len(outcome) == 0 || len(outcome) > MaxOutcomeLength || seen[outcome] {
+			return ErrOutcomes
+		}
+		seen[outcome] = true
+	}
+	if payload.FeeRate < 0 || payload.FeeRate > MaxFeeRate {
+		return ErrFeeRate
+	}
+	return nil
+}
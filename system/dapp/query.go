@@ -5,9 +5,11 @@
 package dapp
 
 import (
+	"bytes"
 	"errors"
 	"reflect"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	"github.com/golang/protobuf/proto"
 )
@@ -95,6 +97,74 @@ func (d *DriverBase) GetAddrTxsCount(reqkey *types.ReqKey) (types.Message, error
 	return &counts, nil
 }
 
+const (
+	defaultListStateCount = 20
+	maxListStateCount     = 100
+)
+
+// Query_ListState 按前缀分页查询当前执行器的状态数据，所有执行器都可以通过Chain33.Query调用
+func (d *DriverBase) Query_ListState(req *types.ReqListState) (types.Message, error) {
+	count := req.Count
+	if count <= 0 {
+		count = defaultListStateCount
+	}
+	if count > maxListStateCount {
+		return nil, types.ErrInvalidParam
+	}
+	prefix := append(types.CalcStatePrefix([]byte(d.GetName())), req.Prefix...)
+	if len(req.Start) > 0 && !bytes.HasPrefix(req.Start, prefix) {
+		return nil, types.ErrInvalidParam
+	}
+	api := d.GetAPI()
+	stateHash := req.StateHash
+	if len(stateHash) == 0 {
+		header, err := api.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		stateHash = header.StateHash
+	}
+	list := &types.StoreList{StateHash: stateHash, Start: prefix, End: prefixEnd(prefix), Count: int64(count), Mode: 1}
+	if req.Direction == dbm.ListDESC {
+		list.Reverse = true
+		//start是包含在结果中的，逆序的时候end是不包含的
+		if len(req.Start) > 0 {
+			list.End = append(append([]byte{}, req.Start...), 0)
+		}
+	} else if len(req.Start) > 0 {
+		list.Start = req.Start
+	}
+	reply, err := api.StoreList(list)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.ReplyListState{StateHash: stateHash, NextKey: reply.NextKey}
+	for i, key := range reply.Keys {
+		resp.KVs = append(resp.KVs, &types.KeyValue{Key: key, Value: reply.Values[i]})
+		if req.Proof {
+			proof, err := api.StoreGetProof(&types.ReqStoreProof{StateHash: stateHash, Key: key})
+			if err != nil {
+				return nil, err
+			}
+			resp.Proofs = append(resp.Proofs, proof)
+		}
+	}
+	return resp, nil
+}
+
+//prefixEnd 比所有以prefix开头的key都大的最小的key，prefix全是0xff的时候为空，表示没有上界
+func prefixEnd(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			end := make([]byte, i+1)
+			copy(end, prefix)
+			end[i]++
+			return end
+		}
+	}
+	return nil
+}
+
 // Query defines query function
 func (d *DriverBase) Query(funcname string, params []byte) (msg types.Message, err error) {
 	funcmap := d.child.GetFuncMap()
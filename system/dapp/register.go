@@ -7,6 +7,7 @@ package dapp
 //store package store the world - state data
 import (
 	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
 )
@@ -21,10 +22,19 @@ type driverWithHeight struct {
 	height int64
 }
 
+// TxCheck 节点级别的交易检查，所有执行器的交易在执行之前都要通过检查
+type TxCheck func(db dbm.KV, tx *types.Transaction, height int64) error
+
+type namedTxCheck struct {
+	name  string
+	check TxCheck
+}
+
 var (
 	execDrivers        = make(map[string]*driverWithHeight)
 	execAddressNameMap = make(map[string]string)
 	registedExecDriver = make(map[string]*driverWithHeight)
+	txChecks           []*namedTxCheck
 )
 
 // Register register dcriver height in name
@@ -106,3 +116,26 @@ func ExecAddress(name string) string {
 	}
 	return address.ExecAddress(name)
 }
+
+// RegisterTxCheck 注册节点级别的交易检查，按注册的顺序执行
+func RegisterTxCheck(name string, check TxCheck) {
+	if check == nil {
+		panic("Execute: RegisterTxCheck check is nil")
+	}
+	for _, c := range txChecks {
+		if c.name == name {
+			panic("Execute: RegisterTxCheck called twice for " + name)
+		}
+	}
+	txChecks = append(txChecks, &namedTxCheck{name: name, check: check})
+}
+
+// CheckTxByRegistered 执行所有注册的交易检查，返回第一个错误
+func CheckTxByRegistered(db dbm.KV, tx *types.Transaction, height int64) error {
+	for _, c := range txChecks {
+		if err := c.check(db, tx, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,362 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands stablecoin插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	sty "github.com/33cn/chain33/system/dapp/stablecoin/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// StablecoinCmd stablecoin command
+func StablecoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stablecoin",
+		Short: "Stablecoin minted against collateral priced by oracle",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		ConfigCmd(),
+		OpenCmd(),
+		DepositCmd(),
+		WithdrawCmd(),
+		MintCmd(),
+		RepayCmd(),
+		LiquidateCmd(),
+		TransferCmd(),
+		QueryCollateralCmd(),
+		QueryVaultCmd(),
+		ListVaultsCmd(),
+		SolvencyCmd(),
+		BalanceCmd(),
+	)
+
+	return cmd
+}
+
+func addAssetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("exec", "e", "coins", "executor of collateral asset")
+	cmd.Flags().StringP("symbol", "s", "", "symbol of collateral asset, empty for coins")
+}
+
+// ConfigCmd config collateral
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Create a transaction to config collateral asset, manager only",
+		Run:   config,
+	}
+	addAssetFlags(cmd)
+	cmd.Flags().StringP("feed", "f", "", "oracle feed of price, value is price * 1e8")
+	cmd.MarkFlagRequired("feed")
+	cmd.Flags().Int64P("ratio", "r", 1500, "liquidation ratio in per mille")
+	cmd.Flags().Int64P("penalty", "p", 100, "liquidation penalty in per mille")
+	cmd.Flags().Float64P("ceiling", "c", 0, "debt ceiling")
+	cmd.MarkFlagRequired("ceiling")
+	cmd.Flags().Int64P("age", "a", 100, "max blocks since price is attested")
+	return cmd
+}
+
+func config(cmd *cobra.Command, args []string) {
+	exec, _ := cmd.Flags().GetString("exec")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	feed, _ := cmd.Flags().GetString("feed")
+	ratio, _ := cmd.Flags().GetInt64("ratio")
+	penalty, _ := cmd.Flags().GetInt64("penalty")
+	ceiling, _ := cmd.Flags().GetFloat64("ceiling")
+	age, _ := cmd.Flags().GetInt64("age")
+	payload := &sty.StableCollateralConfig{
+		AssetExec:        exec,
+		AssetSymbol:      symbol,
+		Feed:             feed,
+		LiquidationRatio: ratio,
+		Penalty:          penalty,
+		DebtCeiling:      commandtypes.FormatAmountDisplay2Value(ceiling),
+		MaxPriceAge:      age,
+	}
+	if err := sty.CheckCollateralConfig(payload); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, sty.StablecoinX, &sty.StablecoinAction{
+		Ty:    sty.StableActionConfig,
+		Value: &sty.StablecoinAction_Config{Config: payload},
+	})
+}
+
+// OpenCmd open vault
+func OpenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open",
+		Short: "Create a transaction to open vault",
+		Run:   open,
+	}
+	addAssetFlags(cmd)
+	cmd.Flags().Float64P("collateral", "c", 0, "collateral to deposit")
+	cmd.Flags().Float64P("debt", "d", 0, "stablecoin to mint")
+	return cmd
+}
+
+func open(cmd *cobra.Command, args []string) {
+	exec, _ := cmd.Flags().GetString("exec")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	collateral, _ := cmd.Flags().GetFloat64("collateral")
+	debt, _ := cmd.Flags().GetFloat64("debt")
+	commandtypes.CreateActionTx(cmd, sty.StablecoinX, &sty.StablecoinAction{
+		Ty: sty.StableActionOpen,
+		Value: &sty.StablecoinAction_Open{Open: &sty.StableOpen{
+			AssetExec:   exec,
+			AssetSymbol: symbol,
+			Collateral:  commandtypes.FormatAmountDisplay2Value(collateral),
+			Debt:        commandtypes.FormatAmountDisplay2Value(debt),
+		}},
+	})
+}
+
+func addVaultFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("id", "i", "", "vault id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+	cmd.MarkFlagRequired("amount")
+}
+
+func vaultFlags(cmd *cobra.Command) (string, int64) {
+	id, _ := cmd.Flags().GetString("id")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	return id, commandtypes.FormatAmountDisplay2Value(amount)
+}
+
+// DepositCmd deposit collateral
+func DepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Create a transaction to deposit collateral into vault",
+		Run:   deposit,
+	}
+	addVaultFlags(cmd)
+	return cmd
+}
+
+func deposit(cmd *cobra.Command, args []string) {
+	id, amount := vaultFlags(cmd)
+	commandtypes.CreateActionTx(cmd, sty.StablecoinX, &sty.StablecoinAction{
+		Ty:    sty.StableActionDeposit,
+		Value: &sty.StablecoinAction_Deposit{Deposit: &sty.StableDeposit{VaultID: id, Amount: amount}},
+	})
+}
+
+// WithdrawCmd withdraw collateral
+func WithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Create a transaction to withdraw collateral from vault",
+		Run:   withdraw,
+	}
+	addVaultFlags(cmd)
+	return cmd
+}
+
+func withdraw(cmd *cobra.Command, args []string) {
+	id, amount := vaultFlags(cmd)
+	commandtypes.CreateActionTx(cmd, sty.StablecoinX, &sty.StablecoinAction{
+		Ty:    sty.StableActionWithdraw,
+		Value: &sty.StablecoinAction_Withdraw{Withdraw: &sty.StableWithdraw{VaultID: id, Amount: amount}},
+	})
+}
+
+// MintCmd mint stablecoin
+func MintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint",
+		Short: "Create a transaction to mint stablecoin against vault",
+		Run:   mint,
+	}
+	addVaultFlags(cmd)
+	return cmd
+}
+
+func mint(cmd *cobra.Command, args []string) {
+	id, amount := vaultFlags(cmd)
+	commandtypes.CreateActionTx(cmd, sty.StablecoinX, &sty.StablecoinAction{
+		Ty:    sty.StableActionMint,
+		Value: &sty.StablecoinAction_Mint{Mint: &sty.StableMint{VaultID: id, Amount: amount}},
+	})
+}
+
+// RepayCmd repay debt
+func RepayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repay",
+		Short: "Create a transaction to repay debt of vault",
+		Run:   repay,
+	}
+	addVaultFlags(cmd)
+	return cmd
+}
+
+func repay(cmd *cobra.Command, args []string) {
+	id, amount := vaultFlags(cmd)
+	commandtypes.CreateActionTx(cmd, sty.StablecoinX, &sty.StablecoinAction{
+		Ty:    sty.StableActionRepay,
+		Value: &sty.StablecoinAction_Repay{Repay: &sty.StableRepay{VaultID: id, Amount: amount}},
+	})
+}
+
+// LiquidateCmd liquidate vault
+func LiquidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidate",
+		Short: "Create a transaction to liquidate unsafe vault",
+		Run:   liquidate,
+	}
+	cmd.Flags().StringP("id", "i", "", "vault id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func liquidate(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, sty.StablecoinX, &sty.StablecoinAction{
+		Ty:    sty.StableActionLiquidate,
+		Value: &sty.StablecoinAction_Liquidate{Liquidate: &sty.StableLiquidate{VaultID: id}},
+	})
+}
+
+// TransferCmd transfer stablecoin
+func TransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "Create a transaction to transfer stablecoin",
+		Run:   transfer,
+	}
+	cmd.Flags().StringP("to", "t", "", "receiver address")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func transfer(cmd *cobra.Command, args []string) {
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	commandtypes.CreateActionTx(cmd, sty.StablecoinX, &sty.StablecoinAction{
+		Ty:    sty.StableActionTransfer,
+		Value: &sty.StablecoinAction_Transfer{Transfer: &sty.StableTransfer{To: to, Amount: commandtypes.FormatAmountDisplay2Value(amount)}},
+	})
+}
+
+func queryStablecoin(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, sty.StablecoinX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryCollateralCmd query collateral
+func QueryCollateralCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateral",
+		Short: "Query config and totals of collateral asset",
+		Run:   queryCollateral,
+	}
+	addAssetFlags(cmd)
+	return cmd
+}
+
+func queryCollateral(cmd *cobra.Command, args []string) {
+	exec, _ := cmd.Flags().GetString("exec")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	var res sty.StableCollateral
+	queryStablecoin(cmd, sty.FuncNameGetCollateral, &sty.ReqStableCollateral{AssetExec: exec, AssetSymbol: symbol}, &res)
+}
+
+// QueryVaultCmd query vault
+func QueryVaultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault",
+		Short: "Query vault by id",
+		Run:   queryVault,
+	}
+	cmd.Flags().StringP("id", "i", "", "vault id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryVault(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res sty.StableVault
+	queryStablecoin(cmd, sty.FuncNameGetVault, &types.ReqString{Data: id}, &res)
+}
+
+// ListVaultsCmd list vaults of owner
+func ListVaultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vaults",
+		Short: "List vaults of owner",
+		Run:   listVaults,
+	}
+	cmd.Flags().StringP("owner", "o", "", "owner address")
+	cmd.MarkFlagRequired("owner")
+	cmd.Flags().StringP("primary", "p", "", "list after this vault id")
+	cmd.Flags().Int32P("count", "c", sty.DefaultListCount, "max count")
+	cmd.Flags().Int32P("direction", "d", 0, "0: desc, 1: asc")
+	return cmd
+}
+
+func listVaults(cmd *cobra.Command, args []string) {
+	owner, _ := cmd.Flags().GetString("owner")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res sty.ReplyStableVaults
+	queryStablecoin(cmd, sty.FuncNameListVaults, &sty.ReqStableVaults{Owner: owner, PrimaryKey: primary, Count: count, Direction: direction}, &res)
+}
+
+// SolvencyCmd query solvency
+func SolvencyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "solvency",
+		Short: "Query collateral value and stablecoin supply of system",
+		Run:   solvency,
+	}
+	return cmd
+}
+
+func solvency(cmd *cobra.Command, args []string) {
+	var res sty.ReplyStableSolvency
+	queryStablecoin(cmd, sty.FuncNameGetSolvency, &types.ReqNil{}, &res)
+}
+
+// BalanceCmd query stablecoin balance
+func BalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance",
+		Short: "Query stablecoin balance of address",
+		Run:   balance,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func balance(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	var res types.Account
+	queryStablecoin(cmd, sty.FuncNameGetBalance, &types.ReqString{Data: addr}, &res)
+}
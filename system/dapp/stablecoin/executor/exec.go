@@ -0,0 +1,58 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	sty "github.com/33cn/chain33/system/dapp/stablecoin/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Config 管理员配置抵押资产
+func (s *Stablecoin) Exec_Config(payload *sty.StableCollateralConfig, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.config(payload)
+}
+
+// Exec_Open 开金库，可以同时存入抵押资产和铸造稳定币
+func (s *Stablecoin) Exec_Open(payload *sty.StableOpen, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.open(payload)
+}
+
+// Exec_Deposit 向金库存入抵押资产
+func (s *Stablecoin) Exec_Deposit(payload *sty.StableDeposit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.depositVault(payload)
+}
+
+// Exec_Withdraw 从金库取出抵押资产
+func (s *Stablecoin) Exec_Withdraw(payload *sty.StableWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.withdraw(payload)
+}
+
+// Exec_Mint 用金库的抵押资产铸造稳定币
+func (s *Stablecoin) Exec_Mint(payload *sty.StableMint, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.mintVault(payload)
+}
+
+// Exec_Repay 销毁稳定币偿还金库的债务
+func (s *Stablecoin) Exec_Repay(payload *sty.StableRepay, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.repay(payload)
+}
+
+// Exec_Liquidate 清算抵押率不足的金库
+func (s *Stablecoin) Exec_Liquidate(payload *sty.StableLiquidate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.liquidate(payload)
+}
+
+// Exec_Transfer 转账稳定币
+func (s *Stablecoin) Exec_Transfer(payload *sty.StableTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.transfer(payload)
+}
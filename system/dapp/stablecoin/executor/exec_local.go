@@ -0,0 +1,71 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	sty "github.com/33cn/chain33/system/dapp/stablecoin/types"
+	"github.com/33cn/chain33/types"
+)
+
+//execLocal 新开的金库添加拥有者的索引
+func (s *Stablecoin) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != sty.TyLogStableVault {
+			continue
+		}
+		var log sty.ReceiptStableVault
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		if log.Prev == nil {
+			kvs = append(kvs, &types.KeyValue{Key: calcOwnerIndexKey(log.Current.Owner, log.Current.VaultID), Value: []byte(log.Current.VaultID)})
+		}
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+// ExecLocal_Config 配置不会产生新的索引
+func (s *Stablecoin) ExecLocal_Config(payload *sty.StableCollateralConfig, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+// ExecLocal_Open 添加拥有者的金库索引
+func (s *Stablecoin) ExecLocal_Open(payload *sty.StableOpen, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+// ExecLocal_Deposit 存入不会产生新的索引
+func (s *Stablecoin) ExecLocal_Deposit(payload *sty.StableDeposit, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+// ExecLocal_Withdraw 取出不会产生新的索引
+func (s *Stablecoin) ExecLocal_Withdraw(payload *sty.StableWithdraw, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+// ExecLocal_Mint 铸造不会产生新的索引
+func (s *Stablecoin) ExecLocal_Mint(payload *sty.StableMint, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+// ExecLocal_Repay 偿还不会产生新的索引
+func (s *Stablecoin) ExecLocal_Repay(payload *sty.StableRepay, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+// ExecLocal_Liquidate 清算不会产生新的索引
+func (s *Stablecoin) ExecLocal_Liquidate(payload *sty.StableLiquidate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+// ExecLocal_Transfer 转账不会产生新的索引
+func (s *Stablecoin) ExecLocal_Transfer(payload *sty.StableTransfer, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
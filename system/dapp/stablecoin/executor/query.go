@@ -0,0 +1,43 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/common/address"
+	sty "github.com/33cn/chain33/system/dapp/stablecoin/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetCollateral 查询抵押资产的配置和总量
+func (s *Stablecoin) Query_GetCollateral(in *sty.ReqStableCollateral) (types.Message, error) {
+	return getCollateral(s.GetStateDB(), in.AssetExec, in.AssetSymbol)
+}
+
+// Query_GetVault 按id查询金库
+func (s *Stablecoin) Query_GetVault(in *types.ReqString) (types.Message, error) {
+	return getVault(s.GetStateDB(), in.Data)
+}
+
+// Query_ListVaults 列出地址的金库
+func (s *Stablecoin) Query_ListVaults(in *sty.ReqStableVaults) (types.Message, error) {
+	return listVaults(s.GetLocalDB(), s.GetStateDB(), in)
+}
+
+// Query_GetSolvency 查询系统的偿付能力
+func (s *Stablecoin) Query_GetSolvency(in *types.ReqNil) (types.Message, error) {
+	return getSolvency(s.GetStateDB(), s.GetHeight())
+}
+
+// Query_GetBalance 查询地址的稳定币余额
+func (s *Stablecoin) Query_GetBalance(in *types.ReqString) (types.Message, error) {
+	if err := address.CheckAddress(in.Data); err != nil {
+		return nil, err
+	}
+	acc, err := stableAccount(s.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	return acc.LoadAccount(in.Data), nil
+}
@@ -0,0 +1,66 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor stablecoin执行器，用抵押资产铸造稳定币，按oracle的价格检查抵押率和清算
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	sty "github.com/33cn/chain33/system/dapp/stablecoin/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.stablecoin")
+	driverName = sty.StablecoinX
+	manageConf = types.ConfSub("manage")
+)
+
+func init() {
+	et := types.LoadExecutorType(driverName)
+	et.InitFuncList(types.ListMethod(&Stablecoin{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newStablecoin, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return stablecoin name
+func GetName() string {
+	return newStablecoin().GetName()
+}
+
+// Stablecoin defines Stablecoin object
+type Stablecoin struct {
+	drivers.DriverBase
+}
+
+func newStablecoin() drivers.Driver {
+	s := &Stablecoin{}
+	s.SetChild(s)
+	s.SetExecutorType(types.LoadExecutorType(driverName))
+	return s
+}
+
+// GetDriverName return a drivername
+func (s *Stablecoin) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (s *Stablecoin) CheckReceiptExecOk() bool {
+	return true
+}
+
+//isManager manage合约的超级管理员可以配置抵押资产
+func isManager(addr string) bool {
+	for _, m := range manageConf.GStrList("superManager") {
+		if addr == m {
+			return true
+		}
+	}
+	return false
+}
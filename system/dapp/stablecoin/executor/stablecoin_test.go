@@ -0,0 +1,139 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	oty "github.com/33cn/chain33/system/dapp/oracle/types"
+	sty "github.com/33cn/chain33/system/dapp/stablecoin/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, execer, action string, param types.Message) (int32, string) {
+	_, detail, err := mock33.SendCallTx(priv, execer, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, fmt.Sprintf("%018d", detail.Height*types.MaxTxsPerBlock+detail.Index)
+}
+
+func send(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) int32 {
+	ty, _ := sendTx(t, mock33, priv, sty.StablecoinX, action, param)
+	return ty
+}
+
+func query(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(sty.StablecoinX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func getVault(t *testing.T, mock33 *testnode.Chain33Mock, id string) *sty.StableVault {
+	return query(t, mock33, sty.FuncNameGetVault, &types.ReqString{Data: id}).(*sty.StableVault)
+}
+
+func stableBalance(t *testing.T, mock33 *testnode.Chain33Mock, addr string) int64 {
+	return query(t, mock33, sty.FuncNameGetBalance, &types.ReqString{Data: addr}).(*types.Account).Balance
+}
+
+func TestStablecoin(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	//manage合约的超级管理员创建数据源，配置发布者和抵押资产
+	manager := util.TestPrivkeyList[0]
+	publisher, publisherPriv := util.Genaddress()
+	owner, ownerPriv := util.Genaddress()
+	liquidator, liquidatorPriv := util.Genaddress()
+	for _, to := range []string{owner, liquidator, publisher, address.PubKeyToAddress(manager.PubKey().Bytes()).String()} {
+		mock33.SendTx(util.CreateCoinsTx(genesis, to, 100*types.Coin))
+		assert.Nil(t, mock33.Wait())
+	}
+	mock33.SendTx(util.CreateCoinsTx(ownerPriv, address.ExecAddress(sty.StablecoinX), 50*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	ty, _ := sendTx(t, mock33, manager, oty.OracleX, "FeedCreate", &oty.OracleFeedCreate{Name: "BTY", Rule: oty.RuleMedian, Quorum: 1})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendTx(t, mock33, manager, "manage", "Modify", &types.ModifyConfig{Key: oty.PublisherKey, Value: publisher, Op: "add"})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	round := int64(1)
+	attest := func(value int64) {
+		ty, _ := sendTx(t, mock33, publisherPriv, oty.OracleX, "Publish", &oty.OraclePublish{Point: &oty.OracleDataPoint{Feed: "BTY", Round: round, Value: value}})
+		assert.Equal(t, int32(types.ExecOk), ty)
+		round++
+	}
+
+	config := &sty.StableCollateralConfig{AssetExec: "coins", Feed: "BTY", LiquidationRatio: 1500, Penalty: 100, DebtCeiling: 1000 * types.Coin, MaxPriceAge: 30}
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, ownerPriv, "Config", config))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, manager, "Config", &sty.StableCollateralConfig{AssetExec: "coins", Feed: "BTY", LiquidationRatio: 900, DebtCeiling: types.Coin, MaxPriceAge: 30}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, manager, "Config", config))
+	collateral := query(t, mock33, sty.FuncNameGetCollateral, &sty.ReqStableCollateral{AssetExec: "coins"}).(*sty.StableCollateral)
+	assert.Equal(t, types.GetCoinSymbol(), collateral.AssetSymbol)
+
+	//没有价格的时候不能铸造
+	open := &sty.StableOpen{AssetExec: "coins", Collateral: 30 * types.Coin, Debt: 20 * types.Coin}
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, ownerPriv, "Open", open))
+	attest(2 * sty.PriceBase)
+	ty, id := sendTx(t, mock33, ownerPriv, sty.StablecoinX, "Open", open)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 20*types.Coin, mock33.GetExecBalance(sty.StablecoinX, owner))
+	assert.Equal(t, 20*types.Coin, stableBalance(t, mock33, owner))
+	vaults := query(t, mock33, sty.FuncNameListVaults, &sty.ReqStableVaults{Owner: owner}).(*sty.ReplyStableVaults)
+	assert.Equal(t, 1, len(vaults.Vaults))
+	assert.Equal(t, id, vaults.Vaults[0].VaultID)
+
+	//抵押率不能低于150%
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, ownerPriv, "Mint", &sty.StableMint{VaultID: id, Amount: 21 * types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, liquidatorPriv, "Mint", &sty.StableMint{VaultID: id, Amount: types.Coin}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, ownerPriv, "Mint", &sty.StableMint{VaultID: id, Amount: 20 * types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, ownerPriv, "Withdraw", &sty.StableWithdraw{VaultID: id, Amount: types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, liquidatorPriv, "Liquidate", &sty.StableLiquidate{VaultID: id}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, ownerPriv, "Repay", &sty.StableRepay{VaultID: id, Amount: 5 * types.Coin}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, ownerPriv, "Withdraw", &sty.StableWithdraw{VaultID: id, Amount: types.Coin}))
+	assert.Equal(t, 21*types.Coin, mock33.GetExecBalance(sty.StablecoinX, owner))
+	vault := getVault(t, mock33, id)
+	assert.Equal(t, 29*types.Coin, vault.Collateral)
+	assert.Equal(t, 35*types.Coin, vault.Debt)
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, ownerPriv, "Transfer", &sty.StableTransfer{To: liquidator, Amount: 30 * types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, ownerPriv, "Transfer", &sty.StableTransfer{To: liquidator, Amount: 6 * types.Coin}))
+	assert.Equal(t, 5*types.Coin, stableBalance(t, mock33, owner))
+
+	//价格下跌以后抵押率不足，清算人偿还全部债务拿走抵押资产和罚金
+	attest(sty.PriceBase * 3 / 2)
+	solvency := query(t, mock33, sty.FuncNameGetSolvency, &types.ReqNil{}).(*sty.ReplyStableSolvency)
+	assert.Equal(t, 35*types.Coin, solvency.Supply)
+	assert.Equal(t, 29*types.Coin*3/2, solvency.Value)
+	assert.Equal(t, int64(1242), solvency.Ratio)
+	assert.True(t, solvency.Solvent)
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, liquidatorPriv, "Liquidate", &sty.StableLiquidate{VaultID: id}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, ownerPriv, "Transfer", &sty.StableTransfer{To: liquidator, Amount: 5 * types.Coin}))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, liquidatorPriv, "Liquidate", &sty.StableLiquidate{VaultID: id}))
+	seized := 35 * types.Coin * 1100 / 1500
+	assert.Equal(t, seized, mock33.GetExecBalance(sty.StablecoinX, liquidator))
+	assert.Equal(t, int64(0), stableBalance(t, mock33, liquidator))
+	vault = getVault(t, mock33, id)
+	assert.Equal(t, 29*types.Coin-seized, vault.Collateral)
+	assert.Equal(t, int64(0), vault.Debt)
+	collateral = query(t, mock33, sty.FuncNameGetCollateral, &sty.ReqStableCollateral{AssetExec: "coins"}).(*sty.StableCollateral)
+	assert.Equal(t, int64(0), collateral.TotalDebt)
+	assert.Equal(t, vault.Collateral, collateral.TotalCollateral)
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, ownerPriv, "Withdraw", &sty.StableWithdraw{VaultID: id, Amount: vault.Collateral}))
+	assert.Equal(t, 21*types.Coin+vault.Collateral, mock33.GetExecBalance(sty.StablecoinX, owner))
+
+	//价格过期以后不能铸造，偿付能力查询标记过期
+	assert.Nil(t, mock33.CreateBlocksTo(mock33.GetLastBlock().Height+31))
+	assert.Equal(t, int32(types.ExecOk), send(t, mock33, ownerPriv, "Deposit", &sty.StableDeposit{VaultID: id, Amount: 10 * types.Coin}))
+	assert.Equal(t, int32(types.ExecPack), send(t, mock33, ownerPriv, "Mint", &sty.StableMint{VaultID: id, Amount: types.Coin}))
+	solvency = query(t, mock33, sty.FuncNameGetSolvency, &types.ReqNil{}).(*sty.ReplyStableSolvency)
+	assert.True(t, solvency.Items[0].Stale)
+	assert.Equal(t, int64(0), solvency.Value)
+}
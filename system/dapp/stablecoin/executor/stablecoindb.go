@@ -0,0 +1,522 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	oracle "github.com/33cn/chain33/system/dapp/oracle/executor"
+	sty "github.com/33cn/chain33/system/dapp/stablecoin/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	collateralKeyPrefix = "mavl-" + sty.StablecoinX + "-collateral-"
+	collateralListKey   = []byte("mavl-" + sty.StablecoinX + "-collaterals")
+	vaultKeyPrefix      = "mavl-" + sty.StablecoinX + "-vault-"
+	ownerIndexPrefix    = "LODB-" + sty.StablecoinX + "-owner-"
+)
+
+func calcCollateralKey(key string) []byte {
+	return []byte(collateralKeyPrefix + key)
+}
+
+func calcVaultKey(id string) []byte {
+	return []byte(vaultKeyPrefix + id)
+}
+
+func calcOwnerIndexKey(owner, id string) []byte {
+	return []byte(ownerIndexPrefix + owner + "-" + id)
+}
+
+//calcVaultID 金库的id按开金库的交易的位置生成
+func calcVaultID(height int64, index int) string {
+	return fmt.Sprintf("%018d", height*types.MaxTxsPerBlock+int64(index))
+}
+
+//calcVaultAddr 每个金库的抵押资产存在一个没有私钥的地址中
+func calcVaultAddr(id string) string {
+	return address.ExecAddress(sty.StablecoinX + "-vault-" + id)
+}
+
+//calcSeized 清算人按债务加上罚金拿走抵押资产，抵押资产不够的时候全部拿走
+func calcSeized(collateral, debt, penalty, price int64) int64 {
+	v := new(big.Int).Mul(big.NewInt(debt), big.NewInt(sty.RatioBase+penalty))
+	v.Mul(v, big.NewInt(sty.PriceBase))
+	v.Div(v, new(big.Int).Mul(big.NewInt(sty.RatioBase), big.NewInt(price)))
+	if v.Cmp(big.NewInt(collateral)) > 0 {
+		return collateral
+	}
+	return v.Int64()
+}
+
+//getPrice oracle数据源最新的价格，超过maxPriceAge个区块的价格不能使用
+func getPrice(db dbm.KV, c *sty.StableCollateral, height int64) (int64, error) {
+	attestation, err := oracle.GetLatestAttestation(db, c.Feed)
+	if err != nil {
+		return 0, sty.ErrPrice
+	}
+	if attestation.Value <= 0 || height-attestation.Height > c.MaxPriceAge {
+		return 0, sty.ErrPrice
+	}
+	return attestation.Value, nil
+}
+
+func stableAccount(db dbm.KV) (*account.DB, error) {
+	return account.NewAccountDB(sty.StablecoinX, sty.StableSymbol, db)
+}
+
+// Action stablecoin交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+	kvs          []*types.KeyValue
+	logs         []*types.ReceiptLog
+}
+
+// NewAction new a action object
+func NewAction(s *Stablecoin, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: s.GetCoinsAccount(),
+		db:           s.GetStateDB(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       s.GetHeight(),
+		index:        index,
+	}
+}
+
+//assetAccount coins用执行器的coins账户，其他资产按执行器和symbol创建账户
+func (a *Action) assetAccount(exec, symbol string) (*account.DB, error) {
+	if exec == "coins" {
+		return a.coinsAccount, nil
+	}
+	return account.NewAccountDB(exec, symbol, a.db)
+}
+
+func (a *Action) merge(receipt *types.Receipt) {
+	a.kvs = append(a.kvs, receipt.KV...)
+	a.logs = append(a.logs, receipt.Logs...)
+}
+
+func (a *Action) receipt() *types.Receipt {
+	return &types.Receipt{Ty: types.ExecOk, KV: a.kvs, Logs: a.logs}
+}
+
+func getCollateral(db dbm.KV, exec, symbol string) (*sty.StableCollateral, error) {
+	exec, symbol = sty.NormalizeAsset(exec, symbol)
+	if exec == "" {
+		return nil, sty.ErrCollateralNotExist
+	}
+	value, err := db.Get(calcCollateralKey(sty.CollateralKey(exec, symbol)))
+	if err != nil || len(value) == 0 {
+		return nil, sty.ErrCollateralNotExist
+	}
+	var c sty.StableCollateral
+	if err := types.Decode(value, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func getCollateralList(db dbm.KV) ([]string, error) {
+	value, err := db.Get(collateralListKey)
+	if err != nil || len(value) == 0 {
+		return nil, nil
+	}
+	var list sty.StableCollateralList
+	if err := types.Decode(value, &list); err != nil {
+		return nil, err
+	}
+	return list.Keys, nil
+}
+
+func (a *Action) saveCollateral(prev, c *sty.StableCollateral) {
+	kv := &types.KeyValue{Key: calcCollateralKey(sty.CollateralKey(c.AssetExec, c.AssetSymbol)), Value: types.Encode(c)}
+	a.db.Set(kv.Key, kv.Value)
+	a.kvs = append(a.kvs, kv)
+	log := &sty.ReceiptStableCollateral{Prev: prev, Current: c}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: sty.TyLogStableCollateral, Log: types.Encode(log)})
+}
+
+func getVault(db dbm.KV, id string) (*sty.StableVault, error) {
+	value, err := db.Get(calcVaultKey(id))
+	if err != nil || len(value) == 0 {
+		return nil, sty.ErrVaultNotExist
+	}
+	var vault sty.StableVault
+	if err := types.Decode(value, &vault); err != nil {
+		return nil, err
+	}
+	return &vault, nil
+}
+
+func (a *Action) saveVault(prev, vault *sty.StableVault) {
+	kv := &types.KeyValue{Key: calcVaultKey(vault.VaultID), Value: types.Encode(vault)}
+	a.db.Set(kv.Key, kv.Value)
+	a.kvs = append(a.kvs, kv)
+	log := &sty.ReceiptStableVault{Prev: prev, Current: vault}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: sty.TyLogStableVault, Log: types.Encode(log)})
+}
+
+//config 修改已有的抵押资产的时候保留债务和抵押资产的总量
+func (a *Action) config(payload *sty.StableCollateralConfig) (*types.Receipt, error) {
+	if !isManager(a.fromaddr) {
+		return nil, types.ErrNotAllow
+	}
+	if err := sty.CheckCollateralConfig(payload); err != nil {
+		return nil, err
+	}
+	exec, symbol := sty.NormalizeAsset(payload.AssetExec, payload.AssetSymbol)
+	prev, err := getCollateral(a.db, exec, symbol)
+	if err != nil && err != sty.ErrCollateralNotExist {
+		return nil, err
+	}
+	c := &sty.StableCollateral{AssetExec: exec, AssetSymbol: symbol}
+	if prev != nil {
+		c.TotalDebt = prev.TotalDebt
+		c.TotalCollateral = prev.TotalCollateral
+	} else {
+		keys, err := getCollateralList(a.db)
+		if err != nil {
+			return nil, err
+		}
+		kv := &types.KeyValue{Key: collateralListKey, Value: types.Encode(&sty.StableCollateralList{Keys: append(keys, sty.CollateralKey(exec, symbol))})}
+		a.db.Set(kv.Key, kv.Value)
+		a.kvs = append(a.kvs, kv)
+	}
+	c.Feed = payload.Feed
+	c.LiquidationRatio = payload.LiquidationRatio
+	c.Penalty = payload.Penalty
+	c.DebtCeiling = payload.DebtCeiling
+	c.MaxPriceAge = payload.MaxPriceAge
+	a.saveCollateral(prev, c)
+	return a.receipt(), nil
+}
+
+//deposit 抵押资产从交易发送者在stablecoin执行器的账户转到金库的地址
+func (a *Action) deposit(c *sty.StableCollateral, vault *sty.StableVault, amount int64) error {
+	if amount <= 0 {
+		return sty.ErrStableAmount
+	}
+	acc, err := a.assetAccount(vault.AssetExec, vault.AssetSymbol)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.ExecTransfer(a.fromaddr, calcVaultAddr(vault.VaultID), a.execaddr, amount)
+	if err != nil {
+		return err
+	}
+	a.merge(receipt)
+	vault.Collateral += amount
+	c.TotalCollateral += amount
+	return nil
+}
+
+//mint 铸造以后金库的抵押率不能低于最低抵押率
+func (a *Action) mint(c *sty.StableCollateral, vault *sty.StableVault, amount int64) error {
+	if amount <= 0 {
+		return sty.ErrStableAmount
+	}
+	if c.TotalDebt+amount > c.DebtCeiling {
+		return sty.ErrDebtCeiling
+	}
+	price, err := getPrice(a.db, c, a.height)
+	if err != nil {
+		return err
+	}
+	if !sty.IsSafe(vault.Collateral, price, vault.Debt+amount, c.LiquidationRatio) {
+		return sty.ErrUnsafe
+	}
+	acc, err := stableAccount(a.db)
+	if err != nil {
+		return err
+	}
+	receipt, err := acc.Mint(vault.Owner, amount)
+	if err != nil {
+		return err
+	}
+	a.merge(receipt)
+	vault.Debt += amount
+	c.TotalDebt += amount
+	return nil
+}
+
+//loadVault 读取交易发送者的金库和抵押资产的配置
+func (a *Action) loadVault(id string) (*sty.StableVault, *sty.StableCollateral, error) {
+	vault, err := getVault(a.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if vault.Owner != a.fromaddr {
+		return nil, nil, sty.ErrVaultOwner
+	}
+	c, err := getCollateral(a.db, vault.AssetExec, vault.AssetSymbol)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vault, c, nil
+}
+
+func (a *Action) save(prevVault, vault *sty.StableVault, prevC, c *sty.StableCollateral) *types.Receipt {
+	a.saveVault(prevVault, vault)
+	a.saveCollateral(prevC, c)
+	return a.receipt()
+}
+
+func (a *Action) open(payload *sty.StableOpen) (*types.Receipt, error) {
+	prevC, err := getCollateral(a.db, payload.AssetExec, payload.AssetSymbol)
+	if err != nil {
+		return nil, err
+	}
+	c := *prevC
+	vault := &sty.StableVault{
+		VaultID:     calcVaultID(a.height, a.index),
+		Owner:       a.fromaddr,
+		AssetExec:   c.AssetExec,
+		AssetSymbol: c.AssetSymbol,
+		Height:      a.height,
+	}
+	if payload.Collateral < 0 || payload.Debt < 0 {
+		return nil, sty.ErrStableAmount
+	}
+	if payload.Collateral > 0 {
+		if err := a.deposit(&c, vault, payload.Collateral); err != nil {
+			return nil, err
+		}
+	}
+	if payload.Debt > 0 {
+		if err := a.mint(&c, vault, payload.Debt); err != nil {
+			return nil, err
+		}
+	}
+	return a.save(nil, vault, prevC, &c), nil
+}
+
+func (a *Action) depositVault(payload *sty.StableDeposit) (*types.Receipt, error) {
+	prev, prevC, err := a.loadVault(payload.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	vault, c := *prev, *prevC
+	if err := a.deposit(&c, &vault, payload.Amount); err != nil {
+		return nil, err
+	}
+	return a.save(prev, &vault, prevC, &c), nil
+}
+
+//withdraw 有债务的时候取出以后的抵押率不能低于最低抵押率
+func (a *Action) withdraw(payload *sty.StableWithdraw) (*types.Receipt, error) {
+	prev, prevC, err := a.loadVault(payload.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	vault, c := *prev, *prevC
+	if payload.Amount <= 0 || payload.Amount > vault.Collateral {
+		return nil, sty.ErrStableAmount
+	}
+	vault.Collateral -= payload.Amount
+	c.TotalCollateral -= payload.Amount
+	if vault.Debt > 0 {
+		price, err := getPrice(a.db, &c, a.height)
+		if err != nil {
+			return nil, err
+		}
+		if !sty.IsSafe(vault.Collateral, price, vault.Debt, c.LiquidationRatio) {
+			return nil, sty.ErrUnsafe
+		}
+	}
+	acc, err := a.assetAccount(vault.AssetExec, vault.AssetSymbol)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.ExecTransfer(calcVaultAddr(vault.VaultID), vault.Owner, a.execaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	a.merge(receipt)
+	return a.save(prev, &vault, prevC, &c), nil
+}
+
+func (a *Action) mintVault(payload *sty.StableMint) (*types.Receipt, error) {
+	prev, prevC, err := a.loadVault(payload.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	vault, c := *prev, *prevC
+	if err := a.mint(&c, &vault, payload.Amount); err != nil {
+		return nil, err
+	}
+	return a.save(prev, &vault, prevC, &c), nil
+}
+
+//repay 销毁金库拥有者的稳定币偿还债务
+func (a *Action) repay(payload *sty.StableRepay) (*types.Receipt, error) {
+	prev, prevC, err := a.loadVault(payload.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	vault, c := *prev, *prevC
+	if payload.Amount <= 0 || payload.Amount > vault.Debt {
+		return nil, sty.ErrStableAmount
+	}
+	acc, err := stableAccount(a.db)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.Burn(a.fromaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	a.merge(receipt)
+	vault.Debt -= payload.Amount
+	c.TotalDebt -= payload.Amount
+	return a.save(prev, &vault, prevC, &c), nil
+}
+
+//liquidate 抵押率低于最低抵押率的时候任何人都可以销毁自己的稳定币偿还金库全部的债务，按价格拿走抵押资产，剩余的抵押资产留给拥有者
+func (a *Action) liquidate(payload *sty.StableLiquidate) (*types.Receipt, error) {
+	prev, err := getVault(a.db, payload.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	prevC, err := getCollateral(a.db, prev.AssetExec, prev.AssetSymbol)
+	if err != nil {
+		return nil, err
+	}
+	vault, c := *prev, *prevC
+	if vault.Debt == 0 {
+		return nil, sty.ErrVaultSafe
+	}
+	price, err := getPrice(a.db, &c, a.height)
+	if err != nil {
+		return nil, err
+	}
+	if sty.IsSafe(vault.Collateral, price, vault.Debt, c.LiquidationRatio) {
+		return nil, sty.ErrVaultSafe
+	}
+	stable, err := stableAccount(a.db)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := stable.Burn(a.fromaddr, vault.Debt)
+	if err != nil {
+		return nil, err
+	}
+	a.merge(receipt)
+	seized := calcSeized(vault.Collateral, vault.Debt, c.Penalty, price)
+	acc, err := a.assetAccount(vault.AssetExec, vault.AssetSymbol)
+	if err != nil {
+		return nil, err
+	}
+	if receipt, err = acc.ExecTransfer(calcVaultAddr(vault.VaultID), a.fromaddr, a.execaddr, seized); err != nil {
+		return nil, err
+	}
+	a.merge(receipt)
+	liquidation := &sty.ReceiptStableLiquidation{
+		VaultID:    vault.VaultID,
+		Liquidator: a.fromaddr,
+		Debt:       vault.Debt,
+		Seized:     seized,
+		Price:      price,
+	}
+	if value := sty.CalcValue(vault.Collateral, price); value < vault.Debt {
+		liquidation.BadDebt = vault.Debt - value
+	}
+	a.logs = append(a.logs, &types.ReceiptLog{Ty: sty.TyLogStableLiquidation, Log: types.Encode(liquidation)})
+	c.TotalDebt -= vault.Debt
+	c.TotalCollateral -= seized
+	vault.Debt = 0
+	vault.Collateral -= seized
+	return a.save(prev, &vault, prevC, &c), nil
+}
+
+func (a *Action) transfer(payload *sty.StableTransfer) (*types.Receipt, error) {
+	if payload.Amount <= 0 {
+		return nil, sty.ErrStableAmount
+	}
+	if err := address.CheckAddress(payload.To); err != nil {
+		return nil, err
+	}
+	acc, err := stableAccount(a.db)
+	if err != nil {
+		return nil, err
+	}
+	return acc.Transfer(a.fromaddr, payload.To, payload.Amount)
+}
+
+func listCount(count int32) int32 {
+	if count <= 0 {
+		return sty.DefaultListCount
+	}
+	if count > sty.MaxListCount {
+		return sty.MaxListCount
+	}
+	return count
+}
+
+func listVaults(localdb dbm.KVDB, statedb dbm.KV, req *sty.ReqStableVaults) (*sty.ReplyStableVaults, error) {
+	if req.Owner == "" {
+		return nil, types.ErrInvalidParam
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcOwnerIndexKey(req.Owner, req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(ownerIndexPrefix+req.Owner+"-"), key, listCount(req.Count), req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &sty.ReplyStableVaults{}
+	for _, value := range values {
+		vault, err := getVault(statedb, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.Vaults = append(reply.Vaults, vault)
+		reply.PrimaryKey = vault.VaultID
+	}
+	return reply, nil
+}
+
+//getSolvency 按最新的价格计算每种抵押资产和整个系统的抵押率，价格过期的抵押资产不计入价值
+func getSolvency(db dbm.KV, height int64) (*sty.ReplyStableSolvency, error) {
+	keys, err := getCollateralList(db)
+	if err != nil {
+		return nil, err
+	}
+	reply := &sty.ReplyStableSolvency{}
+	for _, key := range keys {
+		value, err := db.Get(calcCollateralKey(key))
+		if err != nil {
+			return nil, err
+		}
+		var c sty.StableCollateral
+		if err := types.Decode(value, &c); err != nil {
+			return nil, err
+		}
+		item := &sty.StableSolvencyItem{Collateral: &c}
+		if item.Price, err = getPrice(db, &c, height); err != nil {
+			item.Stale = true
+		} else {
+			item.Value = sty.CalcValue(c.TotalCollateral, item.Price)
+			item.Ratio = sty.CalcRatio(item.Value, c.TotalDebt)
+			reply.Value += item.Value
+		}
+		reply.Supply += c.TotalDebt
+		reply.Items = append(reply.Items, item)
+	}
+	reply.Ratio = sty.CalcRatio(reply.Value, reply.Supply)
+	reply.Solvent = reply.Value >= reply.Supply
+	return reply, nil
+}
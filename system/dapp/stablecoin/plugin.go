@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package stablecoin 稳定币执行器插件
+// 1. 管理员配置可以抵押的coins或者token，以及价格数据源、最低抵押率、清算罚金和债务上限
+// 2. 用户开金库存入抵押资产，按oracle的价格在最低抵押率以内铸造稳定币，偿还以后取回抵押资产
+// 3. 抵押率低于最低抵押率的金库任何人都可以清算，偿付能力查询按最新价格汇总全部抵押资产和稳定币
+package stablecoin
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/stablecoin/commands"
+	"github.com/33cn/chain33/system/dapp/stablecoin/executor"
+	"github.com/33cn/chain33/system/dapp/stablecoin/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.StablecoinX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.StablecoinCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// stablecoin action ty
+const (
+	StableActionConfig = iota + 1
+	StableActionOpen
+	StableActionDeposit
+	StableActionWithdraw
+	StableActionMint
+	StableActionRepay
+	StableActionLiquidate
+	StableActionTransfer
+)
+
+// stablecoin log ty
+const (
+	TyLogStableCollateral  = 600
+	TyLogStableVault       = 601
+	TyLogStableLiquidation = 602
+)
+
+// query func name
+const (
+	FuncNameGetCollateral = "GetCollateral"
+	FuncNameGetVault      = "GetVault"
+	FuncNameListVaults    = "ListVaults"
+	FuncNameGetSolvency   = "GetSolvency"
+	FuncNameGetBalance    = "GetBalance"
+	//StableSymbol 稳定币的symbol，账户按 StablecoinX 和 StableSymbol 创建
+	StableSymbol = "STABLE"
+	//PriceBase 价格的精度
+	PriceBase = 100000000
+	//RatioBase 抵押率和罚金的精度
+	RatioBase = 1000
+	//MaxPenalty 清算罚金最多50%
+	MaxPenalty       = 500
+	DefaultListCount = 20
+	MaxListCount     = 100
+)
@@ -0,0 +1,28 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrCollateralConfig 抵押资产的配置不合法
+	ErrCollateralConfig = errors.New("ErrCollateralConfig")
+	// ErrCollateralNotExist 抵押资产没有配置
+	ErrCollateralNotExist = errors.New("ErrCollateralNotExist")
+	// ErrVaultNotExist 金库不存在
+	ErrVaultNotExist = errors.New("ErrVaultNotExist")
+	// ErrVaultOwner 不是金库的拥有者
+	ErrVaultOwner = errors.New("ErrVaultOwner")
+	// ErrStableAmount 金额不合法，或者超过金库的抵押资产和债务
+	ErrStableAmount = errors.New("ErrStableAmount")
+	// ErrPrice 数据源没有价格，价格不合法或者过期
+	ErrPrice = errors.New("ErrPrice")
+	// ErrUnsafe 操作以后金库的抵押率低于最低抵押率
+	ErrUnsafe = errors.New("ErrUnsafe")
+	// ErrDebtCeiling 超过抵押资产的债务上限
+	ErrDebtCeiling = errors.New("ErrDebtCeiling")
+	// ErrVaultSafe 金库的抵押率没有低于最低抵押率，不能清算
+	ErrVaultSafe = errors.New("ErrVaultSafe")
+)
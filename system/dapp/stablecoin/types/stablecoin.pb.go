@@ -0,0 +1,1597 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: stablecoin.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type StablecoinAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*StablecoinAction_Config
+	//	*StablecoinAction_Open
+	//	*StablecoinAction_Deposit
+	//	*StablecoinAction_Withdraw
+	//	*StablecoinAction_Mint
+	//	*StablecoinAction_Repay
+	//	*StablecoinAction_Liquidate
+	//	*StablecoinAction_Transfer
+	Value                isStablecoinAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                    `protobuf:"varint,9,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *StablecoinAction) Reset()         { *m = StablecoinAction{} }
+func (m *StablecoinAction) String() string { return proto.CompactTextString(m) }
+func (*StablecoinAction) ProtoMessage()    {}
+func (*StablecoinAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{0}
+}
+
+func (m *StablecoinAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StablecoinAction.Unmarshal(m, b)
+}
+func (m *StablecoinAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StablecoinAction.Marshal(b, m, deterministic)
+}
+func (m *StablecoinAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StablecoinAction.Merge(m, src)
+}
+func (m *StablecoinAction) XXX_Size() int {
+	return xxx_messageInfo_StablecoinAction.Size(m)
+}
+func (m *StablecoinAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_StablecoinAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StablecoinAction proto.InternalMessageInfo
+
+type isStablecoinAction_Value interface {
+	isStablecoinAction_Value()
+}
+
+type StablecoinAction_Config struct {
+	Config *StableCollateralConfig `protobuf:"bytes,1,opt,name=config,proto3,oneof"`
+}
+
+type StablecoinAction_Open struct {
+	Open *StableOpen `protobuf:"bytes,2,opt,name=open,proto3,oneof"`
+}
+
+type StablecoinAction_Deposit struct {
+	Deposit *StableDeposit `protobuf:"bytes,3,opt,name=deposit,proto3,oneof"`
+}
+
+type StablecoinAction_Withdraw struct {
+	Withdraw *StableWithdraw `protobuf:"bytes,4,opt,name=withdraw,proto3,oneof"`
+}
+
+type StablecoinAction_Mint struct {
+	Mint *StableMint `protobuf:"bytes,5,opt,name=mint,proto3,oneof"`
+}
+
+type StablecoinAction_Repay struct {
+	Repay *StableRepay `protobuf:"bytes,6,opt,name=repay,proto3,oneof"`
+}
+
+type StablecoinAction_Liquidate struct {
+	Liquidate *StableLiquidate `protobuf:"bytes,7,opt,name=liquidate,proto3,oneof"`
+}
+
+type StablecoinAction_Transfer struct {
+	Transfer *StableTransfer `protobuf:"bytes,8,opt,name=transfer,proto3,oneof"`
+}
+
+func (*StablecoinAction_Config) isStablecoinAction_Value() {}
+
+func (*StablecoinAction_Open) isStablecoinAction_Value() {}
+
+func (*StablecoinAction_Deposit) isStablecoinAction_Value() {}
+
+func (*StablecoinAction_Withdraw) isStablecoinAction_Value() {}
+
+func (*StablecoinAction_Mint) isStablecoinAction_Value() {}
+
+func (*StablecoinAction_Repay) isStablecoinAction_Value() {}
+
+func (*StablecoinAction_Liquidate) isStablecoinAction_Value() {}
+
+func (*StablecoinAction_Transfer) isStablecoinAction_Value() {}
+
+func (m *StablecoinAction) GetValue() isStablecoinAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetConfig() *StableCollateralConfig {
+	if x, ok := m.GetValue().(*StablecoinAction_Config); ok {
+		return x.Config
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetOpen() *StableOpen {
+	if x, ok := m.GetValue().(*StablecoinAction_Open); ok {
+		return x.Open
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetDeposit() *StableDeposit {
+	if x, ok := m.GetValue().(*StablecoinAction_Deposit); ok {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetWithdraw() *StableWithdraw {
+	if x, ok := m.GetValue().(*StablecoinAction_Withdraw); ok {
+		return x.Withdraw
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetMint() *StableMint {
+	if x, ok := m.GetValue().(*StablecoinAction_Mint); ok {
+		return x.Mint
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetRepay() *StableRepay {
+	if x, ok := m.GetValue().(*StablecoinAction_Repay); ok {
+		return x.Repay
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetLiquidate() *StableLiquidate {
+	if x, ok := m.GetValue().(*StablecoinAction_Liquidate); ok {
+		return x.Liquidate
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetTransfer() *StableTransfer {
+	if x, ok := m.GetValue().(*StablecoinAction_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *StablecoinAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*StablecoinAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _StablecoinAction_OneofMarshaler, _StablecoinAction_OneofUnmarshaler, _StablecoinAction_OneofSizer, []interface{}{
+		(*StablecoinAction_Config)(nil),
+		(*StablecoinAction_Open)(nil),
+		(*StablecoinAction_Deposit)(nil),
+		(*StablecoinAction_Withdraw)(nil),
+		(*StablecoinAction_Mint)(nil),
+		(*StablecoinAction_Repay)(nil),
+		(*StablecoinAction_Liquidate)(nil),
+		(*StablecoinAction_Transfer)(nil),
+	}
+}
+
+func _StablecoinAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*StablecoinAction)
+	// value
+	switch x := m.Value.(type) {
+	case *StablecoinAction_Config:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Config); err != nil {
+			return err
+		}
+	case *StablecoinAction_Open:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Open); err != nil {
+			return err
+		}
+	case *StablecoinAction_Deposit:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Deposit); err != nil {
+			return err
+		}
+	case *StablecoinAction_Withdraw:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Withdraw); err != nil {
+			return err
+		}
+	case *StablecoinAction_Mint:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Mint); err != nil {
+			return err
+		}
+	case *StablecoinAction_Repay:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Repay); err != nil {
+			return err
+		}
+	case *StablecoinAction_Liquidate:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Liquidate); err != nil {
+			return err
+		}
+	case *StablecoinAction_Transfer:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Transfer); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("StablecoinAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _StablecoinAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*StablecoinAction)
+	switch tag {
+	case 1: // value.config
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StableCollateralConfig)
+		err := b.DecodeMessage(msg)
+		m.Value = &StablecoinAction_Config{msg}
+		return true, err
+	case 2: // value.open
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StableOpen)
+		err := b.DecodeMessage(msg)
+		m.Value = &StablecoinAction_Open{msg}
+		return true, err
+	case 3: // value.deposit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StableDeposit)
+		err := b.DecodeMessage(msg)
+		m.Value = &StablecoinAction_Deposit{msg}
+		return true, err
+	case 4: // value.withdraw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StableWithdraw)
+		err := b.DecodeMessage(msg)
+		m.Value = &StablecoinAction_Withdraw{msg}
+		return true, err
+	case 5: // value.mint
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StableMint)
+		err := b.DecodeMessage(msg)
+		m.Value = &StablecoinAction_Mint{msg}
+		return true, err
+	case 6: // value.repay
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StableRepay)
+		err := b.DecodeMessage(msg)
+		m.Value = &StablecoinAction_Repay{msg}
+		return true, err
+	case 7: // value.liquidate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StableLiquidate)
+		err := b.DecodeMessage(msg)
+		m.Value = &StablecoinAction_Liquidate{msg}
+		return true, err
+	case 8: // value.transfer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StableTransfer)
+		err := b.DecodeMessage(msg)
+		m.Value = &StablecoinAction_Transfer{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _StablecoinAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*StablecoinAction)
+	// value
+	switch x := m.Value.(type) {
+	case *StablecoinAction_Config:
+		s := proto.Size(x.Config)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StablecoinAction_Open:
+		s := proto.Size(x.Open)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StablecoinAction_Deposit:
+		s := proto.Size(x.Deposit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StablecoinAction_Withdraw:
+		s := proto.Size(x.Withdraw)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StablecoinAction_Mint:
+		s := proto.Size(x.Mint)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StablecoinAction_Repay:
+		s := proto.Size(x.Repay)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StablecoinAction_Liquidate:
+		s := proto.Size(x.Liquidate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StablecoinAction_Transfer:
+		s := proto.Size(x.Transfer)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//manage合约的超级管理员配置抵押资产
+//   feed : oracle数据源，值是一个抵押资产可以换多少稳定币，精度为1e8
+//   liquidationRatio : 千分比的最低抵押率，低于这个抵押率的金库可以被清算
+//   penalty : 千分比的清算罚金，清算人按债务加上罚金拿走抵押资产
+//   debtCeiling : 这种抵押资产最多铸造的稳定币
+//   maxPriceAge : 价格的最大区块数，超过以后不能铸造和清算
+type StableCollateralConfig struct {
+	AssetExec            string   `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Feed                 string   `protobuf:"bytes,3,opt,name=feed,proto3" json:"feed,omitempty"`
+	LiquidationRatio     int64    `protobuf:"varint,4,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
+	Penalty              int64    `protobuf:"varint,5,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	DebtCeiling          int64    `protobuf:"varint,6,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`
+	MaxPriceAge          int64    `protobuf:"varint,7,opt,name=maxPriceAge,proto3" json:"maxPriceAge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableCollateralConfig) Reset()         { *m = StableCollateralConfig{} }
+func (m *StableCollateralConfig) String() string { return proto.CompactTextString(m) }
+func (*StableCollateralConfig) ProtoMessage()    {}
+func (*StableCollateralConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{1}
+}
+
+func (m *StableCollateralConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableCollateralConfig.Unmarshal(m, b)
+}
+func (m *StableCollateralConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableCollateralConfig.Marshal(b, m, deterministic)
+}
+func (m *StableCollateralConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableCollateralConfig.Merge(m, src)
+}
+func (m *StableCollateralConfig) XXX_Size() int {
+	return xxx_messageInfo_StableCollateralConfig.Size(m)
+}
+func (m *StableCollateralConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableCollateralConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableCollateralConfig proto.InternalMessageInfo
+
+func (m *StableCollateralConfig) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *StableCollateralConfig) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *StableCollateralConfig) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *StableCollateralConfig) GetLiquidationRatio() int64 {
+	if m != nil {
+		return m.LiquidationRatio
+	}
+	return 0
+}
+
+func (m *StableCollateralConfig) GetPenalty() int64 {
+	if m != nil {
+		return m.Penalty
+	}
+	return 0
+}
+
+func (m *StableCollateralConfig) GetDebtCeiling() int64 {
+	if m != nil {
+		return m.DebtCeiling
+	}
+	return 0
+}
+
+func (m *StableCollateralConfig) GetMaxPriceAge() int64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+//开一个金库，可以同时存入抵押资产和铸造稳定币
+type StableOpen struct {
+	AssetExec            string   `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Collateral           int64    `protobuf:"varint,3,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Debt                 int64    `protobuf:"varint,4,opt,name=debt,proto3" json:"debt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableOpen) Reset()         { *m = StableOpen{} }
+func (m *StableOpen) String() string { return proto.CompactTextString(m) }
+func (*StableOpen) ProtoMessage()    {}
+func (*StableOpen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{2}
+}
+
+func (m *StableOpen) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableOpen.Unmarshal(m, b)
+}
+func (m *StableOpen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableOpen.Marshal(b, m, deterministic)
+}
+func (m *StableOpen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableOpen.Merge(m, src)
+}
+func (m *StableOpen) XXX_Size() int {
+	return xxx_messageInfo_StableOpen.Size(m)
+}
+func (m *StableOpen) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableOpen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableOpen proto.InternalMessageInfo
+
+func (m *StableOpen) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *StableOpen) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *StableOpen) GetCollateral() int64 {
+	if m != nil {
+		return m.Collateral
+	}
+	return 0
+}
+
+func (m *StableOpen) GetDebt() int64 {
+	if m != nil {
+		return m.Debt
+	}
+	return 0
+}
+
+type StableDeposit struct {
+	VaultID              string   `protobuf:"bytes,1,opt,name=vaultID,proto3" json:"vaultID,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableDeposit) Reset()         { *m = StableDeposit{} }
+func (m *StableDeposit) String() string { return proto.CompactTextString(m) }
+func (*StableDeposit) ProtoMessage()    {}
+func (*StableDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{3}
+}
+
+func (m *StableDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableDeposit.Unmarshal(m, b)
+}
+func (m *StableDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableDeposit.Marshal(b, m, deterministic)
+}
+func (m *StableDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableDeposit.Merge(m, src)
+}
+func (m *StableDeposit) XXX_Size() int {
+	return xxx_messageInfo_StableDeposit.Size(m)
+}
+func (m *StableDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableDeposit proto.InternalMessageInfo
+
+func (m *StableDeposit) GetVaultID() string {
+	if m != nil {
+		return m.VaultID
+	}
+	return ""
+}
+
+func (m *StableDeposit) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type StableWithdraw struct {
+	VaultID              string   `protobuf:"bytes,1,opt,name=vaultID,proto3" json:"vaultID,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableWithdraw) Reset()         { *m = StableWithdraw{} }
+func (m *StableWithdraw) String() string { return proto.CompactTextString(m) }
+func (*StableWithdraw) ProtoMessage()    {}
+func (*StableWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{4}
+}
+
+func (m *StableWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableWithdraw.Unmarshal(m, b)
+}
+func (m *StableWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableWithdraw.Marshal(b, m, deterministic)
+}
+func (m *StableWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableWithdraw.Merge(m, src)
+}
+func (m *StableWithdraw) XXX_Size() int {
+	return xxx_messageInfo_StableWithdraw.Size(m)
+}
+func (m *StableWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableWithdraw proto.InternalMessageInfo
+
+func (m *StableWithdraw) GetVaultID() string {
+	if m != nil {
+		return m.VaultID
+	}
+	return ""
+}
+
+func (m *StableWithdraw) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type StableMint struct {
+	VaultID              string   `protobuf:"bytes,1,opt,name=vaultID,proto3" json:"vaultID,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableMint) Reset()         { *m = StableMint{} }
+func (m *StableMint) String() string { return proto.CompactTextString(m) }
+func (*StableMint) ProtoMessage()    {}
+func (*StableMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{5}
+}
+
+func (m *StableMint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableMint.Unmarshal(m, b)
+}
+func (m *StableMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableMint.Marshal(b, m, deterministic)
+}
+func (m *StableMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableMint.Merge(m, src)
+}
+func (m *StableMint) XXX_Size() int {
+	return xxx_messageInfo_StableMint.Size(m)
+}
+func (m *StableMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableMint proto.InternalMessageInfo
+
+func (m *StableMint) GetVaultID() string {
+	if m != nil {
+		return m.VaultID
+	}
+	return ""
+}
+
+func (m *StableMint) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type StableRepay struct {
+	VaultID              string   `protobuf:"bytes,1,opt,name=vaultID,proto3" json:"vaultID,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableRepay) Reset()         { *m = StableRepay{} }
+func (m *StableRepay) String() string { return proto.CompactTextString(m) }
+func (*StableRepay) ProtoMessage()    {}
+func (*StableRepay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{6}
+}
+
+func (m *StableRepay) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableRepay.Unmarshal(m, b)
+}
+func (m *StableRepay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableRepay.Marshal(b, m, deterministic)
+}
+func (m *StableRepay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableRepay.Merge(m, src)
+}
+func (m *StableRepay) XXX_Size() int {
+	return xxx_messageInfo_StableRepay.Size(m)
+}
+func (m *StableRepay) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableRepay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableRepay proto.InternalMessageInfo
+
+func (m *StableRepay) GetVaultID() string {
+	if m != nil {
+		return m.VaultID
+	}
+	return ""
+}
+
+func (m *StableRepay) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type StableLiquidate struct {
+	VaultID              string   `protobuf:"bytes,1,opt,name=vaultID,proto3" json:"vaultID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableLiquidate) Reset()         { *m = StableLiquidate{} }
+func (m *StableLiquidate) String() string { return proto.CompactTextString(m) }
+func (*StableLiquidate) ProtoMessage()    {}
+func (*StableLiquidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{7}
+}
+
+func (m *StableLiquidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableLiquidate.Unmarshal(m, b)
+}
+func (m *StableLiquidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableLiquidate.Marshal(b, m, deterministic)
+}
+func (m *StableLiquidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableLiquidate.Merge(m, src)
+}
+func (m *StableLiquidate) XXX_Size() int {
+	return xxx_messageInfo_StableLiquidate.Size(m)
+}
+func (m *StableLiquidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableLiquidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableLiquidate proto.InternalMessageInfo
+
+func (m *StableLiquidate) GetVaultID() string {
+	if m != nil {
+		return m.VaultID
+	}
+	return ""
+}
+
+type StableTransfer struct {
+	To                   string   `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableTransfer) Reset()         { *m = StableTransfer{} }
+func (m *StableTransfer) String() string { return proto.CompactTextString(m) }
+func (*StableTransfer) ProtoMessage()    {}
+func (*StableTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{8}
+}
+
+func (m *StableTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableTransfer.Unmarshal(m, b)
+}
+func (m *StableTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableTransfer.Marshal(b, m, deterministic)
+}
+func (m *StableTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableTransfer.Merge(m, src)
+}
+func (m *StableTransfer) XXX_Size() int {
+	return xxx_messageInfo_StableTransfer.Size(m)
+}
+func (m *StableTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableTransfer proto.InternalMessageInfo
+
+func (m *StableTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *StableTransfer) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//totalDebt 和 totalCollateral 是所有这种抵押资产的金库的总和
+type StableCollateral struct {
+	AssetExec            string   `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Feed                 string   `protobuf:"bytes,3,opt,name=feed,proto3" json:"feed,omitempty"`
+	LiquidationRatio     int64    `protobuf:"varint,4,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
+	Penalty              int64    `protobuf:"varint,5,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	DebtCeiling          int64    `protobuf:"varint,6,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`
+	MaxPriceAge          int64    `protobuf:"varint,7,opt,name=maxPriceAge,proto3" json:"maxPriceAge,omitempty"`
+	TotalDebt            int64    `protobuf:"varint,8,opt,name=totalDebt,proto3" json:"totalDebt,omitempty"`
+	TotalCollateral      int64    `protobuf:"varint,9,opt,name=totalCollateral,proto3" json:"totalCollateral,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableCollateral) Reset()         { *m = StableCollateral{} }
+func (m *StableCollateral) String() string { return proto.CompactTextString(m) }
+func (*StableCollateral) ProtoMessage()    {}
+func (*StableCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{9}
+}
+
+func (m *StableCollateral) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableCollateral.Unmarshal(m, b)
+}
+func (m *StableCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableCollateral.Marshal(b, m, deterministic)
+}
+func (m *StableCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableCollateral.Merge(m, src)
+}
+func (m *StableCollateral) XXX_Size() int {
+	return xxx_messageInfo_StableCollateral.Size(m)
+}
+func (m *StableCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableCollateral proto.InternalMessageInfo
+
+func (m *StableCollateral) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *StableCollateral) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *StableCollateral) GetFeed() string {
+	if m != nil {
+		return m.Feed
+	}
+	return ""
+}
+
+func (m *StableCollateral) GetLiquidationRatio() int64 {
+	if m != nil {
+		return m.LiquidationRatio
+	}
+	return 0
+}
+
+func (m *StableCollateral) GetPenalty() int64 {
+	if m != nil {
+		return m.Penalty
+	}
+	return 0
+}
+
+func (m *StableCollateral) GetDebtCeiling() int64 {
+	if m != nil {
+		return m.DebtCeiling
+	}
+	return 0
+}
+
+func (m *StableCollateral) GetMaxPriceAge() int64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *StableCollateral) GetTotalDebt() int64 {
+	if m != nil {
+		return m.TotalDebt
+	}
+	return 0
+}
+
+func (m *StableCollateral) GetTotalCollateral() int64 {
+	if m != nil {
+		return m.TotalCollateral
+	}
+	return 0
+}
+
+type StableCollateralList struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableCollateralList) Reset()         { *m = StableCollateralList{} }
+func (m *StableCollateralList) String() string { return proto.CompactTextString(m) }
+func (*StableCollateralList) ProtoMessage()    {}
+func (*StableCollateralList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{10}
+}
+
+func (m *StableCollateralList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableCollateralList.Unmarshal(m, b)
+}
+func (m *StableCollateralList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableCollateralList.Marshal(b, m, deterministic)
+}
+func (m *StableCollateralList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableCollateralList.Merge(m, src)
+}
+func (m *StableCollateralList) XXX_Size() int {
+	return xxx_messageInfo_StableCollateralList.Size(m)
+}
+func (m *StableCollateralList) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableCollateralList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableCollateralList proto.InternalMessageInfo
+
+func (m *StableCollateralList) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type StableVault struct {
+	VaultID              string   `protobuf:"bytes,1,opt,name=vaultID,proto3" json:"vaultID,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AssetExec            string   `protobuf:"bytes,3,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,4,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Collateral           int64    `protobuf:"varint,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Debt                 int64    `protobuf:"varint,6,opt,name=debt,proto3" json:"debt,omitempty"`
+	Height               int64    `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StableVault) Reset()         { *m = StableVault{} }
+func (m *StableVault) String() string { return proto.CompactTextString(m) }
+func (*StableVault) ProtoMessage()    {}
+func (*StableVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{11}
+}
+
+func (m *StableVault) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableVault.Unmarshal(m, b)
+}
+func (m *StableVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableVault.Marshal(b, m, deterministic)
+}
+func (m *StableVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableVault.Merge(m, src)
+}
+func (m *StableVault) XXX_Size() int {
+	return xxx_messageInfo_StableVault.Size(m)
+}
+func (m *StableVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableVault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableVault proto.InternalMessageInfo
+
+func (m *StableVault) GetVaultID() string {
+	if m != nil {
+		return m.VaultID
+	}
+	return ""
+}
+
+func (m *StableVault) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StableVault) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *StableVault) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *StableVault) GetCollateral() int64 {
+	if m != nil {
+		return m.Collateral
+	}
+	return 0
+}
+
+func (m *StableVault) GetDebt() int64 {
+	if m != nil {
+		return m.Debt
+	}
+	return 0
+}
+
+func (m *StableVault) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ReceiptStableCollateral struct {
+	Prev                 *StableCollateral `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *StableCollateral `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptStableCollateral) Reset()         { *m = ReceiptStableCollateral{} }
+func (m *ReceiptStableCollateral) String() string { return proto.CompactTextString(m) }
+func (*ReceiptStableCollateral) ProtoMessage()    {}
+func (*ReceiptStableCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{12}
+}
+
+func (m *ReceiptStableCollateral) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptStableCollateral.Unmarshal(m, b)
+}
+func (m *ReceiptStableCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptStableCollateral.Marshal(b, m, deterministic)
+}
+func (m *ReceiptStableCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptStableCollateral.Merge(m, src)
+}
+func (m *ReceiptStableCollateral) XXX_Size() int {
+	return xxx_messageInfo_ReceiptStableCollateral.Size(m)
+}
+func (m *ReceiptStableCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptStableCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptStableCollateral proto.InternalMessageInfo
+
+func (m *ReceiptStableCollateral) GetPrev() *StableCollateral {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptStableCollateral) GetCurrent() *StableCollateral {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptStableVault struct {
+	Prev                 *StableVault `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *StableVault `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptStableVault) Reset()         { *m = ReceiptStableVault{} }
+func (m *ReceiptStableVault) String() string { return proto.CompactTextString(m) }
+func (*ReceiptStableVault) ProtoMessage()    {}
+func (*ReceiptStableVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{13}
+}
+
+func (m *ReceiptStableVault) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptStableVault.Unmarshal(m, b)
+}
+func (m *ReceiptStableVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptStableVault.Marshal(b, m, deterministic)
+}
+func (m *ReceiptStableVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptStableVault.Merge(m, src)
+}
+func (m *ReceiptStableVault) XXX_Size() int {
+	return xxx_messageInfo_ReceiptStableVault.Size(m)
+}
+func (m *ReceiptStableVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptStableVault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptStableVault proto.InternalMessageInfo
+
+func (m *ReceiptStableVault) GetPrev() *StableVault {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptStableVault) GetCurrent() *StableVault {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+//清算人偿还金库全部的债务，seized 是拿走的抵押资产，badDebt 是抵押资产的价值不够偿还的债务
+type ReceiptStableLiquidation struct {
+	VaultID              string   `protobuf:"bytes,1,opt,name=vaultID,proto3" json:"vaultID,omitempty"`
+	Liquidator           string   `protobuf:"bytes,2,opt,name=liquidator,proto3" json:"liquidator,omitempty"`
+	Debt                 int64    `protobuf:"varint,3,opt,name=debt,proto3" json:"debt,omitempty"`
+	Seized               int64    `protobuf:"varint,4,opt,name=seized,proto3" json:"seized,omitempty"`
+	Price                int64    `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	BadDebt              int64    `protobuf:"varint,6,opt,name=badDebt,proto3" json:"badDebt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptStableLiquidation) Reset()         { *m = ReceiptStableLiquidation{} }
+func (m *ReceiptStableLiquidation) String() string { return proto.CompactTextString(m) }
+func (*ReceiptStableLiquidation) ProtoMessage()    {}
+func (*ReceiptStableLiquidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{14}
+}
+
+func (m *ReceiptStableLiquidation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptStableLiquidation.Unmarshal(m, b)
+}
+func (m *ReceiptStableLiquidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptStableLiquidation.Marshal(b, m, deterministic)
+}
+func (m *ReceiptStableLiquidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptStableLiquidation.Merge(m, src)
+}
+func (m *ReceiptStableLiquidation) XXX_Size() int {
+	return xxx_messageInfo_ReceiptStableLiquidation.Size(m)
+}
+func (m *ReceiptStableLiquidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptStableLiquidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptStableLiquidation proto.InternalMessageInfo
+
+func (m *ReceiptStableLiquidation) GetVaultID() string {
+	if m != nil {
+		return m.VaultID
+	}
+	return ""
+}
+
+func (m *ReceiptStableLiquidation) GetLiquidator() string {
+	if m != nil {
+		return m.Liquidator
+	}
+	return ""
+}
+
+func (m *ReceiptStableLiquidation) GetDebt() int64 {
+	if m != nil {
+		return m.Debt
+	}
+	return 0
+}
+
+func (m *ReceiptStableLiquidation) GetSeized() int64 {
+	if m != nil {
+		return m.Seized
+	}
+	return 0
+}
+
+func (m *ReceiptStableLiquidation) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ReceiptStableLiquidation) GetBadDebt() int64 {
+	if m != nil {
+		return m.BadDebt
+	}
+	return 0
+}
+
+type ReqStableCollateral struct {
+	AssetExec            string   `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStableCollateral) Reset()         { *m = ReqStableCollateral{} }
+func (m *ReqStableCollateral) String() string { return proto.CompactTextString(m) }
+func (*ReqStableCollateral) ProtoMessage()    {}
+func (*ReqStableCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{15}
+}
+
+func (m *ReqStableCollateral) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStableCollateral.Unmarshal(m, b)
+}
+func (m *ReqStableCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStableCollateral.Marshal(b, m, deterministic)
+}
+func (m *ReqStableCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStableCollateral.Merge(m, src)
+}
+func (m *ReqStableCollateral) XXX_Size() int {
+	return xxx_messageInfo_ReqStableCollateral.Size(m)
+}
+func (m *ReqStableCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStableCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStableCollateral proto.InternalMessageInfo
+
+func (m *ReqStableCollateral) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ReqStableCollateral) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+type ReqStableVaults struct {
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStableVaults) Reset()         { *m = ReqStableVaults{} }
+func (m *ReqStableVaults) String() string { return proto.CompactTextString(m) }
+func (*ReqStableVaults) ProtoMessage()    {}
+func (*ReqStableVaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{16}
+}
+
+func (m *ReqStableVaults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStableVaults.Unmarshal(m, b)
+}
+func (m *ReqStableVaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStableVaults.Marshal(b, m, deterministic)
+}
+func (m *ReqStableVaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStableVaults.Merge(m, src)
+}
+func (m *ReqStableVaults) XXX_Size() int {
+	return xxx_messageInfo_ReqStableVaults.Size(m)
+}
+func (m *ReqStableVaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStableVaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStableVaults proto.InternalMessageInfo
+
+func (m *ReqStableVaults) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqStableVaults) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqStableVaults) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqStableVaults) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyStableVaults struct {
+	Vaults               []*StableVault `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	PrimaryKey           string         `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReplyStableVaults) Reset()         { *m = ReplyStableVaults{} }
+func (m *ReplyStableVaults) String() string { return proto.CompactTextString(m) }
+func (*ReplyStableVaults) ProtoMessage()    {}
+func (*ReplyStableVaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{17}
+}
+
+func (m *ReplyStableVaults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyStableVaults.Unmarshal(m, b)
+}
+func (m *ReplyStableVaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyStableVaults.Marshal(b, m, deterministic)
+}
+func (m *ReplyStableVaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyStableVaults.Merge(m, src)
+}
+func (m *ReplyStableVaults) XXX_Size() int {
+	return xxx_messageInfo_ReplyStableVaults.Size(m)
+}
+func (m *ReplyStableVaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyStableVaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyStableVaults proto.InternalMessageInfo
+
+func (m *ReplyStableVaults) GetVaults() []*StableVault {
+	if m != nil {
+		return m.Vaults
+	}
+	return nil
+}
+
+func (m *ReplyStableVaults) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+//ratio 是千分比的抵押率，没有最新价格的抵押资产不计算价值
+type StableSolvencyItem struct {
+	Collateral           *StableCollateral `protobuf:"bytes,1,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Price                int64             `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Value                int64             `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Ratio                int64             `protobuf:"varint,4,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Stale                bool              `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StableSolvencyItem) Reset()         { *m = StableSolvencyItem{} }
+func (m *StableSolvencyItem) String() string { return proto.CompactTextString(m) }
+func (*StableSolvencyItem) ProtoMessage()    {}
+func (*StableSolvencyItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{18}
+}
+
+func (m *StableSolvencyItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StableSolvencyItem.Unmarshal(m, b)
+}
+func (m *StableSolvencyItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StableSolvencyItem.Marshal(b, m, deterministic)
+}
+func (m *StableSolvencyItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableSolvencyItem.Merge(m, src)
+}
+func (m *StableSolvencyItem) XXX_Size() int {
+	return xxx_messageInfo_StableSolvencyItem.Size(m)
+}
+func (m *StableSolvencyItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableSolvencyItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableSolvencyItem proto.InternalMessageInfo
+
+func (m *StableSolvencyItem) GetCollateral() *StableCollateral {
+	if m != nil {
+		return m.Collateral
+	}
+	return nil
+}
+
+func (m *StableSolvencyItem) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *StableSolvencyItem) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *StableSolvencyItem) GetRatio() int64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+func (m *StableSolvencyItem) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+//supply 是稳定币的总量，等于所有金库的债务之和
+type ReplyStableSolvency struct {
+	Items                []*StableSolvencyItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Supply               int64                 `protobuf:"varint,2,opt,name=supply,proto3" json:"supply,omitempty"`
+	Value                int64                 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Ratio                int64                 `protobuf:"varint,4,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Solvent              bool                  `protobuf:"varint,5,opt,name=solvent,proto3" json:"solvent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReplyStableSolvency) Reset()         { *m = ReplyStableSolvency{} }
+func (m *ReplyStableSolvency) String() string { return proto.CompactTextString(m) }
+func (*ReplyStableSolvency) ProtoMessage()    {}
+func (*ReplyStableSolvency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2050eca2725522fd, []int{19}
+}
+
+func (m *ReplyStableSolvency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyStableSolvency.Unmarshal(m, b)
+}
+func (m *ReplyStableSolvency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyStableSolvency.Marshal(b, m, deterministic)
+}
+func (m *ReplyStableSolvency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyStableSolvency.Merge(m, src)
+}
+func (m *ReplyStableSolvency) XXX_Size() int {
+	return xxx_messageInfo_ReplyStableSolvency.Size(m)
+}
+func (m *ReplyStableSolvency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyStableSolvency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyStableSolvency proto.InternalMessageInfo
+
+func (m *ReplyStableSolvency) GetItems() []*StableSolvencyItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ReplyStableSolvency) GetSupply() int64 {
+	if m != nil {
+		return m.Supply
+	}
+	return 0
+}
+
+func (m *ReplyStableSolvency) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *ReplyStableSolvency) GetRatio() int64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+func (m *ReplyStableSolvency) GetSolvent() bool {
+	if m != nil {
+		return m.Solvent
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*StablecoinAction)(nil), "types.StablecoinAction")
+	proto.RegisterType((*StableCollateralConfig)(nil), "types.StableCollateralConfig")
+	proto.RegisterType((*StableOpen)(nil), "types.StableOpen")
+	proto.RegisterType((*StableDeposit)(nil), "types.StableDeposit")
+	proto.RegisterType((*StableWithdraw)(nil), "types.StableWithdraw")
+	proto.RegisterType((*StableMint)(nil), "types.StableMint")
+	proto.RegisterType((*StableRepay)(nil), "types.StableRepay")
+	proto.RegisterType((*StableLiquidate)(nil), "types.StableLiquidate")
+	proto.RegisterType((*StableTransfer)(nil), "types.StableTransfer")
+	proto.RegisterType((*StableCollateral)(nil), "types.StableCollateral")
+	proto.RegisterType((*StableCollateralList)(nil), "types.StableCollateralList")
+	proto.RegisterType((*StableVault)(nil), "types.StableVault")
+	proto.RegisterType((*ReceiptStableCollateral)(nil), "types.ReceiptStableCollateral")
+	proto.RegisterType((*ReceiptStableVault)(nil), "types.ReceiptStableVault")
+	proto.RegisterType((*ReceiptStableLiquidation)(nil), "types.ReceiptStableLiquidation")
+	proto.RegisterType((*ReqStableCollateral)(nil), "types.ReqStableCollateral")
+	proto.RegisterType((*ReqStableVaults)(nil), "types.ReqStableVaults")
+	proto.RegisterType((*ReplyStableVaults)(nil), "types.ReplyStableVaults")
+	proto.RegisterType((*StableSolvencyItem)(nil), "types.StableSolvencyItem")
+	proto.RegisterType((*ReplyStableSolvency)(nil), "types.ReplyStableSolvency")
+}
+
+func init() { proto.RegisterFile("stablecoin.proto", fileDescriptor_2050eca2725522fd) }
+
+var fileDescriptor_2050eca2725522fd = []byte{
+	// 916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0xe4, 0x44,
+	0x10, 0x1d, 0x8f, 0xc7, 0x33, 0x99, 0x8a, 0xd8, 0x64, 0x9b, 0x90, 0x35, 0x12, 0x44, 0x91, 0x0f,
+	0x10, 0x65, 0x51, 0xf8, 0x58, 0x89, 0xe5, 0x04, 0xca, 0x26, 0x48, 0xb3, 0x22, 0x08, 0xd4, 0x59,
+	0xe0, 0x88, 0x7a, 0x3c, 0x95, 0xa4, 0xc1, 0xe3, 0xf6, 0xda, 0x3d, 0xc9, 0x1a, 0x2e, 0xfc, 0x18,
+	0xb8, 0x73, 0x42, 0xfc, 0x05, 0xfe, 0x10, 0x67, 0xd4, 0xe5, 0x6e, 0x7f, 0x64, 0x27, 0x19, 0x11,
+	0x38, 0xed, 0x65, 0x34, 0x55, 0xfd, 0xda, 0x55, 0xf5, 0xde, 0x6b, 0xb7, 0x61, 0xb3, 0xd0, 0x62,
+	0x9a, 0x60, 0xac, 0x64, 0x7a, 0x90, 0xe5, 0x4a, 0x2b, 0x16, 0xe8, 0x32, 0xc3, 0x22, 0xfa, 0xc3,
+	0x87, 0xcd, 0xd3, 0x7a, 0xed, 0x30, 0xd6, 0x52, 0xa5, 0xec, 0x31, 0x0c, 0x63, 0x95, 0x9e, 0xc9,
+	0xf3, 0xd0, 0xdb, 0xf5, 0xf6, 0xd6, 0x3f, 0x7a, 0xfb, 0x80, 0xc0, 0x07, 0x15, 0xf0, 0x48, 0x25,
+	0x89, 0xd0, 0x98, 0x8b, 0xe4, 0x88, 0x40, 0x93, 0x1e, 0xb7, 0x70, 0xf6, 0x2e, 0x0c, 0x54, 0x86,
+	0x69, 0xd8, 0xa7, 0x6d, 0xf7, 0x3b, 0xdb, 0xbe, 0xca, 0x30, 0x9d, 0xf4, 0x38, 0x01, 0xd8, 0x07,
+	0x30, 0x9a, 0x61, 0xa6, 0x0a, 0xa9, 0x43, 0x9f, 0xb0, 0x5b, 0x1d, 0xec, 0x71, 0xb5, 0x36, 0xe9,
+	0x71, 0x07, 0x63, 0x8f, 0x60, 0xed, 0x4a, 0xea, 0x8b, 0x59, 0x2e, 0xae, 0xc2, 0x01, 0x6d, 0x79,
+	0xa3, 0xb3, 0xe5, 0x3b, 0xbb, 0x38, 0xe9, 0xf1, 0x1a, 0x68, 0xfa, 0x99, 0xcb, 0x54, 0x87, 0xc1,
+	0x92, 0x7e, 0xbe, 0x94, 0xa9, 0x29, 0x40, 0x00, 0xb6, 0x0f, 0x41, 0x8e, 0x99, 0x28, 0xc3, 0x21,
+	0x21, 0x59, 0x07, 0xc9, 0xcd, 0xca, 0xa4, 0xc7, 0x2b, 0x08, 0xfb, 0x18, 0xc6, 0x89, 0x7c, 0xbe,
+	0x90, 0x33, 0xa1, 0x31, 0x1c, 0x11, 0x7e, 0xbb, 0x83, 0x3f, 0x71, 0xab, 0x93, 0x1e, 0x6f, 0xa0,
+	0x66, 0x02, 0x9d, 0x8b, 0xb4, 0x38, 0xc3, 0x3c, 0x5c, 0x5b, 0x32, 0xc1, 0x33, 0xbb, 0x68, 0x26,
+	0x70, 0x40, 0x76, 0x0f, 0xfa, 0xba, 0x0c, 0xc7, 0xbb, 0xde, 0x5e, 0xc0, 0xfb, 0xba, 0x7c, 0x32,
+	0x82, 0xe0, 0x52, 0x24, 0x0b, 0x8c, 0xfe, 0xf6, 0x60, 0x7b, 0xb9, 0x1e, 0xec, 0x2d, 0x18, 0x8b,
+	0xa2, 0x40, 0xfd, 0xf9, 0x0b, 0x8c, 0x49, 0xc1, 0x31, 0x6f, 0x12, 0x6c, 0x17, 0xd6, 0x29, 0x38,
+	0x2d, 0xe7, 0x53, 0x95, 0x90, 0x54, 0x63, 0xde, 0x4e, 0x31, 0x06, 0x83, 0x33, 0xc4, 0x19, 0x29,
+	0x33, 0xe6, 0xf4, 0x9f, 0xed, 0xc3, 0xa6, 0x9b, 0x44, 0xaa, 0x94, 0x9b, 0x5f, 0x92, 0xc1, 0xe7,
+	0x2f, 0xe5, 0x59, 0x08, 0xa3, 0x0c, 0x53, 0x91, 0xe8, 0x92, 0x88, 0xf7, 0xb9, 0x0b, 0x4d, 0xed,
+	0x19, 0x4e, 0xf5, 0x11, 0xca, 0x44, 0xa6, 0xe7, 0x44, 0xb6, 0xcf, 0xdb, 0x29, 0x83, 0x98, 0x8b,
+	0x17, 0x5f, 0xe7, 0x32, 0xc6, 0xc3, 0xf3, 0x8a, 0x5e, 0x9f, 0xb7, 0x53, 0xd1, 0x2f, 0x1e, 0x40,
+	0xe3, 0xa8, 0xff, 0x3c, 0xec, 0x0e, 0x40, 0x5c, 0x13, 0x48, 0x23, 0xfb, 0xbc, 0x95, 0x31, 0x64,
+	0x98, 0xfe, 0xec, 0xb0, 0xf4, 0x3f, 0x3a, 0x84, 0xd7, 0x3a, 0x3e, 0x35, 0x13, 0x5f, 0x8a, 0x45,
+	0xa2, 0x9f, 0x1e, 0xdb, 0x16, 0x5c, 0xc8, 0xb6, 0x61, 0x28, 0xe6, 0x6a, 0x91, 0x6a, 0xaa, 0xed,
+	0x73, 0x1b, 0x45, 0x4f, 0xe0, 0x5e, 0xd7, 0xb7, 0x77, 0x78, 0xc6, 0xa7, 0x8e, 0x08, 0x63, 0xe5,
+	0x3b, 0xec, 0xff, 0x0c, 0xd6, 0x5b, 0x06, 0xbf, 0xc3, 0x03, 0x1e, 0xc2, 0xc6, 0x35, 0xc7, 0xdf,
+	0xfc, 0x90, 0xe8, 0x13, 0x37, 0xf1, 0xb3, 0xb6, 0xb7, 0x95, 0x85, 0xf5, 0xb5, 0xba, 0xb1, 0xcc,
+	0x9f, 0x7d, 0xf7, 0x8e, 0x6a, 0xac, 0xfe, 0x6a, 0x9b, 0xdc, 0x4c, 0xa7, 0x95, 0x16, 0xc9, 0xb1,
+	0xb1, 0xde, 0x1a, 0xad, 0x37, 0x09, 0xb6, 0x07, 0x1b, 0x14, 0x34, 0x74, 0xd0, 0x1b, 0xc2, 0xe7,
+	0xd7, 0xd3, 0xd1, 0x3e, 0x6c, 0x5d, 0x67, 0xee, 0x44, 0x16, 0xda, 0x4c, 0xff, 0x23, 0x96, 0x45,
+	0xe8, 0xed, 0xfa, 0x66, 0x7a, 0xf3, 0x3f, 0xfa, 0xcb, 0x73, 0x7e, 0xf8, 0xd6, 0x48, 0x76, 0x8b,
+	0x1f, 0xb6, 0x20, 0x50, 0x57, 0x29, 0xe6, 0x96, 0xd7, 0x2a, 0xe8, 0x2a, 0xe2, 0xaf, 0x50, 0x64,
+	0xb0, 0xea, 0x24, 0x06, 0x37, 0x9e, 0xc4, 0x61, 0x73, 0x12, 0x8d, 0x65, 0x2e, 0x50, 0x9e, 0x5f,
+	0x68, 0x4b, 0xa2, 0x8d, 0xa2, 0x12, 0x1e, 0x70, 0x8c, 0x51, 0x66, 0xfa, 0x25, 0xe3, 0x3c, 0x84,
+	0x41, 0x96, 0xe3, 0xa5, 0xbd, 0xda, 0x1e, 0xdc, 0x70, 0xb5, 0x71, 0x02, 0xb1, 0x0f, 0x61, 0x14,
+	0x2f, 0xf2, 0x1c, 0xad, 0x27, 0x6f, 0xc1, 0x3b, 0x5c, 0xf4, 0x03, 0xb0, 0x4e, 0xe9, 0x8a, 0xcc,
+	0x77, 0x3a, 0x55, 0xbb, 0xf7, 0x0b, 0x21, 0x6c, 0xc1, 0xf7, 0xae, 0x17, 0x5c, 0x06, 0xad, 0x6b,
+	0xfd, 0xee, 0x41, 0xd8, 0x29, 0x76, 0xd2, 0xd8, 0xf4, 0x16, 0xfd, 0x76, 0x00, 0x9c, 0x9f, 0x95,
+	0x13, 0xb1, 0x95, 0xa9, 0x99, 0xf6, 0xbb, 0x4c, 0x17, 0x28, 0x7f, 0xc2, 0x99, 0x3d, 0x11, 0x36,
+	0x32, 0x5e, 0xc8, 0x8c, 0x6b, 0xad, 0x60, 0x55, 0x60, 0x6a, 0x4f, 0xc5, 0xec, 0xb8, 0x91, 0xcb,
+	0x85, 0xd1, 0x37, 0xf0, 0x3a, 0xc7, 0xe7, 0xff, 0xf7, 0x71, 0x8e, 0x7e, 0x86, 0x8d, 0xfa, 0xb1,
+	0x44, 0x52, 0xd1, 0xb8, 0xd4, 0x6b, 0xbb, 0x74, 0x07, 0x20, 0xcb, 0xe5, 0x5c, 0xe4, 0xe5, 0x17,
+	0x58, 0xba, 0xd9, 0x9b, 0x8c, 0xd9, 0x15, 0xd3, 0x3b, 0xc8, 0xa7, 0x3b, 0xb7, 0x0a, 0x4c, 0x7b,
+	0x33, 0x99, 0x23, 0x7d, 0x1e, 0x11, 0x01, 0x01, 0x6f, 0x12, 0xd1, 0xf7, 0x70, 0x9f, 0x63, 0x96,
+	0x94, 0x9d, 0xf2, 0xfb, 0x30, 0x24, 0xbe, 0xab, 0x43, 0xb6, 0x5c, 0x48, 0x8b, 0x58, 0xd5, 0x54,
+	0xf4, 0x9b, 0x07, 0xac, 0xda, 0x77, 0xaa, 0x92, 0x4b, 0x4c, 0xe3, 0xf2, 0xa9, 0xc6, 0x39, 0x7b,
+	0xdc, 0x39, 0x31, 0x2b, 0x0c, 0xdd, 0x3e, 0x4a, 0xb5, 0x68, 0xfd, 0xb6, 0x68, 0x5b, 0xf6, 0xdb,
+	0xc2, 0xea, 0x5e, 0x05, 0x26, 0x9b, 0xb7, 0xde, 0x84, 0x55, 0x60, 0xb2, 0x85, 0x16, 0x49, 0x25,
+	0xfb, 0x1a, 0xaf, 0x82, 0xe8, 0x57, 0xcf, 0xa8, 0x5b, 0x33, 0xe1, 0x9a, 0x65, 0xef, 0x43, 0x20,
+	0x35, 0xce, 0x1d, 0x15, 0x6f, 0x76, 0x7a, 0x6c, 0x8f, 0xc4, 0x2b, 0x1c, 0xb9, 0x6d, 0x91, 0x65,
+	0x49, 0xe9, 0xae, 0x82, 0x2a, 0xfa, 0x57, 0x2d, 0x86, 0x30, 0x2a, 0xe8, 0xd1, 0xda, 0x36, 0xe9,
+	0xc2, 0xe9, 0x90, 0x3e, 0x81, 0x1f, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xe0, 0x99, 0x1f,
+	0x16, 0x0b, 0x00, 0x00,
+}
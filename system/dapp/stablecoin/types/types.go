@@ -0,0 +1,136 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types stablecoin插件相关的定义
+package types
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// StablecoinX 执行器名称
+	StablecoinX = "stablecoin"
+	actionName  = map[string]int32{
+		"Config":    StableActionConfig,
+		"Open":      StableActionOpen,
+		"Deposit":   StableActionDeposit,
+		"Withdraw":  StableActionWithdraw,
+		"Mint":      StableActionMint,
+		"Repay":     StableActionRepay,
+		"Liquidate": StableActionLiquidate,
+		"Transfer":  StableActionTransfer,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogStableCollateral:  {Ty: reflect.TypeOf(ReceiptStableCollateral{}), Name: "LogStableCollateral"},
+		TyLogStableVault:       {Ty: reflect.TypeOf(ReceiptStableVault{}), Name: "LogStableVault"},
+		TyLogStableLiquidation: {Ty: reflect.TypeOf(ReceiptStableLiquidation{}), Name: "LogStableLiquidation"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(StablecoinX))
+	types.RegistorExecutor(StablecoinX, NewType())
+	types.RegisterDappFork(StablecoinX, "Enable", 0)
+}
+
+// StablecoinType stablecoin执行器类型
+type StablecoinType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a stablecoin type object
+func NewType() *StablecoinType {
+	c := &StablecoinType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return stablecoin action
+func (s *StablecoinType) GetPayload() types.Message {
+	return &StablecoinAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (s *StablecoinType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (s *StablecoinType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (s *StablecoinType) GetName() string {
+	return StablecoinX
+}
+
+// NormalizeAsset coins的symbol为空的时候使用默认的symbol，返回空的执行器表示资产不合法
+func NormalizeAsset(exec, symbol string) (string, string) {
+	if exec == "" || exec == StablecoinX {
+		return "", ""
+	}
+	if exec == "coins" {
+		if symbol != "" && symbol != types.GetCoinSymbol() {
+			return "", ""
+		}
+		return exec, types.GetCoinSymbol()
+	}
+	if symbol == "" {
+		return "", ""
+	}
+	return exec, symbol
+}
+
+// CollateralKey 抵押资产的名字，资产需要先经过NormalizeAsset
+func CollateralKey(exec, symbol string) string {
+	return exec + ":" + symbol
+}
+
+// CheckCollateralConfig 检查抵押资产的配置，最低抵押率不能低于100%
+func CheckCollateralConfig(config *StableCollateralConfig) error {
+	if exec, _ := NormalizeAsset(config.AssetExec, config.AssetSymbol); exec == "" {
+		return ErrCollateralConfig
+	}
+	if config.Feed == "" || config.LiquidationRatio < RatioBase || config.Penalty < 0 || config.Penalty > MaxPenalty {
+		return ErrCollateralConfig
+	}
+	if config.DebtCeiling <= 0 || config.MaxPriceAge <= 0 {
+		return ErrCollateralConfig
+	}
+	return nil
+}
+
+// CalcValue 按价格计算抵押资产值多少稳定币，向下取整，溢出的时候返回最大值
+func CalcValue(amount, price int64) int64 {
+	v := new(big.Int).Mul(big.NewInt(amount), big.NewInt(price))
+	v.Div(v, big.NewInt(PriceBase))
+	if !v.IsInt64() {
+		return math.MaxInt64
+	}
+	return v.Int64()
+}
+
+// CalcRatio 千分比的抵押率，没有债务的时候返回0
+func CalcRatio(value, debt int64) int64 {
+	if debt == 0 {
+		return 0
+	}
+	v := new(big.Int).Mul(big.NewInt(value), big.NewInt(RatioBase))
+	return v.Div(v, big.NewInt(debt)).Int64()
+}
+
+// IsSafe 抵押资产的价值不低于债务乘以千分比的抵押率
+func IsSafe(collateral, price, debt, ratio int64) bool {
+	value := new(big.Int).Mul(big.NewInt(collateral), big.NewInt(price))
+	value.Mul(value, big.NewInt(RatioBase))
+	required := new(big.Int).Mul(big.NewInt(debt), big.NewInt(ratio))
+	required.Mul(required, big.NewInt(PriceBase))
+	return value.Cmp(required) >= 0
+}
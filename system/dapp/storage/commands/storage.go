@@ -0,0 +1,212 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands storage插件命令
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	sty "github.com/33cn/chain33/system/dapp/storage/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// StorageCmd storage command
+func StorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage",
+		Short: "Data anchoring management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		AnchorCmd(),
+		UpdateCmd(),
+		TransferCmd(),
+		QueryRecordCmd(),
+		QueryHashCmd(),
+		ListRecordsCmd(),
+	)
+
+	return cmd
+}
+
+//createStorageTx 附带数据的交易在手续费中加上数据的手续费
+func createStorageTx(cmd *cobra.Command, action *sty.StorageAction) {
+	tx, err := commandtypes.FormatActionTx(cmd, sty.StorageX, action)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	tx.Fee += sty.DataFee(sty.DataSize(action))
+	jsonclient.PrintString(hex.EncodeToString(types.Encode(tx)))
+}
+
+func addContentFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "content hash in hex")
+	cmd.MarkFlagRequired("hash")
+	cmd.Flags().StringP("data", "d", "", "attached data")
+	cmd.Flags().StringP("memo", "m", "", "memo")
+}
+
+func getContent(cmd *cobra.Command) ([]byte, []byte, string, error) {
+	hash, _ := cmd.Flags().GetString("hash")
+	data, _ := cmd.Flags().GetString("data")
+	memo, _ := cmd.Flags().GetString("memo")
+	contentHash, err := common.FromHex(hash)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return contentHash, []byte(data), memo, nil
+}
+
+// AnchorCmd anchor content hash
+func AnchorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchor",
+		Short: "Create a transaction to anchor content hash",
+		Run:   anchor,
+	}
+	addContentFlags(cmd)
+	return cmd
+}
+
+func anchor(cmd *cobra.Command, args []string) {
+	hash, data, memo, err := getContent(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	createStorageTx(cmd, &sty.StorageAction{
+		Ty:    sty.StorageActionAnchor,
+		Value: &sty.StorageAction_Anchor{Anchor: &sty.StorageAnchor{ContentHash: hash, Data: data, Memo: memo}},
+	})
+}
+
+// UpdateCmd update record
+func UpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Create a transaction to update content of record",
+		Run:   update,
+	}
+	cmd.Flags().StringP("id", "i", "", "record id")
+	cmd.MarkFlagRequired("id")
+	addContentFlags(cmd)
+	return cmd
+}
+
+func update(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	hash, data, memo, err := getContent(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	createStorageTx(cmd, &sty.StorageAction{
+		Ty:    sty.StorageActionUpdate,
+		Value: &sty.StorageAction_Update{Update: &sty.StorageUpdate{Id: id, ContentHash: hash, Data: data, Memo: memo}},
+	})
+}
+
+// TransferCmd transfer record
+func TransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "Create a transaction to transfer record to another owner",
+		Run:   transfer,
+	}
+	cmd.Flags().StringP("id", "i", "", "record id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("to", "t", "", "new owner address")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func transfer(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	to, _ := cmd.Flags().GetString("to")
+	createStorageTx(cmd, &sty.StorageAction{
+		Ty:    sty.StorageActionTransfer,
+		Value: &sty.StorageAction_Transfer{Transfer: &sty.StorageTransfer{Id: id, To: to}},
+	})
+}
+
+func queryStorage(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, sty.StorageX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryRecordCmd query record
+func QueryRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record",
+		Short: "Query record by id",
+		Run:   queryRecord,
+	}
+	cmd.Flags().StringP("id", "i", "", "record id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryRecord(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res sty.StorageRecord
+	queryStorage(cmd, sty.FuncNameGetRecord, &types.ReqString{Data: id}, &res)
+}
+
+// QueryHashCmd query content hash
+func QueryHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hash",
+		Short: "Query first anchor and current record of content hash",
+		Run:   queryHash,
+	}
+	cmd.Flags().StringP("hash", "s", "", "content hash in hex")
+	cmd.MarkFlagRequired("hash")
+	return cmd
+}
+
+func queryHash(cmd *cobra.Command, args []string) {
+	hash, _ := cmd.Flags().GetString("hash")
+	var res sty.ReplyStorageHash
+	queryStorage(cmd, sty.FuncNameGetByHash, &types.ReqString{Data: hash}, &res)
+}
+
+// ListRecordsCmd list records of owner
+func ListRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List records of owner",
+		Run:   listRecords,
+	}
+	cmd.Flags().StringP("owner", "o", "", "owner address")
+	cmd.MarkFlagRequired("owner")
+	cmd.Flags().StringP("primary", "p", "", "list after this record id")
+	cmd.Flags().Int32P("count", "c", sty.DefaultListCount, "max count")
+	return cmd
+}
+
+func listRecords(cmd *cobra.Command, args []string) {
+	owner, _ := cmd.Flags().GetString("owner")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res sty.ReplyStorageRecords
+	queryStorage(cmd, sty.FuncNameListByOwner, &sty.ReqStorageRecords{Owner: owner, PrimaryKey: primary, Count: count}, &res)
+}
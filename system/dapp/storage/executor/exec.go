@@ -0,0 +1,28 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	sty "github.com/33cn/chain33/system/dapp/storage/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Anchor 存证
+func (s *Storage) Exec_Anchor(payload *sty.StorageAnchor, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.anchor(payload)
+}
+
+// Exec_Update 更新存证的内容
+func (s *Storage) Exec_Update(payload *sty.StorageUpdate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.update(payload)
+}
+
+// Exec_Transfer 转让存证
+func (s *Storage) Exec_Transfer(payload *sty.StorageTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(s, tx, index)
+	return action.transfer(payload)
+}
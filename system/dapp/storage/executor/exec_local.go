@@ -0,0 +1,42 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	sty "github.com/33cn/chain33/system/dapp/storage/types"
+	"github.com/33cn/chain33/types"
+)
+
+// ExecLocal_Anchor 添加owner的索引
+func (s *Storage) ExecLocal_Anchor(payload *sty.StorageAnchor, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+// ExecLocal_Transfer 修改owner的索引
+func (s *Storage) ExecLocal_Transfer(payload *sty.StorageTransfer, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return s.execLocal(tx, receipt)
+}
+
+//execLocal owner变化的时候修改索引，回滚的时候恢复原来的索引
+func (s *Storage) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != sty.TyLogStorageAnchor && item.Ty != sty.TyLogStorageTransfer {
+			continue
+		}
+		var log sty.ReceiptStorageRecord
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		if log.Prev != nil {
+			kvs = append(kvs, &types.KeyValue{Key: calcOwnerIndexKey(log.Prev.Owner, log.Prev.Id)})
+		}
+		kvs = append(kvs, &types.KeyValue{Key: calcOwnerIndexKey(log.Current.Owner, log.Current.Id), Value: []byte(log.Current.Id)})
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
@@ -0,0 +1,38 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/common"
+	sty "github.com/33cn/chain33/system/dapp/storage/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetRecord 获取存证
+func (s *Storage) Query_GetRecord(in *types.ReqString) (types.Message, error) {
+	return getRecord(s.GetStateDB(), in.Data)
+}
+
+// Query_GetByHash 按hash获取第一次存证的记录和存证当前的内容
+func (s *Storage) Query_GetByHash(in *types.ReqString) (types.Message, error) {
+	hash, err := common.FromHex(in.Data)
+	if err != nil {
+		return nil, err
+	}
+	anchor, err := getHashAnchor(s.GetStateDB(), hash)
+	if err != nil {
+		return nil, err
+	}
+	record, err := getRecord(s.GetStateDB(), anchor.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &sty.ReplyStorageHash{Anchor: anchor, Record: record}, nil
+}
+
+// Query_ListByOwner 列出owner的存证
+func (s *Storage) Query_ListByOwner(in *sty.ReqStorageRecords) (types.Message, error) {
+	return listByOwner(s.GetLocalDB(), s.GetStateDB(), in)
+}
@@ -0,0 +1,78 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor storage执行器，负责数据的存证，更新和转让
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	sty "github.com/33cn/chain33/system/dapp/storage/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.storage")
+	driverName = sty.StorageX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Storage{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newStorage, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return storage name
+func GetName() string {
+	return newStorage().GetName()
+}
+
+// Storage defines Storage object
+type Storage struct {
+	drivers.DriverBase
+}
+
+func newStorage() drivers.Driver {
+	s := &Storage{}
+	s.SetChild(s)
+	s.SetExecutorType(types.LoadExecutorType(driverName))
+	return s
+}
+
+// GetDriverName return a drivername
+func (s *Storage) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx 附带数据的交易的手续费不能少于交易本身的手续费加上数据的手续费
+func (s *Storage) CheckTx(tx *types.Transaction, index int) error {
+	var action sty.StorageAction
+	if err := types.Decode(tx.Payload, &action); err != nil {
+		return err
+	}
+	size := sty.DataSize(&action)
+	if size == 0 {
+		return nil
+	}
+	if size > sty.MaxDataSize {
+		return sty.ErrDataTooLong
+	}
+	realFee, err := tx.GetRealFee(types.GInt("MinFee"))
+	if err != nil {
+		return err
+	}
+	if tx.Fee < realFee+sty.DataFee(size) {
+		return types.ErrTxFeeTooLow
+	}
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (s *Storage) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,109 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	sty "github.com/33cn/chain33/system/dapp/storage/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func createStorageTx(t *testing.T, priv crypto.PrivKey, action string, param types.Message, fee int64) *types.Transaction {
+	txbytes, err := types.CallCreateTx(sty.StorageX, action, param)
+	assert.Nil(t, err)
+	var tx types.Transaction
+	assert.Nil(t, types.Decode(txbytes, &tx))
+	tx.Fee = fee
+	tx.Sign(types.SECP256K1, priv)
+	return &tx
+}
+
+func sendStorageTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message, size int) (int32, string) {
+	tx := createStorageTx(t, priv, action, param, 1e6+sty.DataFee(size))
+	hash := mock33.SendTx(tx)
+	detail, err := mock33.WaitTx(hash)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, common.ToHex(hash)
+}
+
+func queryStorage(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(sty.StorageX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func TestStorage(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	genesisAddr := mock33.GetGenesisAddress()
+	addr, priv := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(genesis, addr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	//附带数据的交易手续费不够
+	data := []byte("hello storage")
+	tx := createStorageTx(t, genesis, "Anchor", &sty.StorageAnchor{ContentHash: []byte("h1"), Data: data}, 1e6)
+	_, err := mock33.GetAPI().SendTx(tx)
+	assert.Equal(t, types.ErrTxFeeTooLow, err)
+
+	ty, id := sendStorageTx(t, mock33, genesis, "Anchor", &sty.StorageAnchor{ContentHash: []byte("h1"), Data: data, Memo: "first"}, len(data))
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendStorageTx(t, mock33, priv, "Anchor", &sty.StorageAnchor{ContentHash: []byte("h1")}, 0)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendStorageTx(t, mock33, priv, "Anchor", &sty.StorageAnchor{}, 0)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, id2 := sendStorageTx(t, mock33, priv, "Anchor", &sty.StorageAnchor{ContentHash: []byte("h2")}, 0)
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	record := queryStorage(t, mock33, sty.FuncNameGetRecord, &types.ReqString{Data: id}).(*sty.StorageRecord)
+	assert.Equal(t, genesisAddr, record.Owner)
+	assert.Equal(t, data, record.Data)
+	assert.Equal(t, int64(1), record.Version)
+
+	//只有owner可以更新，更新的hash也不能重复
+	ty, _ = sendStorageTx(t, mock33, priv, "Update", &sty.StorageUpdate{Id: id, ContentHash: []byte("h3")}, 0)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendStorageTx(t, mock33, genesis, "Update", &sty.StorageUpdate{Id: id, ContentHash: []byte("h2")}, 0)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendStorageTx(t, mock33, genesis, "Update", &sty.StorageUpdate{Id: id, ContentHash: []byte("h3"), Memo: "second"}, 0)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	record = queryStorage(t, mock33, sty.FuncNameGetRecord, &types.ReqString{Data: id}).(*sty.StorageRecord)
+	assert.Equal(t, int64(2), record.Version)
+	assert.Equal(t, "second", record.Memo)
+	assert.Nil(t, record.Data)
+
+	//老的hash仍然可以查到第一次存证的版本
+	reply := queryStorage(t, mock33, sty.FuncNameGetByHash, &types.ReqString{Data: common.ToHex([]byte("h1"))}).(*sty.ReplyStorageHash)
+	assert.Equal(t, int64(1), reply.Anchor.Version)
+	assert.Equal(t, genesisAddr, reply.Anchor.Owner)
+	assert.Equal(t, int64(2), reply.Record.Version)
+
+	//转让以后owner的索引跟着变化
+	ty, _ = sendStorageTx(t, mock33, priv, "Transfer", &sty.StorageTransfer{Id: id, To: addr}, 0)
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendStorageTx(t, mock33, genesis, "Transfer", &sty.StorageTransfer{Id: id, To: addr}, 0)
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	list := queryStorage(t, mock33, sty.FuncNameListByOwner, &sty.ReqStorageRecords{Owner: addr}).(*sty.ReplyStorageRecords)
+	assert.Equal(t, 2, len(list.Records))
+	list = queryStorage(t, mock33, sty.FuncNameListByOwner, &sty.ReqStorageRecords{Owner: addr, Count: 1}).(*sty.ReplyStorageRecords)
+	assert.Equal(t, 1, len(list.Records))
+	list = queryStorage(t, mock33, sty.FuncNameListByOwner, &sty.ReqStorageRecords{Owner: addr, PrimaryKey: list.PrimaryKey}).(*sty.ReplyStorageRecords)
+	assert.Equal(t, 1, len(list.Records))
+	ids := []string{id, id2}
+	assert.Contains(t, ids, list.Records[0].Id)
+	list = queryStorage(t, mock33, sty.FuncNameListByOwner, &sty.ReqStorageRecords{Owner: genesisAddr}).(*sty.ReplyStorageRecords)
+	assert.Equal(t, 0, len(list.Records))
+}
@@ -0,0 +1,198 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	sty "github.com/33cn/chain33/system/dapp/storage/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	recordKeyPrefix  = "mavl-" + sty.StorageX + "-record-"
+	hashKeyPrefix    = "mavl-" + sty.StorageX + "-hash-"
+	ownerIndexPrefix = "LODB-" + sty.StorageX + "-owner-"
+)
+
+func calcRecordKey(id string) []byte {
+	return []byte(recordKeyPrefix + id)
+}
+
+func calcHashKey(hash []byte) []byte {
+	return []byte(hashKeyPrefix + common.ToHex(hash))
+}
+
+func calcOwnerIndexKey(owner, id string) []byte {
+	return []byte(ownerIndexPrefix + owner + "-" + id)
+}
+
+// Action storage交易的执行环境
+type Action struct {
+	db       dbm.KV
+	txhash   []byte
+	fromaddr string
+	height   int64
+	index    int
+}
+
+// NewAction new a action object
+func NewAction(s *Storage, tx *types.Transaction, index int) *Action {
+	return &Action{
+		db:       s.GetStateDB(),
+		txhash:   tx.Hash(),
+		fromaddr: tx.From(),
+		height:   s.GetHeight(),
+		index:    index,
+	}
+}
+
+func getRecord(db dbm.KV, id string) (*sty.StorageRecord, error) {
+	value, err := db.Get(calcRecordKey(id))
+	if err != nil || value == nil {
+		return nil, sty.ErrRecordNotExist
+	}
+	var record sty.StorageRecord
+	err = types.Decode(value, &record)
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func getHashAnchor(db dbm.KV, hash []byte) (*sty.StorageHashAnchor, error) {
+	value, err := db.Get(calcHashKey(hash))
+	if err != nil || value == nil {
+		return nil, sty.ErrRecordNotExist
+	}
+	var anchor sty.StorageHashAnchor
+	err = types.Decode(value, &anchor)
+	if err != nil {
+		return nil, err
+	}
+	return &anchor, nil
+}
+
+func listByOwner(localdb dbm.KVDB, statedb dbm.KV, req *sty.ReqStorageRecords) (*sty.ReplyStorageRecords, error) {
+	if req.Owner == "" {
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count <= 0 {
+		count = sty.DefaultListCount
+	}
+	if count > sty.MaxListCount {
+		count = sty.MaxListCount
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcOwnerIndexKey(req.Owner, req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(ownerIndexPrefix+req.Owner+"-"), key, count, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &sty.ReplyStorageRecords{}
+	for _, value := range values {
+		record, err := getRecord(statedb, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.Records = append(reply.Records, record)
+		reply.PrimaryKey = record.Id
+	}
+	return reply, nil
+}
+
+//checkContent 同一个hash只能存证一次
+func (a *Action) checkContent(hash, data []byte, memo string) error {
+	if len(hash) == 0 || len(hash) > sty.MaxContentHashLength {
+		return sty.ErrContentHash
+	}
+	if len(data) > sty.MaxDataSize || len(memo) > sty.MaxMemoLength {
+		return sty.ErrDataTooLong
+	}
+	if _, err := getHashAnchor(a.db, hash); err == nil {
+		return sty.ErrHashExist
+	}
+	return nil
+}
+
+func (a *Action) saveRecord(record *sty.StorageRecord) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcRecordKey(record.Id), Value: types.Encode(record)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (a *Action) saveHashAnchor(record *sty.StorageRecord) *types.KeyValue {
+	anchor := &sty.StorageHashAnchor{Id: record.Id, Version: record.Version, Owner: record.Owner, Height: a.height}
+	kv := &types.KeyValue{Key: calcHashKey(record.ContentHash), Value: types.Encode(anchor)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func recordReceipt(ty int32, prev, current *sty.StorageRecord) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&sty.ReceiptStorageRecord{Prev: prev, Current: current})}
+}
+
+func (a *Action) anchor(payload *sty.StorageAnchor) (*types.Receipt, error) {
+	if err := a.checkContent(payload.ContentHash, payload.Data, payload.Memo); err != nil {
+		return nil, err
+	}
+	record := &sty.StorageRecord{
+		Id:           common.ToHex(a.txhash),
+		Owner:        a.fromaddr,
+		ContentHash:  payload.ContentHash,
+		Data:         payload.Data,
+		Memo:         payload.Memo,
+		Version:      1,
+		CreateHeight: a.height,
+		UpdateHeight: a.height,
+	}
+	kv := []*types.KeyValue{a.saveRecord(record), a.saveHashAnchor(record)}
+	logs := []*types.ReceiptLog{recordReceipt(sty.TyLogStorageAnchor, nil, record)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) update(payload *sty.StorageUpdate) (*types.Receipt, error) {
+	prev, err := getRecord(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Owner != a.fromaddr {
+		return nil, sty.ErrNotOwner
+	}
+	if err := a.checkContent(payload.ContentHash, payload.Data, payload.Memo); err != nil {
+		return nil, err
+	}
+	record := *prev
+	record.ContentHash = payload.ContentHash
+	record.Data = payload.Data
+	record.Memo = payload.Memo
+	record.Version++
+	record.UpdateHeight = a.height
+	kv := []*types.KeyValue{a.saveRecord(&record), a.saveHashAnchor(&record)}
+	logs := []*types.ReceiptLog{recordReceipt(sty.TyLogStorageUpdate, prev, &record)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) transfer(payload *sty.StorageTransfer) (*types.Receipt, error) {
+	prev, err := getRecord(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Owner != a.fromaddr {
+		return nil, sty.ErrNotOwner
+	}
+	if err := address.CheckAddress(payload.To); err != nil {
+		return nil, err
+	}
+	record := *prev
+	record.Owner = payload.To
+	kv := []*types.KeyValue{a.saveRecord(&record)}
+	logs := []*types.ReceiptLog{recordReceipt(sty.TyLogStorageTransfer, prev, &record)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package storage 数据存证执行器插件
+// 1. 存证数据的hash，可以附带小块的数据，附带的数据按大小收取额外的手续费
+// 2. owner可以更新存证的内容或者转让存证，每个hash第一次存证的记录都可以查询到
+// 3. 本地数据库按owner索引存证，支持列出owner的所有存证
+package storage
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/storage/commands"
+	"github.com/33cn/chain33/system/dapp/storage/executor"
+	"github.com/33cn/chain33/system/dapp/storage/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.StorageX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.StorageCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,33 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// storage action ty
+const (
+	StorageActionAnchor = iota + 1
+	StorageActionUpdate
+	StorageActionTransfer
+)
+
+// storage log ty
+const (
+	TyLogStorageAnchor   = 500
+	TyLogStorageUpdate   = 501
+	TyLogStorageTransfer = 502
+)
+
+// query func name
+const (
+	FuncNameGetRecord    = "GetRecord"
+	FuncNameGetByHash    = "GetByHash"
+	FuncNameListByOwner  = "ListByOwner"
+	MaxContentHashLength = 64
+	MaxDataSize          = 4096
+	MaxMemoLength        = 256
+	DefaultListCount     = 20
+	MaxListCount         = 100
+	//DataFeeRate 附带的数据每1000字节收取MinFee的倍数
+	DataFeeRate = 10
+)
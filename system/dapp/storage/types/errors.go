@@ -0,0 +1,20 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrContentHash hash的长度不合法
+	ErrContentHash = errors.New("ErrContentHash")
+	// ErrHashExist hash已经存证
+	ErrHashExist = errors.New("ErrHashExist")
+	// ErrRecordNotExist 存证不存在
+	ErrRecordNotExist = errors.New("ErrRecordNotExist")
+	// ErrDataTooLong 数据或者备注太长
+	ErrDataTooLong = errors.New("ErrDataTooLong")
+	// ErrNotOwner 不是存证的owner
+	ErrNotOwner = errors.New("ErrNotOwner")
+)
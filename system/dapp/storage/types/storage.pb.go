@@ -0,0 +1,785 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: storage.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type StorageAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*StorageAction_Anchor
+	//	*StorageAction_Update
+	//	*StorageAction_Transfer
+	Value                isStorageAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                 `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StorageAction) Reset()         { *m = StorageAction{} }
+func (m *StorageAction) String() string { return proto.CompactTextString(m) }
+func (*StorageAction) ProtoMessage()    {}
+func (*StorageAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{0}
+}
+
+func (m *StorageAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageAction.Unmarshal(m, b)
+}
+func (m *StorageAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageAction.Marshal(b, m, deterministic)
+}
+func (m *StorageAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageAction.Merge(m, src)
+}
+func (m *StorageAction) XXX_Size() int {
+	return xxx_messageInfo_StorageAction.Size(m)
+}
+func (m *StorageAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageAction proto.InternalMessageInfo
+
+type isStorageAction_Value interface {
+	isStorageAction_Value()
+}
+
+type StorageAction_Anchor struct {
+	Anchor *StorageAnchor `protobuf:"bytes,1,opt,name=anchor,proto3,oneof"`
+}
+
+type StorageAction_Update struct {
+	Update *StorageUpdate `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+type StorageAction_Transfer struct {
+	Transfer *StorageTransfer `protobuf:"bytes,3,opt,name=transfer,proto3,oneof"`
+}
+
+func (*StorageAction_Anchor) isStorageAction_Value() {}
+
+func (*StorageAction_Update) isStorageAction_Value() {}
+
+func (*StorageAction_Transfer) isStorageAction_Value() {}
+
+func (m *StorageAction) GetValue() isStorageAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StorageAction) GetAnchor() *StorageAnchor {
+	if x, ok := m.GetValue().(*StorageAction_Anchor); ok {
+		return x.Anchor
+	}
+	return nil
+}
+
+func (m *StorageAction) GetUpdate() *StorageUpdate {
+	if x, ok := m.GetValue().(*StorageAction_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+func (m *StorageAction) GetTransfer() *StorageTransfer {
+	if x, ok := m.GetValue().(*StorageAction_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *StorageAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*StorageAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _StorageAction_OneofMarshaler, _StorageAction_OneofUnmarshaler, _StorageAction_OneofSizer, []interface{}{
+		(*StorageAction_Anchor)(nil),
+		(*StorageAction_Update)(nil),
+		(*StorageAction_Transfer)(nil),
+	}
+}
+
+func _StorageAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*StorageAction)
+	// value
+	switch x := m.Value.(type) {
+	case *StorageAction_Anchor:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Anchor); err != nil {
+			return err
+		}
+	case *StorageAction_Update:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Update); err != nil {
+			return err
+		}
+	case *StorageAction_Transfer:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Transfer); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("StorageAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _StorageAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*StorageAction)
+	switch tag {
+	case 1: // value.anchor
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StorageAnchor)
+		err := b.DecodeMessage(msg)
+		m.Value = &StorageAction_Anchor{msg}
+		return true, err
+	case 2: // value.update
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StorageUpdate)
+		err := b.DecodeMessage(msg)
+		m.Value = &StorageAction_Update{msg}
+		return true, err
+	case 3: // value.transfer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(StorageTransfer)
+		err := b.DecodeMessage(msg)
+		m.Value = &StorageAction_Transfer{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _StorageAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*StorageAction)
+	// value
+	switch x := m.Value.(type) {
+	case *StorageAction_Anchor:
+		s := proto.Size(x.Anchor)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StorageAction_Update:
+		s := proto.Size(x.Update)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StorageAction_Transfer:
+		s := proto.Size(x.Transfer)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//存证数据的hash，data是可选的小块数据，需要加密的数据由客户端加密
+//附带data的交易按data的大小收取额外的手续费
+type StorageAnchor struct {
+	ContentHash          []byte   `protobuf:"bytes,1,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Memo                 string   `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageAnchor) Reset()         { *m = StorageAnchor{} }
+func (m *StorageAnchor) String() string { return proto.CompactTextString(m) }
+func (*StorageAnchor) ProtoMessage()    {}
+func (*StorageAnchor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{1}
+}
+
+func (m *StorageAnchor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageAnchor.Unmarshal(m, b)
+}
+func (m *StorageAnchor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageAnchor.Marshal(b, m, deterministic)
+}
+func (m *StorageAnchor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageAnchor.Merge(m, src)
+}
+func (m *StorageAnchor) XXX_Size() int {
+	return xxx_messageInfo_StorageAnchor.Size(m)
+}
+func (m *StorageAnchor) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageAnchor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageAnchor proto.InternalMessageInfo
+
+func (m *StorageAnchor) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *StorageAnchor) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *StorageAnchor) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+//owner更新存证的内容，原来的hash仍然可以查询到
+type StorageUpdate struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContentHash          []byte   `protobuf:"bytes,2,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Memo                 string   `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageUpdate) Reset()         { *m = StorageUpdate{} }
+func (m *StorageUpdate) String() string { return proto.CompactTextString(m) }
+func (*StorageUpdate) ProtoMessage()    {}
+func (*StorageUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{2}
+}
+
+func (m *StorageUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageUpdate.Unmarshal(m, b)
+}
+func (m *StorageUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageUpdate.Marshal(b, m, deterministic)
+}
+func (m *StorageUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUpdate.Merge(m, src)
+}
+func (m *StorageUpdate) XXX_Size() int {
+	return xxx_messageInfo_StorageUpdate.Size(m)
+}
+func (m *StorageUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUpdate proto.InternalMessageInfo
+
+func (m *StorageUpdate) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StorageUpdate) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *StorageUpdate) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *StorageUpdate) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+//转让存证的所有权
+type StorageTransfer struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageTransfer) Reset()         { *m = StorageTransfer{} }
+func (m *StorageTransfer) String() string { return proto.CompactTextString(m) }
+func (*StorageTransfer) ProtoMessage()    {}
+func (*StorageTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{3}
+}
+
+func (m *StorageTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageTransfer.Unmarshal(m, b)
+}
+func (m *StorageTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageTransfer.Marshal(b, m, deterministic)
+}
+func (m *StorageTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageTransfer.Merge(m, src)
+}
+func (m *StorageTransfer) XXX_Size() int {
+	return xxx_messageInfo_StorageTransfer.Size(m)
+}
+func (m *StorageTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageTransfer proto.InternalMessageInfo
+
+func (m *StorageTransfer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StorageTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type StorageRecord struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	ContentHash          []byte   `protobuf:"bytes,3,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Memo                 string   `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Version              int64    `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	CreateHeight         int64    `protobuf:"varint,7,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	UpdateHeight         int64    `protobuf:"varint,8,opt,name=updateHeight,proto3" json:"updateHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageRecord) Reset()         { *m = StorageRecord{} }
+func (m *StorageRecord) String() string { return proto.CompactTextString(m) }
+func (*StorageRecord) ProtoMessage()    {}
+func (*StorageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{4}
+}
+
+func (m *StorageRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageRecord.Unmarshal(m, b)
+}
+func (m *StorageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageRecord.Marshal(b, m, deterministic)
+}
+func (m *StorageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageRecord.Merge(m, src)
+}
+func (m *StorageRecord) XXX_Size() int {
+	return xxx_messageInfo_StorageRecord.Size(m)
+}
+func (m *StorageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageRecord proto.InternalMessageInfo
+
+func (m *StorageRecord) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StorageRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StorageRecord) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *StorageRecord) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *StorageRecord) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *StorageRecord) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *StorageRecord) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+func (m *StorageRecord) GetUpdateHeight() int64 {
+	if m != nil {
+		return m.UpdateHeight
+	}
+	return 0
+}
+
+//hash第一次存证的时候记录的存证id和版本
+type StorageHashAnchor struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageHashAnchor) Reset()         { *m = StorageHashAnchor{} }
+func (m *StorageHashAnchor) String() string { return proto.CompactTextString(m) }
+func (*StorageHashAnchor) ProtoMessage()    {}
+func (*StorageHashAnchor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{5}
+}
+
+func (m *StorageHashAnchor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageHashAnchor.Unmarshal(m, b)
+}
+func (m *StorageHashAnchor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageHashAnchor.Marshal(b, m, deterministic)
+}
+func (m *StorageHashAnchor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageHashAnchor.Merge(m, src)
+}
+func (m *StorageHashAnchor) XXX_Size() int {
+	return xxx_messageInfo_StorageHashAnchor.Size(m)
+}
+func (m *StorageHashAnchor) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageHashAnchor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageHashAnchor proto.InternalMessageInfo
+
+func (m *StorageHashAnchor) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StorageHashAnchor) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *StorageHashAnchor) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StorageHashAnchor) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ReceiptStorageRecord struct {
+	Prev                 *StorageRecord `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *StorageRecord `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReceiptStorageRecord) Reset()         { *m = ReceiptStorageRecord{} }
+func (m *ReceiptStorageRecord) String() string { return proto.CompactTextString(m) }
+func (*ReceiptStorageRecord) ProtoMessage()    {}
+func (*ReceiptStorageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{6}
+}
+
+func (m *ReceiptStorageRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptStorageRecord.Unmarshal(m, b)
+}
+func (m *ReceiptStorageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptStorageRecord.Marshal(b, m, deterministic)
+}
+func (m *ReceiptStorageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptStorageRecord.Merge(m, src)
+}
+func (m *ReceiptStorageRecord) XXX_Size() int {
+	return xxx_messageInfo_ReceiptStorageRecord.Size(m)
+}
+func (m *ReceiptStorageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptStorageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptStorageRecord proto.InternalMessageInfo
+
+func (m *ReceiptStorageRecord) GetPrev() *StorageRecord {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptStorageRecord) GetCurrent() *StorageRecord {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReplyStorageHash struct {
+	Anchor               *StorageHashAnchor `protobuf:"bytes,1,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	Record               *StorageRecord     `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReplyStorageHash) Reset()         { *m = ReplyStorageHash{} }
+func (m *ReplyStorageHash) String() string { return proto.CompactTextString(m) }
+func (*ReplyStorageHash) ProtoMessage()    {}
+func (*ReplyStorageHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{7}
+}
+
+func (m *ReplyStorageHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyStorageHash.Unmarshal(m, b)
+}
+func (m *ReplyStorageHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyStorageHash.Marshal(b, m, deterministic)
+}
+func (m *ReplyStorageHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyStorageHash.Merge(m, src)
+}
+func (m *ReplyStorageHash) XXX_Size() int {
+	return xxx_messageInfo_ReplyStorageHash.Size(m)
+}
+func (m *ReplyStorageHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyStorageHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyStorageHash proto.InternalMessageInfo
+
+func (m *ReplyStorageHash) GetAnchor() *StorageHashAnchor {
+	if m != nil {
+		return m.Anchor
+	}
+	return nil
+}
+
+func (m *ReplyStorageHash) GetRecord() *StorageRecord {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+//按id列出owner的存证
+//   primaryKey : 从上一次返回的primaryKey之后开始列出，为空的时候从头开始
+type ReqStorageRecords struct {
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStorageRecords) Reset()         { *m = ReqStorageRecords{} }
+func (m *ReqStorageRecords) String() string { return proto.CompactTextString(m) }
+func (*ReqStorageRecords) ProtoMessage()    {}
+func (*ReqStorageRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{8}
+}
+
+func (m *ReqStorageRecords) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStorageRecords.Unmarshal(m, b)
+}
+func (m *ReqStorageRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStorageRecords.Marshal(b, m, deterministic)
+}
+func (m *ReqStorageRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStorageRecords.Merge(m, src)
+}
+func (m *ReqStorageRecords) XXX_Size() int {
+	return xxx_messageInfo_ReqStorageRecords.Size(m)
+}
+func (m *ReqStorageRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStorageRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStorageRecords proto.InternalMessageInfo
+
+func (m *ReqStorageRecords) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqStorageRecords) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqStorageRecords) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyStorageRecords struct {
+	Records              []*StorageRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	PrimaryKey           string           `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplyStorageRecords) Reset()         { *m = ReplyStorageRecords{} }
+func (m *ReplyStorageRecords) String() string { return proto.CompactTextString(m) }
+func (*ReplyStorageRecords) ProtoMessage()    {}
+func (*ReplyStorageRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{9}
+}
+
+func (m *ReplyStorageRecords) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyStorageRecords.Unmarshal(m, b)
+}
+func (m *ReplyStorageRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyStorageRecords.Marshal(b, m, deterministic)
+}
+func (m *ReplyStorageRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyStorageRecords.Merge(m, src)
+}
+func (m *ReplyStorageRecords) XXX_Size() int {
+	return xxx_messageInfo_ReplyStorageRecords.Size(m)
+}
+func (m *ReplyStorageRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyStorageRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyStorageRecords proto.InternalMessageInfo
+
+func (m *ReplyStorageRecords) GetRecords() []*StorageRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ReplyStorageRecords) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*StorageAction)(nil), "types.StorageAction")
+	proto.RegisterType((*StorageAnchor)(nil), "types.StorageAnchor")
+	proto.RegisterType((*StorageUpdate)(nil), "types.StorageUpdate")
+	proto.RegisterType((*StorageTransfer)(nil), "types.StorageTransfer")
+	proto.RegisterType((*StorageRecord)(nil), "types.StorageRecord")
+	proto.RegisterType((*StorageHashAnchor)(nil), "types.StorageHashAnchor")
+	proto.RegisterType((*ReceiptStorageRecord)(nil), "types.ReceiptStorageRecord")
+	proto.RegisterType((*ReplyStorageHash)(nil), "types.ReplyStorageHash")
+	proto.RegisterType((*ReqStorageRecords)(nil), "types.ReqStorageRecords")
+	proto.RegisterType((*ReplyStorageRecords)(nil), "types.ReplyStorageRecords")
+}
+
+func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
+
+var fileDescriptor_0d2c4ccf1453ffdb = []byte{
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcd, 0x8e, 0xd3, 0x30,
+	0x10, 0xc7, 0x37, 0xdf, 0xdb, 0xd9, 0x2e, 0xb0, 0xa6, 0x5a, 0xe5, 0x84, 0x22, 0x9f, 0x7a, 0x40,
+	0x11, 0x5f, 0x2f, 0x00, 0xa7, 0x4a, 0xdc, 0x0c, 0x1c, 0x38, 0xa1, 0x90, 0x0c, 0xdb, 0x88, 0x6d,
+	0x1c, 0x1c, 0xb7, 0x28, 0x2f, 0xc7, 0xbb, 0xf0, 0x26, 0xc8, 0x1f, 0x6d, 0x9c, 0x6c, 0xca, 0xde,
+	0xe2, 0x99, 0xdf, 0xf8, 0xff, 0x9f, 0xb1, 0x1d, 0xb8, 0xee, 0x24, 0x17, 0xc5, 0x1d, 0xe6, 0xad,
+	0xe0, 0x92, 0x93, 0x48, 0xf6, 0x2d, 0x76, 0xf4, 0x8f, 0x07, 0xd7, 0x9f, 0x4c, 0xe2, 0x7d, 0x29,
+	0x6b, 0xde, 0x90, 0x1c, 0xe2, 0xa2, 0x29, 0xb7, 0x5c, 0xa4, 0x5e, 0xe6, 0xad, 0xaf, 0xde, 0xac,
+	0x72, 0x4d, 0xe6, 0x47, 0x4a, 0xe7, 0x36, 0x17, 0xcc, 0x52, 0x8a, 0xdf, 0xb7, 0x55, 0x21, 0x31,
+	0xf5, 0xe7, 0xf8, 0x2f, 0x3a, 0xa7, 0x78, 0x43, 0x91, 0x77, 0x70, 0x29, 0x45, 0xd1, 0x74, 0x3f,
+	0x50, 0xa4, 0x81, 0xae, 0xb8, 0x1d, 0x57, 0x7c, 0xb6, 0xd9, 0xcd, 0x05, 0x3b, 0x91, 0xe4, 0x09,
+	0xf8, 0xb2, 0x4f, 0xc3, 0xcc, 0x5b, 0x47, 0xcc, 0x97, 0xfd, 0x87, 0x04, 0xa2, 0x43, 0x71, 0xbf,
+	0x47, 0xfa, 0x75, 0xf0, 0x6f, 0xfc, 0x64, 0x70, 0x55, 0xf2, 0x46, 0x62, 0x23, 0x37, 0x45, 0xb7,
+	0xd5, 0x4d, 0x2c, 0x99, 0x1b, 0x22, 0x04, 0xc2, 0xaa, 0x90, 0x85, 0xf6, 0xbb, 0x64, 0xfa, 0x5b,
+	0xc5, 0x76, 0xb8, 0xe3, 0xda, 0xd1, 0x82, 0xe9, 0x6f, 0x5a, 0x9f, 0xb6, 0x36, 0x4d, 0x28, 0x13,
+	0x75, 0xa5, 0x77, 0x5c, 0x30, 0xbf, 0xae, 0xa6, 0x52, 0xfe, 0x79, 0xa9, 0x60, 0x46, 0x2a, 0x74,
+	0xa4, 0x5e, 0xc3, 0xd3, 0x49, 0xf7, 0x0f, 0xc4, 0xd4, 0x04, 0xb8, 0xd6, 0x58, 0x30, 0x5f, 0x72,
+	0xfa, 0x77, 0x38, 0x39, 0x86, 0x25, 0x17, 0xd5, 0x83, 0x8a, 0x15, 0x44, 0xfc, 0x77, 0x83, 0xc2,
+	0x16, 0x99, 0xc5, 0xd4, 0x74, 0x70, 0xde, 0x74, 0x38, 0x63, 0x3a, 0x1a, 0x4c, 0x93, 0x14, 0x92,
+	0x03, 0x8a, 0xae, 0xe6, 0x4d, 0x1a, 0x67, 0xde, 0x3a, 0x60, 0xc7, 0x25, 0xa1, 0xb0, 0x2c, 0x05,
+	0xaa, 0x73, 0xc7, 0xfa, 0x6e, 0x2b, 0xd3, 0x44, 0xa7, 0x47, 0x31, 0xc5, 0x98, 0x1b, 0x61, 0x99,
+	0x4b, 0xc3, 0xb8, 0x31, 0xfa, 0x13, 0x6e, 0x6c, 0x8b, 0xca, 0x98, 0x3d, 0xe0, 0x69, 0x9b, 0x8e,
+	0x0d, 0x7f, 0x6c, 0xe3, 0x34, 0x80, 0xc0, 0x1d, 0xc0, 0x2d, 0xc4, 0x5b, 0x23, 0x19, 0x6a, 0xdc,
+	0xae, 0x68, 0x0b, 0x2b, 0x86, 0x25, 0xd6, 0xad, 0x1c, 0x8f, 0x75, 0x0d, 0x61, 0x2b, 0xf0, 0x30,
+	0xff, 0x1c, 0x0c, 0xc3, 0x34, 0x41, 0x72, 0x48, 0xca, 0xbd, 0x10, 0xd8, 0xc8, 0xf9, 0xb7, 0x60,
+	0xe1, 0x23, 0x44, 0x05, 0x3c, 0x63, 0xd8, 0xde, 0xf7, 0x4e, 0x8f, 0xe4, 0xd5, 0xe4, 0xf9, 0xa5,
+	0xe3, 0x2d, 0x86, 0x39, 0x9c, 0x1e, 0xe0, 0x4b, 0x88, 0x85, 0xde, 0xf8, 0xbf, 0xa2, 0x96, 0xa1,
+	0xdf, 0xe0, 0x86, 0xe1, 0xaf, 0x51, 0xae, 0x1b, 0x06, 0xe5, 0xb9, 0x83, 0x7a, 0x01, 0xd0, 0x8a,
+	0x7a, 0x57, 0x88, 0xfe, 0x23, 0xf6, 0xf6, 0x12, 0x39, 0x11, 0x55, 0x55, 0xf2, 0x7d, 0x23, 0xf5,
+	0x78, 0x23, 0x66, 0x16, 0x14, 0xe1, 0xb9, 0xdb, 0xd4, 0x51, 0x22, 0x87, 0xc4, 0x38, 0xe8, 0x52,
+	0x2f, 0x0b, 0xce, 0xcf, 0xc6, 0x42, 0x8f, 0x89, 0x7f, 0x8f, 0xf5, 0x6f, 0xec, 0xed, 0xbf, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xcd, 0x2d, 0xf1, 0x8f, 0xd7, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,79 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types storage插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// StorageX 执行器名称
+	StorageX   = "storage"
+	actionName = map[string]int32{
+		"Anchor":   StorageActionAnchor,
+		"Update":   StorageActionUpdate,
+		"Transfer": StorageActionTransfer,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogStorageAnchor:   {Ty: reflect.TypeOf(ReceiptStorageRecord{}), Name: "LogStorageAnchor"},
+		TyLogStorageUpdate:   {Ty: reflect.TypeOf(ReceiptStorageRecord{}), Name: "LogStorageUpdate"},
+		TyLogStorageTransfer: {Ty: reflect.TypeOf(ReceiptStorageRecord{}), Name: "LogStorageTransfer"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(StorageX))
+	types.RegistorExecutor(StorageX, NewType())
+	types.RegisterDappFork(StorageX, "Enable", 0)
+}
+
+// StorageType storage执行器类型
+type StorageType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a storage type object
+func NewType() *StorageType {
+	c := &StorageType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return storage action
+func (s *StorageType) GetPayload() types.Message {
+	return &StorageAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (s *StorageType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (s *StorageType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (s *StorageType) GetName() string {
+	return StorageX
+}
+
+// DataFee 附带数据需要的额外手续费，和交易的手续费一样按1000字节计算
+func DataFee(size int) int64 {
+	if size == 0 {
+		return 0
+	}
+	return int64(size/1000+1) * types.GInt("MinFee") * DataFeeRate
+}
+
+// DataSize 交易附带的数据的大小
+func DataSize(action *StorageAction) int {
+	//按payload计算，不依赖ty字段
+	return len(action.GetAnchor().GetData()) + len(action.GetUpdate().GetData())
+}
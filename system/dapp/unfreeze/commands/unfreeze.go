@@ -0,0 +1,217 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands unfreeze插件命令
+package commands
+
+import (
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	uty "github.com/33cn/chain33/system/dapp/unfreeze/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// UnfreezeCmd unfreeze command
+func UnfreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze",
+		Short: "Periodic release management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CreateCmd(),
+		WithdrawCmd(),
+		PauseCmd(),
+		ResumeCmd(),
+		TerminateCmd(),
+		QueryUnfreezeCmd(),
+		ListUnfreezesCmd(),
+	)
+
+	return cmd
+}
+
+// CreateCmd create periodic release
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a transaction to lock assets released to beneficiary every period",
+		Run:   create,
+	}
+	cmd.Flags().StringP("beneficiary", "b", "", "beneficiary address")
+	cmd.MarkFlagRequired("beneficiary")
+	cmd.Flags().Float64P("amount", "a", 0, "locked amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().Float64P("per_period", "r", 0, "amount released every period")
+	cmd.MarkFlagRequired("per_period")
+	cmd.Flags().Int64P("start", "t", 0, "start height, or start unix time in seconds with --time")
+	cmd.Flags().Int64P("period", "p", 0, "period in blocks, or in seconds with --time")
+	cmd.MarkFlagRequired("period")
+	cmd.Flags().Bool("time", false, "count start and period by block time instead of height")
+	cmd.Flags().Bool("pausable", false, "allow creator to pause and resume")
+	cmd.Flags().Bool("terminable", false, "allow creator to terminate and take back the locked assets")
+	cmd.Flags().StringP("asset_exec", "e", "", "asset executor, empty for coins")
+	cmd.Flags().StringP("asset_symbol", "s", "", "asset symbol")
+	return cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	beneficiary, _ := cmd.Flags().GetString("beneficiary")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	perPeriod, _ := cmd.Flags().GetFloat64("per_period")
+	start, _ := cmd.Flags().GetInt64("start")
+	period, _ := cmd.Flags().GetInt64("period")
+	byTime, _ := cmd.Flags().GetBool("time")
+	pausable, _ := cmd.Flags().GetBool("pausable")
+	terminable, _ := cmd.Flags().GetBool("terminable")
+	assetExec, _ := cmd.Flags().GetString("asset_exec")
+	assetSymbol, _ := cmd.Flags().GetString("asset_symbol")
+	unit := int32(uty.PeriodUnitBlock)
+	if byTime {
+		unit = uty.PeriodUnitSecond
+	}
+	commandtypes.CreateActionTx(cmd, uty.UnfreezeX, &uty.UnfreezeAction{
+		Ty: uty.UnfreezeActionCreate,
+		Value: &uty.UnfreezeAction_Create{Create: &uty.UnfreezeCreate{Beneficiary: beneficiary, AssetExec: assetExec, AssetSymbol: assetSymbol,
+			Amount: commandtypes.FormatAmountDisplay2Value(amount), AmountPerPeriod: commandtypes.FormatAmountDisplay2Value(perPeriod), StartAt: start, Period: period, PeriodUnit: unit,
+			AllowPause: pausable, AllowTerminate: terminable}},
+	})
+}
+
+func addIDFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("id", "i", "", "unfreeze id")
+	cmd.MarkFlagRequired("id")
+}
+
+// WithdrawCmd withdraw released assets
+func WithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Create a transaction to withdraw released assets by beneficiary",
+		Run:   withdraw,
+	}
+	addIDFlag(cmd)
+	return cmd
+}
+
+func withdraw(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, uty.UnfreezeX, &uty.UnfreezeAction{
+		Ty:    uty.UnfreezeActionWithdraw,
+		Value: &uty.UnfreezeAction_Withdraw{Withdraw: &uty.UnfreezeWithdraw{Id: id}},
+	})
+}
+
+// PauseCmd pause release
+func PauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Create a transaction to pause release by creator",
+		Run:   pause,
+	}
+	addIDFlag(cmd)
+	return cmd
+}
+
+func pause(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, uty.UnfreezeX, &uty.UnfreezeAction{
+		Ty:    uty.UnfreezeActionPause,
+		Value: &uty.UnfreezeAction_Pause{Pause: &uty.UnfreezePause{Id: id}},
+	})
+}
+
+// ResumeCmd resume release
+func ResumeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Create a transaction to resume paused release by creator",
+		Run:   resume,
+	}
+	addIDFlag(cmd)
+	return cmd
+}
+
+func resume(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, uty.UnfreezeX, &uty.UnfreezeAction{
+		Ty:    uty.UnfreezeActionResume,
+		Value: &uty.UnfreezeAction_Resume{Resume: &uty.UnfreezeResume{Id: id}},
+	})
+}
+
+// TerminateCmd terminate release
+func TerminateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terminate",
+		Short: "Create a transaction to terminate release and refund unreleased assets to creator",
+		Run:   terminate,
+	}
+	addIDFlag(cmd)
+	return cmd
+}
+
+func terminate(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, uty.UnfreezeX, &uty.UnfreezeAction{
+		Ty:    uty.UnfreezeActionTerminate,
+		Value: &uty.UnfreezeAction_Terminate{Terminate: &uty.UnfreezeTerminate{Id: id}},
+	})
+}
+
+func queryUnfreeze(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, uty.UnfreezeX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryUnfreezeCmd query unfreeze
+func QueryUnfreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query unfreeze with released and remaining locked amount",
+		Run:   query,
+	}
+	addIDFlag(cmd)
+	return cmd
+}
+
+func query(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res uty.ReplyUnfreeze
+	queryUnfreeze(cmd, uty.FuncNameGetUnfreeze, &types.ReqString{Data: id}, &res)
+}
+
+// ListUnfreezesCmd list unfreezes of beneficiary or creator
+func ListUnfreezesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List unfreezes of beneficiary or creator",
+		Run:   list,
+	}
+	cmd.Flags().StringP("beneficiary", "b", "", "beneficiary address")
+	cmd.Flags().StringP("creator", "c", "", "creator address")
+	cmd.Flags().StringP("primary", "p", "", "list after this unfreeze id")
+	cmd.Flags().Int32P("count", "n", uty.DefaultListCount, "max count")
+	return cmd
+}
+
+func list(cmd *cobra.Command, args []string) {
+	beneficiary, _ := cmd.Flags().GetString("beneficiary")
+	creator, _ := cmd.Flags().GetString("creator")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res uty.ReplyUnfreezes
+	queryUnfreeze(cmd, uty.FuncNameListUnfreezes, &uty.ReqUnfreezes{Beneficiary: beneficiary, Creator: creator, PrimaryKey: primary, Count: count}, &res)
+}
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	uty "github.com/33cn/chain33/system/dapp/unfreeze/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Create 创建定期释放
+func (u *Unfreeze) Exec_Create(payload *uty.UnfreezeCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(u, tx, index)
+	return action.create(payload)
+}
+
+// Exec_Withdraw 提取已经释放的部分
+func (u *Unfreeze) Exec_Withdraw(payload *uty.UnfreezeWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(u, tx, index)
+	return action.withdraw(payload)
+}
+
+// Exec_Pause 付款人暂停释放
+func (u *Unfreeze) Exec_Pause(payload *uty.UnfreezePause, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(u, tx, index)
+	return action.pause(payload)
+}
+
+// Exec_Resume 付款人恢复释放
+func (u *Unfreeze) Exec_Resume(payload *uty.UnfreezeResume, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(u, tx, index)
+	return action.resume(payload)
+}
+
+// Exec_Terminate 付款人终止释放
+func (u *Unfreeze) Exec_Terminate(payload *uty.UnfreezeTerminate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(u, tx, index)
+	return action.terminate(payload)
+}
@@ -0,0 +1,30 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	uty "github.com/33cn/chain33/system/dapp/unfreeze/types"
+	"github.com/33cn/chain33/types"
+)
+
+// ExecLocal_Create 添加受益人和付款人的索引
+func (u *Unfreeze) ExecLocal_Create(payload *uty.UnfreezeCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != uty.TyLogUnfreezeCreate {
+			continue
+		}
+		var log uty.ReceiptUnfreeze
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, &types.KeyValue{Key: calcBeneficiaryIndexKey(log.Current.Beneficiary, log.Current.Id), Value: []byte(log.Current.Id)})
+		kvs = append(kvs, &types.KeyValue{Key: calcCreatorIndexKey(log.Current.Creator, log.Current.Id), Value: []byte(log.Current.Id)})
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
@@ -0,0 +1,24 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	uty "github.com/33cn/chain33/system/dapp/unfreeze/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetUnfreeze 获取定期释放和按当前区块计算的剩余锁定金额
+func (u *Unfreeze) Query_GetUnfreeze(in *types.ReqString) (types.Message, error) {
+	unfreeze, err := getUnfreeze(u.GetStateDB(), in.Data)
+	if err != nil {
+		return nil, err
+	}
+	return replyUnfreeze(unfreeze, u.GetHeight(), u.GetBlockTime()), nil
+}
+
+// Query_ListUnfreezes 按受益人或者付款人列出定期释放
+func (u *Unfreeze) Query_ListUnfreezes(in *uty.ReqUnfreezes) (types.Message, error) {
+	return listUnfreezes(u.GetLocalDB(), u.GetStateDB(), in, u.GetHeight(), u.GetBlockTime())
+}
@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor unfreeze执行器，负责定期释放的创建、提取、暂停和终止
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	uty "github.com/33cn/chain33/system/dapp/unfreeze/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.unfreeze")
+	driverName = uty.UnfreezeX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Unfreeze{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newUnfreeze, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return unfreeze name
+func GetName() string {
+	return newUnfreeze().GetName()
+}
+
+// Unfreeze defines Unfreeze object
+type Unfreeze struct {
+	drivers.DriverBase
+}
+
+func newUnfreeze() drivers.Driver {
+	u := &Unfreeze{}
+	u.SetChild(u)
+	u.SetExecutorType(types.LoadExecutorType(driverName))
+	return u
+}
+
+// GetDriverName return a drivername
+func (u *Unfreeze) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (u *Unfreeze) CheckReceiptExecOk() bool {
+	return true
+}
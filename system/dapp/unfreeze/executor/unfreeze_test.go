@@ -0,0 +1,153 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	uty "github.com/33cn/chain33/system/dapp/unfreeze/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendUnfreezeTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) (int32, string) {
+	hash, detail, err := mock33.SendCallTx(priv, uty.UnfreezeX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, common.ToHex(hash)
+}
+
+func getUnfreeze(t *testing.T, mock33 *testnode.Chain33Mock, id string) *uty.ReplyUnfreeze {
+	msg, err := mock33.GetAPI().Query(uty.UnfreezeX, uty.FuncNameGetUnfreeze, &types.ReqString{Data: id})
+	assert.Nil(t, err)
+	return msg.(*uty.ReplyUnfreeze)
+}
+
+func TestReleasedAmount(t *testing.T) {
+	u := &uty.Unfreeze{Amount: 100, AmountPerPeriod: 30, StartAt: 10, Period: 5}
+	assert.Equal(t, int64(0), uty.ReleasedAmount(u, 14))
+	assert.Equal(t, int64(30), uty.ReleasedAmount(u, 15))
+	assert.Equal(t, int64(90), uty.ReleasedAmount(u, 29))
+	assert.Equal(t, int64(100), uty.ReleasedAmount(u, 30))
+	//暂停的时候停在暂停的时间，恢复以后扣除暂停的时间
+	u.Paused, u.PausedAt = true, 16
+	assert.Equal(t, int64(30), uty.ReleasedAmount(u, 100))
+	u.Paused, u.PausedDuration = false, 10
+	assert.Equal(t, int64(30), uty.ReleasedAmount(u, 29))
+	assert.Equal(t, int64(60), uty.ReleasedAmount(u, 30))
+	//终止以后不再释放
+	u.Terminated, u.Refunded = true, 40
+	assert.Equal(t, int64(60), uty.ReleasedAmount(u, 1000))
+	//按区块时间计算
+	byTime := &uty.Unfreeze{Amount: 100, AmountPerPeriod: 50, StartAt: 1000, Period: 60, PeriodUnit: uty.PeriodUnitSecond}
+	assert.Equal(t, int64(1060), uty.Clock(byTime, 5, 1060))
+	assert.Equal(t, int64(50), uty.ReleasedAmount(byTime, 1060))
+	//大额不溢出
+	big := &uty.Unfreeze{Amount: math.MaxInt64, AmountPerPeriod: math.MaxInt64 / 2, Period: 1}
+	assert.Equal(t, int64(math.MaxInt64/2*2), uty.ReleasedAmount(big, 2))
+	assert.Equal(t, int64(math.MaxInt64), uty.ReleasedAmount(big, math.MaxInt64))
+}
+
+func TestUnfreeze(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	creator := mock33.GetGenesisKey()
+	creatorAddr := mock33.GetGenesisAddress()
+	addr, priv := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(creator, addr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(creator, address.ExecAddress(uty.UnfreezeX), 20*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	height := mock33.GetLastBlock().Height
+	ty, _ := sendUnfreezeTx(t, mock33, creator, "Create", &uty.UnfreezeCreate{Beneficiary: addr, Amount: types.Coin, AmountPerPeriod: 2 * types.Coin, StartAt: height, Period: 2})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Create", &uty.UnfreezeCreate{Beneficiary: addr, Amount: types.Coin, AmountPerPeriod: types.Coin, StartAt: height, Period: 0})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Create", &uty.UnfreezeCreate{Beneficiary: addr, Amount: 30 * types.Coin, AmountPerPeriod: types.Coin, StartAt: height, Period: 2})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//每2个区块释放1个coin，可以暂停和终止
+	height = mock33.GetLastBlock().Height + 1
+	ty, id := sendUnfreezeTx(t, mock33, creator, "Create", &uty.UnfreezeCreate{Beneficiary: addr, Amount: 5 * types.Coin, AmountPerPeriod: types.Coin,
+		StartAt: height, Period: 2, AllowPause: true, AllowTerminate: true})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 15*types.Coin, mock33.GetExecBalance(uty.UnfreezeX, creatorAddr))
+	ty, _ = sendUnfreezeTx(t, mock33, priv, "Withdraw", &uty.UnfreezeWithdraw{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.Nil(t, mock33.CreateBlocks(2))
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Withdraw", &uty.UnfreezeWithdraw{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, priv, "Withdraw", &uty.UnfreezeWithdraw{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	reply := getUnfreeze(t, mock33, id)
+	assert.Equal(t, height+5, reply.Height)
+	assert.Equal(t, 2*types.Coin, reply.Released)
+	assert.Equal(t, int64(0), reply.Withdrawable)
+	assert.Equal(t, 3*types.Coin, reply.Remaining)
+	assert.Equal(t, 2*types.Coin, mock33.GetExecBalance(uty.UnfreezeX, addr))
+
+	//暂停期间不释放，只有付款人可以暂停和恢复
+	ty, _ = sendUnfreezeTx(t, mock33, priv, "Pause", &uty.UnfreezePause{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Pause", &uty.UnfreezePause{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Pause", &uty.UnfreezePause{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.Nil(t, mock33.CreateBlocks(4))
+	reply = getUnfreeze(t, mock33, id)
+	assert.Equal(t, 3*types.Coin, reply.Released)
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Resume", &uty.UnfreezeResume{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	reply = getUnfreeze(t, mock33, id)
+	assert.Equal(t, int64(6), reply.Unfreeze.PausedDuration)
+	assert.Equal(t, 3*types.Coin, reply.Released)
+
+	//终止以后没有释放的部分退回付款人，已经释放的部分受益人仍然可以提取
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Terminate", &uty.UnfreezeTerminate{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	reply = getUnfreeze(t, mock33, id)
+	assert.Equal(t, 4*types.Coin, reply.Released)
+	assert.Equal(t, types.Coin, reply.Unfreeze.Refunded)
+	assert.Equal(t, int64(0), reply.Remaining)
+	assert.Equal(t, 16*types.Coin, mock33.GetExecBalance(uty.UnfreezeX, creatorAddr))
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Resume", &uty.UnfreezeResume{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, priv, "Withdraw", &uty.UnfreezeWithdraw{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 4*types.Coin, mock33.GetExecBalance(uty.UnfreezeX, addr))
+
+	//创建的时候没有允许就不能暂停和终止
+	ty, fixedID := sendUnfreezeTx(t, mock33, creator, "Create", &uty.UnfreezeCreate{Beneficiary: addr, Amount: 2 * types.Coin, AmountPerPeriod: types.Coin, Period: 1})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Pause", &uty.UnfreezePause{Id: fixedID})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, creator, "Terminate", &uty.UnfreezeTerminate{Id: fixedID})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendUnfreezeTx(t, mock33, priv, "Withdraw", &uty.UnfreezeWithdraw{Id: fixedID})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 6*types.Coin, mock33.GetExecBalance(uty.UnfreezeX, addr))
+
+	msg, err := mock33.GetAPI().Query(uty.UnfreezeX, uty.FuncNameListUnfreezes, &uty.ReqUnfreezes{Beneficiary: addr})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msg.(*uty.ReplyUnfreezes).Unfreezes))
+	msg, err = mock33.GetAPI().Query(uty.UnfreezeX, uty.FuncNameListUnfreezes, &uty.ReqUnfreezes{Creator: creatorAddr, Count: 1})
+	assert.Nil(t, err)
+	list := msg.(*uty.ReplyUnfreezes)
+	assert.Equal(t, 1, len(list.Unfreezes))
+	msg, err = mock33.GetAPI().Query(uty.UnfreezeX, uty.FuncNameListUnfreezes, &uty.ReqUnfreezes{Creator: creatorAddr, PrimaryKey: list.PrimaryKey})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*uty.ReplyUnfreezes).Unfreezes))
+	_, err = mock33.GetAPI().Query(uty.UnfreezeX, uty.FuncNameListUnfreezes, &uty.ReqUnfreezes{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
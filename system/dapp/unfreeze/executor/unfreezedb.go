@@ -0,0 +1,298 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	uty "github.com/33cn/chain33/system/dapp/unfreeze/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	unfreezeKeyPrefix      = "mavl-" + uty.UnfreezeX + "-unfreeze-"
+	beneficiaryIndexPrefix = "LODB-" + uty.UnfreezeX + "-beneficiary-"
+	creatorIndexPrefix     = "LODB-" + uty.UnfreezeX + "-creator-"
+)
+
+func calcUnfreezeKey(id string) []byte {
+	return []byte(unfreezeKeyPrefix + id)
+}
+
+func calcBeneficiaryIndexKey(beneficiary, id string) []byte {
+	return []byte(beneficiaryIndexPrefix + beneficiary + "-" + id)
+}
+
+func calcCreatorIndexKey(creator, id string) []byte {
+	return []byte(creatorIndexPrefix + creator + "-" + id)
+}
+
+//calcUnfreezeAddr 锁定的资产存在由id生成的地址中，没有对应的私钥
+func calcUnfreezeAddr(id string) string {
+	return address.ExecAddress(uty.UnfreezeX + "-" + id)
+}
+
+// Action unfreeze交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	execaddr     string
+	height       int64
+	blocktime    int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(u *Unfreeze, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: u.GetCoinsAccount(),
+		db:           u.GetStateDB(),
+		txhash:       tx.Hash(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       u.GetHeight(),
+		blocktime:    u.GetBlockTime(),
+		index:        index,
+	}
+}
+
+//assetAccount coins用执行器的coins账户，其他资产按执行器和symbol创建账户
+func (a *Action) assetAccount(exec, symbol string) (*account.DB, error) {
+	if exec == "coins" {
+		return a.coinsAccount, nil
+	}
+	return account.NewAccountDB(exec, symbol, a.db)
+}
+
+func (a *Action) clock(unfreeze *uty.Unfreeze) int64 {
+	return uty.Clock(unfreeze, a.height, a.blocktime)
+}
+
+func getUnfreeze(db dbm.KV, id string) (*uty.Unfreeze, error) {
+	value, err := db.Get(calcUnfreezeKey(id))
+	if err != nil || value == nil {
+		return nil, uty.ErrUnfreezeNotExist
+	}
+	var unfreeze uty.Unfreeze
+	err = types.Decode(value, &unfreeze)
+	if err != nil {
+		return nil, err
+	}
+	return &unfreeze, nil
+}
+
+func replyUnfreeze(unfreeze *uty.Unfreeze, height, blockTime int64) *uty.ReplyUnfreeze {
+	released := uty.ReleasedAmount(unfreeze, uty.Clock(unfreeze, height, blockTime))
+	return &uty.ReplyUnfreeze{
+		Unfreeze:     unfreeze,
+		Height:       height,
+		BlockTime:    blockTime,
+		Released:     released,
+		Withdrawable: released - unfreeze.Withdrawn,
+		Remaining:    unfreeze.Amount - unfreeze.Refunded - released,
+	}
+}
+
+func listUnfreezes(localdb dbm.KVDB, statedb dbm.KV, req *uty.ReqUnfreezes, height, blockTime int64) (*uty.ReplyUnfreezes, error) {
+	var prefix string
+	switch {
+	case req.Beneficiary != "":
+		prefix = beneficiaryIndexPrefix + req.Beneficiary + "-"
+	case req.Creator != "":
+		prefix = creatorIndexPrefix + req.Creator + "-"
+	default:
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count <= 0 {
+		count = uty.DefaultListCount
+	}
+	if count > uty.MaxListCount {
+		count = uty.MaxListCount
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = []byte(prefix + req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(prefix), key, count, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &uty.ReplyUnfreezes{}
+	for _, value := range values {
+		unfreeze, err := getUnfreeze(statedb, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.Unfreezes = append(reply.Unfreezes, replyUnfreeze(unfreeze, height, blockTime))
+		reply.PrimaryKey = unfreeze.Id
+	}
+	return reply, nil
+}
+
+func (a *Action) saveUnfreeze(unfreeze *uty.Unfreeze) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcUnfreezeKey(unfreeze.Id), Value: types.Encode(unfreeze)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func unfreezeReceipt(ty int32, prev, current *uty.Unfreeze) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&uty.ReceiptUnfreeze{Prev: prev, Current: current})}
+}
+
+func (a *Action) create(payload *uty.UnfreezeCreate) (*types.Receipt, error) {
+	if err := address.CheckAddress(payload.Beneficiary); err != nil {
+		return nil, uty.ErrBeneficiary
+	}
+	if payload.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+	if payload.AmountPerPeriod <= 0 || payload.AmountPerPeriod > payload.Amount || payload.Period <= 0 || payload.StartAt < 0 ||
+		(payload.PeriodUnit != uty.PeriodUnitBlock && payload.PeriodUnit != uty.PeriodUnitSecond) {
+		return nil, uty.ErrUnfreezePeriod
+	}
+	exec, symbol := payload.AssetExec, payload.AssetSymbol
+	if exec == "" {
+		exec, symbol = "coins", types.GetCoinSymbol()
+	}
+	acc, err := a.assetAccount(exec, symbol)
+	if err != nil {
+		return nil, err
+	}
+	id := common.ToHex(a.txhash)
+	unfreeze := &uty.Unfreeze{
+		Id:              id,
+		Creator:         a.fromaddr,
+		Beneficiary:     payload.Beneficiary,
+		AssetExec:       exec,
+		AssetSymbol:     symbol,
+		Amount:          payload.Amount,
+		AmountPerPeriod: payload.AmountPerPeriod,
+		StartAt:         payload.StartAt,
+		Period:          payload.Period,
+		PeriodUnit:      payload.PeriodUnit,
+		AllowPause:      payload.AllowPause,
+		AllowTerminate:  payload.AllowTerminate,
+		CreateHeight:    a.height,
+		Addr:            calcUnfreezeAddr(id),
+	}
+	receipt, err := acc.ExecTransfer(a.fromaddr, unfreeze.Addr, a.execaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	kv := append(receipt.KV, a.saveUnfreeze(unfreeze))
+	logs := append(receipt.Logs, unfreezeReceipt(uty.TyLogUnfreezeCreate, nil, unfreeze))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) withdraw(payload *uty.UnfreezeWithdraw) (*types.Receipt, error) {
+	unfreeze, err := getUnfreeze(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if a.fromaddr != unfreeze.Beneficiary {
+		return nil, uty.ErrNotBeneficiary
+	}
+	amount := uty.ReleasedAmount(unfreeze, a.clock(unfreeze)) - unfreeze.Withdrawn
+	if amount <= 0 {
+		return nil, uty.ErrNothingToWithdraw
+	}
+	acc, err := a.assetAccount(unfreeze.AssetExec, unfreeze.AssetSymbol)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.ExecTransfer(unfreeze.Addr, unfreeze.Beneficiary, a.execaddr, amount)
+	if err != nil {
+		return nil, err
+	}
+	prev := *unfreeze
+	unfreeze.Withdrawn += amount
+	kv := append(receipt.KV, a.saveUnfreeze(unfreeze))
+	logs := append(receipt.Logs, unfreezeReceipt(uty.TyLogUnfreezeWithdraw, &prev, unfreeze))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//getCreatorUnfreeze 暂停、恢复和终止只能由付款人对没有终止的定期释放操作
+func (a *Action) getCreatorUnfreeze(id string) (*uty.Unfreeze, error) {
+	unfreeze, err := getUnfreeze(a.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.fromaddr != unfreeze.Creator {
+		return nil, uty.ErrNotCreator
+	}
+	if unfreeze.Terminated {
+		return nil, uty.ErrUnfreezeStatus
+	}
+	return unfreeze, nil
+}
+
+func (a *Action) pause(payload *uty.UnfreezePause) (*types.Receipt, error) {
+	unfreeze, err := a.getCreatorUnfreeze(payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !unfreeze.AllowPause {
+		return nil, uty.ErrPermissionDenied
+	}
+	if unfreeze.Paused {
+		return nil, uty.ErrUnfreezeStatus
+	}
+	prev := *unfreeze
+	unfreeze.Paused = true
+	unfreeze.PausedAt = a.clock(unfreeze)
+	kv := []*types.KeyValue{a.saveUnfreeze(unfreeze)}
+	logs := []*types.ReceiptLog{unfreezeReceipt(uty.TyLogUnfreezePause, &prev, unfreeze)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) resume(payload *uty.UnfreezeResume) (*types.Receipt, error) {
+	unfreeze, err := a.getCreatorUnfreeze(payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !unfreeze.Paused {
+		return nil, uty.ErrUnfreezeStatus
+	}
+	prev := *unfreeze
+	unfreeze.Paused = false
+	unfreeze.PausedDuration += a.clock(unfreeze) - unfreeze.PausedAt
+	unfreeze.PausedAt = 0
+	kv := []*types.KeyValue{a.saveUnfreeze(unfreeze)}
+	logs := []*types.ReceiptLog{unfreezeReceipt(uty.TyLogUnfreezeResume, &prev, unfreeze)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) terminate(payload *uty.UnfreezeTerminate) (*types.Receipt, error) {
+	unfreeze, err := a.getCreatorUnfreeze(payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !unfreeze.AllowTerminate {
+		return nil, uty.ErrPermissionDenied
+	}
+	refund := unfreeze.Amount - uty.ReleasedAmount(unfreeze, a.clock(unfreeze))
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if refund > 0 {
+		acc, err := a.assetAccount(unfreeze.AssetExec, unfreeze.AssetSymbol)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err = acc.ExecTransfer(unfreeze.Addr, unfreeze.Creator, a.execaddr, refund)
+		if err != nil {
+			return nil, err
+		}
+	}
+	prev := *unfreeze
+	unfreeze.Terminated = true
+	unfreeze.Refunded = refund
+	kv := append(receipt.KV, a.saveUnfreeze(unfreeze))
+	logs := append(receipt.Logs, unfreezeReceipt(uty.TyLogUnfreezeTerminate, &prev, unfreeze))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
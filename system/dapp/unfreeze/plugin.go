@@ -0,0 +1,27 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package unfreeze 定期释放执行器插件
+// 1. 付款人把coins或者其他资产锁定给受益人，从开始的高度或者时间起每个周期释放固定的金额
+// 2. 受益人随时提取已经释放的部分
+// 3. 创建的时候可以允许付款人暂停和终止，终止以后没有释放的部分退回付款人
+// 4. 本地数据库按受益人和付款人索引，查询的时候返回按当前区块计算的剩余锁定金额
+package unfreeze
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/unfreeze/commands"
+	"github.com/33cn/chain33/system/dapp/unfreeze/executor"
+	"github.com/33cn/chain33/system/dapp/unfreeze/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.UnfreezeX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.UnfreezeCmd,
+		RPC:      nil,
+	})
+}
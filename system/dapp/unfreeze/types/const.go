@@ -0,0 +1,37 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// unfreeze action ty
+const (
+	UnfreezeActionCreate = iota + 1
+	UnfreezeActionWithdraw
+	UnfreezeActionPause
+	UnfreezeActionResume
+	UnfreezeActionTerminate
+)
+
+// unfreeze log ty
+const (
+	TyLogUnfreezeCreate    = 620
+	TyLogUnfreezeWithdraw  = 621
+	TyLogUnfreezePause     = 622
+	TyLogUnfreezeResume    = 623
+	TyLogUnfreezeTerminate = 624
+)
+
+// period unit
+const (
+	PeriodUnitBlock = iota
+	PeriodUnitSecond
+)
+
+// query func name
+const (
+	FuncNameGetUnfreeze   = "GetUnfreeze"
+	FuncNameListUnfreezes = "ListUnfreezes"
+	DefaultListCount      = 20
+	MaxListCount          = 100
+)
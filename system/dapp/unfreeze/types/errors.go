@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrUnfreezeNotExist 释放计划不存在
+	ErrUnfreezeNotExist = errors.New("ErrUnfreezeNotExist")
+	// ErrBeneficiary 受益人地址不合法
+	ErrBeneficiary = errors.New("ErrBeneficiary")
+	// ErrUnfreezePeriod 释放周期或者每期的金额不合法
+	ErrUnfreezePeriod = errors.New("ErrUnfreezePeriod")
+	// ErrNotBeneficiary 不是受益人
+	ErrNotBeneficiary = errors.New("ErrNotBeneficiary")
+	// ErrNotCreator 不是付款人
+	ErrNotCreator = errors.New("ErrNotCreator")
+	// ErrNothingToWithdraw 没有可以提取的部分
+	ErrNothingToWithdraw = errors.New("ErrNothingToWithdraw")
+	// ErrPermissionDenied 创建的时候没有允许暂停或者终止
+	ErrPermissionDenied = errors.New("ErrPermissionDenied")
+	// ErrUnfreezeStatus 释放计划的状态不允许这个操作
+	ErrUnfreezeStatus = errors.New("ErrUnfreezeStatus")
+)
@@ -0,0 +1,101 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types unfreeze插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// UnfreezeX 执行器名称
+	UnfreezeX  = "unfreeze"
+	actionName = map[string]int32{
+		"Create":    UnfreezeActionCreate,
+		"Withdraw":  UnfreezeActionWithdraw,
+		"Pause":     UnfreezeActionPause,
+		"Resume":    UnfreezeActionResume,
+		"Terminate": UnfreezeActionTerminate,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogUnfreezeCreate:    {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogUnfreezeCreate"},
+		TyLogUnfreezeWithdraw:  {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogUnfreezeWithdraw"},
+		TyLogUnfreezePause:     {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogUnfreezePause"},
+		TyLogUnfreezeResume:    {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogUnfreezeResume"},
+		TyLogUnfreezeTerminate: {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogUnfreezeTerminate"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(UnfreezeX))
+	types.RegistorExecutor(UnfreezeX, NewType())
+	types.RegisterDappFork(UnfreezeX, "Enable", 0)
+}
+
+// UnfreezeType unfreeze执行器类型
+type UnfreezeType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a unfreeze type object
+func NewType() *UnfreezeType {
+	c := &UnfreezeType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return unfreeze action
+func (u *UnfreezeType) GetPayload() types.Message {
+	return &UnfreezeAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (u *UnfreezeType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (u *UnfreezeType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (u *UnfreezeType) GetName() string {
+	return UnfreezeX
+}
+
+// Clock 按释放周期的单位取当前的区块高度或者区块时间
+func Clock(u *Unfreeze, height, blockTime int64) int64 {
+	if u.PeriodUnit == PeriodUnitSecond {
+		return blockTime
+	}
+	return height
+}
+
+// ReleasedAmount 在clock的时候已经释放的总额，暂停的时间不计入周期，终止以后不再释放
+func ReleasedAmount(u *Unfreeze, clock int64) int64 {
+	if u.Terminated {
+		return u.Amount - u.Refunded
+	}
+	if u.Paused {
+		clock = u.PausedAt
+	}
+	elapsed := clock - u.StartAt - u.PausedDuration
+	if elapsed < 0 {
+		return 0
+	}
+	periods := elapsed / u.Period
+	//释放全部金额需要的周期数，不用乘法比较避免溢出
+	total := u.Amount / u.AmountPerPeriod
+	if u.Amount%u.AmountPerPeriod != 0 {
+		total++
+	}
+	if periods >= total {
+		return u.Amount
+	}
+	return periods * u.AmountPerPeriod
+}
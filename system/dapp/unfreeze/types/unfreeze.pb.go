@@ -0,0 +1,1045 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: unfreeze.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type UnfreezeAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*UnfreezeAction_Create
+	//	*UnfreezeAction_Withdraw
+	//	*UnfreezeAction_Pause
+	//	*UnfreezeAction_Resume
+	//	*UnfreezeAction_Terminate
+	Value                isUnfreezeAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                  `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UnfreezeAction) Reset()         { *m = UnfreezeAction{} }
+func (m *UnfreezeAction) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeAction) ProtoMessage()    {}
+func (*UnfreezeAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{0}
+}
+
+func (m *UnfreezeAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeAction.Unmarshal(m, b)
+}
+func (m *UnfreezeAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeAction.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeAction.Merge(m, src)
+}
+func (m *UnfreezeAction) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeAction.Size(m)
+}
+func (m *UnfreezeAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeAction proto.InternalMessageInfo
+
+type isUnfreezeAction_Value interface {
+	isUnfreezeAction_Value()
+}
+
+type UnfreezeAction_Create struct {
+	Create *UnfreezeCreate `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type UnfreezeAction_Withdraw struct {
+	Withdraw *UnfreezeWithdraw `protobuf:"bytes,2,opt,name=withdraw,proto3,oneof"`
+}
+
+type UnfreezeAction_Pause struct {
+	Pause *UnfreezePause `protobuf:"bytes,3,opt,name=pause,proto3,oneof"`
+}
+
+type UnfreezeAction_Resume struct {
+	Resume *UnfreezeResume `protobuf:"bytes,4,opt,name=resume,proto3,oneof"`
+}
+
+type UnfreezeAction_Terminate struct {
+	Terminate *UnfreezeTerminate `protobuf:"bytes,5,opt,name=terminate,proto3,oneof"`
+}
+
+func (*UnfreezeAction_Create) isUnfreezeAction_Value() {}
+
+func (*UnfreezeAction_Withdraw) isUnfreezeAction_Value() {}
+
+func (*UnfreezeAction_Pause) isUnfreezeAction_Value() {}
+
+func (*UnfreezeAction_Resume) isUnfreezeAction_Value() {}
+
+func (*UnfreezeAction_Terminate) isUnfreezeAction_Value() {}
+
+func (m *UnfreezeAction) GetValue() isUnfreezeAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *UnfreezeAction) GetCreate() *UnfreezeCreate {
+	if x, ok := m.GetValue().(*UnfreezeAction_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (m *UnfreezeAction) GetWithdraw() *UnfreezeWithdraw {
+	if x, ok := m.GetValue().(*UnfreezeAction_Withdraw); ok {
+		return x.Withdraw
+	}
+	return nil
+}
+
+func (m *UnfreezeAction) GetPause() *UnfreezePause {
+	if x, ok := m.GetValue().(*UnfreezeAction_Pause); ok {
+		return x.Pause
+	}
+	return nil
+}
+
+func (m *UnfreezeAction) GetResume() *UnfreezeResume {
+	if x, ok := m.GetValue().(*UnfreezeAction_Resume); ok {
+		return x.Resume
+	}
+	return nil
+}
+
+func (m *UnfreezeAction) GetTerminate() *UnfreezeTerminate {
+	if x, ok := m.GetValue().(*UnfreezeAction_Terminate); ok {
+		return x.Terminate
+	}
+	return nil
+}
+
+func (m *UnfreezeAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*UnfreezeAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _UnfreezeAction_OneofMarshaler, _UnfreezeAction_OneofUnmarshaler, _UnfreezeAction_OneofSizer, []interface{}{
+		(*UnfreezeAction_Create)(nil),
+		(*UnfreezeAction_Withdraw)(nil),
+		(*UnfreezeAction_Pause)(nil),
+		(*UnfreezeAction_Resume)(nil),
+		(*UnfreezeAction_Terminate)(nil),
+	}
+}
+
+func _UnfreezeAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*UnfreezeAction)
+	// value
+	switch x := m.Value.(type) {
+	case *UnfreezeAction_Create:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Create); err != nil {
+			return err
+		}
+	case *UnfreezeAction_Withdraw:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Withdraw); err != nil {
+			return err
+		}
+	case *UnfreezeAction_Pause:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Pause); err != nil {
+			return err
+		}
+	case *UnfreezeAction_Resume:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Resume); err != nil {
+			return err
+		}
+	case *UnfreezeAction_Terminate:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Terminate); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("UnfreezeAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _UnfreezeAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*UnfreezeAction)
+	switch tag {
+	case 1: // value.create
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnfreezeCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &UnfreezeAction_Create{msg}
+		return true, err
+	case 2: // value.withdraw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnfreezeWithdraw)
+		err := b.DecodeMessage(msg)
+		m.Value = &UnfreezeAction_Withdraw{msg}
+		return true, err
+	case 3: // value.pause
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnfreezePause)
+		err := b.DecodeMessage(msg)
+		m.Value = &UnfreezeAction_Pause{msg}
+		return true, err
+	case 4: // value.resume
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnfreezeResume)
+		err := b.DecodeMessage(msg)
+		m.Value = &UnfreezeAction_Resume{msg}
+		return true, err
+	case 5: // value.terminate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnfreezeTerminate)
+		err := b.DecodeMessage(msg)
+		m.Value = &UnfreezeAction_Terminate{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _UnfreezeAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*UnfreezeAction)
+	// value
+	switch x := m.Value.(type) {
+	case *UnfreezeAction_Create:
+		s := proto.Size(x.Create)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UnfreezeAction_Withdraw:
+		s := proto.Size(x.Withdraw)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UnfreezeAction_Pause:
+		s := proto.Size(x.Pause)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UnfreezeAction_Resume:
+		s := proto.Size(x.Resume)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UnfreezeAction_Terminate:
+		s := proto.Size(x.Terminate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//付款人把amount锁定给受益人，从startAt开始每过period释放amountPerPeriod，直到全部释放
+//periodUnit为0的时候startAt和period按区块高度计算，为1的时候按区块时间的秒数计算
+//allowPause和allowTerminate是付款人暂停和终止的权限，创建以后不能修改
+//assetExec为空的时候锁定coins
+type UnfreezeCreate struct {
+	Beneficiary          string   `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	AssetExec            string   `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,3,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountPerPeriod      int64    `protobuf:"varint,5,opt,name=amountPerPeriod,proto3" json:"amountPerPeriod,omitempty"`
+	StartAt              int64    `protobuf:"varint,6,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	Period               int64    `protobuf:"varint,7,opt,name=period,proto3" json:"period,omitempty"`
+	PeriodUnit           int32    `protobuf:"varint,8,opt,name=periodUnit,proto3" json:"periodUnit,omitempty"`
+	AllowPause           bool     `protobuf:"varint,9,opt,name=allowPause,proto3" json:"allowPause,omitempty"`
+	AllowTerminate       bool     `protobuf:"varint,10,opt,name=allowTerminate,proto3" json:"allowTerminate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeCreate) Reset()         { *m = UnfreezeCreate{} }
+func (m *UnfreezeCreate) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeCreate) ProtoMessage()    {}
+func (*UnfreezeCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{1}
+}
+
+func (m *UnfreezeCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeCreate.Unmarshal(m, b)
+}
+func (m *UnfreezeCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeCreate.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeCreate.Merge(m, src)
+}
+func (m *UnfreezeCreate) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeCreate.Size(m)
+}
+func (m *UnfreezeCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeCreate proto.InternalMessageInfo
+
+func (m *UnfreezeCreate) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *UnfreezeCreate) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *UnfreezeCreate) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *UnfreezeCreate) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *UnfreezeCreate) GetAmountPerPeriod() int64 {
+	if m != nil {
+		return m.AmountPerPeriod
+	}
+	return 0
+}
+
+func (m *UnfreezeCreate) GetStartAt() int64 {
+	if m != nil {
+		return m.StartAt
+	}
+	return 0
+}
+
+func (m *UnfreezeCreate) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *UnfreezeCreate) GetPeriodUnit() int32 {
+	if m != nil {
+		return m.PeriodUnit
+	}
+	return 0
+}
+
+func (m *UnfreezeCreate) GetAllowPause() bool {
+	if m != nil {
+		return m.AllowPause
+	}
+	return false
+}
+
+func (m *UnfreezeCreate) GetAllowTerminate() bool {
+	if m != nil {
+		return m.AllowTerminate
+	}
+	return false
+}
+
+//受益人提取已经释放的部分，转到受益人在unfreeze合约中的账户
+type UnfreezeWithdraw struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeWithdraw) Reset()         { *m = UnfreezeWithdraw{} }
+func (m *UnfreezeWithdraw) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeWithdraw) ProtoMessage()    {}
+func (*UnfreezeWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{2}
+}
+
+func (m *UnfreezeWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeWithdraw.Unmarshal(m, b)
+}
+func (m *UnfreezeWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeWithdraw.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeWithdraw.Merge(m, src)
+}
+func (m *UnfreezeWithdraw) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeWithdraw.Size(m)
+}
+func (m *UnfreezeWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeWithdraw proto.InternalMessageInfo
+
+func (m *UnfreezeWithdraw) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+//付款人暂停释放，暂停期间不计入释放的周期
+type UnfreezePause struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezePause) Reset()         { *m = UnfreezePause{} }
+func (m *UnfreezePause) String() string { return proto.CompactTextString(m) }
+func (*UnfreezePause) ProtoMessage()    {}
+func (*UnfreezePause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{3}
+}
+
+func (m *UnfreezePause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezePause.Unmarshal(m, b)
+}
+func (m *UnfreezePause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezePause.Marshal(b, m, deterministic)
+}
+func (m *UnfreezePause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezePause.Merge(m, src)
+}
+func (m *UnfreezePause) XXX_Size() int {
+	return xxx_messageInfo_UnfreezePause.Size(m)
+}
+func (m *UnfreezePause) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezePause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezePause proto.InternalMessageInfo
+
+func (m *UnfreezePause) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UnfreezeResume struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeResume) Reset()         { *m = UnfreezeResume{} }
+func (m *UnfreezeResume) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeResume) ProtoMessage()    {}
+func (*UnfreezeResume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{4}
+}
+
+func (m *UnfreezeResume) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeResume.Unmarshal(m, b)
+}
+func (m *UnfreezeResume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeResume.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeResume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeResume.Merge(m, src)
+}
+func (m *UnfreezeResume) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeResume.Size(m)
+}
+func (m *UnfreezeResume) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeResume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeResume proto.InternalMessageInfo
+
+func (m *UnfreezeResume) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+//付款人终止释放，没有释放的部分退回付款人，已经释放的部分受益人仍然可以提取
+type UnfreezeTerminate struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeTerminate) Reset()         { *m = UnfreezeTerminate{} }
+func (m *UnfreezeTerminate) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeTerminate) ProtoMessage()    {}
+func (*UnfreezeTerminate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{5}
+}
+
+func (m *UnfreezeTerminate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeTerminate.Unmarshal(m, b)
+}
+func (m *UnfreezeTerminate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeTerminate.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeTerminate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeTerminate.Merge(m, src)
+}
+func (m *UnfreezeTerminate) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeTerminate.Size(m)
+}
+func (m *UnfreezeTerminate) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeTerminate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeTerminate proto.InternalMessageInfo
+
+func (m *UnfreezeTerminate) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type Unfreeze struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Beneficiary          string   `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	AssetExec            string   `protobuf:"bytes,4,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,5,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Amount               int64    `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountPerPeriod      int64    `protobuf:"varint,7,opt,name=amountPerPeriod,proto3" json:"amountPerPeriod,omitempty"`
+	StartAt              int64    `protobuf:"varint,8,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	Period               int64    `protobuf:"varint,9,opt,name=period,proto3" json:"period,omitempty"`
+	PeriodUnit           int32    `protobuf:"varint,10,opt,name=periodUnit,proto3" json:"periodUnit,omitempty"`
+	AllowPause           bool     `protobuf:"varint,11,opt,name=allowPause,proto3" json:"allowPause,omitempty"`
+	AllowTerminate       bool     `protobuf:"varint,12,opt,name=allowTerminate,proto3" json:"allowTerminate,omitempty"`
+	Withdrawn            int64    `protobuf:"varint,13,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	Paused               bool     `protobuf:"varint,14,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedAt             int64    `protobuf:"varint,15,opt,name=pausedAt,proto3" json:"pausedAt,omitempty"`
+	PausedDuration       int64    `protobuf:"varint,16,opt,name=pausedDuration,proto3" json:"pausedDuration,omitempty"`
+	Terminated           bool     `protobuf:"varint,17,opt,name=terminated,proto3" json:"terminated,omitempty"`
+	Refunded             int64    `protobuf:"varint,18,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	CreateHeight         int64    `protobuf:"varint,19,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	Addr                 string   `protobuf:"bytes,20,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Unfreeze) Reset()         { *m = Unfreeze{} }
+func (m *Unfreeze) String() string { return proto.CompactTextString(m) }
+func (*Unfreeze) ProtoMessage()    {}
+func (*Unfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{6}
+}
+
+func (m *Unfreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Unfreeze.Unmarshal(m, b)
+}
+func (m *Unfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Unfreeze.Marshal(b, m, deterministic)
+}
+func (m *Unfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unfreeze.Merge(m, src)
+}
+func (m *Unfreeze) XXX_Size() int {
+	return xxx_messageInfo_Unfreeze.Size(m)
+}
+func (m *Unfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unfreeze proto.InternalMessageInfo
+
+func (m *Unfreeze) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Unfreeze) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Unfreeze) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *Unfreeze) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *Unfreeze) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *Unfreeze) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetAmountPerPeriod() int64 {
+	if m != nil {
+		return m.AmountPerPeriod
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetStartAt() int64 {
+	if m != nil {
+		return m.StartAt
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetPeriodUnit() int32 {
+	if m != nil {
+		return m.PeriodUnit
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetAllowPause() bool {
+	if m != nil {
+		return m.AllowPause
+	}
+	return false
+}
+
+func (m *Unfreeze) GetAllowTerminate() bool {
+	if m != nil {
+		return m.AllowTerminate
+	}
+	return false
+}
+
+func (m *Unfreeze) GetWithdrawn() int64 {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Unfreeze) GetPausedAt() int64 {
+	if m != nil {
+		return m.PausedAt
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetPausedDuration() int64 {
+	if m != nil {
+		return m.PausedDuration
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetTerminated() bool {
+	if m != nil {
+		return m.Terminated
+	}
+	return false
+}
+
+func (m *Unfreeze) GetRefunded() int64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReceiptUnfreeze struct {
+	Prev                 *Unfreeze `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *Unfreeze `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReceiptUnfreeze) Reset()         { *m = ReceiptUnfreeze{} }
+func (m *ReceiptUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*ReceiptUnfreeze) ProtoMessage()    {}
+func (*ReceiptUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{7}
+}
+
+func (m *ReceiptUnfreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptUnfreeze.Unmarshal(m, b)
+}
+func (m *ReceiptUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptUnfreeze.Marshal(b, m, deterministic)
+}
+func (m *ReceiptUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptUnfreeze.Merge(m, src)
+}
+func (m *ReceiptUnfreeze) XXX_Size() int {
+	return xxx_messageInfo_ReceiptUnfreeze.Size(m)
+}
+func (m *ReceiptUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptUnfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptUnfreeze proto.InternalMessageInfo
+
+func (m *ReceiptUnfreeze) GetPrev() *Unfreeze {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptUnfreeze) GetCurrent() *Unfreeze {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+//按当前区块计算的释放情况，remaining是还锁定的部分
+type ReplyUnfreeze struct {
+	Unfreeze             *Unfreeze `protobuf:"bytes,1,opt,name=unfreeze,proto3" json:"unfreeze,omitempty"`
+	Height               int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime            int64     `protobuf:"varint,3,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	Released             int64     `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
+	Withdrawable         int64     `protobuf:"varint,5,opt,name=withdrawable,proto3" json:"withdrawable,omitempty"`
+	Remaining            int64     `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReplyUnfreeze) Reset()         { *m = ReplyUnfreeze{} }
+func (m *ReplyUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*ReplyUnfreeze) ProtoMessage()    {}
+func (*ReplyUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{8}
+}
+
+func (m *ReplyUnfreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyUnfreeze.Unmarshal(m, b)
+}
+func (m *ReplyUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyUnfreeze.Marshal(b, m, deterministic)
+}
+func (m *ReplyUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyUnfreeze.Merge(m, src)
+}
+func (m *ReplyUnfreeze) XXX_Size() int {
+	return xxx_messageInfo_ReplyUnfreeze.Size(m)
+}
+func (m *ReplyUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyUnfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyUnfreeze proto.InternalMessageInfo
+
+func (m *ReplyUnfreeze) GetUnfreeze() *Unfreeze {
+	if m != nil {
+		return m.Unfreeze
+	}
+	return nil
+}
+
+func (m *ReplyUnfreeze) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReplyUnfreeze) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *ReplyUnfreeze) GetReleased() int64 {
+	if m != nil {
+		return m.Released
+	}
+	return 0
+}
+
+func (m *ReplyUnfreeze) GetWithdrawable() int64 {
+	if m != nil {
+		return m.Withdrawable
+	}
+	return 0
+}
+
+func (m *ReplyUnfreeze) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+//按受益人或者付款人列出，两个都填的时候按受益人
+type ReqUnfreezes struct {
+	Beneficiary          string   `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqUnfreezes) Reset()         { *m = ReqUnfreezes{} }
+func (m *ReqUnfreezes) String() string { return proto.CompactTextString(m) }
+func (*ReqUnfreezes) ProtoMessage()    {}
+func (*ReqUnfreezes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{9}
+}
+
+func (m *ReqUnfreezes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqUnfreezes.Unmarshal(m, b)
+}
+func (m *ReqUnfreezes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqUnfreezes.Marshal(b, m, deterministic)
+}
+func (m *ReqUnfreezes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqUnfreezes.Merge(m, src)
+}
+func (m *ReqUnfreezes) XXX_Size() int {
+	return xxx_messageInfo_ReqUnfreezes.Size(m)
+}
+func (m *ReqUnfreezes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqUnfreezes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqUnfreezes proto.InternalMessageInfo
+
+func (m *ReqUnfreezes) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *ReqUnfreezes) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ReqUnfreezes) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqUnfreezes) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyUnfreezes struct {
+	Unfreezes            []*ReplyUnfreeze `protobuf:"bytes,1,rep,name=unfreezes,proto3" json:"unfreezes,omitempty"`
+	PrimaryKey           string           `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplyUnfreezes) Reset()         { *m = ReplyUnfreezes{} }
+func (m *ReplyUnfreezes) String() string { return proto.CompactTextString(m) }
+func (*ReplyUnfreezes) ProtoMessage()    {}
+func (*ReplyUnfreezes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{10}
+}
+
+func (m *ReplyUnfreezes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyUnfreezes.Unmarshal(m, b)
+}
+func (m *ReplyUnfreezes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyUnfreezes.Marshal(b, m, deterministic)
+}
+func (m *ReplyUnfreezes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyUnfreezes.Merge(m, src)
+}
+func (m *ReplyUnfreezes) XXX_Size() int {
+	return xxx_messageInfo_ReplyUnfreezes.Size(m)
+}
+func (m *ReplyUnfreezes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyUnfreezes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyUnfreezes proto.InternalMessageInfo
+
+func (m *ReplyUnfreezes) GetUnfreezes() []*ReplyUnfreeze {
+	if m != nil {
+		return m.Unfreezes
+	}
+	return nil
+}
+
+func (m *ReplyUnfreezes) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*UnfreezeAction)(nil), "types.UnfreezeAction")
+	proto.RegisterType((*UnfreezeCreate)(nil), "types.UnfreezeCreate")
+	proto.RegisterType((*UnfreezeWithdraw)(nil), "types.UnfreezeWithdraw")
+	proto.RegisterType((*UnfreezePause)(nil), "types.UnfreezePause")
+	proto.RegisterType((*UnfreezeResume)(nil), "types.UnfreezeResume")
+	proto.RegisterType((*UnfreezeTerminate)(nil), "types.UnfreezeTerminate")
+	proto.RegisterType((*Unfreeze)(nil), "types.Unfreeze")
+	proto.RegisterType((*ReceiptUnfreeze)(nil), "types.ReceiptUnfreeze")
+	proto.RegisterType((*ReplyUnfreeze)(nil), "types.ReplyUnfreeze")
+	proto.RegisterType((*ReqUnfreezes)(nil), "types.ReqUnfreezes")
+	proto.RegisterType((*ReplyUnfreezes)(nil), "types.ReplyUnfreezes")
+}
+
+func init() { proto.RegisterFile("unfreeze.proto", fileDescriptor_6caa0554cb0b9167) }
+
+var fileDescriptor_6caa0554cb0b9167 = []byte{
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xcd, 0x9f, 0x93, 0x78, 0xda, 0x26, 0xed, 0x7e, 0xfd, 0x60, 0x85, 0x10, 0x44, 0xae, 0x84,
+	0x82, 0x40, 0x45, 0x2a, 0x42, 0xe2, 0xb6, 0xfc, 0x48, 0x91, 0xb8, 0xa9, 0x96, 0x56, 0x5c, 0x6f,
+	0xec, 0x69, 0xbb, 0xc2, 0xb1, 0xc3, 0x7a, 0xdd, 0x12, 0xae, 0x78, 0x1e, 0x1e, 0x06, 0xf1, 0x08,
+	0x3c, 0x0a, 0xda, 0x5d, 0xaf, 0x9d, 0x38, 0x6d, 0xd3, 0xbb, 0x9d, 0x33, 0x67, 0xf6, 0xe7, 0xcc,
+	0xf1, 0x18, 0x06, 0x79, 0x72, 0x2e, 0x11, 0x7f, 0xe0, 0xe1, 0x5c, 0xa6, 0x2a, 0x25, 0x9e, 0x5a,
+	0xcc, 0x31, 0x0b, 0x7e, 0xb5, 0x60, 0x70, 0x56, 0x64, 0x8e, 0x43, 0x25, 0xd2, 0x84, 0xbc, 0x82,
+	0x6e, 0x28, 0x91, 0x2b, 0xa4, 0xcd, 0x51, 0x73, 0xbc, 0x75, 0xf4, 0xff, 0xa1, 0xa1, 0x1e, 0x3a,
+	0xda, 0x7b, 0x93, 0x9c, 0x34, 0x58, 0x41, 0x23, 0x6f, 0xa0, 0x7f, 0x2d, 0xd4, 0x65, 0x24, 0xf9,
+	0x35, 0x6d, 0x99, 0x92, 0x87, 0xb5, 0x92, 0x2f, 0x45, 0x7a, 0xd2, 0x60, 0x25, 0x95, 0xbc, 0x04,
+	0x6f, 0xce, 0xf3, 0x0c, 0x69, 0xdb, 0xd4, 0xec, 0xd7, 0x6a, 0x4e, 0x74, 0x6e, 0xd2, 0x60, 0x96,
+	0xa4, 0x6f, 0x25, 0x31, 0xcb, 0x67, 0x48, 0x3b, 0x37, 0xde, 0x8a, 0x99, 0xa4, 0xbe, 0x95, 0xa5,
+	0x91, 0xb7, 0xe0, 0x2b, 0x94, 0x33, 0x91, 0xe8, 0x97, 0x78, 0xa6, 0x86, 0xd6, 0x6a, 0x4e, 0x5d,
+	0x7e, 0xd2, 0x60, 0x15, 0x99, 0x0c, 0xa0, 0xa5, 0x16, 0xb4, 0x3b, 0x6a, 0x8e, 0x3d, 0xd6, 0x52,
+	0x8b, 0x77, 0x3d, 0xf0, 0xae, 0x78, 0x9c, 0x63, 0xf0, 0x7b, 0x49, 0x2c, 0xab, 0x02, 0x19, 0xc1,
+	0xd6, 0x14, 0x13, 0x3c, 0x17, 0xa1, 0xe0, 0x72, 0x61, 0x14, 0xf3, 0xd9, 0x32, 0x44, 0x1e, 0x83,
+	0xcf, 0xb3, 0x0c, 0xd5, 0xc7, 0xef, 0x18, 0x1a, 0x79, 0x7c, 0x56, 0x01, 0xba, 0xde, 0x04, 0x9f,
+	0x17, 0xb3, 0x69, 0x1a, 0x1b, 0x29, 0x7c, 0xb6, 0x0c, 0x91, 0x07, 0xd0, 0xe5, 0xb3, 0x34, 0x4f,
+	0x94, 0x79, 0x78, 0x9b, 0x15, 0x11, 0x19, 0xc3, 0xd0, 0xae, 0x4e, 0x50, 0x9e, 0xa0, 0x14, 0x69,
+	0x64, 0x5e, 0xd9, 0x66, 0x75, 0x98, 0x50, 0xe8, 0x65, 0x8a, 0x4b, 0x75, 0xac, 0xcc, 0xa3, 0xda,
+	0xcc, 0x85, 0x7a, 0xef, 0xb9, 0x2d, 0xed, 0xd9, 0xbd, 0x6d, 0x44, 0x9e, 0x00, 0xd8, 0xd5, 0x59,
+	0x22, 0x14, 0xed, 0x1b, 0x25, 0x96, 0x10, 0x9d, 0xe7, 0x71, 0x9c, 0x5e, 0x9b, 0x1e, 0x51, 0x7f,
+	0xd4, 0x1c, 0xf7, 0xd9, 0x12, 0x42, 0x9e, 0xc1, 0xc0, 0x44, 0xa5, 0xc0, 0x14, 0x0c, 0xa7, 0x86,
+	0x06, 0x01, 0xec, 0xd6, 0x2d, 0xa2, 0xd5, 0x17, 0x51, 0x21, 0x64, 0x4b, 0x44, 0xc1, 0x53, 0xd8,
+	0x59, 0xb1, 0xc4, 0x1a, 0x61, 0x54, 0x35, 0xc5, 0x9a, 0x60, 0x8d, 0x71, 0x00, 0x7b, 0x6b, 0x2d,
+	0x5f, 0x23, 0xfd, 0xed, 0x40, 0xdf, 0xb1, 0xea, 0x49, 0x2d, 0xa1, 0x31, 0x7b, 0x2a, 0x8b, 0x16,
+	0xba, 0xb0, 0x6e, 0x80, 0xf6, 0x06, 0x03, 0x74, 0x36, 0x18, 0xc0, 0xbb, 0xcb, 0x00, 0xdd, 0x4d,
+	0x06, 0xe8, 0x6d, 0x34, 0x40, 0xff, 0x36, 0x03, 0xf8, 0x77, 0x18, 0x00, 0x36, 0x18, 0x60, 0xeb,
+	0x1e, 0x06, 0xd8, 0xbe, 0xc9, 0x00, 0x5a, 0x1b, 0x37, 0x0f, 0x12, 0xba, 0x63, 0xae, 0x50, 0x01,
+	0xe6, 0x76, 0x7a, 0xbb, 0x88, 0x0e, 0x4c, 0x75, 0x11, 0x91, 0x47, 0xd0, 0xb7, 0xab, 0x63, 0x45,
+	0x87, 0xa6, 0xa8, 0x8c, 0xf5, 0xc9, 0x76, 0xfd, 0x21, 0x97, 0x5c, 0xcf, 0x33, 0xba, 0x6b, 0x18,
+	0x35, 0x54, 0xbf, 0xa0, 0xfc, 0xe2, 0x23, 0xba, 0x67, 0x5f, 0x50, 0x21, 0xfa, 0x0c, 0x89, 0xe7,
+	0x79, 0x12, 0x61, 0x44, 0x89, 0x3d, 0xc3, 0xc5, 0x24, 0x80, 0x6d, 0x3b, 0xfa, 0x26, 0x28, 0x2e,
+	0x2e, 0x15, 0xfd, 0xcf, 0xe4, 0x57, 0x30, 0x42, 0xa0, 0xc3, 0xa3, 0x48, 0xd2, 0x7d, 0xd3, 0x50,
+	0xb3, 0x0e, 0x38, 0x0c, 0x19, 0x86, 0x28, 0xe6, 0xaa, 0x34, 0xda, 0x01, 0x74, 0xe6, 0x12, 0xaf,
+	0x8a, 0x51, 0x3b, 0xac, 0x0f, 0x35, 0x93, 0x24, 0xcf, 0xa1, 0x17, 0xe6, 0x52, 0x62, 0xa2, 0x8a,
+	0xf9, 0xba, 0xc6, 0x73, 0xf9, 0xe0, 0x4f, 0x13, 0x76, 0x18, 0xce, 0xe3, 0x45, 0x79, 0xc2, 0x0b,
+	0xe8, 0xbb, 0xd1, 0x7f, 0xdb, 0x29, 0x25, 0x41, 0x2b, 0x7e, 0x69, 0xdf, 0xd4, 0xb2, 0x7e, 0xb0,
+	0x91, 0xee, 0xd3, 0x34, 0x4e, 0xc3, 0xaf, 0xa7, 0x62, 0x66, 0xe7, 0x75, 0x9b, 0x55, 0x80, 0xd5,
+	0x2a, 0x46, 0xae, 0x3b, 0xd5, 0x71, 0x5a, 0xd9, 0x58, 0x6b, 0xe5, 0x1a, 0xca, 0xa7, 0x31, 0x16,
+	0x33, 0x6a, 0x05, 0xd3, 0xbb, 0x4b, 0x9c, 0x71, 0x91, 0x88, 0xe4, 0xa2, 0x30, 0x79, 0x05, 0x04,
+	0x3f, 0x9b, 0xb0, 0xcd, 0xf0, 0x9b, 0xbb, 0x6d, 0x76, 0x8f, 0x99, 0x7b, 0xfb, 0xe7, 0xaa, 0x8d,
+	0x2d, 0xc5, 0x8c, 0xcb, 0xc5, 0x27, 0x74, 0x5f, 0xeb, 0x12, 0x42, 0xf6, 0xc1, 0x0b, 0xcb, 0x61,
+	0xeb, 0x31, 0x1b, 0x04, 0x11, 0x0c, 0x56, 0x44, 0xcd, 0xc8, 0x11, 0xf8, 0x4e, 0xb4, 0x8c, 0x36,
+	0x47, 0xed, 0xa5, 0x1f, 0xd8, 0x0a, 0x93, 0x55, 0xb4, 0xda, 0xd9, 0xad, 0xfa, 0xd9, 0xd3, 0xae,
+	0xf9, 0x33, 0xbf, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x06, 0x83, 0x8f, 0xab, 0x07, 0x00,
+	0x00,
+}
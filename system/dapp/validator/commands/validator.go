@@ -0,0 +1,191 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands validator插件命令
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/system/crypto/bls"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	vty "github.com/33cn/chain33/system/dapp/validator/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// ValidatorCmd validator command
+func ValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator",
+		Short: "Consensus validator management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		AddCmd(),
+		ProveCmd(),
+		RemoveCmd(),
+		UpdatePowerCmd(),
+		UnjailCmd(),
+		ListCmd(),
+	)
+
+	return cmd
+}
+
+func addPowerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("pubkey", "k", "", "validator public key(hex)")
+	cmd.MarkFlagRequired("pubkey")
+	cmd.Flags().Int64P("power", "p", 0, "voting power")
+	cmd.MarkFlagRequired("power")
+}
+
+// AddCmd add validator
+func AddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Create a transaction to add validator, stake is frozen when not sent by manager",
+		Run:   add,
+	}
+	addPowerFlags(cmd)
+	cmd.Flags().StringP("proof", "r", "", "proof of possession(hex), required for bls public key")
+	return cmd
+}
+
+func add(cmd *cobra.Command, args []string) {
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	power, _ := cmd.Flags().GetInt64("power")
+	proofHex, _ := cmd.Flags().GetString("proof")
+	proof, err := common.FromHex(proofHex)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	commandtypes.CreateActionTx(cmd, vty.ValidatorX, &vty.ValidatorAction{
+		Ty:    vty.ValidatorActionAdd,
+		Value: &vty.ValidatorAction_Add{Add: &vty.ValidatorAdd{PubKey: pubkey, Power: power, Proof: proof}},
+	})
+}
+
+// ProveCmd sign proof of possession for bls validator key
+func ProveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove",
+		Short: "Sign the proof of possession of a bls validator key, needed when adding the validator",
+		Run:   prove,
+	}
+	cmd.Flags().StringP("key", "k", "", "bls private key(hex)")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func prove(cmd *cobra.Command, args []string) {
+	key, _ := cmd.Flags().GetString("key")
+	data, err := common.FromHex(key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv, err := bls.Driver{}.PrivKeyFromBytes(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	proof, err := bls.ProvePossession(priv)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	result := &commandtypes.ValidatorProofResult{PubKey: common.ToHex(priv.PubKey().Bytes()), Proof: common.ToHex(proof.Bytes())}
+	jsonclient.PrintResult(result)
+}
+
+// RemoveCmd remove validator
+func RemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Create a transaction to remove validator",
+		Run:   remove,
+	}
+	cmd.Flags().StringP("pubkey", "k", "", "validator public key(hex)")
+	cmd.MarkFlagRequired("pubkey")
+	return cmd
+}
+
+func remove(cmd *cobra.Command, args []string) {
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	commandtypes.CreateActionTx(cmd, vty.ValidatorX, &vty.ValidatorAction{
+		Ty:    vty.ValidatorActionRemove,
+		Value: &vty.ValidatorAction_Remove{Remove: &vty.ValidatorRemove{PubKey: pubkey}},
+	})
+}
+
+// UpdatePowerCmd update validator power
+func UpdatePowerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update_power",
+		Short: "Create a transaction to update validator voting power",
+		Run:   updatePower,
+	}
+	addPowerFlags(cmd)
+	return cmd
+}
+
+func updatePower(cmd *cobra.Command, args []string) {
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	power, _ := cmd.Flags().GetInt64("power")
+	commandtypes.CreateActionTx(cmd, vty.ValidatorX, &vty.ValidatorAction{
+		Ty:    vty.ValidatorActionUpdatePower,
+		Value: &vty.ValidatorAction_UpdatePower{UpdatePower: &vty.ValidatorUpdatePower{PubKey: pubkey, Power: power}},
+	})
+}
+
+// UnjailCmd unjail validator
+func UnjailCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Short: "Create a transaction to restore jailed validator after jail period",
+		Run:   unjail,
+	}
+	cmd.Flags().StringP("pubkey", "k", "", "validator public key(hex)")
+	cmd.MarkFlagRequired("pubkey")
+	return cmd
+}
+
+func unjail(cmd *cobra.Command, args []string) {
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	commandtypes.CreateActionTx(cmd, vty.ValidatorX, &vty.ValidatorAction{
+		Ty:    vty.ValidatorActionUnjail,
+		Value: &vty.ValidatorAction_Unjail{Unjail: &vty.ValidatorUnjail{PubKey: pubkey}},
+	})
+}
+
+// ListCmd list validators
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Query current validators",
+		Run:   list,
+	}
+	return cmd
+}
+
+func list(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, vty.ValidatorX)
+	params.FuncName = vty.FuncNameGetValidators
+	params.Payload = types.MustPBToJSON(&types.ReqNil{})
+
+	var res vty.ValidatorSet
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -0,0 +1,134 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	tmt "github.com/33cn/chain33/system/consensus/tendermint/types"
+	vty "github.com/33cn/chain33/system/dapp/validator/types"
+	"github.com/33cn/chain33/types"
+)
+
+var evidenceKeyPrefix = "mavl-" + vty.ValidatorX + "-evidence-"
+
+func calcEvidenceKey(pubkey string, vote *tmt.TendermintVote) []byte {
+	return []byte(fmt.Sprintf("%s%s-%d-%d-%d", evidenceKeyPrefix, pubkey, vote.Height, vote.Round, vote.Type))
+}
+
+//decodeVote 解码投票并且检查签名，返回签名的公钥
+func decodeVote(data []byte) (*tmt.TendermintVote, string, error) {
+	var msg tmt.TendermintMessage
+	if err := types.Decode(data, &msg); err != nil {
+		return nil, "", err
+	}
+	vote := msg.GetVote()
+	sig := msg.GetSig()
+	if vote == nil || sig == nil {
+		return nil, "", vty.ErrEvidence
+	}
+	msg.Sig = nil
+	if !types.CheckSign(types.Encode(&msg), "", sig) {
+		return nil, "", types.ErrSign
+	}
+	return vote, common.ToHex(sig.Pubkey), nil
+}
+
+//checkEvidence 同一个验证节点在同一高度同一轮，对不同的区块投了同一种类型的票
+func checkEvidence(payload *vty.ValidatorEvidence) (string, *tmt.TendermintVote, error) {
+	voteA, pubA, err := decodeVote(payload.VoteA)
+	if err != nil {
+		return "", nil, err
+	}
+	voteB, pubB, err := decodeVote(payload.VoteB)
+	if err != nil {
+		return "", nil, err
+	}
+	if pubA != pubB || voteA.Height != voteB.Height || voteA.Round != voteB.Round || voteA.Type != voteB.Type {
+		return "", nil, vty.ErrEvidence
+	}
+	if bytes.Equal(voteA.BlockHash, voteB.BlockHash) {
+		return "", nil, vty.ErrEvidence
+	}
+	return pubA, voteA, nil
+}
+
+func (a *Action) evidence(payload *vty.ValidatorEvidence) (*types.Receipt, error) {
+	pubkey, vote, err := checkEvidence(payload)
+	if err != nil {
+		return nil, err
+	}
+	if vote.Height > a.height {
+		return nil, vty.ErrEvidence
+	}
+	key := calcEvidenceKey(pubkey, vote)
+	if value, err := a.db.Get(key); err == nil && value != nil {
+		return nil, vty.ErrEvidenceExist
+	}
+	set, err := getValidatorSet(a.db)
+	if err != nil {
+		return nil, err
+	}
+	index := findValidator(set, pubkey)
+	if index < 0 {
+		return nil, vty.ErrValidatorNotExist
+	}
+	prev := set.Validators[index]
+	current := *prev
+	current.Jailed = true
+	current.JailUntil = a.height + getJailBlocks()
+	receipt := &types.Receipt{}
+	//罚没的coins奖励给提交证据的地址
+	slash := current.Stake * getSlashRate() / 100
+	if slash > 0 {
+		receipt, err = a.coinsAccount.ExecTransferFrozen(current.Owner, a.fromaddr, a.execaddr, slash)
+		if err != nil {
+			clog.Error("validator slash", "owner", current.Owner, "slash", slash, "err", err)
+			return nil, err
+		}
+		current.Stake -= slash
+		current.Slashed += slash
+	}
+	set.Validators[index] = &current
+	evidenceKV := &types.KeyValue{Key: key, Value: types.Encode(payload)}
+	a.db.Set(evidenceKV.Key, evidenceKV.Value)
+	kv := append(receipt.KV, a.saveValidatorSet(set), evidenceKV)
+	logs := append(receipt.Logs, validatorReceipt(vty.TyLogValidatorSlash, prev, &current))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) unjail(payload *vty.ValidatorUnjail) (*types.Receipt, error) {
+	pubkey, err := formatPubKey(payload.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	set, err := getValidatorSet(a.db)
+	if err != nil {
+		return nil, err
+	}
+	index := findValidator(set, pubkey)
+	if index < 0 {
+		return nil, vty.ErrValidatorNotExist
+	}
+	prev := set.Validators[index]
+	if err := a.checkPermission(prev); err != nil {
+		return nil, err
+	}
+	if !prev.Jailed {
+		return nil, vty.ErrValidatorNotJailed
+	}
+	if a.height < prev.JailUntil {
+		return nil, vty.ErrValidatorJailing
+	}
+	current := *prev
+	current.Jailed = false
+	current.JailUntil = 0
+	set.Validators[index] = &current
+	kv := []*types.KeyValue{a.saveValidatorSet(set)}
+	log := validatorReceipt(vty.TyLogValidatorUnjail, prev, &current)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}, nil
+}
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	vty "github.com/33cn/chain33/system/dapp/validator/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Add 增加验证节点
+func (v *Validator) Exec_Add(payload *vty.ValidatorAdd, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(v, tx, index)
+	return action.add(payload)
+}
+
+// Exec_Remove 删除验证节点
+func (v *Validator) Exec_Remove(payload *vty.ValidatorRemove, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(v, tx, index)
+	return action.remove(payload)
+}
+
+// Exec_UpdatePower 修改验证节点的投票权重
+func (v *Validator) Exec_UpdatePower(payload *vty.ValidatorUpdatePower, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(v, tx, index)
+	return action.updatePower(payload)
+}
+
+// Exec_Evidence 提交验证节点重复投票的证据
+func (v *Validator) Exec_Evidence(payload *vty.ValidatorEvidence, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(v, tx, index)
+	return action.evidence(payload)
+}
+
+// Exec_Unjail 恢复被移出的验证节点
+func (v *Validator) Exec_Unjail(payload *vty.ValidatorUnjail, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(v, tx, index)
+	return action.unjail(payload)
+}
@@ -0,0 +1,31 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetValidators 获取当前的验证节点，共识模块按父区块的状态调用
+func (v *Validator) Query_GetValidators(in *types.ReqNil) (types.Message, error) {
+	return getValidatorSet(v.GetStateDB())
+}
+
+// Query_GetValidator 获取验证节点信息
+func (v *Validator) Query_GetValidator(in *types.ReqString) (types.Message, error) {
+	pubkey, err := formatPubKey(in.Data)
+	if err != nil {
+		return nil, err
+	}
+	set, err := getValidatorSet(v.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	index := findValidator(set, pubkey)
+	if index < 0 {
+		return nil, types.ErrNotFound
+	}
+	return set.Validators[index], nil
+}
@@ -0,0 +1,96 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor validator执行器，负责bft共识验证节点的增加，删除和投票权重的修改
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	vty "github.com/33cn/chain33/system/dapp/validator/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.validator")
+	driverName = vty.ValidatorX
+	conf       = types.ConfSub(driverName)
+	manageConf = types.ConfSub("manage")
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Validator{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newValidator, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return validator name
+func GetName() string {
+	return newValidator().GetName()
+}
+
+// Validator defines Validator object
+type Validator struct {
+	drivers.DriverBase
+}
+
+func newValidator() drivers.Driver {
+	v := &Validator{}
+	v.SetChild(v)
+	v.SetExecutorType(types.LoadExecutorType(driverName))
+	return v
+}
+
+// GetDriverName return a drivername
+func (v *Validator) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx check transaction
+func (v *Validator) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (v *Validator) CheckReceiptExecOk() bool {
+	return true
+}
+
+//isManager manage合约的超级管理员可以直接管理验证节点
+func isManager(addr string) bool {
+	for _, m := range manageConf.GStrList("superManager") {
+		if addr == m {
+			return true
+		}
+	}
+	return false
+}
+
+//getStakePerPower 每一份投票权重需要质押的coins，没有配置的时候只有管理员能增加验证节点
+func getStakePerPower() int64 {
+	if _, err := conf.G("stakePerPower"); err != nil {
+		return 0
+	}
+	return conf.GInt("stakePerPower")
+}
+
+//getSlashRate 作恶的时候罚没质押的百分比，罚没的coins奖励给提交证据的地址
+func getSlashRate() int64 {
+	if _, err := conf.G("slashRate"); err != nil {
+		return vty.DefaultSlashRate
+	}
+	return conf.GInt("slashRate")
+}
+
+//getJailBlocks 作恶的验证节点被移出的区块数
+func getJailBlocks() int64 {
+	if _, err := conf.G("jailBlocks"); err != nil {
+		return vty.DefaultJailBlocks
+	}
+	return conf.GInt("jailBlocks")
+}
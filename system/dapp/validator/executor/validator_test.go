@@ -0,0 +1,192 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	tmt "github.com/33cn/chain33/system/consensus/tendermint/types"
+	"github.com/33cn/chain33/system/crypto/bls"
+	vty "github.com/33cn/chain33/system/dapp/validator/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendValidatorTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) int32 {
+	_, detail, err := mock33.SendCallTx(priv, vty.ValidatorX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty
+}
+
+func getValidators(t *testing.T, mock33 *testnode.Chain33Mock) []*vty.ValidatorInfo {
+	msg, err := mock33.GetAPI().Query(vty.ValidatorX, vty.FuncNameGetValidators, &types.ReqNil{})
+	assert.Nil(t, err)
+	return msg.(*vty.ValidatorSet).Validators
+}
+
+func genPubKey(t *testing.T) string {
+	cr, err := crypto.New("ed25519")
+	assert.Nil(t, err)
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	return common.ToHex(priv.PubKey().Bytes())
+}
+
+func TestValidatorManage(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	//manage合约的超级管理员
+	manager := util.TestPrivkeyList[0]
+	mock33.SendTx(util.CreateCoinsTx(genesis, address.PubKeyToAddress(manager.PubKey().Bytes()).String(), 100*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	pub1 := genPubKey(t)
+
+	ty := sendValidatorTx(t, mock33, manager, "Add", &vty.ValidatorAdd{PubKey: pub1, Power: 10})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendValidatorTx(t, mock33, manager, "Add", &vty.ValidatorAdd{PubKey: pub1, Power: 10})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendValidatorTx(t, mock33, manager, "Add", &vty.ValidatorAdd{PubKey: "0x1234", Power: 10})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendValidatorTx(t, mock33, manager, "UpdatePower", &vty.ValidatorUpdatePower{PubKey: pub1, Power: 20})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	validators := getValidators(t, mock33)
+	assert.Equal(t, 1, len(validators))
+	assert.Equal(t, int64(20), validators[0].Power)
+	assert.Equal(t, "", validators[0].Owner)
+
+	//普通用户质押coins增加验证节点
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	user, err := cr.GenKey()
+	assert.Nil(t, err)
+	userAddr := address.PubKeyToAddress(user.PubKey().Bytes()).String()
+	mock33.SendTx(util.CreateCoinsTx(genesis, userAddr, 100*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(user, address.ExecAddress(vty.ValidatorX), 50*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	pub2 := genPubKey(t)
+	ty = sendValidatorTx(t, mock33, user, "Add", &vty.ValidatorAdd{PubKey: pub2, Power: 100})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendValidatorTx(t, mock33, user, "Add", &vty.ValidatorAdd{PubKey: pub2, Power: 30})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	acc := mock33.GetExecAccount(mock33.GetLastBlock().StateHash, vty.ValidatorX, userAddr)
+	assert.Equal(t, 30*types.Coin, acc.Frozen)
+	ty = sendValidatorTx(t, mock33, user, "UpdatePower", &vty.ValidatorUpdatePower{PubKey: pub2, Power: 10})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	acc = mock33.GetExecAccount(mock33.GetLastBlock().StateHash, vty.ValidatorX, userAddr)
+	assert.Equal(t, 10*types.Coin, acc.Frozen)
+
+	//普通用户不能修改其他的验证节点
+	ty = sendValidatorTx(t, mock33, user, "Remove", &vty.ValidatorRemove{PubKey: pub1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	msg, err := mock33.GetAPI().Query(vty.ValidatorX, vty.FuncNameGetValidator, &types.ReqString{Data: pub2})
+	assert.Nil(t, err)
+	assert.Equal(t, userAddr, msg.(*vty.ValidatorInfo).Owner)
+
+	//管理员删除质押的验证节点，质押的coins解冻
+	ty = sendValidatorTx(t, mock33, manager, "Remove", &vty.ValidatorRemove{PubKey: pub2})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	acc = mock33.GetExecAccount(mock33.GetLastBlock().StateHash, vty.ValidatorX, userAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, 50*types.Coin, acc.Balance)
+	validators = getValidators(t, mock33)
+	assert.Equal(t, 1, len(validators))
+	assert.Equal(t, pub1, validators[0].PubKey)
+
+	//bls公钥必须带有持有证明
+	blsKeys := make([]crypto.PrivKey, 2)
+	for i := range blsKeys {
+		blsKeys[i], err = bls.Driver{}.GenKey()
+		assert.Nil(t, err)
+	}
+	blsPub := common.ToHex(blsKeys[0].PubKey().Bytes())
+	ty = sendValidatorTx(t, mock33, manager, "Add", &vty.ValidatorAdd{PubKey: blsPub, Power: 10})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	otherProof, err := bls.ProvePossession(blsKeys[1])
+	assert.Nil(t, err)
+	ty = sendValidatorTx(t, mock33, manager, "Add", &vty.ValidatorAdd{PubKey: blsPub, Power: 10, Proof: otherProof.Bytes()})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	proof, err := bls.ProvePossession(blsKeys[0])
+	assert.Nil(t, err)
+	ty = sendValidatorTx(t, mock33, manager, "Add", &vty.ValidatorAdd{PubKey: blsPub, Power: 10, Proof: proof.Bytes()})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	validators = getValidators(t, mock33)
+	assert.Equal(t, 2, len(validators))
+	assert.Equal(t, blsPub, validators[1].PubKey)
+}
+
+func signVote(priv crypto.PrivKey, vote *tmt.TendermintVote) []byte {
+	msg := &tmt.TendermintMessage{Value: &tmt.TendermintMessage_Vote{Vote: vote}}
+	msg.Sig = &types.Signature{
+		Ty:        types.ED25519,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(types.Encode(msg)).Bytes(),
+	}
+	return types.Encode(msg)
+}
+
+func TestValidatorEvidence(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	genesis := mock33.GetGenesisKey()
+	genesisAddr := mock33.GetGenesisAddress()
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	user, err := cr.GenKey()
+	assert.Nil(t, err)
+	userAddr := address.PubKeyToAddress(user.PubKey().Bytes()).String()
+	mock33.SendTx(util.CreateCoinsTx(genesis, userAddr, 100*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(user, address.ExecAddress(vty.ValidatorX), 50*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	edcr, err := crypto.New("ed25519")
+	assert.Nil(t, err)
+	validator, err := edcr.GenKey()
+	assert.Nil(t, err)
+	pubkey := common.ToHex(validator.PubKey().Bytes())
+	ty := sendValidatorTx(t, mock33, user, "Add", &vty.ValidatorAdd{PubKey: pubkey, Power: 30})
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	voteA := signVote(validator, &tmt.TendermintVote{Height: 1, Type: tmt.VoteTypePrevote, BlockHash: []byte("a")})
+	voteB := signVote(validator, &tmt.TendermintVote{Height: 1, Type: tmt.VoteTypePrevote, BlockHash: []byte("b")})
+	voteC := signVote(validator, &tmt.TendermintVote{Height: 1, Type: tmt.VoteTypePrecommit, BlockHash: []byte("b")})
+	//不同类型的投票不是冲突的投票
+	ty = sendValidatorTx(t, mock33, genesis, "Evidence", &vty.ValidatorEvidence{VoteA: voteA, VoteB: voteC})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendValidatorTx(t, mock33, genesis, "Evidence", &vty.ValidatorEvidence{VoteA: voteA, VoteB: voteA})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendValidatorTx(t, mock33, genesis, "Evidence", &vty.ValidatorEvidence{VoteA: voteA, VoteB: voteB})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty = sendValidatorTx(t, mock33, genesis, "Evidence", &vty.ValidatorEvidence{VoteA: voteB, VoteB: voteA})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//罚没10%的质押给提交证据的地址，验证节点被移出
+	stateHash := mock33.GetLastBlock().StateHash
+	acc := mock33.GetExecAccount(stateHash, vty.ValidatorX, userAddr)
+	assert.Equal(t, 27*types.Coin, acc.Frozen)
+	acc = mock33.GetExecAccount(stateHash, vty.ValidatorX, genesisAddr)
+	assert.Equal(t, 3*types.Coin, acc.Balance)
+	info := getValidators(t, mock33)[0]
+	assert.True(t, info.Jailed)
+	assert.Equal(t, 3*types.Coin, info.Slashed)
+
+	ty = sendValidatorTx(t, mock33, user, "Unjail", &vty.ValidatorUnjail{PubKey: pubkey})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty = sendValidatorTx(t, mock33, user, "Unjail", &vty.ValidatorUnjail{PubKey: pubkey})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.False(t, getValidators(t, mock33)[0].Jailed)
+}
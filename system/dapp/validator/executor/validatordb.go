@@ -0,0 +1,243 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/crypto/bls"
+	vty "github.com/33cn/chain33/system/dapp/validator/types"
+	"github.com/33cn/chain33/types"
+)
+
+var validatorSetKey = []byte("mavl-" + vty.ValidatorX + "-set")
+
+// Action validator交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(v *Validator, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: v.GetCoinsAccount(),
+		db:           v.GetStateDB(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       v.GetHeight(),
+		index:        index,
+	}
+}
+
+//formatPubKey 公钥统一保存为0x开头的hex格式，和共识模块的配置一致
+func formatPubKey(pubkey string) (string, error) {
+	data, err := common.FromHex(pubkey)
+	if err != nil {
+		return "", vty.ErrValidatorPubKey
+	}
+	//ed25519 32字节，secp256k1 压缩格式33字节，非压缩格式65字节，bls 48字节
+	if len(data) != 32 && len(data) != 33 && len(data) != 65 && len(data) != bls.PubKeyLength {
+		return "", vty.ErrValidatorPubKey
+	}
+	return common.ToHex(data), nil
+}
+
+//checkProof bls的验证节点对同一个区块的投票聚合成一个签名，增加的时候必须检查持有证明，
+//否则可以用其他验证节点的公钥构造恶意公钥，单独伪造通过检查的聚合签名
+func checkProof(pubkey string, proof []byte) error {
+	data, _ := common.FromHex(pubkey)
+	if len(data) != bls.PubKeyLength {
+		return nil
+	}
+	pub, err := bls.Driver{}.PubKeyFromBytes(data)
+	if err != nil {
+		return vty.ErrValidatorPubKey
+	}
+	sig, err := bls.Driver{}.SignatureFromBytes(proof)
+	if err != nil || !bls.VerifyPossession(pub, sig) {
+		return vty.ErrValidatorProof
+	}
+	return nil
+}
+
+func getValidatorSet(db dbm.KV) (*vty.ValidatorSet, error) {
+	value, err := db.Get(validatorSetKey)
+	if err != nil || value == nil {
+		return &vty.ValidatorSet{}, nil
+	}
+	var set vty.ValidatorSet
+	err = types.Decode(value, &set)
+	if err != nil {
+		return nil, err
+	}
+	return &set, nil
+}
+
+func findValidator(set *vty.ValidatorSet, pubkey string) int {
+	for i, v := range set.Validators {
+		if v.PubKey == pubkey {
+			return i
+		}
+	}
+	return -1
+}
+
+func (a *Action) saveValidatorSet(set *vty.ValidatorSet) *types.KeyValue {
+	kv := &types.KeyValue{Key: validatorSetKey, Value: types.Encode(set)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func validatorReceipt(ty int32, prev, current *vty.ValidatorInfo) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&vty.ReceiptValidator{Prev: prev, Current: current})}
+}
+
+//checkPermission 管理员可以修改所有的验证节点，质押增加的验证节点所有者也可以修改
+func (a *Action) checkPermission(info *vty.ValidatorInfo) error {
+	if isManager(a.fromaddr) || (info.Owner != "" && info.Owner == a.fromaddr) {
+		return nil
+	}
+	return vty.ErrValidatorPermission
+}
+
+func calcStake(power, stakePerPower int64) (int64, error) {
+	stake := power * stakePerPower
+	if stake/stakePerPower != power || !types.CheckAmount(stake) {
+		return 0, types.ErrAmount
+	}
+	return stake, nil
+}
+
+//changeStake 冻结或者解冻所有者质押的coins
+func (a *Action) changeStake(owner string, prev, current int64) (*types.Receipt, error) {
+	if current > prev {
+		return a.coinsAccount.ExecFrozen(owner, a.execaddr, current-prev)
+	}
+	if current < prev {
+		return a.coinsAccount.ExecActive(owner, a.execaddr, prev-current)
+	}
+	return &types.Receipt{}, nil
+}
+
+func (a *Action) add(payload *vty.ValidatorAdd) (*types.Receipt, error) {
+	pubkey, err := formatPubKey(payload.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkProof(pubkey, payload.Proof); err != nil {
+		return nil, err
+	}
+	if payload.Power <= 0 {
+		return nil, vty.ErrValidatorPower
+	}
+	set, err := getValidatorSet(a.db)
+	if err != nil {
+		return nil, err
+	}
+	if findValidator(set, pubkey) >= 0 {
+		return nil, vty.ErrValidatorExist
+	}
+	if len(set.Validators) >= vty.MaxValidators {
+		return nil, vty.ErrTooManyValidators
+	}
+	info := &vty.ValidatorInfo{PubKey: pubkey, Power: payload.Power, Height: a.height}
+	receipt := &types.Receipt{}
+	if !isManager(a.fromaddr) {
+		stakePerPower := getStakePerPower()
+		if stakePerPower <= 0 {
+			return nil, vty.ErrValidatorPermission
+		}
+		info.Owner = a.fromaddr
+		info.Stake, err = calcStake(payload.Power, stakePerPower)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err = a.changeStake(info.Owner, 0, info.Stake)
+		if err != nil {
+			clog.Error("validator add", "addr", a.fromaddr, "stake", info.Stake, "err", err)
+			return nil, err
+		}
+	}
+	set.Validators = append(set.Validators, info)
+	kv := append(receipt.KV, a.saveValidatorSet(set))
+	logs := append(receipt.Logs, validatorReceipt(vty.TyLogValidatorAdd, nil, info))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) remove(payload *vty.ValidatorRemove) (*types.Receipt, error) {
+	pubkey, err := formatPubKey(payload.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	set, err := getValidatorSet(a.db)
+	if err != nil {
+		return nil, err
+	}
+	index := findValidator(set, pubkey)
+	if index < 0 {
+		return nil, vty.ErrValidatorNotExist
+	}
+	prev := set.Validators[index]
+	if err := a.checkPermission(prev); err != nil {
+		return nil, err
+	}
+	receipt, err := a.changeStake(prev.Owner, prev.Stake, 0)
+	if err != nil {
+		clog.Error("validator remove", "owner", prev.Owner, "stake", prev.Stake, "err", err)
+		return nil, err
+	}
+	set.Validators = append(set.Validators[:index], set.Validators[index+1:]...)
+	kv := append(receipt.KV, a.saveValidatorSet(set))
+	logs := append(receipt.Logs, validatorReceipt(vty.TyLogValidatorRemove, prev, nil))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) updatePower(payload *vty.ValidatorUpdatePower) (*types.Receipt, error) {
+	pubkey, err := formatPubKey(payload.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Power <= 0 {
+		return nil, vty.ErrValidatorPower
+	}
+	set, err := getValidatorSet(a.db)
+	if err != nil {
+		return nil, err
+	}
+	index := findValidator(set, pubkey)
+	if index < 0 {
+		return nil, vty.ErrValidatorNotExist
+	}
+	prev := set.Validators[index]
+	if err := a.checkPermission(prev); err != nil {
+		return nil, err
+	}
+	current := *prev
+	current.Power = payload.Power
+	receipt := &types.Receipt{}
+	//质押增加的验证节点，质押的coins随投票权重调整
+	if stakePerPower := getStakePerPower(); current.Owner != "" && stakePerPower > 0 {
+		current.Stake, err = calcStake(payload.Power, stakePerPower)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err = a.changeStake(current.Owner, prev.Stake, current.Stake)
+		if err != nil {
+			clog.Error("validator update power", "owner", current.Owner, "stake", current.Stake, "err", err)
+			return nil, err
+		}
+	}
+	set.Validators[index] = &current
+	kv := append(receipt.KV, a.saveValidatorSet(set))
+	logs := append(receipt.Logs, validatorReceipt(vty.TyLogValidatorUpdatePower, prev, &current))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
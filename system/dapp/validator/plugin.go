@@ -0,0 +1,27 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package validator 链上管理bft共识的验证节点
+// 1. 管理员(manage合约的superManager)可以增加，删除验证节点和修改投票权重
+// 2. 配置了stakePerPower的时候，普通用户可以质押coins增加验证节点
+// 3. 提交验证节点重复投票的证据，罚没质押并且移出验证节点
+// 4. 共识模块按照父区块的状态读取验证节点，修改验证节点不需要重启
+package validator
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/validator/commands"
+	"github.com/33cn/chain33/system/dapp/validator/executor"
+	"github.com/33cn/chain33/system/dapp/validator/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.ValidatorX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.ValidatorCmd,
+		RPC:      nil,
+	})
+}
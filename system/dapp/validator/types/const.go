@@ -0,0 +1,35 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// validator action ty
+const (
+	ValidatorActionAdd = iota + 1
+	ValidatorActionRemove
+	ValidatorActionUpdatePower
+	ValidatorActionEvidence
+	ValidatorActionUnjail
+)
+
+// validator log ty
+const (
+	TyLogValidatorAdd         = 430
+	TyLogValidatorRemove      = 431
+	TyLogValidatorUpdatePower = 432
+	TyLogValidatorSlash       = 433
+	TyLogValidatorUnjail      = 434
+)
+
+// query func name
+const (
+	FuncNameGetValidators = "GetValidators"
+	FuncNameGetValidator  = "GetValidator"
+	//MaxValidators 验证节点的最大个数
+	MaxValidators = 100
+	//DefaultSlashRate 默认罚没质押的百分比
+	DefaultSlashRate = 10
+	//DefaultJailBlocks 默认移出验证节点的区块数
+	DefaultJailBlocks = 1000
+)
@@ -0,0 +1,32 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrValidatorExist 验证节点已经存在
+	ErrValidatorExist = errors.New("ErrValidatorExist")
+	// ErrValidatorNotExist 验证节点不存在
+	ErrValidatorNotExist = errors.New("ErrValidatorNotExist")
+	// ErrValidatorPubKey 验证节点公钥不合法
+	ErrValidatorPubKey = errors.New("ErrValidatorPubKey")
+	// ErrValidatorProof bls公钥的持有证明不合法
+	ErrValidatorProof = errors.New("ErrValidatorProof")
+	// ErrValidatorPower 投票权重必须大于0
+	ErrValidatorPower = errors.New("ErrValidatorPower")
+	// ErrTooManyValidators 验证节点个数超过限制
+	ErrTooManyValidators = errors.New("ErrTooManyValidators")
+	// ErrValidatorPermission 没有权限修改验证节点
+	ErrValidatorPermission = errors.New("ErrValidatorPermission")
+	// ErrEvidence 作恶证据不合法
+	ErrEvidence = errors.New("ErrEvidence")
+	// ErrEvidenceExist 作恶证据已经处理过
+	ErrEvidenceExist = errors.New("ErrEvidenceExist")
+	// ErrValidatorNotJailed 验证节点没有被移出
+	ErrValidatorNotJailed = errors.New("ErrValidatorNotJailed")
+	// ErrValidatorJailing 还没有到恢复的高度
+	ErrValidatorJailing = errors.New("ErrValidatorJailing")
+)
@@ -0,0 +1,69 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types validator插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// ValidatorX 执行器名称
+	ValidatorX = "validator"
+	actionName = map[string]int32{
+		"Add":         ValidatorActionAdd,
+		"Remove":      ValidatorActionRemove,
+		"UpdatePower": ValidatorActionUpdatePower,
+		"Evidence":    ValidatorActionEvidence,
+		"Unjail":      ValidatorActionUnjail,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogValidatorAdd:         {Ty: reflect.TypeOf(ReceiptValidator{}), Name: "LogValidatorAdd"},
+		TyLogValidatorRemove:      {Ty: reflect.TypeOf(ReceiptValidator{}), Name: "LogValidatorRemove"},
+		TyLogValidatorUpdatePower: {Ty: reflect.TypeOf(ReceiptValidator{}), Name: "LogValidatorUpdatePower"},
+		TyLogValidatorSlash:       {Ty: reflect.TypeOf(ReceiptValidator{}), Name: "LogValidatorSlash"},
+		TyLogValidatorUnjail:      {Ty: reflect.TypeOf(ReceiptValidator{}), Name: "LogValidatorUnjail"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(ValidatorX))
+	types.RegistorExecutor(ValidatorX, NewType())
+	types.RegisterDappFork(ValidatorX, "Enable", 0)
+}
+
+// ValidatorType validator执行器类型
+type ValidatorType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a validator type object
+func NewType() *ValidatorType {
+	c := &ValidatorType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return validator action
+func (v *ValidatorType) GetPayload() types.Message {
+	return &ValidatorAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (v *ValidatorType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (v *ValidatorType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (v *ValidatorType) GetName() string {
+	return ValidatorX
+}
@@ -0,0 +1,741 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: validator.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ValidatorAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*ValidatorAction_Add
+	//	*ValidatorAction_Remove
+	//	*ValidatorAction_UpdatePower
+	//	*ValidatorAction_Evidence
+	//	*ValidatorAction_Unjail
+	Value                isValidatorAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                   `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ValidatorAction) Reset()         { *m = ValidatorAction{} }
+func (m *ValidatorAction) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAction) ProtoMessage()    {}
+func (*ValidatorAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{0}
+}
+
+func (m *ValidatorAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAction.Unmarshal(m, b)
+}
+func (m *ValidatorAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAction.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAction.Merge(m, src)
+}
+func (m *ValidatorAction) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAction.Size(m)
+}
+func (m *ValidatorAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAction proto.InternalMessageInfo
+
+type isValidatorAction_Value interface {
+	isValidatorAction_Value()
+}
+
+type ValidatorAction_Add struct {
+	Add *ValidatorAdd `protobuf:"bytes,1,opt,name=add,proto3,oneof"`
+}
+
+type ValidatorAction_Remove struct {
+	Remove *ValidatorRemove `protobuf:"bytes,2,opt,name=remove,proto3,oneof"`
+}
+
+type ValidatorAction_UpdatePower struct {
+	UpdatePower *ValidatorUpdatePower `protobuf:"bytes,3,opt,name=updatePower,proto3,oneof"`
+}
+
+type ValidatorAction_Evidence struct {
+	Evidence *ValidatorEvidence `protobuf:"bytes,5,opt,name=evidence,proto3,oneof"`
+}
+
+type ValidatorAction_Unjail struct {
+	Unjail *ValidatorUnjail `protobuf:"bytes,6,opt,name=unjail,proto3,oneof"`
+}
+
+func (*ValidatorAction_Add) isValidatorAction_Value() {}
+
+func (*ValidatorAction_Remove) isValidatorAction_Value() {}
+
+func (*ValidatorAction_UpdatePower) isValidatorAction_Value() {}
+
+func (*ValidatorAction_Evidence) isValidatorAction_Value() {}
+
+func (*ValidatorAction_Unjail) isValidatorAction_Value() {}
+
+func (m *ValidatorAction) GetValue() isValidatorAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ValidatorAction) GetAdd() *ValidatorAdd {
+	if x, ok := m.GetValue().(*ValidatorAction_Add); ok {
+		return x.Add
+	}
+	return nil
+}
+
+func (m *ValidatorAction) GetRemove() *ValidatorRemove {
+	if x, ok := m.GetValue().(*ValidatorAction_Remove); ok {
+		return x.Remove
+	}
+	return nil
+}
+
+func (m *ValidatorAction) GetUpdatePower() *ValidatorUpdatePower {
+	if x, ok := m.GetValue().(*ValidatorAction_UpdatePower); ok {
+		return x.UpdatePower
+	}
+	return nil
+}
+
+func (m *ValidatorAction) GetEvidence() *ValidatorEvidence {
+	if x, ok := m.GetValue().(*ValidatorAction_Evidence); ok {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (m *ValidatorAction) GetUnjail() *ValidatorUnjail {
+	if x, ok := m.GetValue().(*ValidatorAction_Unjail); ok {
+		return x.Unjail
+	}
+	return nil
+}
+
+func (m *ValidatorAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ValidatorAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ValidatorAction_OneofMarshaler, _ValidatorAction_OneofUnmarshaler, _ValidatorAction_OneofSizer, []interface{}{
+		(*ValidatorAction_Add)(nil),
+		(*ValidatorAction_Remove)(nil),
+		(*ValidatorAction_UpdatePower)(nil),
+		(*ValidatorAction_Evidence)(nil),
+		(*ValidatorAction_Unjail)(nil),
+	}
+}
+
+func _ValidatorAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ValidatorAction)
+	// value
+	switch x := m.Value.(type) {
+	case *ValidatorAction_Add:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Add); err != nil {
+			return err
+		}
+	case *ValidatorAction_Remove:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Remove); err != nil {
+			return err
+		}
+	case *ValidatorAction_UpdatePower:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UpdatePower); err != nil {
+			return err
+		}
+	case *ValidatorAction_Evidence:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Evidence); err != nil {
+			return err
+		}
+	case *ValidatorAction_Unjail:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Unjail); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ValidatorAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ValidatorAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ValidatorAction)
+	switch tag {
+	case 1: // value.add
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ValidatorAdd)
+		err := b.DecodeMessage(msg)
+		m.Value = &ValidatorAction_Add{msg}
+		return true, err
+	case 2: // value.remove
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ValidatorRemove)
+		err := b.DecodeMessage(msg)
+		m.Value = &ValidatorAction_Remove{msg}
+		return true, err
+	case 3: // value.updatePower
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ValidatorUpdatePower)
+		err := b.DecodeMessage(msg)
+		m.Value = &ValidatorAction_UpdatePower{msg}
+		return true, err
+	case 5: // value.evidence
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ValidatorEvidence)
+		err := b.DecodeMessage(msg)
+		m.Value = &ValidatorAction_Evidence{msg}
+		return true, err
+	case 6: // value.unjail
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ValidatorUnjail)
+		err := b.DecodeMessage(msg)
+		m.Value = &ValidatorAction_Unjail{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ValidatorAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ValidatorAction)
+	// value
+	switch x := m.Value.(type) {
+	case *ValidatorAction_Add:
+		s := proto.Size(x.Add)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ValidatorAction_Remove:
+		s := proto.Size(x.Remove)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ValidatorAction_UpdatePower:
+		s := proto.Size(x.UpdatePower)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ValidatorAction_Evidence:
+		s := proto.Size(x.Evidence)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ValidatorAction_Unjail:
+		s := proto.Size(x.Unjail)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//增加验证节点，开启质押的时候普通用户也可以增加，需要冻结 power * stakePerPower 的coins
+// 	 proof : bls公钥的持有证明，私钥对公钥的签名，其他签名类型不需要
+type ValidatorAdd struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Power                int64    `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Proof                []byte   `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorAdd) Reset()         { *m = ValidatorAdd{} }
+func (m *ValidatorAdd) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAdd) ProtoMessage()    {}
+func (*ValidatorAdd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{1}
+}
+
+func (m *ValidatorAdd) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAdd.Unmarshal(m, b)
+}
+func (m *ValidatorAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAdd.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAdd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAdd.Merge(m, src)
+}
+func (m *ValidatorAdd) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAdd.Size(m)
+}
+func (m *ValidatorAdd) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAdd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAdd proto.InternalMessageInfo
+
+func (m *ValidatorAdd) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ValidatorAdd) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *ValidatorAdd) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+//删除验证节点，质押的coins解冻
+type ValidatorRemove struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRemove) Reset()         { *m = ValidatorRemove{} }
+func (m *ValidatorRemove) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRemove) ProtoMessage()    {}
+func (*ValidatorRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{2}
+}
+
+func (m *ValidatorRemove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorRemove.Unmarshal(m, b)
+}
+func (m *ValidatorRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorRemove.Marshal(b, m, deterministic)
+}
+func (m *ValidatorRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRemove.Merge(m, src)
+}
+func (m *ValidatorRemove) XXX_Size() int {
+	return xxx_messageInfo_ValidatorRemove.Size(m)
+}
+func (m *ValidatorRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRemove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRemove proto.InternalMessageInfo
+
+func (m *ValidatorRemove) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+//修改验证节点的投票权重，质押的coins同时调整
+type ValidatorUpdatePower struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Power                int64    `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorUpdatePower) Reset()         { *m = ValidatorUpdatePower{} }
+func (m *ValidatorUpdatePower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUpdatePower) ProtoMessage()    {}
+func (*ValidatorUpdatePower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{3}
+}
+
+func (m *ValidatorUpdatePower) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorUpdatePower.Unmarshal(m, b)
+}
+func (m *ValidatorUpdatePower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorUpdatePower.Marshal(b, m, deterministic)
+}
+func (m *ValidatorUpdatePower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUpdatePower.Merge(m, src)
+}
+func (m *ValidatorUpdatePower) XXX_Size() int {
+	return xxx_messageInfo_ValidatorUpdatePower.Size(m)
+}
+func (m *ValidatorUpdatePower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUpdatePower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUpdatePower proto.InternalMessageInfo
+
+func (m *ValidatorUpdatePower) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ValidatorUpdatePower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+//提交验证节点在同一高度同一轮的两个冲突的投票，验证节点的质押被罚没，并且被移出验证节点
+// 	 voteA, voteB : 两个签名的投票，共识模块的消息编码
+type ValidatorEvidence struct {
+	VoteA                []byte   `protobuf:"bytes,1,opt,name=voteA,proto3" json:"voteA,omitempty"`
+	VoteB                []byte   `protobuf:"bytes,2,opt,name=voteB,proto3" json:"voteB,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorEvidence) Reset()         { *m = ValidatorEvidence{} }
+func (m *ValidatorEvidence) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEvidence) ProtoMessage()    {}
+func (*ValidatorEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{4}
+}
+
+func (m *ValidatorEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorEvidence.Unmarshal(m, b)
+}
+func (m *ValidatorEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorEvidence.Marshal(b, m, deterministic)
+}
+func (m *ValidatorEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEvidence.Merge(m, src)
+}
+func (m *ValidatorEvidence) XXX_Size() int {
+	return xxx_messageInfo_ValidatorEvidence.Size(m)
+}
+func (m *ValidatorEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEvidence proto.InternalMessageInfo
+
+func (m *ValidatorEvidence) GetVoteA() []byte {
+	if m != nil {
+		return m.VoteA
+	}
+	return nil
+}
+
+func (m *ValidatorEvidence) GetVoteB() []byte {
+	if m != nil {
+		return m.VoteB
+	}
+	return nil
+}
+
+//被移出的验证节点在jailUntil高度以后可以恢复
+type ValidatorUnjail struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorUnjail) Reset()         { *m = ValidatorUnjail{} }
+func (m *ValidatorUnjail) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUnjail) ProtoMessage()    {}
+func (*ValidatorUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{5}
+}
+
+func (m *ValidatorUnjail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorUnjail.Unmarshal(m, b)
+}
+func (m *ValidatorUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorUnjail.Marshal(b, m, deterministic)
+}
+func (m *ValidatorUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUnjail.Merge(m, src)
+}
+func (m *ValidatorUnjail) XXX_Size() int {
+	return xxx_messageInfo_ValidatorUnjail.Size(m)
+}
+func (m *ValidatorUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUnjail proto.InternalMessageInfo
+
+func (m *ValidatorUnjail) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+// 	 owner : 质押增加的验证节点的所有者，由管理员增加的为空
+// 	 stake : 冻结的coins
+// 	 jailed : 因为作恶被移出，不参与共识
+// 	 slashed : 累计被罚没的coins
+type ValidatorInfo struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Power                int64    `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Stake                int64    `protobuf:"varint,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Jailed               bool     `protobuf:"varint,6,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	JailUntil            int64    `protobuf:"varint,7,opt,name=jailUntil,proto3" json:"jailUntil,omitempty"`
+	Slashed              int64    `protobuf:"varint,8,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorInfo) Reset()         { *m = ValidatorInfo{} }
+func (m *ValidatorInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorInfo) ProtoMessage()    {}
+func (*ValidatorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{6}
+}
+
+func (m *ValidatorInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorInfo.Unmarshal(m, b)
+}
+func (m *ValidatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorInfo.Marshal(b, m, deterministic)
+}
+func (m *ValidatorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorInfo.Merge(m, src)
+}
+func (m *ValidatorInfo) XXX_Size() int {
+	return xxx_messageInfo_ValidatorInfo.Size(m)
+}
+func (m *ValidatorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorInfo proto.InternalMessageInfo
+
+func (m *ValidatorInfo) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ValidatorInfo) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *ValidatorInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ValidatorInfo) GetStake() int64 {
+	if m != nil {
+		return m.Stake
+	}
+	return 0
+}
+
+func (m *ValidatorInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorInfo) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ValidatorInfo) GetJailUntil() int64 {
+	if m != nil {
+		return m.JailUntil
+	}
+	return 0
+}
+
+func (m *ValidatorInfo) GetSlashed() int64 {
+	if m != nil {
+		return m.Slashed
+	}
+	return 0
+}
+
+type ValidatorSet struct {
+	Validators           []*ValidatorInfo `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ValidatorSet) Reset()         { *m = ValidatorSet{} }
+func (m *ValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSet) ProtoMessage()    {}
+func (*ValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{7}
+}
+
+func (m *ValidatorSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorSet.Unmarshal(m, b)
+}
+func (m *ValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorSet.Marshal(b, m, deterministic)
+}
+func (m *ValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSet.Merge(m, src)
+}
+func (m *ValidatorSet) XXX_Size() int {
+	return xxx_messageInfo_ValidatorSet.Size(m)
+}
+func (m *ValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSet proto.InternalMessageInfo
+
+func (m *ValidatorSet) GetValidators() []*ValidatorInfo {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type ReceiptValidator struct {
+	Prev                 *ValidatorInfo `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ValidatorInfo `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReceiptValidator) Reset()         { *m = ReceiptValidator{} }
+func (m *ReceiptValidator) String() string { return proto.CompactTextString(m) }
+func (*ReceiptValidator) ProtoMessage()    {}
+func (*ReceiptValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c6ec7c0d80dd5, []int{8}
+}
+
+func (m *ReceiptValidator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptValidator.Unmarshal(m, b)
+}
+func (m *ReceiptValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptValidator.Marshal(b, m, deterministic)
+}
+func (m *ReceiptValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptValidator.Merge(m, src)
+}
+func (m *ReceiptValidator) XXX_Size() int {
+	return xxx_messageInfo_ReceiptValidator.Size(m)
+}
+func (m *ReceiptValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptValidator proto.InternalMessageInfo
+
+func (m *ReceiptValidator) GetPrev() *ValidatorInfo {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptValidator) GetCurrent() *ValidatorInfo {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ValidatorAction)(nil), "types.ValidatorAction")
+	proto.RegisterType((*ValidatorAdd)(nil), "types.ValidatorAdd")
+	proto.RegisterType((*ValidatorRemove)(nil), "types.ValidatorRemove")
+	proto.RegisterType((*ValidatorUpdatePower)(nil), "types.ValidatorUpdatePower")
+	proto.RegisterType((*ValidatorEvidence)(nil), "types.ValidatorEvidence")
+	proto.RegisterType((*ValidatorUnjail)(nil), "types.ValidatorUnjail")
+	proto.RegisterType((*ValidatorInfo)(nil), "types.ValidatorInfo")
+	proto.RegisterType((*ValidatorSet)(nil), "types.ValidatorSet")
+	proto.RegisterType((*ReceiptValidator)(nil), "types.ReceiptValidator")
+}
+
+func init() { proto.RegisterFile("validator.proto", fileDescriptor_bf1c6ec7c0d80dd5) }
+
+var fileDescriptor_bf1c6ec7c0d80dd5 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0xbb, 0xce, 0x9f, 0x49, 0x20, 0xb0, 0x44, 0xd5, 0x4a, 0x70, 0x88, 0x7c, 0x21,
+	0x5c, 0x22, 0x54, 0x10, 0xd7, 0xaa, 0x51, 0x91, 0x82, 0xb8, 0xa0, 0x41, 0xe1, 0xee, 0x66, 0xa7,
+	0xc4, 0x60, 0xbc, 0xd6, 0x7a, 0xed, 0x2a, 0x6f, 0xc5, 0xab, 0xf0, 0x46, 0x68, 0xc7, 0xce, 0x1f,
+	0x4c, 0x5a, 0xa9, 0x37, 0x7f, 0x33, 0xbf, 0x59, 0x7d, 0xfb, 0x79, 0x16, 0xc6, 0x55, 0x9c, 0x26,
+	0x2a, 0xb6, 0xda, 0xcc, 0x73, 0xa3, 0xad, 0x16, 0xa1, 0xdd, 0xe6, 0x54, 0x44, 0xbf, 0x7d, 0x18,
+	0x7f, 0xdb, 0xb5, 0xae, 0xd6, 0x36, 0xd1, 0x99, 0x78, 0x0d, 0x41, 0xac, 0x94, 0xf4, 0xa6, 0xde,
+	0x6c, 0x78, 0xf1, 0x62, 0xce, 0xe0, 0xfc, 0x00, 0x29, 0xb5, 0xec, 0xa0, 0x23, 0xc4, 0x5b, 0xe8,
+	0x1a, 0xfa, 0xa5, 0x2b, 0x92, 0x3e, 0xb3, 0xe7, 0x6d, 0x16, 0xb9, 0xbb, 0xec, 0x60, 0xc3, 0x89,
+	0x4b, 0x18, 0x96, 0xb9, 0x8a, 0x2d, 0x7d, 0xd1, 0x77, 0x64, 0x64, 0xc0, 0x63, 0x2f, 0xdb, 0x63,
+	0xab, 0x03, 0xb2, 0xec, 0xe0, 0xf1, 0x84, 0xf8, 0x00, 0x7d, 0xaa, 0x12, 0x45, 0xd9, 0x9a, 0x64,
+	0xc8, 0xd3, 0xb2, 0x3d, 0xfd, 0xb1, 0xe9, 0x2f, 0x3b, 0xb8, 0x67, 0x9d, 0xd5, 0x32, 0xfb, 0x11,
+	0x27, 0xa9, 0xec, 0x9e, 0xb6, 0xba, 0xe2, 0xae, 0xb3, 0x5a, 0x73, 0xe2, 0x29, 0xf8, 0x76, 0x2b,
+	0xcf, 0xa6, 0xde, 0x2c, 0x44, 0xdf, 0x6e, 0x17, 0x3d, 0x08, 0xab, 0x38, 0x2d, 0x29, 0x42, 0x18,
+	0x1d, 0x87, 0x21, 0xce, 0xa1, 0x9b, 0x97, 0x37, 0x9f, 0x69, 0xcb, 0x89, 0x0d, 0xb0, 0x51, 0x62,
+	0x02, 0x61, 0xce, 0xb7, 0x74, 0xe1, 0x04, 0x58, 0x0b, 0xae, 0x1a, 0xad, 0x6f, 0xf9, 0xee, 0x23,
+	0xac, 0x45, 0xf4, 0x06, 0xc6, 0xad, 0xd0, 0xee, 0x3b, 0x36, 0xba, 0x86, 0xc9, 0xa9, 0xa0, 0x1e,
+	0x67, 0x23, 0xba, 0x84, 0xe7, 0xff, 0x05, 0xe6, 0xd0, 0x4a, 0x5b, 0xba, 0xe2, 0x13, 0x46, 0x58,
+	0x8b, 0x5d, 0x75, 0x21, 0xfd, 0x43, 0x75, 0xf1, 0x8f, 0xe3, 0x3a, 0xbb, 0x7b, 0x1d, 0xff, 0xf1,
+	0xe0, 0xc9, 0x9e, 0xfd, 0x94, 0xdd, 0xea, 0xc7, 0x47, 0xa6, 0xef, 0xb2, 0x66, 0x5d, 0x06, 0x58,
+	0x0b, 0x57, 0x2d, 0x6c, 0xfc, 0x93, 0xf8, 0x17, 0x05, 0x58, 0x0b, 0x77, 0xf2, 0x86, 0x92, 0xef,
+	0x1b, 0xcb, 0xdb, 0x11, 0x60, 0xa3, 0x5c, 0xdd, 0x79, 0x24, 0xc5, 0xff, 0xbf, 0x8f, 0x8d, 0x12,
+	0xaf, 0x60, 0xe0, 0xbe, 0x56, 0x99, 0x4d, 0x52, 0xd9, 0xe3, 0x91, 0x43, 0x41, 0x48, 0xe8, 0x15,
+	0x69, 0x5c, 0x6c, 0x48, 0xc9, 0x3e, 0xf7, 0x76, 0x32, 0xba, 0x3e, 0x5a, 0x82, 0xaf, 0x64, 0xc5,
+	0x7b, 0x80, 0xfd, 0x0b, 0x2b, 0xa4, 0x37, 0x0d, 0x66, 0xc3, 0x8b, 0x49, 0x7b, 0xc7, 0xdc, 0xdd,
+	0xf1, 0x88, 0x8b, 0x52, 0x78, 0x86, 0xb4, 0xa6, 0x24, 0xb7, 0x7b, 0x46, 0xcc, 0xe0, 0x2c, 0x37,
+	0x54, 0x35, 0xcf, 0xef, 0xf4, 0x19, 0x4c, 0x88, 0x39, 0xf4, 0xd6, 0xa5, 0x31, 0x94, 0x59, 0xe9,
+	0x3f, 0x00, 0xef, 0xa0, 0x9b, 0x2e, 0xbf, 0xfc, 0x77, 0x7f, 0x07, 0x00, 0xed, 0x20, 0x9c, 0x72,
+	0x0c, 0x04, 0x00, 0x00,
+}
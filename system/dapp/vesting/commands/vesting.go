@@ -0,0 +1,174 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package commands vesting插件命令
+package commands
+
+import (
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	vty "github.com/33cn/chain33/system/dapp/vesting/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+// VestingCmd vesting command
+func VestingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting",
+		Short: "Vesting and timelock management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CreateCmd(),
+		TimelockCmd(),
+		ClaimCmd(),
+		QueryScheduleCmd(),
+		ListSchedulesCmd(),
+	)
+
+	return cmd
+}
+
+func addLockFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("beneficiary", "b", "", "beneficiary address")
+	cmd.MarkFlagRequired("beneficiary")
+	cmd.Flags().Float64P("amount", "a", 0, "locked amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("asset_exec", "e", "", "asset executor, empty for coins")
+	cmd.Flags().StringP("asset_symbol", "s", "", "asset symbol")
+	cmd.Flags().StringP("memo", "m", "", "memo")
+}
+
+func sendCreate(cmd *cobra.Command, start, cliff, end int64) {
+	beneficiary, _ := cmd.Flags().GetString("beneficiary")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	assetExec, _ := cmd.Flags().GetString("asset_exec")
+	assetSymbol, _ := cmd.Flags().GetString("asset_symbol")
+	memo, _ := cmd.Flags().GetString("memo")
+	commandtypes.CreateActionTx(cmd, vty.VestingX, &vty.VestingAction{
+		Ty: vty.VestingActionCreate,
+		Value: &vty.VestingAction_Create{Create: &vty.VestingCreate{Beneficiary: beneficiary, AssetExec: assetExec, AssetSymbol: assetSymbol,
+			Amount: commandtypes.FormatAmountDisplay2Value(amount), StartHeight: start, CliffHeight: cliff, EndHeight: end, Memo: memo}},
+	})
+}
+
+// CreateCmd create vesting schedule
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a transaction to lock assets in cliff and linear release schedule",
+		Run:   create,
+	}
+	addLockFlags(cmd)
+	cmd.Flags().Int64P("start", "t", 0, "start height of linear release")
+	cmd.MarkFlagRequired("start")
+	cmd.Flags().Int64P("cliff", "c", -1, "cliff height, start height by default")
+	cmd.Flags().Int64P("end", "n", 0, "height all assets released")
+	cmd.MarkFlagRequired("end")
+	return cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	start, _ := cmd.Flags().GetInt64("start")
+	cliff, _ := cmd.Flags().GetInt64("cliff")
+	end, _ := cmd.Flags().GetInt64("end")
+	if cliff < 0 {
+		cliff = start
+	}
+	sendCreate(cmd, start, cliff, end)
+}
+
+// TimelockCmd create timelock
+func TimelockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelock",
+		Short: "Create a transaction to lock assets until unlock height",
+		Run:   timelock,
+	}
+	addLockFlags(cmd)
+	cmd.Flags().Int64P("unlock", "u", 0, "unlock height")
+	cmd.MarkFlagRequired("unlock")
+	return cmd
+}
+
+func timelock(cmd *cobra.Command, args []string) {
+	unlock, _ := cmd.Flags().GetInt64("unlock")
+	sendCreate(cmd, unlock, unlock, unlock)
+}
+
+// ClaimCmd claim released assets
+func ClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Short: "Create a transaction to claim released assets by beneficiary",
+		Run:   claim,
+	}
+	cmd.Flags().StringP("id", "i", "", "schedule id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func claim(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	commandtypes.CreateActionTx(cmd, vty.VestingX, &vty.VestingAction{
+		Ty:    vty.VestingActionClaim,
+		Value: &vty.VestingAction_Claim{Claim: &vty.VestingClaim{Id: id}},
+	})
+}
+
+func queryVesting(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, vty.VestingX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// QueryScheduleCmd query schedule
+func QueryScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Query schedule with released and remaining amount",
+		Run:   querySchedule,
+	}
+	cmd.Flags().StringP("id", "i", "", "schedule id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func querySchedule(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res vty.ReplyVestingSchedule
+	queryVesting(cmd, vty.FuncNameGetSchedule, &types.ReqString{Data: id}, &res)
+}
+
+// ListSchedulesCmd list schedules of beneficiary
+func ListSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List schedules of beneficiary",
+		Run:   listSchedules,
+	}
+	cmd.Flags().StringP("beneficiary", "b", "", "beneficiary address")
+	cmd.MarkFlagRequired("beneficiary")
+	cmd.Flags().StringP("primary", "p", "", "list after this schedule id")
+	cmd.Flags().Int32P("count", "c", vty.DefaultListCount, "max count")
+	return cmd
+}
+
+func listSchedules(cmd *cobra.Command, args []string) {
+	beneficiary, _ := cmd.Flags().GetString("beneficiary")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res vty.ReplyVestingSchedules
+	queryVesting(cmd, vty.FuncNameListSchedules, &vty.ReqVestingSchedules{Beneficiary: beneficiary, PrimaryKey: primary, Count: count}, &res)
+}
@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	vty "github.com/33cn/chain33/system/dapp/vesting/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Exec_Create 创建释放计划
+func (v *Vesting) Exec_Create(payload *vty.VestingCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(v, tx, index)
+	return action.create(payload)
+}
+
+// Exec_Claim 领取已经释放的部分
+func (v *Vesting) Exec_Claim(payload *vty.VestingClaim, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(v, tx, index)
+	return action.claim(payload)
+}
@@ -0,0 +1,29 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	vty "github.com/33cn/chain33/system/dapp/vesting/types"
+	"github.com/33cn/chain33/types"
+)
+
+// ExecLocal_Create 添加受益人的索引
+func (v *Vesting) ExecLocal_Create(payload *vty.VestingCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != vty.TyLogVestingCreate {
+			continue
+		}
+		var log vty.ReceiptVesting
+		if err := types.Decode(item.Log, &log); err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, &types.KeyValue{Key: calcBeneficiaryIndexKey(log.Current.Beneficiary, log.Current.Id), Value: []byte(log.Current.Id)})
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
@@ -0,0 +1,24 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	vty "github.com/33cn/chain33/system/dapp/vesting/types"
+	"github.com/33cn/chain33/types"
+)
+
+// Query_GetSchedule 获取释放计划和按当前高度计算的释放情况
+func (v *Vesting) Query_GetSchedule(in *types.ReqString) (types.Message, error) {
+	schedule, err := getSchedule(v.GetStateDB(), in.Data)
+	if err != nil {
+		return nil, err
+	}
+	return replySchedule(schedule, v.GetHeight()), nil
+}
+
+// Query_ListSchedules 列出受益人的释放计划
+func (v *Vesting) Query_ListSchedules(in *vty.ReqVestingSchedules) (types.Message, error) {
+	return listSchedules(v.GetLocalDB(), v.GetStateDB(), in, v.GetHeight())
+}
@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor vesting执行器，负责释放计划的创建和受益人的领取
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	vty "github.com/33cn/chain33/system/dapp/vesting/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.vesting")
+	driverName = vty.VestingX
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Vesting{}))
+}
+
+// Init register a driver
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newVesting, types.GetDappFork(driverName, "Enable"))
+}
+
+// GetName return vesting name
+func GetName() string {
+	return newVesting().GetName()
+}
+
+// Vesting defines Vesting object
+type Vesting struct {
+	drivers.DriverBase
+}
+
+func newVesting() drivers.Driver {
+	v := &Vesting{}
+	v.SetChild(v)
+	v.SetExecutorType(types.LoadExecutorType(driverName))
+	return v
+}
+
+// GetDriverName return a drivername
+func (v *Vesting) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (v *Vesting) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,118 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	vty "github.com/33cn/chain33/system/dapp/vesting/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func sendVestingTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) (int32, string) {
+	hash, detail, err := mock33.SendCallTx(priv, vty.VestingX, action, param)
+	assert.Nil(t, err)
+	return detail.Receipt.Ty, common.ToHex(hash)
+}
+
+func getSchedule(t *testing.T, mock33 *testnode.Chain33Mock, id string) *vty.ReplyVestingSchedule {
+	msg, err := mock33.GetAPI().Query(vty.VestingX, vty.FuncNameGetSchedule, &types.ReqString{Data: id})
+	assert.Nil(t, err)
+	return msg.(*vty.ReplyVestingSchedule)
+}
+
+func TestVestedAmount(t *testing.T) {
+	s := &vty.VestingSchedule{Amount: 100, StartHeight: 10, CliffHeight: 20, EndHeight: 60}
+	assert.Equal(t, int64(0), vty.VestedAmount(s, 19))
+	assert.Equal(t, int64(20), vty.VestedAmount(s, 20))
+	assert.Equal(t, int64(98), vty.VestedAmount(s, 59))
+	assert.Equal(t, int64(100), vty.VestedAmount(s, 60))
+	//时间锁到期一次释放
+	lock := &vty.VestingSchedule{Amount: 100, StartHeight: 10, CliffHeight: 10, EndHeight: 10}
+	assert.Equal(t, int64(0), vty.VestedAmount(lock, 9))
+	assert.Equal(t, int64(100), vty.VestedAmount(lock, 10))
+	//大额不溢出
+	big := &vty.VestingSchedule{Amount: math.MaxInt64, EndHeight: vty.MaxDuration}
+	assert.Equal(t, int64(math.MaxInt64/2), vty.VestedAmount(big, vty.MaxDuration/2))
+}
+
+func TestVesting(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	creator := mock33.GetGenesisKey()
+	creatorAddr := mock33.GetGenesisAddress()
+	addr, priv := util.Genaddress()
+	mock33.SendTx(util.CreateCoinsTx(creator, addr, 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+	mock33.SendTx(util.CreateCoinsTx(creator, address.ExecAddress(vty.VestingX), 10*types.Coin))
+	assert.Nil(t, mock33.Wait())
+
+	height := mock33.GetLastBlock().Height
+	ty, _ := sendVestingTx(t, mock33, creator, "Create", &vty.VestingCreate{Beneficiary: addr, Amount: types.Coin, StartHeight: height, CliffHeight: height - 1, EndHeight: height + 10})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendVestingTx(t, mock33, creator, "Create", &vty.VestingCreate{Beneficiary: addr, Amount: types.Coin, StartHeight: 0, CliffHeight: 0, EndHeight: 1})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendVestingTx(t, mock33, creator, "Create", &vty.VestingCreate{Beneficiary: addr, Amount: 20 * types.Coin, StartHeight: height, CliffHeight: height, EndHeight: height + 10})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//cliff之前不能领取，之后按线性领取
+	height = mock33.GetLastBlock().Height
+	ty, id := sendVestingTx(t, mock33, creator, "Create", &vty.VestingCreate{Beneficiary: addr, Amount: 6 * types.Coin, StartHeight: height, CliffHeight: height + 5, EndHeight: height + 15})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 4*types.Coin, mock33.GetExecBalance(vty.VestingX, creatorAddr))
+	ty, _ = sendVestingTx(t, mock33, priv, "Claim", &vty.VestingClaim{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	assert.Nil(t, mock33.CreateBlocks(4))
+	ty, _ = sendVestingTx(t, mock33, creator, "Claim", &vty.VestingClaim{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendVestingTx(t, mock33, priv, "Claim", &vty.VestingClaim{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	reply := getSchedule(t, mock33, id)
+	assert.Equal(t, height+8, reply.Height)
+	assert.Equal(t, 6*types.Coin*8/15, reply.Vested)
+	assert.Equal(t, reply.Vested, reply.Schedule.Claimed)
+	assert.Equal(t, int64(0), reply.Claimable)
+	assert.Equal(t, 6*types.Coin-reply.Vested, reply.Remaining)
+	assert.Equal(t, reply.Vested, mock33.GetExecBalance(vty.VestingX, addr))
+
+	assert.Nil(t, mock33.CreateBlocks(10))
+	ty, _ = sendVestingTx(t, mock33, priv, "Claim", &vty.VestingClaim{Id: id})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 6*types.Coin, mock33.GetExecBalance(vty.VestingX, addr))
+	ty, _ = sendVestingTx(t, mock33, priv, "Claim", &vty.VestingClaim{Id: id})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//时间锁到期一次领取
+	unlock := mock33.GetLastBlock().Height + 3
+	ty, lockID := sendVestingTx(t, mock33, creator, "Create", &vty.VestingCreate{Beneficiary: addr, Amount: 2 * types.Coin, StartHeight: unlock, CliffHeight: unlock, EndHeight: unlock})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	ty, _ = sendVestingTx(t, mock33, priv, "Claim", &vty.VestingClaim{Id: lockID})
+	assert.Equal(t, int32(types.ExecPack), ty)
+	ty, _ = sendVestingTx(t, mock33, priv, "Claim", &vty.VestingClaim{Id: lockID})
+	assert.Equal(t, int32(types.ExecOk), ty)
+	assert.Equal(t, 8*types.Coin, mock33.GetExecBalance(vty.VestingX, addr))
+
+	msg, err := mock33.GetAPI().Query(vty.VestingX, vty.FuncNameListSchedules, &vty.ReqVestingSchedules{Beneficiary: addr})
+	assert.Nil(t, err)
+	list := msg.(*vty.ReplyVestingSchedules)
+	assert.Equal(t, 2, len(list.Schedules))
+	for _, s := range list.Schedules {
+		assert.Equal(t, int64(0), s.Remaining)
+		assert.Equal(t, s.Schedule.Amount, s.Schedule.Claimed)
+	}
+	msg, err = mock33.GetAPI().Query(vty.VestingX, vty.FuncNameListSchedules, &vty.ReqVestingSchedules{Beneficiary: creatorAddr})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(msg.(*vty.ReplyVestingSchedules).Schedules))
+}
@@ -0,0 +1,202 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	vty "github.com/33cn/chain33/system/dapp/vesting/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	scheduleKeyPrefix      = "mavl-" + vty.VestingX + "-schedule-"
+	beneficiaryIndexPrefix = "LODB-" + vty.VestingX + "-beneficiary-"
+)
+
+func calcScheduleKey(id string) []byte {
+	return []byte(scheduleKeyPrefix + id)
+}
+
+func calcBeneficiaryIndexKey(beneficiary, id string) []byte {
+	return []byte(beneficiaryIndexPrefix + beneficiary + "-" + id)
+}
+
+//calcScheduleAddr 锁定的资产存在由计划id生成的地址中，没有对应的私钥
+func calcScheduleAddr(id string) string {
+	return address.ExecAddress(vty.VestingX + "-" + id)
+}
+
+// Action vesting交易的执行环境
+type Action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	execaddr     string
+	height       int64
+	index        int
+}
+
+// NewAction new a action object
+func NewAction(v *Vesting, tx *types.Transaction, index int) *Action {
+	return &Action{
+		coinsAccount: v.GetCoinsAccount(),
+		db:           v.GetStateDB(),
+		txhash:       tx.Hash(),
+		fromaddr:     tx.From(),
+		execaddr:     address.ExecAddress(string(tx.Execer)),
+		height:       v.GetHeight(),
+		index:        index,
+	}
+}
+
+//assetAccount coins用执行器的coins账户，其他资产按执行器和symbol创建账户
+func (a *Action) assetAccount(exec, symbol string) (*account.DB, error) {
+	if exec == "coins" {
+		return a.coinsAccount, nil
+	}
+	return account.NewAccountDB(exec, symbol, a.db)
+}
+
+func getSchedule(db dbm.KV, id string) (*vty.VestingSchedule, error) {
+	value, err := db.Get(calcScheduleKey(id))
+	if err != nil || value == nil {
+		return nil, vty.ErrScheduleNotExist
+	}
+	var schedule vty.VestingSchedule
+	err = types.Decode(value, &schedule)
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func replySchedule(schedule *vty.VestingSchedule, height int64) *vty.ReplyVestingSchedule {
+	vested := vty.VestedAmount(schedule, height)
+	return &vty.ReplyVestingSchedule{
+		Schedule:  schedule,
+		Height:    height,
+		Vested:    vested,
+		Claimable: vested - schedule.Claimed,
+		Remaining: schedule.Amount - vested,
+	}
+}
+
+func listSchedules(localdb dbm.KVDB, statedb dbm.KV, req *vty.ReqVestingSchedules, height int64) (*vty.ReplyVestingSchedules, error) {
+	if req.Beneficiary == "" {
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count <= 0 {
+		count = vty.DefaultListCount
+	}
+	if count > vty.MaxListCount {
+		count = vty.MaxListCount
+	}
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = calcBeneficiaryIndexKey(req.Beneficiary, req.PrimaryKey)
+	}
+	values, err := localdb.List([]byte(beneficiaryIndexPrefix+req.Beneficiary+"-"), key, count, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &vty.ReplyVestingSchedules{}
+	for _, value := range values {
+		schedule, err := getSchedule(statedb, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.Schedules = append(reply.Schedules, replySchedule(schedule, height))
+		reply.PrimaryKey = schedule.Id
+	}
+	return reply, nil
+}
+
+func (a *Action) saveSchedule(schedule *vty.VestingSchedule) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcScheduleKey(schedule.Id), Value: types.Encode(schedule)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func scheduleReceipt(ty int32, prev, current *vty.VestingSchedule) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&vty.ReceiptVesting{Prev: prev, Current: current})}
+}
+
+func (a *Action) create(payload *vty.VestingCreate) (*types.Receipt, error) {
+	if err := address.CheckAddress(payload.Beneficiary); err != nil {
+		return nil, vty.ErrBeneficiary
+	}
+	if payload.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+	if payload.StartHeight < 0 || payload.CliffHeight < payload.StartHeight || payload.EndHeight < payload.CliffHeight ||
+		payload.EndHeight-payload.StartHeight > vty.MaxDuration || payload.EndHeight <= a.height {
+		return nil, vty.ErrScheduleHeight
+	}
+	if len(payload.Memo) > vty.MaxMemoLength {
+		return nil, vty.ErrMemoTooLong
+	}
+	exec, symbol := payload.AssetExec, payload.AssetSymbol
+	if exec == "" {
+		exec, symbol = "coins", types.GetCoinSymbol()
+	}
+	acc, err := a.assetAccount(exec, symbol)
+	if err != nil {
+		return nil, err
+	}
+	id := common.ToHex(a.txhash)
+	schedule := &vty.VestingSchedule{
+		Id:           id,
+		Creator:      a.fromaddr,
+		Beneficiary:  payload.Beneficiary,
+		AssetExec:    exec,
+		AssetSymbol:  symbol,
+		Amount:       payload.Amount,
+		StartHeight:  payload.StartHeight,
+		CliffHeight:  payload.CliffHeight,
+		EndHeight:    payload.EndHeight,
+		Memo:         payload.Memo,
+		CreateHeight: a.height,
+		Addr:         calcScheduleAddr(id),
+	}
+	receipt, err := acc.ExecTransfer(a.fromaddr, schedule.Addr, a.execaddr, payload.Amount)
+	if err != nil {
+		return nil, err
+	}
+	kv := append(receipt.KV, a.saveSchedule(schedule))
+	logs := append(receipt.Logs, scheduleReceipt(vty.TyLogVestingCreate, nil, schedule))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *Action) claim(payload *vty.VestingClaim) (*types.Receipt, error) {
+	schedule, err := getSchedule(a.db, payload.Id)
+	if err != nil {
+		return nil, err
+	}
+	if a.fromaddr != schedule.Beneficiary {
+		return nil, vty.ErrNotBeneficiary
+	}
+	amount := vty.VestedAmount(schedule, a.height) - schedule.Claimed
+	if amount <= 0 {
+		return nil, vty.ErrNothingToClaim
+	}
+	acc, err := a.assetAccount(schedule.AssetExec, schedule.AssetSymbol)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := acc.ExecTransfer(schedule.Addr, schedule.Beneficiary, a.execaddr, amount)
+	if err != nil {
+		return nil, err
+	}
+	prev := *schedule
+	schedule.Claimed += amount
+	kv := append(receipt.KV, a.saveSchedule(schedule))
+	logs := append(receipt.Logs, scheduleReceipt(vty.TyLogVestingClaim, &prev, schedule))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package vesting 资产释放执行器插件
+// 1. 创建者把coins或者其他资产锁定给受益人，按cliff加线性的计划释放，也可以是到期一次释放的时间锁
+// 2. 受益人随时领取已经释放的部分
+// 3. 本地数据库按受益人索引释放计划，查询的时候返回按当前高度计算的剩余计划
+package vesting
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/vesting/commands"
+	"github.com/33cn/chain33/system/dapp/vesting/executor"
+	"github.com/33cn/chain33/system/dapp/vesting/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.VestingX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.VestingCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// vesting action ty
+const (
+	VestingActionCreate = iota + 1
+	VestingActionClaim
+)
+
+// vesting log ty
+const (
+	TyLogVestingCreate = 530
+	TyLogVestingClaim  = 531
+)
+
+// query func name
+const (
+	FuncNameGetSchedule   = "GetSchedule"
+	FuncNameListSchedules = "ListSchedules"
+	MaxDuration           = 100000000
+	MaxMemoLength         = 256
+	DefaultListCount      = 20
+	MaxListCount          = 100
+)
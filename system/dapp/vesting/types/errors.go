@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrScheduleNotExist 释放计划不存在
+	ErrScheduleNotExist = errors.New("ErrScheduleNotExist")
+	// ErrBeneficiary 受益人地址不合法
+	ErrBeneficiary = errors.New("ErrBeneficiary")
+	// ErrScheduleHeight 释放计划的高度不合法
+	ErrScheduleHeight = errors.New("ErrScheduleHeight")
+	// ErrMemoTooLong 备注太长
+	ErrMemoTooLong = errors.New("ErrMemoTooLong")
+	// ErrNotBeneficiary 不是受益人
+	ErrNotBeneficiary = errors.New("ErrNotBeneficiary")
+	// ErrNothingToClaim 没有可以领取的部分
+	ErrNothingToClaim = errors.New("ErrNothingToClaim")
+)
@@ -0,0 +1,76 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types vesting插件相关的定义
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	// VestingX 执行器名称
+	VestingX   = "vesting"
+	actionName = map[string]int32{
+		"Create": VestingActionCreate,
+		"Claim":  VestingActionClaim,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogVestingCreate: {Ty: reflect.TypeOf(ReceiptVesting{}), Name: "LogVestingCreate"},
+		TyLogVestingClaim:  {Ty: reflect.TypeOf(ReceiptVesting{}), Name: "LogVestingClaim"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(VestingX))
+	types.RegistorExecutor(VestingX, NewType())
+	types.RegisterDappFork(VestingX, "Enable", 0)
+}
+
+// VestingType vesting执行器类型
+type VestingType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a vesting type object
+func NewType() *VestingType {
+	c := &VestingType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload return vesting action
+func (v *VestingType) GetPayload() types.Message {
+	return &VestingAction{}
+}
+
+// GetTypeMap return typename of actionname
+func (v *VestingType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetLogMap get log for map
+func (v *VestingType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetName reset name
+func (v *VestingType) GetName() string {
+	return VestingX
+}
+
+// VestedAmount 释放计划在height高度已经释放的总额，按区块线性释放，乘法拆开计算避免溢出
+func VestedAmount(s *VestingSchedule, height int64) int64 {
+	if height < s.CliffHeight {
+		return 0
+	}
+	if height >= s.EndHeight {
+		return s.Amount
+	}
+	duration := s.EndHeight - s.StartHeight
+	elapsed := height - s.StartHeight
+	return s.Amount/duration*elapsed + s.Amount%duration*elapsed/duration
+}
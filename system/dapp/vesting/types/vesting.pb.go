@@ -0,0 +1,722 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: vesting.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type VestingAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*VestingAction_Create
+	//	*VestingAction_Claim
+	Value                isVestingAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                 `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VestingAction) Reset()         { *m = VestingAction{} }
+func (m *VestingAction) String() string { return proto.CompactTextString(m) }
+func (*VestingAction) ProtoMessage()    {}
+func (*VestingAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_998de24bb17fd5b6, []int{0}
+}
+
+func (m *VestingAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VestingAction.Unmarshal(m, b)
+}
+func (m *VestingAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VestingAction.Marshal(b, m, deterministic)
+}
+func (m *VestingAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingAction.Merge(m, src)
+}
+func (m *VestingAction) XXX_Size() int {
+	return xxx_messageInfo_VestingAction.Size(m)
+}
+func (m *VestingAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingAction proto.InternalMessageInfo
+
+type isVestingAction_Value interface {
+	isVestingAction_Value()
+}
+
+type VestingAction_Create struct {
+	Create *VestingCreate `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type VestingAction_Claim struct {
+	Claim *VestingClaim `protobuf:"bytes,2,opt,name=claim,proto3,oneof"`
+}
+
+func (*VestingAction_Create) isVestingAction_Value() {}
+
+func (*VestingAction_Claim) isVestingAction_Value() {}
+
+func (m *VestingAction) GetValue() isVestingAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *VestingAction) GetCreate() *VestingCreate {
+	if x, ok := m.GetValue().(*VestingAction_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (m *VestingAction) GetClaim() *VestingClaim {
+	if x, ok := m.GetValue().(*VestingAction_Claim); ok {
+		return x.Claim
+	}
+	return nil
+}
+
+func (m *VestingAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*VestingAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _VestingAction_OneofMarshaler, _VestingAction_OneofUnmarshaler, _VestingAction_OneofSizer, []interface{}{
+		(*VestingAction_Create)(nil),
+		(*VestingAction_Claim)(nil),
+	}
+}
+
+func _VestingAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*VestingAction)
+	// value
+	switch x := m.Value.(type) {
+	case *VestingAction_Create:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Create); err != nil {
+			return err
+		}
+	case *VestingAction_Claim:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Claim); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("VestingAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _VestingAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*VestingAction)
+	switch tag {
+	case 1: // value.create
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(VestingCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &VestingAction_Create{msg}
+		return true, err
+	case 2: // value.claim
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(VestingClaim)
+		err := b.DecodeMessage(msg)
+		m.Value = &VestingAction_Claim{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _VestingAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*VestingAction)
+	// value
+	switch x := m.Value.(type) {
+	case *VestingAction_Create:
+		s := proto.Size(x.Create)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *VestingAction_Claim:
+		s := proto.Size(x.Claim)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+//创建者把amount锁定给受益人，amount从创建者在vesting合约中的资产转入
+//cliffHeight之前不释放，cliffHeight开始按startHeight到endHeight线性释放，endHeight全部释放
+//startHeight，cliffHeight和endHeight相同的时候是到期一次释放的时间锁
+//assetExec为空的时候锁定coins
+type VestingCreate struct {
+	Beneficiary          string   `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	AssetExec            string   `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,3,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartHeight          int64    `protobuf:"varint,5,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	CliffHeight          int64    `protobuf:"varint,6,opt,name=cliffHeight,proto3" json:"cliffHeight,omitempty"`
+	EndHeight            int64    `protobuf:"varint,7,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	Memo                 string   `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VestingCreate) Reset()         { *m = VestingCreate{} }
+func (m *VestingCreate) String() string { return proto.CompactTextString(m) }
+func (*VestingCreate) ProtoMessage()    {}
+func (*VestingCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_998de24bb17fd5b6, []int{1}
+}
+
+func (m *VestingCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VestingCreate.Unmarshal(m, b)
+}
+func (m *VestingCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VestingCreate.Marshal(b, m, deterministic)
+}
+func (m *VestingCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingCreate.Merge(m, src)
+}
+func (m *VestingCreate) XXX_Size() int {
+	return xxx_messageInfo_VestingCreate.Size(m)
+}
+func (m *VestingCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingCreate proto.InternalMessageInfo
+
+func (m *VestingCreate) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *VestingCreate) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *VestingCreate) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *VestingCreate) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *VestingCreate) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *VestingCreate) GetCliffHeight() int64 {
+	if m != nil {
+		return m.CliffHeight
+	}
+	return 0
+}
+
+func (m *VestingCreate) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *VestingCreate) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+//受益人领取已经释放的部分，转到受益人在vesting合约中的账户
+type VestingClaim struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VestingClaim) Reset()         { *m = VestingClaim{} }
+func (m *VestingClaim) String() string { return proto.CompactTextString(m) }
+func (*VestingClaim) ProtoMessage()    {}
+func (*VestingClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_998de24bb17fd5b6, []int{2}
+}
+
+func (m *VestingClaim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VestingClaim.Unmarshal(m, b)
+}
+func (m *VestingClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VestingClaim.Marshal(b, m, deterministic)
+}
+func (m *VestingClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingClaim.Merge(m, src)
+}
+func (m *VestingClaim) XXX_Size() int {
+	return xxx_messageInfo_VestingClaim.Size(m)
+}
+func (m *VestingClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingClaim proto.InternalMessageInfo
+
+func (m *VestingClaim) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type VestingSchedule struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Beneficiary          string   `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	AssetExec            string   `protobuf:"bytes,4,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,5,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Amount               int64    `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	StartHeight          int64    `protobuf:"varint,7,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	CliffHeight          int64    `protobuf:"varint,8,opt,name=cliffHeight,proto3" json:"cliffHeight,omitempty"`
+	EndHeight            int64    `protobuf:"varint,9,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	Claimed              int64    `protobuf:"varint,10,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	Memo                 string   `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	CreateHeight         int64    `protobuf:"varint,12,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	Addr                 string   `protobuf:"bytes,13,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_998de24bb17fd5b6, []int{3}
+}
+
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VestingSchedule.Unmarshal(m, b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return xxx_messageInfo_VestingSchedule.Size(m)
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetCliffHeight() int64 {
+	if m != nil {
+		return m.CliffHeight
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetClaimed() int64 {
+	if m != nil {
+		return m.Claimed
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReceiptVesting struct {
+	Prev                 *VestingSchedule `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *VestingSchedule `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptVesting) Reset()         { *m = ReceiptVesting{} }
+func (m *ReceiptVesting) String() string { return proto.CompactTextString(m) }
+func (*ReceiptVesting) ProtoMessage()    {}
+func (*ReceiptVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_998de24bb17fd5b6, []int{4}
+}
+
+func (m *ReceiptVesting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptVesting.Unmarshal(m, b)
+}
+func (m *ReceiptVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptVesting.Marshal(b, m, deterministic)
+}
+func (m *ReceiptVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptVesting.Merge(m, src)
+}
+func (m *ReceiptVesting) XXX_Size() int {
+	return xxx_messageInfo_ReceiptVesting.Size(m)
+}
+func (m *ReceiptVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptVesting proto.InternalMessageInfo
+
+func (m *ReceiptVesting) GetPrev() *VestingSchedule {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptVesting) GetCurrent() *VestingSchedule {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+//按当前高度计算的释放情况
+type ReplyVestingSchedule struct {
+	Schedule             *VestingSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Height               int64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Vested               int64            `protobuf:"varint,3,opt,name=vested,proto3" json:"vested,omitempty"`
+	Claimable            int64            `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	Remaining            int64            `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplyVestingSchedule) Reset()         { *m = ReplyVestingSchedule{} }
+func (m *ReplyVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*ReplyVestingSchedule) ProtoMessage()    {}
+func (*ReplyVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_998de24bb17fd5b6, []int{5}
+}
+
+func (m *ReplyVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyVestingSchedule.Unmarshal(m, b)
+}
+func (m *ReplyVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyVestingSchedule.Marshal(b, m, deterministic)
+}
+func (m *ReplyVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyVestingSchedule.Merge(m, src)
+}
+func (m *ReplyVestingSchedule) XXX_Size() int {
+	return xxx_messageInfo_ReplyVestingSchedule.Size(m)
+}
+func (m *ReplyVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyVestingSchedule proto.InternalMessageInfo
+
+func (m *ReplyVestingSchedule) GetSchedule() *VestingSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *ReplyVestingSchedule) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReplyVestingSchedule) GetVested() int64 {
+	if m != nil {
+		return m.Vested
+	}
+	return 0
+}
+
+func (m *ReplyVestingSchedule) GetClaimable() int64 {
+	if m != nil {
+		return m.Claimable
+	}
+	return 0
+}
+
+func (m *ReplyVestingSchedule) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+type ReqVestingSchedules struct {
+	Beneficiary          string   `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqVestingSchedules) Reset()         { *m = ReqVestingSchedules{} }
+func (m *ReqVestingSchedules) String() string { return proto.CompactTextString(m) }
+func (*ReqVestingSchedules) ProtoMessage()    {}
+func (*ReqVestingSchedules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_998de24bb17fd5b6, []int{6}
+}
+
+func (m *ReqVestingSchedules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqVestingSchedules.Unmarshal(m, b)
+}
+func (m *ReqVestingSchedules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqVestingSchedules.Marshal(b, m, deterministic)
+}
+func (m *ReqVestingSchedules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqVestingSchedules.Merge(m, src)
+}
+func (m *ReqVestingSchedules) XXX_Size() int {
+	return xxx_messageInfo_ReqVestingSchedules.Size(m)
+}
+func (m *ReqVestingSchedules) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqVestingSchedules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqVestingSchedules proto.InternalMessageInfo
+
+func (m *ReqVestingSchedules) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *ReqVestingSchedules) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqVestingSchedules) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyVestingSchedules struct {
+	Schedules            []*ReplyVestingSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	PrimaryKey           string                  `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ReplyVestingSchedules) Reset()         { *m = ReplyVestingSchedules{} }
+func (m *ReplyVestingSchedules) String() string { return proto.CompactTextString(m) }
+func (*ReplyVestingSchedules) ProtoMessage()    {}
+func (*ReplyVestingSchedules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_998de24bb17fd5b6, []int{7}
+}
+
+func (m *ReplyVestingSchedules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyVestingSchedules.Unmarshal(m, b)
+}
+func (m *ReplyVestingSchedules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyVestingSchedules.Marshal(b, m, deterministic)
+}
+func (m *ReplyVestingSchedules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyVestingSchedules.Merge(m, src)
+}
+func (m *ReplyVestingSchedules) XXX_Size() int {
+	return xxx_messageInfo_ReplyVestingSchedules.Size(m)
+}
+func (m *ReplyVestingSchedules) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyVestingSchedules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyVestingSchedules proto.InternalMessageInfo
+
+func (m *ReplyVestingSchedules) GetSchedules() []*ReplyVestingSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *ReplyVestingSchedules) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*VestingAction)(nil), "types.VestingAction")
+	proto.RegisterType((*VestingCreate)(nil), "types.VestingCreate")
+	proto.RegisterType((*VestingClaim)(nil), "types.VestingClaim")
+	proto.RegisterType((*VestingSchedule)(nil), "types.VestingSchedule")
+	proto.RegisterType((*ReceiptVesting)(nil), "types.ReceiptVesting")
+	proto.RegisterType((*ReplyVestingSchedule)(nil), "types.ReplyVestingSchedule")
+	proto.RegisterType((*ReqVestingSchedules)(nil), "types.ReqVestingSchedules")
+	proto.RegisterType((*ReplyVestingSchedules)(nil), "types.ReplyVestingSchedules")
+}
+
+func init() { proto.RegisterFile("vesting.proto", fileDescriptor_998de24bb17fd5b6) }
+
+var fileDescriptor_998de24bb17fd5b6 = []byte{
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x8a, 0xdb, 0x30,
+	0x10, 0xc7, 0xd7, 0x4e, 0x9c, 0xc4, 0x93, 0x64, 0x0b, 0xda, 0x74, 0x31, 0xb4, 0x2c, 0xc1, 0xa7,
+	0xd0, 0x42, 0x28, 0xe9, 0xa9, 0xc7, 0xb6, 0x14, 0x02, 0xbd, 0x69, 0xa1, 0x77, 0x45, 0x9e, 0x24,
+	0x02, 0x7f, 0x55, 0x56, 0x42, 0xfd, 0x00, 0x7d, 0xa5, 0xbe, 0x5d, 0x69, 0xd1, 0x47, 0xd6, 0x5e,
+	0xb3, 0x5d, 0xf7, 0xe6, 0xf9, 0xcf, 0x7f, 0xac, 0xd1, 0x6f, 0x24, 0xc1, 0xfc, 0x8c, 0x95, 0x12,
+	0xf9, 0x61, 0x5d, 0xca, 0x42, 0x15, 0x24, 0x50, 0x75, 0x89, 0x55, 0xfc, 0xd3, 0x83, 0xf9, 0x37,
+	0x9b, 0xf8, 0xc8, 0x95, 0x28, 0x72, 0xb2, 0x86, 0x11, 0x97, 0xc8, 0x14, 0x46, 0xde, 0xd2, 0x5b,
+	0x4d, 0x37, 0x8b, 0xb5, 0x71, 0xae, 0x9d, 0xeb, 0xb3, 0xc9, 0x6d, 0xaf, 0xa8, 0x73, 0x91, 0xb7,
+	0x10, 0xf0, 0x94, 0x89, 0x2c, 0xf2, 0x8d, 0xfd, 0xa6, 0x63, 0xd7, 0xa9, 0xed, 0x15, 0xb5, 0x1e,
+	0x72, 0x0d, 0xbe, 0xaa, 0xa3, 0xc1, 0xd2, 0x5b, 0x05, 0xd4, 0x57, 0xf5, 0xa7, 0x31, 0x04, 0x67,
+	0x96, 0x9e, 0x30, 0xfe, 0xd3, 0xf4, 0x61, 0x57, 0x20, 0x4b, 0x98, 0xee, 0x30, 0xc7, 0xbd, 0xe0,
+	0x82, 0xc9, 0xda, 0x34, 0x13, 0xd2, 0xb6, 0x44, 0x5e, 0x43, 0xc8, 0xaa, 0x0a, 0xd5, 0x97, 0x1f,
+	0xc8, 0xcd, 0xea, 0x21, 0x6d, 0x04, 0x5d, 0x6f, 0x82, 0xfb, 0x3a, 0xdb, 0x15, 0xa9, 0x59, 0x33,
+	0xa4, 0x6d, 0x89, 0xdc, 0xc2, 0x88, 0x65, 0xc5, 0x29, 0x57, 0xd1, 0x70, 0xe9, 0xad, 0x06, 0xd4,
+	0x45, 0xba, 0xb2, 0x52, 0x4c, 0xaa, 0x2d, 0x8a, 0xc3, 0x51, 0x45, 0x81, 0x49, 0xb6, 0x25, 0xed,
+	0xe0, 0xa9, 0xd8, 0xef, 0x9d, 0x63, 0x64, 0x1d, 0x2d, 0x49, 0xf7, 0x86, 0x79, 0xe2, 0xf2, 0x63,
+	0x93, 0x6f, 0x04, 0x42, 0x60, 0x98, 0x61, 0x56, 0x44, 0x13, 0xd3, 0x94, 0xf9, 0x8e, 0xef, 0x60,
+	0xd6, 0x66, 0xa6, 0x51, 0x89, 0xc4, 0x6d, 0xdb, 0x17, 0x49, 0xfc, 0xdb, 0x87, 0x17, 0xce, 0x70,
+	0xcf, 0x8f, 0x98, 0x9c, 0x52, 0xec, 0x7a, 0x48, 0x04, 0x63, 0x33, 0x95, 0x42, 0x3a, 0x1e, 0x97,
+	0xb0, 0x4b, 0x73, 0xd0, 0x43, 0x73, 0xd8, 0x43, 0x33, 0x78, 0x8e, 0xe6, 0xe8, 0x39, 0x9a, 0xe3,
+	0x5e, 0x9a, 0x93, 0x1e, 0x9a, 0x61, 0x97, 0xa6, 0xde, 0xb5, 0x46, 0x86, 0x49, 0x04, 0x26, 0x77,
+	0x09, 0x1f, 0x38, 0x4f, 0x1b, 0xce, 0x24, 0x86, 0x99, 0x3d, 0xb9, 0xee, 0x77, 0x33, 0x53, 0xf2,
+	0x48, 0xd3, 0x75, 0x2c, 0x49, 0x64, 0x34, 0xb7, 0x75, 0xfa, 0x3b, 0xce, 0xe1, 0x9a, 0x22, 0x47,
+	0x51, 0x2a, 0x37, 0x05, 0xf2, 0x06, 0x86, 0xa5, 0xc4, 0xb3, 0xbb, 0x27, 0xb7, 0x8f, 0x0f, 0xfe,
+	0x65, 0x46, 0xd4, 0x78, 0xc8, 0x3b, 0x18, 0xf3, 0x93, 0x94, 0x98, 0x2b, 0x77, 0x4f, 0xfe, 0x65,
+	0xbf, 0xd8, 0xe2, 0x5f, 0x1e, 0x2c, 0x28, 0x96, 0x69, 0xdd, 0x1d, 0xfa, 0x06, 0x26, 0x95, 0xfb,
+	0xee, 0x59, 0xfa, 0xc1, 0xa7, 0x87, 0x73, 0xb4, 0xdb, 0xf5, 0xed, 0x70, 0x6c, 0xa4, 0x75, 0xfd,
+	0x2c, 0x60, 0x62, 0x4e, 0xc4, 0x80, 0xba, 0x48, 0x03, 0x37, 0x0c, 0xd9, 0x2e, 0x45, 0x77, 0x3b,
+	0x1a, 0x41, 0x67, 0x25, 0x66, 0x4c, 0xe4, 0x22, 0x3f, 0xb8, 0xeb, 0xd1, 0x08, 0x71, 0x06, 0x37,
+	0x14, 0xbf, 0x77, 0x7a, 0xa9, 0xfe, 0xe3, 0x3e, 0xdf, 0x01, 0x94, 0x52, 0x64, 0x4c, 0xd6, 0x5f,
+	0xb1, 0x76, 0x07, 0xb8, 0xa5, 0x90, 0x05, 0x04, 0xdc, 0x1c, 0x30, 0xfb, 0x7e, 0xd8, 0x20, 0x96,
+	0xf0, 0xf2, 0x29, 0x4c, 0x15, 0xf9, 0x00, 0xe1, 0x65, 0xff, 0x55, 0xe4, 0x2d, 0x07, 0xab, 0xe9,
+	0xe6, 0x95, 0x03, 0xf5, 0x54, 0x01, 0x6d, 0xdc, 0x7d, 0x9d, 0xec, 0x46, 0xe6, 0x0d, 0x7d, 0xff,
+	0x37, 0x00, 0x00, 0xff, 0xff, 0x17, 0x31, 0xbf, 0x3d, 0x54, 0x05, 0x00, 0x00,
+}
@@ -32,6 +32,8 @@ type Mempool struct {
 	done              chan struct{}
 	removeBlockTicket *time.Ticker
 	cache             *txCache
+	feeFloor          int64
+	pusher            *txEventPusher
 }
 
 //GetSync 判断是否mempool 同步
@@ -60,6 +62,7 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	pool.poolHeader = make(chan struct{}, 2)
 	pool.removeBlockTicket = time.NewTicker(time.Minute)
 	pool.cache = newCache(cfg.MaxTxNumPerAccount, cfg.MaxTxLast)
+	pool.pusher = newTxEventPusher(pool.done)
 	return pool
 }
 
@@ -108,6 +111,11 @@ func (mem *Mempool) SetMinFee(fee int64) {
 	mem.proxyMtx.Unlock()
 }
 
+// AddTxEventCallBack 添加mempool交易事件的推送回调
+func (mem *Mempool) AddTxEventCallBack(cb *types.MempoolEventCB) error {
+	return mem.pusher.addTask(cb)
+}
+
 //SetQueueCache 设置排队策略
 func (mem *Mempool) SetQueueCache(qcache QueueCache) {
 	mem.cache.SetQueueCache(qcache)
@@ -126,7 +134,7 @@ func (mem *Mempool) getTxList(filterList *types.TxHashList) (txs []*types.Transa
 }
 
 func (mem *Mempool) filterTxList(count int64, dupMap map[string]bool) (txs []*types.Transaction) {
-	height := mem.header.GetHeight()
+	height := mem.packHeight()
 	blocktime := mem.header.GetBlockTime()
 	mem.cache.Walk(int(count), func(tx *Item) bool {
 		if dupMap != nil {
@@ -147,12 +155,14 @@ func (mem *Mempool) filterTxList(count int64, dupMap map[string]bool) (txs []*ty
 func (mem *Mempool) RemoveTxs(hashList *types.TxHashList) error {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
+	var removed []*types.Transaction
 	for _, hash := range hashList.Hashes {
 		exist := mem.cache.Exist(string(hash))
 		if exist {
-			mem.cache.Remove(string(hash))
+			removed = append(removed, mem.cache.Remove(string(hash)))
 		}
 	}
+	mem.pusher.notify(types.MempoolTxRemoved, types.MempoolRemoveEvicted, mem.header.GetHeight(), removed...)
 	return nil
 }
 
@@ -161,6 +171,10 @@ func (mem *Mempool) PushTx(tx *types.Transaction) error {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
 	err := mem.cache.Push(tx)
+	if err == nil {
+		mem.raiseFeeFloor()
+		mem.pusher.notify(types.MempoolTxAdded, 0, mem.header.GetHeight(), tx)
+	}
 	return err
 }
 
@@ -171,6 +185,12 @@ func (mem *Mempool) setHeader(h *types.Header) {
 	mem.proxyMtx.Unlock()
 }
 
+//packHeight 交易最早被打包到下一个区块，按照下一个区块的高度检查交易是否过期，
+//和执行器执行区块时的检查保持一致，调用者需要持有proxyMtx
+func (mem *Mempool) packHeight() int64 {
+	return mem.header.GetHeight() + 1
+}
+
 // GetHeader 获取Mempool.header
 func (mem *Mempool) GetHeader() *types.Header {
 	mem.proxyMtx.Lock()
@@ -244,7 +264,8 @@ func (mem *Mempool) pollLastHeader() {
 func (mem *Mempool) removeExpired() {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
-	mem.cache.removeExpiredTx(mem.header.GetHeight(), mem.header.GetBlockTime())
+	removed := mem.cache.removeExpiredTx(mem.packHeight(), mem.header.GetBlockTime())
+	mem.pusher.notify(types.MempoolTxRemoved, types.MempoolRemoveExpired, mem.header.GetHeight(), removed...)
 }
 
 // removeBlockedTxs 每隔1分钟清理一次已打包的交易
@@ -271,13 +292,16 @@ func (mem *Mempool) removeBlockedTxs() {
 func (mem *Mempool) RemoveTxsOfBlock(block *types.Block) bool {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
+	var removed []*types.Transaction
 	for _, tx := range block.Txs {
 		hash := tx.Hash()
 		exist := mem.cache.Exist(string(hash))
 		if exist {
-			mem.cache.Remove(string(hash))
+			removed = append(removed, mem.cache.Remove(string(hash)))
 		}
 	}
+	mem.pusher.notify(types.MempoolTxRemoved, types.MempoolRemoveMined, block.Height, removed...)
+	mem.decayFeeFloor()
 	return true
 }
 
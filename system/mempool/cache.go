@@ -46,11 +46,11 @@ func (cache *txCache) SetQueueCache(qcache QueueCache) {
 	cache.qcache = qcache
 }
 
-//Remove 移除txCache中给定tx
-func (cache *txCache) Remove(hash string) {
+//Remove 移除txCache中给定tx，返回被移除的交易
+func (cache *txCache) Remove(hash string) *types.Transaction {
 	item, err := cache.qcache.GetItem(hash)
 	if err != nil {
-		return
+		return nil
 	}
 	tx := item.Value
 	err = cache.qcache.Remove(hash)
@@ -59,6 +59,7 @@ func (cache *txCache) Remove(hash string) {
 	}
 	cache.AccountTxIndex.Remove(tx)
 	cache.LastTxCache.Remove(tx)
+	return tx
 }
 
 //Exist 是否存在
@@ -85,11 +86,14 @@ func (cache *txCache) Walk(count int, cb func(tx *Item) bool) {
 	cache.qcache.Walk(count, cb)
 }
 
-//RemoveTxs 删除一组交易
-func (cache *txCache) RemoveTxs(txs []string) {
+//RemoveTxs 删除一组交易，返回被删除的交易
+func (cache *txCache) RemoveTxs(txs []string) (removed []*types.Transaction) {
 	for _, t := range txs {
-		cache.Remove(t)
+		if tx := cache.Remove(t); tx != nil {
+			removed = append(removed, tx)
+		}
 	}
+	return removed
 }
 
 //Push 存入交易到cache 中
@@ -110,7 +114,7 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 	return nil
 }
 
-func (cache *txCache) removeExpiredTx(height, blocktime int64) {
+func (cache *txCache) removeExpiredTx(height, blocktime int64) []*types.Transaction {
 	var txs []string
 	cache.qcache.Walk(0, func(tx *Item) bool {
 		if isExpired(tx, height, blocktime) {
@@ -118,7 +122,7 @@ func (cache *txCache) removeExpiredTx(height, blocktime int64) {
 		}
 		return true
 	})
-	cache.RemoveTxs(txs)
+	return cache.RemoveTxs(txs)
 }
 
 //判断交易是否过期
@@ -0,0 +1,132 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bytes"
+	"container/heap"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+//candidate 打包候选交易，size和count按交易组整体计算
+type candidate struct {
+	item  *Item
+	size  int64
+	count int64
+}
+
+//feeRateBetter 按手续费率(fee/size)比较，费率相同时先进入mempool的优先
+func (c *candidate) feeRateBetter(other *candidate) bool {
+	left := c.item.Value.Fee * other.size
+	right := other.item.Value.Fee * c.size
+	if left != right {
+		return left > right
+	}
+	if c.item.EnterTime != other.item.EnterTime {
+		return c.item.EnterTime < other.item.EnterTime
+	}
+	return bytes.Compare(c.item.Value.Hash(), other.item.Value.Hash()) < 0
+}
+
+//senderQueue 同一个发送者的交易，保持进入mempool的顺序
+type senderQueue []*candidate
+
+//candidateHeap 各个发送者队首交易组成的最大堆
+type candidateHeap []senderQueue
+
+func (h candidateHeap) Len() int            { return len(h) }
+func (h candidateHeap) Less(i, j int) bool  { return h[i][0].feeRateBetter(h[j][0]) }
+func (h candidateHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *candidateHeap) Push(x interface{}) { *h = append(*h, x.(senderQueue)) }
+func (h *candidateHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[0 : n-1]
+	return x
+}
+
+//collectCandidates 收集没有过期，并且没有被排除的交易，按发送者分组
+func (mem *Mempool) collectCandidates(excludes map[string]bool) (txs []*types.Transaction, items map[string]*candidate) {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	height := mem.packHeight()
+	blocktime := mem.header.GetBlockTime()
+	items = make(map[string]*candidate)
+	mem.cache.Walk(0, func(item *Item) bool {
+		hash := string(item.Value.Hash())
+		if excludes[hash] || isExpired(item, height, blocktime) {
+			return true
+		}
+		count := int64(1)
+		if item.Value.GetGroupCount() > 1 {
+			count = int64(item.Value.GetGroupCount())
+		}
+		items[hash] = &candidate{item: item, size: int64(item.Value.Size()), count: count}
+		txs = append(txs, item.Value)
+		return true
+	})
+	return txs, items
+}
+
+// getBlockCandidate 按手续费率从高到低选取交易，同一个发送者的交易保持进入mempool的顺序，
+// 并且满足区块交易个数和大小的限制，选出的交易已经和链上的交易做过去重
+func (mem *Mempool) getBlockCandidate(req *types.ReqBlockCandidate) []*types.Transaction {
+	maxCount := req.GetMaxCount()
+	if maxCount <= 0 {
+		maxCount = types.GetP(req.GetHeight()).MaxTxNumber
+	}
+	maxSize := req.GetMaxSize()
+	if maxSize <= 0 {
+		maxSize = int64(types.MaxBlockSize)
+	}
+	excludes := make(map[string]bool)
+	for _, hash := range req.GetExcludes() {
+		excludes[string(hash)] = true
+	}
+	txs, items := mem.collectCandidates(excludes)
+	if len(txs) == 0 {
+		return nil
+	}
+	txs, err := util.CheckDupTx(mem.client, txs, req.GetHeight())
+	if err != nil {
+		mlog.Error("getBlockCandidate", "CheckDupTx err", err)
+		return nil
+	}
+	//txs 保持了mempool中的顺序，按发送者分组后即为每个发送者的交易顺序
+	senders := make(map[string]int)
+	var queues []senderQueue
+	for _, tx := range txs {
+		from := tx.From()
+		index, ok := senders[from]
+		if !ok {
+			index = len(queues)
+			senders[from] = index
+			queues = append(queues, nil)
+		}
+		queues[index] = append(queues[index], items[string(tx.Hash())])
+	}
+	h := candidateHeap(queues)
+	heap.Init(&h)
+	var selected []*types.Transaction
+	var count, size int64
+	for h.Len() > 0 {
+		sender := heap.Pop(&h).(senderQueue)
+		best := sender[0]
+		if count+best.count > maxCount || size+best.size > maxSize {
+			//为了保证发送者的交易顺序，这个发送者后面的交易都不能打包
+			continue
+		}
+		count += best.count
+		size += best.size
+		selected = append(selected, best.item.Value)
+		if len(sender) > 1 {
+			heap.Push(&h, sender[1:])
+		}
+	}
+	return selected
+}
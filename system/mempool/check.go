@@ -43,8 +43,50 @@ func (mem *Mempool) checkTxListRemote(txlist *types.ExecTxList) (*types.ReceiptC
 	return msg.GetData().(*types.ReceiptCheckTxList), nil
 }
 
+// simulateTx 在最新状态上模拟执行交易(不会写入状态)，返回一定会执行失败的交易的错误
+func (mem *Mempool) simulateTx(txs []*types.Transaction, header *types.Header) error {
+	if mem.client == nil {
+		panic("client not bind message queue.")
+	}
+	//按照下一个区块的环境执行
+	txlist := &types.ExecTxList{}
+	txlist.Txs = txs
+	txlist.StateHash = header.StateHash
+	txlist.ParentHash = header.Hash
+	txlist.Height = header.Height + 1
+	txlist.BlockTime = types.Now().Unix()
+	txlist.Difficulty = uint64(header.Difficulty)
+	txlist.IsMempool = true
+	msg := mem.client.NewMessage("execs", types.EventExecTxList, txlist)
+	err := mem.client.Send(msg, true)
+	if err != nil {
+		mlog.Error("execs closed", "err", err.Error())
+		return err
+	}
+	msg, err = mem.client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	if msg.Err() != nil {
+		return msg.Err()
+	}
+	receipts := msg.GetData().(*types.Receipts)
+	for _, receipt := range receipts.GetReceipts() {
+		if receipt.GetTy() == types.ExecOk {
+			continue
+		}
+		for _, log := range receipt.GetLogs() {
+			if log.GetTy() == types.TyLogErr {
+				return errors.New(string(log.GetLog()))
+			}
+		}
+		return types.ErrSimulateTxFail
+	}
+	return nil
+}
+
 func (mem *Mempool) checkExpireValid(tx *types.Transaction) bool {
-	if tx.IsExpire(mem.header.GetHeight(), mem.header.GetBlockTime()) {
+	if tx.IsExpire(mem.packHeight(), mem.header.GetBlockTime()) {
 		return false
 	}
 	if tx.Expire > 1000000000 && tx.Expire < types.Now().Unix()+int64(time.Minute/time.Second) {
@@ -56,6 +98,11 @@ func (mem *Mempool) checkExpireValid(tx *types.Transaction) bool {
 // CheckTx 初步检查并筛选交易消息
 func (mem *Mempool) checkTx(msg *queue.Message) *queue.Message {
 	tx := msg.GetData().(types.TxGroup).Tx()
+	// 检查交易是否是规范编码
+	if err := tx.CheckCanonical(); err != nil {
+		msg.Data = err
+		return msg
+	}
 	// 检查接收地址是否合法
 	if err := address.CheckAddress(tx.To); err != nil {
 		msg.Data = types.ErrInvalidAddress
@@ -87,7 +134,7 @@ func (mem *Mempool) checkTxs(msg *queue.Message) *queue.Message {
 	txmsg := msg.GetData().(*types.Transaction)
 	//普通的交易
 	tx := types.NewTransactionCache(txmsg)
-	err := tx.Check(header.GetHeight(), mem.cfg.MinTxFee, mem.cfg.MaxTxFee)
+	err := tx.Check(header.GetHeight(), mem.GetMinTxFee(), mem.cfg.MaxTxFee)
 	if err != nil {
 		msg.Data = err
 		return msg
@@ -158,6 +205,14 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		return msg
 	}
 	errstr := result.Errs[0]
+	if errstr == "" && mem.cfg.Simulate {
+		err = mem.simulateTx(temtxlist.Txs, lastheader)
+		if err != nil {
+			mlog.Error("simulate tx", "err", err)
+			msg.Data = err
+			return msg
+		}
+	}
 	if errstr == "" {
 		err1 := mem.PushTx(txlist.Txs[0])
 		if err1 != nil {
@@ -0,0 +1,75 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+//feeLevel mempool使用率(百分比)达到level时，最低手续费提高到 MinTxFee*multiple
+type feeLevel struct {
+	level    int64
+	multiple int64
+}
+
+//feeLevels 按使用率从高到低排列
+var feeLevels = []feeLevel{
+	{level: 90, multiple: 8},
+	{level: 75, multiple: 4},
+	{level: 50, multiple: 2},
+}
+
+// GetMinTxFee 返回当前mempool接受交易的最低手续费
+func (mem *Mempool) GetMinTxFee() int64 {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	return mem.minTxFee()
+}
+
+func (mem *Mempool) minTxFee() int64 {
+	if mem.feeFloor > mem.cfg.MinTxFee {
+		return mem.feeFloor
+	}
+	return mem.cfg.MinTxFee
+}
+
+//targetFeeFloor 根据当前的使用率计算最低手续费
+func (mem *Mempool) targetFeeFloor() int64 {
+	if mem.cfg.PoolCacheSize <= 0 {
+		return mem.cfg.MinTxFee
+	}
+	usage := int64(mem.cache.Size()) * 100 / mem.cfg.PoolCacheSize
+	for _, l := range feeLevels {
+		if usage >= l.level {
+			return mem.cfg.MinTxFee * l.multiple
+		}
+	}
+	return mem.cfg.MinTxFee
+}
+
+//raiseFeeFloor 交易加入mempool后，使用率超过阈值立即提高最低手续费
+func (mem *Mempool) raiseFeeFloor() {
+	if !mem.cfg.DynamicFee {
+		return
+	}
+	target := mem.targetFeeFloor()
+	if target > mem.feeFloor {
+		mem.feeFloor = target
+		mlog.Info("raise min tx fee", "fee", target, "size", mem.cache.Size())
+	}
+}
+
+//decayFeeFloor 交易被打包后，使用率下降，最低手续费每次减半，直到恢复到目标值
+func (mem *Mempool) decayFeeFloor() {
+	if !mem.cfg.DynamicFee {
+		return
+	}
+	target := mem.targetFeeFloor()
+	if target >= mem.feeFloor {
+		return
+	}
+	floor := mem.feeFloor / 2
+	if floor < target {
+		floor = target
+	}
+	mem.feeFloor = floor
+	mlog.Debug("decay min tx fee", "fee", floor, "size", mem.cache.Size())
+}
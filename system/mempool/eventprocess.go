@@ -90,6 +90,12 @@ func (mem *Mempool) eventProcess() {
 		case types.EventGetProperFee:
 			// 获取对应排队策略中合适的手续费
 			mem.eventGetProperFee(msg)
+		case types.EventGetBlockCandidate:
+			// 获取按手续费率排序的打包候选交易
+			mem.eventGetBlockCandidate(msg)
+		case types.EventAddMempoolTxEventCB:
+			// 订阅mempool中交易的加入和删除事件
+			mem.eventAddTxEventCB(msg)
 		default:
 		}
 		mlog.Debug("mempool", "cost", types.Since(beg), "msg", types.GetEventName(int(msg.Ty)))
@@ -192,8 +198,31 @@ func (mem *Mempool) eventGetAddrTxs(msg *queue.Message) {
 // eventGetProperFee 获取排队策略中合适的手续费
 func (mem *Mempool) eventGetProperFee(msg *queue.Message) {
 	properFee := mem.cache.qcache.GetProperFee()
+	minTxFee := mem.GetMinTxFee()
+	//拥堵的时候，合适的手续费不能低于当前的最低手续费
+	if properFee < minTxFee {
+		properFee = minTxFee
+	}
 	msg.Reply(mem.client.NewMessage("rpc", types.EventReplyProperFee,
-		&types.ReplyProperFee{ProperFee: properFee}))
+		&types.ReplyProperFee{ProperFee: properFee, MinTxFee: minTxFee}))
+}
+
+// eventGetBlockCandidate 获取打包区块的候选交易集合
+func (mem *Mempool) eventGetBlockCandidate(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqBlockCandidate)
+	txs := mem.getBlockCandidate(req)
+	msg.Reply(mem.client.NewMessage("", types.EventReplyTxList, &types.ReplyTxList{Txs: txs}))
+}
+
+// eventAddTxEventCB 添加mempool交易事件的推送回调
+func (mem *Mempool) eventAddTxEventCB(msg *queue.Message) {
+	reply := &types.Reply{IsOk: true}
+	err := mem.AddTxEventCallBack(msg.GetData().(*types.MempoolEventCB))
+	if err != nil {
+		reply.IsOk = false
+		reply.Msg = []byte(err.Error())
+	}
+	msg.Reply(mem.client.NewMessage("rpc", types.EventAddMempoolTxEventCB, reply))
 }
 
 func (mem *Mempool) checkSign(data *queue.Message) *queue.Message {
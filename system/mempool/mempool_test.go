@@ -5,9 +5,14 @@
 package mempool
 
 import (
+	"compress/gzip"
 	"errors"
+	"io/ioutil"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/33cn/chain33/blockchain"
 	"github.com/33cn/chain33/common"
@@ -601,6 +606,96 @@ func TestGetProperFee(t *testing.T) {
 	}
 }
 
+func TestDynamicMinTxFee(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cfg.DynamicFee = true
+	minFee := mem.cfg.MinTxFee
+
+	var txs []*types.Transaction
+	for i := 0; i < 75; i++ {
+		tx := createTx(mainPriv, toAddr, 10000)
+		assert.Nil(t, mem.PushTx(tx))
+		txs = append(txs, tx)
+		if i == 49 {
+			assert.Equal(t, 2*minFee, mem.GetMinTxFee())
+		}
+	}
+	assert.Equal(t, 4*minFee, mem.GetMinTxFee())
+
+	msg := mem.client.NewMessage("mempool", types.EventGetProperFee, nil)
+	mem.client.Send(msg, true)
+	reply, err := mem.client.Wait(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, 4*minFee, reply.GetData().(*types.ReplyProperFee).GetMinTxFee())
+	assert.Equal(t, 4*minFee, reply.GetData().(*types.ReplyProperFee).GetProperFee())
+
+	//低于当前最低手续费的交易被拒绝
+	tx := createTx(mainPriv, toAddr, 10000)
+	tx.Fee = 2 * minFee
+	tx.Sign(types.SECP256K1, mainPriv)
+	msg = mem.client.NewMessage("mempool", types.EventTx, tx)
+	mem.client.Send(msg, true)
+	resp, _ := mem.client.Wait(msg)
+	assert.Equal(t, types.ErrTxFeeTooLow.Error(), string(resp.GetData().(*types.Reply).GetMsg()))
+
+	//打包以后逐步恢复
+	mem.RemoveTxsOfBlock(&types.Block{Txs: txs[:50]})
+	assert.Equal(t, 2*minFee, mem.GetMinTxFee())
+	mem.RemoveTxsOfBlock(&types.Block{})
+	assert.Equal(t, minFee, mem.GetMinTxFee())
+}
+
+func TestTxEventCallBack(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+
+	events := make(chan *types.MempoolTxEvent, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g, err := gzip.NewReader(r.Body)
+		assert.Nil(t, err)
+		data, err := ioutil.ReadAll(g)
+		assert.Nil(t, err)
+		var list types.MempoolTxEvents
+		assert.Nil(t, types.Decode(data, &list))
+		for _, event := range list.Events {
+			events <- event
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	msg := mem.client.NewMessage("mempool", types.EventAddMempoolTxEventCB, &types.MempoolEventCB{Name: "test", URL: ts.URL})
+	mem.client.Send(msg, true)
+	resp, err := mem.client.Wait(msg)
+	assert.Nil(t, err)
+	assert.True(t, resp.GetData().(*types.Reply).GetIsOk())
+
+	tx := createTx(mainPriv, toAddr, 10000)
+	assert.Nil(t, mem.PushTx(tx))
+	event := <-events
+	assert.Equal(t, int32(types.MempoolTxAdded), event.Ty)
+	assert.Equal(t, tx.Hash(), event.Tx.Hash())
+
+	mem.RemoveTxsOfBlock(&types.Block{Height: 3, Txs: []*types.Transaction{tx}})
+	event = <-events
+	assert.Equal(t, int32(types.MempoolTxRemoved), event.Ty)
+	assert.Equal(t, int32(types.MempoolRemoveMined), event.Reason)
+	assert.Equal(t, int64(3), event.Height)
+	assert.Equal(t, tx.Hash(), event.Tx.Hash())
+
+	//删除回调以后不再推送
+	assert.Nil(t, mem.AddTxEventCallBack(&types.MempoolEventCB{Name: "test"}))
+	assert.Nil(t, mem.PushTx(createTx(mainPriv, toAddr, 10000)))
+	select {
+	case <-events:
+		t.Error("callback deleted")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func TestCheckLowFee(t *testing.T) {
 	q, mem := initEnv(0)
 	defer q.Close()
@@ -616,6 +711,27 @@ func TestCheckLowFee(t *testing.T) {
 	}
 }
 
+func TestSimulateTx(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cfg.Simulate = true
+
+	tx := createTx(mainPriv, toAddr, 1e11)
+	msg := mem.client.NewMessage("mempool", types.EventTx, tx)
+	mem.client.Send(msg, true)
+	resp, _ := mem.client.Wait(msg)
+	assert.Equal(t, types.ErrNoBalance.Error(), string(resp.GetData().(*types.Reply).GetMsg()))
+	assert.Equal(t, 0, mem.Size())
+
+	tx = createTx(mainPriv, toAddr, 1e8)
+	msg = mem.client.NewMessage("mempool", types.EventTx, tx)
+	mem.client.Send(msg, true)
+	resp, _ = mem.client.Wait(msg)
+	assert.True(t, resp.GetData().(*types.Reply).GetIsOk())
+	assert.Equal(t, 1, mem.Size())
+}
+
 func TestCheckSignature(t *testing.T) {
 	q, mem := initEnv(0)
 	defer q.Close()
@@ -692,6 +808,58 @@ func TestCheckExpire3(t *testing.T) {
 	assert.Equal(t, mem.Size(), 3)
 }
 
+func TestCheckExpireHeight(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+
+	//交易最早打包在下一个区块，下一个区块高度等于expire的时候已经过期
+	ctx1 := *tx1
+	ctx1.Expire = 10
+	ctx1.Sign(types.SECP256K1, privKey)
+	mem.setHeader(&types.Header{Height: 9, BlockTime: 1e9 + 1})
+	msg := mem.client.NewMessage("mempool", types.EventTx, &ctx1)
+	mem.client.Send(msg, true)
+	resp, _ := mem.client.Wait(msg)
+	assert.Equal(t, types.ErrTxExpire.Error(), string(resp.GetData().(*types.Reply).GetMsg()))
+
+	mem.setHeader(&types.Header{Height: 8, BlockTime: 1e9 + 1})
+	msg = mem.client.NewMessage("mempool", types.EventTx, &ctx1)
+	mem.client.Send(msg, true)
+	resp, _ = mem.client.Wait(msg)
+	assert.True(t, resp.GetData().(*types.Reply).GetIsOk())
+
+	mem.setHeader(&types.Header{Height: 9, BlockTime: 1e9 + 1})
+	mem.removeExpired()
+	assert.Equal(t, 0, mem.Size())
+}
+
+//tx1在高度2过期，以前按当前高度1检查会接受，现在按下一个区块的高度2检查已经过期
+func TestCheckExpireNextBlock(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+
+	mem.setHeader(&types.Header{Height: 1, BlockTime: 1})
+	msg := mem.client.NewMessage("mempool", types.EventTx, tx1)
+	mem.client.Send(msg, true)
+	resp, _ := mem.client.Wait(msg)
+	assert.Equal(t, types.ErrTxExpire.Error(), string(resp.GetData().(*types.Reply).GetMsg()))
+
+	mem.setHeader(&types.Header{Height: 0, BlockTime: 1})
+	msg = mem.client.NewMessage("mempool", types.EventTx, tx1)
+	mem.client.Send(msg, true)
+	resp, _ = mem.client.Wait(msg)
+	assert.True(t, resp.GetData().(*types.Reply).GetIsOk())
+	assert.Equal(t, 1, len(mem.getTxList(&types.TxHashList{Count: 10})))
+
+	//区块高度到1以后，tx1不能再被打包，从mempool中删除
+	mem.setHeader(&types.Header{Height: 1, BlockTime: 1})
+	assert.Equal(t, 0, len(mem.getTxList(&types.TxHashList{Count: 10})))
+	mem.removeExpired()
+	assert.Equal(t, 0, mem.Size())
+}
+
 func TestWrongToAddr(t *testing.T) {
 	q, mem := initEnv(0)
 	defer q.Close()
@@ -834,7 +1002,7 @@ func blockchainProcess(q queue.Queue) {
 		client.Sub("blockchain")
 		for msg := range client.Recv() {
 			if msg.Ty == types.EventGetLastHeader {
-				msg.Reply(client.NewMessage("", types.EventHeader, &types.Header{Height: 1, BlockTime: 1}))
+				msg.Reply(client.NewMessage("", types.EventHeader, &types.Header{Height: 0, BlockTime: 1}))
 			} else if msg.Ty == types.EventIsSync {
 				msg.Reply(client.NewMessage("", types.EventReplyIsSync, &types.IsCaughtUp{Iscaughtup: true}))
 			} else if msg.Ty == types.EventTxHashList {
@@ -865,7 +1033,98 @@ func execProcess(q queue.Queue) {
 					result.Errs = append(result.Errs, "")
 				}
 				msg.Reply(client.NewMessage("", types.EventReceiptCheckTx, result))
+			} else if msg.Ty == types.EventExecTxList {
+				//模拟执行：转账金额超过1e10的交易余额不足
+				datas := msg.GetData().(*types.ExecTxList)
+				result := &types.Receipts{}
+				for _, tx := range datas.Txs {
+					var action cty.CoinsAction
+					types.Decode(tx.Payload, &action)
+					if action.GetTransfer().GetAmount() > 1e10 {
+						errlog := &types.ReceiptLog{Ty: types.TyLogErr, Log: []byte(types.ErrNoBalance.Error())}
+						result.Receipts = append(result.Receipts, &types.Receipt{Ty: types.ExecPack, Logs: []*types.ReceiptLog{errlog}})
+						continue
+					}
+					result.Receipts = append(result.Receipts, &types.Receipt{Ty: types.ExecOk})
+				}
+				msg.Reply(client.NewMessage("", types.EventReceipts, result))
 			}
 		}
 	}()
 }
+
+func initCandidateEnv(t *testing.T) (queue.Queue, *Mempool, []*types.Transaction) {
+	q, mem := initEnv(0)
+	_, priv := genaddress()
+	a1 := createTx(mainPriv, toAddr, 10000)
+	a1.Fee = 1e6
+	a2 := createTx(mainPriv, toAddr, 10000)
+	a2.Fee = 9e8
+	b1 := createTx(priv, toAddr, 10000)
+	b1.Fee = 5e8
+	txs := []*types.Transaction{a1, a2, b1}
+	for _, tx := range txs {
+		tx.Sign(types.SECP256K1, mainPriv)
+		if tx == b1 {
+			tx.Sign(types.SECP256K1, priv)
+		}
+		//直接放入mempool，避免模拟的blockchain把交易当成重复交易
+		assert.Nil(t, mem.PushTx(tx))
+	}
+	return q, mem, txs
+}
+
+func getBlockCandidate(t *testing.T, mem *Mempool, req *types.ReqBlockCandidate) []*types.Transaction {
+	msg := mem.client.NewMessage("mempool", types.EventGetBlockCandidate, req)
+	mem.client.Send(msg, true)
+	reply, err := mem.client.Wait(msg)
+	assert.Nil(t, err)
+	return reply.GetData().(*types.ReplyTxList).GetTxs()
+}
+
+func TestGetBlockCandidate(t *testing.T) {
+	q, mem, txs := initCandidateEnv(t)
+	defer q.Close()
+	defer mem.Close()
+	a1, a2, b1 := txs[0], txs[1], txs[2]
+
+	//a2 手续费最高，但是必须排在同一个发送者的a1之后
+	candidate := getBlockCandidate(t, mem, &types.ReqBlockCandidate{Height: 2})
+	assert.Equal(t, []*types.Transaction{b1, a1, a2}, candidate)
+
+	//已经上链的交易被去重
+	candidate = getBlockCandidate(t, mem, &types.ReqBlockCandidate{Height: 2})
+	assert.Equal(t, 0, len(candidate))
+}
+
+func TestGetBlockCandidateCount(t *testing.T) {
+	q, mem, txs := initCandidateEnv(t)
+	defer q.Close()
+	defer mem.Close()
+	a1, b1 := txs[0], txs[2]
+
+	candidate := getBlockCandidate(t, mem, &types.ReqBlockCandidate{Height: 2, MaxCount: 2})
+	assert.Equal(t, []*types.Transaction{b1, a1}, candidate)
+}
+
+func TestGetBlockCandidateSize(t *testing.T) {
+	q, mem, txs := initCandidateEnv(t)
+	defer q.Close()
+	defer mem.Close()
+	b1 := txs[2]
+
+	//只能放下一笔交易，a1放不下时a2也不能打包
+	candidate := getBlockCandidate(t, mem, &types.ReqBlockCandidate{Height: 2, MaxSize: int64(b1.Size())})
+	assert.Equal(t, []*types.Transaction{b1}, candidate)
+}
+
+func TestGetBlockCandidateExclude(t *testing.T) {
+	q, mem, txs := initCandidateEnv(t)
+	defer q.Close()
+	defer mem.Close()
+	a1, a2, b1 := txs[0], txs[1], txs[2]
+
+	//a1 已经被选中过，a2 的手续费率最高
+	candidate := getBlockCandidate(t, mem, &types.ReqBlockCandidate{Height: 2, Excludes: [][]byte{a1.Hash()}})
+	assert.Equal(t, []*types.Transaction{a2, b1}, candidate)
+}
@@ -0,0 +1,180 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	//maxTxEventCB 最多允许的回调个数
+	maxTxEventCB = 20
+	//txEventBufSize 每个回调缓存的事件个数，超过以后丢弃新的事件
+	txEventBufSize = 10240
+	//maxTxEventBatch 每次推送的最多事件个数
+	maxTxEventBatch = 1000
+)
+
+//txEventTask 每个回调一个task
+type txEventTask struct {
+	cb     *types.MempoolEventCB
+	events chan *types.MempoolTxEvent
+	quit   chan struct{}
+}
+
+//txEventPusher 把mempool中交易的变化推送给订阅者
+type txEventPusher struct {
+	mu     sync.Mutex
+	tasks  map[string]*txEventTask
+	client *http.Client
+	done   <-chan struct{}
+}
+
+func newTxEventPusher(done <-chan struct{}) *txEventPusher {
+	return &txEventPusher{
+		tasks:  make(map[string]*txEventTask),
+		client: &http.Client{Timeout: 10 * time.Second},
+		done:   done,
+	}
+}
+
+//addTask 添加回调，同名的回调会被替换，URL为空表示删除
+func (p *txEventPusher) addTask(cb *types.MempoolEventCB) error {
+	if cb == nil || cb.Name == "" {
+		return types.ErrInvalidParam
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if task, ok := p.tasks[cb.Name]; ok {
+		close(task.quit)
+		delete(p.tasks, cb.Name)
+	}
+	if cb.URL == "" {
+		mlog.Debug("delete tx event callback", "cb", cb)
+		return nil
+	}
+	if len(p.tasks) >= maxTxEventCB {
+		return types.ErrTooManySeqCB
+	}
+	task := &txEventTask{
+		cb:     cb,
+		events: make(chan *types.MempoolTxEvent, txEventBufSize),
+		quit:   make(chan struct{}),
+	}
+	p.tasks[cb.Name] = task
+	go p.runTask(task)
+	mlog.Debug("run tx event callback", "cb", cb)
+	return nil
+}
+
+//notify 不会阻塞mempool，订阅者处理不过来的时候丢弃事件
+func (p *txEventPusher) notify(ty int32, reason int32, height int64, txs ...*types.Transaction) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.tasks) == 0 {
+		return
+	}
+	for _, tx := range txs {
+		event := &types.MempoolTxEvent{Ty: ty, Reason: reason, Tx: tx, Height: height}
+		for _, task := range p.tasks {
+			select {
+			case task.events <- event:
+			default:
+				mlog.Error("tx event dropped", "cb.name", task.cb.Name, "ty", ty)
+			}
+		}
+	}
+}
+
+func (p *txEventPusher) runTask(task *txEventTask) {
+	for {
+		var events []*types.MempoolTxEvent
+		select {
+		case event := <-task.events:
+			events = append(events, event)
+		case <-task.quit:
+			return
+		case <-p.done:
+			return
+		}
+		//把已经缓存的事件一起推送
+	batch:
+		for len(events) < maxTxEventBatch {
+			select {
+			case event := <-task.events:
+				events = append(events, event)
+			default:
+				break batch
+			}
+		}
+		for {
+			err := p.postData(task.cb, &types.MempoolTxEvents{Events: events})
+			if err == nil {
+				break
+			}
+			mlog.Error("post tx events", "cb.name", task.cb.Name, "err", err)
+			select {
+			case <-time.After(time.Second):
+			case <-task.quit:
+				return
+			case <-p.done:
+				return
+			}
+		}
+	}
+}
+
+func (p *txEventPusher) postData(cb *types.MempoolEventCB, data *types.MempoolTxEvents) (err error) {
+	var postdata []byte
+
+	if cb.Encode == "json" {
+		postdata, err = types.PBToJSON(data)
+		if err != nil {
+			return err
+		}
+	} else {
+		postdata = types.Encode(data)
+	}
+
+	//post data in body
+	var buf bytes.Buffer
+	g := gzip.NewWriter(&buf)
+	if _, err = g.Write(postdata); err != nil {
+		return err
+	}
+	if err = g.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", cb.URL, &buf)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if string(body) != "ok" && string(body) != "OK" {
+		mlog.Error("postData fail", "cb.name", cb.Name, "body", string(body))
+		return types.ErrPushSeqPostData
+	}
+	mlog.Debug("postData success", "cb.name", cb.Name, "count", len(data.Events))
+	return nil
+}
@@ -5,9 +5,13 @@
 package store
 
 import (
+	"time"
+
+	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/metrics"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
@@ -22,6 +26,9 @@ import (
 //批量读
 2. EventStoreGet(stateHash, k1,k2,k3)
 
+//存在或者不存在证明
+3. EventStoreGetProof(stateHash, k) -> 返回 StoreProof
+
 */
 
 var slog = log.New("module", "store")
@@ -29,6 +36,18 @@ var slog = log.New("module", "store")
 // EmptyRoot mavl树空的根hash
 var EmptyRoot [32]byte
 
+//defaultSlowOperation 默认的慢操作阈值
+const defaultSlowOperation = time.Second
+
+var (
+	storeGetSeconds    = metrics.NewHistogram("chain33_store_get_seconds", "Latency of store get.", metrics.LatencyBuckets)
+	storeSetSeconds    = metrics.NewHistogram("chain33_store_set_seconds", "Latency of store set and memset.", metrics.LatencyBuckets)
+	storeCommitSeconds = metrics.NewHistogram("chain33_store_commit_seconds", "Latency of store commit.", metrics.LatencyBuckets)
+	storeGetKeys       = metrics.NewHistogram("chain33_store_get_keys", "Keys read by one store get.", metrics.SizeBuckets)
+	storeSetKeys       = metrics.NewHistogram("chain33_store_set_keys", "Key values written by one store set or memset.", metrics.SizeBuckets)
+	storeSlowOps       = metrics.NewCounter("chain33_store_slow_operations_total", "Store operations slower than the slow operation threshold.")
+)
+
 // SetLogLevel set log level
 func SetLogLevel(level string) {
 	clog.SetLogLevel(level)
@@ -55,19 +74,35 @@ type SubStore interface {
 	CommitUpgrade(hash *types.ReqHash) ([]byte, error)
 }
 
+// ProofStore 支持生成存在或者不存在证明的store
+type ProofStore interface {
+	GetProof(req *types.ReqStoreProof) (*types.StoreProof, error)
+}
+
 // BaseStore 基础的store结构体
 type BaseStore struct {
 	db      dbm.DB
 	qclient queue.Client
 	done    chan struct{}
 	child   SubStore
+	//slowOp 超过这个耗时的操作打印日志，0表示不打印
+	slowOp time.Duration
 }
 
 // NewBaseStore new base store struct
 func NewBaseStore(cfg *types.Store) *BaseStore {
 	db := dbm.NewDB("store", cfg.Driver, cfg.DbPath, cfg.DbCache)
 	db.SetCacheSize(102400)
-	store := &BaseStore{db: db}
+	if err := Migrate(cfg.Name, db, cfg.DbPath); err != nil {
+		db.Close()
+		panic(err)
+	}
+	store := &BaseStore{db: db, slowOp: defaultSlowOperation}
+	if cfg.SlowOperationMs > 0 {
+		store.slowOp = time.Duration(cfg.SlowOperationMs) * time.Millisecond
+	} else if cfg.SlowOperationMs < 0 {
+		store.slowOp = 0
+	}
 	store.done = make(chan struct{}, 1)
 	slog.Info("Enter store " + cfg.Name)
 	return store
@@ -96,7 +131,10 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 	if msg.Ty == types.EventStoreSet {
 		go func() {
 			datas := msg.GetData().(*types.StoreSetWithSync)
+			beg := time.Now()
 			hash, err := store.child.Set(datas.Storeset, datas.Sync)
+			storeSetKeys.Observe(float64(len(datas.Storeset.GetKV())))
+			store.trace("set", storeSetSeconds, beg, "height", datas.Storeset.GetHeight(), "kvs", len(datas.Storeset.GetKV()))
 			if err != nil {
 				msg.Reply(client.NewMessage("", types.EventStoreSetReply, err))
 				return
@@ -106,7 +144,10 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 	} else if msg.Ty == types.EventStoreGet {
 		go func() {
 			datas := msg.GetData().(*types.StoreGet)
+			beg := time.Now()
 			values := store.child.Get(datas)
+			storeGetKeys.Observe(float64(len(datas.Keys)))
+			store.trace("get", storeGetSeconds, beg, "keys", len(datas.Keys))
 			msg.Reply(client.NewMessage("", types.EventStoreGetReply, &types.StoreReplyValue{Values: values}))
 		}()
 	} else if msg.Ty == types.EventStoreMemSet { //只是在内存中set 一下，并不改变状态
@@ -114,11 +155,14 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 			datas := msg.GetData().(*types.StoreSetWithSync)
 			var hash []byte
 			var err error
+			beg := time.Now()
 			if datas.Upgrade {
 				hash, err = store.child.MemSetUpgrade(datas.Storeset, datas.Sync)
 			} else {
 				hash, err = store.child.MemSet(datas.Storeset, datas.Sync)
 			}
+			storeSetKeys.Observe(float64(len(datas.Storeset.GetKV())))
+			store.trace("memset", storeSetSeconds, beg, "height", datas.Storeset.GetHeight(), "kvs", len(datas.Storeset.GetKV()))
 			if err != nil {
 				msg.Reply(client.NewMessage("", types.EventStoreSetReply, err))
 				return
@@ -130,11 +174,13 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 			req := msg.GetData().(*types.ReqHash)
 			var hash []byte
 			var err error
+			beg := time.Now()
 			if req.Upgrade {
 				hash, err = store.child.CommitUpgrade(req)
 			} else {
 				hash, err = store.child.Commit(req)
 			}
+			store.trace("commit", storeCommitSeconds, beg, "hash", common.ToHex(req.Hash))
 			if hash == nil {
 				msg.Reply(client.NewMessage("", types.EventStoreCommit, types.ErrHashNotFound))
 				if err == types.ErrDataBaseDamage { //如果是数据库写失败，需要上报给用户
@@ -178,11 +224,34 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 			query := NewStoreListQuery(store.child, req)
 			msg.Reply(client.NewMessage("", types.EventStoreListReply, query.Run()))
 		}()
+	} else if msg.Ty == types.EventStoreGetProof {
+		go func() {
+			proofStore, ok := store.child.(ProofStore)
+			if !ok {
+				msg.Reply(client.NewMessage("", types.EventStoreGetProofReply, types.ErrActionNotSupport))
+				return
+			}
+			proof, err := proofStore.GetProof(msg.GetData().(*types.ReqStoreProof))
+			if err != nil {
+				msg.Reply(client.NewMessage("", types.EventStoreGetProofReply, err))
+				return
+			}
+			msg.Reply(client.NewMessage("", types.EventStoreGetProofReply, proof))
+		}()
 	} else {
 		go store.child.ProcEvent(msg)
 	}
 }
 
+//trace 记录操作耗时，超过慢操作阈值的打印日志
+func (store *BaseStore) trace(op string, h *metrics.Histogram, beg time.Time, ctx ...interface{}) {
+	cost := h.ObserveSince(beg)
+	if store.slowOp > 0 && cost >= store.slowOp {
+		storeSlowOps.Inc()
+		slog.Warn("slow store operation", append([]interface{}{"op", op, "cost", cost}, ctx...)...)
+	}
+}
+
 // SetChild 设置BaseStore中的子存储参数
 func (store *BaseStore) SetChild(sub SubStore) {
 	store.child = sub
@@ -222,7 +291,7 @@ type StorelistQuery struct {
 
 // Run store list query
 func (t *StorelistQuery) Run() *types.StoreListReply {
-	t.store.IterateRangeByStateHash(t.req.StateHash, t.req.Start, t.req.End, true, t.IterateCallBack)
+	t.store.IterateRangeByStateHash(t.req.StateHash, t.req.Start, t.req.End, !t.req.Reverse, t.IterateCallBack)
 	return t.StoreListReply
 }
 
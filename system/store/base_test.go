@@ -7,6 +7,7 @@ package store
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/33cn/chain33/common/log"
 	"github.com/33cn/chain33/queue"
@@ -169,6 +170,24 @@ func TestBaseStore_Queue(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, int64(types.EventStoreListReply), resp.Ty)
 
+	//set和memset都记录在set的耗时中
+	assert.True(t, storeGetSeconds.Count() >= 1)
+	assert.True(t, storeSetSeconds.Count() >= 2)
+	assert.True(t, storeCommitSeconds.Count() >= 1)
+	assert.True(t, storeSetKeys.Sum() >= 4)
+}
+
+func TestBaseStoreSlowOperation(t *testing.T) {
+	store := &BaseStore{slowOp: time.Millisecond}
+	slow := storeSlowOps.Value()
+	store.trace("get", storeGetSeconds, time.Now())
+	assert.Equal(t, slow, storeSlowOps.Value())
+	store.trace("get", storeGetSeconds, time.Now().Add(-time.Second), "keys", 1)
+	assert.Equal(t, slow+1, storeSlowOps.Value())
+	//不打印慢操作日志
+	store.slowOp = 0
+	store.trace("get", storeGetSeconds, time.Now().Add(-time.Second))
+	assert.Equal(t, slow+1, storeSlowOps.Value())
 }
 
 func TestSubStore(t *testing.T) {
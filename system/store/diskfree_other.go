@@ -0,0 +1,12 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build windows plan9
+
+package store
+
+//diskFree 不支持获取剩余空间，不做检查
+func diskFree(dir string) int64 {
+	return -1
+}
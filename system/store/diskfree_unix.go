@@ -0,0 +1,27 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !windows,!plan9
+
+package store
+
+import (
+	"path/filepath"
+	"syscall"
+)
+
+//diskFree dir所在磁盘的剩余字节数，dir不存在的时候检查上级目录，获取失败返回-1
+func diskFree(dir string) int64 {
+	for {
+		var st syscall.Statfs_t
+		if err := syscall.Statfs(dir, &st); err == nil {
+			return int64(st.Bavail) * int64(st.Bsize)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return -1
+		}
+		dir = parent
+	}
+}
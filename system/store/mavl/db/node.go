@@ -181,6 +181,9 @@ func (node *Node) Hash(t *Tree) []byte {
 		innernode.Height = node.height
 		innernode.Size = node.size
 
+		if hashInParallel(node) {
+			node.hashChildren(t)
+		}
 		// left
 		if node.leftNode != nil {
 			leftHash := node.leftNode.Hash(t)
@@ -0,0 +1,121 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+)
+
+//parallelHashHeight 子树高度不小于这个值并且左右子树都需要计算hash的时候并行计算
+const parallelHashHeight = 6
+
+var (
+	// 是否并行计算子树的hash，memtree需要在计算hash的时候更新tree的缓存，不能并行
+	enableParallelHash = true
+	// 当前goroutine也计算hash，单核的时候不并行
+	hashWorkers = make(chan struct{}, runtime.NumCPU()-1)
+	// 是否异步写入commit的batch
+	enableCommitPipeline bool
+	pipe                 = &commitPipe{}
+)
+
+// EnableCommitPipeline 使能异步写入mavl树，写盘和下一个区块的执行并行，使能裁剪的时候不起作用
+func EnableCommitPipeline(enable bool) {
+	enableCommitPipeline = enable
+}
+
+//hashInParallel 左右子树都没有计算过hash，并且有空闲的worker的时候并行计算
+func hashInParallel(node *Node) bool {
+	if !enableParallelHash || enableMemTree || node.height < parallelHashHeight {
+		return false
+	}
+	if node.leftNode == nil || node.rightNode == nil || node.leftNode.hash != nil || node.rightNode.hash != nil {
+		return false
+	}
+	select {
+	case hashWorkers <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+//hashChildren 左子树在新的goroutine中计算，右子树在当前goroutine中计算
+func (node *Node) hashChildren(t *Tree) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer func() {
+			<-hashWorkers
+			wg.Done()
+		}()
+		node.leftNode.Hash(t)
+	}()
+	node.rightNode.Hash(t)
+	wg.Wait()
+}
+
+//commitPipe 同时只有一个batch在异步写入，写入完成之前节点从pending中读取
+type commitPipe struct {
+	mtx     sync.RWMutex
+	pending map[string][]byte
+	done    chan struct{}
+	err     error
+}
+
+//write 等待上一个batch写完以后异步写入batch，上一个batch写入失败的时候返回错误
+func (p *commitPipe) write(batch dbm.Batch, nodes map[string][]byte) error {
+	if err := p.wait(); err != nil {
+		return err
+	}
+	p.mtx.Lock()
+	p.pending = nodes
+	p.done = make(chan struct{})
+	done := p.done
+	p.mtx.Unlock()
+	go func() {
+		beg := time.Now()
+		err := batch.Write()
+		commitSeconds.ObserveSince(beg)
+		if err != nil {
+			treelog.Error("commitPipe batch.Write err", "err", err)
+		}
+		p.mtx.Lock()
+		p.pending = nil
+		p.err = err
+		p.mtx.Unlock()
+		close(done)
+	}()
+	return nil
+}
+
+//wait 等待正在写入的batch完成，返回写入的错误
+func (p *commitPipe) wait() error {
+	p.mtx.RLock()
+	done := p.done
+	p.mtx.RUnlock()
+	if done != nil {
+		<-done
+	}
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	return p.err
+}
+
+func (p *commitPipe) get(hash []byte) ([]byte, bool) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	buf, ok := p.pending[string(hash)]
+	return buf, ok
+}
+
+// WaitCommit 等待异步写入的mavl树写到db中，关闭db之前必须调用
+func WaitCommit() error {
+	return pipe.wait()
+}
@@ -68,20 +68,7 @@ func ReadProof(roothash []byte, leafhash []byte, data []byte) (*Proof, error) {
 
 // InnerNodeProofHash 计算inner节点的hash
 func InnerNodeProofHash(childHash []byte, branch *types.InnerNode) []byte {
-	var innernode types.InnerNode
-
-	innernode.Height = branch.Height
-	innernode.Size = branch.Size
-
-	// left is nil
-	if len(branch.LeftHash) == 0 {
-		innernode.LeftHash = childHash
-		innernode.RightHash = branch.RightHash
-	} else {
-		innernode.LeftHash = branch.LeftHash
-		innernode.RightHash = childHash
-	}
-	return innernode.Hash()
+	return types.InnerNodeProofHash(childHash, branch)
 }
 
 func (node *Node) constructProof(t *Tree, key []byte, valuePtr *[]byte, proof *Proof) (exists bool) {
@@ -136,3 +123,36 @@ func (t *Tree) ConstructProof(key []byte) (value []byte, proof *Proof) {
 	}
 	return nil, nil
 }
+
+// ConstructStoreProof 构造key的存在或者不存在证明，不存在的时候证明key两边相邻的叶子
+func (t *Tree) ConstructStoreProof(key []byte) (*types.StoreProof, error) {
+	if t.root == nil {
+		return nil, types.ErrNotFound
+	}
+	t.root.Hash(t)
+	storeProof := &types.StoreProof{StateHash: t.root.hash, Key: key}
+	index, value, exists := t.Get(key)
+	if exists {
+		storeProof.Exists = true
+		storeProof.Value = value
+		storeProof.Leaf = t.leafProof(key)
+		return storeProof, nil
+	}
+	if index > 0 {
+		leftKey, _ := t.GetByIndex(index - 1)
+		storeProof.Left = t.leafProof(leftKey)
+	}
+	if index < t.Size() {
+		rightKey, _ := t.GetByIndex(index)
+		storeProof.Right = t.leafProof(rightKey)
+	}
+	return storeProof, nil
+}
+
+func (t *Tree) leafProof(key []byte) *types.MAVLLeafProof {
+	value, proof := t.ConstructProof(key)
+	if proof == nil {
+		return nil
+	}
+	return &types.MAVLLeafProof{Key: key, Value: value, InnerNodes: proof.InnerNodes}
+}
@@ -6,6 +6,7 @@ package mavl
 
 import (
 	"bytes"
+	"expvar"
 	"fmt"
 	"sync"
 
@@ -43,6 +44,10 @@ var (
 	enablePrune bool
 	// 每个10000裁剪一次
 	pruneHeight = 10000
+	// 裁剪的时候保留这个间隔整数倍高度的状态，0表示不保留
+	pruneCheckpoint int64
+	// 裁剪的统计，可以通过pprof地址的/debug/vars查看
+	pruneMetrics = expvar.NewMap("mavlPrune")
 	// 裁剪状态
 	pruningState   int32
 	wg             sync.WaitGroup
@@ -67,6 +72,27 @@ func SetPruneHeight(height int) {
 	pruneHeight = height
 }
 
+// SetPruneCheckpoint 设置裁剪时保留的检查点间隔，检查点高度的状态树不会被裁剪
+func SetPruneCheckpoint(interval int64) {
+	pruneCheckpoint = interval
+}
+
+//keepForCheckpoint 高度为height的叶子在nextHeight被更新，中间有检查点的时候需要保留
+func keepForCheckpoint(height, nextHeight int64) bool {
+	if pruneCheckpoint <= 0 || nextHeight <= height {
+		return false
+	}
+	checkpoint := (nextHeight - 1) / pruneCheckpoint * pruneCheckpoint
+	return checkpoint >= height
+}
+
+func getPruneMetric(name string) int64 {
+	if v, ok := pruneMetrics.Get(name).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
 // ClosePrune 关闭裁剪
 func ClosePrune() {
 	quit = true
@@ -181,13 +207,40 @@ func pruning(db dbm.DB, curHeight int64) {
 
 func pruningTree(db dbm.DB, curHeight int64) {
 	setPruning(pruningStateStart)
+	leafs, hashs := getPruneMetric("leafNodes"), getPruneMetric("hashNodes")
+	start := time.Now()
 	// 一级遍历
 	pruningFirstLevel(db, curHeight)
 	// 二级遍历
 	pruningSecondLevel(db, curHeight)
+	pruneMetrics.Add("rounds", 1)
+	pruneMetrics.Add("costMs", int64(time.Since(start)/time.Millisecond))
+	lastHeight := new(expvar.Int)
+	lastHeight.Set(curHeight)
+	pruneMetrics.Set("lastHeight", lastHeight)
+	treelog.Info("pruningTree", "curHeight", curHeight, "leafNodes", getPruneMetric("leafNodes")-leafs,
+		"hashNodes", getPruneMetric("hashNodes")-hashs, "cost", time.Since(start))
 	setPruning(pruningStateEnd)
 }
 
+//deleteLeaf 删除叶子节点，叶子计数节点以及记录在其中的hash节点
+func deleteLeaf(db dbm.DB, batch dbm.Batch, leafCountKey, hash []byte) {
+	value, err := db.Get(leafCountKey)
+	if err == nil {
+		var pData types.PruneData
+		err := proto.Unmarshal(value, &pData)
+		if err == nil {
+			for _, hash := range pData.Hashs {
+				batch.Delete(hash)
+			}
+			pruneMetrics.Add("hashNodes", int64(len(pData.Hashs)))
+		}
+	}
+	batch.Delete(leafCountKey) // 叶子计数节点
+	batch.Delete(hash)         // 叶子节点hash值
+	pruneMetrics.Add("leafNodes", 1)
+}
+
 func pruningFirstLevel(db dbm.DB, curHeight int64) {
 	treelog.Info("pruningTree pruningFirstLevel", "start curHeight:", curHeight)
 	start := time.Now()
@@ -281,21 +334,15 @@ func deleteNode(db dbm.DB, mp map[string][]hashData, curHeight int64, batch dbm.
 	batch.Reset()
 	for key, vals := range mp {
 		if len(vals) > 1 && vals[1].height != vals[0].height { //防止相同高度时候出现的误删除
-			for _, val := range vals[1:] { //从第二个开始判断
+			for i, val := range vals[1:] { //从第二个开始判断
+				//vals按高度从高到低排列，vals[i]是更新val的叶子
+				if keepForCheckpoint(val.height, vals[i].height) {
+					pruneMetrics.Add("checkpointLeafs", 1)
+					continue
+				}
 				if curHeight >= val.height+int64(pruneHeight) {
 					leafCountKey := genLeafCountKey([]byte(key), val.hash, val.height, len(val.hash))
-					value, err := db.Get(leafCountKey)
-					if err == nil {
-						var pData types.PruneData
-						err := proto.Unmarshal(value, &pData)
-						if err == nil {
-							for _, hash := range pData.Hashs {
-								batch.Delete(hash)
-							}
-						}
-					}
-					batch.Delete(leafCountKey) // 叶子计数节点
-					batch.Delete(val.hash)     // 叶子节点hash值
+					deleteLeaf(db, batch, leafCountKey, val.hash)
 					if batch.ValueSize() > batchDataSize {
 						if err = batch.Write(); err != nil {
 							return
@@ -381,21 +428,14 @@ func deleteOldNode(db dbm.DB, mp map[string][]hashData, curHeight int64, batch d
 	for key, vals := range mp {
 		if len(vals) > 1 {
 			if vals[1].height != vals[0].height { //防止相同高度时候出现的误删除
-				for _, val := range vals[1:] { //从第二个开始判断
+				for i, val := range vals[1:] { //从第二个开始判断
+					if keepForCheckpoint(val.height, vals[i].height) {
+						pruneMetrics.Add("checkpointLeafs", 1)
+						continue
+					}
 					if curHeight >= val.height+int64(pruneHeight) {
 						leafCountKey := genOldLeafCountKey([]byte(key), val.hash, val.height, len(val.hash))
-						value, err := db.Get(leafCountKey)
-						if err == nil {
-							var pData types.PruneData
-							err := proto.Unmarshal(value, &pData)
-							if err == nil {
-								for _, hash := range pData.Hashs {
-									batch.Delete(hash)
-								}
-							}
-						}
-						batch.Delete(leafCountKey)
-						batch.Delete(val.hash) // 叶子节点hash值
+						deleteLeaf(db, batch, leafCountKey, val.hash)
 					}
 				}
 			} else {
@@ -403,11 +443,13 @@ func deleteOldNode(db dbm.DB, mp map[string][]hashData, curHeight int64, batch d
 				for _, val := range vals {
 					if curHeight >= val.height+threeLevelPruningHeight {
 						batch.Delete(genOldLeafCountKey([]byte(key), val.hash, val.height, len(val.hash)))
+						pruneMetrics.Add("indexKeys", 1)
 					}
 				}
 			}
 		} else if len(vals) == 1 && curHeight >= vals[0].height+threeLevelPruningHeight { // 删除第三层存储索引key
 			batch.Delete(genOldLeafCountKey([]byte(key), vals[0].hash, vals[0].height, len(vals[0].hash)))
+			pruneMetrics.Add("indexKeys", 1)
 		}
 		delete(mp, key)
 		if batch.ValueSize() > batchDataSize {
@@ -10,10 +10,13 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/metrics"
+	"github.com/33cn/chain33/common/version"
 	"github.com/33cn/chain33/system/store/mavl/db/ticket"
 	"github.com/33cn/chain33/types"
 	farm "github.com/dgryski/go-farm"
@@ -25,6 +28,7 @@ const (
 	hashNodePrefix       = "_mh_"
 	leafNodePrefix       = "_mb_"
 	curMaxBlockHeight    = "_..mcmbh.._"
+	stateHasherKey       = "_..mhasher.._"
 	rootHashHeightPrefix = "_mrhp_"
 )
 
@@ -46,6 +50,18 @@ var (
 	tkCloseCache  MemTreeOpera
 )
 
+// ErrStateHasher 配置的状态树hash算法和数据库中的不一致
+var ErrStateHasher = errors.New("ErrStateHasher")
+
+var (
+	nodeCacheHits     = metrics.NewCounter("chain33_mavl_node_cache_hits_total", "MAVL nodes found in the node cache or mem tree.")
+	nodeCacheMisses   = metrics.NewCounter("chain33_mavl_node_cache_misses_total", "MAVL nodes not found in the node cache or mem tree.")
+	nodeLoadsPerBlock = metrics.NewHistogram("chain33_mavl_node_loads_per_block", "MAVL nodes loaded from db by the tree of one block.", metrics.SizeBuckets)
+	nodeSavesPerBlock = metrics.NewHistogram("chain33_mavl_node_saves_per_block", "MAVL nodes saved by the tree of one block.", metrics.SizeBuckets)
+	commitBatchBytes  = metrics.NewHistogram("chain33_mavl_commit_batch_bytes", "Bytes written by one MAVL tree commit.", metrics.SizeBuckets)
+	commitSeconds     = metrics.NewHistogram("chain33_mavl_commit_seconds", "Latency of writing one MAVL tree commit batch.", metrics.LatencyBuckets)
+)
+
 // EnableMavlPrefix 使能mavl加前缀
 func EnableMavlPrefix(enable bool) {
 	enableMavlPrefix = enable
@@ -214,6 +230,7 @@ func (t *Tree) Save() []byte {
 			}
 		}
 		saveNodeNo := t.root.save(t)
+		nodeSavesPerBlock.Observe(float64(saveNodeNo))
 		treelog.Debug("Tree.Save", "saveNodeNo", saveNodeNo, "tree height", t.blockHeight)
 		// 保存每个高度的roothash
 		if enablePrune {
@@ -450,6 +467,10 @@ type nodeDB struct {
 	db      dbm.DB
 	batch   dbm.Batch
 	orphans map[string]struct{}
+	//loads 从db中加载的节点数
+	loads int64
+	//nodes 异步写入的时候batch中的节点，写入完成之前从这里读取
+	nodes map[string][]byte
 }
 
 func newNodeDB(db dbm.DB, sync bool) *nodeDB {
@@ -459,6 +480,10 @@ func newNodeDB(db dbm.DB, sync bool) *nodeDB {
 		batch:   db.NewBatch(sync),
 		orphans: make(map[string]struct{}),
 	}
+	//裁剪直接读写db，不能异步写入
+	if enableCommitPipeline && !enablePrune {
+		ndb.nodes = make(map[string][]byte)
+	}
 	return ndb
 }
 
@@ -472,6 +497,7 @@ func (ndb *nodeDB) GetNode(t *Tree, hash []byte) (*Node, error) {
 	if ndb.cache != nil {
 		elem, ok := ndb.cache.Get(string(hash))
 		if ok {
+			nodeCacheHits.Inc()
 			return elem.(*Node), nil
 		}
 	}
@@ -479,12 +505,21 @@ func (ndb *nodeDB) GetNode(t *Tree, hash []byte) (*Node, error) {
 	if enableMemTree {
 		node, err := getNodeMemTree(hash)
 		if err == nil {
+			nodeCacheHits.Inc()
 			return node, nil
 		}
 	}
-	// Doesn't exist, load from db.
-	var buf []byte
-	buf, err := ndb.db.Get(hash)
+	//正在异步写入的节点
+	buf, ok := pipe.get(hash)
+	var err error
+	if ok {
+		nodeCacheHits.Inc()
+	} else {
+		nodeCacheMisses.Inc()
+		// Doesn't exist, load from db.
+		buf, err = ndb.db.Get(hash)
+		ndb.loads++
+	}
 
 	if len(buf) == 0 || err != nil {
 		return nil, ErrNodeNotExist
@@ -534,6 +569,9 @@ func (ndb *nodeDB) SaveNode(t *Tree, node *Node) {
 	// Save node bytes to db
 	storenode := node.storeNode(t)
 	ndb.batch.Set(node.hash, storenode)
+	if ndb.nodes != nil {
+		ndb.nodes[string(node.hash)] = storenode
+	}
 	if enablePrune && node.height == 0 {
 		//save leafnode key&hash
 		k := genLeafCountKey(node.key, node.hash, t.blockHeight, len(node.hash))
@@ -704,17 +742,62 @@ func (ndb *nodeDB) Commit() error {
 	ndb.mtx.Lock()
 	defer ndb.mtx.Unlock()
 
-	// Write saves
-	err := ndb.batch.Write()
-	if err != nil {
-		treelog.Error("Commit batch.Write err", "err", err)
+	nodeLoadsPerBlock.Observe(float64(ndb.loads))
+	commitBatchBytes.Observe(float64(ndb.batch.ValueSize()))
+	var err error
+	if ndb.nodes != nil {
+		err = pipe.write(ndb.batch, ndb.nodes)
+	} else {
+		// Write saves
+		beg := time.Now()
+		err = ndb.batch.Write()
+		commitSeconds.ObserveSince(beg)
+		if err != nil {
+			treelog.Error("Commit batch.Write err", "err", err)
+		}
 	}
 
 	ndb.batch = nil
+	ndb.nodes = nil
 	ndb.orphans = make(map[string]struct{})
+	ndb.loads = 0
 	return err
 }
 
+// CheckStateHasher 检查数据库中记录的状态树hash算法和配置的一致，没有记录的时候空数据库记录配置的算法，
+// 老的数据库只能是sha256
+func CheckStateHasher(db dbm.DB) error {
+	name := types.GetStateHasher().Name()
+	value, err := db.Get([]byte(stateHasherKey))
+	if err == nil {
+		if string(value) != name {
+			treelog.Error("CheckStateHasher", "db", string(value), "config", name)
+			return ErrStateHasher
+		}
+		return nil
+	}
+	if err != types.ErrNotFound {
+		return err
+	}
+	it := db.Iterator(nil, nil, false)
+	empty := true
+	for it.Rewind(); it.Valid(); it.Next() {
+		if !bytes.Equal(it.Key(), version.StoreFormatVerKey) {
+			empty = false
+			break
+		}
+	}
+	it.Close()
+	if !empty && name != "sha256" {
+		treelog.Error("CheckStateHasher", "db", "sha256", "config", name)
+		return ErrStateHasher
+	}
+	if dbm.IsReadOnly() {
+		return nil
+	}
+	return db.SetSync([]byte(stateHasherKey), []byte(name))
+}
+
 // SetKVPair 设置kv对外接口
 func SetKVPair(db dbm.DB, storeSet *types.StoreSet, sync bool) ([]byte, error) {
 	tree := NewTree(db, sync)
@@ -797,6 +880,16 @@ func DelLeafCountKV(db dbm.DB, blockHeight int64) error {
 	return nil
 }
 
+// GetStoreProof 获取key在roothash状态下的存在或者不存在证明
+func GetStoreProof(db dbm.DB, roothash []byte, key []byte) (*types.StoreProof, error) {
+	tree := NewTree(db, true)
+	err := tree.Load(roothash)
+	if err != nil {
+		return nil, err
+	}
+	return tree.ConstructStoreProof(key)
+}
+
 // VerifyKVPairProof 验证KVPair 的证明
 func VerifyKVPairProof(db dbm.DB, roothash []byte, keyvalue types.KeyValue, proof []byte) bool {
 
@@ -419,6 +419,55 @@ func kindsSet(t *Tree, mvccdb *db.MVCCHelper, key []byte, value []byte, version
 }
 
 //测试key:value对的proof证明功能
+func TestStoreProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("mavltree", "leveldb", dir, 100)
+
+	tree := NewTree(db, true)
+	for i := 1; i < 20; i += 2 {
+		tree.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%02d", i)))
+	}
+	hash := tree.Save()
+
+	for i := 0; i <= 20; i++ {
+		key := []byte(fmt.Sprintf("key%02d", i))
+		proof, err := GetStoreProof(db, hash, key)
+		require.NoError(t, err)
+		require.Equal(t, hash, proof.StateHash)
+		require.Equal(t, i%2 == 1, proof.Exists)
+		require.NoError(t, types.VerifyStoreProof(proof))
+		if proof.Exists {
+			require.Equal(t, []byte(fmt.Sprintf("value%02d", i)), proof.Value)
+		}
+	}
+
+	//篡改值
+	proof, err := GetStoreProof(db, hash, []byte("key03"))
+	require.NoError(t, err)
+	proof.Value = []byte("value04")
+	proof.Leaf.Value = proof.Value
+	require.Equal(t, types.ErrStoreProof, types.VerifyStoreProof(proof))
+
+	//用存在的key的证明伪造不存在
+	proof, err = GetStoreProof(db, hash, []byte("key04"))
+	require.NoError(t, err)
+	fake := &types.StoreProof{StateHash: hash, Key: []byte("key05"), Left: proof.Left, Right: proof.Right}
+	require.Equal(t, types.ErrStoreProof, types.VerifyStoreProof(fake))
+	//左右两个叶子不相邻
+	other, err := GetStoreProof(db, hash, []byte("key08"))
+	require.NoError(t, err)
+	fake = &types.StoreProof{StateHash: hash, Key: []byte("key06"), Left: proof.Left, Right: other.Right}
+	require.Equal(t, types.ErrStoreProof, types.VerifyStoreProof(fake))
+	//只有一边的叶子
+	fake = &types.StoreProof{StateHash: hash, Key: []byte("key04"), Right: proof.Right}
+	require.Equal(t, types.ErrStoreProof, types.VerifyStoreProof(fake))
+	//其他状态的根
+	proof.StateHash = []byte("00000000000000000000000000000000")
+	require.Equal(t, types.ErrStoreProof, types.VerifyStoreProof(proof))
+}
+
 func TestIAVLProof(t *testing.T) {
 	dir, err := ioutil.TempDir("", "datastore")
 	require.NoError(t, err)
@@ -1514,6 +1563,51 @@ func TestPruningFirstLevelNode(t *testing.T) {
 	VerifySecLevelCountNodeExist(t, db2, secLevelNodes)
 }
 
+func TestPruningCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db1 := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer db1.Close()
+	nodes := []Node{
+		{key: []byte("33333333"), hash: []byte("d95f1027b1ecf9013a1cf870a85d967ca828e8faca366a290ec43adcecfbc46a"), height: 1},
+		{key: []byte("33333333"), hash: []byte("d95f1027b1ecf9013a1cf870a85d967ca828e8faca366a290ec43adcecfbc46b"), height: 5000},
+		{key: []byte("33333333"), hash: []byte("d95f1027b1ecf9013a1cf870a85d967ca828e8faca366a290ec43adcecfbc46c"), height: 12000},
+		{key: []byte("33333333"), hash: []byte("d95f1027b1ecf9013a1cf870a85d967ca828e8faca366a290ec43adcecfbc46d"), height: 15000},
+		{key: []byte("33333333"), hash: []byte("d95f1027b1ecf9013a1cf870a85d967ca828e8faca366a290ec43adcecfbc46e"), height: 30000},
+	}
+	batch := db1.NewBatch(true)
+	for i, node := range nodes {
+		data := &types.PruneData{Hashs: [][]byte{[]byte(fmt.Sprintf("checkpoint%d", i))}}
+		v, err := proto.Marshal(data)
+		require.NoError(t, err)
+		batch.Set(genLeafCountKey(node.key, node.hash, int64(node.height), len(node.hash)), v)
+		batch.Set(node.hash, node.key)
+		batch.Set(data.Hashs[0], data.Hashs[0])
+	}
+	require.NoError(t, batch.Write())
+
+	SetPruneHeight(5000)
+	SetPruneCheckpoint(10000)
+	defer SetPruneCheckpoint(0)
+	leafs := getPruneMetric("leafNodes")
+	hashs := getPruneMetric("hashNodes")
+	pruningFirstLevel(db1, 50000)
+
+	//5000是高度10000的状态，15000是高度20000的状态，需要保留
+	verifyNodeExist(t, db1,
+		[][]byte{nodes[1].hash, []byte("checkpoint1"), nodes[3].hash, []byte("checkpoint3"), nodes[4].hash, []byte("checkpoint4")},
+		[][]byte{nodes[0].hash, []byte("checkpoint0"), nodes[2].hash, []byte("checkpoint2")})
+	require.Equal(t, int64(2), getPruneMetric("leafNodes")-leafs)
+	require.Equal(t, int64(2), getPruneMetric("hashNodes")-hashs)
+
+	require.False(t, keepForCheckpoint(1, 5000))
+	require.True(t, keepForCheckpoint(10000, 10001))
+	require.False(t, keepForCheckpoint(10001, 20000))
+	require.True(t, keepForCheckpoint(10001, 20001))
+}
+
 func verifyNodeExist(t *testing.T, dbm db.DB, existHashs [][]byte, noExistHashs [][]byte) {
 	for _, hash := range existHashs {
 		_, err := dbm.Get(hash)
@@ -2045,3 +2139,113 @@ func TestSize1(t *testing.T) {
 	PrintMemStats(1)
 	fmt.Println(unsafe.Sizeof(a), unsafe.Sizeof(b), unsafe.Sizeof(c), unsafe.Sizeof(d), len(d.Key), cap(d.Key))
 }
+
+func TestTreeMetrics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db1 := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer db1.Close()
+
+	tree := NewTree(db1, true)
+	for i := 0; i < 100; i++ {
+		tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", i)))
+	}
+	blocks, saves := nodeSavesPerBlock.Count(), nodeSavesPerBlock.Sum()
+	batchBytes := commitBatchBytes.Sum()
+	hash := tree.Save()
+	require.NotNil(t, hash)
+	require.Equal(t, blocks+1, nodeSavesPerBlock.Count())
+	require.True(t, nodeSavesPerBlock.Sum()-saves >= 100)
+	require.True(t, commitBatchBytes.Sum() > batchBytes)
+
+	//没有节点缓存的时候，新的tree读取要从db加载节点
+	loads, misses := nodeLoadsPerBlock.Sum(), nodeCacheMisses.Value()
+	tree = NewTree(db1, true)
+	require.NoError(t, tree.Load(hash))
+	_, value, exists := tree.Get([]byte("key050"))
+	require.True(t, exists)
+	require.Equal(t, []byte("value050"), value)
+	tree.Set([]byte("key050"), []byte("new"))
+	require.NotNil(t, tree.Save())
+	require.True(t, nodeLoadsPerBlock.Sum()-loads > 0)
+	require.Equal(t, int64(nodeLoadsPerBlock.Sum()-loads), nodeCacheMisses.Value()-misses)
+}
+
+func TestParallelHash(t *testing.T) {
+	//单核的时候也测试并行计算
+	workers := hashWorkers
+	hashWorkers = make(chan struct{}, 4)
+	defer func() {
+		enableParallelHash = true
+		hashWorkers = workers
+	}()
+	hashes := make([][]byte, 2)
+	for i, parallel := range []bool{false, true} {
+		enableParallelHash = parallel
+		tree := NewTree(nil, true)
+		for j := 0; j < 10000; j++ {
+			key := i2b(int32(j))
+			tree.Set(key, Sha256(key))
+		}
+		hashes[i] = tree.Hash()
+	}
+	assert.Equal(t, hashes[0], hashes[1])
+	assert.Equal(t, 0, len(hashWorkers))
+}
+
+func TestCommitPipeline(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db1 := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer db1.Close()
+	EnableCommitPipeline(true)
+	defer EnableCommitPipeline(false)
+
+	prevHash := make([]byte, 32)
+	for i := 0; i < 10; i++ {
+		prevHash, err = saveBlock(db1, int64(i), prevHash, 100, false)
+		require.NoError(t, err)
+		//写入完成之前可以读取最新的状态
+		tree := NewTree(db1, true)
+		require.NoError(t, tree.Load(prevHash))
+		for j := (i + 1) * 100; j > i*100; j -= 10 {
+			key := i2b(int32(j - 1))
+			_, value, exists := tree.Get(key)
+			require.True(t, exists)
+			assert.Equal(t, Sha256(key), value)
+		}
+	}
+	require.NoError(t, WaitCommit())
+	_, err = db1.Get(prevHash)
+	require.NoError(t, err)
+	assert.Nil(t, pipe.pending)
+}
+
+func benchmarkTreeHash(b *testing.B, parallel bool) {
+	defer func() { enableParallelHash = true }()
+	enableParallelHash = parallel
+	keys := make([][]byte, 100000)
+	for i := range keys {
+		keys[i] = Sha256(i2b(int32(i)))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		tree := NewTree(nil, true)
+		for _, key := range keys {
+			tree.Set(key, key)
+		}
+		b.StartTimer()
+		tree.Hash()
+	}
+}
+
+func BenchmarkTreeHash(b *testing.B) {
+	benchmarkTreeHash(b, false)
+}
+
+func BenchmarkTreeHashParallel(b *testing.B) {
+	benchmarkTreeHash(b, true)
+}
@@ -53,15 +53,23 @@ type subConfig struct {
 	EnableMavlPrune bool `json:"enableMavlPrune"`
 	// 裁剪高度间隔
 	PruneHeight int32 `json:"pruneHeight"`
+	// 裁剪时保留的检查点间隔，这个间隔整数倍高度的状态不会被裁剪，0表示不保留
+	PruneCheckpoint int64 `json:"pruneCheckpoint"`
 	// 是否使能内存树
 	EnableMemTree bool `json:"enableMemTree"`
 	// 是否使能内存树中叶子节点
 	EnableMemVal bool `json:"enableMemVal"`
+	// 是否异步写入mavl树，进程异常退出的时候可能丢失最后一个区块的状态
+	EnableCommitPipeline bool `json:"enableCommitPipeline"`
 }
 
 // New new mavl store module
 func New(cfg *types.Store, sub []byte) queue.Module {
 	bs := drivers.NewBaseStore(cfg)
+	if err := mavl.CheckStateHasher(bs.GetDB()); err != nil {
+		bs.Close()
+		panic(err)
+	}
 	var subcfg subConfig
 	if sub != nil {
 		types.MustDecode(sub, &subcfg)
@@ -78,8 +86,10 @@ func New(cfg *types.Store, sub []byte) queue.Module {
 	mavl.EnableMVCC(mavls.enableMVCC)
 	mavl.EnablePrune(mavls.enableMavlPrune)
 	mavl.SetPruneHeight(int(mavls.pruneHeight))
+	mavl.SetPruneCheckpoint(subcfg.PruneCheckpoint)
 	mavl.EnableMemTree(mavls.enableMemTree)
 	mavl.EnableMemVal(mavls.enableMemVal)
+	mavl.EnableCommitPipeline(subcfg.EnableCommitPipeline)
 	bs.SetChild(mavls)
 	return mavls
 }
@@ -87,6 +97,9 @@ func New(cfg *types.Store, sub []byte) queue.Module {
 // Close close mavl store
 func (mavls *Store) Close() {
 	mavl.ClosePrune()
+	if err := mavl.WaitCommit(); err != nil {
+		mlog.Error("store mavl close", "WaitCommit err", err)
+	}
 	mavls.BaseStore.Close()
 	mlog.Info("store mavl closed")
 }
@@ -221,6 +234,11 @@ func (mavls *Store) IterateRangeByStateHash(statehash []byte, start []byte, end
 	mavl.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, fn)
 }
 
+// GetProof 获取key在stateHash状态下的存在或者不存在证明
+func (mavls *Store) GetProof(req *types.ReqStoreProof) (*types.StoreProof, error) {
+	return mavl.GetStoreProof(mavls.GetDB(), req.StateHash, req.Key)
+}
+
 // ProcEvent not support message
 func (mavls *Store) ProcEvent(msg *queue.Message) {
 	if msg == nil {
@@ -117,6 +117,34 @@ func TestKvdbMemSet(t *testing.T) {
 	assert.Nil(t, notExistHash)
 }
 
+func TestGetProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil).(*Store)
+	assert.NotNil(t, store)
+
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: []byte("mk1"), Value: []byte("v1")})
+	kv = append(kv, &types.KeyValue{Key: []byte("mk3"), Value: []byte("v3")})
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+
+	proof, err := store.GetProof(&types.ReqStoreProof{StateHash: hash, Key: []byte("mk1")})
+	assert.Nil(t, err)
+	assert.True(t, proof.Exists)
+	assert.Equal(t, []byte("v1"), proof.Value)
+	assert.Nil(t, types.VerifyStoreProof(proof))
+
+	proof, err = store.GetProof(&types.ReqStoreProof{StateHash: hash, Key: []byte("mk2")})
+	assert.Nil(t, err)
+	assert.False(t, proof.Exists)
+	assert.Equal(t, []byte("mk1"), proof.Left.Key)
+	assert.Equal(t, []byte("mk3"), proof.Right.Key)
+	assert.Nil(t, types.VerifyStoreProof(proof))
+}
+
 func TestKvdbMemSetUpgrade(t *testing.T) {
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
@@ -1068,3 +1096,56 @@ func BenchmarkStoreCommit(b *testing.B) {
 	fmt.Println("mavl BenchmarkCommit cost time is", end.Sub(start), "num is", b.N)
 	b.StopTimer()
 }
+
+func TestStoreListReverse(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil).(*Store)
+	assert.NotNil(t, store)
+
+	var kv []*types.KeyValue
+	for i := 1; i <= 5; i++ {
+		kv = append(kv, &types.KeyValue{Key: []byte(fmt.Sprintf("mk%d", i)), Value: []byte(fmt.Sprintf("v%d", i))})
+	}
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+
+	req := &types.StoreList{StateHash: hash, Start: []byte("mk"), End: []byte("ml"), Count: 2, Mode: 1, Reverse: true}
+	reply := drivers.NewStoreListQuery(store, req).Run()
+	assert.Equal(t, [][]byte{[]byte("mk5"), []byte("mk4")}, reply.Keys)
+	assert.Equal(t, []byte("mk3"), reply.NextKey)
+
+	req.End = append(reply.NextKey, 0)
+	reply = drivers.NewStoreListQuery(store, req).Run()
+	assert.Equal(t, [][]byte{[]byte("mk3"), []byte("mk2")}, reply.Keys)
+	assert.Equal(t, []byte("mk1"), reply.NextKey)
+}
+
+func TestStateHasherGate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	assert.Nil(t, types.SetStateHasher("blake2b"))
+	defer types.SetStateHasher("")
+
+	store := New(storeCfg, nil).(*Store)
+	kv := []*types.KeyValue{{Key: []byte("mk1"), Value: []byte("v1")}, {Key: []byte("mk2"), Value: []byte("v2")}}
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+	proof, err := store.GetProof(&types.ReqStoreProof{StateHash: hash, Key: []byte("mk1")})
+	assert.Nil(t, err)
+	assert.Nil(t, types.VerifyStoreProof(proof))
+	store.Close()
+
+	//创世以后不能修改hash算法
+	assert.Nil(t, types.SetStateHasher("sha256"))
+	assert.Panics(t, func() { New(storeCfg, nil) })
+	assert.Nil(t, types.SetStateHasher("blake2b"))
+	store = New(storeCfg, nil).(*Store)
+	values := store.Get(&types.StoreGet{StateHash: hash, Keys: [][]byte{[]byte("mk2")}})
+	assert.Equal(t, []byte("v2"), values[0])
+	store.Close()
+}
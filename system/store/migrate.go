@@ -0,0 +1,207 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/metrics"
+	"github.com/33cn/chain33/common/version"
+	"github.com/33cn/chain33/types"
+)
+
+//ErrNoDiskSpace 磁盘剩余空间不够做存储格式升级
+var ErrNoDiskSpace = errors.New("ErrNoDiskSpace")
+
+//ErrFormatTooNew 数据库的存储格式比当前程序支持的新
+var ErrFormatTooNew = errors.New("ErrFormatTooNew")
+
+//progressInterval 升级进度日志的最小间隔
+const progressInterval = 10 * time.Second
+
+var (
+	migrationDone  = metrics.NewGauge("chain33_store_migration_done", "Items processed by the running store format migration.")
+	migrationTotal = metrics.NewGauge("chain33_store_migration_total", "Items to process by the running store format migration, 0 if unknown.")
+)
+
+//Migration 一次存储格式的升级，升级到Version
+//Migrate 必须可以重复执行，升级中途退出以后下次启动会从头重新执行这个升级
+type Migration struct {
+	Version int64
+	Name    string
+	//Space 升级需要的额外磁盘空间，为空的时候按整个数据库的大小估算
+	Space   func(db dbm.DB) int64
+	Migrate func(db dbm.DB, progress *Progress) error
+}
+
+var migrations = make(map[string][]*Migration)
+
+//RegisterMigration 注册store driver的存储格式升级，版本必须大于0并且递增
+func RegisterMigration(name string, m *Migration) {
+	if m == nil || m.Migrate == nil {
+		panic("Store: RegisterMigration migration is nil")
+	}
+	list := migrations[name]
+	if m.Version <= 0 || (len(list) > 0 && list[len(list)-1].Version >= m.Version) {
+		panic(fmt.Sprintf("Store: RegisterMigration version %d not increasing for driver %s", m.Version, name))
+	}
+	migrations[name] = append(list, m)
+}
+
+//FormatVersion store driver当前程序的存储格式版本，没有注册升级的时候为0
+func FormatVersion(name string) int64 {
+	list := migrations[name]
+	if len(list) == 0 {
+		return 0
+	}
+	return list[len(list)-1].Version
+}
+
+//GetFormatVersion 获取数据库中记录的存储格式版本，没有记录的时候空数据库返回当前版本，其他返回0
+func GetFormatVersion(name string, db dbm.DB) (int64, error) {
+	value, err := db.Get(version.StoreFormatVerKey)
+	if err == nil {
+		var ver types.Int64
+		if err := types.Decode(value, &ver); err != nil {
+			return 0, err
+		}
+		return ver.Data, nil
+	}
+	if err != types.ErrNotFound {
+		return 0, err
+	}
+	it := db.Iterator(nil, nil, false)
+	defer it.Close()
+	if it.Rewind() {
+		return 0, nil
+	}
+	return FormatVersion(name), it.Error()
+}
+
+func setFormatVersion(db dbm.DB, ver int64) error {
+	return db.SetSync(version.StoreFormatVerKey, types.Encode(&types.Int64{Data: ver}))
+}
+
+//Progress 升级进度，按时间间隔打印日志
+type Progress struct {
+	name  string
+	total int64
+	done  int64
+	last  time.Time
+	begin time.Time
+}
+
+func newProgress(name string) *Progress {
+	now := time.Now()
+	migrationDone.Set(0)
+	migrationTotal.Set(0)
+	return &Progress{name: name, last: now, begin: now}
+}
+
+//SetTotal 设置需要处理的总数，用于计算进度百分比
+func (p *Progress) SetTotal(total int64) {
+	p.total = total
+	migrationTotal.Set(float64(total))
+}
+
+//Add 增加已经处理的数量
+func (p *Progress) Add(n int64) {
+	p.done += n
+	migrationDone.Set(float64(p.done))
+	if time.Since(p.last) < progressInterval {
+		return
+	}
+	p.last = time.Now()
+	if p.total > 0 {
+		slog.Info("store migration progress", "migration", p.name, "done", p.done, "total", p.total,
+			"percent", fmt.Sprintf("%.2f%%", float64(p.done)*100/float64(p.total)), "cost", time.Since(p.begin))
+		return
+	}
+	slog.Info("store migration progress", "migration", p.name, "done", p.done, "cost", time.Since(p.begin))
+}
+
+//Done 已经处理的数量
+func (p *Progress) Done() int64 {
+	return p.done
+}
+
+//Migrate 把store driver的数据库升级到当前的存储格式版本，dir用于检查磁盘剩余空间
+func Migrate(name string, db dbm.DB, dir string) error {
+	cur, err := GetFormatVersion(name, db)
+	if err != nil {
+		return err
+	}
+	latest := FormatVersion(name)
+	if cur > latest {
+		slog.Error("store format is newer than supported", "driver", name, "format", cur, "supported", latest)
+		return ErrFormatTooNew
+	}
+	var pending []*Migration
+	for _, m := range migrations[name] {
+		if m.Version > cur {
+			pending = append(pending, m)
+		}
+	}
+	//只读节点不能升级，需要先用写入的节点升级
+	if dbm.IsReadOnly() {
+		if len(pending) > 0 {
+			slog.Error("store format needs migration on a read only node", "driver", name, "format", cur, "supported", latest)
+			return types.ErrReadOnly
+		}
+		return nil
+	}
+	if len(pending) > 0 {
+		if err := checkDiskSpace(db, dir, pending); err != nil {
+			return err
+		}
+	}
+	for _, m := range pending {
+		slog.Info("store migration start", "driver", name, "migration", m.Name, "from", cur, "to", m.Version)
+		progress := newProgress(m.Name)
+		if err := m.Migrate(db, progress); err != nil {
+			slog.Error("store migration failed", "driver", name, "migration", m.Name, "err", err)
+			return err
+		}
+		if err := setFormatVersion(db, m.Version); err != nil {
+			return err
+		}
+		cur = m.Version
+		slog.Info("store migration done", "driver", name, "migration", m.Name, "items", progress.Done(), "cost", time.Since(progress.begin))
+	}
+	//空数据库或者老的数据库记录当前版本
+	if _, err := db.Get(version.StoreFormatVerKey); err == types.ErrNotFound {
+		return setFormatVersion(db, cur)
+	}
+	return nil
+}
+
+//checkDiskSpace 升级之前检查磁盘剩余空间，不能获取剩余空间或者估算大小的时候不检查
+func checkDiskSpace(db dbm.DB, dir string, pending []*Migration) error {
+	free := diskFree(dir)
+	if free < 0 {
+		return nil
+	}
+	var need int64
+	for _, m := range pending {
+		if m.Space != nil {
+			need += m.Space(db)
+			continue
+		}
+		if est, ok := db.(dbm.SizeEstimator); ok {
+			size, err := est.SizeOf(nil, nil)
+			if err == nil {
+				need += size
+			}
+		}
+	}
+	if need > free {
+		slog.Error("not enough disk space for store migration", "dir", dir, "need", need, "free", free)
+		return ErrNoDiskSpace
+	}
+	return nil
+}
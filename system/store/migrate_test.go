@@ -0,0 +1,140 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"testing"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/version"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//renameMigration 把old-前缀的key改成new-前缀
+func renameMigration(db dbm.DB, progress *Progress) error {
+	var keys, values [][]byte
+	it := db.Iterator([]byte("old-"), nil, false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		keys = append(keys, append([]byte{}, it.Key()...))
+		values = append(values, it.ValueCopy())
+	}
+	it.Close()
+	progress.SetTotal(int64(len(keys)))
+	batch := db.NewBatch(true)
+	for i, key := range keys {
+		batch.Set(append([]byte("new-"), bytes.TrimPrefix(key, []byte("old-"))...), values[i])
+		batch.Delete(key)
+		progress.Add(1)
+	}
+	return batch.Write()
+}
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	runs := 0
+	RegisterMigration("migrate_test", &Migration{Version: 1, Name: "rename", Migrate: func(db dbm.DB, progress *Progress) error {
+		runs++
+		return renameMigration(db, progress)
+	}})
+	fail := true
+	RegisterMigration("migrate_test", &Migration{Version: 2, Name: "fail", Migrate: func(db dbm.DB, progress *Progress) error {
+		if fail {
+			return errors.New("fail")
+		}
+		return nil
+	}})
+	assert.Equal(t, int64(2), FormatVersion("migrate_test"))
+	assert.Panics(t, func() {
+		RegisterMigration("migrate_test", &Migration{Version: 2, Migrate: renameMigration})
+	})
+
+	//空的数据库直接记录当前版本
+	empty := dbm.NewDB("empty", "goleveldb", dir, 16)
+	require.NoError(t, Migrate("migrate_test", empty, dir))
+	ver, err := GetFormatVersion("migrate_test", empty)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), ver)
+	assert.Equal(t, 0, runs)
+	empty.Close()
+
+	//老的数据库从版本0开始升级，失败的时候停在上一个版本
+	db := dbm.NewDB("legacy", "goleveldb", dir, 16)
+	defer db.Close()
+	require.NoError(t, db.Set([]byte("old-k1"), []byte("v1")))
+	require.NoError(t, db.Set([]byte("old-k2"), []byte("v2")))
+	assert.NotNil(t, Migrate("migrate_test", db, dir))
+	ver, err = GetFormatVersion("migrate_test", db)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), ver)
+	assert.Equal(t, 1, runs)
+	value, err := db.Get([]byte("new-k2"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("v2"), value)
+	_, err = db.Get([]byte("old-k2"))
+	assert.Equal(t, types.ErrNotFound, err)
+
+	fail = false
+	require.NoError(t, Migrate("migrate_test", db, dir))
+	ver, err = GetFormatVersion("migrate_test", db)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), ver)
+	assert.Equal(t, 1, runs)
+
+	//已经是最新的版本不再升级
+	require.NoError(t, Migrate("migrate_test", db, dir))
+	assert.Equal(t, 1, runs)
+	//比程序支持的版本新
+	assert.Equal(t, ErrFormatTooNew, Migrate("other", db, dir))
+}
+
+func TestMigrateDiskSpace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	if diskFree(dir) < 0 {
+		t.Skip("disk free space not supported")
+	}
+	RegisterMigration("migrate_space_test", &Migration{Version: 1, Name: "huge",
+		Space: func(db dbm.DB) int64 { return math.MaxInt64 }, Migrate: renameMigration})
+	db := dbm.NewDB("space", "goleveldb", dir, 16)
+	defer db.Close()
+	require.NoError(t, db.Set([]byte("old-k1"), []byte("v1")))
+	assert.Equal(t, ErrNoDiskSpace, Migrate("migrate_space_test", db, dir))
+	ver, err := GetFormatVersion("migrate_space_test", db)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), ver)
+}
+
+func TestMigrateReadOnly(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	RegisterMigration("migrate_readonly_test", &Migration{Version: 1, Name: "rename", Migrate: renameMigration})
+	db := dbm.NewDB("readonly", "goleveldb", dir, 16)
+	require.NoError(t, db.Set([]byte("old-k1"), []byte("v1")))
+	db.Close()
+
+	//只读节点不升级存储格式
+	dbm.SetReadOnly(true)
+	defer dbm.SetReadOnly(false)
+	db = dbm.NewDB("readonly", "goleveldb", dir, 16)
+	defer db.Close()
+	assert.Equal(t, types.ErrReadOnly, Migrate("migrate_readonly_test", db, dir))
+	value, err := db.Get([]byte("old-k1"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("v1"), value)
+	_, err = db.Get(version.StoreFormatVerKey)
+	assert.Equal(t, types.ErrNotFound, err)
+}
@@ -5,9 +5,6 @@
 package types
 
 import (
-	"runtime"
-	"sync"
-
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
 	proto "github.com/golang/protobuf/proto"
@@ -35,7 +32,7 @@ func (block *Block) HashNew() []byte {
 	if err != nil {
 		panic(err)
 	}
-	return common.Sha256(data)
+	return ChainHash(data)
 }
 
 //HashOld 老版本的hash
@@ -44,7 +41,7 @@ func (block *Block) HashOld() []byte {
 	if err != nil {
 		panic(err)
 	}
-	return common.Sha256(data)
+	return ChainHash(data)
 }
 
 // Size 获取block的Size
@@ -89,10 +86,10 @@ func (block *Block) getHeaderHashNew() *Header {
 	return head
 }
 
-// CheckSign 检测block的签名
+// CheckSign 检测block的签名，共识的提交证明由共识模块检查
 func (block *Block) CheckSign() bool {
 	//检查区块的签名
-	if block.Signature != nil {
+	if block.Signature != nil && block.Signature.Ty != BlockSignCommit {
 		hash := block.Hash()
 		sign := block.GetSignature()
 		if !CheckSign(hash, "", sign) {
@@ -100,77 +97,81 @@ func (block *Block) CheckSign() bool {
 		}
 	}
 	//检查交易的签名
-	cpu := runtime.NumCPU()
-	ok := checkAll(block.Txs, cpu)
-	return ok
+	return CheckTxsSign(block.Txs)
 }
 
-func gen(done <-chan struct{}, task []*Transaction) <-chan *Transaction {
-	ch := make(chan *Transaction)
-	go func() {
-		defer func() {
-			close(ch)
-		}()
-		for i := 0; i < len(task); i++ {
-			select {
-			case ch <- task[i]:
-			case <-done:
-				return
-			}
+//CheckTxsSign 批量检查交易的签名，相同签名算法的签名一起批量验证
+func CheckTxsSign(txs []*Transaction) bool {
+	items := make(map[string][]*crypto.BatchItem)
+	for _, tx := range txs {
+		if tx.GetSignature() == nil {
+			return false
 		}
-	}()
-	return ch
-}
-
-type result struct {
-	isok bool
-}
-
-func check(data *Transaction) bool {
-	return data.CheckSign()
-}
-
-func checksign(done <-chan struct{}, taskes <-chan *Transaction, c chan<- result) {
-	for task := range taskes {
-		select {
-		case c <- result{check(task)}:
-		case <-done:
-			return
+		if !addSignItem(items, tx.SignData(), string(tx.Execer), tx.GetSignature()) {
+			return false
+		}
+		payer := tx.GetFeePayer()
+		if payer == nil {
+			continue
+		}
+		if len(payer.Pubkey) == 0 && payer.MultiSigScript == nil {
+			return false
+		}
+		if !addSignItem(items, tx.feePayerData(), string(tx.Execer), payer) {
+			return false
+		}
+	}
+	for name, list := range items {
+		c, err := crypto.New(name)
+		if err != nil {
+			return false
+		}
+		if !crypto.BatchVerify(c, list) {
+			return false
 		}
 	}
+	return true
 }
 
-func checkAll(task []*Transaction, n int) bool {
-	done := make(chan struct{})
-	defer close(done)
-
-	taskes := gen(done, task)
-
-	// Start a fixed number of goroutines to read and digest files.
-	c := make(chan result) // HLc
-	var wg sync.WaitGroup
-	wg.Add(n)
-	for i := 0; i < n; i++ {
-		go func() {
-			checksign(done, taskes, c) // HLc
-			wg.Done()
-		}()
-	}
-	go func() {
-		wg.Wait()
-		close(c) // HLc
-	}()
-	// End of pipeline. OMIT
-	for r := range c {
-		if !r.isok {
-			return false
+func addSignItem(items map[string][]*crypto.BatchItem, data []byte, execer string, sign *Signature) bool {
+	if sign.Ty == MultiSigSign {
+		name, list, ok := multiSigItems(data, execer, sign)
+		if ok {
+			items[name] = append(items[name], list...)
 		}
+		return ok
 	}
+	//公钥能从签名中恢复出来，签名就是有效的
+	if len(sign.Pubkey) == 0 {
+		_, err := RecoverSignPubKey(data, execer, sign)
+		return err == nil
+	}
+	name := GetSignName(execer, int(sign.Ty))
+	c, err := crypto.New(name)
+	if err != nil {
+		return false
+	}
+	pub, err := c.PubKeyFromBytes(sign.Pubkey)
+	if err != nil {
+		return false
+	}
+	signbytes, err := c.SignatureFromBytes(sign.Signature)
+	if err != nil {
+		return false
+	}
+	items[name] = append(items[name], &crypto.BatchItem{PubKey: pub, Msg: data, Sig: signbytes})
 	return true
 }
 
 // CheckSign 检测签名
 func CheckSign(data []byte, execer string, sign *Signature) bool {
+	if sign.Ty == MultiSigSign {
+		return checkMultiSig(data, execer, sign)
+	}
+	if len(sign.Pubkey) == 0 {
+		_, err := RecoverSignPubKey(data, execer, sign)
+		return err == nil
+	}
 	//GetDefaultSign: 系统内置钱包，非插件中的签名
 	c, err := crypto.New(GetSignName(execer, int(sign.Ty)))
 	if err != nil {
@@ -186,3 +187,37 @@ func CheckSign(data []byte, execer string, sign *Signature) bool {
 	}
 	return pub.VerifyBytes(data, signbytes)
 }
+
+//RecoverSignPubKey 签名中省略了公钥的时候，从可恢复的签名中计算公钥，需要开启TxPubKeyRecover
+func RecoverSignPubKey(data []byte, execer string, sign *Signature) ([]byte, error) {
+	if !IsEnable("TxPubKeyRecover") {
+		return nil, ErrNotSupport
+	}
+	name := GetSignName(execer, int(sign.Ty))
+	key := name + string(common.Sha256(data)) + string(sign.Signature)
+	if pub, ok := recoverCache.Get(key); ok {
+		return pub.([]byte), nil
+	}
+	c, err := crypto.New(name)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := crypto.RecoverPubKey(c, data, sign.Signature)
+	if err != nil {
+		return nil, ErrSign
+	}
+	recoverCache.Add(key, pub.Bytes())
+	return pub.Bytes(), nil
+}
+
+//SignPubKey 签名者的公钥，签名中省略公钥的时候从签名中恢复，多重签名是脚本的编码
+func SignPubKey(data []byte, execer string, sign *Signature) []byte {
+	if sign.GetTy() == MultiSigSign && sign.GetMultiSigScript() != nil {
+		return Encode(sign.MultiSigScript)
+	}
+	if sign == nil || len(sign.Pubkey) > 0 {
+		return sign.GetPubkey()
+	}
+	pub, _ := RecoverSignPubKey(data, execer, sign)
+	return pub
+}
@@ -0,0 +1,99 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+)
+
+//规范编码:
+//同一个消息只有一种合法的编码，没有未知字段，varint是最短编码，字段按编号从小到大排列，
+//默认值的字段不编码。满足这些条件的时候，解码以后再编码得到的数据和原始数据完全一致。
+//未知字段在解码以后会保留在XXX_unrecognized中，再编码的时候原样写回，会改变交易hash，
+//不同编码但是语义相同的交易可以同时进入mempool和区块，所以交易和区块必须拒绝未知字段。
+
+//CheckCanonical 解码data到msg，并检查data是msg的规范编码
+func CheckCanonical(data []byte, msg proto.Message) error {
+	err := Decode(data, msg)
+	if err != nil {
+		return err
+	}
+	if HasUnknownFields(msg) {
+		return ErrNonCanonical
+	}
+	if !bytes.Equal(Encode(msg), data) {
+		return ErrNonCanonical
+	}
+	return nil
+}
+
+//HasUnknownFields 消息以及嵌套的消息中是否有未知字段
+func HasUnknownFields(msg proto.Message) bool {
+	return hasUnknownFields(reflect.ValueOf(msg))
+}
+
+func hasUnknownFields(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return false
+		}
+		return hasUnknownFields(v.Elem())
+	case reflect.Slice:
+		//[]byte 是字段的值，不是嵌套的消息
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return false
+		}
+		for i := 0; i < v.Len(); i++ {
+			if hasUnknownFields(v.Index(i)) {
+				return true
+			}
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			if hasUnknownFields(v.MapIndex(key)) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			name := t.Field(i).Name
+			if name == "XXX_unrecognized" {
+				if v.Field(i).Len() > 0 {
+					return true
+				}
+				continue
+			}
+			if t.Field(i).PkgPath != "" || name == "XXX_NoUnkeyedLiteral" {
+				continue
+			}
+			if hasUnknownFields(v.Field(i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//CheckCanonical 检查交易没有未知字段，交易hash和签名的数据都是重新编码计算的，
+//所以已经解码的交易只需要检查未知字段
+func (tx *Transaction) CheckCanonical() error {
+	if HasUnknownFields(tx) {
+		return ErrNonCanonical
+	}
+	return nil
+}
+
+//CheckCanonical 检查区块头和区块中的交易没有未知字段
+func (block *Block) CheckCanonical() error {
+	if HasUnknownFields(block) {
+		return ErrNonCanonical
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCanonical(t *testing.T) {
+	tx := &Transaction{Execer: []byte("none"), Fee: 1}
+	data := Encode(tx)
+	assert.Equal(t, []byte{0x0a, 0x04, 'n', 'o', 'n', 'e', 0x20, 0x01}, data)
+	var decoded Transaction
+	assert.Nil(t, CheckCanonical(data, &decoded))
+	assert.Nil(t, decoded.CheckCanonical())
+
+	cases := [][]byte{
+		//未知字段 99
+		append(append([]byte{}, data...), 0x98, 0x06, 0x01),
+		//fee 不是最短的varint编码
+		{0x0a, 0x04, 'n', 'o', 'n', 'e', 0x20, 0x81, 0x00},
+		//字段顺序不对
+		{0x20, 0x01, 0x0a, 0x04, 'n', 'o', 'n', 'e'},
+		//编码了默认值的字段 expire
+		append(append([]byte{}, data...), 0x28, 0x00),
+	}
+	for i, c := range cases {
+		var tx Transaction
+		assert.Equal(t, ErrNonCanonical, CheckCanonical(c, &tx), "case %d", i)
+		//交易hash相同，但是编码不同
+		assert.Equal(t, decoded.Hash(), tx.Hash(), "case %d", i)
+	}
+
+	//签名中的未知字段不在签名的数据中，也不影响交易hash
+	tx.Signature = &Signature{Ty: SECP256K1, XXX_unrecognized: []byte{0x98, 0x06, 0x01}}
+	assert.Equal(t, ErrNonCanonical, tx.CheckCanonical())
+	block := &Block{Txs: []*Transaction{{Execer: []byte("none")}, tx}}
+	assert.Equal(t, ErrNonCanonical, block.CheckCanonical())
+	tx.Signature.XXX_unrecognized = nil
+	assert.Nil(t, block.CheckCanonical())
+	block.XXX_unrecognized = []byte{0x98, 0x06, 0x01}
+	assert.Equal(t, ErrNonCanonical, block.CheckCanonical())
+}
@@ -23,6 +23,18 @@ type Config struct {
 	Fork       *ForkList    `protobuf:"bytes,15,opt,name=fork" json:"fork,omitempty"`
 	Health     *HealthCheck `protobuf:"bytes,16,opt,name=health" json:"health,omitempty"`
 	CoinSymbol string       `protobuf:"bytes,16,opt,name=coinSymbol" json:"coinSymbol,omitempty"`
+	//ReadOnly 只读节点，只用于查询，不同步区块不出块，数据库只读打开
+	ReadOnly bool `protobuf:"varint,17,opt,name=readOnly" json:"readOnly,omitempty"`
+	//AddressHRP bech32格式地址的前缀，每条链使用不同的前缀
+	AddressHRP string `protobuf:"bytes,18,opt,name=addressHRP" json:"addressHRP,omitempty"`
+	//AddressVer 普通地址的版本号，默认0，不同的链使用不同的版本号防止交易重放
+	AddressVer int32 `protobuf:"varint,19,opt,name=addressVer" json:"addressVer,omitempty"`
+	//MultiSignAddressVer 多重签名地址的版本号，默认5
+	MultiSignAddressVer int32 `protobuf:"varint,20,opt,name=multiSignAddressVer" json:"multiSignAddressVer,omitempty"`
+	//EnableTxPubKeyRecover 允许交易签名中省略公钥，公钥从可恢复的签名中计算，所有节点的配置必须一致
+	EnableTxPubKeyRecover bool `protobuf:"varint,21,opt,name=enableTxPubKeyRecover" json:"enableTxPubKeyRecover,omitempty"`
+	//ChainID 链的标识，交易签名的数据包含这个标识，不同的链使用不同的标识防止交易重放
+	ChainID int32 `protobuf:"varint,22,opt,name=chainID" json:"chainID,omitempty"`
 }
 
 // ForkList fork列表配置
@@ -67,6 +79,10 @@ type Mempool struct {
 	// 每个账户在mempool中得最大交易数量，默认100
 	MaxTxNumPerAccount int64 `protobuf:"varint,5,opt,name=maxTxNumPerAccount" json:"maxTxNumPerAccount,omitempty"`
 	MaxTxLast          int64 `protobuf:"varint,6,opt,name=maxTxLast" json:"maxTxLast,omitempty"`
+	// 交易进入mempool前，在最新状态上模拟执行，拒绝一定会执行失败的交易，默认关闭
+	Simulate bool `protobuf:"varint,7,opt,name=simulate" json:"simulate,omitempty"`
+	// 是否根据mempool的拥堵程度动态提高最低手续费，默认关闭
+	DynamicFee bool `protobuf:"varint,8,opt,name=dynamicFee" json:"dynamicFee,omitempty"`
 }
 
 // Consensus 配置
@@ -83,6 +99,12 @@ type Consensus struct {
 	ForceMining bool   `protobuf:"varint,6,opt,name=forceMining" json:"forceMining,omitempty"`
 	// 配置挖矿的合约名单
 	MinerExecs []string `protobuf:"bytes,7,rep,name=minerExecs" json:"minerExecs,omitempty"`
+	// 按照manage合约中的consensus-schedule在指定高度切换共识，name为创世时的共识
+	Schedule bool `protobuf:"varint,8,opt,name=schedule" json:"schedule,omitempty"`
+	// 状态树节点的hash算法，sha256(默认)或者blake2b，只能在创世的时候选择
+	StateHash string `protobuf:"bytes,9,opt,name=stateHash" json:"stateHash,omitempty"`
+	// 交易hash、区块hash和交易merkle树的hash算法，sha256(默认)或者blake3，只能在创世的时候选择
+	ChainHash string `protobuf:"bytes,10,opt,name=chainHash" json:"chainHash,omitempty"`
 }
 
 // Wallet 配置
@@ -97,6 +119,34 @@ type Wallet struct {
 	DbCache int32 `protobuf:"varint,4,opt,name=dbCache" json:"dbCache,omitempty"`
 	// 钱包发送交易签名方式
 	SignType string `protobuf:"bytes,5,opt,name=signType" json:"signType,omitempty"`
+	// 钱包账户列表显示的地址格式，支持base58和bech32，默认base58
+	AddressFormat string `protobuf:"bytes,6,opt,name=addressFormat" json:"addressFormat,omitempty"`
+	// TSS门限签名协调者的签名服务地址，为空时不支持导入TSS账户
+	TssCoordinator string `protobuf:"bytes,7,opt,name=tssCoordinator" json:"tssCoordinator,omitempty"`
+	// 等待协调者完成一次门限签名的超时时间，单位秒，默认30秒
+	TssTimeout int32 `protobuf:"varint,8,opt,name=tssTimeout" json:"tssTimeout,omitempty"`
+	// 私钥保存在PKCS#11硬件安全模块中的账户，为空时不使用HSM
+	Hsm *HSM `protobuf:"bytes,9,opt,name=hsm" json:"hsm,omitempty"`
+}
+
+// HSM PKCS#11硬件安全模块配置，私钥不离开HSM，签名由HSM完成
+type HSM struct {
+	// HSM驱动名，默认pkcs11
+	Driver string `protobuf:"bytes,1,opt,name=driver" json:"driver,omitempty"`
+	// PKCS#11动态库路径
+	Lib string `protobuf:"bytes,2,opt,name=lib" json:"lib,omitempty"`
+	// 私钥所在的slot
+	Slot int64 `protobuf:"varint,3,opt,name=slot" json:"slot,omitempty"`
+	// 登录token的用户PIN
+	Pin string `protobuf:"bytes,4,opt,name=pin" json:"pin,omitempty"`
+	// 私钥在HSM中的标签，每个标签对应一个账户
+	Labels []string `protobuf:"bytes,5,rep,name=labels" json:"labels,omitempty"`
+	// 私钥的签名类型，支持secp256k1和ed25519，默认secp256k1
+	SignType string `protobuf:"bytes,6,opt,name=signType" json:"signType,omitempty"`
+	// 同一个slot最多同时打开的会话数，默认4
+	PoolSize int32 `protobuf:"varint,7,opt,name=poolSize" json:"poolSize,omitempty"`
+	// 签名失败的时候换一个新的会话重试的次数，默认2
+	Retry int32 `protobuf:"varint,8,opt,name=retry" json:"retry,omitempty"`
 }
 
 // Store 配置
@@ -113,6 +163,8 @@ type Store struct {
 	LocalDBVersion string `protobuf:"bytes,5,opt,name=localdbVersion" json:"localdbVersion,omitempty"`
 	// 数据库版本
 	StoreDBVersion string `protobuf:"bytes,5,opt,name=storedbVersion" json:"storedbVersion,omitempty"`
+	// 读写和提交超过这个毫秒数打印慢操作日志，0表示默认1000毫秒，负数表示不打印
+	SlowOperationMs int64 `protobuf:"varint,6,opt,name=slowOperationMs" json:"slowOperationMs,omitempty"`
 }
 
 // BlockChain 配置
@@ -219,6 +271,8 @@ type Exec struct {
 	Alias            []string `protobuf:"bytes,5,rep,name=alias" json:"alias,omitempty"`
 	// 是否保存token交易信息
 	SaveTokenTxList bool `protobuf:"varint,6,opt,name=saveTokenTxList" json:"saveTokenTxList,omitempty"`
+	// 每单位gas的手续费，交易执行的gas上限由手续费换算，为0的时候不计量
+	GasPrice int64 `protobuf:"varint,8,opt,name=gasPrice" json:"gasPrice,omitempty"`
 }
 
 // Pprof 配置
@@ -0,0 +1,72 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"github.com/33cn/chain33/common/crypto/blake3"
+)
+
+//ChainHasher 交易hash、区块hash和交易merkle树的hash算法，输出必须是32字节
+//sha256使用标准库的实现，在支持的cpu上会使用SHA指令、AVX2或者ARMv8的汇编实现
+type ChainHasher interface {
+	Name() string
+	Sum(data []byte) []byte
+}
+
+type blake3Hasher struct{}
+
+func (blake3Hasher) Name() string {
+	return "blake3"
+}
+
+func (blake3Hasher) Sum(data []byte) []byte {
+	hash := blake3.Sum256(data)
+	return hash[:]
+}
+
+var (
+	chainHashers = make(map[string]ChainHasher)
+	chainHasher  ChainHasher
+)
+
+func init() {
+	RegisterChainHasher(sha256Hasher{})
+	RegisterChainHasher(blake3Hasher{})
+	chainHasher = sha256Hasher{}
+}
+
+//RegisterChainHasher 注册交易和区块的hash算法
+func RegisterChainHasher(h ChainHasher) {
+	if _, dup := chainHashers[h.Name()]; dup {
+		panic("RegisterChainHasher called twice for " + h.Name())
+	}
+	if len(h.Sum(nil)) != sha256Len {
+		panic("RegisterChainHasher hash size must be 32 bytes: " + h.Name())
+	}
+	chainHashers[h.Name()] = h
+}
+
+//SetChainHasher 设置交易和区块的hash算法，为空表示sha256
+func SetChainHasher(name string) error {
+	if name == "" {
+		name = "sha256"
+	}
+	h, ok := chainHashers[name]
+	if !ok {
+		return ErrNotSupport
+	}
+	chainHasher = h
+	return nil
+}
+
+//GetChainHasher 当前交易和区块的hash算法
+func GetChainHasher() ChainHasher {
+	return chainHasher
+}
+
+//ChainHash 用链配置的算法计算交易和区块的hash
+func ChainHash(data []byte) []byte {
+	return chainHasher.Sum(data)
+}
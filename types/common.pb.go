@@ -708,6 +708,7 @@ type VersionInfo struct {
 	App                  string   `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
 	Chain33              string   `protobuf:"bytes,3,opt,name=chain33,proto3" json:"chain33,omitempty"`
 	LocalDb              string   `protobuf:"bytes,4,opt,name=localDb,proto3" json:"localDb,omitempty"`
+	ChainID              int32    `protobuf:"varint,5,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -766,6 +767,13 @@ func (m *VersionInfo) GetLocalDb() string {
 	return ""
 }
 
+func (m *VersionInfo) GetChainID() int32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Reply)(nil), "types.Reply")
 	proto.RegisterType((*ReqString)(nil), "types.ReqString")
@@ -789,34 +797,35 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xdf, 0x8b, 0xd3, 0x40,
-	0x10, 0xa6, 0xcd, 0xb5, 0x97, 0xcc, 0xe5, 0x41, 0x82, 0x48, 0x38, 0x4f, 0xae, 0xae, 0x0a, 0x7d,
-	0xf1, 0x0a, 0x46, 0xf4, 0x5d, 0x7c, 0xb0, 0x1c, 0x54, 0xd8, 0x3b, 0x0e, 0xf1, 0x6d, 0x9b, 0x6e,
-	0x92, 0xa5, 0xc9, 0x26, 0xe9, 0x6e, 0xe4, 0xf2, 0xdf, 0xcb, 0x4c, 0x37, 0xb5, 0x52, 0xf4, 0xde,
-	0xe6, 0xdb, 0x99, 0xc9, 0xf7, 0x63, 0x08, 0x84, 0x69, 0x5d, 0x55, 0xb5, 0xbe, 0x69, 0x76, 0xb5,
-	0xad, 0xa3, 0x89, 0xed, 0x1b, 0x69, 0xd8, 0x7b, 0x98, 0x70, 0xd9, 0x94, 0x7d, 0x14, 0xc1, 0x99,
-	0x32, 0xdf, 0xb7, 0xf1, 0x68, 0x36, 0x9a, 0xfb, 0x9c, 0xea, 0xe8, 0x19, 0x78, 0x95, 0xc9, 0xe3,
-	0xf1, 0x6c, 0x34, 0x0f, 0x39, 0x96, 0xec, 0x1a, 0x02, 0x2e, 0xdb, 0x3b, 0xbb, 0x53, 0x3a, 0xc7,
-	0x95, 0x8d, 0xb0, 0x82, 0x56, 0x02, 0x4e, 0x35, 0x7b, 0x0d, 0x17, 0xf4, 0xbd, 0xff, 0x8c, 0xbc,
-	0x85, 0xf0, 0x68, 0xc4, 0x44, 0xcf, 0x61, 0x82, 0xef, 0x26, 0x1e, 0xcd, 0xbc, 0x79, 0xc0, 0xf7,
-	0x80, 0xcd, 0x60, 0xca, 0x65, 0xbb, 0xd4, 0x36, 0x7a, 0x01, 0xd3, 0x42, 0xaa, 0xbc, 0xb0, 0xf4,
-	0x15, 0x8f, 0x3b, 0xc4, 0x5e, 0xc2, 0x64, 0xa9, 0xed, 0xa7, 0x8f, 0x7f, 0x91, 0x78, 0x8e, 0xe4,
-	0x33, 0x9c, 0x73, 0xd9, 0x7e, 0x13, 0xa6, 0xc0, 0x76, 0x21, 0x4c, 0x41, 0xed, 0x90, 0x53, 0x1d,
-	0xc5, 0x70, 0xde, 0x35, 0xf9, 0x4e, 0x6c, 0x24, 0xb9, 0xf3, 0xf9, 0x00, 0xf7, 0x0e, 0x9b, 0xb2,
-	0xff, 0xd7, 0x2a, 0xf3, 0x49, 0xd8, 0x4a, 0x95, 0xec, 0x0d, 0x85, 0x81, 0x83, 0xd2, 0x90, 0x4a,
-	0xaa, 0xc8, 0x46, 0xc8, 0x1d, 0x62, 0xef, 0x5c, 0x20, 0x4f, 0x8c, 0x7d, 0x00, 0xff, 0x56, 0xf6,
-	0x0f, 0xa2, 0xec, 0x24, 0xc6, 0xbe, 0x95, 0xbd, 0x23, 0xc5, 0x12, 0x23, 0xfa, 0x85, 0x2d, 0x77,
-	0x8a, 0x3d, 0x60, 0x57, 0x30, 0xbd, 0x7f, 0x3c, 0xd1, 0x19, 0x38, 0x9d, 0x3f, 0x00, 0xee, 0x55,
-	0x25, 0xef, 0xac, 0xb0, 0x9d, 0x41, 0xc3, 0xda, 0x36, 0xf8, 0xe0, 0x86, 0x06, 0x18, 0x5d, 0x41,
-	0x50, 0xd6, 0xa9, 0x28, 0xa9, 0x37, 0xa6, 0xde, 0x9f, 0x07, 0xca, 0x56, 0x65, 0x59, 0xec, 0xb9,
-	0x6c, 0x55, 0x96, 0xb1, 0x4b, 0x4a, 0xe0, 0x56, 0xf6, 0xa7, 0x4a, 0x59, 0x8b, 0x76, 0x5b, 0x2e,
-	0xf4, 0x86, 0x84, 0x5d, 0x82, 0x2f, 0x1f, 0x65, 0xba, 0x12, 0x07, 0xde, 0x03, 0x3e, 0xba, 0xeb,
-	0xf8, 0xf8, 0xae, 0xb8, 0xb3, 0x2e, 0xeb, 0x74, 0xbb, 0xea, 0x2a, 0x47, 0x7b, 0xc0, 0x07, 0xa3,
-	0x67, 0x47, 0x07, 0x51, 0x70, 0xf1, 0x20, 0x77, 0x46, 0xd5, 0x7a, 0xa9, 0xb3, 0x1a, 0xb3, 0xb2,
-	0xca, 0x96, 0x03, 0xdf, 0x1e, 0xa0, 0x52, 0xd1, 0x34, 0xce, 0x1f, 0x96, 0x98, 0x48, 0x5a, 0x08,
-	0xa5, 0x93, 0x84, 0x58, 0x02, 0x3e, 0x40, 0xec, 0x50, 0x00, 0x5f, 0xd7, 0xc4, 0x13, 0xf0, 0x01,
-	0x7e, 0xb9, 0xfe, 0xf9, 0x2a, 0x57, 0xb6, 0xe8, 0xd6, 0x37, 0x69, 0x5d, 0x2d, 0x92, 0x24, 0xd5,
-	0x0b, 0xb7, 0xb4, 0xa0, 0xdf, 0x69, 0x3d, 0xa5, 0x9f, 0x2b, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0x05, 0xe6, 0x9f, 0x5b, 0x6c, 0x03, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0x26, 0x9b, 0x66, 0x9b, 0xbc, 0xe6, 0x20, 0x41, 0x24, 0xd4, 0x4a, 0xe3, 0xa8, 0xb0, 0x17,
+	0xbb, 0x60, 0x44, 0xef, 0xd2, 0x83, 0x4b, 0x61, 0x85, 0x69, 0x29, 0xe2, 0x6d, 0x36, 0x3b, 0x49,
+	0x86, 0x4d, 0x26, 0xc9, 0xce, 0x44, 0x9a, 0xb3, 0xff, 0xb8, 0xcc, 0xdb, 0x49, 0x5c, 0x29, 0xda,
+	0xdb, 0xfb, 0xe6, 0xbd, 0x37, 0xdf, 0x8f, 0x61, 0x20, 0xcc, 0x9a, 0xba, 0x6e, 0xe4, 0x55, 0xbb,
+	0x6f, 0x74, 0x13, 0x79, 0x7a, 0x68, 0xb9, 0x22, 0xef, 0xc1, 0xa3, 0xbc, 0xad, 0x86, 0x28, 0x82,
+	0x13, 0xa1, 0xbe, 0xed, 0x62, 0x27, 0x71, 0x16, 0x3e, 0xc5, 0x3a, 0x7a, 0x06, 0x6e, 0xad, 0x8a,
+	0x78, 0x96, 0x38, 0x8b, 0x90, 0x9a, 0x92, 0x5c, 0x42, 0x40, 0x79, 0x77, 0xab, 0xf7, 0x42, 0x16,
+	0x66, 0x65, 0xcb, 0x34, 0xc3, 0x95, 0x80, 0x62, 0x4d, 0x5e, 0xc3, 0x19, 0xde, 0xf7, 0x9f, 0x91,
+	0xb7, 0x10, 0x1e, 0x8d, 0xa8, 0xe8, 0x39, 0x78, 0xe6, 0x5c, 0xc5, 0x4e, 0xe2, 0x2e, 0x02, 0x7a,
+	0x00, 0x24, 0x81, 0x39, 0xe5, 0xdd, 0x4a, 0xea, 0xe8, 0x05, 0xcc, 0x4b, 0x2e, 0x8a, 0x52, 0xe3,
+	0x2d, 0x2e, 0xb5, 0x88, 0xbc, 0x04, 0x6f, 0x25, 0xf5, 0xa7, 0x8f, 0x7f, 0x91, 0xb8, 0x96, 0xe4,
+	0x33, 0x9c, 0x52, 0xde, 0x7d, 0x65, 0xaa, 0x34, 0xed, 0x92, 0xa9, 0x12, 0xdb, 0x21, 0xc5, 0x3a,
+	0x8a, 0xe1, 0xb4, 0x6f, 0x8b, 0x3d, 0xdb, 0x72, 0x74, 0xe7, 0xd3, 0x11, 0x1e, 0x1c, 0xb6, 0xd5,
+	0xf0, 0xaf, 0x55, 0xe2, 0xa3, 0xb0, 0xb5, 0xa8, 0xc8, 0x1b, 0x0c, 0xc3, 0x0c, 0x72, 0x85, 0x2a,
+	0xb1, 0x42, 0x1b, 0x21, 0xb5, 0x88, 0xbc, 0xb3, 0x81, 0x3c, 0x31, 0xf6, 0x01, 0xfc, 0x1b, 0x3e,
+	0xdc, 0xb3, 0xaa, 0xe7, 0x26, 0xf6, 0x1d, 0x1f, 0x2c, 0xa9, 0x29, 0x4d, 0x44, 0x3f, 0x4d, 0xcb,
+	0x3e, 0xc5, 0x01, 0x90, 0x0b, 0x98, 0xdf, 0x3d, 0x3c, 0xd2, 0x19, 0x58, 0x9d, 0xdf, 0x01, 0xee,
+	0x44, 0xcd, 0x6f, 0x35, 0xd3, 0xbd, 0x32, 0x86, 0xa5, 0x6e, 0xcd, 0x81, 0x1d, 0x1a, 0x61, 0x74,
+	0x01, 0x41, 0xd5, 0x64, 0xac, 0xc2, 0xde, 0x0c, 0x7b, 0x7f, 0x0e, 0x30, 0x5b, 0x91, 0xe7, 0xb1,
+	0x6b, 0xb3, 0x15, 0x79, 0x4e, 0xce, 0x31, 0x81, 0x1b, 0x3e, 0x3c, 0x56, 0x4a, 0x3a, 0x63, 0xb7,
+	0xa3, 0x4c, 0x6e, 0x51, 0xd8, 0x39, 0xf8, 0xfc, 0x81, 0x67, 0x6b, 0x36, 0xf1, 0x4e, 0xf8, 0xe8,
+	0x5d, 0x67, 0xc7, 0xef, 0x6a, 0x76, 0x36, 0x55, 0x93, 0xed, 0xd6, 0x7d, 0x6d, 0x69, 0x27, 0x3c,
+	0x19, 0x3d, 0x39, 0x7a, 0x90, 0x5f, 0x0e, 0x9c, 0xdd, 0xf3, 0xbd, 0x12, 0x8d, 0x5c, 0xc9, 0xbc,
+	0x31, 0x61, 0x69, 0xa1, 0xab, 0x91, 0xf0, 0x00, 0x8c, 0x54, 0xd6, 0xb6, 0xd6, 0xa0, 0x29, 0x4d,
+	0x24, 0x59, 0xc9, 0x84, 0x4c, 0x53, 0xa4, 0x09, 0xe8, 0x08, 0x4d, 0x07, 0x13, 0xb8, 0xde, 0x20,
+	0x51, 0x40, 0x47, 0x38, 0xed, 0xac, 0xae, 0x63, 0x2f, 0x71, 0x16, 0x1e, 0x1d, 0xe1, 0x97, 0xcb,
+	0x1f, 0xaf, 0x0a, 0xa1, 0xcb, 0x7e, 0x73, 0x95, 0x35, 0xf5, 0x32, 0x4d, 0x33, 0xb9, 0xb4, 0xd7,
+	0x2d, 0xf1, 0xa7, 0x6d, 0xe6, 0xf8, 0xef, 0xd2, 0xdf, 0x03, 0x00, 0x98, 0x85, 0x2f, 0x77, 0x87,
+	0x03, 0x00, 0x00,
 }
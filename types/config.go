@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/types/chaincfg"
 	tml "github.com/BurntSushi/toml"
 )
@@ -46,6 +47,7 @@ const (
 func init() {
 	S("TestNet", false)
 	SetMinFee(1e5)
+	S("GasPrice", int64(0))
 	for key, cfg := range chaincfg.LoadAll() {
 		S("cfg."+key, cfg)
 	}
@@ -72,6 +74,7 @@ type ChainParam struct {
 	TargetTimespan           time.Duration
 	TargetTimePerBlock       time.Duration
 	RetargetAdjustmentFactor int64
+	DifficultyAlgo           string
 }
 
 // GetP 获取ChainParam
@@ -90,6 +93,7 @@ func GetP(height int64) *ChainParam {
 	c.TargetTimespan = time.Duration(conf.MGInt("targetTimespan", height)) * time.Second
 	c.TargetTimePerBlock = time.Duration(conf.MGInt("targetTimePerBlock", height)) * time.Second
 	c.RetargetAdjustmentFactor = conf.MGInt("retargetAdjustmentFactor", height)
+	c.DifficultyAlgo = conf.MGStr("difficultyAlgo", height)
 	return c
 }
 
@@ -273,9 +277,19 @@ func Init(t string, cfg *Config) {
 		}
 		if cfg.Consensus != nil {
 			setMinerExecs(cfg.Consensus.MinerExecs)
+			if err := SetStateHasher(cfg.Consensus.StateHash); err != nil {
+				panic("config consensus.stateHash " + cfg.Consensus.StateHash + " not support")
+			}
+			if err := SetChainHasher(cfg.Consensus.ChainHash); err != nil {
+				panic("config consensus.chainHash " + cfg.Consensus.ChainHash + " not support")
+			}
 		}
 		if cfg.Exec != nil {
 			setMinFee(cfg.Exec.MinExecFee)
+			if cfg.Exec.GasPrice < 0 {
+				panic("config exec.gasPrice less than zero")
+			}
+			setChainConfig("GasPrice", cfg.Exec.GasPrice)
 		}
 		setChainConfig("FixTime", cfg.FixTime)
 		if cfg.Exec.MaxExecFee > 0 {
@@ -287,11 +301,23 @@ func Init(t string, cfg *Config) {
 			}
 			coinSymbol = cfg.CoinSymbol
 		}
+		//bech32地址的前缀，没有配置的时候使用小写的coin symbol
+		hrp := cfg.AddressHRP
+		if hrp == "" {
+			hrp = strings.ToLower(coinSymbol)
+		}
+		if err := address.SetBech32HRP(hrp); err != nil {
+			panic("config AddressHRP " + hrp + " not support")
+		}
+		setAddressVersion(cfg.AddressVer, cfg.MultiSignAddressVer)
+		setChainConfig("TxPubKeyRecover", cfg.EnableTxPubKeyRecover)
+		setChainConfig("ChainID", int64(cfg.ChainID))
 	}
 	//local 只用于单元测试
 	if isLocal() {
 		setLocalFork()
 		setChainConfig("TxHeight", true)
+		setChainConfig("TxPubKeyRecover", true)
 		setChainConfig("Debug", true)
 		//更新fork配置信息
 		if mver[title] != nil {
@@ -332,6 +358,11 @@ func GetCoinSymbol() string {
 	return s
 }
 
+// GetChainID 获取链的标识
+func GetChainID() int32 {
+	return int32(GInt("ChainID"))
+}
+
 func isLocal() bool {
 	return title == "local"
 }
@@ -417,6 +448,19 @@ func setMinFee(fee int64) {
 	setChainConfig("MinBalanceTransfer", fee*10)
 }
 
+//setAddressVersion 多重签名地址的版本号不配置的时候使用默认的版本号
+func setAddressVersion(normal, multiSign int32) {
+	if multiSign == 0 {
+		multiSign = int32(address.MultiSignVer)
+	}
+	if normal < 0 || normal > 255 || multiSign < 0 || multiSign > 255 {
+		panic("config AddressVer and MultiSignAddressVer must be in [0, 255]")
+	}
+	if err := address.SetAddressVersion(byte(normal), byte(multiSign)); err != nil {
+		panic("config AddressVer and MultiSignAddressVer must be different")
+	}
+}
+
 // GetParaName 获取平行链name
 func GetParaName() string {
 	if IsPara() {
@@ -223,12 +223,24 @@ ForkChainParamV2= -1
 ForkBlockCheck=1725000
 ForkLocalDBAccess=1
 ForkBase58AddressCheck=1800000
+ForkFeeDelegation= -1
+ForkTxGas= -1
+ForkCanonicalEncoding= -1
+ForkMultiSigScript= -1
+ForkTxChainID= -1
+ForkKeyMigration= -1
+ForkMultiAction= -1
 [fork.sub.coins]
 Enable=0
+ForkTransferBatch=0
 
 [fork.sub.manage]
 Enable=0
 ForkManageExec=100000
+ForkManageFreeze=0
+ForkManageApprove=0
+ForkManageSchedule=0
+ForkManageHistory=0
 
 [fork.sub.store-kvmvccmavl]
 ForkKvmvccmavl=1
@@ -60,6 +60,7 @@ func initChainBase() {
 	chainBaseParam.MaxTxNumber = 1600      //160
 	chainBaseParam.TargetTimespan = 144 * 16 * time.Second
 	chainBaseParam.TargetTimePerBlock = 16 * time.Second
+	chainBaseParam.DifficultyAlgo = "bitcoin"
 }
 
 func getP(height int64) *ChainParam {
@@ -31,6 +31,8 @@ const (
 	UserKeyX = "user."
 	ParaKeyX = "user.p."
 	NoneX    = "none"
+	//MultiActionX 多操作交易的执行器名称
+	MultiActionX = "multiaction"
 )
 
 //UserKeyX 用户自定义执行器前缀byte类型
@@ -70,6 +72,8 @@ var (
 //ty = 3 -> sm2
 //ty = 4 -> onetimeed25519
 //ty = 5 -> RingBaseonED25519
+//ty = 6 -> bls
+//ty = 7 -> schnorr
 //ty = 1+offset(1<<8) ->auth_ecdsa
 //ty = 2+offset(1<<8) -> auth_sm2
 const (
@@ -79,6 +83,12 @@ const (
 	SM2       = 3
 )
 
+//BlockSignCommit 区块头中保存的是共识模块的提交证明，比如验证节点的投票或者leader的签名，签名的内容不是区块hash，由共识模块检查
+const BlockSignCommit = 1 << 16
+
+//MultiSigSign 交易签名中是M-of-N的多重签名脚本和部分签名，不需要多重签名合约
+const MultiSigSign = 1 << 17
+
 // 创建隐私交易的类型定义
 const (
 	PrivacyTypePublic2Privacy = iota + 1
@@ -105,6 +115,8 @@ const (
 	TyLogRollback        = 13
 	TyLogMint            = 14
 	TyLogBurn            = 15
+	//TyLogMultiAction 多操作交易中每个操作的日志之前的标记
+	TyLogMultiAction = 16
 )
 
 //SystemLog 系统log日志
@@ -124,6 +136,7 @@ var SystemLog = map[int64]*LogInfo{
 	TyLogRollback:        {reflect.TypeOf(LocalDBSet{}), "LogRollback"},
 	TyLogMint:            {reflect.TypeOf(ReceiptAccountMint{}), "LogMint"},
 	TyLogBurn:            {reflect.TypeOf(ReceiptAccountBurn{}), "LogBurn"},
+	TyLogMultiAction:     {reflect.TypeOf(ReceiptMultiAction{}), "LogMultiAction"},
 }
 
 //exec type
@@ -133,8 +146,24 @@ const (
 	ExecOk   = 2
 )
 
+//mempool tx event type
+const (
+	MempoolTxAdded   = 1
+	MempoolTxRemoved = 2
+)
+
+//mempool tx remove reason
+const (
+	MempoolRemoveMined    = 1
+	MempoolRemoveExpired  = 2
+	MempoolRemoveReplaced = 3
+	MempoolRemoveEvicted  = 4
+)
+
 func init() {
 	S("TxHeight", false)
+	S("TxPubKeyRecover", false)
+	S("ChainID", int64(0))
 }
 
 //flag:
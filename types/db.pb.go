@@ -203,6 +203,592 @@ func (m *MAVLProof) GetRootHash() []byte {
 	return nil
 }
 
+// mavl树中一个叶子节点到根的路径证明
+type MAVLLeafProof struct {
+	Key                  []byte       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	InnerNodes           []*InnerNode `protobuf:"bytes,3,rep,name=innerNodes,proto3" json:"innerNodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MAVLLeafProof) Reset()         { *m = MAVLLeafProof{} }
+func (m *MAVLLeafProof) String() string { return proto.CompactTextString(m) }
+func (*MAVLLeafProof) ProtoMessage()    {}
+func (*MAVLLeafProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{3}
+}
+
+func (m *MAVLLeafProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MAVLLeafProof.Unmarshal(m, b)
+}
+func (m *MAVLLeafProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MAVLLeafProof.Marshal(b, m, deterministic)
+}
+func (m *MAVLLeafProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MAVLLeafProof.Merge(m, src)
+}
+func (m *MAVLLeafProof) XXX_Size() int {
+	return xxx_messageInfo_MAVLLeafProof.Size(m)
+}
+func (m *MAVLLeafProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MAVLLeafProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MAVLLeafProof proto.InternalMessageInfo
+
+func (m *MAVLLeafProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *MAVLLeafProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MAVLLeafProof) GetInnerNodes() []*InnerNode {
+	if m != nil {
+		return m.InnerNodes
+	}
+	return nil
+}
+
+// 请求key在某个状态下的证明
+type ReqStoreProof struct {
+	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStoreProof) Reset()         { *m = ReqStoreProof{} }
+func (m *ReqStoreProof) String() string { return proto.CompactTextString(m) }
+func (*ReqStoreProof) ProtoMessage()    {}
+func (*ReqStoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{4}
+}
+
+func (m *ReqStoreProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStoreProof.Unmarshal(m, b)
+}
+func (m *ReqStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStoreProof.Marshal(b, m, deterministic)
+}
+func (m *ReqStoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStoreProof.Merge(m, src)
+}
+func (m *ReqStoreProof) XXX_Size() int {
+	return xxx_messageInfo_ReqStoreProof.Size(m)
+}
+func (m *ReqStoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStoreProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStoreProof proto.InternalMessageInfo
+
+func (m *ReqStoreProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReqStoreProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// key在stateHash下的证明
+// 	 exists为true时leaf证明key的值是value
+// 	 exists为false时left和right是key两边相邻的叶子，key比所有叶子都小(大)的时候left(right)为空
+type StoreProof struct {
+	StateHash            []byte         `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Key                  []byte         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte         `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Exists               bool           `protobuf:"varint,4,opt,name=exists,proto3" json:"exists,omitempty"`
+	Leaf                 *MAVLLeafProof `protobuf:"bytes,5,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Left                 *MAVLLeafProof `protobuf:"bytes,6,opt,name=left,proto3" json:"left,omitempty"`
+	Right                *MAVLLeafProof `protobuf:"bytes,7,opt,name=right,proto3" json:"right,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StoreProof) Reset()         { *m = StoreProof{} }
+func (m *StoreProof) String() string { return proto.CompactTextString(m) }
+func (*StoreProof) ProtoMessage()    {}
+func (*StoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{5}
+}
+
+func (m *StoreProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreProof.Unmarshal(m, b)
+}
+func (m *StoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreProof.Marshal(b, m, deterministic)
+}
+func (m *StoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProof.Merge(m, src)
+}
+func (m *StoreProof) XXX_Size() int {
+	return xxx_messageInfo_StoreProof.Size(m)
+}
+func (m *StoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProof proto.InternalMessageInfo
+
+func (m *StoreProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *StoreProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StoreProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StoreProof) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+func (m *StoreProof) GetLeaf() *MAVLLeafProof {
+	if m != nil {
+		return m.Leaf
+	}
+	return nil
+}
+
+func (m *StoreProof) GetLeft() *MAVLLeafProof {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *StoreProof) GetRight() *MAVLLeafProof {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+// 请求key的历史值
+// 	 key : 状态数据的key
+// 	 height : 从这个高度往前查询，小于等于0表示从最新的高度开始
+// 	 count : 最多返回的个数
+type ReqValueHistory struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqValueHistory) Reset()         { *m = ReqValueHistory{} }
+func (m *ReqValueHistory) String() string { return proto.CompactTextString(m) }
+func (*ReqValueHistory) ProtoMessage()    {}
+func (*ReqValueHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{6}
+}
+
+func (m *ReqValueHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqValueHistory.Unmarshal(m, b)
+}
+func (m *ReqValueHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqValueHistory.Marshal(b, m, deterministic)
+}
+func (m *ReqValueHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqValueHistory.Merge(m, src)
+}
+func (m *ReqValueHistory) XXX_Size() int {
+	return xxx_messageInfo_ReqValueHistory.Size(m)
+}
+func (m *ReqValueHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqValueHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqValueHistory proto.InternalMessageInfo
+
+func (m *ReqValueHistory) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ReqValueHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqValueHistory) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// key在height高度被修改成value
+type ValueAtHeight struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValueAtHeight) Reset()         { *m = ValueAtHeight{} }
+func (m *ValueAtHeight) String() string { return proto.CompactTextString(m) }
+func (*ValueAtHeight) ProtoMessage()    {}
+func (*ValueAtHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{7}
+}
+
+func (m *ValueAtHeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueAtHeight.Unmarshal(m, b)
+}
+func (m *ValueAtHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueAtHeight.Marshal(b, m, deterministic)
+}
+func (m *ValueAtHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueAtHeight.Merge(m, src)
+}
+func (m *ValueAtHeight) XXX_Size() int {
+	return xxx_messageInfo_ValueAtHeight.Size(m)
+}
+func (m *ValueAtHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueAtHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueAtHeight proto.InternalMessageInfo
+
+func (m *ValueAtHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValueAtHeight) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// key的历史值，按高度从高到低排列
+type ValueHistory struct {
+	Key                  []byte           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values               []*ValueAtHeight `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ValueHistory) Reset()         { *m = ValueHistory{} }
+func (m *ValueHistory) String() string { return proto.CompactTextString(m) }
+func (*ValueHistory) ProtoMessage()    {}
+func (*ValueHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{8}
+}
+
+func (m *ValueHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueHistory.Unmarshal(m, b)
+}
+func (m *ValueHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueHistory.Marshal(b, m, deterministic)
+}
+func (m *ValueHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueHistory.Merge(m, src)
+}
+func (m *ValueHistory) XXX_Size() int {
+	return xxx_messageInfo_ValueHistory.Size(m)
+}
+func (m *ValueHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueHistory proto.InternalMessageInfo
+
+func (m *ValueHistory) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ValueHistory) GetValues() []*ValueAtHeight {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// 请求压缩数据库
+// 	 name : 数据库的名字，比如blockchain，store，wallet
+// 	 prefix : 只压缩这个前缀的key，为空的时候使用start和end
+// 	 start，end : 压缩[start, end)范围的key，都为空表示整个数据库
+type ReqCompactDB struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix               []byte   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Start                []byte   `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End                  []byte   `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCompactDB) Reset()         { *m = ReqCompactDB{} }
+func (m *ReqCompactDB) String() string { return proto.CompactTextString(m) }
+func (*ReqCompactDB) ProtoMessage()    {}
+func (*ReqCompactDB) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{9}
+}
+
+func (m *ReqCompactDB) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCompactDB.Unmarshal(m, b)
+}
+func (m *ReqCompactDB) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCompactDB.Marshal(b, m, deterministic)
+}
+func (m *ReqCompactDB) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCompactDB.Merge(m, src)
+}
+func (m *ReqCompactDB) XXX_Size() int {
+	return xxx_messageInfo_ReqCompactDB.Size(m)
+}
+func (m *ReqCompactDB) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCompactDB.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCompactDB proto.InternalMessageInfo
+
+func (m *ReqCompactDB) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReqCompactDB) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *ReqCompactDB) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ReqCompactDB) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+// 请求统计数据库的磁盘占用，prefixes为空的时候按key的命名空间自动划分
+type ReqDBUsage struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prefixes             [][]byte `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqDBUsage) Reset()         { *m = ReqDBUsage{} }
+func (m *ReqDBUsage) String() string { return proto.CompactTextString(m) }
+func (*ReqDBUsage) ProtoMessage()    {}
+func (*ReqDBUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{10}
+}
+
+func (m *ReqDBUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqDBUsage.Unmarshal(m, b)
+}
+func (m *ReqDBUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqDBUsage.Marshal(b, m, deterministic)
+}
+func (m *ReqDBUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqDBUsage.Merge(m, src)
+}
+func (m *ReqDBUsage) XXX_Size() int {
+	return xxx_messageInfo_ReqDBUsage.Size(m)
+}
+func (m *ReqDBUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqDBUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqDBUsage proto.InternalMessageInfo
+
+func (m *ReqDBUsage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReqDBUsage) GetPrefixes() [][]byte {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
+// 一个前缀的磁盘占用
+// 	 liveBytes : 有效的key和value的大小
+// 	 diskBytes : 估算的磁盘占用，数据库不支持的时候为0
+// 	 reclaimable : 估算的压缩以后可以回收的空间
+type DBPrefixUsage struct {
+	Prefix               []byte   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Keys                 int64    `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	LiveBytes            int64    `protobuf:"varint,3,opt,name=liveBytes,proto3" json:"liveBytes,omitempty"`
+	DiskBytes            int64    `protobuf:"varint,4,opt,name=diskBytes,proto3" json:"diskBytes,omitempty"`
+	Reclaimable          int64    `protobuf:"varint,5,opt,name=reclaimable,proto3" json:"reclaimable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBPrefixUsage) Reset()         { *m = DBPrefixUsage{} }
+func (m *DBPrefixUsage) String() string { return proto.CompactTextString(m) }
+func (*DBPrefixUsage) ProtoMessage()    {}
+func (*DBPrefixUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{11}
+}
+
+func (m *DBPrefixUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBPrefixUsage.Unmarshal(m, b)
+}
+func (m *DBPrefixUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBPrefixUsage.Marshal(b, m, deterministic)
+}
+func (m *DBPrefixUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBPrefixUsage.Merge(m, src)
+}
+func (m *DBPrefixUsage) XXX_Size() int {
+	return xxx_messageInfo_DBPrefixUsage.Size(m)
+}
+func (m *DBPrefixUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBPrefixUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBPrefixUsage proto.InternalMessageInfo
+
+func (m *DBPrefixUsage) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *DBPrefixUsage) GetKeys() int64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
+}
+
+func (m *DBPrefixUsage) GetLiveBytes() int64 {
+	if m != nil {
+		return m.LiveBytes
+	}
+	return 0
+}
+
+func (m *DBPrefixUsage) GetDiskBytes() int64 {
+	if m != nil {
+		return m.DiskBytes
+	}
+	return 0
+}
+
+func (m *DBPrefixUsage) GetReclaimable() int64 {
+	if m != nil {
+		return m.Reclaimable
+	}
+	return 0
+}
+
+type DBUsage struct {
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prefixes             []*DBPrefixUsage `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DBUsage) Reset()         { *m = DBUsage{} }
+func (m *DBUsage) String() string { return proto.CompactTextString(m) }
+func (*DBUsage) ProtoMessage()    {}
+func (*DBUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{12}
+}
+
+func (m *DBUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DBUsage.Unmarshal(m, b)
+}
+func (m *DBUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DBUsage.Marshal(b, m, deterministic)
+}
+func (m *DBUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBUsage.Merge(m, src)
+}
+func (m *DBUsage) XXX_Size() int {
+	return xxx_messageInfo_DBUsage.Size(m)
+}
+func (m *DBUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBUsage proto.InternalMessageInfo
+
+func (m *DBUsage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DBUsage) GetPrefixes() []*DBPrefixUsage {
+	if m != nil {
+		return m.Prefixes
+	}
+	return nil
+}
+
 type StoreNode struct {
 	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -219,7 +805,7 @@ func (m *StoreNode) Reset()         { *m = StoreNode{} }
 func (m *StoreNode) String() string { return proto.CompactTextString(m) }
 func (*StoreNode) ProtoMessage()    {}
 func (*StoreNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{3}
+	return fileDescriptor_8817812184a13374, []int{13}
 }
 
 func (m *StoreNode) XXX_Unmarshal(b []byte) error {
@@ -294,7 +880,7 @@ func (m *LocalDBSet) Reset()         { *m = LocalDBSet{} }
 func (m *LocalDBSet) String() string { return proto.CompactTextString(m) }
 func (*LocalDBSet) ProtoMessage()    {}
 func (*LocalDBSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{4}
+	return fileDescriptor_8817812184a13374, []int{14}
 }
 
 func (m *LocalDBSet) XXX_Unmarshal(b []byte) error {
@@ -344,7 +930,7 @@ func (m *LocalDBList) Reset()         { *m = LocalDBList{} }
 func (m *LocalDBList) String() string { return proto.CompactTextString(m) }
 func (*LocalDBList) ProtoMessage()    {}
 func (*LocalDBList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{5}
+	return fileDescriptor_8817812184a13374, []int{15}
 }
 
 func (m *LocalDBList) XXX_Unmarshal(b []byte) error {
@@ -412,7 +998,7 @@ func (m *LocalDBGet) Reset()         { *m = LocalDBGet{} }
 func (m *LocalDBGet) String() string { return proto.CompactTextString(m) }
 func (*LocalDBGet) ProtoMessage()    {}
 func (*LocalDBGet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{6}
+	return fileDescriptor_8817812184a13374, []int{16}
 }
 
 func (m *LocalDBGet) XXX_Unmarshal(b []byte) error {
@@ -458,7 +1044,7 @@ func (m *LocalReplyValue) Reset()         { *m = LocalReplyValue{} }
 func (m *LocalReplyValue) String() string { return proto.CompactTextString(m) }
 func (*LocalReplyValue) ProtoMessage()    {}
 func (*LocalReplyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{7}
+	return fileDescriptor_8817812184a13374, []int{17}
 }
 
 func (m *LocalReplyValue) XXX_Unmarshal(b []byte) error {
@@ -499,7 +1085,7 @@ func (m *StoreSet) Reset()         { *m = StoreSet{} }
 func (m *StoreSet) String() string { return proto.CompactTextString(m) }
 func (*StoreSet) ProtoMessage()    {}
 func (*StoreSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{8}
+	return fileDescriptor_8817812184a13374, []int{18}
 }
 
 func (m *StoreSet) XXX_Unmarshal(b []byte) error {
@@ -553,7 +1139,7 @@ func (m *StoreDel) Reset()         { *m = StoreDel{} }
 func (m *StoreDel) String() string { return proto.CompactTextString(m) }
 func (*StoreDel) ProtoMessage()    {}
 func (*StoreDel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{9}
+	return fileDescriptor_8817812184a13374, []int{19}
 }
 
 func (m *StoreDel) XXX_Unmarshal(b []byte) error {
@@ -601,7 +1187,7 @@ func (m *StoreSetWithSync) Reset()         { *m = StoreSetWithSync{} }
 func (m *StoreSetWithSync) String() string { return proto.CompactTextString(m) }
 func (*StoreSetWithSync) ProtoMessage()    {}
 func (*StoreSetWithSync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{10}
+	return fileDescriptor_8817812184a13374, []int{20}
 }
 
 func (m *StoreSetWithSync) XXX_Unmarshal(b []byte) error {
@@ -655,7 +1241,7 @@ func (m *StoreGet) Reset()         { *m = StoreGet{} }
 func (m *StoreGet) String() string { return proto.CompactTextString(m) }
 func (*StoreGet) ProtoMessage()    {}
 func (*StoreGet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{11}
+	return fileDescriptor_8817812184a13374, []int{21}
 }
 
 func (m *StoreGet) XXX_Unmarshal(b []byte) error {
@@ -701,7 +1287,7 @@ func (m *StoreReplyValue) Reset()         { *m = StoreReplyValue{} }
 func (m *StoreReplyValue) String() string { return proto.CompactTextString(m) }
 func (*StoreReplyValue) ProtoMessage()    {}
 func (*StoreReplyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{12}
+	return fileDescriptor_8817812184a13374, []int{22}
 }
 
 func (m *StoreReplyValue) XXX_Unmarshal(b []byte) error {
@@ -730,12 +1316,14 @@ func (m *StoreReplyValue) GetValues() [][]byte {
 }
 
 type StoreList struct {
-	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
-	Start                []byte   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End                  []byte   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
-	Suffix               []byte   `protobuf:"bytes,4,opt,name=suffix,proto3" json:"suffix,omitempty"`
-	Count                int64    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	Mode                 int64    `protobuf:"varint,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	StateHash []byte `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Start     []byte `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End       []byte `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Suffix    []byte `protobuf:"bytes,4,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	Count     int64  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Mode      int64  `protobuf:"varint,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	//从end往start逆序遍历
+	Reverse              bool     `protobuf:"varint,7,opt,name=reverse,proto3" json:"reverse,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -745,7 +1333,7 @@ func (m *StoreList) Reset()         { *m = StoreList{} }
 func (m *StoreList) String() string { return proto.CompactTextString(m) }
 func (*StoreList) ProtoMessage()    {}
 func (*StoreList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{13}
+	return fileDescriptor_8817812184a13374, []int{23}
 }
 
 func (m *StoreList) XXX_Unmarshal(b []byte) error {
@@ -808,6 +1396,13 @@ func (m *StoreList) GetMode() int64 {
 	return 0
 }
 
+func (m *StoreList) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
 type StoreListReply struct {
 	Start                []byte   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
 	End                  []byte   `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
@@ -827,7 +1422,7 @@ func (m *StoreListReply) Reset()         { *m = StoreListReply{} }
 func (m *StoreListReply) String() string { return proto.CompactTextString(m) }
 func (*StoreListReply) ProtoMessage()    {}
 func (*StoreListReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{14}
+	return fileDescriptor_8817812184a13374, []int{24}
 }
 
 func (m *StoreListReply) XXX_Unmarshal(b []byte) error {
@@ -911,6 +1506,156 @@ func (m *StoreListReply) GetValues() [][]byte {
 	return nil
 }
 
+// 按前缀分页查询执行器的状态数据
+// 	 prefix : 执行器状态key前缀mavl-{execer}-之后的部分
+// 	 start : 上一页返回的nextKey，为空表示从头开始
+// 	 count : 每页的数量，最大100
+// 	 direction : 0表示降序，1表示升序
+// 	 proof : 是否返回每个key的存在证明
+// 	 stateHash : 查询的状态，为空表示最新的状态
+type ReqListState struct {
+	Prefix               []byte   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Start                []byte   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Proof                bool     `protobuf:"varint,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	StateHash            []byte   `protobuf:"bytes,6,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqListState) Reset()         { *m = ReqListState{} }
+func (m *ReqListState) String() string { return proto.CompactTextString(m) }
+func (*ReqListState) ProtoMessage()    {}
+func (*ReqListState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{25}
+}
+
+func (m *ReqListState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqListState.Unmarshal(m, b)
+}
+func (m *ReqListState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqListState.Marshal(b, m, deterministic)
+}
+func (m *ReqListState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqListState.Merge(m, src)
+}
+func (m *ReqListState) XXX_Size() int {
+	return xxx_messageInfo_ReqListState.Size(m)
+}
+func (m *ReqListState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqListState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqListState proto.InternalMessageInfo
+
+func (m *ReqListState) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *ReqListState) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ReqListState) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqListState) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqListState) GetProof() bool {
+	if m != nil {
+		return m.Proof
+	}
+	return false
+}
+
+func (m *ReqListState) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+type ReplyListState struct {
+	StateHash []byte      `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	KVs       []*KeyValue `protobuf:"bytes,2,rep,name=KVs,proto3" json:"KVs,omitempty"`
+	//下一页的start，为空表示没有更多的数据
+	NextKey              []byte        `protobuf:"bytes,3,opt,name=nextKey,proto3" json:"nextKey,omitempty"`
+	Proofs               []*StoreProof `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReplyListState) Reset()         { *m = ReplyListState{} }
+func (m *ReplyListState) String() string { return proto.CompactTextString(m) }
+func (*ReplyListState) ProtoMessage()    {}
+func (*ReplyListState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{26}
+}
+
+func (m *ReplyListState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyListState.Unmarshal(m, b)
+}
+func (m *ReplyListState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyListState.Marshal(b, m, deterministic)
+}
+func (m *ReplyListState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyListState.Merge(m, src)
+}
+func (m *ReplyListState) XXX_Size() int {
+	return xxx_messageInfo_ReplyListState.Size(m)
+}
+func (m *ReplyListState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyListState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyListState proto.InternalMessageInfo
+
+func (m *ReplyListState) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReplyListState) GetKVs() []*KeyValue {
+	if m != nil {
+		return m.KVs
+	}
+	return nil
+}
+
+func (m *ReplyListState) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func (m *ReplyListState) GetProofs() []*StoreProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
 type PruneData struct {
 	// 该叶子节点的所有父hash
 	Hashs                [][]byte `protobuf:"bytes,1,rep,name=hashs,proto3" json:"hashs,omitempty"`
@@ -923,7 +1668,7 @@ func (m *PruneData) Reset()         { *m = PruneData{} }
 func (m *PruneData) String() string { return proto.CompactTextString(m) }
 func (*PruneData) ProtoMessage()    {}
 func (*PruneData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{15}
+	return fileDescriptor_8817812184a13374, []int{27}
 }
 
 func (m *PruneData) XXX_Unmarshal(b []byte) error {
@@ -963,7 +1708,7 @@ func (m *StoreValuePool) Reset()         { *m = StoreValuePool{} }
 func (m *StoreValuePool) String() string { return proto.CompactTextString(m) }
 func (*StoreValuePool) ProtoMessage()    {}
 func (*StoreValuePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{16}
+	return fileDescriptor_8817812184a13374, []int{28}
 }
 
 func (m *StoreValuePool) XXX_Unmarshal(b []byte) error {
@@ -995,6 +1740,16 @@ func init() {
 	proto.RegisterType((*LeafNode)(nil), "types.LeafNode")
 	proto.RegisterType((*InnerNode)(nil), "types.InnerNode")
 	proto.RegisterType((*MAVLProof)(nil), "types.MAVLProof")
+	proto.RegisterType((*MAVLLeafProof)(nil), "types.MAVLLeafProof")
+	proto.RegisterType((*ReqStoreProof)(nil), "types.ReqStoreProof")
+	proto.RegisterType((*StoreProof)(nil), "types.StoreProof")
+	proto.RegisterType((*ReqValueHistory)(nil), "types.ReqValueHistory")
+	proto.RegisterType((*ValueAtHeight)(nil), "types.ValueAtHeight")
+	proto.RegisterType((*ValueHistory)(nil), "types.ValueHistory")
+	proto.RegisterType((*ReqCompactDB)(nil), "types.ReqCompactDB")
+	proto.RegisterType((*ReqDBUsage)(nil), "types.ReqDBUsage")
+	proto.RegisterType((*DBPrefixUsage)(nil), "types.DBPrefixUsage")
+	proto.RegisterType((*DBUsage)(nil), "types.DBUsage")
 	proto.RegisterType((*StoreNode)(nil), "types.StoreNode")
 	proto.RegisterType((*LocalDBSet)(nil), "types.LocalDBSet")
 	proto.RegisterType((*LocalDBList)(nil), "types.LocalDBList")
@@ -1007,6 +1762,8 @@ func init() {
 	proto.RegisterType((*StoreReplyValue)(nil), "types.StoreReplyValue")
 	proto.RegisterType((*StoreList)(nil), "types.StoreList")
 	proto.RegisterType((*StoreListReply)(nil), "types.StoreListReply")
+	proto.RegisterType((*ReqListState)(nil), "types.ReqListState")
+	proto.RegisterType((*ReplyListState)(nil), "types.ReplyListState")
 	proto.RegisterType((*PruneData)(nil), "types.PruneData")
 	proto.RegisterType((*StoreValuePool)(nil), "types.StoreValuePool")
 }
@@ -1014,46 +1771,70 @@ func init() {
 func init() { proto.RegisterFile("db.proto", fileDescriptor_8817812184a13374) }
 
 var fileDescriptor_8817812184a13374 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0x45, 0x92, 0x9d, 0x48, 0x93, 0xd0, 0x18, 0x11, 0x8a, 0x08, 0x29, 0x49, 0x75, 0x72, 0x29,
-	0x38, 0xa5, 0xee, 0xb1, 0x87, 0x26, 0x04, 0xd2, 0x62, 0xb7, 0x04, 0x05, 0x5c, 0xe8, 0xa1, 0xb0,
-	0x91, 0xc6, 0x91, 0x88, 0xbd, 0xeb, 0x4a, 0xab, 0x12, 0xf5, 0xd2, 0x8f, 0xe8, 0xa9, 0xbf, 0xd5,
-	0x2f, 0x2a, 0x3b, 0xbb, 0xb2, 0x64, 0x50, 0x93, 0xe6, 0x36, 0x6f, 0xbd, 0x7a, 0xef, 0xcd, 0x9b,
-	0x59, 0x0c, 0x6e, 0x72, 0x3d, 0x5a, 0xe5, 0x42, 0x0a, 0xbf, 0x2f, 0xab, 0x15, 0x16, 0x07, 0xbb,
-	0xb1, 0x58, 0x2e, 0x05, 0xd7, 0x87, 0xe1, 0x57, 0x70, 0xa7, 0xc8, 0xe6, 0x9f, 0x44, 0x82, 0xfe,
-	0x00, 0x9c, 0x5b, 0xac, 0x02, 0xeb, 0xd8, 0x1a, 0xee, 0x46, 0xaa, 0xf4, 0xf7, 0xa1, 0xff, 0x9d,
-	0x2d, 0x4a, 0x0c, 0x6c, 0x3a, 0xd3, 0xc0, 0x7f, 0x0a, 0x5b, 0x29, 0x66, 0x37, 0xa9, 0x0c, 0x9c,
-	0x63, 0x6b, 0xd8, 0x8f, 0x0c, 0xf2, 0x7d, 0xe8, 0x15, 0xd9, 0x0f, 0x0c, 0x7a, 0x74, 0x4a, 0x75,
-	0xf8, 0x0d, 0xbc, 0x0f, 0x9c, 0x63, 0x4e, 0x02, 0x07, 0xe0, 0x2e, 0x70, 0x2e, 0xdf, 0xb3, 0x22,
-	0x35, 0x2a, 0x6b, 0xec, 0x1f, 0x82, 0x97, 0x2b, 0x16, 0xfa, 0x51, 0xcb, 0x35, 0x07, 0x8f, 0x92,
-	0x2c, 0xc1, 0xfb, 0x78, 0x3a, 0x9b, 0x5e, 0xe6, 0x42, 0xcc, 0xb5, 0x24, 0x9b, 0x6f, 0x4a, 0x6a,
-	0xec, 0xbf, 0x02, 0xc8, 0x6a, 0x6f, 0x45, 0x60, 0x1f, 0x3b, 0xc3, 0x9d, 0xd7, 0x83, 0x11, 0xa5,
-	0x34, 0x5a, 0x9b, 0x8e, 0x5a, 0x77, 0x14, 0x5b, 0x2e, 0x84, 0xf6, 0xe8, 0x68, 0xb6, 0x1a, 0x87,
-	0xbf, 0x2d, 0xf0, 0xae, 0xa4, 0xc8, 0xf1, 0x51, 0x59, 0xb6, 0x23, 0x71, 0xee, 0x8b, 0xa4, 0xf7,
-	0xef, 0x48, 0xfa, 0x9d, 0x91, 0x6c, 0xb5, 0x22, 0x39, 0x05, 0x98, 0x8a, 0x98, 0x2d, 0xce, 0xcf,
-	0xae, 0x50, 0xfa, 0x47, 0x60, 0x4f, 0x66, 0xa6, 0xdf, 0x3d, 0xd3, 0xef, 0x04, 0xab, 0x99, 0x32,
-	0x14, 0xd9, 0x93, 0x99, 0xa2, 0x90, 0x77, 0x59, 0x42, 0xc4, 0x4e, 0x44, 0x75, 0xf8, 0x13, 0x76,
-	0x0c, 0xc5, 0x34, 0x2b, 0xa4, 0x52, 0x5f, 0xe5, 0x38, 0xcf, 0xee, 0x4c, 0x8b, 0x06, 0xd5, 0x7d,
-	0xdb, 0x4d, 0xdf, 0x87, 0xe0, 0x25, 0x59, 0x8e, 0xb1, 0xcc, 0x04, 0x37, 0xd3, 0x6b, 0x0e, 0x54,
-	0x2a, 0xb1, 0x28, 0xb9, 0x34, 0x13, 0xd4, 0xa0, 0xd3, 0xc0, 0x9b, 0x75, 0x0f, 0x17, 0x48, 0x37,
-	0x6e, 0xb1, 0xd2, 0x53, 0xdb, 0x8d, 0xa8, 0xee, 0xfc, 0xea, 0x05, 0xec, 0xd1, 0x57, 0x11, 0xae,
-	0x16, 0xba, 0x43, 0x65, 0x9d, 0xb2, 0xaf, 0x3f, 0x36, 0x28, 0x64, 0xe0, 0xd2, 0xfc, 0x54, 0x44,
-	0x87, 0xe0, 0x15, 0x92, 0x49, 0x6c, 0xed, 0x4d, 0x73, 0xf0, 0x70, 0x80, 0x9b, 0xeb, 0xea, 0xd4,
-	0xb3, 0x09, 0xdf, 0x19, 0x89, 0x73, 0x5c, 0x3c, 0x20, 0xd1, 0x30, 0xd8, 0x1b, 0x0c, 0x4b, 0x18,
-	0xd4, 0x26, 0x3f, 0x67, 0x32, 0xbd, 0xaa, 0x78, 0xec, 0xbf, 0x04, 0xb7, 0x50, 0x67, 0x05, 0x4a,
-	0x22, 0x6a, 0x4c, 0xd5, 0x57, 0xa3, 0xf5, 0x05, 0x5a, 0x8f, 0x8a, 0xc7, 0x44, 0xeb, 0x46, 0x54,
-	0xfb, 0x01, 0x6c, 0x97, 0xab, 0x9b, 0x9c, 0x25, 0x48, 0x7e, 0xdd, 0xa8, 0x86, 0xe1, 0x5b, 0x63,
-	0xf8, 0xe2, 0xc1, 0x4c, 0x3a, 0x06, 0xa2, 0xc2, 0xa7, 0xaf, 0xff, 0x23, 0xfc, 0x5f, 0xf5, 0xeb,
-	0xa1, 0xed, 0xba, 0x5f, 0x6a, 0x1f, 0xfa, 0x85, 0x64, 0xb9, 0xac, 0x5f, 0x12, 0x01, 0xb5, 0x79,
-	0xc8, 0x13, 0xf3, 0x88, 0x54, 0xa9, 0xb4, 0x8a, 0x72, 0xae, 0x76, 0x54, 0x3f, 0x1e, 0x83, 0x9a,
-	0x9d, 0xd3, 0x8b, 0xd2, 0xec, 0xdc, 0x52, 0x24, 0xfa, 0xdd, 0x38, 0x11, 0xd5, 0xe1, 0x1f, 0x0b,
-	0x9e, 0xac, 0x5d, 0x51, 0x17, 0x8d, 0xb8, 0xd5, 0x21, 0x6e, 0x77, 0x89, 0x3b, 0xdd, 0xe2, 0xbd,
-	0xb6, 0xf8, 0x00, 0x1c, 0x5e, 0x2e, 0x8d, 0x21, 0x55, 0x76, 0xd9, 0x51, 0x73, 0xe2, 0x78, 0x27,
-	0x27, 0x58, 0x05, 0xdb, 0x44, 0x5a, 0xc3, 0x75, 0xfa, 0x6e, 0xeb, 0x39, 0x34, 0x51, 0x7b, 0x1b,
-	0x51, 0x3f, 0x07, 0xef, 0x32, 0x2f, 0x39, 0x9e, 0x33, 0xc9, 0x94, 0x9d, 0x94, 0x15, 0x69, 0x11,
-	0x58, 0x74, 0x47, 0x83, 0x70, 0x68, 0xda, 0xa6, 0x99, 0x5d, 0x0a, 0xb1, 0x68, 0x91, 0x59, 0x6d,
-	0xb2, 0xb3, 0xa3, 0x2f, 0xcf, 0x6e, 0x32, 0x99, 0x96, 0xd7, 0xa3, 0x58, 0x2c, 0x4f, 0xc6, 0xe3,
-	0x98, 0x9f, 0xc4, 0x29, 0xcb, 0xf8, 0x78, 0x7c, 0x42, 0x2b, 0x78, 0xbd, 0x45, 0xff, 0x33, 0xe3,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xe6, 0x2e, 0x76, 0x88, 0x06, 0x00, 0x00,
+	// 1040 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x06, 0x45, 0x49, 0xa6, 0xc6, 0x72, 0xec, 0x12, 0x46, 0x41, 0x18, 0x29, 0xe2, 0xec, 0x49,
+	0x69, 0x0b, 0x3b, 0x88, 0x7a, 0x4c, 0xd1, 0x5a, 0x15, 0x10, 0x17, 0x72, 0x53, 0x77, 0x8d, 0xaa,
+	0x40, 0x0f, 0x05, 0x28, 0x6a, 0x64, 0x11, 0x96, 0xb8, 0x12, 0xb9, 0x32, 0xac, 0x5e, 0xfa, 0x16,
+	0x05, 0x7a, 0xef, 0xb5, 0x2f, 0xd3, 0xc7, 0xe8, 0x53, 0x14, 0x3b, 0xbb, 0xfc, 0x73, 0x28, 0x29,
+	0xc9, 0x6d, 0x67, 0x39, 0xbb, 0xf3, 0xcd, 0x7c, 0xdf, 0xcc, 0x12, 0x9c, 0xf1, 0xe8, 0x6c, 0x11,
+	0x0b, 0x29, 0xdc, 0x86, 0x5c, 0x2f, 0x30, 0x39, 0x69, 0x07, 0x62, 0x3e, 0x17, 0x91, 0xde, 0x64,
+	0xbf, 0x81, 0x73, 0x85, 0xfe, 0xe4, 0xad, 0x18, 0xa3, 0x7b, 0x04, 0xf6, 0x1d, 0xae, 0x3d, 0xeb,
+	0xd4, 0xea, 0xb4, 0xb9, 0x5a, 0xba, 0xc7, 0xd0, 0xb8, 0xf7, 0x67, 0x2b, 0xf4, 0x6a, 0xb4, 0xa7,
+	0x0d, 0xf7, 0x53, 0x68, 0x4e, 0x31, 0xbc, 0x9d, 0x4a, 0xcf, 0x3e, 0xb5, 0x3a, 0x0d, 0x6e, 0x2c,
+	0xd7, 0x85, 0x7a, 0x12, 0xfe, 0x8e, 0x5e, 0x9d, 0x76, 0x69, 0xcd, 0x96, 0xd0, 0xfa, 0x3e, 0x8a,
+	0x30, 0xa6, 0x00, 0x27, 0xe0, 0xcc, 0x70, 0x22, 0x2f, 0xfd, 0x64, 0x6a, 0xa2, 0x64, 0xb6, 0xfb,
+	0x14, 0x5a, 0xb1, 0xba, 0x85, 0x3e, 0xea, 0x70, 0xf9, 0xc6, 0x07, 0x85, 0x5c, 0x41, 0xeb, 0x87,
+	0x8b, 0xe1, 0xd5, 0x75, 0x2c, 0xc4, 0x44, 0x87, 0xf4, 0x27, 0xe5, 0x90, 0xda, 0x76, 0x5f, 0x02,
+	0x84, 0x29, 0xb6, 0xc4, 0xab, 0x9d, 0xda, 0x9d, 0xfd, 0x57, 0x47, 0x67, 0x54, 0xa5, 0xb3, 0x0c,
+	0x34, 0x2f, 0xf8, 0xa8, 0xdb, 0x62, 0x21, 0x34, 0x46, 0x5b, 0xdf, 0x96, 0xda, 0x2c, 0x84, 0x03,
+	0x15, 0x56, 0x55, 0x53, 0x87, 0x7e, 0xdf, 0x72, 0x96, 0x61, 0xd8, 0xbb, 0x61, 0xb0, 0x6f, 0xe0,
+	0x80, 0xe3, 0xf2, 0x46, 0x8a, 0x18, 0x75, 0xa8, 0xa7, 0xd0, 0x4a, 0xa4, 0x2f, 0xb1, 0x90, 0x66,
+	0xbe, 0x91, 0x02, 0xa9, 0x65, 0x40, 0xd8, 0x7f, 0x16, 0xc0, 0xc7, 0x1f, 0xcf, 0xf3, 0xb0, 0x1f,
+	0xc9, 0x02, 0x1f, 0xc2, 0x44, 0x26, 0xc4, 0x86, 0xc3, 0x8d, 0xe5, 0x76, 0xa0, 0xae, 0x4a, 0xee,
+	0x35, 0x4e, 0xad, 0xce, 0xfe, 0xab, 0x63, 0x93, 0x59, 0xa9, 0x56, 0x9c, 0x3c, 0xb4, 0xe7, 0x44,
+	0x7a, 0xcd, 0xed, 0x9e, 0x13, 0xe9, 0x7e, 0x0e, 0x0d, 0x12, 0x87, 0xb7, 0xb7, 0xc5, 0x55, 0xbb,
+	0xb0, 0x9f, 0xe0, 0x90, 0xe3, 0x72, 0xa8, 0x30, 0x5e, 0x86, 0x89, 0x14, 0xf1, 0xba, 0x82, 0x9a,
+	0x5c, 0x60, 0x2a, 0x4f, 0x3b, 0x13, 0xd8, 0x31, 0x34, 0x02, 0xb1, 0x8a, 0x52, 0xdd, 0x69, 0x83,
+	0x7d, 0x0d, 0x07, 0x74, 0xdf, 0x85, 0xbc, 0xd4, 0x6e, 0xf9, 0x71, 0xeb, 0xf1, 0xf1, 0x77, 0x19,
+	0x67, 0x6f, 0xa1, 0xbd, 0x03, 0xce, 0x97, 0xd0, 0x24, 0xd7, 0x54, 0x96, 0x69, 0x82, 0xa5, 0xa8,
+	0xdc, 0xf8, 0xb0, 0x11, 0xb4, 0x39, 0x2e, 0xbf, 0x13, 0xf3, 0x85, 0x1f, 0xc8, 0x7e, 0x4f, 0x75,
+	0x45, 0xe4, 0xcf, 0x91, 0x2e, 0x6c, 0x71, 0x5a, 0x2b, 0x84, 0x8b, 0x18, 0x27, 0xe1, 0x83, 0x81,
+	0x62, 0x2c, 0x85, 0x30, 0x91, 0x7e, 0x2c, 0x53, 0x2e, 0xc9, 0x50, 0x88, 0x30, 0x1a, 0x13, 0x91,
+	0x6d, 0xae, 0x96, 0xec, 0x35, 0x00, 0xc7, 0x65, 0xbf, 0xf7, 0x73, 0xe2, 0xdf, 0x62, 0x65, 0x84,
+	0x13, 0x70, 0xf4, 0x9d, 0x06, 0x75, 0x9b, 0x67, 0x36, 0xfb, 0xcb, 0x82, 0x83, 0x7e, 0xef, 0x9a,
+	0x4c, 0x7d, 0x43, 0x8e, 0xc7, 0x2a, 0xe1, 0x71, 0xa1, 0x7e, 0x87, 0xeb, 0xc4, 0xd0, 0x40, 0x6b,
+	0xa5, 0xcf, 0x59, 0x78, 0x8f, 0xbd, 0xb5, 0xa4, 0x06, 0x51, 0x1f, 0xf2, 0x0d, 0xf5, 0x75, 0x1c,
+	0x26, 0x77, 0xfa, 0x6b, 0x5d, 0x7f, 0xcd, 0x36, 0xdc, 0x53, 0xd8, 0x8f, 0x31, 0x98, 0xf9, 0xe1,
+	0xdc, 0x1f, 0xcd, 0x90, 0x44, 0x68, 0xf3, 0xe2, 0x16, 0xfb, 0x11, 0xf6, 0xb6, 0xa5, 0xf5, 0xf2,
+	0x51, 0x5a, 0x39, 0x19, 0xa5, 0x84, 0xca, 0xc9, 0xb6, 0xa8, 0xbb, 0x3e, 0x68, 0xaa, 0x16, 0x87,
+	0xa3, 0xbd, 0x6d, 0x38, 0xd6, 0x37, 0x0f, 0xc7, 0x46, 0xe5, 0x70, 0x6c, 0x16, 0x86, 0xe3, 0x05,
+	0xc0, 0x95, 0x08, 0xfc, 0x59, 0xbf, 0x77, 0x83, 0xd2, 0x7d, 0x06, 0xb5, 0xc1, 0xd0, 0x64, 0x75,
+	0x68, 0xb2, 0x1a, 0xe0, 0x9a, 0x54, 0xc6, 0x6b, 0x83, 0xa1, 0xba, 0x42, 0x3e, 0x84, 0x63, 0x53,
+	0x36, 0x5a, 0xb3, 0x3f, 0x60, 0xdf, 0x5c, 0x71, 0x15, 0x26, 0x72, 0x23, 0x91, 0xef, 0x8e, 0x0d,
+	0x22, 0x2a, 0xc6, 0x40, 0x86, 0x22, 0x32, 0xfd, 0x94, 0x6f, 0xe4, 0x9d, 0x56, 0x2f, 0x74, 0x5a,
+	0x25, 0x80, 0xaf, 0xb2, 0x1c, 0xde, 0xa0, 0x2c, 0x08, 0x46, 0x49, 0x4e, 0x0b, 0xa6, 0xea, 0xd4,
+	0x0b, 0x38, 0xa4, 0x53, 0x1c, 0x17, 0x33, 0x9d, 0xa1, 0x82, 0x5e, 0xe8, 0xb2, 0x76, 0xd6, 0x4f,
+	0x3e, 0x38, 0xc4, 0x9f, 0x2a, 0xd1, 0xf6, 0xd9, 0xb8, 0xb3, 0x80, 0xe5, 0x87, 0x2b, 0x1b, 0x0c,
+	0xec, 0x5b, 0x13, 0xa2, 0x8f, 0xb3, 0x1d, 0x21, 0x36, 0x4c, 0x26, 0x36, 0x87, 0xa3, 0x14, 0xe4,
+	0x2f, 0xa1, 0x9c, 0xde, 0xac, 0xa3, 0xc0, 0xfd, 0x02, 0x1c, 0x35, 0x51, 0x30, 0x41, 0x3d, 0x88,
+	0x72, 0x50, 0xa9, 0x2b, 0xcf, 0x1c, 0x48, 0x1e, 0xeb, 0x28, 0xa0, 0x6b, 0x1d, 0x4e, 0x6b, 0xd7,
+	0x83, 0xbd, 0xd5, 0xe2, 0x36, 0xf6, 0xc7, 0x7a, 0xb6, 0x3b, 0x3c, 0x35, 0xd9, 0x6b, 0x03, 0xf8,
+	0xcd, 0xce, 0x9a, 0x54, 0x10, 0xa2, 0x8a, 0x4f, 0xa7, 0xdf, 0xa3, 0xf8, 0xff, 0xa4, 0xdd, 0x43,
+	0xea, 0xda, 0x1e, 0x2a, 0x1b, 0x5e, 0xb5, 0x8a, 0xe1, 0x65, 0x67, 0xc3, 0x4b, 0xc5, 0x4a, 0x56,
+	0x13, 0xa5, 0x51, 0xdd, 0x3c, 0xc6, 0xca, 0x35, 0xa7, 0x85, 0x92, 0x6b, 0x6e, 0x2e, 0xc6, 0xba,
+	0x6f, 0x6c, 0x4e, 0x6b, 0x55, 0x98, 0x18, 0xef, 0x31, 0x4e, 0x90, 0x9e, 0x1c, 0x87, 0xa7, 0x26,
+	0xfb, 0xd7, 0x82, 0x27, 0x19, 0x5e, 0xca, 0x2f, 0x87, 0x65, 0x55, 0xc0, 0xaa, 0x55, 0xc1, 0xb2,
+	0xab, 0x61, 0xd5, 0x8b, 0xb0, 0x8e, 0xc0, 0x8e, 0x56, 0x73, 0x03, 0x55, 0x2d, 0x37, 0x01, 0x8d,
+	0xf0, 0x41, 0x0e, 0x70, 0x4d, 0x40, 0xdb, 0x3c, 0x35, 0x33, 0x5e, 0x9c, 0x42, 0xa3, 0xe4, 0x24,
+	0xb4, 0x4a, 0x24, 0xfc, 0x6d, 0xd1, 0x93, 0xa2, 0x52, 0xba, 0x51, 0xd5, 0xde, 0xd8, 0xe5, 0xd5,
+	0x0c, 0x54, 0xbe, 0x9a, 0xe5, 0xfe, 0xaf, 0x57, 0xf4, 0xff, 0x42, 0x3d, 0xdb, 0x94, 0xa0, 0xc3,
+	0xb5, 0x51, 0xe6, 0xbf, 0xf9, 0x88, 0x7f, 0xf6, 0xa7, 0x05, 0x4f, 0xa8, 0xe4, 0x39, 0xd0, 0xed,
+	0x82, 0x79, 0x0e, 0xf6, 0x60, 0x98, 0x6c, 0x6a, 0x58, 0xf5, 0xad, 0x58, 0x40, 0xbb, 0x5c, 0xc0,
+	0x17, 0xaa, 0x06, 0x42, 0x4c, 0xd4, 0x2b, 0xa3, 0xce, 0x7f, 0x52, 0xec, 0x2d, 0xfd, 0xcb, 0x61,
+	0x1c, 0xd8, 0x73, 0x68, 0x5d, 0xc7, 0xab, 0x08, 0xfb, 0xbe, 0xf4, 0x55, 0x66, 0x53, 0x3f, 0x99,
+	0x26, 0x9e, 0x45, 0x35, 0xd6, 0x06, 0xeb, 0x18, 0xd9, 0x50, 0xe8, 0x6b, 0x21, 0x66, 0x05, 0x32,
+	0xac, 0x22, 0x19, 0xbd, 0x67, 0xbf, 0x7e, 0x76, 0x1b, 0xca, 0xe9, 0x6a, 0x74, 0x16, 0x88, 0xf9,
+	0x79, 0xb7, 0x1b, 0x44, 0xe7, 0xc1, 0xd4, 0x0f, 0xa3, 0x6e, 0xf7, 0x9c, 0x00, 0x8c, 0x9a, 0xf4,
+	0x2f, 0xdf, 0xfd, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x76, 0x61, 0x70, 0x34, 0xec, 0x0b, 0x00, 0x00,
 }
@@ -164,6 +164,8 @@ var (
 	ErrIndex                      = errors.New("ErrIndex")
 	ErrTxGroupParaCount           = errors.New("ErrTxGroupParaCount")
 	ErrTxGroupParaMainMixed       = errors.New("ErrTxGroupParaMainMixed")
+	ErrMultiActionCount           = errors.New("ErrMultiActionCount")
+	ErrMultiActionNested          = errors.New("ErrMultiActionNested")
 
 	//ErrInvalidMainnetRPCAddr rpc模块的错误类型
 	ErrInvalidMainnetRPCAddr = errors.New("ErrInvalidMainnetRPCAddr")
@@ -183,7 +185,22 @@ var (
 	ErrHeightOverflow      = errors.New("ErrHeightOverflow")
 	ErrRecordBlockSequence = errors.New("ErrRecordBlockSequence")
 	ErrExecPanic           = errors.New("ErrExecPanic")
+	ErrSimulateTxFail      = errors.New("ErrSimulateTxFail")
 
 	ErrDisableWrite = errors.New("ErrDisableWrite")
 	ErrDisableRead  = errors.New("ErrDisableRead")
+
+	ErrReorgFinalized = errors.New("ErrReorgFinalized")
+	ErrStoreProof     = errors.New("ErrStoreProof")
+	ErrReadOnly       = errors.New("ErrReadOnly")
+
+	ErrFeePayerNotAllow = errors.New("ErrFeePayerNotAllow")
+	ErrFeePayerInGroup  = errors.New("ErrFeePayerInGroup")
+	ErrOutOfGas         = errors.New("ErrOutOfGas")
+	ErrMuSigSession     = errors.New("ErrMuSigSession")
+	ErrNonCanonical     = errors.New("ErrNonCanonical")
+	ErrMultiSigScript   = errors.New("ErrMultiSigScript")
+	ErrTypedData        = errors.New("ErrTypedData")
+	ErrTxChainID        = errors.New("ErrTxChainID")
+	ErrSignAddrNotAllow = errors.New("ErrSignAddrNotAllow")
 )
@@ -153,6 +153,41 @@ const (
 
 	EventReExecBlock = 142
 
+	//mempool
+	EventGetBlockCandidate   = 143
+	EventAddMempoolTxEventCB = 144
+
+	//consensus
+	EventConsensusBroadcast = 145
+	EventConsensusMsg       = 146
+
+	//store
+	EventStoreGetProof      = 147
+	EventStoreGetProofReply = 148
+
+	//blockchain
+	EventGetValueHistory   = 149
+	EventReplyValueHistory = 150
+
+	//p2p 节点管理
+	EventNetPeers            = 151
+	EventReplyNetPeers       = 152
+	EventNetAddPeer          = 153
+	EventNetRemovePeer       = 154
+	EventNetBanPeer          = 155
+	EventNetUnbanPeer        = 156
+	EventNetBannedPeers      = 157
+	EventReplyNetBannedPeers = 158
+	EventNetSelfInfo         = 159
+	EventReplyNetSelfInfo    = 160
+
+	//p2p 地址簿
+	EventNetAddrBook          = 161
+	EventReplyNetAddrBook     = 162
+	EventNetAddrBookRemove    = 163
+	EventNetAddrBookSeed      = 164
+	EventReplyNetAddrBookEdit = 165
+
 	//exec
 	EventBlockChainQuery = 212
 	EventConsensusQuery  = 213
@@ -299,6 +334,34 @@ var eventName = map[int]string{
 	EventLocalClose:    "EventLocalClose",
 
 	//mempool
-	EventGetProperFee:   "EventGetProperFee",
-	EventReplyProperFee: "EventReplyProperFee",
+	EventGetProperFee:        "EventGetProperFee",
+	EventReplyProperFee:      "EventReplyProperFee",
+	EventGetBlockCandidate:   "EventGetBlockCandidate",
+	EventAddMempoolTxEventCB: "EventAddMempoolTxEventCB",
+
+	EventConsensusBroadcast: "EventConsensusBroadcast",
+	EventConsensusMsg:       "EventConsensusMsg",
+
+	EventStoreGetProof:      "EventStoreGetProof",
+	EventStoreGetProofReply: "EventStoreGetProofReply",
+
+	EventGetValueHistory:   "EventGetValueHistory",
+	EventReplyValueHistory: "EventReplyValueHistory",
+
+	EventNetPeers:            "EventNetPeers",
+	EventReplyNetPeers:       "EventReplyNetPeers",
+	EventNetAddPeer:          "EventNetAddPeer",
+	EventNetRemovePeer:       "EventNetRemovePeer",
+	EventNetBanPeer:          "EventNetBanPeer",
+	EventNetUnbanPeer:        "EventNetUnbanPeer",
+	EventNetBannedPeers:      "EventNetBannedPeers",
+	EventReplyNetBannedPeers: "EventReplyNetBannedPeers",
+	EventNetSelfInfo:         "EventNetSelfInfo",
+	EventReplyNetSelfInfo:    "EventReplyNetSelfInfo",
+
+	EventNetAddrBook:          "EventNetAddrBook",
+	EventReplyNetAddrBook:     "EventReplyNetAddrBook",
+	EventNetAddrBookRemove:    "EventNetAddrBookRemove",
+	EventNetAddrBookSeed:      "EventNetAddrBookSeed",
+	EventReplyNetAddrBookEdit: "EventReplyNetAddrBookEdit",
 }
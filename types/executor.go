@@ -171,6 +171,7 @@ func CreateFormatTx(execName string, payload []byte) (*Transaction, error) {
 func FormatTx(execName string, tx *Transaction) (*Transaction, error) {
 	//填写nonce,execer,to, fee 等信息, 后面会增加一个修改transaction的函数，会加上execer fee 等的修改
 	tx.Nonce = rand.Int63()
+	tx.ChainID = GetChainID()
 	tx.Execer = []byte(execName)
 	//平行链，所有的to地址都是合约地址
 	if IsPara() || tx.To == "" {
@@ -649,6 +650,14 @@ func (base *ExecTypeBase) AssertCreate(c *CreateTx) (*Transaction, error) {
 		return base.child.CreateTransaction("TransferToExec", v)
 	}
 	v := &AssetsTransfer{Cointoken: c.GetTokenSymbol(), Amount: c.Amount, Note: c.GetNote(), To: c.To}
+	if c.NotePubKey != "" {
+		note, err := EncryptNote(c.NotePubKey, c.GetNote())
+		if err != nil {
+			return nil, err
+		}
+		v.Note = nil
+		v.EncryptedNote = note
+	}
 	return base.child.CreateTransaction("Transfer", v)
 }
 
@@ -398,11 +398,13 @@ type ConfigItem struct {
 	//	*ConfigItem_Arr
 	//	*ConfigItem_Str
 	//	*ConfigItem_Int
-	Value                isConfigItem_Value `protobuf_oneof:"value"`
-	Ty                   int32              `protobuf:"varint,11,opt,name=Ty,proto3" json:"Ty,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Value isConfigItem_Value `protobuf_oneof:"value"`
+	Ty    int32              `protobuf:"varint,11,opt,name=Ty,proto3" json:"Ty,omitempty"`
+	//还没有生效的计划修改，按生效高度排序
+	Scheduled            []*ModifyConfig `protobuf:"bytes,12,rep,name=scheduled,proto3" json:"scheduled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *ConfigItem) Reset()         { *m = ConfigItem{} }
@@ -501,6 +503,13 @@ func (m *ConfigItem) GetTy() int32 {
 	return 0
 }
 
+func (m *ConfigItem) GetScheduled() []*ModifyConfig {
+	if m != nil {
+		return m.Scheduled
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ConfigItem) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ConfigItem_OneofMarshaler, _ConfigItem_OneofUnmarshaler, _ConfigItem_OneofSizer, []interface{}{
@@ -594,11 +603,13 @@ func _ConfigItem_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// effectiveHeight 不为0的时候修改在这个高度生效
 type ModifyConfig struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	Op                   string   `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
 	Addr                 string   `protobuf:"bytes,4,opt,name=addr,proto3" json:"addr,omitempty"`
+	EffectiveHeight      int64    `protobuf:"varint,5,opt,name=effectiveHeight,proto3" json:"effectiveHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -657,6 +668,13 @@ func (m *ModifyConfig) GetAddr() string {
 	return ""
 }
 
+func (m *ModifyConfig) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
 type ReceiptConfig struct {
 	Prev                 *ConfigItem `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
 	Current              *ConfigItem `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
@@ -840,47 +858,50 @@ func init() {
 func init() { proto.RegisterFile("executor.proto", fileDescriptor_12d1cdcda51e000f) }
 
 var fileDescriptor_12d1cdcda51e000f = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xed, 0x6a, 0xdb, 0x4a,
-	0x10, 0xbd, 0x92, 0xec, 0x38, 0x1e, 0xfb, 0x86, 0x64, 0xef, 0xa5, 0x88, 0xd0, 0x26, 0x46, 0x49,
-	0x53, 0x43, 0x8b, 0x03, 0x36, 0x7d, 0x80, 0xc6, 0x94, 0x26, 0xd0, 0x14, 0xaa, 0xb8, 0x7f, 0xf2,
-	0xa3, 0xb0, 0x59, 0x8f, 0xed, 0x25, 0xf6, 0xae, 0x58, 0x8d, 0x82, 0xf5, 0x36, 0x7d, 0x96, 0xd2,
-	0x07, 0x2b, 0xbb, 0x92, 0x2d, 0xd1, 0xa4, 0x85, 0xfe, 0xd3, 0x9c, 0x73, 0x74, 0x76, 0xce, 0xec,
-	0x07, 0xec, 0xe1, 0x1a, 0x45, 0x46, 0xda, 0x0c, 0x12, 0xa3, 0x49, 0xb3, 0x26, 0xe5, 0x09, 0xa6,
-	0x87, 0x07, 0x64, 0xb8, 0x4a, 0xb9, 0x20, 0xa9, 0x55, 0xc1, 0x44, 0xc7, 0xd0, 0xfa, 0x80, 0x0a,
-	0x53, 0x99, 0xb2, 0xff, 0xa1, 0x29, 0x53, 0x93, 0xa9, 0xd0, 0xeb, 0x79, 0xfd, 0xdd, 0xb8, 0x28,
-	0xa2, 0x6f, 0x3e, 0xc0, 0xfb, 0x35, 0x8a, 0xc9, 0xfa, 0xa3, 0x4c, 0x89, 0x3d, 0x87, 0x76, 0x4a,
-	0x9c, 0xf0, 0x92, 0xa7, 0x0b, 0x27, 0xec, 0xc6, 0x15, 0xc0, 0x8e, 0x00, 0x12, 0x6e, 0x50, 0x91,
-	0xa3, 0x5b, 0x8e, 0xae, 0x21, 0xec, 0x10, 0x76, 0x57, 0x5c, 0x2a, 0xc7, 0xee, 0x3a, 0x76, 0x5b,
-	0xdb, 0x7f, 0xdd, 0x37, 0xca, 0xf9, 0x82, 0xc2, 0x76, 0xcf, 0xeb, 0x07, 0x71, 0x0d, 0xb1, 0x2b,
-	0xdf, 0x2d, 0xb5, 0xb8, 0x9f, 0xc8, 0x15, 0x86, 0x81, 0xa3, 0x2b, 0x80, 0x3d, 0x83, 0x9d, 0x45,
-	0xf1, 0x67, 0xc3, 0x51, 0x65, 0x65, 0x5d, 0xa7, 0x72, 0x36, 0x93, 0x22, 0x5b, 0x52, 0x1e, 0x36,
-	0x7b, 0x5e, 0xbf, 0x11, 0xd7, 0x10, 0xeb, 0x2a, 0xd3, 0x6b, 0x5c, 0x25, 0x5a, 0x2f, 0xc3, 0x1d,
-	0x17, 0xbc, 0x02, 0xd8, 0x29, 0x04, 0xb4, 0x4e, 0x43, 0xbf, 0x17, 0xf4, 0x3b, 0x43, 0x36, 0x70,
-	0x53, 0x1c, 0x4c, 0xaa, 0x21, 0xc6, 0x96, 0x8e, 0xbe, 0x40, 0xf3, 0x73, 0x86, 0x26, 0xb7, 0x4d,
-	0xd8, 0xc1, 0xa3, 0x29, 0x27, 0x53, 0x56, 0x36, 0xf6, 0x2c, 0x53, 0xe2, 0x13, 0x5f, 0x61, 0xe8,
-	0xf7, 0xbc, 0x7e, 0x3b, 0xde, 0xd6, 0x2c, 0x84, 0x56, 0xc2, 0xf3, 0xa5, 0xe6, 0x53, 0x17, 0xaa,
-	0x1b, 0x6f, 0xca, 0xe8, 0x2b, 0xc0, 0xd8, 0x20, 0x27, 0x9c, 0xac, 0xaf, 0xd4, 0x6f, 0xbd, 0x8f,
-	0x00, 0x8a, 0x5e, 0x6a, 0xee, 0x35, 0xe4, 0x0f, 0xfe, 0x27, 0xd0, 0x79, 0x67, 0x0c, 0xcf, 0xc7,
-	0x5a, 0xcd, 0xe4, 0xdc, 0x6e, 0xff, 0x03, 0x5f, 0x66, 0x76, 0xb6, 0x41, 0xbf, 0x1d, 0x17, 0x45,
-	0x74, 0x0a, 0xdd, 0x1b, 0x32, 0x52, 0xcd, 0x1f, 0xab, 0xbc, 0x4a, 0x75, 0x02, 0x9d, 0x2b, 0x45,
-	0xa3, 0xe1, 0x53, 0xa2, 0xe6, 0x46, 0xf4, 0xc3, 0x03, 0x28, 0x04, 0x57, 0x84, 0x2b, 0xb6, 0x0f,
-	0xc1, 0x3d, 0xe6, 0x2e, 0x4d, 0x3b, 0xb6, 0x9f, 0x8c, 0x41, 0x83, 0x4f, 0xa7, 0xa6, 0x0c, 0xe1,
-	0xbe, 0xd9, 0x19, 0x04, 0xdc, 0x18, 0x67, 0x54, 0xed, 0x40, 0xad, 0xed, 0xcb, 0x7f, 0x62, 0x2b,
-	0x60, 0xaf, 0x20, 0x48, 0xc9, 0xb8, 0xcd, 0xef, 0x0c, 0xff, 0x2b, 0x75, 0xf5, 0xce, 0xad, 0x30,
-	0x25, 0x67, 0x28, 0x15, 0xb9, 0x93, 0x50, 0x19, 0xd6, 0x9a, 0xb7, 0x3a, 0xa9, 0x88, 0xed, 0x81,
-	0x3f, 0xc9, 0xc3, 0x8e, 0x0b, 0xe0, 0x4f, 0xf2, 0x8b, 0x56, 0x99, 0x29, 0xba, 0x85, 0xee, 0xb5,
-	0x9e, 0xca, 0xd9, 0x66, 0x6e, 0x8f, 0x73, 0x6c, 0xe3, 0xfb, 0xb5, 0x19, 0x59, 0x43, 0x9d, 0x94,
-	0x63, 0xf3, 0x75, 0xb2, 0x4d, 0xdb, 0xa8, 0xd2, 0x46, 0x02, 0xfe, 0x8d, 0x51, 0xa0, 0x4c, 0xa8,
-	0x34, 0x7f, 0x09, 0x8d, 0xc4, 0xe0, 0x83, 0x73, 0xef, 0x0c, 0x0f, 0xca, 0x76, 0xab, 0x29, 0xc6,
-	0x8e, 0x66, 0xaf, 0xa1, 0x25, 0x32, 0x63, 0xaf, 0x99, 0x5b, 0xf3, 0x49, 0xe5, 0x46, 0x11, 0xbd,
-	0x85, 0x4e, 0x8c, 0xc9, 0xf2, 0x2f, 0xfb, 0x8f, 0xbe, 0x7b, 0xb0, 0x7f, 0x29, 0x53, 0xd2, 0x26,
-	0x1f, 0xa3, 0xa1, 0x1b, 0xd2, 0x06, 0xed, 0xf5, 0x31, 0x5a, 0x93, 0x40, 0x43, 0x69, 0xe8, 0xf5,
-	0x02, 0xfb, 0x1c, 0x6c, 0x01, 0xf6, 0x06, 0x0e, 0xa4, 0x22, 0x34, 0x2b, 0x9c, 0x4a, 0x4e, 0x38,
-	0x76, 0x2a, 0xdf, 0xa9, 0x1e, 0x13, 0xec, 0x0c, 0xf6, 0x0c, 0x3e, 0x68, 0xc1, 0xed, 0xd9, 0xb5,
-	0x8f, 0x8d, 0x3b, 0x89, 0xdd, 0xf8, 0x17, 0xd4, 0xae, 0x29, 0x32, 0x63, 0x5f, 0x05, 0x5a, 0x94,
-	0xb7, 0xbd, 0x02, 0x2c, 0xab, 0xd6, 0x54, 0xbe, 0x22, 0xcd, 0x82, 0xdd, 0x02, 0x17, 0xc7, 0xb7,
-	0x2f, 0xe6, 0x92, 0x16, 0xd9, 0xdd, 0x40, 0xe8, 0xd5, 0xf9, 0x68, 0x24, 0xd4, 0xb9, 0x58, 0x70,
-	0xa9, 0x46, 0xa3, 0x73, 0x37, 0xb0, 0xbb, 0x1d, 0xf7, 0x2c, 0x8e, 0x7e, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x22, 0x46, 0xb1, 0x62, 0x42, 0x05, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5d, 0x6f, 0xdb, 0x36,
+	0x14, 0x9d, 0x24, 0x3b, 0x8e, 0xaf, 0xbd, 0x2c, 0xe1, 0x86, 0x41, 0x08, 0xb6, 0xc4, 0x50, 0xb2,
+	0xcc, 0xc0, 0x06, 0x07, 0xb3, 0xb1, 0x1f, 0xb0, 0x18, 0x43, 0x13, 0xa0, 0x29, 0x50, 0xc5, 0x7d,
+	0xe9, 0x43, 0x01, 0x86, 0xba, 0xb6, 0x89, 0xd8, 0xa4, 0x40, 0x5d, 0x19, 0xd6, 0x7b, 0x7f, 0x48,
+	0x7f, 0x4b, 0xff, 0x54, 0x5f, 0x0b, 0xd2, 0xb2, 0xa5, 0x26, 0x69, 0x81, 0xbe, 0xe9, 0x9e, 0x73,
+	0x74, 0x79, 0xee, 0xe1, 0x07, 0x1c, 0xe0, 0x1a, 0x45, 0x4e, 0xda, 0x0c, 0x52, 0xa3, 0x49, 0xb3,
+	0x26, 0x15, 0x29, 0x66, 0xc7, 0x47, 0x64, 0xb8, 0xca, 0xb8, 0x20, 0xa9, 0xd5, 0x86, 0x89, 0x4e,
+	0xa1, 0xf5, 0x02, 0x15, 0x66, 0x32, 0x63, 0xbf, 0x40, 0x53, 0x66, 0x26, 0x57, 0xa1, 0xd7, 0xf3,
+	0xfa, 0xfb, 0xf1, 0xa6, 0x88, 0x3e, 0xf8, 0x00, 0xff, 0xaf, 0x51, 0x4c, 0xd6, 0x2f, 0x65, 0x46,
+	0xec, 0x37, 0x68, 0x67, 0xc4, 0x09, 0xaf, 0x79, 0x36, 0x77, 0xc2, 0x6e, 0x5c, 0x01, 0xec, 0x04,
+	0x20, 0xe5, 0x06, 0x15, 0x39, 0xba, 0xe5, 0xe8, 0x1a, 0xc2, 0x8e, 0x61, 0x7f, 0xc9, 0xa5, 0x72,
+	0xec, 0xbe, 0x63, 0x77, 0xb5, 0xfd, 0xd7, 0x7d, 0xa3, 0x9c, 0xcd, 0x29, 0x6c, 0xf7, 0xbc, 0x7e,
+	0x10, 0xd7, 0x10, 0xbb, 0xf2, 0xfd, 0x42, 0x8b, 0x87, 0x89, 0x5c, 0x62, 0x18, 0x38, 0xba, 0x02,
+	0xd8, 0xaf, 0xb0, 0x37, 0xdf, 0xfc, 0xd9, 0x70, 0x54, 0x59, 0xd9, 0xae, 0x89, 0x9c, 0x4e, 0xa5,
+	0xc8, 0x17, 0x54, 0x84, 0xcd, 0x9e, 0xd7, 0x6f, 0xc4, 0x35, 0xc4, 0x76, 0x95, 0xd9, 0x2d, 0x2e,
+	0x53, 0xad, 0x17, 0xe1, 0x9e, 0x1b, 0xbc, 0x02, 0xd8, 0x39, 0x04, 0xb4, 0xce, 0x42, 0xbf, 0x17,
+	0xf4, 0x3b, 0x43, 0x36, 0x70, 0x29, 0x0e, 0x26, 0x55, 0x88, 0xb1, 0xa5, 0xa3, 0x37, 0xd0, 0x7c,
+	0x9d, 0xa3, 0x29, 0xac, 0x09, 0x1b, 0x3c, 0x9a, 0x32, 0x99, 0xb2, 0xb2, 0x63, 0x4f, 0x73, 0x25,
+	0x5e, 0xf1, 0x25, 0x86, 0x7e, 0xcf, 0xeb, 0xb7, 0xe3, 0x5d, 0xcd, 0x42, 0x68, 0xa5, 0xbc, 0x58,
+	0x68, 0x9e, 0xb8, 0xa1, 0xba, 0xf1, 0xb6, 0x8c, 0xde, 0x01, 0x8c, 0x0d, 0x72, 0xc2, 0xc9, 0xfa,
+	0x46, 0x7d, 0xb5, 0xf7, 0x09, 0xc0, 0xc6, 0x4b, 0xad, 0x7b, 0x0d, 0xf9, 0x46, 0xff, 0x33, 0xe8,
+	0xfc, 0x67, 0x0c, 0x2f, 0xc6, 0x5a, 0x4d, 0xe5, 0xcc, 0x6e, 0xff, 0x8a, 0x2f, 0x72, 0x9b, 0x6d,
+	0xd0, 0x6f, 0xc7, 0x9b, 0x22, 0x3a, 0x87, 0xee, 0x1d, 0x19, 0xa9, 0x66, 0x4f, 0x55, 0x5e, 0xa5,
+	0x3a, 0x83, 0xce, 0x8d, 0xa2, 0xd1, 0xf0, 0x39, 0x51, 0x73, 0x2b, 0xfa, 0xe4, 0x01, 0x6c, 0x04,
+	0x37, 0x84, 0x4b, 0x76, 0x08, 0xc1, 0x03, 0x16, 0x6e, 0x9a, 0x76, 0x6c, 0x3f, 0x19, 0x83, 0x06,
+	0x4f, 0x12, 0x53, 0x0e, 0xe1, 0xbe, 0xd9, 0x05, 0x04, 0xdc, 0x18, 0xd7, 0xa8, 0xda, 0x81, 0x9a,
+	0xed, 0xeb, 0x1f, 0x62, 0x2b, 0x60, 0x7f, 0x42, 0x90, 0x91, 0x71, 0x9b, 0xdf, 0x19, 0xfe, 0x5c,
+	0xea, 0xea, 0xce, 0xad, 0x30, 0x23, 0xd7, 0x50, 0x2a, 0x72, 0x27, 0xa1, 0x6a, 0x58, 0x33, 0x6f,
+	0x75, 0x52, 0x11, 0x3b, 0x00, 0x7f, 0x52, 0x84, 0x1d, 0x37, 0x80, 0x3f, 0x29, 0xd8, 0x3f, 0xd0,
+	0xce, 0xc4, 0x1c, 0x93, 0x7c, 0x81, 0x49, 0xd8, 0x75, 0x07, 0x62, 0xbb, 0xcc, 0xad, 0x4e, 0xe4,
+	0xb4, 0xf4, 0x13, 0x57, 0xaa, 0xab, 0x56, 0x19, 0x43, 0xf4, 0xde, 0x83, 0x6e, 0x5d, 0xf4, 0xcc,
+	0xec, 0xbb, 0xc8, 0xfc, 0x5a, 0xae, 0xd6, 0x84, 0x4e, 0xcb, 0xa8, 0x7d, 0x9d, 0xee, 0x12, 0x6a,
+	0xd4, 0x12, 0xea, 0xc3, 0x4f, 0x38, 0x9d, 0xa2, 0x20, 0xb9, 0xc2, 0xf2, 0xf2, 0x34, 0xdd, 0x15,
+	0x78, 0x0c, 0x47, 0x02, 0x7e, 0x8c, 0x51, 0xa0, 0x4c, 0xa9, 0xb4, 0xf1, 0x07, 0x34, 0x52, 0x83,
+	0x2b, 0xe7, 0xa3, 0x33, 0x3c, 0x2a, 0xc7, 0xa9, 0xf6, 0x28, 0x76, 0x34, 0xfb, 0x0b, 0x5a, 0x22,
+	0x37, 0xf6, 0x12, 0x3b, 0x77, 0xcf, 0x2a, 0xb7, 0x8a, 0xe8, 0x5f, 0xe8, 0xc4, 0x98, 0x2e, 0xbe,
+	0x73, 0xd2, 0xe8, 0xa3, 0x07, 0x87, 0xd7, 0x32, 0x23, 0x6d, 0x8a, 0x31, 0x1a, 0xba, 0x23, 0x6d,
+	0xd0, 0x5e, 0x4e, 0xa3, 0x35, 0x09, 0x34, 0x94, 0x85, 0x5e, 0x2f, 0xb0, 0x8f, 0xcd, 0x0e, 0x60,
+	0x7f, 0xc3, 0x91, 0x54, 0x84, 0x66, 0x89, 0x89, 0xe4, 0x84, 0x63, 0xa7, 0xf2, 0x9d, 0xea, 0x29,
+	0xc1, 0x2e, 0xe0, 0xc0, 0xe0, 0x4a, 0x0b, 0x6e, 0x6f, 0x86, 0x7d, 0xca, 0xdc, 0x39, 0xef, 0xc6,
+	0x8f, 0x50, 0xbb, 0xa6, 0xc8, 0x8d, 0x4d, 0x8c, 0xe6, 0xe5, 0x5b, 0x52, 0x01, 0x96, 0x55, 0x6b,
+	0xfa, 0x22, 0xe6, 0x0a, 0xb8, 0x3a, 0x7d, 0xfb, 0xfb, 0x4c, 0xd2, 0x3c, 0xbf, 0x1f, 0x08, 0xbd,
+	0xbc, 0x1c, 0x8d, 0x84, 0xba, 0x14, 0x73, 0x2e, 0xd5, 0x68, 0x74, 0xe9, 0x02, 0xbb, 0xdf, 0x73,
+	0x8f, 0xee, 0xe8, 0x73, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x0b, 0x94, 0x56, 0xa0, 0x05, 0x00,
+	0x00,
 }
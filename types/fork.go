@@ -5,6 +5,7 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -16,6 +17,9 @@ const MaxHeight = 10000000000000000
 
 var systemFork = &Forks{}
 
+//dappVersions 每个dapp注册的最大版本号，没有注册的dapp只有版本1
+var dappVersions = make(map[string]int)
+
 func init() {
 	//先要初始化
 	SetTestNetFork()
@@ -193,6 +197,16 @@ func (f *Forks) IsDappFork(title string, height int64, dapp, fork string) bool {
 	return f.IsFork(title, height, dapp+"."+fork)
 }
 
+// GetDappVersion dapp在height高度生效的版本号，从注册的最大版本往下找第一个到了fork高度的版本
+func (f *Forks) GetDappVersion(title, dapp string, height int64) int {
+	for version := GetDappMaxVersion(dapp); version > 1; version-- {
+		if f.IsDappFork(title, height, dapp, DappVersionFork(version)) {
+			return version
+		}
+	}
+	return 1
+}
+
 //SetTestNetFork bityuan test net fork
 func SetTestNetFork() {
 	systemFork.SetFork("chain33", "ForkChainParamV1", 110000)
@@ -214,6 +228,20 @@ func SetTestNetFork() {
 	systemFork.SetFork("chain33", "ForkLocalDBAccess", 1572391)
 	systemFork.SetFork("chain33", "ForkTxGroupPara", 1687250)
 	systemFork.SetFork("chain33", "ForkBase58AddressCheck", 1800000)
+	//代付手续费的交易，上线高度需要单独配置
+	systemFork.SetFork("chain33", "ForkFeeDelegation", MaxHeight)
+	//按手续费限制交易执行的gas，上线高度需要单独配置
+	systemFork.SetFork("chain33", "ForkTxGas", MaxHeight)
+	//区块中的交易和区块头必须是规范编码，上线高度需要单独配置
+	systemFork.SetFork("chain33", "ForkCanonicalEncoding", MaxHeight)
+	//交易签名中的多重签名脚本，上线高度需要单独配置
+	systemFork.SetFork("chain33", "ForkMultiSigScript", MaxHeight)
+	//交易必须带有本链的标识，上线高度需要单独配置
+	systemFork.SetFork("chain33", "ForkTxChainID", MaxHeight)
+	//密钥迁移，新私钥代表原来的地址签名，上线高度需要单独配置
+	systemFork.SetFork("chain33", "ForkKeyMigration", MaxHeight)
+	//一笔交易中包含多个执行器的操作，原子执行，上线高度需要单独配置
+	systemFork.SetFork("chain33", "ForkMultiAction", MaxHeight)
 
 }
 
@@ -259,6 +287,34 @@ func RegisterDappFork(dapp, fork string, height int64) {
 	systemFork.SetDappFork("chain33", dapp, fork, height)
 }
 
+// DappVersionFork 执行器版本对应的dapp fork名字
+func DappVersionFork(version int) string {
+	return fmt.Sprintf("Version%d", version)
+}
+
+// RegisterDappVersion 注册执行器的新版本，版本号从2开始连续注册，在dapp fork Version<n>的高度生效
+// 执行器用 Exec_<action>_V<n> 这样的方法实现新版本的逻辑，没有实现的action继续使用低版本的方法
+func RegisterDappVersion(dapp string, version int, height int64) {
+	if version < 2 || version != GetDappMaxVersion(dapp)+1 {
+		panic("dapp version must be registered in order: " + dapp + " " + DappVersionFork(version))
+	}
+	dappVersions[dapp] = version
+	RegisterDappFork(dapp, DappVersionFork(version), height)
+}
+
+// GetDappMaxVersion 获取dapp注册的最大版本号
+func GetDappMaxVersion(dapp string) int {
+	if version, ok := dappVersions[dapp]; ok {
+		return version
+	}
+	return 1
+}
+
+// GetDappVersion 获取dapp在height高度生效的版本号
+func GetDappVersion(dapp string, height int64) int {
+	return systemFork.GetDappVersion(GetTitle(), dapp, height)
+}
+
 // GetFork 获取系统fork高度
 func GetFork(fork string) int64 {
 	return systemFork.GetFork(GetTitle(), fork)
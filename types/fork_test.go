@@ -19,3 +19,17 @@ func TestForks(t *testing.T) {
 	assert.Equal(t, systemFork.IsFork("local", 1, "ForkBlockHash"), true)
 	assert.Equal(t, systemFork.IsFork("local", 1, "ForkTransferExec"), true)
 }
+
+func TestDappVersion(t *testing.T) {
+	assert.Equal(t, 1, GetDappMaxVersion("versiontest"))
+	assert.Panics(t, func() { RegisterDappVersion("versiontest", 3, 20) })
+	RegisterDappVersion("versiontest", 2, 10)
+	RegisterDappVersion("versiontest", 3, 20)
+	assert.Panics(t, func() { RegisterDappVersion("versiontest", 3, 30) })
+	assert.Equal(t, 3, GetDappMaxVersion("versiontest"))
+	assert.Equal(t, 1, systemFork.GetDappVersion("chain33", "versiontest", 9))
+	assert.Equal(t, 2, systemFork.GetDappVersion("chain33", "versiontest", 10))
+	assert.Equal(t, 2, systemFork.GetDappVersion("chain33", "versiontest", 19))
+	assert.Equal(t, 3, systemFork.GetDappVersion("chain33", "versiontest", 20))
+	assert.Equal(t, 1, systemFork.GetDappVersion("chain33", "none", 20))
+}
@@ -0,0 +1,93 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// 交易执行的计量，读写状态按次数和字节消耗gas，脚本执行器的执行步数也计入同一个计量
+const (
+	GasStateGet = 20
+	GasStateSet = 100
+	GasPerByte  = 1
+)
+
+// GasMeter 单笔交易执行的gas计量，limit为0的时候不限制
+type GasMeter struct {
+	limit    int64
+	used     int64
+	outOfGas bool
+}
+
+// NewGasMeter new a gas meter
+func NewGasMeter(limit int64) *GasMeter {
+	return &GasMeter{limit: limit}
+}
+
+// Reset 开始计量一笔新的交易
+func (m *GasMeter) Reset(limit int64) {
+	m.limit = limit
+	m.used = 0
+	m.outOfGas = false
+}
+
+// Limited 是否限制了gas，nil 的计量不限制
+func (m *GasMeter) Limited() bool {
+	return m != nil && m.limit > 0
+}
+
+// Consume 消耗gas，超过限制以后一直返回ErrOutOfGas
+func (m *GasMeter) Consume(gas int64) error {
+	if m == nil {
+		return nil
+	}
+	if m.outOfGas {
+		return ErrOutOfGas
+	}
+	if m.limit > 0 && gas > m.limit-m.used {
+		m.outOfGas = true
+		m.used = m.limit
+		return ErrOutOfGas
+	}
+	m.used += gas
+	return nil
+}
+
+// Used 已经消耗的gas
+func (m *GasMeter) Used() int64 {
+	if m == nil {
+		return 0
+	}
+	return m.used
+}
+
+// Remaining 剩余的gas，不限制的时候返回0
+func (m *GasMeter) Remaining() int64 {
+	if !m.Limited() {
+		return 0
+	}
+	return m.limit - m.used
+}
+
+// IsOutOfGas gas是否已经用完
+func (m *GasMeter) IsOutOfGas() bool {
+	return m != nil && m.outOfGas
+}
+
+// GetGasPrice 每单位gas的手续费，为0的时候不计量
+func GetGasPrice() int64 {
+	return GInt("GasPrice")
+}
+
+// TxGasLimit 交易执行可以使用的gas，由交易支付的手续费换算，0 表示不限制
+func TxGasLimit(tx *Transaction, height int64) int64 {
+	price := GetGasPrice()
+	if price <= 0 || !IsFork(height, "ForkTxGas") {
+		return 0
+	}
+	limit := tx.Fee / price
+	if limit <= 0 {
+		//手续费不够一个单位的gas，也不能不限制
+		limit = 1
+	}
+	return limit
+}
@@ -0,0 +1,50 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasMeter(t *testing.T) {
+	var nilMeter *GasMeter
+	assert.False(t, nilMeter.Limited())
+	assert.Nil(t, nilMeter.Consume(100))
+	assert.False(t, nilMeter.IsOutOfGas())
+
+	meter := NewGasMeter(0)
+	assert.False(t, meter.Limited())
+	assert.Nil(t, meter.Consume(1e9))
+	assert.Equal(t, int64(0), meter.Remaining())
+
+	meter.Reset(100)
+	assert.True(t, meter.Limited())
+	assert.Nil(t, meter.Consume(60))
+	assert.Equal(t, int64(40), meter.Remaining())
+	assert.Equal(t, ErrOutOfGas, meter.Consume(41))
+	assert.True(t, meter.IsOutOfGas())
+	assert.Equal(t, int64(100), meter.Used())
+	assert.Equal(t, ErrOutOfGas, meter.Consume(0))
+
+	meter.Reset(100)
+	assert.False(t, meter.IsOutOfGas())
+	assert.Nil(t, meter.Consume(100))
+}
+
+func TestTxGasLimit(t *testing.T) {
+	tx := &Transaction{Fee: 1e5}
+	assert.Equal(t, int64(0), TxGasLimit(tx, MaxHeight))
+	S("GasPrice", int64(10))
+	defer S("GasPrice", int64(0))
+	height := GetFork("ForkTxGas")
+	if height > 0 {
+		assert.Equal(t, int64(0), TxGasLimit(tx, height-1))
+	}
+	assert.Equal(t, int64(1e4), TxGasLimit(tx, height))
+	tx.Fee = 1
+	assert.Equal(t, int64(1), TxGasLimit(tx, height))
+}
@@ -0,0 +1,199 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/33cn/chain33/common"
+)
+
+// 交易的规范json格式，方便其他语言的工具离线构造交易并得到完全一致的签名数据
+// 规则:
+// 1. 对象的key按字节序排列，没有多余的空白，字符串不做html转义
+// 2. 所有的字段都必须出现，没有设置的消息为null，没有元素的数组为[]
+// 3. int64 用十进制的字符串表示，没有前导0，int32 直接用数字表示
+// 4. bytes 用0x开头的小写十六进制表示，空的bytes为""
+// 交易的签名数据为去掉signature和feePayer中的签名之后的protobuf编码，signature中的原地址保留，见 SignData
+type canonicalTx struct {
+	ChainID    int32          `json:"chainID"`
+	Execer     string         `json:"execer"`
+	Expire     string         `json:"expire"`
+	Fee        string         `json:"fee"`
+	FeePayer   *canonicalSign `json:"feePayer"`
+	GroupCount int32          `json:"groupCount"`
+	Header     string         `json:"header"`
+	Next       string         `json:"next"`
+	Nonce      string         `json:"nonce"`
+	Payload    string         `json:"payload"`
+	Signature  *canonicalSign `json:"signature"`
+	To         string         `json:"to"`
+}
+
+type canonicalSign struct {
+	Addr           string              `json:"addr"`
+	MultiSigScript *canonicalScript    `json:"multiSigScript"`
+	PartialSigs    []*canonicalPartial `json:"partialSigs"`
+	Pubkey         string              `json:"pubkey"`
+	Signature      string              `json:"signature"`
+	Ty             int32               `json:"ty"`
+}
+
+type canonicalScript struct {
+	PubKeys   []string `json:"pubKeys"`
+	SignTy    int32    `json:"signTy"`
+	Threshold int32    `json:"threshold"`
+}
+
+type canonicalPartial struct {
+	Index     int32  `json:"index"`
+	Signature string `json:"signature"`
+}
+
+// MarshalCanonicalJSON 交易转换为规范的json格式
+func (tx *Transaction) MarshalCanonicalJSON() ([]byte, error) {
+	ctx := &canonicalTx{
+		ChainID:    tx.ChainID,
+		Execer:     common.ToHex(tx.Execer),
+		Expire:     strconv.FormatInt(tx.Expire, 10),
+		Fee:        strconv.FormatInt(tx.Fee, 10),
+		FeePayer:   toCanonicalSign(tx.FeePayer),
+		GroupCount: tx.GroupCount,
+		Header:     common.ToHex(tx.Header),
+		Next:       common.ToHex(tx.Next),
+		Nonce:      strconv.FormatInt(tx.Nonce, 10),
+		Payload:    common.ToHex(tx.Payload),
+		Signature:  toCanonicalSign(tx.Signature),
+		To:         tx.To,
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(ctx); err != nil {
+		return nil, err
+	}
+	//Encode 会在末尾加上换行
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// UnmarshalCanonicalJSON 解析规范json格式的交易，不是规范格式的数据返回ErrNonCanonical
+func UnmarshalCanonicalJSON(data []byte) (*Transaction, error) {
+	var ctx canonicalTx
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&ctx); err != nil {
+		return nil, err
+	}
+	tx := &Transaction{To: ctx.To, GroupCount: ctx.GroupCount, ChainID: ctx.ChainID}
+	var err error
+	if tx.Execer, err = common.FromHex(ctx.Execer); err != nil {
+		return nil, err
+	}
+	if tx.Payload, err = common.FromHex(ctx.Payload); err != nil {
+		return nil, err
+	}
+	if tx.Header, err = common.FromHex(ctx.Header); err != nil {
+		return nil, err
+	}
+	if tx.Next, err = common.FromHex(ctx.Next); err != nil {
+		return nil, err
+	}
+	if tx.Fee, err = strconv.ParseInt(ctx.Fee, 10, 64); err != nil {
+		return nil, err
+	}
+	if tx.Expire, err = strconv.ParseInt(ctx.Expire, 10, 64); err != nil {
+		return nil, err
+	}
+	if tx.Nonce, err = strconv.ParseInt(ctx.Nonce, 10, 64); err != nil {
+		return nil, err
+	}
+	if tx.Signature, err = fromCanonicalSign(ctx.Signature); err != nil {
+		return nil, err
+	}
+	if tx.FeePayer, err = fromCanonicalSign(ctx.FeePayer); err != nil {
+		return nil, err
+	}
+	//重新编码必须和输入完全一致，保证同一个交易只有一种json表示
+	out, err := tx.MarshalCanonicalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(out, data) {
+		return nil, ErrNonCanonical
+	}
+	return tx, nil
+}
+
+// SignData 交易签名的原始数据，和 Sign 中签名的数据相同，包含代付账户的公钥和签名中的原地址
+func (tx *Transaction) SignData() []byte {
+	copytx := *tx
+	copytx.Signature = signAddr(tx.Signature)
+	copytx.FeePayer = feePayerKey(tx.FeePayer)
+	return Encode(&copytx)
+}
+
+func toCanonicalSign(sign *Signature) *canonicalSign {
+	if sign == nil {
+		return nil
+	}
+	cs := &canonicalSign{
+		Addr:        sign.Addr,
+		PartialSigs: make([]*canonicalPartial, 0, len(sign.PartialSigs)),
+		Pubkey:      common.ToHex(sign.Pubkey),
+		Signature:   common.ToHex(sign.Signature),
+		Ty:          sign.Ty,
+	}
+	if script := sign.MultiSigScript; script != nil {
+		cs.MultiSigScript = &canonicalScript{
+			PubKeys:   make([]string, 0, len(script.PubKeys)),
+			SignTy:    script.SignTy,
+			Threshold: script.Threshold,
+		}
+		for _, pub := range script.PubKeys {
+			cs.MultiSigScript.PubKeys = append(cs.MultiSigScript.PubKeys, common.ToHex(pub))
+		}
+	}
+	for _, partial := range sign.PartialSigs {
+		cs.PartialSigs = append(cs.PartialSigs, &canonicalPartial{Index: partial.Index, Signature: common.ToHex(partial.Signature)})
+	}
+	return cs
+}
+
+func fromCanonicalSign(cs *canonicalSign) (*Signature, error) {
+	if cs == nil {
+		return nil, nil
+	}
+	sign := &Signature{Ty: cs.Ty, Addr: cs.Addr}
+	var err error
+	if sign.Pubkey, err = common.FromHex(cs.Pubkey); err != nil {
+		return nil, err
+	}
+	if sign.Signature, err = common.FromHex(cs.Signature); err != nil {
+		return nil, err
+	}
+	if cs.MultiSigScript != nil {
+		sign.MultiSigScript = &MultiSigScript{SignTy: cs.MultiSigScript.SignTy, Threshold: cs.MultiSigScript.Threshold}
+		for _, pub := range cs.MultiSigScript.PubKeys {
+			b, err := common.FromHex(pub)
+			if err != nil {
+				return nil, err
+			}
+			sign.MultiSigScript.PubKeys = append(sign.MultiSigScript.PubKeys, b)
+		}
+	}
+	for _, partial := range cs.PartialSigs {
+		if partial == nil {
+			return nil, ErrNonCanonical
+		}
+		b, err := common.FromHex(partial.Signature)
+		if err != nil {
+			return nil, err
+		}
+		sign.PartialSigs = append(sign.PartialSigs, &MultiSigPartial{Index: partial.Index, Signature: b})
+	}
+	return sign, nil
+}
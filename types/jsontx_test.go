@@ -0,0 +1,61 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSONTx(t *testing.T) {
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("<payload>"), Fee: 100000, Nonce: -9, To: "1KgE3vayiqZKhfhMftN7vt2gDv9HoMk941"}
+	data, err := tx.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"chainID":0,"execer":"0x636f696e73","expire":"0","fee":"100000","feePayer":null,"groupCount":0,"header":"","next":"","nonce":"-9",`+
+		`"payload":"0x3c7061796c6f61643e","signature":null,"to":"1KgE3vayiqZKhfhMftN7vt2gDv9HoMk941"}`, string(data))
+	tx2, err := UnmarshalCanonicalJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, Encode(tx), Encode(tx2))
+
+	c, err := crypto.New(GetSignName("", SECP256K1))
+	require.NoError(t, err)
+	priv, err := c.GenKey()
+	require.NoError(t, err)
+	signData := tx.SignData()
+	tx.Sign(SECP256K1, priv)
+	assert.Equal(t, signData, tx.SignData())
+	data, err = tx.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	tx2, err = UnmarshalCanonicalJSON(data)
+	require.NoError(t, err)
+	assert.True(t, tx2.CheckSign())
+	script := &MultiSigScript{Threshold: 1, SignTy: SECP256K1, PubKeys: [][]byte{priv.PubKey().Bytes()}}
+	tx.FeePayer = &Signature{Ty: MultiSigSign, MultiSigScript: script, PartialSigs: []*MultiSigPartial{{Index: 0, Signature: []byte{1}}}}
+	data, err = tx.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"pubkey":"`+common.ToHex(priv.PubKey().Bytes())+`"`)
+	assert.Contains(t, string(data), `"partialSigs":[{"index":0,"signature":"0x01"}]`)
+	tx2, err = UnmarshalCanonicalJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, Encode(tx), Encode(tx2))
+
+	//非规范的格式: 多余的空白, 十六进制大写, 数字有前导0, 未知的字段, 缺少字段
+	bad := []string{
+		strings.Replace(string(data), `"fee":`, `"fee": `, 1),
+		strings.Replace(string(data), `0x636f696e73`, `0x636F696E73`, 1),
+		strings.Replace(string(data), `"fee":"100000"`, `"fee":"0100000"`, 1),
+		strings.Replace(string(data), `"to":`, `"extra":1,"to":`, 1),
+		strings.Replace(string(data), `"groupCount":0,`, ``, 1),
+	}
+	for _, b := range bad {
+		_, err = UnmarshalCanonicalJSON([]byte(b))
+		assert.NotNil(t, err, b)
+	}
+}
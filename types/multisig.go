@@ -0,0 +1,177 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+)
+
+//MaxMultiSigPubKeys 多重签名脚本中最多的公钥个数
+const MaxMultiSigPubKeys = 20
+
+//NewMultiSigScript 新建M-of-N多重签名脚本，公钥的顺序会影响地址
+func NewMultiSigScript(threshold int32, signTy int32, pubKeys [][]byte) (*MultiSigScript, error) {
+	script := &MultiSigScript{Threshold: threshold, SignTy: signTy, PubKeys: pubKeys}
+	if err := script.Check(); err != nil {
+		return nil, err
+	}
+	return script, nil
+}
+
+//Check 检查脚本的门限和公钥
+func (script *MultiSigScript) Check() error {
+	n := len(script.GetPubKeys())
+	if n == 0 || n > MaxMultiSigPubKeys {
+		return ErrMultiSigScript
+	}
+	if script.Threshold <= 0 || int(script.Threshold) > n || script.SignTy == MultiSigSign {
+		return ErrMultiSigScript
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if bytes.Equal(script.PubKeys[i], script.PubKeys[j]) {
+				return ErrMultiSigScript
+			}
+		}
+	}
+	return nil
+}
+
+//Address 多重签名脚本的地址，由脚本的编码计算，资产转到这个地址以后需要门限个签名才能转出
+func (script *MultiSigScript) Address() string {
+	return address.PubKeyToAddr(Encode(script))
+}
+
+//AddMultiSig 用脚本中index位置的私钥签名交易，签名的数据和普通签名相同
+//签名的个数达到门限以后交易的签名有效，之前的代付签名会被清除
+func (tx *Transaction) AddMultiSig(script *MultiSigScript, index int, priv crypto.PrivKey) error {
+	if err := script.Check(); err != nil {
+		return err
+	}
+	if index < 0 || index >= len(script.PubKeys) || !bytes.Equal(priv.PubKey().Bytes(), script.PubKeys[index]) {
+		return ErrMultiSigScript
+	}
+	sign := tx.GetSignature()
+	if sign.GetTy() != MultiSigSign || !bytes.Equal(Encode(sign.GetMultiSigScript()), Encode(script)) {
+		sign = &Signature{Ty: MultiSigSign, MultiSigScript: script}
+	}
+	tx.ResetFeePayer()
+	partial := &MultiSigPartial{Index: int32(index), Signature: priv.Sign(tx.SignData()).Bytes()}
+	sigs := sign.PartialSigs
+	i := sort.Search(len(sigs), func(i int) bool { return sigs[i].Index >= partial.Index })
+	if i < len(sigs) && sigs[i].Index == partial.Index {
+		sigs[i] = partial
+	} else {
+		sigs = append(sigs, nil)
+		copy(sigs[i+1:], sigs[i:])
+		sigs[i] = partial
+	}
+	sign.PartialSigs = sigs
+	tx.Signature = sign
+	return nil
+}
+
+//multiSigItems 多重签名的每个部分签名都必须有效，公钥的位置严格递增，个数不少于门限
+func multiSigItems(data []byte, execer string, sign *Signature) (string, []*crypto.BatchItem, bool) {
+	script := sign.GetMultiSigScript()
+	if script == nil || script.Check() != nil || len(sign.Pubkey) > 0 || len(sign.Signature) > 0 {
+		return "", nil, false
+	}
+	if len(sign.PartialSigs) < int(script.Threshold) {
+		return "", nil, false
+	}
+	name := GetSignName(execer, int(script.SignTy))
+	c, err := crypto.New(name)
+	if err != nil {
+		return "", nil, false
+	}
+	items := make([]*crypto.BatchItem, 0, len(sign.PartialSigs))
+	last := int32(-1)
+	for _, partial := range sign.PartialSigs {
+		if partial.GetIndex() <= last || int(partial.GetIndex()) >= len(script.PubKeys) {
+			return "", nil, false
+		}
+		last = partial.Index
+		pub, err := c.PubKeyFromBytes(script.PubKeys[partial.Index])
+		if err != nil {
+			return "", nil, false
+		}
+		sig, err := c.SignatureFromBytes(partial.Signature)
+		if err != nil {
+			return "", nil, false
+		}
+		items = append(items, &crypto.BatchItem{PubKey: pub, Msg: data, Sig: sig})
+	}
+	return name, items, true
+}
+
+func checkMultiSig(data []byte, execer string, sign *Signature) bool {
+	name, items, ok := multiSigItems(data, execer, sign)
+	if !ok {
+		return false
+	}
+	c, err := crypto.New(name)
+	if err != nil {
+		return false
+	}
+	return crypto.BatchVerify(c, items)
+}
+
+//MergeMultiSig 合并同一笔交易的部分签名，每个部分签名都必须有效，同一个位置的签名只保留一个
+//离线签名的时候每个签名者在自己的文件中签名，最后合并以后发送
+func MergeMultiSig(txs []*Transaction) (*Transaction, error) {
+	if len(txs) == 0 {
+		return nil, ErrMultiSigScript
+	}
+	data := txs[0].SignData()
+	script := txs[0].GetSignature().GetMultiSigScript()
+	if txs[0].GetSignature().GetTy() != MultiSigSign || script.Check() != nil {
+		return nil, ErrMultiSigScript
+	}
+	c, err := crypto.New(GetSignName(string(txs[0].Execer), int(script.SignTy)))
+	if err != nil {
+		return nil, err
+	}
+	sigs := make(map[int32]*MultiSigPartial)
+	for _, tx := range txs {
+		sign := tx.GetSignature()
+		if sign.GetTy() != MultiSigSign || !bytes.Equal(Encode(sign.GetMultiSigScript()), Encode(script)) {
+			return nil, ErrMultiSigScript
+		}
+		if !bytes.Equal(tx.SignData(), data) {
+			return nil, ErrSign
+		}
+		for _, partial := range sign.PartialSigs {
+			if partial.GetIndex() < 0 || int(partial.GetIndex()) >= len(script.PubKeys) {
+				return nil, ErrMultiSigScript
+			}
+			if _, ok := sigs[partial.Index]; ok {
+				continue
+			}
+			pub, err := c.PubKeyFromBytes(script.PubKeys[partial.Index])
+			if err != nil {
+				return nil, err
+			}
+			sig, err := c.SignatureFromBytes(partial.Signature)
+			if err != nil || !pub.VerifyBytes(data, sig) {
+				return nil, ErrSign
+			}
+			sigs[partial.Index] = partial
+		}
+	}
+	merged := &Signature{Ty: MultiSigSign, MultiSigScript: script}
+	for _, partial := range sigs {
+		merged.PartialSigs = append(merged.PartialSigs, partial)
+	}
+	sort.Slice(merged.PartialSigs, func(i, j int) bool { return merged.PartialSigs[i].Index < merged.PartialSigs[j].Index })
+	tx := *txs[0]
+	tx.ResetFeePayer()
+	tx.Signature = merged
+	return &tx, nil
+}
@@ -0,0 +1,104 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiSigScript(t *testing.T) {
+	privs := []crypto.PrivKey{
+		getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944"),
+		getprivkey("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01"),
+		getprivkey("B0BB75BC49A787A71F4834DA18614763B53A18291ECE6B5EDEC3AD19D150C3E7"),
+	}
+	var pubs [][]byte
+	for _, priv := range privs {
+		pubs = append(pubs, priv.PubKey().Bytes())
+	}
+	_, err := NewMultiSigScript(3, SECP256K1, pubs[:2])
+	assert.Equal(t, ErrMultiSigScript, err)
+	_, err = NewMultiSigScript(1, SECP256K1, [][]byte{pubs[0], pubs[0]})
+	assert.Equal(t, ErrMultiSigScript, err)
+	script, err := NewMultiSigScript(2, SECP256K1, pubs)
+	assert.Nil(t, err)
+
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1e6, To: "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"}
+	hash := tx.Hash()
+	assert.Equal(t, ErrMultiSigScript, tx.AddMultiSig(script, 0, privs[1]))
+	assert.Nil(t, tx.AddMultiSig(script, 2, privs[2]))
+	//签名个数没有达到门限
+	assert.False(t, tx.CheckSign())
+	assert.Nil(t, tx.AddMultiSig(script, 0, privs[0]))
+	assert.True(t, tx.CheckSign())
+	assert.True(t, CheckTxsSign([]*Transaction{tx}))
+	assert.Equal(t, hash, tx.Hash())
+	assert.Equal(t, script.Address(), tx.From())
+	assert.Equal(t, int32(0), tx.Signature.PartialSigs[0].Index)
+
+	//同一个公钥的签名不能重复计数
+	fake := *tx
+	fake.Signature = &Signature{Ty: MultiSigSign, MultiSigScript: script,
+		PartialSigs: []*MultiSigPartial{tx.Signature.PartialSigs[0], tx.Signature.PartialSigs[0]}}
+	assert.False(t, fake.CheckSign())
+	//签名的位置和公钥不对应
+	fake.Signature = &Signature{Ty: MultiSigSign, MultiSigScript: script,
+		PartialSigs: []*MultiSigPartial{{Index: 1, Signature: tx.Signature.PartialSigs[0].Signature}, tx.Signature.PartialSigs[1]}}
+	assert.False(t, fake.CheckSign())
+	//修改交易以后签名无效
+	fake = *tx
+	fake.Fee = 2e6
+	assert.False(t, fake.CheckSign())
+}
+
+func TestMergeMultiSig(t *testing.T) {
+	privs := []crypto.PrivKey{
+		getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944"),
+		getprivkey("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01"),
+		getprivkey("B0BB75BC49A787A71F4834DA18614763B53A18291ECE6B5EDEC3AD19D150C3E7"),
+	}
+	var pubs [][]byte
+	for _, priv := range privs {
+		pubs = append(pubs, priv.PubKey().Bytes())
+	}
+	script, err := NewMultiSigScript(2, SECP256K1, pubs)
+	assert.Nil(t, err)
+
+	//每个签名者在自己的交易副本上签名
+	base := &Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1e6, To: "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"}
+	var txs []*Transaction
+	for _, i := range []int{2, 0, 2} {
+		tx := *base
+		assert.Nil(t, tx.AddMultiSig(script, i, privs[i]))
+		txs = append(txs, &tx)
+	}
+	assert.False(t, txs[0].CheckSign())
+	merged, err := MergeMultiSig(txs)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(merged.Signature.PartialSigs))
+	assert.Equal(t, int32(0), merged.Signature.PartialSigs[0].Index)
+	assert.True(t, merged.CheckSign())
+	assert.Equal(t, script.Address(), merged.From())
+	//合并不修改原来的交易
+	assert.Equal(t, 1, len(txs[0].Signature.PartialSigs))
+
+	//不同的交易不能合并
+	other := *base
+	other.Fee = 2e6
+	assert.Nil(t, other.AddMultiSig(script, 1, privs[1]))
+	_, err = MergeMultiSig([]*Transaction{txs[0], &other})
+	assert.Equal(t, ErrSign, err)
+	//无效的部分签名
+	bad := *txs[1]
+	bad.Signature = &Signature{Ty: MultiSigSign, MultiSigScript: script,
+		PartialSigs: []*MultiSigPartial{{Index: 1, Signature: txs[1].Signature.PartialSigs[0].Signature}}}
+	_, err = MergeMultiSig([]*Transaction{txs[0], &bad})
+	assert.Equal(t, ErrSign, err)
+	_, err = MergeMultiSig([]*Transaction{base})
+	assert.Equal(t, ErrMultiSigScript, err)
+}
@@ -0,0 +1,53 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+)
+
+//EncryptNote 用接收方的公钥加密转账备注，使用ECIES，只有接收方可以解密
+//pubKey 是十六进制格式的secp256k1公钥
+func EncryptNote(pubKey string, note []byte) ([]byte, error) {
+	pub, err := common.FromHex(pubKey)
+	if err != nil {
+		return nil, ErrPubKeyLen
+	}
+	c, err := crypto.New(GetSignName("", SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	key, err := c.PubKeyFromBytes(pub)
+	if err != nil {
+		return nil, ErrPubKeyLen
+	}
+	return crypto.Encrypt(c, key, note)
+}
+
+//DecryptNote 用接收方的私钥解密转账备注
+func DecryptNote(priv crypto.PrivKey, data []byte) ([]byte, error) {
+	c, err := crypto.New(GetSignName("", SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Decrypt(c, priv, data)
+}
+
+//EncryptedNote 获取交易中加密的备注，只有资产转账交易可能带有加密备注
+func (tx *Transaction) EncryptedNote() []byte {
+	exec := LoadExecutorType(string(tx.Execer))
+	if exec == nil {
+		return nil
+	}
+	_, v, err := exec.DecodePayloadValue(tx)
+	if err != nil || !v.IsValid() {
+		return nil
+	}
+	if ato, ok := v.Interface().(*AssetsTransfer); ok {
+		return ato.GetEncryptedNote()
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptNote(t *testing.T) {
+	c, err := crypto.New(GetSignName("", SECP256K1))
+	require.NoError(t, err)
+	priv, err := c.GenKey()
+	require.NoError(t, err)
+	other, err := c.GenKey()
+	require.NoError(t, err)
+
+	data, err := EncryptNote(common.ToHex(priv.PubKey().Bytes()), []byte("hello"))
+	require.NoError(t, err)
+	note, err := DecryptNote(priv, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), note)
+
+	_, err = DecryptNote(other, data)
+	assert.NotNil(t, err)
+	_, err = EncryptNote("0x1234", []byte("hello"))
+	assert.Equal(t, ErrPubKeyLen, err)
+}
@@ -933,6 +933,55 @@ func (m *P2PBlock) GetBlock() *Block {
 	return nil
 }
 
+//*
+// p2p 广播共识消息，由共识插件自己解析
+type P2PConsensus struct {
+	Driver               string   `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PConsensus) Reset()         { *m = P2PConsensus{} }
+func (m *P2PConsensus) String() string { return proto.CompactTextString(m) }
+func (*P2PConsensus) ProtoMessage()    {}
+func (*P2PConsensus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{17}
+}
+
+func (m *P2PConsensus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_P2PConsensus.Unmarshal(m, b)
+}
+func (m *P2PConsensus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_P2PConsensus.Marshal(b, m, deterministic)
+}
+func (m *P2PConsensus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PConsensus.Merge(m, src)
+}
+func (m *P2PConsensus) XXX_Size() int {
+	return xxx_messageInfo_P2PConsensus.Size(m)
+}
+func (m *P2PConsensus) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PConsensus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PConsensus proto.InternalMessageInfo
+
+func (m *P2PConsensus) GetDriver() string {
+	if m != nil {
+		return m.Driver
+	}
+	return ""
+}
+
+func (m *P2PConsensus) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 //*
 // p2p 协议和软件版本
 type Versions struct {
@@ -948,7 +997,7 @@ func (m *Versions) Reset()         { *m = Versions{} }
 func (m *Versions) String() string { return proto.CompactTextString(m) }
 func (*Versions) ProtoMessage()    {}
 func (*Versions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{17}
+	return fileDescriptor_e7fdddb109e6467a, []int{18}
 }
 
 func (m *Versions) XXX_Unmarshal(b []byte) error {
@@ -998,6 +1047,7 @@ type BroadCastData struct {
 	//	*BroadCastData_Block
 	//	*BroadCastData_Ping
 	//	*BroadCastData_Version
+	//	*BroadCastData_Consensus
 	Value                isBroadCastData_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -1008,7 +1058,7 @@ func (m *BroadCastData) Reset()         { *m = BroadCastData{} }
 func (m *BroadCastData) String() string { return proto.CompactTextString(m) }
 func (*BroadCastData) ProtoMessage()    {}
 func (*BroadCastData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{18}
+	return fileDescriptor_e7fdddb109e6467a, []int{19}
 }
 
 func (m *BroadCastData) XXX_Unmarshal(b []byte) error {
@@ -1049,6 +1099,10 @@ type BroadCastData_Version struct {
 	Version *Versions `protobuf:"bytes,4,opt,name=version,proto3,oneof"`
 }
 
+type BroadCastData_Consensus struct {
+	Consensus *P2PConsensus `protobuf:"bytes,5,opt,name=consensus,proto3,oneof"`
+}
+
 func (*BroadCastData_Tx) isBroadCastData_Value() {}
 
 func (*BroadCastData_Block) isBroadCastData_Value() {}
@@ -1057,6 +1111,8 @@ func (*BroadCastData_Ping) isBroadCastData_Value() {}
 
 func (*BroadCastData_Version) isBroadCastData_Value() {}
 
+func (*BroadCastData_Consensus) isBroadCastData_Value() {}
+
 func (m *BroadCastData) GetValue() isBroadCastData_Value {
 	if m != nil {
 		return m.Value
@@ -1092,6 +1148,13 @@ func (m *BroadCastData) GetVersion() *Versions {
 	return nil
 }
 
+func (m *BroadCastData) GetConsensus() *P2PConsensus {
+	if x, ok := m.GetValue().(*BroadCastData_Consensus); ok {
+		return x.Consensus
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*BroadCastData) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _BroadCastData_OneofMarshaler, _BroadCastData_OneofUnmarshaler, _BroadCastData_OneofSizer, []interface{}{
@@ -1099,6 +1162,7 @@ func (*BroadCastData) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer)
 		(*BroadCastData_Block)(nil),
 		(*BroadCastData_Ping)(nil),
 		(*BroadCastData_Version)(nil),
+		(*BroadCastData_Consensus)(nil),
 	}
 }
 
@@ -1126,6 +1190,11 @@ func _BroadCastData_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Version); err != nil {
 			return err
 		}
+	case *BroadCastData_Consensus:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Consensus); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("BroadCastData.Value has unexpected type %T", x)
@@ -1168,6 +1237,14 @@ func _BroadCastData_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.
 		err := b.DecodeMessage(msg)
 		m.Value = &BroadCastData_Version{msg}
 		return true, err
+	case 5: // value.consensus
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(P2PConsensus)
+		err := b.DecodeMessage(msg)
+		m.Value = &BroadCastData_Consensus{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1197,6 +1274,11 @@ func _BroadCastData_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *BroadCastData_Consensus:
+		s := proto.Size(x.Consensus)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1219,7 +1301,7 @@ func (m *P2PGetHeaders) Reset()         { *m = P2PGetHeaders{} }
 func (m *P2PGetHeaders) String() string { return proto.CompactTextString(m) }
 func (*P2PGetHeaders) ProtoMessage()    {}
 func (*P2PGetHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{19}
+	return fileDescriptor_e7fdddb109e6467a, []int{20}
 }
 
 func (m *P2PGetHeaders) XXX_Unmarshal(b []byte) error {
@@ -1274,7 +1356,7 @@ func (m *P2PHeaders) Reset()         { *m = P2PHeaders{} }
 func (m *P2PHeaders) String() string { return proto.CompactTextString(m) }
 func (*P2PHeaders) ProtoMessage()    {}
 func (*P2PHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{20}
+	return fileDescriptor_e7fdddb109e6467a, []int{21}
 }
 
 func (m *P2PHeaders) XXX_Unmarshal(b []byte) error {
@@ -1319,7 +1401,7 @@ func (m *InvData) Reset()         { *m = InvData{} }
 func (m *InvData) String() string { return proto.CompactTextString(m) }
 func (*InvData) ProtoMessage()    {}
 func (*InvData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{21}
+	return fileDescriptor_e7fdddb109e6467a, []int{22}
 }
 
 func (m *InvData) XXX_Unmarshal(b []byte) error {
@@ -1471,7 +1553,7 @@ func (m *InvDatas) Reset()         { *m = InvDatas{} }
 func (m *InvDatas) String() string { return proto.CompactTextString(m) }
 func (*InvDatas) ProtoMessage()    {}
 func (*InvDatas) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{22}
+	return fileDescriptor_e7fdddb109e6467a, []int{23}
 }
 
 func (m *InvDatas) XXX_Unmarshal(b []byte) error {
@@ -1517,7 +1599,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{23}
+	return fileDescriptor_e7fdddb109e6467a, []int{24}
 }
 
 func (m *Peer) XXX_Unmarshal(b []byte) error {
@@ -1593,7 +1675,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{24}
+	return fileDescriptor_e7fdddb109e6467a, []int{25}
 }
 
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
@@ -1638,7 +1720,7 @@ func (m *NodeNetInfo) Reset()         { *m = NodeNetInfo{} }
 func (m *NodeNetInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeNetInfo) ProtoMessage()    {}
 func (*NodeNetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{25}
+	return fileDescriptor_e7fdddb109e6467a, []int{26}
 }
 
 func (m *NodeNetInfo) XXX_Unmarshal(b []byte) error {
@@ -1705,7 +1787,7 @@ func (m *PeersReply) Reset()         { *m = PeersReply{} }
 func (m *PeersReply) String() string { return proto.CompactTextString(m) }
 func (*PeersReply) ProtoMessage()    {}
 func (*PeersReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{26}
+	return fileDescriptor_e7fdddb109e6467a, []int{27}
 }
 
 func (m *PeersReply) XXX_Unmarshal(b []byte) error {
@@ -1748,7 +1830,7 @@ func (m *PeersInfo) Reset()         { *m = PeersInfo{} }
 func (m *PeersInfo) String() string { return proto.CompactTextString(m) }
 func (*PeersInfo) ProtoMessage()    {}
 func (*PeersInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fdddb109e6467a, []int{27}
+	return fileDescriptor_e7fdddb109e6467a, []int{28}
 }
 
 func (m *PeersInfo) XXX_Unmarshal(b []byte) error {
@@ -1804,6 +1886,651 @@ func (m *PeersInfo) GetP2Pversion() int32 {
 	return 0
 }
 
+//*
+// 节点管理中连接的节点信息
+type NetPeer struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Inbound              bool     `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	Version              int32    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Softversion          string   `protobuf:"bytes,5,opt,name=softversion,proto3" json:"softversion,omitempty"`
+	Latency              int64    `protobuf:"varint,6,opt,name=latency,proto3" json:"latency,omitempty"`
+	Height               int64    `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	MempoolSize          int32    `protobuf:"varint,8,opt,name=mempoolSize,proto3" json:"mempoolSize,omitempty"`
+	Persistent           bool     `protobuf:"varint,9,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetPeer) Reset()         { *m = NetPeer{} }
+func (m *NetPeer) String() string { return proto.CompactTextString(m) }
+func (*NetPeer) ProtoMessage()    {}
+func (*NetPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{29}
+}
+
+func (m *NetPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetPeer.Unmarshal(m, b)
+}
+func (m *NetPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetPeer.Marshal(b, m, deterministic)
+}
+func (m *NetPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetPeer.Merge(m, src)
+}
+func (m *NetPeer) XXX_Size() int {
+	return xxx_messageInfo_NetPeer.Size(m)
+}
+func (m *NetPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetPeer proto.InternalMessageInfo
+
+func (m *NetPeer) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *NetPeer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NetPeer) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
+}
+
+func (m *NetPeer) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *NetPeer) GetSoftversion() string {
+	if m != nil {
+		return m.Softversion
+	}
+	return ""
+}
+
+func (m *NetPeer) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *NetPeer) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *NetPeer) GetMempoolSize() int32 {
+	if m != nil {
+		return m.MempoolSize
+	}
+	return 0
+}
+
+func (m *NetPeer) GetPersistent() bool {
+	if m != nil {
+		return m.Persistent
+	}
+	return false
+}
+
+type NetPeers struct {
+	Peers                []*NetPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *NetPeers) Reset()         { *m = NetPeers{} }
+func (m *NetPeers) String() string { return proto.CompactTextString(m) }
+func (*NetPeers) ProtoMessage()    {}
+func (*NetPeers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{30}
+}
+
+func (m *NetPeers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetPeers.Unmarshal(m, b)
+}
+func (m *NetPeers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetPeers.Marshal(b, m, deterministic)
+}
+func (m *NetPeers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetPeers.Merge(m, src)
+}
+func (m *NetPeers) XXX_Size() int {
+	return xxx_messageInfo_NetPeers.Size(m)
+}
+func (m *NetPeers) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetPeers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetPeers proto.InternalMessageInfo
+
+func (m *NetPeers) GetPeers() []*NetPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+//*
+// 节点管理的请求
+//   banTime : 加入黑名单的秒数，0表示永久
+type ReqNetPeer struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	BanTime              int64    `protobuf:"varint,2,opt,name=banTime,proto3" json:"banTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqNetPeer) Reset()         { *m = ReqNetPeer{} }
+func (m *ReqNetPeer) String() string { return proto.CompactTextString(m) }
+func (*ReqNetPeer) ProtoMessage()    {}
+func (*ReqNetPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{31}
+}
+
+func (m *ReqNetPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqNetPeer.Unmarshal(m, b)
+}
+func (m *ReqNetPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqNetPeer.Marshal(b, m, deterministic)
+}
+func (m *ReqNetPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqNetPeer.Merge(m, src)
+}
+func (m *ReqNetPeer) XXX_Size() int {
+	return xxx_messageInfo_ReqNetPeer.Size(m)
+}
+func (m *ReqNetPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqNetPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqNetPeer proto.InternalMessageInfo
+
+func (m *ReqNetPeer) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqNetPeer) GetBanTime() int64 {
+	if m != nil {
+		return m.BanTime
+	}
+	return 0
+}
+
+type NetBannedPeer struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Deadline             int64    `protobuf:"varint,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetBannedPeer) Reset()         { *m = NetBannedPeer{} }
+func (m *NetBannedPeer) String() string { return proto.CompactTextString(m) }
+func (*NetBannedPeer) ProtoMessage()    {}
+func (*NetBannedPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{32}
+}
+
+func (m *NetBannedPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetBannedPeer.Unmarshal(m, b)
+}
+func (m *NetBannedPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetBannedPeer.Marshal(b, m, deterministic)
+}
+func (m *NetBannedPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetBannedPeer.Merge(m, src)
+}
+func (m *NetBannedPeer) XXX_Size() int {
+	return xxx_messageInfo_NetBannedPeer.Size(m)
+}
+func (m *NetBannedPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetBannedPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetBannedPeer proto.InternalMessageInfo
+
+func (m *NetBannedPeer) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *NetBannedPeer) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+type NetBannedPeers struct {
+	Peers                []*NetBannedPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NetBannedPeers) Reset()         { *m = NetBannedPeers{} }
+func (m *NetBannedPeers) String() string { return proto.CompactTextString(m) }
+func (*NetBannedPeers) ProtoMessage()    {}
+func (*NetBannedPeers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{33}
+}
+
+func (m *NetBannedPeers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetBannedPeers.Unmarshal(m, b)
+}
+func (m *NetBannedPeers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetBannedPeers.Marshal(b, m, deterministic)
+}
+func (m *NetBannedPeers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetBannedPeers.Merge(m, src)
+}
+func (m *NetBannedPeers) XXX_Size() int {
+	return xxx_messageInfo_NetBannedPeers.Size(m)
+}
+func (m *NetBannedPeers) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetBannedPeers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetBannedPeers proto.InternalMessageInfo
+
+func (m *NetBannedPeers) GetPeers() []*NetBannedPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+//*
+// 本节点的节点ID和地址
+type NetSelfInfo struct {
+	NodeID               string   `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Localaddr            string   `protobuf:"bytes,2,opt,name=localaddr,proto3" json:"localaddr,omitempty"`
+	Externaladdr         string   `protobuf:"bytes,3,opt,name=externaladdr,proto3" json:"externaladdr,omitempty"`
+	Service              bool     `protobuf:"varint,4,opt,name=service,proto3" json:"service,omitempty"`
+	Version              int32    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Softversion          string   `protobuf:"bytes,6,opt,name=softversion,proto3" json:"softversion,omitempty"`
+	Seeds                []string `protobuf:"bytes,7,rep,name=seeds,proto3" json:"seeds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetSelfInfo) Reset()         { *m = NetSelfInfo{} }
+func (m *NetSelfInfo) String() string { return proto.CompactTextString(m) }
+func (*NetSelfInfo) ProtoMessage()    {}
+func (*NetSelfInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{34}
+}
+
+func (m *NetSelfInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetSelfInfo.Unmarshal(m, b)
+}
+func (m *NetSelfInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetSelfInfo.Marshal(b, m, deterministic)
+}
+func (m *NetSelfInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetSelfInfo.Merge(m, src)
+}
+func (m *NetSelfInfo) XXX_Size() int {
+	return xxx_messageInfo_NetSelfInfo.Size(m)
+}
+func (m *NetSelfInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetSelfInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetSelfInfo proto.InternalMessageInfo
+
+func (m *NetSelfInfo) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *NetSelfInfo) GetLocaladdr() string {
+	if m != nil {
+		return m.Localaddr
+	}
+	return ""
+}
+
+func (m *NetSelfInfo) GetExternaladdr() string {
+	if m != nil {
+		return m.Externaladdr
+	}
+	return ""
+}
+
+func (m *NetSelfInfo) GetService() bool {
+	if m != nil {
+		return m.Service
+	}
+	return false
+}
+
+func (m *NetSelfInfo) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *NetSelfInfo) GetSoftversion() string {
+	if m != nil {
+		return m.Softversion
+	}
+	return ""
+}
+
+func (m *NetSelfInfo) GetSeeds() []string {
+	if m != nil {
+		return m.Seeds
+	}
+	return nil
+}
+
+//*
+// 地址簿中的地址和连接的统计
+//   lastAttempt, lastSuccess : unix时间，0表示没有
+type AddrBookEntry struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Attempts             int64    `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastAttempt          int64    `protobuf:"varint,3,opt,name=lastAttempt,proto3" json:"lastAttempt,omitempty"`
+	LastSuccess          int64    `protobuf:"varint,4,opt,name=lastSuccess,proto3" json:"lastSuccess,omitempty"`
+	Connected            bool     `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+	Banned               bool     `protobuf:"varint,6,opt,name=banned,proto3" json:"banned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddrBookEntry) Reset()         { *m = AddrBookEntry{} }
+func (m *AddrBookEntry) String() string { return proto.CompactTextString(m) }
+func (*AddrBookEntry) ProtoMessage()    {}
+func (*AddrBookEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{35}
+}
+
+func (m *AddrBookEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddrBookEntry.Unmarshal(m, b)
+}
+func (m *AddrBookEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddrBookEntry.Marshal(b, m, deterministic)
+}
+func (m *AddrBookEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddrBookEntry.Merge(m, src)
+}
+func (m *AddrBookEntry) XXX_Size() int {
+	return xxx_messageInfo_AddrBookEntry.Size(m)
+}
+func (m *AddrBookEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddrBookEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddrBookEntry proto.InternalMessageInfo
+
+func (m *AddrBookEntry) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *AddrBookEntry) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *AddrBookEntry) GetLastAttempt() int64 {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return 0
+}
+
+func (m *AddrBookEntry) GetLastSuccess() int64 {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return 0
+}
+
+func (m *AddrBookEntry) GetConnected() bool {
+	if m != nil {
+		return m.Connected
+	}
+	return false
+}
+
+func (m *AddrBookEntry) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+type AddrBookEntries struct {
+	Entries              []*AddrBookEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AddrBookEntries) Reset()         { *m = AddrBookEntries{} }
+func (m *AddrBookEntries) String() string { return proto.CompactTextString(m) }
+func (*AddrBookEntries) ProtoMessage()    {}
+func (*AddrBookEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{36}
+}
+
+func (m *AddrBookEntries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddrBookEntries.Unmarshal(m, b)
+}
+func (m *AddrBookEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddrBookEntries.Marshal(b, m, deterministic)
+}
+func (m *AddrBookEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddrBookEntries.Merge(m, src)
+}
+func (m *AddrBookEntries) XXX_Size() int {
+	return xxx_messageInfo_AddrBookEntries.Size(m)
+}
+func (m *AddrBookEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddrBookEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddrBookEntries proto.InternalMessageInfo
+
+func (m *AddrBookEntries) GetEntries() []*AddrBookEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+//*
+// 删除地址簿中的地址，addrs和条件满足一个就删除，按条件删除的时候不删除已连接的地址
+//   minAttempts : 连续失败次数不小于minAttempts
+//   staleTime : 超过staleTime秒没有连接成功
+type ReqAddrBookRemove struct {
+	Addrs                []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	MinAttempts          int64    `protobuf:"varint,2,opt,name=minAttempts,proto3" json:"minAttempts,omitempty"`
+	StaleTime            int64    `protobuf:"varint,3,opt,name=staleTime,proto3" json:"staleTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqAddrBookRemove) Reset()         { *m = ReqAddrBookRemove{} }
+func (m *ReqAddrBookRemove) String() string { return proto.CompactTextString(m) }
+func (*ReqAddrBookRemove) ProtoMessage()    {}
+func (*ReqAddrBookRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{37}
+}
+
+func (m *ReqAddrBookRemove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqAddrBookRemove.Unmarshal(m, b)
+}
+func (m *ReqAddrBookRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqAddrBookRemove.Marshal(b, m, deterministic)
+}
+func (m *ReqAddrBookRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAddrBookRemove.Merge(m, src)
+}
+func (m *ReqAddrBookRemove) XXX_Size() int {
+	return xxx_messageInfo_ReqAddrBookRemove.Size(m)
+}
+func (m *ReqAddrBookRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqAddrBookRemove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqAddrBookRemove proto.InternalMessageInfo
+
+func (m *ReqAddrBookRemove) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *ReqAddrBookRemove) GetMinAttempts() int64 {
+	if m != nil {
+		return m.MinAttempts
+	}
+	return 0
+}
+
+func (m *ReqAddrBookRemove) GetStaleTime() int64 {
+	if m != nil {
+		return m.StaleTime
+	}
+	return 0
+}
+
+//*
+// 向地址簿中添加地址，dial为true的时候立即连接
+type ReqAddrBookSeed struct {
+	Addrs                []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	Dial                 bool     `protobuf:"varint,2,opt,name=dial,proto3" json:"dial,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqAddrBookSeed) Reset()         { *m = ReqAddrBookSeed{} }
+func (m *ReqAddrBookSeed) String() string { return proto.CompactTextString(m) }
+func (*ReqAddrBookSeed) ProtoMessage()    {}
+func (*ReqAddrBookSeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{38}
+}
+
+func (m *ReqAddrBookSeed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqAddrBookSeed.Unmarshal(m, b)
+}
+func (m *ReqAddrBookSeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqAddrBookSeed.Marshal(b, m, deterministic)
+}
+func (m *ReqAddrBookSeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAddrBookSeed.Merge(m, src)
+}
+func (m *ReqAddrBookSeed) XXX_Size() int {
+	return xxx_messageInfo_ReqAddrBookSeed.Size(m)
+}
+func (m *ReqAddrBookSeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqAddrBookSeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqAddrBookSeed proto.InternalMessageInfo
+
+func (m *ReqAddrBookSeed) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *ReqAddrBookSeed) GetDial() bool {
+	if m != nil {
+		return m.Dial
+	}
+	return false
+}
+
+type ReplyAddrBookEdit struct {
+	Done                 []string `protobuf:"bytes,1,rep,name=done,proto3" json:"done,omitempty"`
+	Skipped              []string `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyAddrBookEdit) Reset()         { *m = ReplyAddrBookEdit{} }
+func (m *ReplyAddrBookEdit) String() string { return proto.CompactTextString(m) }
+func (*ReplyAddrBookEdit) ProtoMessage()    {}
+func (*ReplyAddrBookEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{39}
+}
+
+func (m *ReplyAddrBookEdit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyAddrBookEdit.Unmarshal(m, b)
+}
+func (m *ReplyAddrBookEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyAddrBookEdit.Marshal(b, m, deterministic)
+}
+func (m *ReplyAddrBookEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyAddrBookEdit.Merge(m, src)
+}
+func (m *ReplyAddrBookEdit) XXX_Size() int {
+	return xxx_messageInfo_ReplyAddrBookEdit.Size(m)
+}
+func (m *ReplyAddrBookEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyAddrBookEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyAddrBookEdit proto.InternalMessageInfo
+
+func (m *ReplyAddrBookEdit) GetDone() []string {
+	if m != nil {
+		return m.Done
+	}
+	return nil
+}
+
+func (m *ReplyAddrBookEdit) GetSkipped() []string {
+	if m != nil {
+		return m.Skipped
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*P2PGetPeerInfo)(nil), "types.P2PGetPeerInfo")
 	proto.RegisterType((*P2PPeerInfo)(nil), "types.P2PPeerInfo")
@@ -1822,6 +2549,7 @@ func init() {
 	proto.RegisterType((*P2PGetData)(nil), "types.P2PGetData")
 	proto.RegisterType((*P2PTx)(nil), "types.P2PTx")
 	proto.RegisterType((*P2PBlock)(nil), "types.P2PBlock")
+	proto.RegisterType((*P2PConsensus)(nil), "types.P2PConsensus")
 	proto.RegisterType((*Versions)(nil), "types.Versions")
 	proto.RegisterType((*BroadCastData)(nil), "types.BroadCastData")
 	proto.RegisterType((*P2PGetHeaders)(nil), "types.P2PGetHeaders")
@@ -1833,93 +2561,131 @@ func init() {
 	proto.RegisterType((*NodeNetInfo)(nil), "types.NodeNetInfo")
 	proto.RegisterType((*PeersReply)(nil), "types.PeersReply")
 	proto.RegisterType((*PeersInfo)(nil), "types.PeersInfo")
+	proto.RegisterType((*NetPeer)(nil), "types.NetPeer")
+	proto.RegisterType((*NetPeers)(nil), "types.NetPeers")
+	proto.RegisterType((*ReqNetPeer)(nil), "types.ReqNetPeer")
+	proto.RegisterType((*NetBannedPeer)(nil), "types.NetBannedPeer")
+	proto.RegisterType((*NetBannedPeers)(nil), "types.NetBannedPeers")
+	proto.RegisterType((*NetSelfInfo)(nil), "types.NetSelfInfo")
+	proto.RegisterType((*AddrBookEntry)(nil), "types.AddrBookEntry")
+	proto.RegisterType((*AddrBookEntries)(nil), "types.AddrBookEntries")
+	proto.RegisterType((*ReqAddrBookRemove)(nil), "types.ReqAddrBookRemove")
+	proto.RegisterType((*ReqAddrBookSeed)(nil), "types.ReqAddrBookSeed")
+	proto.RegisterType((*ReplyAddrBookEdit)(nil), "types.ReplyAddrBookEdit")
 }
 
 func init() { proto.RegisterFile("p2p.proto", fileDescriptor_e7fdddb109e6467a) }
 
 var fileDescriptor_e7fdddb109e6467a = []byte{
-	// 1294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x72, 0x1b, 0x45,
-	0x13, 0xdf, 0xd5, 0x1f, 0x4b, 0x6a, 0xd9, 0xb2, 0x33, 0x5f, 0xbe, 0xaf, 0x54, 0xaa, 0x7c, 0x49,
-	0x98, 0x0a, 0xc4, 0x90, 0x8a, 0x92, 0xac, 0x20, 0x54, 0x11, 0x2e, 0x76, 0x80, 0xd8, 0x55, 0x21,
-	0xb5, 0xb5, 0x32, 0x1c, 0xb8, 0xad, 0x57, 0x63, 0x69, 0x2a, 0xd2, 0xcc, 0xb2, 0x3b, 0x52, 0xd9,
-	0xdc, 0xb9, 0x71, 0xe2, 0x05, 0x38, 0xf0, 0x0a, 0x3c, 0x16, 0x0f, 0x41, 0x4d, 0xef, 0xcc, 0xfe,
-	0x91, 0x64, 0x1d, 0xa0, 0xb8, 0x6d, 0xff, 0xba, 0x7b, 0xfa, 0x7f, 0xb7, 0x04, 0x9d, 0xd8, 0x8b,
-	0x87, 0x71, 0x22, 0x95, 0x24, 0x4d, 0x75, 0x13, 0xb3, 0x74, 0x70, 0x47, 0x25, 0xa1, 0x48, 0xc3,
-	0x48, 0x71, 0x29, 0x32, 0xce, 0x60, 0x3f, 0x92, 0x8b, 0x45, 0x4e, 0x1d, 0x5d, 0xce, 0x65, 0xf4,
-	0x3e, 0x9a, 0x85, 0xdc, 0x20, 0xf4, 0x13, 0xe8, 0xf9, 0x9e, 0xff, 0x86, 0x29, 0x9f, 0xb1, 0xe4,
-	0x5c, 0x5c, 0x49, 0xd2, 0x87, 0xd6, 0x8a, 0x25, 0x29, 0x97, 0xa2, 0xef, 0x3e, 0x74, 0x8f, 0x9b,
-	0x81, 0x25, 0xe9, 0xaf, 0x2e, 0x74, 0x7d, 0xcf, 0xcf, 0x25, 0x09, 0x34, 0xc2, 0xc9, 0x24, 0x41,
-	0xb1, 0x4e, 0x80, 0xdf, 0x1a, 0x8b, 0x65, 0xa2, 0xfa, 0x35, 0x54, 0xc5, 0x6f, 0x8d, 0x89, 0x70,
-	0xc1, 0xfa, 0xf5, 0x4c, 0x4e, 0x7f, 0x93, 0x87, 0xd0, 0x5d, 0xb0, 0x45, 0x2c, 0xe5, 0x7c, 0xcc,
-	0x7f, 0x62, 0xfd, 0x06, 0x8a, 0x97, 0x21, 0xf2, 0x21, 0xec, 0xcd, 0x58, 0x38, 0x61, 0x49, 0xbf,
-	0xf9, 0xd0, 0x3d, 0xee, 0x7a, 0x07, 0x43, 0x0c, 0x72, 0x78, 0x86, 0x60, 0x60, 0x98, 0xf4, 0x4f,
-	0x17, 0xc0, 0xf7, 0xfc, 0xef, 0x33, 0x1f, 0x6f, 0xf7, 0x5e, 0x73, 0x52, 0x96, 0xac, 0x78, 0xc4,
-	0xd0, 0xb9, 0x7a, 0x60, 0x49, 0x72, 0x0f, 0x3a, 0x8a, 0x2f, 0x58, 0xaa, 0xc2, 0x45, 0x8c, 0x4e,
-	0xd6, 0x83, 0x02, 0x20, 0x03, 0x68, 0xeb, 0xc8, 0x02, 0x16, 0xad, 0xd0, 0xcd, 0x4e, 0x90, 0xd3,
-	0x96, 0xf7, 0x4d, 0x22, 0x17, 0xe8, 0xa5, 0xe1, 0x69, 0x9a, 0xdc, 0x85, 0xa6, 0x90, 0x22, 0x62,
-	0xfd, 0x3d, 0x7c, 0x31, 0x23, 0xb4, 0xad, 0x65, 0xca, 0x92, 0x93, 0x29, 0x13, 0xaa, 0xdf, 0x42,
-	0x95, 0x02, 0xd0, 0x59, 0x49, 0x55, 0x98, 0xa8, 0x33, 0xc6, 0xa7, 0x33, 0xd5, 0x6f, 0xa3, 0x66,
-	0x19, 0xa2, 0xdf, 0x41, 0x27, 0x8b, 0xf6, 0x24, 0x7a, 0xff, 0xb7, 0x82, 0xcd, 0xdd, 0xaa, 0x97,
-	0xdc, 0xa2, 0x0b, 0x68, 0xe9, 0xca, 0x72, 0x31, 0x2d, 0x04, 0xdc, 0xb2, 0xdf, 0xb6, 0xd6, 0xb5,
-	0x2d, 0xb5, 0xae, 0x97, 0x6a, 0xfd, 0x08, 0x1a, 0x29, 0x9f, 0x0a, 0xcc, 0x54, 0xd7, 0x3b, 0x32,
-	0x35, 0x1b, 0xf3, 0xa9, 0x08, 0xd5, 0x32, 0x61, 0x01, 0x72, 0xe9, 0x83, 0xcc, 0x9c, 0xbc, 0xcd,
-	0x1c, 0xa5, 0x58, 0xd4, 0x37, 0x4c, 0x9d, 0x68, 0x43, 0xdb, 0x65, 0x5e, 0xe1, 0x23, 0xb7, 0x0b,
-	0xd8, 0xea, 0xcc, 0x79, 0xaa, 0xfb, 0xb1, 0x6e, 0xab, 0xa3, 0x69, 0x3a, 0xc6, 0x56, 0xd6, 0xca,
-	0x6f, 0x79, 0xaa, 0x6e, 0x79, 0x60, 0x08, 0xed, 0x98, 0xb1, 0x84, 0x8b, 0x2b, 0x89, 0x0f, 0x74,
-	0x3d, 0x62, 0x02, 0x2a, 0x8d, 0x41, 0x90, 0xcb, 0xd0, 0xd7, 0x70, 0xe8, 0x7b, 0xfe, 0xd7, 0xd7,
-	0x8a, 0x25, 0x22, 0x9c, 0xdf, 0x3a, 0x23, 0xf7, 0xa0, 0xc3, 0x53, 0xb9, 0x54, 0x29, 0x9f, 0x64,
-	0xe5, 0x69, 0x07, 0x05, 0x40, 0x67, 0xb0, 0x9f, 0x85, 0x7e, 0xaa, 0x67, 0x35, 0xdd, 0x51, 0xe4,
-	0xb5, 0x6e, 0xa9, 0x6d, 0x74, 0x8b, 0xb6, 0xc4, 0xc4, 0xc4, 0xf0, 0x4d, 0x67, 0xe7, 0x00, 0xfd,
-	0x18, 0x0e, 0x32, 0x4b, 0xdf, 0x66, 0x63, 0xb7, 0x63, 0xf4, 0x87, 0xb0, 0xe7, 0x7b, 0xfe, 0xb9,
-	0x58, 0xe9, 0x02, 0x73, 0xb1, 0x4a, 0xfb, 0x2e, 0xe6, 0xc3, 0x16, 0xf8, 0x5c, 0xac, 0x98, 0x50,
-	0x32, 0xb9, 0x09, 0x90, 0x4b, 0xdf, 0x40, 0x27, 0x87, 0x48, 0x0f, 0x6a, 0xea, 0xc6, 0xbc, 0x58,
-	0x53, 0x37, 0x3a, 0x27, 0xb3, 0x30, 0x9d, 0xa1, 0xc3, 0xfb, 0x01, 0x7e, 0x93, 0xff, 0xe9, 0x69,
-	0x2f, 0xb9, 0x69, 0x28, 0xfa, 0xd6, 0x36, 0xc2, 0x57, 0xa1, 0x0a, 0x77, 0xe4, 0xc2, 0xba, 0x55,
-	0xdb, 0xe9, 0xd6, 0x13, 0x68, 0xfa, 0x9e, 0x7f, 0x71, 0x4d, 0x28, 0xd4, 0xd4, 0x35, 0xbe, 0x51,
-	0xd4, 0xf4, 0xa2, 0x58, 0x9e, 0x41, 0x4d, 0x5d, 0xd3, 0x21, 0xb4, 0x7d, 0xcf, 0xc7, 0x2a, 0x10,
-	0x0a, 0x4d, 0x5c, 0x9d, 0x46, 0x65, 0xdf, 0xa8, 0x20, 0x33, 0xc8, 0x58, 0x74, 0x06, 0x6d, 0xb3,
-	0x85, 0x52, 0x72, 0x1f, 0x20, 0xf6, 0xe2, 0xaa, 0xaf, 0x25, 0x04, 0x4b, 0x27, 0xaf, 0x94, 0x15,
-	0xc8, 0xa6, 0xaa, 0x0c, 0xe9, 0xe6, 0xd5, 0x7d, 0x55, 0x5a, 0x9c, 0x39, 0x4d, 0xff, 0x70, 0xe1,
-	0xe0, 0x34, 0x91, 0xe1, 0xe4, 0x75, 0x98, 0x66, 0x89, 0xb9, 0x5f, 0x8a, 0x67, 0xbf, 0xe8, 0xd1,
-	0x8b, 0xeb, 0x33, 0x47, 0xc7, 0x42, 0x1e, 0x5b, 0xff, 0x6b, 0x28, 0x72, 0x58, 0x88, 0x60, 0x08,
-	0x67, 0x8e, 0x09, 0x42, 0xe7, 0x31, 0xe6, 0x62, 0x8a, 0x26, 0xbb, 0x5e, 0xaf, 0xd4, 0xee, 0x5c,
-	0x4c, 0xcf, 0x9c, 0x00, 0xb9, 0xe4, 0x49, 0x51, 0x87, 0x46, 0xe5, 0x41, 0x9b, 0x80, 0x33, 0x27,
-	0x2f, 0xcd, 0x69, 0x0b, 0x9a, 0xab, 0x70, 0xbe, 0x64, 0x94, 0xdb, 0x7e, 0xcb, 0x56, 0xf8, 0xbf,
-	0xd9, 0xda, 0x9f, 0x61, 0xdb, 0x58, 0x3b, 0x8f, 0xa1, 0x95, 0x5d, 0x0b, 0xdb, 0xb6, 0x6b, 0xb7,
-	0xc4, 0x72, 0xa9, 0x80, 0xd6, 0xb9, 0x58, 0x61, 0x46, 0x1f, 0xed, 0xee, 0x10, 0x93, 0xd7, 0x47,
-	0xd5, 0xbc, 0x56, 0xfa, 0xa2, 0x48, 0x6a, 0x36, 0x00, 0x75, 0x3b, 0x00, 0x45, 0x46, 0x9e, 0x43,
-	0xdb, 0xd8, 0x4b, 0xf5, 0x53, 0x5c, 0xb1, 0x85, 0x75, 0xb1, 0x57, 0xb4, 0xb0, 0xe6, 0x07, 0x19,
-	0x93, 0xfe, 0xe6, 0x42, 0x43, 0x6f, 0x9e, 0x7f, 0x74, 0x7c, 0x09, 0x34, 0x52, 0x36, 0xbf, 0xc2,
-	0xda, 0xb5, 0x03, 0xfc, 0x5e, 0x3f, 0xc8, 0xcd, 0x5d, 0x07, 0x79, 0x6f, 0xd7, 0x41, 0x7e, 0x0a,
-	0x6d, 0xed, 0x20, 0xae, 0xd5, 0x0f, 0xa0, 0xa9, 0x9b, 0xd6, 0xc6, 0xd4, 0xb5, 0xed, 0xc4, 0x58,
-	0x12, 0x64, 0x1c, 0xfa, 0xbb, 0x0b, 0xdd, 0x77, 0x72, 0xc2, 0xde, 0x31, 0x85, 0x0b, 0x93, 0xc2,
-	0x3e, 0x33, 0x0b, 0xb4, 0x14, 0x5f, 0x05, 0xd3, 0xb5, 0x9f, 0xcb, 0xc8, 0x08, 0x64, 0xb3, 0x53,
-	0x00, 0xe5, 0xdb, 0x57, 0xc7, 0x00, 0xcb, 0x87, 0x5e, 0x2e, 0xd5, 0xa5, 0x5c, 0x8a, 0x49, 0x6a,
-	0x7e, 0x72, 0x14, 0x80, 0x9e, 0x38, 0x2e, 0x0c, 0x33, 0x0b, 0x3f, 0xa7, 0xe9, 0xa7, 0x00, 0xda,
-	0xe9, 0x34, 0x60, 0xf1, 0xfc, 0x86, 0x7c, 0x54, 0x0d, 0xeb, 0xa8, 0x14, 0x56, 0x8a, 0x27, 0xc1,
-	0xc4, 0xf6, 0xb3, 0x0b, 0x9d, 0x1c, 0xcc, 0x2b, 0xe1, 0x96, 0x2a, 0xd1, 0x83, 0x1a, 0x8f, 0x4d,
-	0x08, 0x35, 0x1e, 0x6f, 0x3d, 0xa9, 0x6b, 0xbb, 0xa2, 0xb1, 0xb9, 0x2b, 0xaa, 0xdb, 0xa6, 0xb9,
-	0xbe, 0x6d, 0xbc, 0x5f, 0x5a, 0xd0, 0x8d, 0xbd, 0x78, 0x6a, 0xf3, 0xf0, 0x04, 0xba, 0xf9, 0xfa,
-	0xb8, 0xb8, 0x26, 0x95, 0x85, 0x31, 0xb0, 0x14, 0x86, 0x4a, 0x1d, 0xf2, 0x02, 0x7a, 0xb9, 0x70,
-	0xb6, 0x0c, 0xd7, 0xb7, 0xc7, 0x86, 0xca, 0x31, 0x34, 0xf0, 0xa7, 0xc4, 0xda, 0xfa, 0x18, 0x94,
-	0x69, 0x29, 0xa6, 0xd4, 0x21, 0x43, 0x68, 0xd9, 0x23, 0x7f, 0xa7, 0x60, 0x1a, 0xa8, 0x2c, 0xaf,
-	0x69, 0xea, 0x90, 0x97, 0xd0, 0x35, 0x4c, 0xec, 0xaf, 0x2d, 0x3a, 0xa4, 0xaa, 0xa3, 0xc5, 0xa8,
-	0x43, 0x9e, 0x43, 0xcb, 0xfe, 0x42, 0x2c, 0xe9, 0x18, 0x68, 0x70, 0x54, 0x81, 0x4e, 0xa2, 0xf7,
-	0xd4, 0x21, 0x5e, 0xbe, 0xcd, 0xbd, 0x6d, 0x2a, 0x9b, 0x10, 0x75, 0xc8, 0x53, 0xe8, 0x8e, 0xe5,
-	0x95, 0xb2, 0x96, 0xd6, 0xc3, 0xdf, 0xcc, 0x6c, 0xa7, 0x38, 0xf3, 0xff, 0xa9, 0x84, 0x92, 0x81,
-	0x83, 0x83, 0x02, 0x3c, 0x17, 0x2b, 0xea, 0x90, 0x11, 0x40, 0x76, 0xaf, 0x7d, 0x7d, 0xaf, 0xef,
-	0x56, 0x74, 0xcc, 0x15, 0xdf, 0x54, 0x7a, 0x81, 0x49, 0xc6, 0xad, 0x56, 0x4d, 0x98, 0x86, 0x06,
-	0x87, 0xd5, 0x45, 0x93, 0x52, 0xe7, 0xb9, 0x4b, 0x3e, 0x47, 0x3b, 0x76, 0x7f, 0x56, 0xed, 0x18,
-	0xb4, 0x9c, 0x02, 0x03, 0x51, 0x87, 0x7c, 0x81, 0x05, 0xca, 0xff, 0x22, 0xfc, 0xb7, 0xa2, 0x69,
-	0xe1, 0xc1, 0x96, 0x9f, 0x51, 0xd4, 0x21, 0xaf, 0xe0, 0x68, 0xcc, 0x92, 0x15, 0x4b, 0xc6, 0x2a,
-	0x61, 0xe1, 0x22, 0x60, 0xe1, 0x24, 0x37, 0x5d, 0x39, 0x77, 0x79, 0x88, 0x01, 0xfb, 0xf1, 0x1d,
-	0x9f, 0x53, 0xe7, 0xd8, 0x25, 0x5f, 0x56, 0x95, 0xc7, 0x4c, 0x4c, 0x36, 0x0a, 0xb0, 0xf5, 0x31,
-	0x8c, 0x77, 0x04, 0xbd, 0xd7, 0x72, 0x3e, 0x67, 0x91, 0x3a, 0x17, 0x38, 0xb1, 0x1b, 0xba, 0x87,
-	0xa5, 0x21, 0x37, 0x4d, 0xf5, 0x12, 0x0e, 0xab, 0x4a, 0xde, 0x86, 0xd6, 0x9d, 0xf2, 0x6a, 0x30,
-	0x75, 0x3f, 0x7d, 0xf0, 0xc3, 0xff, 0xa7, 0x5c, 0xcd, 0x96, 0x97, 0xc3, 0x48, 0x2e, 0x9e, 0x8d,
-	0x46, 0x91, 0x78, 0x86, 0x7f, 0xc9, 0x46, 0xa3, 0x67, 0x28, 0x7d, 0xb9, 0x87, 0xff, 0xcd, 0x46,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x73, 0x56, 0x36, 0x8a, 0xe2, 0x0d, 0x00, 0x00,
+	// 1724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
+	0xf1, 0xe7, 0xa7, 0x48, 0x16, 0xf5, 0xb5, 0xe3, 0xfd, 0x1b, 0x04, 0xe1, 0xbf, 0xbd, 0x69, 0x6c,
+	0xe2, 0x8d, 0x17, 0xd6, 0xae, 0x47, 0x89, 0x03, 0xec, 0x1a, 0x08, 0xa4, 0xb5, 0xb3, 0x12, 0xe0,
+	0x2c, 0x06, 0x43, 0x25, 0x87, 0xdc, 0x46, 0x33, 0x25, 0xaa, 0xa1, 0x61, 0xf7, 0x78, 0xba, 0x49,
+	0x48, 0xb9, 0xe7, 0x96, 0x53, 0x5e, 0x20, 0x87, 0x3c, 0x45, 0x9e, 0x23, 0x4f, 0x11, 0x20, 0x6f,
+	0x90, 0x4b, 0xd0, 0x5f, 0x33, 0x3d, 0xa4, 0xc8, 0x00, 0x06, 0x72, 0x9b, 0xfa, 0xea, 0xae, 0xaa,
+	0x5f, 0x55, 0x77, 0xf5, 0xc0, 0xa8, 0x08, 0x8b, 0x93, 0xa2, 0xe4, 0x92, 0x07, 0x7d, 0xf9, 0x50,
+	0xa0, 0x98, 0x3e, 0x91, 0x65, 0xc2, 0x44, 0x92, 0x4a, 0xca, 0x99, 0x91, 0x4c, 0xf7, 0x53, 0xbe,
+	0x58, 0x54, 0xd4, 0xf1, 0x75, 0xce, 0xd3, 0xbb, 0xf4, 0x36, 0xa1, 0x96, 0x43, 0xbe, 0x80, 0xc3,
+	0x28, 0x8c, 0xde, 0xa3, 0x8c, 0x10, 0xcb, 0x4b, 0x76, 0xc3, 0x83, 0x09, 0x0c, 0x56, 0x58, 0x0a,
+	0xca, 0xd9, 0xa4, 0xfd, 0xac, 0xfd, 0xa2, 0x1f, 0x3b, 0x92, 0xfc, 0xa5, 0x0d, 0xe3, 0x28, 0x8c,
+	0x2a, 0xcd, 0x00, 0x7a, 0x49, 0x96, 0x95, 0x5a, 0x6d, 0x14, 0xeb, 0x6f, 0xc5, 0x2b, 0x78, 0x29,
+	0x27, 0x1d, 0x6d, 0xaa, 0xbf, 0x15, 0x8f, 0x25, 0x0b, 0x9c, 0x74, 0x8d, 0x9e, 0xfa, 0x0e, 0x9e,
+	0xc1, 0x78, 0x81, 0x8b, 0x82, 0xf3, 0x7c, 0x46, 0xff, 0x88, 0x93, 0x9e, 0x56, 0xf7, 0x59, 0xc1,
+	0x4f, 0x61, 0xef, 0x16, 0x93, 0x0c, 0xcb, 0x49, 0xff, 0x59, 0xfb, 0xc5, 0x38, 0x3c, 0x38, 0xd1,
+	0x41, 0x9e, 0x5c, 0x68, 0x66, 0x6c, 0x85, 0xe4, 0x5f, 0x6d, 0x80, 0x28, 0x8c, 0x7e, 0x6f, 0x7c,
+	0xdc, 0xee, 0xbd, 0x92, 0x08, 0x2c, 0x57, 0x34, 0x45, 0xed, 0x5c, 0x37, 0x76, 0x64, 0xf0, 0x09,
+	0x8c, 0x24, 0x5d, 0xa0, 0x90, 0xc9, 0xa2, 0xd0, 0x4e, 0x76, 0xe3, 0x9a, 0x11, 0x4c, 0x61, 0xa8,
+	0x22, 0x8b, 0x31, 0x5d, 0x69, 0x37, 0x47, 0x71, 0x45, 0x3b, 0xd9, 0x6f, 0x4a, 0xbe, 0x98, 0xf4,
+	0x6b, 0x99, 0xa2, 0x83, 0xa7, 0xd0, 0x67, 0x9c, 0xa5, 0x38, 0xd9, 0xd3, 0x2b, 0x1a, 0x42, 0xed,
+	0xb5, 0x14, 0x58, 0x9e, 0xcd, 0x91, 0xc9, 0xc9, 0x40, 0x9b, 0xd4, 0x0c, 0x95, 0x15, 0x21, 0x93,
+	0x52, 0x5e, 0x20, 0x9d, 0xdf, 0xca, 0xc9, 0x50, 0x5b, 0xfa, 0x2c, 0xf2, 0x3b, 0x18, 0x99, 0x68,
+	0xcf, 0xd2, 0xbb, 0x1f, 0x15, 0x6c, 0xe5, 0x56, 0xd7, 0x73, 0x8b, 0x2c, 0x60, 0xa0, 0x90, 0xa5,
+	0x6c, 0x5e, 0x2b, 0xb4, 0x7d, 0xbf, 0x1d, 0xd6, 0x9d, 0x47, 0xb0, 0xee, 0x7a, 0x58, 0x3f, 0x87,
+	0x9e, 0xa0, 0x73, 0xa6, 0x33, 0x35, 0x0e, 0x8f, 0x2d, 0x66, 0x33, 0x3a, 0x67, 0x89, 0x5c, 0x96,
+	0x18, 0x6b, 0x29, 0xf9, 0xcc, 0x6c, 0xc7, 0xb7, 0x6d, 0x47, 0x88, 0x06, 0xf5, 0x3d, 0xca, 0x33,
+	0xb5, 0xd1, 0xe3, 0x3a, 0x6f, 0xf5, 0x22, 0xdb, 0x15, 0x1c, 0x3a, 0x39, 0x15, 0xaa, 0x1e, 0xbb,
+	0x0e, 0x1d, 0x45, 0x93, 0x19, 0x8c, 0xad, 0xf1, 0xf7, 0x54, 0xc8, 0x2d, 0x0b, 0x9c, 0xc0, 0xb0,
+	0x40, 0x2c, 0x29, 0xbb, 0xe1, 0x7a, 0x81, 0x71, 0x18, 0xd8, 0x80, 0xbc, 0x36, 0x88, 0x2b, 0x1d,
+	0xf2, 0x0e, 0x8e, 0xa2, 0x30, 0xfa, 0xee, 0x5e, 0x62, 0xc9, 0x92, 0x7c, 0x6b, 0x8f, 0x7c, 0x02,
+	0x23, 0x2a, 0xf8, 0x52, 0x0a, 0x9a, 0x19, 0x78, 0x86, 0x71, 0xcd, 0x20, 0xb7, 0xb0, 0x6f, 0x42,
+	0x3f, 0x57, 0xbd, 0x2a, 0x76, 0x80, 0xbc, 0x56, 0x2d, 0x9d, 0x8d, 0x6a, 0x51, 0x3b, 0x21, 0xcb,
+	0xac, 0xdc, 0x56, 0x76, 0xc5, 0x20, 0x3f, 0x87, 0x03, 0xb3, 0xd3, 0x6f, 0x4d, 0xdb, 0xed, 0x68,
+	0xfd, 0x13, 0xd8, 0x8b, 0xc2, 0xe8, 0x92, 0xad, 0x14, 0xc0, 0x94, 0xad, 0xc4, 0xa4, 0xfd, 0xac,
+	0xeb, 0x01, 0x7c, 0xc9, 0x56, 0xc8, 0x24, 0x2f, 0x1f, 0x62, 0x2d, 0x25, 0xef, 0x61, 0x54, 0xb1,
+	0x82, 0x43, 0xe8, 0xc8, 0x07, 0xbb, 0x62, 0x47, 0x3e, 0xa8, 0x9c, 0xdc, 0x26, 0xe2, 0x56, 0x3b,
+	0xbc, 0x1f, 0xeb, 0xef, 0xe0, 0x63, 0xd5, 0xed, 0x9e, 0x9b, 0x96, 0x22, 0xdf, 0xbb, 0x42, 0xf8,
+	0x36, 0x91, 0xc9, 0x8e, 0x5c, 0x38, 0xb7, 0x3a, 0x3b, 0xdd, 0x7a, 0x09, 0xfd, 0x28, 0x8c, 0xae,
+	0xee, 0x03, 0x02, 0x1d, 0x79, 0xaf, 0xd7, 0xa8, 0x31, 0xbd, 0xaa, 0x0f, 0xcf, 0xb8, 0x23, 0xef,
+	0xc9, 0x09, 0x0c, 0xa3, 0x30, 0xd2, 0x28, 0x04, 0x04, 0xfa, 0xfa, 0xe8, 0xb4, 0x26, 0xfb, 0xd6,
+	0x44, 0x0b, 0x63, 0x23, 0x22, 0x6f, 0x34, 0x70, 0xef, 0x38, 0x13, 0xc8, 0xc4, 0x52, 0xa8, 0x90,
+	0xb2, 0x92, 0xae, 0xd0, 0x81, 0x6f, 0x29, 0x15, 0x7e, 0x96, 0xc8, 0xc4, 0x85, 0xaf, 0xbe, 0xc9,
+	0x2d, 0x0c, 0xed, 0x09, 0x26, 0x82, 0x4f, 0x01, 0x8a, 0xb0, 0x68, 0xc6, 0xe9, 0x71, 0x34, 0xec,
+	0xfc, 0x46, 0x3a, 0x05, 0xd3, 0x91, 0x3e, 0x4b, 0x15, 0xbe, 0xaa, 0x49, 0xef, 0xd0, 0xad, 0x68,
+	0xf2, 0xcf, 0x36, 0x1c, 0x9c, 0x97, 0x3c, 0xc9, 0xde, 0x25, 0xc2, 0x24, 0xf5, 0x53, 0x2f, 0x17,
+	0xfb, 0x75, 0x7d, 0x5f, 0xdd, 0x5f, 0xb4, 0x54, 0x1e, 0x82, 0xcf, 0x5d, 0xec, 0x1d, 0xad, 0x72,
+	0x54, 0xab, 0xe8, 0xf0, 0x2f, 0x5a, 0x36, 0x01, 0x0a, 0x83, 0x82, 0xb2, 0xb9, 0xde, 0x72, 0x1c,
+	0x1e, 0xd6, 0x7a, 0xea, 0x5c, 0xb9, 0x68, 0xc5, 0x5a, 0x1a, 0xbc, 0xac, 0x31, 0xec, 0x35, 0x16,
+	0x74, 0x09, 0xb8, 0x68, 0xd5, 0xb0, 0x9e, 0xc2, 0x28, 0x75, 0x09, 0xb5, 0xf7, 0xc0, 0x47, 0xf5,
+	0xba, 0x55, 0xae, 0x2f, 0x5a, 0x71, 0xad, 0x77, 0x3e, 0x80, 0xfe, 0x2a, 0xc9, 0x97, 0x48, 0xa8,
+	0x2b, 0x70, 0x73, 0x67, 0xfc, 0x2f, 0x7b, 0xe9, 0x97, 0xba, 0x4e, 0xdd, 0x3e, 0x9f, 0xc3, 0xc0,
+	0x5c, 0x4f, 0xae, 0x4f, 0xd6, 0x2e, 0x2f, 0x27, 0x25, 0x0c, 0x06, 0x97, 0x6c, 0xa5, 0x61, 0x78,
+	0xbe, 0xbb, 0x24, 0x2d, 0x18, 0xcf, 0x9b, 0x60, 0x34, 0x0a, 0xb1, 0x46, 0xc2, 0x74, 0x5c, 0xd7,
+	0x75, 0x5c, 0x9d, 0x91, 0xd7, 0x30, 0xb4, 0xfb, 0x09, 0xb5, 0x14, 0x95, 0xb8, 0x70, 0x2e, 0x1e,
+	0xd6, 0x3d, 0xa3, 0xe4, 0xb1, 0x11, 0x92, 0xbf, 0xb6, 0xa1, 0x17, 0xa1, 0x29, 0xdb, 0x1f, 0x7d,
+	0xdb, 0x07, 0xd0, 0x13, 0x98, 0xdf, 0x68, 0xc0, 0x87, 0xb1, 0xfe, 0x5e, 0x9f, 0x00, 0xfa, 0xbb,
+	0x26, 0x80, 0xbd, 0x5d, 0x13, 0xc0, 0x97, 0x30, 0x54, 0x0e, 0xea, 0x73, 0xfc, 0x27, 0xd0, 0x57,
+	0x95, 0xee, 0x62, 0x1a, 0xbb, 0x5a, 0x41, 0x2c, 0x63, 0x23, 0x21, 0x7f, 0x6b, 0xc3, 0xf8, 0x03,
+	0xcf, 0xf0, 0x03, 0x4a, 0x7d, 0x42, 0x13, 0xd8, 0x47, 0x7b, 0x62, 0x7b, 0xf1, 0x35, 0x78, 0x0a,
+	0xfb, 0x9c, 0xa7, 0x56, 0xc1, 0x34, 0x5c, 0xcd, 0xf0, 0x2f, 0xdb, 0xae, 0x0e, 0xd0, 0x9f, 0x2c,
+	0xf8, 0x52, 0x5e, 0xf3, 0x25, 0xcb, 0x84, 0x9d, 0x71, 0x6a, 0x86, 0x6a, 0x53, 0xca, 0xac, 0xd0,
+	0x84, 0x5f, 0xd1, 0xe4, 0x17, 0x00, 0xca, 0x69, 0x11, 0x63, 0x91, 0x3f, 0x04, 0x3f, 0x6b, 0x86,
+	0x75, 0xec, 0x85, 0x25, 0xf4, 0x1d, 0x64, 0x63, 0xfb, 0x53, 0x1b, 0x46, 0x15, 0xb3, 0x42, 0xa2,
+	0xed, 0x21, 0x71, 0x08, 0x1d, 0x5a, 0xd8, 0x10, 0x3a, 0xb4, 0x78, 0xf4, 0x0e, 0x5f, 0x3b, 0x60,
+	0x7a, 0x9b, 0x07, 0x4c, 0xf3, 0x88, 0xea, 0xaf, 0x1f, 0x51, 0xe4, 0xdf, 0x6d, 0x18, 0x7c, 0x30,
+	0x33, 0xe5, 0xb6, 0xba, 0xd1, 0x9e, 0x75, 0x3c, 0xcf, 0x26, 0x30, 0xb0, 0xd1, 0xbb, 0x2c, 0x5a,
+	0xd2, 0xef, 0xda, 0xde, 0x66, 0xd7, 0x7a, 0x9e, 0xf6, 0x37, 0x3d, 0x9d, 0xc0, 0x20, 0x4f, 0x24,
+	0xb2, 0xf4, 0xc1, 0xce, 0x61, 0x8e, 0xf4, 0x6e, 0x9c, 0x81, 0x7f, 0xe3, 0xac, 0xd7, 0xe5, 0x70,
+	0xb3, 0x2e, 0x55, 0xf4, 0x6a, 0x79, 0x21, 0xd5, 0x10, 0x37, 0xd2, 0xce, 0x7a, 0x1c, 0xd5, 0x64,
+	0x36, 0x78, 0xdd, 0x64, 0x3e, 0x72, 0xae, 0xc9, 0xac, 0xdc, 0xe1, 0xf6, 0x06, 0x20, 0xc6, 0x1f,
+	0x76, 0x65, 0x6c, 0x02, 0x83, 0xeb, 0x84, 0x5d, 0xd1, 0x45, 0x35, 0xd0, 0x59, 0x92, 0xfc, 0x1a,
+	0x0e, 0x3e, 0xa0, 0x3c, 0x4f, 0x18, 0xc3, 0x6c, 0xab, 0xf9, 0x14, 0x86, 0x19, 0x26, 0x59, 0x4e,
+	0x99, 0xb3, 0xaf, 0x68, 0xf2, 0x0d, 0x1c, 0x36, 0x16, 0x10, 0xc1, 0x17, 0x4d, 0xa7, 0x9f, 0xd6,
+	0x4e, 0xd7, 0x5a, 0xce, 0xf5, 0x7f, 0xa8, 0x76, 0x42, 0x39, 0xc3, 0xfc, 0x46, 0x17, 0xdd, 0xc7,
+	0xb0, 0xc7, 0x78, 0x86, 0x97, 0xdf, 0xba, 0x5b, 0xcf, 0x50, 0xff, 0xa5, 0x85, 0xd6, 0x9b, 0xb0,
+	0xfb, 0x48, 0x13, 0x7a, 0x6d, 0xd6, 0x6b, 0xb6, 0x99, 0x57, 0x20, 0xfd, 0x9d, 0x05, 0xb2, 0xb7,
+	0x59, 0x20, 0x4f, 0xa1, 0x2f, 0x10, 0x33, 0x31, 0x19, 0xe8, 0x09, 0xd1, 0x10, 0xe4, 0xef, 0x6d,
+	0x38, 0x50, 0xc3, 0xe1, 0x39, 0xe7, 0x77, 0xdf, 0x31, 0x59, 0x3e, 0x6c, 0xcb, 0x6a, 0x22, 0x25,
+	0x2e, 0x0a, 0x29, 0x5c, 0x56, 0x1d, 0xad, 0x76, 0xce, 0x13, 0x21, 0xcf, 0x0c, 0x6d, 0x2f, 0x0c,
+	0x9f, 0xe5, 0x34, 0x66, 0xcb, 0x34, 0x45, 0x61, 0x8e, 0x87, 0x6e, 0xec, 0xb3, 0x54, 0xce, 0x52,
+	0xce, 0x18, 0xa6, 0x12, 0x33, 0x1d, 0xd9, 0x30, 0xae, 0x19, 0x2a, 0xd3, 0xd7, 0x1a, 0x0e, 0x1d,
+	0xd6, 0x30, 0xb6, 0x14, 0x39, 0x83, 0x23, 0xdf, 0x75, 0x8a, 0x22, 0x38, 0x81, 0x01, 0x9a, 0xcf,
+	0x35, 0x48, 0x1b, 0x31, 0xc6, 0x4e, 0x89, 0x50, 0x78, 0x12, 0xe3, 0x0f, 0x4e, 0x18, 0xe3, 0x82,
+	0xaf, 0xf4, 0xcb, 0x41, 0x45, 0x6d, 0x96, 0x18, 0xc5, 0x86, 0xd0, 0xed, 0x42, 0xd9, 0x59, 0x33,
+	0x0d, 0x3e, 0x4b, 0x45, 0x21, 0x64, 0x92, 0xa3, 0x2e, 0x5e, 0x7b, 0x71, 0x56, 0x0c, 0xf2, 0x16,
+	0x8e, 0xbc, 0xad, 0x66, 0x88, 0xd9, 0x96, 0x8d, 0xd4, 0xd8, 0x44, 0x93, 0xdc, 0x0e, 0xcc, 0xfa,
+	0x9b, 0x9c, 0x29, 0x3f, 0x8b, 0xfc, 0xa1, 0x0a, 0x23, 0xa3, 0xfa, 0x02, 0xca, 0x38, 0x43, 0x6b,
+	0xad, 0xbf, 0x75, 0xed, 0xdc, 0xd1, 0xa2, 0xc0, 0xcc, 0xbe, 0x04, 0x1c, 0x19, 0xfe, 0x79, 0x00,
+	0xe3, 0x22, 0x2c, 0xe6, 0xae, 0x96, 0x5e, 0xc2, 0xb8, 0x1a, 0x8f, 0xae, 0xee, 0x83, 0xc6, 0x40,
+	0x34, 0x75, 0x94, 0xde, 0x94, 0xb4, 0x82, 0xaf, 0xe0, 0xb0, 0x52, 0x36, 0x83, 0xe2, 0xfa, 0x74,
+	0xb4, 0x61, 0xf2, 0x02, 0x7a, 0xfa, 0x99, 0xb5, 0x36, 0x1e, 0x4d, 0x7d, 0x9a, 0xb3, 0x39, 0x69,
+	0x29, 0xd0, 0xdc, 0x03, 0xe8, 0x49, 0x2d, 0xb4, 0x2c, 0x5f, 0x5f, 0xd1, 0xa4, 0x15, 0x7c, 0x0d,
+	0x63, 0x2b, 0xd4, 0x57, 0xe1, 0x23, 0x36, 0x41, 0xd3, 0x46, 0xa9, 0x91, 0x56, 0xf0, 0x1a, 0x06,
+	0xee, 0xf5, 0xec, 0xd9, 0x58, 0xd6, 0xf4, 0xb8, 0xc1, 0x3a, 0x4b, 0xef, 0x48, 0x2b, 0x08, 0xab,
+	0x69, 0x35, 0x7c, 0xcc, 0x64, 0x93, 0x45, 0x5a, 0xc1, 0x97, 0x30, 0x9e, 0xf1, 0x1b, 0xe9, 0x76,
+	0x5a, 0x0f, 0x7f, 0x33, 0xb3, 0xa3, 0xfa, 0x09, 0xf4, 0x51, 0x23, 0x14, 0xc3, 0x9c, 0x1e, 0xd4,
+	0xcc, 0x4b, 0xb6, 0x22, 0xad, 0xe0, 0x14, 0xc0, 0xbc, 0x65, 0x22, 0xf5, 0x96, 0x79, 0xda, 0xb0,
+	0xb1, 0x2f, 0x9c, 0x4d, 0xa3, 0xaf, 0x74, 0x92, 0xf5, 0x00, 0xd6, 0x4c, 0x98, 0x62, 0x4d, 0x8f,
+	0x9a, 0x33, 0x91, 0x20, 0xad, 0xd7, 0xed, 0xe0, 0x57, 0x7a, 0x1f, 0x37, 0xea, 0x35, 0xf7, 0xb1,
+	0x5c, 0x3f, 0x05, 0x96, 0x45, 0x5a, 0xc1, 0x1b, 0x0d, 0x50, 0xf5, 0xfb, 0xe4, 0xff, 0x1a, 0x96,
+	0x8e, 0x3d, 0x7d, 0xe4, 0x89, 0x49, 0x5a, 0xc1, 0x5b, 0x38, 0x9e, 0x61, 0xb9, 0xc2, 0x72, 0x26,
+	0x4b, 0x4c, 0x16, 0x31, 0x26, 0x59, 0xb5, 0x75, 0x63, 0x9c, 0xaf, 0x42, 0x54, 0x17, 0x0a, 0xcd,
+	0x49, 0xeb, 0x45, 0x3b, 0xf8, 0xa6, 0x69, 0x3c, 0x43, 0x96, 0x6d, 0x00, 0xf0, 0xe8, 0x62, 0x3a,
+	0xde, 0x53, 0x38, 0x7c, 0xc7, 0xf3, 0x1c, 0x53, 0x79, 0xc9, 0xcc, 0xfd, 0xb0, 0x6e, 0x7b, 0xe4,
+	0xcd, 0x23, 0xb6, 0xa8, 0xbe, 0x86, 0xa3, 0xa6, 0x51, 0xb8, 0x61, 0xf5, 0xc4, 0xb3, 0x12, 0x16,
+	0xf7, 0xf3, 0xcf, 0xfe, 0xf0, 0xff, 0x73, 0x2a, 0x6f, 0x97, 0xd7, 0x27, 0x29, 0x5f, 0xbc, 0x3a,
+	0x3d, 0x4d, 0xd9, 0x2b, 0xfd, 0xbb, 0xea, 0xf4, 0xf4, 0x95, 0xd6, 0xbe, 0xde, 0xd3, 0xff, 0xad,
+	0x4e, 0xff, 0x33, 0x00, 0x87, 0xf4, 0x94, 0x15, 0xfe, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
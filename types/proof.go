@@ -0,0 +1,94 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+)
+
+//VerifyStoreProof 验证mavl树的存在或者不存在证明，不需要访问数据库，轻节点和跨链合约可以直接使用
+func VerifyStoreProof(proof *StoreProof) error {
+	if proof == nil || len(proof.StateHash) == 0 {
+		return ErrInvalidParam
+	}
+	if proof.Exists {
+		leaf := proof.GetLeaf()
+		if leaf == nil || !bytes.Equal(leaf.Key, proof.Key) || !bytes.Equal(leaf.Value, proof.Value) {
+			return ErrStoreProof
+		}
+		_, _, err := verifyLeafProof(proof.StateHash, leaf)
+		return err
+	}
+	left, right := proof.GetLeft(), proof.GetRight()
+	if left == nil && right == nil {
+		return ErrStoreProof
+	}
+	var leftIndex, rightIndex, size int32
+	var err error
+	if left != nil {
+		if bytes.Compare(left.Key, proof.Key) >= 0 {
+			return ErrStoreProof
+		}
+		leftIndex, size, err = verifyLeafProof(proof.StateHash, left)
+		if err != nil {
+			return err
+		}
+	}
+	if right != nil {
+		if bytes.Compare(right.Key, proof.Key) <= 0 {
+			return ErrStoreProof
+		}
+		rightIndex, size, err = verifyLeafProof(proof.StateHash, right)
+		if err != nil {
+			return err
+		}
+	}
+	//两个叶子必须相邻，只有一边的时候必须是树的第一个或者最后一个叶子
+	switch {
+	case left == nil && rightIndex == 0:
+	case right == nil && leftIndex == size-1:
+	case left != nil && right != nil && rightIndex == leftIndex+1:
+	default:
+		return ErrStoreProof
+	}
+	return nil
+}
+
+//verifyLeafProof 从叶子节点计算到根，返回叶子在树中的序号和树的叶子总数
+func verifyLeafProof(root []byte, leaf *MAVLLeafProof) (index int32, size int32, err error) {
+	node := &LeafNode{Key: leaf.Key, Value: leaf.Value, Height: 0, Size: 1}
+	hash := node.Hash()
+	size = 1
+	for _, branch := range leaf.InnerNodes {
+		//在右子树的时候，左子树的叶子都排在前面
+		if len(branch.LeftHash) != 0 {
+			index += branch.Size - size
+		}
+		hash = InnerNodeProofHash(hash, branch)
+		size = branch.Size
+	}
+	if !bytes.Equal(hash, root) {
+		return 0, 0, ErrStoreProof
+	}
+	return index, size, nil
+}
+
+// InnerNodeProofHash 计算inner节点的hash，branch中为空的一边是childHash
+func InnerNodeProofHash(childHash []byte, branch *InnerNode) []byte {
+	var innernode InnerNode
+
+	innernode.Height = branch.Height
+	innernode.Size = branch.Size
+
+	// left is nil
+	if len(branch.LeftHash) == 0 {
+		innernode.LeftHash = childHash
+		innernode.RightHash = branch.RightHash
+	} else {
+		innernode.LeftHash = branch.LeftHash
+		innernode.RightHash = childHash
+	}
+	return innernode.Hash()
+}
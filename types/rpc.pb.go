@@ -26,74 +26,79 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 1057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x6d, 0x6f, 0xdb, 0x36,
-	0x10, 0xd6, 0x87, 0xad, 0x69, 0x58, 0x27, 0x71, 0x98, 0x34, 0x68, 0x85, 0x15, 0x05, 0x04, 0x0c,
-	0x1b, 0x30, 0xd4, 0x6e, 0xed, 0x35, 0x7b, 0x29, 0x36, 0x20, 0x4e, 0x66, 0xc7, 0x98, 0xeb, 0xb9,
-	0x91, 0xbb, 0x01, 0xfb, 0x46, 0xcb, 0x37, 0x47, 0x88, 0x4c, 0x2a, 0x24, 0x15, 0xdb, 0xff, 0x78,
-	0x3f, 0x63, 0x20, 0x25, 0xea, 0xdd, 0x49, 0xf6, 0x4d, 0xbc, 0xbb, 0xe7, 0xee, 0xc8, 0x7b, 0xee,
-	0x4e, 0x68, 0x97, 0x87, 0x5e, 0x2b, 0xe4, 0x4c, 0x32, 0xfc, 0xa5, 0xdc, 0x84, 0x20, 0xec, 0x86,
-	0xc7, 0x96, 0x4b, 0x46, 0x63, 0xa1, 0x7d, 0x28, 0x39, 0xa1, 0x82, 0x78, 0xd2, 0x4f, 0x45, 0xcd,
-	0x59, 0xc0, 0xbc, 0x1b, 0xef, 0x9a, 0xf8, 0x46, 0xd2, 0x58, 0x91, 0x20, 0x00, 0x99, 0x9c, 0x76,
-	0xc3, 0x4e, 0x98, 0x7c, 0xee, 0x11, 0xcf, 0x63, 0x11, 0x35, 0x9a, 0x7d, 0x58, 0x83, 0x17, 0x49,
-	0xc6, 0xe3, 0x73, 0xe7, 0xdf, 0x13, 0xb4, 0xa3, 0xfd, 0x74, 0xbb, 0xf8, 0x0d, 0xda, 0x1d, 0x80,
-	0xec, 0x29, 0xd7, 0x02, 0x37, 0x5b, 0x3a, 0x97, 0xd6, 0x15, 0xdc, 0xc6, 0x12, 0xbb, 0x91, 0x4a,
-	0xc2, 0x60, 0xe3, 0x58, 0xb8, 0x8d, 0xf6, 0x06, 0x20, 0x47, 0x44, 0xc8, 0x4b, 0x20, 0x73, 0xe0,
-	0x78, 0x2f, 0x83, 0x8c, 0xfd, 0xc0, 0x36, 0xc7, 0x58, 0xeb, 0x58, 0xf8, 0x67, 0x74, 0x7c, 0xce,
-	0x81, 0x48, 0xb8, 0x22, 0xab, 0x69, 0x76, 0x27, 0x7c, 0x90, 0x18, 0xc6, 0xca, 0xe9, 0xda, 0x36,
-	0x82, 0xcf, 0x54, 0xf8, 0x0b, 0x3a, 0x5d, 0x3b, 0x16, 0xbe, 0x40, 0xcd, 0x0c, 0xbb, 0x1e, 0x70,
-	0x16, 0x85, 0xf8, 0x55, 0x11, 0x97, 0x79, 0xd4, 0xea, 0x3a, 0x2f, 0xbf, 0xa2, 0xe6, 0xa7, 0x08,
-	0xf8, 0x26, 0x1f, 0x7d, 0x3f, 0xcb, 0xfa, 0x92, 0x88, 0x6b, 0xfb, 0x45, 0x72, 0xce, 0xd9, 0x5c,
-	0x80, 0x24, 0x7e, 0xe0, 0x58, 0xf8, 0x3d, 0x3a, 0x70, 0x81, 0xce, 0xf3, 0x70, 0x5c, 0x35, 0xaf,
-	0xbc, 0xd4, 0x2f, 0xe8, 0x78, 0x00, 0x32, 0x67, 0xd1, 0xdb, 0x9c, 0xcd, 0xe7, 0x3c, 0x1f, 0x5a,
-	0x9d, 0xed, 0xa3, 0x3c, 0x6e, 0xba, 0x1e, 0xd2, 0x7f, 0x98, 0x70, 0x2c, 0x3c, 0x40, 0x27, 0x65,
-	0xb8, 0xca, 0x14, 0x0a, 0x45, 0x8a, 0x25, 0xf6, 0xcb, 0x6d, 0xd9, 0x2b, 0x47, 0xef, 0x10, 0x1a,
-	0x80, 0xfc, 0x08, 0xcb, 0x09, 0x63, 0x41, 0xb9, 0x5c, 0xb8, 0x18, 0x7c, 0xe4, 0x0b, 0xa9, 0x6f,
-	0xfc, 0x6c, 0x00, 0xf2, 0x2c, 0xe6, 0x90, 0x28, 0x63, 0x9e, 0x27, 0xc7, 0xbf, 0x34, 0xf9, 0x8c,
-	0x95, 0x2e, 0x35, 0x1a, 0xc3, 0x2a, 0x11, 0xe0, 0xe3, 0x1c, 0x2a, 0x95, 0xda, 0xc7, 0x75, 0x60,
-	0xc7, 0xc2, 0x57, 0xe8, 0x79, 0x2c, 0xca, 0xdd, 0x41, 0x65, 0x83, 0x5f, 0x67, 0x6e, 0x6a, 0x0d,
-	0xec, 0x93, 0x82, 0xc7, 0xe9, 0x3a, 0xbb, 0x79, 0x1f, 0xed, 0x0d, 0x97, 0x21, 0xe3, 0x72, 0xc2,
-	0xfd, 0xbb, 0x1b, 0xd8, 0xa4, 0xdc, 0x49, 0x7d, 0x15, 0xd4, 0x5b, 0x73, 0xeb, 0xa1, 0x3d, 0x4d,
-	0x00, 0xa6, 0xea, 0x05, 0x42, 0x54, 0xfd, 0x14, 0xd4, 0x76, 0x33, 0xff, 0xa8, 0xaa, 0x44, 0x8e,
-	0x85, 0x3b, 0xe8, 0xa9, 0xab, 0xb2, 0xeb, 0x03, 0xe0, 0x93, 0x2a, 0x5c, 0xf6, 0x01, 0x2a, 0x0c,
-	0xfa, 0x80, 0x76, 0x5c, 0xd5, 0x6b, 0xb3, 0x00, 0xbf, 0xa8, 0x81, 0x8c, 0xc8, 0x0c, 0x82, 0x7b,
-	0x92, 0x6e, 0x7c, 0x04, 0xbe, 0x80, 0x1e, 0x09, 0x08, 0xf5, 0x00, 0x7f, 0x55, 0xf6, 0x90, 0xd7,
-	0x16, 0x79, 0x10, 0xb3, 0xca, 0xb1, 0xf0, 0x29, 0xda, 0x75, 0x41, 0x4e, 0x88, 0x10, 0xab, 0x39,
-	0x7e, 0x59, 0x93, 0x42, 0xac, 0xaa, 0x24, 0xfe, 0x35, 0xfa, 0x62, 0xc4, 0xbc, 0x9b, 0x32, 0x71,
-	0xca, 0x66, 0x6f, 0xd0, 0x93, 0xcf, 0x54, 0x1b, 0x1e, 0x15, 0x2e, 0x11, 0x0b, 0x6b, 0x46, 0x8f,
-	0x62, 0xe5, 0x04, 0x80, 0xab, 0x1e, 0x29, 0x3b, 0x37, 0x8d, 0xaf, 0xf4, 0x29, 0x8d, 0xf7, 0x93,
-	0x59, 0xf5, 0xbf, 0xd8, 0x7f, 0x8a, 0x1a, 0x2a, 0x0e, 0x67, 0x21, 0x70, 0x55, 0xae, 0x2d, 0xf4,
-	0xd7, 0xa0, 0xd4, 0xca, 0xb1, 0xf0, 0x0f, 0xe8, 0x60, 0x00, 0x32, 0x79, 0x1b, 0x49, 0x64, 0x54,
-	0xe9, 0x9c, 0xe2, 0x35, 0x63, 0x1b, 0xdd, 0x37, 0x4d, 0x33, 0x82, 0xff, 0xb8, 0x03, 0x7e, 0xe7,
-	0xc3, 0xaa, 0x32, 0xa0, 0x4c, 0x99, 0x0b, 0x56, 0x8e, 0x85, 0x7f, 0xd4, 0x41, 0x15, 0xf3, 0xea,
-	0xa0, 0x85, 0x01, 0x93, 0x37, 0xd2, 0x73, 0xa1, 0x61, 0xa2, 0xaa, 0x08, 0xf9, 0x5c, 0x87, 0x54,
-	0xd6, 0x92, 0xf8, 0x1d, 0xda, 0x19, 0x00, 0x75, 0x01, 0xe6, 0xe9, 0x04, 0x4c, 0xce, 0x23, 0x42,
-	0x17, 0x45, 0x88, 0x92, 0x1a, 0x88, 0x2c, 0x41, 0xf4, 0xb9, 0xb7, 0x99, 0xac, 0x6a, 0x21, 0x6d,
-	0xf4, 0xd4, 0x25, 0x77, 0xa0, 0x31, 0x26, 0x77, 0x23, 0xd0, 0xa0, 0x32, 0x31, 0x3a, 0x7a, 0xc2,
-	0x19, 0xa2, 0x1f, 0xe6, 0x76, 0x58, 0xc2, 0x6e, 0xc3, 0x8d, 0xdc, 0xac, 0xea, 0x20, 0xa4, 0x97,
-	0xc2, 0xb9, 0x5a, 0x83, 0xe9, 0xac, 0xd2, 0xa7, 0xdf, 0x92, 0x65, 0x59, 0x17, 0x47, 0xe9, 0xe2,
-	0xea, 0x3d, 0x12, 0x73, 0x8a, 0xf6, 0xe3, 0x38, 0x8c, 0x0a, 0xa0, 0x22, 0x12, 0x8f, 0xc4, 0xfd,
-	0x84, 0x0e, 0x2b, 0x1b, 0x2e, 0xbd, 0x9a, 0xd9, 0x99, 0x43, 0x5a, 0xb7, 0xef, 0xde, 0x6a, 0xda,
-	0x5f, 0xc2, 0x7a, 0xba, 0x8e, 0x77, 0x46, 0x85, 0x4c, 0x8d, 0x74, 0x49, 0xaf, 0x35, 0xe2, 0x3d,
-	0x7a, 0x76, 0x11, 0x2d, 0x43, 0x33, 0x26, 0x73, 0x0b, 0xc6, 0x95, 0xdc, 0xa7, 0x8b, 0x62, 0xa3,
-	0xc4, 0x32, 0xc7, 0xc2, 0x2d, 0xb4, 0xf3, 0x27, 0x70, 0xa1, 0x32, 0xdb, 0xd2, 0x58, 0x89, 0x5a,
-	0xf5, 0xab, 0x63, 0xe1, 0x6f, 0xd0, 0x93, 0xa1, 0x70, 0x37, 0xd4, 0x7b, 0x68, 0x30, 0xb4, 0xd1,
-	0xfe, 0x50, 0x8c, 0x65, 0x78, 0xae, 0xc8, 0xf9, 0x18, 0x40, 0x0b, 0xed, 0x8c, 0x41, 0xd6, 0x8d,
-	0x05, 0x93, 0xc9, 0x98, 0xcd, 0x21, 0x31, 0xd1, 0x4f, 0xa4, 0xba, 0xa6, 0x4f, 0x24, 0x09, 0xfa,
-	0xc4, 0x0f, 0x22, 0x0e, 0xdb, 0x22, 0x0c, 0xa9, 0xec, 0x76, 0xf4, 0x13, 0x1d, 0x27, 0xb3, 0x44,
-	0x77, 0x8c, 0x0b, 0xb7, 0x11, 0x28, 0xb6, 0x6d, 0x87, 0x9d, 0x7e, 0xef, 0x58, 0xb8, 0x8b, 0x0e,
-	0x35, 0xdd, 0x63, 0xeb, 0x07, 0xca, 0x61, 0x40, 0x1f, 0xb2, 0x79, 0x70, 0xcf, 0xd2, 0x3f, 0xca,
-	0x4f, 0x84, 0x6c, 0xe9, 0xbd, 0xd5, 0x3f, 0x68, 0x09, 0xd8, 0x85, 0x5b, 0x5c, 0xf0, 0x9e, 0xf2,
-	0xc5, 0xdc, 0xc2, 0xb1, 0xf0, 0x77, 0x08, 0x9d, 0x07, 0x4c, 0xc0, 0xa7, 0x08, 0x22, 0x78, 0xe8,
-	0xa5, 0xfb, 0xfa, 0x42, 0x67, 0x41, 0xa0, 0x98, 0x6b, 0x5a, 0x2e, 0xb7, 0x9d, 0x8a, 0x9a, 0x74,
-	0x58, 0x16, 0xc5, 0x9a, 0xdf, 0xbb, 0xae, 0xbf, 0xa0, 0xfa, 0xc7, 0x0e, 0x1f, 0xe5, 0x08, 0x67,
-	0x84, 0xc5, 0x39, 0x9b, 0x8a, 0x1d, 0x0b, 0x0f, 0x91, 0x1d, 0x37, 0xc0, 0x98, 0x25, 0xfe, 0xea,
-	0x7e, 0xcd, 0x32, 0xe5, 0x3d, 0xae, 0x4e, 0x51, 0x43, 0x77, 0xe7, 0x15, 0xa1, 0xf3, 0x71, 0xb4,
-	0xc4, 0x19, 0xcf, 0x6f, 0x95, 0x48, 0x57, 0xa7, 0x6e, 0x10, 0x7e, 0xab, 0xa7, 0x5a, 0x9f, 0xf1,
-	0xc2, 0x8e, 0xfb, 0x1d, 0x36, 0xe5, 0x5a, 0xf6, 0x5e, 0xff, 0xfd, 0x6a, 0xe1, 0xcb, 0xeb, 0x68,
-	0xd6, 0xf2, 0xd8, 0xb2, 0xdd, 0xed, 0x7a, 0xb4, 0x9d, 0xfc, 0x79, 0xb7, 0xb5, 0xe1, 0xec, 0x89,
-	0xfe, 0x25, 0xef, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x13, 0xe5, 0x27, 0xe0, 0x11, 0x0c, 0x00,
-	0x00,
+	// 1140 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x51, 0x6f, 0xdb, 0x36,
+	0x10, 0xd6, 0xc3, 0xd6, 0xd4, 0xac, 0x93, 0x38, 0x4c, 0x1a, 0xa4, 0xc2, 0x8a, 0x02, 0x02, 0x86,
+	0x0d, 0x18, 0x6a, 0xb7, 0xf6, 0x9a, 0x6d, 0x2d, 0x36, 0x2c, 0x4e, 0x66, 0xc7, 0x58, 0xea, 0xb9,
+	0x91, 0xd3, 0x01, 0x7b, 0xa3, 0xe5, 0xab, 0x23, 0x44, 0x16, 0x15, 0x92, 0x8a, 0xed, 0xdf, 0xb8,
+	0x3f, 0x35, 0x90, 0x12, 0x25, 0xca, 0x92, 0x93, 0xec, 0xcd, 0xfc, 0x78, 0xdf, 0xf1, 0xc8, 0xfb,
+	0xee, 0x4e, 0x46, 0x35, 0x16, 0x79, 0xcd, 0x88, 0x51, 0x41, 0xf1, 0xd7, 0x62, 0x15, 0x01, 0xb7,
+	0xeb, 0x1e, 0x9d, 0xcf, 0x69, 0x98, 0x80, 0xf6, 0x9e, 0x60, 0x24, 0xe4, 0xc4, 0x13, 0x7e, 0x06,
+	0x35, 0x26, 0x01, 0xf5, 0x6e, 0xbc, 0x6b, 0xe2, 0x6b, 0xa4, 0xbe, 0x20, 0x41, 0x00, 0x22, 0x5d,
+	0xd5, 0xa2, 0x76, 0x94, 0xfe, 0xdc, 0x26, 0x9e, 0x47, 0xe3, 0x50, 0xef, 0xec, 0xc0, 0x12, 0xbc,
+	0x58, 0x50, 0x96, 0xae, 0x9f, 0x4e, 0x27, 0xc9, 0xaf, 0xf6, 0xbf, 0x47, 0x68, 0x4b, 0x79, 0xec,
+	0x74, 0xf0, 0x6b, 0x54, 0xeb, 0x83, 0xe8, 0xca, 0x43, 0x38, 0x6e, 0x34, 0x55, 0x54, 0xcd, 0x4b,
+	0xb8, 0x4d, 0x10, 0xbb, 0x9e, 0x21, 0x51, 0xb0, 0x72, 0x2c, 0xdc, 0x42, 0xdb, 0x7d, 0x10, 0x17,
+	0x84, 0x8b, 0x73, 0x20, 0x53, 0x60, 0x78, 0x3b, 0xa7, 0x0c, 0xfd, 0xc0, 0xd6, 0xcb, 0x64, 0xd7,
+	0xb1, 0xf0, 0x7b, 0x74, 0x70, 0xca, 0x80, 0x08, 0xb8, 0x24, 0x8b, 0x71, 0x7e, 0x3b, 0xbc, 0x9b,
+	0x1a, 0x26, 0x9b, 0xe3, 0xa5, 0xad, 0x81, 0xab, 0x90, 0xfb, 0xb3, 0x70, 0xbc, 0x74, 0x2c, 0x7c,
+	0x86, 0x1a, 0x39, 0x77, 0xd9, 0x67, 0x34, 0x8e, 0xf0, 0xcb, 0x22, 0x2f, 0xf7, 0xa8, 0xb6, 0xab,
+	0xbc, 0xfc, 0x86, 0x1a, 0x9f, 0x62, 0x60, 0x2b, 0xf3, 0xf4, 0x9d, 0x3c, 0xea, 0x73, 0xc2, 0xaf,
+	0xed, 0xa3, 0x74, 0x6d, 0xd8, 0x9c, 0x81, 0x20, 0x7e, 0xe0, 0x58, 0xf8, 0x1d, 0xda, 0x75, 0x21,
+	0x9c, 0x9a, 0x74, 0x5c, 0x36, 0x2f, 0xbd, 0xd4, 0xaf, 0xe8, 0xa0, 0x0f, 0xc2, 0xb0, 0xe8, 0xae,
+	0x4e, 0xa6, 0x53, 0x66, 0x1e, 0x2d, 0xd7, 0xf6, 0xbe, 0xc9, 0x1b, 0x2f, 0x07, 0xe1, 0x17, 0xca,
+	0x1d, 0x0b, 0xf7, 0xd1, 0xe1, 0x3a, 0x5d, 0x46, 0x0a, 0x85, 0x24, 0x25, 0x88, 0xfd, 0x62, 0x53,
+	0xf4, 0xd2, 0xd1, 0x5b, 0x84, 0xfa, 0x20, 0x3e, 0xc2, 0x7c, 0x44, 0x69, 0xb0, 0x9e, 0x2e, 0x5c,
+	0x3c, 0xfc, 0xc2, 0xe7, 0x42, 0xdd, 0xf8, 0x59, 0x1f, 0xc4, 0x49, 0xa2, 0x26, 0xbe, 0xce, 0x79,
+	0x9e, 0x2e, 0xff, 0x56, 0x32, 0xd4, 0x56, 0x2a, 0xd5, 0x68, 0x08, 0x8b, 0x14, 0xc0, 0x07, 0x06,
+	0x2b, 0x43, 0xed, 0x83, 0x2a, 0xb2, 0x63, 0xe1, 0x4b, 0xf4, 0x3c, 0x81, 0x8c, 0x3b, 0xc8, 0x68,
+	0xf0, 0xab, 0xdc, 0x4d, 0xa5, 0x81, 0x7d, 0x58, 0xf0, 0x38, 0x5e, 0xe6, 0x37, 0xef, 0xa1, 0xed,
+	0xc1, 0x3c, 0xa2, 0x4c, 0x8c, 0x98, 0x7f, 0x77, 0x03, 0xab, 0x4c, 0x3b, 0x99, 0xaf, 0xc2, 0xf6,
+	0xc6, 0xd8, 0xba, 0x68, 0x5b, 0x09, 0x80, 0xca, 0x7c, 0x01, 0xe7, 0x65, 0x3f, 0x85, 0x6d, 0xbb,
+	0x61, 0x3e, 0xaa, 0x4c, 0x91, 0x63, 0xe1, 0x36, 0x7a, 0xea, 0xca, 0xe8, 0x7a, 0x00, 0xf8, 0xb0,
+	0x4c, 0x17, 0x3d, 0x80, 0x92, 0x82, 0x3e, 0xa0, 0x2d, 0x57, 0xd6, 0xda, 0x24, 0xc0, 0x47, 0x15,
+	0x94, 0x0b, 0x32, 0x81, 0xe0, 0x9e, 0xa0, 0xeb, 0x1f, 0x81, 0xcd, 0xa0, 0x4b, 0x02, 0x12, 0x7a,
+	0x80, 0xbf, 0x59, 0xf7, 0x60, 0xee, 0x16, 0x75, 0x90, 0xa8, 0xca, 0xb1, 0xf0, 0x31, 0xaa, 0xb9,
+	0x20, 0x46, 0x84, 0xf3, 0xc5, 0x14, 0xbf, 0xa8, 0x08, 0x21, 0xd9, 0x2a, 0x05, 0xfe, 0x2d, 0xfa,
+	0xea, 0x82, 0x7a, 0x37, 0xeb, 0xc2, 0x59, 0x37, 0x7b, 0x8d, 0x9e, 0x5c, 0x85, 0xca, 0x70, 0xbf,
+	0x70, 0x89, 0x04, 0xac, 0x68, 0x3d, 0x52, 0x95, 0x23, 0x00, 0x26, 0x6b, 0x64, 0xdd, 0xb9, 0x2e,
+	0x7c, 0xb9, 0x9f, 0xc9, 0x78, 0x27, 0xed, 0x55, 0xff, 0x4b, 0xfd, 0xc7, 0xa8, 0x2e, 0xcf, 0x61,
+	0x34, 0x02, 0x26, 0xd3, 0xb5, 0x41, 0xfe, 0x8a, 0x94, 0x59, 0x39, 0x16, 0xfe, 0x09, 0xed, 0xf6,
+	0x41, 0xa4, 0x6f, 0x23, 0x88, 0x88, 0x4b, 0x95, 0x53, 0xbc, 0x66, 0x62, 0xa3, 0xea, 0xa6, 0xa1,
+	0x5b, 0xf0, 0x5f, 0x77, 0xc0, 0xee, 0x7c, 0x58, 0x94, 0x1a, 0x94, 0x4e, 0x73, 0xc1, 0xca, 0xb1,
+	0xf0, 0xcf, 0xea, 0x50, 0xa9, 0xbc, 0x2a, 0x6a, 0xa1, 0xc1, 0x98, 0x46, 0xaa, 0x2f, 0xd4, 0xf5,
+	0xa9, 0xf2, 0x04, 0x33, 0xd6, 0x41, 0x28, 0x2a, 0x45, 0xfc, 0x16, 0x6d, 0xf5, 0x21, 0x74, 0x01,
+	0xa6, 0x59, 0x07, 0x4c, 0xd7, 0x17, 0x24, 0x9c, 0x15, 0x29, 0x12, 0xd5, 0x14, 0xb1, 0x46, 0x51,
+	0xeb, 0xee, 0x6a, 0xb4, 0xa8, 0xa4, 0xb4, 0xd0, 0x53, 0x97, 0xdc, 0x81, 0xe2, 0xe8, 0xd8, 0x35,
+	0xa0, 0x48, 0xeb, 0xc2, 0x68, 0xab, 0x0e, 0xa7, 0x85, 0xbe, 0x67, 0xcc, 0xb0, 0x54, 0xdd, 0x5a,
+	0x1b, 0x46, 0xaf, 0x6a, 0x23, 0xa4, 0x86, 0xc2, 0xa9, 0x1c, 0x83, 0x59, 0xaf, 0x52, 0xab, 0x3f,
+	0xd2, 0xb1, 0x59, 0x75, 0x8e, 0xdc, 0x4b, 0xb2, 0xf7, 0x48, 0xce, 0x31, 0xda, 0x49, 0xce, 0xa1,
+	0x21, 0x87, 0x90, 0xc7, 0xfc, 0x91, 0xbc, 0x5f, 0xd0, 0x5e, 0x69, 0xc2, 0x65, 0x57, 0xd3, 0x33,
+	0x73, 0x10, 0x56, 0xcd, 0xbb, 0x37, 0x4a, 0xf6, 0xe7, 0xb0, 0x1c, 0x2f, 0x93, 0x99, 0x51, 0x12,
+	0x53, 0x3d, 0x1b, 0xd2, 0x4b, 0xc5, 0x78, 0x87, 0x9e, 0x9d, 0xc5, 0xf3, 0x48, 0xb7, 0x49, 0x63,
+	0xc0, 0xb8, 0x82, 0xf9, 0xe1, 0xac, 0x58, 0x28, 0x09, 0xe6, 0x58, 0xb8, 0x89, 0xb6, 0x3e, 0x03,
+	0xe3, 0x32, 0xb2, 0x0d, 0x85, 0x95, 0x6e, 0xcb, 0x7a, 0x75, 0x2c, 0xfc, 0x1d, 0x7a, 0x32, 0xe0,
+	0xee, 0x2a, 0xf4, 0x1e, 0x6a, 0x0c, 0x2d, 0xb4, 0x33, 0xe0, 0x43, 0x11, 0x9d, 0x4a, 0x71, 0x3e,
+	0x86, 0xd0, 0x44, 0x5b, 0x43, 0x10, 0x55, 0x6d, 0x41, 0x47, 0x32, 0xa4, 0x53, 0x48, 0x4d, 0xd4,
+	0x13, 0xc9, 0xaa, 0xe9, 0x11, 0x41, 0x82, 0x1e, 0xf1, 0x83, 0x98, 0xc1, 0xa6, 0x13, 0x06, 0xa1,
+	0xe8, 0xb4, 0xd5, 0x13, 0x1d, 0xa4, 0xbd, 0x44, 0x55, 0x8c, 0x0b, 0xb7, 0x31, 0x48, 0xb5, 0x6d,
+	0xa6, 0x1d, 0xff, 0xe8, 0x58, 0xb8, 0x83, 0xf6, 0x94, 0xdc, 0x13, 0xeb, 0x07, 0xd2, 0xa1, 0x49,
+	0x1f, 0xf2, 0x7e, 0x70, 0xcf, 0xd0, 0xdf, 0x37, 0x3b, 0x42, 0x3e, 0xf4, 0xde, 0xa8, 0x0f, 0xb4,
+	0x94, 0xec, 0xc2, 0x2d, 0x2e, 0x78, 0xcf, 0xf4, 0xa2, 0x6f, 0xe1, 0x58, 0xf8, 0x07, 0x84, 0x4e,
+	0x03, 0xca, 0xe1, 0x53, 0x0c, 0x31, 0x3c, 0xf4, 0xd2, 0x3d, 0x75, 0xa1, 0x93, 0x20, 0x90, 0xca,
+	0xd5, 0x25, 0x67, 0x4c, 0xa7, 0xe2, 0x4e, 0xd6, 0x2c, 0x8b, 0xb0, 0xd2, 0x77, 0xcd, 0xf5, 0x67,
+	0xa1, 0xfa, 0xb0, 0xc3, 0xfb, 0x86, 0xe0, 0x34, 0x58, 0xec, 0xb3, 0x19, 0xec, 0x58, 0x78, 0x80,
+	0xec, 0xa4, 0x00, 0x86, 0x34, 0xf5, 0x57, 0xf5, 0x69, 0x96, 0x6f, 0xde, 0xe3, 0xea, 0x18, 0xd5,
+	0x55, 0x75, 0x5e, 0x92, 0x70, 0x3a, 0x8c, 0xe7, 0x38, 0xd7, 0xf9, 0xad, 0x84, 0x54, 0x76, 0xaa,
+	0x1a, 0xe1, 0xf7, 0xaa, 0xab, 0xf5, 0x28, 0x2b, 0xcc, 0xb8, 0x3f, 0x61, 0x55, 0xca, 0xe5, 0x7b,
+	0x95, 0x0e, 0x57, 0x50, 0x06, 0x23, 0x46, 0xe9, 0x17, 0xf3, 0xb3, 0x28, 0x47, 0x6d, 0x5d, 0xd9,
+	0x39, 0xe4, 0x58, 0xf8, 0x77, 0xa5, 0xd2, 0xcf, 0x24, 0x88, 0xe1, 0xdc, 0xe7, 0x82, 0xb2, 0x95,
+	0xf9, 0xe9, 0x60, 0xe2, 0x99, 0x18, 0x4c, 0x50, 0x89, 0xa1, 0x76, 0x4a, 0xe7, 0x11, 0xf1, 0xc4,
+	0x59, 0xd7, 0x7c, 0xe5, 0x0c, 0x2c, 0xe5, 0x37, 0xf9, 0x5a, 0x3c, 0xeb, 0x5e, 0x71, 0x32, 0x2b,
+	0xf4, 0xd2, 0x14, 0xb2, 0xb5, 0x78, 0xd3, 0xb5, 0x63, 0x75, 0x5f, 0xfd, 0xf3, 0x72, 0xe6, 0x8b,
+	0xeb, 0x78, 0xd2, 0xf4, 0xe8, 0xbc, 0xd5, 0xe9, 0x78, 0x61, 0x2b, 0xfd, 0x73, 0xd1, 0x52, 0xa6,
+	0x93, 0x27, 0xea, 0x5f, 0x47, 0xe7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x15, 0xfc, 0x50, 0xc2,
+	0xfe, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -208,6 +213,14 @@ type Chain33Client interface {
 	QueryRandNum(ctx context.Context, in *ReqRandHash, opts ...grpc.CallOption) (*ReplyHash, error)
 	// 获取是否达到fork高度
 	GetFork(ctx context.Context, in *ReqKey, opts ...grpc.CallOption) (*Int64, error)
+	// 获取key在某个状态下的存在或者不存在证明
+	GetStoreProof(ctx context.Context, in *ReqStoreProof, opts ...grpc.CallOption) (*StoreProof, error)
+	// 获取key在各个高度的历史值，需要开启mvcc
+	GetValueHistory(ctx context.Context, in *ReqValueHistory, opts ...grpc.CallOption) (*ValueHistory, error)
+	// 手动压缩数据库的一个范围
+	CompactDB(ctx context.Context, in *ReqCompactDB, opts ...grpc.CallOption) (*Reply, error)
+	// 统计数据库各个前缀的磁盘占用和可以回收的空间
+	GetDBUsage(ctx context.Context, in *ReqDBUsage, opts ...grpc.CallOption) (*DBUsage, error)
 }
 
 type chain33Client struct {
@@ -686,6 +699,42 @@ func (c *chain33Client) GetFork(ctx context.Context, in *ReqKey, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *chain33Client) GetStoreProof(ctx context.Context, in *ReqStoreProof, opts ...grpc.CallOption) (*StoreProof, error) {
+	out := new(StoreProof)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetStoreProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetValueHistory(ctx context.Context, in *ReqValueHistory, opts ...grpc.CallOption) (*ValueHistory, error) {
+	out := new(ValueHistory)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetValueHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) CompactDB(ctx context.Context, in *ReqCompactDB, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := c.cc.Invoke(ctx, "/types.chain33/CompactDB", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetDBUsage(ctx context.Context, in *ReqDBUsage, opts ...grpc.CallOption) (*DBUsage, error) {
+	out := new(DBUsage)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetDBUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Chain33Server is the server API for Chain33 service.
 type Chain33Server interface {
 	// chain33 对外提供服务的接口
@@ -788,6 +837,14 @@ type Chain33Server interface {
 	QueryRandNum(context.Context, *ReqRandHash) (*ReplyHash, error)
 	// 获取是否达到fork高度
 	GetFork(context.Context, *ReqKey) (*Int64, error)
+	// 获取key在某个状态下的存在或者不存在证明
+	GetStoreProof(context.Context, *ReqStoreProof) (*StoreProof, error)
+	// 获取key在各个高度的历史值，需要开启mvcc
+	GetValueHistory(context.Context, *ReqValueHistory) (*ValueHistory, error)
+	// 手动压缩数据库的一个范围
+	CompactDB(context.Context, *ReqCompactDB) (*Reply, error)
+	// 统计数据库各个前缀的磁盘占用和可以回收的空间
+	GetDBUsage(context.Context, *ReqDBUsage) (*DBUsage, error)
 }
 
 func RegisterChain33Server(s *grpc.Server, srv Chain33Server) {
@@ -1730,6 +1787,78 @@ func _Chain33_GetFork_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_GetStoreProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqStoreProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetStoreProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetStoreProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetStoreProof(ctx, req.(*ReqStoreProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetValueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqValueHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetValueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetValueHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetValueHistory(ctx, req.(*ReqValueHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_CompactDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqCompactDB)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).CompactDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/CompactDB",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).CompactDB(ctx, req.(*ReqCompactDB))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetDBUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqDBUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetDBUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetDBUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetDBUsage(ctx, req.(*ReqDBUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Chain33_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.chain33",
 	HandlerType: (*Chain33Server)(nil),
@@ -1942,6 +2071,22 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFork",
 			Handler:    _Chain33_GetFork_Handler,
 		},
+		{
+			MethodName: "GetStoreProof",
+			Handler:    _Chain33_GetStoreProof_Handler,
+		},
+		{
+			MethodName: "GetValueHistory",
+			Handler:    _Chain33_GetValueHistory_Handler,
+		},
+		{
+			MethodName: "CompactDB",
+			Handler:    _Chain33_CompactDB_Handler,
+		},
+		{
+			MethodName: "GetDBUsage",
+			Handler:    _Chain33_GetDBUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"github.com/33cn/chain33/common"
+	"golang.org/x/crypto/blake2b"
+)
+
+//StateHasher 状态树节点的hash算法，输出必须是32字节
+type StateHasher interface {
+	Name() string
+	Sum(data []byte) []byte
+}
+
+type sha256Hasher struct{}
+
+func (sha256Hasher) Name() string {
+	return "sha256"
+}
+
+func (sha256Hasher) Sum(data []byte) []byte {
+	return common.Sha256(data)
+}
+
+type blake2bHasher struct{}
+
+func (blake2bHasher) Name() string {
+	return "blake2b"
+}
+
+func (blake2bHasher) Sum(data []byte) []byte {
+	hash := blake2b.Sum256(data)
+	return hash[:]
+}
+
+var (
+	stateHashers = make(map[string]StateHasher)
+	stateHasher  StateHasher
+)
+
+func init() {
+	RegisterStateHasher(sha256Hasher{})
+	RegisterStateHasher(blake2bHasher{})
+	stateHasher = sha256Hasher{}
+}
+
+//RegisterStateHasher 注册状态树的hash算法
+func RegisterStateHasher(h StateHasher) {
+	if _, dup := stateHashers[h.Name()]; dup {
+		panic("RegisterStateHasher called twice for " + h.Name())
+	}
+	if len(h.Sum(nil)) != sha256Len {
+		panic("RegisterStateHasher hash size must be 32 bytes: " + h.Name())
+	}
+	stateHashers[h.Name()] = h
+}
+
+//SetStateHasher 设置状态树的hash算法，为空表示sha256
+func SetStateHasher(name string) error {
+	if name == "" {
+		name = "sha256"
+	}
+	h, ok := stateHashers[name]
+	if !ok {
+		return ErrNotSupport
+	}
+	stateHasher = h
+	return nil
+}
+
+//GetStateHasher 当前状态树的hash算法
+func GetStateHasher() StateHasher {
+	return stateHasher
+}
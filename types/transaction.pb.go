@@ -216,6 +216,7 @@ type AssetsTransfer struct {
 	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	Note                 []byte   `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
 	To                   string   `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	EncryptedNote        []byte   `protobuf:"bytes,5,opt,name=encryptedNote,proto3" json:"encryptedNote,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -274,6 +275,13 @@ func (m *AssetsTransfer) GetTo() string {
 	return ""
 }
 
+func (m *AssetsTransfer) GetEncryptedNote() []byte {
+	if m != nil {
+		return m.EncryptedNote
+	}
+	return nil
+}
+
 type Asset struct {
 	Exec                 string   `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
 	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
@@ -339,6 +347,7 @@ type CreateTx struct {
 	TokenSymbol          string   `protobuf:"bytes,7,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
 	ExecName             string   `protobuf:"bytes,8,opt,name=execName,proto3" json:"execName,omitempty"`
 	Execer               string   `protobuf:"bytes,9,opt,name=execer,proto3" json:"execer,omitempty"`
+	NotePubKey           string   `protobuf:"bytes,10,opt,name=notePubKey,proto3" json:"notePubKey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -432,6 +441,13 @@ func (m *CreateTx) GetExecer() string {
 	return ""
 }
 
+func (m *CreateTx) GetNotePubKey() string {
+	if m != nil {
+		return m.NotePubKey
+	}
+	return ""
+}
+
 type ReWriteRawTx struct {
 	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
 	// bytes  execer = 2;
@@ -655,10 +671,14 @@ type Transaction struct {
 	//随机ID，可以防止payload 相同的时候，交易重复
 	Nonce int64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	//对方地址，如果没有对方地址，可以为空
-	To                   string   `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
-	GroupCount           int32    `protobuf:"varint,8,opt,name=groupCount,proto3" json:"groupCount,omitempty"`
-	Header               []byte   `protobuf:"bytes,9,opt,name=header,proto3" json:"header,omitempty"`
-	Next                 []byte   `protobuf:"bytes,10,opt,name=next,proto3" json:"next,omitempty"`
+	To         string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	GroupCount int32  `protobuf:"varint,8,opt,name=groupCount,proto3" json:"groupCount,omitempty"`
+	Header     []byte `protobuf:"bytes,9,opt,name=header,proto3" json:"header,omitempty"`
+	Next       []byte `protobuf:"bytes,10,opt,name=next,proto3" json:"next,omitempty"`
+	//代付手续费账户的签名，手续费从这个账户扣除
+	FeePayer *Signature `protobuf:"bytes,11,opt,name=feePayer,proto3" json:"feePayer,omitempty"`
+	//链的标识，包含在签名的数据中，防止交易在其他链上重放
+	ChainID              int32    `protobuf:"varint,12,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -759,6 +779,20 @@ func (m *Transaction) GetNext() []byte {
 	return nil
 }
 
+func (m *Transaction) GetFeePayer() *Signature {
+	if m != nil {
+		return m.FeePayer
+	}
+	return nil
+}
+
+func (m *Transaction) GetChainID() int32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
 type Transactions struct {
 	Txs                  []*Transaction `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -900,7 +934,12 @@ type Signature struct {
 	Ty     int32  `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
 	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	//当ty为5时，格式应该用RingSignature去解析
-	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// M-of-N 多重签名，ty为MultiSigSign时pubkey和signature为空，地址由script计算
+	MultiSigScript *MultiSigScript    `protobuf:"bytes,4,opt,name=multiSigScript" json:"multiSigScript,omitempty"`
+	PartialSigs    []*MultiSigPartial `protobuf:"bytes,5,rep,name=partialSigs" json:"partialSigs,omitempty"`
+	//密钥迁移以后新的私钥代表原来的地址签名，addr为原来的地址，需要链上有迁移记录
+	Addr                 string   `protobuf:"bytes,6,opt,name=addr,proto3" json:"addr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -952,6 +991,27 @@ func (m *Signature) GetSignature() []byte {
 	return nil
 }
 
+func (m *Signature) GetMultiSigScript() *MultiSigScript {
+	if m != nil {
+		return m.MultiSigScript
+	}
+	return nil
+}
+
+func (m *Signature) GetPartialSigs() []*MultiSigPartial {
+	if m != nil {
+		return m.PartialSigs
+	}
+	return nil
+}
+
+func (m *Signature) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
 type AddrOverview struct {
 	Reciver              int64    `protobuf:"varint,1,opt,name=reciver,proto3" json:"reciver,omitempty"`
 	Balance              int64    `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
@@ -1323,7 +1383,9 @@ func (m *ReplyTxList) GetTxs() []*Transaction {
 }
 
 type ReplyProperFee struct {
-	ProperFee            int64    `protobuf:"varint,1,opt,name=properFee,proto3" json:"properFee,omitempty"`
+	ProperFee int64 `protobuf:"varint,1,opt,name=properFee,proto3" json:"properFee,omitempty"`
+	//当前mempool接受交易的最低手续费
+	MinTxFee             int64    `protobuf:"varint,2,opt,name=minTxFee,proto3" json:"minTxFee,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1361,6 +1423,13 @@ func (m *ReplyProperFee) GetProperFee() int64 {
 	return 0
 }
 
+func (m *ReplyProperFee) GetMinTxFee() int64 {
+	if m != nil {
+		return m.MinTxFee
+	}
+	return 0
+}
+
 type TxHashList struct {
 	Hashes               [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
 	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
@@ -1416,6 +1485,236 @@ func (m *TxHashList) GetExpire() []int64 {
 	return nil
 }
 
+// 请求打包区块的候选交易集合
+// 	 height : 将要打包的区块高度
+// 	 maxCount : 最多交易个数
+// 	 maxSize : 交易总大小上限(字节)
+// 	 excludes : 需要排除的交易哈希
+type ReqBlockCandidate struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	MaxCount             int64    `protobuf:"varint,2,opt,name=maxCount,proto3" json:"maxCount,omitempty"`
+	MaxSize              int64    `protobuf:"varint,3,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	Excludes             [][]byte `protobuf:"bytes,4,rep,name=excludes,proto3" json:"excludes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqBlockCandidate) Reset()         { *m = ReqBlockCandidate{} }
+func (m *ReqBlockCandidate) String() string { return proto.CompactTextString(m) }
+func (*ReqBlockCandidate) ProtoMessage()    {}
+func (*ReqBlockCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{24}
+}
+
+func (m *ReqBlockCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBlockCandidate.Unmarshal(m, b)
+}
+func (m *ReqBlockCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBlockCandidate.Marshal(b, m, deterministic)
+}
+func (m *ReqBlockCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBlockCandidate.Merge(m, src)
+}
+func (m *ReqBlockCandidate) XXX_Size() int {
+	return xxx_messageInfo_ReqBlockCandidate.Size(m)
+}
+func (m *ReqBlockCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBlockCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqBlockCandidate proto.InternalMessageInfo
+
+func (m *ReqBlockCandidate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqBlockCandidate) GetMaxCount() int64 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+func (m *ReqBlockCandidate) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *ReqBlockCandidate) GetExcludes() [][]byte {
+	if m != nil {
+		return m.Excludes
+	}
+	return nil
+}
+
+// mempool中交易变化的事件
+// 	 ty : 1 加入mempool 2 从mempool中删除
+// 	 reason : 删除的原因 1 打包 2 过期 3 替换 4 剔除
+// 	 height : 事件发生时mempool的高度
+type MempoolTxEvent struct {
+	Ty                   int32        `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
+	Reason               int32        `protobuf:"varint,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Tx                   *Transaction `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	Height               int64        `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MempoolTxEvent) Reset()         { *m = MempoolTxEvent{} }
+func (m *MempoolTxEvent) String() string { return proto.CompactTextString(m) }
+func (*MempoolTxEvent) ProtoMessage()    {}
+func (*MempoolTxEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{25}
+}
+
+func (m *MempoolTxEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolTxEvent.Unmarshal(m, b)
+}
+func (m *MempoolTxEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolTxEvent.Marshal(b, m, deterministic)
+}
+func (m *MempoolTxEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolTxEvent.Merge(m, src)
+}
+func (m *MempoolTxEvent) XXX_Size() int {
+	return xxx_messageInfo_MempoolTxEvent.Size(m)
+}
+func (m *MempoolTxEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolTxEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolTxEvent proto.InternalMessageInfo
+
+func (m *MempoolTxEvent) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *MempoolTxEvent) GetReason() int32 {
+	if m != nil {
+		return m.Reason
+	}
+	return 0
+}
+
+func (m *MempoolTxEvent) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *MempoolTxEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type MempoolTxEvents struct {
+	Events               []*MempoolTxEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MempoolTxEvents) Reset()         { *m = MempoolTxEvents{} }
+func (m *MempoolTxEvents) String() string { return proto.CompactTextString(m) }
+func (*MempoolTxEvents) ProtoMessage()    {}
+func (*MempoolTxEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{26}
+}
+
+func (m *MempoolTxEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolTxEvents.Unmarshal(m, b)
+}
+func (m *MempoolTxEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolTxEvents.Marshal(b, m, deterministic)
+}
+func (m *MempoolTxEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolTxEvents.Merge(m, src)
+}
+func (m *MempoolTxEvents) XXX_Size() int {
+	return xxx_messageInfo_MempoolTxEvents.Size(m)
+}
+func (m *MempoolTxEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolTxEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolTxEvents proto.InternalMessageInfo
+
+func (m *MempoolTxEvents) GetEvents() []*MempoolTxEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// mempool交易事件推送的回调，URL为空表示删除
+type MempoolEventCB struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	URL                  string   `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	Encode               string   `protobuf:"bytes,3,opt,name=encode,proto3" json:"encode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolEventCB) Reset()         { *m = MempoolEventCB{} }
+func (m *MempoolEventCB) String() string { return proto.CompactTextString(m) }
+func (*MempoolEventCB) ProtoMessage()    {}
+func (*MempoolEventCB) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{27}
+}
+
+func (m *MempoolEventCB) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolEventCB.Unmarshal(m, b)
+}
+func (m *MempoolEventCB) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolEventCB.Marshal(b, m, deterministic)
+}
+func (m *MempoolEventCB) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolEventCB.Merge(m, src)
+}
+func (m *MempoolEventCB) XXX_Size() int {
+	return xxx_messageInfo_MempoolEventCB.Size(m)
+}
+func (m *MempoolEventCB) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolEventCB.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolEventCB proto.InternalMessageInfo
+
+func (m *MempoolEventCB) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MempoolEventCB) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *MempoolEventCB) GetEncode() string {
+	if m != nil {
+		return m.Encode
+	}
+	return ""
+}
+
 type ReplyTxInfos struct {
 	TxInfos              []*ReplyTxInfo `protobuf:"bytes,1,rep,name=txInfos,proto3" json:"txInfos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -1427,7 +1726,7 @@ func (m *ReplyTxInfos) Reset()         { *m = ReplyTxInfos{} }
 func (m *ReplyTxInfos) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxInfos) ProtoMessage()    {}
 func (*ReplyTxInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{24}
+	return fileDescriptor_2cc4e03d2c28c490, []int{28}
 }
 
 func (m *ReplyTxInfos) XXX_Unmarshal(b []byte) error {
@@ -1467,7 +1766,7 @@ func (m *ReceiptLog) Reset()         { *m = ReceiptLog{} }
 func (m *ReceiptLog) String() string { return proto.CompactTextString(m) }
 func (*ReceiptLog) ProtoMessage()    {}
 func (*ReceiptLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{25}
+	return fileDescriptor_2cc4e03d2c28c490, []int{29}
 }
 
 func (m *ReceiptLog) XXX_Unmarshal(b []byte) error {
@@ -1518,7 +1817,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{26}
+	return fileDescriptor_2cc4e03d2c28c490, []int{30}
 }
 
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
@@ -1572,7 +1871,7 @@ func (m *ReceiptData) Reset()         { *m = ReceiptData{} }
 func (m *ReceiptData) String() string { return proto.CompactTextString(m) }
 func (*ReceiptData) ProtoMessage()    {}
 func (*ReceiptData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{27}
+	return fileDescriptor_2cc4e03d2c28c490, []int{31}
 }
 
 func (m *ReceiptData) XXX_Unmarshal(b []byte) error {
@@ -1623,7 +1922,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{28}
+	return fileDescriptor_2cc4e03d2c28c490, []int{32}
 }
 
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
@@ -1706,7 +2005,7 @@ func (m *TransactionDetail) Reset()         { *m = TransactionDetail{} }
 func (m *TransactionDetail) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetail) ProtoMessage()    {}
 func (*TransactionDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{29}
+	return fileDescriptor_2cc4e03d2c28c490, []int{33}
 }
 
 func (m *TransactionDetail) XXX_Unmarshal(b []byte) error {
@@ -1808,7 +2107,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{30}
+	return fileDescriptor_2cc4e03d2c28c490, []int{34}
 }
 
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
@@ -1847,7 +2146,7 @@ func (m *ReqAddrs) Reset()         { *m = ReqAddrs{} }
 func (m *ReqAddrs) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrs) ProtoMessage()    {}
 func (*ReqAddrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{31}
+	return fileDescriptor_2cc4e03d2c28c490, []int{35}
 }
 
 func (m *ReqAddrs) XXX_Unmarshal(b []byte) error {
@@ -1886,7 +2185,7 @@ func (m *ReqDecodeRawTransaction) Reset()         { *m = ReqDecodeRawTransaction
 func (m *ReqDecodeRawTransaction) String() string { return proto.CompactTextString(m) }
 func (*ReqDecodeRawTransaction) ProtoMessage()    {}
 func (*ReqDecodeRawTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{32}
+	return fileDescriptor_2cc4e03d2c28c490, []int{36}
 }
 
 func (m *ReqDecodeRawTransaction) XXX_Unmarshal(b []byte) error {
@@ -1926,7 +2225,7 @@ func (m *UserWrite) Reset()         { *m = UserWrite{} }
 func (m *UserWrite) String() string { return proto.CompactTextString(m) }
 func (*UserWrite) ProtoMessage()    {}
 func (*UserWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{33}
+	return fileDescriptor_2cc4e03d2c28c490, []int{37}
 }
 
 func (m *UserWrite) XXX_Unmarshal(b []byte) error {
@@ -1974,7 +2273,7 @@ func (m *UpgradeMeta) Reset()         { *m = UpgradeMeta{} }
 func (m *UpgradeMeta) String() string { return proto.CompactTextString(m) }
 func (*UpgradeMeta) ProtoMessage()    {}
 func (*UpgradeMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{34}
+	return fileDescriptor_2cc4e03d2c28c490, []int{38}
 }
 
 func (m *UpgradeMeta) XXX_Unmarshal(b []byte) error {
@@ -2016,6 +2315,254 @@ func (m *UpgradeMeta) GetHeight() int64 {
 	return 0
 }
 
+// 多重签名脚本，threshold个公钥的签名有效时交易的签名有效
+type MultiSigScript struct {
+	Threshold            int32    `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	SignTy               int32    `protobuf:"varint,2,opt,name=signTy,proto3" json:"signTy,omitempty"`
+	PubKeys              [][]byte `protobuf:"bytes,3,rep,name=pubKeys,proto3" json:"pubKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigScript) Reset()         { *m = MultiSigScript{} }
+func (m *MultiSigScript) String() string { return proto.CompactTextString(m) }
+func (*MultiSigScript) ProtoMessage()    {}
+func (*MultiSigScript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{39}
+}
+
+func (m *MultiSigScript) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigScript.Unmarshal(m, b)
+}
+func (m *MultiSigScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigScript.Marshal(b, m, deterministic)
+}
+func (m *MultiSigScript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigScript.Merge(m, src)
+}
+func (m *MultiSigScript) XXX_Size() int {
+	return xxx_messageInfo_MultiSigScript.Size(m)
+}
+func (m *MultiSigScript) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigScript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigScript proto.InternalMessageInfo
+
+func (m *MultiSigScript) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultiSigScript) GetSignTy() int32 {
+	if m != nil {
+		return m.SignTy
+	}
+	return 0
+}
+
+func (m *MultiSigScript) GetPubKeys() [][]byte {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+// 多重签名中一个公钥的签名，index是公钥在脚本中的位置
+type MultiSigPartial struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigPartial) Reset()         { *m = MultiSigPartial{} }
+func (m *MultiSigPartial) String() string { return proto.CompactTextString(m) }
+func (*MultiSigPartial) ProtoMessage()    {}
+func (*MultiSigPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{40}
+}
+
+func (m *MultiSigPartial) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigPartial.Unmarshal(m, b)
+}
+func (m *MultiSigPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigPartial.Marshal(b, m, deterministic)
+}
+func (m *MultiSigPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigPartial.Merge(m, src)
+}
+func (m *MultiSigPartial) XXX_Size() int {
+	return xxx_messageInfo_MultiSigPartial.Size(m)
+}
+func (m *MultiSigPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigPartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigPartial proto.InternalMessageInfo
+
+func (m *MultiSigPartial) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MultiSigPartial) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// 多操作交易的payload，多个执行器的操作在一个交易中原子执行，共用一个签名和手续费
+type MultiAction struct {
+	Actions              []*MultiActionItem `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *MultiAction) Reset()         { *m = MultiAction{} }
+func (m *MultiAction) String() string { return proto.CompactTextString(m) }
+func (*MultiAction) ProtoMessage()    {}
+func (*MultiAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{41}
+}
+
+func (m *MultiAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiAction.Unmarshal(m, b)
+}
+func (m *MultiAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiAction.Marshal(b, m, deterministic)
+}
+func (m *MultiAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiAction.Merge(m, src)
+}
+func (m *MultiAction) XXX_Size() int {
+	return xxx_messageInfo_MultiAction.Size(m)
+}
+func (m *MultiAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiAction proto.InternalMessageInfo
+
+func (m *MultiAction) GetActions() []*MultiActionItem {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// 多操作交易中的一个操作，execer是执行这个操作的执行器
+type MultiActionItem struct {
+	Execer               []byte   `protobuf:"bytes,1,opt,name=execer,proto3" json:"execer,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiActionItem) Reset()         { *m = MultiActionItem{} }
+func (m *MultiActionItem) String() string { return proto.CompactTextString(m) }
+func (*MultiActionItem) ProtoMessage()    {}
+func (*MultiActionItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{42}
+}
+
+func (m *MultiActionItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiActionItem.Unmarshal(m, b)
+}
+func (m *MultiActionItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiActionItem.Marshal(b, m, deterministic)
+}
+func (m *MultiActionItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiActionItem.Merge(m, src)
+}
+func (m *MultiActionItem) XXX_Size() int {
+	return xxx_messageInfo_MultiActionItem.Size(m)
+}
+func (m *MultiActionItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiActionItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiActionItem proto.InternalMessageInfo
+
+func (m *MultiActionItem) GetExecer() []byte {
+	if m != nil {
+		return m.Execer
+	}
+	return nil
+}
+
+func (m *MultiActionItem) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MultiActionItem) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+// 多操作交易的收据中每个操作的日志之前的标记
+type ReceiptMultiAction struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Execer               []byte   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptMultiAction) Reset()         { *m = ReceiptMultiAction{} }
+func (m *ReceiptMultiAction) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiAction) ProtoMessage()    {}
+func (*ReceiptMultiAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{43}
+}
+
+func (m *ReceiptMultiAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiAction.Unmarshal(m, b)
+}
+func (m *ReceiptMultiAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiAction.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiAction.Merge(m, src)
+}
+func (m *ReceiptMultiAction) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiAction.Size(m)
+}
+func (m *ReceiptMultiAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiAction proto.InternalMessageInfo
+
+func (m *ReceiptMultiAction) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReceiptMultiAction) GetExecer() []byte {
+	if m != nil {
+		return m.Execer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AssetsGenesis)(nil), "types.AssetsGenesis")
 	proto.RegisterType((*AssetsTransferToExec)(nil), "types.AssetsTransferToExec")
@@ -2041,6 +2588,10 @@ func init() {
 	proto.RegisterType((*ReplyTxList)(nil), "types.ReplyTxList")
 	proto.RegisterType((*ReplyProperFee)(nil), "types.ReplyProperFee")
 	proto.RegisterType((*TxHashList)(nil), "types.TxHashList")
+	proto.RegisterType((*ReqBlockCandidate)(nil), "types.ReqBlockCandidate")
+	proto.RegisterType((*MempoolTxEvent)(nil), "types.MempoolTxEvent")
+	proto.RegisterType((*MempoolTxEvents)(nil), "types.MempoolTxEvents")
+	proto.RegisterType((*MempoolEventCB)(nil), "types.MempoolEventCB")
 	proto.RegisterType((*ReplyTxInfos)(nil), "types.ReplyTxInfos")
 	proto.RegisterType((*ReceiptLog)(nil), "types.ReceiptLog")
 	proto.RegisterType((*Receipt)(nil), "types.Receipt")
@@ -2052,93 +2603,121 @@ func init() {
 	proto.RegisterType((*ReqDecodeRawTransaction)(nil), "types.ReqDecodeRawTransaction")
 	proto.RegisterType((*UserWrite)(nil), "types.UserWrite")
 	proto.RegisterType((*UpgradeMeta)(nil), "types.UpgradeMeta")
+	proto.RegisterType((*MultiSigScript)(nil), "types.MultiSigScript")
+	proto.RegisterType((*MultiSigPartial)(nil), "types.MultiSigPartial")
+	proto.RegisterType((*MultiAction)(nil), "types.MultiAction")
+	proto.RegisterType((*MultiActionItem)(nil), "types.MultiActionItem")
+	proto.RegisterType((*ReceiptMultiAction)(nil), "types.ReceiptMultiAction")
 }
 
 func init() { proto.RegisterFile("transaction.proto", fileDescriptor_2cc4e03d2c28c490) }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 1326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xd1, 0x6e, 0x1b, 0xb7,
-	0x12, 0x85, 0xb4, 0x5a, 0x5b, 0x1a, 0x29, 0xb9, 0xf1, 0xc2, 0x48, 0x84, 0xe0, 0xde, 0xc4, 0x97,
-	0x48, 0x81, 0x20, 0x08, 0x64, 0xc0, 0xce, 0x5b, 0x0b, 0xb4, 0x49, 0xdc, 0x26, 0x81, 0x93, 0x34,
-	0x65, 0x94, 0xa4, 0x68, 0xfb, 0x42, 0xaf, 0xc6, 0xd2, 0x36, 0xd2, 0x52, 0xe6, 0x52, 0xce, 0xea,
-	0x07, 0xfa, 0xd2, 0xbe, 0xf5, 0x93, 0xfa, 0x03, 0xfd, 0x8c, 0x7e, 0x46, 0xc1, 0x21, 0xb9, 0x4b,
-	0x59, 0x72, 0x90, 0x87, 0x02, 0x7d, 0xe3, 0x21, 0x47, 0x33, 0x67, 0xce, 0x0c, 0x67, 0x29, 0xd8,
-	0xd1, 0x4a, 0xe4, 0x85, 0x48, 0x75, 0x26, 0xf3, 0xc1, 0x5c, 0x49, 0x2d, 0x93, 0x58, 0x2f, 0xe7,
-	0x58, 0xdc, 0xec, 0xa5, 0x72, 0x36, 0xf3, 0x9b, 0xec, 0x05, 0x5c, 0x79, 0x58, 0x14, 0xa8, 0x8b,
-	0x27, 0x98, 0x63, 0x91, 0x15, 0xc9, 0x75, 0xd8, 0x12, 0x33, 0xb9, 0xc8, 0x75, 0xbf, 0xb9, 0xd7,
-	0xb8, 0x1b, 0x71, 0x87, 0x92, 0x3b, 0x70, 0x45, 0xa1, 0x5e, 0xa8, 0xfc, 0xe1, 0x68, 0xa4, 0xb0,
-	0x28, 0xfa, 0xd1, 0x5e, 0xe3, 0x6e, 0x87, 0xaf, 0x6e, 0xb2, 0xdf, 0x1a, 0xb0, 0x6b, 0xfd, 0x0d,
-	0x4d, 0xfc, 0x53, 0x54, 0x43, 0xf9, 0x75, 0x89, 0x69, 0xf2, 0x5f, 0xe8, 0xa4, 0x32, 0xcb, 0xb5,
-	0x7c, 0x8f, 0x79, 0xbf, 0x41, 0x3f, 0xad, 0x37, 0x2e, 0x0d, 0x9a, 0x40, 0x2b, 0x97, 0x1a, 0x29,
-	0x56, 0x8f, 0xd3, 0x3a, 0xb9, 0x09, 0x6d, 0x2c, 0x31, 0x7d, 0x29, 0x66, 0xd8, 0x6f, 0x91, 0xa3,
-	0x0a, 0x27, 0x57, 0xa1, 0xa9, 0x65, 0x3f, 0xa6, 0xdd, 0xa6, 0x96, 0xec, 0x97, 0x06, 0x5c, 0xb5,
-	0x74, 0xde, 0x65, 0x7a, 0x32, 0x52, 0xe2, 0xc3, 0xbf, 0x44, 0xe4, 0x67, 0xcf, 0xc3, 0xcb, 0xf2,
-	0x0f, 0xf2, 0xb0, 0xb1, 0x5a, 0x55, 0xac, 0x63, 0x88, 0x29, 0x96, 0x31, 0x36, 0x84, 0x9c, 0x77,
-	0x5a, 0x1b, 0xc7, 0xc5, 0x72, 0x76, 0x22, 0xa7, 0xe4, 0xb8, 0xc3, 0x1d, 0x0a, 0x02, 0x46, 0x61,
-	0x40, 0xf6, 0x57, 0x03, 0xda, 0x8f, 0x15, 0x0a, 0x8d, 0xc3, 0xd2, 0x45, 0x6a, 0xf8, 0x48, 0x97,
-	0xb2, 0xbc, 0x06, 0xd1, 0x29, 0xa2, 0xf3, 0x64, 0x96, 0x15, 0xef, 0x56, 0xc0, 0xfb, 0x16, 0x40,
-	0x56, 0xd5, 0x85, 0xb4, 0x6a, 0xf3, 0x60, 0x27, 0xe9, 0xc3, 0x76, 0x56, 0x0c, 0x49, 0x9f, 0x2d,
-	0x3a, 0xf4, 0x30, 0xd9, 0x83, 0x2e, 0xc9, 0xf4, 0xda, 0x66, 0xb2, 0x4d, 0x84, 0xc2, 0xad, 0x95,
-	0xda, 0xb4, 0x2f, 0xd4, 0xe6, 0x3a, 0x6c, 0x99, 0x35, 0xaa, 0x7e, 0xc7, 0x4a, 0x60, 0x11, 0xcb,
-	0xa1, 0xc7, 0xf1, 0x9d, 0xca, 0x34, 0x72, 0xf1, 0xc1, 0x65, 0x5b, 0x56, 0xd9, 0xfa, 0xec, 0xa3,
-	0x30, 0x7b, 0x2c, 0xe7, 0x99, 0xf2, 0xd5, 0x77, 0xc8, 0x67, 0x1f, 0xd7, 0xd9, 0xef, 0x42, 0x9c,
-	0xe5, 0x23, 0x2c, 0x29, 0x8f, 0x98, 0x5b, 0xc0, 0xee, 0xc1, 0x75, 0xa7, 0x6c, 0x7d, 0x55, 0x9f,
-	0x28, 0xb9, 0x98, 0x1b, 0x0f, 0xba, 0x2c, 0xfa, 0x8d, 0xbd, 0xe8, 0x6e, 0x87, 0x9b, 0x25, 0xbb,
-	0x05, 0xed, 0x37, 0x79, 0x91, 0x8d, 0xf3, 0x61, 0x69, 0xb4, 0x1c, 0x09, 0x2d, 0x88, 0x59, 0x8f,
-	0xd3, 0x9a, 0x49, 0xe8, 0xbe, 0x94, 0x8f, 0xc4, 0x54, 0xe4, 0xa9, 0x29, 0xd4, 0x2e, 0xc4, 0xba,
-	0x7c, 0x8a, 0x9e, 0xbd, 0x05, 0x46, 0xd0, 0xb9, 0x58, 0x9a, 0xab, 0xea, 0x8a, 0xef, 0x21, 0x9d,
-	0xa8, 0xec, 0xfc, 0x3d, 0x2e, 0x5d, 0x7e, 0x1e, 0x5e, 0x96, 0x24, 0xfb, 0xb5, 0x09, 0xdd, 0x80,
-	0x77, 0x20, 0xaa, 0xa5, 0xe5, 0x90, 0x8b, 0x39, 0x95, 0x62, 0x44, 0x31, 0x7b, 0xdc, 0xc3, 0x64,
-	0x00, 0x1d, 0x93, 0x90, 0xd0, 0x0b, 0x65, 0x5b, 0xa5, 0x7b, 0x70, 0x6d, 0x40, 0x23, 0x6a, 0xf0,
-	0xda, 0xef, 0xf3, 0xda, 0xc4, 0xcb, 0xda, 0xaa, 0x65, 0xad, 0xb9, 0x59, 0xad, 0x7d, 0x01, 0x76,
-	0x21, 0xce, 0x65, 0x9e, 0x22, 0xc9, 0x1d, 0x71, 0x0b, 0x5c, 0xf9, 0xb6, 0xab, 0xf2, 0xdd, 0x02,
-	0x18, 0x1b, 0xb5, 0x1f, 0x53, 0x03, 0xb7, 0xa9, 0x32, 0xc1, 0x8e, 0xf1, 0x3e, 0x41, 0x31, 0x72,
-	0x6d, 0xd2, 0xe3, 0x0e, 0x51, 0x2b, 0x63, 0xa9, 0xfb, 0xe0, 0x5a, 0x19, 0x4b, 0xcd, 0x1e, 0x40,
-	0x2f, 0x10, 0xa3, 0x48, 0xee, 0xd4, 0x05, 0xec, 0x1e, 0x24, 0x2e, 0xab, 0xc0, 0xc2, 0x16, 0xf5,
-	0x4b, 0xb8, 0xc2, 0xb3, 0x7c, 0x5c, 0x65, 0x9b, 0x0c, 0x20, 0xce, 0x34, 0xce, 0xfc, 0x0f, 0xfb,
-	0xee, 0x87, 0x2b, 0x46, 0xcf, 0x34, 0xce, 0xb8, 0x35, 0x63, 0xcf, 0x60, 0x67, 0xed, 0xcc, 0xf0,
-	0x9e, 0x2f, 0x4e, 0x4c, 0x29, 0x8d, 0x97, 0x1e, 0x77, 0xc8, 0x0c, 0x9c, 0x5a, 0xef, 0x26, 0x1d,
-	0xd5, 0x1b, 0xec, 0x3b, 0xe8, 0xd4, 0x3c, 0x8c, 0x54, 0x4b, 0x2a, 0x64, 0xcc, 0x9b, 0x7a, 0x19,
-	0xb8, 0xb4, 0x35, 0xdc, 0xe8, 0xd2, 0x8e, 0xa4, 0xc0, 0xe5, 0x4f, 0xd0, 0x33, 0xcd, 0xf5, 0xed,
-	0x39, 0xaa, 0xf3, 0x0c, 0xe9, 0x3e, 0x2b, 0x4c, 0xb3, 0x73, 0xd7, 0x23, 0x11, 0xf7, 0xd0, 0x9c,
-	0x9c, 0xd8, 0xde, 0x75, 0x83, 0xc4, 0x43, 0x73, 0xa2, 0xcb, 0xc7, 0xc1, 0x5c, 0xf2, 0x90, 0xfd,
-	0xde, 0x80, 0x6d, 0x8e, 0x67, 0xd4, 0xbe, 0x09, 0xb4, 0x84, 0xe9, 0x6a, 0x37, 0xe8, 0x84, 0xdb,
-	0x3b, 0x9d, 0x8a, 0x31, 0x39, 0x8c, 0x39, 0xad, 0x4d, 0x63, 0xa4, 0x95, 0xaf, 0x98, 0x5b, 0x60,
-	0xb2, 0x18, 0x65, 0x0a, 0xa9, 0x30, 0xd4, 0x5e, 0x31, 0xaf, 0x37, 0x6c, 0x1b, 0x64, 0xe3, 0x89,
-	0xf6, 0x4d, 0x66, 0xd1, 0xea, 0x9d, 0x8e, 0xfc, 0x9d, 0xfe, 0x1e, 0x80, 0xe3, 0xd9, 0x2b, 0x95,
-	0x9d, 0x8b, 0x74, 0x59, 0xc7, 0x6b, 0x5c, 0x1a, 0xaf, 0x79, 0x79, 0xbc, 0x28, 0x8c, 0xc7, 0x6e,
-	0x40, 0xfc, 0x14, 0xcb, 0xf5, 0xb1, 0xc4, 0x16, 0xd0, 0xe5, 0x38, 0x9f, 0x2e, 0x87, 0xe5, 0xb3,
-	0xfc, 0x54, 0x9a, 0xbc, 0x27, 0xa2, 0x98, 0xf8, 0xe9, 0x60, 0xd6, 0x81, 0xcf, 0xe6, 0xe6, 0x1c,
-	0xa2, 0x20, 0x87, 0xe4, 0x0e, 0x6c, 0x09, 0xfa, 0x56, 0xf5, 0x5b, 0xd4, 0x86, 0x3d, 0xd7, 0x86,
-	0xf4, 0x51, 0xe1, 0xee, 0x8c, 0xfd, 0x1f, 0x3a, 0x1c, 0xcf, 0x86, 0xe5, 0xf3, 0xac, 0xd0, 0xab,
-	0x89, 0x46, 0x2e, 0x51, 0x76, 0x58, 0x31, 0x23, 0xa3, 0x4f, 0xbb, 0x14, 0x03, 0xb8, 0x4a, 0x3f,
-	0x7a, 0xa5, 0xe4, 0x1c, 0xd5, 0x37, 0x88, 0x46, 0xaf, 0xb9, 0x07, 0x2e, 0x40, 0xbd, 0xc1, 0x38,
-	0xc0, 0xb0, 0x7c, 0x2a, 0x8a, 0x09, 0xc5, 0x30, 0x99, 0x8a, 0x62, 0x82, 0x85, 0x6f, 0x7e, 0x8b,
-	0x6a, 0x82, 0xcd, 0x80, 0x60, 0x30, 0x40, 0xa2, 0xbd, 0xa8, 0x1e, 0x20, 0xec, 0x0b, 0xf3, 0x25,
-	0xa8, 0x24, 0x2d, 0x92, 0xfb, 0xa6, 0x0b, 0x69, 0x79, 0x81, 0x7d, 0x60, 0xc5, 0xbd, 0x09, 0x1b,
-	0x98, 0x1e, 0x48, 0x31, 0x9b, 0xeb, 0xe7, 0x72, 0xbc, 0x76, 0x97, 0xae, 0x41, 0x34, 0x95, 0x63,
-	0x77, 0x91, 0xcc, 0x92, 0x09, 0xd3, 0xc8, 0x64, 0xbf, 0x66, 0x7c, 0x1b, 0x9a, 0xc7, 0x6f, 0xe9,
-	0xb2, 0x76, 0x0f, 0xfe, 0xe3, 0x62, 0x1e, 0xe3, 0xf2, 0xad, 0x98, 0x2e, 0x90, 0x37, 0x8f, 0xdf,
-	0x26, 0x9f, 0x41, 0x6b, 0x2a, 0xc7, 0x05, 0xf1, 0xef, 0x1e, 0xec, 0x54, 0xb4, 0x7c, 0x78, 0x4e,
-	0xc7, 0xec, 0xc8, 0x54, 0x82, 0xf6, 0x8e, 0x84, 0x16, 0x6b, 0x61, 0x3e, 0xd1, 0xcb, 0x9f, 0x0d,
-	0x68, 0x0f, 0x4b, 0x8e, 0xc5, 0x62, 0xaa, 0x83, 0x9e, 0x6a, 0x6c, 0xee, 0xa9, 0x66, 0xf0, 0xad,
-	0x4b, 0x18, 0x35, 0xad, 0x9d, 0xf2, 0x9b, 0x4a, 0x6f, 0xbe, 0xaf, 0x0f, 0xa0, 0xab, 0x6c, 0xc8,
-	0x91, 0x70, 0x4f, 0x85, 0x50, 0xe9, 0x8a, 0x3e, 0x0f, 0xcd, 0x4c, 0x77, 0x9c, 0x4c, 0x65, 0xfa,
-	0x5e, 0x67, 0x33, 0xff, 0x1d, 0xa8, 0x37, 0xcc, 0x90, 0xb7, 0x11, 0xe8, 0x25, 0xb0, 0x45, 0x97,
-	0x26, 0xd8, 0x61, 0x7f, 0x34, 0x61, 0x27, 0xe0, 0x71, 0x84, 0x5a, 0x64, 0x53, 0xc7, 0xb6, 0xf1,
-	0x51, 0xb6, 0xf7, 0x69, 0x9a, 0x19, 0x1a, 0x94, 0xe9, 0x66, 0xa6, 0xde, 0x84, 0x26, 0xa8, 0x92,
-	0xf2, 0xd4, 0x6a, 0x6c, 0x26, 0x28, 0xa1, 0x40, 0xc5, 0xd6, 0x66, 0x15, 0xe3, 0xf0, 0x66, 0xae,
-	0xe4, 0xba, 0x75, 0x31, 0xd7, 0xfa, 0x35, 0xb6, 0xbd, 0xf2, 0x1a, 0xbb, 0x09, 0xed, 0x53, 0x25,
-	0x67, 0x34, 0x21, 0xdd, 0x5b, 0xc8, 0xe3, 0x0b, 0xfa, 0x74, 0x2e, 0xea, 0x13, 0xcc, 0x02, 0xf8,
-	0xc8, 0x2c, 0xf8, 0x0a, 0x92, 0x35, 0x11, 0x8b, 0xe4, 0x5e, 0x78, 0xdf, 0xfb, 0xeb, 0x32, 0x5a,
-	0x3b, 0x7b, 0xeb, 0xf7, 0xa0, 0xed, 0x86, 0x39, 0xdd, 0x55, 0xc3, 0xcd, 0xbf, 0x7f, 0x2c, 0x60,
-	0xfb, 0x70, 0x83, 0xe3, 0xd9, 0x11, 0xa6, 0x72, 0x44, 0xef, 0xb3, 0xe0, 0xed, 0xb1, 0xf1, 0xb5,
-	0xc3, 0x3e, 0x87, 0xce, 0x9b, 0x02, 0x15, 0x3d, 0xe8, 0xc8, 0x44, 0xce, 0xb3, 0xb4, 0x32, 0x31,
-	0xc0, 0x7c, 0x5d, 0x52, 0x99, 0x6b, 0x74, 0x73, 0xa1, 0xc3, 0x3d, 0x64, 0x3f, 0x42, 0xf7, 0xcd,
-	0x7c, 0xac, 0xc4, 0x08, 0x5f, 0xa0, 0x16, 0x46, 0xc2, 0x42, 0x0b, 0xa5, 0xb3, 0x7c, 0x4c, 0x1e,
-	0xda, 0xbc, 0xc2, 0xc6, 0xc9, 0x39, 0xaa, 0xc2, 0x0f, 0xf3, 0x0e, 0xf7, 0xf0, 0xb2, 0x51, 0xfe,
-	0xe8, 0xf6, 0x0f, 0xff, 0x1b, 0x67, 0x7a, 0xb2, 0x38, 0x19, 0xa4, 0x72, 0xb6, 0x7f, 0x78, 0x98,
-	0xe6, 0xfb, 0xe9, 0x44, 0x64, 0xf9, 0xe1, 0xe1, 0x3e, 0x89, 0x74, 0xb2, 0x45, 0xff, 0xcd, 0x0e,
-	0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x05, 0xe5, 0x01, 0x1a, 0xc5, 0x0d, 0x00, 0x00,
+	// 1694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5f, 0x6f, 0x1c, 0x49,
+	0x11, 0xd7, 0xfe, 0xb3, 0x77, 0x6b, 0x37, 0x4e, 0x3c, 0x0a, 0xb9, 0x51, 0x04, 0x39, 0xd3, 0x0a,
+	0x52, 0x74, 0x0a, 0x0e, 0xb2, 0xef, 0x01, 0x09, 0xd0, 0x5d, 0x6c, 0x87, 0x4b, 0x70, 0x12, 0xac,
+	0xf6, 0x26, 0x87, 0x80, 0x07, 0xda, 0xb3, 0xe5, 0xdd, 0x56, 0x76, 0xa7, 0xc7, 0x33, 0xbd, 0xbe,
+	0x59, 0x24, 0x5e, 0x11, 0x0f, 0x3c, 0x20, 0xf1, 0x91, 0xf8, 0x02, 0x48, 0xbc, 0xf3, 0x59, 0x50,
+	0x57, 0x77, 0xcf, 0xf4, 0xac, 0xbd, 0x51, 0x84, 0x90, 0x78, 0xeb, 0x5f, 0x75, 0x6d, 0xfd, 0xf9,
+	0x75, 0x75, 0x75, 0xcd, 0xc2, 0xae, 0xce, 0x45, 0x5a, 0x88, 0x44, 0x4b, 0x95, 0xee, 0x67, 0xb9,
+	0xd2, 0x2a, 0xea, 0xe9, 0x55, 0x86, 0xc5, 0xc3, 0x51, 0xa2, 0x16, 0x0b, 0x2f, 0x64, 0x6f, 0xe0,
+	0xce, 0xf3, 0xa2, 0x40, 0x5d, 0x7c, 0x83, 0x29, 0x16, 0xb2, 0x88, 0x1e, 0xc0, 0x96, 0x58, 0xa8,
+	0x65, 0xaa, 0xe3, 0xf6, 0x5e, 0xeb, 0x49, 0x87, 0x3b, 0x14, 0x3d, 0x86, 0x3b, 0x39, 0xea, 0x65,
+	0x9e, 0x3e, 0x9f, 0x4c, 0x72, 0x2c, 0x8a, 0xb8, 0xb3, 0xd7, 0x7a, 0x32, 0xe0, 0x4d, 0x21, 0xfb,
+	0x6b, 0x0b, 0xee, 0x5b, 0x7b, 0x63, 0xe3, 0xff, 0x12, 0xf3, 0xb1, 0x7a, 0x51, 0x62, 0x12, 0x7d,
+	0x1f, 0x06, 0x89, 0x92, 0xa9, 0x56, 0x1f, 0x30, 0x8d, 0x5b, 0xf4, 0xd3, 0x5a, 0xb0, 0xd1, 0x69,
+	0x04, 0xdd, 0x54, 0x69, 0x24, 0x5f, 0x23, 0x4e, 0xeb, 0xe8, 0x21, 0xf4, 0xb1, 0xc4, 0xe4, 0xad,
+	0x58, 0x60, 0xdc, 0x25, 0x43, 0x15, 0x8e, 0x76, 0xa0, 0xad, 0x55, 0xdc, 0x23, 0x69, 0x5b, 0x2b,
+	0xf6, 0xe7, 0x16, 0xec, 0xd8, 0x70, 0xbe, 0x95, 0x7a, 0x36, 0xc9, 0xc5, 0x77, 0xff, 0xa7, 0x40,
+	0xfe, 0x56, 0x05, 0xe2, 0x79, 0xf9, 0x1f, 0x06, 0x62, 0x9d, 0x75, 0xbd, 0x33, 0x73, 0x54, 0x98,
+	0x26, 0xf9, 0x2a, 0xd3, 0x38, 0x79, 0x6b, 0x94, 0x7b, 0xa4, 0xdc, 0x14, 0xb2, 0x53, 0xe8, 0x51,
+	0x44, 0xc6, 0xa4, 0x89, 0xdb, 0xc5, 0x40, 0x6b, 0xe3, 0xbe, 0x58, 0x2d, 0x2e, 0xd4, 0x9c, 0xdc,
+	0x0f, 0xb8, 0x43, 0x41, 0x58, 0x9d, 0x30, 0x2c, 0xf6, 0x97, 0x36, 0xf4, 0x8f, 0x73, 0x14, 0x1a,
+	0xc7, 0xa5, 0x8b, 0xa7, 0x55, 0xc5, 0xb3, 0x29, 0x97, 0x7b, 0xd0, 0xb9, 0x44, 0x74, 0x96, 0xcc,
+	0xb2, 0xca, 0xae, 0x1b, 0x64, 0xf7, 0x08, 0x40, 0x56, 0xc7, 0x47, 0xa9, 0xf4, 0x79, 0x20, 0x89,
+	0x62, 0xd8, 0x96, 0xc5, 0x98, 0x58, 0xdc, 0xa2, 0x4d, 0x0f, 0xa3, 0x3d, 0x18, 0x12, 0x99, 0xe7,
+	0x36, 0x93, 0x6d, 0x0a, 0x28, 0x14, 0x35, 0x8e, 0xb0, 0xbf, 0x76, 0x84, 0x0f, 0x60, 0xcb, 0xac,
+	0x31, 0x8f, 0x07, 0x96, 0x02, 0x8b, 0x4c, 0x3c, 0x26, 0xae, 0xb3, 0xe5, 0xc5, 0x29, 0xae, 0x62,
+	0xa0, 0xbd, 0x40, 0xc2, 0x52, 0x18, 0x71, 0xfc, 0x36, 0x97, 0x1a, 0xb9, 0xf8, 0xce, 0xb1, 0x51,
+	0x56, 0x6c, 0x78, 0x76, 0x3a, 0x21, 0x3b, 0x58, 0x66, 0x32, 0xf7, 0x45, 0xe4, 0x90, 0x67, 0xa7,
+	0x57, 0xb3, 0x73, 0x1f, 0x7a, 0x32, 0x9d, 0x60, 0x49, 0x79, 0xf6, 0xb8, 0x05, 0xec, 0x0b, 0x78,
+	0xe0, 0x98, 0xaf, 0x6f, 0xfc, 0x37, 0xb9, 0x5a, 0x66, 0xc6, 0x82, 0x2e, 0x8b, 0xb8, 0xb5, 0xd7,
+	0x79, 0x32, 0xe0, 0x66, 0xc9, 0x1e, 0x41, 0xff, 0x5d, 0x5a, 0xc8, 0x69, 0x3a, 0x2e, 0x0d, 0xd7,
+	0x13, 0xa1, 0x05, 0x45, 0x36, 0xe2, 0xb4, 0x66, 0x0a, 0x86, 0x6f, 0xd5, 0x91, 0x98, 0x8b, 0x34,
+	0x31, 0x07, 0x79, 0x1f, 0x7a, 0xba, 0x7c, 0x89, 0x3e, 0x7a, 0x0b, 0x0c, 0xe1, 0x99, 0x58, 0x99,
+	0x1b, 0xef, 0x8a, 0xc3, 0x43, 0xda, 0xc9, 0xe5, 0xf5, 0x07, 0x5c, 0xb9, 0xfc, 0x3c, 0xdc, 0x94,
+	0x24, 0xfb, 0x57, 0x1b, 0x86, 0x41, 0xdc, 0x01, 0xe9, 0x36, 0x2c, 0x87, 0x9c, 0xcf, 0xb9, 0x12,
+	0x13, 0xf2, 0x39, 0xe2, 0x1e, 0x46, 0xfb, 0x30, 0x30, 0x09, 0x09, 0xbd, 0xcc, 0x6d, 0x29, 0x0d,
+	0x0f, 0xee, 0xed, 0x53, 0xa7, 0xdb, 0x3f, 0xf7, 0x72, 0x5e, 0xab, 0x78, 0x5a, 0xbb, 0x35, 0xad,
+	0x75, 0x6c, 0x96, 0x6b, 0x87, 0x4c, 0xf6, 0xa9, 0x4a, 0x13, 0x24, 0xba, 0x3b, 0xdc, 0x02, 0x77,
+	0x7c, 0xdb, 0xd5, 0xf1, 0x3d, 0x02, 0x98, 0x1a, 0xb6, 0x8f, 0xa9, 0xc0, 0xfb, 0x74, 0x32, 0x81,
+	0xc4, 0x58, 0x9f, 0xa1, 0x98, 0xb8, 0x32, 0x1a, 0x71, 0x87, 0xa8, 0xd4, 0xb1, 0xd4, 0x31, 0xb8,
+	0x52, 0xc7, 0x52, 0x47, 0x4f, 0xa1, 0x7f, 0x89, 0x78, 0x26, 0x56, 0x98, 0xc7, 0xc3, 0x0d, 0xa9,
+	0x54, 0x1a, 0x86, 0x93, 0x64, 0x26, 0x64, 0xfa, 0xea, 0x24, 0x1e, 0x91, 0x5b, 0x0f, 0xd9, 0x97,
+	0x30, 0x0a, 0x48, 0x2d, 0xa2, 0xc7, 0x75, 0x21, 0x0c, 0x0f, 0x22, 0x67, 0x32, 0xd0, 0xb0, 0xc5,
+	0xf1, 0x15, 0xdc, 0xe1, 0x32, 0x9d, 0x56, 0xae, 0xa2, 0x7d, 0xe8, 0x49, 0x8d, 0x0b, 0xff, 0xc3,
+	0xd8, 0xfd, 0xb0, 0xa1, 0xf4, 0x4a, 0xe3, 0x82, 0x5b, 0x35, 0xf6, 0x0a, 0x76, 0x6f, 0xec, 0x99,
+	0xfc, 0xb3, 0xe5, 0x85, 0x29, 0x09, 0x63, 0x65, 0xc4, 0x1d, 0x32, 0xed, 0xaf, 0x3e, 0xb7, 0x36,
+	0x6d, 0xd5, 0x02, 0xf6, 0xef, 0x16, 0x0c, 0xea, 0x40, 0x0c, 0xe7, 0x2b, 0xaa, 0x88, 0x1e, 0x6f,
+	0xeb, 0x55, 0x60, 0xd3, 0x16, 0xc3, 0xad, 0x36, 0x6d, 0x87, 0xac, 0x05, 0xd1, 0x2f, 0x60, 0x67,
+	0xb1, 0x9c, 0x6b, 0x79, 0x2e, 0xa7, 0xe7, 0x49, 0x2e, 0x33, 0x4d, 0x45, 0x30, 0x3c, 0xf8, 0x9e,
+	0xcb, 0xeb, 0x4d, 0x63, 0x93, 0xaf, 0x29, 0x47, 0x3f, 0x85, 0x61, 0x26, 0x72, 0x2d, 0xc5, 0xfc,
+	0x5c, 0x4e, 0x8b, 0xb8, 0x47, 0x9c, 0x3c, 0x58, 0xfb, 0xed, 0x99, 0xd5, 0xe0, 0xa1, 0xaa, 0x39,
+	0x6a, 0x61, 0x6e, 0xcb, 0x96, 0x6d, 0xb0, 0x66, 0xcd, 0x7e, 0x0f, 0x23, 0x73, 0x65, 0x7e, 0x7d,
+	0x8d, 0xf9, 0xb5, 0x44, 0xea, 0x62, 0x39, 0x26, 0xf2, 0xda, 0x55, 0x7e, 0x87, 0x7b, 0x68, 0x76,
+	0x2e, 0xec, 0x8d, 0x74, 0xed, 0xd3, 0x43, 0xb3, 0xa3, 0xcb, 0xe3, 0xa0, 0x1b, 0x7b, 0xc8, 0xfe,
+	0xde, 0x82, 0x6d, 0x8e, 0x57, 0x74, 0x29, 0xbd, 0xf7, 0x56, 0xed, 0xdd, 0xc8, 0x2e, 0xe7, 0x62,
+	0x4a, 0x06, 0x7b, 0x9c, 0xd6, 0xa6, 0xdc, 0x93, 0xca, 0x56, 0x8f, 0x5b, 0x60, 0x28, 0x9d, 0xc8,
+	0x1c, 0xa9, 0x4c, 0x88, 0xaf, 0x1e, 0xaf, 0x05, 0xb6, 0xb8, 0xe5, 0x74, 0xa6, 0xfd, 0xd5, 0xb1,
+	0xa8, 0xd9, 0xa9, 0x3a, 0xbe, 0x53, 0xfd, 0x06, 0x80, 0xe3, 0xd5, 0x59, 0x2e, 0xaf, 0x45, 0xb2,
+	0xaa, 0xfd, 0xb5, 0x36, 0xfa, 0x6b, 0x6f, 0xf6, 0xd7, 0x09, 0xfd, 0xb1, 0xcf, 0xa0, 0xf7, 0x12,
+	0xcb, 0x9b, 0xcd, 0x96, 0x2d, 0x61, 0xc8, 0x31, 0x9b, 0xaf, 0xc6, 0xe5, 0xab, 0xf4, 0x52, 0x99,
+	0xbc, 0x67, 0xa2, 0x98, 0xf9, 0x9e, 0x67, 0xd6, 0x81, 0xcd, 0xf6, 0xed, 0x39, 0x74, 0x82, 0x1c,
+	0xa2, 0xc7, 0xb0, 0x25, 0xe8, 0x1d, 0x8f, 0xbb, 0x54, 0x00, 0x23, 0x57, 0x00, 0xf4, 0x94, 0x72,
+	0xb7, 0xc7, 0x7e, 0x08, 0x03, 0x8e, 0x57, 0xe3, 0xf2, 0xb5, 0x2c, 0x74, 0x33, 0xd1, 0x8e, 0x4b,
+	0x94, 0x1d, 0x56, 0x91, 0x91, 0xd2, 0xa7, 0x5d, 0xd1, 0x5f, 0xc1, 0x0e, 0xfd, 0xe8, 0x2c, 0x57,
+	0x19, 0xe6, 0xbf, 0x44, 0x34, 0x7c, 0x65, 0x1e, 0x38, 0x07, 0xb5, 0xc0, 0xbc, 0x6f, 0x0b, 0x99,
+	0x8e, 0x4b, 0xb3, 0x69, 0xb3, 0xab, 0x30, 0xe3, 0x00, 0xe3, 0xf2, 0xa5, 0x28, 0x66, 0xe4, 0xdf,
+	0xb0, 0x20, 0x8a, 0x19, 0x16, 0xfe, 0x9a, 0x5a, 0x54, 0x07, 0xdf, 0x0e, 0x82, 0x0f, 0x5a, 0x66,
+	0x67, 0xaf, 0x53, 0xb7, 0x4c, 0xf6, 0x27, 0xd8, 0xe5, 0x78, 0x75, 0x34, 0x57, 0xc9, 0x87, 0x63,
+	0x91, 0x4e, 0xe4, 0x44, 0x68, 0x0c, 0x08, 0x6e, 0x35, 0x08, 0x36, 0xc1, 0x89, 0xf2, 0x38, 0xb0,
+	0x5e, 0x61, 0x53, 0xda, 0x0b, 0x51, 0x9e, 0xcb, 0x3f, 0xfa, 0xf1, 0xc0, 0x43, 0xfb, 0x64, 0x27,
+	0xf3, 0xe5, 0x04, 0xed, 0x11, 0x8c, 0x78, 0x85, 0x99, 0x86, 0x9d, 0x37, 0xb8, 0xc8, 0x94, 0x9a,
+	0x8f, 0xcb, 0x17, 0xd7, 0x98, 0xea, 0xdb, 0x3a, 0x47, 0x8e, 0xa2, 0xa8, 0x6a, 0xcb, 0xa1, 0x88,
+	0x51, 0xdd, 0xd8, 0xe7, 0xe3, 0x36, 0xf6, 0xcd, 0xc3, 0x5d, 0xe7, 0xd1, 0x6d, 0x14, 0xdf, 0xd7,
+	0x70, 0xb7, 0xe9, 0xb5, 0x88, 0x7e, 0x0c, 0x5b, 0x48, 0x2b, 0x77, 0xa0, 0x55, 0x8b, 0x69, 0xe8,
+	0x71, 0xa7, 0xc4, 0xde, 0x56, 0x71, 0x93, 0xfc, 0xf8, 0x88, 0x5e, 0x07, 0x33, 0x94, 0xb8, 0x4b,
+	0x6b, 0xd6, 0xe6, 0xe5, 0x7a, 0xc7, 0x5f, 0xbb, 0x37, 0xd7, 0x2c, 0xe9, 0x18, 0xd2, 0x44, 0x4d,
+	0xd0, 0x3d, 0xb7, 0x0e, 0xb1, 0x9f, 0xc3, 0xc8, 0xd5, 0x96, 0xa9, 0xfa, 0x22, 0x7a, 0x6a, 0x1a,
+	0x05, 0x2d, 0xd7, 0x0a, 0x2c, 0xd0, 0xe2, 0x5e, 0x85, 0xed, 0x9b, 0x6b, 0x9a, 0xa0, 0xcc, 0xf4,
+	0x6b, 0x35, 0xbd, 0xc1, 0xe0, 0x3d, 0xe8, 0xcc, 0xd5, 0xd4, 0x35, 0x5e, 0xb3, 0x64, 0x02, 0xb6,
+	0x9d, 0xfe, 0x0d, 0xe5, 0xcf, 0xa1, 0x7d, 0xfa, 0x9e, 0xba, 0xfb, 0xf0, 0xe0, 0xae, 0xf3, 0x79,
+	0x8a, 0xab, 0xf7, 0x62, 0xbe, 0x44, 0xde, 0x3e, 0x7d, 0x1f, 0xfd, 0x08, 0xba, 0x73, 0x35, 0x2d,
+	0xa8, 0x8c, 0x86, 0x07, 0xbb, 0x55, 0x58, 0xde, 0x3d, 0xa7, 0x6d, 0x76, 0x02, 0x43, 0x27, 0x3b,
+	0x11, 0x5a, 0xdc, 0x70, 0xf3, 0x89, 0x56, 0xfe, 0xd9, 0x82, 0xfe, 0xb8, 0xe4, 0x58, 0x2c, 0xe7,
+	0x7a, 0x63, 0x55, 0x56, 0xd7, 0xbe, 0x1d, 0x0c, 0x59, 0x9f, 0x54, 0x1f, 0x5f, 0xc2, 0x30, 0xb7,
+	0x2e, 0x4d, 0xd9, 0xc7, 0xdd, 0x86, 0x72, 0x10, 0x3e, 0x0f, 0xd5, 0xcc, 0x05, 0xbe, 0x30, 0xf7,
+	0x45, 0xcb, 0x85, 0x1f, 0x40, 0x6a, 0x81, 0x99, 0x2e, 0xac, 0x07, 0x1a, 0x51, 0xed, 0x03, 0x12,
+	0x48, 0xd8, 0x3f, 0xda, 0xb0, 0x1b, 0xc4, 0x71, 0x82, 0x5a, 0xc8, 0xb9, 0x8b, 0xb6, 0xf5, 0xd1,
+	0x68, 0x9f, 0xc2, 0xb6, 0x0b, 0x23, 0x6e, 0x37, 0x14, 0xc3, 0x48, 0xbd, 0x0a, 0xbd, 0xb8, 0xb9,
+	0x52, 0x97, 0x96, 0xe3, 0x11, 0x77, 0x68, 0xd3, 0x9d, 0xa8, 0x59, 0xec, 0x85, 0xcd, 0xb3, 0x91,
+	0xeb, 0xd6, 0x7a, 0xae, 0xf5, 0x67, 0xc2, 0x76, 0xe3, 0x33, 0xe1, 0x21, 0xf4, 0x2f, 0x73, 0xb5,
+	0xa0, 0x47, 0xcc, 0x0d, 0xe9, 0x1e, 0xaf, 0xf1, 0x33, 0x58, 0xe7, 0x27, 0x68, 0xd7, 0xf0, 0x91,
+	0x76, 0xfd, 0x35, 0x44, 0x37, 0x48, 0x2c, 0xa2, 0x2f, 0xc2, 0x96, 0x1c, 0xdf, 0xa4, 0xd1, 0xea,
+	0xd9, 0xc6, 0xbc, 0x07, 0x7d, 0xf7, 0xde, 0x52, 0xcb, 0x34, 0xb1, 0xf9, 0xc1, 0xdb, 0x02, 0xf6,
+	0x0c, 0x3e, 0xe3, 0x78, 0x75, 0x82, 0xe6, 0x82, 0x9a, 0x0f, 0x83, 0xda, 0xce, 0xed, 0x63, 0x36,
+	0xfb, 0x19, 0x0c, 0xde, 0x15, 0x98, 0xd3, 0x97, 0x04, 0xa9, 0xa8, 0x4c, 0x26, 0x95, 0x8a, 0x01,
+	0x34, 0x01, 0xaa, 0x54, 0xa3, 0x6b, 0xa0, 0x03, 0xee, 0x21, 0xfb, 0x1d, 0x0c, 0xdf, 0x65, 0xd3,
+	0x5c, 0x4c, 0xf0, 0x0d, 0x6a, 0x61, 0x28, 0x2c, 0xb4, 0x99, 0x48, 0xd2, 0x29, 0x59, 0xe8, 0xf3,
+	0x0a, 0x1b, 0x23, 0xd7, 0x98, 0x17, 0xfe, 0xbd, 0x1d, 0x70, 0x0f, 0x37, 0xbe, 0xb6, 0x7f, 0x80,
+	0x9d, 0xe6, 0xac, 0x64, 0x0e, 0x56, 0xcf, 0x72, 0x2c, 0x66, 0x6a, 0x3e, 0x71, 0xf7, 0xb2, 0x16,
+	0x18, 0x3b, 0xf4, 0xcd, 0xb1, 0xf2, 0x4d, 0xd7, 0x22, 0x1a, 0xea, 0xe9, 0x9b, 0xc9, 0x57, 0x95,
+	0x87, 0xec, 0x05, 0xdc, 0x5d, 0x9b, 0xa8, 0xea, 0x8a, 0x6a, 0x85, 0xf7, 0x72, 0x6d, 0x8a, 0x6c,
+	0x4e, 0x7c, 0xec, 0x2b, 0x18, 0x92, 0x99, 0xe7, 0x96, 0xe7, 0x9f, 0xc0, 0xb6, 0x9b, 0x88, 0xe3,
+	0xd6, 0xcd, 0xe9, 0xcd, 0x2a, 0xd1, 0x3c, 0xeb, 0xd5, 0xd8, 0x39, 0xdc, 0x5d, 0xdb, 0xfb, 0x2f,
+	0xbe, 0x50, 0xd6, 0x3e, 0xf8, 0xd8, 0x11, 0x44, 0xee, 0x8e, 0x85, 0xc1, 0xdd, 0x9e, 0x5f, 0xed,
+	0xad, 0x1d, 0x7a, 0x3b, 0xfa, 0xfc, 0xb7, 0x3f, 0x98, 0x4a, 0x3d, 0x5b, 0x5e, 0xec, 0x27, 0x6a,
+	0xf1, 0xec, 0xf0, 0x30, 0x49, 0x9f, 0xd1, 0xf0, 0x7f, 0x78, 0xf8, 0x8c, 0x52, 0xba, 0xd8, 0xa2,
+	0xbf, 0x77, 0x0e, 0xff, 0x33, 0x00, 0x87, 0x5e, 0x51, 0xae, 0x08, 0x12, 0x00, 0x00,
 }
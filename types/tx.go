@@ -15,7 +15,6 @@ import (
 
 	"strconv"
 
-	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 )
@@ -25,6 +24,8 @@ var (
 	bToken   = []byte("token")
 	withdraw = "withdraw"
 	txCache  *lru.Cache
+	//recoverCache 从签名中恢复的公钥，避免计算交易from地址时重复恢复
+	recoverCache *lru.Cache
 )
 
 func init() {
@@ -33,6 +34,10 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	recoverCache, err = lru.New(10240)
+	if err != nil {
+		panic(err)
+	}
 }
 
 //TxCacheGet 某些交易的cache 加入缓存中，防止重复进行解析或者计算
@@ -97,6 +102,36 @@ func CreateTxGroup(txs []*Transaction) (*Transactions, error) {
 	return txgroup, nil
 }
 
+//CreateMultiActionTx 多个执行器的操作打包在一笔交易中，执行器按顺序原子执行，任何一个操作失败所有的操作都会回滚。
+//只需要签名一次，手续费由这笔交易支付，fee 小于需要的最低手续费的时候使用最低手续费
+func CreateMultiActionTx(txs []*Transaction, fee int64, expire time.Duration) (*Transaction, error) {
+	if len(txs) == 0 || len(txs) > int(MaxTxGroupSize) {
+		return nil, ErrMultiActionCount
+	}
+	multi := &MultiAction{}
+	for _, tx := range txs {
+		if tx == nil {
+			return nil, ErrTxGroupEmpty
+		}
+		if tx.IsMultiAction() {
+			return nil, ErrMultiActionNested
+		}
+		multi.Actions = append(multi.Actions, &MultiActionItem{Execer: tx.Execer, Payload: tx.Payload, To: tx.To})
+	}
+	tx := &Transaction{Payload: Encode(multi)}
+	if expire != 0 {
+		tx.SetExpire(expire)
+	}
+	tx, err := FormatTx(ExecName(MultiActionX), tx)
+	if err != nil {
+		return nil, err
+	}
+	if fee > tx.Fee {
+		tx.Fee = fee
+	}
+	return tx, nil
+}
+
 //Tx 这比用于检查的交易，包含了所有的交易。
 //主要是为了兼容原来的设计
 func (txgroup *Transactions) Tx() *Transaction {
@@ -126,15 +161,9 @@ func (txgroup *Transactions) SignN(n int, ty int32, priv crypto.PrivKey) error {
 	return nil
 }
 
-//CheckSign 检测交易组的签名
+//CheckSign 检测交易组的签名，组内的签名批量验证
 func (txgroup *Transactions) CheckSign() bool {
-	txs := txgroup.Txs
-	for i := 0; i < len(txs); i++ {
-		if !txs[i].checkSign() {
-			return false
-		}
-	}
-	return true
+	return CheckTxsSign(txgroup.Txs)
 }
 
 //RebuiltGroup 交易内容有变化时需要重新构建交易组
@@ -213,6 +242,20 @@ func (txgroup *Transactions) Check(height, minfee, maxFee int64) error {
 			return ErrTxGroupFeeNotZero
 		}
 	}
+	//交易组的手续费由第一笔交易支付，不支持代付手续费
+	for i := 0; i < len(txs); i++ {
+		if txs[i].FeePayer != nil {
+			return ErrFeePayerInGroup
+		}
+	}
+	//多操作交易本身就是原子执行的，不能再放到交易组中
+	if IsFork(height, "ForkMultiAction") {
+		for i := 0; i < len(txs); i++ {
+			if txs[i].IsMultiAction() {
+				return ErrTxGroupNotSupport
+			}
+		}
+	}
 	//检查txs[0] 的费用是否满足要求
 	totalfee := int64(0)
 	for i := 0; i < len(txs); i++ {
@@ -396,9 +439,10 @@ func CacheToTxs(caches []*TransactionCache) (txs []*Transaction) {
 //HashSign hash 不包含签名，用户通过修改签名无法重新发送交易
 func (tx *Transaction) HashSign() []byte {
 	copytx := *tx
-	copytx.Signature = nil
+	copytx.Signature = signAddr(tx.Signature)
+	copytx.FeePayer = feePayerKey(tx.FeePayer)
 	data := Encode(&copytx)
-	return common.Sha256(data)
+	return ChainHash(data)
 }
 
 //Tx 交易详情
@@ -428,10 +472,11 @@ func (tx *Transaction) GetTxGroup() (*Transactions, error) {
 //Hash 交易的hash不包含header的值，引入tx group的概念后，做了修改
 func (tx *Transaction) Hash() []byte {
 	copytx := clone(tx)
-	copytx.Signature = nil
+	copytx.Signature = signAddr(tx.Signature)
+	copytx.FeePayer = feePayerKey(tx.FeePayer)
 	copytx.Header = nil
 	data := Encode(copytx)
-	return common.Sha256(data)
+	return ChainHash(data)
 }
 
 //clone copytx := proto.Clone(tx).(*Transaction) too slow
@@ -447,6 +492,8 @@ func clone(tx *Transaction) *Transaction {
 	copytx.GroupCount = tx.GroupCount
 	copytx.Header = tx.Header
 	copytx.Next = tx.Next
+	copytx.FeePayer = tx.FeePayer
+	copytx.ChainID = tx.ChainID
 	return copytx
 }
 
@@ -455,16 +502,91 @@ func (tx *Transaction) Size() int {
 	return Size(tx)
 }
 
-//Sign 交易签名
+//Sign 交易签名，代付手续费的签名包含了发送者的签名，重新签名以后需要代付账户重新签名
 func (tx *Transaction) Sign(ty int32, priv crypto.PrivKey) {
-	tx.Signature = nil
-	data := Encode(tx)
+	tx.SignWithAddr(ty, priv, "")
+}
+
+//SignWithAddr 密钥迁移以后用新私钥代表原来的地址签名，addr在签名的数据中，不能被其他人修改
+func (tx *Transaction) SignWithAddr(ty int32, priv crypto.PrivKey, addr string) {
+	tx.ResetFeePayer()
+	tx.Signature = signAddr(&Signature{Addr: addr})
+	data := tx.SignData()
 	pub := priv.PubKey()
 	sign := priv.Sign(data)
 	tx.Signature = &Signature{
 		Ty:        ty,
 		Pubkey:    pub.Bytes(),
 		Signature: sign.Bytes(),
+		Addr:      addr,
+	}
+}
+
+//signAddr 发送者签名的数据和交易的hash包含签名中的原地址，不包含签名本身，
+//原地址不能被其他人去掉或者替换
+func signAddr(sign *Signature) *Signature {
+	if sign.GetAddr() == "" {
+		return nil
+	}
+	return &Signature{Addr: sign.Addr}
+}
+
+//SignRecoverable 交易签名中不带公钥，公钥从签名中恢复，只有支持恢复公钥的签名算法可以使用
+func (tx *Transaction) SignRecoverable(ty int32, priv crypto.PrivKey) error {
+	c, err := crypto.New(GetSignName(string(tx.Execer), int(ty)))
+	if err != nil {
+		return err
+	}
+	tx.ResetFeePayer()
+	sign, err := crypto.SignRecoverable(c, priv, tx.SignData())
+	if err != nil {
+		return err
+	}
+	tx.Signature = &Signature{
+		Ty:        ty,
+		Signature: sign,
+	}
+	return nil
+}
+
+//SetFeePayer 指定代付手续费账户的公钥，发送者签名的数据包含这个公钥，所以必须在发送者签名之前指定
+func (tx *Transaction) SetFeePayer(pubkey []byte) {
+	tx.Signature = nil
+	tx.FeePayer = &Signature{Pubkey: pubkey}
+}
+
+//ResetFeePayer 去掉代付账户的签名，保留发送者指定的代付账户，发送者重新签名以后代付账户需要重新签名
+func (tx *Transaction) ResetFeePayer() {
+	tx.Signature = nil
+	tx.FeePayer = feePayerKey(tx.FeePayer)
+}
+
+//feePayerKey 发送者签名的数据和交易的hash只包含代付账户的公钥，不包含代付账户的签名，
+//代付账户不能被其他人去掉或者替换
+func feePayerKey(payer *Signature) *Signature {
+	if payer == nil {
+		return nil
+	}
+	return &Signature{Pubkey: payer.Pubkey, MultiSigScript: payer.MultiSigScript}
+}
+
+//feePayerData 代付账户签名的数据，包含发送者的签名
+func (tx *Transaction) feePayerData() []byte {
+	copytx := *tx
+	copytx.FeePayer = feePayerKey(tx.FeePayer)
+	return Encode(&copytx)
+}
+
+//SignFeePayer 代付手续费账户签名，签名的数据包含发送者的签名，所以必须在发送者签名之后签名，
+//代付账户必须是发送者签名时指定的账户
+func (tx *Transaction) SignFeePayer(ty int32, priv crypto.PrivKey) {
+	pub := priv.PubKey()
+	tx.FeePayer = &Signature{Pubkey: pub.Bytes()}
+	sign := priv.Sign(tx.feePayerData())
+	tx.FeePayer = &Signature{
+		Ty:        ty,
+		Pubkey:    pub.Bytes(),
+		Signature: sign.Bytes(),
 	}
 }
 
@@ -475,13 +597,21 @@ func (tx *Transaction) CheckSign() bool {
 
 //txgroup 的情况
 func (tx *Transaction) checkSign() bool {
-	copytx := *tx
-	copytx.Signature = nil
-	data := Encode(&copytx)
 	if tx.GetSignature() == nil {
 		return false
 	}
-	return CheckSign(data, string(tx.Execer), tx.GetSignature())
+	if !CheckSign(tx.SignData(), string(tx.Execer), tx.GetSignature()) {
+		return false
+	}
+	payer := tx.GetFeePayer()
+	if payer == nil {
+		return true
+	}
+	//代付账户的公钥必须在发送者签名的数据中，不能从签名中恢复
+	if len(payer.Pubkey) == 0 && payer.MultiSigScript == nil {
+		return false
+	}
+	return CheckSign(tx.feePayerData(), string(tx.Execer), payer)
 }
 
 //Check 交易检测
@@ -501,6 +631,18 @@ func (tx *Transaction) check(height, minfee, maxFee int64) error {
 	if txSize > int(MaxTxSize) {
 		return ErrTxMsgSizeTooBig
 	}
+	if err := tx.CheckChainID(height); err != nil {
+		return err
+	}
+	//分叉之前签名中不能指定密钥迁移的原地址
+	if tx.GetSignature().GetAddr() != "" && !IsFork(height, "ForkKeyMigration") {
+		return ErrSignAddrNotAllow
+	}
+	if tx.IsMultiAction() && IsFork(height, "ForkMultiAction") {
+		if _, err := tx.GetMultiActionTxs(); err != nil {
+			return err
+		}
+	}
 	if minfee == 0 {
 		return nil
 	}
@@ -515,6 +657,17 @@ func (tx *Transaction) check(height, minfee, maxFee int64) error {
 	return nil
 }
 
+//CheckChainID 检查交易的链标识，分叉之后必须和本链的标识相同，分叉之前允许不设置
+func (tx *Transaction) CheckChainID(height int64) error {
+	if tx.ChainID == GetChainID() {
+		return nil
+	}
+	if tx.ChainID == 0 && !IsFork(height, "ForkTxChainID") {
+		return nil
+	}
+	return ErrTxChainID
+}
+
 //SetExpire 设置交易过期时间
 func (tx *Transaction) SetExpire(expire time.Duration) {
 	//Txheight处理
@@ -582,12 +735,41 @@ func (tx *Transaction) GetTxFee() int64 {
 	return group.Txs[0].Fee
 }
 
-//From 交易from地址
+//From 交易from地址，密钥迁移以后用新私钥签名的交易是签名中的原地址
 func (tx *Transaction) From() string {
-	return address.PubKeyToAddr(tx.GetSignature().GetPubkey())
+	if addr := tx.GetSignature().GetAddr(); addr != "" {
+		return addr
+	}
+	return address.PubKeyToAddr(tx.PubKey())
+}
+
+//PubKey 交易签名者的公钥，签名中省略公钥的时候从签名中恢复，多重签名是脚本的编码
+func (tx *Transaction) PubKey() []byte {
+	sign := tx.GetSignature()
+	if sign.GetTy() == MultiSigSign && sign.GetMultiSigScript() != nil {
+		return Encode(sign.MultiSigScript)
+	}
+	if sign == nil || len(sign.Pubkey) > 0 {
+		return sign.GetPubkey()
+	}
+	pub, _ := RecoverSignPubKey(tx.SignData(), string(tx.Execer), sign)
+	return pub
+}
+
+//FeeAddr 支付手续费的地址，有代付签名的时候是代付账户，否则是from地址
+func (tx *Transaction) FeeAddr() string {
+	payer := tx.GetFeePayer()
+	if payer == nil {
+		return tx.From()
+	}
+	if payer.Ty == MultiSigSign && payer.MultiSigScript != nil {
+		return payer.MultiSigScript.Address()
+	}
+	return address.PubKeyToAddr(payer.Pubkey)
 }
 
 //检查交易是否过期，过期返回true，未过期返回false
+//height 是打包交易的区块高度，mempool 用下一个区块的高度检查，和执行器保持一致
 func (tx *Transaction) isExpire(height, blocktime int64) bool {
 	valid := tx.Expire
 	// Expire为0，返回false
@@ -629,10 +811,12 @@ func (tx *Transaction) JSON() string {
 		// 随机ID，可以防止payload 相同的时候，交易重复
 		Nonce int64 `json:"nonce,omitempty"`
 		// 对方地址，如果没有对方地址，可以为空
-		To         string `json:"to,omitempty"`
-		GroupCount int32  `json:"groupCount,omitempty"`
-		Header     string `json:"header,omitempty"`
-		Next       string `json:"next,omitempty"`
+		To         string     `json:"to,omitempty"`
+		GroupCount int32      `json:"groupCount,omitempty"`
+		Header     string     `json:"header,omitempty"`
+		Next       string     `json:"next,omitempty"`
+		FeePayer   *Signature `json:"feePayer,omitempty"`
+		ChainID    int32      `json:"chainID,omitempty"`
 	}
 
 	newtx := &transaction{}
@@ -647,6 +831,8 @@ func (tx *Transaction) JSON() string {
 	newtx.GroupCount = tx.GroupCount
 	newtx.Header = hex.EncodeToString(tx.Header)
 	newtx.Next = hex.EncodeToString(tx.Next)
+	newtx.FeePayer = tx.FeePayer
+	newtx.ChainID = tx.ChainID
 	data, err := json.MarshalIndent(newtx, "", "\t")
 	if err != nil {
 		return err.Error()
@@ -706,6 +892,51 @@ func (tx *Transaction) ActionName() string {
 	return exec.ActionName(tx)
 }
 
+//IsMultiAction 交易是否是多操作交易
+func (tx *Transaction) IsMultiAction() bool {
+	return string(tx.GetExecer()) == ExecName(MultiActionX)
+}
+
+//GetMultiActionTxs 把多操作交易拆成每个操作的交易，交给操作的执行器执行。
+//拆出来的交易使用原交易的签名和过期时间，Header 是原交易的hash，Nonce 是操作的序号，手续费由原交易支付
+func (tx *Transaction) GetMultiActionTxs() ([]*Transaction, error) {
+	var multi MultiAction
+	if err := Decode(tx.GetPayload(), &multi); err != nil {
+		return nil, err
+	}
+	if len(multi.Actions) == 0 || len(multi.Actions) > int(MaxTxGroupSize) {
+		return nil, ErrMultiActionCount
+	}
+	//签名中省略公钥的时候，拆出来的交易不能再从签名中恢复，这里先填上原交易的公钥
+	var sign *Signature
+	if tx.GetSignature() != nil {
+		copysign := *tx.Signature
+		if len(copysign.Pubkey) == 0 && copysign.Ty != MultiSigSign {
+			copysign.Pubkey = tx.PubKey()
+		}
+		sign = &copysign
+	}
+	hash := tx.Hash()
+	txs := make([]*Transaction, len(multi.Actions))
+	for i, action := range multi.Actions {
+		sub := &Transaction{
+			Execer:    action.Execer,
+			Payload:   action.Payload,
+			To:        action.To,
+			Signature: sign,
+			Expire:    tx.Expire,
+			Nonce:     int64(i),
+			ChainID:   tx.ChainID,
+			Header:    hash,
+		}
+		if sub.IsMultiAction() {
+			return nil, ErrMultiActionNested
+		}
+		txs[i] = sub
+	}
+	return txs, nil
+}
+
 //IsWithdraw 判断交易是withdraw交易，需要做from和to地址的swap，方便上层客户理解
 func (tx *Transaction) IsWithdraw() bool {
 	if bytes.Equal(tx.GetExecer(), bCoins) || bytes.Equal(tx.GetExecer(), bToken) {
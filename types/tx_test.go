@@ -312,3 +312,197 @@ func TestSetGroupExpire(t *testing.T) {
 		}
 	}
 }
+
+func TestSignFeePayer(t *testing.T) {
+	sender := getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	payer := getprivkey("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01")
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1e6, To: "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"}
+	plain := *tx
+	plain.Sign(SECP256K1, sender)
+	assert.Equal(t, plain.From(), plain.FeeAddr())
+
+	//发送者没有指定代付账户的时候，代付签名无效
+	unbound := plain
+	unbound.SignFeePayer(SECP256K1, payer)
+	assert.False(t, unbound.CheckSign())
+
+	tx.SetFeePayer(payer.PubKey().Bytes())
+	tx.Sign(SECP256K1, sender)
+	hash := tx.Hash()
+	assert.NotEqual(t, plain.Hash(), hash)
+
+	tx.SignFeePayer(SECP256K1, payer)
+	assert.True(t, tx.CheckSign())
+	assert.True(t, CheckTxsSign([]*Transaction{tx}))
+	assert.Equal(t, hash, tx.Hash())
+	assert.NotEqual(t, tx.From(), tx.FeeAddr())
+	assert.Equal(t, common.ToHex(payer.PubKey().Bytes()), common.ToHex(tx.FeePayer.Pubkey))
+
+	//代付签名不能作为发送者的签名使用
+	fake := *tx
+	fake.Signature = tx.FeePayer
+	fake.FeePayer = nil
+	assert.False(t, fake.CheckSign())
+
+	//去掉代付账户以后发送者的签名失效
+	fake = *tx
+	fake.FeePayer = nil
+	assert.False(t, fake.CheckSign())
+	assert.NotEqual(t, hash, fake.Hash())
+
+	//代付账户不能被其他账户替换
+	fake = *tx
+	fake.SignFeePayer(SECP256K1, sender)
+	assert.False(t, fake.CheckSign())
+	assert.False(t, CheckTxsSign([]*Transaction{&fake}))
+
+	//代付账户必须带公钥
+	fake = *tx
+	fake.FeePayer = &Signature{Ty: SECP256K1, Signature: tx.FeePayer.Signature}
+	assert.False(t, fake.CheckSign())
+
+	//发送者签名变化以后代付签名失效
+	fake = *tx
+	fake.Fee = 2e6
+	fake.ResetFeePayer()
+	fake.Sign(SECP256K1, sender)
+	fake.FeePayer = tx.FeePayer
+	assert.False(t, fake.CheckSign())
+
+	//交易组不支持代付
+	group, err := CreateTxGroup([]*Transaction{{Execer: []byte("coins"), Fee: 1e6}, {Execer: []byte("coins")}})
+	assert.Nil(t, err)
+	group.Txs[1].SignFeePayer(SECP256K1, payer)
+	assert.Equal(t, ErrFeePayerInGroup, group.Check(0, 0, 0))
+}
+
+func TestSignRecoverable(t *testing.T) {
+	S("TxPubKeyRecover", true)
+	defer S("TxPubKeyRecover", false)
+	priv := getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1e6, To: "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"}
+	full := *tx
+	full.Sign(SECP256K1, priv)
+
+	assert.Nil(t, tx.SignRecoverable(SECP256K1, priv))
+	assert.Nil(t, tx.Signature.Pubkey)
+	assert.True(t, Size(tx) < Size(&full))
+	assert.True(t, tx.CheckSign())
+	assert.True(t, CheckTxsSign([]*Transaction{tx, &full}))
+	assert.Equal(t, full.From(), tx.From())
+	assert.Equal(t, full.Hash(), tx.Hash())
+	assert.Equal(t, full.PubKey(), SignPubKey(tx.SignData(), "coins", tx.Signature))
+	assert.Equal(t, full.PubKey(), SignPubKey(full.SignData(), "coins", full.Signature))
+
+	//交易内容修改以后恢复出来的是其他地址
+	fake := *tx
+	fake.Fee = 2e6
+	assert.NotEqual(t, full.From(), fake.From())
+
+	//没有开启的时候不能省略公钥
+	S("TxPubKeyRecover", false)
+	assert.False(t, tx.CheckSign())
+	assert.False(t, CheckTxsSign([]*Transaction{tx}))
+}
+
+func TestCheckChainID(t *testing.T) {
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("none"), Fee: 1e6}
+	assert.Nil(t, tx.CheckChainID(0))
+	S("ChainID", int64(33))
+	defer S("ChainID", int64(0))
+	assert.Equal(t, int32(33), GetChainID())
+
+	fork := GetFork("ForkTxChainID")
+	if fork > 0 {
+		assert.Nil(t, tx.CheckChainID(fork-1))
+	}
+	assert.Equal(t, ErrTxChainID, tx.CheckChainID(fork))
+	tx.ChainID = 34
+	assert.Equal(t, ErrTxChainID, tx.CheckChainID(0))
+	hash := tx.Hash()
+	tx.ChainID = 33
+	assert.Nil(t, tx.CheckChainID(fork))
+	assert.Nil(t, tx.Check(fork, 0, 0))
+	//链的标识包含在交易hash和签名数据中
+	assert.NotEqual(t, hash, tx.Hash())
+
+	formatted, err := FormatTx("coins", &Transaction{Payload: []byte("none")})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(33), formatted.ChainID)
+}
+
+func TestSignWithAddr(t *testing.T) {
+	priv := getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	tx := &Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1e6, To: "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"}
+	plain := *tx
+	plain.Sign(SECP256K1, priv)
+	tx.SignWithAddr(SECP256K1, priv, "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4")
+	assert.True(t, tx.CheckSign())
+	assert.True(t, CheckTxsSign([]*Transaction{tx}))
+	assert.Equal(t, "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4", tx.From())
+	//原地址包含在交易hash和签名数据中
+	assert.NotEqual(t, plain.Hash(), tx.Hash())
+
+	//修改或者去掉原地址以后签名无效，交易hash也不同
+	for _, addr := range []string{"1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP", ""} {
+		fake := *tx
+		sign := *tx.Signature
+		sign.Addr = addr
+		fake.Signature = &sign
+		assert.False(t, fake.CheckSign())
+		assert.False(t, CheckTxsSign([]*Transaction{&fake}))
+		assert.NotEqual(t, tx.Hash(), fake.Hash())
+	}
+
+	//分叉之前签名中不能指定原地址
+	fork := GetFork("ForkKeyMigration")
+	if fork > 0 {
+		assert.Equal(t, ErrSignAddrNotAllow, tx.Check(fork-1, 0, 0))
+		assert.Nil(t, plain.Check(fork-1, 0, 0))
+	}
+	assert.Nil(t, tx.Check(fork, 0, 0))
+}
+
+func TestMultiActionTx(t *testing.T) {
+	priv := getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	actions := []*Transaction{
+		{Execer: []byte("coins"), Payload: []byte("transfer"), To: "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"},
+		{Execer: []byte("token"), Payload: []byte("withdraw"), To: "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"},
+	}
+	_, err := CreateMultiActionTx(nil, 0, 0)
+	assert.Equal(t, ErrMultiActionCount, err)
+	tx, err := CreateMultiActionTx(actions, 1e7, 100)
+	assert.Nil(t, err)
+	assert.True(t, tx.IsMultiAction())
+	assert.Equal(t, int64(1e7), tx.Fee)
+	assert.Equal(t, int64(100), tx.Expire)
+	tx.Sign(SECP256K1, priv)
+	assert.True(t, tx.CheckSign())
+	assert.Nil(t, tx.Check(GetFork("ForkMultiAction"), 0, 0))
+
+	//拆出来的交易用原交易的签名者执行，hash 各不相同
+	txs, err := tx.GetMultiActionTxs()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(txs))
+	for i, sub := range txs {
+		assert.Equal(t, actions[i].Execer, sub.Execer)
+		assert.Equal(t, actions[i].Payload, sub.Payload)
+		assert.Equal(t, actions[i].To, sub.To)
+		assert.Equal(t, tx.From(), sub.From())
+		assert.Equal(t, tx.Hash(), sub.Header)
+		assert.Equal(t, tx.Expire, sub.Expire)
+		assert.Equal(t, int64(0), sub.Fee)
+	}
+	assert.NotEqual(t, txs[0].Hash(), txs[1].Hash())
+
+	//不能嵌套，不能放到交易组中
+	_, err = CreateMultiActionTx([]*Transaction{actions[0], tx}, 0, 0)
+	assert.Equal(t, ErrMultiActionNested, err)
+	nested := *tx
+	nested.Payload = Encode(&MultiAction{Actions: []*MultiActionItem{{Execer: tx.Execer, Payload: tx.Payload}}})
+	_, err = nested.GetMultiActionTxs()
+	assert.Equal(t, ErrMultiActionNested, err)
+	group, err := CreateTxGroup([]*Transaction{tx, actions[0]})
+	assert.Nil(t, err)
+	assert.Equal(t, ErrTxGroupNotSupport, group.Check(GetFork("ForkMultiAction"), 0, 0))
+}
@@ -0,0 +1,353 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+)
+
+//TypedDataDomain 域类型的名称，域数据用于区分不同的dapp和链，防止签名被挪用
+const TypedDataDomain = "EIP712Domain"
+
+//结构体最大的嵌套深度
+const maxTypedDataDepth = 32
+
+//TypedDataField 结构化数据中的字段定义
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+//TypedData 待签名的结构化数据，格式和编码方式与EIP-712相同
+//签名的摘要为 keccak256("\x19\x01" + hashStruct(domain) + hashStruct(message))
+//address 类型既可以是chain33的地址，也可以是0x开头的20字节十六进制
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      map[string]interface{}      `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+//ParseTypedData 解析json格式的结构化数据
+func ParseTypedData(data []byte) (*TypedData, error) {
+	var td TypedData
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&td); err != nil {
+		return nil, err
+	}
+	if _, ok := td.Types[TypedDataDomain]; !ok {
+		tlog.Error("ParseTypedData", "err", "domain type not defined")
+		return nil, ErrTypedData
+	}
+	if _, ok := td.Types[td.PrimaryType]; !ok || td.PrimaryType == TypedDataDomain {
+		tlog.Error("ParseTypedData", "primaryType", td.PrimaryType)
+		return nil, ErrTypedData
+	}
+	return &td, nil
+}
+
+//Hash 计算结构化数据的签名摘要
+func (td *TypedData) Hash() ([]byte, error) {
+	domain, err := td.HashStruct(TypedDataDomain, td.Domain)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0, 66)
+	data = append(data, 0x19, 0x01)
+	data = append(data, domain...)
+	data = append(data, msg...)
+	return common.Sha3(data), nil
+}
+
+//HashStruct hashStruct(s) = keccak256(typeHash + encodeData(s))
+func (td *TypedData) HashStruct(primary string, data map[string]interface{}) ([]byte, error) {
+	enc, err := td.encodeData(primary, data, 0)
+	if err != nil {
+		return nil, err
+	}
+	return common.Sha3(enc), nil
+}
+
+//TypeHash 类型的hash，keccak256(encodeType(primary))
+func (td *TypedData) TypeHash(primary string) []byte {
+	return common.Sha3([]byte(td.EncodeType(primary)))
+}
+
+//EncodeType 类型的编码，主类型在前，依赖的类型按名称排序附加在后面
+//例如 Mail(Person from,Person to,string contents)Person(string name,address wallet)
+func (td *TypedData) EncodeType(primary string) string {
+	deps := make(map[string]bool)
+	td.dependencies(primary, deps)
+	delete(deps, primary)
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	names = append([]string{primary}, names...)
+	var buf bytes.Buffer
+	for _, name := range names {
+		buf.WriteString(name)
+		buf.WriteString("(")
+		for i, field := range td.Types[name] {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString(field.Type)
+			buf.WriteString(" ")
+			buf.WriteString(field.Name)
+		}
+		buf.WriteString(")")
+	}
+	return buf.String()
+}
+
+func (td *TypedData) dependencies(primary string, found map[string]bool) {
+	primary = baseType(primary)
+	if found[primary] {
+		return
+	}
+	if _, ok := td.Types[primary]; !ok {
+		return
+	}
+	found[primary] = true
+	for _, field := range td.Types[primary] {
+		td.dependencies(field.Type, found)
+	}
+}
+
+//数组类型去掉[]，多维数组逐层去掉
+func baseType(typ string) string {
+	for strings.HasSuffix(typ, "]") {
+		typ = typ[:strings.LastIndex(typ, "[")]
+	}
+	return typ
+}
+
+func (td *TypedData) encodeData(primary string, data map[string]interface{}, depth int) ([]byte, error) {
+	if depth > maxTypedDataDepth {
+		return nil, ErrTypedData
+	}
+	fields := td.Types[primary]
+	//所有的字段都必须赋值，也不允许出现类型中没有定义的字段
+	if len(data) != len(fields) {
+		tlog.Error("TypedData encodeData", "type", primary, "err", "fields not match")
+		return nil, ErrTypedData
+	}
+	enc := td.TypeHash(primary)
+	for _, field := range fields {
+		v, ok := data[field.Name]
+		if !ok {
+			tlog.Error("TypedData encodeData", "type", primary, "missing", field.Name)
+			return nil, ErrTypedData
+		}
+		value, err := td.encodeValue(field.Type, v, depth)
+		if err != nil {
+			tlog.Error("TypedData encodeData", "type", primary, "field", field.Name, "err", err)
+			return nil, err
+		}
+		enc = append(enc, value...)
+	}
+	return enc, nil
+}
+
+//encodeValue 每个值都编码为32字节，动态长度的类型和结构体编码为hash
+func (td *TypedData) encodeValue(typ string, v interface{}, depth int) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		items, ok := v.([]interface{})
+		if !ok {
+			return nil, ErrTypedData
+		}
+		i := strings.LastIndex(typ, "[")
+		if size := typ[i+1 : len(typ)-1]; size != "" {
+			n, err := strconv.Atoi(size)
+			if err != nil || n != len(items) {
+				return nil, ErrTypedData
+			}
+		}
+		var enc []byte
+		for _, item := range items {
+			value, err := td.encodeValue(typ[:i], item, depth)
+			if err != nil {
+				return nil, err
+			}
+			enc = append(enc, value...)
+		}
+		return common.Sha3(enc), nil
+	}
+	if _, ok := td.Types[typ]; ok {
+		data, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, ErrTypedData
+		}
+		enc, err := td.encodeData(typ, data, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return common.Sha3(enc), nil
+	}
+	switch {
+	case typ == "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, ErrTypedData
+		}
+		return common.Sha3([]byte(s)), nil
+	case typ == "bytes":
+		b, err := typedDataBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		return common.Sha3(b), nil
+	case typ == "bool":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, ErrTypedData
+		}
+		enc := make([]byte, 32)
+		if b {
+			enc[31] = 1
+		}
+		return enc, nil
+	case typ == "address":
+		s, ok := v.(string)
+		if !ok {
+			return nil, ErrTypedData
+		}
+		hash, err := typedDataAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		return leftPad32(hash), nil
+	case strings.HasPrefix(typ, "bytes"):
+		n, err := strconv.Atoi(typ[len("bytes"):])
+		if err != nil || n < 1 || n > 32 {
+			return nil, ErrTypedData
+		}
+		b, err := typedDataBytes(v)
+		if err != nil || len(b) != n {
+			return nil, ErrTypedData
+		}
+		return rightPad32(b), nil
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"):
+		return typedDataInteger(typ, v)
+	}
+	tlog.Error("TypedData encodeValue", "unknown type", typ)
+	return nil, ErrTypedData
+}
+
+func typedDataBytes(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, ErrTypedData
+	}
+	b, err := common.FromHex(s)
+	if err != nil {
+		return nil, ErrTypedData
+	}
+	return b, nil
+}
+
+func typedDataAddress(s string) ([]byte, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		b, err := common.FromHex(s)
+		if err != nil || len(b) != 20 {
+			return nil, ErrTypedData
+		}
+		return b, nil
+	}
+	addr, err := address.NewAddrFromString(s)
+	if err != nil || addr == nil {
+		return nil, ErrTypedData
+	}
+	return addr.Hash160[:], nil
+}
+
+//整数编码为32字节的大端格式，负数使用补码
+func typedDataInteger(typ string, v interface{}) ([]byte, error) {
+	signed := strings.HasPrefix(typ, "int")
+	bits := 256
+	if size := strings.TrimPrefix(strings.TrimPrefix(typ, "u"), "int"); size != "" {
+		n, err := strconv.Atoi(size)
+		if err != nil || n < 8 || n > 256 || n%8 != 0 {
+			return nil, ErrTypedData
+		}
+		bits = n
+	}
+	var s string
+	switch value := v.(type) {
+	case json.Number:
+		s = value.String()
+	case string:
+		s = value
+	case float64:
+		s = strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return nil, ErrTypedData
+	}
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, ErrTypedData
+	}
+	min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+	if n.Cmp(min) < 0 || n.Cmp(max) >= 0 {
+		return nil, ErrTypedData
+	}
+	if n.Sign() < 0 {
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return leftPad32(n.Bytes()), nil
+}
+
+func leftPad32(b []byte) []byte {
+	enc := make([]byte, 32)
+	copy(enc[32-len(b):], b)
+	return enc
+}
+
+func rightPad32(b []byte) []byte {
+	enc := make([]byte, 32)
+	copy(enc, b)
+	return enc
+}
+
+//VerifyTypedData 验证结构化数据的签名
+func VerifyTypedData(td *TypedData, ty int32, pubKey []byte, sig []byte) (bool, error) {
+	hash, err := td.Hash()
+	if err != nil {
+		return false, err
+	}
+	c, err := crypto.New(GetSignName("", int(ty)))
+	if err != nil {
+		return false, err
+	}
+	pub, err := c.PubKeyFromBytes(pubKey)
+	if err != nil {
+		return false, err
+	}
+	signature, err := c.SignatureFromBytes(sig)
+	if err != nil {
+		return false, err
+	}
+	return pub.VerifyBytes(hash, signature), nil
+}
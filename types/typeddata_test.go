@@ -0,0 +1,116 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//EIP-712 中的例子
+var typedDataMail = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestTypedDataHash(t *testing.T) {
+	td, err := ParseTypedData([]byte(typedDataMail))
+	require.NoError(t, err)
+	assert.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", td.EncodeType("Mail"))
+	assert.Equal(t, "0xa0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", common.ToHex(td.TypeHash("Mail")))
+	domain, err := td.HashStruct(TypedDataDomain, td.Domain)
+	require.NoError(t, err)
+	assert.Equal(t, "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", common.ToHex(domain))
+	msg, err := td.HashStruct(td.PrimaryType, td.Message)
+	require.NoError(t, err)
+	assert.Equal(t, "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", common.ToHex(msg))
+	hash, err := td.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", common.ToHex(hash))
+
+	//缺少字段
+	delete(td.Message, "contents")
+	_, err = td.Hash()
+	assert.Equal(t, ErrTypedData, err)
+	//没有定义主类型
+	_, err = ParseTypedData([]byte(`{"types":{"EIP712Domain":[]},"primaryType":"Mail","domain":{},"message":{}}`))
+	assert.Equal(t, ErrTypedData, err)
+}
+
+func TestTypedDataValue(t *testing.T) {
+	td := &TypedData{Types: map[string][]TypedDataField{}}
+	enc, err := td.encodeValue("int8", "-1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", common.ToHex(enc))
+	_, err = td.encodeValue("int8", "128", 0)
+	assert.Equal(t, ErrTypedData, err)
+	_, err = td.encodeValue("uint8", "-1", 0)
+	assert.Equal(t, ErrTypedData, err)
+	enc, err = td.encodeValue("bytes2", "0x1234", 0)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x12), enc[0])
+	_, err = td.encodeValue("uint256[2]", []interface{}{"1"}, 0)
+	assert.Equal(t, ErrTypedData, err)
+	//chain33 地址和对应的十六进制hash160编码相同
+	addr, err := address.NewAddrFromString("1KgE3vayiqZKhfhMftN7vt2gDv9HoMk941")
+	require.NoError(t, err)
+	enc1, err := td.encodeValue("address", addr.String(), 0)
+	require.NoError(t, err)
+	enc2, err := td.encodeValue("address", common.ToHex(addr.Hash160[:]), 0)
+	require.NoError(t, err)
+	assert.Equal(t, enc1, enc2)
+}
+
+func TestVerifyTypedData(t *testing.T) {
+	td, err := ParseTypedData([]byte(typedDataMail))
+	require.NoError(t, err)
+	c, err := crypto.New(GetSignName("", SECP256K1))
+	require.NoError(t, err)
+	priv, err := c.GenKey()
+	require.NoError(t, err)
+	hash, err := td.Hash()
+	require.NoError(t, err)
+	sig := priv.Sign(hash).Bytes()
+	ok, err := VerifyTypedData(td, SECP256K1, priv.PubKey().Bytes(), sig)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	td.Message["contents"] = "Hello, Alice!"
+	ok, err = VerifyTypedData(td, SECP256K1, priv.PubKey().Bytes(), sig)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
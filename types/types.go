@@ -226,7 +226,7 @@ func (leafnode *LeafNode) Hash() []byte {
 	if err != nil {
 		panic(err)
 	}
-	return common.Sha256(data)
+	return stateHasher.Sum(data)
 }
 
 var sha256Len = 32
@@ -248,7 +248,7 @@ func (innernode *InnerNode) Hash() []byte {
 	}
 	innernode.RightHash = rightHash
 	innernode.LeftHash = leftHash
-	return common.Sha256(data)
+	return stateHasher.Sum(data)
 }
 
 //NewErrReceipt  new一个新的Receipt
@@ -324,6 +324,35 @@ func ManageKey(key string) string {
 	return fmt.Sprintf("%s-%s", ManagePrefix+"manage", key)
 }
 
+// ApplyConfigModify 在配置的列表上执行add或者delete修改，返回新的列表
+func ApplyConfigModify(values []string, modify *ModifyConfig) []string {
+	switch modify.Op {
+	case "add":
+		return append(append([]string{}, values...), modify.Value)
+	case "delete":
+		result := make([]string, 0)
+		for _, value := range values {
+			if value != modify.Value {
+				result = append(result, value)
+			}
+		}
+		return result
+	}
+	return values
+}
+
+// GetConfigValues 配置项在height高度生效的列表，生效高度不超过height的计划修改按顺序合并
+func GetConfigValues(item *ConfigItem, height int64) []string {
+	values := item.GetArr().GetValue()
+	for _, modify := range item.GetScheduled() {
+		if modify.EffectiveHeight > height {
+			break
+		}
+		values = ApplyConfigModify(values, modify)
+	}
+	return values
+}
+
 //ManaeKeyWithHeigh 超级管理员账户key
 func ManaeKeyWithHeigh(key string, height int64) string {
 	if IsFork(height, "ForkExecKey") {
@@ -421,5 +421,61 @@ func TestJsonpbUTF8Tx(t *testing.T) {
 	assert.NotNil(t, pl)
 	pljson, err = PBToJSONUTF8(pl)
 	assert.Nil(t, err)
-	assert.Equal(t, string(pljson), `{"transfer":{"cointoken":"","amount":"200000000","note":"1\n2\n3","to":""},"ty":1}`)
+	assert.Equal(t, string(pljson), `{"transfer":{"cointoken":"","amount":"200000000","note":"1\n2\n3","to":"","encryptedNote":null},"ty":1}`)
+}
+
+func TestStateHasher(t *testing.T) {
+	leaf := &LeafNode{Key: []byte("key"), Value: []byte("value"), Height: 0, Size: 1}
+	assert.Equal(t, "sha256", GetStateHasher().Name())
+	sha := leaf.Hash()
+	assert.Nil(t, SetStateHasher("blake2b"))
+	defer SetStateHasher("")
+	assert.Equal(t, "blake2b", GetStateHasher().Name())
+	blake := leaf.Hash()
+	assert.Equal(t, 32, len(blake))
+	assert.NotEqual(t, sha, blake)
+	inner := &InnerNode{LeftHash: sha, RightHash: blake, Height: 1, Size: 2}
+	assert.Equal(t, 32, len(inner.Hash()))
+
+	assert.Equal(t, ErrNotSupport, SetStateHasher("blake3"))
+	assert.Equal(t, "blake2b", GetStateHasher().Name())
+	assert.Nil(t, SetStateHasher(""))
+	assert.Equal(t, sha, leaf.Hash())
+}
+
+func TestChainHasher(t *testing.T) {
+	tx := &Transaction{Execer: []byte("none"), Payload: []byte("none"), Fee: 1e6}
+	block := &Block{Height: 1, Txs: []*Transaction{tx}}
+	assert.Equal(t, "sha256", GetChainHasher().Name())
+	sha := tx.Hash()
+	assert.Equal(t, common.Sha256(Encode(tx)), sha)
+	shaBlock := block.Hash()
+
+	assert.Nil(t, SetChainHasher("blake3"))
+	defer SetChainHasher("")
+	assert.Equal(t, "blake3", GetChainHasher().Name())
+	assert.Equal(t, 32, len(tx.Hash()))
+	assert.NotEqual(t, sha, tx.Hash())
+	assert.NotEqual(t, shaBlock, block.Hash())
+
+	assert.Equal(t, ErrNotSupport, SetChainHasher("blake2b"))
+	assert.Nil(t, SetChainHasher(""))
+	assert.Equal(t, sha, tx.Hash())
+	assert.Equal(t, shaBlock, block.Hash())
+}
+
+func TestGetConfigValues(t *testing.T) {
+	item := &ConfigItem{
+		Value: &ConfigItem_Arr{Arr: &ArrayConfig{Value: []string{"a", "b"}}},
+		Scheduled: []*ModifyConfig{
+			{Op: "delete", Value: "a", EffectiveHeight: 10},
+			{Op: "add", Value: "c", EffectiveHeight: 10},
+			{Op: "add", Value: "d", EffectiveHeight: 20},
+		},
+	}
+	assert.Equal(t, []string{"a", "b"}, GetConfigValues(item, 9))
+	assert.Equal(t, []string{"b", "c"}, GetConfigValues(item, 10))
+	assert.Equal(t, []string{"b", "c", "d"}, GetConfigValues(item, 20))
+	assert.Equal(t, []string{"a", "b"}, item.GetArr().Value)
+	assert.Nil(t, GetConfigValues(&ConfigItem{}, 10))
 }
@@ -43,6 +43,7 @@ type WalletTxDetail struct {
 	Txhash               []byte       `protobuf:"bytes,8,opt,name=txhash,proto3" json:"txhash,omitempty"`
 	ActionName           string       `protobuf:"bytes,9,opt,name=actionName,proto3" json:"actionName,omitempty"`
 	Payload              []byte       `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
+	Note                 []byte       `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -143,6 +144,13 @@ func (m *WalletTxDetail) GetPayload() []byte {
 	return nil
 }
 
+func (m *WalletTxDetail) GetNote() []byte {
+	if m != nil {
+		return m.Note
+	}
+	return nil
+}
+
 type WalletTxDetails struct {
 	TxDetails            []*WalletTxDetail `protobuf:"bytes,1,rep,name=txDetails,proto3" json:"txDetails,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -1357,7 +1365,10 @@ type ReqSignRawTx struct {
 	Token string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
 	Fee   int64  `protobuf:"varint,8,opt,name=fee,proto3" json:"fee,omitempty"`
 	// bytes  newExecer = 9;
-	NewToAddr            string   `protobuf:"bytes,10,opt,name=newToAddr,proto3" json:"newToAddr,omitempty"`
+	NewToAddr string `protobuf:"bytes,10,opt,name=newToAddr,proto3" json:"newToAddr,omitempty"`
+	//代付手续费签名，交易必须已经由发送者签名
+	FeePayer             bool     `protobuf:"varint,11,opt,name=feePayer,proto3" json:"feePayer,omitempty"`
+	FeePayerPubkey       string   `protobuf:"bytes,12,opt,name=feePayerPubkey,proto3" json:"feePayerPubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1444,6 +1455,20 @@ func (m *ReqSignRawTx) GetNewToAddr() string {
 	return ""
 }
 
+func (m *ReqSignRawTx) GetFeePayer() bool {
+	if m != nil {
+		return m.FeePayer
+	}
+	return false
+}
+
+func (m *ReqSignRawTx) GetFeePayerPubkey() string {
+	if m != nil {
+		return m.FeePayerPubkey
+	}
+	return ""
+}
+
 type ReplySignRawTx struct {
 	TxHex                string   `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1689,8 +1714,10 @@ func (m *ReqCreateTransaction) GetExpire() int64 {
 	return 0
 }
 
+//addrFormat 返回地址的格式，支持base58和bech32，不填的时候使用钱包配置的格式
 type ReqAccountList struct {
 	WithoutBalance       bool     `protobuf:"varint,1,opt,name=withoutBalance,proto3" json:"withoutBalance,omitempty"`
+	AddrFormat           string   `protobuf:"bytes,2,opt,name=addrFormat,proto3" json:"addrFormat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1728,6 +1755,568 @@ func (m *ReqAccountList) GetWithoutBalance() bool {
 	return false
 }
 
+func (m *ReqAccountList) GetAddrFormat() string {
+	if m != nil {
+		return m.AddrFormat
+	}
+	return ""
+}
+
+// MuSig多方签名，所有签名者的schnorr签名聚合成聚合公钥的一个签名，上链的交易和单个签名者的交易一样
+type ReqMuSigPubKeys struct {
+	PubKeys              []string `protobuf:"bytes,1,rep,name=pubKeys,proto3" json:"pubKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMuSigPubKeys) Reset()         { *m = ReqMuSigPubKeys{} }
+func (m *ReqMuSigPubKeys) String() string { return proto.CompactTextString(m) }
+func (*ReqMuSigPubKeys) ProtoMessage()    {}
+func (*ReqMuSigPubKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{30}
+}
+
+func (m *ReqMuSigPubKeys) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMuSigPubKeys.Unmarshal(m, b)
+}
+func (m *ReqMuSigPubKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMuSigPubKeys.Marshal(b, m, deterministic)
+}
+func (m *ReqMuSigPubKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMuSigPubKeys.Merge(m, src)
+}
+func (m *ReqMuSigPubKeys) XXX_Size() int {
+	return xxx_messageInfo_ReqMuSigPubKeys.Size(m)
+}
+func (m *ReqMuSigPubKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMuSigPubKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMuSigPubKeys proto.InternalMessageInfo
+
+func (m *ReqMuSigPubKeys) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+type ReplyMuSigPubKey struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyMuSigPubKey) Reset()         { *m = ReplyMuSigPubKey{} }
+func (m *ReplyMuSigPubKey) String() string { return proto.CompactTextString(m) }
+func (*ReplyMuSigPubKey) ProtoMessage()    {}
+func (*ReplyMuSigPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{31}
+}
+
+func (m *ReplyMuSigPubKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMuSigPubKey.Unmarshal(m, b)
+}
+func (m *ReplyMuSigPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMuSigPubKey.Marshal(b, m, deterministic)
+}
+func (m *ReplyMuSigPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMuSigPubKey.Merge(m, src)
+}
+func (m *ReplyMuSigPubKey) XXX_Size() int {
+	return xxx_messageInfo_ReplyMuSigPubKey.Size(m)
+}
+func (m *ReplyMuSigPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMuSigPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMuSigPubKey proto.InternalMessageInfo
+
+func (m *ReplyMuSigPubKey) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ReplyMuSigPubKey) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+// ReqMuSigStart 用钱包中addr的私钥参与txHex交易的多方签名，返回会话和随机数的承诺
+type ReqMuSigStart struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PubKeys              []string `protobuf:"bytes,2,rep,name=pubKeys,proto3" json:"pubKeys,omitempty"`
+	TxHex                string   `protobuf:"bytes,3,opt,name=txHex,proto3" json:"txHex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMuSigStart) Reset()         { *m = ReqMuSigStart{} }
+func (m *ReqMuSigStart) String() string { return proto.CompactTextString(m) }
+func (*ReqMuSigStart) ProtoMessage()    {}
+func (*ReqMuSigStart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{32}
+}
+
+func (m *ReqMuSigStart) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMuSigStart.Unmarshal(m, b)
+}
+func (m *ReqMuSigStart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMuSigStart.Marshal(b, m, deterministic)
+}
+func (m *ReqMuSigStart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMuSigStart.Merge(m, src)
+}
+func (m *ReqMuSigStart) XXX_Size() int {
+	return xxx_messageInfo_ReqMuSigStart.Size(m)
+}
+func (m *ReqMuSigStart) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMuSigStart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMuSigStart proto.InternalMessageInfo
+
+func (m *ReqMuSigStart) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqMuSigStart) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *ReqMuSigStart) GetTxHex() string {
+	if m != nil {
+		return m.TxHex
+	}
+	return ""
+}
+
+// MuSigPeerData 其他签名者在一轮中发送的数据，承诺、随机数或者部分签名
+type MuSigPeerData struct {
+	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MuSigPeerData) Reset()         { *m = MuSigPeerData{} }
+func (m *MuSigPeerData) String() string { return proto.CompactTextString(m) }
+func (*MuSigPeerData) ProtoMessage()    {}
+func (*MuSigPeerData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{33}
+}
+
+func (m *MuSigPeerData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MuSigPeerData.Unmarshal(m, b)
+}
+func (m *MuSigPeerData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MuSigPeerData.Marshal(b, m, deterministic)
+}
+func (m *MuSigPeerData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MuSigPeerData.Merge(m, src)
+}
+func (m *MuSigPeerData) XXX_Size() int {
+	return xxx_messageInfo_MuSigPeerData.Size(m)
+}
+func (m *MuSigPeerData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MuSigPeerData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MuSigPeerData proto.InternalMessageInfo
+
+func (m *MuSigPeerData) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *MuSigPeerData) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type ReqMuSigStep struct {
+	Session              string           `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Peers                []*MuSigPeerData `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReqMuSigStep) Reset()         { *m = ReqMuSigStep{} }
+func (m *ReqMuSigStep) String() string { return proto.CompactTextString(m) }
+func (*ReqMuSigStep) ProtoMessage()    {}
+func (*ReqMuSigStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{34}
+}
+
+func (m *ReqMuSigStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMuSigStep.Unmarshal(m, b)
+}
+func (m *ReqMuSigStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMuSigStep.Marshal(b, m, deterministic)
+}
+func (m *ReqMuSigStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMuSigStep.Merge(m, src)
+}
+func (m *ReqMuSigStep) XXX_Size() int {
+	return xxx_messageInfo_ReqMuSigStep.Size(m)
+}
+func (m *ReqMuSigStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMuSigStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMuSigStep proto.InternalMessageInfo
+
+func (m *ReqMuSigStep) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *ReqMuSigStep) GetPeers() []*MuSigPeerData {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type ReplyMuSigStep struct {
+	Session              string   `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyMuSigStep) Reset()         { *m = ReplyMuSigStep{} }
+func (m *ReplyMuSigStep) String() string { return proto.CompactTextString(m) }
+func (*ReplyMuSigStep) ProtoMessage()    {}
+func (*ReplyMuSigStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{35}
+}
+
+func (m *ReplyMuSigStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMuSigStep.Unmarshal(m, b)
+}
+func (m *ReplyMuSigStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMuSigStep.Marshal(b, m, deterministic)
+}
+func (m *ReplyMuSigStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMuSigStep.Merge(m, src)
+}
+func (m *ReplyMuSigStep) XXX_Size() int {
+	return xxx_messageInfo_ReplyMuSigStep.Size(m)
+}
+func (m *ReplyMuSigStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMuSigStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMuSigStep proto.InternalMessageInfo
+
+func (m *ReplyMuSigStep) GetSession() string {
+	if m != nil {
+		return m.Session
+	}
+	return ""
+}
+
+func (m *ReplyMuSigStep) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// ReqWalletImportTSSAccount 导入门限签名(TSS)账户，密钥分片保存在各个参与方，钱包只保存协调者中的keyId和公钥
+type ReqWalletImportTSSAccount struct {
+	KeyId                string   `protobuf:"bytes,1,opt,name=keyId,proto3" json:"keyId,omitempty"`
+	PubKey               string   `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqWalletImportTSSAccount) Reset()         { *m = ReqWalletImportTSSAccount{} }
+func (m *ReqWalletImportTSSAccount) String() string { return proto.CompactTextString(m) }
+func (*ReqWalletImportTSSAccount) ProtoMessage()    {}
+func (*ReqWalletImportTSSAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{36}
+}
+
+func (m *ReqWalletImportTSSAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqWalletImportTSSAccount.Unmarshal(m, b)
+}
+func (m *ReqWalletImportTSSAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqWalletImportTSSAccount.Marshal(b, m, deterministic)
+}
+func (m *ReqWalletImportTSSAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqWalletImportTSSAccount.Merge(m, src)
+}
+func (m *ReqWalletImportTSSAccount) XXX_Size() int {
+	return xxx_messageInfo_ReqWalletImportTSSAccount.Size(m)
+}
+func (m *ReqWalletImportTSSAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqWalletImportTSSAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqWalletImportTSSAccount proto.InternalMessageInfo
+
+func (m *ReqWalletImportTSSAccount) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *ReqWalletImportTSSAccount) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ReqWalletImportTSSAccount) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type WalletTSSAccounts struct {
+	Accounts             []*ReqWalletImportTSSAccount `protobuf:"bytes,1,rep,name=accounts" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *WalletTSSAccounts) Reset()         { *m = WalletTSSAccounts{} }
+func (m *WalletTSSAccounts) String() string { return proto.CompactTextString(m) }
+func (*WalletTSSAccounts) ProtoMessage()    {}
+func (*WalletTSSAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{37}
+}
+
+func (m *WalletTSSAccounts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletTSSAccounts.Unmarshal(m, b)
+}
+func (m *WalletTSSAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletTSSAccounts.Marshal(b, m, deterministic)
+}
+func (m *WalletTSSAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletTSSAccounts.Merge(m, src)
+}
+func (m *WalletTSSAccounts) XXX_Size() int {
+	return xxx_messageInfo_WalletTSSAccounts.Size(m)
+}
+func (m *WalletTSSAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletTSSAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletTSSAccounts proto.InternalMessageInfo
+
+func (m *WalletTSSAccounts) GetAccounts() []*ReqWalletImportTSSAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// ReqSignTypedData 用钱包中的账户对结构化数据签名，typedData 为EIP-712格式的json
+type ReqSignTypedData struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	TypedData            string   `protobuf:"bytes,2,opt,name=typedData,proto3" json:"typedData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqSignTypedData) Reset()         { *m = ReqSignTypedData{} }
+func (m *ReqSignTypedData) String() string { return proto.CompactTextString(m) }
+func (*ReqSignTypedData) ProtoMessage()    {}
+func (*ReqSignTypedData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{38}
+}
+
+func (m *ReqSignTypedData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSignTypedData.Unmarshal(m, b)
+}
+func (m *ReqSignTypedData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSignTypedData.Marshal(b, m, deterministic)
+}
+func (m *ReqSignTypedData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSignTypedData.Merge(m, src)
+}
+func (m *ReqSignTypedData) XXX_Size() int {
+	return xxx_messageInfo_ReqSignTypedData.Size(m)
+}
+func (m *ReqSignTypedData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSignTypedData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSignTypedData proto.InternalMessageInfo
+
+func (m *ReqSignTypedData) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqSignTypedData) GetTypedData() string {
+	if m != nil {
+		return m.TypedData
+	}
+	return ""
+}
+
+type ReplySignTypedData struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	PubKey               string   `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Signature            string   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Ty                   int32    `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplySignTypedData) Reset()         { *m = ReplySignTypedData{} }
+func (m *ReplySignTypedData) String() string { return proto.CompactTextString(m) }
+func (*ReplySignTypedData) ProtoMessage()    {}
+func (*ReplySignTypedData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{39}
+}
+
+func (m *ReplySignTypedData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplySignTypedData.Unmarshal(m, b)
+}
+func (m *ReplySignTypedData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplySignTypedData.Marshal(b, m, deterministic)
+}
+func (m *ReplySignTypedData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySignTypedData.Merge(m, src)
+}
+func (m *ReplySignTypedData) XXX_Size() int {
+	return xxx_messageInfo_ReplySignTypedData.Size(m)
+}
+func (m *ReplySignTypedData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplySignTypedData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplySignTypedData proto.InternalMessageInfo
+
+func (m *ReplySignTypedData) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ReplySignTypedData) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ReplySignTypedData) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *ReplySignTypedData) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// ReqVerifyTypedData 验证结构化数据的签名，ty 为签名类型
+type ReqVerifyTypedData struct {
+	TypedData            string   `protobuf:"bytes,1,opt,name=typedData,proto3" json:"typedData,omitempty"`
+	PubKey               string   `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Signature            string   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Ty                   int32    `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqVerifyTypedData) Reset()         { *m = ReqVerifyTypedData{} }
+func (m *ReqVerifyTypedData) String() string { return proto.CompactTextString(m) }
+func (*ReqVerifyTypedData) ProtoMessage()    {}
+func (*ReqVerifyTypedData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{40}
+}
+
+func (m *ReqVerifyTypedData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqVerifyTypedData.Unmarshal(m, b)
+}
+func (m *ReqVerifyTypedData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqVerifyTypedData.Marshal(b, m, deterministic)
+}
+func (m *ReqVerifyTypedData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqVerifyTypedData.Merge(m, src)
+}
+func (m *ReqVerifyTypedData) XXX_Size() int {
+	return xxx_messageInfo_ReqVerifyTypedData.Size(m)
+}
+func (m *ReqVerifyTypedData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqVerifyTypedData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqVerifyTypedData proto.InternalMessageInfo
+
+func (m *ReqVerifyTypedData) GetTypedData() string {
+	if m != nil {
+		return m.TypedData
+	}
+	return ""
+}
+
+func (m *ReqVerifyTypedData) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ReqVerifyTypedData) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *ReqVerifyTypedData) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*WalletTxDetail)(nil), "types.WalletTxDetail")
 	proto.RegisterType((*WalletTxDetails)(nil), "types.WalletTxDetails")
@@ -1759,91 +2348,120 @@ func init() {
 	proto.RegisterType((*Int32)(nil), "types.Int32")
 	proto.RegisterType((*ReqCreateTransaction)(nil), "types.ReqCreateTransaction")
 	proto.RegisterType((*ReqAccountList)(nil), "types.ReqAccountList")
+	proto.RegisterType((*ReqMuSigPubKeys)(nil), "types.ReqMuSigPubKeys")
+	proto.RegisterType((*ReplyMuSigPubKey)(nil), "types.ReplyMuSigPubKey")
+	proto.RegisterType((*ReqMuSigStart)(nil), "types.ReqMuSigStart")
+	proto.RegisterType((*MuSigPeerData)(nil), "types.MuSigPeerData")
+	proto.RegisterType((*ReqMuSigStep)(nil), "types.ReqMuSigStep")
+	proto.RegisterType((*ReplyMuSigStep)(nil), "types.ReplyMuSigStep")
+	proto.RegisterType((*ReqWalletImportTSSAccount)(nil), "types.ReqWalletImportTSSAccount")
+	proto.RegisterType((*WalletTSSAccounts)(nil), "types.WalletTSSAccounts")
+	proto.RegisterType((*ReqSignTypedData)(nil), "types.ReqSignTypedData")
+	proto.RegisterType((*ReplySignTypedData)(nil), "types.ReplySignTypedData")
+	proto.RegisterType((*ReqVerifyTypedData)(nil), "types.ReqVerifyTypedData")
 }
 
 func init() { proto.RegisterFile("wallet.proto", fileDescriptor_b88fd140af4deb6f) }
 
 var fileDescriptor_b88fd140af4deb6f = []byte{
-	// 1292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x6e, 0x1b, 0xb7,
-	0x13, 0xc7, 0x4a, 0x96, 0x6d, 0xd1, 0xb2, 0x93, 0x2c, 0x92, 0x40, 0xf0, 0xff, 0x9f, 0xc4, 0x61,
-	0x91, 0xd4, 0x05, 0x0a, 0x07, 0x88, 0x5e, 0x8a, 0x02, 0x05, 0xe2, 0x7c, 0x3a, 0x80, 0x93, 0x1a,
-	0x94, 0x8a, 0x02, 0x7d, 0x29, 0xa8, 0xdd, 0xb1, 0x44, 0x68, 0xb5, 0x5c, 0x73, 0x29, 0x4b, 0xba,
-	0x49, 0x0f, 0xd0, 0x23, 0xf4, 0x0c, 0x7d, 0xef, 0x3d, 0x7a, 0x88, 0x62, 0x86, 0xe4, 0x6a, 0x37,
-	0x71, 0x1f, 0x82, 0xbe, 0xf1, 0x37, 0x1c, 0xce, 0x70, 0x7e, 0xf3, 0x41, 0xb2, 0xde, 0x52, 0x66,
-	0x19, 0xd8, 0x93, 0xc2, 0x68, 0xab, 0xe3, 0x8e, 0x5d, 0x17, 0x50, 0x1e, 0xde, 0xb1, 0x46, 0xe6,
-	0xa5, 0x4c, 0xac, 0xd2, 0xb9, 0xdb, 0x39, 0xbc, 0x3d, 0xce, 0x74, 0x32, 0x4b, 0xa6, 0x52, 0x05,
-	0xc9, 0xbe, 0x4c, 0x12, 0xbd, 0xc8, 0xfd, 0xd1, 0xc3, 0x03, 0x58, 0x41, 0xb2, 0xb0, 0xda, 0x38,
-	0xcc, 0xff, 0x68, 0xb1, 0x83, 0x9f, 0xc9, 0xf6, 0x68, 0xf5, 0x1a, 0xac, 0x54, 0x59, 0xcc, 0x59,
-	0xcb, 0xae, 0xfa, 0xd1, 0x51, 0x74, 0xbc, 0xf7, 0x3c, 0x3e, 0x21, 0x57, 0x27, 0xa3, 0x8d, 0x27,
-	0xd1, 0xb2, 0xab, 0xf8, 0x5b, 0xb6, 0x63, 0x20, 0x01, 0x55, 0xd8, 0x7e, 0xab, 0xa1, 0x28, 0x9c,
-	0xf4, 0xb5, 0xb4, 0x52, 0x04, 0x95, 0xf8, 0x3e, 0xdb, 0x9e, 0x82, 0x9a, 0x4c, 0x6d, 0xbf, 0x7d,
-	0x14, 0x1d, 0xb7, 0x85, 0x47, 0xf1, 0x5d, 0xd6, 0x51, 0x79, 0x0a, 0xab, 0xfe, 0x16, 0x89, 0x1d,
-	0x88, 0xff, 0xcf, 0xba, 0x14, 0x85, 0x55, 0x73, 0xe8, 0x77, 0x68, 0x67, 0x23, 0x40, 0x5b, 0x72,
-	0x8e, 0x01, 0xf5, 0xb7, 0x9d, 0x2d, 0x87, 0xe2, 0x43, 0xb6, 0x7b, 0x69, 0xf4, 0x5c, 0xa6, 0xa9,
-	0xe9, 0xef, 0x1c, 0x45, 0xc7, 0x5d, 0x51, 0x61, 0x3c, 0x63, 0x57, 0x53, 0x59, 0x4e, 0xfb, 0xbb,
-	0x47, 0xd1, 0x71, 0x4f, 0x78, 0x14, 0x3f, 0x64, 0xcc, 0xc5, 0xf4, 0x51, 0xce, 0xa1, 0xdf, 0xa5,
-	0x53, 0x35, 0x49, 0xdc, 0x67, 0x3b, 0x85, 0x5c, 0x67, 0x5a, 0xa6, 0x7d, 0x46, 0x07, 0x03, 0xe4,
-	0x6f, 0xd9, 0xad, 0x26, 0x6b, 0x65, 0x3c, 0x60, 0x5d, 0x1b, 0x40, 0x3f, 0x3a, 0x6a, 0x1f, 0xef,
-	0x3d, 0xbf, 0xe7, 0x49, 0x69, 0xaa, 0x8a, 0x8d, 0x1e, 0xbf, 0x66, 0xb1, 0xdb, 0x3c, 0x75, 0x59,
-	0x1a, 0x5a, 0x6d, 0x9c, 0x5f, 0xa3, 0xae, 0x67, 0xb0, 0xa6, 0x34, 0x74, 0x45, 0x80, 0xc8, 0x58,
-	0x26, 0xc7, 0x90, 0x11, 0xeb, 0x5d, 0xe1, 0x40, 0x1c, 0xb3, 0x2d, 0x8a, 0xbb, 0x4d, 0x42, 0x5a,
-	0x23, 0x8b, 0xc8, 0xd7, 0xd0, 0xca, 0x79, 0x41, 0xfc, 0x76, 0xc5, 0x46, 0xc0, 0x5f, 0xb0, 0x9e,
-	0xf3, 0x7b, 0xb1, 0x3c, 0x43, 0x26, 0xee, 0xb3, 0xed, 0x82, 0x56, 0xe4, 0xb0, 0x27, 0x3c, 0xc2,
-	0x9b, 0x18, 0x99, 0xa7, 0xa5, 0x35, 0xde, 0x63, 0x80, 0xfc, 0xb7, 0x28, 0x98, 0x18, 0x5a, 0x69,
-	0x17, 0x65, 0xcc, 0x59, 0x4f, 0x95, 0x4e, 0x72, 0xae, 0x93, 0x19, 0x19, 0xda, 0x15, 0x0d, 0x99,
-	0xd3, 0x39, 0x5d, 0x58, 0xfd, 0x41, 0xe5, 0x2a, 0x9f, 0x90, 0x4d, 0xd2, 0xd9, 0xc8, 0xf0, 0xe2,
-	0xaa, 0x3c, 0x93, 0xe5, 0x10, 0x20, 0xa5, 0x88, 0x76, 0xc5, 0x46, 0xe0, 0x2c, 0x8c, 0x54, 0x32,
-	0xf3, 0x5e, 0xb6, 0x82, 0x85, 0x8d, 0x8c, 0xbf, 0x08, 0x25, 0xed, 0x49, 0x2d, 0xe3, 0x13, 0xb6,
-	0xe3, 0x1a, 0x28, 0x64, 0xe6, 0x6e, 0x23, 0x33, 0x5e, 0x4f, 0x04, 0x25, 0xfe, 0x8e, 0xed, 0x37,
-	0x76, 0xe2, 0x23, 0xd6, 0x96, 0x49, 0xe2, 0x9b, 0xe2, 0xc0, 0x1f, 0x0e, 0xc7, 0x70, 0xeb, 0xe6,
-	0xcc, 0xf0, 0x69, 0x20, 0xe9, 0xa7, 0x9c, 0x08, 0x40, 0x9e, 0x65, 0x59, 0x2e, 0x53, 0x9f, 0x58,
-	0x8f, 0x90, 0x67, 0x4c, 0x8e, 0x5e, 0xb8, 0x7e, 0x6a, 0x8b, 0x00, 0xe3, 0xa7, 0xec, 0xc0, 0xdd,
-	0xea, 0x47, 0xe3, 0x42, 0xf4, 0x9c, 0x7c, 0x22, 0xe5, 0x8f, 0xd9, 0xde, 0x3b, 0xc8, 0x91, 0xa3,
-	0x73, 0x99, 0x4f, 0xb0, 0x24, 0x32, 0x99, 0x4f, 0xc8, 0x4d, 0x47, 0xd0, 0x9a, 0x3f, 0x41, 0x15,
-	0x8b, 0x2a, 0x2f, 0xd7, 0x17, 0xcb, 0x7f, 0xbb, 0x0b, 0xff, 0x9e, 0xf5, 0x86, 0xf2, 0x1a, 0x2a,
-	0xbd, 0x98, 0x6d, 0x95, 0x98, 0x0b, 0xa7, 0x45, 0xeb, 0xda, 0xd9, 0x56, 0xe3, 0xec, 0x23, 0xd6,
-	0x15, 0x50, 0x64, 0x6b, 0xca, 0xd5, 0x0d, 0x07, 0xf9, 0x19, 0x8b, 0x05, 0x5c, 0xf9, 0xc2, 0x01,
-	0x7b, 0x51, 0x85, 0xaf, 0xb3, 0x14, 0x41, 0x28, 0x78, 0x0f, 0x71, 0x27, 0x87, 0x25, 0xed, 0xf8,
-	0x02, 0xf4, 0x90, 0x3f, 0x61, 0xfb, 0x02, 0xae, 0x3e, 0xc2, 0x32, 0xe4, 0xa8, 0xca, 0x40, 0x54,
-	0xcf, 0xc0, 0x25, 0xeb, 0x57, 0x0e, 0x6b, 0x53, 0xec, 0x5c, 0x95, 0x34, 0x97, 0x70, 0x46, 0x8c,
-	0x56, 0xa1, 0xea, 0x1d, 0x42, 0x4b, 0x64, 0x92, 0x5c, 0x76, 0x84, 0x03, 0x58, 0x98, 0xa9, 0x32,
-	0x40, 0xc7, 0x29, 0x09, 0x1d, 0xb1, 0x11, 0xf0, 0x33, 0x76, 0xbf, 0xf2, 0xf3, 0x7e, 0x5e, 0x68,
-	0x63, 0x2f, 0x7c, 0xcf, 0x7e, 0x61, 0x37, 0xf3, 0xdf, 0xa3, 0x9a, 0xa9, 0x21, 0xe4, 0xe9, 0x48,
-	0x9f, 0xa6, 0xa9, 0x81, 0xb2, 0x44, 0x46, 0xf1, 0x8a, 0x81, 0x51, 0x5c, 0xc7, 0x07, 0xac, 0x65,
-	0xb5, 0xb7, 0xd0, 0xb2, 0xba, 0x36, 0x20, 0xdb, 0x8d, 0x01, 0x19, 0xb3, 0xad, 0x5c, 0x5b, 0xf0,
-	0xb3, 0x80, 0xd6, 0x78, 0x35, 0x55, 0x8e, 0xf4, 0x0c, 0x72, 0x1a, 0xb4, 0xbb, 0x22, 0xc0, 0xf8,
-	0x88, 0xed, 0x59, 0x5c, 0x0c, 0xd7, 0xf3, 0xb1, 0xce, 0x68, 0xd6, 0x76, 0x45, 0x5d, 0xc4, 0xbf,
-	0x61, 0xb7, 0xea, 0x99, 0x7c, 0x0b, 0xf5, 0xd9, 0x1c, 0xd5, 0x5d, 0xf3, 0x1f, 0xd8, 0x9d, 0xba,
-	0xea, 0x79, 0x63, 0x68, 0x45, 0xb5, 0xa1, 0x75, 0x33, 0x21, 0x5f, 0xb3, 0x7b, 0xd5, 0xf1, 0x0f,
-	0x60, 0x26, 0xf0, 0x52, 0x66, 0x32, 0x4f, 0xc0, 0x87, 0x1e, 0x85, 0xd0, 0xf9, 0x5f, 0x11, 0x39,
-	0xa2, 0x08, 0x2e, 0x0c, 0xbc, 0x32, 0x20, 0x2d, 0xc4, 0x8f, 0x59, 0x2f, 0xc1, 0x95, 0x36, 0xbf,
-	0xd6, 0x1c, 0xee, 0x79, 0x19, 0x52, 0x4b, 0xdc, 0xe0, 0x13, 0xd0, 0xf2, 0xdc, 0x48, 0xf7, 0xd0,
-	0x94, 0x2e, 0x78, 0x37, 0x56, 0x3d, 0xa2, 0x09, 0x94, 0x5b, 0xa3, 0xd3, 0x85, 0xab, 0x04, 0xc7,
-	0x67, 0x43, 0x16, 0x3f, 0x60, 0x4c, 0x2f, 0x73, 0xf0, 0x0e, 0x3b, 0x6e, 0xfa, 0x92, 0xe4, 0xd4,
-	0x87, 0x69, 0xb5, 0x95, 0x99, 0x7f, 0xc2, 0x1c, 0x40, 0x69, 0x61, 0x54, 0x02, 0xf4, 0x7c, 0xb5,
-	0x85, 0x03, 0xdc, 0xb0, 0xbb, 0x21, 0xa4, 0xb7, 0x2a, 0x57, 0xe5, 0xd4, 0x47, 0xf5, 0x15, 0xdb,
-	0xbf, 0x24, 0x0c, 0x8d, 0xb0, 0x7a, 0x41, 0x78, 0xea, 0x1f, 0x3e, 0x1f, 0x43, 0xab, 0x11, 0x43,
-	0xf3, 0x7e, 0xed, 0x4f, 0xee, 0xc7, 0x8b, 0x8d, 0x4f, 0x01, 0xd7, 0x7a, 0x56, 0x63, 0xd2, 0x10,
-	0x6e, 0x32, 0xe9, 0x65, 0xff, 0xc5, 0x23, 0x50, 0x31, 0x7d, 0xd0, 0xa9, 0xba, 0x5c, 0xbf, 0xd2,
-	0xf9, 0xa5, 0x9a, 0xc4, 0xb7, 0x59, 0x7b, 0xd3, 0x32, 0xb8, 0xc4, 0x74, 0xeb, 0x22, 0x54, 0xba,
-	0x2e, 0x90, 0xb0, 0x6b, 0x99, 0x2d, 0xc0, 0x9b, 0x73, 0x00, 0x3f, 0x02, 0x73, 0xb4, 0xa3, 0xc0,
-	0xf8, 0xdc, 0x54, 0x98, 0xff, 0x19, 0xb1, 0x9e, 0x80, 0xab, 0xa1, 0x9a, 0xe4, 0x42, 0x2e, 0x47,
-	0xab, 0x1b, 0x8b, 0xb0, 0xd6, 0xaf, 0xad, 0xcf, 0xfa, 0xd5, 0xae, 0xce, 0x60, 0x15, 0x1c, 0x12,
-	0xc0, 0x90, 0x61, 0x55, 0x28, 0x13, 0x5a, 0xcb, 0xa3, 0xcd, 0xef, 0xa6, 0xe3, 0xa6, 0x88, 0xfb,
-	0xdd, 0x50, 0xee, 0xb1, 0xe1, 0x76, 0xbc, 0x0d, 0x6a, 0xb7, 0xdb, 0xac, 0x7d, 0x09, 0x40, 0xdf,
-	0x93, 0xb6, 0xc0, 0x25, 0x4e, 0x9b, 0x1c, 0x96, 0xae, 0xf5, 0xe9, 0xf7, 0xd1, 0x15, 0x1b, 0x01,
-	0x7f, 0xca, 0x0e, 0xdc, 0x9c, 0xad, 0x22, 0xa9, 0xee, 0x16, 0xd5, 0xee, 0xc6, 0xc7, 0xa4, 0xa7,
-	0x8d, 0x7d, 0x63, 0xcc, 0x9b, 0x6b, 0xc8, 0x2d, 0xfe, 0x79, 0x70, 0x6c, 0xcc, 0x75, 0xba, 0xc8,
-	0xc0, 0x2b, 0xd7, 0x24, 0x48, 0x9f, 0xd5, 0x7e, 0xd7, 0x85, 0x5f, 0x61, 0xf4, 0x01, 0xc6, 0xe8,
-	0x90, 0x3f, 0x07, 0xf8, 0xff, 0x58, 0xe7, 0x7d, 0x6e, 0x07, 0xcf, 0x91, 0xcc, 0x54, 0x5a, 0x19,
-	0xde, 0x1c, 0x5c, 0xf3, 0xbf, 0x23, 0xaa, 0x25, 0x57, 0x40, 0xb5, 0xf9, 0x4b, 0xff, 0x13, 0x0c,
-	0x9d, 0xfa, 0x2e, 0xf2, 0xff, 0x93, 0x20, 0x40, 0x53, 0xf8, 0xc6, 0xfa, 0x01, 0x4c, 0xeb, 0x2f,
-	0x1a, 0x6c, 0x61, 0x50, 0x76, 0x3e, 0x1b, 0x94, 0xdb, 0xd5, 0xa0, 0x7c, 0xc8, 0x58, 0xb1, 0x18,
-	0xcf, 0x60, 0x5d, 0x48, 0x15, 0x28, 0xae, 0x49, 0xa8, 0x90, 0xd4, 0xca, 0x3d, 0x04, 0x7b, 0x74,
-	0x8f, 0x0a, 0xd7, 0x72, 0xde, 0x73, 0x77, 0x71, 0x88, 0x7f, 0x87, 0x7c, 0x5f, 0xf9, 0x17, 0x89,
-	0xde, 0x18, 0x7c, 0xbf, 0x95, 0x9d, 0xea, 0x85, 0xf5, 0x53, 0xcb, 0x7f, 0x8c, 0x3e, 0x91, 0xbe,
-	0x7c, 0xf4, 0xcb, 0x83, 0x89, 0xb2, 0xd3, 0xc5, 0xf8, 0x24, 0xd1, 0xf3, 0x67, 0x83, 0x41, 0x92,
-	0x3f, 0xa3, 0x6f, 0xfc, 0x60, 0xf0, 0x8c, 0x7e, 0x1b, 0xe3, 0x6d, 0xfa, 0xb0, 0x0f, 0xfe, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0x9c, 0xe0, 0x98, 0x89, 0x0b, 0x0c, 0x00, 0x00,
+	// 1582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xd9, 0x6e, 0x1b, 0xbd,
+	0x15, 0xc6, 0x8c, 0x2c, 0xdb, 0xa2, 0x65, 0xff, 0xce, 0x20, 0x7f, 0xa0, 0xba, 0x59, 0x14, 0x16,
+	0x49, 0xdd, 0x05, 0x0e, 0x10, 0xdf, 0x75, 0x09, 0xe2, 0x2c, 0x8e, 0x83, 0x3a, 0xa9, 0x4a, 0xa9,
+	0x0b, 0x7a, 0x13, 0x50, 0x33, 0xc7, 0xd2, 0xc0, 0xd2, 0x70, 0xcc, 0xa1, 0x2c, 0xe9, 0x15, 0xfa,
+	0x04, 0x7d, 0x80, 0x3e, 0x4c, 0x6f, 0xfb, 0x1e, 0x7d, 0x88, 0xe2, 0x1c, 0x92, 0xb3, 0x38, 0x76,
+	0xd1, 0xa0, 0xff, 0x1d, 0xbf, 0x33, 0x87, 0x3c, 0xfb, 0x32, 0xac, 0xbb, 0x94, 0xb3, 0x19, 0x98,
+	0xa3, 0x5c, 0x2b, 0xa3, 0xa2, 0xb6, 0x59, 0xe7, 0x50, 0x1c, 0xdc, 0x33, 0x5a, 0x66, 0x85, 0x8c,
+	0x4d, 0xaa, 0x32, 0xfb, 0xe5, 0x60, 0x7f, 0x3c, 0x53, 0xf1, 0x65, 0x3c, 0x95, 0xa9, 0xa7, 0xec,
+	0xca, 0x38, 0x56, 0x8b, 0xcc, 0x5d, 0x3d, 0xd8, 0x83, 0x15, 0xc4, 0x0b, 0xa3, 0xb4, 0xc5, 0xfc,
+	0x9f, 0x21, 0xdb, 0xfb, 0x33, 0xbd, 0x3d, 0x5a, 0xbd, 0x03, 0x23, 0xd3, 0x59, 0xc4, 0x59, 0x68,
+	0x56, 0xbd, 0xa0, 0x1f, 0x1c, 0xee, 0xbc, 0x8c, 0x8e, 0x48, 0xd4, 0xd1, 0xa8, 0x92, 0x24, 0x42,
+	0xb3, 0x8a, 0x7e, 0xc9, 0xb6, 0x34, 0xc4, 0x90, 0xe6, 0xa6, 0x17, 0x36, 0x18, 0x85, 0xa5, 0xbe,
+	0x93, 0x46, 0x0a, 0xcf, 0x12, 0x3d, 0x60, 0x9b, 0x53, 0x48, 0x27, 0x53, 0xd3, 0x6b, 0xf5, 0x83,
+	0xc3, 0x96, 0x70, 0x28, 0xba, 0xcf, 0xda, 0x69, 0x96, 0xc0, 0xaa, 0xb7, 0x41, 0x64, 0x0b, 0xa2,
+	0x87, 0xac, 0x43, 0x56, 0x98, 0x74, 0x0e, 0xbd, 0x36, 0x7d, 0xa9, 0x08, 0xf8, 0x96, 0x9c, 0xa3,
+	0x41, 0xbd, 0x4d, 0xfb, 0x96, 0x45, 0xd1, 0x01, 0xdb, 0xbe, 0xd0, 0x6a, 0x2e, 0x93, 0x44, 0xf7,
+	0xb6, 0xfa, 0xc1, 0x61, 0x47, 0x94, 0x18, 0xef, 0x98, 0xd5, 0x54, 0x16, 0xd3, 0xde, 0x76, 0x3f,
+	0x38, 0xec, 0x0a, 0x87, 0xa2, 0xc7, 0x8c, 0x59, 0x9b, 0x3e, 0xcb, 0x39, 0xf4, 0x3a, 0x74, 0xab,
+	0x46, 0x89, 0x7a, 0x6c, 0x2b, 0x97, 0xeb, 0x99, 0x92, 0x49, 0x8f, 0xd1, 0x45, 0x0f, 0xa3, 0x88,
+	0x6d, 0x64, 0xca, 0x40, 0x6f, 0x87, 0xc8, 0x74, 0xe6, 0xa7, 0xec, 0xbb, 0xa6, 0x27, 0x8b, 0xe8,
+	0x98, 0x75, 0x8c, 0x07, 0xbd, 0xa0, 0xdf, 0x3a, 0xdc, 0x79, 0xf9, 0xbd, 0x73, 0x54, 0x93, 0x55,
+	0x54, 0x7c, 0xfc, 0x9a, 0x45, 0xf6, 0xe3, 0x89, 0x8d, 0xdc, 0xd0, 0x28, 0x6d, 0x75, 0xd1, 0xe9,
+	0xf5, 0x25, 0xac, 0x29, 0x34, 0x1d, 0xe1, 0x21, 0x7a, 0x71, 0x26, 0xc7, 0x30, 0xa3, 0x48, 0x74,
+	0x84, 0x05, 0xa8, 0x21, 0xf9, 0xa2, 0x45, 0x44, 0x3a, 0xa3, 0x67, 0xd1, 0x87, 0x43, 0x23, 0xe7,
+	0x39, 0xf9, 0xbc, 0x23, 0x2a, 0x02, 0x7f, 0xcd, 0xba, 0x56, 0xee, 0x60, 0x79, 0x86, 0xde, 0x79,
+	0xc0, 0x36, 0x73, 0x3a, 0x91, 0xc0, 0xae, 0x70, 0x08, 0x35, 0xd1, 0x32, 0x4b, 0x0a, 0xa3, 0x9d,
+	0x44, 0x0f, 0xf9, 0xdf, 0x03, 0xff, 0xc4, 0xd0, 0x48, 0xb3, 0x28, 0x22, 0xce, 0xba, 0x69, 0x61,
+	0x29, 0xe7, 0x2a, 0xbe, 0xa4, 0x87, 0xb6, 0x45, 0x83, 0x66, 0x79, 0x4e, 0x16, 0x46, 0x7d, 0x4a,
+	0xb3, 0x34, 0x9b, 0xf4, 0x42, 0xcf, 0x53, 0xd1, 0x50, 0xf1, 0xb4, 0x38, 0x93, 0xc5, 0x10, 0x20,
+	0x21, 0x8b, 0xb6, 0x45, 0x45, 0xb0, 0x2f, 0x8c, 0xd2, 0xf8, 0xd2, 0x49, 0xd9, 0xf0, 0x2f, 0x54,
+	0x34, 0xfe, 0x9a, 0xed, 0x35, 0x9c, 0x5a, 0x44, 0x47, 0x6c, 0xcb, 0x16, 0x95, 0x8f, 0xcc, 0xfd,
+	0x46, 0x64, 0x1c, 0x9f, 0xf0, 0x4c, 0xfc, 0x03, 0xdb, 0x6d, 0x7c, 0x89, 0xfa, 0xac, 0x25, 0xe3,
+	0xd8, 0x15, 0xca, 0x9e, 0xbb, 0xec, 0xaf, 0xe1, 0xa7, 0xdb, 0x23, 0xc3, 0xa7, 0xde, 0x49, 0x7f,
+	0xcc, 0xc8, 0x01, 0xe8, 0x67, 0x59, 0x14, 0xcb, 0xc4, 0x05, 0xd6, 0x21, 0xf4, 0x33, 0x06, 0x47,
+	0x2d, 0x6c, 0x8d, 0xb5, 0x84, 0x87, 0xd1, 0x73, 0xb6, 0x67, 0xb5, 0xfa, 0xbd, 0xb6, 0x26, 0x3a,
+	0x9f, 0xdc, 0xa0, 0xf2, 0xa7, 0x6c, 0xe7, 0x03, 0x64, 0xe8, 0xa3, 0x73, 0x99, 0x4d, 0x30, 0x25,
+	0x66, 0x32, 0x9b, 0x90, 0x98, 0xb6, 0xa0, 0x33, 0x7f, 0x86, 0x2c, 0x06, 0x59, 0xde, 0xac, 0x07,
+	0xcb, 0xbb, 0x74, 0xe1, 0xbf, 0x62, 0xdd, 0xa1, 0xbc, 0x86, 0x92, 0x2f, 0x62, 0x1b, 0x05, 0x80,
+	0xe7, 0xa2, 0x73, 0xed, 0x6e, 0xd8, 0xb8, 0xfb, 0x84, 0x75, 0x04, 0xe4, 0xb3, 0x35, 0xc5, 0xea,
+	0x96, 0x8b, 0xfc, 0x8c, 0x45, 0x02, 0xae, 0x5c, 0xe2, 0x80, 0x19, 0x94, 0xe6, 0xab, 0x59, 0x82,
+	0xc0, 0x27, 0xbc, 0x83, 0xf8, 0x25, 0x83, 0x25, 0x7d, 0x71, 0x09, 0xe8, 0x20, 0x7f, 0xc6, 0x76,
+	0x05, 0x5c, 0x7d, 0x86, 0xa5, 0x8f, 0x51, 0x19, 0x81, 0xa0, 0x1e, 0x81, 0x0b, 0xd6, 0x2b, 0x05,
+	0xd6, 0x3a, 0xdb, 0x79, 0x5a, 0x50, 0xaf, 0xc2, 0xbe, 0x31, 0x5a, 0xf9, 0xac, 0xb7, 0x08, 0x5f,
+	0xa2, 0x27, 0x49, 0x64, 0x5b, 0x58, 0x80, 0x89, 0x99, 0xa4, 0x1a, 0xe8, 0x3a, 0x05, 0xa1, 0x2d,
+	0x2a, 0x02, 0x3f, 0x63, 0x0f, 0x4a, 0x39, 0x1f, 0xe7, 0xb9, 0xd2, 0x66, 0xe0, 0x6a, 0xf6, 0x1b,
+	0xab, 0x99, 0xff, 0x23, 0xa8, 0x3d, 0x35, 0x84, 0x2c, 0x19, 0xa9, 0x93, 0x24, 0xd1, 0x50, 0x14,
+	0xe8, 0x51, 0x54, 0xd1, 0x7b, 0x14, 0xcf, 0xd1, 0x1e, 0x0b, 0x8d, 0x72, 0x2f, 0x84, 0x46, 0xd5,
+	0x9a, 0x66, 0xab, 0xd1, 0x34, 0x7d, 0x1b, 0xb3, 0xbd, 0x80, 0xce, 0xa8, 0x5a, 0x5a, 0x8c, 0xd4,
+	0x25, 0x64, 0xd4, 0x7c, 0xb7, 0x85, 0x87, 0x51, 0x9f, 0xed, 0x18, 0x3c, 0x0c, 0xd7, 0xf3, 0xb1,
+	0x9a, 0x51, 0xff, 0xed, 0x88, 0x3a, 0x89, 0xff, 0x8c, 0x7d, 0x57, 0x8f, 0xe4, 0x29, 0xd4, 0xfb,
+	0x75, 0x50, 0x17, 0xcd, 0x7f, 0xcb, 0xee, 0xd5, 0x59, 0xcf, 0x1b, 0x4d, 0x2b, 0xa8, 0x35, 0xad,
+	0xdb, 0x1d, 0xf2, 0x53, 0xf6, 0x7d, 0x79, 0xfd, 0x13, 0xe8, 0x09, 0xbc, 0x91, 0x33, 0x99, 0xc5,
+	0xe0, 0x4c, 0x0f, 0xbc, 0xe9, 0xfc, 0x5f, 0x01, 0x09, 0x22, 0x0b, 0x06, 0x1a, 0xde, 0x6a, 0x90,
+	0x06, 0xa2, 0xa7, 0xac, 0x1b, 0xe3, 0x49, 0xe9, 0x2f, 0x35, 0x81, 0x3b, 0x8e, 0x86, 0xae, 0x25,
+	0xdf, 0xe0, 0x58, 0x08, 0x9d, 0x6f, 0xa4, 0x1d, 0x3e, 0x85, 0x35, 0xde, 0xb6, 0x55, 0x87, 0xa8,
+	0x03, 0x65, 0x46, 0xab, 0x64, 0x61, 0x33, 0xc1, 0xfa, 0xb3, 0x41, 0x8b, 0x1e, 0x31, 0xa6, 0x96,
+	0x19, 0x38, 0x81, 0x6d, 0xe2, 0xe8, 0x10, 0xe5, 0xc4, 0x99, 0x69, 0x94, 0x91, 0x33, 0x37, 0xd6,
+	0x2c, 0x40, 0x6a, 0xae, 0xd3, 0x18, 0x68, 0xa4, 0xb5, 0x84, 0x05, 0x5c, 0xb3, 0xfb, 0xde, 0xa4,
+	0xd3, 0x34, 0x4b, 0x8b, 0xa9, 0xb3, 0xea, 0x27, 0x6c, 0xf7, 0x82, 0x30, 0x34, 0xcc, 0xea, 0x7a,
+	0xe2, 0x89, 0x1b, 0x86, 0xce, 0x86, 0xb0, 0x61, 0x43, 0x53, 0xbf, 0xd6, 0x0d, 0xfd, 0x78, 0x5e,
+	0xc9, 0x14, 0x70, 0xad, 0x2e, 0x6b, 0x9e, 0xd4, 0x84, 0x9b, 0x9e, 0x74, 0xb4, 0xff, 0x47, 0x22,
+	0x50, 0x32, 0x7d, 0x52, 0x49, 0x7a, 0xb1, 0x7e, 0xab, 0xb2, 0x8b, 0x74, 0x12, 0xed, 0xb3, 0x56,
+	0x55, 0x32, 0x78, 0xc4, 0x70, 0xab, 0xdc, 0x67, 0xba, 0xca, 0xd1, 0x61, 0xd7, 0x72, 0xb6, 0x00,
+	0xf7, 0x9c, 0x05, 0xb8, 0x1c, 0xcc, 0xf1, 0x9d, 0x14, 0xb4, 0x8b, 0x4d, 0x89, 0xf9, 0xdf, 0x42,
+	0xd6, 0x15, 0x70, 0x35, 0x4c, 0x27, 0x99, 0x90, 0xcb, 0xd1, 0xea, 0xd6, 0x24, 0xac, 0xd5, 0x6b,
+	0xf8, 0x55, 0xbd, 0x9a, 0xd5, 0x19, 0xac, 0xbc, 0x40, 0x02, 0x68, 0x32, 0xac, 0xf2, 0x54, 0xfb,
+	0xd2, 0x72, 0xa8, 0xda, 0x78, 0xda, 0xb6, 0x8b, 0x10, 0xb0, 0xb1, 0xc7, 0x82, 0xdb, 0x72, 0x6f,
+	0x20, 0x40, 0x63, 0x2f, 0x00, 0x68, 0x65, 0x69, 0x09, 0x3c, 0x62, 0xb7, 0xc9, 0x60, 0x69, 0x4b,
+	0x9f, 0x36, 0x92, 0x8e, 0xa8, 0x08, 0xb4, 0x01, 0x01, 0x0c, 0xe4, 0x1a, 0x34, 0xed, 0x25, 0xdb,
+	0xa2, 0xc4, 0x38, 0x31, 0xfc, 0x79, 0xb0, 0x18, 0xa3, 0x19, 0x5d, 0xba, 0x7e, 0x83, 0xca, 0x9f,
+	0xb3, 0x3d, 0xdb, 0xab, 0x4b, 0x6f, 0x94, 0xf6, 0x05, 0x35, 0xfb, 0xf8, 0x98, 0xf8, 0x94, 0x36,
+	0xef, 0xb5, 0x7e, 0x7f, 0x0d, 0x99, 0xc1, 0x5d, 0x0a, 0x5b, 0xcf, 0x5c, 0x25, 0x8b, 0x19, 0x38,
+	0xe6, 0x1a, 0x05, 0xb5, 0x33, 0xca, 0x7d, 0xb5, 0x2e, 0x2c, 0x31, 0xca, 0x00, 0xad, 0x95, 0xcf,
+	0x01, 0x0b, 0xf8, 0x8f, 0x59, 0xfb, 0x63, 0x66, 0x8e, 0x5f, 0x62, 0x40, 0x12, 0x69, 0xa4, 0x9f,
+	0x5b, 0x78, 0xe6, 0xff, 0x0e, 0x28, 0x1f, 0x6d, 0x12, 0xd6, 0x7a, 0x38, 0xed, 0x38, 0xe8, 0x3e,
+	0xaa, 0xdd, 0xc0, 0xed, 0x38, 0x9e, 0x80, 0x4f, 0xe1, 0x9c, 0x76, 0x4d, 0x9c, 0xce, 0xdf, 0xd4,
+	0x1c, 0x7d, 0xb3, 0x6d, 0x7f, 0xd5, 0x6c, 0x37, 0xcb, 0x66, 0xfb, 0x98, 0xb1, 0x9c, 0xbc, 0x99,
+	0xcb, 0xd4, 0x87, 0xa9, 0x46, 0xa1, 0x64, 0x4c, 0x57, 0x76, 0x98, 0xec, 0x90, 0x1e, 0x25, 0xae,
+	0xe5, 0x4d, 0xd7, 0xea, 0x62, 0x11, 0xff, 0x0b, 0xfa, 0xfb, 0xca, 0x4d, 0x35, 0x9a, 0x53, 0xb8,
+	0x03, 0xa4, 0x66, 0xaa, 0x16, 0xc6, 0x75, 0x3e, 0xb7, 0x5c, 0xdd, 0xa0, 0xa2, 0x36, 0x98, 0xc1,
+	0xa7, 0x4a, 0xcf, 0xa5, 0x71, 0x9e, 0xaf, 0x51, 0xf8, 0x2f, 0x6c, 0x95, 0x2d, 0x86, 0xe9, 0x64,
+	0xb0, 0x18, 0xff, 0x0e, 0xd6, 0x34, 0x5f, 0x73, 0x7b, 0xa4, 0xcd, 0xa8, 0x23, 0x3c, 0xe4, 0xaf,
+	0xd8, 0x3e, 0xa5, 0x47, 0x8d, 0x9d, 0xc6, 0x3e, 0x9d, 0xca, 0x95, 0xc1, 0xd2, 0x7d, 0x19, 0x85,
+	0x55, 0x19, 0xf1, 0x21, 0xcd, 0x67, 0xba, 0x3d, 0x34, 0x52, 0x9b, 0x3b, 0x6b, 0xcd, 0x89, 0x0f,
+	0x1b, 0xe2, 0x6f, 0xaf, 0x35, 0xfe, 0x6b, 0xb6, 0x6b, 0xf5, 0x01, 0xd0, 0xf8, 0xdf, 0xf1, 0xdf,
+	0x34, 0xa2, 0x3c, 0x72, 0x1a, 0xe1, 0x99, 0x8f, 0xa8, 0xf8, 0x9d, 0x46, 0x90, 0xa3, 0xf0, 0x02,
+	0x8a, 0x02, 0x9b, 0xb8, 0x1b, 0xcc, 0x0e, 0x46, 0x3f, 0x67, 0xed, 0x1c, 0x40, 0x5b, 0xa5, 0xaa,
+	0x6d, 0xb1, 0x21, 0x5a, 0x58, 0x16, 0xfe, 0xca, 0x95, 0xd1, 0xff, 0xf2, 0xee, 0x6d, 0x5a, 0x7d,
+	0x61, 0x3f, 0xba, 0xb1, 0x38, 0x8c, 0x86, 0xc3, 0xda, 0x4e, 0x73, 0x09, 0xeb, 0x8f, 0x7e, 0x87,
+	0xb2, 0xa0, 0x66, 0x74, 0xd8, 0x30, 0xba, 0x1c, 0x9f, 0xad, 0xfa, 0xf8, 0xfc, 0x03, 0xbb, 0xe7,
+	0xd6, 0x9f, 0xf2, 0xdd, 0x22, 0xfa, 0x0d, 0xdb, 0x76, 0x3f, 0x8b, 0x7e, 0x25, 0xee, 0x97, 0x7f,
+	0x75, 0x77, 0x28, 0x23, 0xca, 0x1b, 0xfc, 0x1d, 0xdb, 0x77, 0x6d, 0x74, 0xb4, 0xce, 0x21, 0xa1,
+	0x48, 0xdc, 0x16, 0x5e, 0x2c, 0x50, 0xcf, 0xe0, 0x74, 0xad, 0x08, 0x3c, 0x63, 0x51, 0xd9, 0x80,
+	0x1a, 0xef, 0x4c, 0xfd, 0x8f, 0x48, 0x47, 0xd0, 0xf9, 0x4e, 0x83, 0x1f, 0xb2, 0x4e, 0x91, 0x4e,
+	0x32, 0x69, 0x16, 0xda, 0x4f, 0x81, 0x8a, 0x40, 0xc5, 0xba, 0xa6, 0x92, 0x6e, 0x8b, 0xd0, 0xac,
+	0xf9, 0x8a, 0x76, 0xcf, 0x3f, 0x81, 0x4e, 0x2f, 0xd6, 0x95, 0xbc, 0x86, 0x8e, 0xc1, 0x0d, 0x1d,
+	0x7f, 0x18, 0xc9, 0x6f, 0x9e, 0xfc, 0xf5, 0xd1, 0x24, 0x35, 0xd3, 0xc5, 0xf8, 0x28, 0x56, 0xf3,
+	0x17, 0xc7, 0xc7, 0x71, 0xf6, 0x82, 0xfe, 0xdb, 0x8f, 0x8f, 0x5f, 0x90, 0xd3, 0xc7, 0x9b, 0xf4,
+	0x87, 0x7e, 0xfc, 0x9f, 0x01, 0x00, 0x85, 0x26, 0x5d, 0x6d, 0xfc, 0x0f, 0x00, 0x00,
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/33cn/chain33/util"
 
 	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/limits"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
@@ -137,11 +138,18 @@ func RunChain33(name string) {
 	version.SetStoreDBVersion(cfg.Store.StoreDBVersion)
 	version.SetAppVersion(cfg.Version)
 	log.Info(cfg.Title + "-app:" + version.GetAppVersion() + " chain33:" + version.GetVersion() + " localdb:" + version.GetLocalDBVersion() + " statedb:" + version.GetStoreDBVersion())
+	//只读节点数据库只读打开，不接收交易，不同步区块不出块
+	dbm.SetReadOnly(cfg.ReadOnly)
 	log.Info("loading queue")
 	q := queue.New("channel")
 
 	log.Info("loading mempool module")
-	mem := mempool.New(cfg.Mempool, sub.Mempool)
+	var mem queue.Module
+	if cfg.ReadOnly {
+		mem = &util.MockModule{Key: "mempool"}
+	} else {
+		mem = mempool.New(cfg.Mempool, sub.Mempool)
+	}
 	mem.SetQueueClient(q.Client())
 
 	log.Info("loading execs module")
@@ -156,15 +164,22 @@ func RunChain33(name string) {
 	s := store.New(cfg.Store, sub.Store)
 	s.SetQueueClient(q.Client())
 
-	chain.Upgrade()
+	if !cfg.ReadOnly {
+		chain.Upgrade()
+	}
 
 	log.Info("loading consensus module")
-	cs := consensus.New(cfg.Consensus, sub.Consensus)
+	var cs queue.Module
+	if cfg.ReadOnly {
+		cs = &util.MockModule{Key: "consensus"}
+	} else {
+		cs = consensus.New(cfg.Consensus, sub.Consensus)
+	}
 	cs.SetQueueClient(q.Client())
 
 	log.Info("loading p2p module")
 	var network queue.Module
-	if cfg.P2P.Enable && !types.IsPara() {
+	if cfg.P2P.Enable && !types.IsPara() && !cfg.ReadOnly {
 		network = p2p.New(cfg.P2P)
 	} else {
 		network = &util.MockModule{Key: "p2p"}
@@ -176,7 +191,12 @@ func RunChain33(name string) {
 	rpcapi.SetQueueClient(q.Client())
 
 	log.Info("loading wallet module")
-	walletm := wallet.New(cfg.Wallet, sub.Wallet)
+	var walletm queue.Module
+	if cfg.ReadOnly {
+		walletm = &util.MockModule{Key: "wallet"}
+	} else {
+		walletm = wallet.New(cfg.Wallet, sub.Wallet)
+	}
 	walletm.SetQueueClient(q.Client())
 
 	health := util.NewHealthCheckServer(q.Client())
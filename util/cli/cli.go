@@ -22,6 +22,32 @@ import (
 var rootCmd = &cobra.Command{
 	Use:   types.GetTitle() + "-cli",
 	Short: types.GetTitle() + " client tools",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		output, _ := cmd.Flags().GetString("output")
+		if err := jsonclient.SetOutputFormat(output); err != nil {
+			return err
+		}
+		setChainID(cmd)
+		return nil
+	},
+}
+
+//setChainID 命令行没有加载链的配置，构造交易使用的链标识由参数指定，没有指定的时候从节点读取
+func setChainID(cmd *cobra.Command) {
+	chainID, _ := cmd.Flags().GetInt32("chain_id")
+	if chainID < 0 {
+		rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+		rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+		if err != nil {
+			return
+		}
+		var res types.VersionInfo
+		if err := rpc.Call("Chain33.Version", nil, &res); err != nil {
+			return
+		}
+		chainID = res.ChainID
+	}
+	types.S("ChainID", int64(chainID))
 }
 
 var sendCmd = &cobra.Command{
@@ -50,9 +76,13 @@ func init() {
 	rootCmd.AddCommand(
 		commands.CertCmd(),
 		commands.AccountCmd(),
+		commands.BenchCmd(),
 		commands.BlockCmd(),
+		commands.ChainCmd(),
 		commands.CoinsCmd(),
+		commands.ConfigCmd(),
 		commands.ExecCmd(),
+		commands.KeyCmd(),
 		commands.MempoolCmd(),
 		commands.NetCmd(),
 		commands.SeedCmd(),
@@ -63,6 +93,7 @@ func init() {
 		sendCmd,
 		closeCmd,
 		commands.AssetCmd(),
+		consoleCmd,
 	)
 }
 
@@ -100,6 +131,8 @@ func Run(RPCAddr, ParaName string) {
 	types.S("ParaName", ParaName)
 	rootCmd.PersistentFlags().String("rpc_laddr", types.GStr("RPCAddr"), "http url")
 	rootCmd.PersistentFlags().String("paraName", types.GStr("ParaName"), "parachain")
+	rootCmd.PersistentFlags().String("output", "", "output format, json, yaml or table, default depends on the command")
+	rootCmd.PersistentFlags().Int32("chain_id", -1, "chain id of the created and signed transactions, read from the node if less than 0")
 	if len(os.Args) > 1 {
 		if os.Args[1] == "send" {
 			commands.OneStepSend(os.Args)
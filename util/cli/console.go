@@ -0,0 +1,397 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+//maxHistory 历史记录文件最多保存的行数
+const maxHistory = 1000
+
+var consoleCmd = &cobra.Command{
+	Use:   "console",
+	Short: "Interactive console with history, tab completion and session settings",
+	Long: `Interactive console, every line is run as a cli command with the session settings.
+Builtin commands:
+  set rpc_laddr <url>   set the rpc address of the session
+  set addr <address>    set the default address, $addr in a command is replaced by it
+  set paraName <name>   set the parachain name of the session
+  settings              show the session settings
+  history               show the command history
+  exit, quit            leave the console
+Settings and history are saved in the home directory. Commands can also be piped
+through stdin, one command per line, lines starting with # are ignored.`,
+	Run: runConsole,
+}
+
+//consoleSettings 保存在文件中，下次打开console的时候继续使用
+type consoleSettings struct {
+	RPCAddr  string `json:"rpcLaddr"`
+	Addr     string `json:"addr"`
+	ParaName string `json:"paraName"`
+}
+
+type console struct {
+	dir      string
+	settings consoleSettings
+	editor   *lineEditor
+	//addrs 钱包中的地址和输入过的地址，用于补全
+	addrs map[string]bool
+	out   io.Writer
+}
+
+func consoleDir() string {
+	home := os.Getenv("HOME")
+	if home == "" {
+		home = "."
+	}
+	return filepath.Join(home, "."+types.GetTitle()+"-cli")
+}
+
+func runConsole(cmd *cobra.Command, args []string) {
+	c := &console{dir: consoleDir(), addrs: make(map[string]bool), out: os.Stdout}
+	c.loadSettings()
+	//命令行上指定的参数优先于保存的设置
+	if cmd.Flags().Changed("rpc_laddr") || c.settings.RPCAddr == "" {
+		c.settings.RPCAddr, _ = cmd.Flags().GetString("rpc_laddr")
+	}
+	if cmd.Flags().Changed("paraName") {
+		c.settings.ParaName, _ = cmd.Flags().GetString("paraName")
+	}
+	fd := int(os.Stdin.Fd())
+	if !isTerminal(fd) {
+		c.runScript(os.Stdin)
+		return
+	}
+	c.editor = newLineEditor(os.Stdin, os.Stdout, c.complete)
+	c.editor.history = c.loadHistory()
+	c.loadWalletAddrs()
+	fmt.Fprintln(c.out, "Type help for cli commands, exit to leave the console")
+	for {
+		state, err := makeRaw(fd)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			c.runScript(os.Stdin)
+			return
+		}
+		line, err := c.editor.readLine(types.GetTitle() + "> ")
+		restoreTerm(fd, state)
+		if err == errInterrupt {
+			continue
+		}
+		if err != nil {
+			return
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if c.editor.addHistory(line) {
+			c.appendHistory(line)
+		}
+		if !c.runLine(line) {
+			return
+		}
+	}
+}
+
+//runScript 按行执行输入中的命令
+func (c *console) runScript(in io.Reader) {
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !c.runLine(line) {
+			return
+		}
+	}
+}
+
+//runLine 返回false的时候退出console
+func (c *console) runLine(line string) bool {
+	args, err := splitArgs(line)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return true
+	}
+	if len(args) == 0 {
+		return true
+	}
+	switch args[0] {
+	case "exit", "quit":
+		return false
+	case "set":
+		c.set(args[1:])
+		return true
+	case "settings":
+		fmt.Fprintf(c.out, "rpc_laddr: %s\naddr: %s\nparaName: %s\n", c.settings.RPCAddr, c.settings.Addr, c.settings.ParaName)
+		return true
+	case "history":
+		if c.editor != nil {
+			for i, h := range c.editor.history {
+				fmt.Fprintf(c.out, "%5d  %s\n", i+1, h)
+			}
+		}
+		return true
+	}
+	for i, arg := range args {
+		if arg == "$addr" {
+			if c.settings.Addr == "" {
+				fmt.Fprintln(os.Stderr, "default address is not set, use set addr <address>")
+				return true
+			}
+			args[i] = c.settings.Addr
+		}
+		if address.CheckAddress(arg) == nil {
+			c.addrs[arg] = true
+		}
+	}
+	c.exec(args)
+	return true
+}
+
+func (c *console) set(args []string) {
+	if len(args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: set rpc_laddr|addr|paraName <value>")
+		return
+	}
+	switch args[0] {
+	case "rpc_laddr":
+		c.settings.RPCAddr = testTLS(args[1])
+	case "addr":
+		if err := address.CheckAddress(args[1]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		c.settings.Addr = args[1]
+		c.addrs[args[1]] = true
+	case "paraName":
+		c.settings.ParaName = args[1]
+	default:
+		fmt.Fprintln(os.Stderr, "unknown setting", args[0])
+		return
+	}
+	if err := c.saveSettings(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+//exec 在子进程中执行命令，cobra的flag在多次执行之间会保留上次的值
+func (c *console) exec(args []string) {
+	self, err := os.Executable()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	args = append(args, "--rpc_laddr", c.settings.RPCAddr)
+	if c.settings.ParaName != "" && args[0] != "send" {
+		args = append(args, "--paraName", c.settings.ParaName)
+	}
+	cmd := exec.Command(self, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+//splitArgs 按空白分割，支持单引号和双引号
+func splitArgs(line string) ([]string, error) {
+	var args []string
+	var cur []rune
+	var quote rune
+	inArg := false
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur = append(cur, r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, string(cur))
+				cur = cur[:0]
+				inArg = false
+			}
+		default:
+			cur = append(cur, r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quote")
+	}
+	if inArg {
+		args = append(args, string(cur))
+	}
+	return args, nil
+}
+
+var consoleBuiltins = []string{"exit", "history", "quit", "set", "settings"}
+
+//complete 补全子命令、flag和已知的地址
+func (c *console) complete(line string) []string {
+	fields := strings.Fields(line)
+	word := ""
+	if len(fields) > 0 && !strings.HasSuffix(line, " ") {
+		word = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+	var candidates []string
+	add := func(s string) {
+		if strings.HasPrefix(s, word) {
+			candidates = append(candidates, s)
+		}
+	}
+	if len(fields) == 0 {
+		for _, b := range consoleBuiltins {
+			add(b)
+		}
+	}
+	if len(fields) == 1 && fields[0] == "set" {
+		for _, s := range []string{"addr", "paraName", "rpc_laddr"} {
+			add(s)
+		}
+		return candidates
+	}
+	cmd := rootCmd
+	for _, f := range fields {
+		if sub := findSubCommand(cmd, f); sub != nil {
+			cmd = sub
+		}
+	}
+	if strings.HasPrefix(word, "-") {
+		addFlag := func(f *pflag.Flag) {
+			add("--" + f.Name)
+		}
+		cmd.Flags().VisitAll(addFlag)
+		cmd.InheritedFlags().VisitAll(addFlag)
+	} else {
+		for _, sub := range cmd.Commands() {
+			if !sub.Hidden {
+				add(sub.Name())
+			}
+		}
+		if len(candidates) == 0 && len(fields) > 0 {
+			add("$addr")
+			for addr := range c.addrs {
+				add(addr)
+			}
+		}
+	}
+	sort.Strings(candidates)
+	return candidates
+}
+
+func findSubCommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, sub := range cmd.Commands() {
+		if sub.Name() == name || sub.HasAlias(name) {
+			return sub
+		}
+	}
+	return nil
+}
+
+//loadWalletAddrs 钱包没有解锁或者节点不可用的时候只用输入过的地址补全
+func (c *console) loadWalletAddrs() {
+	if c.settings.Addr != "" {
+		c.addrs[c.settings.Addr] = true
+	}
+	rpc, err := jsonclient.NewJSONClient(c.settings.RPCAddr)
+	if err != nil {
+		return
+	}
+	var res rpctypes.WalletAccounts
+	if err := rpc.Call("Chain33.GetAccounts", types.ReqAccountList{WithoutBalance: true}, &res); err != nil {
+		return
+	}
+	for _, w := range res.Wallets {
+		if w.Acc != nil && w.Acc.Addr != "" {
+			c.addrs[w.Acc.Addr] = true
+		}
+	}
+}
+
+func (c *console) loadSettings() {
+	data, err := ioutil.ReadFile(filepath.Join(c.dir, "console.json"))
+	if err != nil {
+		return
+	}
+	if err := json.Unmarshal(data, &c.settings); err != nil {
+		fmt.Fprintln(os.Stderr, "load console settings:", err)
+	}
+}
+
+func (c *console) saveSettings() error {
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(&c.settings, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(c.dir, "console.json"), data, 0600)
+}
+
+func (c *console) loadHistory() []string {
+	data, err := ioutil.ReadFile(filepath.Join(c.dir, "history"))
+	if err != nil {
+		return nil
+	}
+	content := strings.TrimSpace(string(data))
+	if content == "" {
+		return nil
+	}
+	lines := strings.Split(content, "\n")
+	if len(lines) > maxHistory {
+		lines = lines[len(lines)-maxHistory:]
+	}
+	return lines
+}
+
+//appendHistory 超过maxHistory的时候重写文件，只保留最近的记录
+func (c *console) appendHistory(line string) {
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return
+	}
+	path := filepath.Join(c.dir, "history")
+	if len(c.editor.history) > maxHistory {
+		c.editor.history = c.editor.history[len(c.editor.history)-maxHistory:]
+		ioutil.WriteFile(path, []byte(strings.Join(c.editor.history, "\n")+"\n"), 0600)
+		return
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	fmt.Fprintln(f, line)
+}
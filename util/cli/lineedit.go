@@ -0,0 +1,200 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+//errInterrupt ctrl-c放弃当前输入的行
+var errInterrupt = errors.New("interrupt")
+
+//控制键
+const (
+	keyCtrlA     = 1
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyCtrlE     = 5
+	keyCtrlL     = 12
+	keyCtrlU     = 21
+	keyTab       = 9
+	keyEnter     = 13
+	keyNewline   = 10
+	keyEscape    = 27
+	keyBackspace = 127
+	keyCtrlH     = 8
+)
+
+//lineEditor 终端处于raw模式的时候编辑一行输入，支持历史记录和tab补全
+type lineEditor struct {
+	in      *bufio.Reader
+	out     io.Writer
+	history []string
+	//complete 返回line中光标之前最后一个词的补全候选
+	complete func(line string) []string
+}
+
+func newLineEditor(in io.Reader, out io.Writer, complete func(line string) []string) *lineEditor {
+	return &lineEditor{in: bufio.NewReader(in), out: out, complete: complete}
+}
+
+//addHistory 和上一条相同的不重复记录
+func (e *lineEditor) addHistory(line string) bool {
+	if line == "" || (len(e.history) > 0 && e.history[len(e.history)-1] == line) {
+		return false
+	}
+	e.history = append(e.history, line)
+	return true
+}
+
+type editState struct {
+	prompt string
+	buf    []rune
+	pos    int
+}
+
+func (e *lineEditor) refresh(s *editState) {
+	fmt.Fprintf(e.out, "\r%s%s\x1b[K", s.prompt, string(s.buf))
+	if back := len(s.buf) - s.pos; back > 0 {
+		fmt.Fprintf(e.out, "\x1b[%dD", back)
+	}
+}
+
+//readLine ctrl-d在空行上返回io.EOF
+func (e *lineEditor) readLine(prompt string) (string, error) {
+	s := &editState{prompt: prompt}
+	//hpos 正在浏览的历史记录，等于len(history)的时候是正在编辑的行
+	hpos := len(e.history)
+	var editing []rune
+	e.refresh(s)
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		switch r {
+		case keyEnter, keyNewline:
+			fmt.Fprint(e.out, "\r\n")
+			return string(s.buf), nil
+		case keyCtrlC:
+			fmt.Fprint(e.out, "^C\r\n")
+			return "", errInterrupt
+		case keyCtrlD:
+			if len(s.buf) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+			if s.pos < len(s.buf) {
+				s.buf = append(s.buf[:s.pos], s.buf[s.pos+1:]...)
+			}
+		case keyBackspace, keyCtrlH:
+			if s.pos > 0 {
+				s.buf = append(s.buf[:s.pos-1], s.buf[s.pos:]...)
+				s.pos--
+			}
+		case keyCtrlA:
+			s.pos = 0
+		case keyCtrlE:
+			s.pos = len(s.buf)
+		case keyCtrlU:
+			s.buf = s.buf[:0]
+			s.pos = 0
+		case keyCtrlL:
+			fmt.Fprint(e.out, "\x1b[H\x1b[2J")
+		case keyTab:
+			e.completeLine(s)
+		case keyEscape:
+			key := e.readEscape()
+			switch key {
+			case 'A', 'B':
+				if key == 'A' && hpos > 0 {
+					if hpos == len(e.history) {
+						editing = append([]rune(nil), s.buf...)
+					}
+					hpos--
+				} else if key == 'B' && hpos < len(e.history) {
+					hpos++
+				} else {
+					break
+				}
+				if hpos == len(e.history) {
+					s.buf = append([]rune(nil), editing...)
+				} else {
+					s.buf = []rune(e.history[hpos])
+				}
+				s.pos = len(s.buf)
+			case 'C':
+				if s.pos < len(s.buf) {
+					s.pos++
+				}
+			case 'D':
+				if s.pos > 0 {
+					s.pos--
+				}
+			case 'H':
+				s.pos = 0
+			case 'F':
+				s.pos = len(s.buf)
+			}
+		default:
+			if r < 32 {
+				continue
+			}
+			s.buf = append(s.buf[:s.pos], append([]rune{r}, s.buf[s.pos:]...)...)
+			s.pos++
+		}
+		e.refresh(s)
+	}
+}
+
+//readEscape 读取 ESC [ x 或者 ESC O x，返回x，不认识的序列返回0
+func (e *lineEditor) readEscape() rune {
+	r, _, err := e.in.ReadRune()
+	if err != nil || (r != '[' && r != 'O') {
+		return 0
+	}
+	r, _, err = e.in.ReadRune()
+	if err != nil {
+		return 0
+	}
+	//ESC [ 1 ~ 之类的序列，读到结束符为止
+	for r >= '0' && r <= '9' {
+		if r, _, err = e.in.ReadRune(); err != nil {
+			return 0
+		}
+	}
+	return r
+}
+
+//completeLine 只有一个候选的时候直接补全，多个候选的时候补全公共前缀并列出候选
+func (e *lineEditor) completeLine(s *editState) {
+	if e.complete == nil {
+		return
+	}
+	head := string(s.buf[:s.pos])
+	candidates := e.complete(head)
+	if len(candidates) == 0 {
+		return
+	}
+	word := head[strings.LastIndex(head, " ")+1:]
+	prefix := candidates[0]
+	for _, c := range candidates[1:] {
+		for !strings.HasPrefix(c, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	insert := []rune(strings.TrimPrefix(prefix, word))
+	if len(candidates) == 1 {
+		insert = append(insert, ' ')
+	} else if len(insert) == 0 {
+		fmt.Fprintf(e.out, "\r\n%s\r\n", strings.Join(candidates, "  "))
+	}
+	s.buf = append(s.buf[:s.pos], append(insert, s.buf[s.pos:]...)...)
+	s.pos += len(insert)
+}
@@ -0,0 +1,52 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineEditor(t *testing.T) {
+	complete := func(line string) []string {
+		switch line {
+		case "bl":
+			return []string{"block"}
+		case "block h":
+			return []string{"hash", "header"}
+		}
+		return nil
+	}
+	//tab补全，上键取历史记录后退格修改，左移光标插入，ctrl-c放弃，ctrl-d退出
+	in := bytes.NewBufferString("bl\tget\r\x1b[A\x7f\x7f\x7fh\t\rab\x1b[Dx\x01y\rabc\x03\x04")
+	e := newLineEditor(in, ioutil.Discard, complete)
+	var lines []string
+	for {
+		line, err := e.readLine("> ")
+		if err == errInterrupt {
+			continue
+		}
+		if err != nil {
+			assert.Equal(t, io.EOF, err)
+			break
+		}
+		e.addHistory(line)
+		lines = append(lines, line)
+	}
+	assert.Equal(t, []string{"block get", "block h", "yaxb"}, lines)
+	assert.False(t, e.addHistory("yaxb"))
+}
+
+func TestSplitArgs(t *testing.T) {
+	args, err := splitArgs(`tx send -d "a b"  -k ''`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"tx", "send", "-d", "a b", "-k", ""}, args)
+	_, err = splitArgs(`tx "send`)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,24 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd,!dragonfly
+
+package cli
+
+import "errors"
+
+//其他平台不支持raw模式，console按行读取，没有历史记录和补全
+type rawState struct{}
+
+func isTerminal(fd int) bool {
+	return false
+}
+
+func makeRaw(fd int) (*rawState, error) {
+	return nil, errors.New("raw terminal not supported")
+}
+
+func restoreTerm(fd int, state *rawState) error {
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux darwin freebsd netbsd openbsd dragonfly
+
+package cli
+
+import "golang.org/x/sys/unix"
+
+//rawState 进入raw模式之前的终端设置
+type rawState struct {
+	termios unix.Termios
+}
+
+func isTerminal(fd int) bool {
+	_, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	return err == nil
+}
+
+//makeRaw 关闭回显和行缓冲，按键逐个读取，ctrl-c不产生信号，输出仍然转换换行
+func makeRaw(fd int) (*rawState, error) {
+	termios, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	if err != nil {
+		return nil, err
+	}
+	old := &rawState{termios: *termios}
+	termios.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	termios.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	termios.Cflag &^= unix.CSIZE | unix.PARENB
+	termios.Cflag |= unix.CS8
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(fd, ioctlWriteTermios, termios); err != nil {
+		return nil, err
+	}
+	return old, nil
+}
+
+func restoreTerm(fd int, state *rawState) error {
+	return unix.IoctlSetTermios(fd, ioctlWriteTermios, &state.termios)
+}
@@ -92,6 +92,7 @@ ticketMinerWaitTime = 2
 maxTxNumber = 10000
 targetTimespan = 2304
 targetTimePerBlock = 16
+difficultyAlgo = "bitcoin"
 
 [mver.consensus.ForkChainParamV1]
 maxTxNumber = 10000
@@ -186,6 +187,8 @@ enable=false
 cryptoPath="authdir/crypto"
 # 带证书签名类型，支持"auth_ecdsa", "auth_sm2"
 signType="auth_ecdsa"
+#CA地址列表，CA颁发和吊销证书，开启证书验证以后其他地址需要CA颁发的有效证书才能发交易
+rootCAs=[]
 
 [exec.sub.manage]
 superManager=[
@@ -193,4 +196,17 @@ superManager=[
     "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv", 
     "1Q8hGLfoGe63efeWa8fJ4Pnukhkngt6poK"
 ]
+
+[exec.sub.finality]
+committee=[
+    "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv",
+    "14KEKbYtKKQm4wMthSK9J4La4nAiidGozt",
+    "1EbDHAXpoiewjPLX9uqoz38HsKqMXayZrF"
+]
+checkpointInterval=2
+
+[exec.sub.validator]
+stakePerPower=100000000
+slashRate=10
+jailBlocks=3
 `
@@ -398,6 +398,45 @@ func (mock *Chain33Mock) Wait() error {
 	return err
 }
 
+//SendCallTx 用CallCreateTx构造执行器action的交易，签名发送以后等待打包，返回交易的hash和详情
+func (mock *Chain33Mock) SendCallTx(priv crypto.PrivKey, execer, action string, param types.Message) ([]byte, *rpctypes.TransactionDetail, error) {
+	txbytes, err := types.CallCreateTx(execer, action, param)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx := &types.Transaction{}
+	err = types.Decode(txbytes, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx.Fee = 1e6
+	tx.Sign(types.SECP256K1, priv)
+	hash := mock.SendTx(tx)
+	detail, err := mock.WaitTx(hash)
+	return hash, detail, err
+}
+
+//CreateBlocks 创世地址给自己转账，产生n个区块
+func (mock *Chain33Mock) CreateBlocks(n int) error {
+	for i := 0; i < n; i++ {
+		mock.SendTx(util.CreateCoinsTx(mock.GetGenesisKey(), mock.GetGenesisAddress(), types.Coin))
+		if err := mock.Wait(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//CreateBlocksTo 产生区块直到最新的高度达到height
+func (mock *Chain33Mock) CreateBlocksTo(height int64) error {
+	for mock.GetLastBlock().Height < height {
+		if err := mock.CreateBlocks(1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 //GetAccount :
 func (mock *Chain33Mock) GetAccount(stateHash []byte, addr string) *types.Account {
 	statedb := executor.NewStateDB(mock.client, stateHash, nil, nil)
@@ -414,6 +453,11 @@ func (mock *Chain33Mock) GetExecAccount(stateHash []byte, execer, addr string) *
 	return acc.LoadExecAccount(addr, address.ExecAddress(execer))
 }
 
+//GetExecBalance 最新区块的状态中地址在执行器中的余额
+func (mock *Chain33Mock) GetExecBalance(execer, addr string) int64 {
+	return mock.GetExecAccount(mock.GetLastBlock().StateHash, execer, addr).Balance
+}
+
 //GetBlock :
 func (mock *Chain33Mock) GetBlock(height int64) *types.Block {
 	blocks, err := mock.api.GetBlocks(&types.ReqBlocks{Start: height, End: height})
@@ -471,6 +515,18 @@ func (m *mockP2P) SetQueueClient(client queue.Client) {
 				msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, &types.PeerList{}))
 			case types.EventGetNetInfo:
 				msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, &types.NodeNetInfo{}))
+			case types.EventNetPeers:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetPeers, &types.NetPeers{}))
+			case types.EventNetAddPeer, types.EventNetRemovePeer, types.EventNetBanPeer, types.EventNetUnbanPeer:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReply, &types.Reply{IsOk: true}))
+			case types.EventNetBannedPeers:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetBannedPeers, &types.NetBannedPeers{}))
+			case types.EventNetSelfInfo:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetSelfInfo, &types.NetSelfInfo{}))
+			case types.EventNetAddrBook:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetAddrBook, &types.AddrBookEntries{}))
+			case types.EventNetAddrBookRemove, types.EventNetAddrBookSeed:
+				msg.Reply(client.NewMessage(p2pKey, types.EventReplyNetAddrBookEdit, &types.ReplyAddrBookEdit{}))
 			case types.EventTxBroadcast, types.EventBlockBroadcast:
 			default:
 				msg.ReplyErr("p2p->Do not support "+types.GetEventName(int(msg.Ty)), types.ErrNotSupport)
@@ -244,6 +244,11 @@ func ExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block, er
 		//block的来源不是自己的mempool，而是别人的区块
 		return nil, nil, types.ErrSign
 	}
+	if errReturn && types.IsFork(block.Height, "ForkCanonicalEncoding") {
+		if err := block.CheckCanonical(); err != nil {
+			return nil, nil, err
+		}
+	}
 	//tx交易去重处理, 这个地方要查询数据库，需要一个更快的办法
 	cacheTxs := types.TxsToCache(block.Txs)
 	oldtxscount := len(cacheTxs)
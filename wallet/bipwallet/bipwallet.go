@@ -13,6 +13,7 @@ import (
 	bip44 "github.com/33cn/chain33/wallet/bipwallet/go-bip44"
 	"github.com/33cn/chain33/wallet/bipwallet/transformer"
 	_ "github.com/33cn/chain33/wallet/bipwallet/transformer/btcbase" //register btcbase package
+	_ "github.com/33cn/chain33/wallet/bipwallet/transformer/edbase"  //register edbase package
 )
 
 // https://github.com/satoshilabs/slips/blob/master/slip-0044.md
@@ -0,0 +1,42 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package edbase 转换使用ed25519签名的币种
+//公钥由ed25519私钥生成，地址规则和BTY相同
+package edbase
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/ed25519"
+	"github.com/33cn/chain33/wallet/bipwallet/transformer"
+)
+
+func init() {
+	//ed25519签名的钱包使用YCC的币种类型
+	transformer.Register("YCC", &edBaseTransformer{})
+}
+
+// edBaseTransformer 转换ed25519签名的币种实现类
+type edBaseTransformer struct{}
+
+// PrivKeyToPub 32字节的种子或者64字节的私钥生成32字节的公钥
+func (t edBaseTransformer) PrivKeyToPub(priv []byte) (pub []byte, err error) {
+	if len(priv) != 32 && len(priv) != 64 {
+		return nil, fmt.Errorf("invalid priv key byte")
+	}
+	privKeyBytes := new([64]byte)
+	copy(privKeyBytes[:32], priv[:32])
+	pubKeyBytes := ed25519.MakePublicKey(privKeyBytes)
+	return pubKeyBytes[:], nil
+}
+
+// PubKeyToAddress 32字节的公钥生成base58编码的地址
+func (t edBaseTransformer) PubKeyToAddress(pub []byte) (addr string, err error) {
+	if len(pub) != 32 {
+		return "", fmt.Errorf("invalid public key byte")
+	}
+	return address.PubKeyToAddress(pub).String(), nil
+}
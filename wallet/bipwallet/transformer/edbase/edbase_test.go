@@ -0,0 +1,44 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package edbase
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	_ "github.com/33cn/chain33/system/crypto/ed25519"
+	"github.com/33cn/chain33/wallet/bipwallet/transformer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEdBase(t *testing.T) {
+	trans, err := transformer.New("YCC")
+	assert.Nil(t, err)
+	c, err := crypto.New("ed25519")
+	assert.Nil(t, err)
+	priv, err := c.GenKey()
+	assert.Nil(t, err)
+
+	//64字节的私钥和32字节的种子生成相同的公钥和地址
+	for _, key := range [][]byte{priv.Bytes(), priv.Bytes()[:32]} {
+		pub, err := trans.PrivKeyToPub(key)
+		assert.Nil(t, err)
+		assert.Equal(t, priv.PubKey().Bytes(), pub)
+		addr, err := trans.PubKeyToAddress(pub)
+		assert.Nil(t, err)
+		assert.Equal(t, address.PubKeyToAddress(priv.PubKey().Bytes()).String(), addr)
+
+		key2, err := c.PrivKeyFromBytes(key)
+		assert.Nil(t, err)
+		assert.True(t, priv.Equals(key2))
+	}
+	_, err = trans.PrivKeyToPub(make([]byte, 33))
+	assert.NotNil(t, err)
+	_, err = trans.PubKeyToAddress(make([]byte, 33))
+	assert.NotNil(t, err)
+	_, err = c.SignatureFromBytes(make([]byte, 63))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,182 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+//keystore文件的加密参数
+const (
+	KeyStoreVersion    = 1
+	KeyStoreCipher     = "aes-256-gcm"
+	KeyStoreKDF        = "pbkdf2-sha256"
+	KeyStoreIterations = 262144
+)
+
+//keystore文件的错误
+var (
+	ErrKeyStoreFormat   = errors.New("ErrKeyStoreFormat")
+	ErrKeyStorePassword = errors.New("ErrKeyStorePassword")
+)
+
+// KeyStore 独立保存的私钥文件，私钥用口令加密，离线的机器不需要钱包也能保存私钥
+type KeyStore struct {
+	Version  int             `json:"version"`
+	Address  string          `json:"address"`
+	Label    string          `json:"label,omitempty"`
+	SignType string          `json:"signType"`
+	Crypto   *KeyStoreCrypto `json:"crypto"`
+}
+
+// KeyStoreCrypto 私钥的加密参数和密文，hex编码
+type KeyStoreCrypto struct {
+	Cipher     string `json:"cipher"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       string `json:"salt"`
+	Nonce      string `json:"nonce"`
+	CipherText string `json:"cipherText"`
+}
+
+func keyStoreAEAD(password, salt []byte, iterations int) (cipher.AEAD, error) {
+	key := pbkdf2.Key(password, salt, iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+//keyStoreAddr 私钥对应的地址，用来检查签名类型和私钥是否匹配
+func keyStoreAddr(signType string, privkey []byte) (string, error) {
+	cr, err := crypto.New(signType)
+	if err != nil {
+		return "", err
+	}
+	priv, err := cr.PrivKeyFromBytes(privkey)
+	if err != nil {
+		return "", err
+	}
+	return address.PubKeyToAddr(priv.PubKey().Bytes()), nil
+}
+
+// NewKeyStore 用password加密私钥，地址和签名类型作为附加数据，修改以后不能解密
+func NewKeyStore(signType string, privkey, password []byte, label string) (*KeyStore, error) {
+	addr, err := keyStoreAddr(signType, privkey)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := keyStoreAEAD(password, salt, KeyStoreIterations)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	ks := &KeyStore{
+		Version:  KeyStoreVersion,
+		Address:  addr,
+		Label:    label,
+		SignType: signType,
+		Crypto: &KeyStoreCrypto{
+			Cipher:     KeyStoreCipher,
+			KDF:        KeyStoreKDF,
+			Iterations: KeyStoreIterations,
+			Salt:       common.ToHex(salt),
+			Nonce:      common.ToHex(nonce),
+		},
+	}
+	ks.Crypto.CipherText = common.ToHex(aead.Seal(nil, nonce, privkey, ks.additionalData()))
+	return ks, nil
+}
+
+func (ks *KeyStore) additionalData() []byte {
+	return []byte(ks.Address + ":" + ks.SignType)
+}
+
+// Decrypt 用password解密私钥，并检查私钥和文件中的地址一致
+func (ks *KeyStore) Decrypt(password []byte) ([]byte, error) {
+	c := ks.Crypto
+	if ks.Version != KeyStoreVersion || c == nil || c.Cipher != KeyStoreCipher || c.KDF != KeyStoreKDF || c.Iterations <= 0 {
+		return nil, ErrKeyStoreFormat
+	}
+	salt, err := common.FromHex(c.Salt)
+	if err != nil {
+		return nil, ErrKeyStoreFormat
+	}
+	nonce, err := common.FromHex(c.Nonce)
+	if err != nil {
+		return nil, ErrKeyStoreFormat
+	}
+	data, err := common.FromHex(c.CipherText)
+	if err != nil {
+		return nil, ErrKeyStoreFormat
+	}
+	aead, err := keyStoreAEAD(password, salt, c.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, ErrKeyStoreFormat
+	}
+	privkey, err := aead.Open(nil, nonce, data, ks.additionalData())
+	if err != nil {
+		return nil, ErrKeyStorePassword
+	}
+	addr, err := keyStoreAddr(ks.SignType, privkey)
+	if err != nil || addr != ks.Address {
+		return nil, ErrKeyStoreFormat
+	}
+	return privkey, nil
+}
+
+// ReadKeyStore 读取keystore文件
+func ReadKeyStore(file string) (*KeyStore, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var ks KeyStore
+	if err := json.Unmarshal(data, &ks); err != nil || ks.Address == "" || ks.Crypto == nil {
+		return nil, ErrKeyStoreFormat
+	}
+	return &ks, nil
+}
+
+// WriteKeyStore 写入keystore文件，文件已经存在的时候返回错误，不覆盖已有的私钥
+func WriteKeyStore(file string, ks *KeyStore) error {
+	data, err := json.MarshalIndent(ks, "", "    ")
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(file)
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,57 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	_ "github.com/33cn/chain33/system/crypto/init"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyStore(t *testing.T) {
+	cr, err := crypto.New("secp256k1")
+	require.NoError(t, err)
+	priv, err := cr.GenKey()
+	require.NoError(t, err)
+
+	ks, err := wcom.NewKeyStore("secp256k1", priv.Bytes(), []byte("password123"), "label")
+	require.NoError(t, err)
+	assert.Equal(t, address.PubKeyToAddr(priv.PubKey().Bytes()), ks.Address)
+
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, ks.Address+".json")
+	require.NoError(t, wcom.WriteKeyStore(file, ks))
+	//已经存在的文件不覆盖
+	assert.Error(t, wcom.WriteKeyStore(file, ks))
+
+	ks2, err := wcom.ReadKeyStore(file)
+	require.NoError(t, err)
+	assert.Equal(t, "label", ks2.Label)
+	key, err := ks2.Decrypt([]byte("password123"))
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), key)
+
+	_, err = ks2.Decrypt([]byte("password124"))
+	assert.Equal(t, wcom.ErrKeyStorePassword, err)
+
+	//修改地址以后不能解密
+	ks2.Address = "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"
+	_, err = ks2.Decrypt([]byte("password123"))
+	assert.Equal(t, wcom.ErrKeyStorePassword, err)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte("{}"), 0600))
+	_, err = wcom.ReadKeyStore(file)
+	assert.Equal(t, wcom.ErrKeyStoreFormat, err)
+}
@@ -310,6 +310,15 @@ func (store *Store) DelAccountByLabel(label string) {
 	}
 }
 
+// DelAccount 删除账号信息，Account，Addr，Label三个表同时删除
+func (store *Store) DelAccount(addr string, account *types.WalletAccountStore) error {
+	newbatch := store.NewBatch(true)
+	newbatch.Delete(CalcAccountKey(account.TimeStamp, addr))
+	newbatch.Delete(CalcAddrKey(addr))
+	newbatch.Delete(CalcLabelKey(account.GetLabel()))
+	return newbatch.Write()
+}
+
 //SetWalletVersion 升级数据库的版本号
 func (store *Store) SetWalletVersion(ver int64) error {
 	data, err := json.Marshal(ver)
@@ -0,0 +1,36 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/crypto/hsm"
+)
+
+//loadHSMSigners 配置中每个标签对应HSM中的一个账户，地址由HSM中的公钥计算
+func (wallet *Wallet) loadHSMSigners() error {
+	cfg := wallet.cfg.Hsm
+	if cfg == nil {
+		return nil
+	}
+	for _, label := range cfg.Labels {
+		signer, err := hsm.New(cfg, label)
+		if err != nil {
+			walletlog.Error("loadHSMSigners", "label", label, "err", err)
+			return err
+		}
+		addr := address.PubKeyToAddr(signer.PubKey().Bytes())
+		wallet.hsmSigners[addr] = signer
+		walletlog.Info("loadHSMSigners", "label", label, "addr", addr)
+	}
+	return nil
+}
+
+//getHSMSigner 地址是HSM中的账户的时候返回HSM签名者
+func (wallet *Wallet) getHSMSigner(addr string) (crypto.PrivKey, bool) {
+	signer, ok := wallet.hsmSigners[addr]
+	return signer, ok
+}
@@ -6,9 +6,15 @@ package wallet
 
 const (
 	keyWalletPassKey = "WalletPassKey"
+	keyTSSAccount    = "TSSAccount"
 )
 
 // CalcWalletPassKey 获取钱包密码的数据库字段Key值
 func CalcWalletPassKey() []byte {
 	return []byte(keyWalletPassKey)
 }
+
+// CalcTSSAccountKey 通过addr查询导入的TSS账户
+func CalcTSSAccountKey(addr string) []byte {
+	return []byte(keyTSSAccount + ":" + addr)
+}
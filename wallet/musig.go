@@ -0,0 +1,167 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/crypto/schnorr"
+	"github.com/33cn/chain33/types"
+)
+
+//maxMuSigSessions 钱包中同时进行的MuSig签名会话个数，会话只保存在内存中，随机数不会落盘
+const maxMuSigSessions = 128
+
+//musigSession 钱包参与的一次MuSig签名，签名的内容和单个签名者签名交易时一样
+type musigSession struct {
+	musig *schnorr.MuSig
+	tx    *types.Transaction
+}
+
+func parseMuSigPubKeys(pubKeys []string) ([]crypto.PubKey, error) {
+	var pubs []crypto.PubKey
+	for _, key := range pubKeys {
+		b, err := common.FromHex(key)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := schnorr.Driver{}.PubKeyFromBytes(b)
+		if err != nil {
+			return nil, err
+		}
+		pubs = append(pubs, pub)
+	}
+	return pubs, nil
+}
+
+//ProcMuSigAggregate 计算多个签名者的聚合公钥和地址，资产转到这个地址以后需要所有签名者一起签名才能转出
+func (wallet *Wallet) ProcMuSigAggregate(req *types.ReqMuSigPubKeys) (*types.ReplyMuSigPubKey, error) {
+	pubs, err := parseMuSigPubKeys(req.PubKeys)
+	if err != nil {
+		return nil, err
+	}
+	agg, err := schnorr.AggregatePubKey(pubs)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplyMuSigPubKey{
+		PubKey: common.ToHex(agg.Bytes()),
+		Addr:   address.PubKeyToAddress(agg.Bytes()).String(),
+	}, nil
+}
+
+//ProcMuSigStart 用钱包中addr的私钥开始一次多方签名，交易必须是单个的交易，签名以后不能再修改
+func (wallet *Wallet) ProcMuSigStart(req *types.ReqMuSigStart) (*types.ReplyMuSigStep, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+	ok, err := wallet.CheckWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	if len(wallet.musigs) >= maxMuSigSessions {
+		return nil, types.ErrMuSigSession
+	}
+	key, err := wallet.getPrivKeyByAddr(req.Addr)
+	if err != nil {
+		return nil, err
+	}
+	pubs, err := parseMuSigPubKeys(req.PubKeys)
+	if err != nil {
+		return nil, err
+	}
+	txByteData, err := common.FromHex(req.TxHex)
+	if err != nil {
+		return nil, err
+	}
+	var tx types.Transaction
+	if err = types.Decode(txByteData, &tx); err != nil {
+		return nil, err
+	}
+	if tx.GroupCount > 0 {
+		return nil, types.ErrNotSupport
+	}
+	tx.ResetFeePayer()
+	musig, err := schnorr.NewMuSig(key, pubs, tx.SignData())
+	if err != nil {
+		return nil, err
+	}
+	session := common.ToHex(crypto.CRandBytes(16))
+	wallet.musigs[session] = &musigSession{musig: musig, tx: &tx}
+	return &types.ReplyMuSigStep{Session: session, Data: common.ToHex(musig.Commitment())}, nil
+}
+
+//musigStep 把其他签名者这一轮的数据加入会话，出错的时候删除会话，需要重新开始签名
+func (wallet *Wallet) musigStep(req *types.ReqMuSigStep, add func(m *schnorr.MuSig, pub crypto.PubKey, data []byte) error,
+	next func(s *musigSession) (string, error)) (*types.ReplyMuSigStep, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+	s, ok := wallet.musigs[req.Session]
+	if !ok {
+		return nil, types.ErrMuSigSession
+	}
+	data, err := func() (string, error) {
+		for _, peer := range req.Peers {
+			pubs, err := parseMuSigPubKeys([]string{peer.PubKey})
+			if err != nil {
+				return "", err
+			}
+			b, err := common.FromHex(peer.Data)
+			if err != nil {
+				return "", err
+			}
+			if err := add(s.musig, pubs[0], b); err != nil {
+				return "", err
+			}
+		}
+		return next(s)
+	}()
+	if err != nil {
+		delete(wallet.musigs, req.Session)
+		return nil, err
+	}
+	return &types.ReplyMuSigStep{Session: req.Session, Data: data}, nil
+}
+
+//ProcMuSigNonce 收到其他签名者的承诺以后返回本签名者的随机数
+func (wallet *Wallet) ProcMuSigNonce(req *types.ReqMuSigStep) (*types.ReplyMuSigStep, error) {
+	return wallet.musigStep(req, (*schnorr.MuSig).AddCommitment, func(s *musigSession) (string, error) {
+		nonce, err := s.musig.Nonce()
+		return common.ToHex(nonce), err
+	})
+}
+
+//ProcMuSigPartialSign 收到其他签名者的随机数以后返回本签名者的部分签名
+func (wallet *Wallet) ProcMuSigPartialSign(req *types.ReqMuSigStep) (*types.ReplyMuSigStep, error) {
+	return wallet.musigStep(req, (*schnorr.MuSig).AddNonce, func(s *musigSession) (string, error) {
+		partial, err := s.musig.PartialSign()
+		return common.ToHex(partial), err
+	})
+}
+
+//ProcMuSigCombine 收到其他签名者的部分签名以后合成聚合公钥签名的交易，会话结束
+func (wallet *Wallet) ProcMuSigCombine(req *types.ReqMuSigStep) (*types.ReplyMuSigStep, error) {
+	reply, err := wallet.musigStep(req, (*schnorr.MuSig).AddPartial, func(s *musigSession) (string, error) {
+		sig, err := s.musig.Signature()
+		if err != nil {
+			return "", err
+		}
+		tx := *s.tx
+		tx.Signature = &types.Signature{
+			Ty:        schnorr.ID,
+			Pubkey:    s.musig.AggPubKey().Bytes(),
+			Signature: sig.Bytes(),
+		}
+		return hex.EncodeToString(types.Encode(&tx)), nil
+	})
+	if err == nil {
+		wallet.mtx.Lock()
+		delete(wallet.musigs, req.Session)
+		wallet.mtx.Unlock()
+	}
+	return reply, err
+}
@@ -0,0 +1,64 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/33cn/chain33/types"
+)
+
+//decryptTxNote 用接收地址在钱包中的私钥解密交易中的加密备注
+//钱包没有设置密码或者接收地址不属于本钱包时返回nil
+func (wallet *Wallet) decryptTxNote(tx *types.Transaction) []byte {
+	data := tx.EncryptedNote()
+	if len(data) == 0 {
+		return nil
+	}
+	toaddr := tx.GetRealToAddr()
+	if !wallet.AddrInWallet(toaddr) {
+		return nil
+	}
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+	if wallet.Password == "" {
+		return nil
+	}
+	priv, err := wallet.getPrivKeyByAddr(toaddr)
+	if err != nil {
+		return nil
+	}
+	note, err := types.DecryptNote(priv, data)
+	if err != nil {
+		walletlog.Debug("decryptTxNote", "toaddr", toaddr, "err", err)
+		return nil
+	}
+	return note
+}
+
+//ProcDecryptTxNote 解密发送给本钱包地址的交易中的加密备注
+func (wallet *Wallet) ProcDecryptTxNote(req *types.ReqHash) (*types.ReplyString, error) {
+	if req == nil || len(req.Hash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	ok, err := wallet.CheckWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	detail, err := wallet.queryTx(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	tx := detail.GetTx()
+	if tx == nil || len(tx.EncryptedNote()) == 0 {
+		return nil, types.ErrEmpty
+	}
+	if !wallet.AddrInWallet(tx.GetRealToAddr()) {
+		return nil, types.ErrAddrNotExist
+	}
+	note := wallet.decryptTxNote(tx)
+	if note == nil {
+		return nil, types.ErrDecode
+	}
+	return &types.ReplyString{Data: string(note)}, nil
+}
@@ -25,6 +25,7 @@ import (
 	//	"github.com/piotrnar/gocoin/lib/btc"
 	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/crypto/schnorr"
 	"github.com/33cn/chain33/types"
 )
 
@@ -157,11 +158,11 @@ func GetPrivkeyBySeed(db dbm.DB, seed string) (string, error) {
 		}
 		index = backupindex + 1
 	}
-	if SignType != 1 && SignType != 2 {
+	if SignType != 1 && SignType != 2 && SignType != schnorr.ID {
 		return "", types.ErrNotSupport
 	}
-	//secp256k1
-	if SignType == 1 {
+	//secp256k1，schnorr的私钥格式和secp256k1相同
+	if SignType == 1 || SignType == schnorr.ID {
 
 		wallet, err := bipwallet.NewWalletFromMnemonic(bipwallet.TypeBty, seed)
 		if err != nil {
@@ -113,6 +113,7 @@ func (wallet *Wallet) sendTransaction(payload types.Message, execer []byte, priv
 	}
 	tx := &types.Transaction{Execer: execer, Payload: types.Encode(payload), Fee: minFee, To: to}
 	tx.Nonce = rand.Int63()
+	tx.ChainID = types.GetChainID()
 	tx.Fee, err = tx.GetRealFee(wallet.getFee())
 	if err != nil {
 		return nil, err
@@ -244,6 +245,7 @@ func (wallet *Wallet) createSendToAddress(addrto string, amount int64, note stri
 	}
 
 	tx.Nonce = rand.Int63()
+	tx.ChainID = types.GetChainID()
 	return tx, nil
 }
 
@@ -0,0 +1,218 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+)
+
+//defaultTSSTimeout 等待协调者组织各个参与方完成签名的默认超时时间，单位秒
+const defaultTSSTimeout = 30
+
+//ErrTSSNotConfigured 没有配置TSS协调者
+var ErrTSSNotConfigured = errors.New("ErrTSSNotConfigured")
+
+//tssSignReq 请求协调者用keyId对应的密钥分片签名msg，msg是交易签名的原始数据
+type tssSignReq struct {
+	KeyID    string `json:"keyId"`
+	SignType string `json:"signType"`
+	Msg      string `json:"msg"`
+}
+
+type tssSignResp struct {
+	Signature string `json:"signature"`
+	Error     string `json:"error,omitempty"`
+}
+
+//tssSigner 通过外部的TSS协调者签名，任何地方都没有完整的私钥
+//实现了crypto.PrivKey接口，TSS账户可以和钱包中的普通账户一样签名交易
+type tssSigner struct {
+	url    string
+	keyID  string
+	c      crypto.Crypto
+	name   string
+	pub    crypto.PubKey
+	client *http.Client
+}
+
+//tssEmptySignature 协调者签名失败时返回空的签名，交易的签名检查不会通过
+type tssEmptySignature struct{}
+
+func (tssEmptySignature) Bytes() []byte {
+	return nil
+}
+
+func (tssEmptySignature) IsZero() bool {
+	return true
+}
+
+func (tssEmptySignature) String() string {
+	return "tssEmptySignature"
+}
+
+func (tssEmptySignature) Equals(crypto.Signature) bool {
+	return false
+}
+
+//Bytes TSS账户没有完整的私钥
+func (s *tssSigner) Bytes() []byte {
+	return nil
+}
+
+//PubKey 分布式生成的公钥
+func (s *tssSigner) PubKey() crypto.PubKey {
+	return s.pub
+}
+
+func (s *tssSigner) String() string {
+	return "tssSigner{" + s.keyID + "}"
+}
+
+//Equals 同一个协调者上同一个keyId
+func (s *tssSigner) Equals(other crypto.PrivKey) bool {
+	o, ok := other.(*tssSigner)
+	if !ok {
+		return false
+	}
+	return o.url == s.url && o.keyID == s.keyID && o.pub.Equals(s.pub)
+}
+
+//Sign 签名失败的时候返回空的签名
+func (s *tssSigner) Sign(msg []byte) crypto.Signature {
+	sig, err := s.sign(msg)
+	if err != nil {
+		walletlog.Error("tssSigner sign", "keyId", s.keyID, "err", err)
+		return tssEmptySignature{}
+	}
+	return sig
+}
+
+func (s *tssSigner) sign(msg []byte) (crypto.Signature, error) {
+	body, err := json.Marshal(&tssSignReq{KeyID: s.keyID, SignType: s.name, Msg: common.ToHex(msg)})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tss coordinator status %d", resp.StatusCode)
+	}
+	var reply tssSignResp
+	if err = json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return nil, err
+	}
+	if reply.Error != "" {
+		return nil, errors.New(reply.Error)
+	}
+	sigbytes, err := common.FromHex(reply.Signature)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := s.c.SignatureFromBytes(sigbytes)
+	if err != nil {
+		return nil, err
+	}
+	//协调者返回的签名必须是这个账户的有效签名
+	if !s.pub.VerifyBytes(msg, sig) {
+		return nil, types.ErrSign
+	}
+	return sig, nil
+}
+
+func (wallet *Wallet) newTSSSigner(acc *types.ReqWalletImportTSSAccount) (*tssSigner, error) {
+	if wallet.cfg.TssCoordinator == "" {
+		return nil, ErrTSSNotConfigured
+	}
+	name := types.GetSignName("", SignType)
+	c, err := crypto.New(name)
+	if err != nil {
+		return nil, err
+	}
+	pubbytes, err := common.FromHex(acc.GetPubKey())
+	if err != nil || len(pubbytes) == 0 {
+		return nil, types.ErrFromHex
+	}
+	pub, err := c.PubKeyFromBytes(pubbytes)
+	if err != nil {
+		return nil, err
+	}
+	timeout := wallet.cfg.TssTimeout
+	if timeout <= 0 {
+		timeout = defaultTSSTimeout
+	}
+	return &tssSigner{
+		url:    wallet.cfg.TssCoordinator,
+		keyID:  acc.GetKeyId(),
+		c:      c,
+		name:   name,
+		pub:    pub,
+		client: &http.Client{Timeout: time.Duration(timeout) * time.Second},
+	}, nil
+}
+
+//ProcImportTSSAccount 导入TSS账户，钱包中不保存私钥，签名的时候请求协调者
+func (wallet *Wallet) ProcImportTSSAccount(req *types.ReqWalletImportTSSAccount) (*types.WalletAccount, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	ok, err := wallet.CheckWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	if req == nil || req.GetKeyId() == "" || req.GetLabel() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	signer, err := wallet.newTSSSigner(req)
+	if err != nil {
+		return nil, err
+	}
+	addr := address.PubKeyToAddr(signer.pub.Bytes())
+	if acc, err := wallet.walletStore.GetAccountByLabel(req.GetLabel()); acc != nil && err == nil {
+		return nil, types.ErrLabelHasUsed
+	}
+	if acc, err := wallet.walletStore.GetAccountByAddr(addr); acc != nil && err == nil {
+		return nil, types.ErrPrivkeyExist
+	}
+	if _, err := wallet.walletStore.GetTSSAccount(addr); err == nil {
+		return nil, types.ErrPrivkeyExist
+	}
+	for _, acc := range wallet.walletStore.GetTSSAccounts() {
+		if acc.GetLabel() == req.GetLabel() {
+			return nil, types.ErrLabelHasUsed
+		}
+	}
+	if err = wallet.walletStore.SetTSSAccount(addr, req); err != nil {
+		return nil, err
+	}
+	accounts, err := accountdb.LoadAccounts(wallet.api, []string{addr})
+	if err != nil {
+		walletlog.Error("ProcImportTSSAccount", "LoadAccounts err", err)
+		return nil, err
+	}
+	if len(accounts[0].Addr) == 0 {
+		accounts[0].Addr = addr
+	}
+	return &types.WalletAccount{Acc: accounts[0], Label: req.GetLabel()}, nil
+}
+
+//ProcGetTSSAccountList 钱包中导入的TSS账户
+func (wallet *Wallet) ProcGetTSSAccountList() (*types.WalletTSSAccounts, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+	return &types.WalletTSSAccounts{Accounts: wallet.walletStore.GetTSSAccounts()}, nil
+}
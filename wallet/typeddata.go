@@ -0,0 +1,41 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+)
+
+//ProcSignTypedData 用钱包中的账户对EIP-712格式的结构化数据签名
+func (wallet *Wallet) ProcSignTypedData(req *types.ReqSignTypedData) (*types.ReplySignTypedData, error) {
+	if req == nil || req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	td, err := types.ParseTypedData([]byte(req.TypedData))
+	if err != nil {
+		return nil, err
+	}
+	hash, err := td.Hash()
+	if err != nil {
+		return nil, err
+	}
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+	ok, err := wallet.CheckWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	priv, err := wallet.getPrivKeyByAddr(req.Addr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplySignTypedData{
+		Hash:      common.ToHex(hash),
+		PubKey:    common.ToHex(priv.PubKey().Bytes()),
+		Signature: common.ToHex(priv.Sign(hash).Bytes()),
+		Ty:        int32(SignType),
+	}, nil
+}
@@ -74,6 +74,9 @@ type Wallet struct {
 	rescanwg           *sync.WaitGroup
 	lastHeader         *types.Header
 	initFlag           uint32 // 钱包模块是否初始化完毕的标记，默认为0，表示未初始化
+	musigs             map[string]*musigSession
+	//hsmSigners 私钥保存在HSM中的账户，按地址索引
+	hsmSigners map[string]crypto.PrivKey
 }
 
 // SetLogLevel 设置日志登记
@@ -112,6 +115,11 @@ func New(cfg *types.Wallet, sub map[string][]byte) *Wallet {
 		cfg:              cfg,
 		rescanwg:         &sync.WaitGroup{},
 		initFlag:         0,
+		musigs:           make(map[string]*musigSession),
+		hsmSigners:       make(map[string]crypto.PrivKey),
+	}
+	if err := wallet.loadHSMSigners(); err != nil {
+		panic(err)
 	}
 	wallet.random = rand.New(rand.NewSource(types.Now().UnixNano()))
 	wcom.QueryData.SetThis("wallet", reflect.ValueOf(wallet))
@@ -295,6 +303,14 @@ func (wallet *Wallet) GetPrivKeyByAddr(addr string) (crypto.PrivKey, error) {
 }
 
 func (wallet *Wallet) getPrivKeyByAddr(addr string) (crypto.PrivKey, error) {
+	//HSM账户由HSM签名
+	if signer, ok := wallet.getHSMSigner(addr); ok {
+		return signer, nil
+	}
+	//TSS账户由协调者签名
+	if acc, err := wallet.walletStore.GetTSSAccount(addr); err == nil {
+		return wallet.newTSSSigner(acc)
+	}
 	//获取指定地址在钱包里的账户信息
 	Accountstor, err := wallet.walletStore.GetAccountByAddr(addr)
 	if err != nil {
@@ -325,7 +341,16 @@ func (wallet *Wallet) getPrivKeyByAddr(addr string) (crypto.PrivKey, error) {
 }
 
 //外部已经加了lock
+//mempool拥堵时会提高最低手续费，钱包设置的手续费低于这个值时，使用mempool的最低手续费
 func (wallet *Wallet) getFee() int64 {
+	reply, err := wallet.api.GetProperFee()
+	if err != nil {
+		walletlog.Error("getFee", "GetProperFee err", err)
+		return wallet.FeeAmount
+	}
+	if reply.GetMinTxFee() > wallet.FeeAmount {
+		return reply.GetMinTxFee()
+	}
 	return wallet.FeeAmount
 }
 
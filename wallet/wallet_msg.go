@@ -245,6 +245,92 @@ func (wallet *Wallet) On_FatalFailure(req *types.ReqNil) (types.Message, error)
 	return reply, nil
 }
 
+// On_MuSigAggregate 计算MuSig聚合公钥和地址
+func (wallet *Wallet) On_MuSigAggregate(req *types.ReqMuSigPubKeys) (types.Message, error) {
+	reply, err := wallet.ProcMuSigAggregate(req)
+	if err != nil {
+		walletlog.Error("ProcMuSigAggregate", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_MuSigStart 开始MuSig多方签名，返回随机数的承诺
+func (wallet *Wallet) On_MuSigStart(req *types.ReqMuSigStart) (types.Message, error) {
+	reply, err := wallet.ProcMuSigStart(req)
+	if err != nil {
+		walletlog.Error("ProcMuSigStart", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_MuSigNonce 交换MuSig随机数
+func (wallet *Wallet) On_MuSigNonce(req *types.ReqMuSigStep) (types.Message, error) {
+	reply, err := wallet.ProcMuSigNonce(req)
+	if err != nil {
+		walletlog.Error("ProcMuSigNonce", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_MuSigPartialSign 交换MuSig部分签名
+func (wallet *Wallet) On_MuSigPartialSign(req *types.ReqMuSigStep) (types.Message, error) {
+	reply, err := wallet.ProcMuSigPartialSign(req)
+	if err != nil {
+		walletlog.Error("ProcMuSigPartialSign", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_MuSigCombine 合成MuSig签名的交易
+func (wallet *Wallet) On_MuSigCombine(req *types.ReqMuSigStep) (types.Message, error) {
+	reply, err := wallet.ProcMuSigCombine(req)
+	if err != nil {
+		walletlog.Error("ProcMuSigCombine", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_ImportTSSAccount 导入TSS门限签名账户
+func (wallet *Wallet) On_ImportTSSAccount(req *types.ReqWalletImportTSSAccount) (types.Message, error) {
+	reply, err := wallet.ProcImportTSSAccount(req)
+	if err != nil {
+		walletlog.Error("ProcImportTSSAccount", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_TSSAccountList 获取导入的TSS账户列表
+func (wallet *Wallet) On_TSSAccountList(req *types.ReqNil) (types.Message, error) {
+	return wallet.ProcGetTSSAccountList()
+}
+
+// On_DecryptTxNote 解密发送给本钱包地址的交易中的加密备注
+func (wallet *Wallet) On_DecryptTxNote(req *types.ReqHash) (types.Message, error) {
+	reply, err := wallet.ProcDecryptTxNote(req)
+	if err != nil {
+		walletlog.Error("ProcDecryptTxNote", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_SignTypedData 对结构化数据签名
+func (wallet *Wallet) On_SignTypedData(req *types.ReqSignTypedData) (types.Message, error) {
+	reply, err := wallet.ProcSignTypedData(req)
+	if err != nil {
+		walletlog.Error("ProcSignTypedData", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_DeleteAccount 从钱包中删除账户
+func (wallet *Wallet) On_DeleteAccount(req *types.ReqString) (types.Message, error) {
+	reply, err := wallet.ProcDeleteAccount(req)
+	if err != nil {
+		walletlog.Error("ProcDeleteAccount", "err", err.Error())
+	}
+	return reply, err
+}
+
 // ExecWallet 执行钱包的功能
 func (wallet *Wallet) ExecWallet(msg *queue.Message) (types.Message, error) {
 	if param, ok := msg.Data.(*types.ChainExecutor); ok {
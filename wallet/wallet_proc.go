@@ -81,6 +81,14 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 		return "", err
 	}
 
+	//代付手续费的签名包含发送者的签名，不能再修改交易的内容
+	if unsigned.FeePayer {
+		if tx.GetSignature() == nil || tx.GroupCount > 0 {
+			return "", types.ErrSign
+		}
+		tx.SignFeePayer(int32(SignType), key)
+		return hex.EncodeToString(types.Encode(&tx)), nil
+	}
 	if unsigned.NewToAddr != "" {
 		tx.To = unsigned.NewToAddr
 	}
@@ -106,11 +114,26 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 		return "", err
 	}
 	if group == nil {
+		//离线构造的交易没有设置链的标识时，签名之前自动设置
+		if tx.ChainID == 0 {
+			tx.ChainID = types.GetChainID()
+		}
+		//指定代付账户的时候，发送者的签名包含代付账户的公钥
+		if unsigned.FeePayerPubkey != "" {
+			pub, err := common.FromHex(unsigned.FeePayerPubkey)
+			if err != nil || len(pub) == 0 {
+				return "", types.ErrFromHex
+			}
+			tx.SetFeePayer(pub)
+		}
 		tx.Sign(int32(SignType), key)
 		txHex := types.Encode(&tx)
 		signedTx := hex.EncodeToString(txHex)
 		return signedTx, nil
 	}
+	if unsigned.FeePayerPubkey != "" {
+		return "", types.ErrInvalidParam
+	}
 	if int(index) > len(group.GetTxs()) {
 		return "", types.ErrIndex
 	}
@@ -155,8 +178,16 @@ func (wallet *Wallet) ProcGetAccountList(req *types.ReqAccountList) (*types.Wall
 		walletlog.Info("ProcGetAccountList", "GetAccountByPrefix:err", err)
 		return nil, err
 	}
+	format := req.AddrFormat
+	if format == "" {
+		format = wallet.cfg.AddressFormat
+	}
 	if req.WithoutBalance {
-		return makeAccountWithoutBalance(WalletAccStores)
+		accs, err := makeAccountWithoutBalance(WalletAccStores)
+		if err != nil {
+			return nil, err
+		}
+		return formatAccounts(accs, format)
 	}
 
 	addrs := make([]string, len(WalletAccStores))
@@ -190,7 +221,22 @@ func (wallet *Wallet) ProcGetAccountList(req *types.ReqAccountList) (*types.Wall
 		WalletAccount.Label = WalletAccStores[index].GetLabel()
 		WalletAccounts.Wallets[index] = &WalletAccount
 	}
-	return &WalletAccounts, nil
+	return formatAccounts(&WalletAccounts, format)
+}
+
+//formatAccounts 钱包中保存的是base58格式的地址，按照format转换成显示的格式
+func formatAccounts(accs *types.WalletAccounts, format string) (*types.WalletAccounts, error) {
+	for _, acc := range accs.Wallets {
+		if acc == nil || acc.Acc == nil {
+			continue
+		}
+		addr, err := address.FormatAddress(acc.Acc.Addr, format)
+		if err != nil {
+			return nil, err
+		}
+		acc.Acc.Addr = addr
+	}
+	return accs, nil
 }
 
 func makeAccountWithoutBalance(accountStores []*types.WalletAccountStore) (*types.WalletAccounts, error) {
@@ -502,6 +548,17 @@ func (wallet *Wallet) ProcSendToAddress(SendToAddress *types.ReqWalletSendToAddr
 		return nil, types.ErrInvalidParam
 	}
 
+	//bech32格式的地址转换成链上使用的base58格式
+	from, err := address.NormalizeAddress(SendToAddress.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := address.NormalizeAddress(SendToAddress.To)
+	if err != nil {
+		return nil, err
+	}
+	SendToAddress.From, SendToAddress.To = from, to
+
 	ok, err := wallet.IsTransfer(SendToAddress.GetTo())
 	if !ok {
 		return nil, err
@@ -726,7 +783,7 @@ func (wallet *Wallet) ProcMergeBalance(MergeBalance *types.ReqWalletMergeBalance
 			exec = []byte(types.GetTitle() + "coins")
 			toAddr = address.ExecAddress(string(exec))
 		}
-		tx := &types.Transaction{Execer: exec, Payload: types.Encode(transfer), Fee: wallet.FeeAmount, To: toAddr, Nonce: wallet.random.Int63()}
+		tx := &types.Transaction{Execer: exec, Payload: types.Encode(transfer), Fee: wallet.FeeAmount, To: toAddr, Nonce: wallet.random.Int63(), ChainID: types.GetChainID()}
 		tx.SetExpire(time.Second * 120)
 		tx.Sign(int32(SignType), priv)
 		//walletlog.Info("ProcMergeBalance", "tx.Nonce", tx.Nonce, "tx", tx, "index", index)
@@ -956,8 +1013,6 @@ func (wallet *Wallet) ProcWalletAddBlock(block *types.BlockDetail) {
 		} else { // 默认的执行器类型处理
 			// TODO: 钱包基础功能模块，将会重新建立一个处理策略，将钱包变成一个容器
 			//获取from地址
-			pubkey := block.Block.Txs[index].Signature.GetPubkey()
-			addr := address.PubKeyToAddress(pubkey)
 			param := &buildStoreWalletTxDetailParam{
 				tokenname:  "",
 				block:      block,
@@ -969,7 +1024,7 @@ func (wallet *Wallet) ProcWalletAddBlock(block *types.BlockDetail) {
 				//utxos:      nil,
 			}
 			//from addr
-			fromaddress := addr.String()
+			fromaddress := tx.From()
 			param.senderRecver = fromaddress
 			if len(fromaddress) != 0 && wallet.AddrInWallet(fromaddress) {
 				param.sendRecvFlag = sendTx
@@ -1032,6 +1087,9 @@ func (wallet *Wallet) buildAndStoreWalletTxDetail(param *buildStoreWalletTxDetai
 			walletlog.Error("buildAndStoreWalletTxDetail Amount err", "Height", param.block.Block.Height, "index", param.index)
 		}
 		txdetail.Fromaddr = param.senderRecver
+		if param.sendRecvFlag == recvTx {
+			txdetail.Note = wallet.decryptTxNote(param.tx)
+		}
 		//txdetail.Spendrecv = param.utxos
 
 		txdetailbyte, err := proto.Marshal(&txdetail)
@@ -1074,9 +1132,7 @@ func (wallet *Wallet) ProcWalletDelBlock(block *types.BlockDetail) {
 		} else { // 默认的合约处理流程
 			// TODO:将钱包基础功能移动到专属钱包基础业务的模块中，将钱包模块变成容器
 			//获取from地址
-			pubkey := tx.Signature.GetPubkey()
-			addr := address.PubKeyToAddress(pubkey)
-			fromaddress := addr.String()
+			fromaddress := tx.From()
 			if len(fromaddress) != 0 && wallet.AddrInWallet(fromaddress) {
 				newbatch.Delete(wcom.CalcTxKey(heightstr))
 				continue
@@ -1280,10 +1336,39 @@ func (wallet *Wallet) ProcDumpPrivkey(addr string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	//HSM和TSS账户没有可以导出的私钥
+	if len(priv.Bytes()) == 0 {
+		return "", types.ErrNotSupport
+	}
 	return common.ToHex(priv.Bytes()), nil
 	//return strings.ToUpper(common.ToHex(priv.Bytes())), nil
 }
 
+//ProcDeleteAccount 从钱包中删除地址对应的账户，删除以后私钥不能恢复，seed生成的账户可以重新生成
+func (wallet *Wallet) ProcDeleteAccount(req *types.ReqString) (*types.Reply, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	ok, err := wallet.CheckWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	if req == nil || len(req.Data) == 0 {
+		walletlog.Error("ProcDeleteAccount input para is nil!")
+		return nil, types.ErrInvalidParam
+	}
+	account, err := wallet.walletStore.GetAccountByAddr(req.Data)
+	if err != nil {
+		return nil, types.ErrAccountNotExist
+	}
+	err = wallet.walletStore.DelAccount(req.Data, account)
+	if err != nil {
+		walletlog.Error("ProcDeleteAccount", "DelAccount err", err)
+		return nil, err
+	}
+	return &types.Reply{IsOk: true}, nil
+}
+
 //收到其他模块上报的系统有致命性故障，需要通知前端
 func (wallet *Wallet) setFatalFailure(reportErrEvent *types.ReportErrEvent) {
 
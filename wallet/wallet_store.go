@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 
 	"github.com/33cn/chain33/common/db"
+	"github.com/golang/protobuf/proto"
 	"github.com/33cn/chain33/types"
 	wcom "github.com/33cn/chain33/wallet/common"
 )
@@ -73,3 +74,45 @@ func (ws *walletStore) GetWalletPassword() string {
 	}
 	return string(passwordbytes)
 }
+
+// SetTSSAccount 保存导入的TSS账户，只保存keyId和公钥
+func (ws *walletStore) SetTSSAccount(addr string, acc *types.ReqWalletImportTSSAccount) error {
+	err := ws.GetDB().SetSync(CalcTSSAccountKey(addr), types.Encode(acc))
+	if err != nil {
+		storelog.Error("SetTSSAccount", "SetSync error", err)
+		return err
+	}
+	return nil
+}
+
+// GetTSSAccount 获取addr对应的TSS账户
+func (ws *walletStore) GetTSSAccount(addr string) (*types.ReqWalletImportTSSAccount, error) {
+	accbytes, err := ws.Get(CalcTSSAccountKey(addr))
+	if accbytes == nil || err != nil {
+		return nil, types.ErrAccountNotExist
+	}
+	var acc types.ReqWalletImportTSSAccount
+	err = proto.Unmarshal(accbytes, &acc)
+	if err != nil {
+		storelog.Error("GetTSSAccount", "proto.Unmarshal err:", err)
+		return nil, types.ErrUnmarshal
+	}
+	return &acc, nil
+}
+
+// GetTSSAccounts 获取钱包中所有的TSS账户
+func (ws *walletStore) GetTSSAccounts() []*types.ReqWalletImportTSSAccount {
+	list := ws.NewListHelper()
+	accbytes := list.PrefixScan(CalcTSSAccountKey(""))
+	var accounts []*types.ReqWalletImportTSSAccount
+	for _, accbyte := range accbytes {
+		var acc types.ReqWalletImportTSSAccount
+		err := proto.Unmarshal(accbyte, &acc)
+		if err != nil {
+			storelog.Error("GetTSSAccounts", "proto.Unmarshal err:", err)
+			continue
+		}
+		accounts = append(accounts, &acc)
+	}
+	return accounts
+}
@@ -5,18 +5,24 @@
 package wallet
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/store"
 	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/system/crypto/schnorr"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/wallet/bipwallet"
 	wcom "github.com/33cn/chain33/wallet/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -131,6 +137,8 @@ func mempoolModProc(q queue.Queue) {
 			//walletlog.Info("mempool", "msg.Ty", msg.Ty)
 			if msg.Ty == types.EventTx {
 				msg.Reply(client.NewMessage("wallet", types.EventReply, &types.Reply{IsOk: true}))
+			} else if msg.Ty == types.EventGetProperFee {
+				msg.Reply(client.NewMessage("wallet", types.EventReplyProperFee, &types.ReplyProperFee{ProperFee: 1000000, MinTxFee: 100000}))
 			}
 		}
 	}()
@@ -166,6 +174,7 @@ func TestWallet(t *testing.T) {
 	testProcCreateNewAccount(t, wallet)
 
 	testProcImportPrivKey(t, wallet)
+	testProcDeleteAccount(t, wallet)
 	//wait data sync
 	testProcWalletTxList(t, wallet)
 
@@ -183,6 +192,7 @@ func TestWallet(t *testing.T) {
 
 	testProcWalletAddBlock(t, wallet)
 	testSignRawTx(t, wallet)
+	testSignTypedData(t, wallet)
 	testsetFatalFailure(t, wallet)
 	testgetFatalFailure(t, wallet)
 
@@ -333,6 +343,18 @@ func testProcCreateNewAccount(t *testing.T, wallet *Wallet) {
 			return
 		}
 	}
+
+	//按bech32格式显示地址
+	msgGetAccList = wallet.client.NewMessage("wallet", types.EventWalletGetAccountList, &types.ReqAccountList{WithoutBalance: true, AddrFormat: address.FormatBech32})
+	wallet.client.Send(msgGetAccList, true)
+	resp, err = wallet.client.Wait(msgGetAccList)
+	assert.Nil(t, err)
+	for i, acc1 := range resp.GetData().(*types.WalletAccounts).Wallets {
+		a, err := address.NewAddrFromString(acc1.Acc.Addr)
+		require.NoError(t, err)
+		assert.Equal(t, accountlist.Wallets[i].Acc.Addr, a.String())
+		assert.Equal(t, a.Bech32(), acc1.Acc.Addr)
+	}
 	println("TestProcCreateNewAccount end")
 	println("--------------------------")
 }
@@ -408,6 +430,31 @@ func testProcImportPrivKey(t *testing.T, wallet *Wallet) {
 	println("--------------------------")
 }
 
+func testProcDeleteAccount(t *testing.T, wallet *Wallet) {
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	require.NoError(t, err)
+	priv, err := cr.GenKey()
+	require.NoError(t, err)
+	acc, err := wallet.ProcImportPrivKey(&types.ReqWalletImportPrivkey{Privkey: common.ToHex(priv.Bytes()), Label: "DeleteAccount-Label"})
+	require.NoError(t, err)
+
+	_, err = wallet.ProcDeleteAccount(&types.ReqString{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	reply, err := wallet.ProcDeleteAccount(&types.ReqString{Data: acc.Acc.Addr})
+	require.NoError(t, err)
+	assert.True(t, reply.IsOk)
+	_, err = wallet.walletStore.GetAccountByAddr(acc.Acc.Addr)
+	assert.Equal(t, types.ErrAddrNotExist, err)
+	_, err = wallet.walletStore.GetAccountByLabel("DeleteAccount-Label")
+	assert.Error(t, err)
+	_, err = wallet.ProcDeleteAccount(&types.ReqString{Data: acc.Acc.Addr})
+	assert.Equal(t, types.ErrAccountNotExist, err)
+
+	//删除以后可以重新导入
+	_, err = wallet.ProcImportPrivKey(&types.ReqWalletImportPrivkey{Privkey: common.ToHex(priv.Bytes()), Label: "DeleteAccount-Label"})
+	require.NoError(t, err)
+}
+
 func testProcWalletTxList(t *testing.T, wallet *Wallet) {
 	println("TestProcWalletTxList begin")
 	txList := &types.ReqWalletTransactionList{
@@ -720,6 +767,26 @@ func testSignRawTx(t *testing.T, wallet *Wallet) {
 	println("--------------------------")
 }
 
+func testSignTypedData(t *testing.T, wallet *Wallet) {
+	println("testSignTypedData begin")
+	data := `{"types":{"EIP712Domain":[{"name":"name","type":"string"}],"Order":[{"name":"owner","type":"address"},{"name":"amount","type":"uint256"}]},
+		"primaryType":"Order","domain":{"name":"dex"},"message":{"owner":"` + FromAddr + `","amount":"100000000"}}`
+	reply, err := wallet.ProcSignTypedData(&types.ReqSignTypedData{Addr: FromAddr, TypedData: data})
+	require.NoError(t, err)
+	td, err := types.ParseTypedData([]byte(data))
+	require.NoError(t, err)
+	pub, _ := common.FromHex(reply.PubKey)
+	sig, _ := common.FromHex(reply.Signature)
+	ok, err := types.VerifyTypedData(td, reply.Ty, pub, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = wallet.ProcSignTypedData(&types.ReqSignTypedData{Addr: FromAddr, TypedData: "{}"})
+	require.Equal(t, types.ErrTypedData, err)
+	println("testSignTypedData end")
+	println("--------------------------")
+}
+
 // setFatalFailure
 func testsetFatalFailure(t *testing.T, wallet *Wallet) {
 	println("testsetFatalFailure begin")
@@ -816,3 +883,160 @@ func testSendTx(t *testing.T, wallet *Wallet) {
 	assert.Equal(t, types.ErrActionNotSupport, err)
 
 }
+
+func TestEd25519Seed(t *testing.T) {
+	SignType = 2
+	defer func() { SignType = 1 }()
+	dir, db, _ := util.CreateTestDB()
+	defer util.CloseTestDB(dir, db)
+	seed := "cushion canal bitter result harvest sentence ability time steel basket useful ask depth sorry area course purpose search exile chapter mountain project ranch buffalo"
+	privhex, err := GetPrivkeyBySeed(db, seed)
+	require.NoError(t, err)
+	privbyte, err := common.FromHex(privhex)
+	require.NoError(t, err)
+
+	//钱包生成的地址和交易签名使用的地址相同
+	pub, err := bipwallet.PrivkeyToPub(bipwallet.TypeYcc, privbyte)
+	require.NoError(t, err)
+	addr, err := bipwallet.PubToAddress(bipwallet.TypeYcc, pub)
+	require.NoError(t, err)
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	require.NoError(t, err)
+	priv, err := cr.PrivKeyFromBytes(privbyte)
+	require.NoError(t, err)
+	assert.Equal(t, address.PubKeyToAddress(priv.PubKey().Bytes()).String(), addr)
+
+	tx := &types.Transaction{Execer: []byte("none"), Payload: []byte("none"), Fee: 1e6, To: address.ExecAddress("none")}
+	tx.Sign(int32(SignType), priv)
+	assert.Equal(t, int32(types.ED25519), tx.Signature.Ty)
+	assert.Equal(t, addr, tx.From())
+	assert.True(t, tx.CheckSign())
+}
+
+func TestMuSigSign(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	require.NoError(t, err)
+	var wallets []*Wallet
+	var privs []crypto.PrivKey
+	var pubKeys []string
+	for i := 0; i < 2; i++ {
+		priv, err := cr.GenKey()
+		require.NoError(t, err)
+		privs = append(privs, priv)
+		pubKeys = append(pubKeys, common.ToHex(priv.PubKey().Bytes()))
+		wallets = append(wallets, &Wallet{musigs: make(map[string]*musigSession)})
+	}
+	agg, err := wallets[0].ProcMuSigAggregate(&types.ReqMuSigPubKeys{PubKeys: pubKeys})
+	require.NoError(t, err)
+	pubs, err := parseMuSigPubKeys(pubKeys)
+	require.NoError(t, err)
+
+	//每个签名者在自己的钱包中开始会话，然后交换承诺、随机数和部分签名
+	tx := &types.Transaction{Execer: []byte("none"), Payload: []byte("none"), Fee: 1e6, To: address.ExecAddress("none")}
+	var sessions []string
+	var peers []*types.MuSigPeerData
+	for i, w := range wallets {
+		musig, err := schnorr.NewMuSig(privs[i], pubs, types.Encode(tx))
+		require.NoError(t, err)
+		session := fmt.Sprintf("session-%d", i)
+		w.musigs[session] = &musigSession{musig: musig, tx: tx}
+		sessions = append(sessions, session)
+		peers = append(peers, &types.MuSigPeerData{PubKey: pubKeys[i], Data: common.ToHex(musig.Commitment())})
+	}
+	steps := []func(w *Wallet, req *types.ReqMuSigStep) (*types.ReplyMuSigStep, error){
+		(*Wallet).ProcMuSigNonce, (*Wallet).ProcMuSigPartialSign,
+	}
+	for _, step := range steps {
+		var next []*types.MuSigPeerData
+		for i, w := range wallets {
+			reply, err := step(w, &types.ReqMuSigStep{Session: sessions[i], Peers: []*types.MuSigPeerData{peers[1-i]}})
+			require.NoError(t, err)
+			next = append(next, &types.MuSigPeerData{PubKey: pubKeys[i], Data: reply.Data})
+		}
+		peers = next
+	}
+	reply, err := wallets[0].ProcMuSigCombine(&types.ReqMuSigStep{Session: sessions[0], Peers: []*types.MuSigPeerData{peers[1]}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(wallets[0].musigs))
+	txByte, err := common.FromHex(reply.Data)
+	require.NoError(t, err)
+	var signed types.Transaction
+	require.NoError(t, types.Decode(txByte, &signed))
+	assert.Equal(t, int32(schnorr.ID), signed.Signature.Ty)
+	assert.Equal(t, agg.Addr, signed.From())
+	assert.True(t, signed.CheckSign())
+
+	//错误的部分签名使会话失效
+	_, err = wallets[1].ProcMuSigCombine(&types.ReqMuSigStep{Session: sessions[1], Peers: []*types.MuSigPeerData{peers[1]}})
+	assert.NotNil(t, err)
+	_, err = wallets[1].ProcMuSigCombine(&types.ReqMuSigStep{Session: sessions[1], Peers: []*types.MuSigPeerData{peers[0]}})
+	assert.Equal(t, types.ErrMuSigSession, err)
+}
+
+func TestTSSSigner(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	require.NoError(t, err)
+	priv, err := cr.GenKey()
+	require.NoError(t, err)
+	//模拟协调者，真实环境中由多个参与方共同完成签名
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req tssSignReq
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.KeyID != "key1" {
+			json.NewEncoder(w).Encode(&tssSignResp{Error: "unknown key"})
+			return
+		}
+		msg, _ := common.FromHex(req.Msg)
+		json.NewEncoder(w).Encode(&tssSignResp{Signature: common.ToHex(priv.Sign(msg).Bytes())})
+	}))
+	defer srv.Close()
+
+	wallet := &Wallet{
+		cfg:         &types.Wallet{TssCoordinator: srv.URL},
+		walletStore: newStore(db.NewDB("tss", "memdb", "", 0)),
+	}
+	acc := &types.ReqWalletImportTSSAccount{KeyId: "key1", PubKey: common.ToHex(priv.PubKey().Bytes()), Label: "tss"}
+	addr := address.PubKeyToAddr(priv.PubKey().Bytes())
+	require.NoError(t, wallet.walletStore.SetTSSAccount(addr, acc))
+	require.Equal(t, 1, len(wallet.walletStore.GetTSSAccounts()))
+
+	signer, err := wallet.getPrivKeyByAddr(addr)
+	require.NoError(t, err)
+	tx := &types.Transaction{Execer: []byte("none"), Payload: []byte("none"), Fee: 1e6, To: address.ExecAddress("none")}
+	tx.Sign(int32(SignType), signer)
+	require.True(t, tx.CheckSign())
+	require.Equal(t, addr, tx.From())
+
+	//协调者拒绝签名时交易的签名检查不通过
+	acc.KeyId = "key2"
+	require.NoError(t, wallet.walletStore.SetTSSAccount(addr, acc))
+	signer, err = wallet.getPrivKeyByAddr(addr)
+	require.NoError(t, err)
+	tx.Sign(int32(SignType), signer)
+	require.False(t, tx.CheckSign())
+}
+
+func TestDecryptTxNote(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	require.NoError(t, err)
+	priv, err := cr.GenKey()
+	require.NoError(t, err)
+	addr := address.PubKeyToAddr(priv.PubKey().Bytes())
+
+	wallet := &Wallet{walletStore: newStore(db.NewDB("note", "memdb", "", 0)), Password: "password123"}
+	wallet.initFlag = 1
+	encrypted := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), priv.Bytes())
+	acc := &types.WalletAccountStore{Privkey: common.ToHex(encrypted), Addr: addr, Label: "note"}
+	require.NoError(t, wallet.walletStore.SetWalletAccount(false, addr, acc))
+
+	create := &types.CreateTx{To: addr, Amount: 1e8, Note: []byte("secret"), NotePubKey: common.ToHex(priv.PubKey().Bytes())}
+	tx, err := types.LoadExecutorType("coins").AssertCreate(create)
+	require.NoError(t, err)
+	tx.Execer = []byte("coins")
+	tx.To = addr
+	require.NotEmpty(t, tx.EncryptedNote())
+	require.Equal(t, []byte("secret"), wallet.decryptTxNote(tx))
+
+	//没有密码时无法解密
+	wallet.Password = ""
+	require.Nil(t, wallet.decryptTxNote(tx))
+}